@@ -2,12 +2,15 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
+	// Backup schedules name IANA time zones; the runtime image has no zoneinfo.
+	_ "time/tzdata"
 
 	"smarticky/ent"
 	"smarticky/ent/migrate"
@@ -51,6 +54,20 @@ func getDatabasePath() string {
 	return fmt.Sprintf("file:%s?cache=shared&_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=synchronous(NORMAL)&_pragma=busy_timeout(10000)", dbPath)
 }
 
+// embedderFromEnv returns an OpenAI-compatible embedder when
+// SMARTICKY_EMBEDDING_URL is set, or nil to keep the local default.
+func embedderFromEnv() (searchsvc.Embedder, error) {
+	endpoint := strings.TrimSpace(os.Getenv("SMARTICKY_EMBEDDING_URL"))
+	if endpoint == "" {
+		return nil, nil
+	}
+	return searchsvc.NewHTTPEmbedder(searchsvc.HTTPEmbedderConfig{
+		Endpoint: endpoint,
+		Model:    os.Getenv("SMARTICKY_EMBEDDING_MODEL"),
+		APIKey:   os.Getenv("SMARTICKY_EMBEDDING_API_KEY"),
+	})
+}
+
 func main() {
 	// 1. Initialize data directory
 	dataDir := getDataDir()
@@ -85,10 +102,19 @@ func main() {
 	if err != nil {
 		zap.L().Warn("Failed to initialize note search index", zap.Error(err))
 		searchService = nil
-	} else if err := searchService.Rebuild(context.Background(), client); err != nil {
-		zap.L().Warn("Failed to rebuild note search index", zap.Error(err))
-		_ = searchService.Close()
-		searchService = nil
+	} else {
+		if embedder, err := embedderFromEnv(); err != nil {
+			zap.L().Warn("Invalid embedding configuration, using local embeddings", zap.Error(err))
+		} else if embedder != nil {
+			searchService.SetEmbedder(embedder)
+		}
+		if err := searchService.Rebuild(context.Background(), client); errors.Is(err, searchsvc.ErrSemanticIndex) {
+			zap.L().Warn("Failed to rebuild some note embeddings", zap.Error(err))
+		} else if err != nil {
+			zap.L().Warn("Failed to rebuild note search index", zap.Error(err))
+			_ = searchService.Close()
+			searchService = nil
+		}
 	}
 	if searchService != nil {
 		defer searchService.Close()
@@ -120,6 +146,9 @@ func main() {
 	// Start automatic backup scheduler
 	h.StartAutoBackup()
 
+	// Start note connection job workers and scheduled syncs
+	h.StartNoteConnectionQueue()
+
 	// 4. Routes
 	// API
 	api := e.Group("/api")
@@ -128,6 +157,8 @@ func main() {
 	api.GET("/setup/check", h.CheckSetup)
 	api.POST("/setup", h.Setup)
 	api.POST("/auth/login", h.Login)
+	api.GET("/note-connections/oauth/notion/callback", h.NotionOAuthCallback)
+	api.POST("/note-connections/webhooks/:id", h.ReceiveNoteConnectionWebhook)
 
 	// Version info endpoint (public)
 	api.GET("/version", func(c echo.Context) error {
@@ -151,9 +182,22 @@ func main() {
 	// Notes API
 	protected.GET("/notes", h.ListNotes)
 	protected.GET("/note-links", h.ListNoteLinkGraph)
+	protected.GET("/note-links/suggestions", h.SuggestNoteLinkTargets)
+	protected.GET("/note-links/unresolved", h.ListUnresolvedNoteLinks)
+	protected.POST("/note-links/fix", h.FixNoteLinks)
+	protected.GET("/note-links/orphans", h.ListGraphOrphans)
+	protected.GET("/note-links/dangling", h.ListGraphDanglingTargets)
+	protected.GET("/note-links/hubs", h.ListGraphHubs)
+	protected.GET("/note-links/clusters", h.ListGraphClusters)
+	protected.GET("/note-links/path", h.GetGraphPath)
+	protected.GET("/link-rewrites", h.ListLinkRewrites)
+	protected.POST("/link-rewrites/:id/undo", h.UndoLinkRewrite)
 	protected.POST("/notes", h.CreateNote)
 	protected.POST("/notes/move", h.MoveNotes)
 	protected.GET("/notes/:id/links", h.GetNoteLinks)
+	protected.GET("/notes/:id/related", h.ListRelatedNotes)
+	protected.GET("/notes/:id/neighborhood", h.GetNoteNeighborhood)
+	protected.POST("/notes/:id/rename-links", h.RewriteRenamedNoteLinks)
 	protected.GET("/notes/:id", h.GetNote)
 	protected.PUT("/notes/:id", h.UpdateNote)
 	protected.DELETE("/notes/trash", h.EmptyTrash)
@@ -208,8 +252,20 @@ func main() {
 	protected.POST("/note-connections/accounts/:id/test", h.TestNoteConnectionAccount)
 	protected.GET("/note-connections/accounts/:id/targets", h.ListNoteConnectionTargets)
 	protected.POST("/note-connections/accounts/:id/import", h.ImportNoteConnection)
+	protected.POST("/note-connections/accounts/:id/import/preview", h.PreviewNoteConnectionImport)
+	protected.POST("/note-connections/accounts/:id/import/confirm", h.ConfirmNoteConnectionImport)
 	protected.POST("/note-connections/accounts/:id/push", h.PushNoteConnection)
+	protected.POST("/note-connections/accounts/:id/sync", h.SyncNoteConnection)
+	protected.POST("/note-connections/accounts/:id/webhook", h.RotateNoteConnectionWebhook)
+	protected.DELETE("/note-connections/accounts/:id/webhook", h.DisableNoteConnectionWebhook)
+	protected.GET("/note-connections/accounts/:id/conflicts", h.ListNoteConnectionConflicts)
+	protected.POST("/note-connections/accounts/:id/conflicts/:conflictId/resolve", h.ResolveNoteConnectionConflict)
+	protected.GET("/note-connections/oauth", h.ListNoteConnectionOAuthProviders)
+	protected.POST("/note-connections/oauth/notion/start", h.StartNotionOAuth)
+	protected.POST("/note-connections/oauth/notion/complete", h.CompleteNotionOAuth)
 	protected.GET("/note-connections/jobs", h.ListNoteConnectionJobs)
+	protected.GET("/note-connections/jobs/:jobId", h.GetNoteConnectionJob)
+	protected.POST("/note-connections/jobs/:jobId/cancel", h.CancelNoteConnectionJob)
 
 	// Fonts API
 	protected.POST("/fonts", h.UploadFont)
@@ -240,12 +296,33 @@ func main() {
 	protected.GET("/backup/targets/:id/files", h.ListBackupTargetFiles)
 	protected.POST("/backup/targets/:id/verify", h.VerifyBackupTargetFile)
 	protected.POST("/backup/targets/:id/restore", h.RestoreBackupTargetFile)
+	protected.POST("/backup/targets/:id/drill", h.RunBackupTargetDrill)
+	protected.GET("/backup/targets/:id/drills", h.ListBackupTargetDrills)
+	protected.POST("/backup/targets/:id/pins", h.PinBackupTargetFile)
+	protected.DELETE("/backup/targets/:id/pins/:filename", h.UnpinBackupTargetFile)
+
+	restoreSessionRoutes := protected.Group("/backup/restore-sessions")
+	restoreSessionRoutes.Use(authmw.AdminOnly())
+	restoreSessionRoutes.POST("", h.OpenBackupRestoreSession)
+	restoreSessionRoutes.GET("/:session", h.GetBackupRestoreSession)
+	restoreSessionRoutes.GET("/:session/users/:id", h.ListBackupRestoreItems)
+	restoreSessionRoutes.POST("/:session/import", h.ImportBackupRestoreItems)
+	restoreSessionRoutes.DELETE("/:session", h.CloseBackupRestoreSession)
 
 	protected.GET("/backup/tasks", h.ListBackupTasks)
 	protected.POST("/backup/tasks", h.CreateBackupTask)
+	protected.POST("/backup/tasks/schedule-preview", h.PreviewBackupSchedule)
 	protected.PUT("/backup/tasks/:id", h.UpdateBackupTask)
 	protected.DELETE("/backup/tasks/:id", h.DeleteBackupTask)
 	protected.POST("/backup/tasks/:id/run", h.RunBackupTask)
+	protected.POST("/backup/tasks/:id/retention-preview", h.PreviewBackupTaskRetention)
+	protected.GET("/backup/runs", h.ListBackupRuns)
+
+	backupAlertRoutes := protected.Group("/backup/alerts")
+	backupAlertRoutes.Use(authmw.AdminOnly())
+	backupAlertRoutes.GET("", h.GetBackupAlertSettings)
+	backupAlertRoutes.PUT("", h.UpdateBackupAlertSettings)
+	backupAlertRoutes.POST("/test", h.TestBackupAlerts)
 
 	// Serve uploaded files from data directory
 	uploadsDir := filepath.Join(getDataDir(), "uploads")
@@ -45,6 +45,18 @@ type BackupConfig struct {
 	FolderMaxDepth int `json:"folder_max_depth,omitempty"`
 	// Whether legacy backup config has been migrated to targets/tasks
 	BackupTargetsMigrated bool `json:"backup_targets_migrated,omitempty"`
+	// Backup alerts are POSTed here as JSON; sealed with the server secret box
+	AlertWebhookURL string `json:"-"`
+	// Mail relay for backup alerts, used without authentication
+	AlertSMTPHost string `json:"alert_smtp_host,omitempty"`
+	// AlertSMTPPort holds the value of the "alert_smtp_port" field.
+	AlertSMTPPort int `json:"alert_smtp_port,omitempty"`
+	// AlertSMTPFrom holds the value of the "alert_smtp_from" field.
+	AlertSMTPFrom string `json:"alert_smtp_from,omitempty"`
+	// Comma separated recipients
+	AlertSMTPTo string `json:"alert_smtp_to,omitempty"`
+	// Alert when a backup run fails
+	AlertOnFailure bool `json:"alert_on_failure,omitempty"`
 	// LastBackupAt holds the value of the "last_backup_at" field.
 	LastBackupAt time.Time `json:"last_backup_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -59,11 +71,11 @@ func (*BackupConfig) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case backupconfig.FieldAutoBackupEnabled, backupconfig.FieldBackupTargetsMigrated:
+		case backupconfig.FieldAutoBackupEnabled, backupconfig.FieldBackupTargetsMigrated, backupconfig.FieldAlertOnFailure:
 			values[i] = new(sql.NullBool)
-		case backupconfig.FieldID, backupconfig.FieldBackupRetentionDays, backupconfig.FieldBackupMaxCount, backupconfig.FieldFolderMaxDepth:
+		case backupconfig.FieldID, backupconfig.FieldBackupRetentionDays, backupconfig.FieldBackupMaxCount, backupconfig.FieldFolderMaxDepth, backupconfig.FieldAlertSMTPPort:
 			values[i] = new(sql.NullInt64)
-		case backupconfig.FieldWebdavURL, backupconfig.FieldWebdavUser, backupconfig.FieldWebdavPassword, backupconfig.FieldS3Endpoint, backupconfig.FieldS3Region, backupconfig.FieldS3Bucket, backupconfig.FieldS3AccessKey, backupconfig.FieldS3SecretKey, backupconfig.FieldBackupSchedule:
+		case backupconfig.FieldWebdavURL, backupconfig.FieldWebdavUser, backupconfig.FieldWebdavPassword, backupconfig.FieldS3Endpoint, backupconfig.FieldS3Region, backupconfig.FieldS3Bucket, backupconfig.FieldS3AccessKey, backupconfig.FieldS3SecretKey, backupconfig.FieldBackupSchedule, backupconfig.FieldAlertWebhookURL, backupconfig.FieldAlertSMTPHost, backupconfig.FieldAlertSMTPFrom, backupconfig.FieldAlertSMTPTo:
 			values[i] = new(sql.NullString)
 		case backupconfig.FieldLastBackupAt, backupconfig.FieldCreatedAt, backupconfig.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -172,6 +184,42 @@ func (_m *BackupConfig) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.BackupTargetsMigrated = value.Bool
 			}
+		case backupconfig.FieldAlertWebhookURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_webhook_url", values[i])
+			} else if value.Valid {
+				_m.AlertWebhookURL = value.String
+			}
+		case backupconfig.FieldAlertSMTPHost:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_smtp_host", values[i])
+			} else if value.Valid {
+				_m.AlertSMTPHost = value.String
+			}
+		case backupconfig.FieldAlertSMTPPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_smtp_port", values[i])
+			} else if value.Valid {
+				_m.AlertSMTPPort = int(value.Int64)
+			}
+		case backupconfig.FieldAlertSMTPFrom:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_smtp_from", values[i])
+			} else if value.Valid {
+				_m.AlertSMTPFrom = value.String
+			}
+		case backupconfig.FieldAlertSMTPTo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_smtp_to", values[i])
+			} else if value.Valid {
+				_m.AlertSMTPTo = value.String
+			}
+		case backupconfig.FieldAlertOnFailure:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_on_failure", values[i])
+			} else if value.Valid {
+				_m.AlertOnFailure = value.Bool
+			}
 		case backupconfig.FieldLastBackupAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field last_backup_at", values[i])
@@ -266,6 +314,23 @@ func (_m *BackupConfig) String() string {
 	builder.WriteString("backup_targets_migrated=")
 	builder.WriteString(fmt.Sprintf("%v", _m.BackupTargetsMigrated))
 	builder.WriteString(", ")
+	builder.WriteString("alert_webhook_url=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("alert_smtp_host=")
+	builder.WriteString(_m.AlertSMTPHost)
+	builder.WriteString(", ")
+	builder.WriteString("alert_smtp_port=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AlertSMTPPort))
+	builder.WriteString(", ")
+	builder.WriteString("alert_smtp_from=")
+	builder.WriteString(_m.AlertSMTPFrom)
+	builder.WriteString(", ")
+	builder.WriteString("alert_smtp_to=")
+	builder.WriteString(_m.AlertSMTPTo)
+	builder.WriteString(", ")
+	builder.WriteString("alert_on_failure=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AlertOnFailure))
+	builder.WriteString(", ")
 	builder.WriteString("last_backup_at=")
 	builder.WriteString(_m.LastBackupAt.Format(time.ANSIC))
 	builder.WriteString(", ")
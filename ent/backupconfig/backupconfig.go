@@ -41,6 +41,18 @@ const (
 	FieldFolderMaxDepth = "folder_max_depth"
 	// FieldBackupTargetsMigrated holds the string denoting the backup_targets_migrated field in the database.
 	FieldBackupTargetsMigrated = "backup_targets_migrated"
+	// FieldAlertWebhookURL holds the string denoting the alert_webhook_url field in the database.
+	FieldAlertWebhookURL = "alert_webhook_url"
+	// FieldAlertSMTPHost holds the string denoting the alert_smtp_host field in the database.
+	FieldAlertSMTPHost = "alert_smtp_host"
+	// FieldAlertSMTPPort holds the string denoting the alert_smtp_port field in the database.
+	FieldAlertSMTPPort = "alert_smtp_port"
+	// FieldAlertSMTPFrom holds the string denoting the alert_smtp_from field in the database.
+	FieldAlertSMTPFrom = "alert_smtp_from"
+	// FieldAlertSMTPTo holds the string denoting the alert_smtp_to field in the database.
+	FieldAlertSMTPTo = "alert_smtp_to"
+	// FieldAlertOnFailure holds the string denoting the alert_on_failure field in the database.
+	FieldAlertOnFailure = "alert_on_failure"
 	// FieldLastBackupAt holds the string denoting the last_backup_at field in the database.
 	FieldLastBackupAt = "last_backup_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -68,6 +80,12 @@ var Columns = []string{
 	FieldBackupMaxCount,
 	FieldFolderMaxDepth,
 	FieldBackupTargetsMigrated,
+	FieldAlertWebhookURL,
+	FieldAlertSMTPHost,
+	FieldAlertSMTPPort,
+	FieldAlertSMTPFrom,
+	FieldAlertSMTPTo,
+	FieldAlertOnFailure,
 	FieldLastBackupAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -96,6 +114,10 @@ var (
 	DefaultFolderMaxDepth int
 	// DefaultBackupTargetsMigrated holds the default value on creation for the "backup_targets_migrated" field.
 	DefaultBackupTargetsMigrated bool
+	// DefaultAlertSMTPPort holds the default value on creation for the "alert_smtp_port" field.
+	DefaultAlertSMTPPort int
+	// DefaultAlertOnFailure holds the default value on creation for the "alert_on_failure" field.
+	DefaultAlertOnFailure bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -182,6 +204,36 @@ func ByBackupTargetsMigrated(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBackupTargetsMigrated, opts...).ToFunc()
 }
 
+// ByAlertWebhookURL orders the results by the alert_webhook_url field.
+func ByAlertWebhookURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlertWebhookURL, opts...).ToFunc()
+}
+
+// ByAlertSMTPHost orders the results by the alert_smtp_host field.
+func ByAlertSMTPHost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlertSMTPHost, opts...).ToFunc()
+}
+
+// ByAlertSMTPPort orders the results by the alert_smtp_port field.
+func ByAlertSMTPPort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlertSMTPPort, opts...).ToFunc()
+}
+
+// ByAlertSMTPFrom orders the results by the alert_smtp_from field.
+func ByAlertSMTPFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlertSMTPFrom, opts...).ToFunc()
+}
+
+// ByAlertSMTPTo orders the results by the alert_smtp_to field.
+func ByAlertSMTPTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlertSMTPTo, opts...).ToFunc()
+}
+
+// ByAlertOnFailure orders the results by the alert_on_failure field.
+func ByAlertOnFailure(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlertOnFailure, opts...).ToFunc()
+}
+
 // ByLastBackupAt orders the results by the last_backup_at field.
 func ByLastBackupAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastBackupAt, opts...).ToFunc()
@@ -124,6 +124,36 @@ func BackupTargetsMigrated(v bool) predicate.BackupConfig {
 	return predicate.BackupConfig(sql.FieldEQ(FieldBackupTargetsMigrated, v))
 }
 
+// AlertWebhookURL applies equality check predicate on the "alert_webhook_url" field. It's identical to AlertWebhookURLEQ.
+func AlertWebhookURL(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEQ(FieldAlertWebhookURL, v))
+}
+
+// AlertSMTPHost applies equality check predicate on the "alert_smtp_host" field. It's identical to AlertSMTPHostEQ.
+func AlertSMTPHost(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEQ(FieldAlertSMTPHost, v))
+}
+
+// AlertSMTPPort applies equality check predicate on the "alert_smtp_port" field. It's identical to AlertSMTPPortEQ.
+func AlertSMTPPort(v int) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEQ(FieldAlertSMTPPort, v))
+}
+
+// AlertSMTPFrom applies equality check predicate on the "alert_smtp_from" field. It's identical to AlertSMTPFromEQ.
+func AlertSMTPFrom(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEQ(FieldAlertSMTPFrom, v))
+}
+
+// AlertSMTPTo applies equality check predicate on the "alert_smtp_to" field. It's identical to AlertSMTPToEQ.
+func AlertSMTPTo(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEQ(FieldAlertSMTPTo, v))
+}
+
+// AlertOnFailure applies equality check predicate on the "alert_on_failure" field. It's identical to AlertOnFailureEQ.
+func AlertOnFailure(v bool) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEQ(FieldAlertOnFailure, v))
+}
+
 // LastBackupAt applies equality check predicate on the "last_backup_at" field. It's identical to LastBackupAtEQ.
 func LastBackupAt(v time.Time) predicate.BackupConfig {
 	return predicate.BackupConfig(sql.FieldEQ(FieldLastBackupAt, v))
@@ -944,6 +974,356 @@ func BackupTargetsMigratedNEQ(v bool) predicate.BackupConfig {
 	return predicate.BackupConfig(sql.FieldNEQ(FieldBackupTargetsMigrated, v))
 }
 
+// AlertWebhookURLEQ applies the EQ predicate on the "alert_webhook_url" field.
+func AlertWebhookURLEQ(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEQ(FieldAlertWebhookURL, v))
+}
+
+// AlertWebhookURLNEQ applies the NEQ predicate on the "alert_webhook_url" field.
+func AlertWebhookURLNEQ(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNEQ(FieldAlertWebhookURL, v))
+}
+
+// AlertWebhookURLIn applies the In predicate on the "alert_webhook_url" field.
+func AlertWebhookURLIn(vs ...string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldIn(FieldAlertWebhookURL, vs...))
+}
+
+// AlertWebhookURLNotIn applies the NotIn predicate on the "alert_webhook_url" field.
+func AlertWebhookURLNotIn(vs ...string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNotIn(FieldAlertWebhookURL, vs...))
+}
+
+// AlertWebhookURLGT applies the GT predicate on the "alert_webhook_url" field.
+func AlertWebhookURLGT(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldGT(FieldAlertWebhookURL, v))
+}
+
+// AlertWebhookURLGTE applies the GTE predicate on the "alert_webhook_url" field.
+func AlertWebhookURLGTE(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldGTE(FieldAlertWebhookURL, v))
+}
+
+// AlertWebhookURLLT applies the LT predicate on the "alert_webhook_url" field.
+func AlertWebhookURLLT(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldLT(FieldAlertWebhookURL, v))
+}
+
+// AlertWebhookURLLTE applies the LTE predicate on the "alert_webhook_url" field.
+func AlertWebhookURLLTE(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldLTE(FieldAlertWebhookURL, v))
+}
+
+// AlertWebhookURLContains applies the Contains predicate on the "alert_webhook_url" field.
+func AlertWebhookURLContains(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldContains(FieldAlertWebhookURL, v))
+}
+
+// AlertWebhookURLHasPrefix applies the HasPrefix predicate on the "alert_webhook_url" field.
+func AlertWebhookURLHasPrefix(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldHasPrefix(FieldAlertWebhookURL, v))
+}
+
+// AlertWebhookURLHasSuffix applies the HasSuffix predicate on the "alert_webhook_url" field.
+func AlertWebhookURLHasSuffix(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldHasSuffix(FieldAlertWebhookURL, v))
+}
+
+// AlertWebhookURLIsNil applies the IsNil predicate on the "alert_webhook_url" field.
+func AlertWebhookURLIsNil() predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldIsNull(FieldAlertWebhookURL))
+}
+
+// AlertWebhookURLNotNil applies the NotNil predicate on the "alert_webhook_url" field.
+func AlertWebhookURLNotNil() predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNotNull(FieldAlertWebhookURL))
+}
+
+// AlertWebhookURLEqualFold applies the EqualFold predicate on the "alert_webhook_url" field.
+func AlertWebhookURLEqualFold(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEqualFold(FieldAlertWebhookURL, v))
+}
+
+// AlertWebhookURLContainsFold applies the ContainsFold predicate on the "alert_webhook_url" field.
+func AlertWebhookURLContainsFold(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldContainsFold(FieldAlertWebhookURL, v))
+}
+
+// AlertSMTPHostEQ applies the EQ predicate on the "alert_smtp_host" field.
+func AlertSMTPHostEQ(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEQ(FieldAlertSMTPHost, v))
+}
+
+// AlertSMTPHostNEQ applies the NEQ predicate on the "alert_smtp_host" field.
+func AlertSMTPHostNEQ(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNEQ(FieldAlertSMTPHost, v))
+}
+
+// AlertSMTPHostIn applies the In predicate on the "alert_smtp_host" field.
+func AlertSMTPHostIn(vs ...string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldIn(FieldAlertSMTPHost, vs...))
+}
+
+// AlertSMTPHostNotIn applies the NotIn predicate on the "alert_smtp_host" field.
+func AlertSMTPHostNotIn(vs ...string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNotIn(FieldAlertSMTPHost, vs...))
+}
+
+// AlertSMTPHostGT applies the GT predicate on the "alert_smtp_host" field.
+func AlertSMTPHostGT(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldGT(FieldAlertSMTPHost, v))
+}
+
+// AlertSMTPHostGTE applies the GTE predicate on the "alert_smtp_host" field.
+func AlertSMTPHostGTE(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldGTE(FieldAlertSMTPHost, v))
+}
+
+// AlertSMTPHostLT applies the LT predicate on the "alert_smtp_host" field.
+func AlertSMTPHostLT(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldLT(FieldAlertSMTPHost, v))
+}
+
+// AlertSMTPHostLTE applies the LTE predicate on the "alert_smtp_host" field.
+func AlertSMTPHostLTE(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldLTE(FieldAlertSMTPHost, v))
+}
+
+// AlertSMTPHostContains applies the Contains predicate on the "alert_smtp_host" field.
+func AlertSMTPHostContains(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldContains(FieldAlertSMTPHost, v))
+}
+
+// AlertSMTPHostHasPrefix applies the HasPrefix predicate on the "alert_smtp_host" field.
+func AlertSMTPHostHasPrefix(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldHasPrefix(FieldAlertSMTPHost, v))
+}
+
+// AlertSMTPHostHasSuffix applies the HasSuffix predicate on the "alert_smtp_host" field.
+func AlertSMTPHostHasSuffix(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldHasSuffix(FieldAlertSMTPHost, v))
+}
+
+// AlertSMTPHostIsNil applies the IsNil predicate on the "alert_smtp_host" field.
+func AlertSMTPHostIsNil() predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldIsNull(FieldAlertSMTPHost))
+}
+
+// AlertSMTPHostNotNil applies the NotNil predicate on the "alert_smtp_host" field.
+func AlertSMTPHostNotNil() predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNotNull(FieldAlertSMTPHost))
+}
+
+// AlertSMTPHostEqualFold applies the EqualFold predicate on the "alert_smtp_host" field.
+func AlertSMTPHostEqualFold(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEqualFold(FieldAlertSMTPHost, v))
+}
+
+// AlertSMTPHostContainsFold applies the ContainsFold predicate on the "alert_smtp_host" field.
+func AlertSMTPHostContainsFold(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldContainsFold(FieldAlertSMTPHost, v))
+}
+
+// AlertSMTPPortEQ applies the EQ predicate on the "alert_smtp_port" field.
+func AlertSMTPPortEQ(v int) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEQ(FieldAlertSMTPPort, v))
+}
+
+// AlertSMTPPortNEQ applies the NEQ predicate on the "alert_smtp_port" field.
+func AlertSMTPPortNEQ(v int) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNEQ(FieldAlertSMTPPort, v))
+}
+
+// AlertSMTPPortIn applies the In predicate on the "alert_smtp_port" field.
+func AlertSMTPPortIn(vs ...int) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldIn(FieldAlertSMTPPort, vs...))
+}
+
+// AlertSMTPPortNotIn applies the NotIn predicate on the "alert_smtp_port" field.
+func AlertSMTPPortNotIn(vs ...int) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNotIn(FieldAlertSMTPPort, vs...))
+}
+
+// AlertSMTPPortGT applies the GT predicate on the "alert_smtp_port" field.
+func AlertSMTPPortGT(v int) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldGT(FieldAlertSMTPPort, v))
+}
+
+// AlertSMTPPortGTE applies the GTE predicate on the "alert_smtp_port" field.
+func AlertSMTPPortGTE(v int) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldGTE(FieldAlertSMTPPort, v))
+}
+
+// AlertSMTPPortLT applies the LT predicate on the "alert_smtp_port" field.
+func AlertSMTPPortLT(v int) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldLT(FieldAlertSMTPPort, v))
+}
+
+// AlertSMTPPortLTE applies the LTE predicate on the "alert_smtp_port" field.
+func AlertSMTPPortLTE(v int) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldLTE(FieldAlertSMTPPort, v))
+}
+
+// AlertSMTPFromEQ applies the EQ predicate on the "alert_smtp_from" field.
+func AlertSMTPFromEQ(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEQ(FieldAlertSMTPFrom, v))
+}
+
+// AlertSMTPFromNEQ applies the NEQ predicate on the "alert_smtp_from" field.
+func AlertSMTPFromNEQ(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNEQ(FieldAlertSMTPFrom, v))
+}
+
+// AlertSMTPFromIn applies the In predicate on the "alert_smtp_from" field.
+func AlertSMTPFromIn(vs ...string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldIn(FieldAlertSMTPFrom, vs...))
+}
+
+// AlertSMTPFromNotIn applies the NotIn predicate on the "alert_smtp_from" field.
+func AlertSMTPFromNotIn(vs ...string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNotIn(FieldAlertSMTPFrom, vs...))
+}
+
+// AlertSMTPFromGT applies the GT predicate on the "alert_smtp_from" field.
+func AlertSMTPFromGT(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldGT(FieldAlertSMTPFrom, v))
+}
+
+// AlertSMTPFromGTE applies the GTE predicate on the "alert_smtp_from" field.
+func AlertSMTPFromGTE(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldGTE(FieldAlertSMTPFrom, v))
+}
+
+// AlertSMTPFromLT applies the LT predicate on the "alert_smtp_from" field.
+func AlertSMTPFromLT(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldLT(FieldAlertSMTPFrom, v))
+}
+
+// AlertSMTPFromLTE applies the LTE predicate on the "alert_smtp_from" field.
+func AlertSMTPFromLTE(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldLTE(FieldAlertSMTPFrom, v))
+}
+
+// AlertSMTPFromContains applies the Contains predicate on the "alert_smtp_from" field.
+func AlertSMTPFromContains(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldContains(FieldAlertSMTPFrom, v))
+}
+
+// AlertSMTPFromHasPrefix applies the HasPrefix predicate on the "alert_smtp_from" field.
+func AlertSMTPFromHasPrefix(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldHasPrefix(FieldAlertSMTPFrom, v))
+}
+
+// AlertSMTPFromHasSuffix applies the HasSuffix predicate on the "alert_smtp_from" field.
+func AlertSMTPFromHasSuffix(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldHasSuffix(FieldAlertSMTPFrom, v))
+}
+
+// AlertSMTPFromIsNil applies the IsNil predicate on the "alert_smtp_from" field.
+func AlertSMTPFromIsNil() predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldIsNull(FieldAlertSMTPFrom))
+}
+
+// AlertSMTPFromNotNil applies the NotNil predicate on the "alert_smtp_from" field.
+func AlertSMTPFromNotNil() predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNotNull(FieldAlertSMTPFrom))
+}
+
+// AlertSMTPFromEqualFold applies the EqualFold predicate on the "alert_smtp_from" field.
+func AlertSMTPFromEqualFold(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEqualFold(FieldAlertSMTPFrom, v))
+}
+
+// AlertSMTPFromContainsFold applies the ContainsFold predicate on the "alert_smtp_from" field.
+func AlertSMTPFromContainsFold(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldContainsFold(FieldAlertSMTPFrom, v))
+}
+
+// AlertSMTPToEQ applies the EQ predicate on the "alert_smtp_to" field.
+func AlertSMTPToEQ(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEQ(FieldAlertSMTPTo, v))
+}
+
+// AlertSMTPToNEQ applies the NEQ predicate on the "alert_smtp_to" field.
+func AlertSMTPToNEQ(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNEQ(FieldAlertSMTPTo, v))
+}
+
+// AlertSMTPToIn applies the In predicate on the "alert_smtp_to" field.
+func AlertSMTPToIn(vs ...string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldIn(FieldAlertSMTPTo, vs...))
+}
+
+// AlertSMTPToNotIn applies the NotIn predicate on the "alert_smtp_to" field.
+func AlertSMTPToNotIn(vs ...string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNotIn(FieldAlertSMTPTo, vs...))
+}
+
+// AlertSMTPToGT applies the GT predicate on the "alert_smtp_to" field.
+func AlertSMTPToGT(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldGT(FieldAlertSMTPTo, v))
+}
+
+// AlertSMTPToGTE applies the GTE predicate on the "alert_smtp_to" field.
+func AlertSMTPToGTE(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldGTE(FieldAlertSMTPTo, v))
+}
+
+// AlertSMTPToLT applies the LT predicate on the "alert_smtp_to" field.
+func AlertSMTPToLT(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldLT(FieldAlertSMTPTo, v))
+}
+
+// AlertSMTPToLTE applies the LTE predicate on the "alert_smtp_to" field.
+func AlertSMTPToLTE(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldLTE(FieldAlertSMTPTo, v))
+}
+
+// AlertSMTPToContains applies the Contains predicate on the "alert_smtp_to" field.
+func AlertSMTPToContains(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldContains(FieldAlertSMTPTo, v))
+}
+
+// AlertSMTPToHasPrefix applies the HasPrefix predicate on the "alert_smtp_to" field.
+func AlertSMTPToHasPrefix(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldHasPrefix(FieldAlertSMTPTo, v))
+}
+
+// AlertSMTPToHasSuffix applies the HasSuffix predicate on the "alert_smtp_to" field.
+func AlertSMTPToHasSuffix(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldHasSuffix(FieldAlertSMTPTo, v))
+}
+
+// AlertSMTPToIsNil applies the IsNil predicate on the "alert_smtp_to" field.
+func AlertSMTPToIsNil() predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldIsNull(FieldAlertSMTPTo))
+}
+
+// AlertSMTPToNotNil applies the NotNil predicate on the "alert_smtp_to" field.
+func AlertSMTPToNotNil() predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNotNull(FieldAlertSMTPTo))
+}
+
+// AlertSMTPToEqualFold applies the EqualFold predicate on the "alert_smtp_to" field.
+func AlertSMTPToEqualFold(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEqualFold(FieldAlertSMTPTo, v))
+}
+
+// AlertSMTPToContainsFold applies the ContainsFold predicate on the "alert_smtp_to" field.
+func AlertSMTPToContainsFold(v string) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldContainsFold(FieldAlertSMTPTo, v))
+}
+
+// AlertOnFailureEQ applies the EQ predicate on the "alert_on_failure" field.
+func AlertOnFailureEQ(v bool) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldEQ(FieldAlertOnFailure, v))
+}
+
+// AlertOnFailureNEQ applies the NEQ predicate on the "alert_on_failure" field.
+func AlertOnFailureNEQ(v bool) predicate.BackupConfig {
+	return predicate.BackupConfig(sql.FieldNEQ(FieldAlertOnFailure, v))
+}
+
 // LastBackupAtEQ applies the EQ predicate on the "last_backup_at" field.
 func LastBackupAtEQ(v time.Time) predicate.BackupConfig {
 	return predicate.BackupConfig(sql.FieldEQ(FieldLastBackupAt, v))
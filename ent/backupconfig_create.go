@@ -216,6 +216,90 @@ func (_c *BackupConfigCreate) SetNillableBackupTargetsMigrated(v *bool) *BackupC
 	return _c
 }
 
+// SetAlertWebhookURL sets the "alert_webhook_url" field.
+func (_c *BackupConfigCreate) SetAlertWebhookURL(v string) *BackupConfigCreate {
+	_c.mutation.SetAlertWebhookURL(v)
+	return _c
+}
+
+// SetNillableAlertWebhookURL sets the "alert_webhook_url" field if the given value is not nil.
+func (_c *BackupConfigCreate) SetNillableAlertWebhookURL(v *string) *BackupConfigCreate {
+	if v != nil {
+		_c.SetAlertWebhookURL(*v)
+	}
+	return _c
+}
+
+// SetAlertSMTPHost sets the "alert_smtp_host" field.
+func (_c *BackupConfigCreate) SetAlertSMTPHost(v string) *BackupConfigCreate {
+	_c.mutation.SetAlertSMTPHost(v)
+	return _c
+}
+
+// SetNillableAlertSMTPHost sets the "alert_smtp_host" field if the given value is not nil.
+func (_c *BackupConfigCreate) SetNillableAlertSMTPHost(v *string) *BackupConfigCreate {
+	if v != nil {
+		_c.SetAlertSMTPHost(*v)
+	}
+	return _c
+}
+
+// SetAlertSMTPPort sets the "alert_smtp_port" field.
+func (_c *BackupConfigCreate) SetAlertSMTPPort(v int) *BackupConfigCreate {
+	_c.mutation.SetAlertSMTPPort(v)
+	return _c
+}
+
+// SetNillableAlertSMTPPort sets the "alert_smtp_port" field if the given value is not nil.
+func (_c *BackupConfigCreate) SetNillableAlertSMTPPort(v *int) *BackupConfigCreate {
+	if v != nil {
+		_c.SetAlertSMTPPort(*v)
+	}
+	return _c
+}
+
+// SetAlertSMTPFrom sets the "alert_smtp_from" field.
+func (_c *BackupConfigCreate) SetAlertSMTPFrom(v string) *BackupConfigCreate {
+	_c.mutation.SetAlertSMTPFrom(v)
+	return _c
+}
+
+// SetNillableAlertSMTPFrom sets the "alert_smtp_from" field if the given value is not nil.
+func (_c *BackupConfigCreate) SetNillableAlertSMTPFrom(v *string) *BackupConfigCreate {
+	if v != nil {
+		_c.SetAlertSMTPFrom(*v)
+	}
+	return _c
+}
+
+// SetAlertSMTPTo sets the "alert_smtp_to" field.
+func (_c *BackupConfigCreate) SetAlertSMTPTo(v string) *BackupConfigCreate {
+	_c.mutation.SetAlertSMTPTo(v)
+	return _c
+}
+
+// SetNillableAlertSMTPTo sets the "alert_smtp_to" field if the given value is not nil.
+func (_c *BackupConfigCreate) SetNillableAlertSMTPTo(v *string) *BackupConfigCreate {
+	if v != nil {
+		_c.SetAlertSMTPTo(*v)
+	}
+	return _c
+}
+
+// SetAlertOnFailure sets the "alert_on_failure" field.
+func (_c *BackupConfigCreate) SetAlertOnFailure(v bool) *BackupConfigCreate {
+	_c.mutation.SetAlertOnFailure(v)
+	return _c
+}
+
+// SetNillableAlertOnFailure sets the "alert_on_failure" field if the given value is not nil.
+func (_c *BackupConfigCreate) SetNillableAlertOnFailure(v *bool) *BackupConfigCreate {
+	if v != nil {
+		_c.SetAlertOnFailure(*v)
+	}
+	return _c
+}
+
 // SetLastBackupAt sets the "last_backup_at" field.
 func (_c *BackupConfigCreate) SetLastBackupAt(v time.Time) *BackupConfigCreate {
 	_c.mutation.SetLastBackupAt(v)
@@ -317,6 +401,14 @@ func (_c *BackupConfigCreate) defaults() {
 		v := backupconfig.DefaultBackupTargetsMigrated
 		_c.mutation.SetBackupTargetsMigrated(v)
 	}
+	if _, ok := _c.mutation.AlertSMTPPort(); !ok {
+		v := backupconfig.DefaultAlertSMTPPort
+		_c.mutation.SetAlertSMTPPort(v)
+	}
+	if _, ok := _c.mutation.AlertOnFailure(); !ok {
+		v := backupconfig.DefaultAlertOnFailure
+		_c.mutation.SetAlertOnFailure(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := backupconfig.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -347,6 +439,12 @@ func (_c *BackupConfigCreate) check() error {
 	if _, ok := _c.mutation.BackupTargetsMigrated(); !ok {
 		return &ValidationError{Name: "backup_targets_migrated", err: errors.New(`ent: missing required field "BackupConfig.backup_targets_migrated"`)}
 	}
+	if _, ok := _c.mutation.AlertSMTPPort(); !ok {
+		return &ValidationError{Name: "alert_smtp_port", err: errors.New(`ent: missing required field "BackupConfig.alert_smtp_port"`)}
+	}
+	if _, ok := _c.mutation.AlertOnFailure(); !ok {
+		return &ValidationError{Name: "alert_on_failure", err: errors.New(`ent: missing required field "BackupConfig.alert_on_failure"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BackupConfig.created_at"`)}
 	}
@@ -435,6 +533,30 @@ func (_c *BackupConfigCreate) createSpec() (*BackupConfig, *sqlgraph.CreateSpec)
 		_spec.SetField(backupconfig.FieldBackupTargetsMigrated, field.TypeBool, value)
 		_node.BackupTargetsMigrated = value
 	}
+	if value, ok := _c.mutation.AlertWebhookURL(); ok {
+		_spec.SetField(backupconfig.FieldAlertWebhookURL, field.TypeString, value)
+		_node.AlertWebhookURL = value
+	}
+	if value, ok := _c.mutation.AlertSMTPHost(); ok {
+		_spec.SetField(backupconfig.FieldAlertSMTPHost, field.TypeString, value)
+		_node.AlertSMTPHost = value
+	}
+	if value, ok := _c.mutation.AlertSMTPPort(); ok {
+		_spec.SetField(backupconfig.FieldAlertSMTPPort, field.TypeInt, value)
+		_node.AlertSMTPPort = value
+	}
+	if value, ok := _c.mutation.AlertSMTPFrom(); ok {
+		_spec.SetField(backupconfig.FieldAlertSMTPFrom, field.TypeString, value)
+		_node.AlertSMTPFrom = value
+	}
+	if value, ok := _c.mutation.AlertSMTPTo(); ok {
+		_spec.SetField(backupconfig.FieldAlertSMTPTo, field.TypeString, value)
+		_node.AlertSMTPTo = value
+	}
+	if value, ok := _c.mutation.AlertOnFailure(); ok {
+		_spec.SetField(backupconfig.FieldAlertOnFailure, field.TypeBool, value)
+		_node.AlertOnFailure = value
+	}
 	if value, ok := _c.mutation.LastBackupAt(); ok {
 		_spec.SetField(backupconfig.FieldLastBackupAt, field.TypeTime, value)
 		_node.LastBackupAt = value
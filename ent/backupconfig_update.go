@@ -293,6 +293,121 @@ func (_u *BackupConfigUpdate) SetNillableBackupTargetsMigrated(v *bool) *BackupC
 	return _u
 }
 
+// SetAlertWebhookURL sets the "alert_webhook_url" field.
+func (_u *BackupConfigUpdate) SetAlertWebhookURL(v string) *BackupConfigUpdate {
+	_u.mutation.SetAlertWebhookURL(v)
+	return _u
+}
+
+// SetNillableAlertWebhookURL sets the "alert_webhook_url" field if the given value is not nil.
+func (_u *BackupConfigUpdate) SetNillableAlertWebhookURL(v *string) *BackupConfigUpdate {
+	if v != nil {
+		_u.SetAlertWebhookURL(*v)
+	}
+	return _u
+}
+
+// ClearAlertWebhookURL clears the value of the "alert_webhook_url" field.
+func (_u *BackupConfigUpdate) ClearAlertWebhookURL() *BackupConfigUpdate {
+	_u.mutation.ClearAlertWebhookURL()
+	return _u
+}
+
+// SetAlertSMTPHost sets the "alert_smtp_host" field.
+func (_u *BackupConfigUpdate) SetAlertSMTPHost(v string) *BackupConfigUpdate {
+	_u.mutation.SetAlertSMTPHost(v)
+	return _u
+}
+
+// SetNillableAlertSMTPHost sets the "alert_smtp_host" field if the given value is not nil.
+func (_u *BackupConfigUpdate) SetNillableAlertSMTPHost(v *string) *BackupConfigUpdate {
+	if v != nil {
+		_u.SetAlertSMTPHost(*v)
+	}
+	return _u
+}
+
+// ClearAlertSMTPHost clears the value of the "alert_smtp_host" field.
+func (_u *BackupConfigUpdate) ClearAlertSMTPHost() *BackupConfigUpdate {
+	_u.mutation.ClearAlertSMTPHost()
+	return _u
+}
+
+// SetAlertSMTPPort sets the "alert_smtp_port" field.
+func (_u *BackupConfigUpdate) SetAlertSMTPPort(v int) *BackupConfigUpdate {
+	_u.mutation.ResetAlertSMTPPort()
+	_u.mutation.SetAlertSMTPPort(v)
+	return _u
+}
+
+// SetNillableAlertSMTPPort sets the "alert_smtp_port" field if the given value is not nil.
+func (_u *BackupConfigUpdate) SetNillableAlertSMTPPort(v *int) *BackupConfigUpdate {
+	if v != nil {
+		_u.SetAlertSMTPPort(*v)
+	}
+	return _u
+}
+
+// AddAlertSMTPPort adds value to the "alert_smtp_port" field.
+func (_u *BackupConfigUpdate) AddAlertSMTPPort(v int) *BackupConfigUpdate {
+	_u.mutation.AddAlertSMTPPort(v)
+	return _u
+}
+
+// SetAlertSMTPFrom sets the "alert_smtp_from" field.
+func (_u *BackupConfigUpdate) SetAlertSMTPFrom(v string) *BackupConfigUpdate {
+	_u.mutation.SetAlertSMTPFrom(v)
+	return _u
+}
+
+// SetNillableAlertSMTPFrom sets the "alert_smtp_from" field if the given value is not nil.
+func (_u *BackupConfigUpdate) SetNillableAlertSMTPFrom(v *string) *BackupConfigUpdate {
+	if v != nil {
+		_u.SetAlertSMTPFrom(*v)
+	}
+	return _u
+}
+
+// ClearAlertSMTPFrom clears the value of the "alert_smtp_from" field.
+func (_u *BackupConfigUpdate) ClearAlertSMTPFrom() *BackupConfigUpdate {
+	_u.mutation.ClearAlertSMTPFrom()
+	return _u
+}
+
+// SetAlertSMTPTo sets the "alert_smtp_to" field.
+func (_u *BackupConfigUpdate) SetAlertSMTPTo(v string) *BackupConfigUpdate {
+	_u.mutation.SetAlertSMTPTo(v)
+	return _u
+}
+
+// SetNillableAlertSMTPTo sets the "alert_smtp_to" field if the given value is not nil.
+func (_u *BackupConfigUpdate) SetNillableAlertSMTPTo(v *string) *BackupConfigUpdate {
+	if v != nil {
+		_u.SetAlertSMTPTo(*v)
+	}
+	return _u
+}
+
+// ClearAlertSMTPTo clears the value of the "alert_smtp_to" field.
+func (_u *BackupConfigUpdate) ClearAlertSMTPTo() *BackupConfigUpdate {
+	_u.mutation.ClearAlertSMTPTo()
+	return _u
+}
+
+// SetAlertOnFailure sets the "alert_on_failure" field.
+func (_u *BackupConfigUpdate) SetAlertOnFailure(v bool) *BackupConfigUpdate {
+	_u.mutation.SetAlertOnFailure(v)
+	return _u
+}
+
+// SetNillableAlertOnFailure sets the "alert_on_failure" field if the given value is not nil.
+func (_u *BackupConfigUpdate) SetNillableAlertOnFailure(v *bool) *BackupConfigUpdate {
+	if v != nil {
+		_u.SetAlertOnFailure(*v)
+	}
+	return _u
+}
+
 // SetLastBackupAt sets the "last_backup_at" field.
 func (_u *BackupConfigUpdate) SetLastBackupAt(v time.Time) *BackupConfigUpdate {
 	_u.mutation.SetLastBackupAt(v)
@@ -458,6 +573,39 @@ func (_u *BackupConfigUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.BackupTargetsMigrated(); ok {
 		_spec.SetField(backupconfig.FieldBackupTargetsMigrated, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.AlertWebhookURL(); ok {
+		_spec.SetField(backupconfig.FieldAlertWebhookURL, field.TypeString, value)
+	}
+	if _u.mutation.AlertWebhookURLCleared() {
+		_spec.ClearField(backupconfig.FieldAlertWebhookURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlertSMTPHost(); ok {
+		_spec.SetField(backupconfig.FieldAlertSMTPHost, field.TypeString, value)
+	}
+	if _u.mutation.AlertSMTPHostCleared() {
+		_spec.ClearField(backupconfig.FieldAlertSMTPHost, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlertSMTPPort(); ok {
+		_spec.SetField(backupconfig.FieldAlertSMTPPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAlertSMTPPort(); ok {
+		_spec.AddField(backupconfig.FieldAlertSMTPPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AlertSMTPFrom(); ok {
+		_spec.SetField(backupconfig.FieldAlertSMTPFrom, field.TypeString, value)
+	}
+	if _u.mutation.AlertSMTPFromCleared() {
+		_spec.ClearField(backupconfig.FieldAlertSMTPFrom, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlertSMTPTo(); ok {
+		_spec.SetField(backupconfig.FieldAlertSMTPTo, field.TypeString, value)
+	}
+	if _u.mutation.AlertSMTPToCleared() {
+		_spec.ClearField(backupconfig.FieldAlertSMTPTo, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlertOnFailure(); ok {
+		_spec.SetField(backupconfig.FieldAlertOnFailure, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.LastBackupAt(); ok {
 		_spec.SetField(backupconfig.FieldLastBackupAt, field.TypeTime, value)
 	}
@@ -755,6 +903,121 @@ func (_u *BackupConfigUpdateOne) SetNillableBackupTargetsMigrated(v *bool) *Back
 	return _u
 }
 
+// SetAlertWebhookURL sets the "alert_webhook_url" field.
+func (_u *BackupConfigUpdateOne) SetAlertWebhookURL(v string) *BackupConfigUpdateOne {
+	_u.mutation.SetAlertWebhookURL(v)
+	return _u
+}
+
+// SetNillableAlertWebhookURL sets the "alert_webhook_url" field if the given value is not nil.
+func (_u *BackupConfigUpdateOne) SetNillableAlertWebhookURL(v *string) *BackupConfigUpdateOne {
+	if v != nil {
+		_u.SetAlertWebhookURL(*v)
+	}
+	return _u
+}
+
+// ClearAlertWebhookURL clears the value of the "alert_webhook_url" field.
+func (_u *BackupConfigUpdateOne) ClearAlertWebhookURL() *BackupConfigUpdateOne {
+	_u.mutation.ClearAlertWebhookURL()
+	return _u
+}
+
+// SetAlertSMTPHost sets the "alert_smtp_host" field.
+func (_u *BackupConfigUpdateOne) SetAlertSMTPHost(v string) *BackupConfigUpdateOne {
+	_u.mutation.SetAlertSMTPHost(v)
+	return _u
+}
+
+// SetNillableAlertSMTPHost sets the "alert_smtp_host" field if the given value is not nil.
+func (_u *BackupConfigUpdateOne) SetNillableAlertSMTPHost(v *string) *BackupConfigUpdateOne {
+	if v != nil {
+		_u.SetAlertSMTPHost(*v)
+	}
+	return _u
+}
+
+// ClearAlertSMTPHost clears the value of the "alert_smtp_host" field.
+func (_u *BackupConfigUpdateOne) ClearAlertSMTPHost() *BackupConfigUpdateOne {
+	_u.mutation.ClearAlertSMTPHost()
+	return _u
+}
+
+// SetAlertSMTPPort sets the "alert_smtp_port" field.
+func (_u *BackupConfigUpdateOne) SetAlertSMTPPort(v int) *BackupConfigUpdateOne {
+	_u.mutation.ResetAlertSMTPPort()
+	_u.mutation.SetAlertSMTPPort(v)
+	return _u
+}
+
+// SetNillableAlertSMTPPort sets the "alert_smtp_port" field if the given value is not nil.
+func (_u *BackupConfigUpdateOne) SetNillableAlertSMTPPort(v *int) *BackupConfigUpdateOne {
+	if v != nil {
+		_u.SetAlertSMTPPort(*v)
+	}
+	return _u
+}
+
+// AddAlertSMTPPort adds value to the "alert_smtp_port" field.
+func (_u *BackupConfigUpdateOne) AddAlertSMTPPort(v int) *BackupConfigUpdateOne {
+	_u.mutation.AddAlertSMTPPort(v)
+	return _u
+}
+
+// SetAlertSMTPFrom sets the "alert_smtp_from" field.
+func (_u *BackupConfigUpdateOne) SetAlertSMTPFrom(v string) *BackupConfigUpdateOne {
+	_u.mutation.SetAlertSMTPFrom(v)
+	return _u
+}
+
+// SetNillableAlertSMTPFrom sets the "alert_smtp_from" field if the given value is not nil.
+func (_u *BackupConfigUpdateOne) SetNillableAlertSMTPFrom(v *string) *BackupConfigUpdateOne {
+	if v != nil {
+		_u.SetAlertSMTPFrom(*v)
+	}
+	return _u
+}
+
+// ClearAlertSMTPFrom clears the value of the "alert_smtp_from" field.
+func (_u *BackupConfigUpdateOne) ClearAlertSMTPFrom() *BackupConfigUpdateOne {
+	_u.mutation.ClearAlertSMTPFrom()
+	return _u
+}
+
+// SetAlertSMTPTo sets the "alert_smtp_to" field.
+func (_u *BackupConfigUpdateOne) SetAlertSMTPTo(v string) *BackupConfigUpdateOne {
+	_u.mutation.SetAlertSMTPTo(v)
+	return _u
+}
+
+// SetNillableAlertSMTPTo sets the "alert_smtp_to" field if the given value is not nil.
+func (_u *BackupConfigUpdateOne) SetNillableAlertSMTPTo(v *string) *BackupConfigUpdateOne {
+	if v != nil {
+		_u.SetAlertSMTPTo(*v)
+	}
+	return _u
+}
+
+// ClearAlertSMTPTo clears the value of the "alert_smtp_to" field.
+func (_u *BackupConfigUpdateOne) ClearAlertSMTPTo() *BackupConfigUpdateOne {
+	_u.mutation.ClearAlertSMTPTo()
+	return _u
+}
+
+// SetAlertOnFailure sets the "alert_on_failure" field.
+func (_u *BackupConfigUpdateOne) SetAlertOnFailure(v bool) *BackupConfigUpdateOne {
+	_u.mutation.SetAlertOnFailure(v)
+	return _u
+}
+
+// SetNillableAlertOnFailure sets the "alert_on_failure" field if the given value is not nil.
+func (_u *BackupConfigUpdateOne) SetNillableAlertOnFailure(v *bool) *BackupConfigUpdateOne {
+	if v != nil {
+		_u.SetAlertOnFailure(*v)
+	}
+	return _u
+}
+
 // SetLastBackupAt sets the "last_backup_at" field.
 func (_u *BackupConfigUpdateOne) SetLastBackupAt(v time.Time) *BackupConfigUpdateOne {
 	_u.mutation.SetLastBackupAt(v)
@@ -950,6 +1213,39 @@ func (_u *BackupConfigUpdateOne) sqlSave(ctx context.Context) (_node *BackupConf
 	if value, ok := _u.mutation.BackupTargetsMigrated(); ok {
 		_spec.SetField(backupconfig.FieldBackupTargetsMigrated, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.AlertWebhookURL(); ok {
+		_spec.SetField(backupconfig.FieldAlertWebhookURL, field.TypeString, value)
+	}
+	if _u.mutation.AlertWebhookURLCleared() {
+		_spec.ClearField(backupconfig.FieldAlertWebhookURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlertSMTPHost(); ok {
+		_spec.SetField(backupconfig.FieldAlertSMTPHost, field.TypeString, value)
+	}
+	if _u.mutation.AlertSMTPHostCleared() {
+		_spec.ClearField(backupconfig.FieldAlertSMTPHost, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlertSMTPPort(); ok {
+		_spec.SetField(backupconfig.FieldAlertSMTPPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAlertSMTPPort(); ok {
+		_spec.AddField(backupconfig.FieldAlertSMTPPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AlertSMTPFrom(); ok {
+		_spec.SetField(backupconfig.FieldAlertSMTPFrom, field.TypeString, value)
+	}
+	if _u.mutation.AlertSMTPFromCleared() {
+		_spec.ClearField(backupconfig.FieldAlertSMTPFrom, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlertSMTPTo(); ok {
+		_spec.SetField(backupconfig.FieldAlertSMTPTo, field.TypeString, value)
+	}
+	if _u.mutation.AlertSMTPToCleared() {
+		_spec.ClearField(backupconfig.FieldAlertSMTPTo, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlertOnFailure(); ok {
+		_spec.SetField(backupconfig.FieldAlertOnFailure, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.LastBackupAt(); ok {
 		_spec.SetField(backupconfig.FieldLastBackupAt, field.TypeTime, value)
 	}
@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"smarticky/ent/backupdrill"
+	"smarticky/ent/backuptarget"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BackupDrill is the model entity for the BackupDrill schema.
+type BackupDrill struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TargetID holds the value of the "target_id" field.
+	TargetID int `json:"target_id,omitempty"`
+	// Backup that was restored; empty when the target had none
+	Filename string `json:"filename,omitempty"`
+	// manual, scheduled
+	Trigger string `json:"trigger,omitempty"`
+	// passed, failed
+	Status string `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// ChecksJSON holds the value of the "checks_json" field.
+	ChecksJSON string `json:"checks_json,omitempty"`
+	// Row counts of the restored and the live database
+	CountsJSON string `json:"counts_json,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt time.Time `json:"finished_at,omitempty"`
+	// DurationMs holds the value of the "duration_ms" field.
+	DurationMs int64 `json:"duration_ms,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BackupDrillQuery when eager-loading is set.
+	Edges        BackupDrillEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BackupDrillEdges holds the relations/edges for other nodes in the graph.
+type BackupDrillEdges struct {
+	// Target holds the value of the target edge.
+	Target *BackupTarget `json:"target,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TargetOrErr returns the Target value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BackupDrillEdges) TargetOrErr() (*BackupTarget, error) {
+	if e.Target != nil {
+		return e.Target, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: backuptarget.Label}
+	}
+	return nil, &NotLoadedError{edge: "target"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BackupDrill) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case backupdrill.FieldID, backupdrill.FieldTargetID, backupdrill.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case backupdrill.FieldFilename, backupdrill.FieldTrigger, backupdrill.FieldStatus, backupdrill.FieldError, backupdrill.FieldChecksJSON, backupdrill.FieldCountsJSON:
+			values[i] = new(sql.NullString)
+		case backupdrill.FieldStartedAt, backupdrill.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BackupDrill fields.
+func (_m *BackupDrill) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case backupdrill.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case backupdrill.FieldTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				_m.TargetID = int(value.Int64)
+			}
+		case backupdrill.FieldFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filename", values[i])
+			} else if value.Valid {
+				_m.Filename = value.String
+			}
+		case backupdrill.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				_m.Trigger = value.String
+			}
+		case backupdrill.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case backupdrill.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case backupdrill.FieldChecksJSON:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checks_json", values[i])
+			} else if value.Valid {
+				_m.ChecksJSON = value.String
+			}
+		case backupdrill.FieldCountsJSON:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field counts_json", values[i])
+			} else if value.Valid {
+				_m.CountsJSON = value.String
+			}
+		case backupdrill.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case backupdrill.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = value.Time
+			}
+		case backupdrill.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				_m.DurationMs = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BackupDrill.
+// This includes values selected through modifiers, order, etc.
+func (_m *BackupDrill) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTarget queries the "target" edge of the BackupDrill entity.
+func (_m *BackupDrill) QueryTarget() *BackupTargetQuery {
+	return NewBackupDrillClient(_m.config).QueryTarget(_m)
+}
+
+// Update returns a builder for updating this BackupDrill.
+// Note that you need to call BackupDrill.Unwrap() before calling this method if this BackupDrill
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BackupDrill) Update() *BackupDrillUpdateOne {
+	return NewBackupDrillClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BackupDrill entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BackupDrill) Unwrap() *BackupDrill {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BackupDrill is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BackupDrill) String() string {
+	var builder strings.Builder
+	builder.WriteString("BackupDrill(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("filename=")
+	builder.WriteString(_m.Filename)
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(_m.Trigger)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("checks_json=")
+	builder.WriteString(_m.ChecksJSON)
+	builder.WriteString(", ")
+	builder.WriteString("counts_json=")
+	builder.WriteString(_m.CountsJSON)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(_m.FinishedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DurationMs))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BackupDrills is a parsable slice of BackupDrill.
+type BackupDrills []*BackupDrill
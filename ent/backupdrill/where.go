@@ -0,0 +1,714 @@
+// Code generated by ent, DO NOT EDIT.
+
+package backupdrill
+
+import (
+	"smarticky/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLTE(FieldID, id))
+}
+
+// TargetID applies equality check predicate on the "target_id" field. It's identical to TargetIDEQ.
+func TargetID(v int) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldTargetID, v))
+}
+
+// Filename applies equality check predicate on the "filename" field. It's identical to FilenameEQ.
+func Filename(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldFilename, v))
+}
+
+// Trigger applies equality check predicate on the "trigger" field. It's identical to TriggerEQ.
+func Trigger(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldTrigger, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldStatus, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldError, v))
+}
+
+// ChecksJSON applies equality check predicate on the "checks_json" field. It's identical to ChecksJSONEQ.
+func ChecksJSON(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldChecksJSON, v))
+}
+
+// CountsJSON applies equality check predicate on the "counts_json" field. It's identical to CountsJSONEQ.
+func CountsJSON(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldCountsJSON, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int64) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// TargetIDEQ applies the EQ predicate on the "target_id" field.
+func TargetIDEQ(v int) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldTargetID, v))
+}
+
+// TargetIDNEQ applies the NEQ predicate on the "target_id" field.
+func TargetIDNEQ(v int) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNEQ(FieldTargetID, v))
+}
+
+// TargetIDIn applies the In predicate on the "target_id" field.
+func TargetIDIn(vs ...int) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIn(FieldTargetID, vs...))
+}
+
+// TargetIDNotIn applies the NotIn predicate on the "target_id" field.
+func TargetIDNotIn(vs ...int) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotIn(FieldTargetID, vs...))
+}
+
+// FilenameEQ applies the EQ predicate on the "filename" field.
+func FilenameEQ(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldFilename, v))
+}
+
+// FilenameNEQ applies the NEQ predicate on the "filename" field.
+func FilenameNEQ(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNEQ(FieldFilename, v))
+}
+
+// FilenameIn applies the In predicate on the "filename" field.
+func FilenameIn(vs ...string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIn(FieldFilename, vs...))
+}
+
+// FilenameNotIn applies the NotIn predicate on the "filename" field.
+func FilenameNotIn(vs ...string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotIn(FieldFilename, vs...))
+}
+
+// FilenameGT applies the GT predicate on the "filename" field.
+func FilenameGT(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGT(FieldFilename, v))
+}
+
+// FilenameGTE applies the GTE predicate on the "filename" field.
+func FilenameGTE(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGTE(FieldFilename, v))
+}
+
+// FilenameLT applies the LT predicate on the "filename" field.
+func FilenameLT(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLT(FieldFilename, v))
+}
+
+// FilenameLTE applies the LTE predicate on the "filename" field.
+func FilenameLTE(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLTE(FieldFilename, v))
+}
+
+// FilenameContains applies the Contains predicate on the "filename" field.
+func FilenameContains(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldContains(FieldFilename, v))
+}
+
+// FilenameHasPrefix applies the HasPrefix predicate on the "filename" field.
+func FilenameHasPrefix(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldHasPrefix(FieldFilename, v))
+}
+
+// FilenameHasSuffix applies the HasSuffix predicate on the "filename" field.
+func FilenameHasSuffix(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldHasSuffix(FieldFilename, v))
+}
+
+// FilenameIsNil applies the IsNil predicate on the "filename" field.
+func FilenameIsNil() predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIsNull(FieldFilename))
+}
+
+// FilenameNotNil applies the NotNil predicate on the "filename" field.
+func FilenameNotNil() predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotNull(FieldFilename))
+}
+
+// FilenameEqualFold applies the EqualFold predicate on the "filename" field.
+func FilenameEqualFold(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEqualFold(FieldFilename, v))
+}
+
+// FilenameContainsFold applies the ContainsFold predicate on the "filename" field.
+func FilenameContainsFold(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldContainsFold(FieldFilename, v))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// TriggerGT applies the GT predicate on the "trigger" field.
+func TriggerGT(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGT(FieldTrigger, v))
+}
+
+// TriggerGTE applies the GTE predicate on the "trigger" field.
+func TriggerGTE(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGTE(FieldTrigger, v))
+}
+
+// TriggerLT applies the LT predicate on the "trigger" field.
+func TriggerLT(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLT(FieldTrigger, v))
+}
+
+// TriggerLTE applies the LTE predicate on the "trigger" field.
+func TriggerLTE(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLTE(FieldTrigger, v))
+}
+
+// TriggerContains applies the Contains predicate on the "trigger" field.
+func TriggerContains(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldContains(FieldTrigger, v))
+}
+
+// TriggerHasPrefix applies the HasPrefix predicate on the "trigger" field.
+func TriggerHasPrefix(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldHasPrefix(FieldTrigger, v))
+}
+
+// TriggerHasSuffix applies the HasSuffix predicate on the "trigger" field.
+func TriggerHasSuffix(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldHasSuffix(FieldTrigger, v))
+}
+
+// TriggerEqualFold applies the EqualFold predicate on the "trigger" field.
+func TriggerEqualFold(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEqualFold(FieldTrigger, v))
+}
+
+// TriggerContainsFold applies the ContainsFold predicate on the "trigger" field.
+func TriggerContainsFold(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldContainsFold(FieldTrigger, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldContainsFold(FieldError, v))
+}
+
+// ChecksJSONEQ applies the EQ predicate on the "checks_json" field.
+func ChecksJSONEQ(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldChecksJSON, v))
+}
+
+// ChecksJSONNEQ applies the NEQ predicate on the "checks_json" field.
+func ChecksJSONNEQ(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNEQ(FieldChecksJSON, v))
+}
+
+// ChecksJSONIn applies the In predicate on the "checks_json" field.
+func ChecksJSONIn(vs ...string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIn(FieldChecksJSON, vs...))
+}
+
+// ChecksJSONNotIn applies the NotIn predicate on the "checks_json" field.
+func ChecksJSONNotIn(vs ...string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotIn(FieldChecksJSON, vs...))
+}
+
+// ChecksJSONGT applies the GT predicate on the "checks_json" field.
+func ChecksJSONGT(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGT(FieldChecksJSON, v))
+}
+
+// ChecksJSONGTE applies the GTE predicate on the "checks_json" field.
+func ChecksJSONGTE(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGTE(FieldChecksJSON, v))
+}
+
+// ChecksJSONLT applies the LT predicate on the "checks_json" field.
+func ChecksJSONLT(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLT(FieldChecksJSON, v))
+}
+
+// ChecksJSONLTE applies the LTE predicate on the "checks_json" field.
+func ChecksJSONLTE(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLTE(FieldChecksJSON, v))
+}
+
+// ChecksJSONContains applies the Contains predicate on the "checks_json" field.
+func ChecksJSONContains(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldContains(FieldChecksJSON, v))
+}
+
+// ChecksJSONHasPrefix applies the HasPrefix predicate on the "checks_json" field.
+func ChecksJSONHasPrefix(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldHasPrefix(FieldChecksJSON, v))
+}
+
+// ChecksJSONHasSuffix applies the HasSuffix predicate on the "checks_json" field.
+func ChecksJSONHasSuffix(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldHasSuffix(FieldChecksJSON, v))
+}
+
+// ChecksJSONIsNil applies the IsNil predicate on the "checks_json" field.
+func ChecksJSONIsNil() predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIsNull(FieldChecksJSON))
+}
+
+// ChecksJSONNotNil applies the NotNil predicate on the "checks_json" field.
+func ChecksJSONNotNil() predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotNull(FieldChecksJSON))
+}
+
+// ChecksJSONEqualFold applies the EqualFold predicate on the "checks_json" field.
+func ChecksJSONEqualFold(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEqualFold(FieldChecksJSON, v))
+}
+
+// ChecksJSONContainsFold applies the ContainsFold predicate on the "checks_json" field.
+func ChecksJSONContainsFold(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldContainsFold(FieldChecksJSON, v))
+}
+
+// CountsJSONEQ applies the EQ predicate on the "counts_json" field.
+func CountsJSONEQ(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldCountsJSON, v))
+}
+
+// CountsJSONNEQ applies the NEQ predicate on the "counts_json" field.
+func CountsJSONNEQ(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNEQ(FieldCountsJSON, v))
+}
+
+// CountsJSONIn applies the In predicate on the "counts_json" field.
+func CountsJSONIn(vs ...string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIn(FieldCountsJSON, vs...))
+}
+
+// CountsJSONNotIn applies the NotIn predicate on the "counts_json" field.
+func CountsJSONNotIn(vs ...string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotIn(FieldCountsJSON, vs...))
+}
+
+// CountsJSONGT applies the GT predicate on the "counts_json" field.
+func CountsJSONGT(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGT(FieldCountsJSON, v))
+}
+
+// CountsJSONGTE applies the GTE predicate on the "counts_json" field.
+func CountsJSONGTE(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGTE(FieldCountsJSON, v))
+}
+
+// CountsJSONLT applies the LT predicate on the "counts_json" field.
+func CountsJSONLT(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLT(FieldCountsJSON, v))
+}
+
+// CountsJSONLTE applies the LTE predicate on the "counts_json" field.
+func CountsJSONLTE(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLTE(FieldCountsJSON, v))
+}
+
+// CountsJSONContains applies the Contains predicate on the "counts_json" field.
+func CountsJSONContains(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldContains(FieldCountsJSON, v))
+}
+
+// CountsJSONHasPrefix applies the HasPrefix predicate on the "counts_json" field.
+func CountsJSONHasPrefix(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldHasPrefix(FieldCountsJSON, v))
+}
+
+// CountsJSONHasSuffix applies the HasSuffix predicate on the "counts_json" field.
+func CountsJSONHasSuffix(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldHasSuffix(FieldCountsJSON, v))
+}
+
+// CountsJSONIsNil applies the IsNil predicate on the "counts_json" field.
+func CountsJSONIsNil() predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIsNull(FieldCountsJSON))
+}
+
+// CountsJSONNotNil applies the NotNil predicate on the "counts_json" field.
+func CountsJSONNotNil() predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotNull(FieldCountsJSON))
+}
+
+// CountsJSONEqualFold applies the EqualFold predicate on the "counts_json" field.
+func CountsJSONEqualFold(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEqualFold(FieldCountsJSON, v))
+}
+
+// CountsJSONContainsFold applies the ContainsFold predicate on the "counts_json" field.
+func CountsJSONContainsFold(v string) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldContainsFold(FieldCountsJSON, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int64) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int64) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int64) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int64) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int64) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int64) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int64) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int64) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// HasTarget applies the HasEdge predicate on the "target" edge.
+func HasTarget() predicate.BackupDrill {
+	return predicate.BackupDrill(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TargetTable, TargetColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTargetWith applies the HasEdge predicate on the "target" edge with a given conditions (other predicates).
+func HasTargetWith(preds ...predicate.BackupTarget) predicate.BackupDrill {
+	return predicate.BackupDrill(func(s *sql.Selector) {
+		step := newTargetStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BackupDrill) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BackupDrill) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BackupDrill) predicate.BackupDrill {
+	return predicate.BackupDrill(sql.NotPredicates(p))
+}
@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"smarticky/ent/backupdrill"
+	"smarticky/ent/backuptarget"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupDrillCreate is the builder for creating a BackupDrill entity.
+type BackupDrillCreate struct {
+	config
+	mutation *BackupDrillMutation
+	hooks    []Hook
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *BackupDrillCreate) SetTargetID(v int) *BackupDrillCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetFilename sets the "filename" field.
+func (_c *BackupDrillCreate) SetFilename(v string) *BackupDrillCreate {
+	_c.mutation.SetFilename(v)
+	return _c
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_c *BackupDrillCreate) SetNillableFilename(v *string) *BackupDrillCreate {
+	if v != nil {
+		_c.SetFilename(*v)
+	}
+	return _c
+}
+
+// SetTrigger sets the "trigger" field.
+func (_c *BackupDrillCreate) SetTrigger(v string) *BackupDrillCreate {
+	_c.mutation.SetTrigger(v)
+	return _c
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_c *BackupDrillCreate) SetNillableTrigger(v *string) *BackupDrillCreate {
+	if v != nil {
+		_c.SetTrigger(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BackupDrillCreate) SetStatus(v string) *BackupDrillCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *BackupDrillCreate) SetError(v string) *BackupDrillCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *BackupDrillCreate) SetNillableError(v *string) *BackupDrillCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetChecksJSON sets the "checks_json" field.
+func (_c *BackupDrillCreate) SetChecksJSON(v string) *BackupDrillCreate {
+	_c.mutation.SetChecksJSON(v)
+	return _c
+}
+
+// SetNillableChecksJSON sets the "checks_json" field if the given value is not nil.
+func (_c *BackupDrillCreate) SetNillableChecksJSON(v *string) *BackupDrillCreate {
+	if v != nil {
+		_c.SetChecksJSON(*v)
+	}
+	return _c
+}
+
+// SetCountsJSON sets the "counts_json" field.
+func (_c *BackupDrillCreate) SetCountsJSON(v string) *BackupDrillCreate {
+	_c.mutation.SetCountsJSON(v)
+	return _c
+}
+
+// SetNillableCountsJSON sets the "counts_json" field if the given value is not nil.
+func (_c *BackupDrillCreate) SetNillableCountsJSON(v *string) *BackupDrillCreate {
+	if v != nil {
+		_c.SetCountsJSON(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *BackupDrillCreate) SetStartedAt(v time.Time) *BackupDrillCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *BackupDrillCreate) SetNillableStartedAt(v *time.Time) *BackupDrillCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *BackupDrillCreate) SetFinishedAt(v time.Time) *BackupDrillCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_c *BackupDrillCreate) SetDurationMs(v int64) *BackupDrillCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_c *BackupDrillCreate) SetNillableDurationMs(v *int64) *BackupDrillCreate {
+	if v != nil {
+		_c.SetDurationMs(*v)
+	}
+	return _c
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (_c *BackupDrillCreate) SetTarget(v *BackupTarget) *BackupDrillCreate {
+	return _c.SetTargetID(v.ID)
+}
+
+// Mutation returns the BackupDrillMutation object of the builder.
+func (_c *BackupDrillCreate) Mutation() *BackupDrillMutation {
+	return _c.mutation
+}
+
+// Save creates the BackupDrill in the database.
+func (_c *BackupDrillCreate) Save(ctx context.Context) (*BackupDrill, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BackupDrillCreate) SaveX(ctx context.Context) *BackupDrill {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BackupDrillCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BackupDrillCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BackupDrillCreate) defaults() {
+	if _, ok := _c.mutation.Trigger(); !ok {
+		v := backupdrill.DefaultTrigger
+		_c.mutation.SetTrigger(v)
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		v := backupdrill.DefaultStartedAt()
+		_c.mutation.SetStartedAt(v)
+	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		v := backupdrill.DefaultDurationMs
+		_c.mutation.SetDurationMs(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BackupDrillCreate) check() error {
+	if _, ok := _c.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "BackupDrill.target_id"`)}
+	}
+	if _, ok := _c.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "BackupDrill.trigger"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BackupDrill.status"`)}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "BackupDrill.started_at"`)}
+	}
+	if _, ok := _c.mutation.FinishedAt(); !ok {
+		return &ValidationError{Name: "finished_at", err: errors.New(`ent: missing required field "BackupDrill.finished_at"`)}
+	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`ent: missing required field "BackupDrill.duration_ms"`)}
+	}
+	if len(_c.mutation.TargetIDs()) == 0 {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required edge "BackupDrill.target"`)}
+	}
+	return nil
+}
+
+func (_c *BackupDrillCreate) sqlSave(ctx context.Context) (*BackupDrill, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BackupDrillCreate) createSpec() (*BackupDrill, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BackupDrill{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(backupdrill.Table, sqlgraph.NewFieldSpec(backupdrill.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Filename(); ok {
+		_spec.SetField(backupdrill.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := _c.mutation.Trigger(); ok {
+		_spec.SetField(backupdrill.FieldTrigger, field.TypeString, value)
+		_node.Trigger = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(backupdrill.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(backupdrill.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.ChecksJSON(); ok {
+		_spec.SetField(backupdrill.FieldChecksJSON, field.TypeString, value)
+		_node.ChecksJSON = value
+	}
+	if value, ok := _c.mutation.CountsJSON(); ok {
+		_spec.SetField(backupdrill.FieldCountsJSON, field.TypeString, value)
+		_node.CountsJSON = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(backupdrill.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(backupdrill.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = value
+	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(backupdrill.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = value
+	}
+	if nodes := _c.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backupdrill.TargetTable,
+			Columns: []string{backupdrill.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TargetID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BackupDrillCreateBulk is the builder for creating many BackupDrill entities in bulk.
+type BackupDrillCreateBulk struct {
+	config
+	err      error
+	builders []*BackupDrillCreate
+}
+
+// Save creates the BackupDrill entities in the database.
+func (_c *BackupDrillCreateBulk) Save(ctx context.Context) ([]*BackupDrill, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BackupDrill, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BackupDrillMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BackupDrillCreateBulk) SaveX(ctx context.Context) []*BackupDrill {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BackupDrillCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BackupDrillCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"smarticky/ent/backupdrill"
+	"smarticky/ent/backuptarget"
+	"smarticky/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupDrillUpdate is the builder for updating BackupDrill entities.
+type BackupDrillUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BackupDrillMutation
+}
+
+// Where appends a list predicates to the BackupDrillUpdate builder.
+func (_u *BackupDrillUpdate) Where(ps ...predicate.BackupDrill) *BackupDrillUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *BackupDrillUpdate) SetTargetID(v int) *BackupDrillUpdate {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *BackupDrillUpdate) SetNillableTargetID(v *int) *BackupDrillUpdate {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *BackupDrillUpdate) SetFilename(v string) *BackupDrillUpdate {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *BackupDrillUpdate) SetNillableFilename(v *string) *BackupDrillUpdate {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (_u *BackupDrillUpdate) ClearFilename() *BackupDrillUpdate {
+	_u.mutation.ClearFilename()
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *BackupDrillUpdate) SetTrigger(v string) *BackupDrillUpdate {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *BackupDrillUpdate) SetNillableTrigger(v *string) *BackupDrillUpdate {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BackupDrillUpdate) SetStatus(v string) *BackupDrillUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BackupDrillUpdate) SetNillableStatus(v *string) *BackupDrillUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *BackupDrillUpdate) SetError(v string) *BackupDrillUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *BackupDrillUpdate) SetNillableError(v *string) *BackupDrillUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *BackupDrillUpdate) ClearError() *BackupDrillUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetChecksJSON sets the "checks_json" field.
+func (_u *BackupDrillUpdate) SetChecksJSON(v string) *BackupDrillUpdate {
+	_u.mutation.SetChecksJSON(v)
+	return _u
+}
+
+// SetNillableChecksJSON sets the "checks_json" field if the given value is not nil.
+func (_u *BackupDrillUpdate) SetNillableChecksJSON(v *string) *BackupDrillUpdate {
+	if v != nil {
+		_u.SetChecksJSON(*v)
+	}
+	return _u
+}
+
+// ClearChecksJSON clears the value of the "checks_json" field.
+func (_u *BackupDrillUpdate) ClearChecksJSON() *BackupDrillUpdate {
+	_u.mutation.ClearChecksJSON()
+	return _u
+}
+
+// SetCountsJSON sets the "counts_json" field.
+func (_u *BackupDrillUpdate) SetCountsJSON(v string) *BackupDrillUpdate {
+	_u.mutation.SetCountsJSON(v)
+	return _u
+}
+
+// SetNillableCountsJSON sets the "counts_json" field if the given value is not nil.
+func (_u *BackupDrillUpdate) SetNillableCountsJSON(v *string) *BackupDrillUpdate {
+	if v != nil {
+		_u.SetCountsJSON(*v)
+	}
+	return _u
+}
+
+// ClearCountsJSON clears the value of the "counts_json" field.
+func (_u *BackupDrillUpdate) ClearCountsJSON() *BackupDrillUpdate {
+	_u.mutation.ClearCountsJSON()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *BackupDrillUpdate) SetStartedAt(v time.Time) *BackupDrillUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *BackupDrillUpdate) SetNillableStartedAt(v *time.Time) *BackupDrillUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *BackupDrillUpdate) SetFinishedAt(v time.Time) *BackupDrillUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *BackupDrillUpdate) SetNillableFinishedAt(v *time.Time) *BackupDrillUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *BackupDrillUpdate) SetDurationMs(v int64) *BackupDrillUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *BackupDrillUpdate) SetNillableDurationMs(v *int64) *BackupDrillUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *BackupDrillUpdate) AddDurationMs(v int64) *BackupDrillUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (_u *BackupDrillUpdate) SetTarget(v *BackupTarget) *BackupDrillUpdate {
+	return _u.SetTargetID(v.ID)
+}
+
+// Mutation returns the BackupDrillMutation object of the builder.
+func (_u *BackupDrillUpdate) Mutation() *BackupDrillMutation {
+	return _u.mutation
+}
+
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (_u *BackupDrillUpdate) ClearTarget() *BackupDrillUpdate {
+	_u.mutation.ClearTarget()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BackupDrillUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BackupDrillUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BackupDrillUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BackupDrillUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BackupDrillUpdate) check() error {
+	if _u.mutation.TargetCleared() && len(_u.mutation.TargetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BackupDrill.target"`)
+	}
+	return nil
+}
+
+func (_u *BackupDrillUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(backupdrill.Table, backupdrill.Columns, sqlgraph.NewFieldSpec(backupdrill.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(backupdrill.FieldFilename, field.TypeString, value)
+	}
+	if _u.mutation.FilenameCleared() {
+		_spec.ClearField(backupdrill.FieldFilename, field.TypeString)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(backupdrill.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(backupdrill.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(backupdrill.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(backupdrill.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChecksJSON(); ok {
+		_spec.SetField(backupdrill.FieldChecksJSON, field.TypeString, value)
+	}
+	if _u.mutation.ChecksJSONCleared() {
+		_spec.ClearField(backupdrill.FieldChecksJSON, field.TypeString)
+	}
+	if value, ok := _u.mutation.CountsJSON(); ok {
+		_spec.SetField(backupdrill.FieldCountsJSON, field.TypeString, value)
+	}
+	if _u.mutation.CountsJSONCleared() {
+		_spec.ClearField(backupdrill.FieldCountsJSON, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(backupdrill.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(backupdrill.FieldFinishedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(backupdrill.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(backupdrill.FieldDurationMs, field.TypeInt64, value)
+	}
+	if _u.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backupdrill.TargetTable,
+			Columns: []string{backupdrill.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backupdrill.TargetTable,
+			Columns: []string{backupdrill.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backupdrill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BackupDrillUpdateOne is the builder for updating a single BackupDrill entity.
+type BackupDrillUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BackupDrillMutation
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *BackupDrillUpdateOne) SetTargetID(v int) *BackupDrillUpdateOne {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *BackupDrillUpdateOne) SetNillableTargetID(v *int) *BackupDrillUpdateOne {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *BackupDrillUpdateOne) SetFilename(v string) *BackupDrillUpdateOne {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *BackupDrillUpdateOne) SetNillableFilename(v *string) *BackupDrillUpdateOne {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (_u *BackupDrillUpdateOne) ClearFilename() *BackupDrillUpdateOne {
+	_u.mutation.ClearFilename()
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *BackupDrillUpdateOne) SetTrigger(v string) *BackupDrillUpdateOne {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *BackupDrillUpdateOne) SetNillableTrigger(v *string) *BackupDrillUpdateOne {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BackupDrillUpdateOne) SetStatus(v string) *BackupDrillUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BackupDrillUpdateOne) SetNillableStatus(v *string) *BackupDrillUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *BackupDrillUpdateOne) SetError(v string) *BackupDrillUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *BackupDrillUpdateOne) SetNillableError(v *string) *BackupDrillUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *BackupDrillUpdateOne) ClearError() *BackupDrillUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetChecksJSON sets the "checks_json" field.
+func (_u *BackupDrillUpdateOne) SetChecksJSON(v string) *BackupDrillUpdateOne {
+	_u.mutation.SetChecksJSON(v)
+	return _u
+}
+
+// SetNillableChecksJSON sets the "checks_json" field if the given value is not nil.
+func (_u *BackupDrillUpdateOne) SetNillableChecksJSON(v *string) *BackupDrillUpdateOne {
+	if v != nil {
+		_u.SetChecksJSON(*v)
+	}
+	return _u
+}
+
+// ClearChecksJSON clears the value of the "checks_json" field.
+func (_u *BackupDrillUpdateOne) ClearChecksJSON() *BackupDrillUpdateOne {
+	_u.mutation.ClearChecksJSON()
+	return _u
+}
+
+// SetCountsJSON sets the "counts_json" field.
+func (_u *BackupDrillUpdateOne) SetCountsJSON(v string) *BackupDrillUpdateOne {
+	_u.mutation.SetCountsJSON(v)
+	return _u
+}
+
+// SetNillableCountsJSON sets the "counts_json" field if the given value is not nil.
+func (_u *BackupDrillUpdateOne) SetNillableCountsJSON(v *string) *BackupDrillUpdateOne {
+	if v != nil {
+		_u.SetCountsJSON(*v)
+	}
+	return _u
+}
+
+// ClearCountsJSON clears the value of the "counts_json" field.
+func (_u *BackupDrillUpdateOne) ClearCountsJSON() *BackupDrillUpdateOne {
+	_u.mutation.ClearCountsJSON()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *BackupDrillUpdateOne) SetStartedAt(v time.Time) *BackupDrillUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *BackupDrillUpdateOne) SetNillableStartedAt(v *time.Time) *BackupDrillUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *BackupDrillUpdateOne) SetFinishedAt(v time.Time) *BackupDrillUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *BackupDrillUpdateOne) SetNillableFinishedAt(v *time.Time) *BackupDrillUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *BackupDrillUpdateOne) SetDurationMs(v int64) *BackupDrillUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *BackupDrillUpdateOne) SetNillableDurationMs(v *int64) *BackupDrillUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *BackupDrillUpdateOne) AddDurationMs(v int64) *BackupDrillUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (_u *BackupDrillUpdateOne) SetTarget(v *BackupTarget) *BackupDrillUpdateOne {
+	return _u.SetTargetID(v.ID)
+}
+
+// Mutation returns the BackupDrillMutation object of the builder.
+func (_u *BackupDrillUpdateOne) Mutation() *BackupDrillMutation {
+	return _u.mutation
+}
+
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (_u *BackupDrillUpdateOne) ClearTarget() *BackupDrillUpdateOne {
+	_u.mutation.ClearTarget()
+	return _u
+}
+
+// Where appends a list predicates to the BackupDrillUpdate builder.
+func (_u *BackupDrillUpdateOne) Where(ps ...predicate.BackupDrill) *BackupDrillUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BackupDrillUpdateOne) Select(field string, fields ...string) *BackupDrillUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BackupDrill entity.
+func (_u *BackupDrillUpdateOne) Save(ctx context.Context) (*BackupDrill, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BackupDrillUpdateOne) SaveX(ctx context.Context) *BackupDrill {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BackupDrillUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BackupDrillUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BackupDrillUpdateOne) check() error {
+	if _u.mutation.TargetCleared() && len(_u.mutation.TargetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BackupDrill.target"`)
+	}
+	return nil
+}
+
+func (_u *BackupDrillUpdateOne) sqlSave(ctx context.Context) (_node *BackupDrill, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(backupdrill.Table, backupdrill.Columns, sqlgraph.NewFieldSpec(backupdrill.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BackupDrill.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backupdrill.FieldID)
+		for _, f := range fields {
+			if !backupdrill.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != backupdrill.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(backupdrill.FieldFilename, field.TypeString, value)
+	}
+	if _u.mutation.FilenameCleared() {
+		_spec.ClearField(backupdrill.FieldFilename, field.TypeString)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(backupdrill.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(backupdrill.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(backupdrill.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(backupdrill.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChecksJSON(); ok {
+		_spec.SetField(backupdrill.FieldChecksJSON, field.TypeString, value)
+	}
+	if _u.mutation.ChecksJSONCleared() {
+		_spec.ClearField(backupdrill.FieldChecksJSON, field.TypeString)
+	}
+	if value, ok := _u.mutation.CountsJSON(); ok {
+		_spec.SetField(backupdrill.FieldCountsJSON, field.TypeString, value)
+	}
+	if _u.mutation.CountsJSONCleared() {
+		_spec.ClearField(backupdrill.FieldCountsJSON, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(backupdrill.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(backupdrill.FieldFinishedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(backupdrill.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(backupdrill.FieldDurationMs, field.TypeInt64, value)
+	}
+	if _u.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backupdrill.TargetTable,
+			Columns: []string{backupdrill.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backupdrill.TargetTable,
+			Columns: []string{backupdrill.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BackupDrill{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backupdrill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
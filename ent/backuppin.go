@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"smarticky/ent/backuppin"
+	"smarticky/ent/backuptarget"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BackupPin is the model entity for the BackupPin schema.
+type BackupPin struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TargetID holds the value of the "target_id" field.
+	TargetID int `json:"target_id,omitempty"`
+	// Filename holds the value of the "filename" field.
+	Filename string `json:"filename,omitempty"`
+	// Why the backup is kept, e.g. before a migration
+	Note string `json:"note,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BackupPinQuery when eager-loading is set.
+	Edges        BackupPinEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BackupPinEdges holds the relations/edges for other nodes in the graph.
+type BackupPinEdges struct {
+	// Target holds the value of the target edge.
+	Target *BackupTarget `json:"target,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TargetOrErr returns the Target value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BackupPinEdges) TargetOrErr() (*BackupTarget, error) {
+	if e.Target != nil {
+		return e.Target, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: backuptarget.Label}
+	}
+	return nil, &NotLoadedError{edge: "target"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BackupPin) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case backuppin.FieldID, backuppin.FieldTargetID:
+			values[i] = new(sql.NullInt64)
+		case backuppin.FieldFilename, backuppin.FieldNote:
+			values[i] = new(sql.NullString)
+		case backuppin.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BackupPin fields.
+func (_m *BackupPin) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case backuppin.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case backuppin.FieldTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				_m.TargetID = int(value.Int64)
+			}
+		case backuppin.FieldFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filename", values[i])
+			} else if value.Valid {
+				_m.Filename = value.String
+			}
+		case backuppin.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case backuppin.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BackupPin.
+// This includes values selected through modifiers, order, etc.
+func (_m *BackupPin) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTarget queries the "target" edge of the BackupPin entity.
+func (_m *BackupPin) QueryTarget() *BackupTargetQuery {
+	return NewBackupPinClient(_m.config).QueryTarget(_m)
+}
+
+// Update returns a builder for updating this BackupPin.
+// Note that you need to call BackupPin.Unwrap() before calling this method if this BackupPin
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BackupPin) Update() *BackupPinUpdateOne {
+	return NewBackupPinClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BackupPin entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BackupPin) Unwrap() *BackupPin {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BackupPin is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BackupPin) String() string {
+	var builder strings.Builder
+	builder.WriteString("BackupPin(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("filename=")
+	builder.WriteString(_m.Filename)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BackupPins is a parsable slice of BackupPin.
+type BackupPins []*BackupPin
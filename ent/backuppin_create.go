@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"smarticky/ent/backuppin"
+	"smarticky/ent/backuptarget"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupPinCreate is the builder for creating a BackupPin entity.
+type BackupPinCreate struct {
+	config
+	mutation *BackupPinMutation
+	hooks    []Hook
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *BackupPinCreate) SetTargetID(v int) *BackupPinCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetFilename sets the "filename" field.
+func (_c *BackupPinCreate) SetFilename(v string) *BackupPinCreate {
+	_c.mutation.SetFilename(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *BackupPinCreate) SetNote(v string) *BackupPinCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *BackupPinCreate) SetNillableNote(v *string) *BackupPinCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BackupPinCreate) SetCreatedAt(v time.Time) *BackupPinCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BackupPinCreate) SetNillableCreatedAt(v *time.Time) *BackupPinCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (_c *BackupPinCreate) SetTarget(v *BackupTarget) *BackupPinCreate {
+	return _c.SetTargetID(v.ID)
+}
+
+// Mutation returns the BackupPinMutation object of the builder.
+func (_c *BackupPinCreate) Mutation() *BackupPinMutation {
+	return _c.mutation
+}
+
+// Save creates the BackupPin in the database.
+func (_c *BackupPinCreate) Save(ctx context.Context) (*BackupPin, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BackupPinCreate) SaveX(ctx context.Context) *BackupPin {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BackupPinCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BackupPinCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BackupPinCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := backuppin.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BackupPinCreate) check() error {
+	if _, ok := _c.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "BackupPin.target_id"`)}
+	}
+	if _, ok := _c.mutation.Filename(); !ok {
+		return &ValidationError{Name: "filename", err: errors.New(`ent: missing required field "BackupPin.filename"`)}
+	}
+	if v, ok := _c.mutation.Filename(); ok {
+		if err := backuppin.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "BackupPin.filename": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BackupPin.created_at"`)}
+	}
+	if len(_c.mutation.TargetIDs()) == 0 {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required edge "BackupPin.target"`)}
+	}
+	return nil
+}
+
+func (_c *BackupPinCreate) sqlSave(ctx context.Context) (*BackupPin, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BackupPinCreate) createSpec() (*BackupPin, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BackupPin{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(backuppin.Table, sqlgraph.NewFieldSpec(backuppin.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Filename(); ok {
+		_spec.SetField(backuppin.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(backuppin.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(backuppin.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppin.TargetTable,
+			Columns: []string{backuppin.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TargetID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BackupPinCreateBulk is the builder for creating many BackupPin entities in bulk.
+type BackupPinCreateBulk struct {
+	config
+	err      error
+	builders []*BackupPinCreate
+}
+
+// Save creates the BackupPin entities in the database.
+func (_c *BackupPinCreateBulk) Save(ctx context.Context) ([]*BackupPin, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BackupPin, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BackupPinMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BackupPinCreateBulk) SaveX(ctx context.Context) []*BackupPin {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BackupPinCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BackupPinCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
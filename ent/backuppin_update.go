@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"smarticky/ent/backuppin"
+	"smarticky/ent/backuptarget"
+	"smarticky/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupPinUpdate is the builder for updating BackupPin entities.
+type BackupPinUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BackupPinMutation
+}
+
+// Where appends a list predicates to the BackupPinUpdate builder.
+func (_u *BackupPinUpdate) Where(ps ...predicate.BackupPin) *BackupPinUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *BackupPinUpdate) SetTargetID(v int) *BackupPinUpdate {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *BackupPinUpdate) SetNillableTargetID(v *int) *BackupPinUpdate {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *BackupPinUpdate) SetFilename(v string) *BackupPinUpdate {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *BackupPinUpdate) SetNillableFilename(v *string) *BackupPinUpdate {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *BackupPinUpdate) SetNote(v string) *BackupPinUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *BackupPinUpdate) SetNillableNote(v *string) *BackupPinUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *BackupPinUpdate) ClearNote() *BackupPinUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (_u *BackupPinUpdate) SetTarget(v *BackupTarget) *BackupPinUpdate {
+	return _u.SetTargetID(v.ID)
+}
+
+// Mutation returns the BackupPinMutation object of the builder.
+func (_u *BackupPinUpdate) Mutation() *BackupPinMutation {
+	return _u.mutation
+}
+
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (_u *BackupPinUpdate) ClearTarget() *BackupPinUpdate {
+	_u.mutation.ClearTarget()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BackupPinUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BackupPinUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BackupPinUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BackupPinUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BackupPinUpdate) check() error {
+	if v, ok := _u.mutation.Filename(); ok {
+		if err := backuppin.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "BackupPin.filename": %w`, err)}
+		}
+	}
+	if _u.mutation.TargetCleared() && len(_u.mutation.TargetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BackupPin.target"`)
+	}
+	return nil
+}
+
+func (_u *BackupPinUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(backuppin.Table, backuppin.Columns, sqlgraph.NewFieldSpec(backuppin.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(backuppin.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(backuppin.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(backuppin.FieldNote, field.TypeString)
+	}
+	if _u.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppin.TargetTable,
+			Columns: []string{backuppin.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppin.TargetTable,
+			Columns: []string{backuppin.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backuppin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BackupPinUpdateOne is the builder for updating a single BackupPin entity.
+type BackupPinUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BackupPinMutation
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *BackupPinUpdateOne) SetTargetID(v int) *BackupPinUpdateOne {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *BackupPinUpdateOne) SetNillableTargetID(v *int) *BackupPinUpdateOne {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *BackupPinUpdateOne) SetFilename(v string) *BackupPinUpdateOne {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *BackupPinUpdateOne) SetNillableFilename(v *string) *BackupPinUpdateOne {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *BackupPinUpdateOne) SetNote(v string) *BackupPinUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *BackupPinUpdateOne) SetNillableNote(v *string) *BackupPinUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *BackupPinUpdateOne) ClearNote() *BackupPinUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (_u *BackupPinUpdateOne) SetTarget(v *BackupTarget) *BackupPinUpdateOne {
+	return _u.SetTargetID(v.ID)
+}
+
+// Mutation returns the BackupPinMutation object of the builder.
+func (_u *BackupPinUpdateOne) Mutation() *BackupPinMutation {
+	return _u.mutation
+}
+
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (_u *BackupPinUpdateOne) ClearTarget() *BackupPinUpdateOne {
+	_u.mutation.ClearTarget()
+	return _u
+}
+
+// Where appends a list predicates to the BackupPinUpdate builder.
+func (_u *BackupPinUpdateOne) Where(ps ...predicate.BackupPin) *BackupPinUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BackupPinUpdateOne) Select(field string, fields ...string) *BackupPinUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BackupPin entity.
+func (_u *BackupPinUpdateOne) Save(ctx context.Context) (*BackupPin, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BackupPinUpdateOne) SaveX(ctx context.Context) *BackupPin {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BackupPinUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BackupPinUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BackupPinUpdateOne) check() error {
+	if v, ok := _u.mutation.Filename(); ok {
+		if err := backuppin.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "BackupPin.filename": %w`, err)}
+		}
+	}
+	if _u.mutation.TargetCleared() && len(_u.mutation.TargetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BackupPin.target"`)
+	}
+	return nil
+}
+
+func (_u *BackupPinUpdateOne) sqlSave(ctx context.Context) (_node *BackupPin, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(backuppin.Table, backuppin.Columns, sqlgraph.NewFieldSpec(backuppin.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BackupPin.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backuppin.FieldID)
+		for _, f := range fields {
+			if !backuppin.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != backuppin.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(backuppin.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(backuppin.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(backuppin.FieldNote, field.TypeString)
+	}
+	if _u.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppin.TargetTable,
+			Columns: []string{backuppin.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppin.TargetTable,
+			Columns: []string{backuppin.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BackupPin{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backuppin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
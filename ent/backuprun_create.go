@@ -0,0 +1,413 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"smarticky/ent/backuprun"
+	"smarticky/ent/backuptarget"
+	"smarticky/ent/backuptask"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupRunCreate is the builder for creating a BackupRun entity.
+type BackupRunCreate struct {
+	config
+	mutation *BackupRunMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *BackupRunCreate) SetTaskID(v int) *BackupRunCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_c *BackupRunCreate) SetNillableTaskID(v *int) *BackupRunCreate {
+	if v != nil {
+		_c.SetTaskID(*v)
+	}
+	return _c
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *BackupRunCreate) SetTargetID(v int) *BackupRunCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_c *BackupRunCreate) SetNillableTargetID(v *int) *BackupRunCreate {
+	if v != nil {
+		_c.SetTargetID(*v)
+	}
+	return _c
+}
+
+// SetTrigger sets the "trigger" field.
+func (_c *BackupRunCreate) SetTrigger(v string) *BackupRunCreate {
+	_c.mutation.SetTrigger(v)
+	return _c
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_c *BackupRunCreate) SetNillableTrigger(v *string) *BackupRunCreate {
+	if v != nil {
+		_c.SetTrigger(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BackupRunCreate) SetStatus(v string) *BackupRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetFilename sets the "filename" field.
+func (_c *BackupRunCreate) SetFilename(v string) *BackupRunCreate {
+	_c.mutation.SetFilename(v)
+	return _c
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_c *BackupRunCreate) SetNillableFilename(v *string) *BackupRunCreate {
+	if v != nil {
+		_c.SetFilename(*v)
+	}
+	return _c
+}
+
+// SetBytes sets the "bytes" field.
+func (_c *BackupRunCreate) SetBytes(v int64) *BackupRunCreate {
+	_c.mutation.SetBytes(v)
+	return _c
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (_c *BackupRunCreate) SetNillableBytes(v *int64) *BackupRunCreate {
+	if v != nil {
+		_c.SetBytes(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *BackupRunCreate) SetError(v string) *BackupRunCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *BackupRunCreate) SetNillableError(v *string) *BackupRunCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *BackupRunCreate) SetStartedAt(v time.Time) *BackupRunCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *BackupRunCreate) SetNillableStartedAt(v *time.Time) *BackupRunCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *BackupRunCreate) SetFinishedAt(v time.Time) *BackupRunCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_c *BackupRunCreate) SetDurationMs(v int64) *BackupRunCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_c *BackupRunCreate) SetNillableDurationMs(v *int64) *BackupRunCreate {
+	if v != nil {
+		_c.SetDurationMs(*v)
+	}
+	return _c
+}
+
+// SetTask sets the "task" edge to the BackupTask entity.
+func (_c *BackupRunCreate) SetTask(v *BackupTask) *BackupRunCreate {
+	return _c.SetTaskID(v.ID)
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (_c *BackupRunCreate) SetTarget(v *BackupTarget) *BackupRunCreate {
+	return _c.SetTargetID(v.ID)
+}
+
+// Mutation returns the BackupRunMutation object of the builder.
+func (_c *BackupRunCreate) Mutation() *BackupRunMutation {
+	return _c.mutation
+}
+
+// Save creates the BackupRun in the database.
+func (_c *BackupRunCreate) Save(ctx context.Context) (*BackupRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BackupRunCreate) SaveX(ctx context.Context) *BackupRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BackupRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BackupRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BackupRunCreate) defaults() {
+	if _, ok := _c.mutation.Trigger(); !ok {
+		v := backuprun.DefaultTrigger
+		_c.mutation.SetTrigger(v)
+	}
+	if _, ok := _c.mutation.Bytes(); !ok {
+		v := backuprun.DefaultBytes
+		_c.mutation.SetBytes(v)
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		v := backuprun.DefaultStartedAt()
+		_c.mutation.SetStartedAt(v)
+	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		v := backuprun.DefaultDurationMs
+		_c.mutation.SetDurationMs(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BackupRunCreate) check() error {
+	if _, ok := _c.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "BackupRun.trigger"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BackupRun.status"`)}
+	}
+	if _, ok := _c.mutation.Bytes(); !ok {
+		return &ValidationError{Name: "bytes", err: errors.New(`ent: missing required field "BackupRun.bytes"`)}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "BackupRun.started_at"`)}
+	}
+	if _, ok := _c.mutation.FinishedAt(); !ok {
+		return &ValidationError{Name: "finished_at", err: errors.New(`ent: missing required field "BackupRun.finished_at"`)}
+	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`ent: missing required field "BackupRun.duration_ms"`)}
+	}
+	return nil
+}
+
+func (_c *BackupRunCreate) sqlSave(ctx context.Context) (*BackupRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BackupRunCreate) createSpec() (*BackupRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BackupRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(backuprun.Table, sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Trigger(); ok {
+		_spec.SetField(backuprun.FieldTrigger, field.TypeString, value)
+		_node.Trigger = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(backuprun.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Filename(); ok {
+		_spec.SetField(backuprun.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := _c.mutation.Bytes(); ok {
+		_spec.SetField(backuprun.FieldBytes, field.TypeInt64, value)
+		_node.Bytes = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(backuprun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(backuprun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(backuprun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = value
+	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(backuprun.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = value
+	}
+	if nodes := _c.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuprun.TaskTable,
+			Columns: []string{backuprun.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptask.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuprun.TargetTable,
+			Columns: []string{backuprun.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TargetID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BackupRunCreateBulk is the builder for creating many BackupRun entities in bulk.
+type BackupRunCreateBulk struct {
+	config
+	err      error
+	builders []*BackupRunCreate
+}
+
+// Save creates the BackupRun entities in the database.
+func (_c *BackupRunCreateBulk) Save(ctx context.Context) ([]*BackupRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BackupRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BackupRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BackupRunCreateBulk) SaveX(ctx context.Context) []*BackupRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BackupRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BackupRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,766 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"smarticky/ent/backuprun"
+	"smarticky/ent/backuptarget"
+	"smarticky/ent/backuptask"
+	"smarticky/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupRunUpdate is the builder for updating BackupRun entities.
+type BackupRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BackupRunMutation
+}
+
+// Where appends a list predicates to the BackupRunUpdate builder.
+func (_u *BackupRunUpdate) Where(ps ...predicate.BackupRun) *BackupRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *BackupRunUpdate) SetTaskID(v int) *BackupRunUpdate {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *BackupRunUpdate) SetNillableTaskID(v *int) *BackupRunUpdate {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (_u *BackupRunUpdate) ClearTaskID() *BackupRunUpdate {
+	_u.mutation.ClearTaskID()
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *BackupRunUpdate) SetTargetID(v int) *BackupRunUpdate {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *BackupRunUpdate) SetNillableTargetID(v *int) *BackupRunUpdate {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (_u *BackupRunUpdate) ClearTargetID() *BackupRunUpdate {
+	_u.mutation.ClearTargetID()
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *BackupRunUpdate) SetTrigger(v string) *BackupRunUpdate {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *BackupRunUpdate) SetNillableTrigger(v *string) *BackupRunUpdate {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BackupRunUpdate) SetStatus(v string) *BackupRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BackupRunUpdate) SetNillableStatus(v *string) *BackupRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *BackupRunUpdate) SetFilename(v string) *BackupRunUpdate {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *BackupRunUpdate) SetNillableFilename(v *string) *BackupRunUpdate {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (_u *BackupRunUpdate) ClearFilename() *BackupRunUpdate {
+	_u.mutation.ClearFilename()
+	return _u
+}
+
+// SetBytes sets the "bytes" field.
+func (_u *BackupRunUpdate) SetBytes(v int64) *BackupRunUpdate {
+	_u.mutation.ResetBytes()
+	_u.mutation.SetBytes(v)
+	return _u
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (_u *BackupRunUpdate) SetNillableBytes(v *int64) *BackupRunUpdate {
+	if v != nil {
+		_u.SetBytes(*v)
+	}
+	return _u
+}
+
+// AddBytes adds value to the "bytes" field.
+func (_u *BackupRunUpdate) AddBytes(v int64) *BackupRunUpdate {
+	_u.mutation.AddBytes(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *BackupRunUpdate) SetError(v string) *BackupRunUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *BackupRunUpdate) SetNillableError(v *string) *BackupRunUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *BackupRunUpdate) ClearError() *BackupRunUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *BackupRunUpdate) SetStartedAt(v time.Time) *BackupRunUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *BackupRunUpdate) SetNillableStartedAt(v *time.Time) *BackupRunUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *BackupRunUpdate) SetFinishedAt(v time.Time) *BackupRunUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *BackupRunUpdate) SetNillableFinishedAt(v *time.Time) *BackupRunUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *BackupRunUpdate) SetDurationMs(v int64) *BackupRunUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *BackupRunUpdate) SetNillableDurationMs(v *int64) *BackupRunUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *BackupRunUpdate) AddDurationMs(v int64) *BackupRunUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// SetTask sets the "task" edge to the BackupTask entity.
+func (_u *BackupRunUpdate) SetTask(v *BackupTask) *BackupRunUpdate {
+	return _u.SetTaskID(v.ID)
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (_u *BackupRunUpdate) SetTarget(v *BackupTarget) *BackupRunUpdate {
+	return _u.SetTargetID(v.ID)
+}
+
+// Mutation returns the BackupRunMutation object of the builder.
+func (_u *BackupRunUpdate) Mutation() *BackupRunMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the BackupTask entity.
+func (_u *BackupRunUpdate) ClearTask() *BackupRunUpdate {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (_u *BackupRunUpdate) ClearTarget() *BackupRunUpdate {
+	_u.mutation.ClearTarget()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BackupRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BackupRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BackupRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BackupRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BackupRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(backuprun.Table, backuprun.Columns, sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(backuprun.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(backuprun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(backuprun.FieldFilename, field.TypeString, value)
+	}
+	if _u.mutation.FilenameCleared() {
+		_spec.ClearField(backuprun.FieldFilename, field.TypeString)
+	}
+	if value, ok := _u.mutation.Bytes(); ok {
+		_spec.SetField(backuprun.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytes(); ok {
+		_spec.AddField(backuprun.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(backuprun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(backuprun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(backuprun.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(backuprun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(backuprun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(backuprun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuprun.TaskTable,
+			Columns: []string{backuprun.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptask.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuprun.TaskTable,
+			Columns: []string{backuprun.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptask.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuprun.TargetTable,
+			Columns: []string{backuprun.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuprun.TargetTable,
+			Columns: []string{backuprun.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backuprun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BackupRunUpdateOne is the builder for updating a single BackupRun entity.
+type BackupRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BackupRunMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *BackupRunUpdateOne) SetTaskID(v int) *BackupRunUpdateOne {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *BackupRunUpdateOne) SetNillableTaskID(v *int) *BackupRunUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (_u *BackupRunUpdateOne) ClearTaskID() *BackupRunUpdateOne {
+	_u.mutation.ClearTaskID()
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *BackupRunUpdateOne) SetTargetID(v int) *BackupRunUpdateOne {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *BackupRunUpdateOne) SetNillableTargetID(v *int) *BackupRunUpdateOne {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (_u *BackupRunUpdateOne) ClearTargetID() *BackupRunUpdateOne {
+	_u.mutation.ClearTargetID()
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *BackupRunUpdateOne) SetTrigger(v string) *BackupRunUpdateOne {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *BackupRunUpdateOne) SetNillableTrigger(v *string) *BackupRunUpdateOne {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BackupRunUpdateOne) SetStatus(v string) *BackupRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BackupRunUpdateOne) SetNillableStatus(v *string) *BackupRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *BackupRunUpdateOne) SetFilename(v string) *BackupRunUpdateOne {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *BackupRunUpdateOne) SetNillableFilename(v *string) *BackupRunUpdateOne {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (_u *BackupRunUpdateOne) ClearFilename() *BackupRunUpdateOne {
+	_u.mutation.ClearFilename()
+	return _u
+}
+
+// SetBytes sets the "bytes" field.
+func (_u *BackupRunUpdateOne) SetBytes(v int64) *BackupRunUpdateOne {
+	_u.mutation.ResetBytes()
+	_u.mutation.SetBytes(v)
+	return _u
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (_u *BackupRunUpdateOne) SetNillableBytes(v *int64) *BackupRunUpdateOne {
+	if v != nil {
+		_u.SetBytes(*v)
+	}
+	return _u
+}
+
+// AddBytes adds value to the "bytes" field.
+func (_u *BackupRunUpdateOne) AddBytes(v int64) *BackupRunUpdateOne {
+	_u.mutation.AddBytes(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *BackupRunUpdateOne) SetError(v string) *BackupRunUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *BackupRunUpdateOne) SetNillableError(v *string) *BackupRunUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *BackupRunUpdateOne) ClearError() *BackupRunUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *BackupRunUpdateOne) SetStartedAt(v time.Time) *BackupRunUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *BackupRunUpdateOne) SetNillableStartedAt(v *time.Time) *BackupRunUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *BackupRunUpdateOne) SetFinishedAt(v time.Time) *BackupRunUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *BackupRunUpdateOne) SetNillableFinishedAt(v *time.Time) *BackupRunUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *BackupRunUpdateOne) SetDurationMs(v int64) *BackupRunUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *BackupRunUpdateOne) SetNillableDurationMs(v *int64) *BackupRunUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *BackupRunUpdateOne) AddDurationMs(v int64) *BackupRunUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// SetTask sets the "task" edge to the BackupTask entity.
+func (_u *BackupRunUpdateOne) SetTask(v *BackupTask) *BackupRunUpdateOne {
+	return _u.SetTaskID(v.ID)
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (_u *BackupRunUpdateOne) SetTarget(v *BackupTarget) *BackupRunUpdateOne {
+	return _u.SetTargetID(v.ID)
+}
+
+// Mutation returns the BackupRunMutation object of the builder.
+func (_u *BackupRunUpdateOne) Mutation() *BackupRunMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the BackupTask entity.
+func (_u *BackupRunUpdateOne) ClearTask() *BackupRunUpdateOne {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (_u *BackupRunUpdateOne) ClearTarget() *BackupRunUpdateOne {
+	_u.mutation.ClearTarget()
+	return _u
+}
+
+// Where appends a list predicates to the BackupRunUpdate builder.
+func (_u *BackupRunUpdateOne) Where(ps ...predicate.BackupRun) *BackupRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BackupRunUpdateOne) Select(field string, fields ...string) *BackupRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BackupRun entity.
+func (_u *BackupRunUpdateOne) Save(ctx context.Context) (*BackupRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BackupRunUpdateOne) SaveX(ctx context.Context) *BackupRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BackupRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BackupRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BackupRunUpdateOne) sqlSave(ctx context.Context) (_node *BackupRun, err error) {
+	_spec := sqlgraph.NewUpdateSpec(backuprun.Table, backuprun.Columns, sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BackupRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backuprun.FieldID)
+		for _, f := range fields {
+			if !backuprun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != backuprun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(backuprun.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(backuprun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(backuprun.FieldFilename, field.TypeString, value)
+	}
+	if _u.mutation.FilenameCleared() {
+		_spec.ClearField(backuprun.FieldFilename, field.TypeString)
+	}
+	if value, ok := _u.mutation.Bytes(); ok {
+		_spec.SetField(backuprun.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytes(); ok {
+		_spec.AddField(backuprun.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(backuprun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(backuprun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(backuprun.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(backuprun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(backuprun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(backuprun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuprun.TaskTable,
+			Columns: []string{backuprun.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptask.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuprun.TaskTable,
+			Columns: []string{backuprun.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptask.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuprun.TargetTable,
+			Columns: []string{backuprun.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuprun.TargetTable,
+			Columns: []string{backuprun.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BackupRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backuprun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -19,7 +19,7 @@ type BackupTarget struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
-	// webdav or s3
+	// webdav, s3, local or sftp
 	Type string `json:"type,omitempty"`
 	// Enabled holds the value of the "enabled" field.
 	Enabled bool `json:"enabled,omitempty"`
@@ -51,6 +51,28 @@ type BackupTarget struct {
 	S3AccessKey string `json:"-"`
 	// S3SecretKey holds the value of the "s3_secret_key" field.
 	S3SecretKey string `json:"-"`
+	// Absolute directory on the server, e.g. a mounted NAS share
+	LocalPath string `json:"local_path,omitempty"`
+	// SftpHost holds the value of the "sftp_host" field.
+	SftpHost string `json:"sftp_host,omitempty"`
+	// SftpPort holds the value of the "sftp_port" field.
+	SftpPort int `json:"sftp_port,omitempty"`
+	// SftpUser holds the value of the "sftp_user" field.
+	SftpUser string `json:"sftp_user,omitempty"`
+	// Sealed with the server secret box
+	SftpPassword string `json:"-"`
+	// PEM private key, sealed with the server secret box
+	SftpPrivateKey string `json:"-"`
+	// SftpPath holds the value of the "sftp_path" field.
+	SftpPath string `json:"sftp_path,omitempty"`
+	// SHA256 fingerprint of the server host key, pinned on first use
+	SftpHostKey string `json:"sftp_host_key,omitempty"`
+	// Alert when the target has not had a successful backup for this long (0 = off)
+	AlertAfterHours int `json:"alert_after_hours,omitempty"`
+	// Restore the latest backup as a test every N days (0 = off)
+	DrillIntervalDays int `json:"drill_interval_days,omitempty"`
+	// When the missing-backup alert was last sent; cleared by the next success
+	StaleAlertedAt time.Time `json:"stale_alerted_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -65,9 +87,15 @@ type BackupTarget struct {
 type BackupTargetEdges struct {
 	// Tasks holds the value of the tasks edge.
 	Tasks []*BackupTask `json:"tasks,omitempty"`
+	// Pins holds the value of the pins edge.
+	Pins []*BackupPin `json:"pins,omitempty"`
+	// Runs holds the value of the runs edge.
+	Runs []*BackupRun `json:"runs,omitempty"`
+	// Drills holds the value of the drills edge.
+	Drills []*BackupDrill `json:"drills,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // TasksOrErr returns the Tasks value or an error if the edge
@@ -79,6 +107,33 @@ func (e BackupTargetEdges) TasksOrErr() ([]*BackupTask, error) {
 	return nil, &NotLoadedError{edge: "tasks"}
 }
 
+// PinsOrErr returns the Pins value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupTargetEdges) PinsOrErr() ([]*BackupPin, error) {
+	if e.loadedTypes[1] {
+		return e.Pins, nil
+	}
+	return nil, &NotLoadedError{edge: "pins"}
+}
+
+// RunsOrErr returns the Runs value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupTargetEdges) RunsOrErr() ([]*BackupRun, error) {
+	if e.loadedTypes[2] {
+		return e.Runs, nil
+	}
+	return nil, &NotLoadedError{edge: "runs"}
+}
+
+// DrillsOrErr returns the Drills value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupTargetEdges) DrillsOrErr() ([]*BackupDrill, error) {
+	if e.loadedTypes[3] {
+		return e.Drills, nil
+	}
+	return nil, &NotLoadedError{edge: "drills"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*BackupTarget) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -86,11 +141,11 @@ func (*BackupTarget) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case backuptarget.FieldEnabled:
 			values[i] = new(sql.NullBool)
-		case backuptarget.FieldID:
+		case backuptarget.FieldID, backuptarget.FieldSftpPort, backuptarget.FieldAlertAfterHours, backuptarget.FieldDrillIntervalDays:
 			values[i] = new(sql.NullInt64)
-		case backuptarget.FieldName, backuptarget.FieldType, backuptarget.FieldLastBackupStatus, backuptarget.FieldLastBackupError, backuptarget.FieldLastTestStatus, backuptarget.FieldLastTestError, backuptarget.FieldWebdavURL, backuptarget.FieldWebdavUser, backuptarget.FieldWebdavPassword, backuptarget.FieldS3Endpoint, backuptarget.FieldS3Region, backuptarget.FieldS3Bucket, backuptarget.FieldS3AccessKey, backuptarget.FieldS3SecretKey:
+		case backuptarget.FieldName, backuptarget.FieldType, backuptarget.FieldLastBackupStatus, backuptarget.FieldLastBackupError, backuptarget.FieldLastTestStatus, backuptarget.FieldLastTestError, backuptarget.FieldWebdavURL, backuptarget.FieldWebdavUser, backuptarget.FieldWebdavPassword, backuptarget.FieldS3Endpoint, backuptarget.FieldS3Region, backuptarget.FieldS3Bucket, backuptarget.FieldS3AccessKey, backuptarget.FieldS3SecretKey, backuptarget.FieldLocalPath, backuptarget.FieldSftpHost, backuptarget.FieldSftpUser, backuptarget.FieldSftpPassword, backuptarget.FieldSftpPrivateKey, backuptarget.FieldSftpPath, backuptarget.FieldSftpHostKey:
 			values[i] = new(sql.NullString)
-		case backuptarget.FieldLastBackupAt, backuptarget.FieldLastTestAt, backuptarget.FieldCreatedAt, backuptarget.FieldUpdatedAt:
+		case backuptarget.FieldLastBackupAt, backuptarget.FieldLastTestAt, backuptarget.FieldStaleAlertedAt, backuptarget.FieldCreatedAt, backuptarget.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -215,6 +270,72 @@ func (_m *BackupTarget) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.S3SecretKey = value.String
 			}
+		case backuptarget.FieldLocalPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field local_path", values[i])
+			} else if value.Valid {
+				_m.LocalPath = value.String
+			}
+		case backuptarget.FieldSftpHost:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sftp_host", values[i])
+			} else if value.Valid {
+				_m.SftpHost = value.String
+			}
+		case backuptarget.FieldSftpPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sftp_port", values[i])
+			} else if value.Valid {
+				_m.SftpPort = int(value.Int64)
+			}
+		case backuptarget.FieldSftpUser:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sftp_user", values[i])
+			} else if value.Valid {
+				_m.SftpUser = value.String
+			}
+		case backuptarget.FieldSftpPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sftp_password", values[i])
+			} else if value.Valid {
+				_m.SftpPassword = value.String
+			}
+		case backuptarget.FieldSftpPrivateKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sftp_private_key", values[i])
+			} else if value.Valid {
+				_m.SftpPrivateKey = value.String
+			}
+		case backuptarget.FieldSftpPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sftp_path", values[i])
+			} else if value.Valid {
+				_m.SftpPath = value.String
+			}
+		case backuptarget.FieldSftpHostKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sftp_host_key", values[i])
+			} else if value.Valid {
+				_m.SftpHostKey = value.String
+			}
+		case backuptarget.FieldAlertAfterHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_after_hours", values[i])
+			} else if value.Valid {
+				_m.AlertAfterHours = int(value.Int64)
+			}
+		case backuptarget.FieldDrillIntervalDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field drill_interval_days", values[i])
+			} else if value.Valid {
+				_m.DrillIntervalDays = int(value.Int64)
+			}
+		case backuptarget.FieldStaleAlertedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field stale_alerted_at", values[i])
+			} else if value.Valid {
+				_m.StaleAlertedAt = value.Time
+			}
 		case backuptarget.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -245,6 +366,21 @@ func (_m *BackupTarget) QueryTasks() *BackupTaskQuery {
 	return NewBackupTargetClient(_m.config).QueryTasks(_m)
 }
 
+// QueryPins queries the "pins" edge of the BackupTarget entity.
+func (_m *BackupTarget) QueryPins() *BackupPinQuery {
+	return NewBackupTargetClient(_m.config).QueryPins(_m)
+}
+
+// QueryRuns queries the "runs" edge of the BackupTarget entity.
+func (_m *BackupTarget) QueryRuns() *BackupRunQuery {
+	return NewBackupTargetClient(_m.config).QueryRuns(_m)
+}
+
+// QueryDrills queries the "drills" edge of the BackupTarget entity.
+func (_m *BackupTarget) QueryDrills() *BackupDrillQuery {
+	return NewBackupTargetClient(_m.config).QueryDrills(_m)
+}
+
 // Update returns a builder for updating this BackupTarget.
 // Note that you need to call BackupTarget.Unwrap() before calling this method if this BackupTarget
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -316,6 +452,37 @@ func (_m *BackupTarget) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("s3_secret_key=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("local_path=")
+	builder.WriteString(_m.LocalPath)
+	builder.WriteString(", ")
+	builder.WriteString("sftp_host=")
+	builder.WriteString(_m.SftpHost)
+	builder.WriteString(", ")
+	builder.WriteString("sftp_port=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SftpPort))
+	builder.WriteString(", ")
+	builder.WriteString("sftp_user=")
+	builder.WriteString(_m.SftpUser)
+	builder.WriteString(", ")
+	builder.WriteString("sftp_password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("sftp_private_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("sftp_path=")
+	builder.WriteString(_m.SftpPath)
+	builder.WriteString(", ")
+	builder.WriteString("sftp_host_key=")
+	builder.WriteString(_m.SftpHostKey)
+	builder.WriteString(", ")
+	builder.WriteString("alert_after_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AlertAfterHours))
+	builder.WriteString(", ")
+	builder.WriteString("drill_interval_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DrillIntervalDays))
+	builder.WriteString(", ")
+	builder.WriteString("stale_alerted_at=")
+	builder.WriteString(_m.StaleAlertedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -48,12 +48,40 @@ const (
 	FieldS3AccessKey = "s3_access_key"
 	// FieldS3SecretKey holds the string denoting the s3_secret_key field in the database.
 	FieldS3SecretKey = "s3_secret_key"
+	// FieldLocalPath holds the string denoting the local_path field in the database.
+	FieldLocalPath = "local_path"
+	// FieldSftpHost holds the string denoting the sftp_host field in the database.
+	FieldSftpHost = "sftp_host"
+	// FieldSftpPort holds the string denoting the sftp_port field in the database.
+	FieldSftpPort = "sftp_port"
+	// FieldSftpUser holds the string denoting the sftp_user field in the database.
+	FieldSftpUser = "sftp_user"
+	// FieldSftpPassword holds the string denoting the sftp_password field in the database.
+	FieldSftpPassword = "sftp_password"
+	// FieldSftpPrivateKey holds the string denoting the sftp_private_key field in the database.
+	FieldSftpPrivateKey = "sftp_private_key"
+	// FieldSftpPath holds the string denoting the sftp_path field in the database.
+	FieldSftpPath = "sftp_path"
+	// FieldSftpHostKey holds the string denoting the sftp_host_key field in the database.
+	FieldSftpHostKey = "sftp_host_key"
+	// FieldAlertAfterHours holds the string denoting the alert_after_hours field in the database.
+	FieldAlertAfterHours = "alert_after_hours"
+	// FieldDrillIntervalDays holds the string denoting the drill_interval_days field in the database.
+	FieldDrillIntervalDays = "drill_interval_days"
+	// FieldStaleAlertedAt holds the string denoting the stale_alerted_at field in the database.
+	FieldStaleAlertedAt = "stale_alerted_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeTasks holds the string denoting the tasks edge name in mutations.
 	EdgeTasks = "tasks"
+	// EdgePins holds the string denoting the pins edge name in mutations.
+	EdgePins = "pins"
+	// EdgeRuns holds the string denoting the runs edge name in mutations.
+	EdgeRuns = "runs"
+	// EdgeDrills holds the string denoting the drills edge name in mutations.
+	EdgeDrills = "drills"
 	// Table holds the table name of the backuptarget in the database.
 	Table = "backup_targets"
 	// TasksTable is the table that holds the tasks relation/edge. The primary key declared below.
@@ -61,6 +89,27 @@ const (
 	// TasksInverseTable is the table name for the BackupTask entity.
 	// It exists in this package in order to avoid circular dependency with the "backuptask" package.
 	TasksInverseTable = "backup_tasks"
+	// PinsTable is the table that holds the pins relation/edge.
+	PinsTable = "backup_pins"
+	// PinsInverseTable is the table name for the BackupPin entity.
+	// It exists in this package in order to avoid circular dependency with the "backuppin" package.
+	PinsInverseTable = "backup_pins"
+	// PinsColumn is the table column denoting the pins relation/edge.
+	PinsColumn = "target_id"
+	// RunsTable is the table that holds the runs relation/edge.
+	RunsTable = "backup_runs"
+	// RunsInverseTable is the table name for the BackupRun entity.
+	// It exists in this package in order to avoid circular dependency with the "backuprun" package.
+	RunsInverseTable = "backup_runs"
+	// RunsColumn is the table column denoting the runs relation/edge.
+	RunsColumn = "target_id"
+	// DrillsTable is the table that holds the drills relation/edge.
+	DrillsTable = "backup_drills"
+	// DrillsInverseTable is the table name for the BackupDrill entity.
+	// It exists in this package in order to avoid circular dependency with the "backupdrill" package.
+	DrillsInverseTable = "backup_drills"
+	// DrillsColumn is the table column denoting the drills relation/edge.
+	DrillsColumn = "target_id"
 )
 
 // Columns holds all SQL columns for backuptarget fields.
@@ -83,6 +132,17 @@ var Columns = []string{
 	FieldS3Bucket,
 	FieldS3AccessKey,
 	FieldS3SecretKey,
+	FieldLocalPath,
+	FieldSftpHost,
+	FieldSftpPort,
+	FieldSftpUser,
+	FieldSftpPassword,
+	FieldSftpPrivateKey,
+	FieldSftpPath,
+	FieldSftpHostKey,
+	FieldAlertAfterHours,
+	FieldDrillIntervalDays,
+	FieldStaleAlertedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -114,6 +174,12 @@ var (
 	DefaultLastBackupStatus string
 	// DefaultLastTestStatus holds the default value on creation for the "last_test_status" field.
 	DefaultLastTestStatus string
+	// DefaultSftpPort holds the default value on creation for the "sftp_port" field.
+	DefaultSftpPort int
+	// DefaultAlertAfterHours holds the default value on creation for the "alert_after_hours" field.
+	DefaultAlertAfterHours int
+	// DefaultDrillIntervalDays holds the default value on creation for the "drill_interval_days" field.
+	DefaultDrillIntervalDays int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -215,6 +281,61 @@ func ByS3SecretKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldS3SecretKey, opts...).ToFunc()
 }
 
+// ByLocalPath orders the results by the local_path field.
+func ByLocalPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocalPath, opts...).ToFunc()
+}
+
+// BySftpHost orders the results by the sftp_host field.
+func BySftpHost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSftpHost, opts...).ToFunc()
+}
+
+// BySftpPort orders the results by the sftp_port field.
+func BySftpPort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSftpPort, opts...).ToFunc()
+}
+
+// BySftpUser orders the results by the sftp_user field.
+func BySftpUser(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSftpUser, opts...).ToFunc()
+}
+
+// BySftpPassword orders the results by the sftp_password field.
+func BySftpPassword(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSftpPassword, opts...).ToFunc()
+}
+
+// BySftpPrivateKey orders the results by the sftp_private_key field.
+func BySftpPrivateKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSftpPrivateKey, opts...).ToFunc()
+}
+
+// BySftpPath orders the results by the sftp_path field.
+func BySftpPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSftpPath, opts...).ToFunc()
+}
+
+// BySftpHostKey orders the results by the sftp_host_key field.
+func BySftpHostKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSftpHostKey, opts...).ToFunc()
+}
+
+// ByAlertAfterHours orders the results by the alert_after_hours field.
+func ByAlertAfterHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlertAfterHours, opts...).ToFunc()
+}
+
+// ByDrillIntervalDays orders the results by the drill_interval_days field.
+func ByDrillIntervalDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDrillIntervalDays, opts...).ToFunc()
+}
+
+// ByStaleAlertedAt orders the results by the stale_alerted_at field.
+func ByStaleAlertedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStaleAlertedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -238,6 +359,48 @@ func ByTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPinsCount orders the results by pins count.
+func ByPinsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPinsStep(), opts...)
+	}
+}
+
+// ByPins orders the results by pins terms.
+func ByPins(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPinsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRunsCount orders the results by runs count.
+func ByRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRunsStep(), opts...)
+	}
+}
+
+// ByRuns orders the results by runs terms.
+func ByRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDrillsCount orders the results by drills count.
+func ByDrillsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDrillsStep(), opts...)
+	}
+}
+
+// ByDrills orders the results by drills terms.
+func ByDrills(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDrillsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTasksStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -245,3 +408,24 @@ func newTasksStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, TasksTable, TasksPrimaryKey...),
 	)
 }
+func newPinsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PinsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PinsTable, PinsColumn),
+	)
+}
+func newRunsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RunsTable, RunsColumn),
+	)
+}
+func newDrillsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DrillsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DrillsTable, DrillsColumn),
+	)
+}
@@ -140,6 +140,61 @@ func S3SecretKey(v string) predicate.BackupTarget {
 	return predicate.BackupTarget(sql.FieldEQ(FieldS3SecretKey, v))
 }
 
+// LocalPath applies equality check predicate on the "local_path" field. It's identical to LocalPathEQ.
+func LocalPath(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldLocalPath, v))
+}
+
+// SftpHost applies equality check predicate on the "sftp_host" field. It's identical to SftpHostEQ.
+func SftpHost(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSftpHost, v))
+}
+
+// SftpPort applies equality check predicate on the "sftp_port" field. It's identical to SftpPortEQ.
+func SftpPort(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSftpPort, v))
+}
+
+// SftpUser applies equality check predicate on the "sftp_user" field. It's identical to SftpUserEQ.
+func SftpUser(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSftpUser, v))
+}
+
+// SftpPassword applies equality check predicate on the "sftp_password" field. It's identical to SftpPasswordEQ.
+func SftpPassword(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSftpPassword, v))
+}
+
+// SftpPrivateKey applies equality check predicate on the "sftp_private_key" field. It's identical to SftpPrivateKeyEQ.
+func SftpPrivateKey(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSftpPrivateKey, v))
+}
+
+// SftpPath applies equality check predicate on the "sftp_path" field. It's identical to SftpPathEQ.
+func SftpPath(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSftpPath, v))
+}
+
+// SftpHostKey applies equality check predicate on the "sftp_host_key" field. It's identical to SftpHostKeyEQ.
+func SftpHostKey(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSftpHostKey, v))
+}
+
+// AlertAfterHours applies equality check predicate on the "alert_after_hours" field. It's identical to AlertAfterHoursEQ.
+func AlertAfterHours(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldAlertAfterHours, v))
+}
+
+// DrillIntervalDays applies equality check predicate on the "drill_interval_days" field. It's identical to DrillIntervalDaysEQ.
+func DrillIntervalDays(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldDrillIntervalDays, v))
+}
+
+// StaleAlertedAt applies equality check predicate on the "stale_alerted_at" field. It's identical to StaleAlertedAtEQ.
+func StaleAlertedAt(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldStaleAlertedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.BackupTarget {
 	return predicate.BackupTarget(sql.FieldEQ(FieldCreatedAt, v))
@@ -1270,6 +1325,701 @@ func S3SecretKeyContainsFold(v string) predicate.BackupTarget {
 	return predicate.BackupTarget(sql.FieldContainsFold(FieldS3SecretKey, v))
 }
 
+// LocalPathEQ applies the EQ predicate on the "local_path" field.
+func LocalPathEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldLocalPath, v))
+}
+
+// LocalPathNEQ applies the NEQ predicate on the "local_path" field.
+func LocalPathNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldLocalPath, v))
+}
+
+// LocalPathIn applies the In predicate on the "local_path" field.
+func LocalPathIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldLocalPath, vs...))
+}
+
+// LocalPathNotIn applies the NotIn predicate on the "local_path" field.
+func LocalPathNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldLocalPath, vs...))
+}
+
+// LocalPathGT applies the GT predicate on the "local_path" field.
+func LocalPathGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldLocalPath, v))
+}
+
+// LocalPathGTE applies the GTE predicate on the "local_path" field.
+func LocalPathGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldLocalPath, v))
+}
+
+// LocalPathLT applies the LT predicate on the "local_path" field.
+func LocalPathLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldLocalPath, v))
+}
+
+// LocalPathLTE applies the LTE predicate on the "local_path" field.
+func LocalPathLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldLocalPath, v))
+}
+
+// LocalPathContains applies the Contains predicate on the "local_path" field.
+func LocalPathContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldLocalPath, v))
+}
+
+// LocalPathHasPrefix applies the HasPrefix predicate on the "local_path" field.
+func LocalPathHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldLocalPath, v))
+}
+
+// LocalPathHasSuffix applies the HasSuffix predicate on the "local_path" field.
+func LocalPathHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldLocalPath, v))
+}
+
+// LocalPathIsNil applies the IsNil predicate on the "local_path" field.
+func LocalPathIsNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIsNull(FieldLocalPath))
+}
+
+// LocalPathNotNil applies the NotNil predicate on the "local_path" field.
+func LocalPathNotNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotNull(FieldLocalPath))
+}
+
+// LocalPathEqualFold applies the EqualFold predicate on the "local_path" field.
+func LocalPathEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldLocalPath, v))
+}
+
+// LocalPathContainsFold applies the ContainsFold predicate on the "local_path" field.
+func LocalPathContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldLocalPath, v))
+}
+
+// SftpHostEQ applies the EQ predicate on the "sftp_host" field.
+func SftpHostEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSftpHost, v))
+}
+
+// SftpHostNEQ applies the NEQ predicate on the "sftp_host" field.
+func SftpHostNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldSftpHost, v))
+}
+
+// SftpHostIn applies the In predicate on the "sftp_host" field.
+func SftpHostIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldSftpHost, vs...))
+}
+
+// SftpHostNotIn applies the NotIn predicate on the "sftp_host" field.
+func SftpHostNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldSftpHost, vs...))
+}
+
+// SftpHostGT applies the GT predicate on the "sftp_host" field.
+func SftpHostGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldSftpHost, v))
+}
+
+// SftpHostGTE applies the GTE predicate on the "sftp_host" field.
+func SftpHostGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldSftpHost, v))
+}
+
+// SftpHostLT applies the LT predicate on the "sftp_host" field.
+func SftpHostLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldSftpHost, v))
+}
+
+// SftpHostLTE applies the LTE predicate on the "sftp_host" field.
+func SftpHostLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldSftpHost, v))
+}
+
+// SftpHostContains applies the Contains predicate on the "sftp_host" field.
+func SftpHostContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldSftpHost, v))
+}
+
+// SftpHostHasPrefix applies the HasPrefix predicate on the "sftp_host" field.
+func SftpHostHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldSftpHost, v))
+}
+
+// SftpHostHasSuffix applies the HasSuffix predicate on the "sftp_host" field.
+func SftpHostHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldSftpHost, v))
+}
+
+// SftpHostIsNil applies the IsNil predicate on the "sftp_host" field.
+func SftpHostIsNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIsNull(FieldSftpHost))
+}
+
+// SftpHostNotNil applies the NotNil predicate on the "sftp_host" field.
+func SftpHostNotNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotNull(FieldSftpHost))
+}
+
+// SftpHostEqualFold applies the EqualFold predicate on the "sftp_host" field.
+func SftpHostEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldSftpHost, v))
+}
+
+// SftpHostContainsFold applies the ContainsFold predicate on the "sftp_host" field.
+func SftpHostContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldSftpHost, v))
+}
+
+// SftpPortEQ applies the EQ predicate on the "sftp_port" field.
+func SftpPortEQ(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSftpPort, v))
+}
+
+// SftpPortNEQ applies the NEQ predicate on the "sftp_port" field.
+func SftpPortNEQ(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldSftpPort, v))
+}
+
+// SftpPortIn applies the In predicate on the "sftp_port" field.
+func SftpPortIn(vs ...int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldSftpPort, vs...))
+}
+
+// SftpPortNotIn applies the NotIn predicate on the "sftp_port" field.
+func SftpPortNotIn(vs ...int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldSftpPort, vs...))
+}
+
+// SftpPortGT applies the GT predicate on the "sftp_port" field.
+func SftpPortGT(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldSftpPort, v))
+}
+
+// SftpPortGTE applies the GTE predicate on the "sftp_port" field.
+func SftpPortGTE(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldSftpPort, v))
+}
+
+// SftpPortLT applies the LT predicate on the "sftp_port" field.
+func SftpPortLT(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldSftpPort, v))
+}
+
+// SftpPortLTE applies the LTE predicate on the "sftp_port" field.
+func SftpPortLTE(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldSftpPort, v))
+}
+
+// SftpUserEQ applies the EQ predicate on the "sftp_user" field.
+func SftpUserEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSftpUser, v))
+}
+
+// SftpUserNEQ applies the NEQ predicate on the "sftp_user" field.
+func SftpUserNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldSftpUser, v))
+}
+
+// SftpUserIn applies the In predicate on the "sftp_user" field.
+func SftpUserIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldSftpUser, vs...))
+}
+
+// SftpUserNotIn applies the NotIn predicate on the "sftp_user" field.
+func SftpUserNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldSftpUser, vs...))
+}
+
+// SftpUserGT applies the GT predicate on the "sftp_user" field.
+func SftpUserGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldSftpUser, v))
+}
+
+// SftpUserGTE applies the GTE predicate on the "sftp_user" field.
+func SftpUserGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldSftpUser, v))
+}
+
+// SftpUserLT applies the LT predicate on the "sftp_user" field.
+func SftpUserLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldSftpUser, v))
+}
+
+// SftpUserLTE applies the LTE predicate on the "sftp_user" field.
+func SftpUserLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldSftpUser, v))
+}
+
+// SftpUserContains applies the Contains predicate on the "sftp_user" field.
+func SftpUserContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldSftpUser, v))
+}
+
+// SftpUserHasPrefix applies the HasPrefix predicate on the "sftp_user" field.
+func SftpUserHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldSftpUser, v))
+}
+
+// SftpUserHasSuffix applies the HasSuffix predicate on the "sftp_user" field.
+func SftpUserHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldSftpUser, v))
+}
+
+// SftpUserIsNil applies the IsNil predicate on the "sftp_user" field.
+func SftpUserIsNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIsNull(FieldSftpUser))
+}
+
+// SftpUserNotNil applies the NotNil predicate on the "sftp_user" field.
+func SftpUserNotNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotNull(FieldSftpUser))
+}
+
+// SftpUserEqualFold applies the EqualFold predicate on the "sftp_user" field.
+func SftpUserEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldSftpUser, v))
+}
+
+// SftpUserContainsFold applies the ContainsFold predicate on the "sftp_user" field.
+func SftpUserContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldSftpUser, v))
+}
+
+// SftpPasswordEQ applies the EQ predicate on the "sftp_password" field.
+func SftpPasswordEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSftpPassword, v))
+}
+
+// SftpPasswordNEQ applies the NEQ predicate on the "sftp_password" field.
+func SftpPasswordNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldSftpPassword, v))
+}
+
+// SftpPasswordIn applies the In predicate on the "sftp_password" field.
+func SftpPasswordIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldSftpPassword, vs...))
+}
+
+// SftpPasswordNotIn applies the NotIn predicate on the "sftp_password" field.
+func SftpPasswordNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldSftpPassword, vs...))
+}
+
+// SftpPasswordGT applies the GT predicate on the "sftp_password" field.
+func SftpPasswordGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldSftpPassword, v))
+}
+
+// SftpPasswordGTE applies the GTE predicate on the "sftp_password" field.
+func SftpPasswordGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldSftpPassword, v))
+}
+
+// SftpPasswordLT applies the LT predicate on the "sftp_password" field.
+func SftpPasswordLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldSftpPassword, v))
+}
+
+// SftpPasswordLTE applies the LTE predicate on the "sftp_password" field.
+func SftpPasswordLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldSftpPassword, v))
+}
+
+// SftpPasswordContains applies the Contains predicate on the "sftp_password" field.
+func SftpPasswordContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldSftpPassword, v))
+}
+
+// SftpPasswordHasPrefix applies the HasPrefix predicate on the "sftp_password" field.
+func SftpPasswordHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldSftpPassword, v))
+}
+
+// SftpPasswordHasSuffix applies the HasSuffix predicate on the "sftp_password" field.
+func SftpPasswordHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldSftpPassword, v))
+}
+
+// SftpPasswordIsNil applies the IsNil predicate on the "sftp_password" field.
+func SftpPasswordIsNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIsNull(FieldSftpPassword))
+}
+
+// SftpPasswordNotNil applies the NotNil predicate on the "sftp_password" field.
+func SftpPasswordNotNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotNull(FieldSftpPassword))
+}
+
+// SftpPasswordEqualFold applies the EqualFold predicate on the "sftp_password" field.
+func SftpPasswordEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldSftpPassword, v))
+}
+
+// SftpPasswordContainsFold applies the ContainsFold predicate on the "sftp_password" field.
+func SftpPasswordContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldSftpPassword, v))
+}
+
+// SftpPrivateKeyEQ applies the EQ predicate on the "sftp_private_key" field.
+func SftpPrivateKeyEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSftpPrivateKey, v))
+}
+
+// SftpPrivateKeyNEQ applies the NEQ predicate on the "sftp_private_key" field.
+func SftpPrivateKeyNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldSftpPrivateKey, v))
+}
+
+// SftpPrivateKeyIn applies the In predicate on the "sftp_private_key" field.
+func SftpPrivateKeyIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldSftpPrivateKey, vs...))
+}
+
+// SftpPrivateKeyNotIn applies the NotIn predicate on the "sftp_private_key" field.
+func SftpPrivateKeyNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldSftpPrivateKey, vs...))
+}
+
+// SftpPrivateKeyGT applies the GT predicate on the "sftp_private_key" field.
+func SftpPrivateKeyGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldSftpPrivateKey, v))
+}
+
+// SftpPrivateKeyGTE applies the GTE predicate on the "sftp_private_key" field.
+func SftpPrivateKeyGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldSftpPrivateKey, v))
+}
+
+// SftpPrivateKeyLT applies the LT predicate on the "sftp_private_key" field.
+func SftpPrivateKeyLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldSftpPrivateKey, v))
+}
+
+// SftpPrivateKeyLTE applies the LTE predicate on the "sftp_private_key" field.
+func SftpPrivateKeyLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldSftpPrivateKey, v))
+}
+
+// SftpPrivateKeyContains applies the Contains predicate on the "sftp_private_key" field.
+func SftpPrivateKeyContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldSftpPrivateKey, v))
+}
+
+// SftpPrivateKeyHasPrefix applies the HasPrefix predicate on the "sftp_private_key" field.
+func SftpPrivateKeyHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldSftpPrivateKey, v))
+}
+
+// SftpPrivateKeyHasSuffix applies the HasSuffix predicate on the "sftp_private_key" field.
+func SftpPrivateKeyHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldSftpPrivateKey, v))
+}
+
+// SftpPrivateKeyIsNil applies the IsNil predicate on the "sftp_private_key" field.
+func SftpPrivateKeyIsNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIsNull(FieldSftpPrivateKey))
+}
+
+// SftpPrivateKeyNotNil applies the NotNil predicate on the "sftp_private_key" field.
+func SftpPrivateKeyNotNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotNull(FieldSftpPrivateKey))
+}
+
+// SftpPrivateKeyEqualFold applies the EqualFold predicate on the "sftp_private_key" field.
+func SftpPrivateKeyEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldSftpPrivateKey, v))
+}
+
+// SftpPrivateKeyContainsFold applies the ContainsFold predicate on the "sftp_private_key" field.
+func SftpPrivateKeyContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldSftpPrivateKey, v))
+}
+
+// SftpPathEQ applies the EQ predicate on the "sftp_path" field.
+func SftpPathEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSftpPath, v))
+}
+
+// SftpPathNEQ applies the NEQ predicate on the "sftp_path" field.
+func SftpPathNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldSftpPath, v))
+}
+
+// SftpPathIn applies the In predicate on the "sftp_path" field.
+func SftpPathIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldSftpPath, vs...))
+}
+
+// SftpPathNotIn applies the NotIn predicate on the "sftp_path" field.
+func SftpPathNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldSftpPath, vs...))
+}
+
+// SftpPathGT applies the GT predicate on the "sftp_path" field.
+func SftpPathGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldSftpPath, v))
+}
+
+// SftpPathGTE applies the GTE predicate on the "sftp_path" field.
+func SftpPathGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldSftpPath, v))
+}
+
+// SftpPathLT applies the LT predicate on the "sftp_path" field.
+func SftpPathLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldSftpPath, v))
+}
+
+// SftpPathLTE applies the LTE predicate on the "sftp_path" field.
+func SftpPathLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldSftpPath, v))
+}
+
+// SftpPathContains applies the Contains predicate on the "sftp_path" field.
+func SftpPathContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldSftpPath, v))
+}
+
+// SftpPathHasPrefix applies the HasPrefix predicate on the "sftp_path" field.
+func SftpPathHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldSftpPath, v))
+}
+
+// SftpPathHasSuffix applies the HasSuffix predicate on the "sftp_path" field.
+func SftpPathHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldSftpPath, v))
+}
+
+// SftpPathIsNil applies the IsNil predicate on the "sftp_path" field.
+func SftpPathIsNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIsNull(FieldSftpPath))
+}
+
+// SftpPathNotNil applies the NotNil predicate on the "sftp_path" field.
+func SftpPathNotNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotNull(FieldSftpPath))
+}
+
+// SftpPathEqualFold applies the EqualFold predicate on the "sftp_path" field.
+func SftpPathEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldSftpPath, v))
+}
+
+// SftpPathContainsFold applies the ContainsFold predicate on the "sftp_path" field.
+func SftpPathContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldSftpPath, v))
+}
+
+// SftpHostKeyEQ applies the EQ predicate on the "sftp_host_key" field.
+func SftpHostKeyEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSftpHostKey, v))
+}
+
+// SftpHostKeyNEQ applies the NEQ predicate on the "sftp_host_key" field.
+func SftpHostKeyNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldSftpHostKey, v))
+}
+
+// SftpHostKeyIn applies the In predicate on the "sftp_host_key" field.
+func SftpHostKeyIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldSftpHostKey, vs...))
+}
+
+// SftpHostKeyNotIn applies the NotIn predicate on the "sftp_host_key" field.
+func SftpHostKeyNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldSftpHostKey, vs...))
+}
+
+// SftpHostKeyGT applies the GT predicate on the "sftp_host_key" field.
+func SftpHostKeyGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldSftpHostKey, v))
+}
+
+// SftpHostKeyGTE applies the GTE predicate on the "sftp_host_key" field.
+func SftpHostKeyGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldSftpHostKey, v))
+}
+
+// SftpHostKeyLT applies the LT predicate on the "sftp_host_key" field.
+func SftpHostKeyLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldSftpHostKey, v))
+}
+
+// SftpHostKeyLTE applies the LTE predicate on the "sftp_host_key" field.
+func SftpHostKeyLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldSftpHostKey, v))
+}
+
+// SftpHostKeyContains applies the Contains predicate on the "sftp_host_key" field.
+func SftpHostKeyContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldSftpHostKey, v))
+}
+
+// SftpHostKeyHasPrefix applies the HasPrefix predicate on the "sftp_host_key" field.
+func SftpHostKeyHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldSftpHostKey, v))
+}
+
+// SftpHostKeyHasSuffix applies the HasSuffix predicate on the "sftp_host_key" field.
+func SftpHostKeyHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldSftpHostKey, v))
+}
+
+// SftpHostKeyIsNil applies the IsNil predicate on the "sftp_host_key" field.
+func SftpHostKeyIsNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIsNull(FieldSftpHostKey))
+}
+
+// SftpHostKeyNotNil applies the NotNil predicate on the "sftp_host_key" field.
+func SftpHostKeyNotNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotNull(FieldSftpHostKey))
+}
+
+// SftpHostKeyEqualFold applies the EqualFold predicate on the "sftp_host_key" field.
+func SftpHostKeyEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldSftpHostKey, v))
+}
+
+// SftpHostKeyContainsFold applies the ContainsFold predicate on the "sftp_host_key" field.
+func SftpHostKeyContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldSftpHostKey, v))
+}
+
+// AlertAfterHoursEQ applies the EQ predicate on the "alert_after_hours" field.
+func AlertAfterHoursEQ(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldAlertAfterHours, v))
+}
+
+// AlertAfterHoursNEQ applies the NEQ predicate on the "alert_after_hours" field.
+func AlertAfterHoursNEQ(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldAlertAfterHours, v))
+}
+
+// AlertAfterHoursIn applies the In predicate on the "alert_after_hours" field.
+func AlertAfterHoursIn(vs ...int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldAlertAfterHours, vs...))
+}
+
+// AlertAfterHoursNotIn applies the NotIn predicate on the "alert_after_hours" field.
+func AlertAfterHoursNotIn(vs ...int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldAlertAfterHours, vs...))
+}
+
+// AlertAfterHoursGT applies the GT predicate on the "alert_after_hours" field.
+func AlertAfterHoursGT(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldAlertAfterHours, v))
+}
+
+// AlertAfterHoursGTE applies the GTE predicate on the "alert_after_hours" field.
+func AlertAfterHoursGTE(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldAlertAfterHours, v))
+}
+
+// AlertAfterHoursLT applies the LT predicate on the "alert_after_hours" field.
+func AlertAfterHoursLT(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldAlertAfterHours, v))
+}
+
+// AlertAfterHoursLTE applies the LTE predicate on the "alert_after_hours" field.
+func AlertAfterHoursLTE(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldAlertAfterHours, v))
+}
+
+// DrillIntervalDaysEQ applies the EQ predicate on the "drill_interval_days" field.
+func DrillIntervalDaysEQ(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldDrillIntervalDays, v))
+}
+
+// DrillIntervalDaysNEQ applies the NEQ predicate on the "drill_interval_days" field.
+func DrillIntervalDaysNEQ(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldDrillIntervalDays, v))
+}
+
+// DrillIntervalDaysIn applies the In predicate on the "drill_interval_days" field.
+func DrillIntervalDaysIn(vs ...int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldDrillIntervalDays, vs...))
+}
+
+// DrillIntervalDaysNotIn applies the NotIn predicate on the "drill_interval_days" field.
+func DrillIntervalDaysNotIn(vs ...int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldDrillIntervalDays, vs...))
+}
+
+// DrillIntervalDaysGT applies the GT predicate on the "drill_interval_days" field.
+func DrillIntervalDaysGT(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldDrillIntervalDays, v))
+}
+
+// DrillIntervalDaysGTE applies the GTE predicate on the "drill_interval_days" field.
+func DrillIntervalDaysGTE(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldDrillIntervalDays, v))
+}
+
+// DrillIntervalDaysLT applies the LT predicate on the "drill_interval_days" field.
+func DrillIntervalDaysLT(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldDrillIntervalDays, v))
+}
+
+// DrillIntervalDaysLTE applies the LTE predicate on the "drill_interval_days" field.
+func DrillIntervalDaysLTE(v int) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldDrillIntervalDays, v))
+}
+
+// StaleAlertedAtEQ applies the EQ predicate on the "stale_alerted_at" field.
+func StaleAlertedAtEQ(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldStaleAlertedAt, v))
+}
+
+// StaleAlertedAtNEQ applies the NEQ predicate on the "stale_alerted_at" field.
+func StaleAlertedAtNEQ(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldStaleAlertedAt, v))
+}
+
+// StaleAlertedAtIn applies the In predicate on the "stale_alerted_at" field.
+func StaleAlertedAtIn(vs ...time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldStaleAlertedAt, vs...))
+}
+
+// StaleAlertedAtNotIn applies the NotIn predicate on the "stale_alerted_at" field.
+func StaleAlertedAtNotIn(vs ...time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldStaleAlertedAt, vs...))
+}
+
+// StaleAlertedAtGT applies the GT predicate on the "stale_alerted_at" field.
+func StaleAlertedAtGT(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldStaleAlertedAt, v))
+}
+
+// StaleAlertedAtGTE applies the GTE predicate on the "stale_alerted_at" field.
+func StaleAlertedAtGTE(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldStaleAlertedAt, v))
+}
+
+// StaleAlertedAtLT applies the LT predicate on the "stale_alerted_at" field.
+func StaleAlertedAtLT(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldStaleAlertedAt, v))
+}
+
+// StaleAlertedAtLTE applies the LTE predicate on the "stale_alerted_at" field.
+func StaleAlertedAtLTE(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldStaleAlertedAt, v))
+}
+
+// StaleAlertedAtIsNil applies the IsNil predicate on the "stale_alerted_at" field.
+func StaleAlertedAtIsNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIsNull(FieldStaleAlertedAt))
+}
+
+// StaleAlertedAtNotNil applies the NotNil predicate on the "stale_alerted_at" field.
+func StaleAlertedAtNotNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotNull(FieldStaleAlertedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.BackupTarget {
 	return predicate.BackupTarget(sql.FieldEQ(FieldCreatedAt, v))
@@ -1373,6 +2123,75 @@ func HasTasksWith(preds ...predicate.BackupTask) predicate.BackupTarget {
 	})
 }
 
+// HasPins applies the HasEdge predicate on the "pins" edge.
+func HasPins() predicate.BackupTarget {
+	return predicate.BackupTarget(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PinsTable, PinsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPinsWith applies the HasEdge predicate on the "pins" edge with a given conditions (other predicates).
+func HasPinsWith(preds ...predicate.BackupPin) predicate.BackupTarget {
+	return predicate.BackupTarget(func(s *sql.Selector) {
+		step := newPinsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRuns applies the HasEdge predicate on the "runs" edge.
+func HasRuns() predicate.BackupTarget {
+	return predicate.BackupTarget(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RunsTable, RunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunsWith applies the HasEdge predicate on the "runs" edge with a given conditions (other predicates).
+func HasRunsWith(preds ...predicate.BackupRun) predicate.BackupTarget {
+	return predicate.BackupTarget(func(s *sql.Selector) {
+		step := newRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDrills applies the HasEdge predicate on the "drills" edge.
+func HasDrills() predicate.BackupTarget {
+	return predicate.BackupTarget(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DrillsTable, DrillsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDrillsWith applies the HasEdge predicate on the "drills" edge with a given conditions (other predicates).
+func HasDrillsWith(preds ...predicate.BackupDrill) predicate.BackupTarget {
+	return predicate.BackupTarget(func(s *sql.Selector) {
+		step := newDrillsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.BackupTarget) predicate.BackupTarget {
 	return predicate.BackupTarget(sql.AndPredicates(predicates...))
@@ -6,6 +6,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"smarticky/ent/backupdrill"
+	"smarticky/ent/backuppin"
+	"smarticky/ent/backuprun"
 	"smarticky/ent/backuptarget"
 	"smarticky/ent/backuptask"
 	"time"
@@ -243,6 +246,160 @@ func (_c *BackupTargetCreate) SetNillableS3SecretKey(v *string) *BackupTargetCre
 	return _c
 }
 
+// SetLocalPath sets the "local_path" field.
+func (_c *BackupTargetCreate) SetLocalPath(v string) *BackupTargetCreate {
+	_c.mutation.SetLocalPath(v)
+	return _c
+}
+
+// SetNillableLocalPath sets the "local_path" field if the given value is not nil.
+func (_c *BackupTargetCreate) SetNillableLocalPath(v *string) *BackupTargetCreate {
+	if v != nil {
+		_c.SetLocalPath(*v)
+	}
+	return _c
+}
+
+// SetSftpHost sets the "sftp_host" field.
+func (_c *BackupTargetCreate) SetSftpHost(v string) *BackupTargetCreate {
+	_c.mutation.SetSftpHost(v)
+	return _c
+}
+
+// SetNillableSftpHost sets the "sftp_host" field if the given value is not nil.
+func (_c *BackupTargetCreate) SetNillableSftpHost(v *string) *BackupTargetCreate {
+	if v != nil {
+		_c.SetSftpHost(*v)
+	}
+	return _c
+}
+
+// SetSftpPort sets the "sftp_port" field.
+func (_c *BackupTargetCreate) SetSftpPort(v int) *BackupTargetCreate {
+	_c.mutation.SetSftpPort(v)
+	return _c
+}
+
+// SetNillableSftpPort sets the "sftp_port" field if the given value is not nil.
+func (_c *BackupTargetCreate) SetNillableSftpPort(v *int) *BackupTargetCreate {
+	if v != nil {
+		_c.SetSftpPort(*v)
+	}
+	return _c
+}
+
+// SetSftpUser sets the "sftp_user" field.
+func (_c *BackupTargetCreate) SetSftpUser(v string) *BackupTargetCreate {
+	_c.mutation.SetSftpUser(v)
+	return _c
+}
+
+// SetNillableSftpUser sets the "sftp_user" field if the given value is not nil.
+func (_c *BackupTargetCreate) SetNillableSftpUser(v *string) *BackupTargetCreate {
+	if v != nil {
+		_c.SetSftpUser(*v)
+	}
+	return _c
+}
+
+// SetSftpPassword sets the "sftp_password" field.
+func (_c *BackupTargetCreate) SetSftpPassword(v string) *BackupTargetCreate {
+	_c.mutation.SetSftpPassword(v)
+	return _c
+}
+
+// SetNillableSftpPassword sets the "sftp_password" field if the given value is not nil.
+func (_c *BackupTargetCreate) SetNillableSftpPassword(v *string) *BackupTargetCreate {
+	if v != nil {
+		_c.SetSftpPassword(*v)
+	}
+	return _c
+}
+
+// SetSftpPrivateKey sets the "sftp_private_key" field.
+func (_c *BackupTargetCreate) SetSftpPrivateKey(v string) *BackupTargetCreate {
+	_c.mutation.SetSftpPrivateKey(v)
+	return _c
+}
+
+// SetNillableSftpPrivateKey sets the "sftp_private_key" field if the given value is not nil.
+func (_c *BackupTargetCreate) SetNillableSftpPrivateKey(v *string) *BackupTargetCreate {
+	if v != nil {
+		_c.SetSftpPrivateKey(*v)
+	}
+	return _c
+}
+
+// SetSftpPath sets the "sftp_path" field.
+func (_c *BackupTargetCreate) SetSftpPath(v string) *BackupTargetCreate {
+	_c.mutation.SetSftpPath(v)
+	return _c
+}
+
+// SetNillableSftpPath sets the "sftp_path" field if the given value is not nil.
+func (_c *BackupTargetCreate) SetNillableSftpPath(v *string) *BackupTargetCreate {
+	if v != nil {
+		_c.SetSftpPath(*v)
+	}
+	return _c
+}
+
+// SetSftpHostKey sets the "sftp_host_key" field.
+func (_c *BackupTargetCreate) SetSftpHostKey(v string) *BackupTargetCreate {
+	_c.mutation.SetSftpHostKey(v)
+	return _c
+}
+
+// SetNillableSftpHostKey sets the "sftp_host_key" field if the given value is not nil.
+func (_c *BackupTargetCreate) SetNillableSftpHostKey(v *string) *BackupTargetCreate {
+	if v != nil {
+		_c.SetSftpHostKey(*v)
+	}
+	return _c
+}
+
+// SetAlertAfterHours sets the "alert_after_hours" field.
+func (_c *BackupTargetCreate) SetAlertAfterHours(v int) *BackupTargetCreate {
+	_c.mutation.SetAlertAfterHours(v)
+	return _c
+}
+
+// SetNillableAlertAfterHours sets the "alert_after_hours" field if the given value is not nil.
+func (_c *BackupTargetCreate) SetNillableAlertAfterHours(v *int) *BackupTargetCreate {
+	if v != nil {
+		_c.SetAlertAfterHours(*v)
+	}
+	return _c
+}
+
+// SetDrillIntervalDays sets the "drill_interval_days" field.
+func (_c *BackupTargetCreate) SetDrillIntervalDays(v int) *BackupTargetCreate {
+	_c.mutation.SetDrillIntervalDays(v)
+	return _c
+}
+
+// SetNillableDrillIntervalDays sets the "drill_interval_days" field if the given value is not nil.
+func (_c *BackupTargetCreate) SetNillableDrillIntervalDays(v *int) *BackupTargetCreate {
+	if v != nil {
+		_c.SetDrillIntervalDays(*v)
+	}
+	return _c
+}
+
+// SetStaleAlertedAt sets the "stale_alerted_at" field.
+func (_c *BackupTargetCreate) SetStaleAlertedAt(v time.Time) *BackupTargetCreate {
+	_c.mutation.SetStaleAlertedAt(v)
+	return _c
+}
+
+// SetNillableStaleAlertedAt sets the "stale_alerted_at" field if the given value is not nil.
+func (_c *BackupTargetCreate) SetNillableStaleAlertedAt(v *time.Time) *BackupTargetCreate {
+	if v != nil {
+		_c.SetStaleAlertedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *BackupTargetCreate) SetCreatedAt(v time.Time) *BackupTargetCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -286,6 +443,51 @@ func (_c *BackupTargetCreate) AddTasks(v ...*BackupTask) *BackupTargetCreate {
 	return _c.AddTaskIDs(ids...)
 }
 
+// AddPinIDs adds the "pins" edge to the BackupPin entity by IDs.
+func (_c *BackupTargetCreate) AddPinIDs(ids ...int) *BackupTargetCreate {
+	_c.mutation.AddPinIDs(ids...)
+	return _c
+}
+
+// AddPins adds the "pins" edges to the BackupPin entity.
+func (_c *BackupTargetCreate) AddPins(v ...*BackupPin) *BackupTargetCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPinIDs(ids...)
+}
+
+// AddRunIDs adds the "runs" edge to the BackupRun entity by IDs.
+func (_c *BackupTargetCreate) AddRunIDs(ids ...int) *BackupTargetCreate {
+	_c.mutation.AddRunIDs(ids...)
+	return _c
+}
+
+// AddRuns adds the "runs" edges to the BackupRun entity.
+func (_c *BackupTargetCreate) AddRuns(v ...*BackupRun) *BackupTargetCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRunIDs(ids...)
+}
+
+// AddDrillIDs adds the "drills" edge to the BackupDrill entity by IDs.
+func (_c *BackupTargetCreate) AddDrillIDs(ids ...int) *BackupTargetCreate {
+	_c.mutation.AddDrillIDs(ids...)
+	return _c
+}
+
+// AddDrills adds the "drills" edges to the BackupDrill entity.
+func (_c *BackupTargetCreate) AddDrills(v ...*BackupDrill) *BackupTargetCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDrillIDs(ids...)
+}
+
 // Mutation returns the BackupTargetMutation object of the builder.
 func (_c *BackupTargetCreate) Mutation() *BackupTargetMutation {
 	return _c.mutation
@@ -333,6 +535,18 @@ func (_c *BackupTargetCreate) defaults() {
 		v := backuptarget.DefaultLastTestStatus
 		_c.mutation.SetLastTestStatus(v)
 	}
+	if _, ok := _c.mutation.SftpPort(); !ok {
+		v := backuptarget.DefaultSftpPort
+		_c.mutation.SetSftpPort(v)
+	}
+	if _, ok := _c.mutation.AlertAfterHours(); !ok {
+		v := backuptarget.DefaultAlertAfterHours
+		_c.mutation.SetAlertAfterHours(v)
+	}
+	if _, ok := _c.mutation.DrillIntervalDays(); !ok {
+		v := backuptarget.DefaultDrillIntervalDays
+		_c.mutation.SetDrillIntervalDays(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := backuptarget.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -370,6 +584,15 @@ func (_c *BackupTargetCreate) check() error {
 	if _, ok := _c.mutation.LastTestStatus(); !ok {
 		return &ValidationError{Name: "last_test_status", err: errors.New(`ent: missing required field "BackupTarget.last_test_status"`)}
 	}
+	if _, ok := _c.mutation.SftpPort(); !ok {
+		return &ValidationError{Name: "sftp_port", err: errors.New(`ent: missing required field "BackupTarget.sftp_port"`)}
+	}
+	if _, ok := _c.mutation.AlertAfterHours(); !ok {
+		return &ValidationError{Name: "alert_after_hours", err: errors.New(`ent: missing required field "BackupTarget.alert_after_hours"`)}
+	}
+	if _, ok := _c.mutation.DrillIntervalDays(); !ok {
+		return &ValidationError{Name: "drill_interval_days", err: errors.New(`ent: missing required field "BackupTarget.drill_interval_days"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BackupTarget.created_at"`)}
 	}
@@ -470,6 +693,50 @@ func (_c *BackupTargetCreate) createSpec() (*BackupTarget, *sqlgraph.CreateSpec)
 		_spec.SetField(backuptarget.FieldS3SecretKey, field.TypeString, value)
 		_node.S3SecretKey = value
 	}
+	if value, ok := _c.mutation.LocalPath(); ok {
+		_spec.SetField(backuptarget.FieldLocalPath, field.TypeString, value)
+		_node.LocalPath = value
+	}
+	if value, ok := _c.mutation.SftpHost(); ok {
+		_spec.SetField(backuptarget.FieldSftpHost, field.TypeString, value)
+		_node.SftpHost = value
+	}
+	if value, ok := _c.mutation.SftpPort(); ok {
+		_spec.SetField(backuptarget.FieldSftpPort, field.TypeInt, value)
+		_node.SftpPort = value
+	}
+	if value, ok := _c.mutation.SftpUser(); ok {
+		_spec.SetField(backuptarget.FieldSftpUser, field.TypeString, value)
+		_node.SftpUser = value
+	}
+	if value, ok := _c.mutation.SftpPassword(); ok {
+		_spec.SetField(backuptarget.FieldSftpPassword, field.TypeString, value)
+		_node.SftpPassword = value
+	}
+	if value, ok := _c.mutation.SftpPrivateKey(); ok {
+		_spec.SetField(backuptarget.FieldSftpPrivateKey, field.TypeString, value)
+		_node.SftpPrivateKey = value
+	}
+	if value, ok := _c.mutation.SftpPath(); ok {
+		_spec.SetField(backuptarget.FieldSftpPath, field.TypeString, value)
+		_node.SftpPath = value
+	}
+	if value, ok := _c.mutation.SftpHostKey(); ok {
+		_spec.SetField(backuptarget.FieldSftpHostKey, field.TypeString, value)
+		_node.SftpHostKey = value
+	}
+	if value, ok := _c.mutation.AlertAfterHours(); ok {
+		_spec.SetField(backuptarget.FieldAlertAfterHours, field.TypeInt, value)
+		_node.AlertAfterHours = value
+	}
+	if value, ok := _c.mutation.DrillIntervalDays(); ok {
+		_spec.SetField(backuptarget.FieldDrillIntervalDays, field.TypeInt, value)
+		_node.DrillIntervalDays = value
+	}
+	if value, ok := _c.mutation.StaleAlertedAt(); ok {
+		_spec.SetField(backuptarget.FieldStaleAlertedAt, field.TypeTime, value)
+		_node.StaleAlertedAt = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(backuptarget.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -494,6 +761,54 @@ func (_c *BackupTargetCreate) createSpec() (*BackupTarget, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.PinsTable,
+			Columns: []string{backuptarget.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.RunsTable,
+			Columns: []string{backuptarget.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DrillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.DrillsTable,
+			Columns: []string{backuptarget.DrillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backupdrill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -7,6 +7,9 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"smarticky/ent/backupdrill"
+	"smarticky/ent/backuppin"
+	"smarticky/ent/backuprun"
 	"smarticky/ent/backuptarget"
 	"smarticky/ent/backuptask"
 	"smarticky/ent/predicate"
@@ -25,6 +28,9 @@ type BackupTargetQuery struct {
 	inters     []Interceptor
 	predicates []predicate.BackupTarget
 	withTasks  *BackupTaskQuery
+	withPins   *BackupPinQuery
+	withRuns   *BackupRunQuery
+	withDrills *BackupDrillQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +89,72 @@ func (_q *BackupTargetQuery) QueryTasks() *BackupTaskQuery {
 	return query
 }
 
+// QueryPins chains the current query on the "pins" edge.
+func (_q *BackupTargetQuery) QueryPins() *BackupPinQuery {
+	query := (&BackupPinClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuptarget.Table, backuptarget.FieldID, selector),
+			sqlgraph.To(backuppin.Table, backuppin.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuptarget.PinsTable, backuptarget.PinsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRuns chains the current query on the "runs" edge.
+func (_q *BackupTargetQuery) QueryRuns() *BackupRunQuery {
+	query := (&BackupRunClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuptarget.Table, backuptarget.FieldID, selector),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuptarget.RunsTable, backuptarget.RunsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDrills chains the current query on the "drills" edge.
+func (_q *BackupTargetQuery) QueryDrills() *BackupDrillQuery {
+	query := (&BackupDrillClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuptarget.Table, backuptarget.FieldID, selector),
+			sqlgraph.To(backupdrill.Table, backupdrill.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuptarget.DrillsTable, backuptarget.DrillsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first BackupTarget entity from the query.
 // Returns a *NotFoundError when no BackupTarget was found.
 func (_q *BackupTargetQuery) First(ctx context.Context) (*BackupTarget, error) {
@@ -276,6 +348,9 @@ func (_q *BackupTargetQuery) Clone() *BackupTargetQuery {
 		inters:     append([]Interceptor{}, _q.inters...),
 		predicates: append([]predicate.BackupTarget{}, _q.predicates...),
 		withTasks:  _q.withTasks.Clone(),
+		withPins:   _q.withPins.Clone(),
+		withRuns:   _q.withRuns.Clone(),
+		withDrills: _q.withDrills.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +368,39 @@ func (_q *BackupTargetQuery) WithTasks(opts ...func(*BackupTaskQuery)) *BackupTa
 	return _q
 }
 
+// WithPins tells the query-builder to eager-load the nodes that are connected to
+// the "pins" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BackupTargetQuery) WithPins(opts ...func(*BackupPinQuery)) *BackupTargetQuery {
+	query := (&BackupPinClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPins = query
+	return _q
+}
+
+// WithRuns tells the query-builder to eager-load the nodes that are connected to
+// the "runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BackupTargetQuery) WithRuns(opts ...func(*BackupRunQuery)) *BackupTargetQuery {
+	query := (&BackupRunClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRuns = query
+	return _q
+}
+
+// WithDrills tells the query-builder to eager-load the nodes that are connected to
+// the "drills" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BackupTargetQuery) WithDrills(opts ...func(*BackupDrillQuery)) *BackupTargetQuery {
+	query := (&BackupDrillClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDrills = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +479,11 @@ func (_q *BackupTargetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*BackupTarget{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			_q.withTasks != nil,
+			_q.withPins != nil,
+			_q.withRuns != nil,
+			_q.withDrills != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +511,27 @@ func (_q *BackupTargetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := _q.withPins; query != nil {
+		if err := _q.loadPins(ctx, query, nodes,
+			func(n *BackupTarget) { n.Edges.Pins = []*BackupPin{} },
+			func(n *BackupTarget, e *BackupPin) { n.Edges.Pins = append(n.Edges.Pins, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRuns; query != nil {
+		if err := _q.loadRuns(ctx, query, nodes,
+			func(n *BackupTarget) { n.Edges.Runs = []*BackupRun{} },
+			func(n *BackupTarget, e *BackupRun) { n.Edges.Runs = append(n.Edges.Runs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDrills; query != nil {
+		if err := _q.loadDrills(ctx, query, nodes,
+			func(n *BackupTarget) { n.Edges.Drills = []*BackupDrill{} },
+			func(n *BackupTarget, e *BackupDrill) { n.Edges.Drills = append(n.Edges.Drills, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -464,6 +596,99 @@ func (_q *BackupTargetQuery) loadTasks(ctx context.Context, query *BackupTaskQue
 	}
 	return nil
 }
+func (_q *BackupTargetQuery) loadPins(ctx context.Context, query *BackupPinQuery, nodes []*BackupTarget, init func(*BackupTarget), assign func(*BackupTarget, *BackupPin)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*BackupTarget)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(backuppin.FieldTargetID)
+	}
+	query.Where(predicate.BackupPin(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backuptarget.PinsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TargetID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "target_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *BackupTargetQuery) loadRuns(ctx context.Context, query *BackupRunQuery, nodes []*BackupTarget, init func(*BackupTarget), assign func(*BackupTarget, *BackupRun)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*BackupTarget)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(backuprun.FieldTargetID)
+	}
+	query.Where(predicate.BackupRun(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backuptarget.RunsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TargetID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "target_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "target_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *BackupTargetQuery) loadDrills(ctx context.Context, query *BackupDrillQuery, nodes []*BackupTarget, init func(*BackupTarget), assign func(*BackupTarget, *BackupDrill)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*BackupTarget)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(backupdrill.FieldTargetID)
+	}
+	query.Where(predicate.BackupDrill(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backuptarget.DrillsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TargetID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "target_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *BackupTargetQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
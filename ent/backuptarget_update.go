@@ -6,6 +6,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"smarticky/ent/backupdrill"
+	"smarticky/ent/backuppin"
+	"smarticky/ent/backuprun"
 	"smarticky/ent/backuptarget"
 	"smarticky/ent/backuptask"
 	"smarticky/ent/predicate"
@@ -339,6 +342,229 @@ func (_u *BackupTargetUpdate) ClearS3SecretKey() *BackupTargetUpdate {
 	return _u
 }
 
+// SetLocalPath sets the "local_path" field.
+func (_u *BackupTargetUpdate) SetLocalPath(v string) *BackupTargetUpdate {
+	_u.mutation.SetLocalPath(v)
+	return _u
+}
+
+// SetNillableLocalPath sets the "local_path" field if the given value is not nil.
+func (_u *BackupTargetUpdate) SetNillableLocalPath(v *string) *BackupTargetUpdate {
+	if v != nil {
+		_u.SetLocalPath(*v)
+	}
+	return _u
+}
+
+// ClearLocalPath clears the value of the "local_path" field.
+func (_u *BackupTargetUpdate) ClearLocalPath() *BackupTargetUpdate {
+	_u.mutation.ClearLocalPath()
+	return _u
+}
+
+// SetSftpHost sets the "sftp_host" field.
+func (_u *BackupTargetUpdate) SetSftpHost(v string) *BackupTargetUpdate {
+	_u.mutation.SetSftpHost(v)
+	return _u
+}
+
+// SetNillableSftpHost sets the "sftp_host" field if the given value is not nil.
+func (_u *BackupTargetUpdate) SetNillableSftpHost(v *string) *BackupTargetUpdate {
+	if v != nil {
+		_u.SetSftpHost(*v)
+	}
+	return _u
+}
+
+// ClearSftpHost clears the value of the "sftp_host" field.
+func (_u *BackupTargetUpdate) ClearSftpHost() *BackupTargetUpdate {
+	_u.mutation.ClearSftpHost()
+	return _u
+}
+
+// SetSftpPort sets the "sftp_port" field.
+func (_u *BackupTargetUpdate) SetSftpPort(v int) *BackupTargetUpdate {
+	_u.mutation.ResetSftpPort()
+	_u.mutation.SetSftpPort(v)
+	return _u
+}
+
+// SetNillableSftpPort sets the "sftp_port" field if the given value is not nil.
+func (_u *BackupTargetUpdate) SetNillableSftpPort(v *int) *BackupTargetUpdate {
+	if v != nil {
+		_u.SetSftpPort(*v)
+	}
+	return _u
+}
+
+// AddSftpPort adds value to the "sftp_port" field.
+func (_u *BackupTargetUpdate) AddSftpPort(v int) *BackupTargetUpdate {
+	_u.mutation.AddSftpPort(v)
+	return _u
+}
+
+// SetSftpUser sets the "sftp_user" field.
+func (_u *BackupTargetUpdate) SetSftpUser(v string) *BackupTargetUpdate {
+	_u.mutation.SetSftpUser(v)
+	return _u
+}
+
+// SetNillableSftpUser sets the "sftp_user" field if the given value is not nil.
+func (_u *BackupTargetUpdate) SetNillableSftpUser(v *string) *BackupTargetUpdate {
+	if v != nil {
+		_u.SetSftpUser(*v)
+	}
+	return _u
+}
+
+// ClearSftpUser clears the value of the "sftp_user" field.
+func (_u *BackupTargetUpdate) ClearSftpUser() *BackupTargetUpdate {
+	_u.mutation.ClearSftpUser()
+	return _u
+}
+
+// SetSftpPassword sets the "sftp_password" field.
+func (_u *BackupTargetUpdate) SetSftpPassword(v string) *BackupTargetUpdate {
+	_u.mutation.SetSftpPassword(v)
+	return _u
+}
+
+// SetNillableSftpPassword sets the "sftp_password" field if the given value is not nil.
+func (_u *BackupTargetUpdate) SetNillableSftpPassword(v *string) *BackupTargetUpdate {
+	if v != nil {
+		_u.SetSftpPassword(*v)
+	}
+	return _u
+}
+
+// ClearSftpPassword clears the value of the "sftp_password" field.
+func (_u *BackupTargetUpdate) ClearSftpPassword() *BackupTargetUpdate {
+	_u.mutation.ClearSftpPassword()
+	return _u
+}
+
+// SetSftpPrivateKey sets the "sftp_private_key" field.
+func (_u *BackupTargetUpdate) SetSftpPrivateKey(v string) *BackupTargetUpdate {
+	_u.mutation.SetSftpPrivateKey(v)
+	return _u
+}
+
+// SetNillableSftpPrivateKey sets the "sftp_private_key" field if the given value is not nil.
+func (_u *BackupTargetUpdate) SetNillableSftpPrivateKey(v *string) *BackupTargetUpdate {
+	if v != nil {
+		_u.SetSftpPrivateKey(*v)
+	}
+	return _u
+}
+
+// ClearSftpPrivateKey clears the value of the "sftp_private_key" field.
+func (_u *BackupTargetUpdate) ClearSftpPrivateKey() *BackupTargetUpdate {
+	_u.mutation.ClearSftpPrivateKey()
+	return _u
+}
+
+// SetSftpPath sets the "sftp_path" field.
+func (_u *BackupTargetUpdate) SetSftpPath(v string) *BackupTargetUpdate {
+	_u.mutation.SetSftpPath(v)
+	return _u
+}
+
+// SetNillableSftpPath sets the "sftp_path" field if the given value is not nil.
+func (_u *BackupTargetUpdate) SetNillableSftpPath(v *string) *BackupTargetUpdate {
+	if v != nil {
+		_u.SetSftpPath(*v)
+	}
+	return _u
+}
+
+// ClearSftpPath clears the value of the "sftp_path" field.
+func (_u *BackupTargetUpdate) ClearSftpPath() *BackupTargetUpdate {
+	_u.mutation.ClearSftpPath()
+	return _u
+}
+
+// SetSftpHostKey sets the "sftp_host_key" field.
+func (_u *BackupTargetUpdate) SetSftpHostKey(v string) *BackupTargetUpdate {
+	_u.mutation.SetSftpHostKey(v)
+	return _u
+}
+
+// SetNillableSftpHostKey sets the "sftp_host_key" field if the given value is not nil.
+func (_u *BackupTargetUpdate) SetNillableSftpHostKey(v *string) *BackupTargetUpdate {
+	if v != nil {
+		_u.SetSftpHostKey(*v)
+	}
+	return _u
+}
+
+// ClearSftpHostKey clears the value of the "sftp_host_key" field.
+func (_u *BackupTargetUpdate) ClearSftpHostKey() *BackupTargetUpdate {
+	_u.mutation.ClearSftpHostKey()
+	return _u
+}
+
+// SetAlertAfterHours sets the "alert_after_hours" field.
+func (_u *BackupTargetUpdate) SetAlertAfterHours(v int) *BackupTargetUpdate {
+	_u.mutation.ResetAlertAfterHours()
+	_u.mutation.SetAlertAfterHours(v)
+	return _u
+}
+
+// SetNillableAlertAfterHours sets the "alert_after_hours" field if the given value is not nil.
+func (_u *BackupTargetUpdate) SetNillableAlertAfterHours(v *int) *BackupTargetUpdate {
+	if v != nil {
+		_u.SetAlertAfterHours(*v)
+	}
+	return _u
+}
+
+// AddAlertAfterHours adds value to the "alert_after_hours" field.
+func (_u *BackupTargetUpdate) AddAlertAfterHours(v int) *BackupTargetUpdate {
+	_u.mutation.AddAlertAfterHours(v)
+	return _u
+}
+
+// SetDrillIntervalDays sets the "drill_interval_days" field.
+func (_u *BackupTargetUpdate) SetDrillIntervalDays(v int) *BackupTargetUpdate {
+	_u.mutation.ResetDrillIntervalDays()
+	_u.mutation.SetDrillIntervalDays(v)
+	return _u
+}
+
+// SetNillableDrillIntervalDays sets the "drill_interval_days" field if the given value is not nil.
+func (_u *BackupTargetUpdate) SetNillableDrillIntervalDays(v *int) *BackupTargetUpdate {
+	if v != nil {
+		_u.SetDrillIntervalDays(*v)
+	}
+	return _u
+}
+
+// AddDrillIntervalDays adds value to the "drill_interval_days" field.
+func (_u *BackupTargetUpdate) AddDrillIntervalDays(v int) *BackupTargetUpdate {
+	_u.mutation.AddDrillIntervalDays(v)
+	return _u
+}
+
+// SetStaleAlertedAt sets the "stale_alerted_at" field.
+func (_u *BackupTargetUpdate) SetStaleAlertedAt(v time.Time) *BackupTargetUpdate {
+	_u.mutation.SetStaleAlertedAt(v)
+	return _u
+}
+
+// SetNillableStaleAlertedAt sets the "stale_alerted_at" field if the given value is not nil.
+func (_u *BackupTargetUpdate) SetNillableStaleAlertedAt(v *time.Time) *BackupTargetUpdate {
+	if v != nil {
+		_u.SetStaleAlertedAt(*v)
+	}
+	return _u
+}
+
+// ClearStaleAlertedAt clears the value of the "stale_alerted_at" field.
+func (_u *BackupTargetUpdate) ClearStaleAlertedAt() *BackupTargetUpdate {
+	_u.mutation.ClearStaleAlertedAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *BackupTargetUpdate) SetUpdatedAt(v time.Time) *BackupTargetUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -360,6 +586,51 @@ func (_u *BackupTargetUpdate) AddTasks(v ...*BackupTask) *BackupTargetUpdate {
 	return _u.AddTaskIDs(ids...)
 }
 
+// AddPinIDs adds the "pins" edge to the BackupPin entity by IDs.
+func (_u *BackupTargetUpdate) AddPinIDs(ids ...int) *BackupTargetUpdate {
+	_u.mutation.AddPinIDs(ids...)
+	return _u
+}
+
+// AddPins adds the "pins" edges to the BackupPin entity.
+func (_u *BackupTargetUpdate) AddPins(v ...*BackupPin) *BackupTargetUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPinIDs(ids...)
+}
+
+// AddRunIDs adds the "runs" edge to the BackupRun entity by IDs.
+func (_u *BackupTargetUpdate) AddRunIDs(ids ...int) *BackupTargetUpdate {
+	_u.mutation.AddRunIDs(ids...)
+	return _u
+}
+
+// AddRuns adds the "runs" edges to the BackupRun entity.
+func (_u *BackupTargetUpdate) AddRuns(v ...*BackupRun) *BackupTargetUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRunIDs(ids...)
+}
+
+// AddDrillIDs adds the "drills" edge to the BackupDrill entity by IDs.
+func (_u *BackupTargetUpdate) AddDrillIDs(ids ...int) *BackupTargetUpdate {
+	_u.mutation.AddDrillIDs(ids...)
+	return _u
+}
+
+// AddDrills adds the "drills" edges to the BackupDrill entity.
+func (_u *BackupTargetUpdate) AddDrills(v ...*BackupDrill) *BackupTargetUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDrillIDs(ids...)
+}
+
 // Mutation returns the BackupTargetMutation object of the builder.
 func (_u *BackupTargetUpdate) Mutation() *BackupTargetMutation {
 	return _u.mutation
@@ -386,6 +657,69 @@ func (_u *BackupTargetUpdate) RemoveTasks(v ...*BackupTask) *BackupTargetUpdate
 	return _u.RemoveTaskIDs(ids...)
 }
 
+// ClearPins clears all "pins" edges to the BackupPin entity.
+func (_u *BackupTargetUpdate) ClearPins() *BackupTargetUpdate {
+	_u.mutation.ClearPins()
+	return _u
+}
+
+// RemovePinIDs removes the "pins" edge to BackupPin entities by IDs.
+func (_u *BackupTargetUpdate) RemovePinIDs(ids ...int) *BackupTargetUpdate {
+	_u.mutation.RemovePinIDs(ids...)
+	return _u
+}
+
+// RemovePins removes "pins" edges to BackupPin entities.
+func (_u *BackupTargetUpdate) RemovePins(v ...*BackupPin) *BackupTargetUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePinIDs(ids...)
+}
+
+// ClearRuns clears all "runs" edges to the BackupRun entity.
+func (_u *BackupTargetUpdate) ClearRuns() *BackupTargetUpdate {
+	_u.mutation.ClearRuns()
+	return _u
+}
+
+// RemoveRunIDs removes the "runs" edge to BackupRun entities by IDs.
+func (_u *BackupTargetUpdate) RemoveRunIDs(ids ...int) *BackupTargetUpdate {
+	_u.mutation.RemoveRunIDs(ids...)
+	return _u
+}
+
+// RemoveRuns removes "runs" edges to BackupRun entities.
+func (_u *BackupTargetUpdate) RemoveRuns(v ...*BackupRun) *BackupTargetUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRunIDs(ids...)
+}
+
+// ClearDrills clears all "drills" edges to the BackupDrill entity.
+func (_u *BackupTargetUpdate) ClearDrills() *BackupTargetUpdate {
+	_u.mutation.ClearDrills()
+	return _u
+}
+
+// RemoveDrillIDs removes the "drills" edge to BackupDrill entities by IDs.
+func (_u *BackupTargetUpdate) RemoveDrillIDs(ids ...int) *BackupTargetUpdate {
+	_u.mutation.RemoveDrillIDs(ids...)
+	return _u
+}
+
+// RemoveDrills removes "drills" edges to BackupDrill entities.
+func (_u *BackupTargetUpdate) RemoveDrills(v ...*BackupDrill) *BackupTargetUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDrillIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *BackupTargetUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -536,6 +870,72 @@ func (_u *BackupTargetUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.S3SecretKeyCleared() {
 		_spec.ClearField(backuptarget.FieldS3SecretKey, field.TypeString)
 	}
+	if value, ok := _u.mutation.LocalPath(); ok {
+		_spec.SetField(backuptarget.FieldLocalPath, field.TypeString, value)
+	}
+	if _u.mutation.LocalPathCleared() {
+		_spec.ClearField(backuptarget.FieldLocalPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.SftpHost(); ok {
+		_spec.SetField(backuptarget.FieldSftpHost, field.TypeString, value)
+	}
+	if _u.mutation.SftpHostCleared() {
+		_spec.ClearField(backuptarget.FieldSftpHost, field.TypeString)
+	}
+	if value, ok := _u.mutation.SftpPort(); ok {
+		_spec.SetField(backuptarget.FieldSftpPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSftpPort(); ok {
+		_spec.AddField(backuptarget.FieldSftpPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SftpUser(); ok {
+		_spec.SetField(backuptarget.FieldSftpUser, field.TypeString, value)
+	}
+	if _u.mutation.SftpUserCleared() {
+		_spec.ClearField(backuptarget.FieldSftpUser, field.TypeString)
+	}
+	if value, ok := _u.mutation.SftpPassword(); ok {
+		_spec.SetField(backuptarget.FieldSftpPassword, field.TypeString, value)
+	}
+	if _u.mutation.SftpPasswordCleared() {
+		_spec.ClearField(backuptarget.FieldSftpPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.SftpPrivateKey(); ok {
+		_spec.SetField(backuptarget.FieldSftpPrivateKey, field.TypeString, value)
+	}
+	if _u.mutation.SftpPrivateKeyCleared() {
+		_spec.ClearField(backuptarget.FieldSftpPrivateKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.SftpPath(); ok {
+		_spec.SetField(backuptarget.FieldSftpPath, field.TypeString, value)
+	}
+	if _u.mutation.SftpPathCleared() {
+		_spec.ClearField(backuptarget.FieldSftpPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.SftpHostKey(); ok {
+		_spec.SetField(backuptarget.FieldSftpHostKey, field.TypeString, value)
+	}
+	if _u.mutation.SftpHostKeyCleared() {
+		_spec.ClearField(backuptarget.FieldSftpHostKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlertAfterHours(); ok {
+		_spec.SetField(backuptarget.FieldAlertAfterHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAlertAfterHours(); ok {
+		_spec.AddField(backuptarget.FieldAlertAfterHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DrillIntervalDays(); ok {
+		_spec.SetField(backuptarget.FieldDrillIntervalDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDrillIntervalDays(); ok {
+		_spec.AddField(backuptarget.FieldDrillIntervalDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StaleAlertedAt(); ok {
+		_spec.SetField(backuptarget.FieldStaleAlertedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StaleAlertedAtCleared() {
+		_spec.ClearField(backuptarget.FieldStaleAlertedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(backuptarget.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -584,6 +984,141 @@ func (_u *BackupTargetUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.PinsTable,
+			Columns: []string{backuptarget.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPinsIDs(); len(nodes) > 0 && !_u.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.PinsTable,
+			Columns: []string{backuptarget.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.PinsTable,
+			Columns: []string{backuptarget.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.RunsTable,
+			Columns: []string{backuptarget.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRunsIDs(); len(nodes) > 0 && !_u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.RunsTable,
+			Columns: []string{backuptarget.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.RunsTable,
+			Columns: []string{backuptarget.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DrillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.DrillsTable,
+			Columns: []string{backuptarget.DrillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backupdrill.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDrillsIDs(); len(nodes) > 0 && !_u.mutation.DrillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.DrillsTable,
+			Columns: []string{backuptarget.DrillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backupdrill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DrillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.DrillsTable,
+			Columns: []string{backuptarget.DrillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backupdrill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{backuptarget.Label}
@@ -714,203 +1249,426 @@ func (_u *BackupTargetUpdateOne) SetNillableLastTestStatus(v *string) *BackupTar
 	return _u
 }
 
-// SetLastTestError sets the "last_test_error" field.
-func (_u *BackupTargetUpdateOne) SetLastTestError(v string) *BackupTargetUpdateOne {
-	_u.mutation.SetLastTestError(v)
+// SetLastTestError sets the "last_test_error" field.
+func (_u *BackupTargetUpdateOne) SetLastTestError(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetLastTestError(v)
+	return _u
+}
+
+// SetNillableLastTestError sets the "last_test_error" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableLastTestError(v *string) *BackupTargetUpdateOne {
+	if v != nil {
+		_u.SetLastTestError(*v)
+	}
+	return _u
+}
+
+// ClearLastTestError clears the value of the "last_test_error" field.
+func (_u *BackupTargetUpdateOne) ClearLastTestError() *BackupTargetUpdateOne {
+	_u.mutation.ClearLastTestError()
+	return _u
+}
+
+// SetLastTestAt sets the "last_test_at" field.
+func (_u *BackupTargetUpdateOne) SetLastTestAt(v time.Time) *BackupTargetUpdateOne {
+	_u.mutation.SetLastTestAt(v)
+	return _u
+}
+
+// SetNillableLastTestAt sets the "last_test_at" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableLastTestAt(v *time.Time) *BackupTargetUpdateOne {
+	if v != nil {
+		_u.SetLastTestAt(*v)
+	}
+	return _u
+}
+
+// ClearLastTestAt clears the value of the "last_test_at" field.
+func (_u *BackupTargetUpdateOne) ClearLastTestAt() *BackupTargetUpdateOne {
+	_u.mutation.ClearLastTestAt()
+	return _u
+}
+
+// SetWebdavURL sets the "webdav_url" field.
+func (_u *BackupTargetUpdateOne) SetWebdavURL(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetWebdavURL(v)
+	return _u
+}
+
+// SetNillableWebdavURL sets the "webdav_url" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableWebdavURL(v *string) *BackupTargetUpdateOne {
+	if v != nil {
+		_u.SetWebdavURL(*v)
+	}
+	return _u
+}
+
+// ClearWebdavURL clears the value of the "webdav_url" field.
+func (_u *BackupTargetUpdateOne) ClearWebdavURL() *BackupTargetUpdateOne {
+	_u.mutation.ClearWebdavURL()
+	return _u
+}
+
+// SetWebdavUser sets the "webdav_user" field.
+func (_u *BackupTargetUpdateOne) SetWebdavUser(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetWebdavUser(v)
+	return _u
+}
+
+// SetNillableWebdavUser sets the "webdav_user" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableWebdavUser(v *string) *BackupTargetUpdateOne {
+	if v != nil {
+		_u.SetWebdavUser(*v)
+	}
+	return _u
+}
+
+// ClearWebdavUser clears the value of the "webdav_user" field.
+func (_u *BackupTargetUpdateOne) ClearWebdavUser() *BackupTargetUpdateOne {
+	_u.mutation.ClearWebdavUser()
+	return _u
+}
+
+// SetWebdavPassword sets the "webdav_password" field.
+func (_u *BackupTargetUpdateOne) SetWebdavPassword(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetWebdavPassword(v)
+	return _u
+}
+
+// SetNillableWebdavPassword sets the "webdav_password" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableWebdavPassword(v *string) *BackupTargetUpdateOne {
+	if v != nil {
+		_u.SetWebdavPassword(*v)
+	}
+	return _u
+}
+
+// ClearWebdavPassword clears the value of the "webdav_password" field.
+func (_u *BackupTargetUpdateOne) ClearWebdavPassword() *BackupTargetUpdateOne {
+	_u.mutation.ClearWebdavPassword()
+	return _u
+}
+
+// SetS3Endpoint sets the "s3_endpoint" field.
+func (_u *BackupTargetUpdateOne) SetS3Endpoint(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetS3Endpoint(v)
+	return _u
+}
+
+// SetNillableS3Endpoint sets the "s3_endpoint" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableS3Endpoint(v *string) *BackupTargetUpdateOne {
+	if v != nil {
+		_u.SetS3Endpoint(*v)
+	}
+	return _u
+}
+
+// ClearS3Endpoint clears the value of the "s3_endpoint" field.
+func (_u *BackupTargetUpdateOne) ClearS3Endpoint() *BackupTargetUpdateOne {
+	_u.mutation.ClearS3Endpoint()
+	return _u
+}
+
+// SetS3Region sets the "s3_region" field.
+func (_u *BackupTargetUpdateOne) SetS3Region(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetS3Region(v)
+	return _u
+}
+
+// SetNillableS3Region sets the "s3_region" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableS3Region(v *string) *BackupTargetUpdateOne {
+	if v != nil {
+		_u.SetS3Region(*v)
+	}
+	return _u
+}
+
+// ClearS3Region clears the value of the "s3_region" field.
+func (_u *BackupTargetUpdateOne) ClearS3Region() *BackupTargetUpdateOne {
+	_u.mutation.ClearS3Region()
+	return _u
+}
+
+// SetS3Bucket sets the "s3_bucket" field.
+func (_u *BackupTargetUpdateOne) SetS3Bucket(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetS3Bucket(v)
+	return _u
+}
+
+// SetNillableS3Bucket sets the "s3_bucket" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableS3Bucket(v *string) *BackupTargetUpdateOne {
+	if v != nil {
+		_u.SetS3Bucket(*v)
+	}
+	return _u
+}
+
+// ClearS3Bucket clears the value of the "s3_bucket" field.
+func (_u *BackupTargetUpdateOne) ClearS3Bucket() *BackupTargetUpdateOne {
+	_u.mutation.ClearS3Bucket()
+	return _u
+}
+
+// SetS3AccessKey sets the "s3_access_key" field.
+func (_u *BackupTargetUpdateOne) SetS3AccessKey(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetS3AccessKey(v)
+	return _u
+}
+
+// SetNillableS3AccessKey sets the "s3_access_key" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableS3AccessKey(v *string) *BackupTargetUpdateOne {
+	if v != nil {
+		_u.SetS3AccessKey(*v)
+	}
+	return _u
+}
+
+// ClearS3AccessKey clears the value of the "s3_access_key" field.
+func (_u *BackupTargetUpdateOne) ClearS3AccessKey() *BackupTargetUpdateOne {
+	_u.mutation.ClearS3AccessKey()
+	return _u
+}
+
+// SetS3SecretKey sets the "s3_secret_key" field.
+func (_u *BackupTargetUpdateOne) SetS3SecretKey(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetS3SecretKey(v)
+	return _u
+}
+
+// SetNillableS3SecretKey sets the "s3_secret_key" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableS3SecretKey(v *string) *BackupTargetUpdateOne {
+	if v != nil {
+		_u.SetS3SecretKey(*v)
+	}
+	return _u
+}
+
+// ClearS3SecretKey clears the value of the "s3_secret_key" field.
+func (_u *BackupTargetUpdateOne) ClearS3SecretKey() *BackupTargetUpdateOne {
+	_u.mutation.ClearS3SecretKey()
+	return _u
+}
+
+// SetLocalPath sets the "local_path" field.
+func (_u *BackupTargetUpdateOne) SetLocalPath(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetLocalPath(v)
+	return _u
+}
+
+// SetNillableLocalPath sets the "local_path" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableLocalPath(v *string) *BackupTargetUpdateOne {
+	if v != nil {
+		_u.SetLocalPath(*v)
+	}
+	return _u
+}
+
+// ClearLocalPath clears the value of the "local_path" field.
+func (_u *BackupTargetUpdateOne) ClearLocalPath() *BackupTargetUpdateOne {
+	_u.mutation.ClearLocalPath()
+	return _u
+}
+
+// SetSftpHost sets the "sftp_host" field.
+func (_u *BackupTargetUpdateOne) SetSftpHost(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetSftpHost(v)
 	return _u
 }
 
-// SetNillableLastTestError sets the "last_test_error" field if the given value is not nil.
-func (_u *BackupTargetUpdateOne) SetNillableLastTestError(v *string) *BackupTargetUpdateOne {
+// SetNillableSftpHost sets the "sftp_host" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableSftpHost(v *string) *BackupTargetUpdateOne {
 	if v != nil {
-		_u.SetLastTestError(*v)
+		_u.SetSftpHost(*v)
 	}
 	return _u
 }
 
-// ClearLastTestError clears the value of the "last_test_error" field.
-func (_u *BackupTargetUpdateOne) ClearLastTestError() *BackupTargetUpdateOne {
-	_u.mutation.ClearLastTestError()
+// ClearSftpHost clears the value of the "sftp_host" field.
+func (_u *BackupTargetUpdateOne) ClearSftpHost() *BackupTargetUpdateOne {
+	_u.mutation.ClearSftpHost()
 	return _u
 }
 
-// SetLastTestAt sets the "last_test_at" field.
-func (_u *BackupTargetUpdateOne) SetLastTestAt(v time.Time) *BackupTargetUpdateOne {
-	_u.mutation.SetLastTestAt(v)
+// SetSftpPort sets the "sftp_port" field.
+func (_u *BackupTargetUpdateOne) SetSftpPort(v int) *BackupTargetUpdateOne {
+	_u.mutation.ResetSftpPort()
+	_u.mutation.SetSftpPort(v)
 	return _u
 }
 
-// SetNillableLastTestAt sets the "last_test_at" field if the given value is not nil.
-func (_u *BackupTargetUpdateOne) SetNillableLastTestAt(v *time.Time) *BackupTargetUpdateOne {
+// SetNillableSftpPort sets the "sftp_port" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableSftpPort(v *int) *BackupTargetUpdateOne {
 	if v != nil {
-		_u.SetLastTestAt(*v)
+		_u.SetSftpPort(*v)
 	}
 	return _u
 }
 
-// ClearLastTestAt clears the value of the "last_test_at" field.
-func (_u *BackupTargetUpdateOne) ClearLastTestAt() *BackupTargetUpdateOne {
-	_u.mutation.ClearLastTestAt()
+// AddSftpPort adds value to the "sftp_port" field.
+func (_u *BackupTargetUpdateOne) AddSftpPort(v int) *BackupTargetUpdateOne {
+	_u.mutation.AddSftpPort(v)
 	return _u
 }
 
-// SetWebdavURL sets the "webdav_url" field.
-func (_u *BackupTargetUpdateOne) SetWebdavURL(v string) *BackupTargetUpdateOne {
-	_u.mutation.SetWebdavURL(v)
+// SetSftpUser sets the "sftp_user" field.
+func (_u *BackupTargetUpdateOne) SetSftpUser(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetSftpUser(v)
 	return _u
 }
 
-// SetNillableWebdavURL sets the "webdav_url" field if the given value is not nil.
-func (_u *BackupTargetUpdateOne) SetNillableWebdavURL(v *string) *BackupTargetUpdateOne {
+// SetNillableSftpUser sets the "sftp_user" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableSftpUser(v *string) *BackupTargetUpdateOne {
 	if v != nil {
-		_u.SetWebdavURL(*v)
+		_u.SetSftpUser(*v)
 	}
 	return _u
 }
 
-// ClearWebdavURL clears the value of the "webdav_url" field.
-func (_u *BackupTargetUpdateOne) ClearWebdavURL() *BackupTargetUpdateOne {
-	_u.mutation.ClearWebdavURL()
+// ClearSftpUser clears the value of the "sftp_user" field.
+func (_u *BackupTargetUpdateOne) ClearSftpUser() *BackupTargetUpdateOne {
+	_u.mutation.ClearSftpUser()
 	return _u
 }
 
-// SetWebdavUser sets the "webdav_user" field.
-func (_u *BackupTargetUpdateOne) SetWebdavUser(v string) *BackupTargetUpdateOne {
-	_u.mutation.SetWebdavUser(v)
+// SetSftpPassword sets the "sftp_password" field.
+func (_u *BackupTargetUpdateOne) SetSftpPassword(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetSftpPassword(v)
 	return _u
 }
 
-// SetNillableWebdavUser sets the "webdav_user" field if the given value is not nil.
-func (_u *BackupTargetUpdateOne) SetNillableWebdavUser(v *string) *BackupTargetUpdateOne {
+// SetNillableSftpPassword sets the "sftp_password" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableSftpPassword(v *string) *BackupTargetUpdateOne {
 	if v != nil {
-		_u.SetWebdavUser(*v)
+		_u.SetSftpPassword(*v)
 	}
 	return _u
 }
 
-// ClearWebdavUser clears the value of the "webdav_user" field.
-func (_u *BackupTargetUpdateOne) ClearWebdavUser() *BackupTargetUpdateOne {
-	_u.mutation.ClearWebdavUser()
+// ClearSftpPassword clears the value of the "sftp_password" field.
+func (_u *BackupTargetUpdateOne) ClearSftpPassword() *BackupTargetUpdateOne {
+	_u.mutation.ClearSftpPassword()
 	return _u
 }
 
-// SetWebdavPassword sets the "webdav_password" field.
-func (_u *BackupTargetUpdateOne) SetWebdavPassword(v string) *BackupTargetUpdateOne {
-	_u.mutation.SetWebdavPassword(v)
+// SetSftpPrivateKey sets the "sftp_private_key" field.
+func (_u *BackupTargetUpdateOne) SetSftpPrivateKey(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetSftpPrivateKey(v)
 	return _u
 }
 
-// SetNillableWebdavPassword sets the "webdav_password" field if the given value is not nil.
-func (_u *BackupTargetUpdateOne) SetNillableWebdavPassword(v *string) *BackupTargetUpdateOne {
+// SetNillableSftpPrivateKey sets the "sftp_private_key" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableSftpPrivateKey(v *string) *BackupTargetUpdateOne {
 	if v != nil {
-		_u.SetWebdavPassword(*v)
+		_u.SetSftpPrivateKey(*v)
 	}
 	return _u
 }
 
-// ClearWebdavPassword clears the value of the "webdav_password" field.
-func (_u *BackupTargetUpdateOne) ClearWebdavPassword() *BackupTargetUpdateOne {
-	_u.mutation.ClearWebdavPassword()
+// ClearSftpPrivateKey clears the value of the "sftp_private_key" field.
+func (_u *BackupTargetUpdateOne) ClearSftpPrivateKey() *BackupTargetUpdateOne {
+	_u.mutation.ClearSftpPrivateKey()
 	return _u
 }
 
-// SetS3Endpoint sets the "s3_endpoint" field.
-func (_u *BackupTargetUpdateOne) SetS3Endpoint(v string) *BackupTargetUpdateOne {
-	_u.mutation.SetS3Endpoint(v)
+// SetSftpPath sets the "sftp_path" field.
+func (_u *BackupTargetUpdateOne) SetSftpPath(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetSftpPath(v)
 	return _u
 }
 
-// SetNillableS3Endpoint sets the "s3_endpoint" field if the given value is not nil.
-func (_u *BackupTargetUpdateOne) SetNillableS3Endpoint(v *string) *BackupTargetUpdateOne {
+// SetNillableSftpPath sets the "sftp_path" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableSftpPath(v *string) *BackupTargetUpdateOne {
 	if v != nil {
-		_u.SetS3Endpoint(*v)
+		_u.SetSftpPath(*v)
 	}
 	return _u
 }
 
-// ClearS3Endpoint clears the value of the "s3_endpoint" field.
-func (_u *BackupTargetUpdateOne) ClearS3Endpoint() *BackupTargetUpdateOne {
-	_u.mutation.ClearS3Endpoint()
+// ClearSftpPath clears the value of the "sftp_path" field.
+func (_u *BackupTargetUpdateOne) ClearSftpPath() *BackupTargetUpdateOne {
+	_u.mutation.ClearSftpPath()
 	return _u
 }
 
-// SetS3Region sets the "s3_region" field.
-func (_u *BackupTargetUpdateOne) SetS3Region(v string) *BackupTargetUpdateOne {
-	_u.mutation.SetS3Region(v)
+// SetSftpHostKey sets the "sftp_host_key" field.
+func (_u *BackupTargetUpdateOne) SetSftpHostKey(v string) *BackupTargetUpdateOne {
+	_u.mutation.SetSftpHostKey(v)
 	return _u
 }
 
-// SetNillableS3Region sets the "s3_region" field if the given value is not nil.
-func (_u *BackupTargetUpdateOne) SetNillableS3Region(v *string) *BackupTargetUpdateOne {
+// SetNillableSftpHostKey sets the "sftp_host_key" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableSftpHostKey(v *string) *BackupTargetUpdateOne {
 	if v != nil {
-		_u.SetS3Region(*v)
+		_u.SetSftpHostKey(*v)
 	}
 	return _u
 }
 
-// ClearS3Region clears the value of the "s3_region" field.
-func (_u *BackupTargetUpdateOne) ClearS3Region() *BackupTargetUpdateOne {
-	_u.mutation.ClearS3Region()
+// ClearSftpHostKey clears the value of the "sftp_host_key" field.
+func (_u *BackupTargetUpdateOne) ClearSftpHostKey() *BackupTargetUpdateOne {
+	_u.mutation.ClearSftpHostKey()
 	return _u
 }
 
-// SetS3Bucket sets the "s3_bucket" field.
-func (_u *BackupTargetUpdateOne) SetS3Bucket(v string) *BackupTargetUpdateOne {
-	_u.mutation.SetS3Bucket(v)
+// SetAlertAfterHours sets the "alert_after_hours" field.
+func (_u *BackupTargetUpdateOne) SetAlertAfterHours(v int) *BackupTargetUpdateOne {
+	_u.mutation.ResetAlertAfterHours()
+	_u.mutation.SetAlertAfterHours(v)
 	return _u
 }
 
-// SetNillableS3Bucket sets the "s3_bucket" field if the given value is not nil.
-func (_u *BackupTargetUpdateOne) SetNillableS3Bucket(v *string) *BackupTargetUpdateOne {
+// SetNillableAlertAfterHours sets the "alert_after_hours" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableAlertAfterHours(v *int) *BackupTargetUpdateOne {
 	if v != nil {
-		_u.SetS3Bucket(*v)
+		_u.SetAlertAfterHours(*v)
 	}
 	return _u
 }
 
-// ClearS3Bucket clears the value of the "s3_bucket" field.
-func (_u *BackupTargetUpdateOne) ClearS3Bucket() *BackupTargetUpdateOne {
-	_u.mutation.ClearS3Bucket()
+// AddAlertAfterHours adds value to the "alert_after_hours" field.
+func (_u *BackupTargetUpdateOne) AddAlertAfterHours(v int) *BackupTargetUpdateOne {
+	_u.mutation.AddAlertAfterHours(v)
 	return _u
 }
 
-// SetS3AccessKey sets the "s3_access_key" field.
-func (_u *BackupTargetUpdateOne) SetS3AccessKey(v string) *BackupTargetUpdateOne {
-	_u.mutation.SetS3AccessKey(v)
+// SetDrillIntervalDays sets the "drill_interval_days" field.
+func (_u *BackupTargetUpdateOne) SetDrillIntervalDays(v int) *BackupTargetUpdateOne {
+	_u.mutation.ResetDrillIntervalDays()
+	_u.mutation.SetDrillIntervalDays(v)
 	return _u
 }
 
-// SetNillableS3AccessKey sets the "s3_access_key" field if the given value is not nil.
-func (_u *BackupTargetUpdateOne) SetNillableS3AccessKey(v *string) *BackupTargetUpdateOne {
+// SetNillableDrillIntervalDays sets the "drill_interval_days" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableDrillIntervalDays(v *int) *BackupTargetUpdateOne {
 	if v != nil {
-		_u.SetS3AccessKey(*v)
+		_u.SetDrillIntervalDays(*v)
 	}
 	return _u
 }
 
-// ClearS3AccessKey clears the value of the "s3_access_key" field.
-func (_u *BackupTargetUpdateOne) ClearS3AccessKey() *BackupTargetUpdateOne {
-	_u.mutation.ClearS3AccessKey()
+// AddDrillIntervalDays adds value to the "drill_interval_days" field.
+func (_u *BackupTargetUpdateOne) AddDrillIntervalDays(v int) *BackupTargetUpdateOne {
+	_u.mutation.AddDrillIntervalDays(v)
 	return _u
 }
 
-// SetS3SecretKey sets the "s3_secret_key" field.
-func (_u *BackupTargetUpdateOne) SetS3SecretKey(v string) *BackupTargetUpdateOne {
-	_u.mutation.SetS3SecretKey(v)
+// SetStaleAlertedAt sets the "stale_alerted_at" field.
+func (_u *BackupTargetUpdateOne) SetStaleAlertedAt(v time.Time) *BackupTargetUpdateOne {
+	_u.mutation.SetStaleAlertedAt(v)
 	return _u
 }
 
-// SetNillableS3SecretKey sets the "s3_secret_key" field if the given value is not nil.
-func (_u *BackupTargetUpdateOne) SetNillableS3SecretKey(v *string) *BackupTargetUpdateOne {
+// SetNillableStaleAlertedAt sets the "stale_alerted_at" field if the given value is not nil.
+func (_u *BackupTargetUpdateOne) SetNillableStaleAlertedAt(v *time.Time) *BackupTargetUpdateOne {
 	if v != nil {
-		_u.SetS3SecretKey(*v)
+		_u.SetStaleAlertedAt(*v)
 	}
 	return _u
 }
 
-// ClearS3SecretKey clears the value of the "s3_secret_key" field.
-func (_u *BackupTargetUpdateOne) ClearS3SecretKey() *BackupTargetUpdateOne {
-	_u.mutation.ClearS3SecretKey()
+// ClearStaleAlertedAt clears the value of the "stale_alerted_at" field.
+func (_u *BackupTargetUpdateOne) ClearStaleAlertedAt() *BackupTargetUpdateOne {
+	_u.mutation.ClearStaleAlertedAt()
 	return _u
 }
 
@@ -935,6 +1693,51 @@ func (_u *BackupTargetUpdateOne) AddTasks(v ...*BackupTask) *BackupTargetUpdateO
 	return _u.AddTaskIDs(ids...)
 }
 
+// AddPinIDs adds the "pins" edge to the BackupPin entity by IDs.
+func (_u *BackupTargetUpdateOne) AddPinIDs(ids ...int) *BackupTargetUpdateOne {
+	_u.mutation.AddPinIDs(ids...)
+	return _u
+}
+
+// AddPins adds the "pins" edges to the BackupPin entity.
+func (_u *BackupTargetUpdateOne) AddPins(v ...*BackupPin) *BackupTargetUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPinIDs(ids...)
+}
+
+// AddRunIDs adds the "runs" edge to the BackupRun entity by IDs.
+func (_u *BackupTargetUpdateOne) AddRunIDs(ids ...int) *BackupTargetUpdateOne {
+	_u.mutation.AddRunIDs(ids...)
+	return _u
+}
+
+// AddRuns adds the "runs" edges to the BackupRun entity.
+func (_u *BackupTargetUpdateOne) AddRuns(v ...*BackupRun) *BackupTargetUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRunIDs(ids...)
+}
+
+// AddDrillIDs adds the "drills" edge to the BackupDrill entity by IDs.
+func (_u *BackupTargetUpdateOne) AddDrillIDs(ids ...int) *BackupTargetUpdateOne {
+	_u.mutation.AddDrillIDs(ids...)
+	return _u
+}
+
+// AddDrills adds the "drills" edges to the BackupDrill entity.
+func (_u *BackupTargetUpdateOne) AddDrills(v ...*BackupDrill) *BackupTargetUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDrillIDs(ids...)
+}
+
 // Mutation returns the BackupTargetMutation object of the builder.
 func (_u *BackupTargetUpdateOne) Mutation() *BackupTargetMutation {
 	return _u.mutation
@@ -961,6 +1764,69 @@ func (_u *BackupTargetUpdateOne) RemoveTasks(v ...*BackupTask) *BackupTargetUpda
 	return _u.RemoveTaskIDs(ids...)
 }
 
+// ClearPins clears all "pins" edges to the BackupPin entity.
+func (_u *BackupTargetUpdateOne) ClearPins() *BackupTargetUpdateOne {
+	_u.mutation.ClearPins()
+	return _u
+}
+
+// RemovePinIDs removes the "pins" edge to BackupPin entities by IDs.
+func (_u *BackupTargetUpdateOne) RemovePinIDs(ids ...int) *BackupTargetUpdateOne {
+	_u.mutation.RemovePinIDs(ids...)
+	return _u
+}
+
+// RemovePins removes "pins" edges to BackupPin entities.
+func (_u *BackupTargetUpdateOne) RemovePins(v ...*BackupPin) *BackupTargetUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePinIDs(ids...)
+}
+
+// ClearRuns clears all "runs" edges to the BackupRun entity.
+func (_u *BackupTargetUpdateOne) ClearRuns() *BackupTargetUpdateOne {
+	_u.mutation.ClearRuns()
+	return _u
+}
+
+// RemoveRunIDs removes the "runs" edge to BackupRun entities by IDs.
+func (_u *BackupTargetUpdateOne) RemoveRunIDs(ids ...int) *BackupTargetUpdateOne {
+	_u.mutation.RemoveRunIDs(ids...)
+	return _u
+}
+
+// RemoveRuns removes "runs" edges to BackupRun entities.
+func (_u *BackupTargetUpdateOne) RemoveRuns(v ...*BackupRun) *BackupTargetUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRunIDs(ids...)
+}
+
+// ClearDrills clears all "drills" edges to the BackupDrill entity.
+func (_u *BackupTargetUpdateOne) ClearDrills() *BackupTargetUpdateOne {
+	_u.mutation.ClearDrills()
+	return _u
+}
+
+// RemoveDrillIDs removes the "drills" edge to BackupDrill entities by IDs.
+func (_u *BackupTargetUpdateOne) RemoveDrillIDs(ids ...int) *BackupTargetUpdateOne {
+	_u.mutation.RemoveDrillIDs(ids...)
+	return _u
+}
+
+// RemoveDrills removes "drills" edges to BackupDrill entities.
+func (_u *BackupTargetUpdateOne) RemoveDrills(v ...*BackupDrill) *BackupTargetUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDrillIDs(ids...)
+}
+
 // Where appends a list predicates to the BackupTargetUpdate builder.
 func (_u *BackupTargetUpdateOne) Where(ps ...predicate.BackupTarget) *BackupTargetUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1141,6 +2007,72 @@ func (_u *BackupTargetUpdateOne) sqlSave(ctx context.Context) (_node *BackupTarg
 	if _u.mutation.S3SecretKeyCleared() {
 		_spec.ClearField(backuptarget.FieldS3SecretKey, field.TypeString)
 	}
+	if value, ok := _u.mutation.LocalPath(); ok {
+		_spec.SetField(backuptarget.FieldLocalPath, field.TypeString, value)
+	}
+	if _u.mutation.LocalPathCleared() {
+		_spec.ClearField(backuptarget.FieldLocalPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.SftpHost(); ok {
+		_spec.SetField(backuptarget.FieldSftpHost, field.TypeString, value)
+	}
+	if _u.mutation.SftpHostCleared() {
+		_spec.ClearField(backuptarget.FieldSftpHost, field.TypeString)
+	}
+	if value, ok := _u.mutation.SftpPort(); ok {
+		_spec.SetField(backuptarget.FieldSftpPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSftpPort(); ok {
+		_spec.AddField(backuptarget.FieldSftpPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SftpUser(); ok {
+		_spec.SetField(backuptarget.FieldSftpUser, field.TypeString, value)
+	}
+	if _u.mutation.SftpUserCleared() {
+		_spec.ClearField(backuptarget.FieldSftpUser, field.TypeString)
+	}
+	if value, ok := _u.mutation.SftpPassword(); ok {
+		_spec.SetField(backuptarget.FieldSftpPassword, field.TypeString, value)
+	}
+	if _u.mutation.SftpPasswordCleared() {
+		_spec.ClearField(backuptarget.FieldSftpPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.SftpPrivateKey(); ok {
+		_spec.SetField(backuptarget.FieldSftpPrivateKey, field.TypeString, value)
+	}
+	if _u.mutation.SftpPrivateKeyCleared() {
+		_spec.ClearField(backuptarget.FieldSftpPrivateKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.SftpPath(); ok {
+		_spec.SetField(backuptarget.FieldSftpPath, field.TypeString, value)
+	}
+	if _u.mutation.SftpPathCleared() {
+		_spec.ClearField(backuptarget.FieldSftpPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.SftpHostKey(); ok {
+		_spec.SetField(backuptarget.FieldSftpHostKey, field.TypeString, value)
+	}
+	if _u.mutation.SftpHostKeyCleared() {
+		_spec.ClearField(backuptarget.FieldSftpHostKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlertAfterHours(); ok {
+		_spec.SetField(backuptarget.FieldAlertAfterHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAlertAfterHours(); ok {
+		_spec.AddField(backuptarget.FieldAlertAfterHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DrillIntervalDays(); ok {
+		_spec.SetField(backuptarget.FieldDrillIntervalDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDrillIntervalDays(); ok {
+		_spec.AddField(backuptarget.FieldDrillIntervalDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StaleAlertedAt(); ok {
+		_spec.SetField(backuptarget.FieldStaleAlertedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StaleAlertedAtCleared() {
+		_spec.ClearField(backuptarget.FieldStaleAlertedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(backuptarget.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1189,6 +2121,141 @@ func (_u *BackupTargetUpdateOne) sqlSave(ctx context.Context) (_node *BackupTarg
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.PinsTable,
+			Columns: []string{backuptarget.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPinsIDs(); len(nodes) > 0 && !_u.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.PinsTable,
+			Columns: []string{backuptarget.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.PinsTable,
+			Columns: []string{backuptarget.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.RunsTable,
+			Columns: []string{backuptarget.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRunsIDs(); len(nodes) > 0 && !_u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.RunsTable,
+			Columns: []string{backuptarget.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.RunsTable,
+			Columns: []string{backuptarget.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DrillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.DrillsTable,
+			Columns: []string{backuptarget.DrillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backupdrill.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDrillsIDs(); len(nodes) > 0 && !_u.mutation.DrillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.DrillsTable,
+			Columns: []string{backuptarget.DrillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backupdrill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DrillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.DrillsTable,
+			Columns: []string{backuptarget.DrillsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backupdrill.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &BackupTarget{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
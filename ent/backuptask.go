@@ -21,12 +21,32 @@ type BackupTask struct {
 	Name string `json:"name,omitempty"`
 	// Enabled holds the value of the "enabled" field.
 	Enabled bool `json:"enabled,omitempty"`
-	// manual, daily, weekly, monthly
+	// manual, daily, weekly, monthly, cron
 	Schedule string `json:"schedule,omitempty"`
+	// Five-field cron expression used when schedule is cron
+	CronExpression string `json:"cron_expression,omitempty"`
+	// IANA time zone the schedule is evaluated in; empty uses the server's
+	Timezone string `json:"timezone,omitempty"`
 	// Number of days to retain backup files (0 = no limit)
 	RetentionDays int `json:"retention_days,omitempty"`
 	// Maximum number of backup files to keep (0 = no limit)
 	MaxCount int `json:"max_count,omitempty"`
+	// Grandfather-father-son: newest backup of each of the last N hours
+	KeepHourly int `json:"keep_hourly,omitempty"`
+	// KeepDaily holds the value of the "keep_daily" field.
+	KeepDaily int `json:"keep_daily,omitempty"`
+	// KeepWeekly holds the value of the "keep_weekly" field.
+	KeepWeekly int `json:"keep_weekly,omitempty"`
+	// KeepMonthly holds the value of the "keep_monthly" field.
+	KeepMonthly int `json:"keep_monthly,omitempty"`
+	// KeepYearly holds the value of the "keep_yearly" field.
+	KeepYearly int `json:"keep_yearly,omitempty"`
+	// EncryptionEnabled holds the value of the "encryption_enabled" field.
+	EncryptionEnabled bool `json:"encryption_enabled,omitempty"`
+	// Archive passphrase sealed with the local secret box
+	EncryptionPassphrase string `json:"-"`
+	// Store attachments as content-addressed blobs shared between snapshots
+	Incremental bool `json:"incremental,omitempty"`
 	// never, success, failed
 	LastBackupStatus string `json:"last_backup_status,omitempty"`
 	// LastBackupError holds the value of the "last_backup_error" field.
@@ -47,9 +67,11 @@ type BackupTask struct {
 type BackupTaskEdges struct {
 	// Targets holds the value of the targets edge.
 	Targets []*BackupTarget `json:"targets,omitempty"`
+	// Runs holds the value of the runs edge.
+	Runs []*BackupRun `json:"runs,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // TargetsOrErr returns the Targets value or an error if the edge
@@ -61,16 +83,25 @@ func (e BackupTaskEdges) TargetsOrErr() ([]*BackupTarget, error) {
 	return nil, &NotLoadedError{edge: "targets"}
 }
 
+// RunsOrErr returns the Runs value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupTaskEdges) RunsOrErr() ([]*BackupRun, error) {
+	if e.loadedTypes[1] {
+		return e.Runs, nil
+	}
+	return nil, &NotLoadedError{edge: "runs"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*BackupTask) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case backuptask.FieldEnabled:
+		case backuptask.FieldEnabled, backuptask.FieldEncryptionEnabled, backuptask.FieldIncremental:
 			values[i] = new(sql.NullBool)
-		case backuptask.FieldID, backuptask.FieldRetentionDays, backuptask.FieldMaxCount:
+		case backuptask.FieldID, backuptask.FieldRetentionDays, backuptask.FieldMaxCount, backuptask.FieldKeepHourly, backuptask.FieldKeepDaily, backuptask.FieldKeepWeekly, backuptask.FieldKeepMonthly, backuptask.FieldKeepYearly:
 			values[i] = new(sql.NullInt64)
-		case backuptask.FieldName, backuptask.FieldSchedule, backuptask.FieldLastBackupStatus, backuptask.FieldLastBackupError:
+		case backuptask.FieldName, backuptask.FieldSchedule, backuptask.FieldCronExpression, backuptask.FieldTimezone, backuptask.FieldEncryptionPassphrase, backuptask.FieldLastBackupStatus, backuptask.FieldLastBackupError:
 			values[i] = new(sql.NullString)
 		case backuptask.FieldLastBackupAt, backuptask.FieldCreatedAt, backuptask.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -113,6 +144,18 @@ func (_m *BackupTask) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Schedule = value.String
 			}
+		case backuptask.FieldCronExpression:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron_expression", values[i])
+			} else if value.Valid {
+				_m.CronExpression = value.String
+			}
+		case backuptask.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = value.String
+			}
 		case backuptask.FieldRetentionDays:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field retention_days", values[i])
@@ -125,6 +168,54 @@ func (_m *BackupTask) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.MaxCount = int(value.Int64)
 			}
+		case backuptask.FieldKeepHourly:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field keep_hourly", values[i])
+			} else if value.Valid {
+				_m.KeepHourly = int(value.Int64)
+			}
+		case backuptask.FieldKeepDaily:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field keep_daily", values[i])
+			} else if value.Valid {
+				_m.KeepDaily = int(value.Int64)
+			}
+		case backuptask.FieldKeepWeekly:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field keep_weekly", values[i])
+			} else if value.Valid {
+				_m.KeepWeekly = int(value.Int64)
+			}
+		case backuptask.FieldKeepMonthly:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field keep_monthly", values[i])
+			} else if value.Valid {
+				_m.KeepMonthly = int(value.Int64)
+			}
+		case backuptask.FieldKeepYearly:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field keep_yearly", values[i])
+			} else if value.Valid {
+				_m.KeepYearly = int(value.Int64)
+			}
+		case backuptask.FieldEncryptionEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field encryption_enabled", values[i])
+			} else if value.Valid {
+				_m.EncryptionEnabled = value.Bool
+			}
+		case backuptask.FieldEncryptionPassphrase:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field encryption_passphrase", values[i])
+			} else if value.Valid {
+				_m.EncryptionPassphrase = value.String
+			}
+		case backuptask.FieldIncremental:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field incremental", values[i])
+			} else if value.Valid {
+				_m.Incremental = value.Bool
+			}
 		case backuptask.FieldLastBackupStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field last_backup_status", values[i])
@@ -173,6 +264,11 @@ func (_m *BackupTask) QueryTargets() *BackupTargetQuery {
 	return NewBackupTaskClient(_m.config).QueryTargets(_m)
 }
 
+// QueryRuns queries the "runs" edge of the BackupTask entity.
+func (_m *BackupTask) QueryRuns() *BackupRunQuery {
+	return NewBackupTaskClient(_m.config).QueryRuns(_m)
+}
+
 // Update returns a builder for updating this BackupTask.
 // Note that you need to call BackupTask.Unwrap() before calling this method if this BackupTask
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -205,12 +301,41 @@ func (_m *BackupTask) String() string {
 	builder.WriteString("schedule=")
 	builder.WriteString(_m.Schedule)
 	builder.WriteString(", ")
+	builder.WriteString("cron_expression=")
+	builder.WriteString(_m.CronExpression)
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(_m.Timezone)
+	builder.WriteString(", ")
 	builder.WriteString("retention_days=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RetentionDays))
 	builder.WriteString(", ")
 	builder.WriteString("max_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.MaxCount))
 	builder.WriteString(", ")
+	builder.WriteString("keep_hourly=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeepHourly))
+	builder.WriteString(", ")
+	builder.WriteString("keep_daily=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeepDaily))
+	builder.WriteString(", ")
+	builder.WriteString("keep_weekly=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeepWeekly))
+	builder.WriteString(", ")
+	builder.WriteString("keep_monthly=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeepMonthly))
+	builder.WriteString(", ")
+	builder.WriteString("keep_yearly=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeepYearly))
+	builder.WriteString(", ")
+	builder.WriteString("encryption_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EncryptionEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("encryption_passphrase=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("incremental=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Incremental))
+	builder.WriteString(", ")
 	builder.WriteString("last_backup_status=")
 	builder.WriteString(_m.LastBackupStatus)
 	builder.WriteString(", ")
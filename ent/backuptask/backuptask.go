@@ -20,10 +20,30 @@ const (
 	FieldEnabled = "enabled"
 	// FieldSchedule holds the string denoting the schedule field in the database.
 	FieldSchedule = "schedule"
+	// FieldCronExpression holds the string denoting the cron_expression field in the database.
+	FieldCronExpression = "cron_expression"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
 	// FieldRetentionDays holds the string denoting the retention_days field in the database.
 	FieldRetentionDays = "retention_days"
 	// FieldMaxCount holds the string denoting the max_count field in the database.
 	FieldMaxCount = "max_count"
+	// FieldKeepHourly holds the string denoting the keep_hourly field in the database.
+	FieldKeepHourly = "keep_hourly"
+	// FieldKeepDaily holds the string denoting the keep_daily field in the database.
+	FieldKeepDaily = "keep_daily"
+	// FieldKeepWeekly holds the string denoting the keep_weekly field in the database.
+	FieldKeepWeekly = "keep_weekly"
+	// FieldKeepMonthly holds the string denoting the keep_monthly field in the database.
+	FieldKeepMonthly = "keep_monthly"
+	// FieldKeepYearly holds the string denoting the keep_yearly field in the database.
+	FieldKeepYearly = "keep_yearly"
+	// FieldEncryptionEnabled holds the string denoting the encryption_enabled field in the database.
+	FieldEncryptionEnabled = "encryption_enabled"
+	// FieldEncryptionPassphrase holds the string denoting the encryption_passphrase field in the database.
+	FieldEncryptionPassphrase = "encryption_passphrase"
+	// FieldIncremental holds the string denoting the incremental field in the database.
+	FieldIncremental = "incremental"
 	// FieldLastBackupStatus holds the string denoting the last_backup_status field in the database.
 	FieldLastBackupStatus = "last_backup_status"
 	// FieldLastBackupError holds the string denoting the last_backup_error field in the database.
@@ -36,6 +56,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeTargets holds the string denoting the targets edge name in mutations.
 	EdgeTargets = "targets"
+	// EdgeRuns holds the string denoting the runs edge name in mutations.
+	EdgeRuns = "runs"
 	// Table holds the table name of the backuptask in the database.
 	Table = "backup_tasks"
 	// TargetsTable is the table that holds the targets relation/edge. The primary key declared below.
@@ -43,6 +65,13 @@ const (
 	// TargetsInverseTable is the table name for the BackupTarget entity.
 	// It exists in this package in order to avoid circular dependency with the "backuptarget" package.
 	TargetsInverseTable = "backup_targets"
+	// RunsTable is the table that holds the runs relation/edge.
+	RunsTable = "backup_runs"
+	// RunsInverseTable is the table name for the BackupRun entity.
+	// It exists in this package in order to avoid circular dependency with the "backuprun" package.
+	RunsInverseTable = "backup_runs"
+	// RunsColumn is the table column denoting the runs relation/edge.
+	RunsColumn = "task_id"
 )
 
 // Columns holds all SQL columns for backuptask fields.
@@ -51,8 +80,18 @@ var Columns = []string{
 	FieldName,
 	FieldEnabled,
 	FieldSchedule,
+	FieldCronExpression,
+	FieldTimezone,
 	FieldRetentionDays,
 	FieldMaxCount,
+	FieldKeepHourly,
+	FieldKeepDaily,
+	FieldKeepWeekly,
+	FieldKeepMonthly,
+	FieldKeepYearly,
+	FieldEncryptionEnabled,
+	FieldEncryptionPassphrase,
+	FieldIncremental,
 	FieldLastBackupStatus,
 	FieldLastBackupError,
 	FieldLastBackupAt,
@@ -87,6 +126,20 @@ var (
 	DefaultRetentionDays int
 	// DefaultMaxCount holds the default value on creation for the "max_count" field.
 	DefaultMaxCount int
+	// DefaultKeepHourly holds the default value on creation for the "keep_hourly" field.
+	DefaultKeepHourly int
+	// DefaultKeepDaily holds the default value on creation for the "keep_daily" field.
+	DefaultKeepDaily int
+	// DefaultKeepWeekly holds the default value on creation for the "keep_weekly" field.
+	DefaultKeepWeekly int
+	// DefaultKeepMonthly holds the default value on creation for the "keep_monthly" field.
+	DefaultKeepMonthly int
+	// DefaultKeepYearly holds the default value on creation for the "keep_yearly" field.
+	DefaultKeepYearly int
+	// DefaultEncryptionEnabled holds the default value on creation for the "encryption_enabled" field.
+	DefaultEncryptionEnabled bool
+	// DefaultIncremental holds the default value on creation for the "incremental" field.
+	DefaultIncremental bool
 	// DefaultLastBackupStatus holds the default value on creation for the "last_backup_status" field.
 	DefaultLastBackupStatus string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -120,6 +173,16 @@ func BySchedule(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSchedule, opts...).ToFunc()
 }
 
+// ByCronExpression orders the results by the cron_expression field.
+func ByCronExpression(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCronExpression, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
 // ByRetentionDays orders the results by the retention_days field.
 func ByRetentionDays(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRetentionDays, opts...).ToFunc()
@@ -130,6 +193,46 @@ func ByMaxCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxCount, opts...).ToFunc()
 }
 
+// ByKeepHourly orders the results by the keep_hourly field.
+func ByKeepHourly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeepHourly, opts...).ToFunc()
+}
+
+// ByKeepDaily orders the results by the keep_daily field.
+func ByKeepDaily(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeepDaily, opts...).ToFunc()
+}
+
+// ByKeepWeekly orders the results by the keep_weekly field.
+func ByKeepWeekly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeepWeekly, opts...).ToFunc()
+}
+
+// ByKeepMonthly orders the results by the keep_monthly field.
+func ByKeepMonthly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeepMonthly, opts...).ToFunc()
+}
+
+// ByKeepYearly orders the results by the keep_yearly field.
+func ByKeepYearly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeepYearly, opts...).ToFunc()
+}
+
+// ByEncryptionEnabled orders the results by the encryption_enabled field.
+func ByEncryptionEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEncryptionEnabled, opts...).ToFunc()
+}
+
+// ByEncryptionPassphrase orders the results by the encryption_passphrase field.
+func ByEncryptionPassphrase(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEncryptionPassphrase, opts...).ToFunc()
+}
+
+// ByIncremental orders the results by the incremental field.
+func ByIncremental(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncremental, opts...).ToFunc()
+}
+
 // ByLastBackupStatus orders the results by the last_backup_status field.
 func ByLastBackupStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastBackupStatus, opts...).ToFunc()
@@ -168,6 +271,20 @@ func ByTargets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTargetsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRunsCount orders the results by runs count.
+func ByRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRunsStep(), opts...)
+	}
+}
+
+// ByRuns orders the results by runs terms.
+func ByRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTargetsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -175,3 +292,10 @@ func newTargetsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, TargetsTable, TargetsPrimaryKey...),
 	)
 }
+func newRunsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RunsTable, RunsColumn),
+	)
+}
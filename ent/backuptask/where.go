@@ -70,6 +70,16 @@ func Schedule(v string) predicate.BackupTask {
 	return predicate.BackupTask(sql.FieldEQ(FieldSchedule, v))
 }
 
+// CronExpression applies equality check predicate on the "cron_expression" field. It's identical to CronExpressionEQ.
+func CronExpression(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldCronExpression, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldTimezone, v))
+}
+
 // RetentionDays applies equality check predicate on the "retention_days" field. It's identical to RetentionDaysEQ.
 func RetentionDays(v int) predicate.BackupTask {
 	return predicate.BackupTask(sql.FieldEQ(FieldRetentionDays, v))
@@ -80,6 +90,46 @@ func MaxCount(v int) predicate.BackupTask {
 	return predicate.BackupTask(sql.FieldEQ(FieldMaxCount, v))
 }
 
+// KeepHourly applies equality check predicate on the "keep_hourly" field. It's identical to KeepHourlyEQ.
+func KeepHourly(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldKeepHourly, v))
+}
+
+// KeepDaily applies equality check predicate on the "keep_daily" field. It's identical to KeepDailyEQ.
+func KeepDaily(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldKeepDaily, v))
+}
+
+// KeepWeekly applies equality check predicate on the "keep_weekly" field. It's identical to KeepWeeklyEQ.
+func KeepWeekly(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldKeepWeekly, v))
+}
+
+// KeepMonthly applies equality check predicate on the "keep_monthly" field. It's identical to KeepMonthlyEQ.
+func KeepMonthly(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldKeepMonthly, v))
+}
+
+// KeepYearly applies equality check predicate on the "keep_yearly" field. It's identical to KeepYearlyEQ.
+func KeepYearly(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldKeepYearly, v))
+}
+
+// EncryptionEnabled applies equality check predicate on the "encryption_enabled" field. It's identical to EncryptionEnabledEQ.
+func EncryptionEnabled(v bool) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldEncryptionEnabled, v))
+}
+
+// EncryptionPassphrase applies equality check predicate on the "encryption_passphrase" field. It's identical to EncryptionPassphraseEQ.
+func EncryptionPassphrase(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldEncryptionPassphrase, v))
+}
+
+// Incremental applies equality check predicate on the "incremental" field. It's identical to IncrementalEQ.
+func Incremental(v bool) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldIncremental, v))
+}
+
 // LastBackupStatus applies equality check predicate on the "last_backup_status" field. It's identical to LastBackupStatusEQ.
 func LastBackupStatus(v string) predicate.BackupTask {
 	return predicate.BackupTask(sql.FieldEQ(FieldLastBackupStatus, v))
@@ -245,6 +295,156 @@ func ScheduleContainsFold(v string) predicate.BackupTask {
 	return predicate.BackupTask(sql.FieldContainsFold(FieldSchedule, v))
 }
 
+// CronExpressionEQ applies the EQ predicate on the "cron_expression" field.
+func CronExpressionEQ(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldCronExpression, v))
+}
+
+// CronExpressionNEQ applies the NEQ predicate on the "cron_expression" field.
+func CronExpressionNEQ(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNEQ(FieldCronExpression, v))
+}
+
+// CronExpressionIn applies the In predicate on the "cron_expression" field.
+func CronExpressionIn(vs ...string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldIn(FieldCronExpression, vs...))
+}
+
+// CronExpressionNotIn applies the NotIn predicate on the "cron_expression" field.
+func CronExpressionNotIn(vs ...string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNotIn(FieldCronExpression, vs...))
+}
+
+// CronExpressionGT applies the GT predicate on the "cron_expression" field.
+func CronExpressionGT(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGT(FieldCronExpression, v))
+}
+
+// CronExpressionGTE applies the GTE predicate on the "cron_expression" field.
+func CronExpressionGTE(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGTE(FieldCronExpression, v))
+}
+
+// CronExpressionLT applies the LT predicate on the "cron_expression" field.
+func CronExpressionLT(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLT(FieldCronExpression, v))
+}
+
+// CronExpressionLTE applies the LTE predicate on the "cron_expression" field.
+func CronExpressionLTE(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLTE(FieldCronExpression, v))
+}
+
+// CronExpressionContains applies the Contains predicate on the "cron_expression" field.
+func CronExpressionContains(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldContains(FieldCronExpression, v))
+}
+
+// CronExpressionHasPrefix applies the HasPrefix predicate on the "cron_expression" field.
+func CronExpressionHasPrefix(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldHasPrefix(FieldCronExpression, v))
+}
+
+// CronExpressionHasSuffix applies the HasSuffix predicate on the "cron_expression" field.
+func CronExpressionHasSuffix(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldHasSuffix(FieldCronExpression, v))
+}
+
+// CronExpressionIsNil applies the IsNil predicate on the "cron_expression" field.
+func CronExpressionIsNil() predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldIsNull(FieldCronExpression))
+}
+
+// CronExpressionNotNil applies the NotNil predicate on the "cron_expression" field.
+func CronExpressionNotNil() predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNotNull(FieldCronExpression))
+}
+
+// CronExpressionEqualFold applies the EqualFold predicate on the "cron_expression" field.
+func CronExpressionEqualFold(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEqualFold(FieldCronExpression, v))
+}
+
+// CronExpressionContainsFold applies the ContainsFold predicate on the "cron_expression" field.
+func CronExpressionContainsFold(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldContainsFold(FieldCronExpression, v))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneIsNil applies the IsNil predicate on the "timezone" field.
+func TimezoneIsNil() predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldIsNull(FieldTimezone))
+}
+
+// TimezoneNotNil applies the NotNil predicate on the "timezone" field.
+func TimezoneNotNil() predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNotNull(FieldTimezone))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldContainsFold(FieldTimezone, v))
+}
+
 // RetentionDaysEQ applies the EQ predicate on the "retention_days" field.
 func RetentionDaysEQ(v int) predicate.BackupTask {
 	return predicate.BackupTask(sql.FieldEQ(FieldRetentionDays, v))
@@ -325,6 +525,301 @@ func MaxCountLTE(v int) predicate.BackupTask {
 	return predicate.BackupTask(sql.FieldLTE(FieldMaxCount, v))
 }
 
+// KeepHourlyEQ applies the EQ predicate on the "keep_hourly" field.
+func KeepHourlyEQ(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldKeepHourly, v))
+}
+
+// KeepHourlyNEQ applies the NEQ predicate on the "keep_hourly" field.
+func KeepHourlyNEQ(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNEQ(FieldKeepHourly, v))
+}
+
+// KeepHourlyIn applies the In predicate on the "keep_hourly" field.
+func KeepHourlyIn(vs ...int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldIn(FieldKeepHourly, vs...))
+}
+
+// KeepHourlyNotIn applies the NotIn predicate on the "keep_hourly" field.
+func KeepHourlyNotIn(vs ...int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNotIn(FieldKeepHourly, vs...))
+}
+
+// KeepHourlyGT applies the GT predicate on the "keep_hourly" field.
+func KeepHourlyGT(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGT(FieldKeepHourly, v))
+}
+
+// KeepHourlyGTE applies the GTE predicate on the "keep_hourly" field.
+func KeepHourlyGTE(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGTE(FieldKeepHourly, v))
+}
+
+// KeepHourlyLT applies the LT predicate on the "keep_hourly" field.
+func KeepHourlyLT(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLT(FieldKeepHourly, v))
+}
+
+// KeepHourlyLTE applies the LTE predicate on the "keep_hourly" field.
+func KeepHourlyLTE(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLTE(FieldKeepHourly, v))
+}
+
+// KeepDailyEQ applies the EQ predicate on the "keep_daily" field.
+func KeepDailyEQ(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldKeepDaily, v))
+}
+
+// KeepDailyNEQ applies the NEQ predicate on the "keep_daily" field.
+func KeepDailyNEQ(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNEQ(FieldKeepDaily, v))
+}
+
+// KeepDailyIn applies the In predicate on the "keep_daily" field.
+func KeepDailyIn(vs ...int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldIn(FieldKeepDaily, vs...))
+}
+
+// KeepDailyNotIn applies the NotIn predicate on the "keep_daily" field.
+func KeepDailyNotIn(vs ...int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNotIn(FieldKeepDaily, vs...))
+}
+
+// KeepDailyGT applies the GT predicate on the "keep_daily" field.
+func KeepDailyGT(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGT(FieldKeepDaily, v))
+}
+
+// KeepDailyGTE applies the GTE predicate on the "keep_daily" field.
+func KeepDailyGTE(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGTE(FieldKeepDaily, v))
+}
+
+// KeepDailyLT applies the LT predicate on the "keep_daily" field.
+func KeepDailyLT(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLT(FieldKeepDaily, v))
+}
+
+// KeepDailyLTE applies the LTE predicate on the "keep_daily" field.
+func KeepDailyLTE(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLTE(FieldKeepDaily, v))
+}
+
+// KeepWeeklyEQ applies the EQ predicate on the "keep_weekly" field.
+func KeepWeeklyEQ(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldKeepWeekly, v))
+}
+
+// KeepWeeklyNEQ applies the NEQ predicate on the "keep_weekly" field.
+func KeepWeeklyNEQ(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNEQ(FieldKeepWeekly, v))
+}
+
+// KeepWeeklyIn applies the In predicate on the "keep_weekly" field.
+func KeepWeeklyIn(vs ...int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldIn(FieldKeepWeekly, vs...))
+}
+
+// KeepWeeklyNotIn applies the NotIn predicate on the "keep_weekly" field.
+func KeepWeeklyNotIn(vs ...int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNotIn(FieldKeepWeekly, vs...))
+}
+
+// KeepWeeklyGT applies the GT predicate on the "keep_weekly" field.
+func KeepWeeklyGT(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGT(FieldKeepWeekly, v))
+}
+
+// KeepWeeklyGTE applies the GTE predicate on the "keep_weekly" field.
+func KeepWeeklyGTE(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGTE(FieldKeepWeekly, v))
+}
+
+// KeepWeeklyLT applies the LT predicate on the "keep_weekly" field.
+func KeepWeeklyLT(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLT(FieldKeepWeekly, v))
+}
+
+// KeepWeeklyLTE applies the LTE predicate on the "keep_weekly" field.
+func KeepWeeklyLTE(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLTE(FieldKeepWeekly, v))
+}
+
+// KeepMonthlyEQ applies the EQ predicate on the "keep_monthly" field.
+func KeepMonthlyEQ(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldKeepMonthly, v))
+}
+
+// KeepMonthlyNEQ applies the NEQ predicate on the "keep_monthly" field.
+func KeepMonthlyNEQ(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNEQ(FieldKeepMonthly, v))
+}
+
+// KeepMonthlyIn applies the In predicate on the "keep_monthly" field.
+func KeepMonthlyIn(vs ...int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldIn(FieldKeepMonthly, vs...))
+}
+
+// KeepMonthlyNotIn applies the NotIn predicate on the "keep_monthly" field.
+func KeepMonthlyNotIn(vs ...int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNotIn(FieldKeepMonthly, vs...))
+}
+
+// KeepMonthlyGT applies the GT predicate on the "keep_monthly" field.
+func KeepMonthlyGT(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGT(FieldKeepMonthly, v))
+}
+
+// KeepMonthlyGTE applies the GTE predicate on the "keep_monthly" field.
+func KeepMonthlyGTE(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGTE(FieldKeepMonthly, v))
+}
+
+// KeepMonthlyLT applies the LT predicate on the "keep_monthly" field.
+func KeepMonthlyLT(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLT(FieldKeepMonthly, v))
+}
+
+// KeepMonthlyLTE applies the LTE predicate on the "keep_monthly" field.
+func KeepMonthlyLTE(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLTE(FieldKeepMonthly, v))
+}
+
+// KeepYearlyEQ applies the EQ predicate on the "keep_yearly" field.
+func KeepYearlyEQ(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldKeepYearly, v))
+}
+
+// KeepYearlyNEQ applies the NEQ predicate on the "keep_yearly" field.
+func KeepYearlyNEQ(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNEQ(FieldKeepYearly, v))
+}
+
+// KeepYearlyIn applies the In predicate on the "keep_yearly" field.
+func KeepYearlyIn(vs ...int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldIn(FieldKeepYearly, vs...))
+}
+
+// KeepYearlyNotIn applies the NotIn predicate on the "keep_yearly" field.
+func KeepYearlyNotIn(vs ...int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNotIn(FieldKeepYearly, vs...))
+}
+
+// KeepYearlyGT applies the GT predicate on the "keep_yearly" field.
+func KeepYearlyGT(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGT(FieldKeepYearly, v))
+}
+
+// KeepYearlyGTE applies the GTE predicate on the "keep_yearly" field.
+func KeepYearlyGTE(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGTE(FieldKeepYearly, v))
+}
+
+// KeepYearlyLT applies the LT predicate on the "keep_yearly" field.
+func KeepYearlyLT(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLT(FieldKeepYearly, v))
+}
+
+// KeepYearlyLTE applies the LTE predicate on the "keep_yearly" field.
+func KeepYearlyLTE(v int) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLTE(FieldKeepYearly, v))
+}
+
+// EncryptionEnabledEQ applies the EQ predicate on the "encryption_enabled" field.
+func EncryptionEnabledEQ(v bool) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldEncryptionEnabled, v))
+}
+
+// EncryptionEnabledNEQ applies the NEQ predicate on the "encryption_enabled" field.
+func EncryptionEnabledNEQ(v bool) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNEQ(FieldEncryptionEnabled, v))
+}
+
+// EncryptionPassphraseEQ applies the EQ predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseEQ(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldEncryptionPassphrase, v))
+}
+
+// EncryptionPassphraseNEQ applies the NEQ predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseNEQ(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNEQ(FieldEncryptionPassphrase, v))
+}
+
+// EncryptionPassphraseIn applies the In predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseIn(vs ...string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldIn(FieldEncryptionPassphrase, vs...))
+}
+
+// EncryptionPassphraseNotIn applies the NotIn predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseNotIn(vs ...string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNotIn(FieldEncryptionPassphrase, vs...))
+}
+
+// EncryptionPassphraseGT applies the GT predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseGT(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGT(FieldEncryptionPassphrase, v))
+}
+
+// EncryptionPassphraseGTE applies the GTE predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseGTE(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldGTE(FieldEncryptionPassphrase, v))
+}
+
+// EncryptionPassphraseLT applies the LT predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseLT(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLT(FieldEncryptionPassphrase, v))
+}
+
+// EncryptionPassphraseLTE applies the LTE predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseLTE(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldLTE(FieldEncryptionPassphrase, v))
+}
+
+// EncryptionPassphraseContains applies the Contains predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseContains(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldContains(FieldEncryptionPassphrase, v))
+}
+
+// EncryptionPassphraseHasPrefix applies the HasPrefix predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseHasPrefix(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldHasPrefix(FieldEncryptionPassphrase, v))
+}
+
+// EncryptionPassphraseHasSuffix applies the HasSuffix predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseHasSuffix(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldHasSuffix(FieldEncryptionPassphrase, v))
+}
+
+// EncryptionPassphraseIsNil applies the IsNil predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseIsNil() predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldIsNull(FieldEncryptionPassphrase))
+}
+
+// EncryptionPassphraseNotNil applies the NotNil predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseNotNil() predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNotNull(FieldEncryptionPassphrase))
+}
+
+// EncryptionPassphraseEqualFold applies the EqualFold predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseEqualFold(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEqualFold(FieldEncryptionPassphrase, v))
+}
+
+// EncryptionPassphraseContainsFold applies the ContainsFold predicate on the "encryption_passphrase" field.
+func EncryptionPassphraseContainsFold(v string) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldContainsFold(FieldEncryptionPassphrase, v))
+}
+
+// IncrementalEQ applies the EQ predicate on the "incremental" field.
+func IncrementalEQ(v bool) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldEQ(FieldIncremental, v))
+}
+
+// IncrementalNEQ applies the NEQ predicate on the "incremental" field.
+func IncrementalNEQ(v bool) predicate.BackupTask {
+	return predicate.BackupTask(sql.FieldNEQ(FieldIncremental, v))
+}
+
 // LastBackupStatusEQ applies the EQ predicate on the "last_backup_status" field.
 func LastBackupStatusEQ(v string) predicate.BackupTask {
 	return predicate.BackupTask(sql.FieldEQ(FieldLastBackupStatus, v))
@@ -618,6 +1113,29 @@ func HasTargetsWith(preds ...predicate.BackupTarget) predicate.BackupTask {
 	})
 }
 
+// HasRuns applies the HasEdge predicate on the "runs" edge.
+func HasRuns() predicate.BackupTask {
+	return predicate.BackupTask(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RunsTable, RunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunsWith applies the HasEdge predicate on the "runs" edge with a given conditions (other predicates).
+func HasRunsWith(preds ...predicate.BackupRun) predicate.BackupTask {
+	return predicate.BackupTask(func(s *sql.Selector) {
+		step := newRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.BackupTask) predicate.BackupTask {
 	return predicate.BackupTask(sql.AndPredicates(predicates...))
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"smarticky/ent/backuprun"
 	"smarticky/ent/backuptarget"
 	"smarticky/ent/backuptask"
 	"time"
@@ -55,6 +56,34 @@ func (_c *BackupTaskCreate) SetNillableSchedule(v *string) *BackupTaskCreate {
 	return _c
 }
 
+// SetCronExpression sets the "cron_expression" field.
+func (_c *BackupTaskCreate) SetCronExpression(v string) *BackupTaskCreate {
+	_c.mutation.SetCronExpression(v)
+	return _c
+}
+
+// SetNillableCronExpression sets the "cron_expression" field if the given value is not nil.
+func (_c *BackupTaskCreate) SetNillableCronExpression(v *string) *BackupTaskCreate {
+	if v != nil {
+		_c.SetCronExpression(*v)
+	}
+	return _c
+}
+
+// SetTimezone sets the "timezone" field.
+func (_c *BackupTaskCreate) SetTimezone(v string) *BackupTaskCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *BackupTaskCreate) SetNillableTimezone(v *string) *BackupTaskCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
 // SetRetentionDays sets the "retention_days" field.
 func (_c *BackupTaskCreate) SetRetentionDays(v int) *BackupTaskCreate {
 	_c.mutation.SetRetentionDays(v)
@@ -83,6 +112,118 @@ func (_c *BackupTaskCreate) SetNillableMaxCount(v *int) *BackupTaskCreate {
 	return _c
 }
 
+// SetKeepHourly sets the "keep_hourly" field.
+func (_c *BackupTaskCreate) SetKeepHourly(v int) *BackupTaskCreate {
+	_c.mutation.SetKeepHourly(v)
+	return _c
+}
+
+// SetNillableKeepHourly sets the "keep_hourly" field if the given value is not nil.
+func (_c *BackupTaskCreate) SetNillableKeepHourly(v *int) *BackupTaskCreate {
+	if v != nil {
+		_c.SetKeepHourly(*v)
+	}
+	return _c
+}
+
+// SetKeepDaily sets the "keep_daily" field.
+func (_c *BackupTaskCreate) SetKeepDaily(v int) *BackupTaskCreate {
+	_c.mutation.SetKeepDaily(v)
+	return _c
+}
+
+// SetNillableKeepDaily sets the "keep_daily" field if the given value is not nil.
+func (_c *BackupTaskCreate) SetNillableKeepDaily(v *int) *BackupTaskCreate {
+	if v != nil {
+		_c.SetKeepDaily(*v)
+	}
+	return _c
+}
+
+// SetKeepWeekly sets the "keep_weekly" field.
+func (_c *BackupTaskCreate) SetKeepWeekly(v int) *BackupTaskCreate {
+	_c.mutation.SetKeepWeekly(v)
+	return _c
+}
+
+// SetNillableKeepWeekly sets the "keep_weekly" field if the given value is not nil.
+func (_c *BackupTaskCreate) SetNillableKeepWeekly(v *int) *BackupTaskCreate {
+	if v != nil {
+		_c.SetKeepWeekly(*v)
+	}
+	return _c
+}
+
+// SetKeepMonthly sets the "keep_monthly" field.
+func (_c *BackupTaskCreate) SetKeepMonthly(v int) *BackupTaskCreate {
+	_c.mutation.SetKeepMonthly(v)
+	return _c
+}
+
+// SetNillableKeepMonthly sets the "keep_monthly" field if the given value is not nil.
+func (_c *BackupTaskCreate) SetNillableKeepMonthly(v *int) *BackupTaskCreate {
+	if v != nil {
+		_c.SetKeepMonthly(*v)
+	}
+	return _c
+}
+
+// SetKeepYearly sets the "keep_yearly" field.
+func (_c *BackupTaskCreate) SetKeepYearly(v int) *BackupTaskCreate {
+	_c.mutation.SetKeepYearly(v)
+	return _c
+}
+
+// SetNillableKeepYearly sets the "keep_yearly" field if the given value is not nil.
+func (_c *BackupTaskCreate) SetNillableKeepYearly(v *int) *BackupTaskCreate {
+	if v != nil {
+		_c.SetKeepYearly(*v)
+	}
+	return _c
+}
+
+// SetEncryptionEnabled sets the "encryption_enabled" field.
+func (_c *BackupTaskCreate) SetEncryptionEnabled(v bool) *BackupTaskCreate {
+	_c.mutation.SetEncryptionEnabled(v)
+	return _c
+}
+
+// SetNillableEncryptionEnabled sets the "encryption_enabled" field if the given value is not nil.
+func (_c *BackupTaskCreate) SetNillableEncryptionEnabled(v *bool) *BackupTaskCreate {
+	if v != nil {
+		_c.SetEncryptionEnabled(*v)
+	}
+	return _c
+}
+
+// SetEncryptionPassphrase sets the "encryption_passphrase" field.
+func (_c *BackupTaskCreate) SetEncryptionPassphrase(v string) *BackupTaskCreate {
+	_c.mutation.SetEncryptionPassphrase(v)
+	return _c
+}
+
+// SetNillableEncryptionPassphrase sets the "encryption_passphrase" field if the given value is not nil.
+func (_c *BackupTaskCreate) SetNillableEncryptionPassphrase(v *string) *BackupTaskCreate {
+	if v != nil {
+		_c.SetEncryptionPassphrase(*v)
+	}
+	return _c
+}
+
+// SetIncremental sets the "incremental" field.
+func (_c *BackupTaskCreate) SetIncremental(v bool) *BackupTaskCreate {
+	_c.mutation.SetIncremental(v)
+	return _c
+}
+
+// SetNillableIncremental sets the "incremental" field if the given value is not nil.
+func (_c *BackupTaskCreate) SetNillableIncremental(v *bool) *BackupTaskCreate {
+	if v != nil {
+		_c.SetIncremental(*v)
+	}
+	return _c
+}
+
 // SetLastBackupStatus sets the "last_backup_status" field.
 func (_c *BackupTaskCreate) SetLastBackupStatus(v string) *BackupTaskCreate {
 	_c.mutation.SetLastBackupStatus(v)
@@ -168,6 +309,21 @@ func (_c *BackupTaskCreate) AddTargets(v ...*BackupTarget) *BackupTaskCreate {
 	return _c.AddTargetIDs(ids...)
 }
 
+// AddRunIDs adds the "runs" edge to the BackupRun entity by IDs.
+func (_c *BackupTaskCreate) AddRunIDs(ids ...int) *BackupTaskCreate {
+	_c.mutation.AddRunIDs(ids...)
+	return _c
+}
+
+// AddRuns adds the "runs" edges to the BackupRun entity.
+func (_c *BackupTaskCreate) AddRuns(v ...*BackupRun) *BackupTaskCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRunIDs(ids...)
+}
+
 // Mutation returns the BackupTaskMutation object of the builder.
 func (_c *BackupTaskCreate) Mutation() *BackupTaskMutation {
 	return _c.mutation
@@ -219,6 +375,34 @@ func (_c *BackupTaskCreate) defaults() {
 		v := backuptask.DefaultMaxCount
 		_c.mutation.SetMaxCount(v)
 	}
+	if _, ok := _c.mutation.KeepHourly(); !ok {
+		v := backuptask.DefaultKeepHourly
+		_c.mutation.SetKeepHourly(v)
+	}
+	if _, ok := _c.mutation.KeepDaily(); !ok {
+		v := backuptask.DefaultKeepDaily
+		_c.mutation.SetKeepDaily(v)
+	}
+	if _, ok := _c.mutation.KeepWeekly(); !ok {
+		v := backuptask.DefaultKeepWeekly
+		_c.mutation.SetKeepWeekly(v)
+	}
+	if _, ok := _c.mutation.KeepMonthly(); !ok {
+		v := backuptask.DefaultKeepMonthly
+		_c.mutation.SetKeepMonthly(v)
+	}
+	if _, ok := _c.mutation.KeepYearly(); !ok {
+		v := backuptask.DefaultKeepYearly
+		_c.mutation.SetKeepYearly(v)
+	}
+	if _, ok := _c.mutation.EncryptionEnabled(); !ok {
+		v := backuptask.DefaultEncryptionEnabled
+		_c.mutation.SetEncryptionEnabled(v)
+	}
+	if _, ok := _c.mutation.Incremental(); !ok {
+		v := backuptask.DefaultIncremental
+		_c.mutation.SetIncremental(v)
+	}
 	if _, ok := _c.mutation.LastBackupStatus(); !ok {
 		v := backuptask.DefaultLastBackupStatus
 		_c.mutation.SetLastBackupStatus(v)
@@ -255,6 +439,27 @@ func (_c *BackupTaskCreate) check() error {
 	if _, ok := _c.mutation.MaxCount(); !ok {
 		return &ValidationError{Name: "max_count", err: errors.New(`ent: missing required field "BackupTask.max_count"`)}
 	}
+	if _, ok := _c.mutation.KeepHourly(); !ok {
+		return &ValidationError{Name: "keep_hourly", err: errors.New(`ent: missing required field "BackupTask.keep_hourly"`)}
+	}
+	if _, ok := _c.mutation.KeepDaily(); !ok {
+		return &ValidationError{Name: "keep_daily", err: errors.New(`ent: missing required field "BackupTask.keep_daily"`)}
+	}
+	if _, ok := _c.mutation.KeepWeekly(); !ok {
+		return &ValidationError{Name: "keep_weekly", err: errors.New(`ent: missing required field "BackupTask.keep_weekly"`)}
+	}
+	if _, ok := _c.mutation.KeepMonthly(); !ok {
+		return &ValidationError{Name: "keep_monthly", err: errors.New(`ent: missing required field "BackupTask.keep_monthly"`)}
+	}
+	if _, ok := _c.mutation.KeepYearly(); !ok {
+		return &ValidationError{Name: "keep_yearly", err: errors.New(`ent: missing required field "BackupTask.keep_yearly"`)}
+	}
+	if _, ok := _c.mutation.EncryptionEnabled(); !ok {
+		return &ValidationError{Name: "encryption_enabled", err: errors.New(`ent: missing required field "BackupTask.encryption_enabled"`)}
+	}
+	if _, ok := _c.mutation.Incremental(); !ok {
+		return &ValidationError{Name: "incremental", err: errors.New(`ent: missing required field "BackupTask.incremental"`)}
+	}
 	if _, ok := _c.mutation.LastBackupStatus(); !ok {
 		return &ValidationError{Name: "last_backup_status", err: errors.New(`ent: missing required field "BackupTask.last_backup_status"`)}
 	}
@@ -302,6 +507,14 @@ func (_c *BackupTaskCreate) createSpec() (*BackupTask, *sqlgraph.CreateSpec) {
 		_spec.SetField(backuptask.FieldSchedule, field.TypeString, value)
 		_node.Schedule = value
 	}
+	if value, ok := _c.mutation.CronExpression(); ok {
+		_spec.SetField(backuptask.FieldCronExpression, field.TypeString, value)
+		_node.CronExpression = value
+	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(backuptask.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
 	if value, ok := _c.mutation.RetentionDays(); ok {
 		_spec.SetField(backuptask.FieldRetentionDays, field.TypeInt, value)
 		_node.RetentionDays = value
@@ -310,6 +523,38 @@ func (_c *BackupTaskCreate) createSpec() (*BackupTask, *sqlgraph.CreateSpec) {
 		_spec.SetField(backuptask.FieldMaxCount, field.TypeInt, value)
 		_node.MaxCount = value
 	}
+	if value, ok := _c.mutation.KeepHourly(); ok {
+		_spec.SetField(backuptask.FieldKeepHourly, field.TypeInt, value)
+		_node.KeepHourly = value
+	}
+	if value, ok := _c.mutation.KeepDaily(); ok {
+		_spec.SetField(backuptask.FieldKeepDaily, field.TypeInt, value)
+		_node.KeepDaily = value
+	}
+	if value, ok := _c.mutation.KeepWeekly(); ok {
+		_spec.SetField(backuptask.FieldKeepWeekly, field.TypeInt, value)
+		_node.KeepWeekly = value
+	}
+	if value, ok := _c.mutation.KeepMonthly(); ok {
+		_spec.SetField(backuptask.FieldKeepMonthly, field.TypeInt, value)
+		_node.KeepMonthly = value
+	}
+	if value, ok := _c.mutation.KeepYearly(); ok {
+		_spec.SetField(backuptask.FieldKeepYearly, field.TypeInt, value)
+		_node.KeepYearly = value
+	}
+	if value, ok := _c.mutation.EncryptionEnabled(); ok {
+		_spec.SetField(backuptask.FieldEncryptionEnabled, field.TypeBool, value)
+		_node.EncryptionEnabled = value
+	}
+	if value, ok := _c.mutation.EncryptionPassphrase(); ok {
+		_spec.SetField(backuptask.FieldEncryptionPassphrase, field.TypeString, value)
+		_node.EncryptionPassphrase = value
+	}
+	if value, ok := _c.mutation.Incremental(); ok {
+		_spec.SetField(backuptask.FieldIncremental, field.TypeBool, value)
+		_node.Incremental = value
+	}
 	if value, ok := _c.mutation.LastBackupStatus(); ok {
 		_spec.SetField(backuptask.FieldLastBackupStatus, field.TypeString, value)
 		_node.LastBackupStatus = value
@@ -346,6 +591,22 @@ func (_c *BackupTaskCreate) createSpec() (*BackupTask, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptask.RunsTable,
+			Columns: []string{backuptask.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
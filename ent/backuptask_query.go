@@ -7,6 +7,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"smarticky/ent/backuprun"
 	"smarticky/ent/backuptarget"
 	"smarticky/ent/backuptask"
 	"smarticky/ent/predicate"
@@ -25,6 +26,7 @@ type BackupTaskQuery struct {
 	inters      []Interceptor
 	predicates  []predicate.BackupTask
 	withTargets *BackupTargetQuery
+	withRuns    *BackupRunQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +85,28 @@ func (_q *BackupTaskQuery) QueryTargets() *BackupTargetQuery {
 	return query
 }
 
+// QueryRuns chains the current query on the "runs" edge.
+func (_q *BackupTaskQuery) QueryRuns() *BackupRunQuery {
+	query := (&BackupRunClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuptask.Table, backuptask.FieldID, selector),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuptask.RunsTable, backuptask.RunsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first BackupTask entity from the query.
 // Returns a *NotFoundError when no BackupTask was found.
 func (_q *BackupTaskQuery) First(ctx context.Context) (*BackupTask, error) {
@@ -276,6 +300,7 @@ func (_q *BackupTaskQuery) Clone() *BackupTaskQuery {
 		inters:      append([]Interceptor{}, _q.inters...),
 		predicates:  append([]predicate.BackupTask{}, _q.predicates...),
 		withTargets: _q.withTargets.Clone(),
+		withRuns:    _q.withRuns.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +318,17 @@ func (_q *BackupTaskQuery) WithTargets(opts ...func(*BackupTargetQuery)) *Backup
 	return _q
 }
 
+// WithRuns tells the query-builder to eager-load the nodes that are connected to
+// the "runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BackupTaskQuery) WithRuns(opts ...func(*BackupRunQuery)) *BackupTaskQuery {
+	query := (&BackupRunClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRuns = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +407,9 @@ func (_q *BackupTaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*B
 	var (
 		nodes       = []*BackupTask{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withTargets != nil,
+			_q.withRuns != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +437,13 @@ func (_q *BackupTaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*B
 			return nil, err
 		}
 	}
+	if query := _q.withRuns; query != nil {
+		if err := _q.loadRuns(ctx, query, nodes,
+			func(n *BackupTask) { n.Edges.Runs = []*BackupRun{} },
+			func(n *BackupTask, e *BackupRun) { n.Edges.Runs = append(n.Edges.Runs, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -464,6 +508,39 @@ func (_q *BackupTaskQuery) loadTargets(ctx context.Context, query *BackupTargetQ
 	}
 	return nil
 }
+func (_q *BackupTaskQuery) loadRuns(ctx context.Context, query *BackupRunQuery, nodes []*BackupTask, init func(*BackupTask), assign func(*BackupTask, *BackupRun)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*BackupTask)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(backuprun.FieldTaskID)
+	}
+	query.Where(predicate.BackupRun(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backuptask.RunsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TaskID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "task_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *BackupTaskQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"smarticky/ent/backuprun"
 	"smarticky/ent/backuptarget"
 	"smarticky/ent/backuptask"
 	"smarticky/ent/predicate"
@@ -71,6 +72,46 @@ func (_u *BackupTaskUpdate) SetNillableSchedule(v *string) *BackupTaskUpdate {
 	return _u
 }
 
+// SetCronExpression sets the "cron_expression" field.
+func (_u *BackupTaskUpdate) SetCronExpression(v string) *BackupTaskUpdate {
+	_u.mutation.SetCronExpression(v)
+	return _u
+}
+
+// SetNillableCronExpression sets the "cron_expression" field if the given value is not nil.
+func (_u *BackupTaskUpdate) SetNillableCronExpression(v *string) *BackupTaskUpdate {
+	if v != nil {
+		_u.SetCronExpression(*v)
+	}
+	return _u
+}
+
+// ClearCronExpression clears the value of the "cron_expression" field.
+func (_u *BackupTaskUpdate) ClearCronExpression() *BackupTaskUpdate {
+	_u.mutation.ClearCronExpression()
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *BackupTaskUpdate) SetTimezone(v string) *BackupTaskUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *BackupTaskUpdate) SetNillableTimezone(v *string) *BackupTaskUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (_u *BackupTaskUpdate) ClearTimezone() *BackupTaskUpdate {
+	_u.mutation.ClearTimezone()
+	return _u
+}
+
 // SetRetentionDays sets the "retention_days" field.
 func (_u *BackupTaskUpdate) SetRetentionDays(v int) *BackupTaskUpdate {
 	_u.mutation.ResetRetentionDays()
@@ -113,6 +154,159 @@ func (_u *BackupTaskUpdate) AddMaxCount(v int) *BackupTaskUpdate {
 	return _u
 }
 
+// SetKeepHourly sets the "keep_hourly" field.
+func (_u *BackupTaskUpdate) SetKeepHourly(v int) *BackupTaskUpdate {
+	_u.mutation.ResetKeepHourly()
+	_u.mutation.SetKeepHourly(v)
+	return _u
+}
+
+// SetNillableKeepHourly sets the "keep_hourly" field if the given value is not nil.
+func (_u *BackupTaskUpdate) SetNillableKeepHourly(v *int) *BackupTaskUpdate {
+	if v != nil {
+		_u.SetKeepHourly(*v)
+	}
+	return _u
+}
+
+// AddKeepHourly adds value to the "keep_hourly" field.
+func (_u *BackupTaskUpdate) AddKeepHourly(v int) *BackupTaskUpdate {
+	_u.mutation.AddKeepHourly(v)
+	return _u
+}
+
+// SetKeepDaily sets the "keep_daily" field.
+func (_u *BackupTaskUpdate) SetKeepDaily(v int) *BackupTaskUpdate {
+	_u.mutation.ResetKeepDaily()
+	_u.mutation.SetKeepDaily(v)
+	return _u
+}
+
+// SetNillableKeepDaily sets the "keep_daily" field if the given value is not nil.
+func (_u *BackupTaskUpdate) SetNillableKeepDaily(v *int) *BackupTaskUpdate {
+	if v != nil {
+		_u.SetKeepDaily(*v)
+	}
+	return _u
+}
+
+// AddKeepDaily adds value to the "keep_daily" field.
+func (_u *BackupTaskUpdate) AddKeepDaily(v int) *BackupTaskUpdate {
+	_u.mutation.AddKeepDaily(v)
+	return _u
+}
+
+// SetKeepWeekly sets the "keep_weekly" field.
+func (_u *BackupTaskUpdate) SetKeepWeekly(v int) *BackupTaskUpdate {
+	_u.mutation.ResetKeepWeekly()
+	_u.mutation.SetKeepWeekly(v)
+	return _u
+}
+
+// SetNillableKeepWeekly sets the "keep_weekly" field if the given value is not nil.
+func (_u *BackupTaskUpdate) SetNillableKeepWeekly(v *int) *BackupTaskUpdate {
+	if v != nil {
+		_u.SetKeepWeekly(*v)
+	}
+	return _u
+}
+
+// AddKeepWeekly adds value to the "keep_weekly" field.
+func (_u *BackupTaskUpdate) AddKeepWeekly(v int) *BackupTaskUpdate {
+	_u.mutation.AddKeepWeekly(v)
+	return _u
+}
+
+// SetKeepMonthly sets the "keep_monthly" field.
+func (_u *BackupTaskUpdate) SetKeepMonthly(v int) *BackupTaskUpdate {
+	_u.mutation.ResetKeepMonthly()
+	_u.mutation.SetKeepMonthly(v)
+	return _u
+}
+
+// SetNillableKeepMonthly sets the "keep_monthly" field if the given value is not nil.
+func (_u *BackupTaskUpdate) SetNillableKeepMonthly(v *int) *BackupTaskUpdate {
+	if v != nil {
+		_u.SetKeepMonthly(*v)
+	}
+	return _u
+}
+
+// AddKeepMonthly adds value to the "keep_monthly" field.
+func (_u *BackupTaskUpdate) AddKeepMonthly(v int) *BackupTaskUpdate {
+	_u.mutation.AddKeepMonthly(v)
+	return _u
+}
+
+// SetKeepYearly sets the "keep_yearly" field.
+func (_u *BackupTaskUpdate) SetKeepYearly(v int) *BackupTaskUpdate {
+	_u.mutation.ResetKeepYearly()
+	_u.mutation.SetKeepYearly(v)
+	return _u
+}
+
+// SetNillableKeepYearly sets the "keep_yearly" field if the given value is not nil.
+func (_u *BackupTaskUpdate) SetNillableKeepYearly(v *int) *BackupTaskUpdate {
+	if v != nil {
+		_u.SetKeepYearly(*v)
+	}
+	return _u
+}
+
+// AddKeepYearly adds value to the "keep_yearly" field.
+func (_u *BackupTaskUpdate) AddKeepYearly(v int) *BackupTaskUpdate {
+	_u.mutation.AddKeepYearly(v)
+	return _u
+}
+
+// SetEncryptionEnabled sets the "encryption_enabled" field.
+func (_u *BackupTaskUpdate) SetEncryptionEnabled(v bool) *BackupTaskUpdate {
+	_u.mutation.SetEncryptionEnabled(v)
+	return _u
+}
+
+// SetNillableEncryptionEnabled sets the "encryption_enabled" field if the given value is not nil.
+func (_u *BackupTaskUpdate) SetNillableEncryptionEnabled(v *bool) *BackupTaskUpdate {
+	if v != nil {
+		_u.SetEncryptionEnabled(*v)
+	}
+	return _u
+}
+
+// SetEncryptionPassphrase sets the "encryption_passphrase" field.
+func (_u *BackupTaskUpdate) SetEncryptionPassphrase(v string) *BackupTaskUpdate {
+	_u.mutation.SetEncryptionPassphrase(v)
+	return _u
+}
+
+// SetNillableEncryptionPassphrase sets the "encryption_passphrase" field if the given value is not nil.
+func (_u *BackupTaskUpdate) SetNillableEncryptionPassphrase(v *string) *BackupTaskUpdate {
+	if v != nil {
+		_u.SetEncryptionPassphrase(*v)
+	}
+	return _u
+}
+
+// ClearEncryptionPassphrase clears the value of the "encryption_passphrase" field.
+func (_u *BackupTaskUpdate) ClearEncryptionPassphrase() *BackupTaskUpdate {
+	_u.mutation.ClearEncryptionPassphrase()
+	return _u
+}
+
+// SetIncremental sets the "incremental" field.
+func (_u *BackupTaskUpdate) SetIncremental(v bool) *BackupTaskUpdate {
+	_u.mutation.SetIncremental(v)
+	return _u
+}
+
+// SetNillableIncremental sets the "incremental" field if the given value is not nil.
+func (_u *BackupTaskUpdate) SetNillableIncremental(v *bool) *BackupTaskUpdate {
+	if v != nil {
+		_u.SetIncremental(*v)
+	}
+	return _u
+}
+
 // SetLastBackupStatus sets the "last_backup_status" field.
 func (_u *BackupTaskUpdate) SetLastBackupStatus(v string) *BackupTaskUpdate {
 	_u.mutation.SetLastBackupStatus(v)
@@ -188,6 +382,21 @@ func (_u *BackupTaskUpdate) AddTargets(v ...*BackupTarget) *BackupTaskUpdate {
 	return _u.AddTargetIDs(ids...)
 }
 
+// AddRunIDs adds the "runs" edge to the BackupRun entity by IDs.
+func (_u *BackupTaskUpdate) AddRunIDs(ids ...int) *BackupTaskUpdate {
+	_u.mutation.AddRunIDs(ids...)
+	return _u
+}
+
+// AddRuns adds the "runs" edges to the BackupRun entity.
+func (_u *BackupTaskUpdate) AddRuns(v ...*BackupRun) *BackupTaskUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRunIDs(ids...)
+}
+
 // Mutation returns the BackupTaskMutation object of the builder.
 func (_u *BackupTaskUpdate) Mutation() *BackupTaskMutation {
 	return _u.mutation
@@ -214,6 +423,27 @@ func (_u *BackupTaskUpdate) RemoveTargets(v ...*BackupTarget) *BackupTaskUpdate
 	return _u.RemoveTargetIDs(ids...)
 }
 
+// ClearRuns clears all "runs" edges to the BackupRun entity.
+func (_u *BackupTaskUpdate) ClearRuns() *BackupTaskUpdate {
+	_u.mutation.ClearRuns()
+	return _u
+}
+
+// RemoveRunIDs removes the "runs" edge to BackupRun entities by IDs.
+func (_u *BackupTaskUpdate) RemoveRunIDs(ids ...int) *BackupTaskUpdate {
+	_u.mutation.RemoveRunIDs(ids...)
+	return _u
+}
+
+// RemoveRuns removes "runs" edges to BackupRun entities.
+func (_u *BackupTaskUpdate) RemoveRuns(v ...*BackupRun) *BackupTaskUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRunIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *BackupTaskUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -281,6 +511,18 @@ func (_u *BackupTaskUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Schedule(); ok {
 		_spec.SetField(backuptask.FieldSchedule, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.CronExpression(); ok {
+		_spec.SetField(backuptask.FieldCronExpression, field.TypeString, value)
+	}
+	if _u.mutation.CronExpressionCleared() {
+		_spec.ClearField(backuptask.FieldCronExpression, field.TypeString)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(backuptask.FieldTimezone, field.TypeString, value)
+	}
+	if _u.mutation.TimezoneCleared() {
+		_spec.ClearField(backuptask.FieldTimezone, field.TypeString)
+	}
 	if value, ok := _u.mutation.RetentionDays(); ok {
 		_spec.SetField(backuptask.FieldRetentionDays, field.TypeInt, value)
 	}
@@ -293,6 +535,48 @@ func (_u *BackupTaskUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AddedMaxCount(); ok {
 		_spec.AddField(backuptask.FieldMaxCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.KeepHourly(); ok {
+		_spec.SetField(backuptask.FieldKeepHourly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKeepHourly(); ok {
+		_spec.AddField(backuptask.FieldKeepHourly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.KeepDaily(); ok {
+		_spec.SetField(backuptask.FieldKeepDaily, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKeepDaily(); ok {
+		_spec.AddField(backuptask.FieldKeepDaily, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.KeepWeekly(); ok {
+		_spec.SetField(backuptask.FieldKeepWeekly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKeepWeekly(); ok {
+		_spec.AddField(backuptask.FieldKeepWeekly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.KeepMonthly(); ok {
+		_spec.SetField(backuptask.FieldKeepMonthly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKeepMonthly(); ok {
+		_spec.AddField(backuptask.FieldKeepMonthly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.KeepYearly(); ok {
+		_spec.SetField(backuptask.FieldKeepYearly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKeepYearly(); ok {
+		_spec.AddField(backuptask.FieldKeepYearly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EncryptionEnabled(); ok {
+		_spec.SetField(backuptask.FieldEncryptionEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EncryptionPassphrase(); ok {
+		_spec.SetField(backuptask.FieldEncryptionPassphrase, field.TypeString, value)
+	}
+	if _u.mutation.EncryptionPassphraseCleared() {
+		_spec.ClearField(backuptask.FieldEncryptionPassphrase, field.TypeString)
+	}
+	if value, ok := _u.mutation.Incremental(); ok {
+		_spec.SetField(backuptask.FieldIncremental, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.LastBackupStatus(); ok {
 		_spec.SetField(backuptask.FieldLastBackupStatus, field.TypeString, value)
 	}
@@ -356,6 +640,51 @@ func (_u *BackupTaskUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptask.RunsTable,
+			Columns: []string{backuptask.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRunsIDs(); len(nodes) > 0 && !_u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptask.RunsTable,
+			Columns: []string{backuptask.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptask.RunsTable,
+			Columns: []string{backuptask.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{backuptask.Label}
@@ -418,6 +747,46 @@ func (_u *BackupTaskUpdateOne) SetNillableSchedule(v *string) *BackupTaskUpdateO
 	return _u
 }
 
+// SetCronExpression sets the "cron_expression" field.
+func (_u *BackupTaskUpdateOne) SetCronExpression(v string) *BackupTaskUpdateOne {
+	_u.mutation.SetCronExpression(v)
+	return _u
+}
+
+// SetNillableCronExpression sets the "cron_expression" field if the given value is not nil.
+func (_u *BackupTaskUpdateOne) SetNillableCronExpression(v *string) *BackupTaskUpdateOne {
+	if v != nil {
+		_u.SetCronExpression(*v)
+	}
+	return _u
+}
+
+// ClearCronExpression clears the value of the "cron_expression" field.
+func (_u *BackupTaskUpdateOne) ClearCronExpression() *BackupTaskUpdateOne {
+	_u.mutation.ClearCronExpression()
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *BackupTaskUpdateOne) SetTimezone(v string) *BackupTaskUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *BackupTaskUpdateOne) SetNillableTimezone(v *string) *BackupTaskUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (_u *BackupTaskUpdateOne) ClearTimezone() *BackupTaskUpdateOne {
+	_u.mutation.ClearTimezone()
+	return _u
+}
+
 // SetRetentionDays sets the "retention_days" field.
 func (_u *BackupTaskUpdateOne) SetRetentionDays(v int) *BackupTaskUpdateOne {
 	_u.mutation.ResetRetentionDays()
@@ -460,6 +829,159 @@ func (_u *BackupTaskUpdateOne) AddMaxCount(v int) *BackupTaskUpdateOne {
 	return _u
 }
 
+// SetKeepHourly sets the "keep_hourly" field.
+func (_u *BackupTaskUpdateOne) SetKeepHourly(v int) *BackupTaskUpdateOne {
+	_u.mutation.ResetKeepHourly()
+	_u.mutation.SetKeepHourly(v)
+	return _u
+}
+
+// SetNillableKeepHourly sets the "keep_hourly" field if the given value is not nil.
+func (_u *BackupTaskUpdateOne) SetNillableKeepHourly(v *int) *BackupTaskUpdateOne {
+	if v != nil {
+		_u.SetKeepHourly(*v)
+	}
+	return _u
+}
+
+// AddKeepHourly adds value to the "keep_hourly" field.
+func (_u *BackupTaskUpdateOne) AddKeepHourly(v int) *BackupTaskUpdateOne {
+	_u.mutation.AddKeepHourly(v)
+	return _u
+}
+
+// SetKeepDaily sets the "keep_daily" field.
+func (_u *BackupTaskUpdateOne) SetKeepDaily(v int) *BackupTaskUpdateOne {
+	_u.mutation.ResetKeepDaily()
+	_u.mutation.SetKeepDaily(v)
+	return _u
+}
+
+// SetNillableKeepDaily sets the "keep_daily" field if the given value is not nil.
+func (_u *BackupTaskUpdateOne) SetNillableKeepDaily(v *int) *BackupTaskUpdateOne {
+	if v != nil {
+		_u.SetKeepDaily(*v)
+	}
+	return _u
+}
+
+// AddKeepDaily adds value to the "keep_daily" field.
+func (_u *BackupTaskUpdateOne) AddKeepDaily(v int) *BackupTaskUpdateOne {
+	_u.mutation.AddKeepDaily(v)
+	return _u
+}
+
+// SetKeepWeekly sets the "keep_weekly" field.
+func (_u *BackupTaskUpdateOne) SetKeepWeekly(v int) *BackupTaskUpdateOne {
+	_u.mutation.ResetKeepWeekly()
+	_u.mutation.SetKeepWeekly(v)
+	return _u
+}
+
+// SetNillableKeepWeekly sets the "keep_weekly" field if the given value is not nil.
+func (_u *BackupTaskUpdateOne) SetNillableKeepWeekly(v *int) *BackupTaskUpdateOne {
+	if v != nil {
+		_u.SetKeepWeekly(*v)
+	}
+	return _u
+}
+
+// AddKeepWeekly adds value to the "keep_weekly" field.
+func (_u *BackupTaskUpdateOne) AddKeepWeekly(v int) *BackupTaskUpdateOne {
+	_u.mutation.AddKeepWeekly(v)
+	return _u
+}
+
+// SetKeepMonthly sets the "keep_monthly" field.
+func (_u *BackupTaskUpdateOne) SetKeepMonthly(v int) *BackupTaskUpdateOne {
+	_u.mutation.ResetKeepMonthly()
+	_u.mutation.SetKeepMonthly(v)
+	return _u
+}
+
+// SetNillableKeepMonthly sets the "keep_monthly" field if the given value is not nil.
+func (_u *BackupTaskUpdateOne) SetNillableKeepMonthly(v *int) *BackupTaskUpdateOne {
+	if v != nil {
+		_u.SetKeepMonthly(*v)
+	}
+	return _u
+}
+
+// AddKeepMonthly adds value to the "keep_monthly" field.
+func (_u *BackupTaskUpdateOne) AddKeepMonthly(v int) *BackupTaskUpdateOne {
+	_u.mutation.AddKeepMonthly(v)
+	return _u
+}
+
+// SetKeepYearly sets the "keep_yearly" field.
+func (_u *BackupTaskUpdateOne) SetKeepYearly(v int) *BackupTaskUpdateOne {
+	_u.mutation.ResetKeepYearly()
+	_u.mutation.SetKeepYearly(v)
+	return _u
+}
+
+// SetNillableKeepYearly sets the "keep_yearly" field if the given value is not nil.
+func (_u *BackupTaskUpdateOne) SetNillableKeepYearly(v *int) *BackupTaskUpdateOne {
+	if v != nil {
+		_u.SetKeepYearly(*v)
+	}
+	return _u
+}
+
+// AddKeepYearly adds value to the "keep_yearly" field.
+func (_u *BackupTaskUpdateOne) AddKeepYearly(v int) *BackupTaskUpdateOne {
+	_u.mutation.AddKeepYearly(v)
+	return _u
+}
+
+// SetEncryptionEnabled sets the "encryption_enabled" field.
+func (_u *BackupTaskUpdateOne) SetEncryptionEnabled(v bool) *BackupTaskUpdateOne {
+	_u.mutation.SetEncryptionEnabled(v)
+	return _u
+}
+
+// SetNillableEncryptionEnabled sets the "encryption_enabled" field if the given value is not nil.
+func (_u *BackupTaskUpdateOne) SetNillableEncryptionEnabled(v *bool) *BackupTaskUpdateOne {
+	if v != nil {
+		_u.SetEncryptionEnabled(*v)
+	}
+	return _u
+}
+
+// SetEncryptionPassphrase sets the "encryption_passphrase" field.
+func (_u *BackupTaskUpdateOne) SetEncryptionPassphrase(v string) *BackupTaskUpdateOne {
+	_u.mutation.SetEncryptionPassphrase(v)
+	return _u
+}
+
+// SetNillableEncryptionPassphrase sets the "encryption_passphrase" field if the given value is not nil.
+func (_u *BackupTaskUpdateOne) SetNillableEncryptionPassphrase(v *string) *BackupTaskUpdateOne {
+	if v != nil {
+		_u.SetEncryptionPassphrase(*v)
+	}
+	return _u
+}
+
+// ClearEncryptionPassphrase clears the value of the "encryption_passphrase" field.
+func (_u *BackupTaskUpdateOne) ClearEncryptionPassphrase() *BackupTaskUpdateOne {
+	_u.mutation.ClearEncryptionPassphrase()
+	return _u
+}
+
+// SetIncremental sets the "incremental" field.
+func (_u *BackupTaskUpdateOne) SetIncremental(v bool) *BackupTaskUpdateOne {
+	_u.mutation.SetIncremental(v)
+	return _u
+}
+
+// SetNillableIncremental sets the "incremental" field if the given value is not nil.
+func (_u *BackupTaskUpdateOne) SetNillableIncremental(v *bool) *BackupTaskUpdateOne {
+	if v != nil {
+		_u.SetIncremental(*v)
+	}
+	return _u
+}
+
 // SetLastBackupStatus sets the "last_backup_status" field.
 func (_u *BackupTaskUpdateOne) SetLastBackupStatus(v string) *BackupTaskUpdateOne {
 	_u.mutation.SetLastBackupStatus(v)
@@ -535,6 +1057,21 @@ func (_u *BackupTaskUpdateOne) AddTargets(v ...*BackupTarget) *BackupTaskUpdateO
 	return _u.AddTargetIDs(ids...)
 }
 
+// AddRunIDs adds the "runs" edge to the BackupRun entity by IDs.
+func (_u *BackupTaskUpdateOne) AddRunIDs(ids ...int) *BackupTaskUpdateOne {
+	_u.mutation.AddRunIDs(ids...)
+	return _u
+}
+
+// AddRuns adds the "runs" edges to the BackupRun entity.
+func (_u *BackupTaskUpdateOne) AddRuns(v ...*BackupRun) *BackupTaskUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRunIDs(ids...)
+}
+
 // Mutation returns the BackupTaskMutation object of the builder.
 func (_u *BackupTaskUpdateOne) Mutation() *BackupTaskMutation {
 	return _u.mutation
@@ -561,6 +1098,27 @@ func (_u *BackupTaskUpdateOne) RemoveTargets(v ...*BackupTarget) *BackupTaskUpda
 	return _u.RemoveTargetIDs(ids...)
 }
 
+// ClearRuns clears all "runs" edges to the BackupRun entity.
+func (_u *BackupTaskUpdateOne) ClearRuns() *BackupTaskUpdateOne {
+	_u.mutation.ClearRuns()
+	return _u
+}
+
+// RemoveRunIDs removes the "runs" edge to BackupRun entities by IDs.
+func (_u *BackupTaskUpdateOne) RemoveRunIDs(ids ...int) *BackupTaskUpdateOne {
+	_u.mutation.RemoveRunIDs(ids...)
+	return _u
+}
+
+// RemoveRuns removes "runs" edges to BackupRun entities.
+func (_u *BackupTaskUpdateOne) RemoveRuns(v ...*BackupRun) *BackupTaskUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRunIDs(ids...)
+}
+
 // Where appends a list predicates to the BackupTaskUpdate builder.
 func (_u *BackupTaskUpdateOne) Where(ps ...predicate.BackupTask) *BackupTaskUpdateOne {
 	_u.mutation.Where(ps...)
@@ -658,6 +1216,18 @@ func (_u *BackupTaskUpdateOne) sqlSave(ctx context.Context) (_node *BackupTask,
 	if value, ok := _u.mutation.Schedule(); ok {
 		_spec.SetField(backuptask.FieldSchedule, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.CronExpression(); ok {
+		_spec.SetField(backuptask.FieldCronExpression, field.TypeString, value)
+	}
+	if _u.mutation.CronExpressionCleared() {
+		_spec.ClearField(backuptask.FieldCronExpression, field.TypeString)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(backuptask.FieldTimezone, field.TypeString, value)
+	}
+	if _u.mutation.TimezoneCleared() {
+		_spec.ClearField(backuptask.FieldTimezone, field.TypeString)
+	}
 	if value, ok := _u.mutation.RetentionDays(); ok {
 		_spec.SetField(backuptask.FieldRetentionDays, field.TypeInt, value)
 	}
@@ -670,6 +1240,48 @@ func (_u *BackupTaskUpdateOne) sqlSave(ctx context.Context) (_node *BackupTask,
 	if value, ok := _u.mutation.AddedMaxCount(); ok {
 		_spec.AddField(backuptask.FieldMaxCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.KeepHourly(); ok {
+		_spec.SetField(backuptask.FieldKeepHourly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKeepHourly(); ok {
+		_spec.AddField(backuptask.FieldKeepHourly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.KeepDaily(); ok {
+		_spec.SetField(backuptask.FieldKeepDaily, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKeepDaily(); ok {
+		_spec.AddField(backuptask.FieldKeepDaily, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.KeepWeekly(); ok {
+		_spec.SetField(backuptask.FieldKeepWeekly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKeepWeekly(); ok {
+		_spec.AddField(backuptask.FieldKeepWeekly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.KeepMonthly(); ok {
+		_spec.SetField(backuptask.FieldKeepMonthly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKeepMonthly(); ok {
+		_spec.AddField(backuptask.FieldKeepMonthly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.KeepYearly(); ok {
+		_spec.SetField(backuptask.FieldKeepYearly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKeepYearly(); ok {
+		_spec.AddField(backuptask.FieldKeepYearly, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EncryptionEnabled(); ok {
+		_spec.SetField(backuptask.FieldEncryptionEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EncryptionPassphrase(); ok {
+		_spec.SetField(backuptask.FieldEncryptionPassphrase, field.TypeString, value)
+	}
+	if _u.mutation.EncryptionPassphraseCleared() {
+		_spec.ClearField(backuptask.FieldEncryptionPassphrase, field.TypeString)
+	}
+	if value, ok := _u.mutation.Incremental(); ok {
+		_spec.SetField(backuptask.FieldIncremental, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.LastBackupStatus(); ok {
 		_spec.SetField(backuptask.FieldLastBackupStatus, field.TypeString, value)
 	}
@@ -733,6 +1345,51 @@ func (_u *BackupTaskUpdateOne) sqlSave(ctx context.Context) (_node *BackupTask,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptask.RunsTable,
+			Columns: []string{backuptask.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRunsIDs(); len(nodes) > 0 && !_u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptask.RunsTable,
+			Columns: []string{backuptask.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptask.RunsTable,
+			Columns: []string{backuptask.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &BackupTask{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
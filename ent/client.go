@@ -13,6 +13,9 @@ import (
 
 	"smarticky/ent/attachment"
 	"smarticky/ent/backupconfig"
+	"smarticky/ent/backupdrill"
+	"smarticky/ent/backuppin"
+	"smarticky/ent/backuprun"
 	"smarticky/ent/backuptarget"
 	"smarticky/ent/backuptask"
 	"smarticky/ent/excalidrawlibrary"
@@ -20,10 +23,12 @@ import (
 	"smarticky/ent/font"
 	"smarticky/ent/importitem"
 	"smarticky/ent/importjob"
+	"smarticky/ent/linkrewrite"
 	"smarticky/ent/mcpimage"
 	"smarticky/ent/mcptoken"
 	"smarticky/ent/note"
 	"smarticky/ent/noteconnectionaccount"
+	"smarticky/ent/noteconnectionconflict"
 	"smarticky/ent/noteconnectionitemmap"
 	"smarticky/ent/noteconnectionjob"
 	"smarticky/ent/notelink"
@@ -47,6 +52,12 @@ type Client struct {
 	Attachment *AttachmentClient
 	// BackupConfig is the client for interacting with the BackupConfig builders.
 	BackupConfig *BackupConfigClient
+	// BackupDrill is the client for interacting with the BackupDrill builders.
+	BackupDrill *BackupDrillClient
+	// BackupPin is the client for interacting with the BackupPin builders.
+	BackupPin *BackupPinClient
+	// BackupRun is the client for interacting with the BackupRun builders.
+	BackupRun *BackupRunClient
 	// BackupTarget is the client for interacting with the BackupTarget builders.
 	BackupTarget *BackupTargetClient
 	// BackupTask is the client for interacting with the BackupTask builders.
@@ -61,6 +72,8 @@ type Client struct {
 	ImportItem *ImportItemClient
 	// ImportJob is the client for interacting with the ImportJob builders.
 	ImportJob *ImportJobClient
+	// LinkRewrite is the client for interacting with the LinkRewrite builders.
+	LinkRewrite *LinkRewriteClient
 	// MCPImage is the client for interacting with the MCPImage builders.
 	MCPImage *MCPImageClient
 	// MCPToken is the client for interacting with the MCPToken builders.
@@ -69,6 +82,8 @@ type Client struct {
 	Note *NoteClient
 	// NoteConnectionAccount is the client for interacting with the NoteConnectionAccount builders.
 	NoteConnectionAccount *NoteConnectionAccountClient
+	// NoteConnectionConflict is the client for interacting with the NoteConnectionConflict builders.
+	NoteConnectionConflict *NoteConnectionConflictClient
 	// NoteConnectionItemMap is the client for interacting with the NoteConnectionItemMap builders.
 	NoteConnectionItemMap *NoteConnectionItemMapClient
 	// NoteConnectionJob is the client for interacting with the NoteConnectionJob builders.
@@ -94,6 +109,9 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Attachment = NewAttachmentClient(c.config)
 	c.BackupConfig = NewBackupConfigClient(c.config)
+	c.BackupDrill = NewBackupDrillClient(c.config)
+	c.BackupPin = NewBackupPinClient(c.config)
+	c.BackupRun = NewBackupRunClient(c.config)
 	c.BackupTarget = NewBackupTargetClient(c.config)
 	c.BackupTask = NewBackupTaskClient(c.config)
 	c.ExcalidrawLibrary = NewExcalidrawLibraryClient(c.config)
@@ -101,10 +119,12 @@ func (c *Client) init() {
 	c.Font = NewFontClient(c.config)
 	c.ImportItem = NewImportItemClient(c.config)
 	c.ImportJob = NewImportJobClient(c.config)
+	c.LinkRewrite = NewLinkRewriteClient(c.config)
 	c.MCPImage = NewMCPImageClient(c.config)
 	c.MCPToken = NewMCPTokenClient(c.config)
 	c.Note = NewNoteClient(c.config)
 	c.NoteConnectionAccount = NewNoteConnectionAccountClient(c.config)
+	c.NoteConnectionConflict = NewNoteConnectionConflictClient(c.config)
 	c.NoteConnectionItemMap = NewNoteConnectionItemMapClient(c.config)
 	c.NoteConnectionJob = NewNoteConnectionJobClient(c.config)
 	c.NoteLink = NewNoteLinkClient(c.config)
@@ -201,27 +221,32 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                   ctx,
-		config:                cfg,
-		Attachment:            NewAttachmentClient(cfg),
-		BackupConfig:          NewBackupConfigClient(cfg),
-		BackupTarget:          NewBackupTargetClient(cfg),
-		BackupTask:            NewBackupTaskClient(cfg),
-		ExcalidrawLibrary:     NewExcalidrawLibraryClient(cfg),
-		Folder:                NewFolderClient(cfg),
-		Font:                  NewFontClient(cfg),
-		ImportItem:            NewImportItemClient(cfg),
-		ImportJob:             NewImportJobClient(cfg),
-		MCPImage:              NewMCPImageClient(cfg),
-		MCPToken:              NewMCPTokenClient(cfg),
-		Note:                  NewNoteClient(cfg),
-		NoteConnectionAccount: NewNoteConnectionAccountClient(cfg),
-		NoteConnectionItemMap: NewNoteConnectionItemMapClient(cfg),
-		NoteConnectionJob:     NewNoteConnectionJobClient(cfg),
-		NoteLink:              NewNoteLinkClient(cfg),
-		Tag:                   NewTagClient(cfg),
-		User:                  NewUserClient(cfg),
-		Whiteboard:            NewWhiteboardClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		Attachment:             NewAttachmentClient(cfg),
+		BackupConfig:           NewBackupConfigClient(cfg),
+		BackupDrill:            NewBackupDrillClient(cfg),
+		BackupPin:              NewBackupPinClient(cfg),
+		BackupRun:              NewBackupRunClient(cfg),
+		BackupTarget:           NewBackupTargetClient(cfg),
+		BackupTask:             NewBackupTaskClient(cfg),
+		ExcalidrawLibrary:      NewExcalidrawLibraryClient(cfg),
+		Folder:                 NewFolderClient(cfg),
+		Font:                   NewFontClient(cfg),
+		ImportItem:             NewImportItemClient(cfg),
+		ImportJob:              NewImportJobClient(cfg),
+		LinkRewrite:            NewLinkRewriteClient(cfg),
+		MCPImage:               NewMCPImageClient(cfg),
+		MCPToken:               NewMCPTokenClient(cfg),
+		Note:                   NewNoteClient(cfg),
+		NoteConnectionAccount:  NewNoteConnectionAccountClient(cfg),
+		NoteConnectionConflict: NewNoteConnectionConflictClient(cfg),
+		NoteConnectionItemMap:  NewNoteConnectionItemMapClient(cfg),
+		NoteConnectionJob:      NewNoteConnectionJobClient(cfg),
+		NoteLink:               NewNoteLinkClient(cfg),
+		Tag:                    NewTagClient(cfg),
+		User:                   NewUserClient(cfg),
+		Whiteboard:             NewWhiteboardClient(cfg),
 	}, nil
 }
 
@@ -239,27 +264,32 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                   ctx,
-		config:                cfg,
-		Attachment:            NewAttachmentClient(cfg),
-		BackupConfig:          NewBackupConfigClient(cfg),
-		BackupTarget:          NewBackupTargetClient(cfg),
-		BackupTask:            NewBackupTaskClient(cfg),
-		ExcalidrawLibrary:     NewExcalidrawLibraryClient(cfg),
-		Folder:                NewFolderClient(cfg),
-		Font:                  NewFontClient(cfg),
-		ImportItem:            NewImportItemClient(cfg),
-		ImportJob:             NewImportJobClient(cfg),
-		MCPImage:              NewMCPImageClient(cfg),
-		MCPToken:              NewMCPTokenClient(cfg),
-		Note:                  NewNoteClient(cfg),
-		NoteConnectionAccount: NewNoteConnectionAccountClient(cfg),
-		NoteConnectionItemMap: NewNoteConnectionItemMapClient(cfg),
-		NoteConnectionJob:     NewNoteConnectionJobClient(cfg),
-		NoteLink:              NewNoteLinkClient(cfg),
-		Tag:                   NewTagClient(cfg),
-		User:                  NewUserClient(cfg),
-		Whiteboard:            NewWhiteboardClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		Attachment:             NewAttachmentClient(cfg),
+		BackupConfig:           NewBackupConfigClient(cfg),
+		BackupDrill:            NewBackupDrillClient(cfg),
+		BackupPin:              NewBackupPinClient(cfg),
+		BackupRun:              NewBackupRunClient(cfg),
+		BackupTarget:           NewBackupTargetClient(cfg),
+		BackupTask:             NewBackupTaskClient(cfg),
+		ExcalidrawLibrary:      NewExcalidrawLibraryClient(cfg),
+		Folder:                 NewFolderClient(cfg),
+		Font:                   NewFontClient(cfg),
+		ImportItem:             NewImportItemClient(cfg),
+		ImportJob:              NewImportJobClient(cfg),
+		LinkRewrite:            NewLinkRewriteClient(cfg),
+		MCPImage:               NewMCPImageClient(cfg),
+		MCPToken:               NewMCPTokenClient(cfg),
+		Note:                   NewNoteClient(cfg),
+		NoteConnectionAccount:  NewNoteConnectionAccountClient(cfg),
+		NoteConnectionConflict: NewNoteConnectionConflictClient(cfg),
+		NoteConnectionItemMap:  NewNoteConnectionItemMapClient(cfg),
+		NoteConnectionJob:      NewNoteConnectionJobClient(cfg),
+		NoteLink:               NewNoteLinkClient(cfg),
+		Tag:                    NewTagClient(cfg),
+		User:                   NewUserClient(cfg),
+		Whiteboard:             NewWhiteboardClient(cfg),
 	}, nil
 }
 
@@ -289,10 +319,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Attachment, c.BackupConfig, c.BackupTarget, c.BackupTask, c.ExcalidrawLibrary,
-		c.Folder, c.Font, c.ImportItem, c.ImportJob, c.MCPImage, c.MCPToken, c.Note,
-		c.NoteConnectionAccount, c.NoteConnectionItemMap, c.NoteConnectionJob,
-		c.NoteLink, c.Tag, c.User, c.Whiteboard,
+		c.Attachment, c.BackupConfig, c.BackupDrill, c.BackupPin, c.BackupRun,
+		c.BackupTarget, c.BackupTask, c.ExcalidrawLibrary, c.Folder, c.Font,
+		c.ImportItem, c.ImportJob, c.LinkRewrite, c.MCPImage, c.MCPToken, c.Note,
+		c.NoteConnectionAccount, c.NoteConnectionConflict, c.NoteConnectionItemMap,
+		c.NoteConnectionJob, c.NoteLink, c.Tag, c.User, c.Whiteboard,
 	} {
 		n.Use(hooks...)
 	}
@@ -302,10 +333,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Attachment, c.BackupConfig, c.BackupTarget, c.BackupTask, c.ExcalidrawLibrary,
-		c.Folder, c.Font, c.ImportItem, c.ImportJob, c.MCPImage, c.MCPToken, c.Note,
-		c.NoteConnectionAccount, c.NoteConnectionItemMap, c.NoteConnectionJob,
-		c.NoteLink, c.Tag, c.User, c.Whiteboard,
+		c.Attachment, c.BackupConfig, c.BackupDrill, c.BackupPin, c.BackupRun,
+		c.BackupTarget, c.BackupTask, c.ExcalidrawLibrary, c.Folder, c.Font,
+		c.ImportItem, c.ImportJob, c.LinkRewrite, c.MCPImage, c.MCPToken, c.Note,
+		c.NoteConnectionAccount, c.NoteConnectionConflict, c.NoteConnectionItemMap,
+		c.NoteConnectionJob, c.NoteLink, c.Tag, c.User, c.Whiteboard,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -318,6 +350,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Attachment.mutate(ctx, m)
 	case *BackupConfigMutation:
 		return c.BackupConfig.mutate(ctx, m)
+	case *BackupDrillMutation:
+		return c.BackupDrill.mutate(ctx, m)
+	case *BackupPinMutation:
+		return c.BackupPin.mutate(ctx, m)
+	case *BackupRunMutation:
+		return c.BackupRun.mutate(ctx, m)
 	case *BackupTargetMutation:
 		return c.BackupTarget.mutate(ctx, m)
 	case *BackupTaskMutation:
@@ -332,6 +370,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ImportItem.mutate(ctx, m)
 	case *ImportJobMutation:
 		return c.ImportJob.mutate(ctx, m)
+	case *LinkRewriteMutation:
+		return c.LinkRewrite.mutate(ctx, m)
 	case *MCPImageMutation:
 		return c.MCPImage.mutate(ctx, m)
 	case *MCPTokenMutation:
@@ -340,6 +380,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Note.mutate(ctx, m)
 	case *NoteConnectionAccountMutation:
 		return c.NoteConnectionAccount.mutate(ctx, m)
+	case *NoteConnectionConflictMutation:
+		return c.NoteConnectionConflict.mutate(ctx, m)
 	case *NoteConnectionItemMapMutation:
 		return c.NoteConnectionItemMap.mutate(ctx, m)
 	case *NoteConnectionJobMutation:
@@ -655,6 +697,469 @@ func (c *BackupConfigClient) mutate(ctx context.Context, m *BackupConfigMutation
 	}
 }
 
+// BackupDrillClient is a client for the BackupDrill schema.
+type BackupDrillClient struct {
+	config
+}
+
+// NewBackupDrillClient returns a client for the BackupDrill from the given config.
+func NewBackupDrillClient(c config) *BackupDrillClient {
+	return &BackupDrillClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `backupdrill.Hooks(f(g(h())))`.
+func (c *BackupDrillClient) Use(hooks ...Hook) {
+	c.hooks.BackupDrill = append(c.hooks.BackupDrill, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `backupdrill.Intercept(f(g(h())))`.
+func (c *BackupDrillClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BackupDrill = append(c.inters.BackupDrill, interceptors...)
+}
+
+// Create returns a builder for creating a BackupDrill entity.
+func (c *BackupDrillClient) Create() *BackupDrillCreate {
+	mutation := newBackupDrillMutation(c.config, OpCreate)
+	return &BackupDrillCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BackupDrill entities.
+func (c *BackupDrillClient) CreateBulk(builders ...*BackupDrillCreate) *BackupDrillCreateBulk {
+	return &BackupDrillCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BackupDrillClient) MapCreateBulk(slice any, setFunc func(*BackupDrillCreate, int)) *BackupDrillCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BackupDrillCreateBulk{err: fmt.Errorf("calling to BackupDrillClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BackupDrillCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BackupDrillCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BackupDrill.
+func (c *BackupDrillClient) Update() *BackupDrillUpdate {
+	mutation := newBackupDrillMutation(c.config, OpUpdate)
+	return &BackupDrillUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BackupDrillClient) UpdateOne(_m *BackupDrill) *BackupDrillUpdateOne {
+	mutation := newBackupDrillMutation(c.config, OpUpdateOne, withBackupDrill(_m))
+	return &BackupDrillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BackupDrillClient) UpdateOneID(id int) *BackupDrillUpdateOne {
+	mutation := newBackupDrillMutation(c.config, OpUpdateOne, withBackupDrillID(id))
+	return &BackupDrillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BackupDrill.
+func (c *BackupDrillClient) Delete() *BackupDrillDelete {
+	mutation := newBackupDrillMutation(c.config, OpDelete)
+	return &BackupDrillDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BackupDrillClient) DeleteOne(_m *BackupDrill) *BackupDrillDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BackupDrillClient) DeleteOneID(id int) *BackupDrillDeleteOne {
+	builder := c.Delete().Where(backupdrill.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BackupDrillDeleteOne{builder}
+}
+
+// Query returns a query builder for BackupDrill.
+func (c *BackupDrillClient) Query() *BackupDrillQuery {
+	return &BackupDrillQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBackupDrill},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BackupDrill entity by its id.
+func (c *BackupDrillClient) Get(ctx context.Context, id int) (*BackupDrill, error) {
+	return c.Query().Where(backupdrill.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BackupDrillClient) GetX(ctx context.Context, id int) *BackupDrill {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTarget queries the target edge of a BackupDrill.
+func (c *BackupDrillClient) QueryTarget(_m *BackupDrill) *BackupTargetQuery {
+	query := (&BackupTargetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backupdrill.Table, backupdrill.FieldID, id),
+			sqlgraph.To(backuptarget.Table, backuptarget.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backupdrill.TargetTable, backupdrill.TargetColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BackupDrillClient) Hooks() []Hook {
+	return c.hooks.BackupDrill
+}
+
+// Interceptors returns the client interceptors.
+func (c *BackupDrillClient) Interceptors() []Interceptor {
+	return c.inters.BackupDrill
+}
+
+func (c *BackupDrillClient) mutate(ctx context.Context, m *BackupDrillMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BackupDrillCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BackupDrillUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BackupDrillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BackupDrillDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BackupDrill mutation op: %q", m.Op())
+	}
+}
+
+// BackupPinClient is a client for the BackupPin schema.
+type BackupPinClient struct {
+	config
+}
+
+// NewBackupPinClient returns a client for the BackupPin from the given config.
+func NewBackupPinClient(c config) *BackupPinClient {
+	return &BackupPinClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `backuppin.Hooks(f(g(h())))`.
+func (c *BackupPinClient) Use(hooks ...Hook) {
+	c.hooks.BackupPin = append(c.hooks.BackupPin, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `backuppin.Intercept(f(g(h())))`.
+func (c *BackupPinClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BackupPin = append(c.inters.BackupPin, interceptors...)
+}
+
+// Create returns a builder for creating a BackupPin entity.
+func (c *BackupPinClient) Create() *BackupPinCreate {
+	mutation := newBackupPinMutation(c.config, OpCreate)
+	return &BackupPinCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BackupPin entities.
+func (c *BackupPinClient) CreateBulk(builders ...*BackupPinCreate) *BackupPinCreateBulk {
+	return &BackupPinCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BackupPinClient) MapCreateBulk(slice any, setFunc func(*BackupPinCreate, int)) *BackupPinCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BackupPinCreateBulk{err: fmt.Errorf("calling to BackupPinClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BackupPinCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BackupPinCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BackupPin.
+func (c *BackupPinClient) Update() *BackupPinUpdate {
+	mutation := newBackupPinMutation(c.config, OpUpdate)
+	return &BackupPinUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BackupPinClient) UpdateOne(_m *BackupPin) *BackupPinUpdateOne {
+	mutation := newBackupPinMutation(c.config, OpUpdateOne, withBackupPin(_m))
+	return &BackupPinUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BackupPinClient) UpdateOneID(id int) *BackupPinUpdateOne {
+	mutation := newBackupPinMutation(c.config, OpUpdateOne, withBackupPinID(id))
+	return &BackupPinUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BackupPin.
+func (c *BackupPinClient) Delete() *BackupPinDelete {
+	mutation := newBackupPinMutation(c.config, OpDelete)
+	return &BackupPinDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BackupPinClient) DeleteOne(_m *BackupPin) *BackupPinDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BackupPinClient) DeleteOneID(id int) *BackupPinDeleteOne {
+	builder := c.Delete().Where(backuppin.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BackupPinDeleteOne{builder}
+}
+
+// Query returns a query builder for BackupPin.
+func (c *BackupPinClient) Query() *BackupPinQuery {
+	return &BackupPinQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBackupPin},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BackupPin entity by its id.
+func (c *BackupPinClient) Get(ctx context.Context, id int) (*BackupPin, error) {
+	return c.Query().Where(backuppin.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BackupPinClient) GetX(ctx context.Context, id int) *BackupPin {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTarget queries the target edge of a BackupPin.
+func (c *BackupPinClient) QueryTarget(_m *BackupPin) *BackupTargetQuery {
+	query := (&BackupTargetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuppin.Table, backuppin.FieldID, id),
+			sqlgraph.To(backuptarget.Table, backuptarget.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backuppin.TargetTable, backuppin.TargetColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BackupPinClient) Hooks() []Hook {
+	return c.hooks.BackupPin
+}
+
+// Interceptors returns the client interceptors.
+func (c *BackupPinClient) Interceptors() []Interceptor {
+	return c.inters.BackupPin
+}
+
+func (c *BackupPinClient) mutate(ctx context.Context, m *BackupPinMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BackupPinCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BackupPinUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BackupPinUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BackupPinDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BackupPin mutation op: %q", m.Op())
+	}
+}
+
+// BackupRunClient is a client for the BackupRun schema.
+type BackupRunClient struct {
+	config
+}
+
+// NewBackupRunClient returns a client for the BackupRun from the given config.
+func NewBackupRunClient(c config) *BackupRunClient {
+	return &BackupRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `backuprun.Hooks(f(g(h())))`.
+func (c *BackupRunClient) Use(hooks ...Hook) {
+	c.hooks.BackupRun = append(c.hooks.BackupRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `backuprun.Intercept(f(g(h())))`.
+func (c *BackupRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BackupRun = append(c.inters.BackupRun, interceptors...)
+}
+
+// Create returns a builder for creating a BackupRun entity.
+func (c *BackupRunClient) Create() *BackupRunCreate {
+	mutation := newBackupRunMutation(c.config, OpCreate)
+	return &BackupRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BackupRun entities.
+func (c *BackupRunClient) CreateBulk(builders ...*BackupRunCreate) *BackupRunCreateBulk {
+	return &BackupRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BackupRunClient) MapCreateBulk(slice any, setFunc func(*BackupRunCreate, int)) *BackupRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BackupRunCreateBulk{err: fmt.Errorf("calling to BackupRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BackupRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BackupRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BackupRun.
+func (c *BackupRunClient) Update() *BackupRunUpdate {
+	mutation := newBackupRunMutation(c.config, OpUpdate)
+	return &BackupRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BackupRunClient) UpdateOne(_m *BackupRun) *BackupRunUpdateOne {
+	mutation := newBackupRunMutation(c.config, OpUpdateOne, withBackupRun(_m))
+	return &BackupRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BackupRunClient) UpdateOneID(id int) *BackupRunUpdateOne {
+	mutation := newBackupRunMutation(c.config, OpUpdateOne, withBackupRunID(id))
+	return &BackupRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BackupRun.
+func (c *BackupRunClient) Delete() *BackupRunDelete {
+	mutation := newBackupRunMutation(c.config, OpDelete)
+	return &BackupRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BackupRunClient) DeleteOne(_m *BackupRun) *BackupRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BackupRunClient) DeleteOneID(id int) *BackupRunDeleteOne {
+	builder := c.Delete().Where(backuprun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BackupRunDeleteOne{builder}
+}
+
+// Query returns a query builder for BackupRun.
+func (c *BackupRunClient) Query() *BackupRunQuery {
+	return &BackupRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBackupRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BackupRun entity by its id.
+func (c *BackupRunClient) Get(ctx context.Context, id int) (*BackupRun, error) {
+	return c.Query().Where(backuprun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BackupRunClient) GetX(ctx context.Context, id int) *BackupRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a BackupRun.
+func (c *BackupRunClient) QueryTask(_m *BackupRun) *BackupTaskQuery {
+	query := (&BackupTaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, id),
+			sqlgraph.To(backuptask.Table, backuptask.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backuprun.TaskTable, backuprun.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTarget queries the target edge of a BackupRun.
+func (c *BackupRunClient) QueryTarget(_m *BackupRun) *BackupTargetQuery {
+	query := (&BackupTargetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, id),
+			sqlgraph.To(backuptarget.Table, backuptarget.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backuprun.TargetTable, backuprun.TargetColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BackupRunClient) Hooks() []Hook {
+	return c.hooks.BackupRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *BackupRunClient) Interceptors() []Interceptor {
+	return c.inters.BackupRun
+}
+
+func (c *BackupRunClient) mutate(ctx context.Context, m *BackupRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BackupRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BackupRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BackupRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BackupRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BackupRun mutation op: %q", m.Op())
+	}
+}
+
 // BackupTargetClient is a client for the BackupTarget schema.
 type BackupTargetClient struct {
 	config
@@ -749,29 +1254,77 @@ func (c *BackupTargetClient) Query() *BackupTargetQuery {
 	}
 }
 
-// Get returns a BackupTarget entity by its id.
-func (c *BackupTargetClient) Get(ctx context.Context, id int) (*BackupTarget, error) {
-	return c.Query().Where(backuptarget.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *BackupTargetClient) GetX(ctx context.Context, id int) *BackupTarget {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
+// Get returns a BackupTarget entity by its id.
+func (c *BackupTargetClient) Get(ctx context.Context, id int) (*BackupTarget, error) {
+	return c.Query().Where(backuptarget.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BackupTargetClient) GetX(ctx context.Context, id int) *BackupTarget {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTasks queries the tasks edge of a BackupTarget.
+func (c *BackupTargetClient) QueryTasks(_m *BackupTarget) *BackupTaskQuery {
+	query := (&BackupTaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuptarget.Table, backuptarget.FieldID, id),
+			sqlgraph.To(backuptask.Table, backuptask.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, backuptarget.TasksTable, backuptarget.TasksPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPins queries the pins edge of a BackupTarget.
+func (c *BackupTargetClient) QueryPins(_m *BackupTarget) *BackupPinQuery {
+	query := (&BackupPinClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuptarget.Table, backuptarget.FieldID, id),
+			sqlgraph.To(backuppin.Table, backuppin.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuptarget.PinsTable, backuptarget.PinsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRuns queries the runs edge of a BackupTarget.
+func (c *BackupTargetClient) QueryRuns(_m *BackupTarget) *BackupRunQuery {
+	query := (&BackupRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuptarget.Table, backuptarget.FieldID, id),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuptarget.RunsTable, backuptarget.RunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
 	}
-	return obj
+	return query
 }
 
-// QueryTasks queries the tasks edge of a BackupTarget.
-func (c *BackupTargetClient) QueryTasks(_m *BackupTarget) *BackupTaskQuery {
-	query := (&BackupTaskClient{config: c.config}).Query()
+// QueryDrills queries the drills edge of a BackupTarget.
+func (c *BackupTargetClient) QueryDrills(_m *BackupTarget) *BackupDrillQuery {
+	query := (&BackupDrillClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(backuptarget.Table, backuptarget.FieldID, id),
-			sqlgraph.To(backuptask.Table, backuptask.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, backuptarget.TasksTable, backuptarget.TasksPrimaryKey...),
+			sqlgraph.To(backupdrill.Table, backupdrill.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuptarget.DrillsTable, backuptarget.DrillsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -928,6 +1481,22 @@ func (c *BackupTaskClient) QueryTargets(_m *BackupTask) *BackupTargetQuery {
 	return query
 }
 
+// QueryRuns queries the runs edge of a BackupTask.
+func (c *BackupTaskClient) QueryRuns(_m *BackupTask) *BackupRunQuery {
+	query := (&BackupRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuptask.Table, backuptask.FieldID, id),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuptask.RunsTable, backuptask.RunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *BackupTaskClient) Hooks() []Hook {
 	return c.hooks.BackupTask
@@ -1762,6 +2331,155 @@ func (c *ImportJobClient) mutate(ctx context.Context, m *ImportJobMutation) (Val
 	}
 }
 
+// LinkRewriteClient is a client for the LinkRewrite schema.
+type LinkRewriteClient struct {
+	config
+}
+
+// NewLinkRewriteClient returns a client for the LinkRewrite from the given config.
+func NewLinkRewriteClient(c config) *LinkRewriteClient {
+	return &LinkRewriteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `linkrewrite.Hooks(f(g(h())))`.
+func (c *LinkRewriteClient) Use(hooks ...Hook) {
+	c.hooks.LinkRewrite = append(c.hooks.LinkRewrite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `linkrewrite.Intercept(f(g(h())))`.
+func (c *LinkRewriteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LinkRewrite = append(c.inters.LinkRewrite, interceptors...)
+}
+
+// Create returns a builder for creating a LinkRewrite entity.
+func (c *LinkRewriteClient) Create() *LinkRewriteCreate {
+	mutation := newLinkRewriteMutation(c.config, OpCreate)
+	return &LinkRewriteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LinkRewrite entities.
+func (c *LinkRewriteClient) CreateBulk(builders ...*LinkRewriteCreate) *LinkRewriteCreateBulk {
+	return &LinkRewriteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LinkRewriteClient) MapCreateBulk(slice any, setFunc func(*LinkRewriteCreate, int)) *LinkRewriteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LinkRewriteCreateBulk{err: fmt.Errorf("calling to LinkRewriteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LinkRewriteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LinkRewriteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LinkRewrite.
+func (c *LinkRewriteClient) Update() *LinkRewriteUpdate {
+	mutation := newLinkRewriteMutation(c.config, OpUpdate)
+	return &LinkRewriteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LinkRewriteClient) UpdateOne(_m *LinkRewrite) *LinkRewriteUpdateOne {
+	mutation := newLinkRewriteMutation(c.config, OpUpdateOne, withLinkRewrite(_m))
+	return &LinkRewriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LinkRewriteClient) UpdateOneID(id uuid.UUID) *LinkRewriteUpdateOne {
+	mutation := newLinkRewriteMutation(c.config, OpUpdateOne, withLinkRewriteID(id))
+	return &LinkRewriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LinkRewrite.
+func (c *LinkRewriteClient) Delete() *LinkRewriteDelete {
+	mutation := newLinkRewriteMutation(c.config, OpDelete)
+	return &LinkRewriteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LinkRewriteClient) DeleteOne(_m *LinkRewrite) *LinkRewriteDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LinkRewriteClient) DeleteOneID(id uuid.UUID) *LinkRewriteDeleteOne {
+	builder := c.Delete().Where(linkrewrite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LinkRewriteDeleteOne{builder}
+}
+
+// Query returns a query builder for LinkRewrite.
+func (c *LinkRewriteClient) Query() *LinkRewriteQuery {
+	return &LinkRewriteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLinkRewrite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LinkRewrite entity by its id.
+func (c *LinkRewriteClient) Get(ctx context.Context, id uuid.UUID) (*LinkRewrite, error) {
+	return c.Query().Where(linkrewrite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LinkRewriteClient) GetX(ctx context.Context, id uuid.UUID) *LinkRewrite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a LinkRewrite.
+func (c *LinkRewriteClient) QueryUser(_m *LinkRewrite) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkrewrite.Table, linkrewrite.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, linkrewrite.UserTable, linkrewrite.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LinkRewriteClient) Hooks() []Hook {
+	return c.hooks.LinkRewrite
+}
+
+// Interceptors returns the client interceptors.
+func (c *LinkRewriteClient) Interceptors() []Interceptor {
+	return c.inters.LinkRewrite
+}
+
+func (c *LinkRewriteClient) mutate(ctx context.Context, m *LinkRewriteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LinkRewriteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LinkRewriteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LinkRewriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LinkRewriteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LinkRewrite mutation op: %q", m.Op())
+	}
+}
+
 // MCPImageClient is a client for the MCPImage schema.
 type MCPImageClient struct {
 	config
@@ -2296,6 +3014,22 @@ func (c *NoteClient) QueryConnectionJobs(_m *Note) *NoteConnectionJobQuery {
 	return query
 }
 
+// QueryConnectionConflicts queries the connection_conflicts edge of a Note.
+func (c *NoteClient) QueryConnectionConflicts(_m *Note) *NoteConnectionConflictQuery {
+	query := (&NoteConnectionConflictClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(noteconnectionconflict.Table, noteconnectionconflict.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.ConnectionConflictsTable, note.ConnectionConflictsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryTags queries the tags edge of a Note.
 func (c *NoteClient) QueryTags(_m *Note) *TagQuery {
 	query := (&TagClient{config: c.config}).Query()
@@ -2493,6 +3227,22 @@ func (c *NoteConnectionAccountClient) QueryJobs(_m *NoteConnectionAccount) *Note
 	return query
 }
 
+// QueryConflicts queries the conflicts edge of a NoteConnectionAccount.
+func (c *NoteConnectionAccountClient) QueryConflicts(_m *NoteConnectionAccount) *NoteConnectionConflictQuery {
+	query := (&NoteConnectionConflictClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(noteconnectionaccount.Table, noteconnectionaccount.FieldID, id),
+			sqlgraph.To(noteconnectionconflict.Table, noteconnectionconflict.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, noteconnectionaccount.ConflictsTable, noteconnectionaccount.ConflictsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *NoteConnectionAccountClient) Hooks() []Hook {
 	return c.hooks.NoteConnectionAccount
@@ -2518,6 +3268,171 @@ func (c *NoteConnectionAccountClient) mutate(ctx context.Context, m *NoteConnect
 	}
 }
 
+// NoteConnectionConflictClient is a client for the NoteConnectionConflict schema.
+type NoteConnectionConflictClient struct {
+	config
+}
+
+// NewNoteConnectionConflictClient returns a client for the NoteConnectionConflict from the given config.
+func NewNoteConnectionConflictClient(c config) *NoteConnectionConflictClient {
+	return &NoteConnectionConflictClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `noteconnectionconflict.Hooks(f(g(h())))`.
+func (c *NoteConnectionConflictClient) Use(hooks ...Hook) {
+	c.hooks.NoteConnectionConflict = append(c.hooks.NoteConnectionConflict, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `noteconnectionconflict.Intercept(f(g(h())))`.
+func (c *NoteConnectionConflictClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NoteConnectionConflict = append(c.inters.NoteConnectionConflict, interceptors...)
+}
+
+// Create returns a builder for creating a NoteConnectionConflict entity.
+func (c *NoteConnectionConflictClient) Create() *NoteConnectionConflictCreate {
+	mutation := newNoteConnectionConflictMutation(c.config, OpCreate)
+	return &NoteConnectionConflictCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NoteConnectionConflict entities.
+func (c *NoteConnectionConflictClient) CreateBulk(builders ...*NoteConnectionConflictCreate) *NoteConnectionConflictCreateBulk {
+	return &NoteConnectionConflictCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NoteConnectionConflictClient) MapCreateBulk(slice any, setFunc func(*NoteConnectionConflictCreate, int)) *NoteConnectionConflictCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NoteConnectionConflictCreateBulk{err: fmt.Errorf("calling to NoteConnectionConflictClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NoteConnectionConflictCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NoteConnectionConflictCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NoteConnectionConflict.
+func (c *NoteConnectionConflictClient) Update() *NoteConnectionConflictUpdate {
+	mutation := newNoteConnectionConflictMutation(c.config, OpUpdate)
+	return &NoteConnectionConflictUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NoteConnectionConflictClient) UpdateOne(_m *NoteConnectionConflict) *NoteConnectionConflictUpdateOne {
+	mutation := newNoteConnectionConflictMutation(c.config, OpUpdateOne, withNoteConnectionConflict(_m))
+	return &NoteConnectionConflictUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NoteConnectionConflictClient) UpdateOneID(id int) *NoteConnectionConflictUpdateOne {
+	mutation := newNoteConnectionConflictMutation(c.config, OpUpdateOne, withNoteConnectionConflictID(id))
+	return &NoteConnectionConflictUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NoteConnectionConflict.
+func (c *NoteConnectionConflictClient) Delete() *NoteConnectionConflictDelete {
+	mutation := newNoteConnectionConflictMutation(c.config, OpDelete)
+	return &NoteConnectionConflictDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NoteConnectionConflictClient) DeleteOne(_m *NoteConnectionConflict) *NoteConnectionConflictDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NoteConnectionConflictClient) DeleteOneID(id int) *NoteConnectionConflictDeleteOne {
+	builder := c.Delete().Where(noteconnectionconflict.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NoteConnectionConflictDeleteOne{builder}
+}
+
+// Query returns a query builder for NoteConnectionConflict.
+func (c *NoteConnectionConflictClient) Query() *NoteConnectionConflictQuery {
+	return &NoteConnectionConflictQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNoteConnectionConflict},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NoteConnectionConflict entity by its id.
+func (c *NoteConnectionConflictClient) Get(ctx context.Context, id int) (*NoteConnectionConflict, error) {
+	return c.Query().Where(noteconnectionconflict.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NoteConnectionConflictClient) GetX(ctx context.Context, id int) *NoteConnectionConflict {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a NoteConnectionConflict.
+func (c *NoteConnectionConflictClient) QueryAccount(_m *NoteConnectionConflict) *NoteConnectionAccountQuery {
+	query := (&NoteConnectionAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(noteconnectionconflict.Table, noteconnectionconflict.FieldID, id),
+			sqlgraph.To(noteconnectionaccount.Table, noteconnectionaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, noteconnectionconflict.AccountTable, noteconnectionconflict.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNote queries the note edge of a NoteConnectionConflict.
+func (c *NoteConnectionConflictClient) QueryNote(_m *NoteConnectionConflict) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(noteconnectionconflict.Table, noteconnectionconflict.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, noteconnectionconflict.NoteTable, noteconnectionconflict.NoteColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NoteConnectionConflictClient) Hooks() []Hook {
+	return c.hooks.NoteConnectionConflict
+}
+
+// Interceptors returns the client interceptors.
+func (c *NoteConnectionConflictClient) Interceptors() []Interceptor {
+	return c.inters.NoteConnectionConflict
+}
+
+func (c *NoteConnectionConflictClient) mutate(ctx context.Context, m *NoteConnectionConflictMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NoteConnectionConflictCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NoteConnectionConflictUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NoteConnectionConflictUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NoteConnectionConflictDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NoteConnectionConflict mutation op: %q", m.Op())
+	}
+}
+
 // NoteConnectionItemMapClient is a client for the NoteConnectionItemMap schema.
 type NoteConnectionItemMapClient struct {
 	config
@@ -3526,6 +4441,22 @@ func (c *UserClient) QueryNoteLinks(_m *User) *NoteLinkQuery {
 	return query
 }
 
+// QueryLinkRewrites queries the link_rewrites edge of a User.
+func (c *UserClient) QueryLinkRewrites(_m *User) *LinkRewriteQuery {
+	query := (&LinkRewriteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(linkrewrite.Table, linkrewrite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LinkRewritesTable, user.LinkRewritesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -3719,15 +4650,17 @@ func (c *WhiteboardClient) mutate(ctx context.Context, m *WhiteboardMutation) (V
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Attachment, BackupConfig, BackupTarget, BackupTask, ExcalidrawLibrary, Folder,
-		Font, ImportItem, ImportJob, MCPImage, MCPToken, Note, NoteConnectionAccount,
-		NoteConnectionItemMap, NoteConnectionJob, NoteLink, Tag, User,
-		Whiteboard []ent.Hook
+		Attachment, BackupConfig, BackupDrill, BackupPin, BackupRun, BackupTarget,
+		BackupTask, ExcalidrawLibrary, Folder, Font, ImportItem, ImportJob,
+		LinkRewrite, MCPImage, MCPToken, Note, NoteConnectionAccount,
+		NoteConnectionConflict, NoteConnectionItemMap, NoteConnectionJob, NoteLink,
+		Tag, User, Whiteboard []ent.Hook
 	}
 	inters struct {
-		Attachment, BackupConfig, BackupTarget, BackupTask, ExcalidrawLibrary, Folder,
-		Font, ImportItem, ImportJob, MCPImage, MCPToken, Note, NoteConnectionAccount,
-		NoteConnectionItemMap, NoteConnectionJob, NoteLink, Tag, User,
-		Whiteboard []ent.Interceptor
+		Attachment, BackupConfig, BackupDrill, BackupPin, BackupRun, BackupTarget,
+		BackupTask, ExcalidrawLibrary, Folder, Font, ImportItem, ImportJob,
+		LinkRewrite, MCPImage, MCPToken, Note, NoteConnectionAccount,
+		NoteConnectionConflict, NoteConnectionItemMap, NoteConnectionJob, NoteLink,
+		Tag, User, Whiteboard []ent.Interceptor
 	}
 )
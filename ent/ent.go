@@ -9,6 +9,9 @@ import (
 	"reflect"
 	"smarticky/ent/attachment"
 	"smarticky/ent/backupconfig"
+	"smarticky/ent/backupdrill"
+	"smarticky/ent/backuppin"
+	"smarticky/ent/backuprun"
 	"smarticky/ent/backuptarget"
 	"smarticky/ent/backuptask"
 	"smarticky/ent/excalidrawlibrary"
@@ -16,10 +19,12 @@ import (
 	"smarticky/ent/font"
 	"smarticky/ent/importitem"
 	"smarticky/ent/importjob"
+	"smarticky/ent/linkrewrite"
 	"smarticky/ent/mcpimage"
 	"smarticky/ent/mcptoken"
 	"smarticky/ent/note"
 	"smarticky/ent/noteconnectionaccount"
+	"smarticky/ent/noteconnectionconflict"
 	"smarticky/ent/noteconnectionitemmap"
 	"smarticky/ent/noteconnectionjob"
 	"smarticky/ent/notelink"
@@ -91,25 +96,30 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			attachment.Table:            attachment.ValidColumn,
-			backupconfig.Table:          backupconfig.ValidColumn,
-			backuptarget.Table:          backuptarget.ValidColumn,
-			backuptask.Table:            backuptask.ValidColumn,
-			excalidrawlibrary.Table:     excalidrawlibrary.ValidColumn,
-			folder.Table:                folder.ValidColumn,
-			font.Table:                  font.ValidColumn,
-			importitem.Table:            importitem.ValidColumn,
-			importjob.Table:             importjob.ValidColumn,
-			mcpimage.Table:              mcpimage.ValidColumn,
-			mcptoken.Table:              mcptoken.ValidColumn,
-			note.Table:                  note.ValidColumn,
-			noteconnectionaccount.Table: noteconnectionaccount.ValidColumn,
-			noteconnectionitemmap.Table: noteconnectionitemmap.ValidColumn,
-			noteconnectionjob.Table:     noteconnectionjob.ValidColumn,
-			notelink.Table:              notelink.ValidColumn,
-			tag.Table:                   tag.ValidColumn,
-			user.Table:                  user.ValidColumn,
-			whiteboard.Table:            whiteboard.ValidColumn,
+			attachment.Table:             attachment.ValidColumn,
+			backupconfig.Table:           backupconfig.ValidColumn,
+			backupdrill.Table:            backupdrill.ValidColumn,
+			backuppin.Table:              backuppin.ValidColumn,
+			backuprun.Table:              backuprun.ValidColumn,
+			backuptarget.Table:           backuptarget.ValidColumn,
+			backuptask.Table:             backuptask.ValidColumn,
+			excalidrawlibrary.Table:      excalidrawlibrary.ValidColumn,
+			folder.Table:                 folder.ValidColumn,
+			font.Table:                   font.ValidColumn,
+			importitem.Table:             importitem.ValidColumn,
+			importjob.Table:              importjob.ValidColumn,
+			linkrewrite.Table:            linkrewrite.ValidColumn,
+			mcpimage.Table:               mcpimage.ValidColumn,
+			mcptoken.Table:               mcptoken.ValidColumn,
+			note.Table:                   note.ValidColumn,
+			noteconnectionaccount.Table:  noteconnectionaccount.ValidColumn,
+			noteconnectionconflict.Table: noteconnectionconflict.ValidColumn,
+			noteconnectionitemmap.Table:  noteconnectionitemmap.ValidColumn,
+			noteconnectionjob.Table:      noteconnectionjob.ValidColumn,
+			notelink.Table:               notelink.ValidColumn,
+			tag.Table:                    tag.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			whiteboard.Table:             whiteboard.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -32,6 +32,42 @@ func (f BackupConfigFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BackupConfigMutation", m)
 }
 
+// The BackupDrillFunc type is an adapter to allow the use of ordinary
+// function as BackupDrill mutator.
+type BackupDrillFunc func(context.Context, *ent.BackupDrillMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BackupDrillFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BackupDrillMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BackupDrillMutation", m)
+}
+
+// The BackupPinFunc type is an adapter to allow the use of ordinary
+// function as BackupPin mutator.
+type BackupPinFunc func(context.Context, *ent.BackupPinMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BackupPinFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BackupPinMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BackupPinMutation", m)
+}
+
+// The BackupRunFunc type is an adapter to allow the use of ordinary
+// function as BackupRun mutator.
+type BackupRunFunc func(context.Context, *ent.BackupRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BackupRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BackupRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BackupRunMutation", m)
+}
+
 // The BackupTargetFunc type is an adapter to allow the use of ordinary
 // function as BackupTarget mutator.
 type BackupTargetFunc func(context.Context, *ent.BackupTargetMutation) (ent.Value, error)
@@ -116,6 +152,18 @@ func (f ImportJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ImportJobMutation", m)
 }
 
+// The LinkRewriteFunc type is an adapter to allow the use of ordinary
+// function as LinkRewrite mutator.
+type LinkRewriteFunc func(context.Context, *ent.LinkRewriteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LinkRewriteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LinkRewriteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LinkRewriteMutation", m)
+}
+
 // The MCPImageFunc type is an adapter to allow the use of ordinary
 // function as MCPImage mutator.
 type MCPImageFunc func(context.Context, *ent.MCPImageMutation) (ent.Value, error)
@@ -164,6 +212,18 @@ func (f NoteConnectionAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NoteConnectionAccountMutation", m)
 }
 
+// The NoteConnectionConflictFunc type is an adapter to allow the use of ordinary
+// function as NoteConnectionConflict mutator.
+type NoteConnectionConflictFunc func(context.Context, *ent.NoteConnectionConflictMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NoteConnectionConflictFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NoteConnectionConflictMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NoteConnectionConflictMutation", m)
+}
+
 // The NoteConnectionItemMapFunc type is an adapter to allow the use of ordinary
 // function as NoteConnectionItemMap mutator.
 type NoteConnectionItemMapFunc func(context.Context, *ent.NoteConnectionItemMapMutation) (ent.Value, error)
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"smarticky/ent/linkrewrite"
+	"smarticky/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// LinkRewrite is the model entity for the LinkRewrite schema.
+type LinkRewrite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// NoteID holds the value of the "note_id" field.
+	NoteID uuid.UUID `json:"note_id,omitempty"`
+	// OldTitle holds the value of the "old_title" field.
+	OldTitle string `json:"old_title,omitempty"`
+	// NewTitle holds the value of the "new_title" field.
+	NewTitle string `json:"new_title,omitempty"`
+	// Status holds the value of the "status" field.
+	Status linkrewrite.Status `json:"status,omitempty"`
+	// RewrittenCount holds the value of the "rewritten_count" field.
+	RewrittenCount int `json:"rewritten_count,omitempty"`
+	// ChangesJSON holds the value of the "changes_json" field.
+	ChangesJSON string `json:"changes_json,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UndoneAt holds the value of the "undone_at" field.
+	UndoneAt *time.Time `json:"undone_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LinkRewriteQuery when eager-loading is set.
+	Edges        LinkRewriteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LinkRewriteEdges holds the relations/edges for other nodes in the graph.
+type LinkRewriteEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LinkRewriteEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LinkRewrite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case linkrewrite.FieldUserID, linkrewrite.FieldRewrittenCount:
+			values[i] = new(sql.NullInt64)
+		case linkrewrite.FieldOldTitle, linkrewrite.FieldNewTitle, linkrewrite.FieldStatus, linkrewrite.FieldChangesJSON:
+			values[i] = new(sql.NullString)
+		case linkrewrite.FieldCreatedAt, linkrewrite.FieldUndoneAt:
+			values[i] = new(sql.NullTime)
+		case linkrewrite.FieldID, linkrewrite.FieldNoteID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LinkRewrite fields.
+func (_m *LinkRewrite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case linkrewrite.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case linkrewrite.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case linkrewrite.FieldNoteID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field note_id", values[i])
+			} else if value != nil {
+				_m.NoteID = *value
+			}
+		case linkrewrite.FieldOldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_title", values[i])
+			} else if value.Valid {
+				_m.OldTitle = value.String
+			}
+		case linkrewrite.FieldNewTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_title", values[i])
+			} else if value.Valid {
+				_m.NewTitle = value.String
+			}
+		case linkrewrite.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = linkrewrite.Status(value.String)
+			}
+		case linkrewrite.FieldRewrittenCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rewritten_count", values[i])
+			} else if value.Valid {
+				_m.RewrittenCount = int(value.Int64)
+			}
+		case linkrewrite.FieldChangesJSON:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field changes_json", values[i])
+			} else if value.Valid {
+				_m.ChangesJSON = value.String
+			}
+		case linkrewrite.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case linkrewrite.FieldUndoneAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field undone_at", values[i])
+			} else if value.Valid {
+				_m.UndoneAt = new(time.Time)
+				*_m.UndoneAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LinkRewrite.
+// This includes values selected through modifiers, order, etc.
+func (_m *LinkRewrite) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the LinkRewrite entity.
+func (_m *LinkRewrite) QueryUser() *UserQuery {
+	return NewLinkRewriteClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this LinkRewrite.
+// Note that you need to call LinkRewrite.Unwrap() before calling this method if this LinkRewrite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LinkRewrite) Update() *LinkRewriteUpdateOne {
+	return NewLinkRewriteClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LinkRewrite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LinkRewrite) Unwrap() *LinkRewrite {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LinkRewrite is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LinkRewrite) String() string {
+	var builder strings.Builder
+	builder.WriteString("LinkRewrite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("note_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NoteID))
+	builder.WriteString(", ")
+	builder.WriteString("old_title=")
+	builder.WriteString(_m.OldTitle)
+	builder.WriteString(", ")
+	builder.WriteString("new_title=")
+	builder.WriteString(_m.NewTitle)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("rewritten_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RewrittenCount))
+	builder.WriteString(", ")
+	builder.WriteString("changes_json=")
+	builder.WriteString(_m.ChangesJSON)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.UndoneAt; v != nil {
+		builder.WriteString("undone_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LinkRewrites is a parsable slice of LinkRewrite.
+type LinkRewrites []*LinkRewrite
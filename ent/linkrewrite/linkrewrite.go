@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package linkrewrite
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the linkrewrite type in the database.
+	Label = "link_rewrite"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldNoteID holds the string denoting the note_id field in the database.
+	FieldNoteID = "note_id"
+	// FieldOldTitle holds the string denoting the old_title field in the database.
+	FieldOldTitle = "old_title"
+	// FieldNewTitle holds the string denoting the new_title field in the database.
+	FieldNewTitle = "new_title"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldRewrittenCount holds the string denoting the rewritten_count field in the database.
+	FieldRewrittenCount = "rewritten_count"
+	// FieldChangesJSON holds the string denoting the changes_json field in the database.
+	FieldChangesJSON = "changes_json"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUndoneAt holds the string denoting the undone_at field in the database.
+	FieldUndoneAt = "undone_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the linkrewrite in the database.
+	Table = "link_rewrites"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "link_rewrites"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for linkrewrite fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldNoteID,
+	FieldOldTitle,
+	FieldNewTitle,
+	FieldStatus,
+	FieldRewrittenCount,
+	FieldChangesJSON,
+	FieldCreatedAt,
+	FieldUndoneAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRewrittenCount holds the default value on creation for the "rewritten_count" field.
+	DefaultRewrittenCount int
+	// DefaultChangesJSON holds the default value on creation for the "changes_json" field.
+	DefaultChangesJSON string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusApplied is the default value of the Status enum.
+const DefaultStatus = StatusApplied
+
+// Status values.
+const (
+	StatusApplied Status = "applied"
+	StatusUndone  Status = "undone"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusApplied, StatusUndone:
+		return nil
+	default:
+		return fmt.Errorf("linkrewrite: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the LinkRewrite queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByNoteID orders the results by the note_id field.
+func ByNoteID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNoteID, opts...).ToFunc()
+}
+
+// ByOldTitle orders the results by the old_title field.
+func ByOldTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOldTitle, opts...).ToFunc()
+}
+
+// ByNewTitle orders the results by the new_title field.
+func ByNewTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewTitle, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByRewrittenCount orders the results by the rewritten_count field.
+func ByRewrittenCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRewrittenCount, opts...).ToFunc()
+}
+
+// ByChangesJSON orders the results by the changes_json field.
+func ByChangesJSON(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangesJSON, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUndoneAt orders the results by the undone_at field.
+func ByUndoneAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUndoneAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
@@ -0,0 +1,540 @@
+// Code generated by ent, DO NOT EDIT.
+
+package linkrewrite
+
+import (
+	"smarticky/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldUserID, v))
+}
+
+// NoteID applies equality check predicate on the "note_id" field. It's identical to NoteIDEQ.
+func NoteID(v uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldNoteID, v))
+}
+
+// OldTitle applies equality check predicate on the "old_title" field. It's identical to OldTitleEQ.
+func OldTitle(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldOldTitle, v))
+}
+
+// NewTitle applies equality check predicate on the "new_title" field. It's identical to NewTitleEQ.
+func NewTitle(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldNewTitle, v))
+}
+
+// RewrittenCount applies equality check predicate on the "rewritten_count" field. It's identical to RewrittenCountEQ.
+func RewrittenCount(v int) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldRewrittenCount, v))
+}
+
+// ChangesJSON applies equality check predicate on the "changes_json" field. It's identical to ChangesJSONEQ.
+func ChangesJSON(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldChangesJSON, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UndoneAt applies equality check predicate on the "undone_at" field. It's identical to UndoneAtEQ.
+func UndoneAt(v time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldUndoneAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NoteIDEQ applies the EQ predicate on the "note_id" field.
+func NoteIDEQ(v uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldNoteID, v))
+}
+
+// NoteIDNEQ applies the NEQ predicate on the "note_id" field.
+func NoteIDNEQ(v uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNEQ(FieldNoteID, v))
+}
+
+// NoteIDIn applies the In predicate on the "note_id" field.
+func NoteIDIn(vs ...uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldIn(FieldNoteID, vs...))
+}
+
+// NoteIDNotIn applies the NotIn predicate on the "note_id" field.
+func NoteIDNotIn(vs ...uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNotIn(FieldNoteID, vs...))
+}
+
+// NoteIDGT applies the GT predicate on the "note_id" field.
+func NoteIDGT(v uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGT(FieldNoteID, v))
+}
+
+// NoteIDGTE applies the GTE predicate on the "note_id" field.
+func NoteIDGTE(v uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGTE(FieldNoteID, v))
+}
+
+// NoteIDLT applies the LT predicate on the "note_id" field.
+func NoteIDLT(v uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLT(FieldNoteID, v))
+}
+
+// NoteIDLTE applies the LTE predicate on the "note_id" field.
+func NoteIDLTE(v uuid.UUID) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLTE(FieldNoteID, v))
+}
+
+// OldTitleEQ applies the EQ predicate on the "old_title" field.
+func OldTitleEQ(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldOldTitle, v))
+}
+
+// OldTitleNEQ applies the NEQ predicate on the "old_title" field.
+func OldTitleNEQ(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNEQ(FieldOldTitle, v))
+}
+
+// OldTitleIn applies the In predicate on the "old_title" field.
+func OldTitleIn(vs ...string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldIn(FieldOldTitle, vs...))
+}
+
+// OldTitleNotIn applies the NotIn predicate on the "old_title" field.
+func OldTitleNotIn(vs ...string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNotIn(FieldOldTitle, vs...))
+}
+
+// OldTitleGT applies the GT predicate on the "old_title" field.
+func OldTitleGT(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGT(FieldOldTitle, v))
+}
+
+// OldTitleGTE applies the GTE predicate on the "old_title" field.
+func OldTitleGTE(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGTE(FieldOldTitle, v))
+}
+
+// OldTitleLT applies the LT predicate on the "old_title" field.
+func OldTitleLT(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLT(FieldOldTitle, v))
+}
+
+// OldTitleLTE applies the LTE predicate on the "old_title" field.
+func OldTitleLTE(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLTE(FieldOldTitle, v))
+}
+
+// OldTitleContains applies the Contains predicate on the "old_title" field.
+func OldTitleContains(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldContains(FieldOldTitle, v))
+}
+
+// OldTitleHasPrefix applies the HasPrefix predicate on the "old_title" field.
+func OldTitleHasPrefix(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldHasPrefix(FieldOldTitle, v))
+}
+
+// OldTitleHasSuffix applies the HasSuffix predicate on the "old_title" field.
+func OldTitleHasSuffix(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldHasSuffix(FieldOldTitle, v))
+}
+
+// OldTitleEqualFold applies the EqualFold predicate on the "old_title" field.
+func OldTitleEqualFold(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEqualFold(FieldOldTitle, v))
+}
+
+// OldTitleContainsFold applies the ContainsFold predicate on the "old_title" field.
+func OldTitleContainsFold(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldContainsFold(FieldOldTitle, v))
+}
+
+// NewTitleEQ applies the EQ predicate on the "new_title" field.
+func NewTitleEQ(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldNewTitle, v))
+}
+
+// NewTitleNEQ applies the NEQ predicate on the "new_title" field.
+func NewTitleNEQ(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNEQ(FieldNewTitle, v))
+}
+
+// NewTitleIn applies the In predicate on the "new_title" field.
+func NewTitleIn(vs ...string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldIn(FieldNewTitle, vs...))
+}
+
+// NewTitleNotIn applies the NotIn predicate on the "new_title" field.
+func NewTitleNotIn(vs ...string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNotIn(FieldNewTitle, vs...))
+}
+
+// NewTitleGT applies the GT predicate on the "new_title" field.
+func NewTitleGT(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGT(FieldNewTitle, v))
+}
+
+// NewTitleGTE applies the GTE predicate on the "new_title" field.
+func NewTitleGTE(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGTE(FieldNewTitle, v))
+}
+
+// NewTitleLT applies the LT predicate on the "new_title" field.
+func NewTitleLT(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLT(FieldNewTitle, v))
+}
+
+// NewTitleLTE applies the LTE predicate on the "new_title" field.
+func NewTitleLTE(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLTE(FieldNewTitle, v))
+}
+
+// NewTitleContains applies the Contains predicate on the "new_title" field.
+func NewTitleContains(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldContains(FieldNewTitle, v))
+}
+
+// NewTitleHasPrefix applies the HasPrefix predicate on the "new_title" field.
+func NewTitleHasPrefix(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldHasPrefix(FieldNewTitle, v))
+}
+
+// NewTitleHasSuffix applies the HasSuffix predicate on the "new_title" field.
+func NewTitleHasSuffix(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldHasSuffix(FieldNewTitle, v))
+}
+
+// NewTitleEqualFold applies the EqualFold predicate on the "new_title" field.
+func NewTitleEqualFold(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEqualFold(FieldNewTitle, v))
+}
+
+// NewTitleContainsFold applies the ContainsFold predicate on the "new_title" field.
+func NewTitleContainsFold(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldContainsFold(FieldNewTitle, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// RewrittenCountEQ applies the EQ predicate on the "rewritten_count" field.
+func RewrittenCountEQ(v int) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldRewrittenCount, v))
+}
+
+// RewrittenCountNEQ applies the NEQ predicate on the "rewritten_count" field.
+func RewrittenCountNEQ(v int) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNEQ(FieldRewrittenCount, v))
+}
+
+// RewrittenCountIn applies the In predicate on the "rewritten_count" field.
+func RewrittenCountIn(vs ...int) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldIn(FieldRewrittenCount, vs...))
+}
+
+// RewrittenCountNotIn applies the NotIn predicate on the "rewritten_count" field.
+func RewrittenCountNotIn(vs ...int) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNotIn(FieldRewrittenCount, vs...))
+}
+
+// RewrittenCountGT applies the GT predicate on the "rewritten_count" field.
+func RewrittenCountGT(v int) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGT(FieldRewrittenCount, v))
+}
+
+// RewrittenCountGTE applies the GTE predicate on the "rewritten_count" field.
+func RewrittenCountGTE(v int) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGTE(FieldRewrittenCount, v))
+}
+
+// RewrittenCountLT applies the LT predicate on the "rewritten_count" field.
+func RewrittenCountLT(v int) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLT(FieldRewrittenCount, v))
+}
+
+// RewrittenCountLTE applies the LTE predicate on the "rewritten_count" field.
+func RewrittenCountLTE(v int) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLTE(FieldRewrittenCount, v))
+}
+
+// ChangesJSONEQ applies the EQ predicate on the "changes_json" field.
+func ChangesJSONEQ(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldChangesJSON, v))
+}
+
+// ChangesJSONNEQ applies the NEQ predicate on the "changes_json" field.
+func ChangesJSONNEQ(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNEQ(FieldChangesJSON, v))
+}
+
+// ChangesJSONIn applies the In predicate on the "changes_json" field.
+func ChangesJSONIn(vs ...string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldIn(FieldChangesJSON, vs...))
+}
+
+// ChangesJSONNotIn applies the NotIn predicate on the "changes_json" field.
+func ChangesJSONNotIn(vs ...string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNotIn(FieldChangesJSON, vs...))
+}
+
+// ChangesJSONGT applies the GT predicate on the "changes_json" field.
+func ChangesJSONGT(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGT(FieldChangesJSON, v))
+}
+
+// ChangesJSONGTE applies the GTE predicate on the "changes_json" field.
+func ChangesJSONGTE(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGTE(FieldChangesJSON, v))
+}
+
+// ChangesJSONLT applies the LT predicate on the "changes_json" field.
+func ChangesJSONLT(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLT(FieldChangesJSON, v))
+}
+
+// ChangesJSONLTE applies the LTE predicate on the "changes_json" field.
+func ChangesJSONLTE(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLTE(FieldChangesJSON, v))
+}
+
+// ChangesJSONContains applies the Contains predicate on the "changes_json" field.
+func ChangesJSONContains(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldContains(FieldChangesJSON, v))
+}
+
+// ChangesJSONHasPrefix applies the HasPrefix predicate on the "changes_json" field.
+func ChangesJSONHasPrefix(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldHasPrefix(FieldChangesJSON, v))
+}
+
+// ChangesJSONHasSuffix applies the HasSuffix predicate on the "changes_json" field.
+func ChangesJSONHasSuffix(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldHasSuffix(FieldChangesJSON, v))
+}
+
+// ChangesJSONEqualFold applies the EqualFold predicate on the "changes_json" field.
+func ChangesJSONEqualFold(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEqualFold(FieldChangesJSON, v))
+}
+
+// ChangesJSONContainsFold applies the ContainsFold predicate on the "changes_json" field.
+func ChangesJSONContainsFold(v string) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldContainsFold(FieldChangesJSON, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UndoneAtEQ applies the EQ predicate on the "undone_at" field.
+func UndoneAtEQ(v time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldEQ(FieldUndoneAt, v))
+}
+
+// UndoneAtNEQ applies the NEQ predicate on the "undone_at" field.
+func UndoneAtNEQ(v time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNEQ(FieldUndoneAt, v))
+}
+
+// UndoneAtIn applies the In predicate on the "undone_at" field.
+func UndoneAtIn(vs ...time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldIn(FieldUndoneAt, vs...))
+}
+
+// UndoneAtNotIn applies the NotIn predicate on the "undone_at" field.
+func UndoneAtNotIn(vs ...time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNotIn(FieldUndoneAt, vs...))
+}
+
+// UndoneAtGT applies the GT predicate on the "undone_at" field.
+func UndoneAtGT(v time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGT(FieldUndoneAt, v))
+}
+
+// UndoneAtGTE applies the GTE predicate on the "undone_at" field.
+func UndoneAtGTE(v time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldGTE(FieldUndoneAt, v))
+}
+
+// UndoneAtLT applies the LT predicate on the "undone_at" field.
+func UndoneAtLT(v time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLT(FieldUndoneAt, v))
+}
+
+// UndoneAtLTE applies the LTE predicate on the "undone_at" field.
+func UndoneAtLTE(v time.Time) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldLTE(FieldUndoneAt, v))
+}
+
+// UndoneAtIsNil applies the IsNil predicate on the "undone_at" field.
+func UndoneAtIsNil() predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldIsNull(FieldUndoneAt))
+}
+
+// UndoneAtNotNil applies the NotNil predicate on the "undone_at" field.
+func UndoneAtNotNil() predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.FieldNotNull(FieldUndoneAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.LinkRewrite {
+	return predicate.LinkRewrite(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.LinkRewrite {
+	return predicate.LinkRewrite(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LinkRewrite) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LinkRewrite) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LinkRewrite) predicate.LinkRewrite {
+	return predicate.LinkRewrite(sql.NotPredicates(p))
+}
@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"smarticky/ent/linkrewrite"
+	"smarticky/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LinkRewriteCreate is the builder for creating a LinkRewrite entity.
+type LinkRewriteCreate struct {
+	config
+	mutation *LinkRewriteMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LinkRewriteCreate) SetUserID(v int) *LinkRewriteCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNoteID sets the "note_id" field.
+func (_c *LinkRewriteCreate) SetNoteID(v uuid.UUID) *LinkRewriteCreate {
+	_c.mutation.SetNoteID(v)
+	return _c
+}
+
+// SetOldTitle sets the "old_title" field.
+func (_c *LinkRewriteCreate) SetOldTitle(v string) *LinkRewriteCreate {
+	_c.mutation.SetOldTitle(v)
+	return _c
+}
+
+// SetNewTitle sets the "new_title" field.
+func (_c *LinkRewriteCreate) SetNewTitle(v string) *LinkRewriteCreate {
+	_c.mutation.SetNewTitle(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *LinkRewriteCreate) SetStatus(v linkrewrite.Status) *LinkRewriteCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *LinkRewriteCreate) SetNillableStatus(v *linkrewrite.Status) *LinkRewriteCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRewrittenCount sets the "rewritten_count" field.
+func (_c *LinkRewriteCreate) SetRewrittenCount(v int) *LinkRewriteCreate {
+	_c.mutation.SetRewrittenCount(v)
+	return _c
+}
+
+// SetNillableRewrittenCount sets the "rewritten_count" field if the given value is not nil.
+func (_c *LinkRewriteCreate) SetNillableRewrittenCount(v *int) *LinkRewriteCreate {
+	if v != nil {
+		_c.SetRewrittenCount(*v)
+	}
+	return _c
+}
+
+// SetChangesJSON sets the "changes_json" field.
+func (_c *LinkRewriteCreate) SetChangesJSON(v string) *LinkRewriteCreate {
+	_c.mutation.SetChangesJSON(v)
+	return _c
+}
+
+// SetNillableChangesJSON sets the "changes_json" field if the given value is not nil.
+func (_c *LinkRewriteCreate) SetNillableChangesJSON(v *string) *LinkRewriteCreate {
+	if v != nil {
+		_c.SetChangesJSON(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LinkRewriteCreate) SetCreatedAt(v time.Time) *LinkRewriteCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LinkRewriteCreate) SetNillableCreatedAt(v *time.Time) *LinkRewriteCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUndoneAt sets the "undone_at" field.
+func (_c *LinkRewriteCreate) SetUndoneAt(v time.Time) *LinkRewriteCreate {
+	_c.mutation.SetUndoneAt(v)
+	return _c
+}
+
+// SetNillableUndoneAt sets the "undone_at" field if the given value is not nil.
+func (_c *LinkRewriteCreate) SetNillableUndoneAt(v *time.Time) *LinkRewriteCreate {
+	if v != nil {
+		_c.SetUndoneAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LinkRewriteCreate) SetID(v uuid.UUID) *LinkRewriteCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LinkRewriteCreate) SetNillableID(v *uuid.UUID) *LinkRewriteCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *LinkRewriteCreate) SetUser(v *User) *LinkRewriteCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the LinkRewriteMutation object of the builder.
+func (_c *LinkRewriteCreate) Mutation() *LinkRewriteMutation {
+	return _c.mutation
+}
+
+// Save creates the LinkRewrite in the database.
+func (_c *LinkRewriteCreate) Save(ctx context.Context) (*LinkRewrite, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LinkRewriteCreate) SaveX(ctx context.Context) *LinkRewrite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkRewriteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkRewriteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LinkRewriteCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := linkrewrite.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.RewrittenCount(); !ok {
+		v := linkrewrite.DefaultRewrittenCount
+		_c.mutation.SetRewrittenCount(v)
+	}
+	if _, ok := _c.mutation.ChangesJSON(); !ok {
+		v := linkrewrite.DefaultChangesJSON
+		_c.mutation.SetChangesJSON(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := linkrewrite.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := linkrewrite.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LinkRewriteCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LinkRewrite.user_id"`)}
+	}
+	if _, ok := _c.mutation.NoteID(); !ok {
+		return &ValidationError{Name: "note_id", err: errors.New(`ent: missing required field "LinkRewrite.note_id"`)}
+	}
+	if _, ok := _c.mutation.OldTitle(); !ok {
+		return &ValidationError{Name: "old_title", err: errors.New(`ent: missing required field "LinkRewrite.old_title"`)}
+	}
+	if _, ok := _c.mutation.NewTitle(); !ok {
+		return &ValidationError{Name: "new_title", err: errors.New(`ent: missing required field "LinkRewrite.new_title"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "LinkRewrite.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := linkrewrite.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LinkRewrite.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RewrittenCount(); !ok {
+		return &ValidationError{Name: "rewritten_count", err: errors.New(`ent: missing required field "LinkRewrite.rewritten_count"`)}
+	}
+	if _, ok := _c.mutation.ChangesJSON(); !ok {
+		return &ValidationError{Name: "changes_json", err: errors.New(`ent: missing required field "LinkRewrite.changes_json"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LinkRewrite.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "LinkRewrite.user"`)}
+	}
+	return nil
+}
+
+func (_c *LinkRewriteCreate) sqlSave(ctx context.Context) (*LinkRewrite, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LinkRewriteCreate) createSpec() (*LinkRewrite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LinkRewrite{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(linkrewrite.Table, sqlgraph.NewFieldSpec(linkrewrite.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.NoteID(); ok {
+		_spec.SetField(linkrewrite.FieldNoteID, field.TypeUUID, value)
+		_node.NoteID = value
+	}
+	if value, ok := _c.mutation.OldTitle(); ok {
+		_spec.SetField(linkrewrite.FieldOldTitle, field.TypeString, value)
+		_node.OldTitle = value
+	}
+	if value, ok := _c.mutation.NewTitle(); ok {
+		_spec.SetField(linkrewrite.FieldNewTitle, field.TypeString, value)
+		_node.NewTitle = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(linkrewrite.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RewrittenCount(); ok {
+		_spec.SetField(linkrewrite.FieldRewrittenCount, field.TypeInt, value)
+		_node.RewrittenCount = value
+	}
+	if value, ok := _c.mutation.ChangesJSON(); ok {
+		_spec.SetField(linkrewrite.FieldChangesJSON, field.TypeString, value)
+		_node.ChangesJSON = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(linkrewrite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UndoneAt(); ok {
+		_spec.SetField(linkrewrite.FieldUndoneAt, field.TypeTime, value)
+		_node.UndoneAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkrewrite.UserTable,
+			Columns: []string{linkrewrite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LinkRewriteCreateBulk is the builder for creating many LinkRewrite entities in bulk.
+type LinkRewriteCreateBulk struct {
+	config
+	err      error
+	builders []*LinkRewriteCreate
+}
+
+// Save creates the LinkRewrite entities in the database.
+func (_c *LinkRewriteCreateBulk) Save(ctx context.Context) ([]*LinkRewrite, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LinkRewrite, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LinkRewriteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LinkRewriteCreateBulk) SaveX(ctx context.Context) []*LinkRewrite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkRewriteCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkRewriteCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
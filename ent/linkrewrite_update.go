@@ -0,0 +1,594 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"smarticky/ent/linkrewrite"
+	"smarticky/ent/predicate"
+	"smarticky/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LinkRewriteUpdate is the builder for updating LinkRewrite entities.
+type LinkRewriteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LinkRewriteMutation
+}
+
+// Where appends a list predicates to the LinkRewriteUpdate builder.
+func (_u *LinkRewriteUpdate) Where(ps ...predicate.LinkRewrite) *LinkRewriteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LinkRewriteUpdate) SetUserID(v int) *LinkRewriteUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LinkRewriteUpdate) SetNillableUserID(v *int) *LinkRewriteUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetNoteID sets the "note_id" field.
+func (_u *LinkRewriteUpdate) SetNoteID(v uuid.UUID) *LinkRewriteUpdate {
+	_u.mutation.SetNoteID(v)
+	return _u
+}
+
+// SetNillableNoteID sets the "note_id" field if the given value is not nil.
+func (_u *LinkRewriteUpdate) SetNillableNoteID(v *uuid.UUID) *LinkRewriteUpdate {
+	if v != nil {
+		_u.SetNoteID(*v)
+	}
+	return _u
+}
+
+// SetOldTitle sets the "old_title" field.
+func (_u *LinkRewriteUpdate) SetOldTitle(v string) *LinkRewriteUpdate {
+	_u.mutation.SetOldTitle(v)
+	return _u
+}
+
+// SetNillableOldTitle sets the "old_title" field if the given value is not nil.
+func (_u *LinkRewriteUpdate) SetNillableOldTitle(v *string) *LinkRewriteUpdate {
+	if v != nil {
+		_u.SetOldTitle(*v)
+	}
+	return _u
+}
+
+// SetNewTitle sets the "new_title" field.
+func (_u *LinkRewriteUpdate) SetNewTitle(v string) *LinkRewriteUpdate {
+	_u.mutation.SetNewTitle(v)
+	return _u
+}
+
+// SetNillableNewTitle sets the "new_title" field if the given value is not nil.
+func (_u *LinkRewriteUpdate) SetNillableNewTitle(v *string) *LinkRewriteUpdate {
+	if v != nil {
+		_u.SetNewTitle(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LinkRewriteUpdate) SetStatus(v linkrewrite.Status) *LinkRewriteUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LinkRewriteUpdate) SetNillableStatus(v *linkrewrite.Status) *LinkRewriteUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRewrittenCount sets the "rewritten_count" field.
+func (_u *LinkRewriteUpdate) SetRewrittenCount(v int) *LinkRewriteUpdate {
+	_u.mutation.ResetRewrittenCount()
+	_u.mutation.SetRewrittenCount(v)
+	return _u
+}
+
+// SetNillableRewrittenCount sets the "rewritten_count" field if the given value is not nil.
+func (_u *LinkRewriteUpdate) SetNillableRewrittenCount(v *int) *LinkRewriteUpdate {
+	if v != nil {
+		_u.SetRewrittenCount(*v)
+	}
+	return _u
+}
+
+// AddRewrittenCount adds value to the "rewritten_count" field.
+func (_u *LinkRewriteUpdate) AddRewrittenCount(v int) *LinkRewriteUpdate {
+	_u.mutation.AddRewrittenCount(v)
+	return _u
+}
+
+// SetChangesJSON sets the "changes_json" field.
+func (_u *LinkRewriteUpdate) SetChangesJSON(v string) *LinkRewriteUpdate {
+	_u.mutation.SetChangesJSON(v)
+	return _u
+}
+
+// SetNillableChangesJSON sets the "changes_json" field if the given value is not nil.
+func (_u *LinkRewriteUpdate) SetNillableChangesJSON(v *string) *LinkRewriteUpdate {
+	if v != nil {
+		_u.SetChangesJSON(*v)
+	}
+	return _u
+}
+
+// SetUndoneAt sets the "undone_at" field.
+func (_u *LinkRewriteUpdate) SetUndoneAt(v time.Time) *LinkRewriteUpdate {
+	_u.mutation.SetUndoneAt(v)
+	return _u
+}
+
+// SetNillableUndoneAt sets the "undone_at" field if the given value is not nil.
+func (_u *LinkRewriteUpdate) SetNillableUndoneAt(v *time.Time) *LinkRewriteUpdate {
+	if v != nil {
+		_u.SetUndoneAt(*v)
+	}
+	return _u
+}
+
+// ClearUndoneAt clears the value of the "undone_at" field.
+func (_u *LinkRewriteUpdate) ClearUndoneAt() *LinkRewriteUpdate {
+	_u.mutation.ClearUndoneAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LinkRewriteUpdate) SetUser(v *User) *LinkRewriteUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LinkRewriteMutation object of the builder.
+func (_u *LinkRewriteUpdate) Mutation() *LinkRewriteMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LinkRewriteUpdate) ClearUser() *LinkRewriteUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LinkRewriteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkRewriteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LinkRewriteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkRewriteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkRewriteUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := linkrewrite.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LinkRewrite.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkRewrite.user"`)
+	}
+	return nil
+}
+
+func (_u *LinkRewriteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkrewrite.Table, linkrewrite.Columns, sqlgraph.NewFieldSpec(linkrewrite.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.NoteID(); ok {
+		_spec.SetField(linkrewrite.FieldNoteID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.OldTitle(); ok {
+		_spec.SetField(linkrewrite.FieldOldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NewTitle(); ok {
+		_spec.SetField(linkrewrite.FieldNewTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(linkrewrite.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RewrittenCount(); ok {
+		_spec.SetField(linkrewrite.FieldRewrittenCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRewrittenCount(); ok {
+		_spec.AddField(linkrewrite.FieldRewrittenCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChangesJSON(); ok {
+		_spec.SetField(linkrewrite.FieldChangesJSON, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UndoneAt(); ok {
+		_spec.SetField(linkrewrite.FieldUndoneAt, field.TypeTime, value)
+	}
+	if _u.mutation.UndoneAtCleared() {
+		_spec.ClearField(linkrewrite.FieldUndoneAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkrewrite.UserTable,
+			Columns: []string{linkrewrite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkrewrite.UserTable,
+			Columns: []string{linkrewrite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkrewrite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LinkRewriteUpdateOne is the builder for updating a single LinkRewrite entity.
+type LinkRewriteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LinkRewriteMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LinkRewriteUpdateOne) SetUserID(v int) *LinkRewriteUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LinkRewriteUpdateOne) SetNillableUserID(v *int) *LinkRewriteUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetNoteID sets the "note_id" field.
+func (_u *LinkRewriteUpdateOne) SetNoteID(v uuid.UUID) *LinkRewriteUpdateOne {
+	_u.mutation.SetNoteID(v)
+	return _u
+}
+
+// SetNillableNoteID sets the "note_id" field if the given value is not nil.
+func (_u *LinkRewriteUpdateOne) SetNillableNoteID(v *uuid.UUID) *LinkRewriteUpdateOne {
+	if v != nil {
+		_u.SetNoteID(*v)
+	}
+	return _u
+}
+
+// SetOldTitle sets the "old_title" field.
+func (_u *LinkRewriteUpdateOne) SetOldTitle(v string) *LinkRewriteUpdateOne {
+	_u.mutation.SetOldTitle(v)
+	return _u
+}
+
+// SetNillableOldTitle sets the "old_title" field if the given value is not nil.
+func (_u *LinkRewriteUpdateOne) SetNillableOldTitle(v *string) *LinkRewriteUpdateOne {
+	if v != nil {
+		_u.SetOldTitle(*v)
+	}
+	return _u
+}
+
+// SetNewTitle sets the "new_title" field.
+func (_u *LinkRewriteUpdateOne) SetNewTitle(v string) *LinkRewriteUpdateOne {
+	_u.mutation.SetNewTitle(v)
+	return _u
+}
+
+// SetNillableNewTitle sets the "new_title" field if the given value is not nil.
+func (_u *LinkRewriteUpdateOne) SetNillableNewTitle(v *string) *LinkRewriteUpdateOne {
+	if v != nil {
+		_u.SetNewTitle(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LinkRewriteUpdateOne) SetStatus(v linkrewrite.Status) *LinkRewriteUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LinkRewriteUpdateOne) SetNillableStatus(v *linkrewrite.Status) *LinkRewriteUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRewrittenCount sets the "rewritten_count" field.
+func (_u *LinkRewriteUpdateOne) SetRewrittenCount(v int) *LinkRewriteUpdateOne {
+	_u.mutation.ResetRewrittenCount()
+	_u.mutation.SetRewrittenCount(v)
+	return _u
+}
+
+// SetNillableRewrittenCount sets the "rewritten_count" field if the given value is not nil.
+func (_u *LinkRewriteUpdateOne) SetNillableRewrittenCount(v *int) *LinkRewriteUpdateOne {
+	if v != nil {
+		_u.SetRewrittenCount(*v)
+	}
+	return _u
+}
+
+// AddRewrittenCount adds value to the "rewritten_count" field.
+func (_u *LinkRewriteUpdateOne) AddRewrittenCount(v int) *LinkRewriteUpdateOne {
+	_u.mutation.AddRewrittenCount(v)
+	return _u
+}
+
+// SetChangesJSON sets the "changes_json" field.
+func (_u *LinkRewriteUpdateOne) SetChangesJSON(v string) *LinkRewriteUpdateOne {
+	_u.mutation.SetChangesJSON(v)
+	return _u
+}
+
+// SetNillableChangesJSON sets the "changes_json" field if the given value is not nil.
+func (_u *LinkRewriteUpdateOne) SetNillableChangesJSON(v *string) *LinkRewriteUpdateOne {
+	if v != nil {
+		_u.SetChangesJSON(*v)
+	}
+	return _u
+}
+
+// SetUndoneAt sets the "undone_at" field.
+func (_u *LinkRewriteUpdateOne) SetUndoneAt(v time.Time) *LinkRewriteUpdateOne {
+	_u.mutation.SetUndoneAt(v)
+	return _u
+}
+
+// SetNillableUndoneAt sets the "undone_at" field if the given value is not nil.
+func (_u *LinkRewriteUpdateOne) SetNillableUndoneAt(v *time.Time) *LinkRewriteUpdateOne {
+	if v != nil {
+		_u.SetUndoneAt(*v)
+	}
+	return _u
+}
+
+// ClearUndoneAt clears the value of the "undone_at" field.
+func (_u *LinkRewriteUpdateOne) ClearUndoneAt() *LinkRewriteUpdateOne {
+	_u.mutation.ClearUndoneAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LinkRewriteUpdateOne) SetUser(v *User) *LinkRewriteUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LinkRewriteMutation object of the builder.
+func (_u *LinkRewriteUpdateOne) Mutation() *LinkRewriteMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LinkRewriteUpdateOne) ClearUser() *LinkRewriteUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the LinkRewriteUpdate builder.
+func (_u *LinkRewriteUpdateOne) Where(ps ...predicate.LinkRewrite) *LinkRewriteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LinkRewriteUpdateOne) Select(field string, fields ...string) *LinkRewriteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LinkRewrite entity.
+func (_u *LinkRewriteUpdateOne) Save(ctx context.Context) (*LinkRewrite, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkRewriteUpdateOne) SaveX(ctx context.Context) *LinkRewrite {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LinkRewriteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkRewriteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkRewriteUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := linkrewrite.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LinkRewrite.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkRewrite.user"`)
+	}
+	return nil
+}
+
+func (_u *LinkRewriteUpdateOne) sqlSave(ctx context.Context) (_node *LinkRewrite, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkrewrite.Table, linkrewrite.Columns, sqlgraph.NewFieldSpec(linkrewrite.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LinkRewrite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, linkrewrite.FieldID)
+		for _, f := range fields {
+			if !linkrewrite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != linkrewrite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.NoteID(); ok {
+		_spec.SetField(linkrewrite.FieldNoteID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.OldTitle(); ok {
+		_spec.SetField(linkrewrite.FieldOldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NewTitle(); ok {
+		_spec.SetField(linkrewrite.FieldNewTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(linkrewrite.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RewrittenCount(); ok {
+		_spec.SetField(linkrewrite.FieldRewrittenCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRewrittenCount(); ok {
+		_spec.AddField(linkrewrite.FieldRewrittenCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChangesJSON(); ok {
+		_spec.SetField(linkrewrite.FieldChangesJSON, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UndoneAt(); ok {
+		_spec.SetField(linkrewrite.FieldUndoneAt, field.TypeTime, value)
+	}
+	if _u.mutation.UndoneAtCleared() {
+		_spec.ClearField(linkrewrite.FieldUndoneAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkrewrite.UserTable,
+			Columns: []string{linkrewrite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkrewrite.UserTable,
+			Columns: []string{linkrewrite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LinkRewrite{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkrewrite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -56,6 +56,12 @@ var (
 		{Name: "backup_max_count", Type: field.TypeInt, Default: 10},
 		{Name: "folder_max_depth", Type: field.TypeInt, Default: 3},
 		{Name: "backup_targets_migrated", Type: field.TypeBool, Default: false},
+		{Name: "alert_webhook_url", Type: field.TypeString, Nullable: true},
+		{Name: "alert_smtp_host", Type: field.TypeString, Nullable: true},
+		{Name: "alert_smtp_port", Type: field.TypeInt, Default: 25},
+		{Name: "alert_smtp_from", Type: field.TypeString, Nullable: true},
+		{Name: "alert_smtp_to", Type: field.TypeString, Nullable: true},
+		{Name: "alert_on_failure", Type: field.TypeBool, Default: true},
 		{Name: "last_backup_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -66,6 +72,121 @@ var (
 		Columns:    BackupConfigsColumns,
 		PrimaryKey: []*schema.Column{BackupConfigsColumns[0]},
 	}
+	// BackupDrillsColumns holds the columns for the "backup_drills" table.
+	BackupDrillsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "filename", Type: field.TypeString, Nullable: true},
+		{Name: "trigger", Type: field.TypeString, Default: "manual"},
+		{Name: "status", Type: field.TypeString},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "checks_json", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "counts_json", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime},
+		{Name: "duration_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "target_id", Type: field.TypeInt},
+	}
+	// BackupDrillsTable holds the schema information for the "backup_drills" table.
+	BackupDrillsTable = &schema.Table{
+		Name:       "backup_drills",
+		Columns:    BackupDrillsColumns,
+		PrimaryKey: []*schema.Column{BackupDrillsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "backup_drills_backup_targets_drills",
+				Columns:    []*schema.Column{BackupDrillsColumns[10]},
+				RefColumns: []*schema.Column{BackupTargetsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "backupdrill_target_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{BackupDrillsColumns[10], BackupDrillsColumns[7]},
+			},
+		},
+	}
+	// BackupPinsColumns holds the columns for the "backup_pins" table.
+	BackupPinsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "filename", Type: field.TypeString},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "target_id", Type: field.TypeInt},
+	}
+	// BackupPinsTable holds the schema information for the "backup_pins" table.
+	BackupPinsTable = &schema.Table{
+		Name:       "backup_pins",
+		Columns:    BackupPinsColumns,
+		PrimaryKey: []*schema.Column{BackupPinsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "backup_pins_backup_targets_pins",
+				Columns:    []*schema.Column{BackupPinsColumns[4]},
+				RefColumns: []*schema.Column{BackupTargetsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "backuppin_target_id_filename",
+				Unique:  true,
+				Columns: []*schema.Column{BackupPinsColumns[4], BackupPinsColumns[1]},
+			},
+		},
+	}
+	// BackupRunsColumns holds the columns for the "backup_runs" table.
+	BackupRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "trigger", Type: field.TypeString, Default: "manual"},
+		{Name: "status", Type: field.TypeString},
+		{Name: "filename", Type: field.TypeString, Nullable: true},
+		{Name: "bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime},
+		{Name: "duration_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "target_id", Type: field.TypeInt, Nullable: true},
+		{Name: "task_id", Type: field.TypeInt, Nullable: true},
+	}
+	// BackupRunsTable holds the schema information for the "backup_runs" table.
+	BackupRunsTable = &schema.Table{
+		Name:       "backup_runs",
+		Columns:    BackupRunsColumns,
+		PrimaryKey: []*schema.Column{BackupRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "backup_runs_backup_targets_runs",
+				Columns:    []*schema.Column{BackupRunsColumns[9]},
+				RefColumns: []*schema.Column{BackupTargetsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "backup_runs_backup_tasks_runs",
+				Columns:    []*schema.Column{BackupRunsColumns[10]},
+				RefColumns: []*schema.Column{BackupTasksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "backuprun_task_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{BackupRunsColumns[10], BackupRunsColumns[6]},
+			},
+			{
+				Name:    "backuprun_target_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{BackupRunsColumns[9], BackupRunsColumns[6]},
+			},
+			{
+				Name:    "backuprun_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{BackupRunsColumns[6]},
+			},
+		},
+	}
 	// BackupTargetsColumns holds the columns for the "backup_targets" table.
 	BackupTargetsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -86,6 +207,17 @@ var (
 		{Name: "s3_bucket", Type: field.TypeString, Nullable: true},
 		{Name: "s3_access_key", Type: field.TypeString, Nullable: true},
 		{Name: "s3_secret_key", Type: field.TypeString, Nullable: true},
+		{Name: "local_path", Type: field.TypeString, Nullable: true},
+		{Name: "sftp_host", Type: field.TypeString, Nullable: true},
+		{Name: "sftp_port", Type: field.TypeInt, Default: 22},
+		{Name: "sftp_user", Type: field.TypeString, Nullable: true},
+		{Name: "sftp_password", Type: field.TypeString, Nullable: true},
+		{Name: "sftp_private_key", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "sftp_path", Type: field.TypeString, Nullable: true},
+		{Name: "sftp_host_key", Type: field.TypeString, Nullable: true},
+		{Name: "alert_after_hours", Type: field.TypeInt, Default: 0},
+		{Name: "drill_interval_days", Type: field.TypeInt, Default: 0},
+		{Name: "stale_alerted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -101,8 +233,18 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
 		{Name: "schedule", Type: field.TypeString, Default: "manual"},
+		{Name: "cron_expression", Type: field.TypeString, Nullable: true},
+		{Name: "timezone", Type: field.TypeString, Nullable: true},
 		{Name: "retention_days", Type: field.TypeInt, Default: 30},
 		{Name: "max_count", Type: field.TypeInt, Default: 10},
+		{Name: "keep_hourly", Type: field.TypeInt, Default: 0},
+		{Name: "keep_daily", Type: field.TypeInt, Default: 0},
+		{Name: "keep_weekly", Type: field.TypeInt, Default: 0},
+		{Name: "keep_monthly", Type: field.TypeInt, Default: 0},
+		{Name: "keep_yearly", Type: field.TypeInt, Default: 0},
+		{Name: "encryption_enabled", Type: field.TypeBool, Default: false},
+		{Name: "encryption_passphrase", Type: field.TypeString, Nullable: true},
+		{Name: "incremental", Type: field.TypeBool, Default: false},
 		{Name: "last_backup_status", Type: field.TypeString, Default: "never"},
 		{Name: "last_backup_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "last_backup_at", Type: field.TypeTime, Nullable: true},
@@ -248,6 +390,45 @@ var (
 			},
 		},
 	}
+	// LinkRewritesColumns holds the columns for the "link_rewrites" table.
+	LinkRewritesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "note_id", Type: field.TypeUUID},
+		{Name: "old_title", Type: field.TypeString},
+		{Name: "new_title", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"applied", "undone"}, Default: "applied"},
+		{Name: "rewritten_count", Type: field.TypeInt, Default: 0},
+		{Name: "changes_json", Type: field.TypeString, Size: 2147483647, Default: "[]"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "undone_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// LinkRewritesTable holds the schema information for the "link_rewrites" table.
+	LinkRewritesTable = &schema.Table{
+		Name:       "link_rewrites",
+		Columns:    LinkRewritesColumns,
+		PrimaryKey: []*schema.Column{LinkRewritesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "link_rewrites_users_link_rewrites",
+				Columns:    []*schema.Column{LinkRewritesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "linkrewrite_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LinkRewritesColumns[9], LinkRewritesColumns[7]},
+			},
+			{
+				Name:    "linkrewrite_user_id_note_id",
+				Unique:  false,
+				Columns: []*schema.Column{LinkRewritesColumns[9], LinkRewritesColumns[1]},
+			},
+		},
+	}
 	// McpImagesColumns holds the columns for the "mcp_images" table.
 	McpImagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -310,6 +491,7 @@ var (
 		{Name: "encryption_kdf", Type: field.TypeString, Nullable: true},
 		{Name: "encryption_salt", Type: field.TypeString, Nullable: true},
 		{Name: "encryption_nonce", Type: field.TypeString, Nullable: true},
+		{Name: "aliases", Type: field.TypeJSON, Nullable: true},
 		{Name: "is_starred", Type: field.TypeBool, Default: false},
 		{Name: "is_deleted", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
@@ -325,13 +507,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "notes_folders_notes",
-				Columns:    []*schema.Column{NotesColumns[15]},
+				Columns:    []*schema.Column{NotesColumns[16]},
 				RefColumns: []*schema.Column{FoldersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "notes_users_notes",
-				Columns:    []*schema.Column{NotesColumns[16]},
+				Columns:    []*schema.Column{NotesColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -349,6 +531,8 @@ var (
 		{Name: "credential_alg", Type: field.TypeString, Nullable: true},
 		{Name: "default_target_id", Type: field.TypeString, Nullable: true},
 		{Name: "default_target_name", Type: field.TypeString, Nullable: true},
+		{Name: "sync_interval_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "encrypted_webhook_secret", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "metadata_json", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "last_test_status", Type: field.TypeString, Default: "never"},
 		{Name: "last_test_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
@@ -365,7 +549,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "note_connection_accounts_users_note_connection_accounts",
-				Columns:    []*schema.Column{NoteConnectionAccountsColumns[16]},
+				Columns:    []*schema.Column{NoteConnectionAccountsColumns[18]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -374,7 +558,56 @@ var (
 			{
 				Name:    "noteconnectionaccount_user_id_provider_name",
 				Unique:  true,
-				Columns: []*schema.Column{NoteConnectionAccountsColumns[16], NoteConnectionAccountsColumns[2], NoteConnectionAccountsColumns[1]},
+				Columns: []*schema.Column{NoteConnectionAccountsColumns[18], NoteConnectionAccountsColumns[2], NoteConnectionAccountsColumns[1]},
+			},
+		},
+	}
+	// NoteConnectionConflictsColumns holds the columns for the "note_connection_conflicts" table.
+	NoteConnectionConflictsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "external_id", Type: field.TypeString},
+		{Name: "local_title", Type: field.TypeString},
+		{Name: "local_content", Type: field.TypeString, Size: 2147483647},
+		{Name: "local_updated_at", Type: field.TypeTime},
+		{Name: "remote_title", Type: field.TypeString},
+		{Name: "remote_content", Type: field.TypeString, Size: 2147483647},
+		{Name: "remote_updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "open"},
+		{Name: "resolution", Type: field.TypeString, Nullable: true},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "note_id", Type: field.TypeUUID},
+		{Name: "account_id", Type: field.TypeInt},
+	}
+	// NoteConnectionConflictsTable holds the schema information for the "note_connection_conflicts" table.
+	NoteConnectionConflictsTable = &schema.Table{
+		Name:       "note_connection_conflicts",
+		Columns:    NoteConnectionConflictsColumns,
+		PrimaryKey: []*schema.Column{NoteConnectionConflictsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "note_connection_conflicts_notes_connection_conflicts",
+				Columns:    []*schema.Column{NoteConnectionConflictsColumns[12]},
+				RefColumns: []*schema.Column{NotesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "note_connection_conflicts_note_connection_accounts_conflicts",
+				Columns:    []*schema.Column{NoteConnectionConflictsColumns[13]},
+				RefColumns: []*schema.Column{NoteConnectionAccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "noteconnectionconflict_account_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{NoteConnectionConflictsColumns[13], NoteConnectionConflictsColumns[8]},
+			},
+			{
+				Name:    "noteconnectionconflict_note_id_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{NoteConnectionConflictsColumns[12], NoteConnectionConflictsColumns[13]},
 			},
 		},
 	}
@@ -389,6 +622,8 @@ var (
 		{Name: "last_sync_direction", Type: field.TypeString, Nullable: true},
 		{Name: "last_imported_at", Type: field.TypeTime, Nullable: true},
 		{Name: "last_pushed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_synced_at", Type: field.TypeTime, Nullable: true},
+		{Name: "remote_updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "metadata_json", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -403,13 +638,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "note_connection_item_maps_notes_connection_maps",
-				Columns:    []*schema.Column{NoteConnectionItemMapsColumns[12]},
+				Columns:    []*schema.Column{NoteConnectionItemMapsColumns[14]},
 				RefColumns: []*schema.Column{NotesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "note_connection_item_maps_note_connection_accounts_item_maps",
-				Columns:    []*schema.Column{NoteConnectionItemMapsColumns[13]},
+				Columns:    []*schema.Column{NoteConnectionItemMapsColumns[15]},
 				RefColumns: []*schema.Column{NoteConnectionAccountsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -418,12 +653,12 @@ var (
 			{
 				Name:    "noteconnectionitemmap_account_id_external_id",
 				Unique:  true,
-				Columns: []*schema.Column{NoteConnectionItemMapsColumns[13], NoteConnectionItemMapsColumns[2]},
+				Columns: []*schema.Column{NoteConnectionItemMapsColumns[15], NoteConnectionItemMapsColumns[2]},
 			},
 			{
 				Name:    "noteconnectionitemmap_note_id_account_id",
 				Unique:  true,
-				Columns: []*schema.Column{NoteConnectionItemMapsColumns[12], NoteConnectionItemMapsColumns[13]},
+				Columns: []*schema.Column{NoteConnectionItemMapsColumns[14], NoteConnectionItemMapsColumns[15]},
 			},
 		},
 	}
@@ -433,11 +668,16 @@ var (
 		{Name: "provider", Type: field.TypeString},
 		{Name: "operation", Type: field.TypeString},
 		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "trigger", Type: field.TypeString, Default: "manual"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt, Default: 3},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
 		{Name: "total_count", Type: field.TypeInt, Default: 0},
 		{Name: "imported_count", Type: field.TypeInt, Default: 0},
 		{Name: "pushed_count", Type: field.TypeInt, Default: 0},
 		{Name: "skipped_count", Type: field.TypeInt, Default: 0},
 		{Name: "failed_count", Type: field.TypeInt, Default: 0},
+		{Name: "conflict_count", Type: field.TypeInt, Default: 0},
 		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "options_json", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
@@ -456,23 +696,30 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "note_connection_jobs_notes_connection_jobs",
-				Columns:    []*schema.Column{NoteConnectionJobsColumns[15]},
+				Columns:    []*schema.Column{NoteConnectionJobsColumns[20]},
 				RefColumns: []*schema.Column{NotesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "note_connection_jobs_note_connection_accounts_jobs",
-				Columns:    []*schema.Column{NoteConnectionJobsColumns[16]},
+				Columns:    []*schema.Column{NoteConnectionJobsColumns[21]},
 				RefColumns: []*schema.Column{NoteConnectionAccountsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "note_connection_jobs_users_note_connection_jobs",
-				Columns:    []*schema.Column{NoteConnectionJobsColumns[17]},
+				Columns:    []*schema.Column{NoteConnectionJobsColumns[22]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "noteconnectionjob_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{NoteConnectionJobsColumns[3], NoteConnectionJobsColumns[7]},
+			},
+		},
 	}
 	// NoteLinksColumns holds the columns for the "note_links" table.
 	NoteLinksColumns = []*schema.Column{
@@ -482,6 +729,8 @@ var (
 		{Name: "target_key", Type: field.TypeString},
 		{Name: "display_text", Type: field.TypeString},
 		{Name: "link_type", Type: field.TypeEnum, Enums: []string{"wiki"}, Default: "wiki"},
+		{Name: "anchor_type", Type: field.TypeEnum, Enums: []string{"none", "heading", "block"}, Default: "none"},
+		{Name: "anchor", Type: field.TypeString, Default: ""},
 		{Name: "occurrence_count", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -497,19 +746,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "note_links_notes_outgoing_links",
-				Columns:    []*schema.Column{NoteLinksColumns[9]},
+				Columns:    []*schema.Column{NoteLinksColumns[11]},
 				RefColumns: []*schema.Column{NotesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "note_links_notes_backlinks",
-				Columns:    []*schema.Column{NoteLinksColumns[10]},
+				Columns:    []*schema.Column{NoteLinksColumns[12]},
 				RefColumns: []*schema.Column{NotesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "note_links_users_note_links",
-				Columns:    []*schema.Column{NoteLinksColumns[11]},
+				Columns:    []*schema.Column{NoteLinksColumns[13]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -518,22 +767,22 @@ var (
 			{
 				Name:    "notelink_source_note_id_target_key_link_type",
 				Unique:  true,
-				Columns: []*schema.Column{NoteLinksColumns[9], NoteLinksColumns[3], NoteLinksColumns[5]},
+				Columns: []*schema.Column{NoteLinksColumns[11], NoteLinksColumns[3], NoteLinksColumns[5]},
 			},
 			{
 				Name:    "notelink_user_id_source_note_id",
 				Unique:  false,
-				Columns: []*schema.Column{NoteLinksColumns[11], NoteLinksColumns[9]},
+				Columns: []*schema.Column{NoteLinksColumns[13], NoteLinksColumns[11]},
 			},
 			{
 				Name:    "notelink_user_id_target_note_id",
 				Unique:  false,
-				Columns: []*schema.Column{NoteLinksColumns[11], NoteLinksColumns[10]},
+				Columns: []*schema.Column{NoteLinksColumns[13], NoteLinksColumns[12]},
 			},
 			{
 				Name:    "notelink_user_id_target_ref_norm",
 				Unique:  false,
-				Columns: []*schema.Column{NoteLinksColumns[11], NoteLinksColumns[2]},
+				Columns: []*schema.Column{NoteLinksColumns[13], NoteLinksColumns[2]},
 			},
 		},
 	}
@@ -571,6 +820,7 @@ var (
 		{Name: "avatar", Type: field.TypeString, Nullable: true, Default: ""},
 		{Name: "share_signature", Type: field.TypeString, Default: "Smarticky"},
 		{Name: "time_zone", Type: field.TypeString, Default: "UTC"},
+		{Name: "link_rename_mode", Type: field.TypeEnum, Enums: []string{"ask", "auto", "off"}, Default: "ask"},
 		{Name: "lazycat_uid", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -666,6 +916,9 @@ var (
 	Tables = []*schema.Table{
 		AttachmentsTable,
 		BackupConfigsTable,
+		BackupDrillsTable,
+		BackupPinsTable,
+		BackupRunsTable,
 		BackupTargetsTable,
 		BackupTasksTable,
 		ExcalidrawLibrariesTable,
@@ -673,10 +926,12 @@ var (
 		FontsTable,
 		ImportItemsTable,
 		ImportJobsTable,
+		LinkRewritesTable,
 		McpImagesTable,
 		McpTokensTable,
 		NotesTable,
 		NoteConnectionAccountsTable,
+		NoteConnectionConflictsTable,
 		NoteConnectionItemMapsTable,
 		NoteConnectionJobsTable,
 		NoteLinksTable,
@@ -691,17 +946,24 @@ var (
 func init() {
 	AttachmentsTable.ForeignKeys[0].RefTable = NotesTable
 	AttachmentsTable.ForeignKeys[1].RefTable = UsersTable
+	BackupDrillsTable.ForeignKeys[0].RefTable = BackupTargetsTable
+	BackupPinsTable.ForeignKeys[0].RefTable = BackupTargetsTable
+	BackupRunsTable.ForeignKeys[0].RefTable = BackupTargetsTable
+	BackupRunsTable.ForeignKeys[1].RefTable = BackupTasksTable
 	ExcalidrawLibrariesTable.ForeignKeys[0].RefTable = UsersTable
 	FoldersTable.ForeignKeys[0].RefTable = FoldersTable
 	FoldersTable.ForeignKeys[1].RefTable = UsersTable
 	FontsTable.ForeignKeys[0].RefTable = UsersTable
 	ImportItemsTable.ForeignKeys[0].RefTable = ImportJobsTable
 	ImportJobsTable.ForeignKeys[0].RefTable = UsersTable
+	LinkRewritesTable.ForeignKeys[0].RefTable = UsersTable
 	McpImagesTable.ForeignKeys[0].RefTable = UsersTable
 	McpTokensTable.ForeignKeys[0].RefTable = UsersTable
 	NotesTable.ForeignKeys[0].RefTable = FoldersTable
 	NotesTable.ForeignKeys[1].RefTable = UsersTable
 	NoteConnectionAccountsTable.ForeignKeys[0].RefTable = UsersTable
+	NoteConnectionConflictsTable.ForeignKeys[0].RefTable = NotesTable
+	NoteConnectionConflictsTable.ForeignKeys[1].RefTable = NoteConnectionAccountsTable
 	NoteConnectionItemMapsTable.ForeignKeys[0].RefTable = NotesTable
 	NoteConnectionItemMapsTable.ForeignKeys[1].RefTable = NoteConnectionAccountsTable
 	NoteConnectionJobsTable.ForeignKeys[0].RefTable = NotesTable
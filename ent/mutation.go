@@ -8,6 +8,9 @@ import (
 	"fmt"
 	"smarticky/ent/attachment"
 	"smarticky/ent/backupconfig"
+	"smarticky/ent/backupdrill"
+	"smarticky/ent/backuppin"
+	"smarticky/ent/backuprun"
 	"smarticky/ent/backuptarget"
 	"smarticky/ent/backuptask"
 	"smarticky/ent/excalidrawlibrary"
@@ -15,10 +18,12 @@ import (
 	"smarticky/ent/font"
 	"smarticky/ent/importitem"
 	"smarticky/ent/importjob"
+	"smarticky/ent/linkrewrite"
 	"smarticky/ent/mcpimage"
 	"smarticky/ent/mcptoken"
 	"smarticky/ent/note"
 	"smarticky/ent/noteconnectionaccount"
+	"smarticky/ent/noteconnectionconflict"
 	"smarticky/ent/noteconnectionitemmap"
 	"smarticky/ent/noteconnectionjob"
 	"smarticky/ent/notelink"
@@ -43,25 +48,30 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAttachment            = "Attachment"
-	TypeBackupConfig          = "BackupConfig"
-	TypeBackupTarget          = "BackupTarget"
-	TypeBackupTask            = "BackupTask"
-	TypeExcalidrawLibrary     = "ExcalidrawLibrary"
-	TypeFolder                = "Folder"
-	TypeFont                  = "Font"
-	TypeImportItem            = "ImportItem"
-	TypeImportJob             = "ImportJob"
-	TypeMCPImage              = "MCPImage"
-	TypeMCPToken              = "MCPToken"
-	TypeNote                  = "Note"
-	TypeNoteConnectionAccount = "NoteConnectionAccount"
-	TypeNoteConnectionItemMap = "NoteConnectionItemMap"
-	TypeNoteConnectionJob     = "NoteConnectionJob"
-	TypeNoteLink              = "NoteLink"
-	TypeTag                   = "Tag"
-	TypeUser                  = "User"
-	TypeWhiteboard            = "Whiteboard"
+	TypeAttachment             = "Attachment"
+	TypeBackupConfig           = "BackupConfig"
+	TypeBackupDrill            = "BackupDrill"
+	TypeBackupPin              = "BackupPin"
+	TypeBackupRun              = "BackupRun"
+	TypeBackupTarget           = "BackupTarget"
+	TypeBackupTask             = "BackupTask"
+	TypeExcalidrawLibrary      = "ExcalidrawLibrary"
+	TypeFolder                 = "Folder"
+	TypeFont                   = "Font"
+	TypeImportItem             = "ImportItem"
+	TypeImportJob              = "ImportJob"
+	TypeLinkRewrite            = "LinkRewrite"
+	TypeMCPImage               = "MCPImage"
+	TypeMCPToken               = "MCPToken"
+	TypeNote                   = "Note"
+	TypeNoteConnectionAccount  = "NoteConnectionAccount"
+	TypeNoteConnectionConflict = "NoteConnectionConflict"
+	TypeNoteConnectionItemMap  = "NoteConnectionItemMap"
+	TypeNoteConnectionJob      = "NoteConnectionJob"
+	TypeNoteLink               = "NoteLink"
+	TypeTag                    = "Tag"
+	TypeUser                   = "User"
+	TypeWhiteboard             = "Whiteboard"
 )
 
 // AttachmentMutation represents an operation that mutates the Attachment nodes in the graph.
@@ -813,6 +823,13 @@ type BackupConfigMutation struct {
 	folder_max_depth         *int
 	addfolder_max_depth      *int
 	backup_targets_migrated  *bool
+	alert_webhook_url        *string
+	alert_smtp_host          *string
+	alert_smtp_port          *int
+	addalert_smtp_port       *int
+	alert_smtp_from          *string
+	alert_smtp_to            *string
+	alert_on_failure         *bool
 	last_backup_at           *time.Time
 	created_at               *time.Time
 	updated_at               *time.Time
@@ -1588,6 +1605,294 @@ func (m *BackupConfigMutation) ResetBackupTargetsMigrated() {
 	m.backup_targets_migrated = nil
 }
 
+// SetAlertWebhookURL sets the "alert_webhook_url" field.
+func (m *BackupConfigMutation) SetAlertWebhookURL(s string) {
+	m.alert_webhook_url = &s
+}
+
+// AlertWebhookURL returns the value of the "alert_webhook_url" field in the mutation.
+func (m *BackupConfigMutation) AlertWebhookURL() (r string, exists bool) {
+	v := m.alert_webhook_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlertWebhookURL returns the old "alert_webhook_url" field's value of the BackupConfig entity.
+// If the BackupConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupConfigMutation) OldAlertWebhookURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlertWebhookURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlertWebhookURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlertWebhookURL: %w", err)
+	}
+	return oldValue.AlertWebhookURL, nil
+}
+
+// ClearAlertWebhookURL clears the value of the "alert_webhook_url" field.
+func (m *BackupConfigMutation) ClearAlertWebhookURL() {
+	m.alert_webhook_url = nil
+	m.clearedFields[backupconfig.FieldAlertWebhookURL] = struct{}{}
+}
+
+// AlertWebhookURLCleared returns if the "alert_webhook_url" field was cleared in this mutation.
+func (m *BackupConfigMutation) AlertWebhookURLCleared() bool {
+	_, ok := m.clearedFields[backupconfig.FieldAlertWebhookURL]
+	return ok
+}
+
+// ResetAlertWebhookURL resets all changes to the "alert_webhook_url" field.
+func (m *BackupConfigMutation) ResetAlertWebhookURL() {
+	m.alert_webhook_url = nil
+	delete(m.clearedFields, backupconfig.FieldAlertWebhookURL)
+}
+
+// SetAlertSMTPHost sets the "alert_smtp_host" field.
+func (m *BackupConfigMutation) SetAlertSMTPHost(s string) {
+	m.alert_smtp_host = &s
+}
+
+// AlertSMTPHost returns the value of the "alert_smtp_host" field in the mutation.
+func (m *BackupConfigMutation) AlertSMTPHost() (r string, exists bool) {
+	v := m.alert_smtp_host
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlertSMTPHost returns the old "alert_smtp_host" field's value of the BackupConfig entity.
+// If the BackupConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupConfigMutation) OldAlertSMTPHost(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlertSMTPHost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlertSMTPHost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlertSMTPHost: %w", err)
+	}
+	return oldValue.AlertSMTPHost, nil
+}
+
+// ClearAlertSMTPHost clears the value of the "alert_smtp_host" field.
+func (m *BackupConfigMutation) ClearAlertSMTPHost() {
+	m.alert_smtp_host = nil
+	m.clearedFields[backupconfig.FieldAlertSMTPHost] = struct{}{}
+}
+
+// AlertSMTPHostCleared returns if the "alert_smtp_host" field was cleared in this mutation.
+func (m *BackupConfigMutation) AlertSMTPHostCleared() bool {
+	_, ok := m.clearedFields[backupconfig.FieldAlertSMTPHost]
+	return ok
+}
+
+// ResetAlertSMTPHost resets all changes to the "alert_smtp_host" field.
+func (m *BackupConfigMutation) ResetAlertSMTPHost() {
+	m.alert_smtp_host = nil
+	delete(m.clearedFields, backupconfig.FieldAlertSMTPHost)
+}
+
+// SetAlertSMTPPort sets the "alert_smtp_port" field.
+func (m *BackupConfigMutation) SetAlertSMTPPort(i int) {
+	m.alert_smtp_port = &i
+	m.addalert_smtp_port = nil
+}
+
+// AlertSMTPPort returns the value of the "alert_smtp_port" field in the mutation.
+func (m *BackupConfigMutation) AlertSMTPPort() (r int, exists bool) {
+	v := m.alert_smtp_port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlertSMTPPort returns the old "alert_smtp_port" field's value of the BackupConfig entity.
+// If the BackupConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupConfigMutation) OldAlertSMTPPort(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlertSMTPPort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlertSMTPPort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlertSMTPPort: %w", err)
+	}
+	return oldValue.AlertSMTPPort, nil
+}
+
+// AddAlertSMTPPort adds i to the "alert_smtp_port" field.
+func (m *BackupConfigMutation) AddAlertSMTPPort(i int) {
+	if m.addalert_smtp_port != nil {
+		*m.addalert_smtp_port += i
+	} else {
+		m.addalert_smtp_port = &i
+	}
+}
+
+// AddedAlertSMTPPort returns the value that was added to the "alert_smtp_port" field in this mutation.
+func (m *BackupConfigMutation) AddedAlertSMTPPort() (r int, exists bool) {
+	v := m.addalert_smtp_port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAlertSMTPPort resets all changes to the "alert_smtp_port" field.
+func (m *BackupConfigMutation) ResetAlertSMTPPort() {
+	m.alert_smtp_port = nil
+	m.addalert_smtp_port = nil
+}
+
+// SetAlertSMTPFrom sets the "alert_smtp_from" field.
+func (m *BackupConfigMutation) SetAlertSMTPFrom(s string) {
+	m.alert_smtp_from = &s
+}
+
+// AlertSMTPFrom returns the value of the "alert_smtp_from" field in the mutation.
+func (m *BackupConfigMutation) AlertSMTPFrom() (r string, exists bool) {
+	v := m.alert_smtp_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlertSMTPFrom returns the old "alert_smtp_from" field's value of the BackupConfig entity.
+// If the BackupConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupConfigMutation) OldAlertSMTPFrom(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlertSMTPFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlertSMTPFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlertSMTPFrom: %w", err)
+	}
+	return oldValue.AlertSMTPFrom, nil
+}
+
+// ClearAlertSMTPFrom clears the value of the "alert_smtp_from" field.
+func (m *BackupConfigMutation) ClearAlertSMTPFrom() {
+	m.alert_smtp_from = nil
+	m.clearedFields[backupconfig.FieldAlertSMTPFrom] = struct{}{}
+}
+
+// AlertSMTPFromCleared returns if the "alert_smtp_from" field was cleared in this mutation.
+func (m *BackupConfigMutation) AlertSMTPFromCleared() bool {
+	_, ok := m.clearedFields[backupconfig.FieldAlertSMTPFrom]
+	return ok
+}
+
+// ResetAlertSMTPFrom resets all changes to the "alert_smtp_from" field.
+func (m *BackupConfigMutation) ResetAlertSMTPFrom() {
+	m.alert_smtp_from = nil
+	delete(m.clearedFields, backupconfig.FieldAlertSMTPFrom)
+}
+
+// SetAlertSMTPTo sets the "alert_smtp_to" field.
+func (m *BackupConfigMutation) SetAlertSMTPTo(s string) {
+	m.alert_smtp_to = &s
+}
+
+// AlertSMTPTo returns the value of the "alert_smtp_to" field in the mutation.
+func (m *BackupConfigMutation) AlertSMTPTo() (r string, exists bool) {
+	v := m.alert_smtp_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlertSMTPTo returns the old "alert_smtp_to" field's value of the BackupConfig entity.
+// If the BackupConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupConfigMutation) OldAlertSMTPTo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlertSMTPTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlertSMTPTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlertSMTPTo: %w", err)
+	}
+	return oldValue.AlertSMTPTo, nil
+}
+
+// ClearAlertSMTPTo clears the value of the "alert_smtp_to" field.
+func (m *BackupConfigMutation) ClearAlertSMTPTo() {
+	m.alert_smtp_to = nil
+	m.clearedFields[backupconfig.FieldAlertSMTPTo] = struct{}{}
+}
+
+// AlertSMTPToCleared returns if the "alert_smtp_to" field was cleared in this mutation.
+func (m *BackupConfigMutation) AlertSMTPToCleared() bool {
+	_, ok := m.clearedFields[backupconfig.FieldAlertSMTPTo]
+	return ok
+}
+
+// ResetAlertSMTPTo resets all changes to the "alert_smtp_to" field.
+func (m *BackupConfigMutation) ResetAlertSMTPTo() {
+	m.alert_smtp_to = nil
+	delete(m.clearedFields, backupconfig.FieldAlertSMTPTo)
+}
+
+// SetAlertOnFailure sets the "alert_on_failure" field.
+func (m *BackupConfigMutation) SetAlertOnFailure(b bool) {
+	m.alert_on_failure = &b
+}
+
+// AlertOnFailure returns the value of the "alert_on_failure" field in the mutation.
+func (m *BackupConfigMutation) AlertOnFailure() (r bool, exists bool) {
+	v := m.alert_on_failure
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlertOnFailure returns the old "alert_on_failure" field's value of the BackupConfig entity.
+// If the BackupConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupConfigMutation) OldAlertOnFailure(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlertOnFailure is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlertOnFailure requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlertOnFailure: %w", err)
+	}
+	return oldValue.AlertOnFailure, nil
+}
+
+// ResetAlertOnFailure resets all changes to the "alert_on_failure" field.
+func (m *BackupConfigMutation) ResetAlertOnFailure() {
+	m.alert_on_failure = nil
+}
+
 // SetLastBackupAt sets the "last_backup_at" field.
 func (m *BackupConfigMutation) SetLastBackupAt(t time.Time) {
 	m.last_backup_at = &t
@@ -1743,7 +2048,7 @@ func (m *BackupConfigMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *BackupConfigMutation) Fields() []string {
-	fields := make([]string, 0, 17)
+	fields := make([]string, 0, 23)
 	if m.webdav_url != nil {
 		fields = append(fields, backupconfig.FieldWebdavURL)
 	}
@@ -1786,6 +2091,24 @@ func (m *BackupConfigMutation) Fields() []string {
 	if m.backup_targets_migrated != nil {
 		fields = append(fields, backupconfig.FieldBackupTargetsMigrated)
 	}
+	if m.alert_webhook_url != nil {
+		fields = append(fields, backupconfig.FieldAlertWebhookURL)
+	}
+	if m.alert_smtp_host != nil {
+		fields = append(fields, backupconfig.FieldAlertSMTPHost)
+	}
+	if m.alert_smtp_port != nil {
+		fields = append(fields, backupconfig.FieldAlertSMTPPort)
+	}
+	if m.alert_smtp_from != nil {
+		fields = append(fields, backupconfig.FieldAlertSMTPFrom)
+	}
+	if m.alert_smtp_to != nil {
+		fields = append(fields, backupconfig.FieldAlertSMTPTo)
+	}
+	if m.alert_on_failure != nil {
+		fields = append(fields, backupconfig.FieldAlertOnFailure)
+	}
 	if m.last_backup_at != nil {
 		fields = append(fields, backupconfig.FieldLastBackupAt)
 	}
@@ -1831,6 +2154,18 @@ func (m *BackupConfigMutation) Field(name string) (ent.Value, bool) {
 		return m.FolderMaxDepth()
 	case backupconfig.FieldBackupTargetsMigrated:
 		return m.BackupTargetsMigrated()
+	case backupconfig.FieldAlertWebhookURL:
+		return m.AlertWebhookURL()
+	case backupconfig.FieldAlertSMTPHost:
+		return m.AlertSMTPHost()
+	case backupconfig.FieldAlertSMTPPort:
+		return m.AlertSMTPPort()
+	case backupconfig.FieldAlertSMTPFrom:
+		return m.AlertSMTPFrom()
+	case backupconfig.FieldAlertSMTPTo:
+		return m.AlertSMTPTo()
+	case backupconfig.FieldAlertOnFailure:
+		return m.AlertOnFailure()
 	case backupconfig.FieldLastBackupAt:
 		return m.LastBackupAt()
 	case backupconfig.FieldCreatedAt:
@@ -1874,6 +2209,18 @@ func (m *BackupConfigMutation) OldField(ctx context.Context, name string) (ent.V
 		return m.OldFolderMaxDepth(ctx)
 	case backupconfig.FieldBackupTargetsMigrated:
 		return m.OldBackupTargetsMigrated(ctx)
+	case backupconfig.FieldAlertWebhookURL:
+		return m.OldAlertWebhookURL(ctx)
+	case backupconfig.FieldAlertSMTPHost:
+		return m.OldAlertSMTPHost(ctx)
+	case backupconfig.FieldAlertSMTPPort:
+		return m.OldAlertSMTPPort(ctx)
+	case backupconfig.FieldAlertSMTPFrom:
+		return m.OldAlertSMTPFrom(ctx)
+	case backupconfig.FieldAlertSMTPTo:
+		return m.OldAlertSMTPTo(ctx)
+	case backupconfig.FieldAlertOnFailure:
+		return m.OldAlertOnFailure(ctx)
 	case backupconfig.FieldLastBackupAt:
 		return m.OldLastBackupAt(ctx)
 	case backupconfig.FieldCreatedAt:
@@ -1987,6 +2334,48 @@ func (m *BackupConfigMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetBackupTargetsMigrated(v)
 		return nil
+	case backupconfig.FieldAlertWebhookURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlertWebhookURL(v)
+		return nil
+	case backupconfig.FieldAlertSMTPHost:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlertSMTPHost(v)
+		return nil
+	case backupconfig.FieldAlertSMTPPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlertSMTPPort(v)
+		return nil
+	case backupconfig.FieldAlertSMTPFrom:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlertSMTPFrom(v)
+		return nil
+	case backupconfig.FieldAlertSMTPTo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlertSMTPTo(v)
+		return nil
+	case backupconfig.FieldAlertOnFailure:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlertOnFailure(v)
+		return nil
 	case backupconfig.FieldLastBackupAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -2025,6 +2414,9 @@ func (m *BackupConfigMutation) AddedFields() []string {
 	if m.addfolder_max_depth != nil {
 		fields = append(fields, backupconfig.FieldFolderMaxDepth)
 	}
+	if m.addalert_smtp_port != nil {
+		fields = append(fields, backupconfig.FieldAlertSMTPPort)
+	}
 	return fields
 }
 
@@ -2039,6 +2431,8 @@ func (m *BackupConfigMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedBackupMaxCount()
 	case backupconfig.FieldFolderMaxDepth:
 		return m.AddedFolderMaxDepth()
+	case backupconfig.FieldAlertSMTPPort:
+		return m.AddedAlertSMTPPort()
 	}
 	return nil, false
 }
@@ -2069,6 +2463,13 @@ func (m *BackupConfigMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddFolderMaxDepth(v)
 		return nil
+	case backupconfig.FieldAlertSMTPPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAlertSMTPPort(v)
+		return nil
 	}
 	return fmt.Errorf("unknown BackupConfig numeric field %s", name)
 }
@@ -2101,6 +2502,18 @@ func (m *BackupConfigMutation) ClearedFields() []string {
 	if m.FieldCleared(backupconfig.FieldS3SecretKey) {
 		fields = append(fields, backupconfig.FieldS3SecretKey)
 	}
+	if m.FieldCleared(backupconfig.FieldAlertWebhookURL) {
+		fields = append(fields, backupconfig.FieldAlertWebhookURL)
+	}
+	if m.FieldCleared(backupconfig.FieldAlertSMTPHost) {
+		fields = append(fields, backupconfig.FieldAlertSMTPHost)
+	}
+	if m.FieldCleared(backupconfig.FieldAlertSMTPFrom) {
+		fields = append(fields, backupconfig.FieldAlertSMTPFrom)
+	}
+	if m.FieldCleared(backupconfig.FieldAlertSMTPTo) {
+		fields = append(fields, backupconfig.FieldAlertSMTPTo)
+	}
 	if m.FieldCleared(backupconfig.FieldLastBackupAt) {
 		fields = append(fields, backupconfig.FieldLastBackupAt)
 	}
@@ -2142,6 +2555,18 @@ func (m *BackupConfigMutation) ClearField(name string) error {
 	case backupconfig.FieldS3SecretKey:
 		m.ClearS3SecretKey()
 		return nil
+	case backupconfig.FieldAlertWebhookURL:
+		m.ClearAlertWebhookURL()
+		return nil
+	case backupconfig.FieldAlertSMTPHost:
+		m.ClearAlertSMTPHost()
+		return nil
+	case backupconfig.FieldAlertSMTPFrom:
+		m.ClearAlertSMTPFrom()
+		return nil
+	case backupconfig.FieldAlertSMTPTo:
+		m.ClearAlertSMTPTo()
+		return nil
 	case backupconfig.FieldLastBackupAt:
 		m.ClearLastBackupAt()
 		return nil
@@ -2195,6 +2620,24 @@ func (m *BackupConfigMutation) ResetField(name string) error {
 	case backupconfig.FieldBackupTargetsMigrated:
 		m.ResetBackupTargetsMigrated()
 		return nil
+	case backupconfig.FieldAlertWebhookURL:
+		m.ResetAlertWebhookURL()
+		return nil
+	case backupconfig.FieldAlertSMTPHost:
+		m.ResetAlertSMTPHost()
+		return nil
+	case backupconfig.FieldAlertSMTPPort:
+		m.ResetAlertSMTPPort()
+		return nil
+	case backupconfig.FieldAlertSMTPFrom:
+		m.ResetAlertSMTPFrom()
+		return nil
+	case backupconfig.FieldAlertSMTPTo:
+		m.ResetAlertSMTPTo()
+		return nil
+	case backupconfig.FieldAlertOnFailure:
+		m.ResetAlertOnFailure()
+		return nil
 	case backupconfig.FieldLastBackupAt:
 		m.ResetLastBackupAt()
 		return nil
@@ -2256,51 +2699,41 @@ func (m *BackupConfigMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown BackupConfig edge %s", name)
 }
 
-// BackupTargetMutation represents an operation that mutates the BackupTarget nodes in the graph.
-type BackupTargetMutation struct {
+// BackupDrillMutation represents an operation that mutates the BackupDrill nodes in the graph.
+type BackupDrillMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	name               *string
-	_type              *string
-	enabled            *bool
-	last_backup_status *string
-	last_backup_error  *string
-	last_backup_at     *time.Time
-	last_test_status   *string
-	last_test_error    *string
-	last_test_at       *time.Time
-	webdav_url         *string
-	webdav_user        *string
-	webdav_password    *string
-	s3_endpoint        *string
-	s3_region          *string
-	s3_bucket          *string
-	s3_access_key      *string
-	s3_secret_key      *string
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	tasks              map[int]struct{}
-	removedtasks       map[int]struct{}
-	clearedtasks       bool
-	done               bool
-	oldValue           func(context.Context) (*BackupTarget, error)
-	predicates         []predicate.BackupTarget
-}
-
-var _ ent.Mutation = (*BackupTargetMutation)(nil)
-
-// backuptargetOption allows management of the mutation configuration using functional options.
-type backuptargetOption func(*BackupTargetMutation)
-
-// newBackupTargetMutation creates new mutation for the BackupTarget entity.
-func newBackupTargetMutation(c config, op Op, opts ...backuptargetOption) *BackupTargetMutation {
-	m := &BackupTargetMutation{
+	op             Op
+	typ            string
+	id             *int
+	filename       *string
+	trigger        *string
+	status         *string
+	error          *string
+	checks_json    *string
+	counts_json    *string
+	started_at     *time.Time
+	finished_at    *time.Time
+	duration_ms    *int64
+	addduration_ms *int64
+	clearedFields  map[string]struct{}
+	target         *int
+	clearedtarget  bool
+	done           bool
+	oldValue       func(context.Context) (*BackupDrill, error)
+	predicates     []predicate.BackupDrill
+}
+
+var _ ent.Mutation = (*BackupDrillMutation)(nil)
+
+// backupdrillOption allows management of the mutation configuration using functional options.
+type backupdrillOption func(*BackupDrillMutation)
+
+// newBackupDrillMutation creates new mutation for the BackupDrill entity.
+func newBackupDrillMutation(c config, op Op, opts ...backupdrillOption) *BackupDrillMutation {
+	m := &BackupDrillMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBackupTarget,
+		typ:           TypeBackupDrill,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2309,20 +2742,20 @@ func newBackupTargetMutation(c config, op Op, opts ...backuptargetOption) *Backu
 	return m
 }
 
-// withBackupTargetID sets the ID field of the mutation.
-func withBackupTargetID(id int) backuptargetOption {
-	return func(m *BackupTargetMutation) {
+// withBackupDrillID sets the ID field of the mutation.
+func withBackupDrillID(id int) backupdrillOption {
+	return func(m *BackupDrillMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *BackupTarget
+			value *BackupDrill
 		)
-		m.oldValue = func(ctx context.Context) (*BackupTarget, error) {
+		m.oldValue = func(ctx context.Context) (*BackupDrill, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().BackupTarget.Get(ctx, id)
+					value, err = m.Client().BackupDrill.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2331,10 +2764,10 @@ func withBackupTargetID(id int) backuptargetOption {
 	}
 }
 
-// withBackupTarget sets the old BackupTarget of the mutation.
-func withBackupTarget(node *BackupTarget) backuptargetOption {
-	return func(m *BackupTargetMutation) {
-		m.oldValue = func(context.Context) (*BackupTarget, error) {
+// withBackupDrill sets the old BackupDrill of the mutation.
+func withBackupDrill(node *BackupDrill) backupdrillOption {
+	return func(m *BackupDrillMutation) {
+		m.oldValue = func(context.Context) (*BackupDrill, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2343,7 +2776,7 @@ func withBackupTarget(node *BackupTarget) backuptargetOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BackupTargetMutation) Client() *Client {
+func (m BackupDrillMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2351,7 +2784,7 @@ func (m BackupTargetMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BackupTargetMutation) Tx() (*Tx, error) {
+func (m BackupDrillMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2362,7 +2795,7 @@ func (m BackupTargetMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BackupTargetMutation) ID() (id int, exists bool) {
+func (m *BackupDrillMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2373,7 +2806,7 @@ func (m *BackupTargetMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BackupTargetMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BackupDrillMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2382,915 +2815,1179 @@ func (m *BackupTargetMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().BackupTarget.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BackupDrill.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *BackupTargetMutation) SetName(s string) {
-	m.name = &s
+// SetTargetID sets the "target_id" field.
+func (m *BackupDrillMutation) SetTargetID(i int) {
+	m.target = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *BackupTargetMutation) Name() (r string, exists bool) {
-	v := m.name
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *BackupDrillMutation) TargetID() (r int, exists bool) {
+	v := m.target
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetID returns the old "target_id" field's value of the BackupDrill entity.
+// If the BackupDrill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BackupDrillMutation) OldTargetID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.TargetID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *BackupTargetMutation) ResetName() {
-	m.name = nil
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *BackupDrillMutation) ResetTargetID() {
+	m.target = nil
 }
 
-// SetType sets the "type" field.
-func (m *BackupTargetMutation) SetType(s string) {
-	m._type = &s
+// SetFilename sets the "filename" field.
+func (m *BackupDrillMutation) SetFilename(s string) {
+	m.filename = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *BackupTargetMutation) GetType() (r string, exists bool) {
-	v := m._type
+// Filename returns the value of the "filename" field in the mutation.
+func (m *BackupDrillMutation) Filename() (r string, exists bool) {
+	v := m.filename
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldFilename returns the old "filename" field's value of the BackupDrill entity.
+// If the BackupDrill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *BackupDrillMutation) OldFilename(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldFilename requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Filename, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *BackupTargetMutation) ResetType() {
-	m._type = nil
+// ClearFilename clears the value of the "filename" field.
+func (m *BackupDrillMutation) ClearFilename() {
+	m.filename = nil
+	m.clearedFields[backupdrill.FieldFilename] = struct{}{}
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *BackupTargetMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// FilenameCleared returns if the "filename" field was cleared in this mutation.
+func (m *BackupDrillMutation) FilenameCleared() bool {
+	_, ok := m.clearedFields[backupdrill.FieldFilename]
+	return ok
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *BackupTargetMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// ResetFilename resets all changes to the "filename" field.
+func (m *BackupDrillMutation) ResetFilename() {
+	m.filename = nil
+	delete(m.clearedFields, backupdrill.FieldFilename)
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *BackupDrillMutation) SetTrigger(s string) {
+	m.trigger = &s
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *BackupDrillMutation) Trigger() (r string, exists bool) {
+	v := m.trigger
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldTrigger returns the old "trigger" field's value of the BackupDrill entity.
+// If the BackupDrill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *BackupDrillMutation) OldTrigger(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.Trigger, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *BackupTargetMutation) ResetEnabled() {
-	m.enabled = nil
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *BackupDrillMutation) ResetTrigger() {
+	m.trigger = nil
 }
 
-// SetLastBackupStatus sets the "last_backup_status" field.
-func (m *BackupTargetMutation) SetLastBackupStatus(s string) {
-	m.last_backup_status = &s
+// SetStatus sets the "status" field.
+func (m *BackupDrillMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// LastBackupStatus returns the value of the "last_backup_status" field in the mutation.
-func (m *BackupTargetMutation) LastBackupStatus() (r string, exists bool) {
-	v := m.last_backup_status
+// Status returns the value of the "status" field in the mutation.
+func (m *BackupDrillMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastBackupStatus returns the old "last_backup_status" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the BackupDrill entity.
+// If the BackupDrill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldLastBackupStatus(ctx context.Context) (v string, err error) {
+func (m *BackupDrillMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastBackupStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastBackupStatus requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastBackupStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.LastBackupStatus, nil
+	return oldValue.Status, nil
 }
 
-// ResetLastBackupStatus resets all changes to the "last_backup_status" field.
-func (m *BackupTargetMutation) ResetLastBackupStatus() {
-	m.last_backup_status = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *BackupDrillMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetLastBackupError sets the "last_backup_error" field.
-func (m *BackupTargetMutation) SetLastBackupError(s string) {
-	m.last_backup_error = &s
+// SetError sets the "error" field.
+func (m *BackupDrillMutation) SetError(s string) {
+	m.error = &s
 }
 
-// LastBackupError returns the value of the "last_backup_error" field in the mutation.
-func (m *BackupTargetMutation) LastBackupError() (r string, exists bool) {
-	v := m.last_backup_error
+// Error returns the value of the "error" field in the mutation.
+func (m *BackupDrillMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastBackupError returns the old "last_backup_error" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the BackupDrill entity.
+// If the BackupDrill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldLastBackupError(ctx context.Context) (v string, err error) {
+func (m *BackupDrillMutation) OldError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastBackupError is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastBackupError requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastBackupError: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.LastBackupError, nil
+	return oldValue.Error, nil
 }
 
-// ClearLastBackupError clears the value of the "last_backup_error" field.
-func (m *BackupTargetMutation) ClearLastBackupError() {
-	m.last_backup_error = nil
-	m.clearedFields[backuptarget.FieldLastBackupError] = struct{}{}
+// ClearError clears the value of the "error" field.
+func (m *BackupDrillMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[backupdrill.FieldError] = struct{}{}
 }
 
-// LastBackupErrorCleared returns if the "last_backup_error" field was cleared in this mutation.
-func (m *BackupTargetMutation) LastBackupErrorCleared() bool {
-	_, ok := m.clearedFields[backuptarget.FieldLastBackupError]
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *BackupDrillMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[backupdrill.FieldError]
 	return ok
 }
 
-// ResetLastBackupError resets all changes to the "last_backup_error" field.
-func (m *BackupTargetMutation) ResetLastBackupError() {
-	m.last_backup_error = nil
-	delete(m.clearedFields, backuptarget.FieldLastBackupError)
+// ResetError resets all changes to the "error" field.
+func (m *BackupDrillMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, backupdrill.FieldError)
 }
 
-// SetLastBackupAt sets the "last_backup_at" field.
-func (m *BackupTargetMutation) SetLastBackupAt(t time.Time) {
-	m.last_backup_at = &t
+// SetChecksJSON sets the "checks_json" field.
+func (m *BackupDrillMutation) SetChecksJSON(s string) {
+	m.checks_json = &s
 }
 
-// LastBackupAt returns the value of the "last_backup_at" field in the mutation.
-func (m *BackupTargetMutation) LastBackupAt() (r time.Time, exists bool) {
-	v := m.last_backup_at
+// ChecksJSON returns the value of the "checks_json" field in the mutation.
+func (m *BackupDrillMutation) ChecksJSON() (r string, exists bool) {
+	v := m.checks_json
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastBackupAt returns the old "last_backup_at" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldChecksJSON returns the old "checks_json" field's value of the BackupDrill entity.
+// If the BackupDrill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldLastBackupAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupDrillMutation) OldChecksJSON(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastBackupAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldChecksJSON is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastBackupAt requires an ID field in the mutation")
+		return v, errors.New("OldChecksJSON requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastBackupAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldChecksJSON: %w", err)
 	}
-	return oldValue.LastBackupAt, nil
+	return oldValue.ChecksJSON, nil
 }
 
-// ClearLastBackupAt clears the value of the "last_backup_at" field.
-func (m *BackupTargetMutation) ClearLastBackupAt() {
-	m.last_backup_at = nil
-	m.clearedFields[backuptarget.FieldLastBackupAt] = struct{}{}
+// ClearChecksJSON clears the value of the "checks_json" field.
+func (m *BackupDrillMutation) ClearChecksJSON() {
+	m.checks_json = nil
+	m.clearedFields[backupdrill.FieldChecksJSON] = struct{}{}
 }
 
-// LastBackupAtCleared returns if the "last_backup_at" field was cleared in this mutation.
-func (m *BackupTargetMutation) LastBackupAtCleared() bool {
-	_, ok := m.clearedFields[backuptarget.FieldLastBackupAt]
+// ChecksJSONCleared returns if the "checks_json" field was cleared in this mutation.
+func (m *BackupDrillMutation) ChecksJSONCleared() bool {
+	_, ok := m.clearedFields[backupdrill.FieldChecksJSON]
 	return ok
 }
 
-// ResetLastBackupAt resets all changes to the "last_backup_at" field.
-func (m *BackupTargetMutation) ResetLastBackupAt() {
-	m.last_backup_at = nil
-	delete(m.clearedFields, backuptarget.FieldLastBackupAt)
+// ResetChecksJSON resets all changes to the "checks_json" field.
+func (m *BackupDrillMutation) ResetChecksJSON() {
+	m.checks_json = nil
+	delete(m.clearedFields, backupdrill.FieldChecksJSON)
 }
 
-// SetLastTestStatus sets the "last_test_status" field.
-func (m *BackupTargetMutation) SetLastTestStatus(s string) {
-	m.last_test_status = &s
+// SetCountsJSON sets the "counts_json" field.
+func (m *BackupDrillMutation) SetCountsJSON(s string) {
+	m.counts_json = &s
 }
 
-// LastTestStatus returns the value of the "last_test_status" field in the mutation.
-func (m *BackupTargetMutation) LastTestStatus() (r string, exists bool) {
-	v := m.last_test_status
+// CountsJSON returns the value of the "counts_json" field in the mutation.
+func (m *BackupDrillMutation) CountsJSON() (r string, exists bool) {
+	v := m.counts_json
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastTestStatus returns the old "last_test_status" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldCountsJSON returns the old "counts_json" field's value of the BackupDrill entity.
+// If the BackupDrill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldLastTestStatus(ctx context.Context) (v string, err error) {
+func (m *BackupDrillMutation) OldCountsJSON(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastTestStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldCountsJSON is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastTestStatus requires an ID field in the mutation")
+		return v, errors.New("OldCountsJSON requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastTestStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldCountsJSON: %w", err)
 	}
-	return oldValue.LastTestStatus, nil
+	return oldValue.CountsJSON, nil
 }
 
-// ResetLastTestStatus resets all changes to the "last_test_status" field.
-func (m *BackupTargetMutation) ResetLastTestStatus() {
-	m.last_test_status = nil
+// ClearCountsJSON clears the value of the "counts_json" field.
+func (m *BackupDrillMutation) ClearCountsJSON() {
+	m.counts_json = nil
+	m.clearedFields[backupdrill.FieldCountsJSON] = struct{}{}
 }
 
-// SetLastTestError sets the "last_test_error" field.
-func (m *BackupTargetMutation) SetLastTestError(s string) {
-	m.last_test_error = &s
+// CountsJSONCleared returns if the "counts_json" field was cleared in this mutation.
+func (m *BackupDrillMutation) CountsJSONCleared() bool {
+	_, ok := m.clearedFields[backupdrill.FieldCountsJSON]
+	return ok
 }
 
-// LastTestError returns the value of the "last_test_error" field in the mutation.
-func (m *BackupTargetMutation) LastTestError() (r string, exists bool) {
-	v := m.last_test_error
+// ResetCountsJSON resets all changes to the "counts_json" field.
+func (m *BackupDrillMutation) ResetCountsJSON() {
+	m.counts_json = nil
+	delete(m.clearedFields, backupdrill.FieldCountsJSON)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *BackupDrillMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *BackupDrillMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastTestError returns the old "last_test_error" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the BackupDrill entity.
+// If the BackupDrill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldLastTestError(ctx context.Context) (v string, err error) {
+func (m *BackupDrillMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastTestError is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastTestError requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastTestError: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.LastTestError, nil
-}
-
-// ClearLastTestError clears the value of the "last_test_error" field.
-func (m *BackupTargetMutation) ClearLastTestError() {
-	m.last_test_error = nil
-	m.clearedFields[backuptarget.FieldLastTestError] = struct{}{}
-}
-
-// LastTestErrorCleared returns if the "last_test_error" field was cleared in this mutation.
-func (m *BackupTargetMutation) LastTestErrorCleared() bool {
-	_, ok := m.clearedFields[backuptarget.FieldLastTestError]
-	return ok
+	return oldValue.StartedAt, nil
 }
 
-// ResetLastTestError resets all changes to the "last_test_error" field.
-func (m *BackupTargetMutation) ResetLastTestError() {
-	m.last_test_error = nil
-	delete(m.clearedFields, backuptarget.FieldLastTestError)
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *BackupDrillMutation) ResetStartedAt() {
+	m.started_at = nil
 }
 
-// SetLastTestAt sets the "last_test_at" field.
-func (m *BackupTargetMutation) SetLastTestAt(t time.Time) {
-	m.last_test_at = &t
+// SetFinishedAt sets the "finished_at" field.
+func (m *BackupDrillMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
 }
 
-// LastTestAt returns the value of the "last_test_at" field in the mutation.
-func (m *BackupTargetMutation) LastTestAt() (r time.Time, exists bool) {
-	v := m.last_test_at
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *BackupDrillMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastTestAt returns the old "last_test_at" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldFinishedAt returns the old "finished_at" field's value of the BackupDrill entity.
+// If the BackupDrill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldLastTestAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupDrillMutation) OldFinishedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastTestAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastTestAt requires an ID field in the mutation")
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastTestAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
-	return oldValue.LastTestAt, nil
-}
-
-// ClearLastTestAt clears the value of the "last_test_at" field.
-func (m *BackupTargetMutation) ClearLastTestAt() {
-	m.last_test_at = nil
-	m.clearedFields[backuptarget.FieldLastTestAt] = struct{}{}
-}
-
-// LastTestAtCleared returns if the "last_test_at" field was cleared in this mutation.
-func (m *BackupTargetMutation) LastTestAtCleared() bool {
-	_, ok := m.clearedFields[backuptarget.FieldLastTestAt]
-	return ok
+	return oldValue.FinishedAt, nil
 }
 
-// ResetLastTestAt resets all changes to the "last_test_at" field.
-func (m *BackupTargetMutation) ResetLastTestAt() {
-	m.last_test_at = nil
-	delete(m.clearedFields, backuptarget.FieldLastTestAt)
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *BackupDrillMutation) ResetFinishedAt() {
+	m.finished_at = nil
 }
 
-// SetWebdavURL sets the "webdav_url" field.
-func (m *BackupTargetMutation) SetWebdavURL(s string) {
-	m.webdav_url = &s
+// SetDurationMs sets the "duration_ms" field.
+func (m *BackupDrillMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
 }
 
-// WebdavURL returns the value of the "webdav_url" field in the mutation.
-func (m *BackupTargetMutation) WebdavURL() (r string, exists bool) {
-	v := m.webdav_url
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *BackupDrillMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWebdavURL returns the old "webdav_url" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldDurationMs returns the old "duration_ms" field's value of the BackupDrill entity.
+// If the BackupDrill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldWebdavURL(ctx context.Context) (v string, err error) {
+func (m *BackupDrillMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWebdavURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWebdavURL requires an ID field in the mutation")
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWebdavURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
 	}
-	return oldValue.WebdavURL, nil
-}
-
-// ClearWebdavURL clears the value of the "webdav_url" field.
-func (m *BackupTargetMutation) ClearWebdavURL() {
-	m.webdav_url = nil
-	m.clearedFields[backuptarget.FieldWebdavURL] = struct{}{}
-}
-
-// WebdavURLCleared returns if the "webdav_url" field was cleared in this mutation.
-func (m *BackupTargetMutation) WebdavURLCleared() bool {
-	_, ok := m.clearedFields[backuptarget.FieldWebdavURL]
-	return ok
-}
-
-// ResetWebdavURL resets all changes to the "webdav_url" field.
-func (m *BackupTargetMutation) ResetWebdavURL() {
-	m.webdav_url = nil
-	delete(m.clearedFields, backuptarget.FieldWebdavURL)
+	return oldValue.DurationMs, nil
 }
 
-// SetWebdavUser sets the "webdav_user" field.
-func (m *BackupTargetMutation) SetWebdavUser(s string) {
-	m.webdav_user = &s
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *BackupDrillMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
 }
 
-// WebdavUser returns the value of the "webdav_user" field in the mutation.
-func (m *BackupTargetMutation) WebdavUser() (r string, exists bool) {
-	v := m.webdav_user
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *BackupDrillMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWebdavUser returns the old "webdav_user" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldWebdavUser(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWebdavUser is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWebdavUser requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWebdavUser: %w", err)
-	}
-	return oldValue.WebdavUser, nil
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *BackupDrillMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
 }
 
-// ClearWebdavUser clears the value of the "webdav_user" field.
-func (m *BackupTargetMutation) ClearWebdavUser() {
-	m.webdav_user = nil
-	m.clearedFields[backuptarget.FieldWebdavUser] = struct{}{}
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (m *BackupDrillMutation) ClearTarget() {
+	m.clearedtarget = true
+	m.clearedFields[backupdrill.FieldTargetID] = struct{}{}
 }
 
-// WebdavUserCleared returns if the "webdav_user" field was cleared in this mutation.
-func (m *BackupTargetMutation) WebdavUserCleared() bool {
-	_, ok := m.clearedFields[backuptarget.FieldWebdavUser]
-	return ok
+// TargetCleared reports if the "target" edge to the BackupTarget entity was cleared.
+func (m *BackupDrillMutation) TargetCleared() bool {
+	return m.clearedtarget
 }
 
-// ResetWebdavUser resets all changes to the "webdav_user" field.
-func (m *BackupTargetMutation) ResetWebdavUser() {
-	m.webdav_user = nil
-	delete(m.clearedFields, backuptarget.FieldWebdavUser)
+// TargetIDs returns the "target" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TargetID instead. It exists only for internal usage by the builders.
+func (m *BackupDrillMutation) TargetIDs() (ids []int) {
+	if id := m.target; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// SetWebdavPassword sets the "webdav_password" field.
-func (m *BackupTargetMutation) SetWebdavPassword(s string) {
-	m.webdav_password = &s
+// ResetTarget resets all changes to the "target" edge.
+func (m *BackupDrillMutation) ResetTarget() {
+	m.target = nil
+	m.clearedtarget = false
 }
 
-// WebdavPassword returns the value of the "webdav_password" field in the mutation.
-func (m *BackupTargetMutation) WebdavPassword() (r string, exists bool) {
-	v := m.webdav_password
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the BackupDrillMutation builder.
+func (m *BackupDrillMutation) Where(ps ...predicate.BackupDrill) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldWebdavPassword returns the old "webdav_password" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldWebdavPassword(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWebdavPassword is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWebdavPassword requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWebdavPassword: %w", err)
+// WhereP appends storage-level predicates to the BackupDrillMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BackupDrillMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackupDrill, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.WebdavPassword, nil
-}
-
-// ClearWebdavPassword clears the value of the "webdav_password" field.
-func (m *BackupTargetMutation) ClearWebdavPassword() {
-	m.webdav_password = nil
-	m.clearedFields[backuptarget.FieldWebdavPassword] = struct{}{}
+	m.Where(p...)
 }
 
-// WebdavPasswordCleared returns if the "webdav_password" field was cleared in this mutation.
-func (m *BackupTargetMutation) WebdavPasswordCleared() bool {
-	_, ok := m.clearedFields[backuptarget.FieldWebdavPassword]
-	return ok
+// Op returns the operation name.
+func (m *BackupDrillMutation) Op() Op {
+	return m.op
 }
 
-// ResetWebdavPassword resets all changes to the "webdav_password" field.
-func (m *BackupTargetMutation) ResetWebdavPassword() {
-	m.webdav_password = nil
-	delete(m.clearedFields, backuptarget.FieldWebdavPassword)
+// SetOp allows setting the mutation operation.
+func (m *BackupDrillMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetS3Endpoint sets the "s3_endpoint" field.
-func (m *BackupTargetMutation) SetS3Endpoint(s string) {
-	m.s3_endpoint = &s
+// Type returns the node type of this mutation (BackupDrill).
+func (m *BackupDrillMutation) Type() string {
+	return m.typ
 }
 
-// S3Endpoint returns the value of the "s3_endpoint" field in the mutation.
-func (m *BackupTargetMutation) S3Endpoint() (r string, exists bool) {
-	v := m.s3_endpoint
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BackupDrillMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.target != nil {
+		fields = append(fields, backupdrill.FieldTargetID)
 	}
-	return *v, true
-}
-
-// OldS3Endpoint returns the old "s3_endpoint" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldS3Endpoint(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldS3Endpoint is only allowed on UpdateOne operations")
+	if m.filename != nil {
+		fields = append(fields, backupdrill.FieldFilename)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldS3Endpoint requires an ID field in the mutation")
+	if m.trigger != nil {
+		fields = append(fields, backupdrill.FieldTrigger)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldS3Endpoint: %w", err)
+	if m.status != nil {
+		fields = append(fields, backupdrill.FieldStatus)
 	}
-	return oldValue.S3Endpoint, nil
+	if m.error != nil {
+		fields = append(fields, backupdrill.FieldError)
+	}
+	if m.checks_json != nil {
+		fields = append(fields, backupdrill.FieldChecksJSON)
+	}
+	if m.counts_json != nil {
+		fields = append(fields, backupdrill.FieldCountsJSON)
+	}
+	if m.started_at != nil {
+		fields = append(fields, backupdrill.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, backupdrill.FieldFinishedAt)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, backupdrill.FieldDurationMs)
+	}
+	return fields
 }
 
-// ClearS3Endpoint clears the value of the "s3_endpoint" field.
-func (m *BackupTargetMutation) ClearS3Endpoint() {
-	m.s3_endpoint = nil
-	m.clearedFields[backuptarget.FieldS3Endpoint] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BackupDrillMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case backupdrill.FieldTargetID:
+		return m.TargetID()
+	case backupdrill.FieldFilename:
+		return m.Filename()
+	case backupdrill.FieldTrigger:
+		return m.Trigger()
+	case backupdrill.FieldStatus:
+		return m.Status()
+	case backupdrill.FieldError:
+		return m.Error()
+	case backupdrill.FieldChecksJSON:
+		return m.ChecksJSON()
+	case backupdrill.FieldCountsJSON:
+		return m.CountsJSON()
+	case backupdrill.FieldStartedAt:
+		return m.StartedAt()
+	case backupdrill.FieldFinishedAt:
+		return m.FinishedAt()
+	case backupdrill.FieldDurationMs:
+		return m.DurationMs()
+	}
+	return nil, false
 }
 
-// S3EndpointCleared returns if the "s3_endpoint" field was cleared in this mutation.
-func (m *BackupTargetMutation) S3EndpointCleared() bool {
-	_, ok := m.clearedFields[backuptarget.FieldS3Endpoint]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BackupDrillMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case backupdrill.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case backupdrill.FieldFilename:
+		return m.OldFilename(ctx)
+	case backupdrill.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case backupdrill.FieldStatus:
+		return m.OldStatus(ctx)
+	case backupdrill.FieldError:
+		return m.OldError(ctx)
+	case backupdrill.FieldChecksJSON:
+		return m.OldChecksJSON(ctx)
+	case backupdrill.FieldCountsJSON:
+		return m.OldCountsJSON(ctx)
+	case backupdrill.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case backupdrill.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case backupdrill.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	}
+	return nil, fmt.Errorf("unknown BackupDrill field %s", name)
 }
 
-// ResetS3Endpoint resets all changes to the "s3_endpoint" field.
-func (m *BackupTargetMutation) ResetS3Endpoint() {
-	m.s3_endpoint = nil
-	delete(m.clearedFields, backuptarget.FieldS3Endpoint)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackupDrillMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case backupdrill.FieldTargetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	case backupdrill.FieldFilename:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilename(v)
+		return nil
+	case backupdrill.FieldTrigger:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case backupdrill.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case backupdrill.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case backupdrill.FieldChecksJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksJSON(v)
+		return nil
+	case backupdrill.FieldCountsJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountsJSON(v)
+		return nil
+	case backupdrill.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case backupdrill.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case backupdrill.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BackupDrill field %s", name)
 }
 
-// SetS3Region sets the "s3_region" field.
-func (m *BackupTargetMutation) SetS3Region(s string) {
-	m.s3_region = &s
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BackupDrillMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_ms != nil {
+		fields = append(fields, backupdrill.FieldDurationMs)
+	}
+	return fields
 }
 
-// S3Region returns the value of the "s3_region" field in the mutation.
-func (m *BackupTargetMutation) S3Region() (r string, exists bool) {
-	v := m.s3_region
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BackupDrillMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case backupdrill.FieldDurationMs:
+		return m.AddedDurationMs()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldS3Region returns the old "s3_region" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldS3Region(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldS3Region is only allowed on UpdateOne operations")
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackupDrillMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case backupdrill.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldS3Region requires an ID field in the mutation")
+	return fmt.Errorf("unknown BackupDrill numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BackupDrillMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(backupdrill.FieldFilename) {
+		fields = append(fields, backupdrill.FieldFilename)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldS3Region: %w", err)
+	if m.FieldCleared(backupdrill.FieldError) {
+		fields = append(fields, backupdrill.FieldError)
 	}
-	return oldValue.S3Region, nil
+	if m.FieldCleared(backupdrill.FieldChecksJSON) {
+		fields = append(fields, backupdrill.FieldChecksJSON)
+	}
+	if m.FieldCleared(backupdrill.FieldCountsJSON) {
+		fields = append(fields, backupdrill.FieldCountsJSON)
+	}
+	return fields
 }
 
-// ClearS3Region clears the value of the "s3_region" field.
-func (m *BackupTargetMutation) ClearS3Region() {
-	m.s3_region = nil
-	m.clearedFields[backuptarget.FieldS3Region] = struct{}{}
-}
-
-// S3RegionCleared returns if the "s3_region" field was cleared in this mutation.
-func (m *BackupTargetMutation) S3RegionCleared() bool {
-	_, ok := m.clearedFields[backuptarget.FieldS3Region]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BackupDrillMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetS3Region resets all changes to the "s3_region" field.
-func (m *BackupTargetMutation) ResetS3Region() {
-	m.s3_region = nil
-	delete(m.clearedFields, backuptarget.FieldS3Region)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BackupDrillMutation) ClearField(name string) error {
+	switch name {
+	case backupdrill.FieldFilename:
+		m.ClearFilename()
+		return nil
+	case backupdrill.FieldError:
+		m.ClearError()
+		return nil
+	case backupdrill.FieldChecksJSON:
+		m.ClearChecksJSON()
+		return nil
+	case backupdrill.FieldCountsJSON:
+		m.ClearCountsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BackupDrill nullable field %s", name)
 }
 
-// SetS3Bucket sets the "s3_bucket" field.
-func (m *BackupTargetMutation) SetS3Bucket(s string) {
-	m.s3_bucket = &s
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BackupDrillMutation) ResetField(name string) error {
+	switch name {
+	case backupdrill.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case backupdrill.FieldFilename:
+		m.ResetFilename()
+		return nil
+	case backupdrill.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case backupdrill.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case backupdrill.FieldError:
+		m.ResetError()
+		return nil
+	case backupdrill.FieldChecksJSON:
+		m.ResetChecksJSON()
+		return nil
+	case backupdrill.FieldCountsJSON:
+		m.ResetCountsJSON()
+		return nil
+	case backupdrill.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case backupdrill.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case backupdrill.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	}
+	return fmt.Errorf("unknown BackupDrill field %s", name)
 }
 
-// S3Bucket returns the value of the "s3_bucket" field in the mutation.
-func (m *BackupTargetMutation) S3Bucket() (r string, exists bool) {
-	v := m.s3_bucket
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BackupDrillMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.target != nil {
+		edges = append(edges, backupdrill.EdgeTarget)
 	}
-	return *v, true
+	return edges
 }
 
-// OldS3Bucket returns the old "s3_bucket" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldS3Bucket(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldS3Bucket is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldS3Bucket requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldS3Bucket: %w", err)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BackupDrillMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case backupdrill.EdgeTarget:
+		if id := m.target; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.S3Bucket, nil
+	return nil
 }
 
-// ClearS3Bucket clears the value of the "s3_bucket" field.
-func (m *BackupTargetMutation) ClearS3Bucket() {
-	m.s3_bucket = nil
-	m.clearedFields[backuptarget.FieldS3Bucket] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BackupDrillMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// S3BucketCleared returns if the "s3_bucket" field was cleared in this mutation.
-func (m *BackupTargetMutation) S3BucketCleared() bool {
-	_, ok := m.clearedFields[backuptarget.FieldS3Bucket]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BackupDrillMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetS3Bucket resets all changes to the "s3_bucket" field.
-func (m *BackupTargetMutation) ResetS3Bucket() {
-	m.s3_bucket = nil
-	delete(m.clearedFields, backuptarget.FieldS3Bucket)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BackupDrillMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtarget {
+		edges = append(edges, backupdrill.EdgeTarget)
+	}
+	return edges
 }
 
-// SetS3AccessKey sets the "s3_access_key" field.
-func (m *BackupTargetMutation) SetS3AccessKey(s string) {
-	m.s3_access_key = &s
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BackupDrillMutation) EdgeCleared(name string) bool {
+	switch name {
+	case backupdrill.EdgeTarget:
+		return m.clearedtarget
+	}
+	return false
 }
 
-// S3AccessKey returns the value of the "s3_access_key" field in the mutation.
-func (m *BackupTargetMutation) S3AccessKey() (r string, exists bool) {
-	v := m.s3_access_key
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BackupDrillMutation) ClearEdge(name string) error {
+	switch name {
+	case backupdrill.EdgeTarget:
+		m.ClearTarget()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown BackupDrill unique edge %s", name)
 }
 
-// OldS3AccessKey returns the old "s3_access_key" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldS3AccessKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldS3AccessKey is only allowed on UpdateOne operations")
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BackupDrillMutation) ResetEdge(name string) error {
+	switch name {
+	case backupdrill.EdgeTarget:
+		m.ResetTarget()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldS3AccessKey requires an ID field in the mutation")
+	return fmt.Errorf("unknown BackupDrill edge %s", name)
+}
+
+// BackupPinMutation represents an operation that mutates the BackupPin nodes in the graph.
+type BackupPinMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	filename      *string
+	note          *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	target        *int
+	clearedtarget bool
+	done          bool
+	oldValue      func(context.Context) (*BackupPin, error)
+	predicates    []predicate.BackupPin
+}
+
+var _ ent.Mutation = (*BackupPinMutation)(nil)
+
+// backuppinOption allows management of the mutation configuration using functional options.
+type backuppinOption func(*BackupPinMutation)
+
+// newBackupPinMutation creates new mutation for the BackupPin entity.
+func newBackupPinMutation(c config, op Op, opts ...backuppinOption) *BackupPinMutation {
+	m := &BackupPinMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBackupPin,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldS3AccessKey: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.S3AccessKey, nil
+	return m
 }
 
-// ClearS3AccessKey clears the value of the "s3_access_key" field.
-func (m *BackupTargetMutation) ClearS3AccessKey() {
-	m.s3_access_key = nil
-	m.clearedFields[backuptarget.FieldS3AccessKey] = struct{}{}
+// withBackupPinID sets the ID field of the mutation.
+func withBackupPinID(id int) backuppinOption {
+	return func(m *BackupPinMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BackupPin
+		)
+		m.oldValue = func(ctx context.Context) (*BackupPin, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BackupPin.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// S3AccessKeyCleared returns if the "s3_access_key" field was cleared in this mutation.
-func (m *BackupTargetMutation) S3AccessKeyCleared() bool {
-	_, ok := m.clearedFields[backuptarget.FieldS3AccessKey]
-	return ok
+// withBackupPin sets the old BackupPin of the mutation.
+func withBackupPin(node *BackupPin) backuppinOption {
+	return func(m *BackupPinMutation) {
+		m.oldValue = func(context.Context) (*BackupPin, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ResetS3AccessKey resets all changes to the "s3_access_key" field.
-func (m *BackupTargetMutation) ResetS3AccessKey() {
-	m.s3_access_key = nil
-	delete(m.clearedFields, backuptarget.FieldS3AccessKey)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BackupPinMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetS3SecretKey sets the "s3_secret_key" field.
-func (m *BackupTargetMutation) SetS3SecretKey(s string) {
-	m.s3_secret_key = &s
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BackupPinMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// S3SecretKey returns the value of the "s3_secret_key" field in the mutation.
-func (m *BackupTargetMutation) S3SecretKey() (r string, exists bool) {
-	v := m.s3_secret_key
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BackupPinMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BackupPinMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BackupPin.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *BackupPinMutation) SetTargetID(i int) {
+	m.target = &i
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *BackupPinMutation) TargetID() (r int, exists bool) {
+	v := m.target
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldS3SecretKey returns the old "s3_secret_key" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetID returns the old "target_id" field's value of the BackupPin entity.
+// If the BackupPin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldS3SecretKey(ctx context.Context) (v string, err error) {
+func (m *BackupPinMutation) OldTargetID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldS3SecretKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldS3SecretKey requires an ID field in the mutation")
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldS3SecretKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
 	}
-	return oldValue.S3SecretKey, nil
-}
-
-// ClearS3SecretKey clears the value of the "s3_secret_key" field.
-func (m *BackupTargetMutation) ClearS3SecretKey() {
-	m.s3_secret_key = nil
-	m.clearedFields[backuptarget.FieldS3SecretKey] = struct{}{}
-}
-
-// S3SecretKeyCleared returns if the "s3_secret_key" field was cleared in this mutation.
-func (m *BackupTargetMutation) S3SecretKeyCleared() bool {
-	_, ok := m.clearedFields[backuptarget.FieldS3SecretKey]
-	return ok
+	return oldValue.TargetID, nil
 }
 
-// ResetS3SecretKey resets all changes to the "s3_secret_key" field.
-func (m *BackupTargetMutation) ResetS3SecretKey() {
-	m.s3_secret_key = nil
-	delete(m.clearedFields, backuptarget.FieldS3SecretKey)
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *BackupPinMutation) ResetTargetID() {
+	m.target = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *BackupTargetMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetFilename sets the "filename" field.
+func (m *BackupPinMutation) SetFilename(s string) {
+	m.filename = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *BackupTargetMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Filename returns the value of the "filename" field in the mutation.
+func (m *BackupPinMutation) Filename() (r string, exists bool) {
+	v := m.filename
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldFilename returns the old "filename" field's value of the BackupPin entity.
+// If the BackupPin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupPinMutation) OldFilename(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldFilename requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Filename, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *BackupTargetMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetFilename resets all changes to the "filename" field.
+func (m *BackupPinMutation) ResetFilename() {
+	m.filename = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *BackupTargetMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetNote sets the "note" field.
+func (m *BackupPinMutation) SetNote(s string) {
+	m.note = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *BackupTargetMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Note returns the value of the "note" field in the mutation.
+func (m *BackupPinMutation) Note() (r string, exists bool) {
+	v := m.note
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the BackupTarget entity.
-// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// OldNote returns the old "note" field's value of the BackupPin entity.
+// If the BackupPin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTargetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupPinMutation) OldNote(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldNote requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Note, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *BackupTargetMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearNote clears the value of the "note" field.
+func (m *BackupPinMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[backuppin.FieldNote] = struct{}{}
 }
 
-// AddTaskIDs adds the "tasks" edge to the BackupTask entity by ids.
-func (m *BackupTargetMutation) AddTaskIDs(ids ...int) {
-	if m.tasks == nil {
-		m.tasks = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.tasks[ids[i]] = struct{}{}
-	}
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *BackupPinMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[backuppin.FieldNote]
+	return ok
 }
 
-// ClearTasks clears the "tasks" edge to the BackupTask entity.
-func (m *BackupTargetMutation) ClearTasks() {
-	m.clearedtasks = true
+// ResetNote resets all changes to the "note" field.
+func (m *BackupPinMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, backuppin.FieldNote)
 }
 
-// TasksCleared reports if the "tasks" edge to the BackupTask entity was cleared.
-func (m *BackupTargetMutation) TasksCleared() bool {
-	return m.clearedtasks
+// SetCreatedAt sets the "created_at" field.
+func (m *BackupPinMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveTaskIDs removes the "tasks" edge to the BackupTask entity by IDs.
-func (m *BackupTargetMutation) RemoveTaskIDs(ids ...int) {
-	if m.removedtasks == nil {
-		m.removedtasks = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.tasks, ids[i])
-		m.removedtasks[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BackupPinMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTasks returns the removed IDs of the "tasks" edge to the BackupTask entity.
-func (m *BackupTargetMutation) RemovedTasksIDs() (ids []int) {
-	for id := range m.removedtasks {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the BackupPin entity.
+// If the BackupPin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupPinMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// TasksIDs returns the "tasks" edge IDs in the mutation.
-func (m *BackupTargetMutation) TasksIDs() (ids []int) {
-	for id := range m.tasks {
-		ids = append(ids, id)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BackupPinMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (m *BackupPinMutation) ClearTarget() {
+	m.clearedtarget = true
+	m.clearedFields[backuppin.FieldTargetID] = struct{}{}
+}
+
+// TargetCleared reports if the "target" edge to the BackupTarget entity was cleared.
+func (m *BackupPinMutation) TargetCleared() bool {
+	return m.clearedtarget
+}
+
+// TargetIDs returns the "target" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TargetID instead. It exists only for internal usage by the builders.
+func (m *BackupPinMutation) TargetIDs() (ids []int) {
+	if id := m.target; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTasks resets all changes to the "tasks" edge.
-func (m *BackupTargetMutation) ResetTasks() {
-	m.tasks = nil
-	m.clearedtasks = false
-	m.removedtasks = nil
+// ResetTarget resets all changes to the "target" edge.
+func (m *BackupPinMutation) ResetTarget() {
+	m.target = nil
+	m.clearedtarget = false
 }
 
-// Where appends a list predicates to the BackupTargetMutation builder.
-func (m *BackupTargetMutation) Where(ps ...predicate.BackupTarget) {
+// Where appends a list predicates to the BackupPinMutation builder.
+func (m *BackupPinMutation) Where(ps ...predicate.BackupPin) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the BackupTargetMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BackupPinMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BackupTargetMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.BackupTarget, len(ps))
+func (m *BackupPinMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackupPin, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3298,81 +3995,36 @@ func (m *BackupTargetMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *BackupTargetMutation) Op() Op {
+func (m *BackupPinMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *BackupTargetMutation) SetOp(op Op) {
+func (m *BackupPinMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (BackupTarget).
-func (m *BackupTargetMutation) Type() string {
+// Type returns the node type of this mutation (BackupPin).
+func (m *BackupPinMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *BackupTargetMutation) Fields() []string {
-	fields := make([]string, 0, 19)
-	if m.name != nil {
-		fields = append(fields, backuptarget.FieldName)
-	}
-	if m._type != nil {
-		fields = append(fields, backuptarget.FieldType)
-	}
-	if m.enabled != nil {
-		fields = append(fields, backuptarget.FieldEnabled)
-	}
-	if m.last_backup_status != nil {
-		fields = append(fields, backuptarget.FieldLastBackupStatus)
-	}
-	if m.last_backup_error != nil {
-		fields = append(fields, backuptarget.FieldLastBackupError)
-	}
-	if m.last_backup_at != nil {
-		fields = append(fields, backuptarget.FieldLastBackupAt)
-	}
-	if m.last_test_status != nil {
-		fields = append(fields, backuptarget.FieldLastTestStatus)
-	}
-	if m.last_test_error != nil {
-		fields = append(fields, backuptarget.FieldLastTestError)
-	}
-	if m.last_test_at != nil {
-		fields = append(fields, backuptarget.FieldLastTestAt)
-	}
-	if m.webdav_url != nil {
-		fields = append(fields, backuptarget.FieldWebdavURL)
-	}
-	if m.webdav_user != nil {
-		fields = append(fields, backuptarget.FieldWebdavUser)
-	}
-	if m.webdav_password != nil {
-		fields = append(fields, backuptarget.FieldWebdavPassword)
-	}
-	if m.s3_endpoint != nil {
-		fields = append(fields, backuptarget.FieldS3Endpoint)
-	}
-	if m.s3_region != nil {
-		fields = append(fields, backuptarget.FieldS3Region)
-	}
-	if m.s3_bucket != nil {
-		fields = append(fields, backuptarget.FieldS3Bucket)
+func (m *BackupPinMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.target != nil {
+		fields = append(fields, backuppin.FieldTargetID)
 	}
-	if m.s3_access_key != nil {
-		fields = append(fields, backuptarget.FieldS3AccessKey)
+	if m.filename != nil {
+		fields = append(fields, backuppin.FieldFilename)
 	}
-	if m.s3_secret_key != nil {
-		fields = append(fields, backuptarget.FieldS3SecretKey)
+	if m.note != nil {
+		fields = append(fields, backuppin.FieldNote)
 	}
 	if m.created_at != nil {
-		fields = append(fields, backuptarget.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, backuptarget.FieldUpdatedAt)
+		fields = append(fields, backuppin.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3380,46 +4032,16 @@ func (m *BackupTargetMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BackupTargetMutation) Field(name string) (ent.Value, bool) {
+func (m *BackupPinMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case backuptarget.FieldName:
-		return m.Name()
-	case backuptarget.FieldType:
-		return m.GetType()
-	case backuptarget.FieldEnabled:
-		return m.Enabled()
-	case backuptarget.FieldLastBackupStatus:
-		return m.LastBackupStatus()
-	case backuptarget.FieldLastBackupError:
-		return m.LastBackupError()
-	case backuptarget.FieldLastBackupAt:
-		return m.LastBackupAt()
-	case backuptarget.FieldLastTestStatus:
-		return m.LastTestStatus()
-	case backuptarget.FieldLastTestError:
-		return m.LastTestError()
-	case backuptarget.FieldLastTestAt:
-		return m.LastTestAt()
-	case backuptarget.FieldWebdavURL:
-		return m.WebdavURL()
-	case backuptarget.FieldWebdavUser:
-		return m.WebdavUser()
-	case backuptarget.FieldWebdavPassword:
-		return m.WebdavPassword()
-	case backuptarget.FieldS3Endpoint:
-		return m.S3Endpoint()
-	case backuptarget.FieldS3Region:
-		return m.S3Region()
-	case backuptarget.FieldS3Bucket:
-		return m.S3Bucket()
-	case backuptarget.FieldS3AccessKey:
-		return m.S3AccessKey()
-	case backuptarget.FieldS3SecretKey:
-		return m.S3SecretKey()
-	case backuptarget.FieldCreatedAt:
+	case backuppin.FieldTargetID:
+		return m.TargetID()
+	case backuppin.FieldFilename:
+		return m.Filename()
+	case backuppin.FieldNote:
+		return m.Note()
+	case backuppin.FieldCreatedAt:
 		return m.CreatedAt()
-	case backuptarget.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -3427,495 +4049,241 @@ func (m *BackupTargetMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BackupTargetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BackupPinMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case backuptarget.FieldName:
-		return m.OldName(ctx)
-	case backuptarget.FieldType:
-		return m.OldType(ctx)
-	case backuptarget.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case backuptarget.FieldLastBackupStatus:
-		return m.OldLastBackupStatus(ctx)
-	case backuptarget.FieldLastBackupError:
-		return m.OldLastBackupError(ctx)
-	case backuptarget.FieldLastBackupAt:
-		return m.OldLastBackupAt(ctx)
-	case backuptarget.FieldLastTestStatus:
-		return m.OldLastTestStatus(ctx)
-	case backuptarget.FieldLastTestError:
-		return m.OldLastTestError(ctx)
-	case backuptarget.FieldLastTestAt:
-		return m.OldLastTestAt(ctx)
-	case backuptarget.FieldWebdavURL:
-		return m.OldWebdavURL(ctx)
-	case backuptarget.FieldWebdavUser:
-		return m.OldWebdavUser(ctx)
-	case backuptarget.FieldWebdavPassword:
-		return m.OldWebdavPassword(ctx)
-	case backuptarget.FieldS3Endpoint:
-		return m.OldS3Endpoint(ctx)
-	case backuptarget.FieldS3Region:
-		return m.OldS3Region(ctx)
-	case backuptarget.FieldS3Bucket:
-		return m.OldS3Bucket(ctx)
-	case backuptarget.FieldS3AccessKey:
-		return m.OldS3AccessKey(ctx)
-	case backuptarget.FieldS3SecretKey:
-		return m.OldS3SecretKey(ctx)
-	case backuptarget.FieldCreatedAt:
+	case backuppin.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case backuppin.FieldFilename:
+		return m.OldFilename(ctx)
+	case backuppin.FieldNote:
+		return m.OldNote(ctx)
+	case backuppin.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case backuptarget.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown BackupTarget field %s", name)
+	return nil, fmt.Errorf("unknown BackupPin field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BackupTargetMutation) SetField(name string, value ent.Value) error {
+func (m *BackupPinMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case backuptarget.FieldName:
-		v, ok := value.(string)
+	case backuppin.FieldTargetID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetTargetID(v)
 		return nil
-	case backuptarget.FieldType:
+	case backuppin.FieldFilename:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetFilename(v)
 		return nil
-	case backuptarget.FieldEnabled:
-		v, ok := value.(bool)
+	case backuppin.FieldNote:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetNote(v)
 		return nil
-	case backuptarget.FieldLastBackupStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastBackupStatus(v)
-		return nil
-	case backuptarget.FieldLastBackupError:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastBackupError(v)
-		return nil
-	case backuptarget.FieldLastBackupAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastBackupAt(v)
-		return nil
-	case backuptarget.FieldLastTestStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastTestStatus(v)
-		return nil
-	case backuptarget.FieldLastTestError:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastTestError(v)
-		return nil
-	case backuptarget.FieldLastTestAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastTestAt(v)
-		return nil
-	case backuptarget.FieldWebdavURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWebdavURL(v)
-		return nil
-	case backuptarget.FieldWebdavUser:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWebdavUser(v)
-		return nil
-	case backuptarget.FieldWebdavPassword:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWebdavPassword(v)
-		return nil
-	case backuptarget.FieldS3Endpoint:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetS3Endpoint(v)
-		return nil
-	case backuptarget.FieldS3Region:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetS3Region(v)
-		return nil
-	case backuptarget.FieldS3Bucket:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetS3Bucket(v)
-		return nil
-	case backuptarget.FieldS3AccessKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetS3AccessKey(v)
-		return nil
-	case backuptarget.FieldS3SecretKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetS3SecretKey(v)
-		return nil
-	case backuptarget.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case backuppin.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case backuptarget.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown BackupTarget field %s", name)
+	return fmt.Errorf("unknown BackupPin field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BackupTargetMutation) AddedFields() []string {
-	return nil
+func (m *BackupPinMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BackupTargetMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BackupPinMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BackupTargetMutation) AddField(name string, value ent.Value) error {
+func (m *BackupPinMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown BackupTarget numeric field %s", name)
+	return fmt.Errorf("unknown BackupPin numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BackupTargetMutation) ClearedFields() []string {
+func (m *BackupPinMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(backuptarget.FieldLastBackupError) {
-		fields = append(fields, backuptarget.FieldLastBackupError)
-	}
-	if m.FieldCleared(backuptarget.FieldLastBackupAt) {
-		fields = append(fields, backuptarget.FieldLastBackupAt)
-	}
-	if m.FieldCleared(backuptarget.FieldLastTestError) {
-		fields = append(fields, backuptarget.FieldLastTestError)
-	}
-	if m.FieldCleared(backuptarget.FieldLastTestAt) {
-		fields = append(fields, backuptarget.FieldLastTestAt)
-	}
-	if m.FieldCleared(backuptarget.FieldWebdavURL) {
-		fields = append(fields, backuptarget.FieldWebdavURL)
-	}
-	if m.FieldCleared(backuptarget.FieldWebdavUser) {
-		fields = append(fields, backuptarget.FieldWebdavUser)
-	}
-	if m.FieldCleared(backuptarget.FieldWebdavPassword) {
-		fields = append(fields, backuptarget.FieldWebdavPassword)
-	}
-	if m.FieldCleared(backuptarget.FieldS3Endpoint) {
-		fields = append(fields, backuptarget.FieldS3Endpoint)
-	}
-	if m.FieldCleared(backuptarget.FieldS3Region) {
-		fields = append(fields, backuptarget.FieldS3Region)
-	}
-	if m.FieldCleared(backuptarget.FieldS3Bucket) {
-		fields = append(fields, backuptarget.FieldS3Bucket)
-	}
-	if m.FieldCleared(backuptarget.FieldS3AccessKey) {
-		fields = append(fields, backuptarget.FieldS3AccessKey)
-	}
-	if m.FieldCleared(backuptarget.FieldS3SecretKey) {
-		fields = append(fields, backuptarget.FieldS3SecretKey)
+	if m.FieldCleared(backuppin.FieldNote) {
+		fields = append(fields, backuppin.FieldNote)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BackupTargetMutation) FieldCleared(name string) bool {
+func (m *BackupPinMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BackupTargetMutation) ClearField(name string) error {
+func (m *BackupPinMutation) ClearField(name string) error {
 	switch name {
-	case backuptarget.FieldLastBackupError:
-		m.ClearLastBackupError()
-		return nil
-	case backuptarget.FieldLastBackupAt:
-		m.ClearLastBackupAt()
-		return nil
-	case backuptarget.FieldLastTestError:
-		m.ClearLastTestError()
-		return nil
-	case backuptarget.FieldLastTestAt:
-		m.ClearLastTestAt()
-		return nil
-	case backuptarget.FieldWebdavURL:
-		m.ClearWebdavURL()
-		return nil
-	case backuptarget.FieldWebdavUser:
-		m.ClearWebdavUser()
-		return nil
-	case backuptarget.FieldWebdavPassword:
-		m.ClearWebdavPassword()
-		return nil
-	case backuptarget.FieldS3Endpoint:
-		m.ClearS3Endpoint()
-		return nil
-	case backuptarget.FieldS3Region:
-		m.ClearS3Region()
-		return nil
-	case backuptarget.FieldS3Bucket:
-		m.ClearS3Bucket()
-		return nil
-	case backuptarget.FieldS3AccessKey:
-		m.ClearS3AccessKey()
-		return nil
-	case backuptarget.FieldS3SecretKey:
-		m.ClearS3SecretKey()
+	case backuppin.FieldNote:
+		m.ClearNote()
 		return nil
 	}
-	return fmt.Errorf("unknown BackupTarget nullable field %s", name)
+	return fmt.Errorf("unknown BackupPin nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BackupTargetMutation) ResetField(name string) error {
+func (m *BackupPinMutation) ResetField(name string) error {
 	switch name {
-	case backuptarget.FieldName:
-		m.ResetName()
-		return nil
-	case backuptarget.FieldType:
-		m.ResetType()
-		return nil
-	case backuptarget.FieldEnabled:
-		m.ResetEnabled()
-		return nil
-	case backuptarget.FieldLastBackupStatus:
-		m.ResetLastBackupStatus()
-		return nil
-	case backuptarget.FieldLastBackupError:
-		m.ResetLastBackupError()
-		return nil
-	case backuptarget.FieldLastBackupAt:
-		m.ResetLastBackupAt()
-		return nil
-	case backuptarget.FieldLastTestStatus:
-		m.ResetLastTestStatus()
-		return nil
-	case backuptarget.FieldLastTestError:
-		m.ResetLastTestError()
-		return nil
-	case backuptarget.FieldLastTestAt:
-		m.ResetLastTestAt()
-		return nil
-	case backuptarget.FieldWebdavURL:
-		m.ResetWebdavURL()
-		return nil
-	case backuptarget.FieldWebdavUser:
-		m.ResetWebdavUser()
-		return nil
-	case backuptarget.FieldWebdavPassword:
-		m.ResetWebdavPassword()
-		return nil
-	case backuptarget.FieldS3Endpoint:
-		m.ResetS3Endpoint()
-		return nil
-	case backuptarget.FieldS3Region:
-		m.ResetS3Region()
+	case backuppin.FieldTargetID:
+		m.ResetTargetID()
 		return nil
-	case backuptarget.FieldS3Bucket:
-		m.ResetS3Bucket()
-		return nil
-	case backuptarget.FieldS3AccessKey:
-		m.ResetS3AccessKey()
+	case backuppin.FieldFilename:
+		m.ResetFilename()
 		return nil
-	case backuptarget.FieldS3SecretKey:
-		m.ResetS3SecretKey()
+	case backuppin.FieldNote:
+		m.ResetNote()
 		return nil
-	case backuptarget.FieldCreatedAt:
+	case backuppin.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case backuptarget.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown BackupTarget field %s", name)
+	return fmt.Errorf("unknown BackupPin field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BackupTargetMutation) AddedEdges() []string {
+func (m *BackupPinMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.tasks != nil {
-		edges = append(edges, backuptarget.EdgeTasks)
+	if m.target != nil {
+		edges = append(edges, backuppin.EdgeTarget)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BackupTargetMutation) AddedIDs(name string) []ent.Value {
+func (m *BackupPinMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case backuptarget.EdgeTasks:
-		ids := make([]ent.Value, 0, len(m.tasks))
-		for id := range m.tasks {
-			ids = append(ids, id)
+	case backuppin.EdgeTarget:
+		if id := m.target; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BackupTargetMutation) RemovedEdges() []string {
+func (m *BackupPinMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedtasks != nil {
-		edges = append(edges, backuptarget.EdgeTasks)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BackupTargetMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case backuptarget.EdgeTasks:
-		ids := make([]ent.Value, 0, len(m.removedtasks))
-		for id := range m.removedtasks {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *BackupPinMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BackupTargetMutation) ClearedEdges() []string {
+func (m *BackupPinMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedtasks {
-		edges = append(edges, backuptarget.EdgeTasks)
+	if m.clearedtarget {
+		edges = append(edges, backuppin.EdgeTarget)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BackupTargetMutation) EdgeCleared(name string) bool {
+func (m *BackupPinMutation) EdgeCleared(name string) bool {
 	switch name {
-	case backuptarget.EdgeTasks:
-		return m.clearedtasks
+	case backuppin.EdgeTarget:
+		return m.clearedtarget
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BackupTargetMutation) ClearEdge(name string) error {
+func (m *BackupPinMutation) ClearEdge(name string) error {
 	switch name {
+	case backuppin.EdgeTarget:
+		m.ClearTarget()
+		return nil
 	}
-	return fmt.Errorf("unknown BackupTarget unique edge %s", name)
+	return fmt.Errorf("unknown BackupPin unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BackupTargetMutation) ResetEdge(name string) error {
+func (m *BackupPinMutation) ResetEdge(name string) error {
 	switch name {
-	case backuptarget.EdgeTasks:
-		m.ResetTasks()
+	case backuppin.EdgeTarget:
+		m.ResetTarget()
 		return nil
 	}
-	return fmt.Errorf("unknown BackupTarget edge %s", name)
+	return fmt.Errorf("unknown BackupPin edge %s", name)
 }
 
-// BackupTaskMutation represents an operation that mutates the BackupTask nodes in the graph.
-type BackupTaskMutation struct {
+// BackupRunMutation represents an operation that mutates the BackupRun nodes in the graph.
+type BackupRunMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	name               *string
-	enabled            *bool
-	schedule           *string
-	retention_days     *int
-	addretention_days  *int
-	max_count          *int
-	addmax_count       *int
-	last_backup_status *string
-	last_backup_error  *string
-	last_backup_at     *time.Time
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	targets            map[int]struct{}
-	removedtargets     map[int]struct{}
-	clearedtargets     bool
-	done               bool
-	oldValue           func(context.Context) (*BackupTask, error)
-	predicates         []predicate.BackupTask
-}
-
-var _ ent.Mutation = (*BackupTaskMutation)(nil)
-
-// backuptaskOption allows management of the mutation configuration using functional options.
-type backuptaskOption func(*BackupTaskMutation)
-
-// newBackupTaskMutation creates new mutation for the BackupTask entity.
-func newBackupTaskMutation(c config, op Op, opts ...backuptaskOption) *BackupTaskMutation {
-	m := &BackupTaskMutation{
+	op             Op
+	typ            string
+	id             *int
+	trigger        *string
+	status         *string
+	filename       *string
+	bytes          *int64
+	addbytes       *int64
+	error          *string
+	started_at     *time.Time
+	finished_at    *time.Time
+	duration_ms    *int64
+	addduration_ms *int64
+	clearedFields  map[string]struct{}
+	task           *int
+	clearedtask    bool
+	target         *int
+	clearedtarget  bool
+	done           bool
+	oldValue       func(context.Context) (*BackupRun, error)
+	predicates     []predicate.BackupRun
+}
+
+var _ ent.Mutation = (*BackupRunMutation)(nil)
+
+// backuprunOption allows management of the mutation configuration using functional options.
+type backuprunOption func(*BackupRunMutation)
+
+// newBackupRunMutation creates new mutation for the BackupRun entity.
+func newBackupRunMutation(c config, op Op, opts ...backuprunOption) *BackupRunMutation {
+	m := &BackupRunMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBackupTask,
+		typ:           TypeBackupRun,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3924,20 +4292,20 @@ func newBackupTaskMutation(c config, op Op, opts ...backuptaskOption) *BackupTas
 	return m
 }
 
-// withBackupTaskID sets the ID field of the mutation.
-func withBackupTaskID(id int) backuptaskOption {
-	return func(m *BackupTaskMutation) {
+// withBackupRunID sets the ID field of the mutation.
+func withBackupRunID(id int) backuprunOption {
+	return func(m *BackupRunMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *BackupTask
+			value *BackupRun
 		)
-		m.oldValue = func(ctx context.Context) (*BackupTask, error) {
+		m.oldValue = func(ctx context.Context) (*BackupRun, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().BackupTask.Get(ctx, id)
+					value, err = m.Client().BackupRun.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3946,10 +4314,10 @@ func withBackupTaskID(id int) backuptaskOption {
 	}
 }
 
-// withBackupTask sets the old BackupTask of the mutation.
-func withBackupTask(node *BackupTask) backuptaskOption {
-	return func(m *BackupTaskMutation) {
-		m.oldValue = func(context.Context) (*BackupTask, error) {
+// withBackupRun sets the old BackupRun of the mutation.
+func withBackupRun(node *BackupRun) backuprunOption {
+	return func(m *BackupRunMutation) {
+		m.oldValue = func(context.Context) (*BackupRun, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3958,7 +4326,7 @@ func withBackupTask(node *BackupTask) backuptaskOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BackupTaskMutation) Client() *Client {
+func (m BackupRunMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3966,7 +4334,7 @@ func (m BackupTaskMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BackupTaskMutation) Tx() (*Tx, error) {
+func (m BackupRunMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3977,7 +4345,7 @@ func (m BackupTaskMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BackupTaskMutation) ID() (id int, exists bool) {
+func (m *BackupRunMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3988,7 +4356,7 @@ func (m *BackupTaskMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BackupTaskMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BackupRunMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3997,501 +4365,527 @@ func (m *BackupTaskMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().BackupTask.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BackupRun.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *BackupTaskMutation) SetName(s string) {
-	m.name = &s
+// SetTaskID sets the "task_id" field.
+func (m *BackupRunMutation) SetTaskID(i int) {
+	m.task = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *BackupTaskMutation) Name() (r string, exists bool) {
-	v := m.name
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *BackupRunMutation) TaskID() (r int, exists bool) {
+	v := m.task
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the BackupTask entity.
-// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTaskMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BackupRunMutation) OldTaskID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.TaskID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *BackupTaskMutation) ResetName() {
-	m.name = nil
+// ClearTaskID clears the value of the "task_id" field.
+func (m *BackupRunMutation) ClearTaskID() {
+	m.task = nil
+	m.clearedFields[backuprun.FieldTaskID] = struct{}{}
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *BackupTaskMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *BackupRunMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[backuprun.FieldTaskID]
+	return ok
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *BackupTaskMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *BackupRunMutation) ResetTaskID() {
+	m.task = nil
+	delete(m.clearedFields, backuprun.FieldTaskID)
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *BackupRunMutation) SetTargetID(i int) {
+	m.target = &i
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *BackupRunMutation) TargetID() (r int, exists bool) {
+	v := m.target
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the BackupTask entity.
-// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetID returns the old "target_id" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTaskMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *BackupRunMutation) OldTargetID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.TargetID, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *BackupTaskMutation) ResetEnabled() {
-	m.enabled = nil
+// ClearTargetID clears the value of the "target_id" field.
+func (m *BackupRunMutation) ClearTargetID() {
+	m.target = nil
+	m.clearedFields[backuprun.FieldTargetID] = struct{}{}
 }
 
-// SetSchedule sets the "schedule" field.
-func (m *BackupTaskMutation) SetSchedule(s string) {
-	m.schedule = &s
+// TargetIDCleared returns if the "target_id" field was cleared in this mutation.
+func (m *BackupRunMutation) TargetIDCleared() bool {
+	_, ok := m.clearedFields[backuprun.FieldTargetID]
+	return ok
 }
 
-// Schedule returns the value of the "schedule" field in the mutation.
-func (m *BackupTaskMutation) Schedule() (r string, exists bool) {
-	v := m.schedule
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *BackupRunMutation) ResetTargetID() {
+	m.target = nil
+	delete(m.clearedFields, backuprun.FieldTargetID)
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *BackupRunMutation) SetTrigger(s string) {
+	m.trigger = &s
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *BackupRunMutation) Trigger() (r string, exists bool) {
+	v := m.trigger
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSchedule returns the old "schedule" field's value of the BackupTask entity.
-// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldTrigger returns the old "trigger" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTaskMutation) OldSchedule(ctx context.Context) (v string, err error) {
+func (m *BackupRunMutation) OldTrigger(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSchedule is only allowed on UpdateOne operations")
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSchedule requires an ID field in the mutation")
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSchedule: %w", err)
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
 	}
-	return oldValue.Schedule, nil
+	return oldValue.Trigger, nil
 }
 
-// ResetSchedule resets all changes to the "schedule" field.
-func (m *BackupTaskMutation) ResetSchedule() {
-	m.schedule = nil
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *BackupRunMutation) ResetTrigger() {
+	m.trigger = nil
 }
 
-// SetRetentionDays sets the "retention_days" field.
-func (m *BackupTaskMutation) SetRetentionDays(i int) {
-	m.retention_days = &i
-	m.addretention_days = nil
+// SetStatus sets the "status" field.
+func (m *BackupRunMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// RetentionDays returns the value of the "retention_days" field in the mutation.
-func (m *BackupTaskMutation) RetentionDays() (r int, exists bool) {
-	v := m.retention_days
+// Status returns the value of the "status" field in the mutation.
+func (m *BackupRunMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRetentionDays returns the old "retention_days" field's value of the BackupTask entity.
-// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTaskMutation) OldRetentionDays(ctx context.Context) (v int, err error) {
+func (m *BackupRunMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRetentionDays is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRetentionDays requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRetentionDays: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.RetentionDays, nil
+	return oldValue.Status, nil
 }
 
-// AddRetentionDays adds i to the "retention_days" field.
-func (m *BackupTaskMutation) AddRetentionDays(i int) {
-	if m.addretention_days != nil {
-		*m.addretention_days += i
-	} else {
-		m.addretention_days = &i
-	}
+// ResetStatus resets all changes to the "status" field.
+func (m *BackupRunMutation) ResetStatus() {
+	m.status = nil
 }
 
-// AddedRetentionDays returns the value that was added to the "retention_days" field in this mutation.
-func (m *BackupTaskMutation) AddedRetentionDays() (r int, exists bool) {
-	v := m.addretention_days
+// SetFilename sets the "filename" field.
+func (m *BackupRunMutation) SetFilename(s string) {
+	m.filename = &s
+}
+
+// Filename returns the value of the "filename" field in the mutation.
+func (m *BackupRunMutation) Filename() (r string, exists bool) {
+	v := m.filename
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRetentionDays resets all changes to the "retention_days" field.
-func (m *BackupTaskMutation) ResetRetentionDays() {
-	m.retention_days = nil
-	m.addretention_days = nil
+// OldFilename returns the old "filename" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldFilename(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilename requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+	}
+	return oldValue.Filename, nil
 }
 
-// SetMaxCount sets the "max_count" field.
-func (m *BackupTaskMutation) SetMaxCount(i int) {
-	m.max_count = &i
-	m.addmax_count = nil
+// ClearFilename clears the value of the "filename" field.
+func (m *BackupRunMutation) ClearFilename() {
+	m.filename = nil
+	m.clearedFields[backuprun.FieldFilename] = struct{}{}
 }
 
-// MaxCount returns the value of the "max_count" field in the mutation.
-func (m *BackupTaskMutation) MaxCount() (r int, exists bool) {
-	v := m.max_count
+// FilenameCleared returns if the "filename" field was cleared in this mutation.
+func (m *BackupRunMutation) FilenameCleared() bool {
+	_, ok := m.clearedFields[backuprun.FieldFilename]
+	return ok
+}
+
+// ResetFilename resets all changes to the "filename" field.
+func (m *BackupRunMutation) ResetFilename() {
+	m.filename = nil
+	delete(m.clearedFields, backuprun.FieldFilename)
+}
+
+// SetBytes sets the "bytes" field.
+func (m *BackupRunMutation) SetBytes(i int64) {
+	m.bytes = &i
+	m.addbytes = nil
+}
+
+// Bytes returns the value of the "bytes" field in the mutation.
+func (m *BackupRunMutation) Bytes() (r int64, exists bool) {
+	v := m.bytes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxCount returns the old "max_count" field's value of the BackupTask entity.
-// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldBytes returns the old "bytes" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTaskMutation) OldMaxCount(ctx context.Context) (v int, err error) {
+func (m *BackupRunMutation) OldBytes(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldBytes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxCount requires an ID field in the mutation")
+		return v, errors.New("OldBytes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldBytes: %w", err)
 	}
-	return oldValue.MaxCount, nil
+	return oldValue.Bytes, nil
 }
 
-// AddMaxCount adds i to the "max_count" field.
-func (m *BackupTaskMutation) AddMaxCount(i int) {
-	if m.addmax_count != nil {
-		*m.addmax_count += i
+// AddBytes adds i to the "bytes" field.
+func (m *BackupRunMutation) AddBytes(i int64) {
+	if m.addbytes != nil {
+		*m.addbytes += i
 	} else {
-		m.addmax_count = &i
+		m.addbytes = &i
 	}
 }
 
-// AddedMaxCount returns the value that was added to the "max_count" field in this mutation.
-func (m *BackupTaskMutation) AddedMaxCount() (r int, exists bool) {
-	v := m.addmax_count
+// AddedBytes returns the value that was added to the "bytes" field in this mutation.
+func (m *BackupRunMutation) AddedBytes() (r int64, exists bool) {
+	v := m.addbytes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMaxCount resets all changes to the "max_count" field.
-func (m *BackupTaskMutation) ResetMaxCount() {
-	m.max_count = nil
-	m.addmax_count = nil
+// ResetBytes resets all changes to the "bytes" field.
+func (m *BackupRunMutation) ResetBytes() {
+	m.bytes = nil
+	m.addbytes = nil
 }
 
-// SetLastBackupStatus sets the "last_backup_status" field.
-func (m *BackupTaskMutation) SetLastBackupStatus(s string) {
-	m.last_backup_status = &s
+// SetError sets the "error" field.
+func (m *BackupRunMutation) SetError(s string) {
+	m.error = &s
 }
 
-// LastBackupStatus returns the value of the "last_backup_status" field in the mutation.
-func (m *BackupTaskMutation) LastBackupStatus() (r string, exists bool) {
-	v := m.last_backup_status
+// Error returns the value of the "error" field in the mutation.
+func (m *BackupRunMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastBackupStatus returns the old "last_backup_status" field's value of the BackupTask entity.
-// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTaskMutation) OldLastBackupStatus(ctx context.Context) (v string, err error) {
+func (m *BackupRunMutation) OldError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastBackupStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastBackupStatus requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastBackupStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.LastBackupStatus, nil
+	return oldValue.Error, nil
 }
 
-// ResetLastBackupStatus resets all changes to the "last_backup_status" field.
-func (m *BackupTaskMutation) ResetLastBackupStatus() {
-	m.last_backup_status = nil
+// ClearError clears the value of the "error" field.
+func (m *BackupRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[backuprun.FieldError] = struct{}{}
 }
 
-// SetLastBackupError sets the "last_backup_error" field.
-func (m *BackupTaskMutation) SetLastBackupError(s string) {
-	m.last_backup_error = &s
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *BackupRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[backuprun.FieldError]
+	return ok
 }
 
-// LastBackupError returns the value of the "last_backup_error" field in the mutation.
-func (m *BackupTaskMutation) LastBackupError() (r string, exists bool) {
-	v := m.last_backup_error
+// ResetError resets all changes to the "error" field.
+func (m *BackupRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, backuprun.FieldError)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *BackupRunMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *BackupRunMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastBackupError returns the old "last_backup_error" field's value of the BackupTask entity.
-// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTaskMutation) OldLastBackupError(ctx context.Context) (v string, err error) {
+func (m *BackupRunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastBackupError is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastBackupError requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastBackupError: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.LastBackupError, nil
+	return oldValue.StartedAt, nil
 }
 
-// ClearLastBackupError clears the value of the "last_backup_error" field.
-func (m *BackupTaskMutation) ClearLastBackupError() {
-	m.last_backup_error = nil
-	m.clearedFields[backuptask.FieldLastBackupError] = struct{}{}
-}
-
-// LastBackupErrorCleared returns if the "last_backup_error" field was cleared in this mutation.
-func (m *BackupTaskMutation) LastBackupErrorCleared() bool {
-	_, ok := m.clearedFields[backuptask.FieldLastBackupError]
-	return ok
-}
-
-// ResetLastBackupError resets all changes to the "last_backup_error" field.
-func (m *BackupTaskMutation) ResetLastBackupError() {
-	m.last_backup_error = nil
-	delete(m.clearedFields, backuptask.FieldLastBackupError)
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *BackupRunMutation) ResetStartedAt() {
+	m.started_at = nil
 }
 
-// SetLastBackupAt sets the "last_backup_at" field.
-func (m *BackupTaskMutation) SetLastBackupAt(t time.Time) {
-	m.last_backup_at = &t
+// SetFinishedAt sets the "finished_at" field.
+func (m *BackupRunMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
 }
 
-// LastBackupAt returns the value of the "last_backup_at" field in the mutation.
-func (m *BackupTaskMutation) LastBackupAt() (r time.Time, exists bool) {
-	v := m.last_backup_at
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *BackupRunMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastBackupAt returns the old "last_backup_at" field's value of the BackupTask entity.
-// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldFinishedAt returns the old "finished_at" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTaskMutation) OldLastBackupAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupRunMutation) OldFinishedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastBackupAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastBackupAt requires an ID field in the mutation")
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastBackupAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
-	return oldValue.LastBackupAt, nil
-}
-
-// ClearLastBackupAt clears the value of the "last_backup_at" field.
-func (m *BackupTaskMutation) ClearLastBackupAt() {
-	m.last_backup_at = nil
-	m.clearedFields[backuptask.FieldLastBackupAt] = struct{}{}
-}
-
-// LastBackupAtCleared returns if the "last_backup_at" field was cleared in this mutation.
-func (m *BackupTaskMutation) LastBackupAtCleared() bool {
-	_, ok := m.clearedFields[backuptask.FieldLastBackupAt]
-	return ok
+	return oldValue.FinishedAt, nil
 }
 
-// ResetLastBackupAt resets all changes to the "last_backup_at" field.
-func (m *BackupTaskMutation) ResetLastBackupAt() {
-	m.last_backup_at = nil
-	delete(m.clearedFields, backuptask.FieldLastBackupAt)
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *BackupRunMutation) ResetFinishedAt() {
+	m.finished_at = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *BackupTaskMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetDurationMs sets the "duration_ms" field.
+func (m *BackupRunMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *BackupTaskMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *BackupRunMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the BackupTask entity.
-// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldDurationMs returns the old "duration_ms" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupRunMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.DurationMs, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *BackupTaskMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *BackupTaskMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *BackupRunMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *BackupTaskMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *BackupRunMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the BackupTask entity.
-// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupTaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *BackupRunMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *BackupTaskMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearTask clears the "task" edge to the BackupTask entity.
+func (m *BackupRunMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[backuprun.FieldTaskID] = struct{}{}
 }
 
-// AddTargetIDs adds the "targets" edge to the BackupTarget entity by ids.
-func (m *BackupTaskMutation) AddTargetIDs(ids ...int) {
-	if m.targets == nil {
-		m.targets = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.targets[ids[i]] = struct{}{}
-	}
+// TaskCleared reports if the "task" edge to the BackupTask entity was cleared.
+func (m *BackupRunMutation) TaskCleared() bool {
+	return m.TaskIDCleared() || m.clearedtask
 }
 
-// ClearTargets clears the "targets" edge to the BackupTarget entity.
-func (m *BackupTaskMutation) ClearTargets() {
-	m.clearedtargets = true
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *BackupRunMutation) TaskIDs() (ids []int) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// TargetsCleared reports if the "targets" edge to the BackupTarget entity was cleared.
-func (m *BackupTaskMutation) TargetsCleared() bool {
-	return m.clearedtargets
+// ResetTask resets all changes to the "task" edge.
+func (m *BackupRunMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
 }
 
-// RemoveTargetIDs removes the "targets" edge to the BackupTarget entity by IDs.
-func (m *BackupTaskMutation) RemoveTargetIDs(ids ...int) {
-	if m.removedtargets == nil {
-		m.removedtargets = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.targets, ids[i])
-		m.removedtargets[ids[i]] = struct{}{}
-	}
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (m *BackupRunMutation) ClearTarget() {
+	m.clearedtarget = true
+	m.clearedFields[backuprun.FieldTargetID] = struct{}{}
 }
 
-// RemovedTargets returns the removed IDs of the "targets" edge to the BackupTarget entity.
-func (m *BackupTaskMutation) RemovedTargetsIDs() (ids []int) {
-	for id := range m.removedtargets {
-		ids = append(ids, id)
-	}
-	return
+// TargetCleared reports if the "target" edge to the BackupTarget entity was cleared.
+func (m *BackupRunMutation) TargetCleared() bool {
+	return m.TargetIDCleared() || m.clearedtarget
 }
 
-// TargetsIDs returns the "targets" edge IDs in the mutation.
-func (m *BackupTaskMutation) TargetsIDs() (ids []int) {
-	for id := range m.targets {
-		ids = append(ids, id)
+// TargetIDs returns the "target" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TargetID instead. It exists only for internal usage by the builders.
+func (m *BackupRunMutation) TargetIDs() (ids []int) {
+	if id := m.target; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTargets resets all changes to the "targets" edge.
-func (m *BackupTaskMutation) ResetTargets() {
-	m.targets = nil
-	m.clearedtargets = false
-	m.removedtargets = nil
+// ResetTarget resets all changes to the "target" edge.
+func (m *BackupRunMutation) ResetTarget() {
+	m.target = nil
+	m.clearedtarget = false
 }
 
-// Where appends a list predicates to the BackupTaskMutation builder.
-func (m *BackupTaskMutation) Where(ps ...predicate.BackupTask) {
+// Where appends a list predicates to the BackupRunMutation builder.
+func (m *BackupRunMutation) Where(ps ...predicate.BackupRun) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the BackupTaskMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BackupRunMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BackupTaskMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.BackupTask, len(ps))
+func (m *BackupRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackupRun, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4499,54 +4893,54 @@ func (m *BackupTaskMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *BackupTaskMutation) Op() Op {
+func (m *BackupRunMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *BackupTaskMutation) SetOp(op Op) {
+func (m *BackupRunMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (BackupTask).
-func (m *BackupTaskMutation) Type() string {
+// Type returns the node type of this mutation (BackupRun).
+func (m *BackupRunMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *BackupTaskMutation) Fields() []string {
+func (m *BackupRunMutation) Fields() []string {
 	fields := make([]string, 0, 10)
-	if m.name != nil {
-		fields = append(fields, backuptask.FieldName)
+	if m.task != nil {
+		fields = append(fields, backuprun.FieldTaskID)
 	}
-	if m.enabled != nil {
-		fields = append(fields, backuptask.FieldEnabled)
+	if m.target != nil {
+		fields = append(fields, backuprun.FieldTargetID)
 	}
-	if m.schedule != nil {
-		fields = append(fields, backuptask.FieldSchedule)
+	if m.trigger != nil {
+		fields = append(fields, backuprun.FieldTrigger)
 	}
-	if m.retention_days != nil {
-		fields = append(fields, backuptask.FieldRetentionDays)
+	if m.status != nil {
+		fields = append(fields, backuprun.FieldStatus)
 	}
-	if m.max_count != nil {
-		fields = append(fields, backuptask.FieldMaxCount)
+	if m.filename != nil {
+		fields = append(fields, backuprun.FieldFilename)
 	}
-	if m.last_backup_status != nil {
-		fields = append(fields, backuptask.FieldLastBackupStatus)
+	if m.bytes != nil {
+		fields = append(fields, backuprun.FieldBytes)
 	}
-	if m.last_backup_error != nil {
-		fields = append(fields, backuptask.FieldLastBackupError)
+	if m.error != nil {
+		fields = append(fields, backuprun.FieldError)
 	}
-	if m.last_backup_at != nil {
-		fields = append(fields, backuptask.FieldLastBackupAt)
+	if m.started_at != nil {
+		fields = append(fields, backuprun.FieldStartedAt)
 	}
-	if m.created_at != nil {
-		fields = append(fields, backuptask.FieldCreatedAt)
+	if m.finished_at != nil {
+		fields = append(fields, backuprun.FieldFinishedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, backuptask.FieldUpdatedAt)
+	if m.duration_ms != nil {
+		fields = append(fields, backuprun.FieldDurationMs)
 	}
 	return fields
 }
@@ -4554,28 +4948,28 @@ func (m *BackupTaskMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BackupTaskMutation) Field(name string) (ent.Value, bool) {
+func (m *BackupRunMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case backuptask.FieldName:
-		return m.Name()
-	case backuptask.FieldEnabled:
-		return m.Enabled()
-	case backuptask.FieldSchedule:
-		return m.Schedule()
-	case backuptask.FieldRetentionDays:
-		return m.RetentionDays()
-	case backuptask.FieldMaxCount:
-		return m.MaxCount()
-	case backuptask.FieldLastBackupStatus:
-		return m.LastBackupStatus()
-	case backuptask.FieldLastBackupError:
-		return m.LastBackupError()
-	case backuptask.FieldLastBackupAt:
-		return m.LastBackupAt()
-	case backuptask.FieldCreatedAt:
-		return m.CreatedAt()
-	case backuptask.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case backuprun.FieldTaskID:
+		return m.TaskID()
+	case backuprun.FieldTargetID:
+		return m.TargetID()
+	case backuprun.FieldTrigger:
+		return m.Trigger()
+	case backuprun.FieldStatus:
+		return m.Status()
+	case backuprun.FieldFilename:
+		return m.Filename()
+	case backuprun.FieldBytes:
+		return m.Bytes()
+	case backuprun.FieldError:
+		return m.Error()
+	case backuprun.FieldStartedAt:
+		return m.StartedAt()
+	case backuprun.FieldFinishedAt:
+		return m.FinishedAt()
+	case backuprun.FieldDurationMs:
+		return m.DurationMs()
 	}
 	return nil, false
 }
@@ -4583,120 +4977,120 @@ func (m *BackupTaskMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BackupTaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BackupRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case backuptask.FieldName:
-		return m.OldName(ctx)
-	case backuptask.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case backuptask.FieldSchedule:
-		return m.OldSchedule(ctx)
-	case backuptask.FieldRetentionDays:
-		return m.OldRetentionDays(ctx)
-	case backuptask.FieldMaxCount:
-		return m.OldMaxCount(ctx)
-	case backuptask.FieldLastBackupStatus:
-		return m.OldLastBackupStatus(ctx)
-	case backuptask.FieldLastBackupError:
-		return m.OldLastBackupError(ctx)
-	case backuptask.FieldLastBackupAt:
-		return m.OldLastBackupAt(ctx)
-	case backuptask.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case backuptask.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case backuprun.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case backuprun.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case backuprun.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case backuprun.FieldStatus:
+		return m.OldStatus(ctx)
+	case backuprun.FieldFilename:
+		return m.OldFilename(ctx)
+	case backuprun.FieldBytes:
+		return m.OldBytes(ctx)
+	case backuprun.FieldError:
+		return m.OldError(ctx)
+	case backuprun.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case backuprun.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case backuprun.FieldDurationMs:
+		return m.OldDurationMs(ctx)
 	}
-	return nil, fmt.Errorf("unknown BackupTask field %s", name)
+	return nil, fmt.Errorf("unknown BackupRun field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BackupTaskMutation) SetField(name string, value ent.Value) error {
+func (m *BackupRunMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case backuptask.FieldName:
-		v, ok := value.(string)
+	case backuprun.FieldTaskID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetTaskID(v)
 		return nil
-	case backuptask.FieldEnabled:
-		v, ok := value.(bool)
+	case backuprun.FieldTargetID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetTargetID(v)
 		return nil
-	case backuptask.FieldSchedule:
+	case backuprun.FieldTrigger:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSchedule(v)
+		m.SetTrigger(v)
 		return nil
-	case backuptask.FieldRetentionDays:
-		v, ok := value.(int)
+	case backuprun.FieldStatus:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRetentionDays(v)
+		m.SetStatus(v)
 		return nil
-	case backuptask.FieldMaxCount:
-		v, ok := value.(int)
+	case backuprun.FieldFilename:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMaxCount(v)
+		m.SetFilename(v)
 		return nil
-	case backuptask.FieldLastBackupStatus:
-		v, ok := value.(string)
+	case backuprun.FieldBytes:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastBackupStatus(v)
+		m.SetBytes(v)
 		return nil
-	case backuptask.FieldLastBackupError:
+	case backuprun.FieldError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastBackupError(v)
+		m.SetError(v)
 		return nil
-	case backuptask.FieldLastBackupAt:
+	case backuprun.FieldStartedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastBackupAt(v)
+		m.SetStartedAt(v)
 		return nil
-	case backuptask.FieldCreatedAt:
+	case backuprun.FieldFinishedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetFinishedAt(v)
 		return nil
-	case backuptask.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case backuprun.FieldDurationMs:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetDurationMs(v)
 		return nil
 	}
-	return fmt.Errorf("unknown BackupTask field %s", name)
+	return fmt.Errorf("unknown BackupRun field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BackupTaskMutation) AddedFields() []string {
+func (m *BackupRunMutation) AddedFields() []string {
 	var fields []string
-	if m.addretention_days != nil {
-		fields = append(fields, backuptask.FieldRetentionDays)
+	if m.addbytes != nil {
+		fields = append(fields, backuprun.FieldBytes)
 	}
-	if m.addmax_count != nil {
-		fields = append(fields, backuptask.FieldMaxCount)
+	if m.addduration_ms != nil {
+		fields = append(fields, backuprun.FieldDurationMs)
 	}
 	return fields
 }
@@ -4704,12 +5098,12 @@ func (m *BackupTaskMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BackupTaskMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BackupRunMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case backuptask.FieldRetentionDays:
-		return m.AddedRetentionDays()
-	case backuptask.FieldMaxCount:
-		return m.AddedMaxCount()
+	case backuprun.FieldBytes:
+		return m.AddedBytes()
+	case backuprun.FieldDurationMs:
+		return m.AddedDurationMs()
 	}
 	return nil, false
 }
@@ -4717,210 +5111,270 @@ func (m *BackupTaskMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BackupTaskMutation) AddField(name string, value ent.Value) error {
+func (m *BackupRunMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case backuptask.FieldRetentionDays:
-		v, ok := value.(int)
+	case backuprun.FieldBytes:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRetentionDays(v)
+		m.AddBytes(v)
 		return nil
-	case backuptask.FieldMaxCount:
-		v, ok := value.(int)
+	case backuprun.FieldDurationMs:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMaxCount(v)
+		m.AddDurationMs(v)
 		return nil
 	}
-	return fmt.Errorf("unknown BackupTask numeric field %s", name)
+	return fmt.Errorf("unknown BackupRun numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BackupTaskMutation) ClearedFields() []string {
+func (m *BackupRunMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(backuptask.FieldLastBackupError) {
-		fields = append(fields, backuptask.FieldLastBackupError)
+	if m.FieldCleared(backuprun.FieldTaskID) {
+		fields = append(fields, backuprun.FieldTaskID)
 	}
-	if m.FieldCleared(backuptask.FieldLastBackupAt) {
-		fields = append(fields, backuptask.FieldLastBackupAt)
+	if m.FieldCleared(backuprun.FieldTargetID) {
+		fields = append(fields, backuprun.FieldTargetID)
+	}
+	if m.FieldCleared(backuprun.FieldFilename) {
+		fields = append(fields, backuprun.FieldFilename)
+	}
+	if m.FieldCleared(backuprun.FieldError) {
+		fields = append(fields, backuprun.FieldError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BackupTaskMutation) FieldCleared(name string) bool {
+func (m *BackupRunMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BackupTaskMutation) ClearField(name string) error {
+func (m *BackupRunMutation) ClearField(name string) error {
 	switch name {
-	case backuptask.FieldLastBackupError:
-		m.ClearLastBackupError()
+	case backuprun.FieldTaskID:
+		m.ClearTaskID()
 		return nil
-	case backuptask.FieldLastBackupAt:
-		m.ClearLastBackupAt()
+	case backuprun.FieldTargetID:
+		m.ClearTargetID()
+		return nil
+	case backuprun.FieldFilename:
+		m.ClearFilename()
+		return nil
+	case backuprun.FieldError:
+		m.ClearError()
 		return nil
 	}
-	return fmt.Errorf("unknown BackupTask nullable field %s", name)
+	return fmt.Errorf("unknown BackupRun nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BackupTaskMutation) ResetField(name string) error {
+func (m *BackupRunMutation) ResetField(name string) error {
 	switch name {
-	case backuptask.FieldName:
-		m.ResetName()
+	case backuprun.FieldTaskID:
+		m.ResetTaskID()
 		return nil
-	case backuptask.FieldEnabled:
-		m.ResetEnabled()
+	case backuprun.FieldTargetID:
+		m.ResetTargetID()
 		return nil
-	case backuptask.FieldSchedule:
-		m.ResetSchedule()
+	case backuprun.FieldTrigger:
+		m.ResetTrigger()
 		return nil
-	case backuptask.FieldRetentionDays:
-		m.ResetRetentionDays()
+	case backuprun.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case backuptask.FieldMaxCount:
-		m.ResetMaxCount()
+	case backuprun.FieldFilename:
+		m.ResetFilename()
 		return nil
-	case backuptask.FieldLastBackupStatus:
-		m.ResetLastBackupStatus()
+	case backuprun.FieldBytes:
+		m.ResetBytes()
 		return nil
-	case backuptask.FieldLastBackupError:
-		m.ResetLastBackupError()
+	case backuprun.FieldError:
+		m.ResetError()
 		return nil
-	case backuptask.FieldLastBackupAt:
-		m.ResetLastBackupAt()
+	case backuprun.FieldStartedAt:
+		m.ResetStartedAt()
 		return nil
-	case backuptask.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case backuprun.FieldFinishedAt:
+		m.ResetFinishedAt()
 		return nil
-	case backuptask.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case backuprun.FieldDurationMs:
+		m.ResetDurationMs()
 		return nil
 	}
-	return fmt.Errorf("unknown BackupTask field %s", name)
+	return fmt.Errorf("unknown BackupRun field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BackupTaskMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.targets != nil {
-		edges = append(edges, backuptask.EdgeTargets)
+func (m *BackupRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.task != nil {
+		edges = append(edges, backuprun.EdgeTask)
+	}
+	if m.target != nil {
+		edges = append(edges, backuprun.EdgeTarget)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BackupTaskMutation) AddedIDs(name string) []ent.Value {
+func (m *BackupRunMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case backuptask.EdgeTargets:
-		ids := make([]ent.Value, 0, len(m.targets))
-		for id := range m.targets {
-			ids = append(ids, id)
+	case backuprun.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	case backuprun.EdgeTarget:
+		if id := m.target; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BackupTaskMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedtargets != nil {
-		edges = append(edges, backuptask.EdgeTargets)
-	}
+func (m *BackupRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BackupTaskMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case backuptask.EdgeTargets:
-		ids := make([]ent.Value, 0, len(m.removedtargets))
-		for id := range m.removedtargets {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *BackupRunMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BackupTaskMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedtargets {
-		edges = append(edges, backuptask.EdgeTargets)
+func (m *BackupRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtask {
+		edges = append(edges, backuprun.EdgeTask)
+	}
+	if m.clearedtarget {
+		edges = append(edges, backuprun.EdgeTarget)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BackupTaskMutation) EdgeCleared(name string) bool {
+func (m *BackupRunMutation) EdgeCleared(name string) bool {
 	switch name {
-	case backuptask.EdgeTargets:
-		return m.clearedtargets
+	case backuprun.EdgeTask:
+		return m.clearedtask
+	case backuprun.EdgeTarget:
+		return m.clearedtarget
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BackupTaskMutation) ClearEdge(name string) error {
+func (m *BackupRunMutation) ClearEdge(name string) error {
 	switch name {
+	case backuprun.EdgeTask:
+		m.ClearTask()
+		return nil
+	case backuprun.EdgeTarget:
+		m.ClearTarget()
+		return nil
 	}
-	return fmt.Errorf("unknown BackupTask unique edge %s", name)
+	return fmt.Errorf("unknown BackupRun unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BackupTaskMutation) ResetEdge(name string) error {
+func (m *BackupRunMutation) ResetEdge(name string) error {
 	switch name {
-	case backuptask.EdgeTargets:
-		m.ResetTargets()
+	case backuprun.EdgeTask:
+		m.ResetTask()
+		return nil
+	case backuprun.EdgeTarget:
+		m.ResetTarget()
 		return nil
 	}
-	return fmt.Errorf("unknown BackupTask edge %s", name)
+	return fmt.Errorf("unknown BackupRun edge %s", name)
 }
 
-// ExcalidrawLibraryMutation represents an operation that mutates the ExcalidrawLibrary nodes in the graph.
-type ExcalidrawLibraryMutation struct {
+// BackupTargetMutation represents an operation that mutates the BackupTarget nodes in the graph.
+type BackupTargetMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	library_json  *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*ExcalidrawLibrary, error)
-	predicates    []predicate.ExcalidrawLibrary
+	op                     Op
+	typ                    string
+	id                     *int
+	name                   *string
+	_type                  *string
+	enabled                *bool
+	last_backup_status     *string
+	last_backup_error      *string
+	last_backup_at         *time.Time
+	last_test_status       *string
+	last_test_error        *string
+	last_test_at           *time.Time
+	webdav_url             *string
+	webdav_user            *string
+	webdav_password        *string
+	s3_endpoint            *string
+	s3_region              *string
+	s3_bucket              *string
+	s3_access_key          *string
+	s3_secret_key          *string
+	local_path             *string
+	sftp_host              *string
+	sftp_port              *int
+	addsftp_port           *int
+	sftp_user              *string
+	sftp_password          *string
+	sftp_private_key       *string
+	sftp_path              *string
+	sftp_host_key          *string
+	alert_after_hours      *int
+	addalert_after_hours   *int
+	drill_interval_days    *int
+	adddrill_interval_days *int
+	stale_alerted_at       *time.Time
+	created_at             *time.Time
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	tasks                  map[int]struct{}
+	removedtasks           map[int]struct{}
+	clearedtasks           bool
+	pins                   map[int]struct{}
+	removedpins            map[int]struct{}
+	clearedpins            bool
+	runs                   map[int]struct{}
+	removedruns            map[int]struct{}
+	clearedruns            bool
+	drills                 map[int]struct{}
+	removeddrills          map[int]struct{}
+	cleareddrills          bool
+	done                   bool
+	oldValue               func(context.Context) (*BackupTarget, error)
+	predicates             []predicate.BackupTarget
 }
 
-var _ ent.Mutation = (*ExcalidrawLibraryMutation)(nil)
+var _ ent.Mutation = (*BackupTargetMutation)(nil)
 
-// excalidrawlibraryOption allows management of the mutation configuration using functional options.
-type excalidrawlibraryOption func(*ExcalidrawLibraryMutation)
+// backuptargetOption allows management of the mutation configuration using functional options.
+type backuptargetOption func(*BackupTargetMutation)
 
-// newExcalidrawLibraryMutation creates new mutation for the ExcalidrawLibrary entity.
-func newExcalidrawLibraryMutation(c config, op Op, opts ...excalidrawlibraryOption) *ExcalidrawLibraryMutation {
-	m := &ExcalidrawLibraryMutation{
+// newBackupTargetMutation creates new mutation for the BackupTarget entity.
+func newBackupTargetMutation(c config, op Op, opts ...backuptargetOption) *BackupTargetMutation {
+	m := &BackupTargetMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeExcalidrawLibrary,
+		typ:           TypeBackupTarget,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4929,20 +5383,20 @@ func newExcalidrawLibraryMutation(c config, op Op, opts ...excalidrawlibraryOpti
 	return m
 }
 
-// withExcalidrawLibraryID sets the ID field of the mutation.
-func withExcalidrawLibraryID(id uuid.UUID) excalidrawlibraryOption {
-	return func(m *ExcalidrawLibraryMutation) {
+// withBackupTargetID sets the ID field of the mutation.
+func withBackupTargetID(id int) backuptargetOption {
+	return func(m *BackupTargetMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ExcalidrawLibrary
+			value *BackupTarget
 		)
-		m.oldValue = func(ctx context.Context) (*ExcalidrawLibrary, error) {
+		m.oldValue = func(ctx context.Context) (*BackupTarget, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ExcalidrawLibrary.Get(ctx, id)
+					value, err = m.Client().BackupTarget.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4951,10 +5405,10 @@ func withExcalidrawLibraryID(id uuid.UUID) excalidrawlibraryOption {
 	}
 }
 
-// withExcalidrawLibrary sets the old ExcalidrawLibrary of the mutation.
-func withExcalidrawLibrary(node *ExcalidrawLibrary) excalidrawlibraryOption {
-	return func(m *ExcalidrawLibraryMutation) {
-		m.oldValue = func(context.Context) (*ExcalidrawLibrary, error) {
+// withBackupTarget sets the old BackupTarget of the mutation.
+func withBackupTarget(node *BackupTarget) backuptargetOption {
+	return func(m *BackupTargetMutation) {
+		m.oldValue = func(context.Context) (*BackupTarget, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4963,7 +5417,7 @@ func withExcalidrawLibrary(node *ExcalidrawLibrary) excalidrawlibraryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ExcalidrawLibraryMutation) Client() *Client {
+func (m BackupTargetMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4971,7 +5425,7 @@ func (m ExcalidrawLibraryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ExcalidrawLibraryMutation) Tx() (*Tx, error) {
+func (m BackupTargetMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4980,15 +5434,9 @@ func (m ExcalidrawLibraryMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ExcalidrawLibrary entities.
-func (m *ExcalidrawLibraryMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ExcalidrawLibraryMutation) ID() (id uuid.UUID, exists bool) {
+func (m *BackupTargetMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4999,1817 +5447,1761 @@ func (m *ExcalidrawLibraryMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ExcalidrawLibraryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *BackupTargetMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ExcalidrawLibrary.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BackupTarget.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetLibraryJSON sets the "library_json" field.
-func (m *ExcalidrawLibraryMutation) SetLibraryJSON(s string) {
-	m.library_json = &s
+// SetName sets the "name" field.
+func (m *BackupTargetMutation) SetName(s string) {
+	m.name = &s
 }
 
-// LibraryJSON returns the value of the "library_json" field in the mutation.
-func (m *ExcalidrawLibraryMutation) LibraryJSON() (r string, exists bool) {
-	v := m.library_json
+// Name returns the value of the "name" field in the mutation.
+func (m *BackupTargetMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLibraryJSON returns the old "library_json" field's value of the ExcalidrawLibrary entity.
-// If the ExcalidrawLibrary object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExcalidrawLibraryMutation) OldLibraryJSON(ctx context.Context) (v string, err error) {
+func (m *BackupTargetMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLibraryJSON is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLibraryJSON requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLibraryJSON: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.LibraryJSON, nil
+	return oldValue.Name, nil
 }
 
-// ResetLibraryJSON resets all changes to the "library_json" field.
-func (m *ExcalidrawLibraryMutation) ResetLibraryJSON() {
-	m.library_json = nil
+// ResetName resets all changes to the "name" field.
+func (m *BackupTargetMutation) ResetName() {
+	m.name = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ExcalidrawLibraryMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetType sets the "type" field.
+func (m *BackupTargetMutation) SetType(s string) {
+	m._type = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ExcalidrawLibraryMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// GetType returns the value of the "type" field in the mutation.
+func (m *BackupTargetMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ExcalidrawLibrary entity.
-// If the ExcalidrawLibrary object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExcalidrawLibraryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupTargetMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Type, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ExcalidrawLibraryMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetType resets all changes to the "type" field.
+func (m *BackupTargetMutation) ResetType() {
+	m._type = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ExcalidrawLibraryMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetEnabled sets the "enabled" field.
+func (m *BackupTargetMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ExcalidrawLibraryMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *BackupTargetMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ExcalidrawLibrary entity.
-// If the ExcalidrawLibrary object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExcalidrawLibraryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupTargetMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ExcalidrawLibraryMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *ExcalidrawLibraryMutation) SetUserID(id int) {
-	m.user = &id
+	return oldValue.Enabled, nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *ExcalidrawLibraryMutation) ClearUser() {
-	m.cleareduser = true
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *BackupTargetMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *ExcalidrawLibraryMutation) UserCleared() bool {
-	return m.cleareduser
+// SetLastBackupStatus sets the "last_backup_status" field.
+func (m *BackupTargetMutation) SetLastBackupStatus(s string) {
+	m.last_backup_status = &s
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *ExcalidrawLibraryMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// LastBackupStatus returns the value of the "last_backup_status" field in the mutation.
+func (m *BackupTargetMutation) LastBackupStatus() (r string, exists bool) {
+	v := m.last_backup_status
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *ExcalidrawLibraryMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldLastBackupStatus returns the old "last_backup_status" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldLastBackupStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastBackupStatus is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *ExcalidrawLibraryMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
-// Where appends a list predicates to the ExcalidrawLibraryMutation builder.
-func (m *ExcalidrawLibraryMutation) Where(ps ...predicate.ExcalidrawLibrary) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the ExcalidrawLibraryMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ExcalidrawLibraryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ExcalidrawLibrary, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastBackupStatus requires an ID field in the mutation")
 	}
-	m.Where(p...)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastBackupStatus: %w", err)
+	}
+	return oldValue.LastBackupStatus, nil
 }
 
-// Op returns the operation name.
-func (m *ExcalidrawLibraryMutation) Op() Op {
-	return m.op
+// ResetLastBackupStatus resets all changes to the "last_backup_status" field.
+func (m *BackupTargetMutation) ResetLastBackupStatus() {
+	m.last_backup_status = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ExcalidrawLibraryMutation) SetOp(op Op) {
-	m.op = op
+// SetLastBackupError sets the "last_backup_error" field.
+func (m *BackupTargetMutation) SetLastBackupError(s string) {
+	m.last_backup_error = &s
 }
 
-// Type returns the node type of this mutation (ExcalidrawLibrary).
-func (m *ExcalidrawLibraryMutation) Type() string {
-	return m.typ
+// LastBackupError returns the value of the "last_backup_error" field in the mutation.
+func (m *BackupTargetMutation) LastBackupError() (r string, exists bool) {
+	v := m.last_backup_error
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ExcalidrawLibraryMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.library_json != nil {
-		fields = append(fields, excalidrawlibrary.FieldLibraryJSON)
+// OldLastBackupError returns the old "last_backup_error" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldLastBackupError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastBackupError is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, excalidrawlibrary.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastBackupError requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, excalidrawlibrary.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastBackupError: %w", err)
 	}
-	return fields
+	return oldValue.LastBackupError, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ExcalidrawLibraryMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case excalidrawlibrary.FieldLibraryJSON:
-		return m.LibraryJSON()
-	case excalidrawlibrary.FieldCreatedAt:
-		return m.CreatedAt()
-	case excalidrawlibrary.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
+// ClearLastBackupError clears the value of the "last_backup_error" field.
+func (m *BackupTargetMutation) ClearLastBackupError() {
+	m.last_backup_error = nil
+	m.clearedFields[backuptarget.FieldLastBackupError] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ExcalidrawLibraryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case excalidrawlibrary.FieldLibraryJSON:
-		return m.OldLibraryJSON(ctx)
-	case excalidrawlibrary.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case excalidrawlibrary.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown ExcalidrawLibrary field %s", name)
+// LastBackupErrorCleared returns if the "last_backup_error" field was cleared in this mutation.
+func (m *BackupTargetMutation) LastBackupErrorCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldLastBackupError]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ExcalidrawLibraryMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case excalidrawlibrary.FieldLibraryJSON:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLibraryJSON(v)
-		return nil
-	case excalidrawlibrary.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case excalidrawlibrary.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown ExcalidrawLibrary field %s", name)
+// ResetLastBackupError resets all changes to the "last_backup_error" field.
+func (m *BackupTargetMutation) ResetLastBackupError() {
+	m.last_backup_error = nil
+	delete(m.clearedFields, backuptarget.FieldLastBackupError)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ExcalidrawLibraryMutation) AddedFields() []string {
-	return nil
+// SetLastBackupAt sets the "last_backup_at" field.
+func (m *BackupTargetMutation) SetLastBackupAt(t time.Time) {
+	m.last_backup_at = &t
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ExcalidrawLibraryMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// LastBackupAt returns the value of the "last_backup_at" field in the mutation.
+func (m *BackupTargetMutation) LastBackupAt() (r time.Time, exists bool) {
+	v := m.last_backup_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ExcalidrawLibraryMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldLastBackupAt returns the old "last_backup_at" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldLastBackupAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastBackupAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ExcalidrawLibrary numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastBackupAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastBackupAt: %w", err)
+	}
+	return oldValue.LastBackupAt, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ExcalidrawLibraryMutation) ClearedFields() []string {
-	return nil
+// ClearLastBackupAt clears the value of the "last_backup_at" field.
+func (m *BackupTargetMutation) ClearLastBackupAt() {
+	m.last_backup_at = nil
+	m.clearedFields[backuptarget.FieldLastBackupAt] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ExcalidrawLibraryMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// LastBackupAtCleared returns if the "last_backup_at" field was cleared in this mutation.
+func (m *BackupTargetMutation) LastBackupAtCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldLastBackupAt]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ExcalidrawLibraryMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ExcalidrawLibrary nullable field %s", name)
+// ResetLastBackupAt resets all changes to the "last_backup_at" field.
+func (m *BackupTargetMutation) ResetLastBackupAt() {
+	m.last_backup_at = nil
+	delete(m.clearedFields, backuptarget.FieldLastBackupAt)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ExcalidrawLibraryMutation) ResetField(name string) error {
-	switch name {
-	case excalidrawlibrary.FieldLibraryJSON:
-		m.ResetLibraryJSON()
-		return nil
-	case excalidrawlibrary.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case excalidrawlibrary.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown ExcalidrawLibrary field %s", name)
+// SetLastTestStatus sets the "last_test_status" field.
+func (m *BackupTargetMutation) SetLastTestStatus(s string) {
+	m.last_test_status = &s
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ExcalidrawLibraryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, excalidrawlibrary.EdgeUser)
+// LastTestStatus returns the value of the "last_test_status" field in the mutation.
+func (m *BackupTargetMutation) LastTestStatus() (r string, exists bool) {
+	v := m.last_test_status
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ExcalidrawLibraryMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case excalidrawlibrary.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
+// OldLastTestStatus returns the old "last_test_status" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldLastTestStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastTestStatus is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastTestStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastTestStatus: %w", err)
+	}
+	return oldValue.LastTestStatus, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ExcalidrawLibraryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ResetLastTestStatus resets all changes to the "last_test_status" field.
+func (m *BackupTargetMutation) ResetLastTestStatus() {
+	m.last_test_status = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ExcalidrawLibraryMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetLastTestError sets the "last_test_error" field.
+func (m *BackupTargetMutation) SetLastTestError(s string) {
+	m.last_test_error = &s
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ExcalidrawLibraryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, excalidrawlibrary.EdgeUser)
+// LastTestError returns the value of the "last_test_error" field in the mutation.
+func (m *BackupTargetMutation) LastTestError() (r string, exists bool) {
+	v := m.last_test_error
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ExcalidrawLibraryMutation) EdgeCleared(name string) bool {
-	switch name {
-	case excalidrawlibrary.EdgeUser:
-		return m.cleareduser
+// OldLastTestError returns the old "last_test_error" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldLastTestError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastTestError is only allowed on UpdateOne operations")
 	}
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ExcalidrawLibraryMutation) ClearEdge(name string) error {
-	switch name {
-	case excalidrawlibrary.EdgeUser:
-		m.ClearUser()
-		return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastTestError requires an ID field in the mutation")
 	}
-	return fmt.Errorf("unknown ExcalidrawLibrary unique edge %s", name)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastTestError: %w", err)
+	}
+	return oldValue.LastTestError, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ExcalidrawLibraryMutation) ResetEdge(name string) error {
-	switch name {
-	case excalidrawlibrary.EdgeUser:
-		m.ResetUser()
-		return nil
-	}
-	return fmt.Errorf("unknown ExcalidrawLibrary edge %s", name)
+// ClearLastTestError clears the value of the "last_test_error" field.
+func (m *BackupTargetMutation) ClearLastTestError() {
+	m.last_test_error = nil
+	m.clearedFields[backuptarget.FieldLastTestError] = struct{}{}
 }
 
-// FolderMutation represents an operation that mutates the Folder nodes in the graph.
-type FolderMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	name            *string
-	sort_order      *int
-	addsort_order   *int
-	is_starred      *bool
-	created_at      *time.Time
-	updated_at      *time.Time
-	clearedFields   map[string]struct{}
-	user            *int
-	cleareduser     bool
-	parent          *uuid.UUID
-	clearedparent   bool
-	children        map[uuid.UUID]struct{}
-	removedchildren map[uuid.UUID]struct{}
-	clearedchildren bool
-	notes           map[uuid.UUID]struct{}
-	removednotes    map[uuid.UUID]struct{}
-	clearednotes    bool
-	done            bool
-	oldValue        func(context.Context) (*Folder, error)
-	predicates      []predicate.Folder
+// LastTestErrorCleared returns if the "last_test_error" field was cleared in this mutation.
+func (m *BackupTargetMutation) LastTestErrorCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldLastTestError]
+	return ok
 }
 
-var _ ent.Mutation = (*FolderMutation)(nil)
+// ResetLastTestError resets all changes to the "last_test_error" field.
+func (m *BackupTargetMutation) ResetLastTestError() {
+	m.last_test_error = nil
+	delete(m.clearedFields, backuptarget.FieldLastTestError)
+}
 
-// folderOption allows management of the mutation configuration using functional options.
-type folderOption func(*FolderMutation)
+// SetLastTestAt sets the "last_test_at" field.
+func (m *BackupTargetMutation) SetLastTestAt(t time.Time) {
+	m.last_test_at = &t
+}
 
-// newFolderMutation creates new mutation for the Folder entity.
-func newFolderMutation(c config, op Op, opts ...folderOption) *FolderMutation {
-	m := &FolderMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeFolder,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// LastTestAt returns the value of the "last_test_at" field in the mutation.
+func (m *BackupTargetMutation) LastTestAt() (r time.Time, exists bool) {
+	v := m.last_test_at
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withFolderID sets the ID field of the mutation.
-func withFolderID(id uuid.UUID) folderOption {
-	return func(m *FolderMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Folder
-		)
-		m.oldValue = func(ctx context.Context) (*Folder, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Folder.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldLastTestAt returns the old "last_test_at" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldLastTestAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastTestAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastTestAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastTestAt: %w", err)
 	}
+	return oldValue.LastTestAt, nil
 }
 
-// withFolder sets the old Folder of the mutation.
-func withFolder(node *Folder) folderOption {
-	return func(m *FolderMutation) {
-		m.oldValue = func(context.Context) (*Folder, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ClearLastTestAt clears the value of the "last_test_at" field.
+func (m *BackupTargetMutation) ClearLastTestAt() {
+	m.last_test_at = nil
+	m.clearedFields[backuptarget.FieldLastTestAt] = struct{}{}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FolderMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// LastTestAtCleared returns if the "last_test_at" field was cleared in this mutation.
+func (m *BackupTargetMutation) LastTestAtCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldLastTestAt]
+	return ok
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m FolderMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetLastTestAt resets all changes to the "last_test_at" field.
+func (m *BackupTargetMutation) ResetLastTestAt() {
+	m.last_test_at = nil
+	delete(m.clearedFields, backuptarget.FieldLastTestAt)
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Folder entities.
-func (m *FolderMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// SetWebdavURL sets the "webdav_url" field.
+func (m *BackupTargetMutation) SetWebdavURL(s string) {
+	m.webdav_url = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *FolderMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *FolderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Folder.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetName sets the "name" field.
-func (m *FolderMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *FolderMutation) Name() (r string, exists bool) {
-	v := m.name
+// WebdavURL returns the value of the "webdav_url" field in the mutation.
+func (m *BackupTargetMutation) WebdavURL() (r string, exists bool) {
+	v := m.webdav_url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldWebdavURL returns the old "webdav_url" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BackupTargetMutation) OldWebdavURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebdavURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldWebdavURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldWebdavURL: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.WebdavURL, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *FolderMutation) ResetName() {
-	m.name = nil
+// ClearWebdavURL clears the value of the "webdav_url" field.
+func (m *BackupTargetMutation) ClearWebdavURL() {
+	m.webdav_url = nil
+	m.clearedFields[backuptarget.FieldWebdavURL] = struct{}{}
 }
 
-// SetSortOrder sets the "sort_order" field.
-func (m *FolderMutation) SetSortOrder(i int) {
-	m.sort_order = &i
-	m.addsort_order = nil
+// WebdavURLCleared returns if the "webdav_url" field was cleared in this mutation.
+func (m *BackupTargetMutation) WebdavURLCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldWebdavURL]
+	return ok
 }
 
-// SortOrder returns the value of the "sort_order" field in the mutation.
-func (m *FolderMutation) SortOrder() (r int, exists bool) {
-	v := m.sort_order
+// ResetWebdavURL resets all changes to the "webdav_url" field.
+func (m *BackupTargetMutation) ResetWebdavURL() {
+	m.webdav_url = nil
+	delete(m.clearedFields, backuptarget.FieldWebdavURL)
+}
+
+// SetWebdavUser sets the "webdav_user" field.
+func (m *BackupTargetMutation) SetWebdavUser(s string) {
+	m.webdav_user = &s
+}
+
+// WebdavUser returns the value of the "webdav_user" field in the mutation.
+func (m *BackupTargetMutation) WebdavUser() (r string, exists bool) {
+	v := m.webdav_user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSortOrder returns the old "sort_order" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldWebdavUser returns the old "webdav_user" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldSortOrder(ctx context.Context) (v int, err error) {
+func (m *BackupTargetMutation) OldWebdavUser(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebdavUser is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSortOrder requires an ID field in the mutation")
+		return v, errors.New("OldWebdavUser requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
+		return v, fmt.Errorf("querying old value for OldWebdavUser: %w", err)
 	}
-	return oldValue.SortOrder, nil
+	return oldValue.WebdavUser, nil
 }
 
-// AddSortOrder adds i to the "sort_order" field.
-func (m *FolderMutation) AddSortOrder(i int) {
-	if m.addsort_order != nil {
-		*m.addsort_order += i
-	} else {
-		m.addsort_order = &i
-	}
+// ClearWebdavUser clears the value of the "webdav_user" field.
+func (m *BackupTargetMutation) ClearWebdavUser() {
+	m.webdav_user = nil
+	m.clearedFields[backuptarget.FieldWebdavUser] = struct{}{}
 }
 
-// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
-func (m *FolderMutation) AddedSortOrder() (r int, exists bool) {
-	v := m.addsort_order
-	if v == nil {
-		return
-	}
-	return *v, true
+// WebdavUserCleared returns if the "webdav_user" field was cleared in this mutation.
+func (m *BackupTargetMutation) WebdavUserCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldWebdavUser]
+	return ok
 }
 
-// ResetSortOrder resets all changes to the "sort_order" field.
-func (m *FolderMutation) ResetSortOrder() {
-	m.sort_order = nil
-	m.addsort_order = nil
+// ResetWebdavUser resets all changes to the "webdav_user" field.
+func (m *BackupTargetMutation) ResetWebdavUser() {
+	m.webdav_user = nil
+	delete(m.clearedFields, backuptarget.FieldWebdavUser)
 }
 
-// SetIsStarred sets the "is_starred" field.
-func (m *FolderMutation) SetIsStarred(b bool) {
-	m.is_starred = &b
+// SetWebdavPassword sets the "webdav_password" field.
+func (m *BackupTargetMutation) SetWebdavPassword(s string) {
+	m.webdav_password = &s
 }
 
-// IsStarred returns the value of the "is_starred" field in the mutation.
-func (m *FolderMutation) IsStarred() (r bool, exists bool) {
-	v := m.is_starred
+// WebdavPassword returns the value of the "webdav_password" field in the mutation.
+func (m *BackupTargetMutation) WebdavPassword() (r string, exists bool) {
+	v := m.webdav_password
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsStarred returns the old "is_starred" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldWebdavPassword returns the old "webdav_password" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldIsStarred(ctx context.Context) (v bool, err error) {
+func (m *BackupTargetMutation) OldWebdavPassword(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsStarred is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebdavPassword is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsStarred requires an ID field in the mutation")
+		return v, errors.New("OldWebdavPassword requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsStarred: %w", err)
+		return v, fmt.Errorf("querying old value for OldWebdavPassword: %w", err)
 	}
-	return oldValue.IsStarred, nil
+	return oldValue.WebdavPassword, nil
 }
 
-// ResetIsStarred resets all changes to the "is_starred" field.
-func (m *FolderMutation) ResetIsStarred() {
-	m.is_starred = nil
+// ClearWebdavPassword clears the value of the "webdav_password" field.
+func (m *BackupTargetMutation) ClearWebdavPassword() {
+	m.webdav_password = nil
+	m.clearedFields[backuptarget.FieldWebdavPassword] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FolderMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// WebdavPasswordCleared returns if the "webdav_password" field was cleared in this mutation.
+func (m *BackupTargetMutation) WebdavPasswordCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldWebdavPassword]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FolderMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetWebdavPassword resets all changes to the "webdav_password" field.
+func (m *BackupTargetMutation) ResetWebdavPassword() {
+	m.webdav_password = nil
+	delete(m.clearedFields, backuptarget.FieldWebdavPassword)
+}
+
+// SetS3Endpoint sets the "s3_endpoint" field.
+func (m *BackupTargetMutation) SetS3Endpoint(s string) {
+	m.s3_endpoint = &s
+}
+
+// S3Endpoint returns the value of the "s3_endpoint" field in the mutation.
+func (m *BackupTargetMutation) S3Endpoint() (r string, exists bool) {
+	v := m.s3_endpoint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldS3Endpoint returns the old "s3_endpoint" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupTargetMutation) OldS3Endpoint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldS3Endpoint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldS3Endpoint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldS3Endpoint: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.S3Endpoint, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FolderMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearS3Endpoint clears the value of the "s3_endpoint" field.
+func (m *BackupTargetMutation) ClearS3Endpoint() {
+	m.s3_endpoint = nil
+	m.clearedFields[backuptarget.FieldS3Endpoint] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *FolderMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// S3EndpointCleared returns if the "s3_endpoint" field was cleared in this mutation.
+func (m *BackupTargetMutation) S3EndpointCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldS3Endpoint]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FolderMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetS3Endpoint resets all changes to the "s3_endpoint" field.
+func (m *BackupTargetMutation) ResetS3Endpoint() {
+	m.s3_endpoint = nil
+	delete(m.clearedFields, backuptarget.FieldS3Endpoint)
+}
+
+// SetS3Region sets the "s3_region" field.
+func (m *BackupTargetMutation) SetS3Region(s string) {
+	m.s3_region = &s
+}
+
+// S3Region returns the value of the "s3_region" field in the mutation.
+func (m *BackupTargetMutation) S3Region() (r string, exists bool) {
+	v := m.s3_region
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldS3Region returns the old "s3_region" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupTargetMutation) OldS3Region(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldS3Region is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldS3Region requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldS3Region: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.S3Region, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FolderMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearS3Region clears the value of the "s3_region" field.
+func (m *BackupTargetMutation) ClearS3Region() {
+	m.s3_region = nil
+	m.clearedFields[backuptarget.FieldS3Region] = struct{}{}
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *FolderMutation) SetUserID(id int) {
-	m.user = &id
+// S3RegionCleared returns if the "s3_region" field was cleared in this mutation.
+func (m *BackupTargetMutation) S3RegionCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldS3Region]
+	return ok
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *FolderMutation) ClearUser() {
-	m.cleareduser = true
+// ResetS3Region resets all changes to the "s3_region" field.
+func (m *BackupTargetMutation) ResetS3Region() {
+	m.s3_region = nil
+	delete(m.clearedFields, backuptarget.FieldS3Region)
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *FolderMutation) UserCleared() bool {
-	return m.cleareduser
+// SetS3Bucket sets the "s3_bucket" field.
+func (m *BackupTargetMutation) SetS3Bucket(s string) {
+	m.s3_bucket = &s
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *FolderMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// S3Bucket returns the value of the "s3_bucket" field in the mutation.
+func (m *BackupTargetMutation) S3Bucket() (r string, exists bool) {
+	v := m.s3_bucket
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *FolderMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldS3Bucket returns the old "s3_bucket" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldS3Bucket(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldS3Bucket is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldS3Bucket requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldS3Bucket: %w", err)
+	}
+	return oldValue.S3Bucket, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *FolderMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ClearS3Bucket clears the value of the "s3_bucket" field.
+func (m *BackupTargetMutation) ClearS3Bucket() {
+	m.s3_bucket = nil
+	m.clearedFields[backuptarget.FieldS3Bucket] = struct{}{}
 }
 
-// SetParentID sets the "parent" edge to the Folder entity by id.
-func (m *FolderMutation) SetParentID(id uuid.UUID) {
-	m.parent = &id
+// S3BucketCleared returns if the "s3_bucket" field was cleared in this mutation.
+func (m *BackupTargetMutation) S3BucketCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldS3Bucket]
+	return ok
 }
 
-// ClearParent clears the "parent" edge to the Folder entity.
-func (m *FolderMutation) ClearParent() {
-	m.clearedparent = true
+// ResetS3Bucket resets all changes to the "s3_bucket" field.
+func (m *BackupTargetMutation) ResetS3Bucket() {
+	m.s3_bucket = nil
+	delete(m.clearedFields, backuptarget.FieldS3Bucket)
 }
 
-// ParentCleared reports if the "parent" edge to the Folder entity was cleared.
-func (m *FolderMutation) ParentCleared() bool {
-	return m.clearedparent
+// SetS3AccessKey sets the "s3_access_key" field.
+func (m *BackupTargetMutation) SetS3AccessKey(s string) {
+	m.s3_access_key = &s
 }
 
-// ParentID returns the "parent" edge ID in the mutation.
-func (m *FolderMutation) ParentID() (id uuid.UUID, exists bool) {
-	if m.parent != nil {
-		return *m.parent, true
+// S3AccessKey returns the value of the "s3_access_key" field in the mutation.
+func (m *BackupTargetMutation) S3AccessKey() (r string, exists bool) {
+	v := m.s3_access_key
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *FolderMutation) ParentIDs() (ids []uuid.UUID) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
+// OldS3AccessKey returns the old "s3_access_key" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldS3AccessKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldS3AccessKey is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldS3AccessKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldS3AccessKey: %w", err)
+	}
+	return oldValue.S3AccessKey, nil
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *FolderMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// ClearS3AccessKey clears the value of the "s3_access_key" field.
+func (m *BackupTargetMutation) ClearS3AccessKey() {
+	m.s3_access_key = nil
+	m.clearedFields[backuptarget.FieldS3AccessKey] = struct{}{}
 }
 
-// AddChildIDs adds the "children" edge to the Folder entity by ids.
-func (m *FolderMutation) AddChildIDs(ids ...uuid.UUID) {
-	if m.children == nil {
-		m.children = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
-	}
+// S3AccessKeyCleared returns if the "s3_access_key" field was cleared in this mutation.
+func (m *BackupTargetMutation) S3AccessKeyCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldS3AccessKey]
+	return ok
 }
 
-// ClearChildren clears the "children" edge to the Folder entity.
-func (m *FolderMutation) ClearChildren() {
-	m.clearedchildren = true
+// ResetS3AccessKey resets all changes to the "s3_access_key" field.
+func (m *BackupTargetMutation) ResetS3AccessKey() {
+	m.s3_access_key = nil
+	delete(m.clearedFields, backuptarget.FieldS3AccessKey)
 }
 
-// ChildrenCleared reports if the "children" edge to the Folder entity was cleared.
-func (m *FolderMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// SetS3SecretKey sets the "s3_secret_key" field.
+func (m *BackupTargetMutation) SetS3SecretKey(s string) {
+	m.s3_secret_key = &s
 }
 
-// RemoveChildIDs removes the "children" edge to the Folder entity by IDs.
-func (m *FolderMutation) RemoveChildIDs(ids ...uuid.UUID) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
+// S3SecretKey returns the value of the "s3_secret_key" field in the mutation.
+func (m *BackupTargetMutation) S3SecretKey() (r string, exists bool) {
+	v := m.s3_secret_key
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the Folder entity.
-func (m *FolderMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
+// OldS3SecretKey returns the old "s3_secret_key" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldS3SecretKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldS3SecretKey is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldS3SecretKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldS3SecretKey: %w", err)
+	}
+	return oldValue.S3SecretKey, nil
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *FolderMutation) ChildrenIDs() (ids []uuid.UUID) {
-	for id := range m.children {
-		ids = append(ids, id)
-	}
-	return
+// ClearS3SecretKey clears the value of the "s3_secret_key" field.
+func (m *BackupTargetMutation) ClearS3SecretKey() {
+	m.s3_secret_key = nil
+	m.clearedFields[backuptarget.FieldS3SecretKey] = struct{}{}
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *FolderMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// S3SecretKeyCleared returns if the "s3_secret_key" field was cleared in this mutation.
+func (m *BackupTargetMutation) S3SecretKeyCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldS3SecretKey]
+	return ok
 }
 
-// AddNoteIDs adds the "notes" edge to the Note entity by ids.
-func (m *FolderMutation) AddNoteIDs(ids ...uuid.UUID) {
-	if m.notes == nil {
-		m.notes = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.notes[ids[i]] = struct{}{}
-	}
+// ResetS3SecretKey resets all changes to the "s3_secret_key" field.
+func (m *BackupTargetMutation) ResetS3SecretKey() {
+	m.s3_secret_key = nil
+	delete(m.clearedFields, backuptarget.FieldS3SecretKey)
 }
 
-// ClearNotes clears the "notes" edge to the Note entity.
-func (m *FolderMutation) ClearNotes() {
-	m.clearednotes = true
+// SetLocalPath sets the "local_path" field.
+func (m *BackupTargetMutation) SetLocalPath(s string) {
+	m.local_path = &s
 }
 
-// NotesCleared reports if the "notes" edge to the Note entity was cleared.
-func (m *FolderMutation) NotesCleared() bool {
-	return m.clearednotes
+// LocalPath returns the value of the "local_path" field in the mutation.
+func (m *BackupTargetMutation) LocalPath() (r string, exists bool) {
+	v := m.local_path
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveNoteIDs removes the "notes" edge to the Note entity by IDs.
-func (m *FolderMutation) RemoveNoteIDs(ids ...uuid.UUID) {
-	if m.removednotes == nil {
-		m.removednotes = make(map[uuid.UUID]struct{})
+// OldLocalPath returns the old "local_path" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldLocalPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocalPath is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.notes, ids[i])
-		m.removednotes[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocalPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocalPath: %w", err)
 	}
+	return oldValue.LocalPath, nil
 }
 
-// RemovedNotes returns the removed IDs of the "notes" edge to the Note entity.
-func (m *FolderMutation) RemovedNotesIDs() (ids []uuid.UUID) {
-	for id := range m.removednotes {
-		ids = append(ids, id)
-	}
-	return
+// ClearLocalPath clears the value of the "local_path" field.
+func (m *BackupTargetMutation) ClearLocalPath() {
+	m.local_path = nil
+	m.clearedFields[backuptarget.FieldLocalPath] = struct{}{}
 }
 
-// NotesIDs returns the "notes" edge IDs in the mutation.
-func (m *FolderMutation) NotesIDs() (ids []uuid.UUID) {
-	for id := range m.notes {
-		ids = append(ids, id)
-	}
-	return
+// LocalPathCleared returns if the "local_path" field was cleared in this mutation.
+func (m *BackupTargetMutation) LocalPathCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldLocalPath]
+	return ok
 }
 
-// ResetNotes resets all changes to the "notes" edge.
-func (m *FolderMutation) ResetNotes() {
-	m.notes = nil
-	m.clearednotes = false
-	m.removednotes = nil
+// ResetLocalPath resets all changes to the "local_path" field.
+func (m *BackupTargetMutation) ResetLocalPath() {
+	m.local_path = nil
+	delete(m.clearedFields, backuptarget.FieldLocalPath)
 }
 
-// Where appends a list predicates to the FolderMutation builder.
-func (m *FolderMutation) Where(ps ...predicate.Folder) {
-	m.predicates = append(m.predicates, ps...)
+// SetSftpHost sets the "sftp_host" field.
+func (m *BackupTargetMutation) SetSftpHost(s string) {
+	m.sftp_host = &s
 }
 
-// WhereP appends storage-level predicates to the FolderMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FolderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Folder, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// SftpHost returns the value of the "sftp_host" field in the mutation.
+func (m *BackupTargetMutation) SftpHost() (r string, exists bool) {
+	v := m.sftp_host
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *FolderMutation) Op() Op {
-	return m.op
+// OldSftpHost returns the old "sftp_host" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldSftpHost(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSftpHost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSftpHost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSftpHost: %w", err)
+	}
+	return oldValue.SftpHost, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *FolderMutation) SetOp(op Op) {
-	m.op = op
+// ClearSftpHost clears the value of the "sftp_host" field.
+func (m *BackupTargetMutation) ClearSftpHost() {
+	m.sftp_host = nil
+	m.clearedFields[backuptarget.FieldSftpHost] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Folder).
-func (m *FolderMutation) Type() string {
-	return m.typ
+// SftpHostCleared returns if the "sftp_host" field was cleared in this mutation.
+func (m *BackupTargetMutation) SftpHostCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldSftpHost]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *FolderMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.name != nil {
-		fields = append(fields, folder.FieldName)
-	}
-	if m.sort_order != nil {
-		fields = append(fields, folder.FieldSortOrder)
+// ResetSftpHost resets all changes to the "sftp_host" field.
+func (m *BackupTargetMutation) ResetSftpHost() {
+	m.sftp_host = nil
+	delete(m.clearedFields, backuptarget.FieldSftpHost)
+}
+
+// SetSftpPort sets the "sftp_port" field.
+func (m *BackupTargetMutation) SetSftpPort(i int) {
+	m.sftp_port = &i
+	m.addsftp_port = nil
+}
+
+// SftpPort returns the value of the "sftp_port" field in the mutation.
+func (m *BackupTargetMutation) SftpPort() (r int, exists bool) {
+	v := m.sftp_port
+	if v == nil {
+		return
 	}
-	if m.is_starred != nil {
-		fields = append(fields, folder.FieldIsStarred)
+	return *v, true
+}
+
+// OldSftpPort returns the old "sftp_port" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldSftpPort(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSftpPort is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, folder.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSftpPort requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, folder.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSftpPort: %w", err)
 	}
-	return fields
+	return oldValue.SftpPort, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *FolderMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case folder.FieldName:
-		return m.Name()
-	case folder.FieldSortOrder:
-		return m.SortOrder()
-	case folder.FieldIsStarred:
-		return m.IsStarred()
-	case folder.FieldCreatedAt:
-		return m.CreatedAt()
-	case folder.FieldUpdatedAt:
-		return m.UpdatedAt()
+// AddSftpPort adds i to the "sftp_port" field.
+func (m *BackupTargetMutation) AddSftpPort(i int) {
+	if m.addsftp_port != nil {
+		*m.addsftp_port += i
+	} else {
+		m.addsftp_port = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *FolderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case folder.FieldName:
-		return m.OldName(ctx)
-	case folder.FieldSortOrder:
-		return m.OldSortOrder(ctx)
-	case folder.FieldIsStarred:
-		return m.OldIsStarred(ctx)
-	case folder.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case folder.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// AddedSftpPort returns the value that was added to the "sftp_port" field in this mutation.
+func (m *BackupTargetMutation) AddedSftpPort() (r int, exists bool) {
+	v := m.addsftp_port
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Folder field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *FolderMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case folder.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case folder.FieldSortOrder:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSortOrder(v)
-		return nil
-	case folder.FieldIsStarred:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsStarred(v)
-		return nil
-	case folder.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case folder.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Folder field %s", name)
+// ResetSftpPort resets all changes to the "sftp_port" field.
+func (m *BackupTargetMutation) ResetSftpPort() {
+	m.sftp_port = nil
+	m.addsftp_port = nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *FolderMutation) AddedFields() []string {
-	var fields []string
-	if m.addsort_order != nil {
-		fields = append(fields, folder.FieldSortOrder)
-	}
-	return fields
+// SetSftpUser sets the "sftp_user" field.
+func (m *BackupTargetMutation) SetSftpUser(s string) {
+	m.sftp_user = &s
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *FolderMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case folder.FieldSortOrder:
-		return m.AddedSortOrder()
+// SftpUser returns the value of the "sftp_user" field in the mutation.
+func (m *BackupTargetMutation) SftpUser() (r string, exists bool) {
+	v := m.sftp_user
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *FolderMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case folder.FieldSortOrder:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSortOrder(v)
-		return nil
+// OldSftpUser returns the old "sftp_user" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldSftpUser(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSftpUser is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Folder numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSftpUser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSftpUser: %w", err)
+	}
+	return oldValue.SftpUser, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *FolderMutation) ClearedFields() []string {
-	return nil
+// ClearSftpUser clears the value of the "sftp_user" field.
+func (m *BackupTargetMutation) ClearSftpUser() {
+	m.sftp_user = nil
+	m.clearedFields[backuptarget.FieldSftpUser] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *FolderMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// SftpUserCleared returns if the "sftp_user" field was cleared in this mutation.
+func (m *BackupTargetMutation) SftpUserCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldSftpUser]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *FolderMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Folder nullable field %s", name)
+// ResetSftpUser resets all changes to the "sftp_user" field.
+func (m *BackupTargetMutation) ResetSftpUser() {
+	m.sftp_user = nil
+	delete(m.clearedFields, backuptarget.FieldSftpUser)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *FolderMutation) ResetField(name string) error {
-	switch name {
-	case folder.FieldName:
-		m.ResetName()
-		return nil
-	case folder.FieldSortOrder:
-		m.ResetSortOrder()
-		return nil
-	case folder.FieldIsStarred:
-		m.ResetIsStarred()
-		return nil
-	case folder.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case folder.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Folder field %s", name)
+// SetSftpPassword sets the "sftp_password" field.
+func (m *BackupTargetMutation) SetSftpPassword(s string) {
+	m.sftp_password = &s
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FolderMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.user != nil {
-		edges = append(edges, folder.EdgeUser)
+// SftpPassword returns the value of the "sftp_password" field in the mutation.
+func (m *BackupTargetMutation) SftpPassword() (r string, exists bool) {
+	v := m.sftp_password
+	if v == nil {
+		return
 	}
-	if m.parent != nil {
-		edges = append(edges, folder.EdgeParent)
+	return *v, true
+}
+
+// OldSftpPassword returns the old "sftp_password" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldSftpPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSftpPassword is only allowed on UpdateOne operations")
 	}
-	if m.children != nil {
-		edges = append(edges, folder.EdgeChildren)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSftpPassword requires an ID field in the mutation")
 	}
-	if m.notes != nil {
-		edges = append(edges, folder.EdgeNotes)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSftpPassword: %w", err)
 	}
-	return edges
+	return oldValue.SftpPassword, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *FolderMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case folder.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case folder.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case folder.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	case folder.EdgeNotes:
-		ids := make([]ent.Value, 0, len(m.notes))
-		for id := range m.notes {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearSftpPassword clears the value of the "sftp_password" field.
+func (m *BackupTargetMutation) ClearSftpPassword() {
+	m.sftp_password = nil
+	m.clearedFields[backuptarget.FieldSftpPassword] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FolderMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedchildren != nil {
-		edges = append(edges, folder.EdgeChildren)
-	}
-	if m.removednotes != nil {
-		edges = append(edges, folder.EdgeNotes)
-	}
-	return edges
+// SftpPasswordCleared returns if the "sftp_password" field was cleared in this mutation.
+func (m *BackupTargetMutation) SftpPasswordCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldSftpPassword]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *FolderMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case folder.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	case folder.EdgeNotes:
-		ids := make([]ent.Value, 0, len(m.removednotes))
-		for id := range m.removednotes {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetSftpPassword resets all changes to the "sftp_password" field.
+func (m *BackupTargetMutation) ResetSftpPassword() {
+	m.sftp_password = nil
+	delete(m.clearedFields, backuptarget.FieldSftpPassword)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FolderMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.cleareduser {
-		edges = append(edges, folder.EdgeUser)
-	}
-	if m.clearedparent {
-		edges = append(edges, folder.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, folder.EdgeChildren)
-	}
-	if m.clearednotes {
-		edges = append(edges, folder.EdgeNotes)
-	}
-	return edges
+// SetSftpPrivateKey sets the "sftp_private_key" field.
+func (m *BackupTargetMutation) SetSftpPrivateKey(s string) {
+	m.sftp_private_key = &s
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *FolderMutation) EdgeCleared(name string) bool {
-	switch name {
-	case folder.EdgeUser:
-		return m.cleareduser
-	case folder.EdgeParent:
-		return m.clearedparent
-	case folder.EdgeChildren:
-		return m.clearedchildren
-	case folder.EdgeNotes:
-		return m.clearednotes
+// SftpPrivateKey returns the value of the "sftp_private_key" field in the mutation.
+func (m *BackupTargetMutation) SftpPrivateKey() (r string, exists bool) {
+	v := m.sftp_private_key
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *FolderMutation) ClearEdge(name string) error {
-	switch name {
-	case folder.EdgeUser:
-		m.ClearUser()
-		return nil
-	case folder.EdgeParent:
-		m.ClearParent()
-		return nil
+// OldSftpPrivateKey returns the old "sftp_private_key" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldSftpPrivateKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSftpPrivateKey is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Folder unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *FolderMutation) ResetEdge(name string) error {
-	switch name {
-	case folder.EdgeUser:
-		m.ResetUser()
-		return nil
-	case folder.EdgeParent:
-		m.ResetParent()
-		return nil
-	case folder.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	case folder.EdgeNotes:
-		m.ResetNotes()
-		return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSftpPrivateKey requires an ID field in the mutation")
 	}
-	return fmt.Errorf("unknown Folder edge %s", name)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSftpPrivateKey: %w", err)
+	}
+	return oldValue.SftpPrivateKey, nil
 }
 
-// FontMutation represents an operation that mutates the Font nodes in the graph.
-type FontMutation struct {
-	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	name               *string
-	display_name       *string
-	file_path          *string
-	file_size          *int64
-	addfile_size       *int64
-	format             *font.Format
-	preview_text       *string
-	is_shared          *bool
-	created_at         *time.Time
-	clearedFields      map[string]struct{}
-	uploaded_by        *int
-	cleareduploaded_by bool
-	done               bool
-	oldValue           func(context.Context) (*Font, error)
-	predicates         []predicate.Font
+// ClearSftpPrivateKey clears the value of the "sftp_private_key" field.
+func (m *BackupTargetMutation) ClearSftpPrivateKey() {
+	m.sftp_private_key = nil
+	m.clearedFields[backuptarget.FieldSftpPrivateKey] = struct{}{}
 }
 
-var _ ent.Mutation = (*FontMutation)(nil)
-
-// fontOption allows management of the mutation configuration using functional options.
-type fontOption func(*FontMutation)
-
-// newFontMutation creates new mutation for the Font entity.
-func newFontMutation(c config, op Op, opts ...fontOption) *FontMutation {
-	m := &FontMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeFont,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SftpPrivateKeyCleared returns if the "sftp_private_key" field was cleared in this mutation.
+func (m *BackupTargetMutation) SftpPrivateKeyCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldSftpPrivateKey]
+	return ok
 }
 
-// withFontID sets the ID field of the mutation.
-func withFontID(id uuid.UUID) fontOption {
-	return func(m *FontMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Font
-		)
-		m.oldValue = func(ctx context.Context) (*Font, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Font.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetSftpPrivateKey resets all changes to the "sftp_private_key" field.
+func (m *BackupTargetMutation) ResetSftpPrivateKey() {
+	m.sftp_private_key = nil
+	delete(m.clearedFields, backuptarget.FieldSftpPrivateKey)
 }
 
-// withFont sets the old Font of the mutation.
-func withFont(node *Font) fontOption {
-	return func(m *FontMutation) {
-		m.oldValue = func(context.Context) (*Font, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetSftpPath sets the "sftp_path" field.
+func (m *BackupTargetMutation) SetSftpPath(s string) {
+	m.sftp_path = &s
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FontMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// SftpPath returns the value of the "sftp_path" field in the mutation.
+func (m *BackupTargetMutation) SftpPath() (r string, exists bool) {
+	v := m.sftp_path
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m FontMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// OldSftpPath returns the old "sftp_path" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldSftpPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSftpPath is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSftpPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSftpPath: %w", err)
+	}
+	return oldValue.SftpPath, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Font entities.
-func (m *FontMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ClearSftpPath clears the value of the "sftp_path" field.
+func (m *BackupTargetMutation) ClearSftpPath() {
+	m.sftp_path = nil
+	m.clearedFields[backuptarget.FieldSftpPath] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *FontMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// SftpPathCleared returns if the "sftp_path" field was cleared in this mutation.
+func (m *BackupTargetMutation) SftpPathCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldSftpPath]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *FontMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Font.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetSftpPath resets all changes to the "sftp_path" field.
+func (m *BackupTargetMutation) ResetSftpPath() {
+	m.sftp_path = nil
+	delete(m.clearedFields, backuptarget.FieldSftpPath)
 }
 
-// SetName sets the "name" field.
-func (m *FontMutation) SetName(s string) {
-	m.name = &s
+// SetSftpHostKey sets the "sftp_host_key" field.
+func (m *BackupTargetMutation) SetSftpHostKey(s string) {
+	m.sftp_host_key = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *FontMutation) Name() (r string, exists bool) {
-	v := m.name
+// SftpHostKey returns the value of the "sftp_host_key" field in the mutation.
+func (m *BackupTargetMutation) SftpHostKey() (r string, exists bool) {
+	v := m.sftp_host_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Font entity.
-// If the Font object wasn't provided to the builder, the object is fetched from the database.
+// OldSftpHostKey returns the old "sftp_host_key" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FontMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BackupTargetMutation) OldSftpHostKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSftpHostKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldSftpHostKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSftpHostKey: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.SftpHostKey, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *FontMutation) ResetName() {
-	m.name = nil
+// ClearSftpHostKey clears the value of the "sftp_host_key" field.
+func (m *BackupTargetMutation) ClearSftpHostKey() {
+	m.sftp_host_key = nil
+	m.clearedFields[backuptarget.FieldSftpHostKey] = struct{}{}
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *FontMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SftpHostKeyCleared returns if the "sftp_host_key" field was cleared in this mutation.
+func (m *BackupTargetMutation) SftpHostKeyCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldSftpHostKey]
+	return ok
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *FontMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// ResetSftpHostKey resets all changes to the "sftp_host_key" field.
+func (m *BackupTargetMutation) ResetSftpHostKey() {
+	m.sftp_host_key = nil
+	delete(m.clearedFields, backuptarget.FieldSftpHostKey)
+}
+
+// SetAlertAfterHours sets the "alert_after_hours" field.
+func (m *BackupTargetMutation) SetAlertAfterHours(i int) {
+	m.alert_after_hours = &i
+	m.addalert_after_hours = nil
+}
+
+// AlertAfterHours returns the value of the "alert_after_hours" field in the mutation.
+func (m *BackupTargetMutation) AlertAfterHours() (r int, exists bool) {
+	v := m.alert_after_hours
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the Font entity.
-// If the Font object wasn't provided to the builder, the object is fetched from the database.
+// OldAlertAfterHours returns the old "alert_after_hours" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FontMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *BackupTargetMutation) OldAlertAfterHours(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldAlertAfterHours is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldAlertAfterHours requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldAlertAfterHours: %w", err)
 	}
-	return oldValue.DisplayName, nil
+	return oldValue.AlertAfterHours, nil
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *FontMutation) ResetDisplayName() {
-	m.display_name = nil
+// AddAlertAfterHours adds i to the "alert_after_hours" field.
+func (m *BackupTargetMutation) AddAlertAfterHours(i int) {
+	if m.addalert_after_hours != nil {
+		*m.addalert_after_hours += i
+	} else {
+		m.addalert_after_hours = &i
+	}
 }
 
-// SetFilePath sets the "file_path" field.
-func (m *FontMutation) SetFilePath(s string) {
-	m.file_path = &s
-}
-
-// FilePath returns the value of the "file_path" field in the mutation.
-func (m *FontMutation) FilePath() (r string, exists bool) {
-	v := m.file_path
+// AddedAlertAfterHours returns the value that was added to the "alert_after_hours" field in this mutation.
+func (m *BackupTargetMutation) AddedAlertAfterHours() (r int, exists bool) {
+	v := m.addalert_after_hours
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilePath returns the old "file_path" field's value of the Font entity.
-// If the Font object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FontMutation) OldFilePath(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilePath is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilePath requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilePath: %w", err)
-	}
-	return oldValue.FilePath, nil
-}
-
-// ResetFilePath resets all changes to the "file_path" field.
-func (m *FontMutation) ResetFilePath() {
-	m.file_path = nil
+// ResetAlertAfterHours resets all changes to the "alert_after_hours" field.
+func (m *BackupTargetMutation) ResetAlertAfterHours() {
+	m.alert_after_hours = nil
+	m.addalert_after_hours = nil
 }
 
-// SetFileSize sets the "file_size" field.
-func (m *FontMutation) SetFileSize(i int64) {
-	m.file_size = &i
-	m.addfile_size = nil
+// SetDrillIntervalDays sets the "drill_interval_days" field.
+func (m *BackupTargetMutation) SetDrillIntervalDays(i int) {
+	m.drill_interval_days = &i
+	m.adddrill_interval_days = nil
 }
 
-// FileSize returns the value of the "file_size" field in the mutation.
-func (m *FontMutation) FileSize() (r int64, exists bool) {
-	v := m.file_size
+// DrillIntervalDays returns the value of the "drill_interval_days" field in the mutation.
+func (m *BackupTargetMutation) DrillIntervalDays() (r int, exists bool) {
+	v := m.drill_interval_days
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileSize returns the old "file_size" field's value of the Font entity.
-// If the Font object wasn't provided to the builder, the object is fetched from the database.
+// OldDrillIntervalDays returns the old "drill_interval_days" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FontMutation) OldFileSize(ctx context.Context) (v int64, err error) {
+func (m *BackupTargetMutation) OldDrillIntervalDays(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldDrillIntervalDays is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileSize requires an ID field in the mutation")
+		return v, errors.New("OldDrillIntervalDays requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileSize: %w", err)
+		return v, fmt.Errorf("querying old value for OldDrillIntervalDays: %w", err)
 	}
-	return oldValue.FileSize, nil
+	return oldValue.DrillIntervalDays, nil
 }
 
-// AddFileSize adds i to the "file_size" field.
-func (m *FontMutation) AddFileSize(i int64) {
-	if m.addfile_size != nil {
-		*m.addfile_size += i
+// AddDrillIntervalDays adds i to the "drill_interval_days" field.
+func (m *BackupTargetMutation) AddDrillIntervalDays(i int) {
+	if m.adddrill_interval_days != nil {
+		*m.adddrill_interval_days += i
 	} else {
-		m.addfile_size = &i
+		m.adddrill_interval_days = &i
 	}
 }
 
-// AddedFileSize returns the value that was added to the "file_size" field in this mutation.
-func (m *FontMutation) AddedFileSize() (r int64, exists bool) {
-	v := m.addfile_size
+// AddedDrillIntervalDays returns the value that was added to the "drill_interval_days" field in this mutation.
+func (m *BackupTargetMutation) AddedDrillIntervalDays() (r int, exists bool) {
+	v := m.adddrill_interval_days
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFileSize resets all changes to the "file_size" field.
-func (m *FontMutation) ResetFileSize() {
-	m.file_size = nil
-	m.addfile_size = nil
+// ResetDrillIntervalDays resets all changes to the "drill_interval_days" field.
+func (m *BackupTargetMutation) ResetDrillIntervalDays() {
+	m.drill_interval_days = nil
+	m.adddrill_interval_days = nil
 }
 
-// SetFormat sets the "format" field.
-func (m *FontMutation) SetFormat(f font.Format) {
-	m.format = &f
+// SetStaleAlertedAt sets the "stale_alerted_at" field.
+func (m *BackupTargetMutation) SetStaleAlertedAt(t time.Time) {
+	m.stale_alerted_at = &t
 }
 
-// Format returns the value of the "format" field in the mutation.
-func (m *FontMutation) Format() (r font.Format, exists bool) {
-	v := m.format
+// StaleAlertedAt returns the value of the "stale_alerted_at" field in the mutation.
+func (m *BackupTargetMutation) StaleAlertedAt() (r time.Time, exists bool) {
+	v := m.stale_alerted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFormat returns the old "format" field's value of the Font entity.
-// If the Font object wasn't provided to the builder, the object is fetched from the database.
+// OldStaleAlertedAt returns the old "stale_alerted_at" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FontMutation) OldFormat(ctx context.Context) (v font.Format, err error) {
+func (m *BackupTargetMutation) OldStaleAlertedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+		return v, errors.New("OldStaleAlertedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFormat requires an ID field in the mutation")
+		return v, errors.New("OldStaleAlertedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+		return v, fmt.Errorf("querying old value for OldStaleAlertedAt: %w", err)
 	}
-	return oldValue.Format, nil
+	return oldValue.StaleAlertedAt, nil
 }
 
-// ResetFormat resets all changes to the "format" field.
-func (m *FontMutation) ResetFormat() {
-	m.format = nil
+// ClearStaleAlertedAt clears the value of the "stale_alerted_at" field.
+func (m *BackupTargetMutation) ClearStaleAlertedAt() {
+	m.stale_alerted_at = nil
+	m.clearedFields[backuptarget.FieldStaleAlertedAt] = struct{}{}
 }
 
-// SetPreviewText sets the "preview_text" field.
-func (m *FontMutation) SetPreviewText(s string) {
-	m.preview_text = &s
+// StaleAlertedAtCleared returns if the "stale_alerted_at" field was cleared in this mutation.
+func (m *BackupTargetMutation) StaleAlertedAtCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldStaleAlertedAt]
+	return ok
 }
 
-// PreviewText returns the value of the "preview_text" field in the mutation.
-func (m *FontMutation) PreviewText() (r string, exists bool) {
-	v := m.preview_text
+// ResetStaleAlertedAt resets all changes to the "stale_alerted_at" field.
+func (m *BackupTargetMutation) ResetStaleAlertedAt() {
+	m.stale_alerted_at = nil
+	delete(m.clearedFields, backuptarget.FieldStaleAlertedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BackupTargetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BackupTargetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPreviewText returns the old "preview_text" field's value of the Font entity.
-// If the Font object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FontMutation) OldPreviewText(ctx context.Context) (v string, err error) {
+func (m *BackupTargetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPreviewText is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPreviewText requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPreviewText: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.PreviewText, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetPreviewText resets all changes to the "preview_text" field.
-func (m *FontMutation) ResetPreviewText() {
-	m.preview_text = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BackupTargetMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetIsShared sets the "is_shared" field.
-func (m *FontMutation) SetIsShared(b bool) {
-	m.is_shared = &b
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BackupTargetMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// IsShared returns the value of the "is_shared" field in the mutation.
-func (m *FontMutation) IsShared() (r bool, exists bool) {
-	v := m.is_shared
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BackupTargetMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsShared returns the old "is_shared" field's value of the Font entity.
-// If the Font object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FontMutation) OldIsShared(ctx context.Context) (v bool, err error) {
+func (m *BackupTargetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsShared is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsShared requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsShared: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.IsShared, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetIsShared resets all changes to the "is_shared" field.
-func (m *FontMutation) ResetIsShared() {
-	m.is_shared = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BackupTargetMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FontMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddTaskIDs adds the "tasks" edge to the BackupTask entity by ids.
+func (m *BackupTargetMutation) AddTaskIDs(ids ...int) {
+	if m.tasks == nil {
+		m.tasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tasks[ids[i]] = struct{}{}
+	}
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FontMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearTasks clears the "tasks" edge to the BackupTask entity.
+func (m *BackupTargetMutation) ClearTasks() {
+	m.clearedtasks = true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Font entity.
-// If the Font object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FontMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// TasksCleared reports if the "tasks" edge to the BackupTask entity was cleared.
+func (m *BackupTargetMutation) TasksCleared() bool {
+	return m.clearedtasks
+}
+
+// RemoveTaskIDs removes the "tasks" edge to the BackupTask entity by IDs.
+func (m *BackupTargetMutation) RemoveTaskIDs(ids ...int) {
+	if m.removedtasks == nil {
+		m.removedtasks = make(map[int]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.tasks, ids[i])
+		m.removedtasks[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+}
+
+// RemovedTasks returns the removed IDs of the "tasks" edge to the BackupTask entity.
+func (m *BackupTargetMutation) RemovedTasksIDs() (ids []int) {
+	for id := range m.removedtasks {
+		ids = append(ids, id)
 	}
-	return oldValue.CreatedAt, nil
+	return
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FontMutation) ResetCreatedAt() {
-	m.created_at = nil
+// TasksIDs returns the "tasks" edge IDs in the mutation.
+func (m *BackupTargetMutation) TasksIDs() (ids []int) {
+	for id := range m.tasks {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetUploadedByID sets the "uploaded_by" edge to the User entity by id.
-func (m *FontMutation) SetUploadedByID(id int) {
-	m.uploaded_by = &id
+// ResetTasks resets all changes to the "tasks" edge.
+func (m *BackupTargetMutation) ResetTasks() {
+	m.tasks = nil
+	m.clearedtasks = false
+	m.removedtasks = nil
 }
 
-// ClearUploadedBy clears the "uploaded_by" edge to the User entity.
-func (m *FontMutation) ClearUploadedBy() {
-	m.cleareduploaded_by = true
+// AddPinIDs adds the "pins" edge to the BackupPin entity by ids.
+func (m *BackupTargetMutation) AddPinIDs(ids ...int) {
+	if m.pins == nil {
+		m.pins = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.pins[ids[i]] = struct{}{}
+	}
 }
 
-// UploadedByCleared reports if the "uploaded_by" edge to the User entity was cleared.
-func (m *FontMutation) UploadedByCleared() bool {
-	return m.cleareduploaded_by
+// ClearPins clears the "pins" edge to the BackupPin entity.
+func (m *BackupTargetMutation) ClearPins() {
+	m.clearedpins = true
 }
 
-// UploadedByID returns the "uploaded_by" edge ID in the mutation.
-func (m *FontMutation) UploadedByID() (id int, exists bool) {
-	if m.uploaded_by != nil {
-		return *m.uploaded_by, true
+// PinsCleared reports if the "pins" edge to the BackupPin entity was cleared.
+func (m *BackupTargetMutation) PinsCleared() bool {
+	return m.clearedpins
+}
+
+// RemovePinIDs removes the "pins" edge to the BackupPin entity by IDs.
+func (m *BackupTargetMutation) RemovePinIDs(ids ...int) {
+	if m.removedpins == nil {
+		m.removedpins = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.pins, ids[i])
+		m.removedpins[ids[i]] = struct{}{}
 	}
-	return
 }
 
-// UploadedByIDs returns the "uploaded_by" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UploadedByID instead. It exists only for internal usage by the builders.
-func (m *FontMutation) UploadedByIDs() (ids []int) {
-	if id := m.uploaded_by; id != nil {
-		ids = append(ids, *id)
+// RemovedPins returns the removed IDs of the "pins" edge to the BackupPin entity.
+func (m *BackupTargetMutation) RemovedPinsIDs() (ids []int) {
+	for id := range m.removedpins {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUploadedBy resets all changes to the "uploaded_by" edge.
-func (m *FontMutation) ResetUploadedBy() {
-	m.uploaded_by = nil
-	m.cleareduploaded_by = false
+// PinsIDs returns the "pins" edge IDs in the mutation.
+func (m *BackupTargetMutation) PinsIDs() (ids []int) {
+	for id := range m.pins {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the FontMutation builder.
-func (m *FontMutation) Where(ps ...predicate.Font) {
-	m.predicates = append(m.predicates, ps...)
+// ResetPins resets all changes to the "pins" edge.
+func (m *BackupTargetMutation) ResetPins() {
+	m.pins = nil
+	m.clearedpins = false
+	m.removedpins = nil
 }
 
-// WhereP appends storage-level predicates to the FontMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FontMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Font, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddRunIDs adds the "runs" edge to the BackupRun entity by ids.
+func (m *BackupTargetMutation) AddRunIDs(ids ...int) {
+	if m.runs == nil {
+		m.runs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.runs[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *FontMutation) Op() Op {
+// ClearRuns clears the "runs" edge to the BackupRun entity.
+func (m *BackupTargetMutation) ClearRuns() {
+	m.clearedruns = true
+}
+
+// RunsCleared reports if the "runs" edge to the BackupRun entity was cleared.
+func (m *BackupTargetMutation) RunsCleared() bool {
+	return m.clearedruns
+}
+
+// RemoveRunIDs removes the "runs" edge to the BackupRun entity by IDs.
+func (m *BackupTargetMutation) RemoveRunIDs(ids ...int) {
+	if m.removedruns == nil {
+		m.removedruns = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.runs, ids[i])
+		m.removedruns[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRuns returns the removed IDs of the "runs" edge to the BackupRun entity.
+func (m *BackupTargetMutation) RemovedRunsIDs() (ids []int) {
+	for id := range m.removedruns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RunsIDs returns the "runs" edge IDs in the mutation.
+func (m *BackupTargetMutation) RunsIDs() (ids []int) {
+	for id := range m.runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRuns resets all changes to the "runs" edge.
+func (m *BackupTargetMutation) ResetRuns() {
+	m.runs = nil
+	m.clearedruns = false
+	m.removedruns = nil
+}
+
+// AddDrillIDs adds the "drills" edge to the BackupDrill entity by ids.
+func (m *BackupTargetMutation) AddDrillIDs(ids ...int) {
+	if m.drills == nil {
+		m.drills = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.drills[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDrills clears the "drills" edge to the BackupDrill entity.
+func (m *BackupTargetMutation) ClearDrills() {
+	m.cleareddrills = true
+}
+
+// DrillsCleared reports if the "drills" edge to the BackupDrill entity was cleared.
+func (m *BackupTargetMutation) DrillsCleared() bool {
+	return m.cleareddrills
+}
+
+// RemoveDrillIDs removes the "drills" edge to the BackupDrill entity by IDs.
+func (m *BackupTargetMutation) RemoveDrillIDs(ids ...int) {
+	if m.removeddrills == nil {
+		m.removeddrills = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.drills, ids[i])
+		m.removeddrills[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDrills returns the removed IDs of the "drills" edge to the BackupDrill entity.
+func (m *BackupTargetMutation) RemovedDrillsIDs() (ids []int) {
+	for id := range m.removeddrills {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DrillsIDs returns the "drills" edge IDs in the mutation.
+func (m *BackupTargetMutation) DrillsIDs() (ids []int) {
+	for id := range m.drills {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDrills resets all changes to the "drills" edge.
+func (m *BackupTargetMutation) ResetDrills() {
+	m.drills = nil
+	m.cleareddrills = false
+	m.removeddrills = nil
+}
+
+// Where appends a list predicates to the BackupTargetMutation builder.
+func (m *BackupTargetMutation) Where(ps ...predicate.BackupTarget) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BackupTargetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BackupTargetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackupTarget, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BackupTargetMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FontMutation) SetOp(op Op) {
+func (m *BackupTargetMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Font).
-func (m *FontMutation) Type() string {
+// Type returns the node type of this mutation (BackupTarget).
+func (m *BackupTargetMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FontMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *BackupTargetMutation) Fields() []string {
+	fields := make([]string, 0, 30)
 	if m.name != nil {
-		fields = append(fields, font.FieldName)
+		fields = append(fields, backuptarget.FieldName)
 	}
-	if m.display_name != nil {
-		fields = append(fields, font.FieldDisplayName)
+	if m._type != nil {
+		fields = append(fields, backuptarget.FieldType)
 	}
-	if m.file_path != nil {
-		fields = append(fields, font.FieldFilePath)
+	if m.enabled != nil {
+		fields = append(fields, backuptarget.FieldEnabled)
 	}
-	if m.file_size != nil {
-		fields = append(fields, font.FieldFileSize)
+	if m.last_backup_status != nil {
+		fields = append(fields, backuptarget.FieldLastBackupStatus)
 	}
-	if m.format != nil {
-		fields = append(fields, font.FieldFormat)
+	if m.last_backup_error != nil {
+		fields = append(fields, backuptarget.FieldLastBackupError)
 	}
-	if m.preview_text != nil {
-		fields = append(fields, font.FieldPreviewText)
+	if m.last_backup_at != nil {
+		fields = append(fields, backuptarget.FieldLastBackupAt)
 	}
-	if m.is_shared != nil {
-		fields = append(fields, font.FieldIsShared)
+	if m.last_test_status != nil {
+		fields = append(fields, backuptarget.FieldLastTestStatus)
+	}
+	if m.last_test_error != nil {
+		fields = append(fields, backuptarget.FieldLastTestError)
+	}
+	if m.last_test_at != nil {
+		fields = append(fields, backuptarget.FieldLastTestAt)
+	}
+	if m.webdav_url != nil {
+		fields = append(fields, backuptarget.FieldWebdavURL)
+	}
+	if m.webdav_user != nil {
+		fields = append(fields, backuptarget.FieldWebdavUser)
+	}
+	if m.webdav_password != nil {
+		fields = append(fields, backuptarget.FieldWebdavPassword)
+	}
+	if m.s3_endpoint != nil {
+		fields = append(fields, backuptarget.FieldS3Endpoint)
+	}
+	if m.s3_region != nil {
+		fields = append(fields, backuptarget.FieldS3Region)
+	}
+	if m.s3_bucket != nil {
+		fields = append(fields, backuptarget.FieldS3Bucket)
+	}
+	if m.s3_access_key != nil {
+		fields = append(fields, backuptarget.FieldS3AccessKey)
+	}
+	if m.s3_secret_key != nil {
+		fields = append(fields, backuptarget.FieldS3SecretKey)
+	}
+	if m.local_path != nil {
+		fields = append(fields, backuptarget.FieldLocalPath)
+	}
+	if m.sftp_host != nil {
+		fields = append(fields, backuptarget.FieldSftpHost)
+	}
+	if m.sftp_port != nil {
+		fields = append(fields, backuptarget.FieldSftpPort)
+	}
+	if m.sftp_user != nil {
+		fields = append(fields, backuptarget.FieldSftpUser)
+	}
+	if m.sftp_password != nil {
+		fields = append(fields, backuptarget.FieldSftpPassword)
+	}
+	if m.sftp_private_key != nil {
+		fields = append(fields, backuptarget.FieldSftpPrivateKey)
+	}
+	if m.sftp_path != nil {
+		fields = append(fields, backuptarget.FieldSftpPath)
+	}
+	if m.sftp_host_key != nil {
+		fields = append(fields, backuptarget.FieldSftpHostKey)
+	}
+	if m.alert_after_hours != nil {
+		fields = append(fields, backuptarget.FieldAlertAfterHours)
+	}
+	if m.drill_interval_days != nil {
+		fields = append(fields, backuptarget.FieldDrillIntervalDays)
+	}
+	if m.stale_alerted_at != nil {
+		fields = append(fields, backuptarget.FieldStaleAlertedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, font.FieldCreatedAt)
+		fields = append(fields, backuptarget.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, backuptarget.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -6817,24 +7209,68 @@ func (m *FontMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FontMutation) Field(name string) (ent.Value, bool) {
+func (m *BackupTargetMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case font.FieldName:
+	case backuptarget.FieldName:
 		return m.Name()
-	case font.FieldDisplayName:
-		return m.DisplayName()
-	case font.FieldFilePath:
-		return m.FilePath()
-	case font.FieldFileSize:
-		return m.FileSize()
-	case font.FieldFormat:
-		return m.Format()
-	case font.FieldPreviewText:
-		return m.PreviewText()
-	case font.FieldIsShared:
-		return m.IsShared()
-	case font.FieldCreatedAt:
+	case backuptarget.FieldType:
+		return m.GetType()
+	case backuptarget.FieldEnabled:
+		return m.Enabled()
+	case backuptarget.FieldLastBackupStatus:
+		return m.LastBackupStatus()
+	case backuptarget.FieldLastBackupError:
+		return m.LastBackupError()
+	case backuptarget.FieldLastBackupAt:
+		return m.LastBackupAt()
+	case backuptarget.FieldLastTestStatus:
+		return m.LastTestStatus()
+	case backuptarget.FieldLastTestError:
+		return m.LastTestError()
+	case backuptarget.FieldLastTestAt:
+		return m.LastTestAt()
+	case backuptarget.FieldWebdavURL:
+		return m.WebdavURL()
+	case backuptarget.FieldWebdavUser:
+		return m.WebdavUser()
+	case backuptarget.FieldWebdavPassword:
+		return m.WebdavPassword()
+	case backuptarget.FieldS3Endpoint:
+		return m.S3Endpoint()
+	case backuptarget.FieldS3Region:
+		return m.S3Region()
+	case backuptarget.FieldS3Bucket:
+		return m.S3Bucket()
+	case backuptarget.FieldS3AccessKey:
+		return m.S3AccessKey()
+	case backuptarget.FieldS3SecretKey:
+		return m.S3SecretKey()
+	case backuptarget.FieldLocalPath:
+		return m.LocalPath()
+	case backuptarget.FieldSftpHost:
+		return m.SftpHost()
+	case backuptarget.FieldSftpPort:
+		return m.SftpPort()
+	case backuptarget.FieldSftpUser:
+		return m.SftpUser()
+	case backuptarget.FieldSftpPassword:
+		return m.SftpPassword()
+	case backuptarget.FieldSftpPrivateKey:
+		return m.SftpPrivateKey()
+	case backuptarget.FieldSftpPath:
+		return m.SftpPath()
+	case backuptarget.FieldSftpHostKey:
+		return m.SftpHostKey()
+	case backuptarget.FieldAlertAfterHours:
+		return m.AlertAfterHours()
+	case backuptarget.FieldDrillIntervalDays:
+		return m.DrillIntervalDays()
+	case backuptarget.FieldStaleAlertedAt:
+		return m.StaleAlertedAt()
+	case backuptarget.FieldCreatedAt:
 		return m.CreatedAt()
+	case backuptarget.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -6842,99 +7278,303 @@ func (m *FontMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FontMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BackupTargetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case font.FieldName:
+	case backuptarget.FieldName:
 		return m.OldName(ctx)
-	case font.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case font.FieldFilePath:
-		return m.OldFilePath(ctx)
-	case font.FieldFileSize:
-		return m.OldFileSize(ctx)
-	case font.FieldFormat:
-		return m.OldFormat(ctx)
-	case font.FieldPreviewText:
-		return m.OldPreviewText(ctx)
-	case font.FieldIsShared:
-		return m.OldIsShared(ctx)
-	case font.FieldCreatedAt:
+	case backuptarget.FieldType:
+		return m.OldType(ctx)
+	case backuptarget.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case backuptarget.FieldLastBackupStatus:
+		return m.OldLastBackupStatus(ctx)
+	case backuptarget.FieldLastBackupError:
+		return m.OldLastBackupError(ctx)
+	case backuptarget.FieldLastBackupAt:
+		return m.OldLastBackupAt(ctx)
+	case backuptarget.FieldLastTestStatus:
+		return m.OldLastTestStatus(ctx)
+	case backuptarget.FieldLastTestError:
+		return m.OldLastTestError(ctx)
+	case backuptarget.FieldLastTestAt:
+		return m.OldLastTestAt(ctx)
+	case backuptarget.FieldWebdavURL:
+		return m.OldWebdavURL(ctx)
+	case backuptarget.FieldWebdavUser:
+		return m.OldWebdavUser(ctx)
+	case backuptarget.FieldWebdavPassword:
+		return m.OldWebdavPassword(ctx)
+	case backuptarget.FieldS3Endpoint:
+		return m.OldS3Endpoint(ctx)
+	case backuptarget.FieldS3Region:
+		return m.OldS3Region(ctx)
+	case backuptarget.FieldS3Bucket:
+		return m.OldS3Bucket(ctx)
+	case backuptarget.FieldS3AccessKey:
+		return m.OldS3AccessKey(ctx)
+	case backuptarget.FieldS3SecretKey:
+		return m.OldS3SecretKey(ctx)
+	case backuptarget.FieldLocalPath:
+		return m.OldLocalPath(ctx)
+	case backuptarget.FieldSftpHost:
+		return m.OldSftpHost(ctx)
+	case backuptarget.FieldSftpPort:
+		return m.OldSftpPort(ctx)
+	case backuptarget.FieldSftpUser:
+		return m.OldSftpUser(ctx)
+	case backuptarget.FieldSftpPassword:
+		return m.OldSftpPassword(ctx)
+	case backuptarget.FieldSftpPrivateKey:
+		return m.OldSftpPrivateKey(ctx)
+	case backuptarget.FieldSftpPath:
+		return m.OldSftpPath(ctx)
+	case backuptarget.FieldSftpHostKey:
+		return m.OldSftpHostKey(ctx)
+	case backuptarget.FieldAlertAfterHours:
+		return m.OldAlertAfterHours(ctx)
+	case backuptarget.FieldDrillIntervalDays:
+		return m.OldDrillIntervalDays(ctx)
+	case backuptarget.FieldStaleAlertedAt:
+		return m.OldStaleAlertedAt(ctx)
+	case backuptarget.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case backuptarget.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Font field %s", name)
+	return nil, fmt.Errorf("unknown BackupTarget field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FontMutation) SetField(name string, value ent.Value) error {
+func (m *BackupTargetMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case font.FieldName:
+	case backuptarget.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case font.FieldDisplayName:
+	case backuptarget.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.SetType(v)
 		return nil
-	case font.FieldFilePath:
+	case backuptarget.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case backuptarget.FieldLastBackupStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilePath(v)
+		m.SetLastBackupStatus(v)
 		return nil
-	case font.FieldFileSize:
-		v, ok := value.(int64)
+	case backuptarget.FieldLastBackupError:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileSize(v)
+		m.SetLastBackupError(v)
 		return nil
-	case font.FieldFormat:
-		v, ok := value.(font.Format)
+	case backuptarget.FieldLastBackupAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFormat(v)
+		m.SetLastBackupAt(v)
 		return nil
-	case font.FieldPreviewText:
+	case backuptarget.FieldLastTestStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPreviewText(v)
+		m.SetLastTestStatus(v)
 		return nil
-	case font.FieldIsShared:
-		v, ok := value.(bool)
+	case backuptarget.FieldLastTestError:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsShared(v)
+		m.SetLastTestError(v)
 		return nil
-	case font.FieldCreatedAt:
+	case backuptarget.FieldLastTestAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastTestAt(v)
+		return nil
+	case backuptarget.FieldWebdavURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebdavURL(v)
+		return nil
+	case backuptarget.FieldWebdavUser:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebdavUser(v)
+		return nil
+	case backuptarget.FieldWebdavPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebdavPassword(v)
+		return nil
+	case backuptarget.FieldS3Endpoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetS3Endpoint(v)
+		return nil
+	case backuptarget.FieldS3Region:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetS3Region(v)
+		return nil
+	case backuptarget.FieldS3Bucket:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetS3Bucket(v)
+		return nil
+	case backuptarget.FieldS3AccessKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetS3AccessKey(v)
+		return nil
+	case backuptarget.FieldS3SecretKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetS3SecretKey(v)
+		return nil
+	case backuptarget.FieldLocalPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocalPath(v)
+		return nil
+	case backuptarget.FieldSftpHost:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSftpHost(v)
+		return nil
+	case backuptarget.FieldSftpPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSftpPort(v)
+		return nil
+	case backuptarget.FieldSftpUser:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSftpUser(v)
+		return nil
+	case backuptarget.FieldSftpPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSftpPassword(v)
+		return nil
+	case backuptarget.FieldSftpPrivateKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSftpPrivateKey(v)
+		return nil
+	case backuptarget.FieldSftpPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSftpPath(v)
+		return nil
+	case backuptarget.FieldSftpHostKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSftpHostKey(v)
+		return nil
+	case backuptarget.FieldAlertAfterHours:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlertAfterHours(v)
+		return nil
+	case backuptarget.FieldDrillIntervalDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDrillIntervalDays(v)
+		return nil
+	case backuptarget.FieldStaleAlertedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStaleAlertedAt(v)
+		return nil
+	case backuptarget.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case backuptarget.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Font field %s", name)
+	return fmt.Errorf("unknown BackupTarget field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FontMutation) AddedFields() []string {
+func (m *BackupTargetMutation) AddedFields() []string {
 	var fields []string
-	if m.addfile_size != nil {
-		fields = append(fields, font.FieldFileSize)
+	if m.addsftp_port != nil {
+		fields = append(fields, backuptarget.FieldSftpPort)
+	}
+	if m.addalert_after_hours != nil {
+		fields = append(fields, backuptarget.FieldAlertAfterHours)
+	}
+	if m.adddrill_interval_days != nil {
+		fields = append(fields, backuptarget.FieldDrillIntervalDays)
 	}
 	return fields
 }
@@ -6942,10 +7582,14 @@ func (m *FontMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FontMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BackupTargetMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case font.FieldFileSize:
-		return m.AddedFileSize()
+	case backuptarget.FieldSftpPort:
+		return m.AddedSftpPort()
+	case backuptarget.FieldAlertAfterHours:
+		return m.AddedAlertAfterHours()
+	case backuptarget.FieldDrillIntervalDays:
+		return m.AddedDrillIntervalDays()
 	}
 	return nil, false
 }
@@ -6953,174 +7597,491 @@ func (m *FontMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FontMutation) AddField(name string, value ent.Value) error {
+func (m *BackupTargetMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case font.FieldFileSize:
-		v, ok := value.(int64)
+	case backuptarget.FieldSftpPort:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFileSize(v)
+		m.AddSftpPort(v)
+		return nil
+	case backuptarget.FieldAlertAfterHours:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAlertAfterHours(v)
+		return nil
+	case backuptarget.FieldDrillIntervalDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDrillIntervalDays(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Font numeric field %s", name)
+	return fmt.Errorf("unknown BackupTarget numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FontMutation) ClearedFields() []string {
-	return nil
+func (m *BackupTargetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(backuptarget.FieldLastBackupError) {
+		fields = append(fields, backuptarget.FieldLastBackupError)
+	}
+	if m.FieldCleared(backuptarget.FieldLastBackupAt) {
+		fields = append(fields, backuptarget.FieldLastBackupAt)
+	}
+	if m.FieldCleared(backuptarget.FieldLastTestError) {
+		fields = append(fields, backuptarget.FieldLastTestError)
+	}
+	if m.FieldCleared(backuptarget.FieldLastTestAt) {
+		fields = append(fields, backuptarget.FieldLastTestAt)
+	}
+	if m.FieldCleared(backuptarget.FieldWebdavURL) {
+		fields = append(fields, backuptarget.FieldWebdavURL)
+	}
+	if m.FieldCleared(backuptarget.FieldWebdavUser) {
+		fields = append(fields, backuptarget.FieldWebdavUser)
+	}
+	if m.FieldCleared(backuptarget.FieldWebdavPassword) {
+		fields = append(fields, backuptarget.FieldWebdavPassword)
+	}
+	if m.FieldCleared(backuptarget.FieldS3Endpoint) {
+		fields = append(fields, backuptarget.FieldS3Endpoint)
+	}
+	if m.FieldCleared(backuptarget.FieldS3Region) {
+		fields = append(fields, backuptarget.FieldS3Region)
+	}
+	if m.FieldCleared(backuptarget.FieldS3Bucket) {
+		fields = append(fields, backuptarget.FieldS3Bucket)
+	}
+	if m.FieldCleared(backuptarget.FieldS3AccessKey) {
+		fields = append(fields, backuptarget.FieldS3AccessKey)
+	}
+	if m.FieldCleared(backuptarget.FieldS3SecretKey) {
+		fields = append(fields, backuptarget.FieldS3SecretKey)
+	}
+	if m.FieldCleared(backuptarget.FieldLocalPath) {
+		fields = append(fields, backuptarget.FieldLocalPath)
+	}
+	if m.FieldCleared(backuptarget.FieldSftpHost) {
+		fields = append(fields, backuptarget.FieldSftpHost)
+	}
+	if m.FieldCleared(backuptarget.FieldSftpUser) {
+		fields = append(fields, backuptarget.FieldSftpUser)
+	}
+	if m.FieldCleared(backuptarget.FieldSftpPassword) {
+		fields = append(fields, backuptarget.FieldSftpPassword)
+	}
+	if m.FieldCleared(backuptarget.FieldSftpPrivateKey) {
+		fields = append(fields, backuptarget.FieldSftpPrivateKey)
+	}
+	if m.FieldCleared(backuptarget.FieldSftpPath) {
+		fields = append(fields, backuptarget.FieldSftpPath)
+	}
+	if m.FieldCleared(backuptarget.FieldSftpHostKey) {
+		fields = append(fields, backuptarget.FieldSftpHostKey)
+	}
+	if m.FieldCleared(backuptarget.FieldStaleAlertedAt) {
+		fields = append(fields, backuptarget.FieldStaleAlertedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FontMutation) FieldCleared(name string) bool {
+func (m *BackupTargetMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FontMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Font nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *FontMutation) ResetField(name string) error {
+func (m *BackupTargetMutation) ClearField(name string) error {
 	switch name {
-	case font.FieldName:
-		m.ResetName()
+	case backuptarget.FieldLastBackupError:
+		m.ClearLastBackupError()
 		return nil
-	case font.FieldDisplayName:
-		m.ResetDisplayName()
+	case backuptarget.FieldLastBackupAt:
+		m.ClearLastBackupAt()
 		return nil
-	case font.FieldFilePath:
-		m.ResetFilePath()
+	case backuptarget.FieldLastTestError:
+		m.ClearLastTestError()
 		return nil
-	case font.FieldFileSize:
-		m.ResetFileSize()
+	case backuptarget.FieldLastTestAt:
+		m.ClearLastTestAt()
 		return nil
-	case font.FieldFormat:
-		m.ResetFormat()
+	case backuptarget.FieldWebdavURL:
+		m.ClearWebdavURL()
 		return nil
-	case font.FieldPreviewText:
-		m.ResetPreviewText()
+	case backuptarget.FieldWebdavUser:
+		m.ClearWebdavUser()
 		return nil
-	case font.FieldIsShared:
-		m.ResetIsShared()
+	case backuptarget.FieldWebdavPassword:
+		m.ClearWebdavPassword()
 		return nil
-	case font.FieldCreatedAt:
+	case backuptarget.FieldS3Endpoint:
+		m.ClearS3Endpoint()
+		return nil
+	case backuptarget.FieldS3Region:
+		m.ClearS3Region()
+		return nil
+	case backuptarget.FieldS3Bucket:
+		m.ClearS3Bucket()
+		return nil
+	case backuptarget.FieldS3AccessKey:
+		m.ClearS3AccessKey()
+		return nil
+	case backuptarget.FieldS3SecretKey:
+		m.ClearS3SecretKey()
+		return nil
+	case backuptarget.FieldLocalPath:
+		m.ClearLocalPath()
+		return nil
+	case backuptarget.FieldSftpHost:
+		m.ClearSftpHost()
+		return nil
+	case backuptarget.FieldSftpUser:
+		m.ClearSftpUser()
+		return nil
+	case backuptarget.FieldSftpPassword:
+		m.ClearSftpPassword()
+		return nil
+	case backuptarget.FieldSftpPrivateKey:
+		m.ClearSftpPrivateKey()
+		return nil
+	case backuptarget.FieldSftpPath:
+		m.ClearSftpPath()
+		return nil
+	case backuptarget.FieldSftpHostKey:
+		m.ClearSftpHostKey()
+		return nil
+	case backuptarget.FieldStaleAlertedAt:
+		m.ClearStaleAlertedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BackupTarget nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BackupTargetMutation) ResetField(name string) error {
+	switch name {
+	case backuptarget.FieldName:
+		m.ResetName()
+		return nil
+	case backuptarget.FieldType:
+		m.ResetType()
+		return nil
+	case backuptarget.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case backuptarget.FieldLastBackupStatus:
+		m.ResetLastBackupStatus()
+		return nil
+	case backuptarget.FieldLastBackupError:
+		m.ResetLastBackupError()
+		return nil
+	case backuptarget.FieldLastBackupAt:
+		m.ResetLastBackupAt()
+		return nil
+	case backuptarget.FieldLastTestStatus:
+		m.ResetLastTestStatus()
+		return nil
+	case backuptarget.FieldLastTestError:
+		m.ResetLastTestError()
+		return nil
+	case backuptarget.FieldLastTestAt:
+		m.ResetLastTestAt()
+		return nil
+	case backuptarget.FieldWebdavURL:
+		m.ResetWebdavURL()
+		return nil
+	case backuptarget.FieldWebdavUser:
+		m.ResetWebdavUser()
+		return nil
+	case backuptarget.FieldWebdavPassword:
+		m.ResetWebdavPassword()
+		return nil
+	case backuptarget.FieldS3Endpoint:
+		m.ResetS3Endpoint()
+		return nil
+	case backuptarget.FieldS3Region:
+		m.ResetS3Region()
+		return nil
+	case backuptarget.FieldS3Bucket:
+		m.ResetS3Bucket()
+		return nil
+	case backuptarget.FieldS3AccessKey:
+		m.ResetS3AccessKey()
+		return nil
+	case backuptarget.FieldS3SecretKey:
+		m.ResetS3SecretKey()
+		return nil
+	case backuptarget.FieldLocalPath:
+		m.ResetLocalPath()
+		return nil
+	case backuptarget.FieldSftpHost:
+		m.ResetSftpHost()
+		return nil
+	case backuptarget.FieldSftpPort:
+		m.ResetSftpPort()
+		return nil
+	case backuptarget.FieldSftpUser:
+		m.ResetSftpUser()
+		return nil
+	case backuptarget.FieldSftpPassword:
+		m.ResetSftpPassword()
+		return nil
+	case backuptarget.FieldSftpPrivateKey:
+		m.ResetSftpPrivateKey()
+		return nil
+	case backuptarget.FieldSftpPath:
+		m.ResetSftpPath()
+		return nil
+	case backuptarget.FieldSftpHostKey:
+		m.ResetSftpHostKey()
+		return nil
+	case backuptarget.FieldAlertAfterHours:
+		m.ResetAlertAfterHours()
+		return nil
+	case backuptarget.FieldDrillIntervalDays:
+		m.ResetDrillIntervalDays()
+		return nil
+	case backuptarget.FieldStaleAlertedAt:
+		m.ResetStaleAlertedAt()
+		return nil
+	case backuptarget.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case backuptarget.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Font field %s", name)
+	return fmt.Errorf("unknown BackupTarget field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FontMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.uploaded_by != nil {
-		edges = append(edges, font.EdgeUploadedBy)
+func (m *BackupTargetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.tasks != nil {
+		edges = append(edges, backuptarget.EdgeTasks)
+	}
+	if m.pins != nil {
+		edges = append(edges, backuptarget.EdgePins)
+	}
+	if m.runs != nil {
+		edges = append(edges, backuptarget.EdgeRuns)
+	}
+	if m.drills != nil {
+		edges = append(edges, backuptarget.EdgeDrills)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FontMutation) AddedIDs(name string) []ent.Value {
+func (m *BackupTargetMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case font.EdgeUploadedBy:
-		if id := m.uploaded_by; id != nil {
-			return []ent.Value{*id}
+	case backuptarget.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.tasks))
+		for id := range m.tasks {
+			ids = append(ids, id)
 		}
+		return ids
+	case backuptarget.EdgePins:
+		ids := make([]ent.Value, 0, len(m.pins))
+		for id := range m.pins {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuptarget.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.runs))
+		for id := range m.runs {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuptarget.EdgeDrills:
+		ids := make([]ent.Value, 0, len(m.drills))
+		for id := range m.drills {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FontMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *BackupTargetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedtasks != nil {
+		edges = append(edges, backuptarget.EdgeTasks)
+	}
+	if m.removedpins != nil {
+		edges = append(edges, backuptarget.EdgePins)
+	}
+	if m.removedruns != nil {
+		edges = append(edges, backuptarget.EdgeRuns)
+	}
+	if m.removeddrills != nil {
+		edges = append(edges, backuptarget.EdgeDrills)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FontMutation) RemovedIDs(name string) []ent.Value {
+func (m *BackupTargetMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case backuptarget.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.removedtasks))
+		for id := range m.removedtasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuptarget.EdgePins:
+		ids := make([]ent.Value, 0, len(m.removedpins))
+		for id := range m.removedpins {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuptarget.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.removedruns))
+		for id := range m.removedruns {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuptarget.EdgeDrills:
+		ids := make([]ent.Value, 0, len(m.removeddrills))
+		for id := range m.removeddrills {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FontMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduploaded_by {
-		edges = append(edges, font.EdgeUploadedBy)
+func (m *BackupTargetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedtasks {
+		edges = append(edges, backuptarget.EdgeTasks)
+	}
+	if m.clearedpins {
+		edges = append(edges, backuptarget.EdgePins)
+	}
+	if m.clearedruns {
+		edges = append(edges, backuptarget.EdgeRuns)
+	}
+	if m.cleareddrills {
+		edges = append(edges, backuptarget.EdgeDrills)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FontMutation) EdgeCleared(name string) bool {
+func (m *BackupTargetMutation) EdgeCleared(name string) bool {
 	switch name {
-	case font.EdgeUploadedBy:
-		return m.cleareduploaded_by
+	case backuptarget.EdgeTasks:
+		return m.clearedtasks
+	case backuptarget.EdgePins:
+		return m.clearedpins
+	case backuptarget.EdgeRuns:
+		return m.clearedruns
+	case backuptarget.EdgeDrills:
+		return m.cleareddrills
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FontMutation) ClearEdge(name string) error {
+func (m *BackupTargetMutation) ClearEdge(name string) error {
 	switch name {
-	case font.EdgeUploadedBy:
-		m.ClearUploadedBy()
-		return nil
 	}
-	return fmt.Errorf("unknown Font unique edge %s", name)
+	return fmt.Errorf("unknown BackupTarget unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FontMutation) ResetEdge(name string) error {
+func (m *BackupTargetMutation) ResetEdge(name string) error {
 	switch name {
-	case font.EdgeUploadedBy:
-		m.ResetUploadedBy()
+	case backuptarget.EdgeTasks:
+		m.ResetTasks()
+		return nil
+	case backuptarget.EdgePins:
+		m.ResetPins()
+		return nil
+	case backuptarget.EdgeRuns:
+		m.ResetRuns()
+		return nil
+	case backuptarget.EdgeDrills:
+		m.ResetDrills()
 		return nil
 	}
-	return fmt.Errorf("unknown Font edge %s", name)
+	return fmt.Errorf("unknown BackupTarget edge %s", name)
 }
 
-// ImportItemMutation represents an operation that mutates the ImportItem nodes in the graph.
-type ImportItemMutation struct {
+// BackupTaskMutation represents an operation that mutates the BackupTask nodes in the graph.
+type BackupTaskMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	source_note_key *string
-	note_id         *uuid.UUID
-	title           *string
-	status          *string
-	message         *string
-	clearedFields   map[string]struct{}
-	job             *int
-	clearedjob      bool
-	done            bool
-	oldValue        func(context.Context) (*ImportItem, error)
-	predicates      []predicate.ImportItem
+	op                    Op
+	typ                   string
+	id                    *int
+	name                  *string
+	enabled               *bool
+	schedule              *string
+	cron_expression       *string
+	timezone              *string
+	retention_days        *int
+	addretention_days     *int
+	max_count             *int
+	addmax_count          *int
+	keep_hourly           *int
+	addkeep_hourly        *int
+	keep_daily            *int
+	addkeep_daily         *int
+	keep_weekly           *int
+	addkeep_weekly        *int
+	keep_monthly          *int
+	addkeep_monthly       *int
+	keep_yearly           *int
+	addkeep_yearly        *int
+	encryption_enabled    *bool
+	encryption_passphrase *string
+	incremental           *bool
+	last_backup_status    *string
+	last_backup_error     *string
+	last_backup_at        *time.Time
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	targets               map[int]struct{}
+	removedtargets        map[int]struct{}
+	clearedtargets        bool
+	runs                  map[int]struct{}
+	removedruns           map[int]struct{}
+	clearedruns           bool
+	done                  bool
+	oldValue              func(context.Context) (*BackupTask, error)
+	predicates            []predicate.BackupTask
 }
 
-var _ ent.Mutation = (*ImportItemMutation)(nil)
+var _ ent.Mutation = (*BackupTaskMutation)(nil)
 
-// importitemOption allows management of the mutation configuration using functional options.
-type importitemOption func(*ImportItemMutation)
+// backuptaskOption allows management of the mutation configuration using functional options.
+type backuptaskOption func(*BackupTaskMutation)
 
-// newImportItemMutation creates new mutation for the ImportItem entity.
-func newImportItemMutation(c config, op Op, opts ...importitemOption) *ImportItemMutation {
-	m := &ImportItemMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeImportItem,
+// newBackupTaskMutation creates new mutation for the BackupTask entity.
+func newBackupTaskMutation(c config, op Op, opts ...backuptaskOption) *BackupTaskMutation {
+	m := &BackupTaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBackupTask,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7129,20 +8090,20 @@ func newImportItemMutation(c config, op Op, opts ...importitemOption) *ImportIte
 	return m
 }
 
-// withImportItemID sets the ID field of the mutation.
-func withImportItemID(id int) importitemOption {
-	return func(m *ImportItemMutation) {
+// withBackupTaskID sets the ID field of the mutation.
+func withBackupTaskID(id int) backuptaskOption {
+	return func(m *BackupTaskMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ImportItem
+			value *BackupTask
 		)
-		m.oldValue = func(ctx context.Context) (*ImportItem, error) {
+		m.oldValue = func(ctx context.Context) (*BackupTask, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ImportItem.Get(ctx, id)
+					value, err = m.Client().BackupTask.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7151,10 +8112,10 @@ func withImportItemID(id int) importitemOption {
 	}
 }
 
-// withImportItem sets the old ImportItem of the mutation.
-func withImportItem(node *ImportItem) importitemOption {
-	return func(m *ImportItemMutation) {
-		m.oldValue = func(context.Context) (*ImportItem, error) {
+// withBackupTask sets the old BackupTask of the mutation.
+func withBackupTask(node *BackupTask) backuptaskOption {
+	return func(m *BackupTaskMutation) {
+		m.oldValue = func(context.Context) (*BackupTask, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7163,7 +8124,7 @@ func withImportItem(node *ImportItem) importitemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ImportItemMutation) Client() *Client {
+func (m BackupTaskMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7171,7 +8132,7 @@ func (m ImportItemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ImportItemMutation) Tx() (*Tx, error) {
+func (m BackupTaskMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7182,7 +8143,7 @@ func (m ImportItemMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ImportItemMutation) ID() (id int, exists bool) {
+func (m *BackupTaskMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7193,7 +8154,7 @@ func (m *ImportItemMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ImportItemMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BackupTaskMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7202,1242 +8163,1054 @@ func (m *ImportItemMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ImportItem.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BackupTask.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSourceNoteKey sets the "source_note_key" field.
-func (m *ImportItemMutation) SetSourceNoteKey(s string) {
-	m.source_note_key = &s
+// SetName sets the "name" field.
+func (m *BackupTaskMutation) SetName(s string) {
+	m.name = &s
 }
 
-// SourceNoteKey returns the value of the "source_note_key" field in the mutation.
-func (m *ImportItemMutation) SourceNoteKey() (r string, exists bool) {
-	v := m.source_note_key
+// Name returns the value of the "name" field in the mutation.
+func (m *BackupTaskMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceNoteKey returns the old "source_note_key" field's value of the ImportItem entity.
-// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportItemMutation) OldSourceNoteKey(ctx context.Context) (v string, err error) {
+func (m *BackupTaskMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceNoteKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceNoteKey requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceNoteKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.SourceNoteKey, nil
+	return oldValue.Name, nil
 }
 
-// ResetSourceNoteKey resets all changes to the "source_note_key" field.
-func (m *ImportItemMutation) ResetSourceNoteKey() {
-	m.source_note_key = nil
+// ResetName resets all changes to the "name" field.
+func (m *BackupTaskMutation) ResetName() {
+	m.name = nil
 }
 
-// SetNoteID sets the "note_id" field.
-func (m *ImportItemMutation) SetNoteID(u uuid.UUID) {
-	m.note_id = &u
+// SetEnabled sets the "enabled" field.
+func (m *BackupTaskMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// NoteID returns the value of the "note_id" field in the mutation.
-func (m *ImportItemMutation) NoteID() (r uuid.UUID, exists bool) {
-	v := m.note_id
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *BackupTaskMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNoteID returns the old "note_id" field's value of the ImportItem entity.
-// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportItemMutation) OldNoteID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *BackupTaskMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNoteID is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNoteID requires an ID field in the mutation")
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNoteID: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return oldValue.NoteID, nil
-}
-
-// ClearNoteID clears the value of the "note_id" field.
-func (m *ImportItemMutation) ClearNoteID() {
-	m.note_id = nil
-	m.clearedFields[importitem.FieldNoteID] = struct{}{}
-}
-
-// NoteIDCleared returns if the "note_id" field was cleared in this mutation.
-func (m *ImportItemMutation) NoteIDCleared() bool {
-	_, ok := m.clearedFields[importitem.FieldNoteID]
-	return ok
+	return oldValue.Enabled, nil
 }
 
-// ResetNoteID resets all changes to the "note_id" field.
-func (m *ImportItemMutation) ResetNoteID() {
-	m.note_id = nil
-	delete(m.clearedFields, importitem.FieldNoteID)
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *BackupTaskMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *ImportItemMutation) SetTitle(s string) {
-	m.title = &s
+// SetSchedule sets the "schedule" field.
+func (m *BackupTaskMutation) SetSchedule(s string) {
+	m.schedule = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *ImportItemMutation) Title() (r string, exists bool) {
-	v := m.title
+// Schedule returns the value of the "schedule" field in the mutation.
+func (m *BackupTaskMutation) Schedule() (r string, exists bool) {
+	v := m.schedule
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the ImportItem entity.
-// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
+// OldSchedule returns the old "schedule" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportItemMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *BackupTaskMutation) OldSchedule(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldSchedule is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldSchedule requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldSchedule: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Schedule, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *ImportItemMutation) ResetTitle() {
-	m.title = nil
+// ResetSchedule resets all changes to the "schedule" field.
+func (m *BackupTaskMutation) ResetSchedule() {
+	m.schedule = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *ImportItemMutation) SetStatus(s string) {
-	m.status = &s
+// SetCronExpression sets the "cron_expression" field.
+func (m *BackupTaskMutation) SetCronExpression(s string) {
+	m.cron_expression = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ImportItemMutation) Status() (r string, exists bool) {
-	v := m.status
+// CronExpression returns the value of the "cron_expression" field in the mutation.
+func (m *BackupTaskMutation) CronExpression() (r string, exists bool) {
+	v := m.cron_expression
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the ImportItem entity.
-// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
+// OldCronExpression returns the old "cron_expression" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportItemMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *BackupTaskMutation) OldCronExpression(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldCronExpression is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldCronExpression requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldCronExpression: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.CronExpression, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ImportItemMutation) ResetStatus() {
-	m.status = nil
+// ClearCronExpression clears the value of the "cron_expression" field.
+func (m *BackupTaskMutation) ClearCronExpression() {
+	m.cron_expression = nil
+	m.clearedFields[backuptask.FieldCronExpression] = struct{}{}
 }
 
-// SetMessage sets the "message" field.
-func (m *ImportItemMutation) SetMessage(s string) {
-	m.message = &s
+// CronExpressionCleared returns if the "cron_expression" field was cleared in this mutation.
+func (m *BackupTaskMutation) CronExpressionCleared() bool {
+	_, ok := m.clearedFields[backuptask.FieldCronExpression]
+	return ok
 }
 
-// Message returns the value of the "message" field in the mutation.
-func (m *ImportItemMutation) Message() (r string, exists bool) {
-	v := m.message
+// ResetCronExpression resets all changes to the "cron_expression" field.
+func (m *BackupTaskMutation) ResetCronExpression() {
+	m.cron_expression = nil
+	delete(m.clearedFields, backuptask.FieldCronExpression)
+}
+
+// SetTimezone sets the "timezone" field.
+func (m *BackupTaskMutation) SetTimezone(s string) {
+	m.timezone = &s
+}
+
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *BackupTaskMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessage returns the old "message" field's value of the ImportItem entity.
-// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
+// OldTimezone returns the old "timezone" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportItemMutation) OldMessage(ctx context.Context) (v string, err error) {
+func (m *BackupTaskMutation) OldTimezone(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessage requires an ID field in the mutation")
+		return v, errors.New("OldTimezone requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
 	}
-	return oldValue.Message, nil
+	return oldValue.Timezone, nil
 }
 
-// ClearMessage clears the value of the "message" field.
-func (m *ImportItemMutation) ClearMessage() {
-	m.message = nil
-	m.clearedFields[importitem.FieldMessage] = struct{}{}
+// ClearTimezone clears the value of the "timezone" field.
+func (m *BackupTaskMutation) ClearTimezone() {
+	m.timezone = nil
+	m.clearedFields[backuptask.FieldTimezone] = struct{}{}
 }
 
-// MessageCleared returns if the "message" field was cleared in this mutation.
-func (m *ImportItemMutation) MessageCleared() bool {
-	_, ok := m.clearedFields[importitem.FieldMessage]
+// TimezoneCleared returns if the "timezone" field was cleared in this mutation.
+func (m *BackupTaskMutation) TimezoneCleared() bool {
+	_, ok := m.clearedFields[backuptask.FieldTimezone]
 	return ok
 }
 
-// ResetMessage resets all changes to the "message" field.
-func (m *ImportItemMutation) ResetMessage() {
-	m.message = nil
-	delete(m.clearedFields, importitem.FieldMessage)
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *BackupTaskMutation) ResetTimezone() {
+	m.timezone = nil
+	delete(m.clearedFields, backuptask.FieldTimezone)
 }
 
-// SetJobID sets the "job" edge to the ImportJob entity by id.
-func (m *ImportItemMutation) SetJobID(id int) {
-	m.job = &id
+// SetRetentionDays sets the "retention_days" field.
+func (m *BackupTaskMutation) SetRetentionDays(i int) {
+	m.retention_days = &i
+	m.addretention_days = nil
 }
 
-// ClearJob clears the "job" edge to the ImportJob entity.
-func (m *ImportItemMutation) ClearJob() {
-	m.clearedjob = true
+// RetentionDays returns the value of the "retention_days" field in the mutation.
+func (m *BackupTaskMutation) RetentionDays() (r int, exists bool) {
+	v := m.retention_days
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// JobCleared reports if the "job" edge to the ImportJob entity was cleared.
-func (m *ImportItemMutation) JobCleared() bool {
-	return m.clearedjob
+// OldRetentionDays returns the old "retention_days" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTaskMutation) OldRetentionDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetentionDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetentionDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetentionDays: %w", err)
+	}
+	return oldValue.RetentionDays, nil
 }
 
-// JobID returns the "job" edge ID in the mutation.
-func (m *ImportItemMutation) JobID() (id int, exists bool) {
-	if m.job != nil {
-		return *m.job, true
+// AddRetentionDays adds i to the "retention_days" field.
+func (m *BackupTaskMutation) AddRetentionDays(i int) {
+	if m.addretention_days != nil {
+		*m.addretention_days += i
+	} else {
+		m.addretention_days = &i
 	}
-	return
 }
 
-// JobIDs returns the "job" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// JobID instead. It exists only for internal usage by the builders.
-func (m *ImportItemMutation) JobIDs() (ids []int) {
-	if id := m.job; id != nil {
-		ids = append(ids, *id)
+// AddedRetentionDays returns the value that was added to the "retention_days" field in this mutation.
+func (m *BackupTaskMutation) AddedRetentionDays() (r int, exists bool) {
+	v := m.addretention_days
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetJob resets all changes to the "job" edge.
-func (m *ImportItemMutation) ResetJob() {
-	m.job = nil
-	m.clearedjob = false
+// ResetRetentionDays resets all changes to the "retention_days" field.
+func (m *BackupTaskMutation) ResetRetentionDays() {
+	m.retention_days = nil
+	m.addretention_days = nil
 }
 
-// Where appends a list predicates to the ImportItemMutation builder.
-func (m *ImportItemMutation) Where(ps ...predicate.ImportItem) {
-	m.predicates = append(m.predicates, ps...)
+// SetMaxCount sets the "max_count" field.
+func (m *BackupTaskMutation) SetMaxCount(i int) {
+	m.max_count = &i
+	m.addmax_count = nil
 }
 
-// WhereP appends storage-level predicates to the ImportItemMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ImportItemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ImportItem, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// MaxCount returns the value of the "max_count" field in the mutation.
+func (m *BackupTaskMutation) MaxCount() (r int, exists bool) {
+	v := m.max_count
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ImportItemMutation) Op() Op {
-	return m.op
+// OldMaxCount returns the old "max_count" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTaskMutation) OldMaxCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxCount: %w", err)
+	}
+	return oldValue.MaxCount, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ImportItemMutation) SetOp(op Op) {
-	m.op = op
+// AddMaxCount adds i to the "max_count" field.
+func (m *BackupTaskMutation) AddMaxCount(i int) {
+	if m.addmax_count != nil {
+		*m.addmax_count += i
+	} else {
+		m.addmax_count = &i
+	}
 }
 
-// Type returns the node type of this mutation (ImportItem).
-func (m *ImportItemMutation) Type() string {
-	return m.typ
+// AddedMaxCount returns the value that was added to the "max_count" field in this mutation.
+func (m *BackupTaskMutation) AddedMaxCount() (r int, exists bool) {
+	v := m.addmax_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ImportItemMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.source_note_key != nil {
-		fields = append(fields, importitem.FieldSourceNoteKey)
+// ResetMaxCount resets all changes to the "max_count" field.
+func (m *BackupTaskMutation) ResetMaxCount() {
+	m.max_count = nil
+	m.addmax_count = nil
+}
+
+// SetKeepHourly sets the "keep_hourly" field.
+func (m *BackupTaskMutation) SetKeepHourly(i int) {
+	m.keep_hourly = &i
+	m.addkeep_hourly = nil
+}
+
+// KeepHourly returns the value of the "keep_hourly" field in the mutation.
+func (m *BackupTaskMutation) KeepHourly() (r int, exists bool) {
+	v := m.keep_hourly
+	if v == nil {
+		return
 	}
-	if m.note_id != nil {
-		fields = append(fields, importitem.FieldNoteID)
+	return *v, true
+}
+
+// OldKeepHourly returns the old "keep_hourly" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTaskMutation) OldKeepHourly(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepHourly is only allowed on UpdateOne operations")
 	}
-	if m.title != nil {
-		fields = append(fields, importitem.FieldTitle)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepHourly requires an ID field in the mutation")
 	}
-	if m.status != nil {
-		fields = append(fields, importitem.FieldStatus)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepHourly: %w", err)
 	}
-	if m.message != nil {
-		fields = append(fields, importitem.FieldMessage)
+	return oldValue.KeepHourly, nil
+}
+
+// AddKeepHourly adds i to the "keep_hourly" field.
+func (m *BackupTaskMutation) AddKeepHourly(i int) {
+	if m.addkeep_hourly != nil {
+		*m.addkeep_hourly += i
+	} else {
+		m.addkeep_hourly = &i
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ImportItemMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case importitem.FieldSourceNoteKey:
-		return m.SourceNoteKey()
-	case importitem.FieldNoteID:
-		return m.NoteID()
-	case importitem.FieldTitle:
-		return m.Title()
-	case importitem.FieldStatus:
-		return m.Status()
-	case importitem.FieldMessage:
-		return m.Message()
+// AddedKeepHourly returns the value that was added to the "keep_hourly" field in this mutation.
+func (m *BackupTaskMutation) AddedKeepHourly() (r int, exists bool) {
+	v := m.addkeep_hourly
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ImportItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case importitem.FieldSourceNoteKey:
-		return m.OldSourceNoteKey(ctx)
-	case importitem.FieldNoteID:
-		return m.OldNoteID(ctx)
-	case importitem.FieldTitle:
-		return m.OldTitle(ctx)
-	case importitem.FieldStatus:
-		return m.OldStatus(ctx)
-	case importitem.FieldMessage:
-		return m.OldMessage(ctx)
+// ResetKeepHourly resets all changes to the "keep_hourly" field.
+func (m *BackupTaskMutation) ResetKeepHourly() {
+	m.keep_hourly = nil
+	m.addkeep_hourly = nil
+}
+
+// SetKeepDaily sets the "keep_daily" field.
+func (m *BackupTaskMutation) SetKeepDaily(i int) {
+	m.keep_daily = &i
+	m.addkeep_daily = nil
+}
+
+// KeepDaily returns the value of the "keep_daily" field in the mutation.
+func (m *BackupTaskMutation) KeepDaily() (r int, exists bool) {
+	v := m.keep_daily
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown ImportItem field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ImportItemMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case importitem.FieldSourceNoteKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSourceNoteKey(v)
-		return nil
-	case importitem.FieldNoteID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNoteID(v)
-		return nil
-	case importitem.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case importitem.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case importitem.FieldMessage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMessage(v)
-		return nil
+// OldKeepDaily returns the old "keep_daily" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTaskMutation) OldKeepDaily(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepDaily is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ImportItem field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepDaily requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepDaily: %w", err)
+	}
+	return oldValue.KeepDaily, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ImportItemMutation) AddedFields() []string {
-	return nil
+// AddKeepDaily adds i to the "keep_daily" field.
+func (m *BackupTaskMutation) AddKeepDaily(i int) {
+	if m.addkeep_daily != nil {
+		*m.addkeep_daily += i
+	} else {
+		m.addkeep_daily = &i
+	}
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ImportItemMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// AddedKeepDaily returns the value that was added to the "keep_daily" field in this mutation.
+func (m *BackupTaskMutation) AddedKeepDaily() (r int, exists bool) {
+	v := m.addkeep_daily
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ImportItemMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// ResetKeepDaily resets all changes to the "keep_daily" field.
+func (m *BackupTaskMutation) ResetKeepDaily() {
+	m.keep_daily = nil
+	m.addkeep_daily = nil
+}
+
+// SetKeepWeekly sets the "keep_weekly" field.
+func (m *BackupTaskMutation) SetKeepWeekly(i int) {
+	m.keep_weekly = &i
+	m.addkeep_weekly = nil
+}
+
+// KeepWeekly returns the value of the "keep_weekly" field in the mutation.
+func (m *BackupTaskMutation) KeepWeekly() (r int, exists bool) {
+	v := m.keep_weekly
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ImportItem numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ImportItemMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(importitem.FieldNoteID) {
-		fields = append(fields, importitem.FieldNoteID)
+// OldKeepWeekly returns the old "keep_weekly" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTaskMutation) OldKeepWeekly(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepWeekly is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(importitem.FieldMessage) {
-		fields = append(fields, importitem.FieldMessage)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepWeekly requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepWeekly: %w", err)
+	}
+	return oldValue.KeepWeekly, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ImportItemMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddKeepWeekly adds i to the "keep_weekly" field.
+func (m *BackupTaskMutation) AddKeepWeekly(i int) {
+	if m.addkeep_weekly != nil {
+		*m.addkeep_weekly += i
+	} else {
+		m.addkeep_weekly = &i
+	}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ImportItemMutation) ClearField(name string) error {
-	switch name {
-	case importitem.FieldNoteID:
-		m.ClearNoteID()
-		return nil
-	case importitem.FieldMessage:
-		m.ClearMessage()
-		return nil
+// AddedKeepWeekly returns the value that was added to the "keep_weekly" field in this mutation.
+func (m *BackupTaskMutation) AddedKeepWeekly() (r int, exists bool) {
+	v := m.addkeep_weekly
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ImportItem nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ImportItemMutation) ResetField(name string) error {
-	switch name {
-	case importitem.FieldSourceNoteKey:
-		m.ResetSourceNoteKey()
-		return nil
-	case importitem.FieldNoteID:
-		m.ResetNoteID()
-		return nil
-	case importitem.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case importitem.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case importitem.FieldMessage:
-		m.ResetMessage()
-		return nil
-	}
-	return fmt.Errorf("unknown ImportItem field %s", name)
+// ResetKeepWeekly resets all changes to the "keep_weekly" field.
+func (m *BackupTaskMutation) ResetKeepWeekly() {
+	m.keep_weekly = nil
+	m.addkeep_weekly = nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ImportItemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.job != nil {
-		edges = append(edges, importitem.EdgeJob)
-	}
-	return edges
+// SetKeepMonthly sets the "keep_monthly" field.
+func (m *BackupTaskMutation) SetKeepMonthly(i int) {
+	m.keep_monthly = &i
+	m.addkeep_monthly = nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ImportItemMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case importitem.EdgeJob:
-		if id := m.job; id != nil {
-			return []ent.Value{*id}
-		}
+// KeepMonthly returns the value of the "keep_monthly" field in the mutation.
+func (m *BackupTaskMutation) KeepMonthly() (r int, exists bool) {
+	v := m.keep_monthly
+	if v == nil {
+		return
 	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ImportItemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ImportItemMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ImportItemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedjob {
-		edges = append(edges, importitem.EdgeJob)
+// OldKeepMonthly returns the old "keep_monthly" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTaskMutation) OldKeepMonthly(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepMonthly is only allowed on UpdateOne operations")
 	}
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ImportItemMutation) EdgeCleared(name string) bool {
-	switch name {
-	case importitem.EdgeJob:
-		return m.clearedjob
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepMonthly requires an ID field in the mutation")
 	}
-	return false
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepMonthly: %w", err)
+	}
+	return oldValue.KeepMonthly, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ImportItemMutation) ClearEdge(name string) error {
-	switch name {
-	case importitem.EdgeJob:
-		m.ClearJob()
-		return nil
+// AddKeepMonthly adds i to the "keep_monthly" field.
+func (m *BackupTaskMutation) AddKeepMonthly(i int) {
+	if m.addkeep_monthly != nil {
+		*m.addkeep_monthly += i
+	} else {
+		m.addkeep_monthly = &i
 	}
-	return fmt.Errorf("unknown ImportItem unique edge %s", name)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ImportItemMutation) ResetEdge(name string) error {
-	switch name {
-	case importitem.EdgeJob:
-		m.ResetJob()
-		return nil
+// AddedKeepMonthly returns the value that was added to the "keep_monthly" field in this mutation.
+func (m *BackupTaskMutation) AddedKeepMonthly() (r int, exists bool) {
+	v := m.addkeep_monthly
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ImportItem edge %s", name)
+	return *v, true
 }
 
-// ImportJobMutation represents an operation that mutates the ImportJob nodes in the graph.
-type ImportJobMutation struct {
-	config
-	op                Op
-	typ               string
-	id                *int
-	source            *string
-	filename          *string
-	status            *string
-	note_count        *int
-	addnote_count     *int
-	imported_count    *int
-	addimported_count *int
-	skipped_count     *int
-	addskipped_count  *int
-	failed_count      *int
-	addfailed_count   *int
-	options_json      *string
-	created_at        *time.Time
-	completed_at      *time.Time
-	clearedFields     map[string]struct{}
-	user              *int
-	cleareduser       bool
-	items             map[int]struct{}
-	removeditems      map[int]struct{}
-	cleareditems      bool
-	done              bool
-	oldValue          func(context.Context) (*ImportJob, error)
-	predicates        []predicate.ImportJob
+// ResetKeepMonthly resets all changes to the "keep_monthly" field.
+func (m *BackupTaskMutation) ResetKeepMonthly() {
+	m.keep_monthly = nil
+	m.addkeep_monthly = nil
 }
 
-var _ ent.Mutation = (*ImportJobMutation)(nil)
-
-// importjobOption allows management of the mutation configuration using functional options.
-type importjobOption func(*ImportJobMutation)
-
-// newImportJobMutation creates new mutation for the ImportJob entity.
-func newImportJobMutation(c config, op Op, opts ...importjobOption) *ImportJobMutation {
-	m := &ImportJobMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeImportJob,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetKeepYearly sets the "keep_yearly" field.
+func (m *BackupTaskMutation) SetKeepYearly(i int) {
+	m.keep_yearly = &i
+	m.addkeep_yearly = nil
 }
 
-// withImportJobID sets the ID field of the mutation.
-func withImportJobID(id int) importjobOption {
-	return func(m *ImportJobMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ImportJob
-		)
-		m.oldValue = func(ctx context.Context) (*ImportJob, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ImportJob.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// KeepYearly returns the value of the "keep_yearly" field in the mutation.
+func (m *BackupTaskMutation) KeepYearly() (r int, exists bool) {
+	v := m.keep_yearly
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withImportJob sets the old ImportJob of the mutation.
-func withImportJob(node *ImportJob) importjobOption {
-	return func(m *ImportJobMutation) {
-		m.oldValue = func(context.Context) (*ImportJob, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldKeepYearly returns the old "keep_yearly" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTaskMutation) OldKeepYearly(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepYearly is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepYearly requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepYearly: %w", err)
+	}
+	return oldValue.KeepYearly, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ImportJobMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ImportJobMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddKeepYearly adds i to the "keep_yearly" field.
+func (m *BackupTaskMutation) AddKeepYearly(i int) {
+	if m.addkeep_yearly != nil {
+		*m.addkeep_yearly += i
+	} else {
+		m.addkeep_yearly = &i
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ImportJobMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AddedKeepYearly returns the value that was added to the "keep_yearly" field in this mutation.
+func (m *BackupTaskMutation) AddedKeepYearly() (r int, exists bool) {
+	v := m.addkeep_yearly
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ImportJobMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ImportJob.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetKeepYearly resets all changes to the "keep_yearly" field.
+func (m *BackupTaskMutation) ResetKeepYearly() {
+	m.keep_yearly = nil
+	m.addkeep_yearly = nil
 }
 
-// SetSource sets the "source" field.
-func (m *ImportJobMutation) SetSource(s string) {
-	m.source = &s
+// SetEncryptionEnabled sets the "encryption_enabled" field.
+func (m *BackupTaskMutation) SetEncryptionEnabled(b bool) {
+	m.encryption_enabled = &b
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *ImportJobMutation) Source() (r string, exists bool) {
-	v := m.source
+// EncryptionEnabled returns the value of the "encryption_enabled" field in the mutation.
+func (m *BackupTaskMutation) EncryptionEnabled() (r bool, exists bool) {
+	v := m.encryption_enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSource returns the old "source" field's value of the ImportJob entity.
-// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldEncryptionEnabled returns the old "encryption_enabled" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportJobMutation) OldSource(ctx context.Context) (v string, err error) {
+func (m *BackupTaskMutation) OldEncryptionEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+		return v, errors.New("OldEncryptionEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+		return v, errors.New("OldEncryptionEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+		return v, fmt.Errorf("querying old value for OldEncryptionEnabled: %w", err)
 	}
-	return oldValue.Source, nil
+	return oldValue.EncryptionEnabled, nil
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *ImportJobMutation) ResetSource() {
-	m.source = nil
+// ResetEncryptionEnabled resets all changes to the "encryption_enabled" field.
+func (m *BackupTaskMutation) ResetEncryptionEnabled() {
+	m.encryption_enabled = nil
 }
 
-// SetFilename sets the "filename" field.
-func (m *ImportJobMutation) SetFilename(s string) {
-	m.filename = &s
+// SetEncryptionPassphrase sets the "encryption_passphrase" field.
+func (m *BackupTaskMutation) SetEncryptionPassphrase(s string) {
+	m.encryption_passphrase = &s
 }
 
-// Filename returns the value of the "filename" field in the mutation.
-func (m *ImportJobMutation) Filename() (r string, exists bool) {
-	v := m.filename
+// EncryptionPassphrase returns the value of the "encryption_passphrase" field in the mutation.
+func (m *BackupTaskMutation) EncryptionPassphrase() (r string, exists bool) {
+	v := m.encryption_passphrase
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilename returns the old "filename" field's value of the ImportJob entity.
-// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldEncryptionPassphrase returns the old "encryption_passphrase" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportJobMutation) OldFilename(ctx context.Context) (v string, err error) {
+func (m *BackupTaskMutation) OldEncryptionPassphrase(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+		return v, errors.New("OldEncryptionPassphrase is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilename requires an ID field in the mutation")
+		return v, errors.New("OldEncryptionPassphrase requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+		return v, fmt.Errorf("querying old value for OldEncryptionPassphrase: %w", err)
 	}
-	return oldValue.Filename, nil
+	return oldValue.EncryptionPassphrase, nil
 }
 
-// ResetFilename resets all changes to the "filename" field.
-func (m *ImportJobMutation) ResetFilename() {
-	m.filename = nil
+// ClearEncryptionPassphrase clears the value of the "encryption_passphrase" field.
+func (m *BackupTaskMutation) ClearEncryptionPassphrase() {
+	m.encryption_passphrase = nil
+	m.clearedFields[backuptask.FieldEncryptionPassphrase] = struct{}{}
 }
 
-// SetStatus sets the "status" field.
-func (m *ImportJobMutation) SetStatus(s string) {
-	m.status = &s
+// EncryptionPassphraseCleared returns if the "encryption_passphrase" field was cleared in this mutation.
+func (m *BackupTaskMutation) EncryptionPassphraseCleared() bool {
+	_, ok := m.clearedFields[backuptask.FieldEncryptionPassphrase]
+	return ok
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ImportJobMutation) Status() (r string, exists bool) {
-	v := m.status
+// ResetEncryptionPassphrase resets all changes to the "encryption_passphrase" field.
+func (m *BackupTaskMutation) ResetEncryptionPassphrase() {
+	m.encryption_passphrase = nil
+	delete(m.clearedFields, backuptask.FieldEncryptionPassphrase)
+}
+
+// SetIncremental sets the "incremental" field.
+func (m *BackupTaskMutation) SetIncremental(b bool) {
+	m.incremental = &b
+}
+
+// Incremental returns the value of the "incremental" field in the mutation.
+func (m *BackupTaskMutation) Incremental() (r bool, exists bool) {
+	v := m.incremental
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the ImportJob entity.
-// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldIncremental returns the old "incremental" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportJobMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *BackupTaskMutation) OldIncremental(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldIncremental is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldIncremental requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldIncremental: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Incremental, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ImportJobMutation) ResetStatus() {
-	m.status = nil
+// ResetIncremental resets all changes to the "incremental" field.
+func (m *BackupTaskMutation) ResetIncremental() {
+	m.incremental = nil
 }
 
-// SetNoteCount sets the "note_count" field.
-func (m *ImportJobMutation) SetNoteCount(i int) {
-	m.note_count = &i
-	m.addnote_count = nil
+// SetLastBackupStatus sets the "last_backup_status" field.
+func (m *BackupTaskMutation) SetLastBackupStatus(s string) {
+	m.last_backup_status = &s
 }
 
-// NoteCount returns the value of the "note_count" field in the mutation.
-func (m *ImportJobMutation) NoteCount() (r int, exists bool) {
-	v := m.note_count
+// LastBackupStatus returns the value of the "last_backup_status" field in the mutation.
+func (m *BackupTaskMutation) LastBackupStatus() (r string, exists bool) {
+	v := m.last_backup_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNoteCount returns the old "note_count" field's value of the ImportJob entity.
-// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldLastBackupStatus returns the old "last_backup_status" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportJobMutation) OldNoteCount(ctx context.Context) (v int, err error) {
+func (m *BackupTaskMutation) OldLastBackupStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNoteCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastBackupStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNoteCount requires an ID field in the mutation")
+		return v, errors.New("OldLastBackupStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNoteCount: %w", err)
-	}
-	return oldValue.NoteCount, nil
-}
-
-// AddNoteCount adds i to the "note_count" field.
-func (m *ImportJobMutation) AddNoteCount(i int) {
-	if m.addnote_count != nil {
-		*m.addnote_count += i
-	} else {
-		m.addnote_count = &i
-	}
-}
-
-// AddedNoteCount returns the value that was added to the "note_count" field in this mutation.
-func (m *ImportJobMutation) AddedNoteCount() (r int, exists bool) {
-	v := m.addnote_count
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldLastBackupStatus: %w", err)
 	}
-	return *v, true
+	return oldValue.LastBackupStatus, nil
 }
 
-// ResetNoteCount resets all changes to the "note_count" field.
-func (m *ImportJobMutation) ResetNoteCount() {
-	m.note_count = nil
-	m.addnote_count = nil
+// ResetLastBackupStatus resets all changes to the "last_backup_status" field.
+func (m *BackupTaskMutation) ResetLastBackupStatus() {
+	m.last_backup_status = nil
 }
 
-// SetImportedCount sets the "imported_count" field.
-func (m *ImportJobMutation) SetImportedCount(i int) {
-	m.imported_count = &i
-	m.addimported_count = nil
+// SetLastBackupError sets the "last_backup_error" field.
+func (m *BackupTaskMutation) SetLastBackupError(s string) {
+	m.last_backup_error = &s
 }
 
-// ImportedCount returns the value of the "imported_count" field in the mutation.
-func (m *ImportJobMutation) ImportedCount() (r int, exists bool) {
-	v := m.imported_count
+// LastBackupError returns the value of the "last_backup_error" field in the mutation.
+func (m *BackupTaskMutation) LastBackupError() (r string, exists bool) {
+	v := m.last_backup_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImportedCount returns the old "imported_count" field's value of the ImportJob entity.
-// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldLastBackupError returns the old "last_backup_error" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportJobMutation) OldImportedCount(ctx context.Context) (v int, err error) {
+func (m *BackupTaskMutation) OldLastBackupError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImportedCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastBackupError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImportedCount requires an ID field in the mutation")
+		return v, errors.New("OldLastBackupError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImportedCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastBackupError: %w", err)
 	}
-	return oldValue.ImportedCount, nil
+	return oldValue.LastBackupError, nil
 }
 
-// AddImportedCount adds i to the "imported_count" field.
-func (m *ImportJobMutation) AddImportedCount(i int) {
-	if m.addimported_count != nil {
-		*m.addimported_count += i
-	} else {
-		m.addimported_count = &i
-	}
+// ClearLastBackupError clears the value of the "last_backup_error" field.
+func (m *BackupTaskMutation) ClearLastBackupError() {
+	m.last_backup_error = nil
+	m.clearedFields[backuptask.FieldLastBackupError] = struct{}{}
 }
 
-// AddedImportedCount returns the value that was added to the "imported_count" field in this mutation.
-func (m *ImportJobMutation) AddedImportedCount() (r int, exists bool) {
-	v := m.addimported_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// LastBackupErrorCleared returns if the "last_backup_error" field was cleared in this mutation.
+func (m *BackupTaskMutation) LastBackupErrorCleared() bool {
+	_, ok := m.clearedFields[backuptask.FieldLastBackupError]
+	return ok
 }
 
-// ResetImportedCount resets all changes to the "imported_count" field.
-func (m *ImportJobMutation) ResetImportedCount() {
-	m.imported_count = nil
-	m.addimported_count = nil
+// ResetLastBackupError resets all changes to the "last_backup_error" field.
+func (m *BackupTaskMutation) ResetLastBackupError() {
+	m.last_backup_error = nil
+	delete(m.clearedFields, backuptask.FieldLastBackupError)
 }
 
-// SetSkippedCount sets the "skipped_count" field.
-func (m *ImportJobMutation) SetSkippedCount(i int) {
-	m.skipped_count = &i
-	m.addskipped_count = nil
+// SetLastBackupAt sets the "last_backup_at" field.
+func (m *BackupTaskMutation) SetLastBackupAt(t time.Time) {
+	m.last_backup_at = &t
 }
 
-// SkippedCount returns the value of the "skipped_count" field in the mutation.
-func (m *ImportJobMutation) SkippedCount() (r int, exists bool) {
-	v := m.skipped_count
+// LastBackupAt returns the value of the "last_backup_at" field in the mutation.
+func (m *BackupTaskMutation) LastBackupAt() (r time.Time, exists bool) {
+	v := m.last_backup_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSkippedCount returns the old "skipped_count" field's value of the ImportJob entity.
-// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldLastBackupAt returns the old "last_backup_at" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportJobMutation) OldSkippedCount(ctx context.Context) (v int, err error) {
+func (m *BackupTaskMutation) OldLastBackupAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSkippedCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastBackupAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSkippedCount requires an ID field in the mutation")
+		return v, errors.New("OldLastBackupAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSkippedCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastBackupAt: %w", err)
 	}
-	return oldValue.SkippedCount, nil
+	return oldValue.LastBackupAt, nil
 }
 
-// AddSkippedCount adds i to the "skipped_count" field.
-func (m *ImportJobMutation) AddSkippedCount(i int) {
-	if m.addskipped_count != nil {
-		*m.addskipped_count += i
-	} else {
-		m.addskipped_count = &i
-	}
+// ClearLastBackupAt clears the value of the "last_backup_at" field.
+func (m *BackupTaskMutation) ClearLastBackupAt() {
+	m.last_backup_at = nil
+	m.clearedFields[backuptask.FieldLastBackupAt] = struct{}{}
 }
 
-// AddedSkippedCount returns the value that was added to the "skipped_count" field in this mutation.
-func (m *ImportJobMutation) AddedSkippedCount() (r int, exists bool) {
-	v := m.addskipped_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// LastBackupAtCleared returns if the "last_backup_at" field was cleared in this mutation.
+func (m *BackupTaskMutation) LastBackupAtCleared() bool {
+	_, ok := m.clearedFields[backuptask.FieldLastBackupAt]
+	return ok
 }
 
-// ResetSkippedCount resets all changes to the "skipped_count" field.
-func (m *ImportJobMutation) ResetSkippedCount() {
-	m.skipped_count = nil
-	m.addskipped_count = nil
+// ResetLastBackupAt resets all changes to the "last_backup_at" field.
+func (m *BackupTaskMutation) ResetLastBackupAt() {
+	m.last_backup_at = nil
+	delete(m.clearedFields, backuptask.FieldLastBackupAt)
 }
 
-// SetFailedCount sets the "failed_count" field.
-func (m *ImportJobMutation) SetFailedCount(i int) {
-	m.failed_count = &i
-	m.addfailed_count = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *BackupTaskMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// FailedCount returns the value of the "failed_count" field in the mutation.
-func (m *ImportJobMutation) FailedCount() (r int, exists bool) {
-	v := m.failed_count
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BackupTaskMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFailedCount returns the old "failed_count" field's value of the ImportJob entity.
-// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportJobMutation) OldFailedCount(ctx context.Context) (v int, err error) {
+func (m *BackupTaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFailedCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFailedCount requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFailedCount: %w", err)
-	}
-	return oldValue.FailedCount, nil
-}
-
-// AddFailedCount adds i to the "failed_count" field.
-func (m *ImportJobMutation) AddFailedCount(i int) {
-	if m.addfailed_count != nil {
-		*m.addfailed_count += i
-	} else {
-		m.addfailed_count = &i
-	}
-}
-
-// AddedFailedCount returns the value that was added to the "failed_count" field in this mutation.
-func (m *ImportJobMutation) AddedFailedCount() (r int, exists bool) {
-	v := m.addfailed_count
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.CreatedAt, nil
 }
 
-// ResetFailedCount resets all changes to the "failed_count" field.
-func (m *ImportJobMutation) ResetFailedCount() {
-	m.failed_count = nil
-	m.addfailed_count = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BackupTaskMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetOptionsJSON sets the "options_json" field.
-func (m *ImportJobMutation) SetOptionsJSON(s string) {
-	m.options_json = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BackupTaskMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// OptionsJSON returns the value of the "options_json" field in the mutation.
-func (m *ImportJobMutation) OptionsJSON() (r string, exists bool) {
-	v := m.options_json
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BackupTaskMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOptionsJSON returns the old "options_json" field's value of the ImportJob entity.
-// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the BackupTask entity.
+// If the BackupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportJobMutation) OldOptionsJSON(ctx context.Context) (v string, err error) {
+func (m *BackupTaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOptionsJSON is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOptionsJSON requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOptionsJSON: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.OptionsJSON, nil
-}
-
-// ClearOptionsJSON clears the value of the "options_json" field.
-func (m *ImportJobMutation) ClearOptionsJSON() {
-	m.options_json = nil
-	m.clearedFields[importjob.FieldOptionsJSON] = struct{}{}
-}
-
-// OptionsJSONCleared returns if the "options_json" field was cleared in this mutation.
-func (m *ImportJobMutation) OptionsJSONCleared() bool {
-	_, ok := m.clearedFields[importjob.FieldOptionsJSON]
-	return ok
-}
-
-// ResetOptionsJSON resets all changes to the "options_json" field.
-func (m *ImportJobMutation) ResetOptionsJSON() {
-	m.options_json = nil
-	delete(m.clearedFields, importjob.FieldOptionsJSON)
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *ImportJobMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+	return oldValue.UpdatedAt, nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ImportJobMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BackupTaskMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ImportJob entity.
-// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// AddTargetIDs adds the "targets" edge to the BackupTarget entity by ids.
+func (m *BackupTaskMutation) AddTargetIDs(ids ...int) {
+	if m.targets == nil {
+		m.targets = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for i := range ids {
+		m.targets[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ImportJobMutation) ResetCreatedAt() {
-	m.created_at = nil
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *ImportJobMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// ClearTargets clears the "targets" edge to the BackupTarget entity.
+func (m *BackupTaskMutation) ClearTargets() {
+	m.clearedtargets = true
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *ImportJobMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// TargetsCleared reports if the "targets" edge to the BackupTarget entity was cleared.
+func (m *BackupTaskMutation) TargetsCleared() bool {
+	return m.clearedtargets
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the ImportJob entity.
-// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportJobMutation) OldCompletedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+// RemoveTargetIDs removes the "targets" edge to the BackupTarget entity by IDs.
+func (m *BackupTaskMutation) RemoveTargetIDs(ids ...int) {
+	if m.removedtargets == nil {
+		m.removedtargets = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	for i := range ids {
+		delete(m.targets, ids[i])
+		m.removedtargets[ids[i]] = struct{}{}
 	}
-	return oldValue.CompletedAt, nil
-}
-
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *ImportJobMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[importjob.FieldCompletedAt] = struct{}{}
-}
-
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *ImportJobMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[importjob.FieldCompletedAt]
-	return ok
-}
-
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *ImportJobMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, importjob.FieldCompletedAt)
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *ImportJobMutation) SetUserID(id int) {
-	m.user = &id
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *ImportJobMutation) ClearUser() {
-	m.cleareduser = true
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *ImportJobMutation) UserCleared() bool {
-	return m.cleareduser
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *ImportJobMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// RemovedTargets returns the removed IDs of the "targets" edge to the BackupTarget entity.
+func (m *BackupTaskMutation) RemovedTargetsIDs() (ids []int) {
+	for id := range m.removedtargets {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *ImportJobMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// TargetsIDs returns the "targets" edge IDs in the mutation.
+func (m *BackupTaskMutation) TargetsIDs() (ids []int) {
+	for id := range m.targets {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *ImportJobMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetTargets resets all changes to the "targets" edge.
+func (m *BackupTaskMutation) ResetTargets() {
+	m.targets = nil
+	m.clearedtargets = false
+	m.removedtargets = nil
 }
 
-// AddItemIDs adds the "items" edge to the ImportItem entity by ids.
-func (m *ImportJobMutation) AddItemIDs(ids ...int) {
-	if m.items == nil {
-		m.items = make(map[int]struct{})
+// AddRunIDs adds the "runs" edge to the BackupRun entity by ids.
+func (m *BackupTaskMutation) AddRunIDs(ids ...int) {
+	if m.runs == nil {
+		m.runs = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.items[ids[i]] = struct{}{}
+		m.runs[ids[i]] = struct{}{}
 	}
 }
 
-// ClearItems clears the "items" edge to the ImportItem entity.
-func (m *ImportJobMutation) ClearItems() {
-	m.cleareditems = true
+// ClearRuns clears the "runs" edge to the BackupRun entity.
+func (m *BackupTaskMutation) ClearRuns() {
+	m.clearedruns = true
 }
 
-// ItemsCleared reports if the "items" edge to the ImportItem entity was cleared.
-func (m *ImportJobMutation) ItemsCleared() bool {
-	return m.cleareditems
+// RunsCleared reports if the "runs" edge to the BackupRun entity was cleared.
+func (m *BackupTaskMutation) RunsCleared() bool {
+	return m.clearedruns
 }
 
-// RemoveItemIDs removes the "items" edge to the ImportItem entity by IDs.
-func (m *ImportJobMutation) RemoveItemIDs(ids ...int) {
-	if m.removeditems == nil {
-		m.removeditems = make(map[int]struct{})
+// RemoveRunIDs removes the "runs" edge to the BackupRun entity by IDs.
+func (m *BackupTaskMutation) RemoveRunIDs(ids ...int) {
+	if m.removedruns == nil {
+		m.removedruns = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.items, ids[i])
-		m.removeditems[ids[i]] = struct{}{}
+		delete(m.runs, ids[i])
+		m.removedruns[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedItems returns the removed IDs of the "items" edge to the ImportItem entity.
-func (m *ImportJobMutation) RemovedItemsIDs() (ids []int) {
-	for id := range m.removeditems {
+// RemovedRuns returns the removed IDs of the "runs" edge to the BackupRun entity.
+func (m *BackupTaskMutation) RemovedRunsIDs() (ids []int) {
+	for id := range m.removedruns {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ItemsIDs returns the "items" edge IDs in the mutation.
-func (m *ImportJobMutation) ItemsIDs() (ids []int) {
-	for id := range m.items {
+// RunsIDs returns the "runs" edge IDs in the mutation.
+func (m *BackupTaskMutation) RunsIDs() (ids []int) {
+	for id := range m.runs {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetItems resets all changes to the "items" edge.
-func (m *ImportJobMutation) ResetItems() {
-	m.items = nil
-	m.cleareditems = false
-	m.removeditems = nil
+// ResetRuns resets all changes to the "runs" edge.
+func (m *BackupTaskMutation) ResetRuns() {
+	m.runs = nil
+	m.clearedruns = false
+	m.removedruns = nil
 }
 
-// Where appends a list predicates to the ImportJobMutation builder.
-func (m *ImportJobMutation) Where(ps ...predicate.ImportJob) {
+// Where appends a list predicates to the BackupTaskMutation builder.
+func (m *BackupTaskMutation) Where(ps ...predicate.BackupTask) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ImportJobMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BackupTaskMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ImportJobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ImportJob, len(ps))
+func (m *BackupTaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackupTask, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8445,54 +9218,84 @@ func (m *ImportJobMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ImportJobMutation) Op() Op {
+func (m *BackupTaskMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ImportJobMutation) SetOp(op Op) {
+func (m *BackupTaskMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ImportJob).
-func (m *ImportJobMutation) Type() string {
+// Type returns the node type of this mutation (BackupTask).
+func (m *BackupTaskMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ImportJobMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.source != nil {
-		fields = append(fields, importjob.FieldSource)
+func (m *BackupTaskMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.name != nil {
+		fields = append(fields, backuptask.FieldName)
 	}
-	if m.filename != nil {
-		fields = append(fields, importjob.FieldFilename)
+	if m.enabled != nil {
+		fields = append(fields, backuptask.FieldEnabled)
 	}
-	if m.status != nil {
-		fields = append(fields, importjob.FieldStatus)
+	if m.schedule != nil {
+		fields = append(fields, backuptask.FieldSchedule)
 	}
-	if m.note_count != nil {
-		fields = append(fields, importjob.FieldNoteCount)
+	if m.cron_expression != nil {
+		fields = append(fields, backuptask.FieldCronExpression)
 	}
-	if m.imported_count != nil {
-		fields = append(fields, importjob.FieldImportedCount)
+	if m.timezone != nil {
+		fields = append(fields, backuptask.FieldTimezone)
 	}
-	if m.skipped_count != nil {
-		fields = append(fields, importjob.FieldSkippedCount)
+	if m.retention_days != nil {
+		fields = append(fields, backuptask.FieldRetentionDays)
 	}
-	if m.failed_count != nil {
-		fields = append(fields, importjob.FieldFailedCount)
+	if m.max_count != nil {
+		fields = append(fields, backuptask.FieldMaxCount)
 	}
-	if m.options_json != nil {
-		fields = append(fields, importjob.FieldOptionsJSON)
+	if m.keep_hourly != nil {
+		fields = append(fields, backuptask.FieldKeepHourly)
+	}
+	if m.keep_daily != nil {
+		fields = append(fields, backuptask.FieldKeepDaily)
+	}
+	if m.keep_weekly != nil {
+		fields = append(fields, backuptask.FieldKeepWeekly)
+	}
+	if m.keep_monthly != nil {
+		fields = append(fields, backuptask.FieldKeepMonthly)
+	}
+	if m.keep_yearly != nil {
+		fields = append(fields, backuptask.FieldKeepYearly)
+	}
+	if m.encryption_enabled != nil {
+		fields = append(fields, backuptask.FieldEncryptionEnabled)
+	}
+	if m.encryption_passphrase != nil {
+		fields = append(fields, backuptask.FieldEncryptionPassphrase)
+	}
+	if m.incremental != nil {
+		fields = append(fields, backuptask.FieldIncremental)
+	}
+	if m.last_backup_status != nil {
+		fields = append(fields, backuptask.FieldLastBackupStatus)
+	}
+	if m.last_backup_error != nil {
+		fields = append(fields, backuptask.FieldLastBackupError)
+	}
+	if m.last_backup_at != nil {
+		fields = append(fields, backuptask.FieldLastBackupAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, importjob.FieldCreatedAt)
+		fields = append(fields, backuptask.FieldCreatedAt)
 	}
-	if m.completed_at != nil {
-		fields = append(fields, importjob.FieldCompletedAt)
+	if m.updated_at != nil {
+		fields = append(fields, backuptask.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -8500,28 +9303,48 @@ func (m *ImportJobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ImportJobMutation) Field(name string) (ent.Value, bool) {
+func (m *BackupTaskMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case importjob.FieldSource:
-		return m.Source()
-	case importjob.FieldFilename:
-		return m.Filename()
-	case importjob.FieldStatus:
-		return m.Status()
-	case importjob.FieldNoteCount:
-		return m.NoteCount()
-	case importjob.FieldImportedCount:
-		return m.ImportedCount()
-	case importjob.FieldSkippedCount:
-		return m.SkippedCount()
-	case importjob.FieldFailedCount:
-		return m.FailedCount()
-	case importjob.FieldOptionsJSON:
-		return m.OptionsJSON()
-	case importjob.FieldCreatedAt:
+	case backuptask.FieldName:
+		return m.Name()
+	case backuptask.FieldEnabled:
+		return m.Enabled()
+	case backuptask.FieldSchedule:
+		return m.Schedule()
+	case backuptask.FieldCronExpression:
+		return m.CronExpression()
+	case backuptask.FieldTimezone:
+		return m.Timezone()
+	case backuptask.FieldRetentionDays:
+		return m.RetentionDays()
+	case backuptask.FieldMaxCount:
+		return m.MaxCount()
+	case backuptask.FieldKeepHourly:
+		return m.KeepHourly()
+	case backuptask.FieldKeepDaily:
+		return m.KeepDaily()
+	case backuptask.FieldKeepWeekly:
+		return m.KeepWeekly()
+	case backuptask.FieldKeepMonthly:
+		return m.KeepMonthly()
+	case backuptask.FieldKeepYearly:
+		return m.KeepYearly()
+	case backuptask.FieldEncryptionEnabled:
+		return m.EncryptionEnabled()
+	case backuptask.FieldEncryptionPassphrase:
+		return m.EncryptionPassphrase()
+	case backuptask.FieldIncremental:
+		return m.Incremental()
+	case backuptask.FieldLastBackupStatus:
+		return m.LastBackupStatus()
+	case backuptask.FieldLastBackupError:
+		return m.LastBackupError()
+	case backuptask.FieldLastBackupAt:
+		return m.LastBackupAt()
+	case backuptask.FieldCreatedAt:
 		return m.CreatedAt()
-	case importjob.FieldCompletedAt:
-		return m.CompletedAt()
+	case backuptask.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -8529,126 +9352,225 @@ func (m *ImportJobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ImportJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BackupTaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case importjob.FieldSource:
-		return m.OldSource(ctx)
-	case importjob.FieldFilename:
-		return m.OldFilename(ctx)
-	case importjob.FieldStatus:
-		return m.OldStatus(ctx)
-	case importjob.FieldNoteCount:
-		return m.OldNoteCount(ctx)
-	case importjob.FieldImportedCount:
-		return m.OldImportedCount(ctx)
-	case importjob.FieldSkippedCount:
-		return m.OldSkippedCount(ctx)
-	case importjob.FieldFailedCount:
-		return m.OldFailedCount(ctx)
-	case importjob.FieldOptionsJSON:
-		return m.OldOptionsJSON(ctx)
-	case importjob.FieldCreatedAt:
+	case backuptask.FieldName:
+		return m.OldName(ctx)
+	case backuptask.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case backuptask.FieldSchedule:
+		return m.OldSchedule(ctx)
+	case backuptask.FieldCronExpression:
+		return m.OldCronExpression(ctx)
+	case backuptask.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case backuptask.FieldRetentionDays:
+		return m.OldRetentionDays(ctx)
+	case backuptask.FieldMaxCount:
+		return m.OldMaxCount(ctx)
+	case backuptask.FieldKeepHourly:
+		return m.OldKeepHourly(ctx)
+	case backuptask.FieldKeepDaily:
+		return m.OldKeepDaily(ctx)
+	case backuptask.FieldKeepWeekly:
+		return m.OldKeepWeekly(ctx)
+	case backuptask.FieldKeepMonthly:
+		return m.OldKeepMonthly(ctx)
+	case backuptask.FieldKeepYearly:
+		return m.OldKeepYearly(ctx)
+	case backuptask.FieldEncryptionEnabled:
+		return m.OldEncryptionEnabled(ctx)
+	case backuptask.FieldEncryptionPassphrase:
+		return m.OldEncryptionPassphrase(ctx)
+	case backuptask.FieldIncremental:
+		return m.OldIncremental(ctx)
+	case backuptask.FieldLastBackupStatus:
+		return m.OldLastBackupStatus(ctx)
+	case backuptask.FieldLastBackupError:
+		return m.OldLastBackupError(ctx)
+	case backuptask.FieldLastBackupAt:
+		return m.OldLastBackupAt(ctx)
+	case backuptask.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case importjob.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
+	case backuptask.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ImportJob field %s", name)
+	return nil, fmt.Errorf("unknown BackupTask field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ImportJobMutation) SetField(name string, value ent.Value) error {
+func (m *BackupTaskMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case importjob.FieldSource:
+	case backuptask.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSource(v)
+		m.SetName(v)
 		return nil
-	case importjob.FieldFilename:
+	case backuptask.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case backuptask.FieldSchedule:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilename(v)
+		m.SetSchedule(v)
 		return nil
-	case importjob.FieldStatus:
+	case backuptask.FieldCronExpression:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetCronExpression(v)
 		return nil
-	case importjob.FieldNoteCount:
+	case backuptask.FieldTimezone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimezone(v)
+		return nil
+	case backuptask.FieldRetentionDays:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNoteCount(v)
+		m.SetRetentionDays(v)
 		return nil
-	case importjob.FieldImportedCount:
+	case backuptask.FieldMaxCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImportedCount(v)
+		m.SetMaxCount(v)
 		return nil
-	case importjob.FieldSkippedCount:
+	case backuptask.FieldKeepHourly:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSkippedCount(v)
+		m.SetKeepHourly(v)
 		return nil
-	case importjob.FieldFailedCount:
+	case backuptask.FieldKeepDaily:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFailedCount(v)
+		m.SetKeepDaily(v)
 		return nil
-	case importjob.FieldOptionsJSON:
+	case backuptask.FieldKeepWeekly:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepWeekly(v)
+		return nil
+	case backuptask.FieldKeepMonthly:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepMonthly(v)
+		return nil
+	case backuptask.FieldKeepYearly:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepYearly(v)
+		return nil
+	case backuptask.FieldEncryptionEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptionEnabled(v)
+		return nil
+	case backuptask.FieldEncryptionPassphrase:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOptionsJSON(v)
+		m.SetEncryptionPassphrase(v)
 		return nil
-	case importjob.FieldCreatedAt:
+	case backuptask.FieldIncremental:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIncremental(v)
+		return nil
+	case backuptask.FieldLastBackupStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastBackupStatus(v)
+		return nil
+	case backuptask.FieldLastBackupError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastBackupError(v)
+		return nil
+	case backuptask.FieldLastBackupAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastBackupAt(v)
+		return nil
+	case backuptask.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case importjob.FieldCompletedAt:
+	case backuptask.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompletedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ImportJob field %s", name)
+	return fmt.Errorf("unknown BackupTask field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ImportJobMutation) AddedFields() []string {
+func (m *BackupTaskMutation) AddedFields() []string {
 	var fields []string
-	if m.addnote_count != nil {
-		fields = append(fields, importjob.FieldNoteCount)
+	if m.addretention_days != nil {
+		fields = append(fields, backuptask.FieldRetentionDays)
 	}
-	if m.addimported_count != nil {
-		fields = append(fields, importjob.FieldImportedCount)
+	if m.addmax_count != nil {
+		fields = append(fields, backuptask.FieldMaxCount)
 	}
-	if m.addskipped_count != nil {
-		fields = append(fields, importjob.FieldSkippedCount)
+	if m.addkeep_hourly != nil {
+		fields = append(fields, backuptask.FieldKeepHourly)
 	}
-	if m.addfailed_count != nil {
-		fields = append(fields, importjob.FieldFailedCount)
+	if m.addkeep_daily != nil {
+		fields = append(fields, backuptask.FieldKeepDaily)
+	}
+	if m.addkeep_weekly != nil {
+		fields = append(fields, backuptask.FieldKeepWeekly)
+	}
+	if m.addkeep_monthly != nil {
+		fields = append(fields, backuptask.FieldKeepMonthly)
+	}
+	if m.addkeep_yearly != nil {
+		fields = append(fields, backuptask.FieldKeepYearly)
 	}
 	return fields
 }
@@ -8656,16 +9578,22 @@ func (m *ImportJobMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ImportJobMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BackupTaskMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case importjob.FieldNoteCount:
-		return m.AddedNoteCount()
-	case importjob.FieldImportedCount:
-		return m.AddedImportedCount()
-	case importjob.FieldSkippedCount:
-		return m.AddedSkippedCount()
-	case importjob.FieldFailedCount:
-		return m.AddedFailedCount()
+	case backuptask.FieldRetentionDays:
+		return m.AddedRetentionDays()
+	case backuptask.FieldMaxCount:
+		return m.AddedMaxCount()
+	case backuptask.FieldKeepHourly:
+		return m.AddedKeepHourly()
+	case backuptask.FieldKeepDaily:
+		return m.AddedKeepDaily()
+	case backuptask.FieldKeepWeekly:
+		return m.AddedKeepWeekly()
+	case backuptask.FieldKeepMonthly:
+		return m.AddedKeepMonthly()
+	case backuptask.FieldKeepYearly:
+		return m.AddedKeepYearly()
 	}
 	return nil, false
 }
@@ -8673,135 +9601,206 @@ func (m *ImportJobMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ImportJobMutation) AddField(name string, value ent.Value) error {
+func (m *BackupTaskMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case importjob.FieldNoteCount:
+	case backuptask.FieldRetentionDays:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddNoteCount(v)
+		m.AddRetentionDays(v)
 		return nil
-	case importjob.FieldImportedCount:
+	case backuptask.FieldMaxCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddImportedCount(v)
+		m.AddMaxCount(v)
 		return nil
-	case importjob.FieldSkippedCount:
+	case backuptask.FieldKeepHourly:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSkippedCount(v)
+		m.AddKeepHourly(v)
 		return nil
-	case importjob.FieldFailedCount:
+	case backuptask.FieldKeepDaily:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFailedCount(v)
+		m.AddKeepDaily(v)
+		return nil
+	case backuptask.FieldKeepWeekly:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeepWeekly(v)
+		return nil
+	case backuptask.FieldKeepMonthly:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeepMonthly(v)
+		return nil
+	case backuptask.FieldKeepYearly:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeepYearly(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ImportJob numeric field %s", name)
+	return fmt.Errorf("unknown BackupTask numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ImportJobMutation) ClearedFields() []string {
+func (m *BackupTaskMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(importjob.FieldOptionsJSON) {
-		fields = append(fields, importjob.FieldOptionsJSON)
+	if m.FieldCleared(backuptask.FieldCronExpression) {
+		fields = append(fields, backuptask.FieldCronExpression)
 	}
-	if m.FieldCleared(importjob.FieldCompletedAt) {
-		fields = append(fields, importjob.FieldCompletedAt)
+	if m.FieldCleared(backuptask.FieldTimezone) {
+		fields = append(fields, backuptask.FieldTimezone)
+	}
+	if m.FieldCleared(backuptask.FieldEncryptionPassphrase) {
+		fields = append(fields, backuptask.FieldEncryptionPassphrase)
+	}
+	if m.FieldCleared(backuptask.FieldLastBackupError) {
+		fields = append(fields, backuptask.FieldLastBackupError)
+	}
+	if m.FieldCleared(backuptask.FieldLastBackupAt) {
+		fields = append(fields, backuptask.FieldLastBackupAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ImportJobMutation) FieldCleared(name string) bool {
+func (m *BackupTaskMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ImportJobMutation) ClearField(name string) error {
+func (m *BackupTaskMutation) ClearField(name string) error {
 	switch name {
-	case importjob.FieldOptionsJSON:
-		m.ClearOptionsJSON()
+	case backuptask.FieldCronExpression:
+		m.ClearCronExpression()
 		return nil
-	case importjob.FieldCompletedAt:
-		m.ClearCompletedAt()
+	case backuptask.FieldTimezone:
+		m.ClearTimezone()
+		return nil
+	case backuptask.FieldEncryptionPassphrase:
+		m.ClearEncryptionPassphrase()
+		return nil
+	case backuptask.FieldLastBackupError:
+		m.ClearLastBackupError()
+		return nil
+	case backuptask.FieldLastBackupAt:
+		m.ClearLastBackupAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ImportJob nullable field %s", name)
+	return fmt.Errorf("unknown BackupTask nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ImportJobMutation) ResetField(name string) error {
+func (m *BackupTaskMutation) ResetField(name string) error {
 	switch name {
-	case importjob.FieldSource:
-		m.ResetSource()
+	case backuptask.FieldName:
+		m.ResetName()
 		return nil
-	case importjob.FieldFilename:
-		m.ResetFilename()
+	case backuptask.FieldEnabled:
+		m.ResetEnabled()
 		return nil
-	case importjob.FieldStatus:
-		m.ResetStatus()
+	case backuptask.FieldSchedule:
+		m.ResetSchedule()
 		return nil
-	case importjob.FieldNoteCount:
-		m.ResetNoteCount()
+	case backuptask.FieldCronExpression:
+		m.ResetCronExpression()
 		return nil
-	case importjob.FieldImportedCount:
-		m.ResetImportedCount()
+	case backuptask.FieldTimezone:
+		m.ResetTimezone()
 		return nil
-	case importjob.FieldSkippedCount:
-		m.ResetSkippedCount()
+	case backuptask.FieldRetentionDays:
+		m.ResetRetentionDays()
 		return nil
-	case importjob.FieldFailedCount:
-		m.ResetFailedCount()
+	case backuptask.FieldMaxCount:
+		m.ResetMaxCount()
 		return nil
-	case importjob.FieldOptionsJSON:
-		m.ResetOptionsJSON()
+	case backuptask.FieldKeepHourly:
+		m.ResetKeepHourly()
 		return nil
-	case importjob.FieldCreatedAt:
+	case backuptask.FieldKeepDaily:
+		m.ResetKeepDaily()
+		return nil
+	case backuptask.FieldKeepWeekly:
+		m.ResetKeepWeekly()
+		return nil
+	case backuptask.FieldKeepMonthly:
+		m.ResetKeepMonthly()
+		return nil
+	case backuptask.FieldKeepYearly:
+		m.ResetKeepYearly()
+		return nil
+	case backuptask.FieldEncryptionEnabled:
+		m.ResetEncryptionEnabled()
+		return nil
+	case backuptask.FieldEncryptionPassphrase:
+		m.ResetEncryptionPassphrase()
+		return nil
+	case backuptask.FieldIncremental:
+		m.ResetIncremental()
+		return nil
+	case backuptask.FieldLastBackupStatus:
+		m.ResetLastBackupStatus()
+		return nil
+	case backuptask.FieldLastBackupError:
+		m.ResetLastBackupError()
+		return nil
+	case backuptask.FieldLastBackupAt:
+		m.ResetLastBackupAt()
+		return nil
+	case backuptask.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case importjob.FieldCompletedAt:
-		m.ResetCompletedAt()
+	case backuptask.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ImportJob field %s", name)
+	return fmt.Errorf("unknown BackupTask field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ImportJobMutation) AddedEdges() []string {
+func (m *BackupTaskMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, importjob.EdgeUser)
+	if m.targets != nil {
+		edges = append(edges, backuptask.EdgeTargets)
 	}
-	if m.items != nil {
-		edges = append(edges, importjob.EdgeItems)
+	if m.runs != nil {
+		edges = append(edges, backuptask.EdgeRuns)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ImportJobMutation) AddedIDs(name string) []ent.Value {
+func (m *BackupTaskMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case importjob.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
+	case backuptask.EdgeTargets:
+		ids := make([]ent.Value, 0, len(m.targets))
+		for id := range m.targets {
+			ids = append(ids, id)
 		}
-	case importjob.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.items))
-		for id := range m.items {
+		return ids
+	case backuptask.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.runs))
+		for id := range m.runs {
 			ids = append(ids, id)
 		}
 		return ids
@@ -8810,21 +9809,30 @@ func (m *ImportJobMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ImportJobMutation) RemovedEdges() []string {
+func (m *BackupTaskMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removeditems != nil {
-		edges = append(edges, importjob.EdgeItems)
+	if m.removedtargets != nil {
+		edges = append(edges, backuptask.EdgeTargets)
+	}
+	if m.removedruns != nil {
+		edges = append(edges, backuptask.EdgeRuns)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ImportJobMutation) RemovedIDs(name string) []ent.Value {
+func (m *BackupTaskMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case importjob.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.removeditems))
-		for id := range m.removeditems {
+	case backuptask.EdgeTargets:
+		ids := make([]ent.Value, 0, len(m.removedtargets))
+		for id := range m.removedtargets {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuptask.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.removedruns))
+		for id := range m.removedruns {
 			ids = append(ids, id)
 		}
 		return ids
@@ -8833,86 +9841,79 @@ func (m *ImportJobMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ImportJobMutation) ClearedEdges() []string {
+func (m *BackupTaskMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, importjob.EdgeUser)
+	if m.clearedtargets {
+		edges = append(edges, backuptask.EdgeTargets)
 	}
-	if m.cleareditems {
-		edges = append(edges, importjob.EdgeItems)
+	if m.clearedruns {
+		edges = append(edges, backuptask.EdgeRuns)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ImportJobMutation) EdgeCleared(name string) bool {
+func (m *BackupTaskMutation) EdgeCleared(name string) bool {
 	switch name {
-	case importjob.EdgeUser:
-		return m.cleareduser
-	case importjob.EdgeItems:
-		return m.cleareditems
+	case backuptask.EdgeTargets:
+		return m.clearedtargets
+	case backuptask.EdgeRuns:
+		return m.clearedruns
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ImportJobMutation) ClearEdge(name string) error {
+func (m *BackupTaskMutation) ClearEdge(name string) error {
 	switch name {
-	case importjob.EdgeUser:
-		m.ClearUser()
-		return nil
 	}
-	return fmt.Errorf("unknown ImportJob unique edge %s", name)
+	return fmt.Errorf("unknown BackupTask unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ImportJobMutation) ResetEdge(name string) error {
+func (m *BackupTaskMutation) ResetEdge(name string) error {
 	switch name {
-	case importjob.EdgeUser:
-		m.ResetUser()
+	case backuptask.EdgeTargets:
+		m.ResetTargets()
 		return nil
-	case importjob.EdgeItems:
-		m.ResetItems()
+	case backuptask.EdgeRuns:
+		m.ResetRuns()
 		return nil
 	}
-	return fmt.Errorf("unknown ImportJob edge %s", name)
+	return fmt.Errorf("unknown BackupTask edge %s", name)
 }
 
-// MCPImageMutation represents an operation that mutates the MCPImage nodes in the graph.
-type MCPImageMutation struct {
+// ExcalidrawLibraryMutation represents an operation that mutates the ExcalidrawLibrary nodes in the graph.
+type ExcalidrawLibraryMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *int
-	filename      *string
-	_path         *string
-	content_type  *string
-	size          *int64
-	addsize       *int64
+	id            *uuid.UUID
+	library_json  *string
 	created_at    *time.Time
 	updated_at    *time.Time
 	clearedFields map[string]struct{}
 	user          *int
 	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*MCPImage, error)
-	predicates    []predicate.MCPImage
+	oldValue      func(context.Context) (*ExcalidrawLibrary, error)
+	predicates    []predicate.ExcalidrawLibrary
 }
 
-var _ ent.Mutation = (*MCPImageMutation)(nil)
+var _ ent.Mutation = (*ExcalidrawLibraryMutation)(nil)
 
-// mcpimageOption allows management of the mutation configuration using functional options.
-type mcpimageOption func(*MCPImageMutation)
+// excalidrawlibraryOption allows management of the mutation configuration using functional options.
+type excalidrawlibraryOption func(*ExcalidrawLibraryMutation)
 
-// newMCPImageMutation creates new mutation for the MCPImage entity.
-func newMCPImageMutation(c config, op Op, opts ...mcpimageOption) *MCPImageMutation {
-	m := &MCPImageMutation{
+// newExcalidrawLibraryMutation creates new mutation for the ExcalidrawLibrary entity.
+func newExcalidrawLibraryMutation(c config, op Op, opts ...excalidrawlibraryOption) *ExcalidrawLibraryMutation {
+	m := &ExcalidrawLibraryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMCPImage,
+		typ:           TypeExcalidrawLibrary,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8921,20 +9922,20 @@ func newMCPImageMutation(c config, op Op, opts ...mcpimageOption) *MCPImageMutat
 	return m
 }
 
-// withMCPImageID sets the ID field of the mutation.
-func withMCPImageID(id int) mcpimageOption {
-	return func(m *MCPImageMutation) {
+// withExcalidrawLibraryID sets the ID field of the mutation.
+func withExcalidrawLibraryID(id uuid.UUID) excalidrawlibraryOption {
+	return func(m *ExcalidrawLibraryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MCPImage
+			value *ExcalidrawLibrary
 		)
-		m.oldValue = func(ctx context.Context) (*MCPImage, error) {
+		m.oldValue = func(ctx context.Context) (*ExcalidrawLibrary, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MCPImage.Get(ctx, id)
+					value, err = m.Client().ExcalidrawLibrary.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8943,10 +9944,10 @@ func withMCPImageID(id int) mcpimageOption {
 	}
 }
 
-// withMCPImage sets the old MCPImage of the mutation.
-func withMCPImage(node *MCPImage) mcpimageOption {
-	return func(m *MCPImageMutation) {
-		m.oldValue = func(context.Context) (*MCPImage, error) {
+// withExcalidrawLibrary sets the old ExcalidrawLibrary of the mutation.
+func withExcalidrawLibrary(node *ExcalidrawLibrary) excalidrawlibraryOption {
+	return func(m *ExcalidrawLibraryMutation) {
+		m.oldValue = func(context.Context) (*ExcalidrawLibrary, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8955,7 +9956,7 @@ func withMCPImage(node *MCPImage) mcpimageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MCPImageMutation) Client() *Client {
+func (m ExcalidrawLibraryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8963,7 +9964,7 @@ func (m MCPImageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MCPImageMutation) Tx() (*Tx, error) {
+func (m ExcalidrawLibraryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8972,9 +9973,15 @@ func (m MCPImageMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExcalidrawLibrary entities.
+func (m *ExcalidrawLibraryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MCPImageMutation) ID() (id int, exists bool) {
+func (m *ExcalidrawLibraryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8985,208 +9992,80 @@ func (m *MCPImageMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MCPImageMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ExcalidrawLibraryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MCPImage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ExcalidrawLibrary.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetFilename sets the "filename" field.
-func (m *MCPImageMutation) SetFilename(s string) {
-	m.filename = &s
+// SetLibraryJSON sets the "library_json" field.
+func (m *ExcalidrawLibraryMutation) SetLibraryJSON(s string) {
+	m.library_json = &s
 }
 
-// Filename returns the value of the "filename" field in the mutation.
-func (m *MCPImageMutation) Filename() (r string, exists bool) {
-	v := m.filename
+// LibraryJSON returns the value of the "library_json" field in the mutation.
+func (m *ExcalidrawLibraryMutation) LibraryJSON() (r string, exists bool) {
+	v := m.library_json
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilename returns the old "filename" field's value of the MCPImage entity.
-// If the MCPImage object wasn't provided to the builder, the object is fetched from the database.
+// OldLibraryJSON returns the old "library_json" field's value of the ExcalidrawLibrary entity.
+// If the ExcalidrawLibrary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MCPImageMutation) OldFilename(ctx context.Context) (v string, err error) {
+func (m *ExcalidrawLibraryMutation) OldLibraryJSON(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+		return v, errors.New("OldLibraryJSON is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilename requires an ID field in the mutation")
+		return v, errors.New("OldLibraryJSON requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+		return v, fmt.Errorf("querying old value for OldLibraryJSON: %w", err)
 	}
-	return oldValue.Filename, nil
+	return oldValue.LibraryJSON, nil
 }
 
-// ResetFilename resets all changes to the "filename" field.
-func (m *MCPImageMutation) ResetFilename() {
-	m.filename = nil
+// ResetLibraryJSON resets all changes to the "library_json" field.
+func (m *ExcalidrawLibraryMutation) ResetLibraryJSON() {
+	m.library_json = nil
 }
 
-// SetPath sets the "path" field.
-func (m *MCPImageMutation) SetPath(s string) {
-	m._path = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *ExcalidrawLibraryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Path returns the value of the "path" field in the mutation.
-func (m *MCPImageMutation) Path() (r string, exists bool) {
-	v := m._path
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ExcalidrawLibraryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPath returns the old "path" field's value of the MCPImage entity.
-// If the MCPImage object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ExcalidrawLibrary entity.
+// If the ExcalidrawLibrary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MCPImageMutation) OldPath(ctx context.Context) (v string, err error) {
+func (m *ExcalidrawLibraryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
-	}
-	return oldValue.Path, nil
-}
-
-// ResetPath resets all changes to the "path" field.
-func (m *MCPImageMutation) ResetPath() {
-	m._path = nil
-}
-
-// SetContentType sets the "content_type" field.
-func (m *MCPImageMutation) SetContentType(s string) {
-	m.content_type = &s
-}
-
-// ContentType returns the value of the "content_type" field in the mutation.
-func (m *MCPImageMutation) ContentType() (r string, exists bool) {
-	v := m.content_type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldContentType returns the old "content_type" field's value of the MCPImage entity.
-// If the MCPImage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MCPImageMutation) OldContentType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
-	}
-	return oldValue.ContentType, nil
-}
-
-// ResetContentType resets all changes to the "content_type" field.
-func (m *MCPImageMutation) ResetContentType() {
-	m.content_type = nil
-}
-
-// SetSize sets the "size" field.
-func (m *MCPImageMutation) SetSize(i int64) {
-	m.size = &i
-	m.addsize = nil
-}
-
-// Size returns the value of the "size" field in the mutation.
-func (m *MCPImageMutation) Size() (r int64, exists bool) {
-	v := m.size
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSize returns the old "size" field's value of the MCPImage entity.
-// If the MCPImage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MCPImageMutation) OldSize(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
-	}
-	return oldValue.Size, nil
-}
-
-// AddSize adds i to the "size" field.
-func (m *MCPImageMutation) AddSize(i int64) {
-	if m.addsize != nil {
-		*m.addsize += i
-	} else {
-		m.addsize = &i
-	}
-}
-
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *MCPImageMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetSize resets all changes to the "size" field.
-func (m *MCPImageMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *MCPImageMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MCPImageMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the MCPImage entity.
-// If the MCPImage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MCPImageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
@@ -9196,17 +10075,17 @@ func (m *MCPImageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MCPImageMutation) ResetCreatedAt() {
+func (m *ExcalidrawLibraryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *MCPImageMutation) SetUpdatedAt(t time.Time) {
+func (m *ExcalidrawLibraryMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MCPImageMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ExcalidrawLibraryMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -9214,10 +10093,10 @@ func (m *MCPImageMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the MCPImage entity.
-// If the MCPImage object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ExcalidrawLibrary entity.
+// If the ExcalidrawLibrary object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MCPImageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ExcalidrawLibraryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -9232,27 +10111,27 @@ func (m *MCPImageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MCPImageMutation) ResetUpdatedAt() {
+func (m *ExcalidrawLibraryMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetUserID sets the "user" edge to the User entity by id.
-func (m *MCPImageMutation) SetUserID(id int) {
+func (m *ExcalidrawLibraryMutation) SetUserID(id int) {
 	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *MCPImageMutation) ClearUser() {
+func (m *ExcalidrawLibraryMutation) ClearUser() {
 	m.cleareduser = true
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *MCPImageMutation) UserCleared() bool {
+func (m *ExcalidrawLibraryMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the "user" edge ID in the mutation.
-func (m *MCPImageMutation) UserID() (id int, exists bool) {
+func (m *ExcalidrawLibraryMutation) UserID() (id int, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -9262,7 +10141,7 @@ func (m *MCPImageMutation) UserID() (id int, exists bool) {
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *MCPImageMutation) UserIDs() (ids []int) {
+func (m *ExcalidrawLibraryMutation) UserIDs() (ids []int) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -9270,20 +10149,20 @@ func (m *MCPImageMutation) UserIDs() (ids []int) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *MCPImageMutation) ResetUser() {
+func (m *ExcalidrawLibraryMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the MCPImageMutation builder.
-func (m *MCPImageMutation) Where(ps ...predicate.MCPImage) {
+// Where appends a list predicates to the ExcalidrawLibraryMutation builder.
+func (m *ExcalidrawLibraryMutation) Where(ps ...predicate.ExcalidrawLibrary) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MCPImageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ExcalidrawLibraryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MCPImageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MCPImage, len(ps))
+func (m *ExcalidrawLibraryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExcalidrawLibrary, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9291,42 +10170,33 @@ func (m *MCPImageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MCPImageMutation) Op() Op {
+func (m *ExcalidrawLibraryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MCPImageMutation) SetOp(op Op) {
+func (m *ExcalidrawLibraryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MCPImage).
-func (m *MCPImageMutation) Type() string {
+// Type returns the node type of this mutation (ExcalidrawLibrary).
+func (m *ExcalidrawLibraryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MCPImageMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.filename != nil {
-		fields = append(fields, mcpimage.FieldFilename)
-	}
-	if m._path != nil {
-		fields = append(fields, mcpimage.FieldPath)
-	}
-	if m.content_type != nil {
-		fields = append(fields, mcpimage.FieldContentType)
-	}
-	if m.size != nil {
-		fields = append(fields, mcpimage.FieldSize)
+func (m *ExcalidrawLibraryMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.library_json != nil {
+		fields = append(fields, excalidrawlibrary.FieldLibraryJSON)
 	}
 	if m.created_at != nil {
-		fields = append(fields, mcpimage.FieldCreatedAt)
+		fields = append(fields, excalidrawlibrary.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, mcpimage.FieldUpdatedAt)
+		fields = append(fields, excalidrawlibrary.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -9334,19 +10204,13 @@ func (m *MCPImageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MCPImageMutation) Field(name string) (ent.Value, bool) {
+func (m *ExcalidrawLibraryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case mcpimage.FieldFilename:
-		return m.Filename()
-	case mcpimage.FieldPath:
-		return m.Path()
-	case mcpimage.FieldContentType:
-		return m.ContentType()
-	case mcpimage.FieldSize:
-		return m.Size()
-	case mcpimage.FieldCreatedAt:
+	case excalidrawlibrary.FieldLibraryJSON:
+		return m.LibraryJSON()
+	case excalidrawlibrary.FieldCreatedAt:
 		return m.CreatedAt()
-	case mcpimage.FieldUpdatedAt:
+	case excalidrawlibrary.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -9355,65 +10219,38 @@ func (m *MCPImageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MCPImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ExcalidrawLibraryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case mcpimage.FieldFilename:
-		return m.OldFilename(ctx)
-	case mcpimage.FieldPath:
-		return m.OldPath(ctx)
-	case mcpimage.FieldContentType:
-		return m.OldContentType(ctx)
-	case mcpimage.FieldSize:
-		return m.OldSize(ctx)
-	case mcpimage.FieldCreatedAt:
+	case excalidrawlibrary.FieldLibraryJSON:
+		return m.OldLibraryJSON(ctx)
+	case excalidrawlibrary.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case mcpimage.FieldUpdatedAt:
+	case excalidrawlibrary.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MCPImage field %s", name)
+	return nil, fmt.Errorf("unknown ExcalidrawLibrary field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MCPImageMutation) SetField(name string, value ent.Value) error {
+func (m *ExcalidrawLibraryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case mcpimage.FieldFilename:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFilename(v)
-		return nil
-	case mcpimage.FieldPath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPath(v)
-		return nil
-	case mcpimage.FieldContentType:
+	case excalidrawlibrary.FieldLibraryJSON:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContentType(v)
+		m.SetLibraryJSON(v)
 		return nil
-	case mcpimage.FieldSize:
-		v, ok := value.(int64)
+	case excalidrawlibrary.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSize(v)
+		m.SetCreatedAt(v)
 		return nil
-	case mcpimage.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case mcpimage.FieldUpdatedAt:
+	case excalidrawlibrary.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -9421,523 +10258,7180 @@ func (m *MCPImageMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MCPImage field %s", name)
+	return fmt.Errorf("unknown ExcalidrawLibrary field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MCPImageMutation) AddedFields() []string {
-	var fields []string
-	if m.addsize != nil {
-		fields = append(fields, mcpimage.FieldSize)
-	}
-	return fields
+func (m *ExcalidrawLibraryMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MCPImageMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case mcpimage.FieldSize:
-		return m.AddedSize()
-	}
+func (m *ExcalidrawLibraryMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MCPImageMutation) AddField(name string, value ent.Value) error {
+func (m *ExcalidrawLibraryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case mcpimage.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSize(v)
-		return nil
 	}
-	return fmt.Errorf("unknown MCPImage numeric field %s", name)
+	return fmt.Errorf("unknown ExcalidrawLibrary numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MCPImageMutation) ClearedFields() []string {
+func (m *ExcalidrawLibraryMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MCPImageMutation) FieldCleared(name string) bool {
+func (m *ExcalidrawLibraryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MCPImageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MCPImage nullable field %s", name)
+func (m *ExcalidrawLibraryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExcalidrawLibrary nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MCPImageMutation) ResetField(name string) error {
+func (m *ExcalidrawLibraryMutation) ResetField(name string) error {
 	switch name {
-	case mcpimage.FieldFilename:
-		m.ResetFilename()
-		return nil
-	case mcpimage.FieldPath:
-		m.ResetPath()
-		return nil
-	case mcpimage.FieldContentType:
-		m.ResetContentType()
-		return nil
-	case mcpimage.FieldSize:
-		m.ResetSize()
+	case excalidrawlibrary.FieldLibraryJSON:
+		m.ResetLibraryJSON()
 		return nil
-	case mcpimage.FieldCreatedAt:
+	case excalidrawlibrary.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case mcpimage.FieldUpdatedAt:
+	case excalidrawlibrary.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown MCPImage field %s", name)
+	return fmt.Errorf("unknown ExcalidrawLibrary field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExcalidrawLibraryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, excalidrawlibrary.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExcalidrawLibraryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case excalidrawlibrary.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExcalidrawLibraryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExcalidrawLibraryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExcalidrawLibraryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, excalidrawlibrary.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExcalidrawLibraryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case excalidrawlibrary.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExcalidrawLibraryMutation) ClearEdge(name string) error {
+	switch name {
+	case excalidrawlibrary.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ExcalidrawLibrary unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExcalidrawLibraryMutation) ResetEdge(name string) error {
+	switch name {
+	case excalidrawlibrary.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ExcalidrawLibrary edge %s", name)
+}
+
+// FolderMutation represents an operation that mutates the Folder nodes in the graph.
+type FolderMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	name            *string
+	sort_order      *int
+	addsort_order   *int
+	is_starred      *bool
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	user            *int
+	cleareduser     bool
+	parent          *uuid.UUID
+	clearedparent   bool
+	children        map[uuid.UUID]struct{}
+	removedchildren map[uuid.UUID]struct{}
+	clearedchildren bool
+	notes           map[uuid.UUID]struct{}
+	removednotes    map[uuid.UUID]struct{}
+	clearednotes    bool
+	done            bool
+	oldValue        func(context.Context) (*Folder, error)
+	predicates      []predicate.Folder
+}
+
+var _ ent.Mutation = (*FolderMutation)(nil)
+
+// folderOption allows management of the mutation configuration using functional options.
+type folderOption func(*FolderMutation)
+
+// newFolderMutation creates new mutation for the Folder entity.
+func newFolderMutation(c config, op Op, opts ...folderOption) *FolderMutation {
+	m := &FolderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFolder,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFolderID sets the ID field of the mutation.
+func withFolderID(id uuid.UUID) folderOption {
+	return func(m *FolderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Folder
+		)
+		m.oldValue = func(ctx context.Context) (*Folder, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Folder.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFolder sets the old Folder of the mutation.
+func withFolder(node *Folder) folderOption {
+	return func(m *FolderMutation) {
+		m.oldValue = func(context.Context) (*Folder, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FolderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FolderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Folder entities.
+func (m *FolderMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FolderMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FolderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Folder.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *FolderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FolderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FolderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (m *FolderMutation) SetSortOrder(i int) {
+	m.sort_order = &i
+	m.addsort_order = nil
+}
+
+// SortOrder returns the value of the "sort_order" field in the mutation.
+func (m *FolderMutation) SortOrder() (r int, exists bool) {
+	v := m.sort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSortOrder returns the old "sort_order" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldSortOrder(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSortOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
+	}
+	return oldValue.SortOrder, nil
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (m *FolderMutation) AddSortOrder(i int) {
+	if m.addsort_order != nil {
+		*m.addsort_order += i
+	} else {
+		m.addsort_order = &i
+	}
+}
+
+// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
+func (m *FolderMutation) AddedSortOrder() (r int, exists bool) {
+	v := m.addsort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSortOrder resets all changes to the "sort_order" field.
+func (m *FolderMutation) ResetSortOrder() {
+	m.sort_order = nil
+	m.addsort_order = nil
+}
+
+// SetIsStarred sets the "is_starred" field.
+func (m *FolderMutation) SetIsStarred(b bool) {
+	m.is_starred = &b
+}
+
+// IsStarred returns the value of the "is_starred" field in the mutation.
+func (m *FolderMutation) IsStarred() (r bool, exists bool) {
+	v := m.is_starred
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsStarred returns the old "is_starred" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldIsStarred(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsStarred is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsStarred requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsStarred: %w", err)
+	}
+	return oldValue.IsStarred, nil
+}
+
+// ResetIsStarred resets all changes to the "is_starred" field.
+func (m *FolderMutation) ResetIsStarred() {
+	m.is_starred = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FolderMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FolderMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FolderMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FolderMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FolderMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FolderMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *FolderMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *FolderMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *FolderMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *FolderMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *FolderMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *FolderMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetParentID sets the "parent" edge to the Folder entity by id.
+func (m *FolderMutation) SetParentID(id uuid.UUID) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Folder entity.
+func (m *FolderMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Folder entity was cleared.
+func (m *FolderMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *FolderMutation) ParentID() (id uuid.UUID, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *FolderMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *FolderMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Folder entity by ids.
+func (m *FolderMutation) AddChildIDs(ids ...uuid.UUID) {
+	if m.children == nil {
+		m.children = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Folder entity.
+func (m *FolderMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Folder entity was cleared.
+func (m *FolderMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Folder entity by IDs.
+func (m *FolderMutation) RemoveChildIDs(ids ...uuid.UUID) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Folder entity.
+func (m *FolderMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *FolderMutation) ChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *FolderMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by ids.
+func (m *FolderMutation) AddNoteIDs(ids ...uuid.UUID) {
+	if m.notes == nil {
+		m.notes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.notes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotes clears the "notes" edge to the Note entity.
+func (m *FolderMutation) ClearNotes() {
+	m.clearednotes = true
+}
+
+// NotesCleared reports if the "notes" edge to the Note entity was cleared.
+func (m *FolderMutation) NotesCleared() bool {
+	return m.clearednotes
+}
+
+// RemoveNoteIDs removes the "notes" edge to the Note entity by IDs.
+func (m *FolderMutation) RemoveNoteIDs(ids ...uuid.UUID) {
+	if m.removednotes == nil {
+		m.removednotes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.notes, ids[i])
+		m.removednotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotes returns the removed IDs of the "notes" edge to the Note entity.
+func (m *FolderMutation) RemovedNotesIDs() (ids []uuid.UUID) {
+	for id := range m.removednotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotesIDs returns the "notes" edge IDs in the mutation.
+func (m *FolderMutation) NotesIDs() (ids []uuid.UUID) {
+	for id := range m.notes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotes resets all changes to the "notes" edge.
+func (m *FolderMutation) ResetNotes() {
+	m.notes = nil
+	m.clearednotes = false
+	m.removednotes = nil
+}
+
+// Where appends a list predicates to the FolderMutation builder.
+func (m *FolderMutation) Where(ps ...predicate.Folder) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FolderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FolderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Folder, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FolderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FolderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Folder).
+func (m *FolderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FolderMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, folder.FieldName)
+	}
+	if m.sort_order != nil {
+		fields = append(fields, folder.FieldSortOrder)
+	}
+	if m.is_starred != nil {
+		fields = append(fields, folder.FieldIsStarred)
+	}
+	if m.created_at != nil {
+		fields = append(fields, folder.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, folder.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FolderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case folder.FieldName:
+		return m.Name()
+	case folder.FieldSortOrder:
+		return m.SortOrder()
+	case folder.FieldIsStarred:
+		return m.IsStarred()
+	case folder.FieldCreatedAt:
+		return m.CreatedAt()
+	case folder.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FolderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case folder.FieldName:
+		return m.OldName(ctx)
+	case folder.FieldSortOrder:
+		return m.OldSortOrder(ctx)
+	case folder.FieldIsStarred:
+		return m.OldIsStarred(ctx)
+	case folder.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case folder.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Folder field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FolderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case folder.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case folder.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSortOrder(v)
+		return nil
+	case folder.FieldIsStarred:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsStarred(v)
+		return nil
+	case folder.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case folder.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Folder field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FolderMutation) AddedFields() []string {
+	var fields []string
+	if m.addsort_order != nil {
+		fields = append(fields, folder.FieldSortOrder)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FolderMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case folder.FieldSortOrder:
+		return m.AddedSortOrder()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FolderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case folder.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSortOrder(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Folder numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FolderMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FolderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FolderMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Folder nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FolderMutation) ResetField(name string) error {
+	switch name {
+	case folder.FieldName:
+		m.ResetName()
+		return nil
+	case folder.FieldSortOrder:
+		m.ResetSortOrder()
+		return nil
+	case folder.FieldIsStarred:
+		m.ResetIsStarred()
+		return nil
+	case folder.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case folder.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FolderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.user != nil {
+		edges = append(edges, folder.EdgeUser)
+	}
+	if m.parent != nil {
+		edges = append(edges, folder.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, folder.EdgeChildren)
+	}
+	if m.notes != nil {
+		edges = append(edges, folder.EdgeNotes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FolderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case folder.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case folder.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case folder.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case folder.EdgeNotes:
+		ids := make([]ent.Value, 0, len(m.notes))
+		for id := range m.notes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FolderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, folder.EdgeChildren)
+	}
+	if m.removednotes != nil {
+		edges = append(edges, folder.EdgeNotes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FolderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case folder.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case folder.EdgeNotes:
+		ids := make([]ent.Value, 0, len(m.removednotes))
+		for id := range m.removednotes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FolderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleareduser {
+		edges = append(edges, folder.EdgeUser)
+	}
+	if m.clearedparent {
+		edges = append(edges, folder.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, folder.EdgeChildren)
+	}
+	if m.clearednotes {
+		edges = append(edges, folder.EdgeNotes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FolderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case folder.EdgeUser:
+		return m.cleareduser
+	case folder.EdgeParent:
+		return m.clearedparent
+	case folder.EdgeChildren:
+		return m.clearedchildren
+	case folder.EdgeNotes:
+		return m.clearednotes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FolderMutation) ClearEdge(name string) error {
+	switch name {
+	case folder.EdgeUser:
+		m.ClearUser()
+		return nil
+	case folder.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FolderMutation) ResetEdge(name string) error {
+	switch name {
+	case folder.EdgeUser:
+		m.ResetUser()
+		return nil
+	case folder.EdgeParent:
+		m.ResetParent()
+		return nil
+	case folder.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case folder.EdgeNotes:
+		m.ResetNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder edge %s", name)
+}
+
+// FontMutation represents an operation that mutates the Font nodes in the graph.
+type FontMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	name               *string
+	display_name       *string
+	file_path          *string
+	file_size          *int64
+	addfile_size       *int64
+	format             *font.Format
+	preview_text       *string
+	is_shared          *bool
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	uploaded_by        *int
+	cleareduploaded_by bool
+	done               bool
+	oldValue           func(context.Context) (*Font, error)
+	predicates         []predicate.Font
+}
+
+var _ ent.Mutation = (*FontMutation)(nil)
+
+// fontOption allows management of the mutation configuration using functional options.
+type fontOption func(*FontMutation)
+
+// newFontMutation creates new mutation for the Font entity.
+func newFontMutation(c config, op Op, opts ...fontOption) *FontMutation {
+	m := &FontMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFont,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFontID sets the ID field of the mutation.
+func withFontID(id uuid.UUID) fontOption {
+	return func(m *FontMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Font
+		)
+		m.oldValue = func(ctx context.Context) (*Font, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Font.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFont sets the old Font of the mutation.
+func withFont(node *Font) fontOption {
+	return func(m *FontMutation) {
+		m.oldValue = func(context.Context) (*Font, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FontMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FontMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Font entities.
+func (m *FontMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FontMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FontMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Font.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *FontMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FontMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Font entity.
+// If the Font object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FontMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FontMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *FontMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *FontMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the Font entity.
+// If the Font object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FontMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *FontMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetFilePath sets the "file_path" field.
+func (m *FontMutation) SetFilePath(s string) {
+	m.file_path = &s
+}
+
+// FilePath returns the value of the "file_path" field in the mutation.
+func (m *FontMutation) FilePath() (r string, exists bool) {
+	v := m.file_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilePath returns the old "file_path" field's value of the Font entity.
+// If the Font object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FontMutation) OldFilePath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilePath: %w", err)
+	}
+	return oldValue.FilePath, nil
+}
+
+// ResetFilePath resets all changes to the "file_path" field.
+func (m *FontMutation) ResetFilePath() {
+	m.file_path = nil
+}
+
+// SetFileSize sets the "file_size" field.
+func (m *FontMutation) SetFileSize(i int64) {
+	m.file_size = &i
+	m.addfile_size = nil
+}
+
+// FileSize returns the value of the "file_size" field in the mutation.
+func (m *FontMutation) FileSize() (r int64, exists bool) {
+	v := m.file_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileSize returns the old "file_size" field's value of the Font entity.
+// If the Font object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FontMutation) OldFileSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileSize: %w", err)
+	}
+	return oldValue.FileSize, nil
+}
+
+// AddFileSize adds i to the "file_size" field.
+func (m *FontMutation) AddFileSize(i int64) {
+	if m.addfile_size != nil {
+		*m.addfile_size += i
+	} else {
+		m.addfile_size = &i
+	}
+}
+
+// AddedFileSize returns the value that was added to the "file_size" field in this mutation.
+func (m *FontMutation) AddedFileSize() (r int64, exists bool) {
+	v := m.addfile_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFileSize resets all changes to the "file_size" field.
+func (m *FontMutation) ResetFileSize() {
+	m.file_size = nil
+	m.addfile_size = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *FontMutation) SetFormat(f font.Format) {
+	m.format = &f
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *FontMutation) Format() (r font.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the Font entity.
+// If the Font object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FontMutation) OldFormat(ctx context.Context) (v font.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *FontMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetPreviewText sets the "preview_text" field.
+func (m *FontMutation) SetPreviewText(s string) {
+	m.preview_text = &s
+}
+
+// PreviewText returns the value of the "preview_text" field in the mutation.
+func (m *FontMutation) PreviewText() (r string, exists bool) {
+	v := m.preview_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviewText returns the old "preview_text" field's value of the Font entity.
+// If the Font object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FontMutation) OldPreviewText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviewText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviewText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviewText: %w", err)
+	}
+	return oldValue.PreviewText, nil
+}
+
+// ResetPreviewText resets all changes to the "preview_text" field.
+func (m *FontMutation) ResetPreviewText() {
+	m.preview_text = nil
+}
+
+// SetIsShared sets the "is_shared" field.
+func (m *FontMutation) SetIsShared(b bool) {
+	m.is_shared = &b
+}
+
+// IsShared returns the value of the "is_shared" field in the mutation.
+func (m *FontMutation) IsShared() (r bool, exists bool) {
+	v := m.is_shared
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsShared returns the old "is_shared" field's value of the Font entity.
+// If the Font object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FontMutation) OldIsShared(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsShared is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsShared requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsShared: %w", err)
+	}
+	return oldValue.IsShared, nil
+}
+
+// ResetIsShared resets all changes to the "is_shared" field.
+func (m *FontMutation) ResetIsShared() {
+	m.is_shared = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FontMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FontMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Font entity.
+// If the Font object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FontMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FontMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUploadedByID sets the "uploaded_by" edge to the User entity by id.
+func (m *FontMutation) SetUploadedByID(id int) {
+	m.uploaded_by = &id
+}
+
+// ClearUploadedBy clears the "uploaded_by" edge to the User entity.
+func (m *FontMutation) ClearUploadedBy() {
+	m.cleareduploaded_by = true
+}
+
+// UploadedByCleared reports if the "uploaded_by" edge to the User entity was cleared.
+func (m *FontMutation) UploadedByCleared() bool {
+	return m.cleareduploaded_by
+}
+
+// UploadedByID returns the "uploaded_by" edge ID in the mutation.
+func (m *FontMutation) UploadedByID() (id int, exists bool) {
+	if m.uploaded_by != nil {
+		return *m.uploaded_by, true
+	}
+	return
+}
+
+// UploadedByIDs returns the "uploaded_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UploadedByID instead. It exists only for internal usage by the builders.
+func (m *FontMutation) UploadedByIDs() (ids []int) {
+	if id := m.uploaded_by; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUploadedBy resets all changes to the "uploaded_by" edge.
+func (m *FontMutation) ResetUploadedBy() {
+	m.uploaded_by = nil
+	m.cleareduploaded_by = false
+}
+
+// Where appends a list predicates to the FontMutation builder.
+func (m *FontMutation) Where(ps ...predicate.Font) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FontMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FontMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Font, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FontMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FontMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Font).
+func (m *FontMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FontMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, font.FieldName)
+	}
+	if m.display_name != nil {
+		fields = append(fields, font.FieldDisplayName)
+	}
+	if m.file_path != nil {
+		fields = append(fields, font.FieldFilePath)
+	}
+	if m.file_size != nil {
+		fields = append(fields, font.FieldFileSize)
+	}
+	if m.format != nil {
+		fields = append(fields, font.FieldFormat)
+	}
+	if m.preview_text != nil {
+		fields = append(fields, font.FieldPreviewText)
+	}
+	if m.is_shared != nil {
+		fields = append(fields, font.FieldIsShared)
+	}
+	if m.created_at != nil {
+		fields = append(fields, font.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FontMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case font.FieldName:
+		return m.Name()
+	case font.FieldDisplayName:
+		return m.DisplayName()
+	case font.FieldFilePath:
+		return m.FilePath()
+	case font.FieldFileSize:
+		return m.FileSize()
+	case font.FieldFormat:
+		return m.Format()
+	case font.FieldPreviewText:
+		return m.PreviewText()
+	case font.FieldIsShared:
+		return m.IsShared()
+	case font.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FontMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case font.FieldName:
+		return m.OldName(ctx)
+	case font.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case font.FieldFilePath:
+		return m.OldFilePath(ctx)
+	case font.FieldFileSize:
+		return m.OldFileSize(ctx)
+	case font.FieldFormat:
+		return m.OldFormat(ctx)
+	case font.FieldPreviewText:
+		return m.OldPreviewText(ctx)
+	case font.FieldIsShared:
+		return m.OldIsShared(ctx)
+	case font.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Font field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FontMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case font.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case font.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case font.FieldFilePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilePath(v)
+		return nil
+	case font.FieldFileSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileSize(v)
+		return nil
+	case font.FieldFormat:
+		v, ok := value.(font.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case font.FieldPreviewText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviewText(v)
+		return nil
+	case font.FieldIsShared:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsShared(v)
+		return nil
+	case font.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Font field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FontMutation) AddedFields() []string {
+	var fields []string
+	if m.addfile_size != nil {
+		fields = append(fields, font.FieldFileSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FontMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case font.FieldFileSize:
+		return m.AddedFileSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FontMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case font.FieldFileSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFileSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Font numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FontMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FontMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FontMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Font nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FontMutation) ResetField(name string) error {
+	switch name {
+	case font.FieldName:
+		m.ResetName()
+		return nil
+	case font.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case font.FieldFilePath:
+		m.ResetFilePath()
+		return nil
+	case font.FieldFileSize:
+		m.ResetFileSize()
+		return nil
+	case font.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case font.FieldPreviewText:
+		m.ResetPreviewText()
+		return nil
+	case font.FieldIsShared:
+		m.ResetIsShared()
+		return nil
+	case font.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Font field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FontMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.uploaded_by != nil {
+		edges = append(edges, font.EdgeUploadedBy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FontMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case font.EdgeUploadedBy:
+		if id := m.uploaded_by; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FontMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FontMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FontMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduploaded_by {
+		edges = append(edges, font.EdgeUploadedBy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FontMutation) EdgeCleared(name string) bool {
+	switch name {
+	case font.EdgeUploadedBy:
+		return m.cleareduploaded_by
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FontMutation) ClearEdge(name string) error {
+	switch name {
+	case font.EdgeUploadedBy:
+		m.ClearUploadedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Font unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FontMutation) ResetEdge(name string) error {
+	switch name {
+	case font.EdgeUploadedBy:
+		m.ResetUploadedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Font edge %s", name)
+}
+
+// ImportItemMutation represents an operation that mutates the ImportItem nodes in the graph.
+type ImportItemMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	source_note_key *string
+	note_id         *uuid.UUID
+	title           *string
+	status          *string
+	message         *string
+	clearedFields   map[string]struct{}
+	job             *int
+	clearedjob      bool
+	done            bool
+	oldValue        func(context.Context) (*ImportItem, error)
+	predicates      []predicate.ImportItem
+}
+
+var _ ent.Mutation = (*ImportItemMutation)(nil)
+
+// importitemOption allows management of the mutation configuration using functional options.
+type importitemOption func(*ImportItemMutation)
+
+// newImportItemMutation creates new mutation for the ImportItem entity.
+func newImportItemMutation(c config, op Op, opts ...importitemOption) *ImportItemMutation {
+	m := &ImportItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeImportItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withImportItemID sets the ID field of the mutation.
+func withImportItemID(id int) importitemOption {
+	return func(m *ImportItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ImportItem
+		)
+		m.oldValue = func(ctx context.Context) (*ImportItem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ImportItem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withImportItem sets the old ImportItem of the mutation.
+func withImportItem(node *ImportItem) importitemOption {
+	return func(m *ImportItemMutation) {
+		m.oldValue = func(context.Context) (*ImportItem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ImportItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ImportItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ImportItemMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ImportItemMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ImportItem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSourceNoteKey sets the "source_note_key" field.
+func (m *ImportItemMutation) SetSourceNoteKey(s string) {
+	m.source_note_key = &s
+}
+
+// SourceNoteKey returns the value of the "source_note_key" field in the mutation.
+func (m *ImportItemMutation) SourceNoteKey() (r string, exists bool) {
+	v := m.source_note_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceNoteKey returns the old "source_note_key" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportItemMutation) OldSourceNoteKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceNoteKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceNoteKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceNoteKey: %w", err)
+	}
+	return oldValue.SourceNoteKey, nil
+}
+
+// ResetSourceNoteKey resets all changes to the "source_note_key" field.
+func (m *ImportItemMutation) ResetSourceNoteKey() {
+	m.source_note_key = nil
+}
+
+// SetNoteID sets the "note_id" field.
+func (m *ImportItemMutation) SetNoteID(u uuid.UUID) {
+	m.note_id = &u
+}
+
+// NoteID returns the value of the "note_id" field in the mutation.
+func (m *ImportItemMutation) NoteID() (r uuid.UUID, exists bool) {
+	v := m.note_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNoteID returns the old "note_id" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportItemMutation) OldNoteID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNoteID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNoteID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNoteID: %w", err)
+	}
+	return oldValue.NoteID, nil
+}
+
+// ClearNoteID clears the value of the "note_id" field.
+func (m *ImportItemMutation) ClearNoteID() {
+	m.note_id = nil
+	m.clearedFields[importitem.FieldNoteID] = struct{}{}
+}
+
+// NoteIDCleared returns if the "note_id" field was cleared in this mutation.
+func (m *ImportItemMutation) NoteIDCleared() bool {
+	_, ok := m.clearedFields[importitem.FieldNoteID]
+	return ok
+}
+
+// ResetNoteID resets all changes to the "note_id" field.
+func (m *ImportItemMutation) ResetNoteID() {
+	m.note_id = nil
+	delete(m.clearedFields, importitem.FieldNoteID)
+}
+
+// SetTitle sets the "title" field.
+func (m *ImportItemMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ImportItemMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportItemMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ImportItemMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ImportItemMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ImportItemMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportItemMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ImportItemMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *ImportItemMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *ImportItemMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportItemMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *ImportItemMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[importitem.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *ImportItemMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[importitem.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *ImportItemMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, importitem.FieldMessage)
+}
+
+// SetJobID sets the "job" edge to the ImportJob entity by id.
+func (m *ImportItemMutation) SetJobID(id int) {
+	m.job = &id
+}
+
+// ClearJob clears the "job" edge to the ImportJob entity.
+func (m *ImportItemMutation) ClearJob() {
+	m.clearedjob = true
+}
+
+// JobCleared reports if the "job" edge to the ImportJob entity was cleared.
+func (m *ImportItemMutation) JobCleared() bool {
+	return m.clearedjob
+}
+
+// JobID returns the "job" edge ID in the mutation.
+func (m *ImportItemMutation) JobID() (id int, exists bool) {
+	if m.job != nil {
+		return *m.job, true
+	}
+	return
+}
+
+// JobIDs returns the "job" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// JobID instead. It exists only for internal usage by the builders.
+func (m *ImportItemMutation) JobIDs() (ids []int) {
+	if id := m.job; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetJob resets all changes to the "job" edge.
+func (m *ImportItemMutation) ResetJob() {
+	m.job = nil
+	m.clearedjob = false
+}
+
+// Where appends a list predicates to the ImportItemMutation builder.
+func (m *ImportItemMutation) Where(ps ...predicate.ImportItem) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ImportItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ImportItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ImportItem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ImportItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ImportItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ImportItem).
+func (m *ImportItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ImportItemMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.source_note_key != nil {
+		fields = append(fields, importitem.FieldSourceNoteKey)
+	}
+	if m.note_id != nil {
+		fields = append(fields, importitem.FieldNoteID)
+	}
+	if m.title != nil {
+		fields = append(fields, importitem.FieldTitle)
+	}
+	if m.status != nil {
+		fields = append(fields, importitem.FieldStatus)
+	}
+	if m.message != nil {
+		fields = append(fields, importitem.FieldMessage)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ImportItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case importitem.FieldSourceNoteKey:
+		return m.SourceNoteKey()
+	case importitem.FieldNoteID:
+		return m.NoteID()
+	case importitem.FieldTitle:
+		return m.Title()
+	case importitem.FieldStatus:
+		return m.Status()
+	case importitem.FieldMessage:
+		return m.Message()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ImportItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case importitem.FieldSourceNoteKey:
+		return m.OldSourceNoteKey(ctx)
+	case importitem.FieldNoteID:
+		return m.OldNoteID(ctx)
+	case importitem.FieldTitle:
+		return m.OldTitle(ctx)
+	case importitem.FieldStatus:
+		return m.OldStatus(ctx)
+	case importitem.FieldMessage:
+		return m.OldMessage(ctx)
+	}
+	return nil, fmt.Errorf("unknown ImportItem field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case importitem.FieldSourceNoteKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceNoteKey(v)
+		return nil
+	case importitem.FieldNoteID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNoteID(v)
+		return nil
+	case importitem.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case importitem.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case importitem.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportItem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ImportItemMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ImportItemMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ImportItem numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ImportItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(importitem.FieldNoteID) {
+		fields = append(fields, importitem.FieldNoteID)
+	}
+	if m.FieldCleared(importitem.FieldMessage) {
+		fields = append(fields, importitem.FieldMessage)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ImportItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ImportItemMutation) ClearField(name string) error {
+	switch name {
+	case importitem.FieldNoteID:
+		m.ClearNoteID()
+		return nil
+	case importitem.FieldMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportItem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ImportItemMutation) ResetField(name string) error {
+	switch name {
+	case importitem.FieldSourceNoteKey:
+		m.ResetSourceNoteKey()
+		return nil
+	case importitem.FieldNoteID:
+		m.ResetNoteID()
+		return nil
+	case importitem.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case importitem.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case importitem.FieldMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportItem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ImportItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.job != nil {
+		edges = append(edges, importitem.EdgeJob)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ImportItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case importitem.EdgeJob:
+		if id := m.job; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ImportItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ImportItemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ImportItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedjob {
+		edges = append(edges, importitem.EdgeJob)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ImportItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case importitem.EdgeJob:
+		return m.clearedjob
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ImportItemMutation) ClearEdge(name string) error {
+	switch name {
+	case importitem.EdgeJob:
+		m.ClearJob()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportItem unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ImportItemMutation) ResetEdge(name string) error {
+	switch name {
+	case importitem.EdgeJob:
+		m.ResetJob()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportItem edge %s", name)
+}
+
+// ImportJobMutation represents an operation that mutates the ImportJob nodes in the graph.
+type ImportJobMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	source            *string
+	filename          *string
+	status            *string
+	note_count        *int
+	addnote_count     *int
+	imported_count    *int
+	addimported_count *int
+	skipped_count     *int
+	addskipped_count  *int
+	failed_count      *int
+	addfailed_count   *int
+	options_json      *string
+	created_at        *time.Time
+	completed_at      *time.Time
+	clearedFields     map[string]struct{}
+	user              *int
+	cleareduser       bool
+	items             map[int]struct{}
+	removeditems      map[int]struct{}
+	cleareditems      bool
+	done              bool
+	oldValue          func(context.Context) (*ImportJob, error)
+	predicates        []predicate.ImportJob
+}
+
+var _ ent.Mutation = (*ImportJobMutation)(nil)
+
+// importjobOption allows management of the mutation configuration using functional options.
+type importjobOption func(*ImportJobMutation)
+
+// newImportJobMutation creates new mutation for the ImportJob entity.
+func newImportJobMutation(c config, op Op, opts ...importjobOption) *ImportJobMutation {
+	m := &ImportJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeImportJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withImportJobID sets the ID field of the mutation.
+func withImportJobID(id int) importjobOption {
+	return func(m *ImportJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ImportJob
+		)
+		m.oldValue = func(ctx context.Context) (*ImportJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ImportJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withImportJob sets the old ImportJob of the mutation.
+func withImportJob(node *ImportJob) importjobOption {
+	return func(m *ImportJobMutation) {
+		m.oldValue = func(context.Context) (*ImportJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ImportJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ImportJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ImportJobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ImportJobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ImportJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSource sets the "source" field.
+func (m *ImportJobMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *ImportJobMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *ImportJobMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetFilename sets the "filename" field.
+func (m *ImportJobMutation) SetFilename(s string) {
+	m.filename = &s
+}
+
+// Filename returns the value of the "filename" field in the mutation.
+func (m *ImportJobMutation) Filename() (r string, exists bool) {
+	v := m.filename
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilename returns the old "filename" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldFilename(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilename requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+	}
+	return oldValue.Filename, nil
+}
+
+// ResetFilename resets all changes to the "filename" field.
+func (m *ImportJobMutation) ResetFilename() {
+	m.filename = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ImportJobMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ImportJobMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ImportJobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetNoteCount sets the "note_count" field.
+func (m *ImportJobMutation) SetNoteCount(i int) {
+	m.note_count = &i
+	m.addnote_count = nil
+}
+
+// NoteCount returns the value of the "note_count" field in the mutation.
+func (m *ImportJobMutation) NoteCount() (r int, exists bool) {
+	v := m.note_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNoteCount returns the old "note_count" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldNoteCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNoteCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNoteCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNoteCount: %w", err)
+	}
+	return oldValue.NoteCount, nil
+}
+
+// AddNoteCount adds i to the "note_count" field.
+func (m *ImportJobMutation) AddNoteCount(i int) {
+	if m.addnote_count != nil {
+		*m.addnote_count += i
+	} else {
+		m.addnote_count = &i
+	}
+}
+
+// AddedNoteCount returns the value that was added to the "note_count" field in this mutation.
+func (m *ImportJobMutation) AddedNoteCount() (r int, exists bool) {
+	v := m.addnote_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNoteCount resets all changes to the "note_count" field.
+func (m *ImportJobMutation) ResetNoteCount() {
+	m.note_count = nil
+	m.addnote_count = nil
+}
+
+// SetImportedCount sets the "imported_count" field.
+func (m *ImportJobMutation) SetImportedCount(i int) {
+	m.imported_count = &i
+	m.addimported_count = nil
+}
+
+// ImportedCount returns the value of the "imported_count" field in the mutation.
+func (m *ImportJobMutation) ImportedCount() (r int, exists bool) {
+	v := m.imported_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImportedCount returns the old "imported_count" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldImportedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImportedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImportedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImportedCount: %w", err)
+	}
+	return oldValue.ImportedCount, nil
+}
+
+// AddImportedCount adds i to the "imported_count" field.
+func (m *ImportJobMutation) AddImportedCount(i int) {
+	if m.addimported_count != nil {
+		*m.addimported_count += i
+	} else {
+		m.addimported_count = &i
+	}
+}
+
+// AddedImportedCount returns the value that was added to the "imported_count" field in this mutation.
+func (m *ImportJobMutation) AddedImportedCount() (r int, exists bool) {
+	v := m.addimported_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetImportedCount resets all changes to the "imported_count" field.
+func (m *ImportJobMutation) ResetImportedCount() {
+	m.imported_count = nil
+	m.addimported_count = nil
+}
+
+// SetSkippedCount sets the "skipped_count" field.
+func (m *ImportJobMutation) SetSkippedCount(i int) {
+	m.skipped_count = &i
+	m.addskipped_count = nil
+}
+
+// SkippedCount returns the value of the "skipped_count" field in the mutation.
+func (m *ImportJobMutation) SkippedCount() (r int, exists bool) {
+	v := m.skipped_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkippedCount returns the old "skipped_count" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldSkippedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkippedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkippedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkippedCount: %w", err)
+	}
+	return oldValue.SkippedCount, nil
+}
+
+// AddSkippedCount adds i to the "skipped_count" field.
+func (m *ImportJobMutation) AddSkippedCount(i int) {
+	if m.addskipped_count != nil {
+		*m.addskipped_count += i
+	} else {
+		m.addskipped_count = &i
+	}
+}
+
+// AddedSkippedCount returns the value that was added to the "skipped_count" field in this mutation.
+func (m *ImportJobMutation) AddedSkippedCount() (r int, exists bool) {
+	v := m.addskipped_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSkippedCount resets all changes to the "skipped_count" field.
+func (m *ImportJobMutation) ResetSkippedCount() {
+	m.skipped_count = nil
+	m.addskipped_count = nil
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (m *ImportJobMutation) SetFailedCount(i int) {
+	m.failed_count = &i
+	m.addfailed_count = nil
+}
+
+// FailedCount returns the value of the "failed_count" field in the mutation.
+func (m *ImportJobMutation) FailedCount() (r int, exists bool) {
+	v := m.failed_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedCount returns the old "failed_count" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldFailedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedCount: %w", err)
+	}
+	return oldValue.FailedCount, nil
+}
+
+// AddFailedCount adds i to the "failed_count" field.
+func (m *ImportJobMutation) AddFailedCount(i int) {
+	if m.addfailed_count != nil {
+		*m.addfailed_count += i
+	} else {
+		m.addfailed_count = &i
+	}
+}
+
+// AddedFailedCount returns the value that was added to the "failed_count" field in this mutation.
+func (m *ImportJobMutation) AddedFailedCount() (r int, exists bool) {
+	v := m.addfailed_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailedCount resets all changes to the "failed_count" field.
+func (m *ImportJobMutation) ResetFailedCount() {
+	m.failed_count = nil
+	m.addfailed_count = nil
+}
+
+// SetOptionsJSON sets the "options_json" field.
+func (m *ImportJobMutation) SetOptionsJSON(s string) {
+	m.options_json = &s
+}
+
+// OptionsJSON returns the value of the "options_json" field in the mutation.
+func (m *ImportJobMutation) OptionsJSON() (r string, exists bool) {
+	v := m.options_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptionsJSON returns the old "options_json" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldOptionsJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptionsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptionsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptionsJSON: %w", err)
+	}
+	return oldValue.OptionsJSON, nil
+}
+
+// ClearOptionsJSON clears the value of the "options_json" field.
+func (m *ImportJobMutation) ClearOptionsJSON() {
+	m.options_json = nil
+	m.clearedFields[importjob.FieldOptionsJSON] = struct{}{}
+}
+
+// OptionsJSONCleared returns if the "options_json" field was cleared in this mutation.
+func (m *ImportJobMutation) OptionsJSONCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldOptionsJSON]
+	return ok
+}
+
+// ResetOptionsJSON resets all changes to the "options_json" field.
+func (m *ImportJobMutation) ResetOptionsJSON() {
+	m.options_json = nil
+	delete(m.clearedFields, importjob.FieldOptionsJSON)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ImportJobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ImportJobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ImportJobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *ImportJobMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *ImportJobMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldCompletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *ImportJobMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[importjob.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *ImportJobMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *ImportJobMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, importjob.FieldCompletedAt)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ImportJobMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ImportJobMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ImportJobMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *ImportJobMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ImportJobMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ImportJobMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddItemIDs adds the "items" edge to the ImportItem entity by ids.
+func (m *ImportJobMutation) AddItemIDs(ids ...int) {
+	if m.items == nil {
+		m.items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the ImportItem entity.
+func (m *ImportJobMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the ImportItem entity was cleared.
+func (m *ImportJobMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the ImportItem entity by IDs.
+func (m *ImportJobMutation) RemoveItemIDs(ids ...int) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the ImportItem entity.
+func (m *ImportJobMutation) RemovedItemsIDs() (ids []int) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *ImportJobMutation) ItemsIDs() (ids []int) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *ImportJobMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// Where appends a list predicates to the ImportJobMutation builder.
+func (m *ImportJobMutation) Where(ps ...predicate.ImportJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ImportJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ImportJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ImportJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ImportJobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ImportJobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ImportJob).
+func (m *ImportJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ImportJobMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.source != nil {
+		fields = append(fields, importjob.FieldSource)
+	}
+	if m.filename != nil {
+		fields = append(fields, importjob.FieldFilename)
+	}
+	if m.status != nil {
+		fields = append(fields, importjob.FieldStatus)
+	}
+	if m.note_count != nil {
+		fields = append(fields, importjob.FieldNoteCount)
+	}
+	if m.imported_count != nil {
+		fields = append(fields, importjob.FieldImportedCount)
+	}
+	if m.skipped_count != nil {
+		fields = append(fields, importjob.FieldSkippedCount)
+	}
+	if m.failed_count != nil {
+		fields = append(fields, importjob.FieldFailedCount)
+	}
+	if m.options_json != nil {
+		fields = append(fields, importjob.FieldOptionsJSON)
+	}
+	if m.created_at != nil {
+		fields = append(fields, importjob.FieldCreatedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, importjob.FieldCompletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ImportJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case importjob.FieldSource:
+		return m.Source()
+	case importjob.FieldFilename:
+		return m.Filename()
+	case importjob.FieldStatus:
+		return m.Status()
+	case importjob.FieldNoteCount:
+		return m.NoteCount()
+	case importjob.FieldImportedCount:
+		return m.ImportedCount()
+	case importjob.FieldSkippedCount:
+		return m.SkippedCount()
+	case importjob.FieldFailedCount:
+		return m.FailedCount()
+	case importjob.FieldOptionsJSON:
+		return m.OptionsJSON()
+	case importjob.FieldCreatedAt:
+		return m.CreatedAt()
+	case importjob.FieldCompletedAt:
+		return m.CompletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ImportJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case importjob.FieldSource:
+		return m.OldSource(ctx)
+	case importjob.FieldFilename:
+		return m.OldFilename(ctx)
+	case importjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case importjob.FieldNoteCount:
+		return m.OldNoteCount(ctx)
+	case importjob.FieldImportedCount:
+		return m.OldImportedCount(ctx)
+	case importjob.FieldSkippedCount:
+		return m.OldSkippedCount(ctx)
+	case importjob.FieldFailedCount:
+		return m.OldFailedCount(ctx)
+	case importjob.FieldOptionsJSON:
+		return m.OldOptionsJSON(ctx)
+	case importjob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case importjob.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ImportJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case importjob.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case importjob.FieldFilename:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilename(v)
+		return nil
+	case importjob.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case importjob.FieldNoteCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNoteCount(v)
+		return nil
+	case importjob.FieldImportedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImportedCount(v)
+		return nil
+	case importjob.FieldSkippedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkippedCount(v)
+		return nil
+	case importjob.FieldFailedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedCount(v)
+		return nil
+	case importjob.FieldOptionsJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptionsJSON(v)
+		return nil
+	case importjob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case importjob.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ImportJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addnote_count != nil {
+		fields = append(fields, importjob.FieldNoteCount)
+	}
+	if m.addimported_count != nil {
+		fields = append(fields, importjob.FieldImportedCount)
+	}
+	if m.addskipped_count != nil {
+		fields = append(fields, importjob.FieldSkippedCount)
+	}
+	if m.addfailed_count != nil {
+		fields = append(fields, importjob.FieldFailedCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ImportJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case importjob.FieldNoteCount:
+		return m.AddedNoteCount()
+	case importjob.FieldImportedCount:
+		return m.AddedImportedCount()
+	case importjob.FieldSkippedCount:
+		return m.AddedSkippedCount()
+	case importjob.FieldFailedCount:
+		return m.AddedFailedCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case importjob.FieldNoteCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNoteCount(v)
+		return nil
+	case importjob.FieldImportedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddImportedCount(v)
+		return nil
+	case importjob.FieldSkippedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSkippedCount(v)
+		return nil
+	case importjob.FieldFailedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailedCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ImportJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(importjob.FieldOptionsJSON) {
+		fields = append(fields, importjob.FieldOptionsJSON)
+	}
+	if m.FieldCleared(importjob.FieldCompletedAt) {
+		fields = append(fields, importjob.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ImportJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ImportJobMutation) ClearField(name string) error {
+	switch name {
+	case importjob.FieldOptionsJSON:
+		m.ClearOptionsJSON()
+		return nil
+	case importjob.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ImportJobMutation) ResetField(name string) error {
+	switch name {
+	case importjob.FieldSource:
+		m.ResetSource()
+		return nil
+	case importjob.FieldFilename:
+		m.ResetFilename()
+		return nil
+	case importjob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case importjob.FieldNoteCount:
+		m.ResetNoteCount()
+		return nil
+	case importjob.FieldImportedCount:
+		m.ResetImportedCount()
+		return nil
+	case importjob.FieldSkippedCount:
+		m.ResetSkippedCount()
+		return nil
+	case importjob.FieldFailedCount:
+		m.ResetFailedCount()
+		return nil
+	case importjob.FieldOptionsJSON:
+		m.ResetOptionsJSON()
+		return nil
+	case importjob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case importjob.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ImportJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, importjob.EdgeUser)
+	}
+	if m.items != nil {
+		edges = append(edges, importjob.EdgeItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ImportJobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case importjob.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case importjob.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ImportJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeditems != nil {
+		edges = append(edges, importjob.EdgeItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ImportJobMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case importjob.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ImportJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, importjob.EdgeUser)
+	}
+	if m.cleareditems {
+		edges = append(edges, importjob.EdgeItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ImportJobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case importjob.EdgeUser:
+		return m.cleareduser
+	case importjob.EdgeItems:
+		return m.cleareditems
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ImportJobMutation) ClearEdge(name string) error {
+	switch name {
+	case importjob.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ImportJobMutation) ResetEdge(name string) error {
+	switch name {
+	case importjob.EdgeUser:
+		m.ResetUser()
+		return nil
+	case importjob.EdgeItems:
+		m.ResetItems()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob edge %s", name)
+}
+
+// LinkRewriteMutation represents an operation that mutates the LinkRewrite nodes in the graph.
+type LinkRewriteMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	note_id            *uuid.UUID
+	old_title          *string
+	new_title          *string
+	status             *linkrewrite.Status
+	rewritten_count    *int
+	addrewritten_count *int
+	changes_json       *string
+	created_at         *time.Time
+	undone_at          *time.Time
+	clearedFields      map[string]struct{}
+	user               *int
+	cleareduser        bool
+	done               bool
+	oldValue           func(context.Context) (*LinkRewrite, error)
+	predicates         []predicate.LinkRewrite
+}
+
+var _ ent.Mutation = (*LinkRewriteMutation)(nil)
+
+// linkrewriteOption allows management of the mutation configuration using functional options.
+type linkrewriteOption func(*LinkRewriteMutation)
+
+// newLinkRewriteMutation creates new mutation for the LinkRewrite entity.
+func newLinkRewriteMutation(c config, op Op, opts ...linkrewriteOption) *LinkRewriteMutation {
+	m := &LinkRewriteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLinkRewrite,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLinkRewriteID sets the ID field of the mutation.
+func withLinkRewriteID(id uuid.UUID) linkrewriteOption {
+	return func(m *LinkRewriteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LinkRewrite
+		)
+		m.oldValue = func(ctx context.Context) (*LinkRewrite, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LinkRewrite.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLinkRewrite sets the old LinkRewrite of the mutation.
+func withLinkRewrite(node *LinkRewrite) linkrewriteOption {
+	return func(m *LinkRewriteMutation) {
+		m.oldValue = func(context.Context) (*LinkRewrite, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LinkRewriteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LinkRewriteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LinkRewrite entities.
+func (m *LinkRewriteMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LinkRewriteMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LinkRewriteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LinkRewrite.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *LinkRewriteMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *LinkRewriteMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the LinkRewrite entity.
+// If the LinkRewrite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkRewriteMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *LinkRewriteMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetNoteID sets the "note_id" field.
+func (m *LinkRewriteMutation) SetNoteID(u uuid.UUID) {
+	m.note_id = &u
+}
+
+// NoteID returns the value of the "note_id" field in the mutation.
+func (m *LinkRewriteMutation) NoteID() (r uuid.UUID, exists bool) {
+	v := m.note_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNoteID returns the old "note_id" field's value of the LinkRewrite entity.
+// If the LinkRewrite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkRewriteMutation) OldNoteID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNoteID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNoteID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNoteID: %w", err)
+	}
+	return oldValue.NoteID, nil
+}
+
+// ResetNoteID resets all changes to the "note_id" field.
+func (m *LinkRewriteMutation) ResetNoteID() {
+	m.note_id = nil
+}
+
+// SetOldTitle sets the "old_title" field.
+func (m *LinkRewriteMutation) SetOldTitle(s string) {
+	m.old_title = &s
+}
+
+// OldTitle returns the value of the "old_title" field in the mutation.
+func (m *LinkRewriteMutation) OldTitle() (r string, exists bool) {
+	v := m.old_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOldTitle returns the old "old_title" field's value of the LinkRewrite entity.
+// If the LinkRewrite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkRewriteMutation) OldOldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOldTitle: %w", err)
+	}
+	return oldValue.OldTitle, nil
+}
+
+// ResetOldTitle resets all changes to the "old_title" field.
+func (m *LinkRewriteMutation) ResetOldTitle() {
+	m.old_title = nil
+}
+
+// SetNewTitle sets the "new_title" field.
+func (m *LinkRewriteMutation) SetNewTitle(s string) {
+	m.new_title = &s
+}
+
+// NewTitle returns the value of the "new_title" field in the mutation.
+func (m *LinkRewriteMutation) NewTitle() (r string, exists bool) {
+	v := m.new_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewTitle returns the old "new_title" field's value of the LinkRewrite entity.
+// If the LinkRewrite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkRewriteMutation) OldNewTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewTitle: %w", err)
+	}
+	return oldValue.NewTitle, nil
+}
+
+// ResetNewTitle resets all changes to the "new_title" field.
+func (m *LinkRewriteMutation) ResetNewTitle() {
+	m.new_title = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *LinkRewriteMutation) SetStatus(l linkrewrite.Status) {
+	m.status = &l
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *LinkRewriteMutation) Status() (r linkrewrite.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the LinkRewrite entity.
+// If the LinkRewrite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkRewriteMutation) OldStatus(ctx context.Context) (v linkrewrite.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *LinkRewriteMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRewrittenCount sets the "rewritten_count" field.
+func (m *LinkRewriteMutation) SetRewrittenCount(i int) {
+	m.rewritten_count = &i
+	m.addrewritten_count = nil
+}
+
+// RewrittenCount returns the value of the "rewritten_count" field in the mutation.
+func (m *LinkRewriteMutation) RewrittenCount() (r int, exists bool) {
+	v := m.rewritten_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRewrittenCount returns the old "rewritten_count" field's value of the LinkRewrite entity.
+// If the LinkRewrite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkRewriteMutation) OldRewrittenCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRewrittenCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRewrittenCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRewrittenCount: %w", err)
+	}
+	return oldValue.RewrittenCount, nil
+}
+
+// AddRewrittenCount adds i to the "rewritten_count" field.
+func (m *LinkRewriteMutation) AddRewrittenCount(i int) {
+	if m.addrewritten_count != nil {
+		*m.addrewritten_count += i
+	} else {
+		m.addrewritten_count = &i
+	}
+}
+
+// AddedRewrittenCount returns the value that was added to the "rewritten_count" field in this mutation.
+func (m *LinkRewriteMutation) AddedRewrittenCount() (r int, exists bool) {
+	v := m.addrewritten_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRewrittenCount resets all changes to the "rewritten_count" field.
+func (m *LinkRewriteMutation) ResetRewrittenCount() {
+	m.rewritten_count = nil
+	m.addrewritten_count = nil
+}
+
+// SetChangesJSON sets the "changes_json" field.
+func (m *LinkRewriteMutation) SetChangesJSON(s string) {
+	m.changes_json = &s
+}
+
+// ChangesJSON returns the value of the "changes_json" field in the mutation.
+func (m *LinkRewriteMutation) ChangesJSON() (r string, exists bool) {
+	v := m.changes_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangesJSON returns the old "changes_json" field's value of the LinkRewrite entity.
+// If the LinkRewrite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkRewriteMutation) OldChangesJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangesJSON: %w", err)
+	}
+	return oldValue.ChangesJSON, nil
+}
+
+// ResetChangesJSON resets all changes to the "changes_json" field.
+func (m *LinkRewriteMutation) ResetChangesJSON() {
+	m.changes_json = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LinkRewriteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LinkRewriteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LinkRewrite entity.
+// If the LinkRewrite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkRewriteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LinkRewriteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUndoneAt sets the "undone_at" field.
+func (m *LinkRewriteMutation) SetUndoneAt(t time.Time) {
+	m.undone_at = &t
+}
+
+// UndoneAt returns the value of the "undone_at" field in the mutation.
+func (m *LinkRewriteMutation) UndoneAt() (r time.Time, exists bool) {
+	v := m.undone_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUndoneAt returns the old "undone_at" field's value of the LinkRewrite entity.
+// If the LinkRewrite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkRewriteMutation) OldUndoneAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUndoneAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUndoneAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUndoneAt: %w", err)
+	}
+	return oldValue.UndoneAt, nil
+}
+
+// ClearUndoneAt clears the value of the "undone_at" field.
+func (m *LinkRewriteMutation) ClearUndoneAt() {
+	m.undone_at = nil
+	m.clearedFields[linkrewrite.FieldUndoneAt] = struct{}{}
+}
+
+// UndoneAtCleared returns if the "undone_at" field was cleared in this mutation.
+func (m *LinkRewriteMutation) UndoneAtCleared() bool {
+	_, ok := m.clearedFields[linkrewrite.FieldUndoneAt]
+	return ok
+}
+
+// ResetUndoneAt resets all changes to the "undone_at" field.
+func (m *LinkRewriteMutation) ResetUndoneAt() {
+	m.undone_at = nil
+	delete(m.clearedFields, linkrewrite.FieldUndoneAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *LinkRewriteMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[linkrewrite.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *LinkRewriteMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *LinkRewriteMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *LinkRewriteMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the LinkRewriteMutation builder.
+func (m *LinkRewriteMutation) Where(ps ...predicate.LinkRewrite) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LinkRewriteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LinkRewriteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LinkRewrite, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LinkRewriteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LinkRewriteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LinkRewrite).
+func (m *LinkRewriteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LinkRewriteMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.user != nil {
+		fields = append(fields, linkrewrite.FieldUserID)
+	}
+	if m.note_id != nil {
+		fields = append(fields, linkrewrite.FieldNoteID)
+	}
+	if m.old_title != nil {
+		fields = append(fields, linkrewrite.FieldOldTitle)
+	}
+	if m.new_title != nil {
+		fields = append(fields, linkrewrite.FieldNewTitle)
+	}
+	if m.status != nil {
+		fields = append(fields, linkrewrite.FieldStatus)
+	}
+	if m.rewritten_count != nil {
+		fields = append(fields, linkrewrite.FieldRewrittenCount)
+	}
+	if m.changes_json != nil {
+		fields = append(fields, linkrewrite.FieldChangesJSON)
+	}
+	if m.created_at != nil {
+		fields = append(fields, linkrewrite.FieldCreatedAt)
+	}
+	if m.undone_at != nil {
+		fields = append(fields, linkrewrite.FieldUndoneAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LinkRewriteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case linkrewrite.FieldUserID:
+		return m.UserID()
+	case linkrewrite.FieldNoteID:
+		return m.NoteID()
+	case linkrewrite.FieldOldTitle:
+		return m.OldTitle()
+	case linkrewrite.FieldNewTitle:
+		return m.NewTitle()
+	case linkrewrite.FieldStatus:
+		return m.Status()
+	case linkrewrite.FieldRewrittenCount:
+		return m.RewrittenCount()
+	case linkrewrite.FieldChangesJSON:
+		return m.ChangesJSON()
+	case linkrewrite.FieldCreatedAt:
+		return m.CreatedAt()
+	case linkrewrite.FieldUndoneAt:
+		return m.UndoneAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LinkRewriteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case linkrewrite.FieldUserID:
+		return m.OldUserID(ctx)
+	case linkrewrite.FieldNoteID:
+		return m.OldNoteID(ctx)
+	case linkrewrite.FieldOldTitle:
+		return m.OldOldTitle(ctx)
+	case linkrewrite.FieldNewTitle:
+		return m.OldNewTitle(ctx)
+	case linkrewrite.FieldStatus:
+		return m.OldStatus(ctx)
+	case linkrewrite.FieldRewrittenCount:
+		return m.OldRewrittenCount(ctx)
+	case linkrewrite.FieldChangesJSON:
+		return m.OldChangesJSON(ctx)
+	case linkrewrite.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case linkrewrite.FieldUndoneAt:
+		return m.OldUndoneAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LinkRewrite field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkRewriteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case linkrewrite.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case linkrewrite.FieldNoteID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNoteID(v)
+		return nil
+	case linkrewrite.FieldOldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldTitle(v)
+		return nil
+	case linkrewrite.FieldNewTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewTitle(v)
+		return nil
+	case linkrewrite.FieldStatus:
+		v, ok := value.(linkrewrite.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case linkrewrite.FieldRewrittenCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRewrittenCount(v)
+		return nil
+	case linkrewrite.FieldChangesJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangesJSON(v)
+		return nil
+	case linkrewrite.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case linkrewrite.FieldUndoneAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUndoneAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LinkRewrite field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LinkRewriteMutation) AddedFields() []string {
+	var fields []string
+	if m.addrewritten_count != nil {
+		fields = append(fields, linkrewrite.FieldRewrittenCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LinkRewriteMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case linkrewrite.FieldRewrittenCount:
+		return m.AddedRewrittenCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkRewriteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case linkrewrite.FieldRewrittenCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRewrittenCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LinkRewrite numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LinkRewriteMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(linkrewrite.FieldUndoneAt) {
+		fields = append(fields, linkrewrite.FieldUndoneAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LinkRewriteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LinkRewriteMutation) ClearField(name string) error {
+	switch name {
+	case linkrewrite.FieldUndoneAt:
+		m.ClearUndoneAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkRewrite nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LinkRewriteMutation) ResetField(name string) error {
+	switch name {
+	case linkrewrite.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case linkrewrite.FieldNoteID:
+		m.ResetNoteID()
+		return nil
+	case linkrewrite.FieldOldTitle:
+		m.ResetOldTitle()
+		return nil
+	case linkrewrite.FieldNewTitle:
+		m.ResetNewTitle()
+		return nil
+	case linkrewrite.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case linkrewrite.FieldRewrittenCount:
+		m.ResetRewrittenCount()
+		return nil
+	case linkrewrite.FieldChangesJSON:
+		m.ResetChangesJSON()
+		return nil
+	case linkrewrite.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case linkrewrite.FieldUndoneAt:
+		m.ResetUndoneAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkRewrite field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LinkRewriteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, linkrewrite.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LinkRewriteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case linkrewrite.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LinkRewriteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LinkRewriteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LinkRewriteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, linkrewrite.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LinkRewriteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case linkrewrite.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LinkRewriteMutation) ClearEdge(name string) error {
+	switch name {
+	case linkrewrite.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkRewrite unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LinkRewriteMutation) ResetEdge(name string) error {
+	switch name {
+	case linkrewrite.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkRewrite edge %s", name)
+}
+
+// MCPImageMutation represents an operation that mutates the MCPImage nodes in the graph.
+type MCPImageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	filename      *string
+	_path         *string
+	content_type  *string
+	size          *int64
+	addsize       *int64
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*MCPImage, error)
+	predicates    []predicate.MCPImage
+}
+
+var _ ent.Mutation = (*MCPImageMutation)(nil)
+
+// mcpimageOption allows management of the mutation configuration using functional options.
+type mcpimageOption func(*MCPImageMutation)
+
+// newMCPImageMutation creates new mutation for the MCPImage entity.
+func newMCPImageMutation(c config, op Op, opts ...mcpimageOption) *MCPImageMutation {
+	m := &MCPImageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMCPImage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMCPImageID sets the ID field of the mutation.
+func withMCPImageID(id int) mcpimageOption {
+	return func(m *MCPImageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MCPImage
+		)
+		m.oldValue = func(ctx context.Context) (*MCPImage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MCPImage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMCPImage sets the old MCPImage of the mutation.
+func withMCPImage(node *MCPImage) mcpimageOption {
+	return func(m *MCPImageMutation) {
+		m.oldValue = func(context.Context) (*MCPImage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MCPImageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MCPImageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MCPImageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MCPImageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MCPImage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFilename sets the "filename" field.
+func (m *MCPImageMutation) SetFilename(s string) {
+	m.filename = &s
+}
+
+// Filename returns the value of the "filename" field in the mutation.
+func (m *MCPImageMutation) Filename() (r string, exists bool) {
+	v := m.filename
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilename returns the old "filename" field's value of the MCPImage entity.
+// If the MCPImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MCPImageMutation) OldFilename(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilename requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+	}
+	return oldValue.Filename, nil
+}
+
+// ResetFilename resets all changes to the "filename" field.
+func (m *MCPImageMutation) ResetFilename() {
+	m.filename = nil
+}
+
+// SetPath sets the "path" field.
+func (m *MCPImageMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *MCPImageMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the MCPImage entity.
+// If the MCPImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MCPImageMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *MCPImageMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetContentType sets the "content_type" field.
+func (m *MCPImageMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *MCPImageMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentType returns the old "content_type" field's value of the MCPImage entity.
+// If the MCPImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MCPImageMutation) OldContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
+}
+
+// ResetContentType resets all changes to the "content_type" field.
+func (m *MCPImageMutation) ResetContentType() {
+	m.content_type = nil
+}
+
+// SetSize sets the "size" field.
+func (m *MCPImageMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *MCPImageMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the MCPImage entity.
+// If the MCPImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MCPImageMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *MCPImageMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *MCPImageMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *MCPImageMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MCPImageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MCPImageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MCPImage entity.
+// If the MCPImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MCPImageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MCPImageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MCPImageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MCPImageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the MCPImage entity.
+// If the MCPImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MCPImageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MCPImageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *MCPImageMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MCPImageMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MCPImageMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *MCPImageMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MCPImageMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MCPImageMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the MCPImageMutation builder.
+func (m *MCPImageMutation) Where(ps ...predicate.MCPImage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MCPImageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MCPImageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MCPImage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MCPImageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MCPImageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MCPImage).
+func (m *MCPImageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MCPImageMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.filename != nil {
+		fields = append(fields, mcpimage.FieldFilename)
+	}
+	if m._path != nil {
+		fields = append(fields, mcpimage.FieldPath)
+	}
+	if m.content_type != nil {
+		fields = append(fields, mcpimage.FieldContentType)
+	}
+	if m.size != nil {
+		fields = append(fields, mcpimage.FieldSize)
+	}
+	if m.created_at != nil {
+		fields = append(fields, mcpimage.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, mcpimage.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MCPImageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mcpimage.FieldFilename:
+		return m.Filename()
+	case mcpimage.FieldPath:
+		return m.Path()
+	case mcpimage.FieldContentType:
+		return m.ContentType()
+	case mcpimage.FieldSize:
+		return m.Size()
+	case mcpimage.FieldCreatedAt:
+		return m.CreatedAt()
+	case mcpimage.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MCPImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mcpimage.FieldFilename:
+		return m.OldFilename(ctx)
+	case mcpimage.FieldPath:
+		return m.OldPath(ctx)
+	case mcpimage.FieldContentType:
+		return m.OldContentType(ctx)
+	case mcpimage.FieldSize:
+		return m.OldSize(ctx)
+	case mcpimage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case mcpimage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MCPImage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MCPImageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mcpimage.FieldFilename:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilename(v)
+		return nil
+	case mcpimage.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case mcpimage.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case mcpimage.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case mcpimage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case mcpimage.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MCPImage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MCPImageMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, mcpimage.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MCPImageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case mcpimage.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MCPImageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case mcpimage.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MCPImage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MCPImageMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MCPImageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MCPImageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MCPImage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MCPImageMutation) ResetField(name string) error {
+	switch name {
+	case mcpimage.FieldFilename:
+		m.ResetFilename()
+		return nil
+	case mcpimage.FieldPath:
+		m.ResetPath()
+		return nil
+	case mcpimage.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case mcpimage.FieldSize:
+		m.ResetSize()
+		return nil
+	case mcpimage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case mcpimage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MCPImage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MCPImageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, mcpimage.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MCPImageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mcpimage.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MCPImageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MCPImageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MCPImageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, mcpimage.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MCPImageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mcpimage.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MCPImageMutation) ClearEdge(name string) error {
+	switch name {
+	case mcpimage.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MCPImage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MCPImageMutation) ResetEdge(name string) error {
+	switch name {
+	case mcpimage.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MCPImage edge %s", name)
+}
+
+// MCPTokenMutation represents an operation that mutates the MCPToken nodes in the graph.
+type MCPTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	token_hash    *string
+	last_used_at  *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*MCPToken, error)
+	predicates    []predicate.MCPToken
+}
+
+var _ ent.Mutation = (*MCPTokenMutation)(nil)
+
+// mcptokenOption allows management of the mutation configuration using functional options.
+type mcptokenOption func(*MCPTokenMutation)
+
+// newMCPTokenMutation creates new mutation for the MCPToken entity.
+func newMCPTokenMutation(c config, op Op, opts ...mcptokenOption) *MCPTokenMutation {
+	m := &MCPTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMCPToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMCPTokenID sets the ID field of the mutation.
+func withMCPTokenID(id int) mcptokenOption {
+	return func(m *MCPTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MCPToken
+		)
+		m.oldValue = func(ctx context.Context) (*MCPToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MCPToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMCPToken sets the old MCPToken of the mutation.
+func withMCPToken(node *MCPToken) mcptokenOption {
+	return func(m *MCPTokenMutation) {
+		m.oldValue = func(context.Context) (*MCPToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MCPTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MCPTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MCPTokenMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MCPTokenMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MCPToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *MCPTokenMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MCPTokenMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the MCPToken entity.
+// If the MCPToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MCPTokenMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MCPTokenMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *MCPTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *MCPTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the MCPToken entity.
+// If the MCPToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MCPTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *MCPTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *MCPTokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *MCPTokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the MCPToken entity.
+// If the MCPToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MCPTokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *MCPTokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[mcptoken.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *MCPTokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[mcptoken.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *MCPTokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, mcptoken.FieldLastUsedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MCPTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MCPTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MCPToken entity.
+// If the MCPToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MCPTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MCPTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MCPTokenMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MCPTokenMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the MCPToken entity.
+// If the MCPToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MCPTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MCPTokenMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *MCPTokenMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MCPTokenMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MCPTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *MCPTokenMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MCPTokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MCPTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the MCPTokenMutation builder.
+func (m *MCPTokenMutation) Where(ps ...predicate.MCPToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MCPTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MCPTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MCPToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MCPTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MCPTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MCPToken).
+func (m *MCPTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MCPTokenMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, mcptoken.FieldName)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, mcptoken.FieldTokenHash)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, mcptoken.FieldLastUsedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, mcptoken.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, mcptoken.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MCPTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mcptoken.FieldName:
+		return m.Name()
+	case mcptoken.FieldTokenHash:
+		return m.TokenHash()
+	case mcptoken.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case mcptoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case mcptoken.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MCPTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mcptoken.FieldName:
+		return m.OldName(ctx)
+	case mcptoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case mcptoken.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case mcptoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case mcptoken.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MCPToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MCPTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mcptoken.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case mcptoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case mcptoken.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case mcptoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case mcptoken.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MCPToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MCPTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MCPTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MCPTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MCPToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MCPTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(mcptoken.FieldLastUsedAt) {
+		fields = append(fields, mcptoken.FieldLastUsedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MCPTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MCPTokenMutation) ClearField(name string) error {
+	switch name {
+	case mcptoken.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MCPToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MCPTokenMutation) ResetField(name string) error {
+	switch name {
+	case mcptoken.FieldName:
+		m.ResetName()
+		return nil
+	case mcptoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case mcptoken.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case mcptoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case mcptoken.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MCPToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MCPTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, mcptoken.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MCPTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mcptoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MCPTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MCPTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MCPTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, mcptoken.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MCPTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mcptoken.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MCPTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case mcptoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MCPToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MCPTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case mcptoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MCPToken edge %s", name)
+}
+
+// NoteMutation represents an operation that mutates the Note nodes in the graph.
+type NoteMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *uuid.UUID
+	title                       *string
+	content                     *string
+	color                       *string
+	protection_mode             *note.ProtectionMode
+	protection_password_hash    *string
+	encrypted_content           *string
+	encryption_alg              *string
+	encryption_kdf              *string
+	encryption_salt             *string
+	encryption_nonce            *string
+	aliases                     *[]string
+	appendaliases               []string
+	is_starred                  *bool
+	is_deleted                  *bool
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	clearedFields               map[string]struct{}
+	user                        *int
+	cleareduser                 bool
+	folder                      *uuid.UUID
+	clearedfolder               bool
+	attachments                 map[int]struct{}
+	removedattachments          map[int]struct{}
+	clearedattachments          bool
+	whiteboards                 map[uuid.UUID]struct{}
+	removedwhiteboards          map[uuid.UUID]struct{}
+	clearedwhiteboards          bool
+	outgoing_links              map[uuid.UUID]struct{}
+	removedoutgoing_links       map[uuid.UUID]struct{}
+	clearedoutgoing_links       bool
+	backlinks                   map[uuid.UUID]struct{}
+	removedbacklinks            map[uuid.UUID]struct{}
+	clearedbacklinks            bool
+	connection_maps             map[int]struct{}
+	removedconnection_maps      map[int]struct{}
+	clearedconnection_maps      bool
+	connection_jobs             map[int]struct{}
+	removedconnection_jobs      map[int]struct{}
+	clearedconnection_jobs      bool
+	connection_conflicts        map[int]struct{}
+	removedconnection_conflicts map[int]struct{}
+	clearedconnection_conflicts bool
+	tags                        map[uuid.UUID]struct{}
+	removedtags                 map[uuid.UUID]struct{}
+	clearedtags                 bool
+	done                        bool
+	oldValue                    func(context.Context) (*Note, error)
+	predicates                  []predicate.Note
+}
+
+var _ ent.Mutation = (*NoteMutation)(nil)
+
+// noteOption allows management of the mutation configuration using functional options.
+type noteOption func(*NoteMutation)
+
+// newNoteMutation creates new mutation for the Note entity.
+func newNoteMutation(c config, op Op, opts ...noteOption) *NoteMutation {
+	m := &NoteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNote,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNoteID sets the ID field of the mutation.
+func withNoteID(id uuid.UUID) noteOption {
+	return func(m *NoteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Note
+		)
+		m.oldValue = func(ctx context.Context) (*Note, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Note.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNote sets the old Note of the mutation.
+func withNote(node *Note) noteOption {
+	return func(m *NoteMutation) {
+		m.oldValue = func(context.Context) (*Note, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NoteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NoteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Note entities.
+func (m *NoteMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NoteMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NoteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Note.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *NoteMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *NoteMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *NoteMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetContent sets the "content" field.
+func (m *NoteMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *NoteMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ClearContent clears the value of the "content" field.
+func (m *NoteMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[note.FieldContent] = struct{}{}
+}
+
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *NoteMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[note.FieldContent]
+	return ok
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *NoteMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, note.FieldContent)
+}
+
+// SetColor sets the "color" field.
+func (m *NoteMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *NoteMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ClearColor clears the value of the "color" field.
+func (m *NoteMutation) ClearColor() {
+	m.color = nil
+	m.clearedFields[note.FieldColor] = struct{}{}
+}
+
+// ColorCleared returns if the "color" field was cleared in this mutation.
+func (m *NoteMutation) ColorCleared() bool {
+	_, ok := m.clearedFields[note.FieldColor]
+	return ok
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *NoteMutation) ResetColor() {
+	m.color = nil
+	delete(m.clearedFields, note.FieldColor)
+}
+
+// SetProtectionMode sets the "protection_mode" field.
+func (m *NoteMutation) SetProtectionMode(nm note.ProtectionMode) {
+	m.protection_mode = &nm
+}
+
+// ProtectionMode returns the value of the "protection_mode" field in the mutation.
+func (m *NoteMutation) ProtectionMode() (r note.ProtectionMode, exists bool) {
+	v := m.protection_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProtectionMode returns the old "protection_mode" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldProtectionMode(ctx context.Context) (v note.ProtectionMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProtectionMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProtectionMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProtectionMode: %w", err)
+	}
+	return oldValue.ProtectionMode, nil
+}
+
+// ResetProtectionMode resets all changes to the "protection_mode" field.
+func (m *NoteMutation) ResetProtectionMode() {
+	m.protection_mode = nil
+}
+
+// SetProtectionPasswordHash sets the "protection_password_hash" field.
+func (m *NoteMutation) SetProtectionPasswordHash(s string) {
+	m.protection_password_hash = &s
+}
+
+// ProtectionPasswordHash returns the value of the "protection_password_hash" field in the mutation.
+func (m *NoteMutation) ProtectionPasswordHash() (r string, exists bool) {
+	v := m.protection_password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProtectionPasswordHash returns the old "protection_password_hash" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldProtectionPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProtectionPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProtectionPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProtectionPasswordHash: %w", err)
+	}
+	return oldValue.ProtectionPasswordHash, nil
+}
+
+// ClearProtectionPasswordHash clears the value of the "protection_password_hash" field.
+func (m *NoteMutation) ClearProtectionPasswordHash() {
+	m.protection_password_hash = nil
+	m.clearedFields[note.FieldProtectionPasswordHash] = struct{}{}
+}
+
+// ProtectionPasswordHashCleared returns if the "protection_password_hash" field was cleared in this mutation.
+func (m *NoteMutation) ProtectionPasswordHashCleared() bool {
+	_, ok := m.clearedFields[note.FieldProtectionPasswordHash]
+	return ok
+}
+
+// ResetProtectionPasswordHash resets all changes to the "protection_password_hash" field.
+func (m *NoteMutation) ResetProtectionPasswordHash() {
+	m.protection_password_hash = nil
+	delete(m.clearedFields, note.FieldProtectionPasswordHash)
+}
+
+// SetEncryptedContent sets the "encrypted_content" field.
+func (m *NoteMutation) SetEncryptedContent(s string) {
+	m.encrypted_content = &s
+}
+
+// EncryptedContent returns the value of the "encrypted_content" field in the mutation.
+func (m *NoteMutation) EncryptedContent() (r string, exists bool) {
+	v := m.encrypted_content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptedContent returns the old "encrypted_content" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldEncryptedContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptedContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptedContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptedContent: %w", err)
+	}
+	return oldValue.EncryptedContent, nil
+}
+
+// ClearEncryptedContent clears the value of the "encrypted_content" field.
+func (m *NoteMutation) ClearEncryptedContent() {
+	m.encrypted_content = nil
+	m.clearedFields[note.FieldEncryptedContent] = struct{}{}
+}
+
+// EncryptedContentCleared returns if the "encrypted_content" field was cleared in this mutation.
+func (m *NoteMutation) EncryptedContentCleared() bool {
+	_, ok := m.clearedFields[note.FieldEncryptedContent]
+	return ok
+}
+
+// ResetEncryptedContent resets all changes to the "encrypted_content" field.
+func (m *NoteMutation) ResetEncryptedContent() {
+	m.encrypted_content = nil
+	delete(m.clearedFields, note.FieldEncryptedContent)
+}
+
+// SetEncryptionAlg sets the "encryption_alg" field.
+func (m *NoteMutation) SetEncryptionAlg(s string) {
+	m.encryption_alg = &s
+}
+
+// EncryptionAlg returns the value of the "encryption_alg" field in the mutation.
+func (m *NoteMutation) EncryptionAlg() (r string, exists bool) {
+	v := m.encryption_alg
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptionAlg returns the old "encryption_alg" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldEncryptionAlg(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptionAlg is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptionAlg requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptionAlg: %w", err)
+	}
+	return oldValue.EncryptionAlg, nil
+}
+
+// ClearEncryptionAlg clears the value of the "encryption_alg" field.
+func (m *NoteMutation) ClearEncryptionAlg() {
+	m.encryption_alg = nil
+	m.clearedFields[note.FieldEncryptionAlg] = struct{}{}
+}
+
+// EncryptionAlgCleared returns if the "encryption_alg" field was cleared in this mutation.
+func (m *NoteMutation) EncryptionAlgCleared() bool {
+	_, ok := m.clearedFields[note.FieldEncryptionAlg]
+	return ok
+}
+
+// ResetEncryptionAlg resets all changes to the "encryption_alg" field.
+func (m *NoteMutation) ResetEncryptionAlg() {
+	m.encryption_alg = nil
+	delete(m.clearedFields, note.FieldEncryptionAlg)
+}
+
+// SetEncryptionKdf sets the "encryption_kdf" field.
+func (m *NoteMutation) SetEncryptionKdf(s string) {
+	m.encryption_kdf = &s
+}
+
+// EncryptionKdf returns the value of the "encryption_kdf" field in the mutation.
+func (m *NoteMutation) EncryptionKdf() (r string, exists bool) {
+	v := m.encryption_kdf
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptionKdf returns the old "encryption_kdf" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldEncryptionKdf(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptionKdf is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptionKdf requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptionKdf: %w", err)
+	}
+	return oldValue.EncryptionKdf, nil
+}
+
+// ClearEncryptionKdf clears the value of the "encryption_kdf" field.
+func (m *NoteMutation) ClearEncryptionKdf() {
+	m.encryption_kdf = nil
+	m.clearedFields[note.FieldEncryptionKdf] = struct{}{}
+}
+
+// EncryptionKdfCleared returns if the "encryption_kdf" field was cleared in this mutation.
+func (m *NoteMutation) EncryptionKdfCleared() bool {
+	_, ok := m.clearedFields[note.FieldEncryptionKdf]
+	return ok
+}
+
+// ResetEncryptionKdf resets all changes to the "encryption_kdf" field.
+func (m *NoteMutation) ResetEncryptionKdf() {
+	m.encryption_kdf = nil
+	delete(m.clearedFields, note.FieldEncryptionKdf)
+}
+
+// SetEncryptionSalt sets the "encryption_salt" field.
+func (m *NoteMutation) SetEncryptionSalt(s string) {
+	m.encryption_salt = &s
+}
+
+// EncryptionSalt returns the value of the "encryption_salt" field in the mutation.
+func (m *NoteMutation) EncryptionSalt() (r string, exists bool) {
+	v := m.encryption_salt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptionSalt returns the old "encryption_salt" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldEncryptionSalt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptionSalt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptionSalt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptionSalt: %w", err)
+	}
+	return oldValue.EncryptionSalt, nil
+}
+
+// ClearEncryptionSalt clears the value of the "encryption_salt" field.
+func (m *NoteMutation) ClearEncryptionSalt() {
+	m.encryption_salt = nil
+	m.clearedFields[note.FieldEncryptionSalt] = struct{}{}
+}
+
+// EncryptionSaltCleared returns if the "encryption_salt" field was cleared in this mutation.
+func (m *NoteMutation) EncryptionSaltCleared() bool {
+	_, ok := m.clearedFields[note.FieldEncryptionSalt]
+	return ok
+}
+
+// ResetEncryptionSalt resets all changes to the "encryption_salt" field.
+func (m *NoteMutation) ResetEncryptionSalt() {
+	m.encryption_salt = nil
+	delete(m.clearedFields, note.FieldEncryptionSalt)
+}
+
+// SetEncryptionNonce sets the "encryption_nonce" field.
+func (m *NoteMutation) SetEncryptionNonce(s string) {
+	m.encryption_nonce = &s
+}
+
+// EncryptionNonce returns the value of the "encryption_nonce" field in the mutation.
+func (m *NoteMutation) EncryptionNonce() (r string, exists bool) {
+	v := m.encryption_nonce
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptionNonce returns the old "encryption_nonce" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldEncryptionNonce(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptionNonce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptionNonce requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptionNonce: %w", err)
+	}
+	return oldValue.EncryptionNonce, nil
+}
+
+// ClearEncryptionNonce clears the value of the "encryption_nonce" field.
+func (m *NoteMutation) ClearEncryptionNonce() {
+	m.encryption_nonce = nil
+	m.clearedFields[note.FieldEncryptionNonce] = struct{}{}
+}
+
+// EncryptionNonceCleared returns if the "encryption_nonce" field was cleared in this mutation.
+func (m *NoteMutation) EncryptionNonceCleared() bool {
+	_, ok := m.clearedFields[note.FieldEncryptionNonce]
+	return ok
+}
+
+// ResetEncryptionNonce resets all changes to the "encryption_nonce" field.
+func (m *NoteMutation) ResetEncryptionNonce() {
+	m.encryption_nonce = nil
+	delete(m.clearedFields, note.FieldEncryptionNonce)
+}
+
+// SetAliases sets the "aliases" field.
+func (m *NoteMutation) SetAliases(s []string) {
+	m.aliases = &s
+	m.appendaliases = nil
+}
+
+// Aliases returns the value of the "aliases" field in the mutation.
+func (m *NoteMutation) Aliases() (r []string, exists bool) {
+	v := m.aliases
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAliases returns the old "aliases" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldAliases(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAliases is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAliases requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAliases: %w", err)
+	}
+	return oldValue.Aliases, nil
+}
+
+// AppendAliases adds s to the "aliases" field.
+func (m *NoteMutation) AppendAliases(s []string) {
+	m.appendaliases = append(m.appendaliases, s...)
+}
+
+// AppendedAliases returns the list of values that were appended to the "aliases" field in this mutation.
+func (m *NoteMutation) AppendedAliases() ([]string, bool) {
+	if len(m.appendaliases) == 0 {
+		return nil, false
+	}
+	return m.appendaliases, true
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (m *NoteMutation) ClearAliases() {
+	m.aliases = nil
+	m.appendaliases = nil
+	m.clearedFields[note.FieldAliases] = struct{}{}
+}
+
+// AliasesCleared returns if the "aliases" field was cleared in this mutation.
+func (m *NoteMutation) AliasesCleared() bool {
+	_, ok := m.clearedFields[note.FieldAliases]
+	return ok
+}
+
+// ResetAliases resets all changes to the "aliases" field.
+func (m *NoteMutation) ResetAliases() {
+	m.aliases = nil
+	m.appendaliases = nil
+	delete(m.clearedFields, note.FieldAliases)
+}
+
+// SetIsStarred sets the "is_starred" field.
+func (m *NoteMutation) SetIsStarred(b bool) {
+	m.is_starred = &b
+}
+
+// IsStarred returns the value of the "is_starred" field in the mutation.
+func (m *NoteMutation) IsStarred() (r bool, exists bool) {
+	v := m.is_starred
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsStarred returns the old "is_starred" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldIsStarred(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsStarred is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsStarred requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsStarred: %w", err)
+	}
+	return oldValue.IsStarred, nil
+}
+
+// ResetIsStarred resets all changes to the "is_starred" field.
+func (m *NoteMutation) ResetIsStarred() {
+	m.is_starred = nil
+}
+
+// SetIsDeleted sets the "is_deleted" field.
+func (m *NoteMutation) SetIsDeleted(b bool) {
+	m.is_deleted = &b
+}
+
+// IsDeleted returns the value of the "is_deleted" field in the mutation.
+func (m *NoteMutation) IsDeleted() (r bool, exists bool) {
+	v := m.is_deleted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDeleted returns the old "is_deleted" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldIsDeleted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDeleted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDeleted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDeleted: %w", err)
+	}
+	return oldValue.IsDeleted, nil
+}
+
+// ResetIsDeleted resets all changes to the "is_deleted" field.
+func (m *NoteMutation) ResetIsDeleted() {
+	m.is_deleted = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NoteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NoteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NoteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NoteMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NoteMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NoteMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *NoteMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *NoteMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *NoteMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *NoteMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *NoteMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *NoteMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetFolderID sets the "folder" edge to the Folder entity by id.
+func (m *NoteMutation) SetFolderID(id uuid.UUID) {
+	m.folder = &id
+}
+
+// ClearFolder clears the "folder" edge to the Folder entity.
+func (m *NoteMutation) ClearFolder() {
+	m.clearedfolder = true
+}
+
+// FolderCleared reports if the "folder" edge to the Folder entity was cleared.
+func (m *NoteMutation) FolderCleared() bool {
+	return m.clearedfolder
+}
+
+// FolderID returns the "folder" edge ID in the mutation.
+func (m *NoteMutation) FolderID() (id uuid.UUID, exists bool) {
+	if m.folder != nil {
+		return *m.folder, true
+	}
+	return
+}
+
+// FolderIDs returns the "folder" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FolderID instead. It exists only for internal usage by the builders.
+func (m *NoteMutation) FolderIDs() (ids []uuid.UUID) {
+	if id := m.folder; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFolder resets all changes to the "folder" edge.
+func (m *NoteMutation) ResetFolder() {
+	m.folder = nil
+	m.clearedfolder = false
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
+func (m *NoteMutation) AddAttachmentIDs(ids ...int) {
+	if m.attachments == nil {
+		m.attachments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttachments clears the "attachments" edge to the Attachment entity.
+func (m *NoteMutation) ClearAttachments() {
+	m.clearedattachments = true
+}
+
+// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
+func (m *NoteMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
+func (m *NoteMutation) RemoveAttachmentIDs(ids ...int) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
+func (m *NoteMutation) RemovedAttachmentsIDs() (ids []int) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *NoteMutation) AttachmentsIDs() (ids []int) {
+	for id := range m.attachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *NoteMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
+}
+
+// AddWhiteboardIDs adds the "whiteboards" edge to the Whiteboard entity by ids.
+func (m *NoteMutation) AddWhiteboardIDs(ids ...uuid.UUID) {
+	if m.whiteboards == nil {
+		m.whiteboards = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.whiteboards[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWhiteboards clears the "whiteboards" edge to the Whiteboard entity.
+func (m *NoteMutation) ClearWhiteboards() {
+	m.clearedwhiteboards = true
+}
+
+// WhiteboardsCleared reports if the "whiteboards" edge to the Whiteboard entity was cleared.
+func (m *NoteMutation) WhiteboardsCleared() bool {
+	return m.clearedwhiteboards
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MCPImageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, mcpimage.EdgeUser)
+// RemoveWhiteboardIDs removes the "whiteboards" edge to the Whiteboard entity by IDs.
+func (m *NoteMutation) RemoveWhiteboardIDs(ids ...uuid.UUID) {
+	if m.removedwhiteboards == nil {
+		m.removedwhiteboards = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.whiteboards, ids[i])
+		m.removedwhiteboards[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *MCPImageMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case mcpimage.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
+// RemovedWhiteboards returns the removed IDs of the "whiteboards" edge to the Whiteboard entity.
+func (m *NoteMutation) RemovedWhiteboardsIDs() (ids []uuid.UUID) {
+	for id := range m.removedwhiteboards {
+		ids = append(ids, id)
 	}
-	return nil
+	return
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MCPImageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// WhiteboardsIDs returns the "whiteboards" edge IDs in the mutation.
+func (m *NoteMutation) WhiteboardsIDs() (ids []uuid.UUID) {
+	for id := range m.whiteboards {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *MCPImageMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ResetWhiteboards resets all changes to the "whiteboards" edge.
+func (m *NoteMutation) ResetWhiteboards() {
+	m.whiteboards = nil
+	m.clearedwhiteboards = false
+	m.removedwhiteboards = nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MCPImageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, mcpimage.EdgeUser)
+// AddOutgoingLinkIDs adds the "outgoing_links" edge to the NoteLink entity by ids.
+func (m *NoteMutation) AddOutgoingLinkIDs(ids ...uuid.UUID) {
+	if m.outgoing_links == nil {
+		m.outgoing_links = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.outgoing_links[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *MCPImageMutation) EdgeCleared(name string) bool {
-	switch name {
-	case mcpimage.EdgeUser:
-		return m.cleareduser
-	}
-	return false
+// ClearOutgoingLinks clears the "outgoing_links" edge to the NoteLink entity.
+func (m *NoteMutation) ClearOutgoingLinks() {
+	m.clearedoutgoing_links = true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *MCPImageMutation) ClearEdge(name string) error {
-	switch name {
-	case mcpimage.EdgeUser:
-		m.ClearUser()
-		return nil
-	}
-	return fmt.Errorf("unknown MCPImage unique edge %s", name)
+// OutgoingLinksCleared reports if the "outgoing_links" edge to the NoteLink entity was cleared.
+func (m *NoteMutation) OutgoingLinksCleared() bool {
+	return m.clearedoutgoing_links
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *MCPImageMutation) ResetEdge(name string) error {
-	switch name {
-	case mcpimage.EdgeUser:
-		m.ResetUser()
-		return nil
+// RemoveOutgoingLinkIDs removes the "outgoing_links" edge to the NoteLink entity by IDs.
+func (m *NoteMutation) RemoveOutgoingLinkIDs(ids ...uuid.UUID) {
+	if m.removedoutgoing_links == nil {
+		m.removedoutgoing_links = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.outgoing_links, ids[i])
+		m.removedoutgoing_links[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown MCPImage edge %s", name)
 }
 
-// MCPTokenMutation represents an operation that mutates the MCPToken nodes in the graph.
-type MCPTokenMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	token_hash    *string
-	last_used_at  *time.Time
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*MCPToken, error)
-	predicates    []predicate.MCPToken
+// RemovedOutgoingLinks returns the removed IDs of the "outgoing_links" edge to the NoteLink entity.
+func (m *NoteMutation) RemovedOutgoingLinksIDs() (ids []uuid.UUID) {
+	for id := range m.removedoutgoing_links {
+		ids = append(ids, id)
+	}
+	return
 }
 
-var _ ent.Mutation = (*MCPTokenMutation)(nil)
+// OutgoingLinksIDs returns the "outgoing_links" edge IDs in the mutation.
+func (m *NoteMutation) OutgoingLinksIDs() (ids []uuid.UUID) {
+	for id := range m.outgoing_links {
+		ids = append(ids, id)
+	}
+	return
+}
 
-// mcptokenOption allows management of the mutation configuration using functional options.
-type mcptokenOption func(*MCPTokenMutation)
+// ResetOutgoingLinks resets all changes to the "outgoing_links" edge.
+func (m *NoteMutation) ResetOutgoingLinks() {
+	m.outgoing_links = nil
+	m.clearedoutgoing_links = false
+	m.removedoutgoing_links = nil
+}
 
-// newMCPTokenMutation creates new mutation for the MCPToken entity.
-func newMCPTokenMutation(c config, op Op, opts ...mcptokenOption) *MCPTokenMutation {
-	m := &MCPTokenMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeMCPToken,
-		clearedFields: make(map[string]struct{}),
+// AddBacklinkIDs adds the "backlinks" edge to the NoteLink entity by ids.
+func (m *NoteMutation) AddBacklinkIDs(ids ...uuid.UUID) {
+	if m.backlinks == nil {
+		m.backlinks = make(map[uuid.UUID]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		m.backlinks[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withMCPTokenID sets the ID field of the mutation.
-func withMCPTokenID(id int) mcptokenOption {
-	return func(m *MCPTokenMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *MCPToken
-		)
-		m.oldValue = func(ctx context.Context) (*MCPToken, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().MCPToken.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ClearBacklinks clears the "backlinks" edge to the NoteLink entity.
+func (m *NoteMutation) ClearBacklinks() {
+	m.clearedbacklinks = true
 }
 
-// withMCPToken sets the old MCPToken of the mutation.
-func withMCPToken(node *MCPToken) mcptokenOption {
-	return func(m *MCPTokenMutation) {
-		m.oldValue = func(context.Context) (*MCPToken, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// BacklinksCleared reports if the "backlinks" edge to the NoteLink entity was cleared.
+func (m *NoteMutation) BacklinksCleared() bool {
+	return m.clearedbacklinks
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MCPTokenMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// RemoveBacklinkIDs removes the "backlinks" edge to the NoteLink entity by IDs.
+func (m *NoteMutation) RemoveBacklinkIDs(ids ...uuid.UUID) {
+	if m.removedbacklinks == nil {
+		m.removedbacklinks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.backlinks, ids[i])
+		m.removedbacklinks[ids[i]] = struct{}{}
+	}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m MCPTokenMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// RemovedBacklinks returns the removed IDs of the "backlinks" edge to the NoteLink entity.
+func (m *NoteMutation) RemovedBacklinksIDs() (ids []uuid.UUID) {
+	for id := range m.removedbacklinks {
+		ids = append(ids, id)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *MCPTokenMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+// BacklinksIDs returns the "backlinks" edge IDs in the mutation.
+func (m *NoteMutation) BacklinksIDs() (ids []uuid.UUID) {
+	for id := range m.backlinks {
+		ids = append(ids, id)
 	}
-	return *m.id, true
+	return
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *MCPTokenMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MCPToken.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// ResetBacklinks resets all changes to the "backlinks" edge.
+func (m *NoteMutation) ResetBacklinks() {
+	m.backlinks = nil
+	m.clearedbacklinks = false
+	m.removedbacklinks = nil
+}
+
+// AddConnectionMapIDs adds the "connection_maps" edge to the NoteConnectionItemMap entity by ids.
+func (m *NoteMutation) AddConnectionMapIDs(ids ...int) {
+	if m.connection_maps == nil {
+		m.connection_maps = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.connection_maps[ids[i]] = struct{}{}
 	}
 }
 
-// SetName sets the "name" field.
-func (m *MCPTokenMutation) SetName(s string) {
-	m.name = &s
+// ClearConnectionMaps clears the "connection_maps" edge to the NoteConnectionItemMap entity.
+func (m *NoteMutation) ClearConnectionMaps() {
+	m.clearedconnection_maps = true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *MCPTokenMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
+// ConnectionMapsCleared reports if the "connection_maps" edge to the NoteConnectionItemMap entity was cleared.
+func (m *NoteMutation) ConnectionMapsCleared() bool {
+	return m.clearedconnection_maps
 }
 
-// OldName returns the old "name" field's value of the MCPToken entity.
-// If the MCPToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MCPTokenMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+// RemoveConnectionMapIDs removes the "connection_maps" edge to the NoteConnectionItemMap entity by IDs.
+func (m *NoteMutation) RemoveConnectionMapIDs(ids ...int) {
+	if m.removedconnection_maps == nil {
+		m.removedconnection_maps = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	for i := range ids {
+		delete(m.connection_maps, ids[i])
+		m.removedconnection_maps[ids[i]] = struct{}{}
 	}
-	return oldValue.Name, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *MCPTokenMutation) ResetName() {
-	m.name = nil
+// RemovedConnectionMaps returns the removed IDs of the "connection_maps" edge to the NoteConnectionItemMap entity.
+func (m *NoteMutation) RemovedConnectionMapsIDs() (ids []int) {
+	for id := range m.removedconnection_maps {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetTokenHash sets the "token_hash" field.
-func (m *MCPTokenMutation) SetTokenHash(s string) {
-	m.token_hash = &s
+// ConnectionMapsIDs returns the "connection_maps" edge IDs in the mutation.
+func (m *NoteMutation) ConnectionMapsIDs() (ids []int) {
+	for id := range m.connection_maps {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// TokenHash returns the value of the "token_hash" field in the mutation.
-func (m *MCPTokenMutation) TokenHash() (r string, exists bool) {
-	v := m.token_hash
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetConnectionMaps resets all changes to the "connection_maps" edge.
+func (m *NoteMutation) ResetConnectionMaps() {
+	m.connection_maps = nil
+	m.clearedconnection_maps = false
+	m.removedconnection_maps = nil
 }
 
-// OldTokenHash returns the old "token_hash" field's value of the MCPToken entity.
-// If the MCPToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MCPTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+// AddConnectionJobIDs adds the "connection_jobs" edge to the NoteConnectionJob entity by ids.
+func (m *NoteMutation) AddConnectionJobIDs(ids ...int) {
+	if m.connection_jobs == nil {
+		m.connection_jobs = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	for i := range ids {
+		m.connection_jobs[ids[i]] = struct{}{}
 	}
-	return oldValue.TokenHash, nil
 }
 
-// ResetTokenHash resets all changes to the "token_hash" field.
-func (m *MCPTokenMutation) ResetTokenHash() {
-	m.token_hash = nil
+// ClearConnectionJobs clears the "connection_jobs" edge to the NoteConnectionJob entity.
+func (m *NoteMutation) ClearConnectionJobs() {
+	m.clearedconnection_jobs = true
 }
 
-// SetLastUsedAt sets the "last_used_at" field.
-func (m *MCPTokenMutation) SetLastUsedAt(t time.Time) {
-	m.last_used_at = &t
+// ConnectionJobsCleared reports if the "connection_jobs" edge to the NoteConnectionJob entity was cleared.
+func (m *NoteMutation) ConnectionJobsCleared() bool {
+	return m.clearedconnection_jobs
 }
 
-// LastUsedAt returns the value of the "last_used_at" field in the mutation.
-func (m *MCPTokenMutation) LastUsedAt() (r time.Time, exists bool) {
-	v := m.last_used_at
-	if v == nil {
-		return
+// RemoveConnectionJobIDs removes the "connection_jobs" edge to the NoteConnectionJob entity by IDs.
+func (m *NoteMutation) RemoveConnectionJobIDs(ids ...int) {
+	if m.removedconnection_jobs == nil {
+		m.removedconnection_jobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.connection_jobs, ids[i])
+		m.removedconnection_jobs[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldLastUsedAt returns the old "last_used_at" field's value of the MCPToken entity.
-// If the MCPToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MCPTokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+// RemovedConnectionJobs returns the removed IDs of the "connection_jobs" edge to the NoteConnectionJob entity.
+func (m *NoteMutation) RemovedConnectionJobsIDs() (ids []int) {
+	for id := range m.removedconnection_jobs {
+		ids = append(ids, id)
 	}
-	return oldValue.LastUsedAt, nil
+	return
 }
 
-// ClearLastUsedAt clears the value of the "last_used_at" field.
-func (m *MCPTokenMutation) ClearLastUsedAt() {
-	m.last_used_at = nil
-	m.clearedFields[mcptoken.FieldLastUsedAt] = struct{}{}
+// ConnectionJobsIDs returns the "connection_jobs" edge IDs in the mutation.
+func (m *NoteMutation) ConnectionJobsIDs() (ids []int) {
+	for id := range m.connection_jobs {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
-func (m *MCPTokenMutation) LastUsedAtCleared() bool {
-	_, ok := m.clearedFields[mcptoken.FieldLastUsedAt]
-	return ok
+// ResetConnectionJobs resets all changes to the "connection_jobs" edge.
+func (m *NoteMutation) ResetConnectionJobs() {
+	m.connection_jobs = nil
+	m.clearedconnection_jobs = false
+	m.removedconnection_jobs = nil
 }
 
-// ResetLastUsedAt resets all changes to the "last_used_at" field.
-func (m *MCPTokenMutation) ResetLastUsedAt() {
-	m.last_used_at = nil
-	delete(m.clearedFields, mcptoken.FieldLastUsedAt)
+// AddConnectionConflictIDs adds the "connection_conflicts" edge to the NoteConnectionConflict entity by ids.
+func (m *NoteMutation) AddConnectionConflictIDs(ids ...int) {
+	if m.connection_conflicts == nil {
+		m.connection_conflicts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.connection_conflicts[ids[i]] = struct{}{}
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *MCPTokenMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearConnectionConflicts clears the "connection_conflicts" edge to the NoteConnectionConflict entity.
+func (m *NoteMutation) ClearConnectionConflicts() {
+	m.clearedconnection_conflicts = true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MCPTokenMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ConnectionConflictsCleared reports if the "connection_conflicts" edge to the NoteConnectionConflict entity was cleared.
+func (m *NoteMutation) ConnectionConflictsCleared() bool {
+	return m.clearedconnection_conflicts
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the MCPToken entity.
-// If the MCPToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MCPTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// RemoveConnectionConflictIDs removes the "connection_conflicts" edge to the NoteConnectionConflict entity by IDs.
+func (m *NoteMutation) RemoveConnectionConflictIDs(ids ...int) {
+	if m.removedconnection_conflicts == nil {
+		m.removedconnection_conflicts = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for i := range ids {
+		delete(m.connection_conflicts, ids[i])
+		m.removedconnection_conflicts[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MCPTokenMutation) ResetCreatedAt() {
-	m.created_at = nil
+// RemovedConnectionConflicts returns the removed IDs of the "connection_conflicts" edge to the NoteConnectionConflict entity.
+func (m *NoteMutation) RemovedConnectionConflictsIDs() (ids []int) {
+	for id := range m.removedconnection_conflicts {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *MCPTokenMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ConnectionConflictsIDs returns the "connection_conflicts" edge IDs in the mutation.
+func (m *NoteMutation) ConnectionConflictsIDs() (ids []int) {
+	for id := range m.connection_conflicts {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MCPTokenMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetConnectionConflicts resets all changes to the "connection_conflicts" edge.
+func (m *NoteMutation) ResetConnectionConflicts() {
+	m.connection_conflicts = nil
+	m.clearedconnection_conflicts = false
+	m.removedconnection_conflicts = nil
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the MCPToken entity.
-// If the MCPToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MCPTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *NoteMutation) AddTagIDs(ids ...uuid.UUID) {
+	if m.tags == nil {
+		m.tags = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MCPTokenMutation) ResetUpdatedAt() {
-	m.updated_at = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *MCPTokenMutation) SetUserID(id int) {
-	m.user = &id
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *NoteMutation) ClearTags() {
+	m.clearedtags = true
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *MCPTokenMutation) ClearUser() {
-	m.cleareduser = true
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *NoteMutation) TagsCleared() bool {
+	return m.clearedtags
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *MCPTokenMutation) UserCleared() bool {
-	return m.cleareduser
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *NoteMutation) RemoveTagIDs(ids ...uuid.UUID) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *MCPTokenMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *NoteMutation) RemovedTagsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *MCPTokenMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *NoteMutation) TagsIDs() (ids []uuid.UUID) {
+	for id := range m.tags {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *MCPTokenMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetTags resets all changes to the "tags" edge.
+func (m *NoteMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
 }
 
-// Where appends a list predicates to the MCPTokenMutation builder.
-func (m *MCPTokenMutation) Where(ps ...predicate.MCPToken) {
+// Where appends a list predicates to the NoteMutation builder.
+func (m *NoteMutation) Where(ps ...predicate.Note) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MCPTokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NoteMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MCPTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MCPToken, len(ps))
+func (m *NoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Note, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9945,39 +17439,69 @@ func (m *MCPTokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MCPTokenMutation) Op() Op {
+func (m *NoteMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MCPTokenMutation) SetOp(op Op) {
+func (m *NoteMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MCPToken).
-func (m *MCPTokenMutation) Type() string {
+// Type returns the node type of this mutation (Note).
+func (m *NoteMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MCPTokenMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.name != nil {
-		fields = append(fields, mcptoken.FieldName)
+func (m *NoteMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.title != nil {
+		fields = append(fields, note.FieldTitle)
 	}
-	if m.token_hash != nil {
-		fields = append(fields, mcptoken.FieldTokenHash)
+	if m.content != nil {
+		fields = append(fields, note.FieldContent)
 	}
-	if m.last_used_at != nil {
-		fields = append(fields, mcptoken.FieldLastUsedAt)
+	if m.color != nil {
+		fields = append(fields, note.FieldColor)
+	}
+	if m.protection_mode != nil {
+		fields = append(fields, note.FieldProtectionMode)
+	}
+	if m.protection_password_hash != nil {
+		fields = append(fields, note.FieldProtectionPasswordHash)
+	}
+	if m.encrypted_content != nil {
+		fields = append(fields, note.FieldEncryptedContent)
+	}
+	if m.encryption_alg != nil {
+		fields = append(fields, note.FieldEncryptionAlg)
+	}
+	if m.encryption_kdf != nil {
+		fields = append(fields, note.FieldEncryptionKdf)
+	}
+	if m.encryption_salt != nil {
+		fields = append(fields, note.FieldEncryptionSalt)
+	}
+	if m.encryption_nonce != nil {
+		fields = append(fields, note.FieldEncryptionNonce)
+	}
+	if m.aliases != nil {
+		fields = append(fields, note.FieldAliases)
+	}
+	if m.is_starred != nil {
+		fields = append(fields, note.FieldIsStarred)
+	}
+	if m.is_deleted != nil {
+		fields = append(fields, note.FieldIsDeleted)
 	}
 	if m.created_at != nil {
-		fields = append(fields, mcptoken.FieldCreatedAt)
+		fields = append(fields, note.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, mcptoken.FieldUpdatedAt)
+		fields = append(fields, note.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -9985,17 +17509,37 @@ func (m *MCPTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MCPTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *NoteMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case mcptoken.FieldName:
-		return m.Name()
-	case mcptoken.FieldTokenHash:
-		return m.TokenHash()
-	case mcptoken.FieldLastUsedAt:
-		return m.LastUsedAt()
-	case mcptoken.FieldCreatedAt:
+	case note.FieldTitle:
+		return m.Title()
+	case note.FieldContent:
+		return m.Content()
+	case note.FieldColor:
+		return m.Color()
+	case note.FieldProtectionMode:
+		return m.ProtectionMode()
+	case note.FieldProtectionPasswordHash:
+		return m.ProtectionPasswordHash()
+	case note.FieldEncryptedContent:
+		return m.EncryptedContent()
+	case note.FieldEncryptionAlg:
+		return m.EncryptionAlg()
+	case note.FieldEncryptionKdf:
+		return m.EncryptionKdf()
+	case note.FieldEncryptionSalt:
+		return m.EncryptionSalt()
+	case note.FieldEncryptionNonce:
+		return m.EncryptionNonce()
+	case note.FieldAliases:
+		return m.Aliases()
+	case note.FieldIsStarred:
+		return m.IsStarred()
+	case note.FieldIsDeleted:
+		return m.IsDeleted()
+	case note.FieldCreatedAt:
 		return m.CreatedAt()
-	case mcptoken.FieldUpdatedAt:
+	case note.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -10004,56 +17548,146 @@ func (m *MCPTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MCPTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case mcptoken.FieldName:
-		return m.OldName(ctx)
-	case mcptoken.FieldTokenHash:
-		return m.OldTokenHash(ctx)
-	case mcptoken.FieldLastUsedAt:
-		return m.OldLastUsedAt(ctx)
-	case mcptoken.FieldCreatedAt:
+	case note.FieldTitle:
+		return m.OldTitle(ctx)
+	case note.FieldContent:
+		return m.OldContent(ctx)
+	case note.FieldColor:
+		return m.OldColor(ctx)
+	case note.FieldProtectionMode:
+		return m.OldProtectionMode(ctx)
+	case note.FieldProtectionPasswordHash:
+		return m.OldProtectionPasswordHash(ctx)
+	case note.FieldEncryptedContent:
+		return m.OldEncryptedContent(ctx)
+	case note.FieldEncryptionAlg:
+		return m.OldEncryptionAlg(ctx)
+	case note.FieldEncryptionKdf:
+		return m.OldEncryptionKdf(ctx)
+	case note.FieldEncryptionSalt:
+		return m.OldEncryptionSalt(ctx)
+	case note.FieldEncryptionNonce:
+		return m.OldEncryptionNonce(ctx)
+	case note.FieldAliases:
+		return m.OldAliases(ctx)
+	case note.FieldIsStarred:
+		return m.OldIsStarred(ctx)
+	case note.FieldIsDeleted:
+		return m.OldIsDeleted(ctx)
+	case note.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case mcptoken.FieldUpdatedAt:
+	case note.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MCPToken field %s", name)
+	return nil, fmt.Errorf("unknown Note field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MCPTokenMutation) SetField(name string, value ent.Value) error {
+func (m *NoteMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case mcptoken.FieldName:
+	case note.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetTitle(v)
 		return nil
-	case mcptoken.FieldTokenHash:
+	case note.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTokenHash(v)
+		m.SetContent(v)
 		return nil
-	case mcptoken.FieldLastUsedAt:
-		v, ok := value.(time.Time)
+	case note.FieldColor:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastUsedAt(v)
+		m.SetColor(v)
 		return nil
-	case mcptoken.FieldCreatedAt:
+	case note.FieldProtectionMode:
+		v, ok := value.(note.ProtectionMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProtectionMode(v)
+		return nil
+	case note.FieldProtectionPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProtectionPasswordHash(v)
+		return nil
+	case note.FieldEncryptedContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptedContent(v)
+		return nil
+	case note.FieldEncryptionAlg:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptionAlg(v)
+		return nil
+	case note.FieldEncryptionKdf:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptionKdf(v)
+		return nil
+	case note.FieldEncryptionSalt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptionSalt(v)
+		return nil
+	case note.FieldEncryptionNonce:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptionNonce(v)
+		return nil
+	case note.FieldAliases:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAliases(v)
+		return nil
+	case note.FieldIsStarred:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsStarred(v)
+		return nil
+	case note.FieldIsDeleted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDeleted(v)
+		return nil
+	case note.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case mcptoken.FieldUpdatedAt:
+	case note.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -10061,218 +17695,514 @@ func (m *MCPTokenMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MCPToken field %s", name)
+	return fmt.Errorf("unknown Note field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MCPTokenMutation) AddedFields() []string {
+func (m *NoteMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MCPTokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NoteMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MCPTokenMutation) AddField(name string, value ent.Value) error {
+func (m *NoteMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown MCPToken numeric field %s", name)
+	return fmt.Errorf("unknown Note numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MCPTokenMutation) ClearedFields() []string {
+func (m *NoteMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(mcptoken.FieldLastUsedAt) {
-		fields = append(fields, mcptoken.FieldLastUsedAt)
+	if m.FieldCleared(note.FieldContent) {
+		fields = append(fields, note.FieldContent)
+	}
+	if m.FieldCleared(note.FieldColor) {
+		fields = append(fields, note.FieldColor)
+	}
+	if m.FieldCleared(note.FieldProtectionPasswordHash) {
+		fields = append(fields, note.FieldProtectionPasswordHash)
+	}
+	if m.FieldCleared(note.FieldEncryptedContent) {
+		fields = append(fields, note.FieldEncryptedContent)
+	}
+	if m.FieldCleared(note.FieldEncryptionAlg) {
+		fields = append(fields, note.FieldEncryptionAlg)
+	}
+	if m.FieldCleared(note.FieldEncryptionKdf) {
+		fields = append(fields, note.FieldEncryptionKdf)
+	}
+	if m.FieldCleared(note.FieldEncryptionSalt) {
+		fields = append(fields, note.FieldEncryptionSalt)
+	}
+	if m.FieldCleared(note.FieldEncryptionNonce) {
+		fields = append(fields, note.FieldEncryptionNonce)
+	}
+	if m.FieldCleared(note.FieldAliases) {
+		fields = append(fields, note.FieldAliases)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MCPTokenMutation) FieldCleared(name string) bool {
+func (m *NoteMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MCPTokenMutation) ClearField(name string) error {
+func (m *NoteMutation) ClearField(name string) error {
 	switch name {
-	case mcptoken.FieldLastUsedAt:
-		m.ClearLastUsedAt()
+	case note.FieldContent:
+		m.ClearContent()
+		return nil
+	case note.FieldColor:
+		m.ClearColor()
+		return nil
+	case note.FieldProtectionPasswordHash:
+		m.ClearProtectionPasswordHash()
+		return nil
+	case note.FieldEncryptedContent:
+		m.ClearEncryptedContent()
+		return nil
+	case note.FieldEncryptionAlg:
+		m.ClearEncryptionAlg()
+		return nil
+	case note.FieldEncryptionKdf:
+		m.ClearEncryptionKdf()
+		return nil
+	case note.FieldEncryptionSalt:
+		m.ClearEncryptionSalt()
+		return nil
+	case note.FieldEncryptionNonce:
+		m.ClearEncryptionNonce()
+		return nil
+	case note.FieldAliases:
+		m.ClearAliases()
 		return nil
 	}
-	return fmt.Errorf("unknown MCPToken nullable field %s", name)
+	return fmt.Errorf("unknown Note nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MCPTokenMutation) ResetField(name string) error {
+func (m *NoteMutation) ResetField(name string) error {
 	switch name {
-	case mcptoken.FieldName:
-		m.ResetName()
+	case note.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case mcptoken.FieldTokenHash:
-		m.ResetTokenHash()
+	case note.FieldContent:
+		m.ResetContent()
 		return nil
-	case mcptoken.FieldLastUsedAt:
-		m.ResetLastUsedAt()
+	case note.FieldColor:
+		m.ResetColor()
 		return nil
-	case mcptoken.FieldCreatedAt:
+	case note.FieldProtectionMode:
+		m.ResetProtectionMode()
+		return nil
+	case note.FieldProtectionPasswordHash:
+		m.ResetProtectionPasswordHash()
+		return nil
+	case note.FieldEncryptedContent:
+		m.ResetEncryptedContent()
+		return nil
+	case note.FieldEncryptionAlg:
+		m.ResetEncryptionAlg()
+		return nil
+	case note.FieldEncryptionKdf:
+		m.ResetEncryptionKdf()
+		return nil
+	case note.FieldEncryptionSalt:
+		m.ResetEncryptionSalt()
+		return nil
+	case note.FieldEncryptionNonce:
+		m.ResetEncryptionNonce()
+		return nil
+	case note.FieldAliases:
+		m.ResetAliases()
+		return nil
+	case note.FieldIsStarred:
+		m.ResetIsStarred()
+		return nil
+	case note.FieldIsDeleted:
+		m.ResetIsDeleted()
+		return nil
+	case note.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case mcptoken.FieldUpdatedAt:
+	case note.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown MCPToken field %s", name)
+	return fmt.Errorf("unknown Note field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MCPTokenMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *NoteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 10)
 	if m.user != nil {
-		edges = append(edges, mcptoken.EdgeUser)
+		edges = append(edges, note.EdgeUser)
+	}
+	if m.folder != nil {
+		edges = append(edges, note.EdgeFolder)
+	}
+	if m.attachments != nil {
+		edges = append(edges, note.EdgeAttachments)
+	}
+	if m.whiteboards != nil {
+		edges = append(edges, note.EdgeWhiteboards)
+	}
+	if m.outgoing_links != nil {
+		edges = append(edges, note.EdgeOutgoingLinks)
+	}
+	if m.backlinks != nil {
+		edges = append(edges, note.EdgeBacklinks)
+	}
+	if m.connection_maps != nil {
+		edges = append(edges, note.EdgeConnectionMaps)
+	}
+	if m.connection_jobs != nil {
+		edges = append(edges, note.EdgeConnectionJobs)
+	}
+	if m.connection_conflicts != nil {
+		edges = append(edges, note.EdgeConnectionConflicts)
+	}
+	if m.tags != nil {
+		edges = append(edges, note.EdgeTags)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MCPTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *NoteMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case mcptoken.EdgeUser:
+	case note.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
+	case note.EdgeFolder:
+		if id := m.folder; id != nil {
+			return []ent.Value{*id}
+		}
+	case note.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeWhiteboards:
+		ids := make([]ent.Value, 0, len(m.whiteboards))
+		for id := range m.whiteboards {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeOutgoingLinks:
+		ids := make([]ent.Value, 0, len(m.outgoing_links))
+		for id := range m.outgoing_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeBacklinks:
+		ids := make([]ent.Value, 0, len(m.backlinks))
+		for id := range m.backlinks {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeConnectionMaps:
+		ids := make([]ent.Value, 0, len(m.connection_maps))
+		for id := range m.connection_maps {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeConnectionJobs:
+		ids := make([]ent.Value, 0, len(m.connection_jobs))
+		for id := range m.connection_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeConnectionConflicts:
+		ids := make([]ent.Value, 0, len(m.connection_conflicts))
+		for id := range m.connection_conflicts {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MCPTokenMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *NoteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.removedattachments != nil {
+		edges = append(edges, note.EdgeAttachments)
+	}
+	if m.removedwhiteboards != nil {
+		edges = append(edges, note.EdgeWhiteboards)
+	}
+	if m.removedoutgoing_links != nil {
+		edges = append(edges, note.EdgeOutgoingLinks)
+	}
+	if m.removedbacklinks != nil {
+		edges = append(edges, note.EdgeBacklinks)
+	}
+	if m.removedconnection_maps != nil {
+		edges = append(edges, note.EdgeConnectionMaps)
+	}
+	if m.removedconnection_jobs != nil {
+		edges = append(edges, note.EdgeConnectionJobs)
+	}
+	if m.removedconnection_conflicts != nil {
+		edges = append(edges, note.EdgeConnectionConflicts)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, note.EdgeTags)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MCPTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *NoteMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case note.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeWhiteboards:
+		ids := make([]ent.Value, 0, len(m.removedwhiteboards))
+		for id := range m.removedwhiteboards {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeOutgoingLinks:
+		ids := make([]ent.Value, 0, len(m.removedoutgoing_links))
+		for id := range m.removedoutgoing_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeBacklinks:
+		ids := make([]ent.Value, 0, len(m.removedbacklinks))
+		for id := range m.removedbacklinks {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeConnectionMaps:
+		ids := make([]ent.Value, 0, len(m.removedconnection_maps))
+		for id := range m.removedconnection_maps {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeConnectionJobs:
+		ids := make([]ent.Value, 0, len(m.removedconnection_jobs))
+		for id := range m.removedconnection_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeConnectionConflicts:
+		ids := make([]ent.Value, 0, len(m.removedconnection_conflicts))
+		for id := range m.removedconnection_conflicts {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MCPTokenMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *NoteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 10)
 	if m.cleareduser {
-		edges = append(edges, mcptoken.EdgeUser)
+		edges = append(edges, note.EdgeUser)
+	}
+	if m.clearedfolder {
+		edges = append(edges, note.EdgeFolder)
+	}
+	if m.clearedattachments {
+		edges = append(edges, note.EdgeAttachments)
+	}
+	if m.clearedwhiteboards {
+		edges = append(edges, note.EdgeWhiteboards)
+	}
+	if m.clearedoutgoing_links {
+		edges = append(edges, note.EdgeOutgoingLinks)
+	}
+	if m.clearedbacklinks {
+		edges = append(edges, note.EdgeBacklinks)
+	}
+	if m.clearedconnection_maps {
+		edges = append(edges, note.EdgeConnectionMaps)
+	}
+	if m.clearedconnection_jobs {
+		edges = append(edges, note.EdgeConnectionJobs)
+	}
+	if m.clearedconnection_conflicts {
+		edges = append(edges, note.EdgeConnectionConflicts)
+	}
+	if m.clearedtags {
+		edges = append(edges, note.EdgeTags)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MCPTokenMutation) EdgeCleared(name string) bool {
+func (m *NoteMutation) EdgeCleared(name string) bool {
 	switch name {
-	case mcptoken.EdgeUser:
+	case note.EdgeUser:
 		return m.cleareduser
+	case note.EdgeFolder:
+		return m.clearedfolder
+	case note.EdgeAttachments:
+		return m.clearedattachments
+	case note.EdgeWhiteboards:
+		return m.clearedwhiteboards
+	case note.EdgeOutgoingLinks:
+		return m.clearedoutgoing_links
+	case note.EdgeBacklinks:
+		return m.clearedbacklinks
+	case note.EdgeConnectionMaps:
+		return m.clearedconnection_maps
+	case note.EdgeConnectionJobs:
+		return m.clearedconnection_jobs
+	case note.EdgeConnectionConflicts:
+		return m.clearedconnection_conflicts
+	case note.EdgeTags:
+		return m.clearedtags
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MCPTokenMutation) ClearEdge(name string) error {
+func (m *NoteMutation) ClearEdge(name string) error {
 	switch name {
-	case mcptoken.EdgeUser:
+	case note.EdgeUser:
 		m.ClearUser()
 		return nil
+	case note.EdgeFolder:
+		m.ClearFolder()
+		return nil
 	}
-	return fmt.Errorf("unknown MCPToken unique edge %s", name)
+	return fmt.Errorf("unknown Note unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MCPTokenMutation) ResetEdge(name string) error {
+func (m *NoteMutation) ResetEdge(name string) error {
 	switch name {
-	case mcptoken.EdgeUser:
+	case note.EdgeUser:
 		m.ResetUser()
 		return nil
+	case note.EdgeFolder:
+		m.ResetFolder()
+		return nil
+	case note.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	case note.EdgeWhiteboards:
+		m.ResetWhiteboards()
+		return nil
+	case note.EdgeOutgoingLinks:
+		m.ResetOutgoingLinks()
+		return nil
+	case note.EdgeBacklinks:
+		m.ResetBacklinks()
+		return nil
+	case note.EdgeConnectionMaps:
+		m.ResetConnectionMaps()
+		return nil
+	case note.EdgeConnectionJobs:
+		m.ResetConnectionJobs()
+		return nil
+	case note.EdgeConnectionConflicts:
+		m.ResetConnectionConflicts()
+		return nil
+	case note.EdgeTags:
+		m.ResetTags()
+		return nil
 	}
-	return fmt.Errorf("unknown MCPToken edge %s", name)
+	return fmt.Errorf("unknown Note edge %s", name)
 }
 
-// NoteMutation represents an operation that mutates the Note nodes in the graph.
-type NoteMutation struct {
+// NoteConnectionAccountMutation represents an operation that mutates the NoteConnectionAccount nodes in the graph.
+type NoteConnectionAccountMutation struct {
 	config
 	op                       Op
 	typ                      string
-	id                       *uuid.UUID
-	title                    *string
-	content                  *string
-	color                    *string
-	protection_mode          *note.ProtectionMode
-	protection_password_hash *string
-	encrypted_content        *string
-	encryption_alg           *string
-	encryption_kdf           *string
-	encryption_salt          *string
-	encryption_nonce         *string
-	is_starred               *bool
-	is_deleted               *bool
+	id                       *int
+	name                     *string
+	provider                 *string
+	endpoint                 *string
+	enabled                  *bool
+	auth_type                *string
+	encrypted_credentials    *string
+	credential_alg           *string
+	default_target_id        *string
+	default_target_name      *string
+	sync_interval_minutes    *int
+	addsync_interval_minutes *int
+	encrypted_webhook_secret *string
+	metadata_json            *string
+	last_test_status         *string
+	last_test_error          *string
+	last_test_at             *time.Time
 	created_at               *time.Time
 	updated_at               *time.Time
 	clearedFields            map[string]struct{}
 	user                     *int
 	cleareduser              bool
-	folder                   *uuid.UUID
-	clearedfolder            bool
-	attachments              map[int]struct{}
-	removedattachments       map[int]struct{}
-	clearedattachments       bool
-	whiteboards              map[uuid.UUID]struct{}
-	removedwhiteboards       map[uuid.UUID]struct{}
-	clearedwhiteboards       bool
-	outgoing_links           map[uuid.UUID]struct{}
-	removedoutgoing_links    map[uuid.UUID]struct{}
-	clearedoutgoing_links    bool
-	backlinks                map[uuid.UUID]struct{}
-	removedbacklinks         map[uuid.UUID]struct{}
-	clearedbacklinks         bool
-	connection_maps          map[int]struct{}
-	removedconnection_maps   map[int]struct{}
-	clearedconnection_maps   bool
-	connection_jobs          map[int]struct{}
-	removedconnection_jobs   map[int]struct{}
-	clearedconnection_jobs   bool
-	tags                     map[uuid.UUID]struct{}
-	removedtags              map[uuid.UUID]struct{}
-	clearedtags              bool
+	item_maps                map[int]struct{}
+	removeditem_maps         map[int]struct{}
+	cleareditem_maps         bool
+	jobs                     map[int]struct{}
+	removedjobs              map[int]struct{}
+	clearedjobs              bool
+	conflicts                map[int]struct{}
+	removedconflicts         map[int]struct{}
+	clearedconflicts         bool
 	done                     bool
-	oldValue                 func(context.Context) (*Note, error)
-	predicates               []predicate.Note
+	oldValue                 func(context.Context) (*NoteConnectionAccount, error)
+	predicates               []predicate.NoteConnectionAccount
 }
 
-var _ ent.Mutation = (*NoteMutation)(nil)
+var _ ent.Mutation = (*NoteConnectionAccountMutation)(nil)
 
-// noteOption allows management of the mutation configuration using functional options.
-type noteOption func(*NoteMutation)
+// noteconnectionaccountOption allows management of the mutation configuration using functional options.
+type noteconnectionaccountOption func(*NoteConnectionAccountMutation)
 
-// newNoteMutation creates new mutation for the Note entity.
-func newNoteMutation(c config, op Op, opts ...noteOption) *NoteMutation {
-	m := &NoteMutation{
+// newNoteConnectionAccountMutation creates new mutation for the NoteConnectionAccount entity.
+func newNoteConnectionAccountMutation(c config, op Op, opts ...noteconnectionaccountOption) *NoteConnectionAccountMutation {
+	m := &NoteConnectionAccountMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNote,
+		typ:           TypeNoteConnectionAccount,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10281,1164 +18211,1068 @@ func newNoteMutation(c config, op Op, opts ...noteOption) *NoteMutation {
 	return m
 }
 
-// withNoteID sets the ID field of the mutation.
-func withNoteID(id uuid.UUID) noteOption {
-	return func(m *NoteMutation) {
+// withNoteConnectionAccountID sets the ID field of the mutation.
+func withNoteConnectionAccountID(id int) noteconnectionaccountOption {
+	return func(m *NoteConnectionAccountMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Note
+			value *NoteConnectionAccount
 		)
-		m.oldValue = func(ctx context.Context) (*Note, error) {
+		m.oldValue = func(ctx context.Context) (*NoteConnectionAccount, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Note.Get(ctx, id)
+					value, err = m.Client().NoteConnectionAccount.Get(ctx, id)
 				}
 			})
 			return value, err
 		}
 		m.id = &id
 	}
-}
-
-// withNote sets the old Note of the mutation.
-func withNote(node *Note) noteOption {
-	return func(m *NoteMutation) {
-		m.oldValue = func(context.Context) (*Note, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NoteMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m NoteMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Note entities.
-func (m *NoteMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *NoteMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *NoteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Note.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetTitle sets the "title" field.
-func (m *NoteMutation) SetTitle(s string) {
-	m.title = &s
-}
-
-// Title returns the value of the "title" field in the mutation.
-func (m *NoteMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTitle returns the old "title" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *NoteMutation) ResetTitle() {
-	m.title = nil
-}
-
-// SetContent sets the "content" field.
-func (m *NoteMutation) SetContent(s string) {
-	m.content = &s
-}
-
-// Content returns the value of the "content" field in the mutation.
-func (m *NoteMutation) Content() (r string, exists bool) {
-	v := m.content
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldContent returns the old "content" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldContent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+}
+
+// withNoteConnectionAccount sets the old NoteConnectionAccount of the mutation.
+func withNoteConnectionAccount(node *NoteConnectionAccount) noteconnectionaccountOption {
+	return func(m *NoteConnectionAccountMutation) {
+		m.oldValue = func(context.Context) (*NoteConnectionAccount, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.Content, nil
 }
 
-// ClearContent clears the value of the "content" field.
-func (m *NoteMutation) ClearContent() {
-	m.content = nil
-	m.clearedFields[note.FieldContent] = struct{}{}
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NoteConnectionAccountMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ContentCleared returns if the "content" field was cleared in this mutation.
-func (m *NoteMutation) ContentCleared() bool {
-	_, ok := m.clearedFields[note.FieldContent]
-	return ok
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NoteConnectionAccountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *NoteMutation) ResetContent() {
-	m.content = nil
-	delete(m.clearedFields, note.FieldContent)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NoteConnectionAccountMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// SetColor sets the "color" field.
-func (m *NoteMutation) SetColor(s string) {
-	m.color = &s
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NoteConnectionAccountMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NoteConnectionAccount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// Color returns the value of the "color" field in the mutation.
-func (m *NoteMutation) Color() (r string, exists bool) {
-	v := m.color
+// SetName sets the "name" field.
+func (m *NoteConnectionAccountMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *NoteConnectionAccountMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldColor returns the old "color" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldColor(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionAccountMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldColor requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Color, nil
-}
-
-// ClearColor clears the value of the "color" field.
-func (m *NoteMutation) ClearColor() {
-	m.color = nil
-	m.clearedFields[note.FieldColor] = struct{}{}
-}
-
-// ColorCleared returns if the "color" field was cleared in this mutation.
-func (m *NoteMutation) ColorCleared() bool {
-	_, ok := m.clearedFields[note.FieldColor]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetColor resets all changes to the "color" field.
-func (m *NoteMutation) ResetColor() {
-	m.color = nil
-	delete(m.clearedFields, note.FieldColor)
+// ResetName resets all changes to the "name" field.
+func (m *NoteConnectionAccountMutation) ResetName() {
+	m.name = nil
 }
 
-// SetProtectionMode sets the "protection_mode" field.
-func (m *NoteMutation) SetProtectionMode(nm note.ProtectionMode) {
-	m.protection_mode = &nm
+// SetProvider sets the "provider" field.
+func (m *NoteConnectionAccountMutation) SetProvider(s string) {
+	m.provider = &s
 }
 
-// ProtectionMode returns the value of the "protection_mode" field in the mutation.
-func (m *NoteMutation) ProtectionMode() (r note.ProtectionMode, exists bool) {
-	v := m.protection_mode
+// Provider returns the value of the "provider" field in the mutation.
+func (m *NoteConnectionAccountMutation) Provider() (r string, exists bool) {
+	v := m.provider
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProtectionMode returns the old "protection_mode" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldProvider returns the old "provider" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldProtectionMode(ctx context.Context) (v note.ProtectionMode, err error) {
+func (m *NoteConnectionAccountMutation) OldProvider(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProtectionMode is only allowed on UpdateOne operations")
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProtectionMode requires an ID field in the mutation")
+		return v, errors.New("OldProvider requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProtectionMode: %w", err)
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
 	}
-	return oldValue.ProtectionMode, nil
+	return oldValue.Provider, nil
 }
 
-// ResetProtectionMode resets all changes to the "protection_mode" field.
-func (m *NoteMutation) ResetProtectionMode() {
-	m.protection_mode = nil
+// ResetProvider resets all changes to the "provider" field.
+func (m *NoteConnectionAccountMutation) ResetProvider() {
+	m.provider = nil
 }
 
-// SetProtectionPasswordHash sets the "protection_password_hash" field.
-func (m *NoteMutation) SetProtectionPasswordHash(s string) {
-	m.protection_password_hash = &s
+// SetUserID sets the "user_id" field.
+func (m *NoteConnectionAccountMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// ProtectionPasswordHash returns the value of the "protection_password_hash" field in the mutation.
-func (m *NoteMutation) ProtectionPasswordHash() (r string, exists bool) {
-	v := m.protection_password_hash
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *NoteConnectionAccountMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProtectionPasswordHash returns the old "protection_password_hash" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldProtectionPasswordHash(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionAccountMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProtectionPasswordHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProtectionPasswordHash requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProtectionPasswordHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.ProtectionPasswordHash, nil
-}
-
-// ClearProtectionPasswordHash clears the value of the "protection_password_hash" field.
-func (m *NoteMutation) ClearProtectionPasswordHash() {
-	m.protection_password_hash = nil
-	m.clearedFields[note.FieldProtectionPasswordHash] = struct{}{}
-}
-
-// ProtectionPasswordHashCleared returns if the "protection_password_hash" field was cleared in this mutation.
-func (m *NoteMutation) ProtectionPasswordHashCleared() bool {
-	_, ok := m.clearedFields[note.FieldProtectionPasswordHash]
-	return ok
+	return oldValue.UserID, nil
 }
 
-// ResetProtectionPasswordHash resets all changes to the "protection_password_hash" field.
-func (m *NoteMutation) ResetProtectionPasswordHash() {
-	m.protection_password_hash = nil
-	delete(m.clearedFields, note.FieldProtectionPasswordHash)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *NoteConnectionAccountMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetEncryptedContent sets the "encrypted_content" field.
-func (m *NoteMutation) SetEncryptedContent(s string) {
-	m.encrypted_content = &s
+// SetEndpoint sets the "endpoint" field.
+func (m *NoteConnectionAccountMutation) SetEndpoint(s string) {
+	m.endpoint = &s
 }
 
-// EncryptedContent returns the value of the "encrypted_content" field in the mutation.
-func (m *NoteMutation) EncryptedContent() (r string, exists bool) {
-	v := m.encrypted_content
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *NoteConnectionAccountMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncryptedContent returns the old "encrypted_content" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldEndpoint returns the old "endpoint" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldEncryptedContent(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionAccountMutation) OldEndpoint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncryptedContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncryptedContent requires an ID field in the mutation")
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncryptedContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
 	}
-	return oldValue.EncryptedContent, nil
+	return oldValue.Endpoint, nil
 }
 
-// ClearEncryptedContent clears the value of the "encrypted_content" field.
-func (m *NoteMutation) ClearEncryptedContent() {
-	m.encrypted_content = nil
-	m.clearedFields[note.FieldEncryptedContent] = struct{}{}
+// ClearEndpoint clears the value of the "endpoint" field.
+func (m *NoteConnectionAccountMutation) ClearEndpoint() {
+	m.endpoint = nil
+	m.clearedFields[noteconnectionaccount.FieldEndpoint] = struct{}{}
 }
 
-// EncryptedContentCleared returns if the "encrypted_content" field was cleared in this mutation.
-func (m *NoteMutation) EncryptedContentCleared() bool {
-	_, ok := m.clearedFields[note.FieldEncryptedContent]
+// EndpointCleared returns if the "endpoint" field was cleared in this mutation.
+func (m *NoteConnectionAccountMutation) EndpointCleared() bool {
+	_, ok := m.clearedFields[noteconnectionaccount.FieldEndpoint]
 	return ok
 }
 
-// ResetEncryptedContent resets all changes to the "encrypted_content" field.
-func (m *NoteMutation) ResetEncryptedContent() {
-	m.encrypted_content = nil
-	delete(m.clearedFields, note.FieldEncryptedContent)
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *NoteConnectionAccountMutation) ResetEndpoint() {
+	m.endpoint = nil
+	delete(m.clearedFields, noteconnectionaccount.FieldEndpoint)
 }
 
-// SetEncryptionAlg sets the "encryption_alg" field.
-func (m *NoteMutation) SetEncryptionAlg(s string) {
-	m.encryption_alg = &s
+// SetEnabled sets the "enabled" field.
+func (m *NoteConnectionAccountMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// EncryptionAlg returns the value of the "encryption_alg" field in the mutation.
-func (m *NoteMutation) EncryptionAlg() (r string, exists bool) {
-	v := m.encryption_alg
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *NoteConnectionAccountMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncryptionAlg returns the old "encryption_alg" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldEncryptionAlg(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionAccountMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncryptionAlg is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncryptionAlg requires an ID field in the mutation")
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncryptionAlg: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return oldValue.EncryptionAlg, nil
-}
-
-// ClearEncryptionAlg clears the value of the "encryption_alg" field.
-func (m *NoteMutation) ClearEncryptionAlg() {
-	m.encryption_alg = nil
-	m.clearedFields[note.FieldEncryptionAlg] = struct{}{}
-}
-
-// EncryptionAlgCleared returns if the "encryption_alg" field was cleared in this mutation.
-func (m *NoteMutation) EncryptionAlgCleared() bool {
-	_, ok := m.clearedFields[note.FieldEncryptionAlg]
-	return ok
+	return oldValue.Enabled, nil
 }
 
-// ResetEncryptionAlg resets all changes to the "encryption_alg" field.
-func (m *NoteMutation) ResetEncryptionAlg() {
-	m.encryption_alg = nil
-	delete(m.clearedFields, note.FieldEncryptionAlg)
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *NoteConnectionAccountMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
-// SetEncryptionKdf sets the "encryption_kdf" field.
-func (m *NoteMutation) SetEncryptionKdf(s string) {
-	m.encryption_kdf = &s
+// SetAuthType sets the "auth_type" field.
+func (m *NoteConnectionAccountMutation) SetAuthType(s string) {
+	m.auth_type = &s
 }
 
-// EncryptionKdf returns the value of the "encryption_kdf" field in the mutation.
-func (m *NoteMutation) EncryptionKdf() (r string, exists bool) {
-	v := m.encryption_kdf
+// AuthType returns the value of the "auth_type" field in the mutation.
+func (m *NoteConnectionAccountMutation) AuthType() (r string, exists bool) {
+	v := m.auth_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncryptionKdf returns the old "encryption_kdf" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthType returns the old "auth_type" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldEncryptionKdf(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionAccountMutation) OldAuthType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncryptionKdf is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncryptionKdf requires an ID field in the mutation")
+		return v, errors.New("OldAuthType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncryptionKdf: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthType: %w", err)
 	}
-	return oldValue.EncryptionKdf, nil
-}
-
-// ClearEncryptionKdf clears the value of the "encryption_kdf" field.
-func (m *NoteMutation) ClearEncryptionKdf() {
-	m.encryption_kdf = nil
-	m.clearedFields[note.FieldEncryptionKdf] = struct{}{}
-}
-
-// EncryptionKdfCleared returns if the "encryption_kdf" field was cleared in this mutation.
-func (m *NoteMutation) EncryptionKdfCleared() bool {
-	_, ok := m.clearedFields[note.FieldEncryptionKdf]
-	return ok
+	return oldValue.AuthType, nil
 }
 
-// ResetEncryptionKdf resets all changes to the "encryption_kdf" field.
-func (m *NoteMutation) ResetEncryptionKdf() {
-	m.encryption_kdf = nil
-	delete(m.clearedFields, note.FieldEncryptionKdf)
+// ResetAuthType resets all changes to the "auth_type" field.
+func (m *NoteConnectionAccountMutation) ResetAuthType() {
+	m.auth_type = nil
 }
 
-// SetEncryptionSalt sets the "encryption_salt" field.
-func (m *NoteMutation) SetEncryptionSalt(s string) {
-	m.encryption_salt = &s
+// SetEncryptedCredentials sets the "encrypted_credentials" field.
+func (m *NoteConnectionAccountMutation) SetEncryptedCredentials(s string) {
+	m.encrypted_credentials = &s
 }
 
-// EncryptionSalt returns the value of the "encryption_salt" field in the mutation.
-func (m *NoteMutation) EncryptionSalt() (r string, exists bool) {
-	v := m.encryption_salt
+// EncryptedCredentials returns the value of the "encrypted_credentials" field in the mutation.
+func (m *NoteConnectionAccountMutation) EncryptedCredentials() (r string, exists bool) {
+	v := m.encrypted_credentials
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncryptionSalt returns the old "encryption_salt" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldEncryptedCredentials returns the old "encrypted_credentials" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldEncryptionSalt(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionAccountMutation) OldEncryptedCredentials(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncryptionSalt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEncryptedCredentials is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncryptionSalt requires an ID field in the mutation")
+		return v, errors.New("OldEncryptedCredentials requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncryptionSalt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEncryptedCredentials: %w", err)
 	}
-	return oldValue.EncryptionSalt, nil
+	return oldValue.EncryptedCredentials, nil
 }
 
-// ClearEncryptionSalt clears the value of the "encryption_salt" field.
-func (m *NoteMutation) ClearEncryptionSalt() {
-	m.encryption_salt = nil
-	m.clearedFields[note.FieldEncryptionSalt] = struct{}{}
+// ClearEncryptedCredentials clears the value of the "encrypted_credentials" field.
+func (m *NoteConnectionAccountMutation) ClearEncryptedCredentials() {
+	m.encrypted_credentials = nil
+	m.clearedFields[noteconnectionaccount.FieldEncryptedCredentials] = struct{}{}
 }
 
-// EncryptionSaltCleared returns if the "encryption_salt" field was cleared in this mutation.
-func (m *NoteMutation) EncryptionSaltCleared() bool {
-	_, ok := m.clearedFields[note.FieldEncryptionSalt]
+// EncryptedCredentialsCleared returns if the "encrypted_credentials" field was cleared in this mutation.
+func (m *NoteConnectionAccountMutation) EncryptedCredentialsCleared() bool {
+	_, ok := m.clearedFields[noteconnectionaccount.FieldEncryptedCredentials]
 	return ok
 }
 
-// ResetEncryptionSalt resets all changes to the "encryption_salt" field.
-func (m *NoteMutation) ResetEncryptionSalt() {
-	m.encryption_salt = nil
-	delete(m.clearedFields, note.FieldEncryptionSalt)
+// ResetEncryptedCredentials resets all changes to the "encrypted_credentials" field.
+func (m *NoteConnectionAccountMutation) ResetEncryptedCredentials() {
+	m.encrypted_credentials = nil
+	delete(m.clearedFields, noteconnectionaccount.FieldEncryptedCredentials)
 }
 
-// SetEncryptionNonce sets the "encryption_nonce" field.
-func (m *NoteMutation) SetEncryptionNonce(s string) {
-	m.encryption_nonce = &s
+// SetCredentialAlg sets the "credential_alg" field.
+func (m *NoteConnectionAccountMutation) SetCredentialAlg(s string) {
+	m.credential_alg = &s
 }
 
-// EncryptionNonce returns the value of the "encryption_nonce" field in the mutation.
-func (m *NoteMutation) EncryptionNonce() (r string, exists bool) {
-	v := m.encryption_nonce
+// CredentialAlg returns the value of the "credential_alg" field in the mutation.
+func (m *NoteConnectionAccountMutation) CredentialAlg() (r string, exists bool) {
+	v := m.credential_alg
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncryptionNonce returns the old "encryption_nonce" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldCredentialAlg returns the old "credential_alg" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldEncryptionNonce(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionAccountMutation) OldCredentialAlg(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncryptionNonce is only allowed on UpdateOne operations")
+		return v, errors.New("OldCredentialAlg is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncryptionNonce requires an ID field in the mutation")
+		return v, errors.New("OldCredentialAlg requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncryptionNonce: %w", err)
+		return v, fmt.Errorf("querying old value for OldCredentialAlg: %w", err)
 	}
-	return oldValue.EncryptionNonce, nil
+	return oldValue.CredentialAlg, nil
 }
 
-// ClearEncryptionNonce clears the value of the "encryption_nonce" field.
-func (m *NoteMutation) ClearEncryptionNonce() {
-	m.encryption_nonce = nil
-	m.clearedFields[note.FieldEncryptionNonce] = struct{}{}
+// ClearCredentialAlg clears the value of the "credential_alg" field.
+func (m *NoteConnectionAccountMutation) ClearCredentialAlg() {
+	m.credential_alg = nil
+	m.clearedFields[noteconnectionaccount.FieldCredentialAlg] = struct{}{}
 }
 
-// EncryptionNonceCleared returns if the "encryption_nonce" field was cleared in this mutation.
-func (m *NoteMutation) EncryptionNonceCleared() bool {
-	_, ok := m.clearedFields[note.FieldEncryptionNonce]
+// CredentialAlgCleared returns if the "credential_alg" field was cleared in this mutation.
+func (m *NoteConnectionAccountMutation) CredentialAlgCleared() bool {
+	_, ok := m.clearedFields[noteconnectionaccount.FieldCredentialAlg]
 	return ok
 }
 
-// ResetEncryptionNonce resets all changes to the "encryption_nonce" field.
-func (m *NoteMutation) ResetEncryptionNonce() {
-	m.encryption_nonce = nil
-	delete(m.clearedFields, note.FieldEncryptionNonce)
+// ResetCredentialAlg resets all changes to the "credential_alg" field.
+func (m *NoteConnectionAccountMutation) ResetCredentialAlg() {
+	m.credential_alg = nil
+	delete(m.clearedFields, noteconnectionaccount.FieldCredentialAlg)
 }
 
-// SetIsStarred sets the "is_starred" field.
-func (m *NoteMutation) SetIsStarred(b bool) {
-	m.is_starred = &b
+// SetDefaultTargetID sets the "default_target_id" field.
+func (m *NoteConnectionAccountMutation) SetDefaultTargetID(s string) {
+	m.default_target_id = &s
 }
 
-// IsStarred returns the value of the "is_starred" field in the mutation.
-func (m *NoteMutation) IsStarred() (r bool, exists bool) {
-	v := m.is_starred
+// DefaultTargetID returns the value of the "default_target_id" field in the mutation.
+func (m *NoteConnectionAccountMutation) DefaultTargetID() (r string, exists bool) {
+	v := m.default_target_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsStarred returns the old "is_starred" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldDefaultTargetID returns the old "default_target_id" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldIsStarred(ctx context.Context) (v bool, err error) {
+func (m *NoteConnectionAccountMutation) OldDefaultTargetID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsStarred is only allowed on UpdateOne operations")
+		return v, errors.New("OldDefaultTargetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsStarred requires an ID field in the mutation")
+		return v, errors.New("OldDefaultTargetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsStarred: %w", err)
+		return v, fmt.Errorf("querying old value for OldDefaultTargetID: %w", err)
 	}
-	return oldValue.IsStarred, nil
+	return oldValue.DefaultTargetID, nil
 }
 
-// ResetIsStarred resets all changes to the "is_starred" field.
-func (m *NoteMutation) ResetIsStarred() {
-	m.is_starred = nil
+// ClearDefaultTargetID clears the value of the "default_target_id" field.
+func (m *NoteConnectionAccountMutation) ClearDefaultTargetID() {
+	m.default_target_id = nil
+	m.clearedFields[noteconnectionaccount.FieldDefaultTargetID] = struct{}{}
 }
 
-// SetIsDeleted sets the "is_deleted" field.
-func (m *NoteMutation) SetIsDeleted(b bool) {
-	m.is_deleted = &b
+// DefaultTargetIDCleared returns if the "default_target_id" field was cleared in this mutation.
+func (m *NoteConnectionAccountMutation) DefaultTargetIDCleared() bool {
+	_, ok := m.clearedFields[noteconnectionaccount.FieldDefaultTargetID]
+	return ok
 }
 
-// IsDeleted returns the value of the "is_deleted" field in the mutation.
-func (m *NoteMutation) IsDeleted() (r bool, exists bool) {
-	v := m.is_deleted
+// ResetDefaultTargetID resets all changes to the "default_target_id" field.
+func (m *NoteConnectionAccountMutation) ResetDefaultTargetID() {
+	m.default_target_id = nil
+	delete(m.clearedFields, noteconnectionaccount.FieldDefaultTargetID)
+}
+
+// SetDefaultTargetName sets the "default_target_name" field.
+func (m *NoteConnectionAccountMutation) SetDefaultTargetName(s string) {
+	m.default_target_name = &s
+}
+
+// DefaultTargetName returns the value of the "default_target_name" field in the mutation.
+func (m *NoteConnectionAccountMutation) DefaultTargetName() (r string, exists bool) {
+	v := m.default_target_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsDeleted returns the old "is_deleted" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldDefaultTargetName returns the old "default_target_name" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldIsDeleted(ctx context.Context) (v bool, err error) {
+func (m *NoteConnectionAccountMutation) OldDefaultTargetName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDeleted is only allowed on UpdateOne operations")
+		return v, errors.New("OldDefaultTargetName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDeleted requires an ID field in the mutation")
+		return v, errors.New("OldDefaultTargetName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDeleted: %w", err)
+		return v, fmt.Errorf("querying old value for OldDefaultTargetName: %w", err)
 	}
-	return oldValue.IsDeleted, nil
+	return oldValue.DefaultTargetName, nil
 }
 
-// ResetIsDeleted resets all changes to the "is_deleted" field.
-func (m *NoteMutation) ResetIsDeleted() {
-	m.is_deleted = nil
+// ClearDefaultTargetName clears the value of the "default_target_name" field.
+func (m *NoteConnectionAccountMutation) ClearDefaultTargetName() {
+	m.default_target_name = nil
+	m.clearedFields[noteconnectionaccount.FieldDefaultTargetName] = struct{}{}
+}
+
+// DefaultTargetNameCleared returns if the "default_target_name" field was cleared in this mutation.
+func (m *NoteConnectionAccountMutation) DefaultTargetNameCleared() bool {
+	_, ok := m.clearedFields[noteconnectionaccount.FieldDefaultTargetName]
+	return ok
+}
+
+// ResetDefaultTargetName resets all changes to the "default_target_name" field.
+func (m *NoteConnectionAccountMutation) ResetDefaultTargetName() {
+	m.default_target_name = nil
+	delete(m.clearedFields, noteconnectionaccount.FieldDefaultTargetName)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NoteMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
+func (m *NoteConnectionAccountMutation) SetSyncIntervalMinutes(i int) {
+	m.sync_interval_minutes = &i
+	m.addsync_interval_minutes = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NoteMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SyncIntervalMinutes returns the value of the "sync_interval_minutes" field in the mutation.
+func (m *NoteConnectionAccountMutation) SyncIntervalMinutes() (r int, exists bool) {
+	v := m.sync_interval_minutes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldSyncIntervalMinutes returns the old "sync_interval_minutes" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NoteConnectionAccountMutation) OldSyncIntervalMinutes(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSyncIntervalMinutes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldSyncIntervalMinutes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSyncIntervalMinutes: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.SyncIntervalMinutes, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NoteMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddSyncIntervalMinutes adds i to the "sync_interval_minutes" field.
+func (m *NoteConnectionAccountMutation) AddSyncIntervalMinutes(i int) {
+	if m.addsync_interval_minutes != nil {
+		*m.addsync_interval_minutes += i
+	} else {
+		m.addsync_interval_minutes = &i
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *NoteMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedSyncIntervalMinutes returns the value that was added to the "sync_interval_minutes" field in this mutation.
+func (m *NoteConnectionAccountMutation) AddedSyncIntervalMinutes() (r int, exists bool) {
+	v := m.addsync_interval_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NoteMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetSyncIntervalMinutes resets all changes to the "sync_interval_minutes" field.
+func (m *NoteConnectionAccountMutation) ResetSyncIntervalMinutes() {
+	m.sync_interval_minutes = nil
+	m.addsync_interval_minutes = nil
+}
+
+// SetEncryptedWebhookSecret sets the "encrypted_webhook_secret" field.
+func (m *NoteConnectionAccountMutation) SetEncryptedWebhookSecret(s string) {
+	m.encrypted_webhook_secret = &s
+}
+
+// EncryptedWebhookSecret returns the value of the "encrypted_webhook_secret" field in the mutation.
+func (m *NoteConnectionAccountMutation) EncryptedWebhookSecret() (r string, exists bool) {
+	v := m.encrypted_webhook_secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldEncryptedWebhookSecret returns the old "encrypted_webhook_secret" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NoteConnectionAccountMutation) OldEncryptedWebhookSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEncryptedWebhookSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEncryptedWebhookSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEncryptedWebhookSecret: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.EncryptedWebhookSecret, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NoteMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearEncryptedWebhookSecret clears the value of the "encrypted_webhook_secret" field.
+func (m *NoteConnectionAccountMutation) ClearEncryptedWebhookSecret() {
+	m.encrypted_webhook_secret = nil
+	m.clearedFields[noteconnectionaccount.FieldEncryptedWebhookSecret] = struct{}{}
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *NoteMutation) SetUserID(id int) {
-	m.user = &id
+// EncryptedWebhookSecretCleared returns if the "encrypted_webhook_secret" field was cleared in this mutation.
+func (m *NoteConnectionAccountMutation) EncryptedWebhookSecretCleared() bool {
+	_, ok := m.clearedFields[noteconnectionaccount.FieldEncryptedWebhookSecret]
+	return ok
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *NoteMutation) ClearUser() {
-	m.cleareduser = true
+// ResetEncryptedWebhookSecret resets all changes to the "encrypted_webhook_secret" field.
+func (m *NoteConnectionAccountMutation) ResetEncryptedWebhookSecret() {
+	m.encrypted_webhook_secret = nil
+	delete(m.clearedFields, noteconnectionaccount.FieldEncryptedWebhookSecret)
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *NoteMutation) UserCleared() bool {
-	return m.cleareduser
+// SetMetadataJSON sets the "metadata_json" field.
+func (m *NoteConnectionAccountMutation) SetMetadataJSON(s string) {
+	m.metadata_json = &s
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *NoteMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// MetadataJSON returns the value of the "metadata_json" field in the mutation.
+func (m *NoteConnectionAccountMutation) MetadataJSON() (r string, exists bool) {
+	v := m.metadata_json
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *NoteMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldMetadataJSON returns the old "metadata_json" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionAccountMutation) OldMetadataJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadataJSON is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadataJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadataJSON: %w", err)
+	}
+	return oldValue.MetadataJSON, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *NoteMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ClearMetadataJSON clears the value of the "metadata_json" field.
+func (m *NoteConnectionAccountMutation) ClearMetadataJSON() {
+	m.metadata_json = nil
+	m.clearedFields[noteconnectionaccount.FieldMetadataJSON] = struct{}{}
 }
 
-// SetFolderID sets the "folder" edge to the Folder entity by id.
-func (m *NoteMutation) SetFolderID(id uuid.UUID) {
-	m.folder = &id
+// MetadataJSONCleared returns if the "metadata_json" field was cleared in this mutation.
+func (m *NoteConnectionAccountMutation) MetadataJSONCleared() bool {
+	_, ok := m.clearedFields[noteconnectionaccount.FieldMetadataJSON]
+	return ok
 }
 
-// ClearFolder clears the "folder" edge to the Folder entity.
-func (m *NoteMutation) ClearFolder() {
-	m.clearedfolder = true
+// ResetMetadataJSON resets all changes to the "metadata_json" field.
+func (m *NoteConnectionAccountMutation) ResetMetadataJSON() {
+	m.metadata_json = nil
+	delete(m.clearedFields, noteconnectionaccount.FieldMetadataJSON)
 }
 
-// FolderCleared reports if the "folder" edge to the Folder entity was cleared.
-func (m *NoteMutation) FolderCleared() bool {
-	return m.clearedfolder
+// SetLastTestStatus sets the "last_test_status" field.
+func (m *NoteConnectionAccountMutation) SetLastTestStatus(s string) {
+	m.last_test_status = &s
 }
 
-// FolderID returns the "folder" edge ID in the mutation.
-func (m *NoteMutation) FolderID() (id uuid.UUID, exists bool) {
-	if m.folder != nil {
-		return *m.folder, true
+// LastTestStatus returns the value of the "last_test_status" field in the mutation.
+func (m *NoteConnectionAccountMutation) LastTestStatus() (r string, exists bool) {
+	v := m.last_test_status
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// FolderIDs returns the "folder" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FolderID instead. It exists only for internal usage by the builders.
-func (m *NoteMutation) FolderIDs() (ids []uuid.UUID) {
-	if id := m.folder; id != nil {
-		ids = append(ids, *id)
+// OldLastTestStatus returns the old "last_test_status" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionAccountMutation) OldLastTestStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastTestStatus is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetFolder resets all changes to the "folder" edge.
-func (m *NoteMutation) ResetFolder() {
-	m.folder = nil
-	m.clearedfolder = false
-}
-
-// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
-func (m *NoteMutation) AddAttachmentIDs(ids ...int) {
-	if m.attachments == nil {
-		m.attachments = make(map[int]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastTestStatus requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.attachments[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastTestStatus: %w", err)
 	}
+	return oldValue.LastTestStatus, nil
 }
 
-// ClearAttachments clears the "attachments" edge to the Attachment entity.
-func (m *NoteMutation) ClearAttachments() {
-	m.clearedattachments = true
+// ResetLastTestStatus resets all changes to the "last_test_status" field.
+func (m *NoteConnectionAccountMutation) ResetLastTestStatus() {
+	m.last_test_status = nil
 }
 
-// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
-func (m *NoteMutation) AttachmentsCleared() bool {
-	return m.clearedattachments
+// SetLastTestError sets the "last_test_error" field.
+func (m *NoteConnectionAccountMutation) SetLastTestError(s string) {
+	m.last_test_error = &s
 }
 
-// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
-func (m *NoteMutation) RemoveAttachmentIDs(ids ...int) {
-	if m.removedattachments == nil {
-		m.removedattachments = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.attachments, ids[i])
-		m.removedattachments[ids[i]] = struct{}{}
+// LastTestError returns the value of the "last_test_error" field in the mutation.
+func (m *NoteConnectionAccountMutation) LastTestError() (r string, exists bool) {
+	v := m.last_test_error
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
-func (m *NoteMutation) RemovedAttachmentsIDs() (ids []int) {
-	for id := range m.removedattachments {
-		ids = append(ids, id)
+// OldLastTestError returns the old "last_test_error" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionAccountMutation) OldLastTestError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastTestError is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
-func (m *NoteMutation) AttachmentsIDs() (ids []int) {
-	for id := range m.attachments {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastTestError requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastTestError: %w", err)
+	}
+	return oldValue.LastTestError, nil
 }
 
-// ResetAttachments resets all changes to the "attachments" edge.
-func (m *NoteMutation) ResetAttachments() {
-	m.attachments = nil
-	m.clearedattachments = false
-	m.removedattachments = nil
+// ClearLastTestError clears the value of the "last_test_error" field.
+func (m *NoteConnectionAccountMutation) ClearLastTestError() {
+	m.last_test_error = nil
+	m.clearedFields[noteconnectionaccount.FieldLastTestError] = struct{}{}
 }
 
-// AddWhiteboardIDs adds the "whiteboards" edge to the Whiteboard entity by ids.
-func (m *NoteMutation) AddWhiteboardIDs(ids ...uuid.UUID) {
-	if m.whiteboards == nil {
-		m.whiteboards = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.whiteboards[ids[i]] = struct{}{}
-	}
+// LastTestErrorCleared returns if the "last_test_error" field was cleared in this mutation.
+func (m *NoteConnectionAccountMutation) LastTestErrorCleared() bool {
+	_, ok := m.clearedFields[noteconnectionaccount.FieldLastTestError]
+	return ok
 }
 
-// ClearWhiteboards clears the "whiteboards" edge to the Whiteboard entity.
-func (m *NoteMutation) ClearWhiteboards() {
-	m.clearedwhiteboards = true
+// ResetLastTestError resets all changes to the "last_test_error" field.
+func (m *NoteConnectionAccountMutation) ResetLastTestError() {
+	m.last_test_error = nil
+	delete(m.clearedFields, noteconnectionaccount.FieldLastTestError)
 }
 
-// WhiteboardsCleared reports if the "whiteboards" edge to the Whiteboard entity was cleared.
-func (m *NoteMutation) WhiteboardsCleared() bool {
-	return m.clearedwhiteboards
+// SetLastTestAt sets the "last_test_at" field.
+func (m *NoteConnectionAccountMutation) SetLastTestAt(t time.Time) {
+	m.last_test_at = &t
 }
 
-// RemoveWhiteboardIDs removes the "whiteboards" edge to the Whiteboard entity by IDs.
-func (m *NoteMutation) RemoveWhiteboardIDs(ids ...uuid.UUID) {
-	if m.removedwhiteboards == nil {
-		m.removedwhiteboards = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.whiteboards, ids[i])
-		m.removedwhiteboards[ids[i]] = struct{}{}
+// LastTestAt returns the value of the "last_test_at" field in the mutation.
+func (m *NoteConnectionAccountMutation) LastTestAt() (r time.Time, exists bool) {
+	v := m.last_test_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedWhiteboards returns the removed IDs of the "whiteboards" edge to the Whiteboard entity.
-func (m *NoteMutation) RemovedWhiteboardsIDs() (ids []uuid.UUID) {
-	for id := range m.removedwhiteboards {
-		ids = append(ids, id)
+// OldLastTestAt returns the old "last_test_at" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionAccountMutation) OldLastTestAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastTestAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// WhiteboardsIDs returns the "whiteboards" edge IDs in the mutation.
-func (m *NoteMutation) WhiteboardsIDs() (ids []uuid.UUID) {
-	for id := range m.whiteboards {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastTestAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastTestAt: %w", err)
+	}
+	return oldValue.LastTestAt, nil
 }
 
-// ResetWhiteboards resets all changes to the "whiteboards" edge.
-func (m *NoteMutation) ResetWhiteboards() {
-	m.whiteboards = nil
-	m.clearedwhiteboards = false
-	m.removedwhiteboards = nil
+// ClearLastTestAt clears the value of the "last_test_at" field.
+func (m *NoteConnectionAccountMutation) ClearLastTestAt() {
+	m.last_test_at = nil
+	m.clearedFields[noteconnectionaccount.FieldLastTestAt] = struct{}{}
 }
 
-// AddOutgoingLinkIDs adds the "outgoing_links" edge to the NoteLink entity by ids.
-func (m *NoteMutation) AddOutgoingLinkIDs(ids ...uuid.UUID) {
-	if m.outgoing_links == nil {
-		m.outgoing_links = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.outgoing_links[ids[i]] = struct{}{}
-	}
+// LastTestAtCleared returns if the "last_test_at" field was cleared in this mutation.
+func (m *NoteConnectionAccountMutation) LastTestAtCleared() bool {
+	_, ok := m.clearedFields[noteconnectionaccount.FieldLastTestAt]
+	return ok
 }
 
-// ClearOutgoingLinks clears the "outgoing_links" edge to the NoteLink entity.
-func (m *NoteMutation) ClearOutgoingLinks() {
-	m.clearedoutgoing_links = true
+// ResetLastTestAt resets all changes to the "last_test_at" field.
+func (m *NoteConnectionAccountMutation) ResetLastTestAt() {
+	m.last_test_at = nil
+	delete(m.clearedFields, noteconnectionaccount.FieldLastTestAt)
 }
 
-// OutgoingLinksCleared reports if the "outgoing_links" edge to the NoteLink entity was cleared.
-func (m *NoteMutation) OutgoingLinksCleared() bool {
-	return m.clearedoutgoing_links
+// SetCreatedAt sets the "created_at" field.
+func (m *NoteConnectionAccountMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveOutgoingLinkIDs removes the "outgoing_links" edge to the NoteLink entity by IDs.
-func (m *NoteMutation) RemoveOutgoingLinkIDs(ids ...uuid.UUID) {
-	if m.removedoutgoing_links == nil {
-		m.removedoutgoing_links = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.outgoing_links, ids[i])
-		m.removedoutgoing_links[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NoteConnectionAccountMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedOutgoingLinks returns the removed IDs of the "outgoing_links" edge to the NoteLink entity.
-func (m *NoteMutation) RemovedOutgoingLinksIDs() (ids []uuid.UUID) {
-	for id := range m.removedoutgoing_links {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionAccountMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// OutgoingLinksIDs returns the "outgoing_links" edge IDs in the mutation.
-func (m *NoteMutation) OutgoingLinksIDs() (ids []uuid.UUID) {
-	for id := range m.outgoing_links {
-		ids = append(ids, id)
-	}
-	return
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NoteConnectionAccountMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ResetOutgoingLinks resets all changes to the "outgoing_links" edge.
-func (m *NoteMutation) ResetOutgoingLinks() {
-	m.outgoing_links = nil
-	m.clearedoutgoing_links = false
-	m.removedoutgoing_links = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NoteConnectionAccountMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// AddBacklinkIDs adds the "backlinks" edge to the NoteLink entity by ids.
-func (m *NoteMutation) AddBacklinkIDs(ids ...uuid.UUID) {
-	if m.backlinks == nil {
-		m.backlinks = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.backlinks[ids[i]] = struct{}{}
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NoteConnectionAccountMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearBacklinks clears the "backlinks" edge to the NoteLink entity.
-func (m *NoteMutation) ClearBacklinks() {
-	m.clearedbacklinks = true
+// OldUpdatedAt returns the old "updated_at" field's value of the NoteConnectionAccount entity.
+// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionAccountMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// BacklinksCleared reports if the "backlinks" edge to the NoteLink entity was cleared.
-func (m *NoteMutation) BacklinksCleared() bool {
-	return m.clearedbacklinks
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NoteConnectionAccountMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// RemoveBacklinkIDs removes the "backlinks" edge to the NoteLink entity by IDs.
-func (m *NoteMutation) RemoveBacklinkIDs(ids ...uuid.UUID) {
-	if m.removedbacklinks == nil {
-		m.removedbacklinks = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.backlinks, ids[i])
-		m.removedbacklinks[ids[i]] = struct{}{}
-	}
+// ClearUser clears the "user" edge to the User entity.
+func (m *NoteConnectionAccountMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[noteconnectionaccount.FieldUserID] = struct{}{}
 }
 
-// RemovedBacklinks returns the removed IDs of the "backlinks" edge to the NoteLink entity.
-func (m *NoteMutation) RemovedBacklinksIDs() (ids []uuid.UUID) {
-	for id := range m.removedbacklinks {
-		ids = append(ids, id)
-	}
-	return
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *NoteConnectionAccountMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// BacklinksIDs returns the "backlinks" edge IDs in the mutation.
-func (m *NoteMutation) BacklinksIDs() (ids []uuid.UUID) {
-	for id := range m.backlinks {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *NoteConnectionAccountMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetBacklinks resets all changes to the "backlinks" edge.
-func (m *NoteMutation) ResetBacklinks() {
-	m.backlinks = nil
-	m.clearedbacklinks = false
-	m.removedbacklinks = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *NoteConnectionAccountMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// AddConnectionMapIDs adds the "connection_maps" edge to the NoteConnectionItemMap entity by ids.
-func (m *NoteMutation) AddConnectionMapIDs(ids ...int) {
-	if m.connection_maps == nil {
-		m.connection_maps = make(map[int]struct{})
+// AddItemMapIDs adds the "item_maps" edge to the NoteConnectionItemMap entity by ids.
+func (m *NoteConnectionAccountMutation) AddItemMapIDs(ids ...int) {
+	if m.item_maps == nil {
+		m.item_maps = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.connection_maps[ids[i]] = struct{}{}
+		m.item_maps[ids[i]] = struct{}{}
 	}
 }
 
-// ClearConnectionMaps clears the "connection_maps" edge to the NoteConnectionItemMap entity.
-func (m *NoteMutation) ClearConnectionMaps() {
-	m.clearedconnection_maps = true
+// ClearItemMaps clears the "item_maps" edge to the NoteConnectionItemMap entity.
+func (m *NoteConnectionAccountMutation) ClearItemMaps() {
+	m.cleareditem_maps = true
 }
 
-// ConnectionMapsCleared reports if the "connection_maps" edge to the NoteConnectionItemMap entity was cleared.
-func (m *NoteMutation) ConnectionMapsCleared() bool {
-	return m.clearedconnection_maps
+// ItemMapsCleared reports if the "item_maps" edge to the NoteConnectionItemMap entity was cleared.
+func (m *NoteConnectionAccountMutation) ItemMapsCleared() bool {
+	return m.cleareditem_maps
 }
 
-// RemoveConnectionMapIDs removes the "connection_maps" edge to the NoteConnectionItemMap entity by IDs.
-func (m *NoteMutation) RemoveConnectionMapIDs(ids ...int) {
-	if m.removedconnection_maps == nil {
-		m.removedconnection_maps = make(map[int]struct{})
+// RemoveItemMapIDs removes the "item_maps" edge to the NoteConnectionItemMap entity by IDs.
+func (m *NoteConnectionAccountMutation) RemoveItemMapIDs(ids ...int) {
+	if m.removeditem_maps == nil {
+		m.removeditem_maps = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.connection_maps, ids[i])
-		m.removedconnection_maps[ids[i]] = struct{}{}
+		delete(m.item_maps, ids[i])
+		m.removeditem_maps[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedConnectionMaps returns the removed IDs of the "connection_maps" edge to the NoteConnectionItemMap entity.
-func (m *NoteMutation) RemovedConnectionMapsIDs() (ids []int) {
-	for id := range m.removedconnection_maps {
+// RemovedItemMaps returns the removed IDs of the "item_maps" edge to the NoteConnectionItemMap entity.
+func (m *NoteConnectionAccountMutation) RemovedItemMapsIDs() (ids []int) {
+	for id := range m.removeditem_maps {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ConnectionMapsIDs returns the "connection_maps" edge IDs in the mutation.
-func (m *NoteMutation) ConnectionMapsIDs() (ids []int) {
-	for id := range m.connection_maps {
+// ItemMapsIDs returns the "item_maps" edge IDs in the mutation.
+func (m *NoteConnectionAccountMutation) ItemMapsIDs() (ids []int) {
+	for id := range m.item_maps {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetConnectionMaps resets all changes to the "connection_maps" edge.
-func (m *NoteMutation) ResetConnectionMaps() {
-	m.connection_maps = nil
-	m.clearedconnection_maps = false
-	m.removedconnection_maps = nil
+// ResetItemMaps resets all changes to the "item_maps" edge.
+func (m *NoteConnectionAccountMutation) ResetItemMaps() {
+	m.item_maps = nil
+	m.cleareditem_maps = false
+	m.removeditem_maps = nil
 }
 
-// AddConnectionJobIDs adds the "connection_jobs" edge to the NoteConnectionJob entity by ids.
-func (m *NoteMutation) AddConnectionJobIDs(ids ...int) {
-	if m.connection_jobs == nil {
-		m.connection_jobs = make(map[int]struct{})
+// AddJobIDs adds the "jobs" edge to the NoteConnectionJob entity by ids.
+func (m *NoteConnectionAccountMutation) AddJobIDs(ids ...int) {
+	if m.jobs == nil {
+		m.jobs = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.connection_jobs[ids[i]] = struct{}{}
+		m.jobs[ids[i]] = struct{}{}
 	}
 }
 
-// ClearConnectionJobs clears the "connection_jobs" edge to the NoteConnectionJob entity.
-func (m *NoteMutation) ClearConnectionJobs() {
-	m.clearedconnection_jobs = true
+// ClearJobs clears the "jobs" edge to the NoteConnectionJob entity.
+func (m *NoteConnectionAccountMutation) ClearJobs() {
+	m.clearedjobs = true
 }
 
-// ConnectionJobsCleared reports if the "connection_jobs" edge to the NoteConnectionJob entity was cleared.
-func (m *NoteMutation) ConnectionJobsCleared() bool {
-	return m.clearedconnection_jobs
+// JobsCleared reports if the "jobs" edge to the NoteConnectionJob entity was cleared.
+func (m *NoteConnectionAccountMutation) JobsCleared() bool {
+	return m.clearedjobs
 }
 
-// RemoveConnectionJobIDs removes the "connection_jobs" edge to the NoteConnectionJob entity by IDs.
-func (m *NoteMutation) RemoveConnectionJobIDs(ids ...int) {
-	if m.removedconnection_jobs == nil {
-		m.removedconnection_jobs = make(map[int]struct{})
+// RemoveJobIDs removes the "jobs" edge to the NoteConnectionJob entity by IDs.
+func (m *NoteConnectionAccountMutation) RemoveJobIDs(ids ...int) {
+	if m.removedjobs == nil {
+		m.removedjobs = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.connection_jobs, ids[i])
-		m.removedconnection_jobs[ids[i]] = struct{}{}
+		delete(m.jobs, ids[i])
+		m.removedjobs[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedConnectionJobs returns the removed IDs of the "connection_jobs" edge to the NoteConnectionJob entity.
-func (m *NoteMutation) RemovedConnectionJobsIDs() (ids []int) {
-	for id := range m.removedconnection_jobs {
+// RemovedJobs returns the removed IDs of the "jobs" edge to the NoteConnectionJob entity.
+func (m *NoteConnectionAccountMutation) RemovedJobsIDs() (ids []int) {
+	for id := range m.removedjobs {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ConnectionJobsIDs returns the "connection_jobs" edge IDs in the mutation.
-func (m *NoteMutation) ConnectionJobsIDs() (ids []int) {
-	for id := range m.connection_jobs {
+// JobsIDs returns the "jobs" edge IDs in the mutation.
+func (m *NoteConnectionAccountMutation) JobsIDs() (ids []int) {
+	for id := range m.jobs {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetConnectionJobs resets all changes to the "connection_jobs" edge.
-func (m *NoteMutation) ResetConnectionJobs() {
-	m.connection_jobs = nil
-	m.clearedconnection_jobs = false
-	m.removedconnection_jobs = nil
+// ResetJobs resets all changes to the "jobs" edge.
+func (m *NoteConnectionAccountMutation) ResetJobs() {
+	m.jobs = nil
+	m.clearedjobs = false
+	m.removedjobs = nil
 }
 
-// AddTagIDs adds the "tags" edge to the Tag entity by ids.
-func (m *NoteMutation) AddTagIDs(ids ...uuid.UUID) {
-	if m.tags == nil {
-		m.tags = make(map[uuid.UUID]struct{})
+// AddConflictIDs adds the "conflicts" edge to the NoteConnectionConflict entity by ids.
+func (m *NoteConnectionAccountMutation) AddConflictIDs(ids ...int) {
+	if m.conflicts == nil {
+		m.conflicts = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.tags[ids[i]] = struct{}{}
+		m.conflicts[ids[i]] = struct{}{}
 	}
 }
 
-// ClearTags clears the "tags" edge to the Tag entity.
-func (m *NoteMutation) ClearTags() {
-	m.clearedtags = true
+// ClearConflicts clears the "conflicts" edge to the NoteConnectionConflict entity.
+func (m *NoteConnectionAccountMutation) ClearConflicts() {
+	m.clearedconflicts = true
 }
 
-// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
-func (m *NoteMutation) TagsCleared() bool {
-	return m.clearedtags
+// ConflictsCleared reports if the "conflicts" edge to the NoteConnectionConflict entity was cleared.
+func (m *NoteConnectionAccountMutation) ConflictsCleared() bool {
+	return m.clearedconflicts
 }
 
-// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
-func (m *NoteMutation) RemoveTagIDs(ids ...uuid.UUID) {
-	if m.removedtags == nil {
-		m.removedtags = make(map[uuid.UUID]struct{})
+// RemoveConflictIDs removes the "conflicts" edge to the NoteConnectionConflict entity by IDs.
+func (m *NoteConnectionAccountMutation) RemoveConflictIDs(ids ...int) {
+	if m.removedconflicts == nil {
+		m.removedconflicts = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.tags, ids[i])
-		m.removedtags[ids[i]] = struct{}{}
+		delete(m.conflicts, ids[i])
+		m.removedconflicts[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
-func (m *NoteMutation) RemovedTagsIDs() (ids []uuid.UUID) {
-	for id := range m.removedtags {
+// RemovedConflicts returns the removed IDs of the "conflicts" edge to the NoteConnectionConflict entity.
+func (m *NoteConnectionAccountMutation) RemovedConflictsIDs() (ids []int) {
+	for id := range m.removedconflicts {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// TagsIDs returns the "tags" edge IDs in the mutation.
-func (m *NoteMutation) TagsIDs() (ids []uuid.UUID) {
-	for id := range m.tags {
+// ConflictsIDs returns the "conflicts" edge IDs in the mutation.
+func (m *NoteConnectionAccountMutation) ConflictsIDs() (ids []int) {
+	for id := range m.conflicts {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTags resets all changes to the "tags" edge.
-func (m *NoteMutation) ResetTags() {
-	m.tags = nil
-	m.clearedtags = false
-	m.removedtags = nil
+// ResetConflicts resets all changes to the "conflicts" edge.
+func (m *NoteConnectionAccountMutation) ResetConflicts() {
+	m.conflicts = nil
+	m.clearedconflicts = false
+	m.removedconflicts = nil
 }
 
-// Where appends a list predicates to the NoteMutation builder.
-func (m *NoteMutation) Where(ps ...predicate.Note) {
+// Where appends a list predicates to the NoteConnectionAccountMutation builder.
+func (m *NoteConnectionAccountMutation) Where(ps ...predicate.NoteConnectionAccount) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NoteMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NoteConnectionAccountMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NoteMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Note, len(ps))
+func (m *NoteConnectionAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NoteConnectionAccount, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -11446,66 +19280,78 @@ func (m *NoteMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NoteMutation) Op() Op {
+func (m *NoteConnectionAccountMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NoteMutation) SetOp(op Op) {
+func (m *NoteConnectionAccountMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Note).
-func (m *NoteMutation) Type() string {
+// Type returns the node type of this mutation (NoteConnectionAccount).
+func (m *NoteConnectionAccountMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NoteMutation) Fields() []string {
-	fields := make([]string, 0, 14)
-	if m.title != nil {
-		fields = append(fields, note.FieldTitle)
+func (m *NoteConnectionAccountMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.name != nil {
+		fields = append(fields, noteconnectionaccount.FieldName)
 	}
-	if m.content != nil {
-		fields = append(fields, note.FieldContent)
+	if m.provider != nil {
+		fields = append(fields, noteconnectionaccount.FieldProvider)
 	}
-	if m.color != nil {
-		fields = append(fields, note.FieldColor)
+	if m.user != nil {
+		fields = append(fields, noteconnectionaccount.FieldUserID)
 	}
-	if m.protection_mode != nil {
-		fields = append(fields, note.FieldProtectionMode)
+	if m.endpoint != nil {
+		fields = append(fields, noteconnectionaccount.FieldEndpoint)
 	}
-	if m.protection_password_hash != nil {
-		fields = append(fields, note.FieldProtectionPasswordHash)
+	if m.enabled != nil {
+		fields = append(fields, noteconnectionaccount.FieldEnabled)
 	}
-	if m.encrypted_content != nil {
-		fields = append(fields, note.FieldEncryptedContent)
+	if m.auth_type != nil {
+		fields = append(fields, noteconnectionaccount.FieldAuthType)
 	}
-	if m.encryption_alg != nil {
-		fields = append(fields, note.FieldEncryptionAlg)
+	if m.encrypted_credentials != nil {
+		fields = append(fields, noteconnectionaccount.FieldEncryptedCredentials)
 	}
-	if m.encryption_kdf != nil {
-		fields = append(fields, note.FieldEncryptionKdf)
+	if m.credential_alg != nil {
+		fields = append(fields, noteconnectionaccount.FieldCredentialAlg)
 	}
-	if m.encryption_salt != nil {
-		fields = append(fields, note.FieldEncryptionSalt)
+	if m.default_target_id != nil {
+		fields = append(fields, noteconnectionaccount.FieldDefaultTargetID)
 	}
-	if m.encryption_nonce != nil {
-		fields = append(fields, note.FieldEncryptionNonce)
+	if m.default_target_name != nil {
+		fields = append(fields, noteconnectionaccount.FieldDefaultTargetName)
 	}
-	if m.is_starred != nil {
-		fields = append(fields, note.FieldIsStarred)
+	if m.sync_interval_minutes != nil {
+		fields = append(fields, noteconnectionaccount.FieldSyncIntervalMinutes)
 	}
-	if m.is_deleted != nil {
-		fields = append(fields, note.FieldIsDeleted)
+	if m.encrypted_webhook_secret != nil {
+		fields = append(fields, noteconnectionaccount.FieldEncryptedWebhookSecret)
+	}
+	if m.metadata_json != nil {
+		fields = append(fields, noteconnectionaccount.FieldMetadataJSON)
+	}
+	if m.last_test_status != nil {
+		fields = append(fields, noteconnectionaccount.FieldLastTestStatus)
+	}
+	if m.last_test_error != nil {
+		fields = append(fields, noteconnectionaccount.FieldLastTestError)
+	}
+	if m.last_test_at != nil {
+		fields = append(fields, noteconnectionaccount.FieldLastTestAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, note.FieldCreatedAt)
+		fields = append(fields, noteconnectionaccount.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, note.FieldUpdatedAt)
+		fields = append(fields, noteconnectionaccount.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -11513,35 +19359,43 @@ func (m *NoteMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NoteMutation) Field(name string) (ent.Value, bool) {
+func (m *NoteConnectionAccountMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case note.FieldTitle:
-		return m.Title()
-	case note.FieldContent:
-		return m.Content()
-	case note.FieldColor:
-		return m.Color()
-	case note.FieldProtectionMode:
-		return m.ProtectionMode()
-	case note.FieldProtectionPasswordHash:
-		return m.ProtectionPasswordHash()
-	case note.FieldEncryptedContent:
-		return m.EncryptedContent()
-	case note.FieldEncryptionAlg:
-		return m.EncryptionAlg()
-	case note.FieldEncryptionKdf:
-		return m.EncryptionKdf()
-	case note.FieldEncryptionSalt:
-		return m.EncryptionSalt()
-	case note.FieldEncryptionNonce:
-		return m.EncryptionNonce()
-	case note.FieldIsStarred:
-		return m.IsStarred()
-	case note.FieldIsDeleted:
-		return m.IsDeleted()
-	case note.FieldCreatedAt:
+	case noteconnectionaccount.FieldName:
+		return m.Name()
+	case noteconnectionaccount.FieldProvider:
+		return m.Provider()
+	case noteconnectionaccount.FieldUserID:
+		return m.UserID()
+	case noteconnectionaccount.FieldEndpoint:
+		return m.Endpoint()
+	case noteconnectionaccount.FieldEnabled:
+		return m.Enabled()
+	case noteconnectionaccount.FieldAuthType:
+		return m.AuthType()
+	case noteconnectionaccount.FieldEncryptedCredentials:
+		return m.EncryptedCredentials()
+	case noteconnectionaccount.FieldCredentialAlg:
+		return m.CredentialAlg()
+	case noteconnectionaccount.FieldDefaultTargetID:
+		return m.DefaultTargetID()
+	case noteconnectionaccount.FieldDefaultTargetName:
+		return m.DefaultTargetName()
+	case noteconnectionaccount.FieldSyncIntervalMinutes:
+		return m.SyncIntervalMinutes()
+	case noteconnectionaccount.FieldEncryptedWebhookSecret:
+		return m.EncryptedWebhookSecret()
+	case noteconnectionaccount.FieldMetadataJSON:
+		return m.MetadataJSON()
+	case noteconnectionaccount.FieldLastTestStatus:
+		return m.LastTestStatus()
+	case noteconnectionaccount.FieldLastTestError:
+		return m.LastTestError()
+	case noteconnectionaccount.FieldLastTestAt:
+		return m.LastTestAt()
+	case noteconnectionaccount.FieldCreatedAt:
 		return m.CreatedAt()
-	case note.FieldUpdatedAt:
+	case noteconnectionaccount.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -11550,137 +19404,173 @@ func (m *NoteMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NoteConnectionAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case note.FieldTitle:
-		return m.OldTitle(ctx)
-	case note.FieldContent:
-		return m.OldContent(ctx)
-	case note.FieldColor:
-		return m.OldColor(ctx)
-	case note.FieldProtectionMode:
-		return m.OldProtectionMode(ctx)
-	case note.FieldProtectionPasswordHash:
-		return m.OldProtectionPasswordHash(ctx)
-	case note.FieldEncryptedContent:
-		return m.OldEncryptedContent(ctx)
-	case note.FieldEncryptionAlg:
-		return m.OldEncryptionAlg(ctx)
-	case note.FieldEncryptionKdf:
-		return m.OldEncryptionKdf(ctx)
-	case note.FieldEncryptionSalt:
-		return m.OldEncryptionSalt(ctx)
-	case note.FieldEncryptionNonce:
-		return m.OldEncryptionNonce(ctx)
-	case note.FieldIsStarred:
-		return m.OldIsStarred(ctx)
-	case note.FieldIsDeleted:
-		return m.OldIsDeleted(ctx)
-	case note.FieldCreatedAt:
+	case noteconnectionaccount.FieldName:
+		return m.OldName(ctx)
+	case noteconnectionaccount.FieldProvider:
+		return m.OldProvider(ctx)
+	case noteconnectionaccount.FieldUserID:
+		return m.OldUserID(ctx)
+	case noteconnectionaccount.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case noteconnectionaccount.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case noteconnectionaccount.FieldAuthType:
+		return m.OldAuthType(ctx)
+	case noteconnectionaccount.FieldEncryptedCredentials:
+		return m.OldEncryptedCredentials(ctx)
+	case noteconnectionaccount.FieldCredentialAlg:
+		return m.OldCredentialAlg(ctx)
+	case noteconnectionaccount.FieldDefaultTargetID:
+		return m.OldDefaultTargetID(ctx)
+	case noteconnectionaccount.FieldDefaultTargetName:
+		return m.OldDefaultTargetName(ctx)
+	case noteconnectionaccount.FieldSyncIntervalMinutes:
+		return m.OldSyncIntervalMinutes(ctx)
+	case noteconnectionaccount.FieldEncryptedWebhookSecret:
+		return m.OldEncryptedWebhookSecret(ctx)
+	case noteconnectionaccount.FieldMetadataJSON:
+		return m.OldMetadataJSON(ctx)
+	case noteconnectionaccount.FieldLastTestStatus:
+		return m.OldLastTestStatus(ctx)
+	case noteconnectionaccount.FieldLastTestError:
+		return m.OldLastTestError(ctx)
+	case noteconnectionaccount.FieldLastTestAt:
+		return m.OldLastTestAt(ctx)
+	case noteconnectionaccount.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case note.FieldUpdatedAt:
+	case noteconnectionaccount.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Note field %s", name)
+	return nil, fmt.Errorf("unknown NoteConnectionAccount field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NoteMutation) SetField(name string, value ent.Value) error {
+func (m *NoteConnectionAccountMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case note.FieldTitle:
+	case noteconnectionaccount.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetName(v)
 		return nil
-	case note.FieldContent:
+	case noteconnectionaccount.FieldProvider:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetProvider(v)
 		return nil
-	case note.FieldColor:
+	case noteconnectionaccount.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case noteconnectionaccount.FieldEndpoint:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetColor(v)
+		m.SetEndpoint(v)
 		return nil
-	case note.FieldProtectionMode:
-		v, ok := value.(note.ProtectionMode)
+	case noteconnectionaccount.FieldEnabled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProtectionMode(v)
+		m.SetEnabled(v)
 		return nil
-	case note.FieldProtectionPasswordHash:
+	case noteconnectionaccount.FieldAuthType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProtectionPasswordHash(v)
+		m.SetAuthType(v)
 		return nil
-	case note.FieldEncryptedContent:
+	case noteconnectionaccount.FieldEncryptedCredentials:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEncryptedContent(v)
+		m.SetEncryptedCredentials(v)
 		return nil
-	case note.FieldEncryptionAlg:
+	case noteconnectionaccount.FieldCredentialAlg:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEncryptionAlg(v)
+		m.SetCredentialAlg(v)
 		return nil
-	case note.FieldEncryptionKdf:
+	case noteconnectionaccount.FieldDefaultTargetID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEncryptionKdf(v)
+		m.SetDefaultTargetID(v)
 		return nil
-	case note.FieldEncryptionSalt:
+	case noteconnectionaccount.FieldDefaultTargetName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEncryptionSalt(v)
+		m.SetDefaultTargetName(v)
 		return nil
-	case note.FieldEncryptionNonce:
+	case noteconnectionaccount.FieldSyncIntervalMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSyncIntervalMinutes(v)
+		return nil
+	case noteconnectionaccount.FieldEncryptedWebhookSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEncryptionNonce(v)
+		m.SetEncryptedWebhookSecret(v)
 		return nil
-	case note.FieldIsStarred:
-		v, ok := value.(bool)
+	case noteconnectionaccount.FieldMetadataJSON:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsStarred(v)
+		m.SetMetadataJSON(v)
 		return nil
-	case note.FieldIsDeleted:
-		v, ok := value.(bool)
+	case noteconnectionaccount.FieldLastTestStatus:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDeleted(v)
+		m.SetLastTestStatus(v)
 		return nil
-	case note.FieldCreatedAt:
+	case noteconnectionaccount.FieldLastTestError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastTestError(v)
+		return nil
+	case noteconnectionaccount.FieldLastTestAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastTestAt(v)
+		return nil
+	case noteconnectionaccount.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case note.FieldUpdatedAt:
+	case noteconnectionaccount.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -11688,235 +19578,225 @@ func (m *NoteMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Note field %s", name)
+	return fmt.Errorf("unknown NoteConnectionAccount field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NoteMutation) AddedFields() []string {
-	return nil
+func (m *NoteConnectionAccountMutation) AddedFields() []string {
+	var fields []string
+	if m.addsync_interval_minutes != nil {
+		fields = append(fields, noteconnectionaccount.FieldSyncIntervalMinutes)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NoteMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NoteConnectionAccountMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case noteconnectionaccount.FieldSyncIntervalMinutes:
+		return m.AddedSyncIntervalMinutes()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NoteMutation) AddField(name string, value ent.Value) error {
+func (m *NoteConnectionAccountMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case noteconnectionaccount.FieldSyncIntervalMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSyncIntervalMinutes(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Note numeric field %s", name)
+	return fmt.Errorf("unknown NoteConnectionAccount numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NoteMutation) ClearedFields() []string {
+func (m *NoteConnectionAccountMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(note.FieldContent) {
-		fields = append(fields, note.FieldContent)
+	if m.FieldCleared(noteconnectionaccount.FieldEndpoint) {
+		fields = append(fields, noteconnectionaccount.FieldEndpoint)
 	}
-	if m.FieldCleared(note.FieldColor) {
-		fields = append(fields, note.FieldColor)
+	if m.FieldCleared(noteconnectionaccount.FieldEncryptedCredentials) {
+		fields = append(fields, noteconnectionaccount.FieldEncryptedCredentials)
 	}
-	if m.FieldCleared(note.FieldProtectionPasswordHash) {
-		fields = append(fields, note.FieldProtectionPasswordHash)
+	if m.FieldCleared(noteconnectionaccount.FieldCredentialAlg) {
+		fields = append(fields, noteconnectionaccount.FieldCredentialAlg)
 	}
-	if m.FieldCleared(note.FieldEncryptedContent) {
-		fields = append(fields, note.FieldEncryptedContent)
+	if m.FieldCleared(noteconnectionaccount.FieldDefaultTargetID) {
+		fields = append(fields, noteconnectionaccount.FieldDefaultTargetID)
 	}
-	if m.FieldCleared(note.FieldEncryptionAlg) {
-		fields = append(fields, note.FieldEncryptionAlg)
+	if m.FieldCleared(noteconnectionaccount.FieldDefaultTargetName) {
+		fields = append(fields, noteconnectionaccount.FieldDefaultTargetName)
 	}
-	if m.FieldCleared(note.FieldEncryptionKdf) {
-		fields = append(fields, note.FieldEncryptionKdf)
+	if m.FieldCleared(noteconnectionaccount.FieldEncryptedWebhookSecret) {
+		fields = append(fields, noteconnectionaccount.FieldEncryptedWebhookSecret)
 	}
-	if m.FieldCleared(note.FieldEncryptionSalt) {
-		fields = append(fields, note.FieldEncryptionSalt)
+	if m.FieldCleared(noteconnectionaccount.FieldMetadataJSON) {
+		fields = append(fields, noteconnectionaccount.FieldMetadataJSON)
 	}
-	if m.FieldCleared(note.FieldEncryptionNonce) {
-		fields = append(fields, note.FieldEncryptionNonce)
+	if m.FieldCleared(noteconnectionaccount.FieldLastTestError) {
+		fields = append(fields, noteconnectionaccount.FieldLastTestError)
+	}
+	if m.FieldCleared(noteconnectionaccount.FieldLastTestAt) {
+		fields = append(fields, noteconnectionaccount.FieldLastTestAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NoteMutation) FieldCleared(name string) bool {
+func (m *NoteConnectionAccountMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NoteMutation) ClearField(name string) error {
+func (m *NoteConnectionAccountMutation) ClearField(name string) error {
 	switch name {
-	case note.FieldContent:
-		m.ClearContent()
+	case noteconnectionaccount.FieldEndpoint:
+		m.ClearEndpoint()
 		return nil
-	case note.FieldColor:
-		m.ClearColor()
+	case noteconnectionaccount.FieldEncryptedCredentials:
+		m.ClearEncryptedCredentials()
 		return nil
-	case note.FieldProtectionPasswordHash:
-		m.ClearProtectionPasswordHash()
+	case noteconnectionaccount.FieldCredentialAlg:
+		m.ClearCredentialAlg()
 		return nil
-	case note.FieldEncryptedContent:
-		m.ClearEncryptedContent()
+	case noteconnectionaccount.FieldDefaultTargetID:
+		m.ClearDefaultTargetID()
 		return nil
-	case note.FieldEncryptionAlg:
-		m.ClearEncryptionAlg()
+	case noteconnectionaccount.FieldDefaultTargetName:
+		m.ClearDefaultTargetName()
 		return nil
-	case note.FieldEncryptionKdf:
-		m.ClearEncryptionKdf()
+	case noteconnectionaccount.FieldEncryptedWebhookSecret:
+		m.ClearEncryptedWebhookSecret()
 		return nil
-	case note.FieldEncryptionSalt:
-		m.ClearEncryptionSalt()
+	case noteconnectionaccount.FieldMetadataJSON:
+		m.ClearMetadataJSON()
 		return nil
-	case note.FieldEncryptionNonce:
-		m.ClearEncryptionNonce()
+	case noteconnectionaccount.FieldLastTestError:
+		m.ClearLastTestError()
+		return nil
+	case noteconnectionaccount.FieldLastTestAt:
+		m.ClearLastTestAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Note nullable field %s", name)
+	return fmt.Errorf("unknown NoteConnectionAccount nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NoteMutation) ResetField(name string) error {
+func (m *NoteConnectionAccountMutation) ResetField(name string) error {
 	switch name {
-	case note.FieldTitle:
-		m.ResetTitle()
+	case noteconnectionaccount.FieldName:
+		m.ResetName()
 		return nil
-	case note.FieldContent:
-		m.ResetContent()
+	case noteconnectionaccount.FieldProvider:
+		m.ResetProvider()
 		return nil
-	case note.FieldColor:
-		m.ResetColor()
+	case noteconnectionaccount.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case note.FieldProtectionMode:
-		m.ResetProtectionMode()
+	case noteconnectionaccount.FieldEndpoint:
+		m.ResetEndpoint()
 		return nil
-	case note.FieldProtectionPasswordHash:
-		m.ResetProtectionPasswordHash()
+	case noteconnectionaccount.FieldEnabled:
+		m.ResetEnabled()
 		return nil
-	case note.FieldEncryptedContent:
-		m.ResetEncryptedContent()
+	case noteconnectionaccount.FieldAuthType:
+		m.ResetAuthType()
 		return nil
-	case note.FieldEncryptionAlg:
-		m.ResetEncryptionAlg()
+	case noteconnectionaccount.FieldEncryptedCredentials:
+		m.ResetEncryptedCredentials()
 		return nil
-	case note.FieldEncryptionKdf:
-		m.ResetEncryptionKdf()
+	case noteconnectionaccount.FieldCredentialAlg:
+		m.ResetCredentialAlg()
 		return nil
-	case note.FieldEncryptionSalt:
-		m.ResetEncryptionSalt()
+	case noteconnectionaccount.FieldDefaultTargetID:
+		m.ResetDefaultTargetID()
 		return nil
-	case note.FieldEncryptionNonce:
-		m.ResetEncryptionNonce()
+	case noteconnectionaccount.FieldDefaultTargetName:
+		m.ResetDefaultTargetName()
 		return nil
-	case note.FieldIsStarred:
-		m.ResetIsStarred()
+	case noteconnectionaccount.FieldSyncIntervalMinutes:
+		m.ResetSyncIntervalMinutes()
 		return nil
-	case note.FieldIsDeleted:
-		m.ResetIsDeleted()
+	case noteconnectionaccount.FieldEncryptedWebhookSecret:
+		m.ResetEncryptedWebhookSecret()
 		return nil
-	case note.FieldCreatedAt:
+	case noteconnectionaccount.FieldMetadataJSON:
+		m.ResetMetadataJSON()
+		return nil
+	case noteconnectionaccount.FieldLastTestStatus:
+		m.ResetLastTestStatus()
+		return nil
+	case noteconnectionaccount.FieldLastTestError:
+		m.ResetLastTestError()
+		return nil
+	case noteconnectionaccount.FieldLastTestAt:
+		m.ResetLastTestAt()
+		return nil
+	case noteconnectionaccount.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case note.FieldUpdatedAt:
+	case noteconnectionaccount.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Note field %s", name)
+	return fmt.Errorf("unknown NoteConnectionAccount field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NoteMutation) AddedEdges() []string {
-	edges := make([]string, 0, 9)
+func (m *NoteConnectionAccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.user != nil {
-		edges = append(edges, note.EdgeUser)
-	}
-	if m.folder != nil {
-		edges = append(edges, note.EdgeFolder)
-	}
-	if m.attachments != nil {
-		edges = append(edges, note.EdgeAttachments)
-	}
-	if m.whiteboards != nil {
-		edges = append(edges, note.EdgeWhiteboards)
-	}
-	if m.outgoing_links != nil {
-		edges = append(edges, note.EdgeOutgoingLinks)
-	}
-	if m.backlinks != nil {
-		edges = append(edges, note.EdgeBacklinks)
+		edges = append(edges, noteconnectionaccount.EdgeUser)
 	}
-	if m.connection_maps != nil {
-		edges = append(edges, note.EdgeConnectionMaps)
+	if m.item_maps != nil {
+		edges = append(edges, noteconnectionaccount.EdgeItemMaps)
 	}
-	if m.connection_jobs != nil {
-		edges = append(edges, note.EdgeConnectionJobs)
+	if m.jobs != nil {
+		edges = append(edges, noteconnectionaccount.EdgeJobs)
 	}
-	if m.tags != nil {
-		edges = append(edges, note.EdgeTags)
+	if m.conflicts != nil {
+		edges = append(edges, noteconnectionaccount.EdgeConflicts)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NoteMutation) AddedIDs(name string) []ent.Value {
+func (m *NoteConnectionAccountMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case note.EdgeUser:
+	case noteconnectionaccount.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case note.EdgeFolder:
-		if id := m.folder; id != nil {
-			return []ent.Value{*id}
-		}
-	case note.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.attachments))
-		for id := range m.attachments {
-			ids = append(ids, id)
-		}
-		return ids
-	case note.EdgeWhiteboards:
-		ids := make([]ent.Value, 0, len(m.whiteboards))
-		for id := range m.whiteboards {
-			ids = append(ids, id)
-		}
-		return ids
-	case note.EdgeOutgoingLinks:
-		ids := make([]ent.Value, 0, len(m.outgoing_links))
-		for id := range m.outgoing_links {
-			ids = append(ids, id)
-		}
-		return ids
-	case note.EdgeBacklinks:
-		ids := make([]ent.Value, 0, len(m.backlinks))
-		for id := range m.backlinks {
-			ids = append(ids, id)
-		}
-		return ids
-	case note.EdgeConnectionMaps:
-		ids := make([]ent.Value, 0, len(m.connection_maps))
-		for id := range m.connection_maps {
+	case noteconnectionaccount.EdgeItemMaps:
+		ids := make([]ent.Value, 0, len(m.item_maps))
+		for id := range m.item_maps {
 			ids = append(ids, id)
 		}
 		return ids
-	case note.EdgeConnectionJobs:
-		ids := make([]ent.Value, 0, len(m.connection_jobs))
-		for id := range m.connection_jobs {
+	case noteconnectionaccount.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.jobs))
+		for id := range m.jobs {
 			ids = append(ids, id)
 		}
 		return ids
-	case note.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.tags))
-		for id := range m.tags {
+	case noteconnectionaccount.EdgeConflicts:
+		ids := make([]ent.Value, 0, len(m.conflicts))
+		for id := range m.conflicts {
 			ids = append(ids, id)
 		}
 		return ids
@@ -11925,75 +19805,39 @@ func (m *NoteMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NoteMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 9)
-	if m.removedattachments != nil {
-		edges = append(edges, note.EdgeAttachments)
-	}
-	if m.removedwhiteboards != nil {
-		edges = append(edges, note.EdgeWhiteboards)
-	}
-	if m.removedoutgoing_links != nil {
-		edges = append(edges, note.EdgeOutgoingLinks)
-	}
-	if m.removedbacklinks != nil {
-		edges = append(edges, note.EdgeBacklinks)
-	}
-	if m.removedconnection_maps != nil {
-		edges = append(edges, note.EdgeConnectionMaps)
+func (m *NoteConnectionAccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removeditem_maps != nil {
+		edges = append(edges, noteconnectionaccount.EdgeItemMaps)
 	}
-	if m.removedconnection_jobs != nil {
-		edges = append(edges, note.EdgeConnectionJobs)
+	if m.removedjobs != nil {
+		edges = append(edges, noteconnectionaccount.EdgeJobs)
 	}
-	if m.removedtags != nil {
-		edges = append(edges, note.EdgeTags)
+	if m.removedconflicts != nil {
+		edges = append(edges, noteconnectionaccount.EdgeConflicts)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NoteMutation) RemovedIDs(name string) []ent.Value {
+func (m *NoteConnectionAccountMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case note.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.removedattachments))
-		for id := range m.removedattachments {
-			ids = append(ids, id)
-		}
-		return ids
-	case note.EdgeWhiteboards:
-		ids := make([]ent.Value, 0, len(m.removedwhiteboards))
-		for id := range m.removedwhiteboards {
-			ids = append(ids, id)
-		}
-		return ids
-	case note.EdgeOutgoingLinks:
-		ids := make([]ent.Value, 0, len(m.removedoutgoing_links))
-		for id := range m.removedoutgoing_links {
-			ids = append(ids, id)
-		}
-		return ids
-	case note.EdgeBacklinks:
-		ids := make([]ent.Value, 0, len(m.removedbacklinks))
-		for id := range m.removedbacklinks {
-			ids = append(ids, id)
-		}
-		return ids
-	case note.EdgeConnectionMaps:
-		ids := make([]ent.Value, 0, len(m.removedconnection_maps))
-		for id := range m.removedconnection_maps {
+	case noteconnectionaccount.EdgeItemMaps:
+		ids := make([]ent.Value, 0, len(m.removeditem_maps))
+		for id := range m.removeditem_maps {
 			ids = append(ids, id)
 		}
 		return ids
-	case note.EdgeConnectionJobs:
-		ids := make([]ent.Value, 0, len(m.removedconnection_jobs))
-		for id := range m.removedconnection_jobs {
+	case noteconnectionaccount.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.removedjobs))
+		for id := range m.removedjobs {
 			ids = append(ids, id)
 		}
 		return ids
-	case note.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.removedtags))
-		for id := range m.removedtags {
+	case noteconnectionaccount.EdgeConflicts:
+		ids := make([]ent.Value, 0, len(m.removedconflicts))
+		for id := range m.removedconflicts {
 			ids = append(ids, id)
 		}
 		return ids
@@ -12002,159 +19846,108 @@ func (m *NoteMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NoteMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 9)
+func (m *NoteConnectionAccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.cleareduser {
-		edges = append(edges, note.EdgeUser)
-	}
-	if m.clearedfolder {
-		edges = append(edges, note.EdgeFolder)
-	}
-	if m.clearedattachments {
-		edges = append(edges, note.EdgeAttachments)
-	}
-	if m.clearedwhiteboards {
-		edges = append(edges, note.EdgeWhiteboards)
-	}
-	if m.clearedoutgoing_links {
-		edges = append(edges, note.EdgeOutgoingLinks)
-	}
-	if m.clearedbacklinks {
-		edges = append(edges, note.EdgeBacklinks)
+		edges = append(edges, noteconnectionaccount.EdgeUser)
 	}
-	if m.clearedconnection_maps {
-		edges = append(edges, note.EdgeConnectionMaps)
+	if m.cleareditem_maps {
+		edges = append(edges, noteconnectionaccount.EdgeItemMaps)
 	}
-	if m.clearedconnection_jobs {
-		edges = append(edges, note.EdgeConnectionJobs)
+	if m.clearedjobs {
+		edges = append(edges, noteconnectionaccount.EdgeJobs)
 	}
-	if m.clearedtags {
-		edges = append(edges, note.EdgeTags)
+	if m.clearedconflicts {
+		edges = append(edges, noteconnectionaccount.EdgeConflicts)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NoteMutation) EdgeCleared(name string) bool {
+func (m *NoteConnectionAccountMutation) EdgeCleared(name string) bool {
 	switch name {
-	case note.EdgeUser:
+	case noteconnectionaccount.EdgeUser:
 		return m.cleareduser
-	case note.EdgeFolder:
-		return m.clearedfolder
-	case note.EdgeAttachments:
-		return m.clearedattachments
-	case note.EdgeWhiteboards:
-		return m.clearedwhiteboards
-	case note.EdgeOutgoingLinks:
-		return m.clearedoutgoing_links
-	case note.EdgeBacklinks:
-		return m.clearedbacklinks
-	case note.EdgeConnectionMaps:
-		return m.clearedconnection_maps
-	case note.EdgeConnectionJobs:
-		return m.clearedconnection_jobs
-	case note.EdgeTags:
-		return m.clearedtags
+	case noteconnectionaccount.EdgeItemMaps:
+		return m.cleareditem_maps
+	case noteconnectionaccount.EdgeJobs:
+		return m.clearedjobs
+	case noteconnectionaccount.EdgeConflicts:
+		return m.clearedconflicts
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NoteMutation) ClearEdge(name string) error {
+func (m *NoteConnectionAccountMutation) ClearEdge(name string) error {
 	switch name {
-	case note.EdgeUser:
+	case noteconnectionaccount.EdgeUser:
 		m.ClearUser()
 		return nil
-	case note.EdgeFolder:
-		m.ClearFolder()
-		return nil
 	}
-	return fmt.Errorf("unknown Note unique edge %s", name)
+	return fmt.Errorf("unknown NoteConnectionAccount unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NoteMutation) ResetEdge(name string) error {
+func (m *NoteConnectionAccountMutation) ResetEdge(name string) error {
 	switch name {
-	case note.EdgeUser:
+	case noteconnectionaccount.EdgeUser:
 		m.ResetUser()
 		return nil
-	case note.EdgeFolder:
-		m.ResetFolder()
-		return nil
-	case note.EdgeAttachments:
-		m.ResetAttachments()
-		return nil
-	case note.EdgeWhiteboards:
-		m.ResetWhiteboards()
-		return nil
-	case note.EdgeOutgoingLinks:
-		m.ResetOutgoingLinks()
-		return nil
-	case note.EdgeBacklinks:
-		m.ResetBacklinks()
-		return nil
-	case note.EdgeConnectionMaps:
-		m.ResetConnectionMaps()
-		return nil
-	case note.EdgeConnectionJobs:
-		m.ResetConnectionJobs()
+	case noteconnectionaccount.EdgeItemMaps:
+		m.ResetItemMaps()
 		return nil
-	case note.EdgeTags:
-		m.ResetTags()
+	case noteconnectionaccount.EdgeJobs:
+		m.ResetJobs()
 		return nil
-	}
-	return fmt.Errorf("unknown Note edge %s", name)
-}
-
-// NoteConnectionAccountMutation represents an operation that mutates the NoteConnectionAccount nodes in the graph.
-type NoteConnectionAccountMutation struct {
-	config
-	op                    Op
-	typ                   string
-	id                    *int
-	name                  *string
-	provider              *string
-	endpoint              *string
-	enabled               *bool
-	auth_type             *string
-	encrypted_credentials *string
-	credential_alg        *string
-	default_target_id     *string
-	default_target_name   *string
-	metadata_json         *string
-	last_test_status      *string
-	last_test_error       *string
-	last_test_at          *time.Time
-	created_at            *time.Time
-	updated_at            *time.Time
-	clearedFields         map[string]struct{}
-	user                  *int
-	cleareduser           bool
-	item_maps             map[int]struct{}
-	removeditem_maps      map[int]struct{}
-	cleareditem_maps      bool
-	jobs                  map[int]struct{}
-	removedjobs           map[int]struct{}
-	clearedjobs           bool
-	done                  bool
-	oldValue              func(context.Context) (*NoteConnectionAccount, error)
-	predicates            []predicate.NoteConnectionAccount
+	case noteconnectionaccount.EdgeConflicts:
+		m.ResetConflicts()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteConnectionAccount edge %s", name)
 }
 
-var _ ent.Mutation = (*NoteConnectionAccountMutation)(nil)
+// NoteConnectionConflictMutation represents an operation that mutates the NoteConnectionConflict nodes in the graph.
+type NoteConnectionConflictMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	external_id       *string
+	local_title       *string
+	local_content     *string
+	local_updated_at  *time.Time
+	remote_title      *string
+	remote_content    *string
+	remote_updated_at *time.Time
+	status            *string
+	resolution        *string
+	resolved_at       *time.Time
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	account           *int
+	clearedaccount    bool
+	note              *uuid.UUID
+	clearednote       bool
+	done              bool
+	oldValue          func(context.Context) (*NoteConnectionConflict, error)
+	predicates        []predicate.NoteConnectionConflict
+}
 
-// noteconnectionaccountOption allows management of the mutation configuration using functional options.
-type noteconnectionaccountOption func(*NoteConnectionAccountMutation)
+var _ ent.Mutation = (*NoteConnectionConflictMutation)(nil)
 
-// newNoteConnectionAccountMutation creates new mutation for the NoteConnectionAccount entity.
-func newNoteConnectionAccountMutation(c config, op Op, opts ...noteconnectionaccountOption) *NoteConnectionAccountMutation {
-	m := &NoteConnectionAccountMutation{
+// noteconnectionconflictOption allows management of the mutation configuration using functional options.
+type noteconnectionconflictOption func(*NoteConnectionConflictMutation)
+
+// newNoteConnectionConflictMutation creates new mutation for the NoteConnectionConflict entity.
+func newNoteConnectionConflictMutation(c config, op Op, opts ...noteconnectionconflictOption) *NoteConnectionConflictMutation {
+	m := &NoteConnectionConflictMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNoteConnectionAccount,
+		typ:           TypeNoteConnectionConflict,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -12163,20 +19956,20 @@ func newNoteConnectionAccountMutation(c config, op Op, opts ...noteconnectionacc
 	return m
 }
 
-// withNoteConnectionAccountID sets the ID field of the mutation.
-func withNoteConnectionAccountID(id int) noteconnectionaccountOption {
-	return func(m *NoteConnectionAccountMutation) {
+// withNoteConnectionConflictID sets the ID field of the mutation.
+func withNoteConnectionConflictID(id int) noteconnectionconflictOption {
+	return func(m *NoteConnectionConflictMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *NoteConnectionAccount
+			value *NoteConnectionConflict
 		)
-		m.oldValue = func(ctx context.Context) (*NoteConnectionAccount, error) {
+		m.oldValue = func(ctx context.Context) (*NoteConnectionConflict, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().NoteConnectionAccount.Get(ctx, id)
+					value, err = m.Client().NoteConnectionConflict.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -12185,10 +19978,10 @@ func withNoteConnectionAccountID(id int) noteconnectionaccountOption {
 	}
 }
 
-// withNoteConnectionAccount sets the old NoteConnectionAccount of the mutation.
-func withNoteConnectionAccount(node *NoteConnectionAccount) noteconnectionaccountOption {
-	return func(m *NoteConnectionAccountMutation) {
-		m.oldValue = func(context.Context) (*NoteConnectionAccount, error) {
+// withNoteConnectionConflict sets the old NoteConnectionConflict of the mutation.
+func withNoteConnectionConflict(node *NoteConnectionConflict) noteconnectionconflictOption {
+	return func(m *NoteConnectionConflictMutation) {
+		m.oldValue = func(context.Context) (*NoteConnectionConflict, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -12197,7 +19990,7 @@ func withNoteConnectionAccount(node *NoteConnectionAccount) noteconnectionaccoun
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NoteConnectionAccountMutation) Client() *Client {
+func (m NoteConnectionConflictMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -12205,7 +19998,7 @@ func (m NoteConnectionAccountMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NoteConnectionAccountMutation) Tx() (*Tx, error) {
+func (m NoteConnectionConflictMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -12216,7 +20009,7 @@ func (m NoteConnectionAccountMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NoteConnectionAccountMutation) ID() (id int, exists bool) {
+func (m *NoteConnectionConflictMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -12227,7 +20020,7 @@ func (m *NoteConnectionAccountMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NoteConnectionAccountMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *NoteConnectionConflictMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -12236,836 +20029,582 @@ func (m *NoteConnectionAccountMutation) IDs(ctx context.Context) ([]int, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NoteConnectionAccount.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().NoteConnectionConflict.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *NoteConnectionAccountMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *NoteConnectionAccountMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *NoteConnectionAccountMutation) ResetName() {
-	m.name = nil
-}
-
-// SetProvider sets the "provider" field.
-func (m *NoteConnectionAccountMutation) SetProvider(s string) {
-	m.provider = &s
-}
-
-// Provider returns the value of the "provider" field in the mutation.
-func (m *NoteConnectionAccountMutation) Provider() (r string, exists bool) {
-	v := m.provider
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldProvider returns the old "provider" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldProvider(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProvider requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
-	}
-	return oldValue.Provider, nil
-}
-
-// ResetProvider resets all changes to the "provider" field.
-func (m *NoteConnectionAccountMutation) ResetProvider() {
-	m.provider = nil
-}
-
-// SetUserID sets the "user_id" field.
-func (m *NoteConnectionAccountMutation) SetUserID(i int) {
-	m.user = &i
-}
-
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *NoteConnectionAccountMutation) UserID() (r int, exists bool) {
-	v := m.user
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUserID returns the old "user_id" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldUserID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
-	}
-	return oldValue.UserID, nil
-}
-
-// ResetUserID resets all changes to the "user_id" field.
-func (m *NoteConnectionAccountMutation) ResetUserID() {
-	m.user = nil
-}
-
-// SetEndpoint sets the "endpoint" field.
-func (m *NoteConnectionAccountMutation) SetEndpoint(s string) {
-	m.endpoint = &s
-}
-
-// Endpoint returns the value of the "endpoint" field in the mutation.
-func (m *NoteConnectionAccountMutation) Endpoint() (r string, exists bool) {
-	v := m.endpoint
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEndpoint returns the old "endpoint" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldEndpoint(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndpoint requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
-	}
-	return oldValue.Endpoint, nil
-}
-
-// ClearEndpoint clears the value of the "endpoint" field.
-func (m *NoteConnectionAccountMutation) ClearEndpoint() {
-	m.endpoint = nil
-	m.clearedFields[noteconnectionaccount.FieldEndpoint] = struct{}{}
-}
-
-// EndpointCleared returns if the "endpoint" field was cleared in this mutation.
-func (m *NoteConnectionAccountMutation) EndpointCleared() bool {
-	_, ok := m.clearedFields[noteconnectionaccount.FieldEndpoint]
-	return ok
-}
-
-// ResetEndpoint resets all changes to the "endpoint" field.
-func (m *NoteConnectionAccountMutation) ResetEndpoint() {
-	m.endpoint = nil
-	delete(m.clearedFields, noteconnectionaccount.FieldEndpoint)
-}
-
-// SetEnabled sets the "enabled" field.
-func (m *NoteConnectionAccountMutation) SetEnabled(b bool) {
-	m.enabled = &b
-}
-
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *NoteConnectionAccountMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEnabled returns the old "enabled" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldEnabled(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
-	}
-	return oldValue.Enabled, nil
-}
-
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *NoteConnectionAccountMutation) ResetEnabled() {
-	m.enabled = nil
-}
-
-// SetAuthType sets the "auth_type" field.
-func (m *NoteConnectionAccountMutation) SetAuthType(s string) {
-	m.auth_type = &s
+// SetAccountID sets the "account_id" field.
+func (m *NoteConnectionConflictMutation) SetAccountID(i int) {
+	m.account = &i
 }
 
-// AuthType returns the value of the "auth_type" field in the mutation.
-func (m *NoteConnectionAccountMutation) AuthType() (r string, exists bool) {
-	v := m.auth_type
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *NoteConnectionConflictMutation) AccountID() (r int, exists bool) {
+	v := m.account
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthType returns the old "auth_type" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldAccountID returns the old "account_id" field's value of the NoteConnectionConflict entity.
+// If the NoteConnectionConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldAuthType(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionConflictMutation) OldAccountID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthType is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthType requires an ID field in the mutation")
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthType: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
 	}
-	return oldValue.AuthType, nil
+	return oldValue.AccountID, nil
 }
 
-// ResetAuthType resets all changes to the "auth_type" field.
-func (m *NoteConnectionAccountMutation) ResetAuthType() {
-	m.auth_type = nil
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *NoteConnectionConflictMutation) ResetAccountID() {
+	m.account = nil
 }
 
-// SetEncryptedCredentials sets the "encrypted_credentials" field.
-func (m *NoteConnectionAccountMutation) SetEncryptedCredentials(s string) {
-	m.encrypted_credentials = &s
+// SetNoteID sets the "note_id" field.
+func (m *NoteConnectionConflictMutation) SetNoteID(u uuid.UUID) {
+	m.note = &u
 }
 
-// EncryptedCredentials returns the value of the "encrypted_credentials" field in the mutation.
-func (m *NoteConnectionAccountMutation) EncryptedCredentials() (r string, exists bool) {
-	v := m.encrypted_credentials
+// NoteID returns the value of the "note_id" field in the mutation.
+func (m *NoteConnectionConflictMutation) NoteID() (r uuid.UUID, exists bool) {
+	v := m.note
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncryptedCredentials returns the old "encrypted_credentials" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldNoteID returns the old "note_id" field's value of the NoteConnectionConflict entity.
+// If the NoteConnectionConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldEncryptedCredentials(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionConflictMutation) OldNoteID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncryptedCredentials is only allowed on UpdateOne operations")
+		return v, errors.New("OldNoteID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncryptedCredentials requires an ID field in the mutation")
+		return v, errors.New("OldNoteID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncryptedCredentials: %w", err)
+		return v, fmt.Errorf("querying old value for OldNoteID: %w", err)
 	}
-	return oldValue.EncryptedCredentials, nil
-}
-
-// ClearEncryptedCredentials clears the value of the "encrypted_credentials" field.
-func (m *NoteConnectionAccountMutation) ClearEncryptedCredentials() {
-	m.encrypted_credentials = nil
-	m.clearedFields[noteconnectionaccount.FieldEncryptedCredentials] = struct{}{}
-}
-
-// EncryptedCredentialsCleared returns if the "encrypted_credentials" field was cleared in this mutation.
-func (m *NoteConnectionAccountMutation) EncryptedCredentialsCleared() bool {
-	_, ok := m.clearedFields[noteconnectionaccount.FieldEncryptedCredentials]
-	return ok
+	return oldValue.NoteID, nil
 }
 
-// ResetEncryptedCredentials resets all changes to the "encrypted_credentials" field.
-func (m *NoteConnectionAccountMutation) ResetEncryptedCredentials() {
-	m.encrypted_credentials = nil
-	delete(m.clearedFields, noteconnectionaccount.FieldEncryptedCredentials)
+// ResetNoteID resets all changes to the "note_id" field.
+func (m *NoteConnectionConflictMutation) ResetNoteID() {
+	m.note = nil
 }
 
-// SetCredentialAlg sets the "credential_alg" field.
-func (m *NoteConnectionAccountMutation) SetCredentialAlg(s string) {
-	m.credential_alg = &s
+// SetExternalID sets the "external_id" field.
+func (m *NoteConnectionConflictMutation) SetExternalID(s string) {
+	m.external_id = &s
 }
 
-// CredentialAlg returns the value of the "credential_alg" field in the mutation.
-func (m *NoteConnectionAccountMutation) CredentialAlg() (r string, exists bool) {
-	v := m.credential_alg
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *NoteConnectionConflictMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCredentialAlg returns the old "credential_alg" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldExternalID returns the old "external_id" field's value of the NoteConnectionConflict entity.
+// If the NoteConnectionConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldCredentialAlg(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionConflictMutation) OldExternalID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCredentialAlg is only allowed on UpdateOne operations")
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCredentialAlg requires an ID field in the mutation")
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCredentialAlg: %w", err)
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
 	}
-	return oldValue.CredentialAlg, nil
-}
-
-// ClearCredentialAlg clears the value of the "credential_alg" field.
-func (m *NoteConnectionAccountMutation) ClearCredentialAlg() {
-	m.credential_alg = nil
-	m.clearedFields[noteconnectionaccount.FieldCredentialAlg] = struct{}{}
-}
-
-// CredentialAlgCleared returns if the "credential_alg" field was cleared in this mutation.
-func (m *NoteConnectionAccountMutation) CredentialAlgCleared() bool {
-	_, ok := m.clearedFields[noteconnectionaccount.FieldCredentialAlg]
-	return ok
+	return oldValue.ExternalID, nil
 }
 
-// ResetCredentialAlg resets all changes to the "credential_alg" field.
-func (m *NoteConnectionAccountMutation) ResetCredentialAlg() {
-	m.credential_alg = nil
-	delete(m.clearedFields, noteconnectionaccount.FieldCredentialAlg)
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *NoteConnectionConflictMutation) ResetExternalID() {
+	m.external_id = nil
 }
 
-// SetDefaultTargetID sets the "default_target_id" field.
-func (m *NoteConnectionAccountMutation) SetDefaultTargetID(s string) {
-	m.default_target_id = &s
+// SetLocalTitle sets the "local_title" field.
+func (m *NoteConnectionConflictMutation) SetLocalTitle(s string) {
+	m.local_title = &s
 }
 
-// DefaultTargetID returns the value of the "default_target_id" field in the mutation.
-func (m *NoteConnectionAccountMutation) DefaultTargetID() (r string, exists bool) {
-	v := m.default_target_id
+// LocalTitle returns the value of the "local_title" field in the mutation.
+func (m *NoteConnectionConflictMutation) LocalTitle() (r string, exists bool) {
+	v := m.local_title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDefaultTargetID returns the old "default_target_id" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldLocalTitle returns the old "local_title" field's value of the NoteConnectionConflict entity.
+// If the NoteConnectionConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldDefaultTargetID(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionConflictMutation) OldLocalTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDefaultTargetID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocalTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDefaultTargetID requires an ID field in the mutation")
+		return v, errors.New("OldLocalTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDefaultTargetID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocalTitle: %w", err)
 	}
-	return oldValue.DefaultTargetID, nil
-}
-
-// ClearDefaultTargetID clears the value of the "default_target_id" field.
-func (m *NoteConnectionAccountMutation) ClearDefaultTargetID() {
-	m.default_target_id = nil
-	m.clearedFields[noteconnectionaccount.FieldDefaultTargetID] = struct{}{}
-}
-
-// DefaultTargetIDCleared returns if the "default_target_id" field was cleared in this mutation.
-func (m *NoteConnectionAccountMutation) DefaultTargetIDCleared() bool {
-	_, ok := m.clearedFields[noteconnectionaccount.FieldDefaultTargetID]
-	return ok
+	return oldValue.LocalTitle, nil
 }
 
-// ResetDefaultTargetID resets all changes to the "default_target_id" field.
-func (m *NoteConnectionAccountMutation) ResetDefaultTargetID() {
-	m.default_target_id = nil
-	delete(m.clearedFields, noteconnectionaccount.FieldDefaultTargetID)
+// ResetLocalTitle resets all changes to the "local_title" field.
+func (m *NoteConnectionConflictMutation) ResetLocalTitle() {
+	m.local_title = nil
 }
 
-// SetDefaultTargetName sets the "default_target_name" field.
-func (m *NoteConnectionAccountMutation) SetDefaultTargetName(s string) {
-	m.default_target_name = &s
+// SetLocalContent sets the "local_content" field.
+func (m *NoteConnectionConflictMutation) SetLocalContent(s string) {
+	m.local_content = &s
 }
 
-// DefaultTargetName returns the value of the "default_target_name" field in the mutation.
-func (m *NoteConnectionAccountMutation) DefaultTargetName() (r string, exists bool) {
-	v := m.default_target_name
+// LocalContent returns the value of the "local_content" field in the mutation.
+func (m *NoteConnectionConflictMutation) LocalContent() (r string, exists bool) {
+	v := m.local_content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDefaultTargetName returns the old "default_target_name" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldLocalContent returns the old "local_content" field's value of the NoteConnectionConflict entity.
+// If the NoteConnectionConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldDefaultTargetName(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionConflictMutation) OldLocalContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDefaultTargetName is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocalContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDefaultTargetName requires an ID field in the mutation")
+		return v, errors.New("OldLocalContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDefaultTargetName: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocalContent: %w", err)
 	}
-	return oldValue.DefaultTargetName, nil
-}
-
-// ClearDefaultTargetName clears the value of the "default_target_name" field.
-func (m *NoteConnectionAccountMutation) ClearDefaultTargetName() {
-	m.default_target_name = nil
-	m.clearedFields[noteconnectionaccount.FieldDefaultTargetName] = struct{}{}
+	return oldValue.LocalContent, nil
 }
 
-// DefaultTargetNameCleared returns if the "default_target_name" field was cleared in this mutation.
-func (m *NoteConnectionAccountMutation) DefaultTargetNameCleared() bool {
-	_, ok := m.clearedFields[noteconnectionaccount.FieldDefaultTargetName]
-	return ok
-}
-
-// ResetDefaultTargetName resets all changes to the "default_target_name" field.
-func (m *NoteConnectionAccountMutation) ResetDefaultTargetName() {
-	m.default_target_name = nil
-	delete(m.clearedFields, noteconnectionaccount.FieldDefaultTargetName)
+// ResetLocalContent resets all changes to the "local_content" field.
+func (m *NoteConnectionConflictMutation) ResetLocalContent() {
+	m.local_content = nil
 }
 
-// SetMetadataJSON sets the "metadata_json" field.
-func (m *NoteConnectionAccountMutation) SetMetadataJSON(s string) {
-	m.metadata_json = &s
+// SetLocalUpdatedAt sets the "local_updated_at" field.
+func (m *NoteConnectionConflictMutation) SetLocalUpdatedAt(t time.Time) {
+	m.local_updated_at = &t
 }
 
-// MetadataJSON returns the value of the "metadata_json" field in the mutation.
-func (m *NoteConnectionAccountMutation) MetadataJSON() (r string, exists bool) {
-	v := m.metadata_json
+// LocalUpdatedAt returns the value of the "local_updated_at" field in the mutation.
+func (m *NoteConnectionConflictMutation) LocalUpdatedAt() (r time.Time, exists bool) {
+	v := m.local_updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadataJSON returns the old "metadata_json" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldLocalUpdatedAt returns the old "local_updated_at" field's value of the NoteConnectionConflict entity.
+// If the NoteConnectionConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldMetadataJSON(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionConflictMutation) OldLocalUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadataJSON is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocalUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadataJSON requires an ID field in the mutation")
+		return v, errors.New("OldLocalUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadataJSON: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocalUpdatedAt: %w", err)
 	}
-	return oldValue.MetadataJSON, nil
-}
-
-// ClearMetadataJSON clears the value of the "metadata_json" field.
-func (m *NoteConnectionAccountMutation) ClearMetadataJSON() {
-	m.metadata_json = nil
-	m.clearedFields[noteconnectionaccount.FieldMetadataJSON] = struct{}{}
-}
-
-// MetadataJSONCleared returns if the "metadata_json" field was cleared in this mutation.
-func (m *NoteConnectionAccountMutation) MetadataJSONCleared() bool {
-	_, ok := m.clearedFields[noteconnectionaccount.FieldMetadataJSON]
-	return ok
+	return oldValue.LocalUpdatedAt, nil
 }
 
-// ResetMetadataJSON resets all changes to the "metadata_json" field.
-func (m *NoteConnectionAccountMutation) ResetMetadataJSON() {
-	m.metadata_json = nil
-	delete(m.clearedFields, noteconnectionaccount.FieldMetadataJSON)
+// ResetLocalUpdatedAt resets all changes to the "local_updated_at" field.
+func (m *NoteConnectionConflictMutation) ResetLocalUpdatedAt() {
+	m.local_updated_at = nil
 }
 
-// SetLastTestStatus sets the "last_test_status" field.
-func (m *NoteConnectionAccountMutation) SetLastTestStatus(s string) {
-	m.last_test_status = &s
+// SetRemoteTitle sets the "remote_title" field.
+func (m *NoteConnectionConflictMutation) SetRemoteTitle(s string) {
+	m.remote_title = &s
 }
 
-// LastTestStatus returns the value of the "last_test_status" field in the mutation.
-func (m *NoteConnectionAccountMutation) LastTestStatus() (r string, exists bool) {
-	v := m.last_test_status
+// RemoteTitle returns the value of the "remote_title" field in the mutation.
+func (m *NoteConnectionConflictMutation) RemoteTitle() (r string, exists bool) {
+	v := m.remote_title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastTestStatus returns the old "last_test_status" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldRemoteTitle returns the old "remote_title" field's value of the NoteConnectionConflict entity.
+// If the NoteConnectionConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldLastTestStatus(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionConflictMutation) OldRemoteTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastTestStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldRemoteTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastTestStatus requires an ID field in the mutation")
+		return v, errors.New("OldRemoteTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastTestStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldRemoteTitle: %w", err)
 	}
-	return oldValue.LastTestStatus, nil
+	return oldValue.RemoteTitle, nil
 }
 
-// ResetLastTestStatus resets all changes to the "last_test_status" field.
-func (m *NoteConnectionAccountMutation) ResetLastTestStatus() {
-	m.last_test_status = nil
+// ResetRemoteTitle resets all changes to the "remote_title" field.
+func (m *NoteConnectionConflictMutation) ResetRemoteTitle() {
+	m.remote_title = nil
 }
 
-// SetLastTestError sets the "last_test_error" field.
-func (m *NoteConnectionAccountMutation) SetLastTestError(s string) {
-	m.last_test_error = &s
+// SetRemoteContent sets the "remote_content" field.
+func (m *NoteConnectionConflictMutation) SetRemoteContent(s string) {
+	m.remote_content = &s
 }
 
-// LastTestError returns the value of the "last_test_error" field in the mutation.
-func (m *NoteConnectionAccountMutation) LastTestError() (r string, exists bool) {
-	v := m.last_test_error
+// RemoteContent returns the value of the "remote_content" field in the mutation.
+func (m *NoteConnectionConflictMutation) RemoteContent() (r string, exists bool) {
+	v := m.remote_content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastTestError returns the old "last_test_error" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldRemoteContent returns the old "remote_content" field's value of the NoteConnectionConflict entity.
+// If the NoteConnectionConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldLastTestError(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionConflictMutation) OldRemoteContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastTestError is only allowed on UpdateOne operations")
+		return v, errors.New("OldRemoteContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastTestError requires an ID field in the mutation")
+		return v, errors.New("OldRemoteContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastTestError: %w", err)
+		return v, fmt.Errorf("querying old value for OldRemoteContent: %w", err)
 	}
-	return oldValue.LastTestError, nil
-}
-
-// ClearLastTestError clears the value of the "last_test_error" field.
-func (m *NoteConnectionAccountMutation) ClearLastTestError() {
-	m.last_test_error = nil
-	m.clearedFields[noteconnectionaccount.FieldLastTestError] = struct{}{}
-}
-
-// LastTestErrorCleared returns if the "last_test_error" field was cleared in this mutation.
-func (m *NoteConnectionAccountMutation) LastTestErrorCleared() bool {
-	_, ok := m.clearedFields[noteconnectionaccount.FieldLastTestError]
-	return ok
+	return oldValue.RemoteContent, nil
 }
 
-// ResetLastTestError resets all changes to the "last_test_error" field.
-func (m *NoteConnectionAccountMutation) ResetLastTestError() {
-	m.last_test_error = nil
-	delete(m.clearedFields, noteconnectionaccount.FieldLastTestError)
+// ResetRemoteContent resets all changes to the "remote_content" field.
+func (m *NoteConnectionConflictMutation) ResetRemoteContent() {
+	m.remote_content = nil
 }
 
-// SetLastTestAt sets the "last_test_at" field.
-func (m *NoteConnectionAccountMutation) SetLastTestAt(t time.Time) {
-	m.last_test_at = &t
+// SetRemoteUpdatedAt sets the "remote_updated_at" field.
+func (m *NoteConnectionConflictMutation) SetRemoteUpdatedAt(t time.Time) {
+	m.remote_updated_at = &t
 }
 
-// LastTestAt returns the value of the "last_test_at" field in the mutation.
-func (m *NoteConnectionAccountMutation) LastTestAt() (r time.Time, exists bool) {
-	v := m.last_test_at
+// RemoteUpdatedAt returns the value of the "remote_updated_at" field in the mutation.
+func (m *NoteConnectionConflictMutation) RemoteUpdatedAt() (r time.Time, exists bool) {
+	v := m.remote_updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastTestAt returns the old "last_test_at" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldRemoteUpdatedAt returns the old "remote_updated_at" field's value of the NoteConnectionConflict entity.
+// If the NoteConnectionConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldLastTestAt(ctx context.Context) (v time.Time, err error) {
+func (m *NoteConnectionConflictMutation) OldRemoteUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastTestAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRemoteUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastTestAt requires an ID field in the mutation")
+		return v, errors.New("OldRemoteUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastTestAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRemoteUpdatedAt: %w", err)
 	}
-	return oldValue.LastTestAt, nil
+	return oldValue.RemoteUpdatedAt, nil
 }
 
-// ClearLastTestAt clears the value of the "last_test_at" field.
-func (m *NoteConnectionAccountMutation) ClearLastTestAt() {
-	m.last_test_at = nil
-	m.clearedFields[noteconnectionaccount.FieldLastTestAt] = struct{}{}
+// ClearRemoteUpdatedAt clears the value of the "remote_updated_at" field.
+func (m *NoteConnectionConflictMutation) ClearRemoteUpdatedAt() {
+	m.remote_updated_at = nil
+	m.clearedFields[noteconnectionconflict.FieldRemoteUpdatedAt] = struct{}{}
 }
 
-// LastTestAtCleared returns if the "last_test_at" field was cleared in this mutation.
-func (m *NoteConnectionAccountMutation) LastTestAtCleared() bool {
-	_, ok := m.clearedFields[noteconnectionaccount.FieldLastTestAt]
+// RemoteUpdatedAtCleared returns if the "remote_updated_at" field was cleared in this mutation.
+func (m *NoteConnectionConflictMutation) RemoteUpdatedAtCleared() bool {
+	_, ok := m.clearedFields[noteconnectionconflict.FieldRemoteUpdatedAt]
 	return ok
 }
 
-// ResetLastTestAt resets all changes to the "last_test_at" field.
-func (m *NoteConnectionAccountMutation) ResetLastTestAt() {
-	m.last_test_at = nil
-	delete(m.clearedFields, noteconnectionaccount.FieldLastTestAt)
+// ResetRemoteUpdatedAt resets all changes to the "remote_updated_at" field.
+func (m *NoteConnectionConflictMutation) ResetRemoteUpdatedAt() {
+	m.remote_updated_at = nil
+	delete(m.clearedFields, noteconnectionconflict.FieldRemoteUpdatedAt)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NoteConnectionAccountMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetStatus sets the "status" field.
+func (m *NoteConnectionConflictMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NoteConnectionAccountMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Status returns the value of the "status" field in the mutation.
+func (m *NoteConnectionConflictMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the NoteConnectionConflict entity.
+// If the NoteConnectionConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NoteConnectionConflictMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Status, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NoteConnectionAccountMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *NoteConnectionConflictMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *NoteConnectionAccountMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetResolution sets the "resolution" field.
+func (m *NoteConnectionConflictMutation) SetResolution(s string) {
+	m.resolution = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NoteConnectionAccountMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Resolution returns the value of the "resolution" field in the mutation.
+func (m *NoteConnectionConflictMutation) Resolution() (r string, exists bool) {
+	v := m.resolution
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the NoteConnectionAccount entity.
-// If the NoteConnectionAccount object wasn't provided to the builder, the object is fetched from the database.
+// OldResolution returns the old "resolution" field's value of the NoteConnectionConflict entity.
+// If the NoteConnectionConflict object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionAccountMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NoteConnectionConflictMutation) OldResolution(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldResolution is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldResolution requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldResolution: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Resolution, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NoteConnectionAccountMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearResolution clears the value of the "resolution" field.
+func (m *NoteConnectionConflictMutation) ClearResolution() {
+	m.resolution = nil
+	m.clearedFields[noteconnectionconflict.FieldResolution] = struct{}{}
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *NoteConnectionAccountMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[noteconnectionaccount.FieldUserID] = struct{}{}
+// ResolutionCleared returns if the "resolution" field was cleared in this mutation.
+func (m *NoteConnectionConflictMutation) ResolutionCleared() bool {
+	_, ok := m.clearedFields[noteconnectionconflict.FieldResolution]
+	return ok
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *NoteConnectionAccountMutation) UserCleared() bool {
-	return m.cleareduser
+// ResetResolution resets all changes to the "resolution" field.
+func (m *NoteConnectionConflictMutation) ResetResolution() {
+	m.resolution = nil
+	delete(m.clearedFields, noteconnectionconflict.FieldResolution)
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *NoteConnectionAccountMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetResolvedAt sets the "resolved_at" field.
+func (m *NoteConnectionConflictMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *NoteConnectionAccountMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *NoteConnectionConflictMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddItemMapIDs adds the "item_maps" edge to the NoteConnectionItemMap entity by ids.
-func (m *NoteConnectionAccountMutation) AddItemMapIDs(ids ...int) {
-	if m.item_maps == nil {
-		m.item_maps = make(map[int]struct{})
+// OldResolvedAt returns the old "resolved_at" field's value of the NoteConnectionConflict entity.
+// If the NoteConnectionConflict object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionConflictMutation) OldResolvedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.item_maps[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
 	}
+	return oldValue.ResolvedAt, nil
 }
 
-// ClearItemMaps clears the "item_maps" edge to the NoteConnectionItemMap entity.
-func (m *NoteConnectionAccountMutation) ClearItemMaps() {
-	m.cleareditem_maps = true
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *NoteConnectionConflictMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[noteconnectionconflict.FieldResolvedAt] = struct{}{}
 }
 
-// ItemMapsCleared reports if the "item_maps" edge to the NoteConnectionItemMap entity was cleared.
-func (m *NoteConnectionAccountMutation) ItemMapsCleared() bool {
-	return m.cleareditem_maps
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *NoteConnectionConflictMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[noteconnectionconflict.FieldResolvedAt]
+	return ok
 }
 
-// RemoveItemMapIDs removes the "item_maps" edge to the NoteConnectionItemMap entity by IDs.
-func (m *NoteConnectionAccountMutation) RemoveItemMapIDs(ids ...int) {
-	if m.removeditem_maps == nil {
-		m.removeditem_maps = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.item_maps, ids[i])
-		m.removeditem_maps[ids[i]] = struct{}{}
-	}
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *NoteConnectionConflictMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, noteconnectionconflict.FieldResolvedAt)
 }
 
-// RemovedItemMaps returns the removed IDs of the "item_maps" edge to the NoteConnectionItemMap entity.
-func (m *NoteConnectionAccountMutation) RemovedItemMapsIDs() (ids []int) {
-	for id := range m.removeditem_maps {
-		ids = append(ids, id)
-	}
-	return
+// SetCreatedAt sets the "created_at" field.
+func (m *NoteConnectionConflictMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ItemMapsIDs returns the "item_maps" edge IDs in the mutation.
-func (m *NoteConnectionAccountMutation) ItemMapsIDs() (ids []int) {
-	for id := range m.item_maps {
-		ids = append(ids, id)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NoteConnectionConflictMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetItemMaps resets all changes to the "item_maps" edge.
-func (m *NoteConnectionAccountMutation) ResetItemMaps() {
-	m.item_maps = nil
-	m.cleareditem_maps = false
-	m.removeditem_maps = nil
+	return *v, true
 }
 
-// AddJobIDs adds the "jobs" edge to the NoteConnectionJob entity by ids.
-func (m *NoteConnectionAccountMutation) AddJobIDs(ids ...int) {
-	if m.jobs == nil {
-		m.jobs = make(map[int]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the NoteConnectionConflict entity.
+// If the NoteConnectionConflict object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionConflictMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.jobs[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearJobs clears the "jobs" edge to the NoteConnectionJob entity.
-func (m *NoteConnectionAccountMutation) ClearJobs() {
-	m.clearedjobs = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NoteConnectionConflictMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// JobsCleared reports if the "jobs" edge to the NoteConnectionJob entity was cleared.
-func (m *NoteConnectionAccountMutation) JobsCleared() bool {
-	return m.clearedjobs
+// ClearAccount clears the "account" edge to the NoteConnectionAccount entity.
+func (m *NoteConnectionConflictMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[noteconnectionconflict.FieldAccountID] = struct{}{}
 }
 
-// RemoveJobIDs removes the "jobs" edge to the NoteConnectionJob entity by IDs.
-func (m *NoteConnectionAccountMutation) RemoveJobIDs(ids ...int) {
-	if m.removedjobs == nil {
-		m.removedjobs = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.jobs, ids[i])
-		m.removedjobs[ids[i]] = struct{}{}
-	}
+// AccountCleared reports if the "account" edge to the NoteConnectionAccount entity was cleared.
+func (m *NoteConnectionConflictMutation) AccountCleared() bool {
+	return m.clearedaccount
 }
 
-// RemovedJobs returns the removed IDs of the "jobs" edge to the NoteConnectionJob entity.
-func (m *NoteConnectionAccountMutation) RemovedJobsIDs() (ids []int) {
-	for id := range m.removedjobs {
-		ids = append(ids, id)
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *NoteConnectionConflictMutation) AccountIDs() (ids []int) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// JobsIDs returns the "jobs" edge IDs in the mutation.
-func (m *NoteConnectionAccountMutation) JobsIDs() (ids []int) {
-	for id := range m.jobs {
-		ids = append(ids, id)
+// ResetAccount resets all changes to the "account" edge.
+func (m *NoteConnectionConflictMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (m *NoteConnectionConflictMutation) ClearNote() {
+	m.clearednote = true
+	m.clearedFields[noteconnectionconflict.FieldNoteID] = struct{}{}
+}
+
+// NoteCleared reports if the "note" edge to the Note entity was cleared.
+func (m *NoteConnectionConflictMutation) NoteCleared() bool {
+	return m.clearednote
+}
+
+// NoteIDs returns the "note" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NoteID instead. It exists only for internal usage by the builders.
+func (m *NoteConnectionConflictMutation) NoteIDs() (ids []uuid.UUID) {
+	if id := m.note; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetJobs resets all changes to the "jobs" edge.
-func (m *NoteConnectionAccountMutation) ResetJobs() {
-	m.jobs = nil
-	m.clearedjobs = false
-	m.removedjobs = nil
+// ResetNote resets all changes to the "note" edge.
+func (m *NoteConnectionConflictMutation) ResetNote() {
+	m.note = nil
+	m.clearednote = false
 }
 
-// Where appends a list predicates to the NoteConnectionAccountMutation builder.
-func (m *NoteConnectionAccountMutation) Where(ps ...predicate.NoteConnectionAccount) {
+// Where appends a list predicates to the NoteConnectionConflictMutation builder.
+func (m *NoteConnectionConflictMutation) Where(ps ...predicate.NoteConnectionConflict) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NoteConnectionAccountMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NoteConnectionConflictMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NoteConnectionAccountMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.NoteConnectionAccount, len(ps))
+func (m *NoteConnectionConflictMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NoteConnectionConflict, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -13073,113 +20612,98 @@ func (m *NoteConnectionAccountMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NoteConnectionAccountMutation) Op() Op {
+func (m *NoteConnectionConflictMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NoteConnectionAccountMutation) SetOp(op Op) {
+func (m *NoteConnectionConflictMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (NoteConnectionAccount).
-func (m *NoteConnectionAccountMutation) Type() string {
+// Type returns the node type of this mutation (NoteConnectionConflict).
+func (m *NoteConnectionConflictMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NoteConnectionAccountMutation) Fields() []string {
-	fields := make([]string, 0, 16)
-	if m.name != nil {
-		fields = append(fields, noteconnectionaccount.FieldName)
-	}
-	if m.provider != nil {
-		fields = append(fields, noteconnectionaccount.FieldProvider)
-	}
-	if m.user != nil {
-		fields = append(fields, noteconnectionaccount.FieldUserID)
+func (m *NoteConnectionConflictMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.account != nil {
+		fields = append(fields, noteconnectionconflict.FieldAccountID)
 	}
-	if m.endpoint != nil {
-		fields = append(fields, noteconnectionaccount.FieldEndpoint)
+	if m.note != nil {
+		fields = append(fields, noteconnectionconflict.FieldNoteID)
 	}
-	if m.enabled != nil {
-		fields = append(fields, noteconnectionaccount.FieldEnabled)
+	if m.external_id != nil {
+		fields = append(fields, noteconnectionconflict.FieldExternalID)
 	}
-	if m.auth_type != nil {
-		fields = append(fields, noteconnectionaccount.FieldAuthType)
+	if m.local_title != nil {
+		fields = append(fields, noteconnectionconflict.FieldLocalTitle)
 	}
-	if m.encrypted_credentials != nil {
-		fields = append(fields, noteconnectionaccount.FieldEncryptedCredentials)
+	if m.local_content != nil {
+		fields = append(fields, noteconnectionconflict.FieldLocalContent)
 	}
-	if m.credential_alg != nil {
-		fields = append(fields, noteconnectionaccount.FieldCredentialAlg)
+	if m.local_updated_at != nil {
+		fields = append(fields, noteconnectionconflict.FieldLocalUpdatedAt)
 	}
-	if m.default_target_id != nil {
-		fields = append(fields, noteconnectionaccount.FieldDefaultTargetID)
+	if m.remote_title != nil {
+		fields = append(fields, noteconnectionconflict.FieldRemoteTitle)
 	}
-	if m.default_target_name != nil {
-		fields = append(fields, noteconnectionaccount.FieldDefaultTargetName)
+	if m.remote_content != nil {
+		fields = append(fields, noteconnectionconflict.FieldRemoteContent)
 	}
-	if m.metadata_json != nil {
-		fields = append(fields, noteconnectionaccount.FieldMetadataJSON)
+	if m.remote_updated_at != nil {
+		fields = append(fields, noteconnectionconflict.FieldRemoteUpdatedAt)
 	}
-	if m.last_test_status != nil {
-		fields = append(fields, noteconnectionaccount.FieldLastTestStatus)
+	if m.status != nil {
+		fields = append(fields, noteconnectionconflict.FieldStatus)
 	}
-	if m.last_test_error != nil {
-		fields = append(fields, noteconnectionaccount.FieldLastTestError)
+	if m.resolution != nil {
+		fields = append(fields, noteconnectionconflict.FieldResolution)
 	}
-	if m.last_test_at != nil {
-		fields = append(fields, noteconnectionaccount.FieldLastTestAt)
+	if m.resolved_at != nil {
+		fields = append(fields, noteconnectionconflict.FieldResolvedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, noteconnectionaccount.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, noteconnectionaccount.FieldUpdatedAt)
+		fields = append(fields, noteconnectionconflict.FieldCreatedAt)
 	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *NoteConnectionAccountMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case noteconnectionaccount.FieldName:
-		return m.Name()
-	case noteconnectionaccount.FieldProvider:
-		return m.Provider()
-	case noteconnectionaccount.FieldUserID:
-		return m.UserID()
-	case noteconnectionaccount.FieldEndpoint:
-		return m.Endpoint()
-	case noteconnectionaccount.FieldEnabled:
-		return m.Enabled()
-	case noteconnectionaccount.FieldAuthType:
-		return m.AuthType()
-	case noteconnectionaccount.FieldEncryptedCredentials:
-		return m.EncryptedCredentials()
-	case noteconnectionaccount.FieldCredentialAlg:
-		return m.CredentialAlg()
-	case noteconnectionaccount.FieldDefaultTargetID:
-		return m.DefaultTargetID()
-	case noteconnectionaccount.FieldDefaultTargetName:
-		return m.DefaultTargetName()
-	case noteconnectionaccount.FieldMetadataJSON:
-		return m.MetadataJSON()
-	case noteconnectionaccount.FieldLastTestStatus:
-		return m.LastTestStatus()
-	case noteconnectionaccount.FieldLastTestError:
-		return m.LastTestError()
-	case noteconnectionaccount.FieldLastTestAt:
-		return m.LastTestAt()
-	case noteconnectionaccount.FieldCreatedAt:
+// schema.
+func (m *NoteConnectionConflictMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case noteconnectionconflict.FieldAccountID:
+		return m.AccountID()
+	case noteconnectionconflict.FieldNoteID:
+		return m.NoteID()
+	case noteconnectionconflict.FieldExternalID:
+		return m.ExternalID()
+	case noteconnectionconflict.FieldLocalTitle:
+		return m.LocalTitle()
+	case noteconnectionconflict.FieldLocalContent:
+		return m.LocalContent()
+	case noteconnectionconflict.FieldLocalUpdatedAt:
+		return m.LocalUpdatedAt()
+	case noteconnectionconflict.FieldRemoteTitle:
+		return m.RemoteTitle()
+	case noteconnectionconflict.FieldRemoteContent:
+		return m.RemoteContent()
+	case noteconnectionconflict.FieldRemoteUpdatedAt:
+		return m.RemoteUpdatedAt()
+	case noteconnectionconflict.FieldStatus:
+		return m.Status()
+	case noteconnectionconflict.FieldResolution:
+		return m.Resolution()
+	case noteconnectionconflict.FieldResolvedAt:
+		return m.ResolvedAt()
+	case noteconnectionconflict.FieldCreatedAt:
 		return m.CreatedAt()
-	case noteconnectionaccount.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -13187,168 +20711,141 @@ func (m *NoteConnectionAccountMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NoteConnectionAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NoteConnectionConflictMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case noteconnectionaccount.FieldName:
-		return m.OldName(ctx)
-	case noteconnectionaccount.FieldProvider:
-		return m.OldProvider(ctx)
-	case noteconnectionaccount.FieldUserID:
-		return m.OldUserID(ctx)
-	case noteconnectionaccount.FieldEndpoint:
-		return m.OldEndpoint(ctx)
-	case noteconnectionaccount.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case noteconnectionaccount.FieldAuthType:
-		return m.OldAuthType(ctx)
-	case noteconnectionaccount.FieldEncryptedCredentials:
-		return m.OldEncryptedCredentials(ctx)
-	case noteconnectionaccount.FieldCredentialAlg:
-		return m.OldCredentialAlg(ctx)
-	case noteconnectionaccount.FieldDefaultTargetID:
-		return m.OldDefaultTargetID(ctx)
-	case noteconnectionaccount.FieldDefaultTargetName:
-		return m.OldDefaultTargetName(ctx)
-	case noteconnectionaccount.FieldMetadataJSON:
-		return m.OldMetadataJSON(ctx)
-	case noteconnectionaccount.FieldLastTestStatus:
-		return m.OldLastTestStatus(ctx)
-	case noteconnectionaccount.FieldLastTestError:
-		return m.OldLastTestError(ctx)
-	case noteconnectionaccount.FieldLastTestAt:
-		return m.OldLastTestAt(ctx)
-	case noteconnectionaccount.FieldCreatedAt:
+	case noteconnectionconflict.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case noteconnectionconflict.FieldNoteID:
+		return m.OldNoteID(ctx)
+	case noteconnectionconflict.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case noteconnectionconflict.FieldLocalTitle:
+		return m.OldLocalTitle(ctx)
+	case noteconnectionconflict.FieldLocalContent:
+		return m.OldLocalContent(ctx)
+	case noteconnectionconflict.FieldLocalUpdatedAt:
+		return m.OldLocalUpdatedAt(ctx)
+	case noteconnectionconflict.FieldRemoteTitle:
+		return m.OldRemoteTitle(ctx)
+	case noteconnectionconflict.FieldRemoteContent:
+		return m.OldRemoteContent(ctx)
+	case noteconnectionconflict.FieldRemoteUpdatedAt:
+		return m.OldRemoteUpdatedAt(ctx)
+	case noteconnectionconflict.FieldStatus:
+		return m.OldStatus(ctx)
+	case noteconnectionconflict.FieldResolution:
+		return m.OldResolution(ctx)
+	case noteconnectionconflict.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	case noteconnectionconflict.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case noteconnectionaccount.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown NoteConnectionAccount field %s", name)
+	return nil, fmt.Errorf("unknown NoteConnectionConflict field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NoteConnectionAccountMutation) SetField(name string, value ent.Value) error {
+func (m *NoteConnectionConflictMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case noteconnectionaccount.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case noteconnectionaccount.FieldProvider:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProvider(v)
-		return nil
-	case noteconnectionaccount.FieldUserID:
+	case noteconnectionconflict.FieldAccountID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetAccountID(v)
 		return nil
-	case noteconnectionaccount.FieldEndpoint:
-		v, ok := value.(string)
+	case noteconnectionconflict.FieldNoteID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndpoint(v)
+		m.SetNoteID(v)
 		return nil
-	case noteconnectionaccount.FieldEnabled:
-		v, ok := value.(bool)
+	case noteconnectionconflict.FieldExternalID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetExternalID(v)
 		return nil
-	case noteconnectionaccount.FieldAuthType:
+	case noteconnectionconflict.FieldLocalTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthType(v)
+		m.SetLocalTitle(v)
 		return nil
-	case noteconnectionaccount.FieldEncryptedCredentials:
+	case noteconnectionconflict.FieldLocalContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEncryptedCredentials(v)
+		m.SetLocalContent(v)
 		return nil
-	case noteconnectionaccount.FieldCredentialAlg:
-		v, ok := value.(string)
+	case noteconnectionconflict.FieldLocalUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCredentialAlg(v)
+		m.SetLocalUpdatedAt(v)
 		return nil
-	case noteconnectionaccount.FieldDefaultTargetID:
+	case noteconnectionconflict.FieldRemoteTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDefaultTargetID(v)
+		m.SetRemoteTitle(v)
 		return nil
-	case noteconnectionaccount.FieldDefaultTargetName:
+	case noteconnectionconflict.FieldRemoteContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDefaultTargetName(v)
+		m.SetRemoteContent(v)
 		return nil
-	case noteconnectionaccount.FieldMetadataJSON:
-		v, ok := value.(string)
+	case noteconnectionconflict.FieldRemoteUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadataJSON(v)
+		m.SetRemoteUpdatedAt(v)
 		return nil
-	case noteconnectionaccount.FieldLastTestStatus:
+	case noteconnectionconflict.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastTestStatus(v)
+		m.SetStatus(v)
 		return nil
-	case noteconnectionaccount.FieldLastTestError:
+	case noteconnectionconflict.FieldResolution:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastTestError(v)
+		m.SetResolution(v)
 		return nil
-	case noteconnectionaccount.FieldLastTestAt:
+	case noteconnectionconflict.FieldResolvedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastTestAt(v)
+		m.SetResolvedAt(v)
 		return nil
-	case noteconnectionaccount.FieldCreatedAt:
+	case noteconnectionconflict.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case noteconnectionaccount.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown NoteConnectionAccount field %s", name)
+	return fmt.Errorf("unknown NoteConnectionConflict field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NoteConnectionAccountMutation) AddedFields() []string {
+func (m *NoteConnectionConflictMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -13356,7 +20853,7 @@ func (m *NoteConnectionAccountMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NoteConnectionAccountMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NoteConnectionConflictMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -13365,264 +20862,189 @@ func (m *NoteConnectionAccountMutation) AddedField(name string) (ent.Value, bool
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NoteConnectionAccountMutation) AddField(name string, value ent.Value) error {
+func (m *NoteConnectionConflictMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown NoteConnectionAccount numeric field %s", name)
+	return fmt.Errorf("unknown NoteConnectionConflict numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NoteConnectionAccountMutation) ClearedFields() []string {
+func (m *NoteConnectionConflictMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(noteconnectionaccount.FieldEndpoint) {
-		fields = append(fields, noteconnectionaccount.FieldEndpoint)
+	if m.FieldCleared(noteconnectionconflict.FieldRemoteUpdatedAt) {
+		fields = append(fields, noteconnectionconflict.FieldRemoteUpdatedAt)
 	}
-	if m.FieldCleared(noteconnectionaccount.FieldEncryptedCredentials) {
-		fields = append(fields, noteconnectionaccount.FieldEncryptedCredentials)
-	}
-	if m.FieldCleared(noteconnectionaccount.FieldCredentialAlg) {
-		fields = append(fields, noteconnectionaccount.FieldCredentialAlg)
-	}
-	if m.FieldCleared(noteconnectionaccount.FieldDefaultTargetID) {
-		fields = append(fields, noteconnectionaccount.FieldDefaultTargetID)
-	}
-	if m.FieldCleared(noteconnectionaccount.FieldDefaultTargetName) {
-		fields = append(fields, noteconnectionaccount.FieldDefaultTargetName)
-	}
-	if m.FieldCleared(noteconnectionaccount.FieldMetadataJSON) {
-		fields = append(fields, noteconnectionaccount.FieldMetadataJSON)
-	}
-	if m.FieldCleared(noteconnectionaccount.FieldLastTestError) {
-		fields = append(fields, noteconnectionaccount.FieldLastTestError)
+	if m.FieldCleared(noteconnectionconflict.FieldResolution) {
+		fields = append(fields, noteconnectionconflict.FieldResolution)
 	}
-	if m.FieldCleared(noteconnectionaccount.FieldLastTestAt) {
-		fields = append(fields, noteconnectionaccount.FieldLastTestAt)
+	if m.FieldCleared(noteconnectionconflict.FieldResolvedAt) {
+		fields = append(fields, noteconnectionconflict.FieldResolvedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NoteConnectionAccountMutation) FieldCleared(name string) bool {
+func (m *NoteConnectionConflictMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NoteConnectionAccountMutation) ClearField(name string) error {
+func (m *NoteConnectionConflictMutation) ClearField(name string) error {
 	switch name {
-	case noteconnectionaccount.FieldEndpoint:
-		m.ClearEndpoint()
-		return nil
-	case noteconnectionaccount.FieldEncryptedCredentials:
-		m.ClearEncryptedCredentials()
-		return nil
-	case noteconnectionaccount.FieldCredentialAlg:
-		m.ClearCredentialAlg()
-		return nil
-	case noteconnectionaccount.FieldDefaultTargetID:
-		m.ClearDefaultTargetID()
+	case noteconnectionconflict.FieldRemoteUpdatedAt:
+		m.ClearRemoteUpdatedAt()
 		return nil
-	case noteconnectionaccount.FieldDefaultTargetName:
-		m.ClearDefaultTargetName()
-		return nil
-	case noteconnectionaccount.FieldMetadataJSON:
-		m.ClearMetadataJSON()
-		return nil
-	case noteconnectionaccount.FieldLastTestError:
-		m.ClearLastTestError()
+	case noteconnectionconflict.FieldResolution:
+		m.ClearResolution()
 		return nil
-	case noteconnectionaccount.FieldLastTestAt:
-		m.ClearLastTestAt()
+	case noteconnectionconflict.FieldResolvedAt:
+		m.ClearResolvedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown NoteConnectionAccount nullable field %s", name)
+	return fmt.Errorf("unknown NoteConnectionConflict nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NoteConnectionAccountMutation) ResetField(name string) error {
+func (m *NoteConnectionConflictMutation) ResetField(name string) error {
 	switch name {
-	case noteconnectionaccount.FieldName:
-		m.ResetName()
-		return nil
-	case noteconnectionaccount.FieldProvider:
-		m.ResetProvider()
-		return nil
-	case noteconnectionaccount.FieldUserID:
-		m.ResetUserID()
+	case noteconnectionconflict.FieldAccountID:
+		m.ResetAccountID()
 		return nil
-	case noteconnectionaccount.FieldEndpoint:
-		m.ResetEndpoint()
+	case noteconnectionconflict.FieldNoteID:
+		m.ResetNoteID()
 		return nil
-	case noteconnectionaccount.FieldEnabled:
-		m.ResetEnabled()
+	case noteconnectionconflict.FieldExternalID:
+		m.ResetExternalID()
 		return nil
-	case noteconnectionaccount.FieldAuthType:
-		m.ResetAuthType()
+	case noteconnectionconflict.FieldLocalTitle:
+		m.ResetLocalTitle()
 		return nil
-	case noteconnectionaccount.FieldEncryptedCredentials:
-		m.ResetEncryptedCredentials()
+	case noteconnectionconflict.FieldLocalContent:
+		m.ResetLocalContent()
 		return nil
-	case noteconnectionaccount.FieldCredentialAlg:
-		m.ResetCredentialAlg()
+	case noteconnectionconflict.FieldLocalUpdatedAt:
+		m.ResetLocalUpdatedAt()
 		return nil
-	case noteconnectionaccount.FieldDefaultTargetID:
-		m.ResetDefaultTargetID()
+	case noteconnectionconflict.FieldRemoteTitle:
+		m.ResetRemoteTitle()
 		return nil
-	case noteconnectionaccount.FieldDefaultTargetName:
-		m.ResetDefaultTargetName()
+	case noteconnectionconflict.FieldRemoteContent:
+		m.ResetRemoteContent()
 		return nil
-	case noteconnectionaccount.FieldMetadataJSON:
-		m.ResetMetadataJSON()
+	case noteconnectionconflict.FieldRemoteUpdatedAt:
+		m.ResetRemoteUpdatedAt()
 		return nil
-	case noteconnectionaccount.FieldLastTestStatus:
-		m.ResetLastTestStatus()
+	case noteconnectionconflict.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case noteconnectionaccount.FieldLastTestError:
-		m.ResetLastTestError()
+	case noteconnectionconflict.FieldResolution:
+		m.ResetResolution()
 		return nil
-	case noteconnectionaccount.FieldLastTestAt:
-		m.ResetLastTestAt()
+	case noteconnectionconflict.FieldResolvedAt:
+		m.ResetResolvedAt()
 		return nil
-	case noteconnectionaccount.FieldCreatedAt:
+	case noteconnectionconflict.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case noteconnectionaccount.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown NoteConnectionAccount field %s", name)
+	return fmt.Errorf("unknown NoteConnectionConflict field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NoteConnectionAccountMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.user != nil {
-		edges = append(edges, noteconnectionaccount.EdgeUser)
-	}
-	if m.item_maps != nil {
-		edges = append(edges, noteconnectionaccount.EdgeItemMaps)
+func (m *NoteConnectionConflictMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.account != nil {
+		edges = append(edges, noteconnectionconflict.EdgeAccount)
 	}
-	if m.jobs != nil {
-		edges = append(edges, noteconnectionaccount.EdgeJobs)
+	if m.note != nil {
+		edges = append(edges, noteconnectionconflict.EdgeNote)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NoteConnectionAccountMutation) AddedIDs(name string) []ent.Value {
+func (m *NoteConnectionConflictMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case noteconnectionaccount.EdgeUser:
-		if id := m.user; id != nil {
+	case noteconnectionconflict.EdgeAccount:
+		if id := m.account; id != nil {
 			return []ent.Value{*id}
 		}
-	case noteconnectionaccount.EdgeItemMaps:
-		ids := make([]ent.Value, 0, len(m.item_maps))
-		for id := range m.item_maps {
-			ids = append(ids, id)
-		}
-		return ids
-	case noteconnectionaccount.EdgeJobs:
-		ids := make([]ent.Value, 0, len(m.jobs))
-		for id := range m.jobs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NoteConnectionAccountMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removeditem_maps != nil {
-		edges = append(edges, noteconnectionaccount.EdgeItemMaps)
-	}
-	if m.removedjobs != nil {
-		edges = append(edges, noteconnectionaccount.EdgeJobs)
-	}
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *NoteConnectionAccountMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case noteconnectionaccount.EdgeItemMaps:
-		ids := make([]ent.Value, 0, len(m.removeditem_maps))
-		for id := range m.removeditem_maps {
-			ids = append(ids, id)
-		}
-		return ids
-	case noteconnectionaccount.EdgeJobs:
-		ids := make([]ent.Value, 0, len(m.removedjobs))
-		for id := range m.removedjobs {
-			ids = append(ids, id)
+	case noteconnectionconflict.EdgeNote:
+		if id := m.note; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NoteConnectionConflictMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NoteConnectionConflictMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NoteConnectionAccountMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleareduser {
-		edges = append(edges, noteconnectionaccount.EdgeUser)
-	}
-	if m.cleareditem_maps {
-		edges = append(edges, noteconnectionaccount.EdgeItemMaps)
+func (m *NoteConnectionConflictMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedaccount {
+		edges = append(edges, noteconnectionconflict.EdgeAccount)
 	}
-	if m.clearedjobs {
-		edges = append(edges, noteconnectionaccount.EdgeJobs)
+	if m.clearednote {
+		edges = append(edges, noteconnectionconflict.EdgeNote)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NoteConnectionAccountMutation) EdgeCleared(name string) bool {
+func (m *NoteConnectionConflictMutation) EdgeCleared(name string) bool {
 	switch name {
-	case noteconnectionaccount.EdgeUser:
-		return m.cleareduser
-	case noteconnectionaccount.EdgeItemMaps:
-		return m.cleareditem_maps
-	case noteconnectionaccount.EdgeJobs:
-		return m.clearedjobs
+	case noteconnectionconflict.EdgeAccount:
+		return m.clearedaccount
+	case noteconnectionconflict.EdgeNote:
+		return m.clearednote
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NoteConnectionAccountMutation) ClearEdge(name string) error {
+func (m *NoteConnectionConflictMutation) ClearEdge(name string) error {
 	switch name {
-	case noteconnectionaccount.EdgeUser:
-		m.ClearUser()
+	case noteconnectionconflict.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	case noteconnectionconflict.EdgeNote:
+		m.ClearNote()
 		return nil
 	}
-	return fmt.Errorf("unknown NoteConnectionAccount unique edge %s", name)
+	return fmt.Errorf("unknown NoteConnectionConflict unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NoteConnectionAccountMutation) ResetEdge(name string) error {
+func (m *NoteConnectionConflictMutation) ResetEdge(name string) error {
 	switch name {
-	case noteconnectionaccount.EdgeUser:
-		m.ResetUser()
-		return nil
-	case noteconnectionaccount.EdgeItemMaps:
-		m.ResetItemMaps()
+	case noteconnectionconflict.EdgeAccount:
+		m.ResetAccount()
 		return nil
-	case noteconnectionaccount.EdgeJobs:
-		m.ResetJobs()
+	case noteconnectionconflict.EdgeNote:
+		m.ResetNote()
 		return nil
 	}
-	return fmt.Errorf("unknown NoteConnectionAccount edge %s", name)
+	return fmt.Errorf("unknown NoteConnectionConflict edge %s", name)
 }
 
 // NoteConnectionItemMapMutation represents an operation that mutates the NoteConnectionItemMap nodes in the graph.
@@ -13639,6 +21061,8 @@ type NoteConnectionItemMapMutation struct {
 	last_sync_direction *string
 	last_imported_at    *time.Time
 	last_pushed_at      *time.Time
+	last_synced_at      *time.Time
+	remote_updated_at   *time.Time
 	metadata_json       *string
 	created_at          *time.Time
 	updated_at          *time.Time
@@ -14188,6 +21612,104 @@ func (m *NoteConnectionItemMapMutation) ResetLastPushedAt() {
 	delete(m.clearedFields, noteconnectionitemmap.FieldLastPushedAt)
 }
 
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (m *NoteConnectionItemMapMutation) SetLastSyncedAt(t time.Time) {
+	m.last_synced_at = &t
+}
+
+// LastSyncedAt returns the value of the "last_synced_at" field in the mutation.
+func (m *NoteConnectionItemMapMutation) LastSyncedAt() (r time.Time, exists bool) {
+	v := m.last_synced_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSyncedAt returns the old "last_synced_at" field's value of the NoteConnectionItemMap entity.
+// If the NoteConnectionItemMap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionItemMapMutation) OldLastSyncedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSyncedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSyncedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSyncedAt: %w", err)
+	}
+	return oldValue.LastSyncedAt, nil
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (m *NoteConnectionItemMapMutation) ClearLastSyncedAt() {
+	m.last_synced_at = nil
+	m.clearedFields[noteconnectionitemmap.FieldLastSyncedAt] = struct{}{}
+}
+
+// LastSyncedAtCleared returns if the "last_synced_at" field was cleared in this mutation.
+func (m *NoteConnectionItemMapMutation) LastSyncedAtCleared() bool {
+	_, ok := m.clearedFields[noteconnectionitemmap.FieldLastSyncedAt]
+	return ok
+}
+
+// ResetLastSyncedAt resets all changes to the "last_synced_at" field.
+func (m *NoteConnectionItemMapMutation) ResetLastSyncedAt() {
+	m.last_synced_at = nil
+	delete(m.clearedFields, noteconnectionitemmap.FieldLastSyncedAt)
+}
+
+// SetRemoteUpdatedAt sets the "remote_updated_at" field.
+func (m *NoteConnectionItemMapMutation) SetRemoteUpdatedAt(t time.Time) {
+	m.remote_updated_at = &t
+}
+
+// RemoteUpdatedAt returns the value of the "remote_updated_at" field in the mutation.
+func (m *NoteConnectionItemMapMutation) RemoteUpdatedAt() (r time.Time, exists bool) {
+	v := m.remote_updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemoteUpdatedAt returns the old "remote_updated_at" field's value of the NoteConnectionItemMap entity.
+// If the NoteConnectionItemMap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionItemMapMutation) OldRemoteUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemoteUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemoteUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemoteUpdatedAt: %w", err)
+	}
+	return oldValue.RemoteUpdatedAt, nil
+}
+
+// ClearRemoteUpdatedAt clears the value of the "remote_updated_at" field.
+func (m *NoteConnectionItemMapMutation) ClearRemoteUpdatedAt() {
+	m.remote_updated_at = nil
+	m.clearedFields[noteconnectionitemmap.FieldRemoteUpdatedAt] = struct{}{}
+}
+
+// RemoteUpdatedAtCleared returns if the "remote_updated_at" field was cleared in this mutation.
+func (m *NoteConnectionItemMapMutation) RemoteUpdatedAtCleared() bool {
+	_, ok := m.clearedFields[noteconnectionitemmap.FieldRemoteUpdatedAt]
+	return ok
+}
+
+// ResetRemoteUpdatedAt resets all changes to the "remote_updated_at" field.
+func (m *NoteConnectionItemMapMutation) ResetRemoteUpdatedAt() {
+	m.remote_updated_at = nil
+	delete(m.clearedFields, noteconnectionitemmap.FieldRemoteUpdatedAt)
+}
+
 // SetMetadataJSON sets the "metadata_json" field.
 func (m *NoteConnectionItemMapMutation) SetMetadataJSON(s string) {
 	m.metadata_json = &s
@@ -14397,7 +21919,7 @@ func (m *NoteConnectionItemMapMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *NoteConnectionItemMapMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+	fields := make([]string, 0, 15)
 	if m.provider != nil {
 		fields = append(fields, noteconnectionitemmap.FieldProvider)
 	}
@@ -14428,6 +21950,12 @@ func (m *NoteConnectionItemMapMutation) Fields() []string {
 	if m.last_pushed_at != nil {
 		fields = append(fields, noteconnectionitemmap.FieldLastPushedAt)
 	}
+	if m.last_synced_at != nil {
+		fields = append(fields, noteconnectionitemmap.FieldLastSyncedAt)
+	}
+	if m.remote_updated_at != nil {
+		fields = append(fields, noteconnectionitemmap.FieldRemoteUpdatedAt)
+	}
 	if m.metadata_json != nil {
 		fields = append(fields, noteconnectionitemmap.FieldMetadataJSON)
 	}
@@ -14465,6 +21993,10 @@ func (m *NoteConnectionItemMapMutation) Field(name string) (ent.Value, bool) {
 		return m.LastImportedAt()
 	case noteconnectionitemmap.FieldLastPushedAt:
 		return m.LastPushedAt()
+	case noteconnectionitemmap.FieldLastSyncedAt:
+		return m.LastSyncedAt()
+	case noteconnectionitemmap.FieldRemoteUpdatedAt:
+		return m.RemoteUpdatedAt()
 	case noteconnectionitemmap.FieldMetadataJSON:
 		return m.MetadataJSON()
 	case noteconnectionitemmap.FieldCreatedAt:
@@ -14500,6 +22032,10 @@ func (m *NoteConnectionItemMapMutation) OldField(ctx context.Context, name strin
 		return m.OldLastImportedAt(ctx)
 	case noteconnectionitemmap.FieldLastPushedAt:
 		return m.OldLastPushedAt(ctx)
+	case noteconnectionitemmap.FieldLastSyncedAt:
+		return m.OldLastSyncedAt(ctx)
+	case noteconnectionitemmap.FieldRemoteUpdatedAt:
+		return m.OldRemoteUpdatedAt(ctx)
 	case noteconnectionitemmap.FieldMetadataJSON:
 		return m.OldMetadataJSON(ctx)
 	case noteconnectionitemmap.FieldCreatedAt:
@@ -14585,6 +22121,20 @@ func (m *NoteConnectionItemMapMutation) SetField(name string, value ent.Value) e
 		}
 		m.SetLastPushedAt(v)
 		return nil
+	case noteconnectionitemmap.FieldLastSyncedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSyncedAt(v)
+		return nil
+	case noteconnectionitemmap.FieldRemoteUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemoteUpdatedAt(v)
+		return nil
 	case noteconnectionitemmap.FieldMetadataJSON:
 		v, ok := value.(string)
 		if !ok {
@@ -14657,6 +22207,12 @@ func (m *NoteConnectionItemMapMutation) ClearedFields() []string {
 	if m.FieldCleared(noteconnectionitemmap.FieldLastPushedAt) {
 		fields = append(fields, noteconnectionitemmap.FieldLastPushedAt)
 	}
+	if m.FieldCleared(noteconnectionitemmap.FieldLastSyncedAt) {
+		fields = append(fields, noteconnectionitemmap.FieldLastSyncedAt)
+	}
+	if m.FieldCleared(noteconnectionitemmap.FieldRemoteUpdatedAt) {
+		fields = append(fields, noteconnectionitemmap.FieldRemoteUpdatedAt)
+	}
 	if m.FieldCleared(noteconnectionitemmap.FieldMetadataJSON) {
 		fields = append(fields, noteconnectionitemmap.FieldMetadataJSON)
 	}
@@ -14692,6 +22248,12 @@ func (m *NoteConnectionItemMapMutation) ClearField(name string) error {
 	case noteconnectionitemmap.FieldLastPushedAt:
 		m.ClearLastPushedAt()
 		return nil
+	case noteconnectionitemmap.FieldLastSyncedAt:
+		m.ClearLastSyncedAt()
+		return nil
+	case noteconnectionitemmap.FieldRemoteUpdatedAt:
+		m.ClearRemoteUpdatedAt()
+		return nil
 	case noteconnectionitemmap.FieldMetadataJSON:
 		m.ClearMetadataJSON()
 		return nil
@@ -14733,6 +22295,12 @@ func (m *NoteConnectionItemMapMutation) ResetField(name string) error {
 	case noteconnectionitemmap.FieldLastPushedAt:
 		m.ResetLastPushedAt()
 		return nil
+	case noteconnectionitemmap.FieldLastSyncedAt:
+		m.ResetLastSyncedAt()
+		return nil
+	case noteconnectionitemmap.FieldRemoteUpdatedAt:
+		m.ResetRemoteUpdatedAt()
+		return nil
 	case noteconnectionitemmap.FieldMetadataJSON:
 		m.ResetMetadataJSON()
 		return nil
@@ -14847,6 +22415,12 @@ type NoteConnectionJobMutation struct {
 	provider          *string
 	operation         *string
 	status            *string
+	trigger           *string
+	attempts          *int
+	addattempts       *int
+	max_attempts      *int
+	addmax_attempts   *int
+	next_attempt_at   *time.Time
 	total_count       *int
 	addtotal_count    *int
 	imported_count    *int
@@ -14857,6 +22431,8 @@ type NoteConnectionJobMutation struct {
 	addskipped_count  *int
 	failed_count      *int
 	addfailed_count   *int
+	conflict_count    *int
+	addconflict_count *int
 	message           *string
 	options_json      *string
 	started_at        *time.Time
@@ -15117,89 +22693,286 @@ func (m *NoteConnectionJobMutation) ResetAccountID() {
 	m.account = nil
 }
 
-// SetNoteID sets the "note_id" field.
-func (m *NoteConnectionJobMutation) SetNoteID(u uuid.UUID) {
-	m.note = &u
+// SetNoteID sets the "note_id" field.
+func (m *NoteConnectionJobMutation) SetNoteID(u uuid.UUID) {
+	m.note = &u
+}
+
+// NoteID returns the value of the "note_id" field in the mutation.
+func (m *NoteConnectionJobMutation) NoteID() (r uuid.UUID, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNoteID returns the old "note_id" field's value of the NoteConnectionJob entity.
+// If the NoteConnectionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionJobMutation) OldNoteID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNoteID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNoteID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNoteID: %w", err)
+	}
+	return oldValue.NoteID, nil
+}
+
+// ClearNoteID clears the value of the "note_id" field.
+func (m *NoteConnectionJobMutation) ClearNoteID() {
+	m.note = nil
+	m.clearedFields[noteconnectionjob.FieldNoteID] = struct{}{}
+}
+
+// NoteIDCleared returns if the "note_id" field was cleared in this mutation.
+func (m *NoteConnectionJobMutation) NoteIDCleared() bool {
+	_, ok := m.clearedFields[noteconnectionjob.FieldNoteID]
+	return ok
+}
+
+// ResetNoteID resets all changes to the "note_id" field.
+func (m *NoteConnectionJobMutation) ResetNoteID() {
+	m.note = nil
+	delete(m.clearedFields, noteconnectionjob.FieldNoteID)
+}
+
+// SetStatus sets the "status" field.
+func (m *NoteConnectionJobMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *NoteConnectionJobMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the NoteConnectionJob entity.
+// If the NoteConnectionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionJobMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *NoteConnectionJobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *NoteConnectionJobMutation) SetTrigger(s string) {
+	m.trigger = &s
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *NoteConnectionJobMutation) Trigger() (r string, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the NoteConnectionJob entity.
+// If the NoteConnectionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionJobMutation) OldTrigger(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *NoteConnectionJobMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *NoteConnectionJobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *NoteConnectionJobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the NoteConnectionJob entity.
+// If the NoteConnectionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionJobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *NoteConnectionJobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *NoteConnectionJobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *NoteConnectionJobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (m *NoteConnectionJobMutation) SetMaxAttempts(i int) {
+	m.max_attempts = &i
+	m.addmax_attempts = nil
 }
 
-// NoteID returns the value of the "note_id" field in the mutation.
-func (m *NoteConnectionJobMutation) NoteID() (r uuid.UUID, exists bool) {
-	v := m.note
+// MaxAttempts returns the value of the "max_attempts" field in the mutation.
+func (m *NoteConnectionJobMutation) MaxAttempts() (r int, exists bool) {
+	v := m.max_attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNoteID returns the old "note_id" field's value of the NoteConnectionJob entity.
+// OldMaxAttempts returns the old "max_attempts" field's value of the NoteConnectionJob entity.
 // If the NoteConnectionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionJobMutation) OldNoteID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *NoteConnectionJobMutation) OldMaxAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNoteID is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNoteID requires an ID field in the mutation")
+		return v, errors.New("OldMaxAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNoteID: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxAttempts: %w", err)
 	}
-	return oldValue.NoteID, nil
+	return oldValue.MaxAttempts, nil
 }
 
-// ClearNoteID clears the value of the "note_id" field.
-func (m *NoteConnectionJobMutation) ClearNoteID() {
-	m.note = nil
-	m.clearedFields[noteconnectionjob.FieldNoteID] = struct{}{}
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (m *NoteConnectionJobMutation) AddMaxAttempts(i int) {
+	if m.addmax_attempts != nil {
+		*m.addmax_attempts += i
+	} else {
+		m.addmax_attempts = &i
+	}
 }
 
-// NoteIDCleared returns if the "note_id" field was cleared in this mutation.
-func (m *NoteConnectionJobMutation) NoteIDCleared() bool {
-	_, ok := m.clearedFields[noteconnectionjob.FieldNoteID]
-	return ok
+// AddedMaxAttempts returns the value that was added to the "max_attempts" field in this mutation.
+func (m *NoteConnectionJobMutation) AddedMaxAttempts() (r int, exists bool) {
+	v := m.addmax_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetNoteID resets all changes to the "note_id" field.
-func (m *NoteConnectionJobMutation) ResetNoteID() {
-	m.note = nil
-	delete(m.clearedFields, noteconnectionjob.FieldNoteID)
+// ResetMaxAttempts resets all changes to the "max_attempts" field.
+func (m *NoteConnectionJobMutation) ResetMaxAttempts() {
+	m.max_attempts = nil
+	m.addmax_attempts = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *NoteConnectionJobMutation) SetStatus(s string) {
-	m.status = &s
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *NoteConnectionJobMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *NoteConnectionJobMutation) Status() (r string, exists bool) {
-	v := m.status
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *NoteConnectionJobMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the NoteConnectionJob entity.
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the NoteConnectionJob entity.
 // If the NoteConnectionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteConnectionJobMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *NoteConnectionJobMutation) OldNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.NextAttemptAt, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *NoteConnectionJobMutation) ResetStatus() {
-	m.status = nil
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *NoteConnectionJobMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[noteconnectionjob.FieldNextAttemptAt] = struct{}{}
+}
+
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *NoteConnectionJobMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[noteconnectionjob.FieldNextAttemptAt]
+	return ok
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *NoteConnectionJobMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, noteconnectionjob.FieldNextAttemptAt)
 }
 
 // SetTotalCount sets the "total_count" field.
@@ -15482,6 +23255,62 @@ func (m *NoteConnectionJobMutation) ResetFailedCount() {
 	m.addfailed_count = nil
 }
 
+// SetConflictCount sets the "conflict_count" field.
+func (m *NoteConnectionJobMutation) SetConflictCount(i int) {
+	m.conflict_count = &i
+	m.addconflict_count = nil
+}
+
+// ConflictCount returns the value of the "conflict_count" field in the mutation.
+func (m *NoteConnectionJobMutation) ConflictCount() (r int, exists bool) {
+	v := m.conflict_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConflictCount returns the old "conflict_count" field's value of the NoteConnectionJob entity.
+// If the NoteConnectionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteConnectionJobMutation) OldConflictCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConflictCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConflictCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConflictCount: %w", err)
+	}
+	return oldValue.ConflictCount, nil
+}
+
+// AddConflictCount adds i to the "conflict_count" field.
+func (m *NoteConnectionJobMutation) AddConflictCount(i int) {
+	if m.addconflict_count != nil {
+		*m.addconflict_count += i
+	} else {
+		m.addconflict_count = &i
+	}
+}
+
+// AddedConflictCount returns the value that was added to the "conflict_count" field in this mutation.
+func (m *NoteConnectionJobMutation) AddedConflictCount() (r int, exists bool) {
+	v := m.addconflict_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConflictCount resets all changes to the "conflict_count" field.
+func (m *NoteConnectionJobMutation) ResetConflictCount() {
+	m.conflict_count = nil
+	m.addconflict_count = nil
+}
+
 // SetMessage sets the "message" field.
 func (m *NoteConnectionJobMutation) SetMessage(s string) {
 	m.message = &s
@@ -15865,7 +23694,7 @@ func (m *NoteConnectionJobMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *NoteConnectionJobMutation) Fields() []string {
-	fields := make([]string, 0, 17)
+	fields := make([]string, 0, 22)
 	if m.provider != nil {
 		fields = append(fields, noteconnectionjob.FieldProvider)
 	}
@@ -15884,6 +23713,18 @@ func (m *NoteConnectionJobMutation) Fields() []string {
 	if m.status != nil {
 		fields = append(fields, noteconnectionjob.FieldStatus)
 	}
+	if m.trigger != nil {
+		fields = append(fields, noteconnectionjob.FieldTrigger)
+	}
+	if m.attempts != nil {
+		fields = append(fields, noteconnectionjob.FieldAttempts)
+	}
+	if m.max_attempts != nil {
+		fields = append(fields, noteconnectionjob.FieldMaxAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, noteconnectionjob.FieldNextAttemptAt)
+	}
 	if m.total_count != nil {
 		fields = append(fields, noteconnectionjob.FieldTotalCount)
 	}
@@ -15899,6 +23740,9 @@ func (m *NoteConnectionJobMutation) Fields() []string {
 	if m.failed_count != nil {
 		fields = append(fields, noteconnectionjob.FieldFailedCount)
 	}
+	if m.conflict_count != nil {
+		fields = append(fields, noteconnectionjob.FieldConflictCount)
+	}
 	if m.message != nil {
 		fields = append(fields, noteconnectionjob.FieldMessage)
 	}
@@ -15937,6 +23781,14 @@ func (m *NoteConnectionJobMutation) Field(name string) (ent.Value, bool) {
 		return m.NoteID()
 	case noteconnectionjob.FieldStatus:
 		return m.Status()
+	case noteconnectionjob.FieldTrigger:
+		return m.Trigger()
+	case noteconnectionjob.FieldAttempts:
+		return m.Attempts()
+	case noteconnectionjob.FieldMaxAttempts:
+		return m.MaxAttempts()
+	case noteconnectionjob.FieldNextAttemptAt:
+		return m.NextAttemptAt()
 	case noteconnectionjob.FieldTotalCount:
 		return m.TotalCount()
 	case noteconnectionjob.FieldImportedCount:
@@ -15947,6 +23799,8 @@ func (m *NoteConnectionJobMutation) Field(name string) (ent.Value, bool) {
 		return m.SkippedCount()
 	case noteconnectionjob.FieldFailedCount:
 		return m.FailedCount()
+	case noteconnectionjob.FieldConflictCount:
+		return m.ConflictCount()
 	case noteconnectionjob.FieldMessage:
 		return m.Message()
 	case noteconnectionjob.FieldOptionsJSON:
@@ -15980,6 +23834,14 @@ func (m *NoteConnectionJobMutation) OldField(ctx context.Context, name string) (
 		return m.OldNoteID(ctx)
 	case noteconnectionjob.FieldStatus:
 		return m.OldStatus(ctx)
+	case noteconnectionjob.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case noteconnectionjob.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case noteconnectionjob.FieldMaxAttempts:
+		return m.OldMaxAttempts(ctx)
+	case noteconnectionjob.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
 	case noteconnectionjob.FieldTotalCount:
 		return m.OldTotalCount(ctx)
 	case noteconnectionjob.FieldImportedCount:
@@ -15990,6 +23852,8 @@ func (m *NoteConnectionJobMutation) OldField(ctx context.Context, name string) (
 		return m.OldSkippedCount(ctx)
 	case noteconnectionjob.FieldFailedCount:
 		return m.OldFailedCount(ctx)
+	case noteconnectionjob.FieldConflictCount:
+		return m.OldConflictCount(ctx)
 	case noteconnectionjob.FieldMessage:
 		return m.OldMessage(ctx)
 	case noteconnectionjob.FieldOptionsJSON:
@@ -16053,6 +23917,34 @@ func (m *NoteConnectionJobMutation) SetField(name string, value ent.Value) error
 		}
 		m.SetStatus(v)
 		return nil
+	case noteconnectionjob.FieldTrigger:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case noteconnectionjob.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case noteconnectionjob.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAttempts(v)
+		return nil
+	case noteconnectionjob.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
 	case noteconnectionjob.FieldTotalCount:
 		v, ok := value.(int)
 		if !ok {
@@ -16088,6 +23980,13 @@ func (m *NoteConnectionJobMutation) SetField(name string, value ent.Value) error
 		}
 		m.SetFailedCount(v)
 		return nil
+	case noteconnectionjob.FieldConflictCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConflictCount(v)
+		return nil
 	case noteconnectionjob.FieldMessage:
 		v, ok := value.(string)
 		if !ok {
@@ -16138,6 +24037,12 @@ func (m *NoteConnectionJobMutation) SetField(name string, value ent.Value) error
 // this mutation.
 func (m *NoteConnectionJobMutation) AddedFields() []string {
 	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, noteconnectionjob.FieldAttempts)
+	}
+	if m.addmax_attempts != nil {
+		fields = append(fields, noteconnectionjob.FieldMaxAttempts)
+	}
 	if m.addtotal_count != nil {
 		fields = append(fields, noteconnectionjob.FieldTotalCount)
 	}
@@ -16153,6 +24058,9 @@ func (m *NoteConnectionJobMutation) AddedFields() []string {
 	if m.addfailed_count != nil {
 		fields = append(fields, noteconnectionjob.FieldFailedCount)
 	}
+	if m.addconflict_count != nil {
+		fields = append(fields, noteconnectionjob.FieldConflictCount)
+	}
 	return fields
 }
 
@@ -16161,6 +24069,10 @@ func (m *NoteConnectionJobMutation) AddedFields() []string {
 // was not set, or was not defined in the schema.
 func (m *NoteConnectionJobMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case noteconnectionjob.FieldAttempts:
+		return m.AddedAttempts()
+	case noteconnectionjob.FieldMaxAttempts:
+		return m.AddedMaxAttempts()
 	case noteconnectionjob.FieldTotalCount:
 		return m.AddedTotalCount()
 	case noteconnectionjob.FieldImportedCount:
@@ -16171,6 +24083,8 @@ func (m *NoteConnectionJobMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedSkippedCount()
 	case noteconnectionjob.FieldFailedCount:
 		return m.AddedFailedCount()
+	case noteconnectionjob.FieldConflictCount:
+		return m.AddedConflictCount()
 	}
 	return nil, false
 }
@@ -16180,6 +24094,20 @@ func (m *NoteConnectionJobMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *NoteConnectionJobMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case noteconnectionjob.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case noteconnectionjob.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAttempts(v)
+		return nil
 	case noteconnectionjob.FieldTotalCount:
 		v, ok := value.(int)
 		if !ok {
@@ -16215,6 +24143,13 @@ func (m *NoteConnectionJobMutation) AddField(name string, value ent.Value) error
 		}
 		m.AddFailedCount(v)
 		return nil
+	case noteconnectionjob.FieldConflictCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConflictCount(v)
+		return nil
 	}
 	return fmt.Errorf("unknown NoteConnectionJob numeric field %s", name)
 }
@@ -16226,6 +24161,9 @@ func (m *NoteConnectionJobMutation) ClearedFields() []string {
 	if m.FieldCleared(noteconnectionjob.FieldNoteID) {
 		fields = append(fields, noteconnectionjob.FieldNoteID)
 	}
+	if m.FieldCleared(noteconnectionjob.FieldNextAttemptAt) {
+		fields = append(fields, noteconnectionjob.FieldNextAttemptAt)
+	}
 	if m.FieldCleared(noteconnectionjob.FieldMessage) {
 		fields = append(fields, noteconnectionjob.FieldMessage)
 	}
@@ -16255,6 +24193,9 @@ func (m *NoteConnectionJobMutation) ClearField(name string) error {
 	case noteconnectionjob.FieldNoteID:
 		m.ClearNoteID()
 		return nil
+	case noteconnectionjob.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
+		return nil
 	case noteconnectionjob.FieldMessage:
 		m.ClearMessage()
 		return nil
@@ -16293,6 +24234,18 @@ func (m *NoteConnectionJobMutation) ResetField(name string) error {
 	case noteconnectionjob.FieldStatus:
 		m.ResetStatus()
 		return nil
+	case noteconnectionjob.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case noteconnectionjob.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case noteconnectionjob.FieldMaxAttempts:
+		m.ResetMaxAttempts()
+		return nil
+	case noteconnectionjob.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
 	case noteconnectionjob.FieldTotalCount:
 		m.ResetTotalCount()
 		return nil
@@ -16308,6 +24261,9 @@ func (m *NoteConnectionJobMutation) ResetField(name string) error {
 	case noteconnectionjob.FieldFailedCount:
 		m.ResetFailedCount()
 		return nil
+	case noteconnectionjob.FieldConflictCount:
+		m.ResetConflictCount()
+		return nil
 	case noteconnectionjob.FieldMessage:
 		m.ResetMessage()
 		return nil
@@ -16451,6 +24407,8 @@ type NoteLinkMutation struct {
 	target_key          *string
 	display_text        *string
 	link_type           *notelink.LinkType
+	anchor_type         *notelink.AnchorType
+	anchor              *string
 	occurrence_count    *int
 	addoccurrence_count *int
 	created_at          *time.Time
@@ -16872,6 +24830,78 @@ func (m *NoteLinkMutation) ResetLinkType() {
 	m.link_type = nil
 }
 
+// SetAnchorType sets the "anchor_type" field.
+func (m *NoteLinkMutation) SetAnchorType(nt notelink.AnchorType) {
+	m.anchor_type = &nt
+}
+
+// AnchorType returns the value of the "anchor_type" field in the mutation.
+func (m *NoteLinkMutation) AnchorType() (r notelink.AnchorType, exists bool) {
+	v := m.anchor_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnchorType returns the old "anchor_type" field's value of the NoteLink entity.
+// If the NoteLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteLinkMutation) OldAnchorType(ctx context.Context) (v notelink.AnchorType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnchorType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnchorType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnchorType: %w", err)
+	}
+	return oldValue.AnchorType, nil
+}
+
+// ResetAnchorType resets all changes to the "anchor_type" field.
+func (m *NoteLinkMutation) ResetAnchorType() {
+	m.anchor_type = nil
+}
+
+// SetAnchor sets the "anchor" field.
+func (m *NoteLinkMutation) SetAnchor(s string) {
+	m.anchor = &s
+}
+
+// Anchor returns the value of the "anchor" field in the mutation.
+func (m *NoteLinkMutation) Anchor() (r string, exists bool) {
+	v := m.anchor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnchor returns the old "anchor" field's value of the NoteLink entity.
+// If the NoteLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteLinkMutation) OldAnchor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnchor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnchor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnchor: %w", err)
+	}
+	return oldValue.Anchor, nil
+}
+
+// ResetAnchor resets all changes to the "anchor" field.
+func (m *NoteLinkMutation) ResetAnchor() {
+	m.anchor = nil
+}
+
 // SetOccurrenceCount sets the "occurrence_count" field.
 func (m *NoteLinkMutation) SetOccurrenceCount(i int) {
 	m.occurrence_count = &i
@@ -17115,7 +25145,7 @@ func (m *NoteLinkMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *NoteLinkMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 13)
 	if m.user != nil {
 		fields = append(fields, notelink.FieldUserID)
 	}
@@ -17140,6 +25170,12 @@ func (m *NoteLinkMutation) Fields() []string {
 	if m.link_type != nil {
 		fields = append(fields, notelink.FieldLinkType)
 	}
+	if m.anchor_type != nil {
+		fields = append(fields, notelink.FieldAnchorType)
+	}
+	if m.anchor != nil {
+		fields = append(fields, notelink.FieldAnchor)
+	}
 	if m.occurrence_count != nil {
 		fields = append(fields, notelink.FieldOccurrenceCount)
 	}
@@ -17173,6 +25209,10 @@ func (m *NoteLinkMutation) Field(name string) (ent.Value, bool) {
 		return m.DisplayText()
 	case notelink.FieldLinkType:
 		return m.LinkType()
+	case notelink.FieldAnchorType:
+		return m.AnchorType()
+	case notelink.FieldAnchor:
+		return m.Anchor()
 	case notelink.FieldOccurrenceCount:
 		return m.OccurrenceCount()
 	case notelink.FieldCreatedAt:
@@ -17204,6 +25244,10 @@ func (m *NoteLinkMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldDisplayText(ctx)
 	case notelink.FieldLinkType:
 		return m.OldLinkType(ctx)
+	case notelink.FieldAnchorType:
+		return m.OldAnchorType(ctx)
+	case notelink.FieldAnchor:
+		return m.OldAnchor(ctx)
 	case notelink.FieldOccurrenceCount:
 		return m.OldOccurrenceCount(ctx)
 	case notelink.FieldCreatedAt:
@@ -17275,6 +25319,20 @@ func (m *NoteLinkMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetLinkType(v)
 		return nil
+	case notelink.FieldAnchorType:
+		v, ok := value.(notelink.AnchorType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnchorType(v)
+		return nil
+	case notelink.FieldAnchor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnchor(v)
+		return nil
 	case notelink.FieldOccurrenceCount:
 		v, ok := value.(int)
 		if !ok {
@@ -17393,6 +25451,12 @@ func (m *NoteLinkMutation) ResetField(name string) error {
 	case notelink.FieldLinkType:
 		m.ResetLinkType()
 		return nil
+	case notelink.FieldAnchorType:
+		m.ResetAnchorType()
+		return nil
+	case notelink.FieldAnchor:
+		m.ResetAnchor()
+		return nil
 	case notelink.FieldOccurrenceCount:
 		m.ResetOccurrenceCount()
 		return nil
@@ -18198,6 +26262,7 @@ type UserMutation struct {
 	avatar                          *string
 	share_signature                 *string
 	time_zone                       *string
+	link_rename_mode                *user.LinkRenameMode
 	lazycat_uid                     *string
 	created_at                      *time.Time
 	updated_at                      *time.Time
@@ -18240,6 +26305,9 @@ type UserMutation struct {
 	note_links                      map[uuid.UUID]struct{}
 	removednote_links               map[uuid.UUID]struct{}
 	clearednote_links               bool
+	link_rewrites                   map[uuid.UUID]struct{}
+	removedlink_rewrites            map[uuid.UUID]struct{}
+	clearedlink_rewrites            bool
 	done                            bool
 	oldValue                        func(context.Context) (*User, error)
 	predicates                      []predicate.User
@@ -18670,6 +26738,42 @@ func (m *UserMutation) ResetTimeZone() {
 	m.time_zone = nil
 }
 
+// SetLinkRenameMode sets the "link_rename_mode" field.
+func (m *UserMutation) SetLinkRenameMode(urm user.LinkRenameMode) {
+	m.link_rename_mode = &urm
+}
+
+// LinkRenameMode returns the value of the "link_rename_mode" field in the mutation.
+func (m *UserMutation) LinkRenameMode() (r user.LinkRenameMode, exists bool) {
+	v := m.link_rename_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLinkRenameMode returns the old "link_rename_mode" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLinkRenameMode(ctx context.Context) (v user.LinkRenameMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLinkRenameMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLinkRenameMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLinkRenameMode: %w", err)
+	}
+	return oldValue.LinkRenameMode, nil
+}
+
+// ResetLinkRenameMode resets all changes to the "link_rename_mode" field.
+func (m *UserMutation) ResetLinkRenameMode() {
+	m.link_rename_mode = nil
+}
+
 // SetLazycatUID sets the "lazycat_uid" field.
 func (m *UserMutation) SetLazycatUID(s string) {
 	m.lazycat_uid = &s
@@ -19478,6 +27582,60 @@ func (m *UserMutation) ResetNoteLinks() {
 	m.removednote_links = nil
 }
 
+// AddLinkRewriteIDs adds the "link_rewrites" edge to the LinkRewrite entity by ids.
+func (m *UserMutation) AddLinkRewriteIDs(ids ...uuid.UUID) {
+	if m.link_rewrites == nil {
+		m.link_rewrites = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.link_rewrites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLinkRewrites clears the "link_rewrites" edge to the LinkRewrite entity.
+func (m *UserMutation) ClearLinkRewrites() {
+	m.clearedlink_rewrites = true
+}
+
+// LinkRewritesCleared reports if the "link_rewrites" edge to the LinkRewrite entity was cleared.
+func (m *UserMutation) LinkRewritesCleared() bool {
+	return m.clearedlink_rewrites
+}
+
+// RemoveLinkRewriteIDs removes the "link_rewrites" edge to the LinkRewrite entity by IDs.
+func (m *UserMutation) RemoveLinkRewriteIDs(ids ...uuid.UUID) {
+	if m.removedlink_rewrites == nil {
+		m.removedlink_rewrites = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.link_rewrites, ids[i])
+		m.removedlink_rewrites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLinkRewrites returns the removed IDs of the "link_rewrites" edge to the LinkRewrite entity.
+func (m *UserMutation) RemovedLinkRewritesIDs() (ids []uuid.UUID) {
+	for id := range m.removedlink_rewrites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LinkRewritesIDs returns the "link_rewrites" edge IDs in the mutation.
+func (m *UserMutation) LinkRewritesIDs() (ids []uuid.UUID) {
+	for id := range m.link_rewrites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLinkRewrites resets all changes to the "link_rewrites" edge.
+func (m *UserMutation) ResetLinkRewrites() {
+	m.link_rewrites = nil
+	m.clearedlink_rewrites = false
+	m.removedlink_rewrites = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -19512,7 +27670,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 12)
 	if m.username != nil {
 		fields = append(fields, user.FieldUsername)
 	}
@@ -19537,6 +27695,9 @@ func (m *UserMutation) Fields() []string {
 	if m.time_zone != nil {
 		fields = append(fields, user.FieldTimeZone)
 	}
+	if m.link_rename_mode != nil {
+		fields = append(fields, user.FieldLinkRenameMode)
+	}
 	if m.lazycat_uid != nil {
 		fields = append(fields, user.FieldLazycatUID)
 	}
@@ -19570,6 +27731,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.ShareSignature()
 	case user.FieldTimeZone:
 		return m.TimeZone()
+	case user.FieldLinkRenameMode:
+		return m.LinkRenameMode()
 	case user.FieldLazycatUID:
 		return m.LazycatUID()
 	case user.FieldCreatedAt:
@@ -19601,6 +27764,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldShareSignature(ctx)
 	case user.FieldTimeZone:
 		return m.OldTimeZone(ctx)
+	case user.FieldLinkRenameMode:
+		return m.OldLinkRenameMode(ctx)
 	case user.FieldLazycatUID:
 		return m.OldLazycatUID(ctx)
 	case user.FieldCreatedAt:
@@ -19672,6 +27837,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTimeZone(v)
 		return nil
+	case user.FieldLinkRenameMode:
+		v, ok := value.(user.LinkRenameMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLinkRenameMode(v)
+		return nil
 	case user.FieldLazycatUID:
 		v, ok := value.(string)
 		if !ok {
@@ -19793,6 +27965,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldTimeZone:
 		m.ResetTimeZone()
 		return nil
+	case user.FieldLinkRenameMode:
+		m.ResetLinkRenameMode()
+		return nil
 	case user.FieldLazycatUID:
 		m.ResetLazycatUID()
 		return nil
@@ -19808,7 +27983,7 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 13)
+	edges := make([]string, 0, 14)
 	if m.notes != nil {
 		edges = append(edges, user.EdgeNotes)
 	}
@@ -19848,6 +28023,9 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.note_links != nil {
 		edges = append(edges, user.EdgeNoteLinks)
 	}
+	if m.link_rewrites != nil {
+		edges = append(edges, user.EdgeLinkRewrites)
+	}
 	return edges
 }
 
@@ -19931,13 +28109,19 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeLinkRewrites:
+		ids := make([]ent.Value, 0, len(m.link_rewrites))
+		for id := range m.link_rewrites {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 13)
+	edges := make([]string, 0, 14)
 	if m.removednotes != nil {
 		edges = append(edges, user.EdgeNotes)
 	}
@@ -19974,6 +28158,9 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removednote_links != nil {
 		edges = append(edges, user.EdgeNoteLinks)
 	}
+	if m.removedlink_rewrites != nil {
+		edges = append(edges, user.EdgeLinkRewrites)
+	}
 	return edges
 }
 
@@ -20053,13 +28240,19 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeLinkRewrites:
+		ids := make([]ent.Value, 0, len(m.removedlink_rewrites))
+		for id := range m.removedlink_rewrites {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 13)
+	edges := make([]string, 0, 14)
 	if m.clearednotes {
 		edges = append(edges, user.EdgeNotes)
 	}
@@ -20099,6 +28292,9 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearednote_links {
 		edges = append(edges, user.EdgeNoteLinks)
 	}
+	if m.clearedlink_rewrites {
+		edges = append(edges, user.EdgeLinkRewrites)
+	}
 	return edges
 }
 
@@ -20132,6 +28328,8 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearednote_connection_jobs
 	case user.EdgeNoteLinks:
 		return m.clearednote_links
+	case user.EdgeLinkRewrites:
+		return m.clearedlink_rewrites
 	}
 	return false
 }
@@ -20190,6 +28388,9 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeNoteLinks:
 		m.ResetNoteLinks()
 		return nil
+	case user.EdgeLinkRewrites:
+		m.ResetLinkRewrites()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
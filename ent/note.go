@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"smarticky/ent/folder"
 	"smarticky/ent/note"
@@ -40,6 +41,8 @@ type Note struct {
 	EncryptionSalt string `json:"encryption_salt,omitempty"`
 	// EncryptionNonce holds the value of the "encryption_nonce" field.
 	EncryptionNonce string `json:"encryption_nonce,omitempty"`
+	// Aliases holds the value of the "aliases" field.
+	Aliases []string `json:"aliases,omitempty"`
 	// IsStarred holds the value of the "is_starred" field.
 	IsStarred bool `json:"is_starred,omitempty"`
 	// IsDeleted holds the value of the "is_deleted" field.
@@ -74,11 +77,13 @@ type NoteEdges struct {
 	ConnectionMaps []*NoteConnectionItemMap `json:"connection_maps,omitempty"`
 	// ConnectionJobs holds the value of the connection_jobs edge.
 	ConnectionJobs []*NoteConnectionJob `json:"connection_jobs,omitempty"`
+	// ConnectionConflicts holds the value of the connection_conflicts edge.
+	ConnectionConflicts []*NoteConnectionConflict `json:"connection_conflicts,omitempty"`
 	// Tags holds the value of the tags edge.
 	Tags []*Tag `json:"tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [9]bool
+	loadedTypes [10]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -157,10 +162,19 @@ func (e NoteEdges) ConnectionJobsOrErr() ([]*NoteConnectionJob, error) {
 	return nil, &NotLoadedError{edge: "connection_jobs"}
 }
 
+// ConnectionConflictsOrErr returns the ConnectionConflicts value or an error if the edge
+// was not loaded in eager-loading.
+func (e NoteEdges) ConnectionConflictsOrErr() ([]*NoteConnectionConflict, error) {
+	if e.loadedTypes[8] {
+		return e.ConnectionConflicts, nil
+	}
+	return nil, &NotLoadedError{edge: "connection_conflicts"}
+}
+
 // TagsOrErr returns the Tags value or an error if the edge
 // was not loaded in eager-loading.
 func (e NoteEdges) TagsOrErr() ([]*Tag, error) {
-	if e.loadedTypes[8] {
+	if e.loadedTypes[9] {
 		return e.Tags, nil
 	}
 	return nil, &NotLoadedError{edge: "tags"}
@@ -171,6 +185,8 @@ func (*Note) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case note.FieldAliases:
+			values[i] = new([]byte)
 		case note.FieldIsStarred, note.FieldIsDeleted:
 			values[i] = new(sql.NullBool)
 		case note.FieldTitle, note.FieldContent, note.FieldColor, note.FieldProtectionMode, note.FieldProtectionPasswordHash, note.FieldEncryptedContent, note.FieldEncryptionAlg, note.FieldEncryptionKdf, note.FieldEncryptionSalt, note.FieldEncryptionNonce:
@@ -264,6 +280,14 @@ func (_m *Note) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.EncryptionNonce = value.String
 			}
+		case note.FieldAliases:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aliases", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Aliases); err != nil {
+					return fmt.Errorf("unmarshal field aliases: %w", err)
+				}
+			}
 		case note.FieldIsStarred:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_starred", values[i])
@@ -355,6 +379,11 @@ func (_m *Note) QueryConnectionJobs() *NoteConnectionJobQuery {
 	return NewNoteClient(_m.config).QueryConnectionJobs(_m)
 }
 
+// QueryConnectionConflicts queries the "connection_conflicts" edge of the Note entity.
+func (_m *Note) QueryConnectionConflicts() *NoteConnectionConflictQuery {
+	return NewNoteClient(_m.config).QueryConnectionConflicts(_m)
+}
+
 // QueryTags queries the "tags" edge of the Note entity.
 func (_m *Note) QueryTags() *TagQuery {
 	return NewNoteClient(_m.config).QueryTags(_m)
@@ -411,6 +440,9 @@ func (_m *Note) String() string {
 	builder.WriteString("encryption_nonce=")
 	builder.WriteString(_m.EncryptionNonce)
 	builder.WriteString(", ")
+	builder.WriteString("aliases=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Aliases))
+	builder.WriteString(", ")
 	builder.WriteString("is_starred=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsStarred))
 	builder.WriteString(", ")
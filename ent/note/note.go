@@ -36,6 +36,8 @@ const (
 	FieldEncryptionSalt = "encryption_salt"
 	// FieldEncryptionNonce holds the string denoting the encryption_nonce field in the database.
 	FieldEncryptionNonce = "encryption_nonce"
+	// FieldAliases holds the string denoting the aliases field in the database.
+	FieldAliases = "aliases"
 	// FieldIsStarred holds the string denoting the is_starred field in the database.
 	FieldIsStarred = "is_starred"
 	// FieldIsDeleted holds the string denoting the is_deleted field in the database.
@@ -60,6 +62,8 @@ const (
 	EdgeConnectionMaps = "connection_maps"
 	// EdgeConnectionJobs holds the string denoting the connection_jobs edge name in mutations.
 	EdgeConnectionJobs = "connection_jobs"
+	// EdgeConnectionConflicts holds the string denoting the connection_conflicts edge name in mutations.
+	EdgeConnectionConflicts = "connection_conflicts"
 	// EdgeTags holds the string denoting the tags edge name in mutations.
 	EdgeTags = "tags"
 	// Table holds the table name of the note in the database.
@@ -120,6 +124,13 @@ const (
 	ConnectionJobsInverseTable = "note_connection_jobs"
 	// ConnectionJobsColumn is the table column denoting the connection_jobs relation/edge.
 	ConnectionJobsColumn = "note_id"
+	// ConnectionConflictsTable is the table that holds the connection_conflicts relation/edge.
+	ConnectionConflictsTable = "note_connection_conflicts"
+	// ConnectionConflictsInverseTable is the table name for the NoteConnectionConflict entity.
+	// It exists in this package in order to avoid circular dependency with the "noteconnectionconflict" package.
+	ConnectionConflictsInverseTable = "note_connection_conflicts"
+	// ConnectionConflictsColumn is the table column denoting the connection_conflicts relation/edge.
+	ConnectionConflictsColumn = "note_id"
 	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
 	TagsTable = "note_tags"
 	// TagsInverseTable is the table name for the Tag entity.
@@ -140,6 +151,7 @@ var Columns = []string{
 	FieldEncryptionKdf,
 	FieldEncryptionSalt,
 	FieldEncryptionNonce,
+	FieldAliases,
 	FieldIsStarred,
 	FieldIsDeleted,
 	FieldCreatedAt,
@@ -396,6 +408,20 @@ func ByConnectionJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByConnectionConflictsCount orders the results by connection_conflicts count.
+func ByConnectionConflictsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newConnectionConflictsStep(), opts...)
+	}
+}
+
+// ByConnectionConflicts orders the results by connection_conflicts terms.
+func ByConnectionConflicts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConnectionConflictsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByTagsCount orders the results by tags count.
 func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -465,6 +491,13 @@ func newConnectionJobsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ConnectionJobsTable, ConnectionJobsColumn),
 	)
 }
+func newConnectionConflictsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConnectionConflictsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ConnectionConflictsTable, ConnectionConflictsColumn),
+	)
+}
 func newTagsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -806,6 +806,16 @@ func EncryptionNonceContainsFold(v string) predicate.Note {
 	return predicate.Note(sql.FieldContainsFold(FieldEncryptionNonce, v))
 }
 
+// AliasesIsNil applies the IsNil predicate on the "aliases" field.
+func AliasesIsNil() predicate.Note {
+	return predicate.Note(sql.FieldIsNull(FieldAliases))
+}
+
+// AliasesNotNil applies the NotNil predicate on the "aliases" field.
+func AliasesNotNil() predicate.Note {
+	return predicate.Note(sql.FieldNotNull(FieldAliases))
+}
+
 // IsStarredEQ applies the EQ predicate on the "is_starred" field.
 func IsStarredEQ(v bool) predicate.Note {
 	return predicate.Note(sql.FieldEQ(FieldIsStarred, v))
@@ -1090,6 +1100,29 @@ func HasConnectionJobsWith(preds ...predicate.NoteConnectionJob) predicate.Note
 	})
 }
 
+// HasConnectionConflicts applies the HasEdge predicate on the "connection_conflicts" edge.
+func HasConnectionConflicts() predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ConnectionConflictsTable, ConnectionConflictsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConnectionConflictsWith applies the HasEdge predicate on the "connection_conflicts" edge with a given conditions (other predicates).
+func HasConnectionConflictsWith(preds ...predicate.NoteConnectionConflict) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		step := newConnectionConflictsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasTags applies the HasEdge predicate on the "tags" edge.
 func HasTags() predicate.Note {
 	return predicate.Note(func(s *sql.Selector) {
@@ -9,6 +9,7 @@ import (
 	"smarticky/ent/attachment"
 	"smarticky/ent/folder"
 	"smarticky/ent/note"
+	"smarticky/ent/noteconnectionconflict"
 	"smarticky/ent/noteconnectionitemmap"
 	"smarticky/ent/noteconnectionjob"
 	"smarticky/ent/notelink"
@@ -169,6 +170,12 @@ func (_c *NoteCreate) SetNillableEncryptionNonce(v *string) *NoteCreate {
 	return _c
 }
 
+// SetAliases sets the "aliases" field.
+func (_c *NoteCreate) SetAliases(v []string) *NoteCreate {
+	_c.mutation.SetAliases(v)
+	return _c
+}
+
 // SetIsStarred sets the "is_starred" field.
 func (_c *NoteCreate) SetIsStarred(v bool) *NoteCreate {
 	_c.mutation.SetIsStarred(v)
@@ -367,6 +374,21 @@ func (_c *NoteCreate) AddConnectionJobs(v ...*NoteConnectionJob) *NoteCreate {
 	return _c.AddConnectionJobIDs(ids...)
 }
 
+// AddConnectionConflictIDs adds the "connection_conflicts" edge to the NoteConnectionConflict entity by IDs.
+func (_c *NoteCreate) AddConnectionConflictIDs(ids ...int) *NoteCreate {
+	_c.mutation.AddConnectionConflictIDs(ids...)
+	return _c
+}
+
+// AddConnectionConflicts adds the "connection_conflicts" edges to the NoteConnectionConflict entity.
+func (_c *NoteCreate) AddConnectionConflicts(v ...*NoteConnectionConflict) *NoteCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddConnectionConflictIDs(ids...)
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (_c *NoteCreate) AddTagIDs(ids ...uuid.UUID) *NoteCreate {
 	_c.mutation.AddTagIDs(ids...)
@@ -551,6 +573,10 @@ func (_c *NoteCreate) createSpec() (*Note, *sqlgraph.CreateSpec) {
 		_spec.SetField(note.FieldEncryptionNonce, field.TypeString, value)
 		_node.EncryptionNonce = value
 	}
+	if value, ok := _c.mutation.Aliases(); ok {
+		_spec.SetField(note.FieldAliases, field.TypeJSON, value)
+		_node.Aliases = value
+	}
 	if value, ok := _c.mutation.IsStarred(); ok {
 		_spec.SetField(note.FieldIsStarred, field.TypeBool, value)
 		_node.IsStarred = value
@@ -697,6 +723,22 @@ func (_c *NoteCreate) createSpec() (*Note, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ConnectionConflictsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ConnectionConflictsTable,
+			Columns: []string{note.ConnectionConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
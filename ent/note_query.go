@@ -10,6 +10,7 @@ import (
 	"smarticky/ent/attachment"
 	"smarticky/ent/folder"
 	"smarticky/ent/note"
+	"smarticky/ent/noteconnectionconflict"
 	"smarticky/ent/noteconnectionitemmap"
 	"smarticky/ent/noteconnectionjob"
 	"smarticky/ent/notelink"
@@ -28,20 +29,21 @@ import (
 // NoteQuery is the builder for querying Note entities.
 type NoteQuery struct {
 	config
-	ctx                *QueryContext
-	order              []note.OrderOption
-	inters             []Interceptor
-	predicates         []predicate.Note
-	withUser           *UserQuery
-	withFolder         *FolderQuery
-	withAttachments    *AttachmentQuery
-	withWhiteboards    *WhiteboardQuery
-	withOutgoingLinks  *NoteLinkQuery
-	withBacklinks      *NoteLinkQuery
-	withConnectionMaps *NoteConnectionItemMapQuery
-	withConnectionJobs *NoteConnectionJobQuery
-	withTags           *TagQuery
-	withFKs            bool
+	ctx                     *QueryContext
+	order                   []note.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.Note
+	withUser                *UserQuery
+	withFolder              *FolderQuery
+	withAttachments         *AttachmentQuery
+	withWhiteboards         *WhiteboardQuery
+	withOutgoingLinks       *NoteLinkQuery
+	withBacklinks           *NoteLinkQuery
+	withConnectionMaps      *NoteConnectionItemMapQuery
+	withConnectionJobs      *NoteConnectionJobQuery
+	withConnectionConflicts *NoteConnectionConflictQuery
+	withTags                *TagQuery
+	withFKs                 bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -254,6 +256,28 @@ func (_q *NoteQuery) QueryConnectionJobs() *NoteConnectionJobQuery {
 	return query
 }
 
+// QueryConnectionConflicts chains the current query on the "connection_conflicts" edge.
+func (_q *NoteQuery) QueryConnectionConflicts() *NoteConnectionConflictQuery {
+	query := (&NoteConnectionConflictClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, selector),
+			sqlgraph.To(noteconnectionconflict.Table, noteconnectionconflict.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.ConnectionConflictsTable, note.ConnectionConflictsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryTags chains the current query on the "tags" edge.
 func (_q *NoteQuery) QueryTags() *TagQuery {
 	query := (&TagClient{config: _q.config}).Query()
@@ -463,20 +487,21 @@ func (_q *NoteQuery) Clone() *NoteQuery {
 		return nil
 	}
 	return &NoteQuery{
-		config:             _q.config,
-		ctx:                _q.ctx.Clone(),
-		order:              append([]note.OrderOption{}, _q.order...),
-		inters:             append([]Interceptor{}, _q.inters...),
-		predicates:         append([]predicate.Note{}, _q.predicates...),
-		withUser:           _q.withUser.Clone(),
-		withFolder:         _q.withFolder.Clone(),
-		withAttachments:    _q.withAttachments.Clone(),
-		withWhiteboards:    _q.withWhiteboards.Clone(),
-		withOutgoingLinks:  _q.withOutgoingLinks.Clone(),
-		withBacklinks:      _q.withBacklinks.Clone(),
-		withConnectionMaps: _q.withConnectionMaps.Clone(),
-		withConnectionJobs: _q.withConnectionJobs.Clone(),
-		withTags:           _q.withTags.Clone(),
+		config:                  _q.config,
+		ctx:                     _q.ctx.Clone(),
+		order:                   append([]note.OrderOption{}, _q.order...),
+		inters:                  append([]Interceptor{}, _q.inters...),
+		predicates:              append([]predicate.Note{}, _q.predicates...),
+		withUser:                _q.withUser.Clone(),
+		withFolder:              _q.withFolder.Clone(),
+		withAttachments:         _q.withAttachments.Clone(),
+		withWhiteboards:         _q.withWhiteboards.Clone(),
+		withOutgoingLinks:       _q.withOutgoingLinks.Clone(),
+		withBacklinks:           _q.withBacklinks.Clone(),
+		withConnectionMaps:      _q.withConnectionMaps.Clone(),
+		withConnectionJobs:      _q.withConnectionJobs.Clone(),
+		withConnectionConflicts: _q.withConnectionConflicts.Clone(),
+		withTags:                _q.withTags.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -571,6 +596,17 @@ func (_q *NoteQuery) WithConnectionJobs(opts ...func(*NoteConnectionJobQuery)) *
 	return _q
 }
 
+// WithConnectionConflicts tells the query-builder to eager-load the nodes that are connected to
+// the "connection_conflicts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *NoteQuery) WithConnectionConflicts(opts ...func(*NoteConnectionConflictQuery)) *NoteQuery {
+	query := (&NoteConnectionConflictClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withConnectionConflicts = query
+	return _q
+}
+
 // WithTags tells the query-builder to eager-load the nodes that are connected to
 // the "tags" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *NoteQuery) WithTags(opts ...func(*TagQuery)) *NoteQuery {
@@ -661,7 +697,7 @@ func (_q *NoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Note, e
 		nodes       = []*Note{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [9]bool{
+		loadedTypes = [10]bool{
 			_q.withUser != nil,
 			_q.withFolder != nil,
 			_q.withAttachments != nil,
@@ -670,6 +706,7 @@ func (_q *NoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Note, e
 			_q.withBacklinks != nil,
 			_q.withConnectionMaps != nil,
 			_q.withConnectionJobs != nil,
+			_q.withConnectionConflicts != nil,
 			_q.withTags != nil,
 		}
 	)
@@ -751,6 +788,15 @@ func (_q *NoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Note, e
 			return nil, err
 		}
 	}
+	if query := _q.withConnectionConflicts; query != nil {
+		if err := _q.loadConnectionConflicts(ctx, query, nodes,
+			func(n *Note) { n.Edges.ConnectionConflicts = []*NoteConnectionConflict{} },
+			func(n *Note, e *NoteConnectionConflict) {
+				n.Edges.ConnectionConflicts = append(n.Edges.ConnectionConflicts, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withTags; query != nil {
 		if err := _q.loadTags(ctx, query, nodes,
 			func(n *Note) { n.Edges.Tags = []*Tag{} },
@@ -1013,6 +1059,36 @@ func (_q *NoteQuery) loadConnectionJobs(ctx context.Context, query *NoteConnecti
 	}
 	return nil
 }
+func (_q *NoteQuery) loadConnectionConflicts(ctx context.Context, query *NoteConnectionConflictQuery, nodes []*Note, init func(*Note), assign func(*Note, *NoteConnectionConflict)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Note)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(noteconnectionconflict.FieldNoteID)
+	}
+	query.Where(predicate.NoteConnectionConflict(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(note.ConnectionConflictsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.NoteID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "note_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *NoteQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Note, init func(*Note), assign func(*Note, *Tag)) error {
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[uuid.UUID]*Note)
@@ -9,6 +9,7 @@ import (
 	"smarticky/ent/attachment"
 	"smarticky/ent/folder"
 	"smarticky/ent/note"
+	"smarticky/ent/noteconnectionconflict"
 	"smarticky/ent/noteconnectionitemmap"
 	"smarticky/ent/noteconnectionjob"
 	"smarticky/ent/notelink"
@@ -20,6 +21,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
@@ -225,6 +227,24 @@ func (_u *NoteUpdate) ClearEncryptionNonce() *NoteUpdate {
 	return _u
 }
 
+// SetAliases sets the "aliases" field.
+func (_u *NoteUpdate) SetAliases(v []string) *NoteUpdate {
+	_u.mutation.SetAliases(v)
+	return _u
+}
+
+// AppendAliases appends value to the "aliases" field.
+func (_u *NoteUpdate) AppendAliases(v []string) *NoteUpdate {
+	_u.mutation.AppendAliases(v)
+	return _u
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (_u *NoteUpdate) ClearAliases() *NoteUpdate {
+	_u.mutation.ClearAliases()
+	return _u
+}
+
 // SetIsStarred sets the "is_starred" field.
 func (_u *NoteUpdate) SetIsStarred(v bool) *NoteUpdate {
 	_u.mutation.SetIsStarred(v)
@@ -401,6 +421,21 @@ func (_u *NoteUpdate) AddConnectionJobs(v ...*NoteConnectionJob) *NoteUpdate {
 	return _u.AddConnectionJobIDs(ids...)
 }
 
+// AddConnectionConflictIDs adds the "connection_conflicts" edge to the NoteConnectionConflict entity by IDs.
+func (_u *NoteUpdate) AddConnectionConflictIDs(ids ...int) *NoteUpdate {
+	_u.mutation.AddConnectionConflictIDs(ids...)
+	return _u
+}
+
+// AddConnectionConflicts adds the "connection_conflicts" edges to the NoteConnectionConflict entity.
+func (_u *NoteUpdate) AddConnectionConflicts(v ...*NoteConnectionConflict) *NoteUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddConnectionConflictIDs(ids...)
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (_u *NoteUpdate) AddTagIDs(ids ...uuid.UUID) *NoteUpdate {
 	_u.mutation.AddTagIDs(ids...)
@@ -559,6 +594,27 @@ func (_u *NoteUpdate) RemoveConnectionJobs(v ...*NoteConnectionJob) *NoteUpdate
 	return _u.RemoveConnectionJobIDs(ids...)
 }
 
+// ClearConnectionConflicts clears all "connection_conflicts" edges to the NoteConnectionConflict entity.
+func (_u *NoteUpdate) ClearConnectionConflicts() *NoteUpdate {
+	_u.mutation.ClearConnectionConflicts()
+	return _u
+}
+
+// RemoveConnectionConflictIDs removes the "connection_conflicts" edge to NoteConnectionConflict entities by IDs.
+func (_u *NoteUpdate) RemoveConnectionConflictIDs(ids ...int) *NoteUpdate {
+	_u.mutation.RemoveConnectionConflictIDs(ids...)
+	return _u
+}
+
+// RemoveConnectionConflicts removes "connection_conflicts" edges to NoteConnectionConflict entities.
+func (_u *NoteUpdate) RemoveConnectionConflicts(v ...*NoteConnectionConflict) *NoteUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveConnectionConflictIDs(ids...)
+}
+
 // ClearTags clears all "tags" edges to the Tag entity.
 func (_u *NoteUpdate) ClearTags() *NoteUpdate {
 	_u.mutation.ClearTags()
@@ -692,6 +748,17 @@ func (_u *NoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.EncryptionNonceCleared() {
 		_spec.ClearField(note.FieldEncryptionNonce, field.TypeString)
 	}
+	if value, ok := _u.mutation.Aliases(); ok {
+		_spec.SetField(note.FieldAliases, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAliases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, note.FieldAliases, value)
+		})
+	}
+	if _u.mutation.AliasesCleared() {
+		_spec.ClearField(note.FieldAliases, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.IsStarred(); ok {
 		_spec.SetField(note.FieldIsStarred, field.TypeBool, value)
 	}
@@ -1032,6 +1099,51 @@ func (_u *NoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ConnectionConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ConnectionConflictsTable,
+			Columns: []string{note.ConnectionConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedConnectionConflictsIDs(); len(nodes) > 0 && !_u.mutation.ConnectionConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ConnectionConflictsTable,
+			Columns: []string{note.ConnectionConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConnectionConflictsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ConnectionConflictsTable,
+			Columns: []string{note.ConnectionConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1285,6 +1397,24 @@ func (_u *NoteUpdateOne) ClearEncryptionNonce() *NoteUpdateOne {
 	return _u
 }
 
+// SetAliases sets the "aliases" field.
+func (_u *NoteUpdateOne) SetAliases(v []string) *NoteUpdateOne {
+	_u.mutation.SetAliases(v)
+	return _u
+}
+
+// AppendAliases appends value to the "aliases" field.
+func (_u *NoteUpdateOne) AppendAliases(v []string) *NoteUpdateOne {
+	_u.mutation.AppendAliases(v)
+	return _u
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (_u *NoteUpdateOne) ClearAliases() *NoteUpdateOne {
+	_u.mutation.ClearAliases()
+	return _u
+}
+
 // SetIsStarred sets the "is_starred" field.
 func (_u *NoteUpdateOne) SetIsStarred(v bool) *NoteUpdateOne {
 	_u.mutation.SetIsStarred(v)
@@ -1461,6 +1591,21 @@ func (_u *NoteUpdateOne) AddConnectionJobs(v ...*NoteConnectionJob) *NoteUpdateO
 	return _u.AddConnectionJobIDs(ids...)
 }
 
+// AddConnectionConflictIDs adds the "connection_conflicts" edge to the NoteConnectionConflict entity by IDs.
+func (_u *NoteUpdateOne) AddConnectionConflictIDs(ids ...int) *NoteUpdateOne {
+	_u.mutation.AddConnectionConflictIDs(ids...)
+	return _u
+}
+
+// AddConnectionConflicts adds the "connection_conflicts" edges to the NoteConnectionConflict entity.
+func (_u *NoteUpdateOne) AddConnectionConflicts(v ...*NoteConnectionConflict) *NoteUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddConnectionConflictIDs(ids...)
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (_u *NoteUpdateOne) AddTagIDs(ids ...uuid.UUID) *NoteUpdateOne {
 	_u.mutation.AddTagIDs(ids...)
@@ -1619,6 +1764,27 @@ func (_u *NoteUpdateOne) RemoveConnectionJobs(v ...*NoteConnectionJob) *NoteUpda
 	return _u.RemoveConnectionJobIDs(ids...)
 }
 
+// ClearConnectionConflicts clears all "connection_conflicts" edges to the NoteConnectionConflict entity.
+func (_u *NoteUpdateOne) ClearConnectionConflicts() *NoteUpdateOne {
+	_u.mutation.ClearConnectionConflicts()
+	return _u
+}
+
+// RemoveConnectionConflictIDs removes the "connection_conflicts" edge to NoteConnectionConflict entities by IDs.
+func (_u *NoteUpdateOne) RemoveConnectionConflictIDs(ids ...int) *NoteUpdateOne {
+	_u.mutation.RemoveConnectionConflictIDs(ids...)
+	return _u
+}
+
+// RemoveConnectionConflicts removes "connection_conflicts" edges to NoteConnectionConflict entities.
+func (_u *NoteUpdateOne) RemoveConnectionConflicts(v ...*NoteConnectionConflict) *NoteUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveConnectionConflictIDs(ids...)
+}
+
 // ClearTags clears all "tags" edges to the Tag entity.
 func (_u *NoteUpdateOne) ClearTags() *NoteUpdateOne {
 	_u.mutation.ClearTags()
@@ -1782,6 +1948,17 @@ func (_u *NoteUpdateOne) sqlSave(ctx context.Context) (_node *Note, err error) {
 	if _u.mutation.EncryptionNonceCleared() {
 		_spec.ClearField(note.FieldEncryptionNonce, field.TypeString)
 	}
+	if value, ok := _u.mutation.Aliases(); ok {
+		_spec.SetField(note.FieldAliases, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAliases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, note.FieldAliases, value)
+		})
+	}
+	if _u.mutation.AliasesCleared() {
+		_spec.ClearField(note.FieldAliases, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.IsStarred(); ok {
 		_spec.SetField(note.FieldIsStarred, field.TypeBool, value)
 	}
@@ -2122,6 +2299,51 @@ func (_u *NoteUpdateOne) sqlSave(ctx context.Context) (_node *Note, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ConnectionConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ConnectionConflictsTable,
+			Columns: []string{note.ConnectionConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedConnectionConflictsIDs(); len(nodes) > 0 && !_u.mutation.ConnectionConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ConnectionConflictsTable,
+			Columns: []string{note.ConnectionConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConnectionConflictsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ConnectionConflictsTable,
+			Columns: []string{note.ConnectionConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -38,6 +38,10 @@ type NoteConnectionAccount struct {
 	DefaultTargetID string `json:"default_target_id,omitempty"`
 	// DefaultTargetName holds the value of the "default_target_name" field.
 	DefaultTargetName string `json:"default_target_name,omitempty"`
+	// Scheduled two-way sync interval; 0 disables scheduled sync
+	SyncIntervalMinutes int `json:"sync_interval_minutes,omitempty"`
+	// Secret inbound change webhooks present or sign with; empty disables the webhook
+	EncryptedWebhookSecret string `json:"-"`
 	// MetadataJSON holds the value of the "metadata_json" field.
 	MetadataJSON string `json:"metadata_json,omitempty"`
 	// never, success, failed
@@ -64,9 +68,11 @@ type NoteConnectionAccountEdges struct {
 	ItemMaps []*NoteConnectionItemMap `json:"item_maps,omitempty"`
 	// Jobs holds the value of the jobs edge.
 	Jobs []*NoteConnectionJob `json:"jobs,omitempty"`
+	// Conflicts holds the value of the conflicts edge.
+	Conflicts []*NoteConnectionConflict `json:"conflicts,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -98,6 +104,15 @@ func (e NoteConnectionAccountEdges) JobsOrErr() ([]*NoteConnectionJob, error) {
 	return nil, &NotLoadedError{edge: "jobs"}
 }
 
+// ConflictsOrErr returns the Conflicts value or an error if the edge
+// was not loaded in eager-loading.
+func (e NoteConnectionAccountEdges) ConflictsOrErr() ([]*NoteConnectionConflict, error) {
+	if e.loadedTypes[3] {
+		return e.Conflicts, nil
+	}
+	return nil, &NotLoadedError{edge: "conflicts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*NoteConnectionAccount) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -105,9 +120,9 @@ func (*NoteConnectionAccount) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case noteconnectionaccount.FieldEnabled:
 			values[i] = new(sql.NullBool)
-		case noteconnectionaccount.FieldID, noteconnectionaccount.FieldUserID:
+		case noteconnectionaccount.FieldID, noteconnectionaccount.FieldUserID, noteconnectionaccount.FieldSyncIntervalMinutes:
 			values[i] = new(sql.NullInt64)
-		case noteconnectionaccount.FieldName, noteconnectionaccount.FieldProvider, noteconnectionaccount.FieldEndpoint, noteconnectionaccount.FieldAuthType, noteconnectionaccount.FieldEncryptedCredentials, noteconnectionaccount.FieldCredentialAlg, noteconnectionaccount.FieldDefaultTargetID, noteconnectionaccount.FieldDefaultTargetName, noteconnectionaccount.FieldMetadataJSON, noteconnectionaccount.FieldLastTestStatus, noteconnectionaccount.FieldLastTestError:
+		case noteconnectionaccount.FieldName, noteconnectionaccount.FieldProvider, noteconnectionaccount.FieldEndpoint, noteconnectionaccount.FieldAuthType, noteconnectionaccount.FieldEncryptedCredentials, noteconnectionaccount.FieldCredentialAlg, noteconnectionaccount.FieldDefaultTargetID, noteconnectionaccount.FieldDefaultTargetName, noteconnectionaccount.FieldEncryptedWebhookSecret, noteconnectionaccount.FieldMetadataJSON, noteconnectionaccount.FieldLastTestStatus, noteconnectionaccount.FieldLastTestError:
 			values[i] = new(sql.NullString)
 		case noteconnectionaccount.FieldLastTestAt, noteconnectionaccount.FieldCreatedAt, noteconnectionaccount.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -192,6 +207,18 @@ func (_m *NoteConnectionAccount) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				_m.DefaultTargetName = value.String
 			}
+		case noteconnectionaccount.FieldSyncIntervalMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_interval_minutes", values[i])
+			} else if value.Valid {
+				_m.SyncIntervalMinutes = int(value.Int64)
+			}
+		case noteconnectionaccount.FieldEncryptedWebhookSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field encrypted_webhook_secret", values[i])
+			} else if value.Valid {
+				_m.EncryptedWebhookSecret = value.String
+			}
 		case noteconnectionaccount.FieldMetadataJSON:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata_json", values[i])
@@ -256,6 +283,11 @@ func (_m *NoteConnectionAccount) QueryJobs() *NoteConnectionJobQuery {
 	return NewNoteConnectionAccountClient(_m.config).QueryJobs(_m)
 }
 
+// QueryConflicts queries the "conflicts" edge of the NoteConnectionAccount entity.
+func (_m *NoteConnectionAccount) QueryConflicts() *NoteConnectionConflictQuery {
+	return NewNoteConnectionAccountClient(_m.config).QueryConflicts(_m)
+}
+
 // Update returns a builder for updating this NoteConnectionAccount.
 // Note that you need to call NoteConnectionAccount.Unwrap() before calling this method if this NoteConnectionAccount
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -308,6 +340,11 @@ func (_m *NoteConnectionAccount) String() string {
 	builder.WriteString("default_target_name=")
 	builder.WriteString(_m.DefaultTargetName)
 	builder.WriteString(", ")
+	builder.WriteString("sync_interval_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SyncIntervalMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("encrypted_webhook_secret=<sensitive>")
+	builder.WriteString(", ")
 	builder.WriteString("metadata_json=")
 	builder.WriteString(_m.MetadataJSON)
 	builder.WriteString(", ")
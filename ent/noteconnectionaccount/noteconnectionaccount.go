@@ -34,6 +34,10 @@ const (
 	FieldDefaultTargetID = "default_target_id"
 	// FieldDefaultTargetName holds the string denoting the default_target_name field in the database.
 	FieldDefaultTargetName = "default_target_name"
+	// FieldSyncIntervalMinutes holds the string denoting the sync_interval_minutes field in the database.
+	FieldSyncIntervalMinutes = "sync_interval_minutes"
+	// FieldEncryptedWebhookSecret holds the string denoting the encrypted_webhook_secret field in the database.
+	FieldEncryptedWebhookSecret = "encrypted_webhook_secret"
 	// FieldMetadataJSON holds the string denoting the metadata_json field in the database.
 	FieldMetadataJSON = "metadata_json"
 	// FieldLastTestStatus holds the string denoting the last_test_status field in the database.
@@ -52,6 +56,8 @@ const (
 	EdgeItemMaps = "item_maps"
 	// EdgeJobs holds the string denoting the jobs edge name in mutations.
 	EdgeJobs = "jobs"
+	// EdgeConflicts holds the string denoting the conflicts edge name in mutations.
+	EdgeConflicts = "conflicts"
 	// Table holds the table name of the noteconnectionaccount in the database.
 	Table = "note_connection_accounts"
 	// UserTable is the table that holds the user relation/edge.
@@ -75,6 +81,13 @@ const (
 	JobsInverseTable = "note_connection_jobs"
 	// JobsColumn is the table column denoting the jobs relation/edge.
 	JobsColumn = "account_id"
+	// ConflictsTable is the table that holds the conflicts relation/edge.
+	ConflictsTable = "note_connection_conflicts"
+	// ConflictsInverseTable is the table name for the NoteConnectionConflict entity.
+	// It exists in this package in order to avoid circular dependency with the "noteconnectionconflict" package.
+	ConflictsInverseTable = "note_connection_conflicts"
+	// ConflictsColumn is the table column denoting the conflicts relation/edge.
+	ConflictsColumn = "account_id"
 )
 
 // Columns holds all SQL columns for noteconnectionaccount fields.
@@ -90,6 +103,8 @@ var Columns = []string{
 	FieldCredentialAlg,
 	FieldDefaultTargetID,
 	FieldDefaultTargetName,
+	FieldSyncIntervalMinutes,
+	FieldEncryptedWebhookSecret,
 	FieldMetadataJSON,
 	FieldLastTestStatus,
 	FieldLastTestError,
@@ -117,6 +132,8 @@ var (
 	DefaultEnabled bool
 	// DefaultAuthType holds the default value on creation for the "auth_type" field.
 	DefaultAuthType string
+	// DefaultSyncIntervalMinutes holds the default value on creation for the "sync_interval_minutes" field.
+	DefaultSyncIntervalMinutes int
 	// DefaultLastTestStatus holds the default value on creation for the "last_test_status" field.
 	DefaultLastTestStatus string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -185,6 +202,16 @@ func ByDefaultTargetName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDefaultTargetName, opts...).ToFunc()
 }
 
+// BySyncIntervalMinutes orders the results by the sync_interval_minutes field.
+func BySyncIntervalMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncIntervalMinutes, opts...).ToFunc()
+}
+
+// ByEncryptedWebhookSecret orders the results by the encrypted_webhook_secret field.
+func ByEncryptedWebhookSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEncryptedWebhookSecret, opts...).ToFunc()
+}
+
 // ByMetadataJSON orders the results by the metadata_json field.
 func ByMetadataJSON(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMetadataJSON, opts...).ToFunc()
@@ -249,6 +276,20 @@ func ByJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByConflictsCount orders the results by conflicts count.
+func ByConflictsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newConflictsStep(), opts...)
+	}
+}
+
+// ByConflicts orders the results by conflicts terms.
+func ByConflicts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConflictsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -270,3 +311,10 @@ func newJobsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, JobsTable, JobsColumn),
 	)
 }
+func newConflictsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConflictsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ConflictsTable, ConflictsColumn),
+	)
+}
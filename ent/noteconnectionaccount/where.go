@@ -105,6 +105,16 @@ func DefaultTargetName(v string) predicate.NoteConnectionAccount {
 	return predicate.NoteConnectionAccount(sql.FieldEQ(FieldDefaultTargetName, v))
 }
 
+// SyncIntervalMinutes applies equality check predicate on the "sync_interval_minutes" field. It's identical to SyncIntervalMinutesEQ.
+func SyncIntervalMinutes(v int) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldEQ(FieldSyncIntervalMinutes, v))
+}
+
+// EncryptedWebhookSecret applies equality check predicate on the "encrypted_webhook_secret" field. It's identical to EncryptedWebhookSecretEQ.
+func EncryptedWebhookSecret(v string) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldEQ(FieldEncryptedWebhookSecret, v))
+}
+
 // MetadataJSON applies equality check predicate on the "metadata_json" field. It's identical to MetadataJSONEQ.
 func MetadataJSON(v string) predicate.NoteConnectionAccount {
 	return predicate.NoteConnectionAccount(sql.FieldEQ(FieldMetadataJSON, v))
@@ -735,6 +745,121 @@ func DefaultTargetNameContainsFold(v string) predicate.NoteConnectionAccount {
 	return predicate.NoteConnectionAccount(sql.FieldContainsFold(FieldDefaultTargetName, v))
 }
 
+// SyncIntervalMinutesEQ applies the EQ predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesEQ(v int) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldEQ(FieldSyncIntervalMinutes, v))
+}
+
+// SyncIntervalMinutesNEQ applies the NEQ predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesNEQ(v int) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldNEQ(FieldSyncIntervalMinutes, v))
+}
+
+// SyncIntervalMinutesIn applies the In predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesIn(vs ...int) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldIn(FieldSyncIntervalMinutes, vs...))
+}
+
+// SyncIntervalMinutesNotIn applies the NotIn predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesNotIn(vs ...int) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldNotIn(FieldSyncIntervalMinutes, vs...))
+}
+
+// SyncIntervalMinutesGT applies the GT predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesGT(v int) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldGT(FieldSyncIntervalMinutes, v))
+}
+
+// SyncIntervalMinutesGTE applies the GTE predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesGTE(v int) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldGTE(FieldSyncIntervalMinutes, v))
+}
+
+// SyncIntervalMinutesLT applies the LT predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesLT(v int) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldLT(FieldSyncIntervalMinutes, v))
+}
+
+// SyncIntervalMinutesLTE applies the LTE predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesLTE(v int) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldLTE(FieldSyncIntervalMinutes, v))
+}
+
+// EncryptedWebhookSecretEQ applies the EQ predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretEQ(v string) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldEQ(FieldEncryptedWebhookSecret, v))
+}
+
+// EncryptedWebhookSecretNEQ applies the NEQ predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretNEQ(v string) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldNEQ(FieldEncryptedWebhookSecret, v))
+}
+
+// EncryptedWebhookSecretIn applies the In predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretIn(vs ...string) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldIn(FieldEncryptedWebhookSecret, vs...))
+}
+
+// EncryptedWebhookSecretNotIn applies the NotIn predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretNotIn(vs ...string) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldNotIn(FieldEncryptedWebhookSecret, vs...))
+}
+
+// EncryptedWebhookSecretGT applies the GT predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretGT(v string) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldGT(FieldEncryptedWebhookSecret, v))
+}
+
+// EncryptedWebhookSecretGTE applies the GTE predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretGTE(v string) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldGTE(FieldEncryptedWebhookSecret, v))
+}
+
+// EncryptedWebhookSecretLT applies the LT predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretLT(v string) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldLT(FieldEncryptedWebhookSecret, v))
+}
+
+// EncryptedWebhookSecretLTE applies the LTE predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretLTE(v string) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldLTE(FieldEncryptedWebhookSecret, v))
+}
+
+// EncryptedWebhookSecretContains applies the Contains predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretContains(v string) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldContains(FieldEncryptedWebhookSecret, v))
+}
+
+// EncryptedWebhookSecretHasPrefix applies the HasPrefix predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretHasPrefix(v string) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldHasPrefix(FieldEncryptedWebhookSecret, v))
+}
+
+// EncryptedWebhookSecretHasSuffix applies the HasSuffix predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretHasSuffix(v string) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldHasSuffix(FieldEncryptedWebhookSecret, v))
+}
+
+// EncryptedWebhookSecretIsNil applies the IsNil predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretIsNil() predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldIsNull(FieldEncryptedWebhookSecret))
+}
+
+// EncryptedWebhookSecretNotNil applies the NotNil predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretNotNil() predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldNotNull(FieldEncryptedWebhookSecret))
+}
+
+// EncryptedWebhookSecretEqualFold applies the EqualFold predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretEqualFold(v string) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldEqualFold(FieldEncryptedWebhookSecret, v))
+}
+
+// EncryptedWebhookSecretContainsFold applies the ContainsFold predicate on the "encrypted_webhook_secret" field.
+func EncryptedWebhookSecretContainsFold(v string) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(sql.FieldContainsFold(FieldEncryptedWebhookSecret, v))
+}
+
 // MetadataJSONEQ applies the EQ predicate on the "metadata_json" field.
 func MetadataJSONEQ(v string) predicate.NoteConnectionAccount {
 	return predicate.NoteConnectionAccount(sql.FieldEQ(FieldMetadataJSON, v))
@@ -1149,6 +1274,29 @@ func HasJobsWith(preds ...predicate.NoteConnectionJob) predicate.NoteConnectionA
 	})
 }
 
+// HasConflicts applies the HasEdge predicate on the "conflicts" edge.
+func HasConflicts() predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ConflictsTable, ConflictsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConflictsWith applies the HasEdge predicate on the "conflicts" edge with a given conditions (other predicates).
+func HasConflictsWith(preds ...predicate.NoteConnectionConflict) predicate.NoteConnectionAccount {
+	return predicate.NoteConnectionAccount(func(s *sql.Selector) {
+		step := newConflictsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.NoteConnectionAccount) predicate.NoteConnectionAccount {
 	return predicate.NoteConnectionAccount(sql.AndPredicates(predicates...))
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"smarticky/ent/noteconnectionaccount"
+	"smarticky/ent/noteconnectionconflict"
 	"smarticky/ent/noteconnectionitemmap"
 	"smarticky/ent/noteconnectionjob"
 	"smarticky/ent/user"
@@ -139,6 +140,34 @@ func (_c *NoteConnectionAccountCreate) SetNillableDefaultTargetName(v *string) *
 	return _c
 }
 
+// SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
+func (_c *NoteConnectionAccountCreate) SetSyncIntervalMinutes(v int) *NoteConnectionAccountCreate {
+	_c.mutation.SetSyncIntervalMinutes(v)
+	return _c
+}
+
+// SetNillableSyncIntervalMinutes sets the "sync_interval_minutes" field if the given value is not nil.
+func (_c *NoteConnectionAccountCreate) SetNillableSyncIntervalMinutes(v *int) *NoteConnectionAccountCreate {
+	if v != nil {
+		_c.SetSyncIntervalMinutes(*v)
+	}
+	return _c
+}
+
+// SetEncryptedWebhookSecret sets the "encrypted_webhook_secret" field.
+func (_c *NoteConnectionAccountCreate) SetEncryptedWebhookSecret(v string) *NoteConnectionAccountCreate {
+	_c.mutation.SetEncryptedWebhookSecret(v)
+	return _c
+}
+
+// SetNillableEncryptedWebhookSecret sets the "encrypted_webhook_secret" field if the given value is not nil.
+func (_c *NoteConnectionAccountCreate) SetNillableEncryptedWebhookSecret(v *string) *NoteConnectionAccountCreate {
+	if v != nil {
+		_c.SetEncryptedWebhookSecret(*v)
+	}
+	return _c
+}
+
 // SetMetadataJSON sets the "metadata_json" field.
 func (_c *NoteConnectionAccountCreate) SetMetadataJSON(v string) *NoteConnectionAccountCreate {
 	_c.mutation.SetMetadataJSON(v)
@@ -258,6 +287,21 @@ func (_c *NoteConnectionAccountCreate) AddJobs(v ...*NoteConnectionJob) *NoteCon
 	return _c.AddJobIDs(ids...)
 }
 
+// AddConflictIDs adds the "conflicts" edge to the NoteConnectionConflict entity by IDs.
+func (_c *NoteConnectionAccountCreate) AddConflictIDs(ids ...int) *NoteConnectionAccountCreate {
+	_c.mutation.AddConflictIDs(ids...)
+	return _c
+}
+
+// AddConflicts adds the "conflicts" edges to the NoteConnectionConflict entity.
+func (_c *NoteConnectionAccountCreate) AddConflicts(v ...*NoteConnectionConflict) *NoteConnectionAccountCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddConflictIDs(ids...)
+}
+
 // Mutation returns the NoteConnectionAccountMutation object of the builder.
 func (_c *NoteConnectionAccountCreate) Mutation() *NoteConnectionAccountMutation {
 	return _c.mutation
@@ -301,6 +345,10 @@ func (_c *NoteConnectionAccountCreate) defaults() {
 		v := noteconnectionaccount.DefaultAuthType
 		_c.mutation.SetAuthType(v)
 	}
+	if _, ok := _c.mutation.SyncIntervalMinutes(); !ok {
+		v := noteconnectionaccount.DefaultSyncIntervalMinutes
+		_c.mutation.SetSyncIntervalMinutes(v)
+	}
 	if _, ok := _c.mutation.LastTestStatus(); !ok {
 		v := noteconnectionaccount.DefaultLastTestStatus
 		_c.mutation.SetLastTestStatus(v)
@@ -342,6 +390,9 @@ func (_c *NoteConnectionAccountCreate) check() error {
 	if _, ok := _c.mutation.AuthType(); !ok {
 		return &ValidationError{Name: "auth_type", err: errors.New(`ent: missing required field "NoteConnectionAccount.auth_type"`)}
 	}
+	if _, ok := _c.mutation.SyncIntervalMinutes(); !ok {
+		return &ValidationError{Name: "sync_interval_minutes", err: errors.New(`ent: missing required field "NoteConnectionAccount.sync_interval_minutes"`)}
+	}
 	if _, ok := _c.mutation.LastTestStatus(); !ok {
 		return &ValidationError{Name: "last_test_status", err: errors.New(`ent: missing required field "NoteConnectionAccount.last_test_status"`)}
 	}
@@ -416,6 +467,14 @@ func (_c *NoteConnectionAccountCreate) createSpec() (*NoteConnectionAccount, *sq
 		_spec.SetField(noteconnectionaccount.FieldDefaultTargetName, field.TypeString, value)
 		_node.DefaultTargetName = value
 	}
+	if value, ok := _c.mutation.SyncIntervalMinutes(); ok {
+		_spec.SetField(noteconnectionaccount.FieldSyncIntervalMinutes, field.TypeInt, value)
+		_node.SyncIntervalMinutes = value
+	}
+	if value, ok := _c.mutation.EncryptedWebhookSecret(); ok {
+		_spec.SetField(noteconnectionaccount.FieldEncryptedWebhookSecret, field.TypeString, value)
+		_node.EncryptedWebhookSecret = value
+	}
 	if value, ok := _c.mutation.MetadataJSON(); ok {
 		_spec.SetField(noteconnectionaccount.FieldMetadataJSON, field.TypeString, value)
 		_node.MetadataJSON = value
@@ -489,6 +548,22 @@ func (_c *NoteConnectionAccountCreate) createSpec() (*NoteConnectionAccount, *sq
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ConflictsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   noteconnectionaccount.ConflictsTable,
+			Columns: []string{noteconnectionaccount.ConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 	"smarticky/ent/noteconnectionaccount"
+	"smarticky/ent/noteconnectionconflict"
 	"smarticky/ent/noteconnectionitemmap"
 	"smarticky/ent/noteconnectionjob"
 	"smarticky/ent/predicate"
@@ -22,13 +23,14 @@ import (
 // NoteConnectionAccountQuery is the builder for querying NoteConnectionAccount entities.
 type NoteConnectionAccountQuery struct {
 	config
-	ctx          *QueryContext
-	order        []noteconnectionaccount.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.NoteConnectionAccount
-	withUser     *UserQuery
-	withItemMaps *NoteConnectionItemMapQuery
-	withJobs     *NoteConnectionJobQuery
+	ctx           *QueryContext
+	order         []noteconnectionaccount.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.NoteConnectionAccount
+	withUser      *UserQuery
+	withItemMaps  *NoteConnectionItemMapQuery
+	withJobs      *NoteConnectionJobQuery
+	withConflicts *NoteConnectionConflictQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +133,28 @@ func (_q *NoteConnectionAccountQuery) QueryJobs() *NoteConnectionJobQuery {
 	return query
 }
 
+// QueryConflicts chains the current query on the "conflicts" edge.
+func (_q *NoteConnectionAccountQuery) QueryConflicts() *NoteConnectionConflictQuery {
+	query := (&NoteConnectionConflictClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(noteconnectionaccount.Table, noteconnectionaccount.FieldID, selector),
+			sqlgraph.To(noteconnectionconflict.Table, noteconnectionconflict.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, noteconnectionaccount.ConflictsTable, noteconnectionaccount.ConflictsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first NoteConnectionAccount entity from the query.
 // Returns a *NotFoundError when no NoteConnectionAccount was found.
 func (_q *NoteConnectionAccountQuery) First(ctx context.Context) (*NoteConnectionAccount, error) {
@@ -318,14 +342,15 @@ func (_q *NoteConnectionAccountQuery) Clone() *NoteConnectionAccountQuery {
 		return nil
 	}
 	return &NoteConnectionAccountQuery{
-		config:       _q.config,
-		ctx:          _q.ctx.Clone(),
-		order:        append([]noteconnectionaccount.OrderOption{}, _q.order...),
-		inters:       append([]Interceptor{}, _q.inters...),
-		predicates:   append([]predicate.NoteConnectionAccount{}, _q.predicates...),
-		withUser:     _q.withUser.Clone(),
-		withItemMaps: _q.withItemMaps.Clone(),
-		withJobs:     _q.withJobs.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]noteconnectionaccount.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.NoteConnectionAccount{}, _q.predicates...),
+		withUser:      _q.withUser.Clone(),
+		withItemMaps:  _q.withItemMaps.Clone(),
+		withJobs:      _q.withJobs.Clone(),
+		withConflicts: _q.withConflicts.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +390,17 @@ func (_q *NoteConnectionAccountQuery) WithJobs(opts ...func(*NoteConnectionJobQu
 	return _q
 }
 
+// WithConflicts tells the query-builder to eager-load the nodes that are connected to
+// the "conflicts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *NoteConnectionAccountQuery) WithConflicts(opts ...func(*NoteConnectionConflictQuery)) *NoteConnectionAccountQuery {
+	query := (&NoteConnectionConflictClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withConflicts = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +479,11 @@ func (_q *NoteConnectionAccountQuery) sqlAll(ctx context.Context, hooks ...query
 	var (
 		nodes       = []*NoteConnectionAccount{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			_q.withUser != nil,
 			_q.withItemMaps != nil,
 			_q.withJobs != nil,
+			_q.withConflicts != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -489,6 +526,15 @@ func (_q *NoteConnectionAccountQuery) sqlAll(ctx context.Context, hooks ...query
 			return nil, err
 		}
 	}
+	if query := _q.withConflicts; query != nil {
+		if err := _q.loadConflicts(ctx, query, nodes,
+			func(n *NoteConnectionAccount) { n.Edges.Conflicts = []*NoteConnectionConflict{} },
+			func(n *NoteConnectionAccount, e *NoteConnectionConflict) {
+				n.Edges.Conflicts = append(n.Edges.Conflicts, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -581,6 +627,36 @@ func (_q *NoteConnectionAccountQuery) loadJobs(ctx context.Context, query *NoteC
 	}
 	return nil
 }
+func (_q *NoteConnectionAccountQuery) loadConflicts(ctx context.Context, query *NoteConnectionConflictQuery, nodes []*NoteConnectionAccount, init func(*NoteConnectionAccount), assign func(*NoteConnectionAccount, *NoteConnectionConflict)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*NoteConnectionAccount)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(noteconnectionconflict.FieldAccountID)
+	}
+	query.Where(predicate.NoteConnectionConflict(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(noteconnectionaccount.ConflictsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *NoteConnectionAccountQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
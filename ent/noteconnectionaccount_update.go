@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"smarticky/ent/noteconnectionaccount"
+	"smarticky/ent/noteconnectionconflict"
 	"smarticky/ent/noteconnectionitemmap"
 	"smarticky/ent/noteconnectionjob"
 	"smarticky/ent/predicate"
@@ -201,6 +202,47 @@ func (_u *NoteConnectionAccountUpdate) ClearDefaultTargetName() *NoteConnectionA
 	return _u
 }
 
+// SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
+func (_u *NoteConnectionAccountUpdate) SetSyncIntervalMinutes(v int) *NoteConnectionAccountUpdate {
+	_u.mutation.ResetSyncIntervalMinutes()
+	_u.mutation.SetSyncIntervalMinutes(v)
+	return _u
+}
+
+// SetNillableSyncIntervalMinutes sets the "sync_interval_minutes" field if the given value is not nil.
+func (_u *NoteConnectionAccountUpdate) SetNillableSyncIntervalMinutes(v *int) *NoteConnectionAccountUpdate {
+	if v != nil {
+		_u.SetSyncIntervalMinutes(*v)
+	}
+	return _u
+}
+
+// AddSyncIntervalMinutes adds value to the "sync_interval_minutes" field.
+func (_u *NoteConnectionAccountUpdate) AddSyncIntervalMinutes(v int) *NoteConnectionAccountUpdate {
+	_u.mutation.AddSyncIntervalMinutes(v)
+	return _u
+}
+
+// SetEncryptedWebhookSecret sets the "encrypted_webhook_secret" field.
+func (_u *NoteConnectionAccountUpdate) SetEncryptedWebhookSecret(v string) *NoteConnectionAccountUpdate {
+	_u.mutation.SetEncryptedWebhookSecret(v)
+	return _u
+}
+
+// SetNillableEncryptedWebhookSecret sets the "encrypted_webhook_secret" field if the given value is not nil.
+func (_u *NoteConnectionAccountUpdate) SetNillableEncryptedWebhookSecret(v *string) *NoteConnectionAccountUpdate {
+	if v != nil {
+		_u.SetEncryptedWebhookSecret(*v)
+	}
+	return _u
+}
+
+// ClearEncryptedWebhookSecret clears the value of the "encrypted_webhook_secret" field.
+func (_u *NoteConnectionAccountUpdate) ClearEncryptedWebhookSecret() *NoteConnectionAccountUpdate {
+	_u.mutation.ClearEncryptedWebhookSecret()
+	return _u
+}
+
 // SetMetadataJSON sets the "metadata_json" field.
 func (_u *NoteConnectionAccountUpdate) SetMetadataJSON(v string) *NoteConnectionAccountUpdate {
 	_u.mutation.SetMetadataJSON(v)
@@ -316,6 +358,21 @@ func (_u *NoteConnectionAccountUpdate) AddJobs(v ...*NoteConnectionJob) *NoteCon
 	return _u.AddJobIDs(ids...)
 }
 
+// AddConflictIDs adds the "conflicts" edge to the NoteConnectionConflict entity by IDs.
+func (_u *NoteConnectionAccountUpdate) AddConflictIDs(ids ...int) *NoteConnectionAccountUpdate {
+	_u.mutation.AddConflictIDs(ids...)
+	return _u
+}
+
+// AddConflicts adds the "conflicts" edges to the NoteConnectionConflict entity.
+func (_u *NoteConnectionAccountUpdate) AddConflicts(v ...*NoteConnectionConflict) *NoteConnectionAccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddConflictIDs(ids...)
+}
+
 // Mutation returns the NoteConnectionAccountMutation object of the builder.
 func (_u *NoteConnectionAccountUpdate) Mutation() *NoteConnectionAccountMutation {
 	return _u.mutation
@@ -369,6 +426,27 @@ func (_u *NoteConnectionAccountUpdate) RemoveJobs(v ...*NoteConnectionJob) *Note
 	return _u.RemoveJobIDs(ids...)
 }
 
+// ClearConflicts clears all "conflicts" edges to the NoteConnectionConflict entity.
+func (_u *NoteConnectionAccountUpdate) ClearConflicts() *NoteConnectionAccountUpdate {
+	_u.mutation.ClearConflicts()
+	return _u
+}
+
+// RemoveConflictIDs removes the "conflicts" edge to NoteConnectionConflict entities by IDs.
+func (_u *NoteConnectionAccountUpdate) RemoveConflictIDs(ids ...int) *NoteConnectionAccountUpdate {
+	_u.mutation.RemoveConflictIDs(ids...)
+	return _u
+}
+
+// RemoveConflicts removes "conflicts" edges to NoteConnectionConflict entities.
+func (_u *NoteConnectionAccountUpdate) RemoveConflicts(v ...*NoteConnectionConflict) *NoteConnectionAccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveConflictIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *NoteConnectionAccountUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -477,6 +555,18 @@ func (_u *NoteConnectionAccountUpdate) sqlSave(ctx context.Context) (_node int,
 	if _u.mutation.DefaultTargetNameCleared() {
 		_spec.ClearField(noteconnectionaccount.FieldDefaultTargetName, field.TypeString)
 	}
+	if value, ok := _u.mutation.SyncIntervalMinutes(); ok {
+		_spec.SetField(noteconnectionaccount.FieldSyncIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSyncIntervalMinutes(); ok {
+		_spec.AddField(noteconnectionaccount.FieldSyncIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EncryptedWebhookSecret(); ok {
+		_spec.SetField(noteconnectionaccount.FieldEncryptedWebhookSecret, field.TypeString, value)
+	}
+	if _u.mutation.EncryptedWebhookSecretCleared() {
+		_spec.ClearField(noteconnectionaccount.FieldEncryptedWebhookSecret, field.TypeString)
+	}
 	if value, ok := _u.mutation.MetadataJSON(); ok {
 		_spec.SetField(noteconnectionaccount.FieldMetadataJSON, field.TypeString, value)
 	}
@@ -620,6 +710,51 @@ func (_u *NoteConnectionAccountUpdate) sqlSave(ctx context.Context) (_node int,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   noteconnectionaccount.ConflictsTable,
+			Columns: []string{noteconnectionaccount.ConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedConflictsIDs(); len(nodes) > 0 && !_u.mutation.ConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   noteconnectionaccount.ConflictsTable,
+			Columns: []string{noteconnectionaccount.ConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConflictsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   noteconnectionaccount.ConflictsTable,
+			Columns: []string{noteconnectionaccount.ConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{noteconnectionaccount.Label}
@@ -810,6 +945,47 @@ func (_u *NoteConnectionAccountUpdateOne) ClearDefaultTargetName() *NoteConnecti
 	return _u
 }
 
+// SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
+func (_u *NoteConnectionAccountUpdateOne) SetSyncIntervalMinutes(v int) *NoteConnectionAccountUpdateOne {
+	_u.mutation.ResetSyncIntervalMinutes()
+	_u.mutation.SetSyncIntervalMinutes(v)
+	return _u
+}
+
+// SetNillableSyncIntervalMinutes sets the "sync_interval_minutes" field if the given value is not nil.
+func (_u *NoteConnectionAccountUpdateOne) SetNillableSyncIntervalMinutes(v *int) *NoteConnectionAccountUpdateOne {
+	if v != nil {
+		_u.SetSyncIntervalMinutes(*v)
+	}
+	return _u
+}
+
+// AddSyncIntervalMinutes adds value to the "sync_interval_minutes" field.
+func (_u *NoteConnectionAccountUpdateOne) AddSyncIntervalMinutes(v int) *NoteConnectionAccountUpdateOne {
+	_u.mutation.AddSyncIntervalMinutes(v)
+	return _u
+}
+
+// SetEncryptedWebhookSecret sets the "encrypted_webhook_secret" field.
+func (_u *NoteConnectionAccountUpdateOne) SetEncryptedWebhookSecret(v string) *NoteConnectionAccountUpdateOne {
+	_u.mutation.SetEncryptedWebhookSecret(v)
+	return _u
+}
+
+// SetNillableEncryptedWebhookSecret sets the "encrypted_webhook_secret" field if the given value is not nil.
+func (_u *NoteConnectionAccountUpdateOne) SetNillableEncryptedWebhookSecret(v *string) *NoteConnectionAccountUpdateOne {
+	if v != nil {
+		_u.SetEncryptedWebhookSecret(*v)
+	}
+	return _u
+}
+
+// ClearEncryptedWebhookSecret clears the value of the "encrypted_webhook_secret" field.
+func (_u *NoteConnectionAccountUpdateOne) ClearEncryptedWebhookSecret() *NoteConnectionAccountUpdateOne {
+	_u.mutation.ClearEncryptedWebhookSecret()
+	return _u
+}
+
 // SetMetadataJSON sets the "metadata_json" field.
 func (_u *NoteConnectionAccountUpdateOne) SetMetadataJSON(v string) *NoteConnectionAccountUpdateOne {
 	_u.mutation.SetMetadataJSON(v)
@@ -925,6 +1101,21 @@ func (_u *NoteConnectionAccountUpdateOne) AddJobs(v ...*NoteConnectionJob) *Note
 	return _u.AddJobIDs(ids...)
 }
 
+// AddConflictIDs adds the "conflicts" edge to the NoteConnectionConflict entity by IDs.
+func (_u *NoteConnectionAccountUpdateOne) AddConflictIDs(ids ...int) *NoteConnectionAccountUpdateOne {
+	_u.mutation.AddConflictIDs(ids...)
+	return _u
+}
+
+// AddConflicts adds the "conflicts" edges to the NoteConnectionConflict entity.
+func (_u *NoteConnectionAccountUpdateOne) AddConflicts(v ...*NoteConnectionConflict) *NoteConnectionAccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddConflictIDs(ids...)
+}
+
 // Mutation returns the NoteConnectionAccountMutation object of the builder.
 func (_u *NoteConnectionAccountUpdateOne) Mutation() *NoteConnectionAccountMutation {
 	return _u.mutation
@@ -978,6 +1169,27 @@ func (_u *NoteConnectionAccountUpdateOne) RemoveJobs(v ...*NoteConnectionJob) *N
 	return _u.RemoveJobIDs(ids...)
 }
 
+// ClearConflicts clears all "conflicts" edges to the NoteConnectionConflict entity.
+func (_u *NoteConnectionAccountUpdateOne) ClearConflicts() *NoteConnectionAccountUpdateOne {
+	_u.mutation.ClearConflicts()
+	return _u
+}
+
+// RemoveConflictIDs removes the "conflicts" edge to NoteConnectionConflict entities by IDs.
+func (_u *NoteConnectionAccountUpdateOne) RemoveConflictIDs(ids ...int) *NoteConnectionAccountUpdateOne {
+	_u.mutation.RemoveConflictIDs(ids...)
+	return _u
+}
+
+// RemoveConflicts removes "conflicts" edges to NoteConnectionConflict entities.
+func (_u *NoteConnectionAccountUpdateOne) RemoveConflicts(v ...*NoteConnectionConflict) *NoteConnectionAccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveConflictIDs(ids...)
+}
+
 // Where appends a list predicates to the NoteConnectionAccountUpdate builder.
 func (_u *NoteConnectionAccountUpdateOne) Where(ps ...predicate.NoteConnectionAccount) *NoteConnectionAccountUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1116,6 +1328,18 @@ func (_u *NoteConnectionAccountUpdateOne) sqlSave(ctx context.Context) (_node *N
 	if _u.mutation.DefaultTargetNameCleared() {
 		_spec.ClearField(noteconnectionaccount.FieldDefaultTargetName, field.TypeString)
 	}
+	if value, ok := _u.mutation.SyncIntervalMinutes(); ok {
+		_spec.SetField(noteconnectionaccount.FieldSyncIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSyncIntervalMinutes(); ok {
+		_spec.AddField(noteconnectionaccount.FieldSyncIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EncryptedWebhookSecret(); ok {
+		_spec.SetField(noteconnectionaccount.FieldEncryptedWebhookSecret, field.TypeString, value)
+	}
+	if _u.mutation.EncryptedWebhookSecretCleared() {
+		_spec.ClearField(noteconnectionaccount.FieldEncryptedWebhookSecret, field.TypeString)
+	}
 	if value, ok := _u.mutation.MetadataJSON(); ok {
 		_spec.SetField(noteconnectionaccount.FieldMetadataJSON, field.TypeString, value)
 	}
@@ -1259,6 +1483,51 @@ func (_u *NoteConnectionAccountUpdateOne) sqlSave(ctx context.Context) (_node *N
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   noteconnectionaccount.ConflictsTable,
+			Columns: []string{noteconnectionaccount.ConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedConflictsIDs(); len(nodes) > 0 && !_u.mutation.ConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   noteconnectionaccount.ConflictsTable,
+			Columns: []string{noteconnectionaccount.ConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConflictsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   noteconnectionaccount.ConflictsTable,
+			Columns: []string{noteconnectionaccount.ConflictsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &NoteConnectionAccount{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
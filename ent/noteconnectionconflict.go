@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"smarticky/ent/note"
+	"smarticky/ent/noteconnectionaccount"
+	"smarticky/ent/noteconnectionconflict"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// NoteConnectionConflict is the model entity for the NoteConnectionConflict schema.
+type NoteConnectionConflict struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID int `json:"account_id,omitempty"`
+	// NoteID holds the value of the "note_id" field.
+	NoteID uuid.UUID `json:"note_id,omitempty"`
+	// ExternalID holds the value of the "external_id" field.
+	ExternalID string `json:"external_id,omitempty"`
+	// LocalTitle holds the value of the "local_title" field.
+	LocalTitle string `json:"local_title,omitempty"`
+	// LocalContent holds the value of the "local_content" field.
+	LocalContent string `json:"local_content,omitempty"`
+	// LocalUpdatedAt holds the value of the "local_updated_at" field.
+	LocalUpdatedAt time.Time `json:"local_updated_at,omitempty"`
+	// RemoteTitle holds the value of the "remote_title" field.
+	RemoteTitle string `json:"remote_title,omitempty"`
+	// RemoteContent holds the value of the "remote_content" field.
+	RemoteContent string `json:"remote_content,omitempty"`
+	// RemoteUpdatedAt holds the value of the "remote_updated_at" field.
+	RemoteUpdatedAt time.Time `json:"remote_updated_at,omitempty"`
+	// open, resolved
+	Status string `json:"status,omitempty"`
+	// local or remote
+	Resolution string `json:"resolution,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt time.Time `json:"resolved_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NoteConnectionConflictQuery when eager-loading is set.
+	Edges        NoteConnectionConflictEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NoteConnectionConflictEdges holds the relations/edges for other nodes in the graph.
+type NoteConnectionConflictEdges struct {
+	// Account holds the value of the account edge.
+	Account *NoteConnectionAccount `json:"account,omitempty"`
+	// Note holds the value of the note edge.
+	Note *Note `json:"note,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NoteConnectionConflictEdges) AccountOrErr() (*NoteConnectionAccount, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: noteconnectionaccount.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// NoteOrErr returns the Note value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NoteConnectionConflictEdges) NoteOrErr() (*Note, error) {
+	if e.Note != nil {
+		return e.Note, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: note.Label}
+	}
+	return nil, &NotLoadedError{edge: "note"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NoteConnectionConflict) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case noteconnectionconflict.FieldID, noteconnectionconflict.FieldAccountID:
+			values[i] = new(sql.NullInt64)
+		case noteconnectionconflict.FieldExternalID, noteconnectionconflict.FieldLocalTitle, noteconnectionconflict.FieldLocalContent, noteconnectionconflict.FieldRemoteTitle, noteconnectionconflict.FieldRemoteContent, noteconnectionconflict.FieldStatus, noteconnectionconflict.FieldResolution:
+			values[i] = new(sql.NullString)
+		case noteconnectionconflict.FieldLocalUpdatedAt, noteconnectionconflict.FieldRemoteUpdatedAt, noteconnectionconflict.FieldResolvedAt, noteconnectionconflict.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case noteconnectionconflict.FieldNoteID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NoteConnectionConflict fields.
+func (_m *NoteConnectionConflict) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case noteconnectionconflict.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case noteconnectionconflict.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = int(value.Int64)
+			}
+		case noteconnectionconflict.FieldNoteID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field note_id", values[i])
+			} else if value != nil {
+				_m.NoteID = *value
+			}
+		case noteconnectionconflict.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				_m.ExternalID = value.String
+			}
+		case noteconnectionconflict.FieldLocalTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field local_title", values[i])
+			} else if value.Valid {
+				_m.LocalTitle = value.String
+			}
+		case noteconnectionconflict.FieldLocalContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field local_content", values[i])
+			} else if value.Valid {
+				_m.LocalContent = value.String
+			}
+		case noteconnectionconflict.FieldLocalUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field local_updated_at", values[i])
+			} else if value.Valid {
+				_m.LocalUpdatedAt = value.Time
+			}
+		case noteconnectionconflict.FieldRemoteTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remote_title", values[i])
+			} else if value.Valid {
+				_m.RemoteTitle = value.String
+			}
+		case noteconnectionconflict.FieldRemoteContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remote_content", values[i])
+			} else if value.Valid {
+				_m.RemoteContent = value.String
+			}
+		case noteconnectionconflict.FieldRemoteUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field remote_updated_at", values[i])
+			} else if value.Valid {
+				_m.RemoteUpdatedAt = value.Time
+			}
+		case noteconnectionconflict.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case noteconnectionconflict.FieldResolution:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution", values[i])
+			} else if value.Valid {
+				_m.Resolution = value.String
+			}
+		case noteconnectionconflict.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = value.Time
+			}
+		case noteconnectionconflict.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NoteConnectionConflict.
+// This includes values selected through modifiers, order, etc.
+func (_m *NoteConnectionConflict) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the NoteConnectionConflict entity.
+func (_m *NoteConnectionConflict) QueryAccount() *NoteConnectionAccountQuery {
+	return NewNoteConnectionConflictClient(_m.config).QueryAccount(_m)
+}
+
+// QueryNote queries the "note" edge of the NoteConnectionConflict entity.
+func (_m *NoteConnectionConflict) QueryNote() *NoteQuery {
+	return NewNoteConnectionConflictClient(_m.config).QueryNote(_m)
+}
+
+// Update returns a builder for updating this NoteConnectionConflict.
+// Note that you need to call NoteConnectionConflict.Unwrap() before calling this method if this NoteConnectionConflict
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *NoteConnectionConflict) Update() *NoteConnectionConflictUpdateOne {
+	return NewNoteConnectionConflictClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the NoteConnectionConflict entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *NoteConnectionConflict) Unwrap() *NoteConnectionConflict {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NoteConnectionConflict is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *NoteConnectionConflict) String() string {
+	var builder strings.Builder
+	builder.WriteString("NoteConnectionConflict(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("note_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NoteID))
+	builder.WriteString(", ")
+	builder.WriteString("external_id=")
+	builder.WriteString(_m.ExternalID)
+	builder.WriteString(", ")
+	builder.WriteString("local_title=")
+	builder.WriteString(_m.LocalTitle)
+	builder.WriteString(", ")
+	builder.WriteString("local_content=")
+	builder.WriteString(_m.LocalContent)
+	builder.WriteString(", ")
+	builder.WriteString("local_updated_at=")
+	builder.WriteString(_m.LocalUpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("remote_title=")
+	builder.WriteString(_m.RemoteTitle)
+	builder.WriteString(", ")
+	builder.WriteString("remote_content=")
+	builder.WriteString(_m.RemoteContent)
+	builder.WriteString(", ")
+	builder.WriteString("remote_updated_at=")
+	builder.WriteString(_m.RemoteUpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("resolution=")
+	builder.WriteString(_m.Resolution)
+	builder.WriteString(", ")
+	builder.WriteString("resolved_at=")
+	builder.WriteString(_m.ResolvedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NoteConnectionConflicts is a parsable slice of NoteConnectionConflict.
+type NoteConnectionConflicts []*NoteConnectionConflict
@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package noteconnectionconflict
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the noteconnectionconflict type in the database.
+	Label = "note_connection_conflict"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldNoteID holds the string denoting the note_id field in the database.
+	FieldNoteID = "note_id"
+	// FieldExternalID holds the string denoting the external_id field in the database.
+	FieldExternalID = "external_id"
+	// FieldLocalTitle holds the string denoting the local_title field in the database.
+	FieldLocalTitle = "local_title"
+	// FieldLocalContent holds the string denoting the local_content field in the database.
+	FieldLocalContent = "local_content"
+	// FieldLocalUpdatedAt holds the string denoting the local_updated_at field in the database.
+	FieldLocalUpdatedAt = "local_updated_at"
+	// FieldRemoteTitle holds the string denoting the remote_title field in the database.
+	FieldRemoteTitle = "remote_title"
+	// FieldRemoteContent holds the string denoting the remote_content field in the database.
+	FieldRemoteContent = "remote_content"
+	// FieldRemoteUpdatedAt holds the string denoting the remote_updated_at field in the database.
+	FieldRemoteUpdatedAt = "remote_updated_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldResolution holds the string denoting the resolution field in the database.
+	FieldResolution = "resolution"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// EdgeNote holds the string denoting the note edge name in mutations.
+	EdgeNote = "note"
+	// Table holds the table name of the noteconnectionconflict in the database.
+	Table = "note_connection_conflicts"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "note_connection_conflicts"
+	// AccountInverseTable is the table name for the NoteConnectionAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "noteconnectionaccount" package.
+	AccountInverseTable = "note_connection_accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+	// NoteTable is the table that holds the note relation/edge.
+	NoteTable = "note_connection_conflicts"
+	// NoteInverseTable is the table name for the Note entity.
+	// It exists in this package in order to avoid circular dependency with the "note" package.
+	NoteInverseTable = "notes"
+	// NoteColumn is the table column denoting the note relation/edge.
+	NoteColumn = "note_id"
+)
+
+// Columns holds all SQL columns for noteconnectionconflict fields.
+var Columns = []string{
+	FieldID,
+	FieldAccountID,
+	FieldNoteID,
+	FieldExternalID,
+	FieldLocalTitle,
+	FieldLocalContent,
+	FieldLocalUpdatedAt,
+	FieldRemoteTitle,
+	FieldRemoteContent,
+	FieldRemoteUpdatedAt,
+	FieldStatus,
+	FieldResolution,
+	FieldResolvedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	ExternalIDValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the NoteConnectionConflict queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByNoteID orders the results by the note_id field.
+func ByNoteID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNoteID, opts...).ToFunc()
+}
+
+// ByExternalID orders the results by the external_id field.
+func ByExternalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalID, opts...).ToFunc()
+}
+
+// ByLocalTitle orders the results by the local_title field.
+func ByLocalTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocalTitle, opts...).ToFunc()
+}
+
+// ByLocalContent orders the results by the local_content field.
+func ByLocalContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocalContent, opts...).ToFunc()
+}
+
+// ByLocalUpdatedAt orders the results by the local_updated_at field.
+func ByLocalUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocalUpdatedAt, opts...).ToFunc()
+}
+
+// ByRemoteTitle orders the results by the remote_title field.
+func ByRemoteTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemoteTitle, opts...).ToFunc()
+}
+
+// ByRemoteContent orders the results by the remote_content field.
+func ByRemoteContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemoteContent, opts...).ToFunc()
+}
+
+// ByRemoteUpdatedAt orders the results by the remote_updated_at field.
+func ByRemoteUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemoteUpdatedAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByResolution orders the results by the resolution field.
+func ByResolution(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolution, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByNoteField orders the results by note field.
+func ByNoteField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNoteStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+	)
+}
+func newNoteStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NoteInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, NoteTable, NoteColumn),
+	)
+}
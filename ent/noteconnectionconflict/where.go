@@ -0,0 +1,868 @@
+// Code generated by ent, DO NOT EDIT.
+
+package noteconnectionconflict
+
+import (
+	"smarticky/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLTE(FieldID, id))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v int) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldAccountID, v))
+}
+
+// NoteID applies equality check predicate on the "note_id" field. It's identical to NoteIDEQ.
+func NoteID(v uuid.UUID) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldNoteID, v))
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldExternalID, v))
+}
+
+// LocalTitle applies equality check predicate on the "local_title" field. It's identical to LocalTitleEQ.
+func LocalTitle(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldLocalTitle, v))
+}
+
+// LocalContent applies equality check predicate on the "local_content" field. It's identical to LocalContentEQ.
+func LocalContent(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldLocalContent, v))
+}
+
+// LocalUpdatedAt applies equality check predicate on the "local_updated_at" field. It's identical to LocalUpdatedAtEQ.
+func LocalUpdatedAt(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldLocalUpdatedAt, v))
+}
+
+// RemoteTitle applies equality check predicate on the "remote_title" field. It's identical to RemoteTitleEQ.
+func RemoteTitle(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldRemoteTitle, v))
+}
+
+// RemoteContent applies equality check predicate on the "remote_content" field. It's identical to RemoteContentEQ.
+func RemoteContent(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldRemoteContent, v))
+}
+
+// RemoteUpdatedAt applies equality check predicate on the "remote_updated_at" field. It's identical to RemoteUpdatedAtEQ.
+func RemoteUpdatedAt(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldRemoteUpdatedAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldStatus, v))
+}
+
+// Resolution applies equality check predicate on the "resolution" field. It's identical to ResolutionEQ.
+func Resolution(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldResolution, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v int) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v int) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...int) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...int) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// NoteIDEQ applies the EQ predicate on the "note_id" field.
+func NoteIDEQ(v uuid.UUID) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldNoteID, v))
+}
+
+// NoteIDNEQ applies the NEQ predicate on the "note_id" field.
+func NoteIDNEQ(v uuid.UUID) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNEQ(FieldNoteID, v))
+}
+
+// NoteIDIn applies the In predicate on the "note_id" field.
+func NoteIDIn(vs ...uuid.UUID) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIn(FieldNoteID, vs...))
+}
+
+// NoteIDNotIn applies the NotIn predicate on the "note_id" field.
+func NoteIDNotIn(vs ...uuid.UUID) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotIn(FieldNoteID, vs...))
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNEQ(FieldExternalID, v))
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIn(FieldExternalID, vs...))
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotIn(FieldExternalID, vs...))
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGT(FieldExternalID, v))
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGTE(FieldExternalID, v))
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLT(FieldExternalID, v))
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLTE(FieldExternalID, v))
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldContains(FieldExternalID, v))
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldHasPrefix(FieldExternalID, v))
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldHasSuffix(FieldExternalID, v))
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEqualFold(FieldExternalID, v))
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldContainsFold(FieldExternalID, v))
+}
+
+// LocalTitleEQ applies the EQ predicate on the "local_title" field.
+func LocalTitleEQ(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldLocalTitle, v))
+}
+
+// LocalTitleNEQ applies the NEQ predicate on the "local_title" field.
+func LocalTitleNEQ(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNEQ(FieldLocalTitle, v))
+}
+
+// LocalTitleIn applies the In predicate on the "local_title" field.
+func LocalTitleIn(vs ...string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIn(FieldLocalTitle, vs...))
+}
+
+// LocalTitleNotIn applies the NotIn predicate on the "local_title" field.
+func LocalTitleNotIn(vs ...string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotIn(FieldLocalTitle, vs...))
+}
+
+// LocalTitleGT applies the GT predicate on the "local_title" field.
+func LocalTitleGT(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGT(FieldLocalTitle, v))
+}
+
+// LocalTitleGTE applies the GTE predicate on the "local_title" field.
+func LocalTitleGTE(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGTE(FieldLocalTitle, v))
+}
+
+// LocalTitleLT applies the LT predicate on the "local_title" field.
+func LocalTitleLT(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLT(FieldLocalTitle, v))
+}
+
+// LocalTitleLTE applies the LTE predicate on the "local_title" field.
+func LocalTitleLTE(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLTE(FieldLocalTitle, v))
+}
+
+// LocalTitleContains applies the Contains predicate on the "local_title" field.
+func LocalTitleContains(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldContains(FieldLocalTitle, v))
+}
+
+// LocalTitleHasPrefix applies the HasPrefix predicate on the "local_title" field.
+func LocalTitleHasPrefix(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldHasPrefix(FieldLocalTitle, v))
+}
+
+// LocalTitleHasSuffix applies the HasSuffix predicate on the "local_title" field.
+func LocalTitleHasSuffix(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldHasSuffix(FieldLocalTitle, v))
+}
+
+// LocalTitleEqualFold applies the EqualFold predicate on the "local_title" field.
+func LocalTitleEqualFold(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEqualFold(FieldLocalTitle, v))
+}
+
+// LocalTitleContainsFold applies the ContainsFold predicate on the "local_title" field.
+func LocalTitleContainsFold(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldContainsFold(FieldLocalTitle, v))
+}
+
+// LocalContentEQ applies the EQ predicate on the "local_content" field.
+func LocalContentEQ(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldLocalContent, v))
+}
+
+// LocalContentNEQ applies the NEQ predicate on the "local_content" field.
+func LocalContentNEQ(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNEQ(FieldLocalContent, v))
+}
+
+// LocalContentIn applies the In predicate on the "local_content" field.
+func LocalContentIn(vs ...string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIn(FieldLocalContent, vs...))
+}
+
+// LocalContentNotIn applies the NotIn predicate on the "local_content" field.
+func LocalContentNotIn(vs ...string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotIn(FieldLocalContent, vs...))
+}
+
+// LocalContentGT applies the GT predicate on the "local_content" field.
+func LocalContentGT(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGT(FieldLocalContent, v))
+}
+
+// LocalContentGTE applies the GTE predicate on the "local_content" field.
+func LocalContentGTE(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGTE(FieldLocalContent, v))
+}
+
+// LocalContentLT applies the LT predicate on the "local_content" field.
+func LocalContentLT(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLT(FieldLocalContent, v))
+}
+
+// LocalContentLTE applies the LTE predicate on the "local_content" field.
+func LocalContentLTE(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLTE(FieldLocalContent, v))
+}
+
+// LocalContentContains applies the Contains predicate on the "local_content" field.
+func LocalContentContains(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldContains(FieldLocalContent, v))
+}
+
+// LocalContentHasPrefix applies the HasPrefix predicate on the "local_content" field.
+func LocalContentHasPrefix(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldHasPrefix(FieldLocalContent, v))
+}
+
+// LocalContentHasSuffix applies the HasSuffix predicate on the "local_content" field.
+func LocalContentHasSuffix(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldHasSuffix(FieldLocalContent, v))
+}
+
+// LocalContentEqualFold applies the EqualFold predicate on the "local_content" field.
+func LocalContentEqualFold(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEqualFold(FieldLocalContent, v))
+}
+
+// LocalContentContainsFold applies the ContainsFold predicate on the "local_content" field.
+func LocalContentContainsFold(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldContainsFold(FieldLocalContent, v))
+}
+
+// LocalUpdatedAtEQ applies the EQ predicate on the "local_updated_at" field.
+func LocalUpdatedAtEQ(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldLocalUpdatedAt, v))
+}
+
+// LocalUpdatedAtNEQ applies the NEQ predicate on the "local_updated_at" field.
+func LocalUpdatedAtNEQ(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNEQ(FieldLocalUpdatedAt, v))
+}
+
+// LocalUpdatedAtIn applies the In predicate on the "local_updated_at" field.
+func LocalUpdatedAtIn(vs ...time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIn(FieldLocalUpdatedAt, vs...))
+}
+
+// LocalUpdatedAtNotIn applies the NotIn predicate on the "local_updated_at" field.
+func LocalUpdatedAtNotIn(vs ...time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotIn(FieldLocalUpdatedAt, vs...))
+}
+
+// LocalUpdatedAtGT applies the GT predicate on the "local_updated_at" field.
+func LocalUpdatedAtGT(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGT(FieldLocalUpdatedAt, v))
+}
+
+// LocalUpdatedAtGTE applies the GTE predicate on the "local_updated_at" field.
+func LocalUpdatedAtGTE(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGTE(FieldLocalUpdatedAt, v))
+}
+
+// LocalUpdatedAtLT applies the LT predicate on the "local_updated_at" field.
+func LocalUpdatedAtLT(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLT(FieldLocalUpdatedAt, v))
+}
+
+// LocalUpdatedAtLTE applies the LTE predicate on the "local_updated_at" field.
+func LocalUpdatedAtLTE(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLTE(FieldLocalUpdatedAt, v))
+}
+
+// RemoteTitleEQ applies the EQ predicate on the "remote_title" field.
+func RemoteTitleEQ(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldRemoteTitle, v))
+}
+
+// RemoteTitleNEQ applies the NEQ predicate on the "remote_title" field.
+func RemoteTitleNEQ(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNEQ(FieldRemoteTitle, v))
+}
+
+// RemoteTitleIn applies the In predicate on the "remote_title" field.
+func RemoteTitleIn(vs ...string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIn(FieldRemoteTitle, vs...))
+}
+
+// RemoteTitleNotIn applies the NotIn predicate on the "remote_title" field.
+func RemoteTitleNotIn(vs ...string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotIn(FieldRemoteTitle, vs...))
+}
+
+// RemoteTitleGT applies the GT predicate on the "remote_title" field.
+func RemoteTitleGT(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGT(FieldRemoteTitle, v))
+}
+
+// RemoteTitleGTE applies the GTE predicate on the "remote_title" field.
+func RemoteTitleGTE(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGTE(FieldRemoteTitle, v))
+}
+
+// RemoteTitleLT applies the LT predicate on the "remote_title" field.
+func RemoteTitleLT(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLT(FieldRemoteTitle, v))
+}
+
+// RemoteTitleLTE applies the LTE predicate on the "remote_title" field.
+func RemoteTitleLTE(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLTE(FieldRemoteTitle, v))
+}
+
+// RemoteTitleContains applies the Contains predicate on the "remote_title" field.
+func RemoteTitleContains(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldContains(FieldRemoteTitle, v))
+}
+
+// RemoteTitleHasPrefix applies the HasPrefix predicate on the "remote_title" field.
+func RemoteTitleHasPrefix(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldHasPrefix(FieldRemoteTitle, v))
+}
+
+// RemoteTitleHasSuffix applies the HasSuffix predicate on the "remote_title" field.
+func RemoteTitleHasSuffix(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldHasSuffix(FieldRemoteTitle, v))
+}
+
+// RemoteTitleEqualFold applies the EqualFold predicate on the "remote_title" field.
+func RemoteTitleEqualFold(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEqualFold(FieldRemoteTitle, v))
+}
+
+// RemoteTitleContainsFold applies the ContainsFold predicate on the "remote_title" field.
+func RemoteTitleContainsFold(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldContainsFold(FieldRemoteTitle, v))
+}
+
+// RemoteContentEQ applies the EQ predicate on the "remote_content" field.
+func RemoteContentEQ(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldRemoteContent, v))
+}
+
+// RemoteContentNEQ applies the NEQ predicate on the "remote_content" field.
+func RemoteContentNEQ(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNEQ(FieldRemoteContent, v))
+}
+
+// RemoteContentIn applies the In predicate on the "remote_content" field.
+func RemoteContentIn(vs ...string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIn(FieldRemoteContent, vs...))
+}
+
+// RemoteContentNotIn applies the NotIn predicate on the "remote_content" field.
+func RemoteContentNotIn(vs ...string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotIn(FieldRemoteContent, vs...))
+}
+
+// RemoteContentGT applies the GT predicate on the "remote_content" field.
+func RemoteContentGT(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGT(FieldRemoteContent, v))
+}
+
+// RemoteContentGTE applies the GTE predicate on the "remote_content" field.
+func RemoteContentGTE(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGTE(FieldRemoteContent, v))
+}
+
+// RemoteContentLT applies the LT predicate on the "remote_content" field.
+func RemoteContentLT(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLT(FieldRemoteContent, v))
+}
+
+// RemoteContentLTE applies the LTE predicate on the "remote_content" field.
+func RemoteContentLTE(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLTE(FieldRemoteContent, v))
+}
+
+// RemoteContentContains applies the Contains predicate on the "remote_content" field.
+func RemoteContentContains(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldContains(FieldRemoteContent, v))
+}
+
+// RemoteContentHasPrefix applies the HasPrefix predicate on the "remote_content" field.
+func RemoteContentHasPrefix(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldHasPrefix(FieldRemoteContent, v))
+}
+
+// RemoteContentHasSuffix applies the HasSuffix predicate on the "remote_content" field.
+func RemoteContentHasSuffix(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldHasSuffix(FieldRemoteContent, v))
+}
+
+// RemoteContentEqualFold applies the EqualFold predicate on the "remote_content" field.
+func RemoteContentEqualFold(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEqualFold(FieldRemoteContent, v))
+}
+
+// RemoteContentContainsFold applies the ContainsFold predicate on the "remote_content" field.
+func RemoteContentContainsFold(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldContainsFold(FieldRemoteContent, v))
+}
+
+// RemoteUpdatedAtEQ applies the EQ predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtEQ(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldRemoteUpdatedAt, v))
+}
+
+// RemoteUpdatedAtNEQ applies the NEQ predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtNEQ(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNEQ(FieldRemoteUpdatedAt, v))
+}
+
+// RemoteUpdatedAtIn applies the In predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtIn(vs ...time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIn(FieldRemoteUpdatedAt, vs...))
+}
+
+// RemoteUpdatedAtNotIn applies the NotIn predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtNotIn(vs ...time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotIn(FieldRemoteUpdatedAt, vs...))
+}
+
+// RemoteUpdatedAtGT applies the GT predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtGT(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGT(FieldRemoteUpdatedAt, v))
+}
+
+// RemoteUpdatedAtGTE applies the GTE predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtGTE(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGTE(FieldRemoteUpdatedAt, v))
+}
+
+// RemoteUpdatedAtLT applies the LT predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtLT(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLT(FieldRemoteUpdatedAt, v))
+}
+
+// RemoteUpdatedAtLTE applies the LTE predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtLTE(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLTE(FieldRemoteUpdatedAt, v))
+}
+
+// RemoteUpdatedAtIsNil applies the IsNil predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtIsNil() predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIsNull(FieldRemoteUpdatedAt))
+}
+
+// RemoteUpdatedAtNotNil applies the NotNil predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtNotNil() predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotNull(FieldRemoteUpdatedAt))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ResolutionEQ applies the EQ predicate on the "resolution" field.
+func ResolutionEQ(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldResolution, v))
+}
+
+// ResolutionNEQ applies the NEQ predicate on the "resolution" field.
+func ResolutionNEQ(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNEQ(FieldResolution, v))
+}
+
+// ResolutionIn applies the In predicate on the "resolution" field.
+func ResolutionIn(vs ...string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIn(FieldResolution, vs...))
+}
+
+// ResolutionNotIn applies the NotIn predicate on the "resolution" field.
+func ResolutionNotIn(vs ...string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotIn(FieldResolution, vs...))
+}
+
+// ResolutionGT applies the GT predicate on the "resolution" field.
+func ResolutionGT(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGT(FieldResolution, v))
+}
+
+// ResolutionGTE applies the GTE predicate on the "resolution" field.
+func ResolutionGTE(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGTE(FieldResolution, v))
+}
+
+// ResolutionLT applies the LT predicate on the "resolution" field.
+func ResolutionLT(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLT(FieldResolution, v))
+}
+
+// ResolutionLTE applies the LTE predicate on the "resolution" field.
+func ResolutionLTE(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLTE(FieldResolution, v))
+}
+
+// ResolutionContains applies the Contains predicate on the "resolution" field.
+func ResolutionContains(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldContains(FieldResolution, v))
+}
+
+// ResolutionHasPrefix applies the HasPrefix predicate on the "resolution" field.
+func ResolutionHasPrefix(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldHasPrefix(FieldResolution, v))
+}
+
+// ResolutionHasSuffix applies the HasSuffix predicate on the "resolution" field.
+func ResolutionHasSuffix(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldHasSuffix(FieldResolution, v))
+}
+
+// ResolutionIsNil applies the IsNil predicate on the "resolution" field.
+func ResolutionIsNil() predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIsNull(FieldResolution))
+}
+
+// ResolutionNotNil applies the NotNil predicate on the "resolution" field.
+func ResolutionNotNil() predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotNull(FieldResolution))
+}
+
+// ResolutionEqualFold applies the EqualFold predicate on the "resolution" field.
+func ResolutionEqualFold(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEqualFold(FieldResolution, v))
+}
+
+// ResolutionContainsFold applies the ContainsFold predicate on the "resolution" field.
+func ResolutionContainsFold(v string) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldContainsFold(FieldResolution, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.NoteConnectionAccount) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNote applies the HasEdge predicate on the "note" edge.
+func HasNote() predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, NoteTable, NoteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNoteWith applies the HasEdge predicate on the "note" edge with a given conditions (other predicates).
+func HasNoteWith(preds ...predicate.Note) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(func(s *sql.Selector) {
+		step := newNoteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NoteConnectionConflict) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NoteConnectionConflict) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NoteConnectionConflict) predicate.NoteConnectionConflict {
+	return predicate.NoteConnectionConflict(sql.NotPredicates(p))
+}
@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"smarticky/ent/note"
+	"smarticky/ent/noteconnectionaccount"
+	"smarticky/ent/noteconnectionconflict"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// NoteConnectionConflictCreate is the builder for creating a NoteConnectionConflict entity.
+type NoteConnectionConflictCreate struct {
+	config
+	mutation *NoteConnectionConflictMutation
+	hooks    []Hook
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *NoteConnectionConflictCreate) SetAccountID(v int) *NoteConnectionConflictCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNoteID sets the "note_id" field.
+func (_c *NoteConnectionConflictCreate) SetNoteID(v uuid.UUID) *NoteConnectionConflictCreate {
+	_c.mutation.SetNoteID(v)
+	return _c
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *NoteConnectionConflictCreate) SetExternalID(v string) *NoteConnectionConflictCreate {
+	_c.mutation.SetExternalID(v)
+	return _c
+}
+
+// SetLocalTitle sets the "local_title" field.
+func (_c *NoteConnectionConflictCreate) SetLocalTitle(v string) *NoteConnectionConflictCreate {
+	_c.mutation.SetLocalTitle(v)
+	return _c
+}
+
+// SetLocalContent sets the "local_content" field.
+func (_c *NoteConnectionConflictCreate) SetLocalContent(v string) *NoteConnectionConflictCreate {
+	_c.mutation.SetLocalContent(v)
+	return _c
+}
+
+// SetLocalUpdatedAt sets the "local_updated_at" field.
+func (_c *NoteConnectionConflictCreate) SetLocalUpdatedAt(v time.Time) *NoteConnectionConflictCreate {
+	_c.mutation.SetLocalUpdatedAt(v)
+	return _c
+}
+
+// SetRemoteTitle sets the "remote_title" field.
+func (_c *NoteConnectionConflictCreate) SetRemoteTitle(v string) *NoteConnectionConflictCreate {
+	_c.mutation.SetRemoteTitle(v)
+	return _c
+}
+
+// SetRemoteContent sets the "remote_content" field.
+func (_c *NoteConnectionConflictCreate) SetRemoteContent(v string) *NoteConnectionConflictCreate {
+	_c.mutation.SetRemoteContent(v)
+	return _c
+}
+
+// SetRemoteUpdatedAt sets the "remote_updated_at" field.
+func (_c *NoteConnectionConflictCreate) SetRemoteUpdatedAt(v time.Time) *NoteConnectionConflictCreate {
+	_c.mutation.SetRemoteUpdatedAt(v)
+	return _c
+}
+
+// SetNillableRemoteUpdatedAt sets the "remote_updated_at" field if the given value is not nil.
+func (_c *NoteConnectionConflictCreate) SetNillableRemoteUpdatedAt(v *time.Time) *NoteConnectionConflictCreate {
+	if v != nil {
+		_c.SetRemoteUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *NoteConnectionConflictCreate) SetStatus(v string) *NoteConnectionConflictCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *NoteConnectionConflictCreate) SetNillableStatus(v *string) *NoteConnectionConflictCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetResolution sets the "resolution" field.
+func (_c *NoteConnectionConflictCreate) SetResolution(v string) *NoteConnectionConflictCreate {
+	_c.mutation.SetResolution(v)
+	return _c
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (_c *NoteConnectionConflictCreate) SetNillableResolution(v *string) *NoteConnectionConflictCreate {
+	if v != nil {
+		_c.SetResolution(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *NoteConnectionConflictCreate) SetResolvedAt(v time.Time) *NoteConnectionConflictCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *NoteConnectionConflictCreate) SetNillableResolvedAt(v *time.Time) *NoteConnectionConflictCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NoteConnectionConflictCreate) SetCreatedAt(v time.Time) *NoteConnectionConflictCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NoteConnectionConflictCreate) SetNillableCreatedAt(v *time.Time) *NoteConnectionConflictCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the NoteConnectionAccount entity.
+func (_c *NoteConnectionConflictCreate) SetAccount(v *NoteConnectionAccount) *NoteConnectionConflictCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (_c *NoteConnectionConflictCreate) SetNote(v *Note) *NoteConnectionConflictCreate {
+	return _c.SetNoteID(v.ID)
+}
+
+// Mutation returns the NoteConnectionConflictMutation object of the builder.
+func (_c *NoteConnectionConflictCreate) Mutation() *NoteConnectionConflictMutation {
+	return _c.mutation
+}
+
+// Save creates the NoteConnectionConflict in the database.
+func (_c *NoteConnectionConflictCreate) Save(ctx context.Context) (*NoteConnectionConflict, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NoteConnectionConflictCreate) SaveX(ctx context.Context) *NoteConnectionConflict {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NoteConnectionConflictCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NoteConnectionConflictCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NoteConnectionConflictCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := noteconnectionconflict.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := noteconnectionconflict.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NoteConnectionConflictCreate) check() error {
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "NoteConnectionConflict.account_id"`)}
+	}
+	if _, ok := _c.mutation.NoteID(); !ok {
+		return &ValidationError{Name: "note_id", err: errors.New(`ent: missing required field "NoteConnectionConflict.note_id"`)}
+	}
+	if _, ok := _c.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "external_id", err: errors.New(`ent: missing required field "NoteConnectionConflict.external_id"`)}
+	}
+	if v, ok := _c.mutation.ExternalID(); ok {
+		if err := noteconnectionconflict.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "NoteConnectionConflict.external_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LocalTitle(); !ok {
+		return &ValidationError{Name: "local_title", err: errors.New(`ent: missing required field "NoteConnectionConflict.local_title"`)}
+	}
+	if _, ok := _c.mutation.LocalContent(); !ok {
+		return &ValidationError{Name: "local_content", err: errors.New(`ent: missing required field "NoteConnectionConflict.local_content"`)}
+	}
+	if _, ok := _c.mutation.LocalUpdatedAt(); !ok {
+		return &ValidationError{Name: "local_updated_at", err: errors.New(`ent: missing required field "NoteConnectionConflict.local_updated_at"`)}
+	}
+	if _, ok := _c.mutation.RemoteTitle(); !ok {
+		return &ValidationError{Name: "remote_title", err: errors.New(`ent: missing required field "NoteConnectionConflict.remote_title"`)}
+	}
+	if _, ok := _c.mutation.RemoteContent(); !ok {
+		return &ValidationError{Name: "remote_content", err: errors.New(`ent: missing required field "NoteConnectionConflict.remote_content"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "NoteConnectionConflict.status"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NoteConnectionConflict.created_at"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "NoteConnectionConflict.account"`)}
+	}
+	if len(_c.mutation.NoteIDs()) == 0 {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required edge "NoteConnectionConflict.note"`)}
+	}
+	return nil
+}
+
+func (_c *NoteConnectionConflictCreate) sqlSave(ctx context.Context) (*NoteConnectionConflict, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NoteConnectionConflictCreate) createSpec() (*NoteConnectionConflict, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NoteConnectionConflict{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(noteconnectionconflict.Table, sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(noteconnectionconflict.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = value
+	}
+	if value, ok := _c.mutation.LocalTitle(); ok {
+		_spec.SetField(noteconnectionconflict.FieldLocalTitle, field.TypeString, value)
+		_node.LocalTitle = value
+	}
+	if value, ok := _c.mutation.LocalContent(); ok {
+		_spec.SetField(noteconnectionconflict.FieldLocalContent, field.TypeString, value)
+		_node.LocalContent = value
+	}
+	if value, ok := _c.mutation.LocalUpdatedAt(); ok {
+		_spec.SetField(noteconnectionconflict.FieldLocalUpdatedAt, field.TypeTime, value)
+		_node.LocalUpdatedAt = value
+	}
+	if value, ok := _c.mutation.RemoteTitle(); ok {
+		_spec.SetField(noteconnectionconflict.FieldRemoteTitle, field.TypeString, value)
+		_node.RemoteTitle = value
+	}
+	if value, ok := _c.mutation.RemoteContent(); ok {
+		_spec.SetField(noteconnectionconflict.FieldRemoteContent, field.TypeString, value)
+		_node.RemoteContent = value
+	}
+	if value, ok := _c.mutation.RemoteUpdatedAt(); ok {
+		_spec.SetField(noteconnectionconflict.FieldRemoteUpdatedAt, field.TypeTime, value)
+		_node.RemoteUpdatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(noteconnectionconflict.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Resolution(); ok {
+		_spec.SetField(noteconnectionconflict.FieldResolution, field.TypeString, value)
+		_node.Resolution = value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(noteconnectionconflict.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(noteconnectionconflict.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteconnectionconflict.AccountTable,
+			Columns: []string{noteconnectionconflict.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteconnectionconflict.NoteTable,
+			Columns: []string{noteconnectionconflict.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.NoteID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NoteConnectionConflictCreateBulk is the builder for creating many NoteConnectionConflict entities in bulk.
+type NoteConnectionConflictCreateBulk struct {
+	config
+	err      error
+	builders []*NoteConnectionConflictCreate
+}
+
+// Save creates the NoteConnectionConflict entities in the database.
+func (_c *NoteConnectionConflictCreateBulk) Save(ctx context.Context) ([]*NoteConnectionConflict, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NoteConnectionConflict, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NoteConnectionConflictMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NoteConnectionConflictCreateBulk) SaveX(ctx context.Context) []*NoteConnectionConflict {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NoteConnectionConflictCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NoteConnectionConflictCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"smarticky/ent/noteconnectionconflict"
+	"smarticky/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NoteConnectionConflictDelete is the builder for deleting a NoteConnectionConflict entity.
+type NoteConnectionConflictDelete struct {
+	config
+	hooks    []Hook
+	mutation *NoteConnectionConflictMutation
+}
+
+// Where appends a list predicates to the NoteConnectionConflictDelete builder.
+func (_d *NoteConnectionConflictDelete) Where(ps ...predicate.NoteConnectionConflict) *NoteConnectionConflictDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *NoteConnectionConflictDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *NoteConnectionConflictDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *NoteConnectionConflictDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(noteconnectionconflict.Table, sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// NoteConnectionConflictDeleteOne is the builder for deleting a single NoteConnectionConflict entity.
+type NoteConnectionConflictDeleteOne struct {
+	_d *NoteConnectionConflictDelete
+}
+
+// Where appends a list predicates to the NoteConnectionConflictDelete builder.
+func (_d *NoteConnectionConflictDeleteOne) Where(ps ...predicate.NoteConnectionConflict) *NoteConnectionConflictDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *NoteConnectionConflictDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{noteconnectionconflict.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *NoteConnectionConflictDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
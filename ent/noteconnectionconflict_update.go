@@ -0,0 +1,827 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"smarticky/ent/note"
+	"smarticky/ent/noteconnectionaccount"
+	"smarticky/ent/noteconnectionconflict"
+	"smarticky/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// NoteConnectionConflictUpdate is the builder for updating NoteConnectionConflict entities.
+type NoteConnectionConflictUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NoteConnectionConflictMutation
+}
+
+// Where appends a list predicates to the NoteConnectionConflictUpdate builder.
+func (_u *NoteConnectionConflictUpdate) Where(ps ...predicate.NoteConnectionConflict) *NoteConnectionConflictUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *NoteConnectionConflictUpdate) SetAccountID(v int) *NoteConnectionConflictUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdate) SetNillableAccountID(v *int) *NoteConnectionConflictUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetNoteID sets the "note_id" field.
+func (_u *NoteConnectionConflictUpdate) SetNoteID(v uuid.UUID) *NoteConnectionConflictUpdate {
+	_u.mutation.SetNoteID(v)
+	return _u
+}
+
+// SetNillableNoteID sets the "note_id" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdate) SetNillableNoteID(v *uuid.UUID) *NoteConnectionConflictUpdate {
+	if v != nil {
+		_u.SetNoteID(*v)
+	}
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *NoteConnectionConflictUpdate) SetExternalID(v string) *NoteConnectionConflictUpdate {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdate) SetNillableExternalID(v *string) *NoteConnectionConflictUpdate {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// SetLocalTitle sets the "local_title" field.
+func (_u *NoteConnectionConflictUpdate) SetLocalTitle(v string) *NoteConnectionConflictUpdate {
+	_u.mutation.SetLocalTitle(v)
+	return _u
+}
+
+// SetNillableLocalTitle sets the "local_title" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdate) SetNillableLocalTitle(v *string) *NoteConnectionConflictUpdate {
+	if v != nil {
+		_u.SetLocalTitle(*v)
+	}
+	return _u
+}
+
+// SetLocalContent sets the "local_content" field.
+func (_u *NoteConnectionConflictUpdate) SetLocalContent(v string) *NoteConnectionConflictUpdate {
+	_u.mutation.SetLocalContent(v)
+	return _u
+}
+
+// SetNillableLocalContent sets the "local_content" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdate) SetNillableLocalContent(v *string) *NoteConnectionConflictUpdate {
+	if v != nil {
+		_u.SetLocalContent(*v)
+	}
+	return _u
+}
+
+// SetLocalUpdatedAt sets the "local_updated_at" field.
+func (_u *NoteConnectionConflictUpdate) SetLocalUpdatedAt(v time.Time) *NoteConnectionConflictUpdate {
+	_u.mutation.SetLocalUpdatedAt(v)
+	return _u
+}
+
+// SetNillableLocalUpdatedAt sets the "local_updated_at" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdate) SetNillableLocalUpdatedAt(v *time.Time) *NoteConnectionConflictUpdate {
+	if v != nil {
+		_u.SetLocalUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetRemoteTitle sets the "remote_title" field.
+func (_u *NoteConnectionConflictUpdate) SetRemoteTitle(v string) *NoteConnectionConflictUpdate {
+	_u.mutation.SetRemoteTitle(v)
+	return _u
+}
+
+// SetNillableRemoteTitle sets the "remote_title" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdate) SetNillableRemoteTitle(v *string) *NoteConnectionConflictUpdate {
+	if v != nil {
+		_u.SetRemoteTitle(*v)
+	}
+	return _u
+}
+
+// SetRemoteContent sets the "remote_content" field.
+func (_u *NoteConnectionConflictUpdate) SetRemoteContent(v string) *NoteConnectionConflictUpdate {
+	_u.mutation.SetRemoteContent(v)
+	return _u
+}
+
+// SetNillableRemoteContent sets the "remote_content" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdate) SetNillableRemoteContent(v *string) *NoteConnectionConflictUpdate {
+	if v != nil {
+		_u.SetRemoteContent(*v)
+	}
+	return _u
+}
+
+// SetRemoteUpdatedAt sets the "remote_updated_at" field.
+func (_u *NoteConnectionConflictUpdate) SetRemoteUpdatedAt(v time.Time) *NoteConnectionConflictUpdate {
+	_u.mutation.SetRemoteUpdatedAt(v)
+	return _u
+}
+
+// SetNillableRemoteUpdatedAt sets the "remote_updated_at" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdate) SetNillableRemoteUpdatedAt(v *time.Time) *NoteConnectionConflictUpdate {
+	if v != nil {
+		_u.SetRemoteUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearRemoteUpdatedAt clears the value of the "remote_updated_at" field.
+func (_u *NoteConnectionConflictUpdate) ClearRemoteUpdatedAt() *NoteConnectionConflictUpdate {
+	_u.mutation.ClearRemoteUpdatedAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *NoteConnectionConflictUpdate) SetStatus(v string) *NoteConnectionConflictUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdate) SetNillableStatus(v *string) *NoteConnectionConflictUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetResolution sets the "resolution" field.
+func (_u *NoteConnectionConflictUpdate) SetResolution(v string) *NoteConnectionConflictUpdate {
+	_u.mutation.SetResolution(v)
+	return _u
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdate) SetNillableResolution(v *string) *NoteConnectionConflictUpdate {
+	if v != nil {
+		_u.SetResolution(*v)
+	}
+	return _u
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (_u *NoteConnectionConflictUpdate) ClearResolution() *NoteConnectionConflictUpdate {
+	_u.mutation.ClearResolution()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *NoteConnectionConflictUpdate) SetResolvedAt(v time.Time) *NoteConnectionConflictUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdate) SetNillableResolvedAt(v *time.Time) *NoteConnectionConflictUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *NoteConnectionConflictUpdate) ClearResolvedAt() *NoteConnectionConflictUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the NoteConnectionAccount entity.
+func (_u *NoteConnectionConflictUpdate) SetAccount(v *NoteConnectionAccount) *NoteConnectionConflictUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (_u *NoteConnectionConflictUpdate) SetNote(v *Note) *NoteConnectionConflictUpdate {
+	return _u.SetNoteID(v.ID)
+}
+
+// Mutation returns the NoteConnectionConflictMutation object of the builder.
+func (_u *NoteConnectionConflictUpdate) Mutation() *NoteConnectionConflictMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the NoteConnectionAccount entity.
+func (_u *NoteConnectionConflictUpdate) ClearAccount() *NoteConnectionConflictUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (_u *NoteConnectionConflictUpdate) ClearNote() *NoteConnectionConflictUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NoteConnectionConflictUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NoteConnectionConflictUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NoteConnectionConflictUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NoteConnectionConflictUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NoteConnectionConflictUpdate) check() error {
+	if v, ok := _u.mutation.ExternalID(); ok {
+		if err := noteconnectionconflict.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "NoteConnectionConflict.external_id": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NoteConnectionConflict.account"`)
+	}
+	if _u.mutation.NoteCleared() && len(_u.mutation.NoteIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NoteConnectionConflict.note"`)
+	}
+	return nil
+}
+
+func (_u *NoteConnectionConflictUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(noteconnectionconflict.Table, noteconnectionconflict.Columns, sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(noteconnectionconflict.FieldExternalID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LocalTitle(); ok {
+		_spec.SetField(noteconnectionconflict.FieldLocalTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LocalContent(); ok {
+		_spec.SetField(noteconnectionconflict.FieldLocalContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LocalUpdatedAt(); ok {
+		_spec.SetField(noteconnectionconflict.FieldLocalUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RemoteTitle(); ok {
+		_spec.SetField(noteconnectionconflict.FieldRemoteTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RemoteContent(); ok {
+		_spec.SetField(noteconnectionconflict.FieldRemoteContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RemoteUpdatedAt(); ok {
+		_spec.SetField(noteconnectionconflict.FieldRemoteUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RemoteUpdatedAtCleared() {
+		_spec.ClearField(noteconnectionconflict.FieldRemoteUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(noteconnectionconflict.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Resolution(); ok {
+		_spec.SetField(noteconnectionconflict.FieldResolution, field.TypeString, value)
+	}
+	if _u.mutation.ResolutionCleared() {
+		_spec.ClearField(noteconnectionconflict.FieldResolution, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(noteconnectionconflict.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(noteconnectionconflict.FieldResolvedAt, field.TypeTime)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteconnectionconflict.AccountTable,
+			Columns: []string{noteconnectionconflict.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionaccount.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteconnectionconflict.AccountTable,
+			Columns: []string{noteconnectionconflict.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NoteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteconnectionconflict.NoteTable,
+			Columns: []string{noteconnectionconflict.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteconnectionconflict.NoteTable,
+			Columns: []string{noteconnectionconflict.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{noteconnectionconflict.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NoteConnectionConflictUpdateOne is the builder for updating a single NoteConnectionConflict entity.
+type NoteConnectionConflictUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NoteConnectionConflictMutation
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *NoteConnectionConflictUpdateOne) SetAccountID(v int) *NoteConnectionConflictUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdateOne) SetNillableAccountID(v *int) *NoteConnectionConflictUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetNoteID sets the "note_id" field.
+func (_u *NoteConnectionConflictUpdateOne) SetNoteID(v uuid.UUID) *NoteConnectionConflictUpdateOne {
+	_u.mutation.SetNoteID(v)
+	return _u
+}
+
+// SetNillableNoteID sets the "note_id" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdateOne) SetNillableNoteID(v *uuid.UUID) *NoteConnectionConflictUpdateOne {
+	if v != nil {
+		_u.SetNoteID(*v)
+	}
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *NoteConnectionConflictUpdateOne) SetExternalID(v string) *NoteConnectionConflictUpdateOne {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdateOne) SetNillableExternalID(v *string) *NoteConnectionConflictUpdateOne {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// SetLocalTitle sets the "local_title" field.
+func (_u *NoteConnectionConflictUpdateOne) SetLocalTitle(v string) *NoteConnectionConflictUpdateOne {
+	_u.mutation.SetLocalTitle(v)
+	return _u
+}
+
+// SetNillableLocalTitle sets the "local_title" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdateOne) SetNillableLocalTitle(v *string) *NoteConnectionConflictUpdateOne {
+	if v != nil {
+		_u.SetLocalTitle(*v)
+	}
+	return _u
+}
+
+// SetLocalContent sets the "local_content" field.
+func (_u *NoteConnectionConflictUpdateOne) SetLocalContent(v string) *NoteConnectionConflictUpdateOne {
+	_u.mutation.SetLocalContent(v)
+	return _u
+}
+
+// SetNillableLocalContent sets the "local_content" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdateOne) SetNillableLocalContent(v *string) *NoteConnectionConflictUpdateOne {
+	if v != nil {
+		_u.SetLocalContent(*v)
+	}
+	return _u
+}
+
+// SetLocalUpdatedAt sets the "local_updated_at" field.
+func (_u *NoteConnectionConflictUpdateOne) SetLocalUpdatedAt(v time.Time) *NoteConnectionConflictUpdateOne {
+	_u.mutation.SetLocalUpdatedAt(v)
+	return _u
+}
+
+// SetNillableLocalUpdatedAt sets the "local_updated_at" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdateOne) SetNillableLocalUpdatedAt(v *time.Time) *NoteConnectionConflictUpdateOne {
+	if v != nil {
+		_u.SetLocalUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetRemoteTitle sets the "remote_title" field.
+func (_u *NoteConnectionConflictUpdateOne) SetRemoteTitle(v string) *NoteConnectionConflictUpdateOne {
+	_u.mutation.SetRemoteTitle(v)
+	return _u
+}
+
+// SetNillableRemoteTitle sets the "remote_title" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdateOne) SetNillableRemoteTitle(v *string) *NoteConnectionConflictUpdateOne {
+	if v != nil {
+		_u.SetRemoteTitle(*v)
+	}
+	return _u
+}
+
+// SetRemoteContent sets the "remote_content" field.
+func (_u *NoteConnectionConflictUpdateOne) SetRemoteContent(v string) *NoteConnectionConflictUpdateOne {
+	_u.mutation.SetRemoteContent(v)
+	return _u
+}
+
+// SetNillableRemoteContent sets the "remote_content" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdateOne) SetNillableRemoteContent(v *string) *NoteConnectionConflictUpdateOne {
+	if v != nil {
+		_u.SetRemoteContent(*v)
+	}
+	return _u
+}
+
+// SetRemoteUpdatedAt sets the "remote_updated_at" field.
+func (_u *NoteConnectionConflictUpdateOne) SetRemoteUpdatedAt(v time.Time) *NoteConnectionConflictUpdateOne {
+	_u.mutation.SetRemoteUpdatedAt(v)
+	return _u
+}
+
+// SetNillableRemoteUpdatedAt sets the "remote_updated_at" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdateOne) SetNillableRemoteUpdatedAt(v *time.Time) *NoteConnectionConflictUpdateOne {
+	if v != nil {
+		_u.SetRemoteUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearRemoteUpdatedAt clears the value of the "remote_updated_at" field.
+func (_u *NoteConnectionConflictUpdateOne) ClearRemoteUpdatedAt() *NoteConnectionConflictUpdateOne {
+	_u.mutation.ClearRemoteUpdatedAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *NoteConnectionConflictUpdateOne) SetStatus(v string) *NoteConnectionConflictUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdateOne) SetNillableStatus(v *string) *NoteConnectionConflictUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetResolution sets the "resolution" field.
+func (_u *NoteConnectionConflictUpdateOne) SetResolution(v string) *NoteConnectionConflictUpdateOne {
+	_u.mutation.SetResolution(v)
+	return _u
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdateOne) SetNillableResolution(v *string) *NoteConnectionConflictUpdateOne {
+	if v != nil {
+		_u.SetResolution(*v)
+	}
+	return _u
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (_u *NoteConnectionConflictUpdateOne) ClearResolution() *NoteConnectionConflictUpdateOne {
+	_u.mutation.ClearResolution()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *NoteConnectionConflictUpdateOne) SetResolvedAt(v time.Time) *NoteConnectionConflictUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *NoteConnectionConflictUpdateOne) SetNillableResolvedAt(v *time.Time) *NoteConnectionConflictUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *NoteConnectionConflictUpdateOne) ClearResolvedAt() *NoteConnectionConflictUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the NoteConnectionAccount entity.
+func (_u *NoteConnectionConflictUpdateOne) SetAccount(v *NoteConnectionAccount) *NoteConnectionConflictUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (_u *NoteConnectionConflictUpdateOne) SetNote(v *Note) *NoteConnectionConflictUpdateOne {
+	return _u.SetNoteID(v.ID)
+}
+
+// Mutation returns the NoteConnectionConflictMutation object of the builder.
+func (_u *NoteConnectionConflictUpdateOne) Mutation() *NoteConnectionConflictMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the NoteConnectionAccount entity.
+func (_u *NoteConnectionConflictUpdateOne) ClearAccount() *NoteConnectionConflictUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (_u *NoteConnectionConflictUpdateOne) ClearNote() *NoteConnectionConflictUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// Where appends a list predicates to the NoteConnectionConflictUpdate builder.
+func (_u *NoteConnectionConflictUpdateOne) Where(ps ...predicate.NoteConnectionConflict) *NoteConnectionConflictUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NoteConnectionConflictUpdateOne) Select(field string, fields ...string) *NoteConnectionConflictUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NoteConnectionConflict entity.
+func (_u *NoteConnectionConflictUpdateOne) Save(ctx context.Context) (*NoteConnectionConflict, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NoteConnectionConflictUpdateOne) SaveX(ctx context.Context) *NoteConnectionConflict {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NoteConnectionConflictUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NoteConnectionConflictUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NoteConnectionConflictUpdateOne) check() error {
+	if v, ok := _u.mutation.ExternalID(); ok {
+		if err := noteconnectionconflict.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "NoteConnectionConflict.external_id": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NoteConnectionConflict.account"`)
+	}
+	if _u.mutation.NoteCleared() && len(_u.mutation.NoteIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NoteConnectionConflict.note"`)
+	}
+	return nil
+}
+
+func (_u *NoteConnectionConflictUpdateOne) sqlSave(ctx context.Context) (_node *NoteConnectionConflict, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(noteconnectionconflict.Table, noteconnectionconflict.Columns, sqlgraph.NewFieldSpec(noteconnectionconflict.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NoteConnectionConflict.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, noteconnectionconflict.FieldID)
+		for _, f := range fields {
+			if !noteconnectionconflict.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != noteconnectionconflict.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(noteconnectionconflict.FieldExternalID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LocalTitle(); ok {
+		_spec.SetField(noteconnectionconflict.FieldLocalTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LocalContent(); ok {
+		_spec.SetField(noteconnectionconflict.FieldLocalContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LocalUpdatedAt(); ok {
+		_spec.SetField(noteconnectionconflict.FieldLocalUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RemoteTitle(); ok {
+		_spec.SetField(noteconnectionconflict.FieldRemoteTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RemoteContent(); ok {
+		_spec.SetField(noteconnectionconflict.FieldRemoteContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RemoteUpdatedAt(); ok {
+		_spec.SetField(noteconnectionconflict.FieldRemoteUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RemoteUpdatedAtCleared() {
+		_spec.ClearField(noteconnectionconflict.FieldRemoteUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(noteconnectionconflict.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Resolution(); ok {
+		_spec.SetField(noteconnectionconflict.FieldResolution, field.TypeString, value)
+	}
+	if _u.mutation.ResolutionCleared() {
+		_spec.ClearField(noteconnectionconflict.FieldResolution, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(noteconnectionconflict.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(noteconnectionconflict.FieldResolvedAt, field.TypeTime)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteconnectionconflict.AccountTable,
+			Columns: []string{noteconnectionconflict.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionaccount.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteconnectionconflict.AccountTable,
+			Columns: []string{noteconnectionconflict.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteconnectionaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NoteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteconnectionconflict.NoteTable,
+			Columns: []string{noteconnectionconflict.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteconnectionconflict.NoteTable,
+			Columns: []string{noteconnectionconflict.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NoteConnectionConflict{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{noteconnectionconflict.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -34,12 +34,16 @@ type NoteConnectionItemMap struct {
 	ExternalPath string `json:"external_path,omitempty"`
 	// ExternalURL holds the value of the "external_url" field.
 	ExternalURL string `json:"external_url,omitempty"`
-	// import or push
+	// import, push or sync
 	LastSyncDirection string `json:"last_sync_direction,omitempty"`
 	// LastImportedAt holds the value of the "last_imported_at" field.
 	LastImportedAt time.Time `json:"last_imported_at,omitempty"`
 	// LastPushedAt holds the value of the "last_pushed_at" field.
 	LastPushedAt time.Time `json:"last_pushed_at,omitempty"`
+	// When both sides last matched
+	LastSyncedAt time.Time `json:"last_synced_at,omitempty"`
+	// Remote modification time seen at the last sync
+	RemoteUpdatedAt time.Time `json:"remote_updated_at,omitempty"`
 	// MetadataJSON holds the value of the "metadata_json" field.
 	MetadataJSON string `json:"metadata_json,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -94,7 +98,7 @@ func (*NoteConnectionItemMap) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case noteconnectionitemmap.FieldProvider, noteconnectionitemmap.FieldExternalID, noteconnectionitemmap.FieldExternalTargetID, noteconnectionitemmap.FieldExternalPath, noteconnectionitemmap.FieldExternalURL, noteconnectionitemmap.FieldLastSyncDirection, noteconnectionitemmap.FieldMetadataJSON:
 			values[i] = new(sql.NullString)
-		case noteconnectionitemmap.FieldLastImportedAt, noteconnectionitemmap.FieldLastPushedAt, noteconnectionitemmap.FieldCreatedAt, noteconnectionitemmap.FieldUpdatedAt:
+		case noteconnectionitemmap.FieldLastImportedAt, noteconnectionitemmap.FieldLastPushedAt, noteconnectionitemmap.FieldLastSyncedAt, noteconnectionitemmap.FieldRemoteUpdatedAt, noteconnectionitemmap.FieldCreatedAt, noteconnectionitemmap.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case noteconnectionitemmap.FieldNoteID:
 			values[i] = new(uuid.UUID)
@@ -179,6 +183,18 @@ func (_m *NoteConnectionItemMap) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				_m.LastPushedAt = value.Time
 			}
+		case noteconnectionitemmap.FieldLastSyncedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_synced_at", values[i])
+			} else if value.Valid {
+				_m.LastSyncedAt = value.Time
+			}
+		case noteconnectionitemmap.FieldRemoteUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field remote_updated_at", values[i])
+			} else if value.Valid {
+				_m.RemoteUpdatedAt = value.Time
+			}
 		case noteconnectionitemmap.FieldMetadataJSON:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata_json", values[i])
@@ -273,6 +289,12 @@ func (_m *NoteConnectionItemMap) String() string {
 	builder.WriteString("last_pushed_at=")
 	builder.WriteString(_m.LastPushedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("last_synced_at=")
+	builder.WriteString(_m.LastSyncedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("remote_updated_at=")
+	builder.WriteString(_m.RemoteUpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("metadata_json=")
 	builder.WriteString(_m.MetadataJSON)
 	builder.WriteString(", ")
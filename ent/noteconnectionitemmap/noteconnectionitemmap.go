@@ -34,6 +34,10 @@ const (
 	FieldLastImportedAt = "last_imported_at"
 	// FieldLastPushedAt holds the string denoting the last_pushed_at field in the database.
 	FieldLastPushedAt = "last_pushed_at"
+	// FieldLastSyncedAt holds the string denoting the last_synced_at field in the database.
+	FieldLastSyncedAt = "last_synced_at"
+	// FieldRemoteUpdatedAt holds the string denoting the remote_updated_at field in the database.
+	FieldRemoteUpdatedAt = "remote_updated_at"
 	// FieldMetadataJSON holds the string denoting the metadata_json field in the database.
 	FieldMetadataJSON = "metadata_json"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -75,6 +79,8 @@ var Columns = []string{
 	FieldLastSyncDirection,
 	FieldLastImportedAt,
 	FieldLastPushedAt,
+	FieldLastSyncedAt,
+	FieldRemoteUpdatedAt,
 	FieldMetadataJSON,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -161,6 +167,16 @@ func ByLastPushedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastPushedAt, opts...).ToFunc()
 }
 
+// ByLastSyncedAt orders the results by the last_synced_at field.
+func ByLastSyncedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncedAt, opts...).ToFunc()
+}
+
+// ByRemoteUpdatedAt orders the results by the remote_updated_at field.
+func ByRemoteUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemoteUpdatedAt, opts...).ToFunc()
+}
+
 // ByMetadataJSON orders the results by the metadata_json field.
 func ByMetadataJSON(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMetadataJSON, opts...).ToFunc()
@@ -106,6 +106,16 @@ func LastPushedAt(v time.Time) predicate.NoteConnectionItemMap {
 	return predicate.NoteConnectionItemMap(sql.FieldEQ(FieldLastPushedAt, v))
 }
 
+// LastSyncedAt applies equality check predicate on the "last_synced_at" field. It's identical to LastSyncedAtEQ.
+func LastSyncedAt(v time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldEQ(FieldLastSyncedAt, v))
+}
+
+// RemoteUpdatedAt applies equality check predicate on the "remote_updated_at" field. It's identical to RemoteUpdatedAtEQ.
+func RemoteUpdatedAt(v time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldEQ(FieldRemoteUpdatedAt, v))
+}
+
 // MetadataJSON applies equality check predicate on the "metadata_json" field. It's identical to MetadataJSONEQ.
 func MetadataJSON(v string) predicate.NoteConnectionItemMap {
 	return predicate.NoteConnectionItemMap(sql.FieldEQ(FieldMetadataJSON, v))
@@ -691,6 +701,106 @@ func LastPushedAtNotNil() predicate.NoteConnectionItemMap {
 	return predicate.NoteConnectionItemMap(sql.FieldNotNull(FieldLastPushedAt))
 }
 
+// LastSyncedAtEQ applies the EQ predicate on the "last_synced_at" field.
+func LastSyncedAtEQ(v time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldEQ(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtNEQ applies the NEQ predicate on the "last_synced_at" field.
+func LastSyncedAtNEQ(v time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldNEQ(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtIn applies the In predicate on the "last_synced_at" field.
+func LastSyncedAtIn(vs ...time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldIn(FieldLastSyncedAt, vs...))
+}
+
+// LastSyncedAtNotIn applies the NotIn predicate on the "last_synced_at" field.
+func LastSyncedAtNotIn(vs ...time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldNotIn(FieldLastSyncedAt, vs...))
+}
+
+// LastSyncedAtGT applies the GT predicate on the "last_synced_at" field.
+func LastSyncedAtGT(v time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldGT(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtGTE applies the GTE predicate on the "last_synced_at" field.
+func LastSyncedAtGTE(v time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldGTE(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtLT applies the LT predicate on the "last_synced_at" field.
+func LastSyncedAtLT(v time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldLT(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtLTE applies the LTE predicate on the "last_synced_at" field.
+func LastSyncedAtLTE(v time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldLTE(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtIsNil applies the IsNil predicate on the "last_synced_at" field.
+func LastSyncedAtIsNil() predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldIsNull(FieldLastSyncedAt))
+}
+
+// LastSyncedAtNotNil applies the NotNil predicate on the "last_synced_at" field.
+func LastSyncedAtNotNil() predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldNotNull(FieldLastSyncedAt))
+}
+
+// RemoteUpdatedAtEQ applies the EQ predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtEQ(v time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldEQ(FieldRemoteUpdatedAt, v))
+}
+
+// RemoteUpdatedAtNEQ applies the NEQ predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtNEQ(v time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldNEQ(FieldRemoteUpdatedAt, v))
+}
+
+// RemoteUpdatedAtIn applies the In predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtIn(vs ...time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldIn(FieldRemoteUpdatedAt, vs...))
+}
+
+// RemoteUpdatedAtNotIn applies the NotIn predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtNotIn(vs ...time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldNotIn(FieldRemoteUpdatedAt, vs...))
+}
+
+// RemoteUpdatedAtGT applies the GT predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtGT(v time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldGT(FieldRemoteUpdatedAt, v))
+}
+
+// RemoteUpdatedAtGTE applies the GTE predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtGTE(v time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldGTE(FieldRemoteUpdatedAt, v))
+}
+
+// RemoteUpdatedAtLT applies the LT predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtLT(v time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldLT(FieldRemoteUpdatedAt, v))
+}
+
+// RemoteUpdatedAtLTE applies the LTE predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtLTE(v time.Time) predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldLTE(FieldRemoteUpdatedAt, v))
+}
+
+// RemoteUpdatedAtIsNil applies the IsNil predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtIsNil() predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldIsNull(FieldRemoteUpdatedAt))
+}
+
+// RemoteUpdatedAtNotNil applies the NotNil predicate on the "remote_updated_at" field.
+func RemoteUpdatedAtNotNil() predicate.NoteConnectionItemMap {
+	return predicate.NoteConnectionItemMap(sql.FieldNotNull(FieldRemoteUpdatedAt))
+}
+
 // MetadataJSONEQ applies the EQ predicate on the "metadata_json" field.
 func MetadataJSONEQ(v string) predicate.NoteConnectionItemMap {
 	return predicate.NoteConnectionItemMap(sql.FieldEQ(FieldMetadataJSON, v))
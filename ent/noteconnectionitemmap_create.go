@@ -131,6 +131,34 @@ func (_c *NoteConnectionItemMapCreate) SetNillableLastPushedAt(v *time.Time) *No
 	return _c
 }
 
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (_c *NoteConnectionItemMapCreate) SetLastSyncedAt(v time.Time) *NoteConnectionItemMapCreate {
+	_c.mutation.SetLastSyncedAt(v)
+	return _c
+}
+
+// SetNillableLastSyncedAt sets the "last_synced_at" field if the given value is not nil.
+func (_c *NoteConnectionItemMapCreate) SetNillableLastSyncedAt(v *time.Time) *NoteConnectionItemMapCreate {
+	if v != nil {
+		_c.SetLastSyncedAt(*v)
+	}
+	return _c
+}
+
+// SetRemoteUpdatedAt sets the "remote_updated_at" field.
+func (_c *NoteConnectionItemMapCreate) SetRemoteUpdatedAt(v time.Time) *NoteConnectionItemMapCreate {
+	_c.mutation.SetRemoteUpdatedAt(v)
+	return _c
+}
+
+// SetNillableRemoteUpdatedAt sets the "remote_updated_at" field if the given value is not nil.
+func (_c *NoteConnectionItemMapCreate) SetNillableRemoteUpdatedAt(v *time.Time) *NoteConnectionItemMapCreate {
+	if v != nil {
+		_c.SetRemoteUpdatedAt(*v)
+	}
+	return _c
+}
+
 // SetMetadataJSON sets the "metadata_json" field.
 func (_c *NoteConnectionItemMapCreate) SetMetadataJSON(v string) *NoteConnectionItemMapCreate {
 	_c.mutation.SetMetadataJSON(v)
@@ -322,6 +350,14 @@ func (_c *NoteConnectionItemMapCreate) createSpec() (*NoteConnectionItemMap, *sq
 		_spec.SetField(noteconnectionitemmap.FieldLastPushedAt, field.TypeTime, value)
 		_node.LastPushedAt = value
 	}
+	if value, ok := _c.mutation.LastSyncedAt(); ok {
+		_spec.SetField(noteconnectionitemmap.FieldLastSyncedAt, field.TypeTime, value)
+		_node.LastSyncedAt = value
+	}
+	if value, ok := _c.mutation.RemoteUpdatedAt(); ok {
+		_spec.SetField(noteconnectionitemmap.FieldRemoteUpdatedAt, field.TypeTime, value)
+		_node.RemoteUpdatedAt = value
+	}
 	if value, ok := _c.mutation.MetadataJSON(); ok {
 		_spec.SetField(noteconnectionitemmap.FieldMetadataJSON, field.TypeString, value)
 		_node.MetadataJSON = value
@@ -207,6 +207,46 @@ func (_u *NoteConnectionItemMapUpdate) ClearLastPushedAt() *NoteConnectionItemMa
 	return _u
 }
 
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (_u *NoteConnectionItemMapUpdate) SetLastSyncedAt(v time.Time) *NoteConnectionItemMapUpdate {
+	_u.mutation.SetLastSyncedAt(v)
+	return _u
+}
+
+// SetNillableLastSyncedAt sets the "last_synced_at" field if the given value is not nil.
+func (_u *NoteConnectionItemMapUpdate) SetNillableLastSyncedAt(v *time.Time) *NoteConnectionItemMapUpdate {
+	if v != nil {
+		_u.SetLastSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (_u *NoteConnectionItemMapUpdate) ClearLastSyncedAt() *NoteConnectionItemMapUpdate {
+	_u.mutation.ClearLastSyncedAt()
+	return _u
+}
+
+// SetRemoteUpdatedAt sets the "remote_updated_at" field.
+func (_u *NoteConnectionItemMapUpdate) SetRemoteUpdatedAt(v time.Time) *NoteConnectionItemMapUpdate {
+	_u.mutation.SetRemoteUpdatedAt(v)
+	return _u
+}
+
+// SetNillableRemoteUpdatedAt sets the "remote_updated_at" field if the given value is not nil.
+func (_u *NoteConnectionItemMapUpdate) SetNillableRemoteUpdatedAt(v *time.Time) *NoteConnectionItemMapUpdate {
+	if v != nil {
+		_u.SetRemoteUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearRemoteUpdatedAt clears the value of the "remote_updated_at" field.
+func (_u *NoteConnectionItemMapUpdate) ClearRemoteUpdatedAt() *NoteConnectionItemMapUpdate {
+	_u.mutation.ClearRemoteUpdatedAt()
+	return _u
+}
+
 // SetMetadataJSON sets the "metadata_json" field.
 func (_u *NoteConnectionItemMapUpdate) SetMetadataJSON(v string) *NoteConnectionItemMapUpdate {
 	_u.mutation.SetMetadataJSON(v)
@@ -371,6 +411,18 @@ func (_u *NoteConnectionItemMapUpdate) sqlSave(ctx context.Context) (_node int,
 	if _u.mutation.LastPushedAtCleared() {
 		_spec.ClearField(noteconnectionitemmap.FieldLastPushedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.LastSyncedAt(); ok {
+		_spec.SetField(noteconnectionitemmap.FieldLastSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncedAtCleared() {
+		_spec.ClearField(noteconnectionitemmap.FieldLastSyncedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RemoteUpdatedAt(); ok {
+		_spec.SetField(noteconnectionitemmap.FieldRemoteUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RemoteUpdatedAtCleared() {
+		_spec.ClearField(noteconnectionitemmap.FieldRemoteUpdatedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.MetadataJSON(); ok {
 		_spec.SetField(noteconnectionitemmap.FieldMetadataJSON, field.TypeString, value)
 	}
@@ -634,6 +686,46 @@ func (_u *NoteConnectionItemMapUpdateOne) ClearLastPushedAt() *NoteConnectionIte
 	return _u
 }
 
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (_u *NoteConnectionItemMapUpdateOne) SetLastSyncedAt(v time.Time) *NoteConnectionItemMapUpdateOne {
+	_u.mutation.SetLastSyncedAt(v)
+	return _u
+}
+
+// SetNillableLastSyncedAt sets the "last_synced_at" field if the given value is not nil.
+func (_u *NoteConnectionItemMapUpdateOne) SetNillableLastSyncedAt(v *time.Time) *NoteConnectionItemMapUpdateOne {
+	if v != nil {
+		_u.SetLastSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (_u *NoteConnectionItemMapUpdateOne) ClearLastSyncedAt() *NoteConnectionItemMapUpdateOne {
+	_u.mutation.ClearLastSyncedAt()
+	return _u
+}
+
+// SetRemoteUpdatedAt sets the "remote_updated_at" field.
+func (_u *NoteConnectionItemMapUpdateOne) SetRemoteUpdatedAt(v time.Time) *NoteConnectionItemMapUpdateOne {
+	_u.mutation.SetRemoteUpdatedAt(v)
+	return _u
+}
+
+// SetNillableRemoteUpdatedAt sets the "remote_updated_at" field if the given value is not nil.
+func (_u *NoteConnectionItemMapUpdateOne) SetNillableRemoteUpdatedAt(v *time.Time) *NoteConnectionItemMapUpdateOne {
+	if v != nil {
+		_u.SetRemoteUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearRemoteUpdatedAt clears the value of the "remote_updated_at" field.
+func (_u *NoteConnectionItemMapUpdateOne) ClearRemoteUpdatedAt() *NoteConnectionItemMapUpdateOne {
+	_u.mutation.ClearRemoteUpdatedAt()
+	return _u
+}
+
 // SetMetadataJSON sets the "metadata_json" field.
 func (_u *NoteConnectionItemMapUpdateOne) SetMetadataJSON(v string) *NoteConnectionItemMapUpdateOne {
 	_u.mutation.SetMetadataJSON(v)
@@ -828,6 +920,18 @@ func (_u *NoteConnectionItemMapUpdateOne) sqlSave(ctx context.Context) (_node *N
 	if _u.mutation.LastPushedAtCleared() {
 		_spec.ClearField(noteconnectionitemmap.FieldLastPushedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.LastSyncedAt(); ok {
+		_spec.SetField(noteconnectionitemmap.FieldLastSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncedAtCleared() {
+		_spec.ClearField(noteconnectionitemmap.FieldLastSyncedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RemoteUpdatedAt(); ok {
+		_spec.SetField(noteconnectionitemmap.FieldRemoteUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RemoteUpdatedAtCleared() {
+		_spec.ClearField(noteconnectionitemmap.FieldRemoteUpdatedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.MetadataJSON(); ok {
 		_spec.SetField(noteconnectionitemmap.FieldMetadataJSON, field.TypeString, value)
 	}
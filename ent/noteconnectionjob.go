@@ -23,7 +23,7 @@ type NoteConnectionJob struct {
 	ID int `json:"id,omitempty"`
 	// Provider holds the value of the "provider" field.
 	Provider string `json:"provider,omitempty"`
-	// import or push
+	// import, push or sync
 	Operation string `json:"operation,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID int `json:"user_id,omitempty"`
@@ -31,8 +31,16 @@ type NoteConnectionJob struct {
 	AccountID int `json:"account_id,omitempty"`
 	// NoteID holds the value of the "note_id" field.
 	NoteID *uuid.UUID `json:"note_id,omitempty"`
-	// pending, running, completed, completed_with_errors, failed
+	// previewed, pending, running, completed, completed_with_errors, failed, canceled
 	Status string `json:"status,omitempty"`
+	// manual, scheduled, or webhook
+	Trigger string `json:"trigger,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// MaxAttempts holds the value of the "max_attempts" field.
+	MaxAttempts int `json:"max_attempts,omitempty"`
+	// Earliest time a pending job may be picked up again after a failed attempt
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
 	// TotalCount holds the value of the "total_count" field.
 	TotalCount int `json:"total_count,omitempty"`
 	// ImportedCount holds the value of the "imported_count" field.
@@ -43,6 +51,8 @@ type NoteConnectionJob struct {
 	SkippedCount int `json:"skipped_count,omitempty"`
 	// FailedCount holds the value of the "failed_count" field.
 	FailedCount int `json:"failed_count,omitempty"`
+	// ConflictCount holds the value of the "conflict_count" field.
+	ConflictCount int `json:"conflict_count,omitempty"`
 	// Message holds the value of the "message" field.
 	Message string `json:"message,omitempty"`
 	// OptionsJSON holds the value of the "options_json" field.
@@ -114,11 +124,11 @@ func (*NoteConnectionJob) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case noteconnectionjob.FieldNoteID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case noteconnectionjob.FieldID, noteconnectionjob.FieldUserID, noteconnectionjob.FieldAccountID, noteconnectionjob.FieldTotalCount, noteconnectionjob.FieldImportedCount, noteconnectionjob.FieldPushedCount, noteconnectionjob.FieldSkippedCount, noteconnectionjob.FieldFailedCount:
+		case noteconnectionjob.FieldID, noteconnectionjob.FieldUserID, noteconnectionjob.FieldAccountID, noteconnectionjob.FieldAttempts, noteconnectionjob.FieldMaxAttempts, noteconnectionjob.FieldTotalCount, noteconnectionjob.FieldImportedCount, noteconnectionjob.FieldPushedCount, noteconnectionjob.FieldSkippedCount, noteconnectionjob.FieldFailedCount, noteconnectionjob.FieldConflictCount:
 			values[i] = new(sql.NullInt64)
-		case noteconnectionjob.FieldProvider, noteconnectionjob.FieldOperation, noteconnectionjob.FieldStatus, noteconnectionjob.FieldMessage, noteconnectionjob.FieldOptionsJSON:
+		case noteconnectionjob.FieldProvider, noteconnectionjob.FieldOperation, noteconnectionjob.FieldStatus, noteconnectionjob.FieldTrigger, noteconnectionjob.FieldMessage, noteconnectionjob.FieldOptionsJSON:
 			values[i] = new(sql.NullString)
-		case noteconnectionjob.FieldStartedAt, noteconnectionjob.FieldCompletedAt, noteconnectionjob.FieldCreatedAt, noteconnectionjob.FieldUpdatedAt:
+		case noteconnectionjob.FieldNextAttemptAt, noteconnectionjob.FieldStartedAt, noteconnectionjob.FieldCompletedAt, noteconnectionjob.FieldCreatedAt, noteconnectionjob.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -178,6 +188,31 @@ func (_m *NoteConnectionJob) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.Status = value.String
 			}
+		case noteconnectionjob.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				_m.Trigger = value.String
+			}
+		case noteconnectionjob.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case noteconnectionjob.FieldMaxAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_attempts", values[i])
+			} else if value.Valid {
+				_m.MaxAttempts = int(value.Int64)
+			}
+		case noteconnectionjob.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				_m.NextAttemptAt = new(time.Time)
+				*_m.NextAttemptAt = value.Time
+			}
 		case noteconnectionjob.FieldTotalCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field total_count", values[i])
@@ -208,6 +243,12 @@ func (_m *NoteConnectionJob) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.FailedCount = int(value.Int64)
 			}
+		case noteconnectionjob.FieldConflictCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field conflict_count", values[i])
+			} else if value.Valid {
+				_m.ConflictCount = int(value.Int64)
+			}
 		case noteconnectionjob.FieldMessage:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field message", values[i])
@@ -315,6 +356,20 @@ func (_m *NoteConnectionJob) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(_m.Status)
 	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(_m.Trigger)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("max_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxAttempts))
+	builder.WriteString(", ")
+	if v := _m.NextAttemptAt; v != nil {
+		builder.WriteString("next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("total_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TotalCount))
 	builder.WriteString(", ")
@@ -330,6 +385,9 @@ func (_m *NoteConnectionJob) String() string {
 	builder.WriteString("failed_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.FailedCount))
 	builder.WriteString(", ")
+	builder.WriteString("conflict_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConflictCount))
+	builder.WriteString(", ")
 	builder.WriteString("message=")
 	builder.WriteString(_m.Message)
 	builder.WriteString(", ")
@@ -26,6 +26,14 @@ const (
 	FieldNoteID = "note_id"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldTrigger holds the string denoting the trigger field in the database.
+	FieldTrigger = "trigger"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldMaxAttempts holds the string denoting the max_attempts field in the database.
+	FieldMaxAttempts = "max_attempts"
+	// FieldNextAttemptAt holds the string denoting the next_attempt_at field in the database.
+	FieldNextAttemptAt = "next_attempt_at"
 	// FieldTotalCount holds the string denoting the total_count field in the database.
 	FieldTotalCount = "total_count"
 	// FieldImportedCount holds the string denoting the imported_count field in the database.
@@ -36,6 +44,8 @@ const (
 	FieldSkippedCount = "skipped_count"
 	// FieldFailedCount holds the string denoting the failed_count field in the database.
 	FieldFailedCount = "failed_count"
+	// FieldConflictCount holds the string denoting the conflict_count field in the database.
+	FieldConflictCount = "conflict_count"
 	// FieldMessage holds the string denoting the message field in the database.
 	FieldMessage = "message"
 	// FieldOptionsJSON holds the string denoting the options_json field in the database.
@@ -88,11 +98,16 @@ var Columns = []string{
 	FieldAccountID,
 	FieldNoteID,
 	FieldStatus,
+	FieldTrigger,
+	FieldAttempts,
+	FieldMaxAttempts,
+	FieldNextAttemptAt,
 	FieldTotalCount,
 	FieldImportedCount,
 	FieldPushedCount,
 	FieldSkippedCount,
 	FieldFailedCount,
+	FieldConflictCount,
 	FieldMessage,
 	FieldOptionsJSON,
 	FieldStartedAt,
@@ -118,6 +133,12 @@ var (
 	OperationValidator func(string) error
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
+	// DefaultTrigger holds the default value on creation for the "trigger" field.
+	DefaultTrigger string
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultMaxAttempts holds the default value on creation for the "max_attempts" field.
+	DefaultMaxAttempts int
 	// DefaultTotalCount holds the default value on creation for the "total_count" field.
 	DefaultTotalCount int
 	// DefaultImportedCount holds the default value on creation for the "imported_count" field.
@@ -128,6 +149,8 @@ var (
 	DefaultSkippedCount int
 	// DefaultFailedCount holds the default value on creation for the "failed_count" field.
 	DefaultFailedCount int
+	// DefaultConflictCount holds the default value on creation for the "conflict_count" field.
+	DefaultConflictCount int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -174,6 +197,26 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByTrigger orders the results by the trigger field.
+func ByTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrigger, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByMaxAttempts orders the results by the max_attempts field.
+func ByMaxAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAttempts, opts...).ToFunc()
+}
+
+// ByNextAttemptAt orders the results by the next_attempt_at field.
+func ByNextAttemptAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextAttemptAt, opts...).ToFunc()
+}
+
 // ByTotalCount orders the results by the total_count field.
 func ByTotalCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotalCount, opts...).ToFunc()
@@ -199,6 +242,11 @@ func ByFailedCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFailedCount, opts...).ToFunc()
 }
 
+// ByConflictCount orders the results by the conflict_count field.
+func ByConflictCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConflictCount, opts...).ToFunc()
+}
+
 // ByMessage orders the results by the message field.
 func ByMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMessage, opts...).ToFunc()
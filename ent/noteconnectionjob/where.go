@@ -86,6 +86,26 @@ func Status(v string) predicate.NoteConnectionJob {
 	return predicate.NoteConnectionJob(sql.FieldEQ(FieldStatus, v))
 }
 
+// Trigger applies equality check predicate on the "trigger" field. It's identical to TriggerEQ.
+func Trigger(v string) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldEQ(FieldTrigger, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldEQ(FieldAttempts, v))
+}
+
+// MaxAttempts applies equality check predicate on the "max_attempts" field. It's identical to MaxAttemptsEQ.
+func MaxAttempts(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
 // TotalCount applies equality check predicate on the "total_count" field. It's identical to TotalCountEQ.
 func TotalCount(v int) predicate.NoteConnectionJob {
 	return predicate.NoteConnectionJob(sql.FieldEQ(FieldTotalCount, v))
@@ -111,6 +131,11 @@ func FailedCount(v int) predicate.NoteConnectionJob {
 	return predicate.NoteConnectionJob(sql.FieldEQ(FieldFailedCount, v))
 }
 
+// ConflictCount applies equality check predicate on the "conflict_count" field. It's identical to ConflictCountEQ.
+func ConflictCount(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldEQ(FieldConflictCount, v))
+}
+
 // Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
 func Message(v string) predicate.NoteConnectionJob {
 	return predicate.NoteConnectionJob(sql.FieldEQ(FieldMessage, v))
@@ -406,6 +431,201 @@ func StatusContainsFold(v string) predicate.NoteConnectionJob {
 	return predicate.NoteConnectionJob(sql.FieldContainsFold(FieldStatus, v))
 }
 
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v string) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v string) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...string) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...string) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// TriggerGT applies the GT predicate on the "trigger" field.
+func TriggerGT(v string) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldGT(FieldTrigger, v))
+}
+
+// TriggerGTE applies the GTE predicate on the "trigger" field.
+func TriggerGTE(v string) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldGTE(FieldTrigger, v))
+}
+
+// TriggerLT applies the LT predicate on the "trigger" field.
+func TriggerLT(v string) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldLT(FieldTrigger, v))
+}
+
+// TriggerLTE applies the LTE predicate on the "trigger" field.
+func TriggerLTE(v string) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldLTE(FieldTrigger, v))
+}
+
+// TriggerContains applies the Contains predicate on the "trigger" field.
+func TriggerContains(v string) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldContains(FieldTrigger, v))
+}
+
+// TriggerHasPrefix applies the HasPrefix predicate on the "trigger" field.
+func TriggerHasPrefix(v string) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldHasPrefix(FieldTrigger, v))
+}
+
+// TriggerHasSuffix applies the HasSuffix predicate on the "trigger" field.
+func TriggerHasSuffix(v string) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldHasSuffix(FieldTrigger, v))
+}
+
+// TriggerEqualFold applies the EqualFold predicate on the "trigger" field.
+func TriggerEqualFold(v string) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldEqualFold(FieldTrigger, v))
+}
+
+// TriggerContainsFold applies the ContainsFold predicate on the "trigger" field.
+func TriggerContainsFold(v string) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldContainsFold(FieldTrigger, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldLTE(FieldAttempts, v))
+}
+
+// MaxAttemptsEQ applies the EQ predicate on the "max_attempts" field.
+func MaxAttemptsEQ(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsNEQ applies the NEQ predicate on the "max_attempts" field.
+func MaxAttemptsNEQ(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldNEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsIn applies the In predicate on the "max_attempts" field.
+func MaxAttemptsIn(vs ...int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsNotIn applies the NotIn predicate on the "max_attempts" field.
+func MaxAttemptsNotIn(vs ...int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldNotIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsGT applies the GT predicate on the "max_attempts" field.
+func MaxAttemptsGT(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldGT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsGTE applies the GTE predicate on the "max_attempts" field.
+func MaxAttemptsGTE(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldGTE(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLT applies the LT predicate on the "max_attempts" field.
+func MaxAttemptsLT(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldLT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLTE applies the LTE predicate on the "max_attempts" field.
+func MaxAttemptsLTE(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldLTE(FieldMaxAttempts, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIsNil applies the IsNil predicate on the "next_attempt_at" field.
+func NextAttemptAtIsNil() predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldIsNull(FieldNextAttemptAt))
+}
+
+// NextAttemptAtNotNil applies the NotNil predicate on the "next_attempt_at" field.
+func NextAttemptAtNotNil() predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldNotNull(FieldNextAttemptAt))
+}
+
 // TotalCountEQ applies the EQ predicate on the "total_count" field.
 func TotalCountEQ(v int) predicate.NoteConnectionJob {
 	return predicate.NoteConnectionJob(sql.FieldEQ(FieldTotalCount, v))
@@ -606,6 +826,46 @@ func FailedCountLTE(v int) predicate.NoteConnectionJob {
 	return predicate.NoteConnectionJob(sql.FieldLTE(FieldFailedCount, v))
 }
 
+// ConflictCountEQ applies the EQ predicate on the "conflict_count" field.
+func ConflictCountEQ(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldEQ(FieldConflictCount, v))
+}
+
+// ConflictCountNEQ applies the NEQ predicate on the "conflict_count" field.
+func ConflictCountNEQ(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldNEQ(FieldConflictCount, v))
+}
+
+// ConflictCountIn applies the In predicate on the "conflict_count" field.
+func ConflictCountIn(vs ...int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldIn(FieldConflictCount, vs...))
+}
+
+// ConflictCountNotIn applies the NotIn predicate on the "conflict_count" field.
+func ConflictCountNotIn(vs ...int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldNotIn(FieldConflictCount, vs...))
+}
+
+// ConflictCountGT applies the GT predicate on the "conflict_count" field.
+func ConflictCountGT(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldGT(FieldConflictCount, v))
+}
+
+// ConflictCountGTE applies the GTE predicate on the "conflict_count" field.
+func ConflictCountGTE(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldGTE(FieldConflictCount, v))
+}
+
+// ConflictCountLT applies the LT predicate on the "conflict_count" field.
+func ConflictCountLT(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldLT(FieldConflictCount, v))
+}
+
+// ConflictCountLTE applies the LTE predicate on the "conflict_count" field.
+func ConflictCountLTE(v int) predicate.NoteConnectionJob {
+	return predicate.NoteConnectionJob(sql.FieldLTE(FieldConflictCount, v))
+}
+
 // MessageEQ applies the EQ predicate on the "message" field.
 func MessageEQ(v string) predicate.NoteConnectionJob {
 	return predicate.NoteConnectionJob(sql.FieldEQ(FieldMessage, v))
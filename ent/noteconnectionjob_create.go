@@ -76,6 +76,62 @@ func (_c *NoteConnectionJobCreate) SetNillableStatus(v *string) *NoteConnectionJ
 	return _c
 }
 
+// SetTrigger sets the "trigger" field.
+func (_c *NoteConnectionJobCreate) SetTrigger(v string) *NoteConnectionJobCreate {
+	_c.mutation.SetTrigger(v)
+	return _c
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_c *NoteConnectionJobCreate) SetNillableTrigger(v *string) *NoteConnectionJobCreate {
+	if v != nil {
+		_c.SetTrigger(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *NoteConnectionJobCreate) SetAttempts(v int) *NoteConnectionJobCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *NoteConnectionJobCreate) SetNillableAttempts(v *int) *NoteConnectionJobCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_c *NoteConnectionJobCreate) SetMaxAttempts(v int) *NoteConnectionJobCreate {
+	_c.mutation.SetMaxAttempts(v)
+	return _c
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_c *NoteConnectionJobCreate) SetNillableMaxAttempts(v *int) *NoteConnectionJobCreate {
+	if v != nil {
+		_c.SetMaxAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *NoteConnectionJobCreate) SetNextAttemptAt(v time.Time) *NoteConnectionJobCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *NoteConnectionJobCreate) SetNillableNextAttemptAt(v *time.Time) *NoteConnectionJobCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
 // SetTotalCount sets the "total_count" field.
 func (_c *NoteConnectionJobCreate) SetTotalCount(v int) *NoteConnectionJobCreate {
 	_c.mutation.SetTotalCount(v)
@@ -146,6 +202,20 @@ func (_c *NoteConnectionJobCreate) SetNillableFailedCount(v *int) *NoteConnectio
 	return _c
 }
 
+// SetConflictCount sets the "conflict_count" field.
+func (_c *NoteConnectionJobCreate) SetConflictCount(v int) *NoteConnectionJobCreate {
+	_c.mutation.SetConflictCount(v)
+	return _c
+}
+
+// SetNillableConflictCount sets the "conflict_count" field if the given value is not nil.
+func (_c *NoteConnectionJobCreate) SetNillableConflictCount(v *int) *NoteConnectionJobCreate {
+	if v != nil {
+		_c.SetConflictCount(*v)
+	}
+	return _c
+}
+
 // SetMessage sets the "message" field.
 func (_c *NoteConnectionJobCreate) SetMessage(v string) *NoteConnectionJobCreate {
 	_c.mutation.SetMessage(v)
@@ -284,6 +354,18 @@ func (_c *NoteConnectionJobCreate) defaults() {
 		v := noteconnectionjob.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.Trigger(); !ok {
+		v := noteconnectionjob.DefaultTrigger
+		_c.mutation.SetTrigger(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := noteconnectionjob.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.MaxAttempts(); !ok {
+		v := noteconnectionjob.DefaultMaxAttempts
+		_c.mutation.SetMaxAttempts(v)
+	}
 	if _, ok := _c.mutation.TotalCount(); !ok {
 		v := noteconnectionjob.DefaultTotalCount
 		_c.mutation.SetTotalCount(v)
@@ -304,6 +386,10 @@ func (_c *NoteConnectionJobCreate) defaults() {
 		v := noteconnectionjob.DefaultFailedCount
 		_c.mutation.SetFailedCount(v)
 	}
+	if _, ok := _c.mutation.ConflictCount(); !ok {
+		v := noteconnectionjob.DefaultConflictCount
+		_c.mutation.SetConflictCount(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := noteconnectionjob.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -341,6 +427,15 @@ func (_c *NoteConnectionJobCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "NoteConnectionJob.status"`)}
 	}
+	if _, ok := _c.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "NoteConnectionJob.trigger"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "NoteConnectionJob.attempts"`)}
+	}
+	if _, ok := _c.mutation.MaxAttempts(); !ok {
+		return &ValidationError{Name: "max_attempts", err: errors.New(`ent: missing required field "NoteConnectionJob.max_attempts"`)}
+	}
 	if _, ok := _c.mutation.TotalCount(); !ok {
 		return &ValidationError{Name: "total_count", err: errors.New(`ent: missing required field "NoteConnectionJob.total_count"`)}
 	}
@@ -356,6 +451,9 @@ func (_c *NoteConnectionJobCreate) check() error {
 	if _, ok := _c.mutation.FailedCount(); !ok {
 		return &ValidationError{Name: "failed_count", err: errors.New(`ent: missing required field "NoteConnectionJob.failed_count"`)}
 	}
+	if _, ok := _c.mutation.ConflictCount(); !ok {
+		return &ValidationError{Name: "conflict_count", err: errors.New(`ent: missing required field "NoteConnectionJob.conflict_count"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NoteConnectionJob.created_at"`)}
 	}
@@ -406,6 +504,22 @@ func (_c *NoteConnectionJobCreate) createSpec() (*NoteConnectionJob, *sqlgraph.C
 		_spec.SetField(noteconnectionjob.FieldStatus, field.TypeString, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.Trigger(); ok {
+		_spec.SetField(noteconnectionjob.FieldTrigger, field.TypeString, value)
+		_node.Trigger = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(noteconnectionjob.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.MaxAttempts(); ok {
+		_spec.SetField(noteconnectionjob.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(noteconnectionjob.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
 	if value, ok := _c.mutation.TotalCount(); ok {
 		_spec.SetField(noteconnectionjob.FieldTotalCount, field.TypeInt, value)
 		_node.TotalCount = value
@@ -426,6 +540,10 @@ func (_c *NoteConnectionJobCreate) createSpec() (*NoteConnectionJob, *sqlgraph.C
 		_spec.SetField(noteconnectionjob.FieldFailedCount, field.TypeInt, value)
 		_node.FailedCount = value
 	}
+	if value, ok := _c.mutation.ConflictCount(); ok {
+		_spec.SetField(noteconnectionjob.FieldConflictCount, field.TypeInt, value)
+		_node.ConflictCount = value
+	}
 	if value, ok := _c.mutation.Message(); ok {
 		_spec.SetField(noteconnectionjob.FieldMessage, field.TypeString, value)
 		_node.Message = value
@@ -122,6 +122,82 @@ func (_u *NoteConnectionJobUpdate) SetNillableStatus(v *string) *NoteConnectionJ
 	return _u
 }
 
+// SetTrigger sets the "trigger" field.
+func (_u *NoteConnectionJobUpdate) SetTrigger(v string) *NoteConnectionJobUpdate {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *NoteConnectionJobUpdate) SetNillableTrigger(v *string) *NoteConnectionJobUpdate {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *NoteConnectionJobUpdate) SetAttempts(v int) *NoteConnectionJobUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *NoteConnectionJobUpdate) SetNillableAttempts(v *int) *NoteConnectionJobUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *NoteConnectionJobUpdate) AddAttempts(v int) *NoteConnectionJobUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *NoteConnectionJobUpdate) SetMaxAttempts(v int) *NoteConnectionJobUpdate {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *NoteConnectionJobUpdate) SetNillableMaxAttempts(v *int) *NoteConnectionJobUpdate {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *NoteConnectionJobUpdate) AddMaxAttempts(v int) *NoteConnectionJobUpdate {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *NoteConnectionJobUpdate) SetNextAttemptAt(v time.Time) *NoteConnectionJobUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *NoteConnectionJobUpdate) SetNillableNextAttemptAt(v *time.Time) *NoteConnectionJobUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *NoteConnectionJobUpdate) ClearNextAttemptAt() *NoteConnectionJobUpdate {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
 // SetTotalCount sets the "total_count" field.
 func (_u *NoteConnectionJobUpdate) SetTotalCount(v int) *NoteConnectionJobUpdate {
 	_u.mutation.ResetTotalCount()
@@ -227,6 +303,27 @@ func (_u *NoteConnectionJobUpdate) AddFailedCount(v int) *NoteConnectionJobUpdat
 	return _u
 }
 
+// SetConflictCount sets the "conflict_count" field.
+func (_u *NoteConnectionJobUpdate) SetConflictCount(v int) *NoteConnectionJobUpdate {
+	_u.mutation.ResetConflictCount()
+	_u.mutation.SetConflictCount(v)
+	return _u
+}
+
+// SetNillableConflictCount sets the "conflict_count" field if the given value is not nil.
+func (_u *NoteConnectionJobUpdate) SetNillableConflictCount(v *int) *NoteConnectionJobUpdate {
+	if v != nil {
+		_u.SetConflictCount(*v)
+	}
+	return _u
+}
+
+// AddConflictCount adds value to the "conflict_count" field.
+func (_u *NoteConnectionJobUpdate) AddConflictCount(v int) *NoteConnectionJobUpdate {
+	_u.mutation.AddConflictCount(v)
+	return _u
+}
+
 // SetMessage sets the "message" field.
 func (_u *NoteConnectionJobUpdate) SetMessage(v string) *NoteConnectionJobUpdate {
 	_u.mutation.SetMessage(v)
@@ -429,6 +526,27 @@ func (_u *NoteConnectionJobUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(noteconnectionjob.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(noteconnectionjob.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(noteconnectionjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(noteconnectionjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(noteconnectionjob.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(noteconnectionjob.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(noteconnectionjob.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(noteconnectionjob.FieldNextAttemptAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.TotalCount(); ok {
 		_spec.SetField(noteconnectionjob.FieldTotalCount, field.TypeInt, value)
 	}
@@ -459,6 +577,12 @@ func (_u *NoteConnectionJobUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.AddedFailedCount(); ok {
 		_spec.AddField(noteconnectionjob.FieldFailedCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ConflictCount(); ok {
+		_spec.SetField(noteconnectionjob.FieldConflictCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConflictCount(); ok {
+		_spec.AddField(noteconnectionjob.FieldConflictCount, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Message(); ok {
 		_spec.SetField(noteconnectionjob.FieldMessage, field.TypeString, value)
 	}
@@ -683,6 +807,82 @@ func (_u *NoteConnectionJobUpdateOne) SetNillableStatus(v *string) *NoteConnecti
 	return _u
 }
 
+// SetTrigger sets the "trigger" field.
+func (_u *NoteConnectionJobUpdateOne) SetTrigger(v string) *NoteConnectionJobUpdateOne {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *NoteConnectionJobUpdateOne) SetNillableTrigger(v *string) *NoteConnectionJobUpdateOne {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *NoteConnectionJobUpdateOne) SetAttempts(v int) *NoteConnectionJobUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *NoteConnectionJobUpdateOne) SetNillableAttempts(v *int) *NoteConnectionJobUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *NoteConnectionJobUpdateOne) AddAttempts(v int) *NoteConnectionJobUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *NoteConnectionJobUpdateOne) SetMaxAttempts(v int) *NoteConnectionJobUpdateOne {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *NoteConnectionJobUpdateOne) SetNillableMaxAttempts(v *int) *NoteConnectionJobUpdateOne {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *NoteConnectionJobUpdateOne) AddMaxAttempts(v int) *NoteConnectionJobUpdateOne {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *NoteConnectionJobUpdateOne) SetNextAttemptAt(v time.Time) *NoteConnectionJobUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *NoteConnectionJobUpdateOne) SetNillableNextAttemptAt(v *time.Time) *NoteConnectionJobUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *NoteConnectionJobUpdateOne) ClearNextAttemptAt() *NoteConnectionJobUpdateOne {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
 // SetTotalCount sets the "total_count" field.
 func (_u *NoteConnectionJobUpdateOne) SetTotalCount(v int) *NoteConnectionJobUpdateOne {
 	_u.mutation.ResetTotalCount()
@@ -788,6 +988,27 @@ func (_u *NoteConnectionJobUpdateOne) AddFailedCount(v int) *NoteConnectionJobUp
 	return _u
 }
 
+// SetConflictCount sets the "conflict_count" field.
+func (_u *NoteConnectionJobUpdateOne) SetConflictCount(v int) *NoteConnectionJobUpdateOne {
+	_u.mutation.ResetConflictCount()
+	_u.mutation.SetConflictCount(v)
+	return _u
+}
+
+// SetNillableConflictCount sets the "conflict_count" field if the given value is not nil.
+func (_u *NoteConnectionJobUpdateOne) SetNillableConflictCount(v *int) *NoteConnectionJobUpdateOne {
+	if v != nil {
+		_u.SetConflictCount(*v)
+	}
+	return _u
+}
+
+// AddConflictCount adds value to the "conflict_count" field.
+func (_u *NoteConnectionJobUpdateOne) AddConflictCount(v int) *NoteConnectionJobUpdateOne {
+	_u.mutation.AddConflictCount(v)
+	return _u
+}
+
 // SetMessage sets the "message" field.
 func (_u *NoteConnectionJobUpdateOne) SetMessage(v string) *NoteConnectionJobUpdateOne {
 	_u.mutation.SetMessage(v)
@@ -1020,6 +1241,27 @@ func (_u *NoteConnectionJobUpdateOne) sqlSave(ctx context.Context) (_node *NoteC
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(noteconnectionjob.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(noteconnectionjob.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(noteconnectionjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(noteconnectionjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(noteconnectionjob.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(noteconnectionjob.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(noteconnectionjob.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(noteconnectionjob.FieldNextAttemptAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.TotalCount(); ok {
 		_spec.SetField(noteconnectionjob.FieldTotalCount, field.TypeInt, value)
 	}
@@ -1050,6 +1292,12 @@ func (_u *NoteConnectionJobUpdateOne) sqlSave(ctx context.Context) (_node *NoteC
 	if value, ok := _u.mutation.AddedFailedCount(); ok {
 		_spec.AddField(noteconnectionjob.FieldFailedCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ConflictCount(); ok {
+		_spec.SetField(noteconnectionjob.FieldConflictCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConflictCount(); ok {
+		_spec.AddField(noteconnectionjob.FieldConflictCount, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Message(); ok {
 		_spec.SetField(noteconnectionjob.FieldMessage, field.TypeString, value)
 	}
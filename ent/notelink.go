@@ -36,6 +36,10 @@ type NoteLink struct {
 	DisplayText string `json:"display_text,omitempty"`
 	// LinkType holds the value of the "link_type" field.
 	LinkType notelink.LinkType `json:"link_type,omitempty"`
+	// AnchorType holds the value of the "anchor_type" field.
+	AnchorType notelink.AnchorType `json:"anchor_type,omitempty"`
+	// Anchor holds the value of the "anchor" field.
+	Anchor string `json:"anchor,omitempty"`
 	// OccurrenceCount holds the value of the "occurrence_count" field.
 	OccurrenceCount int `json:"occurrence_count,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -103,7 +107,7 @@ func (*NoteLink) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case notelink.FieldUserID, notelink.FieldOccurrenceCount:
 			values[i] = new(sql.NullInt64)
-		case notelink.FieldTargetRef, notelink.FieldTargetRefNorm, notelink.FieldTargetKey, notelink.FieldDisplayText, notelink.FieldLinkType:
+		case notelink.FieldTargetRef, notelink.FieldTargetRefNorm, notelink.FieldTargetKey, notelink.FieldDisplayText, notelink.FieldLinkType, notelink.FieldAnchorType, notelink.FieldAnchor:
 			values[i] = new(sql.NullString)
 		case notelink.FieldCreatedAt, notelink.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -179,6 +183,18 @@ func (_m *NoteLink) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.LinkType = notelink.LinkType(value.String)
 			}
+		case notelink.FieldAnchorType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field anchor_type", values[i])
+			} else if value.Valid {
+				_m.AnchorType = notelink.AnchorType(value.String)
+			}
+		case notelink.FieldAnchor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field anchor", values[i])
+			} else if value.Valid {
+				_m.Anchor = value.String
+			}
 		case notelink.FieldOccurrenceCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field occurrence_count", values[i])
@@ -274,6 +290,12 @@ func (_m *NoteLink) String() string {
 	builder.WriteString("link_type=")
 	builder.WriteString(fmt.Sprintf("%v", _m.LinkType))
 	builder.WriteString(", ")
+	builder.WriteString("anchor_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AnchorType))
+	builder.WriteString(", ")
+	builder.WriteString("anchor=")
+	builder.WriteString(_m.Anchor)
+	builder.WriteString(", ")
 	builder.WriteString("occurrence_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.OccurrenceCount))
 	builder.WriteString(", ")
@@ -32,6 +32,10 @@ const (
 	FieldDisplayText = "display_text"
 	// FieldLinkType holds the string denoting the link_type field in the database.
 	FieldLinkType = "link_type"
+	// FieldAnchorType holds the string denoting the anchor_type field in the database.
+	FieldAnchorType = "anchor_type"
+	// FieldAnchor holds the string denoting the anchor field in the database.
+	FieldAnchor = "anchor"
 	// FieldOccurrenceCount holds the string denoting the occurrence_count field in the database.
 	FieldOccurrenceCount = "occurrence_count"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -80,6 +84,8 @@ var Columns = []string{
 	FieldTargetKey,
 	FieldDisplayText,
 	FieldLinkType,
+	FieldAnchorType,
+	FieldAnchor,
 	FieldOccurrenceCount,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -96,6 +102,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultAnchor holds the default value on creation for the "anchor" field.
+	DefaultAnchor string
 	// DefaultOccurrenceCount holds the default value on creation for the "occurrence_count" field.
 	DefaultOccurrenceCount int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -133,6 +141,33 @@ func LinkTypeValidator(lt LinkType) error {
 	}
 }
 
+// AnchorType defines the type for the "anchor_type" enum field.
+type AnchorType string
+
+// AnchorTypeNone is the default value of the AnchorType enum.
+const DefaultAnchorType = AnchorTypeNone
+
+// AnchorType values.
+const (
+	AnchorTypeNone    AnchorType = "none"
+	AnchorTypeHeading AnchorType = "heading"
+	AnchorTypeBlock   AnchorType = "block"
+)
+
+func (at AnchorType) String() string {
+	return string(at)
+}
+
+// AnchorTypeValidator is a validator for the "anchor_type" field enum values. It is called by the builders before save.
+func AnchorTypeValidator(at AnchorType) error {
+	switch at {
+	case AnchorTypeNone, AnchorTypeHeading, AnchorTypeBlock:
+		return nil
+	default:
+		return fmt.Errorf("notelink: invalid enum value for anchor_type field: %q", at)
+	}
+}
+
 // OrderOption defines the ordering options for the NoteLink queries.
 type OrderOption func(*sql.Selector)
 
@@ -181,6 +216,16 @@ func ByLinkType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLinkType, opts...).ToFunc()
 }
 
+// ByAnchorType orders the results by the anchor_type field.
+func ByAnchorType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnchorType, opts...).ToFunc()
+}
+
+// ByAnchor orders the results by the anchor field.
+func ByAnchor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnchor, opts...).ToFunc()
+}
+
 // ByOccurrenceCount orders the results by the occurrence_count field.
 func ByOccurrenceCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOccurrenceCount, opts...).ToFunc()
@@ -91,6 +91,11 @@ func DisplayText(v string) predicate.NoteLink {
 	return predicate.NoteLink(sql.FieldEQ(FieldDisplayText, v))
 }
 
+// Anchor applies equality check predicate on the "anchor" field. It's identical to AnchorEQ.
+func Anchor(v string) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldEQ(FieldAnchor, v))
+}
+
 // OccurrenceCount applies equality check predicate on the "occurrence_count" field. It's identical to OccurrenceCountEQ.
 func OccurrenceCount(v int) predicate.NoteLink {
 	return predicate.NoteLink(sql.FieldEQ(FieldOccurrenceCount, v))
@@ -456,6 +461,91 @@ func LinkTypeNotIn(vs ...LinkType) predicate.NoteLink {
 	return predicate.NoteLink(sql.FieldNotIn(FieldLinkType, vs...))
 }
 
+// AnchorTypeEQ applies the EQ predicate on the "anchor_type" field.
+func AnchorTypeEQ(v AnchorType) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldEQ(FieldAnchorType, v))
+}
+
+// AnchorTypeNEQ applies the NEQ predicate on the "anchor_type" field.
+func AnchorTypeNEQ(v AnchorType) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldNEQ(FieldAnchorType, v))
+}
+
+// AnchorTypeIn applies the In predicate on the "anchor_type" field.
+func AnchorTypeIn(vs ...AnchorType) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldIn(FieldAnchorType, vs...))
+}
+
+// AnchorTypeNotIn applies the NotIn predicate on the "anchor_type" field.
+func AnchorTypeNotIn(vs ...AnchorType) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldNotIn(FieldAnchorType, vs...))
+}
+
+// AnchorEQ applies the EQ predicate on the "anchor" field.
+func AnchorEQ(v string) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldEQ(FieldAnchor, v))
+}
+
+// AnchorNEQ applies the NEQ predicate on the "anchor" field.
+func AnchorNEQ(v string) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldNEQ(FieldAnchor, v))
+}
+
+// AnchorIn applies the In predicate on the "anchor" field.
+func AnchorIn(vs ...string) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldIn(FieldAnchor, vs...))
+}
+
+// AnchorNotIn applies the NotIn predicate on the "anchor" field.
+func AnchorNotIn(vs ...string) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldNotIn(FieldAnchor, vs...))
+}
+
+// AnchorGT applies the GT predicate on the "anchor" field.
+func AnchorGT(v string) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldGT(FieldAnchor, v))
+}
+
+// AnchorGTE applies the GTE predicate on the "anchor" field.
+func AnchorGTE(v string) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldGTE(FieldAnchor, v))
+}
+
+// AnchorLT applies the LT predicate on the "anchor" field.
+func AnchorLT(v string) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldLT(FieldAnchor, v))
+}
+
+// AnchorLTE applies the LTE predicate on the "anchor" field.
+func AnchorLTE(v string) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldLTE(FieldAnchor, v))
+}
+
+// AnchorContains applies the Contains predicate on the "anchor" field.
+func AnchorContains(v string) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldContains(FieldAnchor, v))
+}
+
+// AnchorHasPrefix applies the HasPrefix predicate on the "anchor" field.
+func AnchorHasPrefix(v string) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldHasPrefix(FieldAnchor, v))
+}
+
+// AnchorHasSuffix applies the HasSuffix predicate on the "anchor" field.
+func AnchorHasSuffix(v string) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldHasSuffix(FieldAnchor, v))
+}
+
+// AnchorEqualFold applies the EqualFold predicate on the "anchor" field.
+func AnchorEqualFold(v string) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldEqualFold(FieldAnchor, v))
+}
+
+// AnchorContainsFold applies the ContainsFold predicate on the "anchor" field.
+func AnchorContainsFold(v string) predicate.NoteLink {
+	return predicate.NoteLink(sql.FieldContainsFold(FieldAnchor, v))
+}
+
 // OccurrenceCountEQ applies the EQ predicate on the "occurrence_count" field.
 func OccurrenceCountEQ(v int) predicate.NoteLink {
 	return predicate.NoteLink(sql.FieldEQ(FieldOccurrenceCount, v))
@@ -87,6 +87,34 @@ func (_c *NoteLinkCreate) SetNillableLinkType(v *notelink.LinkType) *NoteLinkCre
 	return _c
 }
 
+// SetAnchorType sets the "anchor_type" field.
+func (_c *NoteLinkCreate) SetAnchorType(v notelink.AnchorType) *NoteLinkCreate {
+	_c.mutation.SetAnchorType(v)
+	return _c
+}
+
+// SetNillableAnchorType sets the "anchor_type" field if the given value is not nil.
+func (_c *NoteLinkCreate) SetNillableAnchorType(v *notelink.AnchorType) *NoteLinkCreate {
+	if v != nil {
+		_c.SetAnchorType(*v)
+	}
+	return _c
+}
+
+// SetAnchor sets the "anchor" field.
+func (_c *NoteLinkCreate) SetAnchor(v string) *NoteLinkCreate {
+	_c.mutation.SetAnchor(v)
+	return _c
+}
+
+// SetNillableAnchor sets the "anchor" field if the given value is not nil.
+func (_c *NoteLinkCreate) SetNillableAnchor(v *string) *NoteLinkCreate {
+	if v != nil {
+		_c.SetAnchor(*v)
+	}
+	return _c
+}
+
 // SetOccurrenceCount sets the "occurrence_count" field.
 func (_c *NoteLinkCreate) SetOccurrenceCount(v int) *NoteLinkCreate {
 	_c.mutation.SetOccurrenceCount(v)
@@ -197,6 +225,14 @@ func (_c *NoteLinkCreate) defaults() {
 		v := notelink.DefaultLinkType
 		_c.mutation.SetLinkType(v)
 	}
+	if _, ok := _c.mutation.AnchorType(); !ok {
+		v := notelink.DefaultAnchorType
+		_c.mutation.SetAnchorType(v)
+	}
+	if _, ok := _c.mutation.Anchor(); !ok {
+		v := notelink.DefaultAnchor
+		_c.mutation.SetAnchor(v)
+	}
 	if _, ok := _c.mutation.OccurrenceCount(); !ok {
 		v := notelink.DefaultOccurrenceCount
 		_c.mutation.SetOccurrenceCount(v)
@@ -243,6 +279,17 @@ func (_c *NoteLinkCreate) check() error {
 			return &ValidationError{Name: "link_type", err: fmt.Errorf(`ent: validator failed for field "NoteLink.link_type": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.AnchorType(); !ok {
+		return &ValidationError{Name: "anchor_type", err: errors.New(`ent: missing required field "NoteLink.anchor_type"`)}
+	}
+	if v, ok := _c.mutation.AnchorType(); ok {
+		if err := notelink.AnchorTypeValidator(v); err != nil {
+			return &ValidationError{Name: "anchor_type", err: fmt.Errorf(`ent: validator failed for field "NoteLink.anchor_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Anchor(); !ok {
+		return &ValidationError{Name: "anchor", err: errors.New(`ent: missing required field "NoteLink.anchor"`)}
+	}
 	if _, ok := _c.mutation.OccurrenceCount(); !ok {
 		return &ValidationError{Name: "occurrence_count", err: errors.New(`ent: missing required field "NoteLink.occurrence_count"`)}
 	}
@@ -313,6 +360,14 @@ func (_c *NoteLinkCreate) createSpec() (*NoteLink, *sqlgraph.CreateSpec) {
 		_spec.SetField(notelink.FieldLinkType, field.TypeEnum, value)
 		_node.LinkType = value
 	}
+	if value, ok := _c.mutation.AnchorType(); ok {
+		_spec.SetField(notelink.FieldAnchorType, field.TypeEnum, value)
+		_node.AnchorType = value
+	}
+	if value, ok := _c.mutation.Anchor(); ok {
+		_spec.SetField(notelink.FieldAnchor, field.TypeString, value)
+		_node.Anchor = value
+	}
 	if value, ok := _c.mutation.OccurrenceCount(); ok {
 		_spec.SetField(notelink.FieldOccurrenceCount, field.TypeInt, value)
 		_node.OccurrenceCount = value
@@ -149,6 +149,34 @@ func (_u *NoteLinkUpdate) SetNillableLinkType(v *notelink.LinkType) *NoteLinkUpd
 	return _u
 }
 
+// SetAnchorType sets the "anchor_type" field.
+func (_u *NoteLinkUpdate) SetAnchorType(v notelink.AnchorType) *NoteLinkUpdate {
+	_u.mutation.SetAnchorType(v)
+	return _u
+}
+
+// SetNillableAnchorType sets the "anchor_type" field if the given value is not nil.
+func (_u *NoteLinkUpdate) SetNillableAnchorType(v *notelink.AnchorType) *NoteLinkUpdate {
+	if v != nil {
+		_u.SetAnchorType(*v)
+	}
+	return _u
+}
+
+// SetAnchor sets the "anchor" field.
+func (_u *NoteLinkUpdate) SetAnchor(v string) *NoteLinkUpdate {
+	_u.mutation.SetAnchor(v)
+	return _u
+}
+
+// SetNillableAnchor sets the "anchor" field if the given value is not nil.
+func (_u *NoteLinkUpdate) SetNillableAnchor(v *string) *NoteLinkUpdate {
+	if v != nil {
+		_u.SetAnchor(*v)
+	}
+	return _u
+}
+
 // SetOccurrenceCount sets the "occurrence_count" field.
 func (_u *NoteLinkUpdate) SetOccurrenceCount(v int) *NoteLinkUpdate {
 	_u.mutation.ResetOccurrenceCount()
@@ -257,6 +285,11 @@ func (_u *NoteLinkUpdate) check() error {
 			return &ValidationError{Name: "link_type", err: fmt.Errorf(`ent: validator failed for field "NoteLink.link_type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AnchorType(); ok {
+		if err := notelink.AnchorTypeValidator(v); err != nil {
+			return &ValidationError{Name: "anchor_type", err: fmt.Errorf(`ent: validator failed for field "NoteLink.anchor_type": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "NoteLink.user"`)
 	}
@@ -293,6 +326,12 @@ func (_u *NoteLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.LinkType(); ok {
 		_spec.SetField(notelink.FieldLinkType, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.AnchorType(); ok {
+		_spec.SetField(notelink.FieldAnchorType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Anchor(); ok {
+		_spec.SetField(notelink.FieldAnchor, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.OccurrenceCount(); ok {
 		_spec.SetField(notelink.FieldOccurrenceCount, field.TypeInt, value)
 	}
@@ -527,6 +566,34 @@ func (_u *NoteLinkUpdateOne) SetNillableLinkType(v *notelink.LinkType) *NoteLink
 	return _u
 }
 
+// SetAnchorType sets the "anchor_type" field.
+func (_u *NoteLinkUpdateOne) SetAnchorType(v notelink.AnchorType) *NoteLinkUpdateOne {
+	_u.mutation.SetAnchorType(v)
+	return _u
+}
+
+// SetNillableAnchorType sets the "anchor_type" field if the given value is not nil.
+func (_u *NoteLinkUpdateOne) SetNillableAnchorType(v *notelink.AnchorType) *NoteLinkUpdateOne {
+	if v != nil {
+		_u.SetAnchorType(*v)
+	}
+	return _u
+}
+
+// SetAnchor sets the "anchor" field.
+func (_u *NoteLinkUpdateOne) SetAnchor(v string) *NoteLinkUpdateOne {
+	_u.mutation.SetAnchor(v)
+	return _u
+}
+
+// SetNillableAnchor sets the "anchor" field if the given value is not nil.
+func (_u *NoteLinkUpdateOne) SetNillableAnchor(v *string) *NoteLinkUpdateOne {
+	if v != nil {
+		_u.SetAnchor(*v)
+	}
+	return _u
+}
+
 // SetOccurrenceCount sets the "occurrence_count" field.
 func (_u *NoteLinkUpdateOne) SetOccurrenceCount(v int) *NoteLinkUpdateOne {
 	_u.mutation.ResetOccurrenceCount()
@@ -648,6 +715,11 @@ func (_u *NoteLinkUpdateOne) check() error {
 			return &ValidationError{Name: "link_type", err: fmt.Errorf(`ent: validator failed for field "NoteLink.link_type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AnchorType(); ok {
+		if err := notelink.AnchorTypeValidator(v); err != nil {
+			return &ValidationError{Name: "anchor_type", err: fmt.Errorf(`ent: validator failed for field "NoteLink.anchor_type": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "NoteLink.user"`)
 	}
@@ -701,6 +773,12 @@ func (_u *NoteLinkUpdateOne) sqlSave(ctx context.Context) (_node *NoteLink, err
 	if value, ok := _u.mutation.LinkType(); ok {
 		_spec.SetField(notelink.FieldLinkType, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.AnchorType(); ok {
+		_spec.SetField(notelink.FieldAnchorType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Anchor(); ok {
+		_spec.SetField(notelink.FieldAnchor, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.OccurrenceCount(); ok {
 		_spec.SetField(notelink.FieldOccurrenceCount, field.TypeInt, value)
 	}
@@ -12,6 +12,15 @@ type Attachment func(*sql.Selector)
 // BackupConfig is the predicate function for backupconfig builders.
 type BackupConfig func(*sql.Selector)
 
+// BackupDrill is the predicate function for backupdrill builders.
+type BackupDrill func(*sql.Selector)
+
+// BackupPin is the predicate function for backuppin builders.
+type BackupPin func(*sql.Selector)
+
+// BackupRun is the predicate function for backuprun builders.
+type BackupRun func(*sql.Selector)
+
 // BackupTarget is the predicate function for backuptarget builders.
 type BackupTarget func(*sql.Selector)
 
@@ -33,6 +42,9 @@ type ImportItem func(*sql.Selector)
 // ImportJob is the predicate function for importjob builders.
 type ImportJob func(*sql.Selector)
 
+// LinkRewrite is the predicate function for linkrewrite builders.
+type LinkRewrite func(*sql.Selector)
+
 // MCPImage is the predicate function for mcpimage builders.
 type MCPImage func(*sql.Selector)
 
@@ -45,6 +57,9 @@ type Note func(*sql.Selector)
 // NoteConnectionAccount is the predicate function for noteconnectionaccount builders.
 type NoteConnectionAccount func(*sql.Selector)
 
+// NoteConnectionConflict is the predicate function for noteconnectionconflict builders.
+type NoteConnectionConflict func(*sql.Selector)
+
 // NoteConnectionItemMap is the predicate function for noteconnectionitemmap builders.
 type NoteConnectionItemMap func(*sql.Selector)
 
@@ -5,6 +5,9 @@ package ent
 import (
 	"smarticky/ent/attachment"
 	"smarticky/ent/backupconfig"
+	"smarticky/ent/backupdrill"
+	"smarticky/ent/backuppin"
+	"smarticky/ent/backuprun"
 	"smarticky/ent/backuptarget"
 	"smarticky/ent/backuptask"
 	"smarticky/ent/excalidrawlibrary"
@@ -12,10 +15,12 @@ import (
 	"smarticky/ent/font"
 	"smarticky/ent/importitem"
 	"smarticky/ent/importjob"
+	"smarticky/ent/linkrewrite"
 	"smarticky/ent/mcpimage"
 	"smarticky/ent/mcptoken"
 	"smarticky/ent/note"
 	"smarticky/ent/noteconnectionaccount"
+	"smarticky/ent/noteconnectionconflict"
 	"smarticky/ent/noteconnectionitemmap"
 	"smarticky/ent/noteconnectionjob"
 	"smarticky/ent/notelink"
@@ -76,16 +81,66 @@ func init() {
 	backupconfigDescBackupTargetsMigrated := backupconfigFields[13].Descriptor()
 	// backupconfig.DefaultBackupTargetsMigrated holds the default value on creation for the backup_targets_migrated field.
 	backupconfig.DefaultBackupTargetsMigrated = backupconfigDescBackupTargetsMigrated.Default.(bool)
+	// backupconfigDescAlertSMTPPort is the schema descriptor for alert_smtp_port field.
+	backupconfigDescAlertSMTPPort := backupconfigFields[16].Descriptor()
+	// backupconfig.DefaultAlertSMTPPort holds the default value on creation for the alert_smtp_port field.
+	backupconfig.DefaultAlertSMTPPort = backupconfigDescAlertSMTPPort.Default.(int)
+	// backupconfigDescAlertOnFailure is the schema descriptor for alert_on_failure field.
+	backupconfigDescAlertOnFailure := backupconfigFields[19].Descriptor()
+	// backupconfig.DefaultAlertOnFailure holds the default value on creation for the alert_on_failure field.
+	backupconfig.DefaultAlertOnFailure = backupconfigDescAlertOnFailure.Default.(bool)
 	// backupconfigDescCreatedAt is the schema descriptor for created_at field.
-	backupconfigDescCreatedAt := backupconfigFields[15].Descriptor()
+	backupconfigDescCreatedAt := backupconfigFields[21].Descriptor()
 	// backupconfig.DefaultCreatedAt holds the default value on creation for the created_at field.
 	backupconfig.DefaultCreatedAt = backupconfigDescCreatedAt.Default.(func() time.Time)
 	// backupconfigDescUpdatedAt is the schema descriptor for updated_at field.
-	backupconfigDescUpdatedAt := backupconfigFields[16].Descriptor()
+	backupconfigDescUpdatedAt := backupconfigFields[22].Descriptor()
 	// backupconfig.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	backupconfig.DefaultUpdatedAt = backupconfigDescUpdatedAt.Default.(func() time.Time)
 	// backupconfig.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	backupconfig.UpdateDefaultUpdatedAt = backupconfigDescUpdatedAt.UpdateDefault.(func() time.Time)
+	backupdrillFields := schema.BackupDrill{}.Fields()
+	_ = backupdrillFields
+	// backupdrillDescTrigger is the schema descriptor for trigger field.
+	backupdrillDescTrigger := backupdrillFields[2].Descriptor()
+	// backupdrill.DefaultTrigger holds the default value on creation for the trigger field.
+	backupdrill.DefaultTrigger = backupdrillDescTrigger.Default.(string)
+	// backupdrillDescStartedAt is the schema descriptor for started_at field.
+	backupdrillDescStartedAt := backupdrillFields[7].Descriptor()
+	// backupdrill.DefaultStartedAt holds the default value on creation for the started_at field.
+	backupdrill.DefaultStartedAt = backupdrillDescStartedAt.Default.(func() time.Time)
+	// backupdrillDescDurationMs is the schema descriptor for duration_ms field.
+	backupdrillDescDurationMs := backupdrillFields[9].Descriptor()
+	// backupdrill.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	backupdrill.DefaultDurationMs = backupdrillDescDurationMs.Default.(int64)
+	backuppinFields := schema.BackupPin{}.Fields()
+	_ = backuppinFields
+	// backuppinDescFilename is the schema descriptor for filename field.
+	backuppinDescFilename := backuppinFields[1].Descriptor()
+	// backuppin.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	backuppin.FilenameValidator = backuppinDescFilename.Validators[0].(func(string) error)
+	// backuppinDescCreatedAt is the schema descriptor for created_at field.
+	backuppinDescCreatedAt := backuppinFields[3].Descriptor()
+	// backuppin.DefaultCreatedAt holds the default value on creation for the created_at field.
+	backuppin.DefaultCreatedAt = backuppinDescCreatedAt.Default.(func() time.Time)
+	backuprunFields := schema.BackupRun{}.Fields()
+	_ = backuprunFields
+	// backuprunDescTrigger is the schema descriptor for trigger field.
+	backuprunDescTrigger := backuprunFields[2].Descriptor()
+	// backuprun.DefaultTrigger holds the default value on creation for the trigger field.
+	backuprun.DefaultTrigger = backuprunDescTrigger.Default.(string)
+	// backuprunDescBytes is the schema descriptor for bytes field.
+	backuprunDescBytes := backuprunFields[5].Descriptor()
+	// backuprun.DefaultBytes holds the default value on creation for the bytes field.
+	backuprun.DefaultBytes = backuprunDescBytes.Default.(int64)
+	// backuprunDescStartedAt is the schema descriptor for started_at field.
+	backuprunDescStartedAt := backuprunFields[7].Descriptor()
+	// backuprun.DefaultStartedAt holds the default value on creation for the started_at field.
+	backuprun.DefaultStartedAt = backuprunDescStartedAt.Default.(func() time.Time)
+	// backuprunDescDurationMs is the schema descriptor for duration_ms field.
+	backuprunDescDurationMs := backuprunFields[9].Descriptor()
+	// backuprun.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	backuprun.DefaultDurationMs = backuprunDescDurationMs.Default.(int64)
 	backuptargetFields := schema.BackupTarget{}.Fields()
 	_ = backuptargetFields
 	// backuptargetDescName is the schema descriptor for name field.
@@ -108,12 +163,24 @@ func init() {
 	backuptargetDescLastTestStatus := backuptargetFields[6].Descriptor()
 	// backuptarget.DefaultLastTestStatus holds the default value on creation for the last_test_status field.
 	backuptarget.DefaultLastTestStatus = backuptargetDescLastTestStatus.Default.(string)
+	// backuptargetDescSftpPort is the schema descriptor for sftp_port field.
+	backuptargetDescSftpPort := backuptargetFields[19].Descriptor()
+	// backuptarget.DefaultSftpPort holds the default value on creation for the sftp_port field.
+	backuptarget.DefaultSftpPort = backuptargetDescSftpPort.Default.(int)
+	// backuptargetDescAlertAfterHours is the schema descriptor for alert_after_hours field.
+	backuptargetDescAlertAfterHours := backuptargetFields[25].Descriptor()
+	// backuptarget.DefaultAlertAfterHours holds the default value on creation for the alert_after_hours field.
+	backuptarget.DefaultAlertAfterHours = backuptargetDescAlertAfterHours.Default.(int)
+	// backuptargetDescDrillIntervalDays is the schema descriptor for drill_interval_days field.
+	backuptargetDescDrillIntervalDays := backuptargetFields[26].Descriptor()
+	// backuptarget.DefaultDrillIntervalDays holds the default value on creation for the drill_interval_days field.
+	backuptarget.DefaultDrillIntervalDays = backuptargetDescDrillIntervalDays.Default.(int)
 	// backuptargetDescCreatedAt is the schema descriptor for created_at field.
-	backuptargetDescCreatedAt := backuptargetFields[17].Descriptor()
+	backuptargetDescCreatedAt := backuptargetFields[28].Descriptor()
 	// backuptarget.DefaultCreatedAt holds the default value on creation for the created_at field.
 	backuptarget.DefaultCreatedAt = backuptargetDescCreatedAt.Default.(func() time.Time)
 	// backuptargetDescUpdatedAt is the schema descriptor for updated_at field.
-	backuptargetDescUpdatedAt := backuptargetFields[18].Descriptor()
+	backuptargetDescUpdatedAt := backuptargetFields[29].Descriptor()
 	// backuptarget.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	backuptarget.DefaultUpdatedAt = backuptargetDescUpdatedAt.Default.(func() time.Time)
 	// backuptarget.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -133,23 +200,51 @@ func init() {
 	// backuptask.DefaultSchedule holds the default value on creation for the schedule field.
 	backuptask.DefaultSchedule = backuptaskDescSchedule.Default.(string)
 	// backuptaskDescRetentionDays is the schema descriptor for retention_days field.
-	backuptaskDescRetentionDays := backuptaskFields[3].Descriptor()
+	backuptaskDescRetentionDays := backuptaskFields[5].Descriptor()
 	// backuptask.DefaultRetentionDays holds the default value on creation for the retention_days field.
 	backuptask.DefaultRetentionDays = backuptaskDescRetentionDays.Default.(int)
 	// backuptaskDescMaxCount is the schema descriptor for max_count field.
-	backuptaskDescMaxCount := backuptaskFields[4].Descriptor()
+	backuptaskDescMaxCount := backuptaskFields[6].Descriptor()
 	// backuptask.DefaultMaxCount holds the default value on creation for the max_count field.
 	backuptask.DefaultMaxCount = backuptaskDescMaxCount.Default.(int)
+	// backuptaskDescKeepHourly is the schema descriptor for keep_hourly field.
+	backuptaskDescKeepHourly := backuptaskFields[7].Descriptor()
+	// backuptask.DefaultKeepHourly holds the default value on creation for the keep_hourly field.
+	backuptask.DefaultKeepHourly = backuptaskDescKeepHourly.Default.(int)
+	// backuptaskDescKeepDaily is the schema descriptor for keep_daily field.
+	backuptaskDescKeepDaily := backuptaskFields[8].Descriptor()
+	// backuptask.DefaultKeepDaily holds the default value on creation for the keep_daily field.
+	backuptask.DefaultKeepDaily = backuptaskDescKeepDaily.Default.(int)
+	// backuptaskDescKeepWeekly is the schema descriptor for keep_weekly field.
+	backuptaskDescKeepWeekly := backuptaskFields[9].Descriptor()
+	// backuptask.DefaultKeepWeekly holds the default value on creation for the keep_weekly field.
+	backuptask.DefaultKeepWeekly = backuptaskDescKeepWeekly.Default.(int)
+	// backuptaskDescKeepMonthly is the schema descriptor for keep_monthly field.
+	backuptaskDescKeepMonthly := backuptaskFields[10].Descriptor()
+	// backuptask.DefaultKeepMonthly holds the default value on creation for the keep_monthly field.
+	backuptask.DefaultKeepMonthly = backuptaskDescKeepMonthly.Default.(int)
+	// backuptaskDescKeepYearly is the schema descriptor for keep_yearly field.
+	backuptaskDescKeepYearly := backuptaskFields[11].Descriptor()
+	// backuptask.DefaultKeepYearly holds the default value on creation for the keep_yearly field.
+	backuptask.DefaultKeepYearly = backuptaskDescKeepYearly.Default.(int)
+	// backuptaskDescEncryptionEnabled is the schema descriptor for encryption_enabled field.
+	backuptaskDescEncryptionEnabled := backuptaskFields[12].Descriptor()
+	// backuptask.DefaultEncryptionEnabled holds the default value on creation for the encryption_enabled field.
+	backuptask.DefaultEncryptionEnabled = backuptaskDescEncryptionEnabled.Default.(bool)
+	// backuptaskDescIncremental is the schema descriptor for incremental field.
+	backuptaskDescIncremental := backuptaskFields[14].Descriptor()
+	// backuptask.DefaultIncremental holds the default value on creation for the incremental field.
+	backuptask.DefaultIncremental = backuptaskDescIncremental.Default.(bool)
 	// backuptaskDescLastBackupStatus is the schema descriptor for last_backup_status field.
-	backuptaskDescLastBackupStatus := backuptaskFields[5].Descriptor()
+	backuptaskDescLastBackupStatus := backuptaskFields[15].Descriptor()
 	// backuptask.DefaultLastBackupStatus holds the default value on creation for the last_backup_status field.
 	backuptask.DefaultLastBackupStatus = backuptaskDescLastBackupStatus.Default.(string)
 	// backuptaskDescCreatedAt is the schema descriptor for created_at field.
-	backuptaskDescCreatedAt := backuptaskFields[8].Descriptor()
+	backuptaskDescCreatedAt := backuptaskFields[18].Descriptor()
 	// backuptask.DefaultCreatedAt holds the default value on creation for the created_at field.
 	backuptask.DefaultCreatedAt = backuptaskDescCreatedAt.Default.(func() time.Time)
 	// backuptaskDescUpdatedAt is the schema descriptor for updated_at field.
-	backuptaskDescUpdatedAt := backuptaskFields[9].Descriptor()
+	backuptaskDescUpdatedAt := backuptaskFields[19].Descriptor()
 	// backuptask.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	backuptask.DefaultUpdatedAt = backuptaskDescUpdatedAt.Default.(func() time.Time)
 	// backuptask.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -284,6 +379,24 @@ func init() {
 	importjobDescCreatedAt := importjobFields[8].Descriptor()
 	// importjob.DefaultCreatedAt holds the default value on creation for the created_at field.
 	importjob.DefaultCreatedAt = importjobDescCreatedAt.Default.(func() time.Time)
+	linkrewriteFields := schema.LinkRewrite{}.Fields()
+	_ = linkrewriteFields
+	// linkrewriteDescRewrittenCount is the schema descriptor for rewritten_count field.
+	linkrewriteDescRewrittenCount := linkrewriteFields[6].Descriptor()
+	// linkrewrite.DefaultRewrittenCount holds the default value on creation for the rewritten_count field.
+	linkrewrite.DefaultRewrittenCount = linkrewriteDescRewrittenCount.Default.(int)
+	// linkrewriteDescChangesJSON is the schema descriptor for changes_json field.
+	linkrewriteDescChangesJSON := linkrewriteFields[7].Descriptor()
+	// linkrewrite.DefaultChangesJSON holds the default value on creation for the changes_json field.
+	linkrewrite.DefaultChangesJSON = linkrewriteDescChangesJSON.Default.(string)
+	// linkrewriteDescCreatedAt is the schema descriptor for created_at field.
+	linkrewriteDescCreatedAt := linkrewriteFields[8].Descriptor()
+	// linkrewrite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	linkrewrite.DefaultCreatedAt = linkrewriteDescCreatedAt.Default.(func() time.Time)
+	// linkrewriteDescID is the schema descriptor for id field.
+	linkrewriteDescID := linkrewriteFields[0].Descriptor()
+	// linkrewrite.DefaultID holds the default value on creation for the id field.
+	linkrewrite.DefaultID = linkrewriteDescID.Default.(func() uuid.UUID)
 	mcpimageFields := schema.MCPImage{}.Fields()
 	_ = mcpimageFields
 	// mcpimageDescFilename is the schema descriptor for filename field.
@@ -343,19 +456,19 @@ func init() {
 	// note.DefaultColor holds the default value on creation for the color field.
 	note.DefaultColor = noteDescColor.Default.(string)
 	// noteDescIsStarred is the schema descriptor for is_starred field.
-	noteDescIsStarred := noteFields[11].Descriptor()
+	noteDescIsStarred := noteFields[12].Descriptor()
 	// note.DefaultIsStarred holds the default value on creation for the is_starred field.
 	note.DefaultIsStarred = noteDescIsStarred.Default.(bool)
 	// noteDescIsDeleted is the schema descriptor for is_deleted field.
-	noteDescIsDeleted := noteFields[12].Descriptor()
+	noteDescIsDeleted := noteFields[13].Descriptor()
 	// note.DefaultIsDeleted holds the default value on creation for the is_deleted field.
 	note.DefaultIsDeleted = noteDescIsDeleted.Default.(bool)
 	// noteDescCreatedAt is the schema descriptor for created_at field.
-	noteDescCreatedAt := noteFields[13].Descriptor()
+	noteDescCreatedAt := noteFields[14].Descriptor()
 	// note.DefaultCreatedAt holds the default value on creation for the created_at field.
 	note.DefaultCreatedAt = noteDescCreatedAt.Default.(func() time.Time)
 	// noteDescUpdatedAt is the schema descriptor for updated_at field.
-	noteDescUpdatedAt := noteFields[14].Descriptor()
+	noteDescUpdatedAt := noteFields[15].Descriptor()
 	// note.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	note.DefaultUpdatedAt = noteDescUpdatedAt.Default.(func() time.Time)
 	// note.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -382,20 +495,38 @@ func init() {
 	noteconnectionaccountDescAuthType := noteconnectionaccountFields[5].Descriptor()
 	// noteconnectionaccount.DefaultAuthType holds the default value on creation for the auth_type field.
 	noteconnectionaccount.DefaultAuthType = noteconnectionaccountDescAuthType.Default.(string)
+	// noteconnectionaccountDescSyncIntervalMinutes is the schema descriptor for sync_interval_minutes field.
+	noteconnectionaccountDescSyncIntervalMinutes := noteconnectionaccountFields[10].Descriptor()
+	// noteconnectionaccount.DefaultSyncIntervalMinutes holds the default value on creation for the sync_interval_minutes field.
+	noteconnectionaccount.DefaultSyncIntervalMinutes = noteconnectionaccountDescSyncIntervalMinutes.Default.(int)
 	// noteconnectionaccountDescLastTestStatus is the schema descriptor for last_test_status field.
-	noteconnectionaccountDescLastTestStatus := noteconnectionaccountFields[11].Descriptor()
+	noteconnectionaccountDescLastTestStatus := noteconnectionaccountFields[13].Descriptor()
 	// noteconnectionaccount.DefaultLastTestStatus holds the default value on creation for the last_test_status field.
 	noteconnectionaccount.DefaultLastTestStatus = noteconnectionaccountDescLastTestStatus.Default.(string)
 	// noteconnectionaccountDescCreatedAt is the schema descriptor for created_at field.
-	noteconnectionaccountDescCreatedAt := noteconnectionaccountFields[14].Descriptor()
+	noteconnectionaccountDescCreatedAt := noteconnectionaccountFields[16].Descriptor()
 	// noteconnectionaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
 	noteconnectionaccount.DefaultCreatedAt = noteconnectionaccountDescCreatedAt.Default.(func() time.Time)
 	// noteconnectionaccountDescUpdatedAt is the schema descriptor for updated_at field.
-	noteconnectionaccountDescUpdatedAt := noteconnectionaccountFields[15].Descriptor()
+	noteconnectionaccountDescUpdatedAt := noteconnectionaccountFields[17].Descriptor()
 	// noteconnectionaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	noteconnectionaccount.DefaultUpdatedAt = noteconnectionaccountDescUpdatedAt.Default.(func() time.Time)
 	// noteconnectionaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	noteconnectionaccount.UpdateDefaultUpdatedAt = noteconnectionaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	noteconnectionconflictFields := schema.NoteConnectionConflict{}.Fields()
+	_ = noteconnectionconflictFields
+	// noteconnectionconflictDescExternalID is the schema descriptor for external_id field.
+	noteconnectionconflictDescExternalID := noteconnectionconflictFields[2].Descriptor()
+	// noteconnectionconflict.ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	noteconnectionconflict.ExternalIDValidator = noteconnectionconflictDescExternalID.Validators[0].(func(string) error)
+	// noteconnectionconflictDescStatus is the schema descriptor for status field.
+	noteconnectionconflictDescStatus := noteconnectionconflictFields[9].Descriptor()
+	// noteconnectionconflict.DefaultStatus holds the default value on creation for the status field.
+	noteconnectionconflict.DefaultStatus = noteconnectionconflictDescStatus.Default.(string)
+	// noteconnectionconflictDescCreatedAt is the schema descriptor for created_at field.
+	noteconnectionconflictDescCreatedAt := noteconnectionconflictFields[12].Descriptor()
+	// noteconnectionconflict.DefaultCreatedAt holds the default value on creation for the created_at field.
+	noteconnectionconflict.DefaultCreatedAt = noteconnectionconflictDescCreatedAt.Default.(func() time.Time)
 	noteconnectionitemmapFields := schema.NoteConnectionItemMap{}.Fields()
 	_ = noteconnectionitemmapFields
 	// noteconnectionitemmapDescProvider is the schema descriptor for provider field.
@@ -407,11 +538,11 @@ func init() {
 	// noteconnectionitemmap.ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
 	noteconnectionitemmap.ExternalIDValidator = noteconnectionitemmapDescExternalID.Validators[0].(func(string) error)
 	// noteconnectionitemmapDescCreatedAt is the schema descriptor for created_at field.
-	noteconnectionitemmapDescCreatedAt := noteconnectionitemmapFields[11].Descriptor()
+	noteconnectionitemmapDescCreatedAt := noteconnectionitemmapFields[13].Descriptor()
 	// noteconnectionitemmap.DefaultCreatedAt holds the default value on creation for the created_at field.
 	noteconnectionitemmap.DefaultCreatedAt = noteconnectionitemmapDescCreatedAt.Default.(func() time.Time)
 	// noteconnectionitemmapDescUpdatedAt is the schema descriptor for updated_at field.
-	noteconnectionitemmapDescUpdatedAt := noteconnectionitemmapFields[12].Descriptor()
+	noteconnectionitemmapDescUpdatedAt := noteconnectionitemmapFields[14].Descriptor()
 	// noteconnectionitemmap.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	noteconnectionitemmap.DefaultUpdatedAt = noteconnectionitemmapDescUpdatedAt.Default.(func() time.Time)
 	// noteconnectionitemmap.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -430,48 +561,68 @@ func init() {
 	noteconnectionjobDescStatus := noteconnectionjobFields[5].Descriptor()
 	// noteconnectionjob.DefaultStatus holds the default value on creation for the status field.
 	noteconnectionjob.DefaultStatus = noteconnectionjobDescStatus.Default.(string)
+	// noteconnectionjobDescTrigger is the schema descriptor for trigger field.
+	noteconnectionjobDescTrigger := noteconnectionjobFields[6].Descriptor()
+	// noteconnectionjob.DefaultTrigger holds the default value on creation for the trigger field.
+	noteconnectionjob.DefaultTrigger = noteconnectionjobDescTrigger.Default.(string)
+	// noteconnectionjobDescAttempts is the schema descriptor for attempts field.
+	noteconnectionjobDescAttempts := noteconnectionjobFields[7].Descriptor()
+	// noteconnectionjob.DefaultAttempts holds the default value on creation for the attempts field.
+	noteconnectionjob.DefaultAttempts = noteconnectionjobDescAttempts.Default.(int)
+	// noteconnectionjobDescMaxAttempts is the schema descriptor for max_attempts field.
+	noteconnectionjobDescMaxAttempts := noteconnectionjobFields[8].Descriptor()
+	// noteconnectionjob.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
+	noteconnectionjob.DefaultMaxAttempts = noteconnectionjobDescMaxAttempts.Default.(int)
 	// noteconnectionjobDescTotalCount is the schema descriptor for total_count field.
-	noteconnectionjobDescTotalCount := noteconnectionjobFields[6].Descriptor()
+	noteconnectionjobDescTotalCount := noteconnectionjobFields[10].Descriptor()
 	// noteconnectionjob.DefaultTotalCount holds the default value on creation for the total_count field.
 	noteconnectionjob.DefaultTotalCount = noteconnectionjobDescTotalCount.Default.(int)
 	// noteconnectionjobDescImportedCount is the schema descriptor for imported_count field.
-	noteconnectionjobDescImportedCount := noteconnectionjobFields[7].Descriptor()
+	noteconnectionjobDescImportedCount := noteconnectionjobFields[11].Descriptor()
 	// noteconnectionjob.DefaultImportedCount holds the default value on creation for the imported_count field.
 	noteconnectionjob.DefaultImportedCount = noteconnectionjobDescImportedCount.Default.(int)
 	// noteconnectionjobDescPushedCount is the schema descriptor for pushed_count field.
-	noteconnectionjobDescPushedCount := noteconnectionjobFields[8].Descriptor()
+	noteconnectionjobDescPushedCount := noteconnectionjobFields[12].Descriptor()
 	// noteconnectionjob.DefaultPushedCount holds the default value on creation for the pushed_count field.
 	noteconnectionjob.DefaultPushedCount = noteconnectionjobDescPushedCount.Default.(int)
 	// noteconnectionjobDescSkippedCount is the schema descriptor for skipped_count field.
-	noteconnectionjobDescSkippedCount := noteconnectionjobFields[9].Descriptor()
+	noteconnectionjobDescSkippedCount := noteconnectionjobFields[13].Descriptor()
 	// noteconnectionjob.DefaultSkippedCount holds the default value on creation for the skipped_count field.
 	noteconnectionjob.DefaultSkippedCount = noteconnectionjobDescSkippedCount.Default.(int)
 	// noteconnectionjobDescFailedCount is the schema descriptor for failed_count field.
-	noteconnectionjobDescFailedCount := noteconnectionjobFields[10].Descriptor()
+	noteconnectionjobDescFailedCount := noteconnectionjobFields[14].Descriptor()
 	// noteconnectionjob.DefaultFailedCount holds the default value on creation for the failed_count field.
 	noteconnectionjob.DefaultFailedCount = noteconnectionjobDescFailedCount.Default.(int)
+	// noteconnectionjobDescConflictCount is the schema descriptor for conflict_count field.
+	noteconnectionjobDescConflictCount := noteconnectionjobFields[15].Descriptor()
+	// noteconnectionjob.DefaultConflictCount holds the default value on creation for the conflict_count field.
+	noteconnectionjob.DefaultConflictCount = noteconnectionjobDescConflictCount.Default.(int)
 	// noteconnectionjobDescCreatedAt is the schema descriptor for created_at field.
-	noteconnectionjobDescCreatedAt := noteconnectionjobFields[15].Descriptor()
+	noteconnectionjobDescCreatedAt := noteconnectionjobFields[20].Descriptor()
 	// noteconnectionjob.DefaultCreatedAt holds the default value on creation for the created_at field.
 	noteconnectionjob.DefaultCreatedAt = noteconnectionjobDescCreatedAt.Default.(func() time.Time)
 	// noteconnectionjobDescUpdatedAt is the schema descriptor for updated_at field.
-	noteconnectionjobDescUpdatedAt := noteconnectionjobFields[16].Descriptor()
+	noteconnectionjobDescUpdatedAt := noteconnectionjobFields[21].Descriptor()
 	// noteconnectionjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	noteconnectionjob.DefaultUpdatedAt = noteconnectionjobDescUpdatedAt.Default.(func() time.Time)
 	// noteconnectionjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	noteconnectionjob.UpdateDefaultUpdatedAt = noteconnectionjobDescUpdatedAt.UpdateDefault.(func() time.Time)
 	notelinkFields := schema.NoteLink{}.Fields()
 	_ = notelinkFields
+	// notelinkDescAnchor is the schema descriptor for anchor field.
+	notelinkDescAnchor := notelinkFields[10].Descriptor()
+	// notelink.DefaultAnchor holds the default value on creation for the anchor field.
+	notelink.DefaultAnchor = notelinkDescAnchor.Default.(string)
 	// notelinkDescOccurrenceCount is the schema descriptor for occurrence_count field.
-	notelinkDescOccurrenceCount := notelinkFields[9].Descriptor()
+	notelinkDescOccurrenceCount := notelinkFields[11].Descriptor()
 	// notelink.DefaultOccurrenceCount holds the default value on creation for the occurrence_count field.
 	notelink.DefaultOccurrenceCount = notelinkDescOccurrenceCount.Default.(int)
 	// notelinkDescCreatedAt is the schema descriptor for created_at field.
-	notelinkDescCreatedAt := notelinkFields[10].Descriptor()
+	notelinkDescCreatedAt := notelinkFields[12].Descriptor()
 	// notelink.DefaultCreatedAt holds the default value on creation for the created_at field.
 	notelink.DefaultCreatedAt = notelinkDescCreatedAt.Default.(func() time.Time)
 	// notelinkDescUpdatedAt is the schema descriptor for updated_at field.
-	notelinkDescUpdatedAt := notelinkFields[11].Descriptor()
+	notelinkDescUpdatedAt := notelinkFields[13].Descriptor()
 	// notelink.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	notelink.DefaultUpdatedAt = notelinkDescUpdatedAt.Default.(func() time.Time)
 	// notelink.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -531,11 +682,11 @@ func init() {
 	// user.DefaultTimeZone holds the default value on creation for the time_zone field.
 	user.DefaultTimeZone = userDescTimeZone.Default.(string)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[9].Descriptor()
+	userDescCreatedAt := userFields[10].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[10].Descriptor()
+	userDescUpdatedAt := userFields[11].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
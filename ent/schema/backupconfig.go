@@ -49,6 +49,23 @@ func (BackupConfig) Fields() []ent.Field {
 		field.Bool("backup_targets_migrated").
 			Default(false).
 			Comment("Whether legacy backup config has been migrated to targets/tasks"),
+		field.String("alert_webhook_url").
+			Optional().
+			Sensitive().
+			Comment("Backup alerts are POSTed here as JSON; sealed with the server secret box"),
+		field.String("alert_smtp_host").
+			Optional().
+			Comment("Mail relay for backup alerts, used without authentication"),
+		field.Int("alert_smtp_port").
+			Default(25),
+		field.String("alert_smtp_from").
+			Optional(),
+		field.String("alert_smtp_to").
+			Optional().
+			Comment("Comma separated recipients"),
+		field.Bool("alert_on_failure").
+			Default(true).
+			Comment("Alert when a backup run fails"),
 		field.Time("last_backup_at").
 			Optional(),
 		field.Time("created_at").
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BackupDrill records a restore test: the latest backup of a target is
+// restored into a scratch directory and checked for usability.
+type BackupDrill struct {
+	ent.Schema
+}
+
+func (BackupDrill) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("target_id"),
+		field.String("filename").
+			Optional().
+			Comment("Backup that was restored; empty when the target had none"),
+		field.String("trigger").
+			Default("manual").
+			Comment("manual, scheduled"),
+		field.String("status").
+			Comment("passed, failed"),
+		field.Text("error").
+			Optional(),
+		field.Text("checks_json").
+			Optional(),
+		field.Text("counts_json").
+			Optional().
+			Comment("Row counts of the restored and the live database"),
+		field.Time("started_at").
+			Default(time.Now),
+		field.Time("finished_at"),
+		field.Int64("duration_ms").
+			Default(0),
+	}
+}
+
+func (BackupDrill) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("target", BackupTarget.Type).
+			Ref("drills").
+			Field("target_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (BackupDrill) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_id", "started_at"),
+	}
+}
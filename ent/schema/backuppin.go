@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BackupPin protects one backup file on a target from retention cleanup.
+type BackupPin struct {
+	ent.Schema
+}
+
+func (BackupPin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("target_id"),
+		field.String("filename").
+			NotEmpty(),
+		field.String("note").
+			Optional().
+			Comment("Why the backup is kept, e.g. before a migration"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (BackupPin) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("target", BackupTarget.Type).
+			Ref("pins").
+			Field("target_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (BackupPin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_id", "filename").
+			Unique(),
+	}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BackupRun records one attempt to write a backup to a target. Runs that
+// fail before any target is tried have no target.
+type BackupRun struct {
+	ent.Schema
+}
+
+func (BackupRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("task_id").
+			Optional().
+			Nillable().
+			Comment("Empty for one-off target backups and deleted tasks"),
+		field.Int("target_id").
+			Optional().
+			Nillable(),
+		field.String("trigger").
+			Default("manual").
+			Comment("manual, scheduled"),
+		field.String("status").
+			Comment("success, failed"),
+		field.String("filename").
+			Optional(),
+		field.Int64("bytes").
+			Default(0).
+			Comment("Bytes uploaded to the target, including snapshot blobs"),
+		field.Text("error").
+			Optional(),
+		field.Time("started_at").
+			Default(time.Now),
+		field.Time("finished_at"),
+		field.Int64("duration_ms").
+			Default(0),
+	}
+}
+
+func (BackupRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", BackupTask.Type).
+			Ref("runs").
+			Field("task_id").
+			Unique(),
+		edge.From("target", BackupTarget.Type).
+			Ref("runs").
+			Field("target_id").
+			Unique(),
+	}
+}
+
+func (BackupRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "started_at"),
+		index.Fields("target_id", "started_at"),
+		index.Fields("started_at"),
+	}
+}
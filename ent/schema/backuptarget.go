@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -18,7 +19,7 @@ func (BackupTarget) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("type").
 			NotEmpty().
-			Comment("webdav or s3"),
+			Comment("webdav, s3, local or sftp"),
 		field.Bool("enabled").
 			Default(true),
 		field.String("last_backup_status").
@@ -54,6 +55,37 @@ func (BackupTarget) Fields() []ent.Field {
 		field.String("s3_secret_key").
 			Optional().
 			Sensitive(),
+		field.String("local_path").
+			Optional().
+			Comment("Absolute directory on the server, e.g. a mounted NAS share"),
+		field.String("sftp_host").
+			Optional(),
+		field.Int("sftp_port").
+			Default(22),
+		field.String("sftp_user").
+			Optional(),
+		field.String("sftp_password").
+			Optional().
+			Sensitive().
+			Comment("Sealed with the server secret box"),
+		field.Text("sftp_private_key").
+			Optional().
+			Sensitive().
+			Comment("PEM private key, sealed with the server secret box"),
+		field.String("sftp_path").
+			Optional(),
+		field.String("sftp_host_key").
+			Optional().
+			Comment("SHA256 fingerprint of the server host key, pinned on first use"),
+		field.Int("alert_after_hours").
+			Default(0).
+			Comment("Alert when the target has not had a successful backup for this long (0 = off)"),
+		field.Int("drill_interval_days").
+			Default(0).
+			Comment("Restore the latest backup as a test every N days (0 = off)"),
+		field.Time("stale_alerted_at").
+			Optional().
+			Comment("When the missing-backup alert was last sent; cleared by the next success"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -66,5 +98,11 @@ func (BackupTarget) Fields() []ent.Field {
 func (BackupTarget) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("tasks", BackupTask.Type).Ref("targets"),
+		edge.To("pins", BackupPin.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("runs", BackupRun.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("drills", BackupDrill.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
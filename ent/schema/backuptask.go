@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -20,13 +21,39 @@ func (BackupTask) Fields() []ent.Field {
 			Default(true),
 		field.String("schedule").
 			Default("manual").
-			Comment("manual, daily, weekly, monthly"),
+			Comment("manual, daily, weekly, monthly, cron"),
+		field.String("cron_expression").
+			Optional().
+			Comment("Five-field cron expression used when schedule is cron"),
+		field.String("timezone").
+			Optional().
+			Comment("IANA time zone the schedule is evaluated in; empty uses the server's"),
 		field.Int("retention_days").
 			Default(30).
 			Comment("Number of days to retain backup files (0 = no limit)"),
 		field.Int("max_count").
 			Default(10).
 			Comment("Maximum number of backup files to keep (0 = no limit)"),
+		field.Int("keep_hourly").
+			Default(0).
+			Comment("Grandfather-father-son: newest backup of each of the last N hours"),
+		field.Int("keep_daily").
+			Default(0),
+		field.Int("keep_weekly").
+			Default(0),
+		field.Int("keep_monthly").
+			Default(0),
+		field.Int("keep_yearly").
+			Default(0),
+		field.Bool("encryption_enabled").
+			Default(false),
+		field.String("encryption_passphrase").
+			Optional().
+			Sensitive().
+			Comment("Archive passphrase sealed with the local secret box"),
+		field.Bool("incremental").
+			Default(false).
+			Comment("Store attachments as content-addressed blobs shared between snapshots"),
 		field.String("last_backup_status").
 			Default("never").
 			Comment("never, success, failed"),
@@ -46,5 +73,8 @@ func (BackupTask) Fields() []ent.Field {
 func (BackupTask) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("targets", BackupTarget.Type),
+		// Run history outlives the task so targets keep their full record.
+		edge.To("runs", BackupRun.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
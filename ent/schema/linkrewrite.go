@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LinkRewrite records a batch of wiki-link rewrites caused by a note rename so
+// the whole change can be undone in one step.
+type LinkRewrite struct {
+	ent.Schema
+}
+
+// Fields of the LinkRewrite.
+func (LinkRewrite) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Int("user_id"),
+		field.UUID("note_id", uuid.UUID{}),
+		field.String("old_title"),
+		field.String("new_title"),
+		field.Enum("status").
+			Values("applied", "undone").
+			Default("applied"),
+		field.Int("rewritten_count").
+			Default(0),
+		field.Text("changes_json").
+			Default("[]"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("undone_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the LinkRewrite.
+func (LinkRewrite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("link_rewrites").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the LinkRewrite.
+func (LinkRewrite) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("user_id", "note_id"),
+	}
+}
@@ -44,6 +44,8 @@ func (Note) Fields() []ent.Field {
 			Optional(),
 		field.String("encryption_nonce").
 			Optional(),
+		field.Strings("aliases").
+			Optional(), // Alternative titles that wiki links may resolve to
 		field.Bool("is_starred").
 			Default(false),
 		field.Bool("is_deleted").
@@ -73,6 +75,7 @@ func (Note) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.SetNull)),
 		edge.To("connection_maps", NoteConnectionItemMap.Type),
 		edge.To("connection_jobs", NoteConnectionJob.Type),
+		edge.To("connection_conflicts", NoteConnectionConflict.Type),
 		edge.To("tags", Tag.Type), // Many-to-many relationship with tags
 	}
 }
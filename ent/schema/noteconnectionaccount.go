@@ -40,6 +40,13 @@ func (NoteConnectionAccount) Fields() []ent.Field {
 			Comment("Provider-specific notebook/folder/page id"),
 		field.String("default_target_name").
 			Optional(),
+		field.Int("sync_interval_minutes").
+			Default(0).
+			Comment("Scheduled two-way sync interval; 0 disables scheduled sync"),
+		field.Text("encrypted_webhook_secret").
+			Optional().
+			Sensitive().
+			Comment("Secret inbound change webhooks present or sign with; empty disables the webhook"),
 		field.Text("metadata_json").
 			Optional(),
 		field.String("last_test_status").
@@ -68,6 +75,7 @@ func (NoteConnectionAccount) Edges() []ent.Edge {
 			Required(),
 		edge.To("item_maps", NoteConnectionItemMap.Type),
 		edge.To("jobs", NoteConnectionJob.Type),
+		edge.To("conflicts", NoteConnectionConflict.Type),
 	}
 }
 
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// NoteConnectionConflict keeps both versions of a note that changed locally
+// and remotely since the last sync, until the user picks one.
+type NoteConnectionConflict struct {
+	ent.Schema
+}
+
+// Fields of the NoteConnectionConflict.
+func (NoteConnectionConflict) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("account_id"),
+		field.UUID("note_id", uuid.UUID{}),
+		field.String("external_id").
+			NotEmpty(),
+		field.String("local_title"),
+		field.Text("local_content"),
+		field.Time("local_updated_at"),
+		field.String("remote_title"),
+		field.Text("remote_content"),
+		field.Time("remote_updated_at").
+			Optional(),
+		field.String("status").
+			Default("open").
+			Comment("open, resolved"),
+		field.String("resolution").
+			Optional().
+			Comment("local or remote"),
+		field.Time("resolved_at").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the NoteConnectionConflict.
+func (NoteConnectionConflict) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", NoteConnectionAccount.Type).
+			Ref("conflicts").
+			Field("account_id").
+			Unique().
+			Required(),
+		edge.From("note", Note.Type).
+			Ref("connection_conflicts").
+			Field("note_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the NoteConnectionConflict.
+func (NoteConnectionConflict) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "status"),
+		index.Fields("note_id", "account_id"),
+	}
+}
@@ -32,11 +32,17 @@ func (NoteConnectionItemMap) Fields() []ent.Field {
 			Optional(),
 		field.String("last_sync_direction").
 			Optional().
-			Comment("import or push"),
+			Comment("import, push or sync"),
 		field.Time("last_imported_at").
 			Optional(),
 		field.Time("last_pushed_at").
 			Optional(),
+		field.Time("last_synced_at").
+			Optional().
+			Comment("When both sides last matched"),
+		field.Time("remote_updated_at").
+			Optional().
+			Comment("Remote modification time seen at the last sync"),
 		field.Text("metadata_json").
 			Optional(),
 		field.Time("created_at").
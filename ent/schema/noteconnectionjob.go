@@ -6,10 +6,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
-// NoteConnectionJob records external note import and push operations.
+// NoteConnectionJob records external note import, push and sync operations.
+// Pending jobs form the persistent queue the background workers drain.
 type NoteConnectionJob struct {
 	ent.Schema
 }
@@ -21,7 +23,7 @@ func (NoteConnectionJob) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("operation").
 			NotEmpty().
-			Comment("import or push"),
+			Comment("import, push or sync"),
 		field.Int("user_id"),
 		field.Int("account_id"),
 		field.UUID("note_id", uuid.UUID{}).
@@ -29,7 +31,18 @@ func (NoteConnectionJob) Fields() []ent.Field {
 			Nillable(),
 		field.String("status").
 			Default("pending").
-			Comment("pending, running, completed, completed_with_errors, failed"),
+			Comment("previewed, pending, running, completed, completed_with_errors, failed, canceled"),
+		field.String("trigger").
+			Default("manual").
+			Comment("manual, scheduled, or webhook"),
+		field.Int("attempts").
+			Default(0),
+		field.Int("max_attempts").
+			Default(3),
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable().
+			Comment("Earliest time a pending job may be picked up again after a failed attempt"),
 		field.Int("total_count").
 			Default(0),
 		field.Int("imported_count").
@@ -40,6 +53,8 @@ func (NoteConnectionJob) Fields() []ent.Field {
 			Default(0),
 		field.Int("failed_count").
 			Default(0),
+		field.Int("conflict_count").
+			Default(0),
 		field.Text("message").
 			Optional(),
 		field.Text("options_json").
@@ -76,3 +91,10 @@ func (NoteConnectionJob) Edges() []ent.Edge {
 			Unique(),
 	}
 }
+
+// Indexes of the NoteConnectionJob.
+func (NoteConnectionJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+	}
+}
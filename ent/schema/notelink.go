@@ -32,6 +32,11 @@ func (NoteLink) Fields() []ent.Field {
 		field.Enum("link_type").
 			Values("wiki").
 			Default("wiki"),
+		field.Enum("anchor_type").
+			Values("none", "heading", "block").
+			Default("none"),
+		field.String("anchor").
+			Default(""),
 		field.Int("occurrence_count").
 			Default(1),
 		field.Time("created_at").
@@ -38,6 +38,10 @@ func (User) Fields() []ent.Field {
 			Default("Smarticky"),
 		field.String("time_zone").
 			Default("UTC"),
+		field.Enum("link_rename_mode").
+			Values("ask", "auto", "off").
+			Default("ask"), // How backlinks are rewritten when a note title changes
+
 		field.String("lazycat_uid").
 			Optional().
 			Nillable().
@@ -68,5 +72,7 @@ func (User) Edges() []ent.Edge {
 		edge.To("note_connection_jobs", NoteConnectionJob.Type),
 		edge.To("note_links", NoteLink.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("link_rewrites", LinkRewrite.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
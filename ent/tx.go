@@ -16,6 +16,12 @@ type Tx struct {
 	Attachment *AttachmentClient
 	// BackupConfig is the client for interacting with the BackupConfig builders.
 	BackupConfig *BackupConfigClient
+	// BackupDrill is the client for interacting with the BackupDrill builders.
+	BackupDrill *BackupDrillClient
+	// BackupPin is the client for interacting with the BackupPin builders.
+	BackupPin *BackupPinClient
+	// BackupRun is the client for interacting with the BackupRun builders.
+	BackupRun *BackupRunClient
 	// BackupTarget is the client for interacting with the BackupTarget builders.
 	BackupTarget *BackupTargetClient
 	// BackupTask is the client for interacting with the BackupTask builders.
@@ -30,6 +36,8 @@ type Tx struct {
 	ImportItem *ImportItemClient
 	// ImportJob is the client for interacting with the ImportJob builders.
 	ImportJob *ImportJobClient
+	// LinkRewrite is the client for interacting with the LinkRewrite builders.
+	LinkRewrite *LinkRewriteClient
 	// MCPImage is the client for interacting with the MCPImage builders.
 	MCPImage *MCPImageClient
 	// MCPToken is the client for interacting with the MCPToken builders.
@@ -38,6 +46,8 @@ type Tx struct {
 	Note *NoteClient
 	// NoteConnectionAccount is the client for interacting with the NoteConnectionAccount builders.
 	NoteConnectionAccount *NoteConnectionAccountClient
+	// NoteConnectionConflict is the client for interacting with the NoteConnectionConflict builders.
+	NoteConnectionConflict *NoteConnectionConflictClient
 	// NoteConnectionItemMap is the client for interacting with the NoteConnectionItemMap builders.
 	NoteConnectionItemMap *NoteConnectionItemMapClient
 	// NoteConnectionJob is the client for interacting with the NoteConnectionJob builders.
@@ -183,6 +193,9 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.Attachment = NewAttachmentClient(tx.config)
 	tx.BackupConfig = NewBackupConfigClient(tx.config)
+	tx.BackupDrill = NewBackupDrillClient(tx.config)
+	tx.BackupPin = NewBackupPinClient(tx.config)
+	tx.BackupRun = NewBackupRunClient(tx.config)
 	tx.BackupTarget = NewBackupTargetClient(tx.config)
 	tx.BackupTask = NewBackupTaskClient(tx.config)
 	tx.ExcalidrawLibrary = NewExcalidrawLibraryClient(tx.config)
@@ -190,10 +203,12 @@ func (tx *Tx) init() {
 	tx.Font = NewFontClient(tx.config)
 	tx.ImportItem = NewImportItemClient(tx.config)
 	tx.ImportJob = NewImportJobClient(tx.config)
+	tx.LinkRewrite = NewLinkRewriteClient(tx.config)
 	tx.MCPImage = NewMCPImageClient(tx.config)
 	tx.MCPToken = NewMCPTokenClient(tx.config)
 	tx.Note = NewNoteClient(tx.config)
 	tx.NoteConnectionAccount = NewNoteConnectionAccountClient(tx.config)
+	tx.NoteConnectionConflict = NewNoteConnectionConflictClient(tx.config)
 	tx.NoteConnectionItemMap = NewNoteConnectionItemMapClient(tx.config)
 	tx.NoteConnectionJob = NewNoteConnectionJobClient(tx.config)
 	tx.NoteLink = NewNoteLinkClient(tx.config)
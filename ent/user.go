@@ -34,6 +34,8 @@ type User struct {
 	ShareSignature string `json:"share_signature,omitempty"`
 	// TimeZone holds the value of the "time_zone" field.
 	TimeZone string `json:"time_zone,omitempty"`
+	// LinkRenameMode holds the value of the "link_rename_mode" field.
+	LinkRenameMode user.LinkRenameMode `json:"link_rename_mode,omitempty"`
 	// LazycatUID holds the value of the "lazycat_uid" field.
 	LazycatUID *string `json:"lazycat_uid,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -74,9 +76,11 @@ type UserEdges struct {
 	NoteConnectionJobs []*NoteConnectionJob `json:"note_connection_jobs,omitempty"`
 	// NoteLinks holds the value of the note_links edge.
 	NoteLinks []*NoteLink `json:"note_links,omitempty"`
+	// LinkRewrites holds the value of the link_rewrites edge.
+	LinkRewrites []*LinkRewrite `json:"link_rewrites,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [13]bool
+	loadedTypes [14]bool
 }
 
 // NotesOrErr returns the Notes value or an error if the edge
@@ -198,6 +202,15 @@ func (e UserEdges) NoteLinksOrErr() ([]*NoteLink, error) {
 	return nil, &NotLoadedError{edge: "note_links"}
 }
 
+// LinkRewritesOrErr returns the LinkRewrites value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LinkRewritesOrErr() ([]*LinkRewrite, error) {
+	if e.loadedTypes[13] {
+		return e.LinkRewrites, nil
+	}
+	return nil, &NotLoadedError{edge: "link_rewrites"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -205,7 +218,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldPasswordHash, user.FieldEmail, user.FieldNickname, user.FieldRole, user.FieldAvatar, user.FieldShareSignature, user.FieldTimeZone, user.FieldLazycatUID:
+		case user.FieldUsername, user.FieldPasswordHash, user.FieldEmail, user.FieldNickname, user.FieldRole, user.FieldAvatar, user.FieldShareSignature, user.FieldTimeZone, user.FieldLinkRenameMode, user.FieldLazycatUID:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -278,6 +291,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TimeZone = value.String
 			}
+		case user.FieldLinkRenameMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field link_rename_mode", values[i])
+			} else if value.Valid {
+				_m.LinkRenameMode = user.LinkRenameMode(value.String)
+			}
 		case user.FieldLazycatUID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field lazycat_uid", values[i])
@@ -375,6 +394,11 @@ func (_m *User) QueryNoteLinks() *NoteLinkQuery {
 	return NewUserClient(_m.config).QueryNoteLinks(_m)
 }
 
+// QueryLinkRewrites queries the "link_rewrites" edge of the User entity.
+func (_m *User) QueryLinkRewrites() *LinkRewriteQuery {
+	return NewUserClient(_m.config).QueryLinkRewrites(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -421,6 +445,9 @@ func (_m *User) String() string {
 	builder.WriteString("time_zone=")
 	builder.WriteString(_m.TimeZone)
 	builder.WriteString(", ")
+	builder.WriteString("link_rename_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LinkRenameMode))
+	builder.WriteString(", ")
 	if v := _m.LazycatUID; v != nil {
 		builder.WriteString("lazycat_uid=")
 		builder.WriteString(*v)
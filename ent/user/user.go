@@ -31,6 +31,8 @@ const (
 	FieldShareSignature = "share_signature"
 	// FieldTimeZone holds the string denoting the time_zone field in the database.
 	FieldTimeZone = "time_zone"
+	// FieldLinkRenameMode holds the string denoting the link_rename_mode field in the database.
+	FieldLinkRenameMode = "link_rename_mode"
 	// FieldLazycatUID holds the string denoting the lazycat_uid field in the database.
 	FieldLazycatUID = "lazycat_uid"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -63,6 +65,8 @@ const (
 	EdgeNoteConnectionJobs = "note_connection_jobs"
 	// EdgeNoteLinks holds the string denoting the note_links edge name in mutations.
 	EdgeNoteLinks = "note_links"
+	// EdgeLinkRewrites holds the string denoting the link_rewrites edge name in mutations.
+	EdgeLinkRewrites = "link_rewrites"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// NotesTable is the table that holds the notes relation/edge.
@@ -156,6 +160,13 @@ const (
 	NoteLinksInverseTable = "note_links"
 	// NoteLinksColumn is the table column denoting the note_links relation/edge.
 	NoteLinksColumn = "user_id"
+	// LinkRewritesTable is the table that holds the link_rewrites relation/edge.
+	LinkRewritesTable = "link_rewrites"
+	// LinkRewritesInverseTable is the table name for the LinkRewrite entity.
+	// It exists in this package in order to avoid circular dependency with the "linkrewrite" package.
+	LinkRewritesInverseTable = "link_rewrites"
+	// LinkRewritesColumn is the table column denoting the link_rewrites relation/edge.
+	LinkRewritesColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -169,6 +180,7 @@ var Columns = []string{
 	FieldAvatar,
 	FieldShareSignature,
 	FieldTimeZone,
+	FieldLinkRenameMode,
 	FieldLazycatUID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -231,6 +243,33 @@ func RoleValidator(r Role) error {
 	}
 }
 
+// LinkRenameMode defines the type for the "link_rename_mode" enum field.
+type LinkRenameMode string
+
+// LinkRenameModeAsk is the default value of the LinkRenameMode enum.
+const DefaultLinkRenameMode = LinkRenameModeAsk
+
+// LinkRenameMode values.
+const (
+	LinkRenameModeAsk  LinkRenameMode = "ask"
+	LinkRenameModeAuto LinkRenameMode = "auto"
+	LinkRenameModeOff  LinkRenameMode = "off"
+)
+
+func (lrm LinkRenameMode) String() string {
+	return string(lrm)
+}
+
+// LinkRenameModeValidator is a validator for the "link_rename_mode" field enum values. It is called by the builders before save.
+func LinkRenameModeValidator(lrm LinkRenameMode) error {
+	switch lrm {
+	case LinkRenameModeAsk, LinkRenameModeAuto, LinkRenameModeOff:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for link_rename_mode field: %q", lrm)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -279,6 +318,11 @@ func ByTimeZone(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTimeZone, opts...).ToFunc()
 }
 
+// ByLinkRenameMode orders the results by the link_rename_mode field.
+func ByLinkRenameMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkRenameMode, opts...).ToFunc()
+}
+
 // ByLazycatUID orders the results by the lazycat_uid field.
 func ByLazycatUID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLazycatUID, opts...).ToFunc()
@@ -468,6 +512,20 @@ func ByNoteLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newNoteLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByLinkRewritesCount orders the results by link_rewrites count.
+func ByLinkRewritesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinkRewritesStep(), opts...)
+	}
+}
+
+// ByLinkRewrites orders the results by link_rewrites terms.
+func ByLinkRewrites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinkRewritesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newNotesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -559,3 +617,10 @@ func newNoteLinksStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, NoteLinksTable, NoteLinksColumn),
 	)
 }
+func newLinkRewritesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinkRewritesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LinkRewritesTable, LinkRewritesColumn),
+	)
+}
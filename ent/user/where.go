@@ -610,6 +610,26 @@ func TimeZoneContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldTimeZone, v))
 }
 
+// LinkRenameModeEQ applies the EQ predicate on the "link_rename_mode" field.
+func LinkRenameModeEQ(v LinkRenameMode) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLinkRenameMode, v))
+}
+
+// LinkRenameModeNEQ applies the NEQ predicate on the "link_rename_mode" field.
+func LinkRenameModeNEQ(v LinkRenameMode) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldLinkRenameMode, v))
+}
+
+// LinkRenameModeIn applies the In predicate on the "link_rename_mode" field.
+func LinkRenameModeIn(vs ...LinkRenameMode) predicate.User {
+	return predicate.User(sql.FieldIn(FieldLinkRenameMode, vs...))
+}
+
+// LinkRenameModeNotIn applies the NotIn predicate on the "link_rename_mode" field.
+func LinkRenameModeNotIn(vs ...LinkRenameMode) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldLinkRenameMode, vs...))
+}
+
 // LazycatUIDEQ applies the EQ predicate on the "lazycat_uid" field.
 func LazycatUIDEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldLazycatUID, v))
@@ -1064,6 +1084,29 @@ func HasNoteLinksWith(preds ...predicate.NoteLink) predicate.User {
 	})
 }
 
+// HasLinkRewrites applies the HasEdge predicate on the "link_rewrites" edge.
+func HasLinkRewrites() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LinkRewritesTable, LinkRewritesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinkRewritesWith applies the HasEdge predicate on the "link_rewrites" edge with a given conditions (other predicates).
+func HasLinkRewritesWith(preds ...predicate.LinkRewrite) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newLinkRewritesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -11,6 +11,7 @@ import (
 	"smarticky/ent/folder"
 	"smarticky/ent/font"
 	"smarticky/ent/importjob"
+	"smarticky/ent/linkrewrite"
 	"smarticky/ent/mcpimage"
 	"smarticky/ent/mcptoken"
 	"smarticky/ent/note"
@@ -130,6 +131,20 @@ func (_c *UserCreate) SetNillableTimeZone(v *string) *UserCreate {
 	return _c
 }
 
+// SetLinkRenameMode sets the "link_rename_mode" field.
+func (_c *UserCreate) SetLinkRenameMode(v user.LinkRenameMode) *UserCreate {
+	_c.mutation.SetLinkRenameMode(v)
+	return _c
+}
+
+// SetNillableLinkRenameMode sets the "link_rename_mode" field if the given value is not nil.
+func (_c *UserCreate) SetNillableLinkRenameMode(v *user.LinkRenameMode) *UserCreate {
+	if v != nil {
+		_c.SetLinkRenameMode(*v)
+	}
+	return _c
+}
+
 // SetLazycatUID sets the "lazycat_uid" field.
 func (_c *UserCreate) SetLazycatUID(v string) *UserCreate {
 	_c.mutation.SetLazycatUID(v)
@@ -371,6 +386,21 @@ func (_c *UserCreate) AddNoteLinks(v ...*NoteLink) *UserCreate {
 	return _c.AddNoteLinkIDs(ids...)
 }
 
+// AddLinkRewriteIDs adds the "link_rewrites" edge to the LinkRewrite entity by IDs.
+func (_c *UserCreate) AddLinkRewriteIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddLinkRewriteIDs(ids...)
+	return _c
+}
+
+// AddLinkRewrites adds the "link_rewrites" edges to the LinkRewrite entity.
+func (_c *UserCreate) AddLinkRewrites(v ...*LinkRewrite) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLinkRewriteIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -426,6 +456,10 @@ func (_c *UserCreate) defaults() {
 		v := user.DefaultTimeZone
 		_c.mutation.SetTimeZone(v)
 	}
+	if _, ok := _c.mutation.LinkRenameMode(); !ok {
+		v := user.DefaultLinkRenameMode
+		_c.mutation.SetLinkRenameMode(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -468,6 +502,14 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.TimeZone(); !ok {
 		return &ValidationError{Name: "time_zone", err: errors.New(`ent: missing required field "User.time_zone"`)}
 	}
+	if _, ok := _c.mutation.LinkRenameMode(); !ok {
+		return &ValidationError{Name: "link_rename_mode", err: errors.New(`ent: missing required field "User.link_rename_mode"`)}
+	}
+	if v, ok := _c.mutation.LinkRenameMode(); ok {
+		if err := user.LinkRenameModeValidator(v); err != nil {
+			return &ValidationError{Name: "link_rename_mode", err: fmt.Errorf(`ent: validator failed for field "User.link_rename_mode": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -532,6 +574,10 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldTimeZone, field.TypeString, value)
 		_node.TimeZone = value
 	}
+	if value, ok := _c.mutation.LinkRenameMode(); ok {
+		_spec.SetField(user.FieldLinkRenameMode, field.TypeEnum, value)
+		_node.LinkRenameMode = value
+	}
 	if value, ok := _c.mutation.LazycatUID(); ok {
 		_spec.SetField(user.FieldLazycatUID, field.TypeString, value)
 		_node.LazycatUID = &value
@@ -752,6 +798,22 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.LinkRewritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LinkRewritesTable,
+			Columns: []string{user.LinkRewritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkrewrite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -12,6 +12,7 @@ import (
 	"smarticky/ent/folder"
 	"smarticky/ent/font"
 	"smarticky/ent/importjob"
+	"smarticky/ent/linkrewrite"
 	"smarticky/ent/mcpimage"
 	"smarticky/ent/mcptoken"
 	"smarticky/ent/note"
@@ -49,6 +50,7 @@ type UserQuery struct {
 	withNoteConnectionAccounts *NoteConnectionAccountQuery
 	withNoteConnectionJobs     *NoteConnectionJobQuery
 	withNoteLinks              *NoteLinkQuery
+	withLinkRewrites           *LinkRewriteQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -371,6 +373,28 @@ func (_q *UserQuery) QueryNoteLinks() *NoteLinkQuery {
 	return query
 }
 
+// QueryLinkRewrites chains the current query on the "link_rewrites" edge.
+func (_q *UserQuery) QueryLinkRewrites() *LinkRewriteQuery {
+	query := (&LinkRewriteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(linkrewrite.Table, linkrewrite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LinkRewritesTable, user.LinkRewritesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -576,6 +600,7 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withNoteConnectionAccounts: _q.withNoteConnectionAccounts.Clone(),
 		withNoteConnectionJobs:     _q.withNoteConnectionJobs.Clone(),
 		withNoteLinks:              _q.withNoteLinks.Clone(),
+		withLinkRewrites:           _q.withLinkRewrites.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -725,6 +750,17 @@ func (_q *UserQuery) WithNoteLinks(opts ...func(*NoteLinkQuery)) *UserQuery {
 	return _q
 }
 
+// WithLinkRewrites tells the query-builder to eager-load the nodes that are connected to
+// the "link_rewrites" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithLinkRewrites(opts ...func(*LinkRewriteQuery)) *UserQuery {
+	query := (&LinkRewriteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLinkRewrites = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -803,7 +839,7 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [13]bool{
+		loadedTypes = [14]bool{
 			_q.withNotes != nil,
 			_q.withFolders != nil,
 			_q.withAttachments != nil,
@@ -817,6 +853,7 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			_q.withNoteConnectionAccounts != nil,
 			_q.withNoteConnectionJobs != nil,
 			_q.withNoteLinks != nil,
+			_q.withLinkRewrites != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -931,6 +968,13 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withLinkRewrites; query != nil {
+		if err := _q.loadLinkRewrites(ctx, query, nodes,
+			func(n *User) { n.Edges.LinkRewrites = []*LinkRewrite{} },
+			func(n *User, e *LinkRewrite) { n.Edges.LinkRewrites = append(n.Edges.LinkRewrites, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -1331,6 +1375,36 @@ func (_q *UserQuery) loadNoteLinks(ctx context.Context, query *NoteLinkQuery, no
 	}
 	return nil
 }
+func (_q *UserQuery) loadLinkRewrites(ctx context.Context, query *LinkRewriteQuery, nodes []*User, init func(*User), assign func(*User, *LinkRewrite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(linkrewrite.FieldUserID)
+	}
+	query.Where(predicate.LinkRewrite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LinkRewritesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
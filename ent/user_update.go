@@ -11,6 +11,7 @@ import (
 	"smarticky/ent/folder"
 	"smarticky/ent/font"
 	"smarticky/ent/importjob"
+	"smarticky/ent/linkrewrite"
 	"smarticky/ent/mcpimage"
 	"smarticky/ent/mcptoken"
 	"smarticky/ent/note"
@@ -172,6 +173,20 @@ func (_u *UserUpdate) SetNillableTimeZone(v *string) *UserUpdate {
 	return _u
 }
 
+// SetLinkRenameMode sets the "link_rename_mode" field.
+func (_u *UserUpdate) SetLinkRenameMode(v user.LinkRenameMode) *UserUpdate {
+	_u.mutation.SetLinkRenameMode(v)
+	return _u
+}
+
+// SetNillableLinkRenameMode sets the "link_rename_mode" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableLinkRenameMode(v *user.LinkRenameMode) *UserUpdate {
+	if v != nil {
+		_u.SetLinkRenameMode(*v)
+	}
+	return _u
+}
+
 // SetLazycatUID sets the "lazycat_uid" field.
 func (_u *UserUpdate) SetLazycatUID(v string) *UserUpdate {
 	_u.mutation.SetLazycatUID(v)
@@ -397,6 +412,21 @@ func (_u *UserUpdate) AddNoteLinks(v ...*NoteLink) *UserUpdate {
 	return _u.AddNoteLinkIDs(ids...)
 }
 
+// AddLinkRewriteIDs adds the "link_rewrites" edge to the LinkRewrite entity by IDs.
+func (_u *UserUpdate) AddLinkRewriteIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddLinkRewriteIDs(ids...)
+	return _u
+}
+
+// AddLinkRewrites adds the "link_rewrites" edges to the LinkRewrite entity.
+func (_u *UserUpdate) AddLinkRewrites(v ...*LinkRewrite) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLinkRewriteIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -660,6 +690,27 @@ func (_u *UserUpdate) RemoveNoteLinks(v ...*NoteLink) *UserUpdate {
 	return _u.RemoveNoteLinkIDs(ids...)
 }
 
+// ClearLinkRewrites clears all "link_rewrites" edges to the LinkRewrite entity.
+func (_u *UserUpdate) ClearLinkRewrites() *UserUpdate {
+	_u.mutation.ClearLinkRewrites()
+	return _u
+}
+
+// RemoveLinkRewriteIDs removes the "link_rewrites" edge to LinkRewrite entities by IDs.
+func (_u *UserUpdate) RemoveLinkRewriteIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveLinkRewriteIDs(ids...)
+	return _u
+}
+
+// RemoveLinkRewrites removes "link_rewrites" edges to LinkRewrite entities.
+func (_u *UserUpdate) RemoveLinkRewrites(v ...*LinkRewrite) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLinkRewriteIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -713,6 +764,11 @@ func (_u *UserUpdate) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.LinkRenameMode(); ok {
+		if err := user.LinkRenameModeValidator(v); err != nil {
+			return &ValidationError{Name: "link_rename_mode", err: fmt.Errorf(`ent: validator failed for field "User.link_rename_mode": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -761,6 +817,9 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.TimeZone(); ok {
 		_spec.SetField(user.FieldTimeZone, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.LinkRenameMode(); ok {
+		_spec.SetField(user.FieldLinkRenameMode, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.LazycatUID(); ok {
 		_spec.SetField(user.FieldLazycatUID, field.TypeString, value)
 	}
@@ -1339,6 +1398,51 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.LinkRewritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LinkRewritesTable,
+			Columns: []string{user.LinkRewritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkrewrite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinkRewritesIDs(); len(nodes) > 0 && !_u.mutation.LinkRewritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LinkRewritesTable,
+			Columns: []string{user.LinkRewritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkrewrite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkRewritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LinkRewritesTable,
+			Columns: []string{user.LinkRewritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkrewrite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -1489,6 +1593,20 @@ func (_u *UserUpdateOne) SetNillableTimeZone(v *string) *UserUpdateOne {
 	return _u
 }
 
+// SetLinkRenameMode sets the "link_rename_mode" field.
+func (_u *UserUpdateOne) SetLinkRenameMode(v user.LinkRenameMode) *UserUpdateOne {
+	_u.mutation.SetLinkRenameMode(v)
+	return _u
+}
+
+// SetNillableLinkRenameMode sets the "link_rename_mode" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLinkRenameMode(v *user.LinkRenameMode) *UserUpdateOne {
+	if v != nil {
+		_u.SetLinkRenameMode(*v)
+	}
+	return _u
+}
+
 // SetLazycatUID sets the "lazycat_uid" field.
 func (_u *UserUpdateOne) SetLazycatUID(v string) *UserUpdateOne {
 	_u.mutation.SetLazycatUID(v)
@@ -1714,6 +1832,21 @@ func (_u *UserUpdateOne) AddNoteLinks(v ...*NoteLink) *UserUpdateOne {
 	return _u.AddNoteLinkIDs(ids...)
 }
 
+// AddLinkRewriteIDs adds the "link_rewrites" edge to the LinkRewrite entity by IDs.
+func (_u *UserUpdateOne) AddLinkRewriteIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddLinkRewriteIDs(ids...)
+	return _u
+}
+
+// AddLinkRewrites adds the "link_rewrites" edges to the LinkRewrite entity.
+func (_u *UserUpdateOne) AddLinkRewrites(v ...*LinkRewrite) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLinkRewriteIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -1977,6 +2110,27 @@ func (_u *UserUpdateOne) RemoveNoteLinks(v ...*NoteLink) *UserUpdateOne {
 	return _u.RemoveNoteLinkIDs(ids...)
 }
 
+// ClearLinkRewrites clears all "link_rewrites" edges to the LinkRewrite entity.
+func (_u *UserUpdateOne) ClearLinkRewrites() *UserUpdateOne {
+	_u.mutation.ClearLinkRewrites()
+	return _u
+}
+
+// RemoveLinkRewriteIDs removes the "link_rewrites" edge to LinkRewrite entities by IDs.
+func (_u *UserUpdateOne) RemoveLinkRewriteIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveLinkRewriteIDs(ids...)
+	return _u
+}
+
+// RemoveLinkRewrites removes "link_rewrites" edges to LinkRewrite entities.
+func (_u *UserUpdateOne) RemoveLinkRewrites(v ...*LinkRewrite) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLinkRewriteIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -2043,6 +2197,11 @@ func (_u *UserUpdateOne) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.LinkRenameMode(); ok {
+		if err := user.LinkRenameModeValidator(v); err != nil {
+			return &ValidationError{Name: "link_rename_mode", err: fmt.Errorf(`ent: validator failed for field "User.link_rename_mode": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -2108,6 +2267,9 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.TimeZone(); ok {
 		_spec.SetField(user.FieldTimeZone, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.LinkRenameMode(); ok {
+		_spec.SetField(user.FieldLinkRenameMode, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.LazycatUID(); ok {
 		_spec.SetField(user.FieldLazycatUID, field.TypeString, value)
 	}
@@ -2686,6 +2848,51 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.LinkRewritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LinkRewritesTable,
+			Columns: []string{user.LinkRewritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkrewrite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinkRewritesIDs(); len(nodes) > 0 && !_u.mutation.LinkRewritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LinkRewritesTable,
+			Columns: []string{user.LinkRewritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkrewrite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkRewritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LinkRewritesTable,
+			Columns: []string{user.LinkRewritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkrewrite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,216 @@
+package connections
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"smarticky/ent"
+	"smarticky/ent/attachment"
+	"smarticky/ent/note"
+	"smarticky/ent/noteconnectionitemmap"
+	"smarticky/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// maxRemoteAttachmentSize bounds a single file copied to or from a provider.
+const maxRemoteAttachmentSize = 25 << 20
+
+// localAttachmentLinkPattern matches the link target the editor writes for
+// an uploaded attachment and captures the attachment id.
+var localAttachmentLinkPattern = regexp.MustCompile(`\]\(/api/attachments/(\d+)/download\)`)
+
+// itemMetadata is kept in an item map's metadata_json.
+type itemMetadata struct {
+	// Attachments maps local attachment ids to the links earlier pushes
+	// uploaded them to, so unchanged files are not uploaded again.
+	Attachments map[string]string `json:"attachments,omitempty"`
+}
+
+func decodeItemMetadata(item *ent.NoteConnectionItemMap) itemMetadata {
+	var meta itemMetadata
+	if item != nil && strings.TrimSpace(item.MetadataJSON) != "" {
+		_ = json.Unmarshal([]byte(item.MetadataJSON), &meta)
+	}
+	if meta.Attachments == nil {
+		meta.Attachments = map[string]string{}
+	}
+	return meta
+}
+
+// saveItemMetadata stores metadata on the item map of a note once an import
+// or push has created or updated it.
+func saveItemMetadata(ctx context.Context, client *ent.Client, accountID int, noteID uuid.UUID, meta itemMetadata) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	_, err = client.NoteConnectionItemMap.Update().
+		Where(
+			noteconnectionitemmap.AccountIDEQ(accountID),
+			noteconnectionitemmap.NoteIDEQ(noteID),
+		).
+		SetMetadataJSON(string(data)).
+		Save(ctx)
+	return err
+}
+
+// fetchRemoteAttachments downloads the files a remote note embeds so they
+// can be stored with the imported note. A file that cannot be downloaded
+// keeps its remote link instead of failing the whole note.
+func fetchRemoteAttachments(ctx context.Context, provider Provider, remote RemoteNote) (RemoteNote, error) {
+	refs, err := provider.ListAttachments(ctx, remote)
+	if err != nil {
+		return remote, err
+	}
+	for _, ref := range refs {
+		file, err := provider.DownloadAttachment(ctx, ref)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return remote, ctxErr
+			}
+			continue
+		}
+		if len(file.Data) > maxRemoteAttachmentSize {
+			continue
+		}
+		file.Name = defaultString(file.Name, ref.Name)
+		file.Embed = defaultString(file.Embed, ref.Embed)
+		remote.Attachments = append(remote.Attachments, file)
+	}
+	return remote, nil
+}
+
+// uploadLocalAttachments uploads the attachments linked from content and
+// points the links at the uploaded copies. uploaded holds the links of
+// earlier pushes and gains the new ones. Links to attachments the user does
+// not own are left alone.
+func (s *Service) uploadLocalAttachments(ctx context.Context, provider Provider, userID int, content string, uploaded map[string]string) (string, error) {
+	var uploadErr error
+	content = localAttachmentLinkPattern.ReplaceAllStringFunc(content, func(match string) string {
+		id := localAttachmentLinkPattern.FindStringSubmatch(match)[1]
+		link, ok := uploaded[id]
+		if !ok && uploadErr == nil {
+			var err error
+			link, err = s.uploadLocalAttachment(ctx, provider, userID, id)
+			if err != nil {
+				uploadErr = err
+				return match
+			}
+			if link != "" {
+				uploaded[id] = link
+			}
+		}
+		if link == "" {
+			return match
+		}
+		return "](" + link + ")"
+	})
+	if uploadErr != nil {
+		return "", uploadErr
+	}
+	return content, nil
+}
+
+func (s *Service) uploadLocalAttachment(ctx context.Context, provider Provider, userID int, id string) (string, error) {
+	attachmentID, err := strconv.Atoi(id)
+	if err != nil {
+		return "", nil
+	}
+	row, err := s.client.Attachment.Query().
+		Where(attachment.IDEQ(attachmentID), attachment.HasUserWith(user.IDEQ(userID))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if row.FileSize > maxRemoteAttachmentSize {
+		return "", fmt.Errorf("attachment %q is larger than %d bytes", row.Filename, maxRemoteAttachmentSize)
+	}
+	data, err := s.fs.ReadFile(row.FilePath)
+	if err != nil {
+		return "", fmt.Errorf("attachment %q: %w", row.Filename, err)
+	}
+	link, err := provider.UploadAttachment(ctx, LocalAttachment{
+		Name:     row.Filename,
+		MIMEType: row.MimeType,
+		Data:     data,
+	})
+	if err != nil {
+		return "", fmt.Errorf("upload attachment %q: %w", row.Filename, err)
+	}
+	return link, nil
+}
+
+// storeRemoteAttachments saves the files that came with a remote note as
+// attachments of the local note and points their embeds in the content at
+// the stored copies. Files already attached under the same name and size are
+// reused, so pulling the same note again does not duplicate them. The remote
+// links of the files are added to links, so a push does not upload them back.
+func (s *Service) storeRemoteAttachments(ctx context.Context, client *ent.Client, userID int, noteID uuid.UUID, remote RemoteNote, links map[string]string) (string, error) {
+	content := remote.Content
+	for _, item := range remote.Attachments {
+		row, err := s.saveRemoteAttachment(ctx, client, userID, noteID, item)
+		if err != nil {
+			return "", fmt.Errorf("attachment %q: %w", item.Name, err)
+		}
+		if item.Link != "" {
+			links[strconv.Itoa(row.ID)] = item.Link
+		}
+		if item.Embed != "" {
+			content = strings.ReplaceAll(content, item.Embed, attachmentMarkdown(row))
+		}
+	}
+	return content, nil
+}
+
+func (s *Service) saveRemoteAttachment(ctx context.Context, client *ent.Client, userID int, noteID uuid.UUID, item RemoteAttachment) (*ent.Attachment, error) {
+	filename := strings.TrimSpace(filepath.Base(item.Name))
+	if filename == "" || filename == "." || filename == string(filepath.Separator) {
+		filename = "attachment"
+	}
+	existing, err := client.Attachment.Query().
+		Where(
+			attachment.HasNoteWith(note.IDEQ(noteID)),
+			attachment.FilenameEQ(filename),
+			attachment.FileSizeEQ(int64(len(item.Data))),
+		).
+		First(ctx)
+	if err == nil {
+		return existing, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	filePath := filepath.Join(s.fs.GetUploadsDir("attachments"), uuid.New().String()+filepath.Ext(filename))
+	if err := s.fs.WriteFile(filePath, item.Data, 0644); err != nil {
+		return nil, err
+	}
+	return client.Attachment.Create().
+		SetFilename(filename).
+		SetFilePath(filePath).
+		SetFileSize(int64(len(item.Data))).
+		SetMimeType(defaultString(item.MIMEType, "application/octet-stream")).
+		SetNoteID(noteID).
+		SetUserID(userID).
+		Save(ctx)
+}
+
+// attachmentMarkdown links a stored attachment the way the editor does after
+// an upload: images inline, everything else as a plain link.
+func attachmentMarkdown(row *ent.Attachment) string {
+	url := fmt.Sprintf("/api/attachments/%d/download", row.ID)
+	label := strings.NewReplacer("[", "", "]", "").Replace(row.Filename)
+	if strings.HasPrefix(row.MimeType, "image/") {
+		return fmt.Sprintf("![%s](%s)", label, url)
+	}
+	return fmt.Sprintf("[%s](%s)", label, url)
+}
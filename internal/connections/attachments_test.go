@@ -0,0 +1,122 @@
+package connections
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/enttest"
+	"smarticky/ent/note"
+	"smarticky/internal/storage"
+
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestAttachmentsTravelWithJoplinNotes(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestAttachmentsTravelWithJoplinNotes?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetUsername("owner").
+		SetPasswordHash("hash").
+		SaveX(ctx)
+	other := client.User.Create().
+		SetUsername("other").
+		SetPasswordHash("hash").
+		SaveX(ctx)
+	photoID := strings.Repeat("a", 32)
+	missingID := strings.Repeat("b", 32)
+	joplin := newFakeJoplin(t, "joplin-token")
+	joplin.putResource(photoID, "photo.png", "image/png", []byte("\x89PNG\r\n\x1a\nphoto"))
+	joplin.put(joplinNote{
+		ID:          "trip",
+		Title:       "Trip",
+		Body:        "Day one\n\n![photo.png](:/" + photoID + ")\n\n![gone](:/" + missingID + ")",
+		UpdatedTime: time.Now().Add(-time.Hour).UnixMilli(),
+	})
+
+	fs := storage.NewMemoryFileSystem()
+	service := NewService(client, testSecretBox(t), fs)
+	account, err := service.CreateAccount(ctx, u.ID, AccountInput{
+		Provider: ProviderJoplin,
+		Endpoint: joplin.server.URL,
+		Token:    stringPtr("joplin-token"),
+		Enabled:  true,
+	})
+	if err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+	if _, err := service.ImportNotes(ctx, u.ID, account.ID, ImportRequest{}); err != nil {
+		t.Fatalf("import notes: %v", err)
+	}
+
+	// Resources become attachments; links to missing ones are kept.
+	trip := client.Note.Query().Where(note.TitleEQ("Trip")).OnlyX(ctx)
+	rows := trip.QueryAttachments().AllX(ctx)
+	if len(rows) != 1 || rows[0].Filename != "photo.png" || rows[0].MimeType != "image/png" {
+		t.Fatalf("unexpected attachments: %+v", rows)
+	}
+	if !strings.Contains(trip.Content, attachmentMarkdown(rows[0])) || !strings.Contains(trip.Content, ":/"+missingID) {
+		t.Fatalf("links were not rewritten: %q", trip.Content)
+	}
+	if data, err := fs.ReadFile(rows[0].FilePath); err != nil || !strings.HasSuffix(string(data), "photo") {
+		t.Fatalf("attachment file was not stored: %q %v", data, err)
+	}
+
+	// New local attachments are uploaded; imported ones point back at
+	// their resource and files of other users are left alone.
+	addAttachment := func(userID int, noteRow *ent.Note, name, content string) int {
+		t.Helper()
+		path := filepath.Join(fs.GetUploadsDir("attachments"), name)
+		if err := fs.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("write attachment: %v", err)
+		}
+		return client.Attachment.Create().
+			SetFilename(name).
+			SetFilePath(path).
+			SetFileSize(int64(len(content))).
+			SetMimeType("text/plain").
+			SetNote(noteRow).
+			SetUserID(userID).
+			SaveX(ctx).ID
+	}
+	diary := client.Note.Create().SetTitle("Diary").SetContent("Private").SetUserID(other.ID).SaveX(ctx)
+	listID := addAttachment(u.ID, trip, "list.txt", "socks")
+	secretID := addAttachment(other.ID, diary, "secret.txt", "hidden")
+	edited := fmt.Sprintf("%s\n\n[list.txt](/api/attachments/%d/download)\n\n[secret](/api/attachments/%d/download)", trip.Content, listID, secretID)
+	trip.Update().SetContent(edited).ExecX(ctx)
+	result, err := runQueuedSync(ctx, service, u.ID, account.ID)
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if result.PushedCount != 1 {
+		t.Fatalf("expected the note to be pushed, got %+v", result)
+	}
+	body := joplin.get("trip").Body
+	if !strings.Contains(body, "![photo.png](:/"+photoID+")") ||
+		!strings.Contains(body, "[list.txt](:/") ||
+		!strings.Contains(body, fmt.Sprintf("[secret](/api/attachments/%d/download)", secretID)) {
+		t.Fatalf("unexpected pushed body: %q", body)
+	}
+	if count := joplin.resourceCount(); count != 2 {
+		t.Fatalf("expected only the new file to be uploaded, got %d resources", count)
+	}
+
+	// Pushing again reuses the uploaded resource.
+	time.Sleep(10 * time.Millisecond)
+	trip.Update().SetContent(edited + "\n\nDay two").ExecX(ctx)
+	if _, err := runQueuedSync(ctx, service, u.ID, account.ID); err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if !strings.HasSuffix(joplin.get("trip").Body, "Day two") {
+		t.Fatalf("the second edit was not pushed: %q", joplin.get("trip").Body)
+	}
+	if count := joplin.resourceCount(); count != 2 {
+		t.Fatalf("expected no new uploads, got %d resources", count)
+	}
+}
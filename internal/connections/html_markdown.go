@@ -0,0 +1,676 @@
+package connections
+
+import (
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/jomei/notionapi"
+	htmlparse "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Providers that store rich text as HTML, like Trilium, go through the same
+// block model as Notion pages, so both write the same Markdown. HTML is read
+// into Notion blocks and rendered with notionBlocksMarkdown; Markdown is
+// parsed with parseMarkdownBlocks and written as the HTML of a CKEditor
+// document.
+
+// markdownToHTML converts Markdown to HTML.
+func markdownToHTML(markdown string) string {
+	lines := strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n")
+	return mdBlocksHTML(parseMarkdownBlocks(lines))
+}
+
+func mdBlocksHTML(nodes []*mdBlock) string {
+	var builder strings.Builder
+	for i := 0; i < len(nodes); {
+		kind := nodes[i].kind
+		if kind != mdBulleted && kind != mdNumbered && kind != mdToDo {
+			builder.WriteString(mdBlockHTML(nodes[i]))
+			i++
+			continue
+		}
+		open, closing := "<ul>", "</ul>"
+		switch kind {
+		case mdNumbered:
+			open, closing = "<ol>", "</ol>"
+		case mdToDo:
+			open = `<ul class="todo-list">`
+		}
+		builder.WriteString(open)
+		for ; i < len(nodes) && nodes[i].kind == kind; i++ {
+			builder.WriteString(mdListItemHTML(nodes[i]))
+		}
+		builder.WriteString(closing)
+	}
+	return builder.String()
+}
+
+func mdListItemHTML(node *mdBlock) string {
+	text := richTextHTML(parseInlineMarkdown(node.text))
+	if node.kind == mdToDo {
+		checked := ""
+		if node.checked {
+			checked = ` checked="checked"`
+		}
+		text = `<label class="todo-list__label"><input type="checkbox" disabled="disabled"` + checked + `><span class="todo-list__label__description">` + text + `</span></label>`
+	}
+	return "<li>" + text + mdBlocksHTML(node.children) + "</li>"
+}
+
+func mdBlockHTML(node *mdBlock) string {
+	switch node.kind {
+	case mdHeading:
+		// The note title is the page's first heading, so sections start
+		// at the second level.
+		tag := "h" + strconv.Itoa(min(node.level+1, 6))
+		return "<" + tag + ">" + richTextHTML(parseInlineMarkdown(node.text)) + "</" + tag + ">"
+	case mdQuote:
+		return "<blockquote>" + mdLeadHTML(node) + "</blockquote>"
+	case mdCallout:
+		kind := "note"
+		for alert, emoji := range notionCalloutIcons {
+			if emoji == node.icon {
+				kind = strings.ToLower(alert)
+			}
+		}
+		return `<aside class="admonition ` + kind + `">` + mdLeadHTML(node) + "</aside>"
+	case mdToggle:
+		return mdLeadHTML(node)
+	case mdCode:
+		language := "text-plain"
+		if node.language != "" {
+			language = "text-x-" + strings.ToLower(strings.Fields(node.language)[0])
+		}
+		return `<pre><code class="language-` + html.EscapeString(language) + `">` + html.EscapeString(node.text) + "</code></pre>"
+	case mdEquation:
+		return `<p><span class="math-tex">\[` + html.EscapeString(node.text) + `\]</span></p>`
+	case mdDivider:
+		return "<hr>"
+	case mdTable:
+		return mdTableHTML(node.rows)
+	case mdFile:
+		if match := notionFileLinePattern.FindStringSubmatch(node.text); match != nil && match[1] == "!" {
+			return `<figure class="image">` + imageHTML(match[3], match[2]) + "</figure>"
+		}
+		return "<p>" + richTextHTML(parseInlineMarkdown(node.text)) + "</p>"
+	default:
+		return "<p>" + richTextHTML(parseInlineMarkdown(node.text)) + "</p>"
+	}
+}
+
+// mdLeadHTML writes a container's own text as its first paragraph.
+func mdLeadHTML(node *mdBlock) string {
+	out := ""
+	if node.text != "" {
+		out = "<p>" + richTextHTML(parseInlineMarkdown(node.text)) + "</p>"
+	}
+	return out + mdBlocksHTML(node.children)
+}
+
+func mdTableHTML(rows [][]string) string {
+	var builder strings.Builder
+	builder.WriteString(`<figure class="table"><table>`)
+	for i, row := range rows {
+		cell := "td"
+		switch i {
+		case 0:
+			builder.WriteString("<thead>")
+			cell = "th"
+		case 1:
+			builder.WriteString("<tbody>")
+		}
+		builder.WriteString("<tr>")
+		for _, value := range row {
+			builder.WriteString("<" + cell + ">" + richTextHTML(parseInlineMarkdown(value)) + "</" + cell + ">")
+		}
+		builder.WriteString("</tr>")
+		if i == 0 {
+			builder.WriteString("</thead>")
+		}
+	}
+	if len(rows) > 1 {
+		builder.WriteString("</tbody>")
+	}
+	builder.WriteString("</table></figure>")
+	return builder.String()
+}
+
+func imageHTML(src, alt string) string {
+	return `<img src="` + html.EscapeString(src) + `" alt="` + html.EscapeString(alt) + `">`
+}
+
+// richTextHTML writes inline text as HTML. Links and images the Markdown
+// parser kept as text, because their targets are relative, become elements
+// again.
+func richTextHTML(values []notionapi.RichText) string {
+	var builder strings.Builder
+	for _, value := range values {
+		if value.Equation != nil {
+			builder.WriteString(`<span class="math-tex">\(` + html.EscapeString(value.Equation.Expression) + `\)</span>`)
+			continue
+		}
+		content, link := value.PlainText, value.Href
+		if value.Text != nil {
+			content = value.Text.Content
+			if value.Text.Link != nil {
+				link = value.Text.Link.Url
+			}
+		}
+		annotations := value.Annotations
+		if annotations == nil {
+			annotations = &notionapi.Annotations{}
+		}
+		var out string
+		if annotations.Code || link != "" {
+			out = strings.ReplaceAll(html.EscapeString(content), "\n", "<br>")
+		} else {
+			out = inlineLinksHTML(content)
+		}
+		if annotations.Code {
+			out = "<code>" + out + "</code>"
+		}
+		if annotations.Strikethrough {
+			out = "<s>" + out + "</s>"
+		}
+		if annotations.Italic {
+			out = "<i>" + out + "</i>"
+		}
+		if annotations.Bold {
+			out = "<strong>" + out + "</strong>"
+		}
+		if annotations.Underline {
+			out = "<u>" + out + "</u>"
+		}
+		if link != "" {
+			out = `<a href="` + html.EscapeString(link) + `">` + out + "</a>"
+		}
+		builder.WriteString(out)
+	}
+	return builder.String()
+}
+
+func inlineLinksHTML(text string) string {
+	var builder strings.Builder
+	last := 0
+	for _, match := range notionLinkPattern.FindAllStringSubmatchIndex(text, -1) {
+		builder.WriteString(strings.ReplaceAll(html.EscapeString(text[last:match[0]]), "\n", "<br>"))
+		label, target := text[match[2]:match[3]], text[match[4]:match[5]]
+		if text[match[0]] == '!' {
+			builder.WriteString(imageHTML(target, label))
+		} else {
+			builder.WriteString(`<a href="` + html.EscapeString(target) + `">` + html.EscapeString(label) + "</a>")
+		}
+		last = match[1]
+	}
+	builder.WriteString(strings.ReplaceAll(html.EscapeString(text[last:]), "\n", "<br>"))
+	return builder.String()
+}
+
+// htmlToMarkdown converts an HTML document body to Markdown.
+func htmlToMarkdown(source string) string {
+	nodes, err := htmlparse.ParseFragment(strings.NewReader(source), &htmlparse.Node{
+		Type:     htmlparse.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return source
+	}
+	return notionBlocksMarkdown(htmlBlocks(nodes))
+}
+
+// htmlBlockReader collects the blocks of HTML content. Inline content is
+// gathered until a block element ends the paragraph it belongs to.
+type htmlBlockReader struct {
+	blocks notionapi.Blocks
+	inline []notionapi.RichText
+}
+
+func htmlBlocks(nodes []*htmlparse.Node) notionapi.Blocks {
+	reader := &htmlBlockReader{}
+	for _, node := range nodes {
+		reader.node(node, markdownStyle{})
+	}
+	reader.flush()
+	return reader.blocks
+}
+
+func htmlChildBlocks(node *htmlparse.Node) notionapi.Blocks {
+	var children []*htmlparse.Node
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		children = append(children, child)
+	}
+	return htmlBlocks(children)
+}
+
+func (r *htmlBlockReader) add(block notionapi.Block) {
+	r.flush()
+	r.blocks = append(r.blocks, block)
+}
+
+// flush ends the current paragraph.
+func (r *htmlBlockReader) flush() {
+	text := trimRichText(r.inline)
+	r.inline = nil
+	if len(text) == 0 {
+		return
+	}
+	r.blocks = append(r.blocks, &notionapi.ParagraphBlock{
+		BasicBlock: notionBasicBlock(notionapi.BlockTypeParagraph),
+		Paragraph:  notionapi.Paragraph{RichText: text},
+	})
+}
+
+func (r *htmlBlockReader) text(content string, style markdownStyle) {
+	if !style.code {
+		content = collapseHTMLSpace(content)
+		if r.endsWithSpace() {
+			content = strings.TrimLeft(content, " ")
+		}
+	}
+	if content == "" {
+		return
+	}
+	value := notionapi.RichText{
+		Type: notionapi.ObjectTypeText,
+		Text: &notionapi.Text{Content: content},
+		Annotations: &notionapi.Annotations{
+			Bold:          style.bold,
+			Italic:        style.italic,
+			Strikethrough: style.strike,
+			Underline:     style.underline,
+			Code:          style.code,
+		},
+	}
+	if style.link != "" {
+		value.Text.Link = &notionapi.Link{Url: style.link}
+	}
+	r.inline = append(r.inline, value)
+}
+
+func (r *htmlBlockReader) endsWithSpace() bool {
+	if len(r.inline) == 0 {
+		return true
+	}
+	last := r.inline[len(r.inline)-1]
+	return last.Text != nil && (strings.HasSuffix(last.Text.Content, " ") || strings.HasSuffix(last.Text.Content, "\n"))
+}
+
+func (r *htmlBlockReader) children(node *htmlparse.Node, style markdownStyle) {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		r.node(child, style)
+	}
+}
+
+func (r *htmlBlockReader) node(node *htmlparse.Node, style markdownStyle) {
+	switch node.Type {
+	case htmlparse.TextNode:
+		r.text(node.Data, style)
+		return
+	case htmlparse.ElementNode:
+	default:
+		return
+	}
+	switch node.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := max(int(node.Data[1]-'0')-1, 1)
+		r.flush()
+		r.children(node, style)
+		heading := notionapi.Heading{RichText: trimRichText(r.inline)}
+		r.inline = nil
+		switch level {
+		case 1:
+			r.add(&notionapi.Heading1Block{BasicBlock: notionBasicBlock(notionapi.BlockTypeHeading1), Heading1: heading})
+		case 2:
+			r.add(&notionapi.Heading2Block{BasicBlock: notionBasicBlock(notionapi.BlockTypeHeading2), Heading2: heading})
+		default:
+			r.add(&notionapi.Heading3Block{BasicBlock: notionBasicBlock(notionapi.BlockTypeHeading3), Heading3: heading})
+		}
+	case atom.Ul, atom.Ol:
+		r.flush()
+		todo := htmlHasClass(node, "todo-list")
+		for item := node.FirstChild; item != nil; item = item.NextSibling {
+			if item.DataAtom == atom.Li {
+				r.add(htmlListItem(item, node.DataAtom == atom.Ol, todo))
+			}
+		}
+	case atom.Blockquote:
+		text, children := splitLeadRichText(htmlChildBlocks(node))
+		r.add(&notionapi.QuoteBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeQuote),
+			Quote:      notionapi.Quote{RichText: text, Children: children},
+		})
+	case atom.Aside:
+		if !htmlHasClass(node, "admonition") {
+			r.flush()
+			r.children(node, style)
+			r.flush()
+			return
+		}
+		emoji := notionapi.Emoji(notionCalloutIcons["NOTE"])
+		for alert, icon := range notionCalloutIcons {
+			if htmlHasClass(node, strings.ToLower(alert)) {
+				emoji = notionapi.Emoji(icon)
+			}
+		}
+		text, children := splitLeadRichText(htmlChildBlocks(node))
+		r.add(&notionapi.CalloutBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeCallout),
+			Callout: notionapi.Callout{
+				RichText: text,
+				Icon:     &notionapi.Icon{Type: "emoji", Emoji: &emoji},
+				Children: children,
+			},
+		})
+	case atom.Pre:
+		language := ""
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if child.DataAtom == atom.Code {
+				language = htmlCodeLanguage(child)
+			}
+		}
+		r.add(&notionapi.CodeBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeCode),
+			Code: notionapi.Code{
+				RichText: notionPlainRichText(strings.TrimSuffix(htmlText(node), "\n")),
+				Language: notionCodeLanguage(language),
+			},
+		})
+	case atom.Hr:
+		r.add(&notionapi.DividerBlock{BasicBlock: notionBasicBlock(notionapi.BlockTypeDivider)})
+	case atom.Table:
+		r.add(htmlTable(node))
+	case atom.Img:
+		if block := htmlImage(node, ""); block != nil {
+			r.add(block)
+		}
+	case atom.Figure:
+		caption := ""
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if child.DataAtom == atom.Figcaption {
+				caption = strings.TrimSpace(collapseHTMLSpace(htmlText(child)))
+			}
+		}
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			switch child.DataAtom {
+			case atom.Img:
+				if block := htmlImage(child, caption); block != nil {
+					r.add(block)
+				}
+			case atom.Table:
+				r.add(htmlTable(child))
+			}
+		}
+	case atom.Br:
+		r.inline = append(r.inline, notionapi.RichText{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: "\n"}})
+	case atom.Strong, atom.B:
+		style.bold = true
+		r.children(node, style)
+	case atom.Em, atom.I:
+		style.italic = true
+		r.children(node, style)
+	case atom.S, atom.Del, atom.Strike:
+		style.strike = true
+		r.children(node, style)
+	case atom.U, atom.Ins:
+		style.underline = true
+		r.children(node, style)
+	case atom.Code, atom.Kbd, atom.Samp:
+		style.code = true
+		r.children(node, style)
+	case atom.A:
+		style.link = htmlAttr(node, "href")
+		r.children(node, style)
+	case atom.Span:
+		if htmlHasClass(node, "math-tex") {
+			r.math(htmlText(node))
+			return
+		}
+		r.children(node, style)
+	case atom.Script, atom.Style, atom.Input, atom.Template:
+	case atom.P, atom.Div, atom.Section, atom.Article, atom.Header, atom.Footer, atom.Main, atom.Nav, atom.Details, atom.Summary:
+		r.flush()
+		r.children(node, style)
+		r.flush()
+	default:
+		r.children(node, style)
+	}
+}
+
+// math reads a CKEditor math element, which wraps display equations in
+// \[ \] and inline ones in \( \).
+func (r *htmlBlockReader) math(source string) {
+	source = strings.TrimSpace(source)
+	if expression, ok := strings.CutPrefix(source, `\[`); ok {
+		r.add(&notionapi.EquationBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeEquation),
+			Equation:   notionapi.Equation{Expression: strings.TrimSpace(strings.TrimSuffix(expression, `\]`))},
+		})
+		return
+	}
+	expression := strings.TrimSuffix(strings.TrimPrefix(source, `\(`), `\)`)
+	r.inline = append(r.inline, notionapi.RichText{
+		Type:     notionapi.ObjectType("equation"),
+		Equation: &notionapi.Equation{Expression: strings.TrimSpace(expression)},
+	})
+}
+
+func htmlListItem(item *htmlparse.Node, numbered, todo bool) notionapi.Block {
+	text, children := splitLeadRichText(htmlChildBlocks(item))
+	switch {
+	case todo:
+		return &notionapi.ToDoBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeToDo),
+			ToDo:       notionapi.ToDo{RichText: text, Children: children, Checked: htmlChecked(item)},
+		}
+	case numbered:
+		return &notionapi.NumberedListItemBlock{
+			BasicBlock:       notionBasicBlock(notionapi.BlockTypeNumberedListItem),
+			NumberedListItem: notionapi.ListItem{RichText: text, Children: children},
+		}
+	default:
+		return &notionapi.BulletedListItemBlock{
+			BasicBlock:       notionBasicBlock(notionapi.BlockTypeBulletedListItem),
+			BulletedListItem: notionapi.ListItem{RichText: text, Children: children},
+		}
+	}
+}
+
+// htmlChecked reports whether the to-do item's own checkbox is ticked.
+func htmlChecked(item *htmlparse.Node) bool {
+	for node := item.FirstChild; node != nil; node = node.NextSibling {
+		if node.DataAtom == atom.Ul || node.DataAtom == atom.Ol {
+			continue
+		}
+		if node.DataAtom == atom.Input {
+			_, checked := htmlAttrValue(node, "checked")
+			return checked
+		}
+		if node.FirstChild != nil && htmlChecked(node) {
+			return true
+		}
+	}
+	return false
+}
+
+func htmlTable(node *htmlparse.Node) notionapi.Block {
+	table := &notionapi.TableBlock{
+		BasicBlock: notionBasicBlock(notionapi.BlockTypeTableBlock),
+		Table:      notionapi.Table{HasColumnHeader: true},
+	}
+	var rows func(*htmlparse.Node)
+	rows = func(parent *htmlparse.Node) {
+		for child := parent.FirstChild; child != nil; child = child.NextSibling {
+			switch child.DataAtom {
+			case atom.Thead, atom.Tbody, atom.Tfoot:
+				rows(child)
+			case atom.Tr:
+				var cells [][]notionapi.RichText
+				for cell := child.FirstChild; cell != nil; cell = cell.NextSibling {
+					if cell.DataAtom == atom.Th || cell.DataAtom == atom.Td {
+						cells = append(cells, htmlCellRichText(cell))
+					}
+				}
+				table.Table.TableWidth = max(table.Table.TableWidth, len(cells))
+				table.Table.Children = append(table.Table.Children, &notionapi.TableRowBlock{
+					BasicBlock: notionBasicBlock(notionapi.BlockTypeTableRowBlock),
+					TableRow:   notionapi.TableRow{Cells: cells},
+				})
+			}
+		}
+	}
+	rows(node)
+	return table
+}
+
+// htmlCellRichText reads a table cell, joining its paragraphs with line
+// breaks.
+func htmlCellRichText(cell *htmlparse.Node) []notionapi.RichText {
+	values := []notionapi.RichText{}
+	for _, block := range htmlChildBlocks(cell) {
+		paragraph, ok := block.(*notionapi.ParagraphBlock)
+		if !ok {
+			continue
+		}
+		if len(values) > 0 {
+			values = append(values, notionapi.RichText{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: "\n"}})
+		}
+		values = append(values, paragraph.Paragraph.RichText...)
+	}
+	return values
+}
+
+func htmlImage(node *htmlparse.Node, caption string) notionapi.Block {
+	src := htmlAttr(node, "src")
+	if src == "" {
+		return nil
+	}
+	var text []notionapi.RichText
+	if caption = defaultString(caption, htmlAttr(node, "alt")); caption != "" {
+		text = notionPlainRichText(caption)
+	}
+	return &notionapi.ImageBlock{
+		BasicBlock: notionBasicBlock(notionapi.BlockTypeImage),
+		Image: notionapi.Image{
+			Caption:  text,
+			Type:     notionapi.FileTypeExternal,
+			External: &notionapi.FileObject{URL: src},
+		},
+	}
+}
+
+// htmlCodeLanguage reads the language of a code element from its class.
+// CKEditor names languages by MIME type, such as language-text-x-python.
+func htmlCodeLanguage(node *htmlparse.Node) string {
+	for _, class := range strings.Fields(htmlAttr(node, "class")) {
+		language, ok := strings.CutPrefix(class, "language-")
+		if !ok {
+			continue
+		}
+		switch {
+		case language == "text-plain" || language == "auto":
+			return ""
+		case strings.HasPrefix(language, "text-x-"):
+			return strings.TrimPrefix(language, "text-x-")
+		case strings.HasPrefix(language, "application-javascript"):
+			return "javascript"
+		case strings.HasPrefix(language, "application-"), strings.HasPrefix(language, "text-"):
+			return language[strings.Index(language, "-")+1:]
+		default:
+			return language
+		}
+	}
+	return ""
+}
+
+// splitLeadRichText takes a leading paragraph as a container's own text.
+func splitLeadRichText(blocks notionapi.Blocks) ([]notionapi.RichText, notionapi.Blocks) {
+	if len(blocks) > 0 {
+		if paragraph, ok := blocks[0].(*notionapi.ParagraphBlock); ok {
+			return paragraph.Paragraph.RichText, blocks[1:]
+		}
+	}
+	return []notionapi.RichText{}, blocks
+}
+
+// trimRichText drops the whitespace at both ends of inline text.
+func trimRichText(values []notionapi.RichText) []notionapi.RichText {
+	for len(values) > 0 && values[0].Text != nil {
+		values[0].Text.Content = strings.TrimLeft(values[0].Text.Content, " \n")
+		if values[0].Text.Content != "" {
+			break
+		}
+		values = values[1:]
+	}
+	for len(values) > 0 && values[len(values)-1].Text != nil {
+		last := values[len(values)-1].Text
+		last.Content = strings.TrimRight(last.Content, " \n")
+		if last.Content != "" {
+			break
+		}
+		values = values[:len(values)-1]
+	}
+	return values
+}
+
+// collapseHTMLSpace folds runs of whitespace into one space, as browsers
+// render them.
+func collapseHTMLSpace(text string) string {
+	var builder strings.Builder
+	space := false
+	for _, r := range text {
+		if isHTMLSpace(r) {
+			if !space {
+				builder.WriteByte(' ')
+			}
+			space = true
+			continue
+		}
+		space = false
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+func isHTMLSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == '\f'
+}
+
+func htmlText(node *htmlparse.Node) string {
+	if node.Type == htmlparse.TextNode {
+		return node.Data
+	}
+	if node.DataAtom == atom.Br {
+		return "\n"
+	}
+	var builder strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		builder.WriteString(htmlText(child))
+	}
+	return builder.String()
+}
+
+func htmlAttr(node *htmlparse.Node, name string) string {
+	value, _ := htmlAttrValue(node, name)
+	return value
+}
+
+func htmlAttrValue(node *htmlparse.Node, name string) (string, bool) {
+	for _, attr := range node.Attr {
+		if attr.Key == name {
+			return attr.Val, true
+		}
+	}
+	return "", false
+}
+
+func htmlHasClass(node *htmlparse.Node, class string) bool {
+	for _, value := range strings.Fields(htmlAttr(node, "class")) {
+		if value == class {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package connections
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkdownToHTMLWritesTriliumMarkup(t *testing.T) {
+	html := markdownToHTML("## Plan\n\n- [x] Done\n- [ ] Next\n\n```go\nfmt.Println(1)\n```\n\n> quoted\n\n![chart](api/attachments/a1/image/chart.png)\n")
+
+	for _, want := range []string{
+		"<h3>Plan</h3>",
+		`<ul class="todo-list">`,
+		`checked="checked"`,
+		`<pre><code class="language-text-x-go">fmt.Println(1)</code></pre>`,
+		"<blockquote>",
+		`<img src="api/attachments/a1/image/chart.png" alt="chart">`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("html %q does not contain %q", html, want)
+		}
+	}
+}
+
+func TestHTMLMarkdownRoundTrip(t *testing.T) {
+	for _, markdown := range []string{
+		"# Title\n\nSome **bold**, *italic* and `code` with [a link](https://example.com).",
+		"- one\n- two\n\nThen:\n\n1. first\n2. second",
+		"- [x] done\n- [ ] open",
+		"```python\nprint(1)\n```",
+		"> quoted text",
+		"Before\n\n---\n\nAfter",
+	} {
+		got := htmlToMarkdown(markdownToHTML(markdown))
+		if got != markdown {
+			t.Errorf("round trip of %q gave %q", markdown, got)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package connections
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/folder"
+	"smarticky/ent/note"
+	"smarticky/ent/noteconnectionitemmap"
+	"smarticky/ent/noteconnectionjob"
+	"smarticky/ent/user"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DuplicateLinked marks a remote note already imported into this
+	// account; importing skips it.
+	DuplicateLinked = "linked"
+	// DuplicateTitle marks a remote note whose title matches a local note
+	// that is not linked to it; importing creates a second note.
+	DuplicateTitle = "title"
+)
+
+var (
+	ErrPreviewClosed       = errors.New("import preview was already confirmed or discarded")
+	ErrNothingSelected     = errors.New("select at least one note to import")
+	ErrInvalidImportFolder = errors.New("import folder does not exist")
+)
+
+// ImportPreview lists what an import would do without changing anything.
+// It is kept as a previewed job until it is confirmed or discarded.
+type ImportPreview struct {
+	Job            *ent.NoteConnectionJob `json:"job"`
+	JobID          int                    `json:"job_id"`
+	TotalCount     int                    `json:"total_count"`
+	DuplicateCount int                    `json:"duplicate_count"`
+	Items          []ImportPreviewItem    `json:"items"`
+	Folders        []ImportPreviewFolder  `json:"folders"`
+}
+
+type ImportPreviewItem struct {
+	ExternalID string     `json:"external_id"`
+	Title      string     `json:"title"`
+	Path       string     `json:"path,omitempty"`
+	Folder     string     `json:"folder"`
+	Tags       []string   `json:"tags"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+	Duplicate  string     `json:"duplicate,omitempty"`
+	NoteID     *uuid.UUID `json:"note_id,omitempty"`
+}
+
+// ImportPreviewFolder is a local folder path the import would file notes
+// under. LocalFolderID is set when that folder already exists.
+type ImportPreviewFolder struct {
+	Path          string     `json:"path"`
+	NoteCount     int        `json:"note_count"`
+	LocalFolderID *uuid.UUID `json:"local_folder_id,omitempty"`
+}
+
+// ImportConfirmRequest picks the previewed notes to import. FolderMap
+// files the notes of a previewed folder path into an existing local folder
+// instead, or into no folder when the value is empty.
+type ImportConfirmRequest struct {
+	JobID       int               `json:"job_id"`
+	ExternalIDs []string          `json:"external_ids"`
+	FolderMap   map[string]string `json:"folder_map,omitempty"`
+}
+
+// PreviewImport lists the remote notes an import with req would bring in,
+// with the folder and tags each would get and whether it duplicates a local
+// note.
+func (s *Service) PreviewImport(ctx context.Context, userID, accountID int, req ImportRequest) (*ImportPreview, error) {
+	row, err := s.accountForUser(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureAccountEnabled(row); err != nil {
+		return nil, err
+	}
+	provider, err := s.providerForAccount(ctx, row, nil)
+	if err != nil {
+		return nil, err
+	}
+	options := importOptions{
+		TargetID:                strings.TrimSpace(req.TargetID),
+		Limit:                   clampLimit(req.Limit),
+		PreserveRemoteHierarchy: importPreserveRemoteHierarchy(req),
+	}
+	remoteNotes, err := provider.ImportNotes(ctx, options.TargetID, options.Limit)
+	if err != nil {
+		return nil, redactProviderError(err)
+	}
+
+	externalIDs := make([]string, 0, len(remoteNotes))
+	for _, remote := range remoteNotes {
+		if strings.TrimSpace(remote.ExternalID) != "" {
+			externalIDs = append(externalIDs, remote.ExternalID)
+		}
+	}
+	linked, err := s.linkedNotes(ctx, row.ID, externalIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &ImportPreview{Items: make([]ImportPreviewItem, 0, len(externalIDs)), Folders: []ImportPreviewFolder{}}
+	folderIndex := map[string]int{}
+	for _, remote := range remoteNotes {
+		if strings.TrimSpace(remote.ExternalID) == "" {
+			continue
+		}
+		segments := importFolderSegments(row, remote, options.PreserveRemoteHierarchy)
+		item := ImportPreviewItem{
+			ExternalID: remote.ExternalID,
+			Title:      titleOrUntitled(remote.Title),
+			Path:       remote.Path,
+			Folder:     strings.Join(segments, "/"),
+			Tags:       uniqueStrings(remote.Tags),
+			UpdatedAt:  remote.UpdatedAt,
+		}
+		if noteID, ok := linked[remote.ExternalID]; ok {
+			item.Duplicate = DuplicateLinked
+			item.NoteID = &noteID
+		} else if noteID, err := s.noteWithTitle(ctx, userID, item.Title); err != nil {
+			return nil, err
+		} else if noteID != nil {
+			item.Duplicate = DuplicateTitle
+			item.NoteID = noteID
+		}
+		if item.Duplicate != "" {
+			preview.DuplicateCount++
+		}
+		if index, ok := folderIndex[item.Folder]; ok {
+			preview.Folders[index].NoteCount++
+		} else if item.Folder != "" {
+			existing, err := s.folderAtPath(ctx, userID, segments)
+			if err != nil {
+				return nil, err
+			}
+			folderIndex[item.Folder] = len(preview.Folders)
+			preview.Folders = append(preview.Folders, ImportPreviewFolder{Path: item.Folder, NoteCount: 1, LocalFolderID: existing})
+		}
+		preview.Items = append(preview.Items, item)
+	}
+
+	// An account has one open preview; a new one replaces it.
+	if err := s.client.NoteConnectionJob.Update().
+		Where(
+			noteconnectionjob.AccountIDEQ(row.ID),
+			noteconnectionjob.StatusEQ(JobPreviewed),
+		).
+		SetStatus(JobCanceled).
+		SetCompletedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	options.ExternalIDs = externalIDs
+	job, err := s.client.NoteConnectionJob.Create().
+		SetProvider(row.Provider).
+		SetOperation(OperationImport).
+		SetUserID(userID).
+		SetAccountID(row.ID).
+		SetStatus(JobPreviewed).
+		SetTotalCount(len(preview.Items)).
+		SetSkippedCount(len(linked)).
+		SetOptionsJSON(encodeImportOptions(options)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	preview.Job = job
+	preview.JobID = job.ID
+	preview.TotalCount = len(preview.Items)
+	return preview, nil
+}
+
+// ConfirmImport narrows a previewed import to the selected notes, applies
+// the folder choices and queues it. Notes are imported exactly as a normal
+// import would, so linked notes are still skipped.
+func (s *Service) ConfirmImport(ctx context.Context, userID, accountID int, req ImportConfirmRequest) (*ent.NoteConnectionJob, error) {
+	job, err := s.client.NoteConnectionJob.Query().
+		Where(
+			noteconnectionjob.ID(req.JobID),
+			noteconnectionjob.UserIDEQ(userID),
+			noteconnectionjob.AccountIDEQ(accountID),
+			noteconnectionjob.OperationEQ(OperationImport),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != JobPreviewed {
+		return nil, ErrPreviewClosed
+	}
+	options, err := decodeImportOptions(job.OptionsJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := map[string]bool{}
+	for _, id := range req.ExternalIDs {
+		wanted[strings.TrimSpace(id)] = true
+	}
+	selected := make([]string, 0, len(wanted))
+	for _, id := range options.ExternalIDs {
+		if wanted[id] {
+			selected = append(selected, id)
+		}
+	}
+	if len(selected) == 0 {
+		return nil, ErrNothingSelected
+	}
+	folderMap := make(map[string]string, len(req.FolderMap))
+	for path, folderID := range req.FolderMap {
+		folderID = strings.TrimSpace(folderID)
+		if folderID != "" {
+			if err := s.checkImportFolder(ctx, s.client, userID, folderID); err != nil {
+				return nil, err
+			}
+		}
+		folderMap[strings.Trim(strings.TrimSpace(path), "/")] = folderID
+	}
+	if _, err := s.queueableAccount(ctx, userID, accountID, OperationImport); err != nil {
+		return nil, err
+	}
+	options.ExternalIDs = selected
+	options.FolderMap = folderMap
+
+	updated, err := s.client.NoteConnectionJob.Update().
+		Where(noteconnectionjob.ID(job.ID), noteconnectionjob.StatusEQ(JobPreviewed)).
+		SetStatus(JobPending).
+		SetMaxAttempts(defaultJobAttempts).
+		SetTotalCount(len(selected)).
+		SetSkippedCount(0).
+		SetOptionsJSON(encodeImportOptions(options)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, ErrPreviewClosed
+	}
+	s.queue.notify()
+	return s.GetJob(ctx, userID, job.ID)
+}
+
+// selectRemoteNotes keeps the notes with the given external ids.
+func selectRemoteNotes(remoteNotes []RemoteNote, externalIDs []string) []RemoteNote {
+	wanted := make(map[string]bool, len(externalIDs))
+	for _, id := range externalIDs {
+		wanted[id] = true
+	}
+	selected := make([]RemoteNote, 0, len(externalIDs))
+	for _, remote := range remoteNotes {
+		if wanted[remote.ExternalID] {
+			selected = append(selected, remote)
+		}
+	}
+	return selected
+}
+
+// linkedNotes maps the external ids already imported into the account to
+// their local notes.
+func (s *Service) linkedNotes(ctx context.Context, accountID int, externalIDs []string) (map[string]uuid.UUID, error) {
+	linked := map[string]uuid.UUID{}
+	if len(externalIDs) == 0 {
+		return linked, nil
+	}
+	items, err := s.client.NoteConnectionItemMap.Query().
+		Where(
+			noteconnectionitemmap.AccountIDEQ(accountID),
+			noteconnectionitemmap.ExternalIDIn(externalIDs...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		linked[item.ExternalID] = item.NoteID
+	}
+	return linked, nil
+}
+
+func (s *Service) noteWithTitle(ctx context.Context, userID int, title string) (*uuid.UUID, error) {
+	existing, err := s.client.Note.Query().
+		Where(
+			note.TitleEqualFold(title),
+			note.IsDeletedEQ(false),
+			note.HasUserWith(user.IDEQ(userID)),
+		).
+		Order(ent.Asc(note.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &existing.ID, nil
+}
+
+// folderAtPath finds the folder an import would reuse for the path, or nil
+// when the import would create it.
+func (s *Service) folderAtPath(ctx context.Context, userID int, segments []string) (*uuid.UUID, error) {
+	var parent *ent.Folder
+	for _, name := range cleanRemoteFolderSegments(segments) {
+		query := s.client.Folder.Query().
+			Where(folder.NameEQ(name), folder.HasUserWith(user.IDEQ(userID))).
+			Order(ent.Asc(folder.FieldCreatedAt))
+		if parent == nil {
+			query.Where(folder.Not(folder.HasParent()))
+		} else {
+			query.Where(folder.HasParentWith(folder.ID(parent.ID)))
+		}
+		next, err := query.First(ctx)
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		parent = next
+	}
+	if parent == nil {
+		return nil, nil
+	}
+	return &parent.ID, nil
+}
+
+func (s *Service) checkImportFolder(ctx context.Context, client *ent.Client, userID int, folderID string) error {
+	id, err := uuid.Parse(folderID)
+	if err != nil {
+		return ErrInvalidImportFolder
+	}
+	exists, err := client.Folder.Query().
+		Where(folder.ID(id), folder.HasUserWith(user.IDEQ(userID))).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrInvalidImportFolder
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package connections
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"smarticky/ent/enttest"
+	"smarticky/ent/note"
+)
+
+func TestPreviewImportListsNotesAndConfirmsSelection(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestPreviewImportListsNotesAndConfirmsSelection?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeMemosJSON(t, w, map[string]any{"memos": []map[string]any{
+			{"name": "memos/1", "content": "# Plan\n\nShip it", "tags": []string{"work/projects", "urgent"}},
+			{"name": "memos/2", "content": "# Groceries\n\nMilk", "tags": []string{"home"}},
+			{"name": "memos/3", "content": "# Linked\n\nAlready here", "tags": []string{"home"}},
+		}})
+	}))
+	defer server.Close()
+
+	u := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	service := NewService(client, testSecretBox(t), nil)
+	account, err := service.CreateAccount(ctx, u.ID, AccountInput{
+		Provider: ProviderMemos,
+		Endpoint: server.URL,
+		Token:    stringPtr("memos-token"),
+		Enabled:  true,
+	})
+	if err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+	home := client.Folder.Create().SetName("home").SetUserID(u.ID).SaveX(ctx)
+	inbox := client.Folder.Create().SetName("Inbox").SetUserID(u.ID).SaveX(ctx)
+	groceries := client.Note.Create().SetTitle("groceries").SetContent("old list").SetUserID(u.ID).SaveX(ctx)
+	linked := client.Note.Create().SetTitle("Linked").SetContent("Already here").SetUserID(u.ID).SaveX(ctx)
+	client.NoteConnectionItemMap.Create().
+		SetProvider(ProviderMemos).
+		SetAccountID(account.ID).
+		SetNoteID(linked.ID).
+		SetExternalID("memos/3").
+		ExecX(ctx)
+
+	preview, err := service.PreviewImport(ctx, u.ID, account.ID, ImportRequest{})
+	if err != nil {
+		t.Fatalf("preview import: %v", err)
+	}
+	if preview.Job.Status != JobPreviewed || preview.TotalCount != 3 || preview.DuplicateCount != 2 {
+		t.Fatalf("preview = %+v, want three notes and two duplicates", preview)
+	}
+	plan, grocery, linkedItem := preview.Items[0], preview.Items[1], preview.Items[2]
+	if plan.Folder != "work/projects" || len(plan.Tags) != 1 || plan.Tags[0] != "urgent" || plan.Duplicate != "" {
+		t.Fatalf("plan = %+v, want folder work/projects and tag urgent", plan)
+	}
+	if grocery.Duplicate != DuplicateTitle || grocery.NoteID == nil || *grocery.NoteID != groceries.ID {
+		t.Fatalf("groceries = %+v, want a title duplicate of the local note", grocery)
+	}
+	if linkedItem.Duplicate != DuplicateLinked || linkedItem.NoteID == nil || *linkedItem.NoteID != linked.ID {
+		t.Fatalf("linked = %+v, want the already imported note", linkedItem)
+	}
+	if len(preview.Folders) != 2 || preview.Folders[0].LocalFolderID != nil ||
+		preview.Folders[1].Path != "home" || preview.Folders[1].NoteCount != 2 ||
+		preview.Folders[1].LocalFolderID == nil || *preview.Folders[1].LocalFolderID != home.ID {
+		t.Fatalf("folders = %+v, want work/projects to be new and home to exist", preview.Folders)
+	}
+	if n := client.Note.Query().CountX(ctx); n != 2 {
+		t.Fatalf("preview created notes: count = %d, want 2", n)
+	}
+
+	if _, err := service.ConfirmImport(ctx, u.ID, account.ID, ImportConfirmRequest{JobID: preview.JobID, ExternalIDs: []string{"memos/unknown"}}); !errors.Is(err, ErrNothingSelected) {
+		t.Fatalf("empty selection error = %v, want ErrNothingSelected", err)
+	}
+	if _, err := service.ConfirmImport(ctx, u.ID, account.ID, ImportConfirmRequest{
+		JobID:       preview.JobID,
+		ExternalIDs: []string{"memos/1"},
+		FolderMap:   map[string]string{"work/projects": "not-a-folder"},
+	}); !errors.Is(err, ErrInvalidImportFolder) {
+		t.Fatalf("bad folder error = %v, want ErrInvalidImportFolder", err)
+	}
+	job, err := service.ConfirmImport(ctx, u.ID, account.ID, ImportConfirmRequest{
+		JobID:       preview.JobID,
+		ExternalIDs: []string{"memos/1", "memos/3"},
+		FolderMap:   map[string]string{"work/projects": inbox.ID.String()},
+	})
+	if err != nil {
+		t.Fatalf("confirm import: %v", err)
+	}
+	if job.Status != JobPending || job.TotalCount != 2 {
+		t.Fatalf("job = %+v, want a pending job for the two selected notes", job)
+	}
+	if _, err := service.ConfirmImport(ctx, u.ID, account.ID, ImportConfirmRequest{JobID: preview.JobID, ExternalIDs: []string{"memos/1"}}); !errors.Is(err, ErrPreviewClosed) {
+		t.Fatalf("second confirm error = %v, want ErrPreviewClosed", err)
+	}
+
+	result, err := service.runImportJob(ctx, job)
+	if err != nil {
+		t.Fatalf("run import job: %v", err)
+	}
+	if result.ImportedCount != 1 || result.SkippedCount != 1 || result.TotalCount != 2 {
+		t.Fatalf("result = %+v, want the plan imported and the linked note skipped", result)
+	}
+	imported := client.Note.Query().Where(note.TitleEQ("Plan")).WithFolder().OnlyX(ctx)
+	if imported.Edges.Folder == nil || imported.Edges.Folder.ID != inbox.ID {
+		t.Fatalf("plan folder = %+v, want the remapped Inbox folder", imported.Edges.Folder)
+	}
+	if n := client.Note.Query().Where(note.TitleEQ("Groceries")).CountX(ctx); n != 0 {
+		t.Fatalf("deselected note was imported")
+	}
+	if n := client.Folder.Query().CountX(ctx); n != 2 {
+		t.Fatalf("folder count = %d, want no folders created for the remapped path", n)
+	}
+}
+
+func TestPreviewImportReplacesOpenPreview(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestPreviewImportReplacesOpenPreview?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	joplin := newFakeJoplin(t, "joplin-token")
+	joplin.put(joplinNote{ID: "alpha", Title: "Alpha", Body: "Alpha v1"})
+	u := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	service := NewService(client, testSecretBox(t), nil)
+	account, err := service.CreateAccount(ctx, u.ID, AccountInput{
+		Provider: ProviderJoplin,
+		Endpoint: joplin.server.URL,
+		Token:    stringPtr("joplin-token"),
+		Enabled:  true,
+	})
+	if err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+
+	first, err := service.PreviewImport(ctx, u.ID, account.ID, ImportRequest{})
+	if err != nil {
+		t.Fatalf("first preview: %v", err)
+	}
+	second, err := service.PreviewImport(ctx, u.ID, account.ID, ImportRequest{})
+	if err != nil {
+		t.Fatalf("second preview: %v", err)
+	}
+	if got := client.NoteConnectionJob.GetX(ctx, first.JobID).Status; got != JobCanceled {
+		t.Fatalf("first preview status = %q, want canceled", got)
+	}
+	if _, err := service.ConfirmImport(ctx, u.ID, account.ID, ImportConfirmRequest{JobID: first.JobID, ExternalIDs: []string{"alpha"}}); !errors.Is(err, ErrPreviewClosed) {
+		t.Fatalf("confirm replaced preview error = %v, want ErrPreviewClosed", err)
+	}
+
+	discarded, err := service.CancelJob(ctx, u.ID, second.JobID)
+	if err != nil || discarded.Status != JobCanceled {
+		t.Fatalf("discard preview = %+v, %v, want canceled", discarded, err)
+	}
+	if n := client.Note.Query().CountX(ctx); n != 0 {
+		t.Fatalf("previews imported %d notes, want none", n)
+	}
+}
@@ -0,0 +1,1357 @@
+package connections
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jomei/notionapi"
+)
+
+// Notion pages are converted to Markdown and back block by block. Block
+// types without a Markdown counterpart use the closest common extension:
+// toggles become <details> elements, callouts GitHub alert quotes and
+// equations are written between dollar signs as the note renderer expects.
+
+const (
+	// notionMaxRichText is the longest text one rich text object may hold.
+	notionMaxRichText = 2000
+	// notionMaxBlocks is the most blocks one request may append.
+	notionMaxBlocks = 100
+	// notionMaxDepth limits how deep nested blocks are read.
+	notionMaxDepth = 8
+)
+
+// notionCalloutIcons maps the GitHub alert types to the icons of the
+// callouts they become.
+var notionCalloutIcons = map[string]string{
+	"NOTE":      "ℹ️",
+	"TIP":       "💡",
+	"IMPORTANT": "❗",
+	"WARNING":   "⚠️",
+	"CAUTION":   "🛑",
+}
+
+// notionCodeLanguages lists the languages Notion accepts for code blocks.
+var notionCodeLanguages = func() map[string]bool {
+	names := "abap|arduino|bash|basic|c|clojure|coffeescript|c++|c#|css|dart|diff|docker|elixir|elm|erlang|flow|" +
+		"fortran|f#|gherkin|glsl|go|graphql|groovy|haskell|html|java|javascript|json|julia|kotlin|latex|less|lisp|" +
+		"livescript|lua|makefile|markdown|markup|matlab|mermaid|nix|objective-c|ocaml|pascal|perl|php|plain text|" +
+		"powershell|prolog|protobuf|python|r|reason|ruby|rust|sass|scala|scheme|scss|shell|sql|swift|typescript|" +
+		"vb.net|verilog|vhdl|visual basic|webassembly|xml|yaml|java/c/c++/c#"
+	languages := map[string]bool{}
+	for _, name := range strings.Split(names, "|") {
+		languages[name] = true
+	}
+	return languages
+}()
+
+// notionCodeAliases maps common fence languages to Notion's names.
+var notionCodeAliases = map[string]string{
+	"cpp":        "c++",
+	"cs":         "c#",
+	"csharp":     "c#",
+	"dockerfile": "docker",
+	"fsharp":     "f#",
+	"golang":     "go",
+	"js":         "javascript",
+	"jsx":        "javascript",
+	"kt":         "kotlin",
+	"md":         "markdown",
+	"objc":       "objective-c",
+	"plaintext":  "plain text",
+	"proto":      "protobuf",
+	"ps1":        "powershell",
+	"py":         "python",
+	"rb":         "ruby",
+	"rs":         "rust",
+	"sh":         "shell",
+	"tex":        "latex",
+	"text":       "plain text",
+	"ts":         "typescript",
+	"tsx":        "typescript",
+	"txt":        "plain text",
+	"yml":        "yaml",
+	"zsh":        "shell",
+}
+
+var (
+	mdHeadingPattern        = regexp.MustCompile(`^(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	mdListPattern           = regexp.MustCompile(`^(?:[-*+]|\d{1,9}[.)])(?:[ \t]+|$)`)
+	mdTaskPattern           = regexp.MustCompile(`^\[([ xX])\](?:[ \t]+|$)`)
+	mdDividerPattern        = regexp.MustCompile(`^(?:(?:-[ \t]*){3,}|(?:\*[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	mdFencePattern          = regexp.MustCompile("^(`{3,}|~{3,})[ \t]*([^`]*)$")
+	mdTableDelimiterPattern = regexp.MustCompile(`^\|?[ \t]*:?-+:?[ \t]*(?:\|[ \t]*:?-+:?[ \t]*)*\|?$`)
+	mdAlertPattern          = regexp.MustCompile(`^\[!([A-Za-z]+)\][ \t]*(.*)$`)
+	mdDetailsEndPattern     = regexp.MustCompile(`(?i)</details>`)
+	mdSummaryPattern        = regexp.MustCompile(`(?is)^\s*<summary>(.*?)</summary>`)
+	mdAutolinkPattern       = regexp.MustCompile(`^<((?:https?|mailto):[^\s<>]+)>`)
+	mdLineBreakPattern      = regexp.MustCompile(`(?i)^<br\s*/?>`)
+)
+
+type mdBlockKind int
+
+const (
+	mdParagraph mdBlockKind = iota
+	mdHeading
+	mdBulleted
+	mdNumbered
+	mdToDo
+	mdQuote
+	mdCallout
+	mdToggle
+	mdCode
+	mdEquation
+	mdDivider
+	mdTable
+	mdFile
+)
+
+// mdBlock is a Markdown block, limited to what Notion can represent.
+type mdBlock struct {
+	kind mdBlockKind
+	// text is inline Markdown, except for code and equations.
+	text     string
+	level    int
+	language string
+	checked  bool
+	icon     string
+	rows     [][]string
+	children []*mdBlock
+}
+
+// markdownToNotionBlocks converts Markdown to Notion blocks. Nested blocks
+// are kept as children; appendBlocks sends them level by level.
+func markdownToNotionBlocks(markdown string) notionapi.Blocks {
+	lines := strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n")
+	return notionBlocksFromMarkdown(parseMarkdownBlocks(lines))
+}
+
+func parseMarkdownBlocks(lines []string) []*mdBlock {
+	var blocks []*mdBlock
+	var paragraph []string
+	flush := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, &mdBlock{kind: mdParagraph, text: strings.Join(paragraph, "\n")})
+			paragraph = nil
+		}
+	}
+	for i := 0; i < len(lines); {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" {
+			flush()
+			i++
+			continue
+		}
+		block, next := parseMarkdownBlock(lines, i)
+		if block == nil {
+			paragraph = append(paragraph, trimmed)
+			i++
+			continue
+		}
+		flush()
+		blocks = append(blocks, block)
+		i = next
+	}
+	flush()
+	return blocks
+}
+
+// parseMarkdownBlock parses the block starting at line i and returns it
+// with the index of the line after it, or nil when the line continues a
+// paragraph.
+func parseMarkdownBlock(lines []string, i int) (*mdBlock, int) {
+	indent := markdownIndent(lines[i])
+	if indent > 3 {
+		return nil, i
+	}
+	trimmed := strings.TrimSpace(lines[i])
+	lower := strings.ToLower(trimmed)
+	switch {
+	case mdFencePattern.MatchString(trimmed):
+		match := mdFencePattern.FindStringSubmatch(trimmed)
+		return parseMarkdownFence(lines, i, indent, match[1], match[2])
+	case strings.HasPrefix(trimmed, "$$"):
+		return parseMarkdownEquation(lines, i)
+	case mdHeadingPattern.MatchString(trimmed):
+		match := mdHeadingPattern.FindStringSubmatch(trimmed)
+		return &mdBlock{kind: mdHeading, level: len(match[1]), text: match[2]}, i + 1
+	case mdDividerPattern.MatchString(trimmed):
+		return &mdBlock{kind: mdDivider}, i + 1
+	case strings.HasPrefix(trimmed, ">"):
+		return parseMarkdownQuote(lines, i)
+	case strings.HasPrefix(lower, "<details>") || strings.HasPrefix(lower, "<details "):
+		return parseMarkdownDetails(lines, i)
+	case mdListPattern.MatchString(trimmed):
+		return parseMarkdownListItem(lines, i)
+	case i+1 < len(lines) && strings.Contains(trimmed, "|") && mdTableDelimiterPattern.MatchString(strings.TrimSpace(lines[i+1])):
+		return parseMarkdownTable(lines, i)
+	}
+	if _, ok := notionFileBlock(trimmed); ok {
+		return &mdBlock{kind: mdFile, text: trimmed}, i + 1
+	}
+	return nil, i
+}
+
+func parseMarkdownFence(lines []string, i, indent int, fence, info string) (*mdBlock, int) {
+	block := &mdBlock{kind: mdCode, language: strings.TrimSpace(info)}
+	var code []string
+	for j := i + 1; j < len(lines); j++ {
+		trimmed := strings.TrimSpace(lines[j])
+		if len(trimmed) >= len(fence) && strings.Trim(trimmed, fence[:1]) == "" {
+			block.text = strings.Join(code, "\n")
+			return block, j + 1
+		}
+		code = append(code, dedentMarkdown(lines[j], indent))
+	}
+	// An unclosed fence runs to the end of the document.
+	block.text = strings.Join(code, "\n")
+	return block, len(lines)
+}
+
+func parseMarkdownEquation(lines []string, i int) (*mdBlock, int) {
+	first := strings.TrimSpace(lines[i])[2:]
+	if expression, ok := strings.CutSuffix(first, "$$"); ok {
+		return &mdBlock{kind: mdEquation, text: strings.TrimSpace(expression)}, i + 1
+	}
+	var parts []string
+	if strings.TrimSpace(first) != "" {
+		parts = append(parts, first)
+	}
+	for j := i + 1; j < len(lines); j++ {
+		trimmed := strings.TrimSpace(lines[j])
+		if expression, ok := strings.CutSuffix(trimmed, "$$"); ok {
+			if expression != "" {
+				parts = append(parts, expression)
+			}
+			return &mdBlock{kind: mdEquation, text: strings.TrimSpace(strings.Join(parts, "\n"))}, j + 1
+		}
+		parts = append(parts, trimmed)
+	}
+	return nil, i
+}
+
+func parseMarkdownQuote(lines []string, i int) (*mdBlock, int) {
+	var inner []string
+	j := i
+	for ; j < len(lines); j++ {
+		trimmed := strings.TrimLeft(lines[j], " \t")
+		if !strings.HasPrefix(trimmed, ">") {
+			break
+		}
+		inner = append(inner, strings.TrimPrefix(trimmed[1:], " "))
+	}
+	block := &mdBlock{kind: mdQuote}
+	if match := mdAlertPattern.FindStringSubmatch(strings.TrimSpace(inner[0])); match != nil {
+		if icon, ok := notionCalloutIcons[strings.ToUpper(match[1])]; ok {
+			block.kind = mdCallout
+			block.icon = icon
+			inner = inner[1:]
+			if rest := strings.TrimSpace(match[2]); isEmojiToken(rest) {
+				block.icon = rest
+			} else if rest != "" {
+				inner = append([]string{rest}, inner...)
+			}
+		}
+	}
+	block.text, block.children = splitLeadParagraph(parseMarkdownBlocks(inner))
+	return block, j
+}
+
+func parseMarkdownDetails(lines []string, i int) (*mdBlock, int) {
+	depth := 0
+	for j := i; j < len(lines); j++ {
+		lower := strings.ToLower(lines[j])
+		depth += strings.Count(lower, "<details") - strings.Count(lower, "</details>")
+		if depth > 0 {
+			continue
+		}
+		html := strings.Join(lines[i:j+1], "\n")
+		ends := mdDetailsEndPattern.FindAllStringIndex(html, -1)
+		inner := html[strings.Index(html, ">")+1 : ends[len(ends)-1][0]]
+		block := &mdBlock{kind: mdToggle}
+		if match := mdSummaryPattern.FindStringSubmatchIndex(inner); match != nil {
+			block.text = strings.TrimSpace(inner[match[2]:match[3]])
+			inner = inner[match[1]:]
+		}
+		block.children = parseMarkdownBlocks(strings.Split(inner, "\n"))
+		return block, j + 1
+	}
+	// Without a closing tag the element is kept as text.
+	return nil, i
+}
+
+func parseMarkdownListItem(lines []string, i int) (*mdBlock, int) {
+	line := lines[i]
+	indent := markdownIndent(line)
+	rest := strings.TrimLeft(line, " \t")
+	marker := mdListPattern.FindString(rest)
+	content := rest[len(marker):]
+	symbol := strings.TrimSpace(marker)
+	width := indent + len(marker)
+	if strings.TrimSpace(content) == "" || len(marker)-len(symbol) > 4 {
+		width = indent + len(symbol) + 1
+	}
+
+	block := &mdBlock{kind: mdBulleted}
+	if last := symbol[len(symbol)-1]; last == '.' || last == ')' {
+		block.kind = mdNumbered
+	} else if match := mdTaskPattern.FindStringSubmatch(content); match != nil {
+		block.kind = mdToDo
+		block.checked = match[1] != " "
+		content = content[len(match[0]):]
+	}
+
+	itemLines := []string{content}
+	j := i + 1
+	for ; j < len(lines); j++ {
+		next := lines[j]
+		if strings.TrimSpace(next) == "" {
+			// A blank line belongs to the item only when more of it follows.
+			k := j
+			for k < len(lines) && strings.TrimSpace(lines[k]) == "" {
+				k++
+			}
+			if k == len(lines) || markdownIndent(lines[k]) < width {
+				break
+			}
+			itemLines = append(itemLines, "")
+			continue
+		}
+		if markdownIndent(next) >= width {
+			itemLines = append(itemLines, dedentMarkdown(next, width))
+			continue
+		}
+		// Unindented text right after the item continues its paragraph.
+		if itemLines[len(itemLines)-1] != "" {
+			if block, _ := parseMarkdownBlock(lines, j); block == nil {
+				itemLines = append(itemLines, strings.TrimSpace(next))
+				continue
+			}
+		}
+		break
+	}
+	block.text, block.children = splitLeadParagraph(parseMarkdownBlocks(itemLines))
+	return block, j
+}
+
+func parseMarkdownTable(lines []string, i int) (*mdBlock, int) {
+	block := &mdBlock{kind: mdTable, rows: [][]string{splitMarkdownTableRow(lines[i])}}
+	j := i + 2
+	for ; j < len(lines); j++ {
+		trimmed := strings.TrimSpace(lines[j])
+		if trimmed == "" || !strings.Contains(trimmed, "|") {
+			break
+		}
+		block.rows = append(block.rows, splitMarkdownTableRow(trimmed))
+	}
+	return block, j
+}
+
+func splitMarkdownTableRow(line string) []string {
+	line = strings.TrimPrefix(strings.TrimSpace(line), "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+	var cells []string
+	var cell strings.Builder
+	for k := 0; k < len(line); k++ {
+		switch {
+		case line[k] == '\\' && k+1 < len(line) && line[k+1] == '|':
+			cell.WriteString(`\|`)
+			k++
+		case line[k] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[k])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// splitLeadParagraph separates the paragraph that opens a container, which
+// Notion keeps as the container's own text, from the blocks after it.
+func splitLeadParagraph(blocks []*mdBlock) (string, []*mdBlock) {
+	if len(blocks) > 0 && blocks[0].kind == mdParagraph {
+		return blocks[0].text, blocks[1:]
+	}
+	return "", blocks
+}
+
+// markdownIndent returns the width of a line's leading whitespace, counting
+// a tab as four columns.
+func markdownIndent(line string) int {
+	width := 0
+	for _, r := range line {
+		switch r {
+		case ' ':
+			width++
+		case '\t':
+			width += 4
+		default:
+			return width
+		}
+	}
+	return width
+}
+
+// dedentMarkdown removes up to width columns of leading whitespace.
+func dedentMarkdown(line string, width int) string {
+	column := 0
+	for i, r := range line {
+		if column >= width {
+			return line[i:]
+		}
+		switch r {
+		case ' ':
+			column++
+		case '\t':
+			column += 4
+			if column > width {
+				return strings.Repeat(" ", column-width) + line[i+1:]
+			}
+		default:
+			return line[i:]
+		}
+	}
+	return ""
+}
+
+// isEmojiToken reports whether value looks like a single emoji rather than
+// text, for the icon that may follow a callout's alert type.
+func isEmojiToken(value string) bool {
+	if value == "" || utf8.RuneCountInString(value) > 8 {
+		return false
+	}
+	for _, r := range value {
+		if r < utf8.RuneSelf || unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func notionBlocksFromMarkdown(nodes []*mdBlock) notionapi.Blocks {
+	blocks := make(notionapi.Blocks, 0, len(nodes))
+	for _, node := range nodes {
+		blocks = append(blocks, notionBlockFromMarkdown(node))
+	}
+	return blocks
+}
+
+func notionBlockFromMarkdown(node *mdBlock) notionapi.Block {
+	text := parseInlineMarkdown(node.text)
+	children := notionBlocksFromMarkdown(node.children)
+	if len(children) == 0 {
+		children = nil
+	}
+	switch node.kind {
+	case mdHeading:
+		heading := notionapi.Heading{RichText: text}
+		switch node.level {
+		case 1:
+			return &notionapi.Heading1Block{BasicBlock: notionBasicBlock(notionapi.BlockTypeHeading1), Heading1: heading}
+		case 2:
+			return &notionapi.Heading2Block{BasicBlock: notionBasicBlock(notionapi.BlockTypeHeading2), Heading2: heading}
+		default:
+			return &notionapi.Heading3Block{BasicBlock: notionBasicBlock(notionapi.BlockTypeHeading3), Heading3: heading}
+		}
+	case mdBulleted:
+		return &notionapi.BulletedListItemBlock{
+			BasicBlock:       notionBasicBlock(notionapi.BlockTypeBulletedListItem),
+			BulletedListItem: notionapi.ListItem{RichText: text, Children: children},
+		}
+	case mdNumbered:
+		return &notionapi.NumberedListItemBlock{
+			BasicBlock:       notionBasicBlock(notionapi.BlockTypeNumberedListItem),
+			NumberedListItem: notionapi.ListItem{RichText: text, Children: children},
+		}
+	case mdToDo:
+		return &notionapi.ToDoBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeToDo),
+			ToDo:       notionapi.ToDo{RichText: text, Children: children, Checked: node.checked},
+		}
+	case mdQuote:
+		return &notionapi.QuoteBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeQuote),
+			Quote:      notionapi.Quote{RichText: text, Children: children},
+		}
+	case mdCallout:
+		emoji := notionapi.Emoji(node.icon)
+		return &notionapi.CalloutBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeCallout),
+			Callout: notionapi.Callout{
+				RichText: text,
+				Icon:     &notionapi.Icon{Type: "emoji", Emoji: &emoji},
+				Children: children,
+			},
+		}
+	case mdToggle:
+		return &notionapi.ToggleBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeToggle),
+			Toggle:     notionapi.Toggle{RichText: text, Children: children},
+		}
+	case mdCode:
+		return &notionapi.CodeBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeCode),
+			Code:       notionapi.Code{RichText: notionPlainRichText(node.text), Language: notionCodeLanguage(node.language)},
+		}
+	case mdEquation:
+		return &notionapi.EquationBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeEquation),
+			Equation:   notionapi.Equation{Expression: node.text},
+		}
+	case mdDivider:
+		return &notionapi.DividerBlock{BasicBlock: notionBasicBlock(notionapi.BlockTypeDivider)}
+	case mdTable:
+		return notionTableFromMarkdown(node.rows)
+	case mdFile:
+		block, _ := notionFileBlock(node.text)
+		return block
+	default:
+		return &notionapi.ParagraphBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeParagraph),
+			Paragraph:  notionapi.Paragraph{RichText: text},
+		}
+	}
+}
+
+func notionTableFromMarkdown(rows [][]string) notionapi.Block {
+	width := 0
+	for _, row := range rows {
+		width = maxInt(width, len(row))
+	}
+	table := &notionapi.TableBlock{
+		BasicBlock: notionBasicBlock(notionapi.BlockTypeTableBlock),
+		Table:      notionapi.Table{TableWidth: width, HasColumnHeader: true},
+	}
+	for _, row := range rows {
+		cells := make([][]notionapi.RichText, width)
+		for i := range cells {
+			cells[i] = []notionapi.RichText{}
+			if i < len(row) {
+				cells[i] = parseInlineMarkdown(row[i])
+			}
+		}
+		table.Table.Children = append(table.Table.Children, &notionapi.TableRowBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeTableRowBlock),
+			TableRow:   notionapi.TableRow{Cells: cells},
+		})
+	}
+	return table
+}
+
+func notionBasicBlock(blockType notionapi.BlockType) notionapi.BasicBlock {
+	return notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: blockType}
+}
+
+func notionCodeLanguage(info string) string {
+	info = strings.ToLower(strings.TrimSpace(info))
+	if notionCodeLanguages[info] {
+		return info
+	}
+	if fields := strings.Fields(info); len(fields) > 0 {
+		info = fields[0]
+	}
+	if alias, ok := notionCodeAliases[info]; ok {
+		return alias
+	}
+	if notionCodeLanguages[info] {
+		return info
+	}
+	return "plain text"
+}
+
+// notionChildren returns the children of the block types that can hold
+// them, so they can be filled in after reading or detached before sending.
+func notionChildren(block notionapi.Block) *notionapi.Blocks {
+	switch typed := block.(type) {
+	case *notionapi.ParagraphBlock:
+		return &typed.Paragraph.Children
+	case *notionapi.Heading1Block:
+		return &typed.Heading1.Children
+	case *notionapi.Heading2Block:
+		return &typed.Heading2.Children
+	case *notionapi.Heading3Block:
+		return &typed.Heading3.Children
+	case *notionapi.BulletedListItemBlock:
+		return &typed.BulletedListItem.Children
+	case *notionapi.NumberedListItemBlock:
+		return &typed.NumberedListItem.Children
+	case *notionapi.ToDoBlock:
+		return &typed.ToDo.Children
+	case *notionapi.QuoteBlock:
+		return &typed.Quote.Children
+	case *notionapi.CalloutBlock:
+		return &typed.Callout.Children
+	case *notionapi.ToggleBlock:
+		return &typed.Toggle.Children
+	case *notionapi.TableBlock:
+		return &typed.Table.Children
+	case *notionapi.ColumnListBlock:
+		return &typed.ColumnList.Children
+	case *notionapi.ColumnBlock:
+		return &typed.Column.Children
+	case *notionapi.SyncedBlock:
+		return &typed.SyncedBlock.Children
+	case *notionapi.TemplateBlock:
+		return &typed.Template.Children
+	default:
+		return nil
+	}
+}
+
+// notionBlocksMarkdown renders blocks and their children as Markdown.
+func notionBlocksMarkdown(blocks notionapi.Blocks) string {
+	var builder strings.Builder
+	number := 0
+	previousList := false
+	for _, block := range blocks {
+		if _, ok := block.(*notionapi.NumberedListItemBlock); ok {
+			number++
+		} else {
+			number = 0
+		}
+		text := notionBlockMarkdown(block, number)
+		if text == "" {
+			continue
+		}
+		list := isNotionListItem(block)
+		if builder.Len() > 0 {
+			if list && previousList {
+				builder.WriteString("\n")
+			} else {
+				builder.WriteString("\n\n")
+			}
+		}
+		builder.WriteString(text)
+		previousList = list
+	}
+	return builder.String()
+}
+
+// notionBlockMarkdown renders one block. number is the position of a
+// numbered list item within its list.
+func notionBlockMarkdown(block notionapi.Block, number int) string {
+	switch typed := block.(type) {
+	case *notionapi.ParagraphBlock:
+		return joinMarkdown(richTextMarkdown(typed.Paragraph.RichText), notionBlocksMarkdown(typed.Paragraph.Children))
+	case *notionapi.Heading1Block:
+		return notionHeadingMarkdown("# ", typed.Heading1)
+	case *notionapi.Heading2Block:
+		return notionHeadingMarkdown("## ", typed.Heading2)
+	case *notionapi.Heading3Block:
+		return notionHeadingMarkdown("### ", typed.Heading3)
+	case *notionapi.BulletedListItemBlock:
+		return notionListItemMarkdown("- ", "  ", typed.BulletedListItem.RichText, typed.BulletedListItem.Children)
+	case *notionapi.NumberedListItemBlock:
+		marker := strconv.Itoa(number) + ". "
+		return notionListItemMarkdown(marker, strings.Repeat(" ", len(marker)), typed.NumberedListItem.RichText, typed.NumberedListItem.Children)
+	case *notionapi.ToDoBlock:
+		return notionListItemMarkdown("- ["+checkboxMark(typed.ToDo.Checked)+"] ", "  ", typed.ToDo.RichText, typed.ToDo.Children)
+	case *notionapi.QuoteBlock:
+		body := joinMarkdown(richTextMarkdown(typed.Quote.RichText), notionBlocksMarkdown(typed.Quote.Children))
+		return prefixMarkdownLines(body, "> ", "> ")
+	case *notionapi.CalloutBlock:
+		body := joinMarkdown(richTextMarkdown(typed.Callout.RichText), notionBlocksMarkdown(typed.Callout.Children))
+		return prefixMarkdownLines(joinLines(notionCalloutMarker(typed.Callout.Icon), body), "> ", "> ")
+	case *notionapi.ToggleBlock:
+		summary := strings.ReplaceAll(richTextMarkdown(typed.Toggle.RichText), "\n", "<br>")
+		out := "<details>\n<summary>" + summary + "</summary>\n"
+		if children := notionBlocksMarkdown(typed.Toggle.Children); children != "" {
+			out += "\n" + children + "\n\n"
+		}
+		return out + "</details>"
+	case *notionapi.CodeBlock:
+		code := richTextPlain(typed.Code.RichText)
+		fence := "```"
+		for strings.Contains(code, fence) {
+			fence += "`"
+		}
+		language := typed.Code.Language
+		if language == "plain text" {
+			language = ""
+		}
+		return fence + language + "\n" + code + "\n" + fence
+	case *notionapi.EquationBlock:
+		return "$$\n" + strings.TrimSpace(typed.Equation.Expression) + "\n$$"
+	case *notionapi.DividerBlock:
+		return "---"
+	case *notionapi.TableBlock:
+		return notionTableMarkdown(typed.Table)
+	case *notionapi.ChildPageBlock:
+		return "## " + typed.ChildPage.Title
+	case *notionapi.ImageBlock:
+		return notionImageMarkdown(typed.Image)
+	case *notionapi.FileBlock:
+		return notionFileMarkdown(typed.File.Caption, typed.File.File, typed.File.External)
+	case *notionapi.PdfBlock:
+		return notionFileMarkdown(typed.Pdf.Caption, typed.Pdf.File, typed.Pdf.External)
+	case *notionapi.VideoBlock:
+		return notionFileMarkdown(typed.Video.Caption, typed.Video.File, typed.Video.External)
+	case *notionapi.AudioBlock:
+		return notionFileMarkdown(typed.Audio.Caption, typed.Audio.File, typed.Audio.External)
+	case *notionapi.BookmarkBlock:
+		return notionURLMarkdown(typed.Bookmark.Caption, typed.Bookmark.URL)
+	case *notionapi.EmbedBlock:
+		return notionURLMarkdown(typed.Embed.Caption, typed.Embed.URL)
+	case *notionapi.LinkPreviewBlock:
+		return notionURLMarkdown(nil, typed.LinkPreview.URL)
+	case *notionapi.ColumnListBlock:
+		var columns []string
+		for _, column := range typed.ColumnList.Children {
+			if children := notionChildren(column); children != nil {
+				columns = append(columns, notionBlocksMarkdown(*children))
+			}
+		}
+		return joinMarkdown(columns...)
+	case *notionapi.SyncedBlock:
+		return notionBlocksMarkdown(typed.SyncedBlock.Children)
+	default:
+		return ""
+	}
+}
+
+func notionHeadingMarkdown(marker string, heading notionapi.Heading) string {
+	// Toggleable headings keep their children as the blocks that follow.
+	return joinMarkdown(marker+strings.ReplaceAll(richTextMarkdown(heading.RichText), "\n", " "), notionBlocksMarkdown(heading.Children))
+}
+
+// notionListItemMarkdown renders a list item. Nested lines are indented to
+// the content after the list marker, which for to-dos is before the box.
+func notionListItemMarkdown(marker, indent string, text []notionapi.RichText, children notionapi.Blocks) string {
+	out := prefixMarkdownLines(richTextMarkdown(text), marker, indent)
+	nested := notionBlocksMarkdown(children)
+	if nested == "" {
+		return out
+	}
+	separator := "\n\n"
+	if isNotionListItem(children[0]) {
+		separator = "\n"
+	}
+	return out + separator + prefixMarkdownLines(nested, indent, indent)
+}
+
+// notionCalloutMarker writes a callout's icon as a GitHub alert type, or
+// as the emoji after a note alert when no type uses it.
+func notionCalloutMarker(icon *notionapi.Icon) string {
+	if icon == nil || icon.Emoji == nil || *icon.Emoji == "" {
+		return "[!NOTE]"
+	}
+	for kind, emoji := range notionCalloutIcons {
+		if emoji == string(*icon.Emoji) {
+			return "[!" + kind + "]"
+		}
+	}
+	return "[!NOTE] " + string(*icon.Emoji)
+}
+
+func notionTableMarkdown(table notionapi.Table) string {
+	var rows [][]string
+	width := table.TableWidth
+	for _, child := range table.Children {
+		row, ok := child.(*notionapi.TableRowBlock)
+		if !ok {
+			continue
+		}
+		cells := make([]string, 0, len(row.TableRow.Cells))
+		for _, cell := range row.TableRow.Cells {
+			text := strings.ReplaceAll(richTextMarkdown(cell), "|", `\|`)
+			cells = append(cells, strings.ReplaceAll(text, "\n", "<br>"))
+		}
+		rows = append(rows, cells)
+		width = maxInt(width, len(cells))
+	}
+	if len(rows) == 0 || width == 0 {
+		return ""
+	}
+	lines := make([]string, 0, len(rows)+1)
+	for i, row := range rows {
+		for len(row) < width {
+			row = append(row, "")
+		}
+		lines = append(lines, "| "+strings.Join(row, " | ")+" |")
+		if i == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", width))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func notionURLMarkdown(caption []notionapi.RichText, target string) string {
+	if target == "" {
+		return ""
+	}
+	label := defaultString(notionLinkLabel(richTextPlain(caption)), target)
+	return "[" + label + "](" + markdownLinkTarget(target) + ")"
+}
+
+func isNotionListItem(block notionapi.Block) bool {
+	switch block.(type) {
+	case *notionapi.BulletedListItemBlock, *notionapi.NumberedListItemBlock, *notionapi.ToDoBlock:
+		return true
+	default:
+		return false
+	}
+}
+
+// joinMarkdown joins the non-empty parts as separate blocks.
+func joinMarkdown(parts ...string) string {
+	var kept []string
+	for _, part := range parts {
+		if part != "" {
+			kept = append(kept, part)
+		}
+	}
+	return strings.Join(kept, "\n\n")
+}
+
+// joinLines joins the non-empty parts as lines of one block.
+func joinLines(parts ...string) string {
+	var kept []string
+	for _, part := range parts {
+		if part != "" {
+			kept = append(kept, part)
+		}
+	}
+	return strings.Join(kept, "\n")
+}
+
+// prefixMarkdownLines prefixes the first line of text with first and the
+// others with rest. Blank lines get no trailing spaces.
+func prefixMarkdownLines(text, first, rest string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		prefix := rest
+		if i == 0 {
+			prefix = first
+		}
+		if line == "" {
+			prefix = strings.TrimRight(prefix, " ")
+		}
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// markdownStyle is the formatting of a run of inline text.
+type markdownStyle struct {
+	bold, italic, strike, underline, code bool
+	link                                  string
+}
+
+// markdownSegment is a run of text with one style, or an inline equation.
+type markdownSegment struct {
+	text     string
+	style    markdownStyle
+	equation bool
+}
+
+// markdownSegments collects segments, merging neighbours of equal style.
+type markdownSegments []markdownSegment
+
+func (s *markdownSegments) add(text string, style markdownStyle) {
+	if text == "" {
+		return
+	}
+	if n := len(*s); n > 0 && !(*s)[n-1].equation && (*s)[n-1].style == style {
+		(*s)[n-1].text += text
+		return
+	}
+	*s = append(*s, markdownSegment{text: text, style: style})
+}
+
+// parseInlineMarkdown converts inline Markdown to rich text.
+func parseInlineMarkdown(text string) []notionapi.RichText {
+	var segments markdownSegments
+	segments.parse(text, markdownStyle{})
+	values := []notionapi.RichText{}
+	for _, segment := range segments {
+		if segment.equation {
+			values = append(values, notionapi.RichText{
+				Type:     notionapi.ObjectType("equation"),
+				Equation: &notionapi.Equation{Expression: segment.text},
+			})
+			continue
+		}
+		style := segment.style
+		for _, chunk := range textChunks(segment.text, notionMaxRichText) {
+			value := notionapi.RichText{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: chunk}}
+			if style.link != "" {
+				value.Text.Link = &notionapi.Link{Url: style.link}
+			}
+			if style != (markdownStyle{link: style.link}) {
+				value.Annotations = &notionapi.Annotations{
+					Bold:          style.bold,
+					Italic:        style.italic,
+					Strikethrough: style.strike,
+					Underline:     style.underline,
+					Code:          style.code,
+					Color:         notionapi.ColorDefault,
+				}
+			}
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func (s *markdownSegments) parse(text string, style markdownStyle) {
+	var literal strings.Builder
+	flush := func() {
+		s.add(literal.String(), style)
+		literal.Reset()
+	}
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch c {
+		case '\\':
+			if i+1 < len(text) && isMarkdownPunct(text[i+1]) {
+				literal.WriteByte(text[i+1])
+				i += 2
+				continue
+			}
+		case '`':
+			n := markdownRun(text, i, '`')
+			if end := markdownCodeEnd(text, i+n, n); end >= 0 {
+				flush()
+				code := text[i+n : end]
+				if len(code) >= 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.Trim(code, " ") != "" {
+					code = code[1 : len(code)-1]
+				}
+				styled := style
+				styled.code = true
+				s.add(code, styled)
+				i = end + n
+				continue
+			}
+			literal.WriteString(text[i : i+n])
+			i += n
+			continue
+		case '$':
+			if end := markdownMathEnd(text, i); end > 0 {
+				flush()
+				*s = append(*s, markdownSegment{text: text[i+1 : end], equation: true})
+				i = end + 1
+				continue
+			}
+		case '!':
+			// Inline images have no rich text counterpart and stay as written.
+			if i+1 < len(text) && text[i+1] == '[' {
+				if _, _, end := parseMarkdownLink(text, i+1); end > 0 {
+					literal.WriteString(text[i:end])
+					i = end
+					continue
+				}
+			}
+		case '[':
+			if label, target, end := parseMarkdownLink(text, i); end > 0 {
+				if !notionLinkable(target) {
+					literal.WriteString(text[i:end])
+				} else {
+					flush()
+					styled := style
+					styled.link = target
+					s.parse(label, styled)
+				}
+				i = end
+				continue
+			}
+		case '<':
+			rest := text[i:]
+			if strings.HasPrefix(rest, "<u>") {
+				if end := strings.Index(rest, "</u>"); end > 0 {
+					flush()
+					styled := style
+					styled.underline = true
+					s.parse(rest[3:end], styled)
+					i += end + len("</u>")
+					continue
+				}
+			}
+			if match := mdLineBreakPattern.FindString(rest); match != "" {
+				literal.WriteByte('\n')
+				i += len(match)
+				continue
+			}
+			if match := mdAutolinkPattern.FindStringSubmatch(rest); match != nil {
+				flush()
+				styled := style
+				styled.link = match[1]
+				s.add(match[1], styled)
+				i += len(match[0])
+				continue
+			}
+		case '*', '_', '~':
+			if size, end := markdownEmphasis(text, i); end > 0 {
+				flush()
+				styled := style
+				switch {
+				case c == '~':
+					styled.strike = true
+				case size == 1:
+					styled.italic = true
+				case size == 2:
+					styled.bold = true
+				default:
+					styled.bold, styled.italic = true, true
+				}
+				s.parse(text[i+size:end], styled)
+				i = end + size
+				continue
+			}
+			n := markdownRun(text, i, c)
+			literal.WriteString(text[i : i+n])
+			i += n
+			continue
+		}
+		literal.WriteByte(c)
+		i++
+	}
+	flush()
+}
+
+// markdownEmphasis matches the emphasis opening at i and returns the size
+// of its delimiter and the index of the closing one.
+func markdownEmphasis(text string, i int) (int, int) {
+	c := text[i]
+	run := markdownRun(text, i, c)
+	if i+run >= len(text) || isMarkdownSpace(text[i+run]) {
+		return 0, -1
+	}
+	if c == '_' && i > 0 && isMarkdownWord(text[i-1]) {
+		return 0, -1
+	}
+	for size := min(run, 3); size >= 1; size-- {
+		if c == '~' && size != 2 {
+			continue
+		}
+		if end := markdownEmphasisEnd(text, i+size, c, size); end > 0 {
+			return size, end
+		}
+	}
+	return 0, -1
+}
+
+func markdownEmphasisEnd(text string, from int, c byte, size int) int {
+	for j := from; j < len(text); j++ {
+		switch text[j] {
+		case '\\':
+			j++
+			continue
+		case '`':
+			n := markdownRun(text, j, '`')
+			if end := markdownCodeEnd(text, j+n, n); end >= 0 {
+				j = end + n - 1
+			} else {
+				j += n - 1
+			}
+			continue
+		}
+		if text[j] != c {
+			continue
+		}
+		run := markdownRun(text, j, c)
+		closes := run == size || (size > 1 && run > size)
+		if j == from || isMarkdownSpace(text[j-1]) || !closes {
+			j += run - 1
+			continue
+		}
+		if c == '_' && j+run < len(text) && isMarkdownWord(text[j+run]) {
+			j += run - 1
+			continue
+		}
+		return j + run - size
+	}
+	return -1
+}
+
+func markdownCodeEnd(text string, from, n int) int {
+	for j := from; j < len(text); {
+		if text[j] != '`' {
+			j++
+			continue
+		}
+		run := markdownRun(text, j, '`')
+		if run == n {
+			return j
+		}
+		j += run
+	}
+	return -1
+}
+
+// markdownMathEnd returns the closing dollar of an inline equation opening
+// at i. Amounts such as "$5 and $10" are not equations.
+func markdownMathEnd(text string, i int) int {
+	if i+1 >= len(text) || text[i+1] == '$' || isMarkdownSpace(text[i+1]) || (i > 0 && text[i-1] == '$') {
+		return -1
+	}
+	for j := i + 1; j < len(text); j++ {
+		switch {
+		case text[j] == '\\':
+			j++
+		case text[j] == '$' && !isMarkdownSpace(text[j-1]) && (j+1 >= len(text) || text[j+1] < '0' || text[j+1] > '9'):
+			return j
+		}
+	}
+	return -1
+}
+
+// parseMarkdownLink parses the link opening with the bracket at i and
+// returns its label, target and end.
+func parseMarkdownLink(text string, i int) (string, string, int) {
+	depth := 0
+	for j := i; j < len(text); j++ {
+		switch text[j] {
+		case '\\':
+			j++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth > 0 {
+				continue
+			}
+			if j+1 >= len(text) || text[j+1] != '(' {
+				return "", "", -1
+			}
+			end := markdownLinkTargetEnd(text, j+2)
+			if end < 0 {
+				return "", "", -1
+			}
+			fields := strings.Fields(text[j+2 : end])
+			if len(fields) == 0 {
+				return "", "", -1
+			}
+			target := strings.TrimSuffix(strings.TrimPrefix(fields[0], "<"), ">")
+			return text[i+1 : j], target, end + 1
+		}
+	}
+	return "", "", -1
+}
+
+func markdownLinkTargetEnd(text string, from int) int {
+	depth := 0
+	for j := from; j < len(text); j++ {
+		switch text[j] {
+		case '\\':
+			j++
+		case '\n':
+			return -1
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return j
+			}
+			depth--
+		}
+	}
+	return -1
+}
+
+// notionLinkable reports whether Notion accepts target as a link. Relative
+// links are kept as text instead of failing the push.
+func notionLinkable(target string) bool {
+	parsed, err := url.Parse(target)
+	if err != nil {
+		return false
+	}
+	switch parsed.Scheme {
+	case "http", "https":
+		return parsed.Host != ""
+	case "mailto", "tel":
+		return parsed.Opaque != ""
+	default:
+		return false
+	}
+}
+
+func markdownRun(text string, i int, c byte) int {
+	n := 0
+	for i+n < len(text) && text[i+n] == c {
+		n++
+	}
+	return n
+}
+
+func isMarkdownPunct(c byte) bool {
+	return c < utf8.RuneSelf && unicode.IsPunct(rune(c)) || strings.IndexByte("$+<=>^`|~", c) >= 0
+}
+
+func isMarkdownSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n'
+}
+
+func isMarkdownWord(c byte) bool {
+	return c >= utf8.RuneSelf || c == '_' || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))
+}
+
+// richTextMarkdown converts rich text to inline Markdown.
+func richTextMarkdown(values []notionapi.RichText) string {
+	var segments markdownSegments
+	for _, value := range values {
+		if value.Equation != nil {
+			segments = append(segments, markdownSegment{text: value.Equation.Expression, equation: true})
+			continue
+		}
+		content := value.PlainText
+		link := value.Href
+		if value.Text != nil {
+			content = value.Text.Content
+			if value.Text.Link != nil {
+				link = value.Text.Link.Url
+			}
+		}
+		style := markdownStyle{link: link}
+		if value.Annotations != nil {
+			style.bold = value.Annotations.Bold
+			style.italic = value.Annotations.Italic
+			style.strike = value.Annotations.Strikethrough
+			style.underline = value.Annotations.Underline
+			style.code = value.Annotations.Code
+		}
+		segments.add(content, style)
+	}
+	var builder strings.Builder
+	for i := 0; i < len(segments); {
+		link := segments[i].style.link
+		if link == "" || segments[i].equation {
+			builder.WriteString(segments[i].markdown())
+			i++
+			continue
+		}
+		// Neighbours sharing a link are written as one link.
+		var label strings.Builder
+		for ; i < len(segments) && !segments[i].equation && segments[i].style.link == link; i++ {
+			segment := segments[i]
+			segment.style.link = ""
+			label.WriteString(segment.markdown())
+		}
+		builder.WriteString("[" + label.String() + "](" + markdownLinkTarget(link) + ")")
+	}
+	return escapeMarkdownLineStarts(builder.String())
+}
+
+func (s markdownSegment) markdown() string {
+	if s.equation {
+		return "$" + strings.TrimSpace(s.text) + "$"
+	}
+	core := strings.TrimSpace(s.text)
+	if core == "" {
+		return escapeMarkdownText(s.text)
+	}
+	start := strings.Index(s.text, core)
+	lead, trail := s.text[:start], s.text[start+len(core):]
+	if s.style.code {
+		core = markdownCodeSpan(core)
+	} else {
+		core = escapeMarkdownText(core)
+	}
+	if s.style.strike {
+		core = "~~" + core + "~~"
+	}
+	if s.style.italic {
+		// Underscores keep bold italic text from starting with three stars.
+		if s.style.bold {
+			core = "_" + core + "_"
+		} else {
+			core = "*" + core + "*"
+		}
+	}
+	if s.style.bold {
+		core = "**" + core + "**"
+	}
+	if s.style.underline {
+		core = "<u>" + core + "</u>"
+	}
+	return lead + core + trail
+}
+
+func markdownCodeSpan(code string) string {
+	longest := 0
+	for i := 0; i < len(code); i++ {
+		if code[i] == '`' {
+			run := markdownRun(code, i, '`')
+			longest = maxInt(longest, run)
+			i += run - 1
+		}
+	}
+	fence := strings.Repeat("`", longest+1)
+	if strings.HasPrefix(code, "`") || strings.HasSuffix(code, "`") {
+		code = " " + code + " "
+	}
+	return fence + code + fence
+}
+
+func markdownLinkTarget(target string) string {
+	return strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29").Replace(target)
+}
+
+// escapeMarkdownText escapes the characters that would otherwise start
+// inline formatting.
+func escapeMarkdownText(text string) string {
+	var builder strings.Builder
+	for i, r := range text {
+		before, _ := utf8.DecodeLastRuneInString(text[:i])
+		after, _ := utf8.DecodeRuneInString(text[i+utf8.RuneLen(r):])
+		escape := false
+		switch r {
+		case '\\', '`', '*', '[', ']':
+			escape = true
+		case '$':
+			escape = before == '$' || after == '$' || markdownMathEnd(text, i) > 0
+		case '_':
+			escape = !isWordRune(before) || !isWordRune(after)
+		case '~':
+			escape = before == '~' || after == '~'
+		case '<':
+			escape = unicode.IsLetter(after) || after == '/'
+		}
+		if escape {
+			builder.WriteByte('\\')
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// escapeMarkdownLineStarts escapes lines of text that would otherwise be
+// read as headings, quotes, list items or dividers.
+func escapeMarkdownLineStarts(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " ")
+		lead := line[:len(line)-len(trimmed)]
+		switch {
+		case mdHeadingPattern.MatchString(trimmed), mdDividerPattern.MatchString(trimmed), strings.HasPrefix(trimmed, ">"):
+			lines[i] = lead + `\` + trimmed
+		case mdListPattern.MatchString(trimmed):
+			digits := len(trimmed) - len(strings.TrimLeft(trimmed, "0123456789"))
+			lines[i] = lead + trimmed[:digits] + `\` + trimmed[digits:]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// notionPlainRichText splits unformatted text into rich text objects Notion
+// accepts.
+func notionPlainRichText(text string) []notionapi.RichText {
+	values := []notionapi.RichText{}
+	if text == "" {
+		return values
+	}
+	for _, chunk := range textChunks(text, notionMaxRichText) {
+		values = append(values, notionapi.RichText{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: chunk}})
+	}
+	return values
+}
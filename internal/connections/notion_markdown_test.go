@@ -0,0 +1,102 @@
+package connections
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jomei/notionapi"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestNotionBlocksToMarkdown(t *testing.T) {
+	for _, input := range goldenInputs(t, "testdata/notion/to_markdown/*.json") {
+		t.Run(filepath.Base(input), func(t *testing.T) {
+			var blocks notionapi.Blocks
+			if err := json.Unmarshal(readGolden(t, input), &blocks); err != nil {
+				t.Fatalf("decode blocks: %v", err)
+			}
+			markdown := notionBlocksMarkdown(blocks) + "\n"
+			checkGolden(t, strings.TrimSuffix(input, ".json")+".md", []byte(markdown))
+		})
+	}
+}
+
+func TestMarkdownToNotionBlocks(t *testing.T) {
+	for _, input := range goldenInputs(t, "testdata/notion/to_blocks/*.md") {
+		t.Run(filepath.Base(input), func(t *testing.T) {
+			raw, err := json.MarshalIndent(markdownToNotionBlocks(string(readGolden(t, input))), "", "  ")
+			if err != nil {
+				t.Fatalf("encode blocks: %v", err)
+			}
+			checkGolden(t, strings.TrimSuffix(input, ".md")+".json", append(raw, '\n'))
+
+			// The blocks read back as Notion returns them convert to
+			// Markdown that produces the same blocks again.
+			var blocks notionapi.Blocks
+			if err := json.Unmarshal(raw, &blocks); err != nil {
+				t.Fatalf("decode blocks: %v", err)
+			}
+			again, err := json.MarshalIndent(markdownToNotionBlocks(notionBlocksMarkdown(blocks)), "", "  ")
+			if err != nil {
+				t.Fatalf("encode blocks: %v", err)
+			}
+			if !bytes.Equal(raw, again) {
+				t.Fatalf("blocks changed after a round trip:\n%s\nwant:\n%s", again, raw)
+			}
+		})
+	}
+}
+
+func TestNotionInlineMarkdownEdgeCases(t *testing.T) {
+	for markdown, want := range map[string]string{
+		"costs $5 and $10":       "costs $5 and $10",
+		"a **b *c* d** e":        "a **b** **_c_** **d** e",
+		"intra_word_underscores": "intra_word_underscores",
+		"**unclosed":             "\\*\\*unclosed",
+		"line<br>break":          "line\nbreak",
+		"[x](:/resource)":        `\[x\](:/resource)`,
+		"``a ` b``":              "``a ` b``",
+	} {
+		got := richTextMarkdown(parseInlineMarkdown(markdown))
+		if got != want {
+			t.Errorf("%q became %q, want %q", markdown, got, want)
+		}
+	}
+}
+
+func goldenInputs(t *testing.T, pattern string) []string {
+	t.Helper()
+	inputs, err := filepath.Glob(pattern)
+	if err != nil || len(inputs) == 0 {
+		t.Fatalf("no golden inputs match %s: %v", pattern, err)
+	}
+	return inputs
+}
+
+func readGolden(t *testing.T, path string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s: %v", path, err)
+	}
+	return data
+}
+
+func checkGolden(t *testing.T, path string, got []byte) {
+	t.Helper()
+	if *updateGolden {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("write %s: %v", path, err)
+		}
+		return
+	}
+	if want := readGolden(t, path); !bytes.Equal(got, want) {
+		t.Fatalf("%s does not match:\n%s", path, got)
+	}
+}
@@ -0,0 +1,408 @@
+package connections
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/noteconnectionaccount"
+
+	"github.com/jomei/notionapi"
+)
+
+const (
+	AuthTypeToken = "token"
+	AuthTypeOAuth = "oauth"
+	AuthTypeNone  = "none"
+
+	// oauthStateTTL bounds how long the user can take on the provider's
+	// consent page.
+	oauthStateTTL = 15 * time.Minute
+	// oauthRefreshMargin refreshes access tokens shortly before they expire
+	// so a job does not start with a token that lapses halfway through.
+	oauthRefreshMargin = 2 * time.Minute
+)
+
+var (
+	ErrOAuthNotConfigured   = errors.New("OAuth is not configured for this provider")
+	ErrInvalidOAuthState    = errors.New("OAuth request is invalid or has expired; start the connection again")
+	ErrAuthorizationExpired = errors.New("provider authorization expired or was revoked; reconnect the account")
+)
+
+// OAuthConfig holds the settings of an OAuth integration registered with a
+// provider. RedirectURL must point at the server's OAuth callback route.
+type OAuthConfig struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	AuthURL      string
+	TokenURL     string
+}
+
+// Enabled reports whether the integration is fully configured.
+func (c OAuthConfig) Enabled() bool {
+	return c.ClientID != "" && c.ClientSecret != "" && c.RedirectURL != ""
+}
+
+// notionOAuthConfigFromEnv reads the Notion public integration settings.
+func notionOAuthConfigFromEnv() OAuthConfig {
+	return OAuthConfig{
+		ClientID:     strings.TrimSpace(os.Getenv("SMARTICKY_NOTION_CLIENT_ID")),
+		ClientSecret: strings.TrimSpace(os.Getenv("SMARTICKY_NOTION_CLIENT_SECRET")),
+		RedirectURL:  strings.TrimSpace(os.Getenv("SMARTICKY_NOTION_REDIRECT_URL")),
+		AuthURL:      notionAPIURL + "/oauth/authorize",
+		TokenURL:     notionAPIURL + "/oauth/token",
+	}
+}
+
+// SetNotionOAuthConfig replaces the Notion OAuth settings read from the
+// environment.
+func (s *Service) SetNotionOAuthConfig(config OAuthConfig) {
+	s.notionOAuth = config
+}
+
+type OAuthProvidersResponse struct {
+	Notion bool `json:"notion"`
+}
+
+// OAuthProviders reports which providers can be connected with OAuth.
+func (s *Service) OAuthProviders() OAuthProvidersResponse {
+	return OAuthProvidersResponse{Notion: s.notionOAuth.Enabled()}
+}
+
+type OAuthStartRequest struct {
+	// AccountID reconnects an existing account instead of creating one.
+	AccountID int `json:"account_id,omitempty"`
+	// Name overrides the workspace name a new account is given.
+	Name string `json:"name,omitempty"`
+}
+
+type OAuthStartResponse struct {
+	AuthorizeURL string `json:"authorize_url"`
+}
+
+type OAuthCompleteRequest struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+}
+
+// oauthState travels through the provider sealed, so the callback needs no
+// server-side session and cannot be forged or replayed for another user.
+type oauthState struct {
+	UserID    int       `json:"user_id"`
+	AccountID int       `json:"account_id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// StartNotionOAuth returns the Notion consent page the user is sent to.
+func (s *Service) StartNotionOAuth(ctx context.Context, userID int, req OAuthStartRequest) (OAuthStartResponse, error) {
+	if !s.notionOAuth.Enabled() {
+		return OAuthStartResponse{}, ErrOAuthNotConfigured
+	}
+	if req.AccountID != 0 {
+		row, err := s.accountForUser(ctx, userID, req.AccountID)
+		if err != nil {
+			return OAuthStartResponse{}, err
+		}
+		if row.Provider != ProviderNotion {
+			return OAuthStartResponse{}, ErrUnsupportedProvider
+		}
+	}
+	raw, err := json.Marshal(oauthState{
+		UserID:    userID,
+		AccountID: req.AccountID,
+		Name:      strings.TrimSpace(req.Name),
+		ExpiresAt: time.Now().Add(oauthStateTTL),
+	})
+	if err != nil {
+		return OAuthStartResponse{}, err
+	}
+	state, err := s.box.Seal(raw)
+	if err != nil {
+		return OAuthStartResponse{}, err
+	}
+	authorizeURL, err := url.Parse(s.notionOAuth.AuthURL)
+	if err != nil {
+		return OAuthStartResponse{}, err
+	}
+	query := authorizeURL.Query()
+	query.Set("client_id", s.notionOAuth.ClientID)
+	query.Set("response_type", "code")
+	query.Set("owner", "user")
+	query.Set("redirect_uri", s.notionOAuth.RedirectURL)
+	query.Set("state", state)
+	authorizeURL.RawQuery = query.Encode()
+	return OAuthStartResponse{AuthorizeURL: authorizeURL.String()}, nil
+}
+
+// CompleteNotionOAuth exchanges the authorization code for tokens and
+// stores them on a new account, or on the account being reconnected.
+func (s *Service) CompleteNotionOAuth(ctx context.Context, userID int, req OAuthCompleteRequest) (AccountResponse, error) {
+	if !s.notionOAuth.Enabled() {
+		return AccountResponse{}, ErrOAuthNotConfigured
+	}
+	state, err := s.openOAuthState(req.State)
+	if err != nil || state.UserID != userID {
+		return AccountResponse{}, ErrInvalidOAuthState
+	}
+	code := strings.TrimSpace(req.Code)
+	if code == "" {
+		return AccountResponse{}, ErrInvalidOAuthState
+	}
+
+	token, err := s.notionTokenRequest(ctx, map[string]string{
+		"grant_type":   "authorization_code",
+		"code":         code,
+		"redirect_uri": s.notionOAuth.RedirectURL,
+	})
+	if err != nil {
+		return AccountResponse{}, err
+	}
+	encrypted, err := s.encryptCredentials(token.credentials(time.Now()))
+	if err != nil {
+		return AccountResponse{}, err
+	}
+
+	now := time.Now()
+	var row *ent.NoteConnectionAccount
+	if state.AccountID != 0 {
+		row, err = s.accountForUser(ctx, userID, state.AccountID)
+		if err != nil {
+			return AccountResponse{}, err
+		}
+		row, err = row.Update().
+			SetAuthType(AuthTypeOAuth).
+			SetEncryptedCredentials(encrypted).
+			SetCredentialAlg(credentialAlg).
+			SetLastTestStatus(StatusSuccess).
+			ClearLastTestError().
+			SetLastTestAt(now).
+			Save(ctx)
+	} else {
+		name := state.Name
+		if name == "" {
+			name = token.WorkspaceName
+		}
+		normalized, normErr := normalizeAccountInput(AccountInput{Name: name, Provider: ProviderNotion})
+		if normErr != nil {
+			return AccountResponse{}, normErr
+		}
+		name, err = s.uniqueAccountName(ctx, userID, ProviderNotion, normalized.Name)
+		if err != nil {
+			return AccountResponse{}, err
+		}
+		row, err = s.client.NoteConnectionAccount.Create().
+			SetName(name).
+			SetProvider(ProviderNotion).
+			SetUserID(userID).
+			SetEnabled(true).
+			SetAuthType(AuthTypeOAuth).
+			SetEncryptedCredentials(encrypted).
+			SetCredentialAlg(credentialAlg).
+			SetLastTestStatus(StatusSuccess).
+			SetLastTestAt(now).
+			Save(ctx)
+	}
+	if err != nil {
+		return AccountResponse{}, err
+	}
+	s.upsertSyncSchedule(row)
+	response := accountResponse(row)
+	response.NextSyncAt = s.nextSyncAt(row.ID)
+	return response, nil
+}
+
+func (s *Service) openOAuthState(value string) (oauthState, error) {
+	raw, err := s.box.Open(strings.TrimSpace(value))
+	if err != nil {
+		return oauthState{}, err
+	}
+	var state oauthState
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return oauthState{}, err
+	}
+	if state.UserID == 0 || time.Now().After(state.ExpiresAt) {
+		return oauthState{}, ErrInvalidOAuthState
+	}
+	return state, nil
+}
+
+// uniqueAccountName numbers the name when the user already has an account
+// of the provider with that name.
+func (s *Service) uniqueAccountName(ctx context.Context, userID int, provider, name string) (string, error) {
+	candidate := name
+	for i := 2; ; i++ {
+		exists, err := s.client.NoteConnectionAccount.Query().
+			Where(
+				noteconnectionaccount.UserIDEQ(userID),
+				noteconnectionaccount.ProviderEQ(provider),
+				noteconnectionaccount.NameEQ(candidate),
+			).
+			Exist(ctx)
+		if err != nil || !exists {
+			return candidate, err
+		}
+		candidate = fmt.Sprintf("%s (%d)", name, i)
+	}
+}
+
+type oauthTokenResponse struct {
+	AccessToken   string `json:"access_token"`
+	RefreshToken  string `json:"refresh_token"`
+	ExpiresIn     int    `json:"expires_in"`
+	WorkspaceName string `json:"workspace_name"`
+}
+
+func (t oauthTokenResponse) credentials(now time.Time) Credentials {
+	credentials := Credentials{Token: t.AccessToken, RefreshToken: t.RefreshToken}
+	if t.ExpiresIn > 0 {
+		expiresAt := now.Add(time.Duration(t.ExpiresIn) * time.Second)
+		credentials.ExpiresAt = &expiresAt
+	}
+	return credentials
+}
+
+// oauthGrantError is a token endpoint refusal, as opposed to a failure to
+// reach the endpoint.
+type oauthGrantError struct {
+	Status      int
+	Code        string
+	Description string
+}
+
+func (e *oauthGrantError) Error() string {
+	message := e.Code
+	if message == "" {
+		message = http.StatusText(e.Status)
+	}
+	if e.Description != "" {
+		message += ": " + e.Description
+	}
+	return "OAuth token request failed: " + message
+}
+
+// revoked reports whether the grant itself is no longer usable.
+func (e *oauthGrantError) revoked() bool {
+	return e.Code == "invalid_grant" || e.Status == http.StatusUnauthorized
+}
+
+func (s *Service) notionTokenRequest(ctx context.Context, body map[string]string) (oauthTokenResponse, error) {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return oauthTokenResponse{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.notionOAuth.TokenURL, bytes.NewReader(raw))
+	if err != nil {
+		return oauthTokenResponse{}, err
+	}
+	req.SetBasicAuth(s.notionOAuth.ClientID, s.notionOAuth.ClientSecret)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Notion-Version", notionAPIVersion)
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return oauthTokenResponse{}, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return oauthTokenResponse{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var failure struct {
+			Error       string `json:"error"`
+			Description string `json:"error_description"`
+		}
+		_ = json.Unmarshal(data, &failure)
+		return oauthTokenResponse{}, &oauthGrantError{Status: resp.StatusCode, Code: failure.Error, Description: failure.Description}
+	}
+	var token oauthTokenResponse
+	if err := json.Unmarshal(data, &token); err != nil {
+		return oauthTokenResponse{}, err
+	}
+	if token.AccessToken == "" {
+		return oauthTokenResponse{}, errors.New("OAuth token response has no access token")
+	}
+	return token, nil
+}
+
+// oauthCredentials returns the account's tokens, refreshing the access token
+// when it is about to expire. Refreshes are serialized so concurrent jobs
+// do not spend the same single-use refresh token twice.
+func (s *Service) oauthCredentials(ctx context.Context, row *ent.NoteConnectionAccount) (Credentials, error) {
+	credentials, err := s.decryptCredentials(row)
+	if err != nil || !credentials.needsRefresh(time.Now()) {
+		return credentials, err
+	}
+
+	s.oauthRefresh.Lock()
+	defer s.oauthRefresh.Unlock()
+	// Another caller may have refreshed while this one waited.
+	row, err = s.client.NoteConnectionAccount.Get(ctx, row.ID)
+	if err != nil {
+		return Credentials{}, err
+	}
+	credentials, err = s.decryptCredentials(row)
+	if err != nil || !credentials.needsRefresh(time.Now()) {
+		return credentials, err
+	}
+	if credentials.RefreshToken == "" {
+		s.markAuthorizationExpired(ctx, row.ID)
+		return Credentials{}, ErrAuthorizationExpired
+	}
+
+	token, err := s.notionTokenRequest(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"refresh_token": credentials.RefreshToken,
+	})
+	var grantErr *oauthGrantError
+	if errors.As(err, &grantErr) && grantErr.revoked() {
+		s.markAuthorizationExpired(ctx, row.ID)
+		return Credentials{}, ErrAuthorizationExpired
+	}
+	if err != nil {
+		return Credentials{}, err
+	}
+	refreshed := token.credentials(time.Now())
+	if refreshed.RefreshToken == "" {
+		refreshed.RefreshToken = credentials.RefreshToken
+	}
+	encrypted, err := s.encryptCredentials(refreshed)
+	if err != nil {
+		return Credentials{}, err
+	}
+	if err := row.Update().SetEncryptedCredentials(encrypted).SetCredentialAlg(credentialAlg).Exec(ctx); err != nil {
+		return Credentials{}, err
+	}
+	return refreshed, nil
+}
+
+func (c Credentials) needsRefresh(now time.Time) bool {
+	return c.ExpiresAt != nil && now.Add(oauthRefreshMargin).After(*c.ExpiresAt)
+}
+
+// markAuthorizationExpired surfaces a dead grant on the account so the user
+// sees it needs reconnecting.
+func (s *Service) markAuthorizationExpired(ctx context.Context, accountID int) {
+	_ = s.client.NoteConnectionAccount.UpdateOneID(accountID).
+		SetLastTestStatus(StatusFailed).
+		SetLastTestError(ErrAuthorizationExpired.Error()).
+		SetLastTestAt(time.Now()).
+		Exec(context.WithoutCancel(ctx))
+}
+
+// authorizationRevoked reports whether the provider rejected the stored
+// credentials themselves.
+func authorizationRevoked(err error) bool {
+	var notionErr *notionapi.Error
+	return errors.As(err, &notionErr) && notionErr.Status == http.StatusUnauthorized
+}
@@ -0,0 +1,313 @@
+package connections
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/enttest"
+	"smarticky/internal/storage"
+
+	"github.com/jomei/notionapi"
+)
+
+// fakeNotionTokenServer answers Notion's OAuth token endpoint. respond
+// builds the reply for each decoded request body.
+type fakeNotionTokenServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []map[string]string
+}
+
+func newFakeNotionTokenServer(t *testing.T, respond func(body map[string]string) (int, any)) *fakeNotionTokenServer {
+	t.Helper()
+	fake := &fakeNotionTokenServer{}
+	fake.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id, secret, ok := r.BasicAuth(); !ok || id != "client-id" || secret != "client-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+			return
+		}
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fake.mu.Lock()
+		fake.requests = append(fake.requests, body)
+		fake.mu.Unlock()
+		status, reply := respond(body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(reply)
+	}))
+	t.Cleanup(fake.Close)
+	return fake
+}
+
+func (f *fakeNotionTokenServer) grantTypes() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []string
+	for _, body := range f.requests {
+		out = append(out, body["grant_type"])
+	}
+	return out
+}
+
+func newOAuthTestService(t *testing.T, client *ent.Client, tokenURL string) *Service {
+	t.Helper()
+	service := NewService(client, testSecretBox(t), storage.NewMemoryFileSystem())
+	service.SetNotionOAuthConfig(OAuthConfig{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  "https://notes.example.com/api/note-connections/oauth/notion/callback",
+		AuthURL:      "https://api.notion.com/v1/oauth/authorize",
+		TokenURL:     tokenURL,
+	})
+	return service
+}
+
+func TestNotionOAuthCreatesAccountWithSealedTokens(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestNotionOAuthCreatesAccountWithSealedTokens?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	other := client.User.Create().SetUsername("other").SetPasswordHash("hash").SaveX(ctx)
+
+	tokens := newFakeNotionTokenServer(t, func(body map[string]string) (int, any) {
+		if body["code"] != "auth-code" || body["redirect_uri"] == "" {
+			return http.StatusBadRequest, map[string]string{"error": "invalid_grant"}
+		}
+		return http.StatusOK, map[string]any{
+			"access_token":   "notion-access-token",
+			"refresh_token":  "notion-refresh-token",
+			"expires_in":     3600,
+			"workspace_name": "Team Space",
+		}
+	})
+	service := newOAuthTestService(t, client, tokens.URL)
+	if !service.OAuthProviders().Notion {
+		t.Fatal("expected Notion OAuth to be enabled")
+	}
+
+	start := func() string {
+		t.Helper()
+		resp, err := service.StartNotionOAuth(ctx, owner.ID, OAuthStartRequest{})
+		if err != nil {
+			t.Fatalf("start oauth: %v", err)
+		}
+		authorizeURL, err := url.Parse(resp.AuthorizeURL)
+		if err != nil {
+			t.Fatalf("parse authorize url: %v", err)
+		}
+		query := authorizeURL.Query()
+		if query.Get("client_id") != "client-id" || query.Get("response_type") != "code" || query.Get("owner") != "user" {
+			t.Fatalf("unexpected authorize query: %v", query)
+		}
+		return query.Get("state")
+	}
+
+	state := start()
+	if _, err := service.CompleteNotionOAuth(ctx, other.ID, OAuthCompleteRequest{Code: "auth-code", State: state}); !errors.Is(err, ErrInvalidOAuthState) {
+		t.Fatalf("expected another user's state to be rejected, got %v", err)
+	}
+	account, err := service.CompleteNotionOAuth(ctx, owner.ID, OAuthCompleteRequest{Code: "auth-code", State: state})
+	if err != nil {
+		t.Fatalf("complete oauth: %v", err)
+	}
+	if account.Name != "Team Space" || account.AuthType != AuthTypeOAuth || account.LastTestStatus != StatusSuccess || !account.HasCredentials {
+		t.Fatalf("unexpected account: %+v", account)
+	}
+
+	row := client.NoteConnectionAccount.GetX(ctx, account.ID)
+	if strings.Contains(row.EncryptedCredentials, "notion-access-token") || strings.Contains(row.EncryptedCredentials, "notion-refresh-token") {
+		t.Fatal("oauth tokens were stored in plaintext")
+	}
+	credentials, err := service.decryptCredentials(row)
+	if err != nil {
+		t.Fatalf("decrypt credentials: %v", err)
+	}
+	if credentials.Token != "notion-access-token" || credentials.RefreshToken != "notion-refresh-token" || credentials.ExpiresAt == nil {
+		t.Fatalf("unexpected credentials: %+v", credentials)
+	}
+
+	again, err := service.CompleteNotionOAuth(ctx, owner.ID, OAuthCompleteRequest{Code: "auth-code", State: start()})
+	if err != nil {
+		t.Fatalf("complete second oauth: %v", err)
+	}
+	if again.Name != "Team Space (2)" {
+		t.Fatalf("expected second workspace account to be numbered, got %q", again.Name)
+	}
+}
+
+func TestNotionOAuthReconnectReplacesPastedToken(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestNotionOAuthReconnectReplacesPastedToken?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+
+	tokens := newFakeNotionTokenServer(t, func(map[string]string) (int, any) {
+		return http.StatusOK, map[string]any{"access_token": "oauth-token", "workspace_name": "Ignored"}
+	})
+	service := newOAuthTestService(t, client, tokens.URL)
+	created, err := service.CreateAccount(ctx, owner.ID, AccountInput{
+		Name:     "My Notion",
+		Provider: ProviderNotion,
+		Token:    stringPtr("pasted-token"),
+		Enabled:  true,
+	})
+	if err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+
+	resp, err := service.StartNotionOAuth(ctx, owner.ID, OAuthStartRequest{AccountID: created.ID})
+	if err != nil {
+		t.Fatalf("start oauth: %v", err)
+	}
+	authorizeURL, _ := url.Parse(resp.AuthorizeURL)
+	account, err := service.CompleteNotionOAuth(ctx, owner.ID, OAuthCompleteRequest{Code: "code", State: authorizeURL.Query().Get("state")})
+	if err != nil {
+		t.Fatalf("complete oauth: %v", err)
+	}
+	if account.ID != created.ID || account.Name != "My Notion" || account.AuthType != AuthTypeOAuth {
+		t.Fatalf("expected the account to be reconnected in place, got %+v", account)
+	}
+	credentials, err := service.decryptCredentials(client.NoteConnectionAccount.GetX(ctx, created.ID))
+	if err != nil || credentials.Token != "oauth-token" || credentials.ExpiresAt != nil {
+		t.Fatalf("unexpected credentials %+v: %v", credentials, err)
+	}
+
+	updated, err := service.UpdateAccount(ctx, owner.ID, created.ID, AccountInput{
+		Name:    "My Notion",
+		Token:   stringPtr("pasted-again"),
+		Enabled: true,
+	})
+	if err != nil {
+		t.Fatalf("update account: %v", err)
+	}
+	if updated.AuthType != AuthTypeToken {
+		t.Fatalf("expected a pasted token to switch the account back to token auth, got %q", updated.AuthType)
+	}
+}
+
+func TestOAuthAccountRefreshesExpiredAccessToken(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestOAuthAccountRefreshesExpiredAccessToken?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+
+	tokens := newFakeNotionTokenServer(t, func(body map[string]string) (int, any) {
+		if body["grant_type"] != "refresh_token" || body["refresh_token"] != "old-refresh" {
+			return http.StatusBadRequest, map[string]string{"error": "invalid_grant"}
+		}
+		return http.StatusOK, map[string]any{"access_token": "new-access", "expires_in": 3600}
+	})
+	service := newOAuthTestService(t, client, tokens.URL)
+	row := createOAuthAccount(t, service, owner.ID, Credentials{
+		Token:        "old-access",
+		RefreshToken: "old-refresh",
+		ExpiresAt:    timePtr(time.Now().Add(-time.Minute)),
+	})
+
+	provider, err := service.providerForAccount(ctx, row, nil)
+	if err != nil {
+		t.Fatalf("provider for account: %v", err)
+	}
+	if token := provider.(*notionProvider).token; token != "new-access" {
+		t.Fatalf("expected the refreshed token to be used, got %q", token)
+	}
+	credentials, err := service.decryptCredentials(client.NoteConnectionAccount.GetX(ctx, row.ID))
+	if err != nil {
+		t.Fatalf("decrypt credentials: %v", err)
+	}
+	if credentials.Token != "new-access" || credentials.RefreshToken != "old-refresh" || !credentials.ExpiresAt.After(time.Now()) {
+		t.Fatalf("refreshed credentials were not saved: %+v", credentials)
+	}
+
+	// The saved token is fresh now, so the stale row does not refresh again.
+	if _, err := service.providerForAccount(ctx, row, nil); err != nil {
+		t.Fatalf("provider for stale row: %v", err)
+	}
+	if got := tokens.grantTypes(); len(got) != 1 {
+		t.Fatalf("expected one refresh, got %v", got)
+	}
+}
+
+func TestRevokedOAuthGrantMarksAccountFailed(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestRevokedOAuthGrantMarksAccountFailed?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+
+	tokens := newFakeNotionTokenServer(t, func(map[string]string) (int, any) {
+		return http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "refresh token revoked"}
+	})
+	service := newOAuthTestService(t, client, tokens.URL)
+	row := createOAuthAccount(t, service, owner.ID, Credentials{
+		Token:        "old-access",
+		RefreshToken: "revoked-refresh",
+		ExpiresAt:    timePtr(time.Now().Add(-time.Hour)),
+	})
+
+	if _, err := service.providerForAccount(ctx, row, nil); !errors.Is(err, ErrAuthorizationExpired) {
+		t.Fatalf("expected expired authorization, got %v", err)
+	}
+	row = client.NoteConnectionAccount.GetX(ctx, row.ID)
+	if row.LastTestStatus != StatusFailed || row.LastTestError != ErrAuthorizationExpired.Error() {
+		t.Fatalf("expected the account to be marked failed, got %q %q", row.LastTestStatus, row.LastTestError)
+	}
+	if retryableJobError(ErrAuthorizationExpired) {
+		t.Fatal("expired authorization should not be retried")
+	}
+}
+
+func TestNotionUnauthorizedErrorMeansAuthorizationExpired(t *testing.T) {
+	err := redactProviderError(&notionapi.Error{Status: http.StatusUnauthorized, Code: "unauthorized", Message: "API token is invalid."})
+	if !errors.Is(err, ErrAuthorizationExpired) {
+		t.Fatalf("expected expired authorization, got %v", err)
+	}
+	if err := redactProviderError(&notionapi.Error{Status: http.StatusNotFound, Message: "not found"}); errors.Is(err, ErrAuthorizationExpired) {
+		t.Fatal("a missing page is not an authorization failure")
+	}
+}
+
+func TestNotionOAuthRequiresConfiguration(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestNotionOAuthRequiresConfiguration?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+
+	service := NewService(client, testSecretBox(t), storage.NewMemoryFileSystem())
+	service.SetNotionOAuthConfig(OAuthConfig{})
+	if service.OAuthProviders().Notion {
+		t.Fatal("expected Notion OAuth to be disabled")
+	}
+	if _, err := service.StartNotionOAuth(ctx, owner.ID, OAuthStartRequest{}); !errors.Is(err, ErrOAuthNotConfigured) {
+		t.Fatalf("expected not configured, got %v", err)
+	}
+}
+
+func createOAuthAccount(t *testing.T, service *Service, userID int, credentials Credentials) *ent.NoteConnectionAccount {
+	t.Helper()
+	encrypted, err := service.encryptCredentials(credentials)
+	if err != nil {
+		t.Fatalf("encrypt credentials: %v", err)
+	}
+	return service.client.NoteConnectionAccount.Create().
+		SetName("Notion").
+		SetProvider(ProviderNotion).
+		SetUserID(userID).
+		SetAuthType(AuthTypeOAuth).
+		SetEncryptedCredentials(encrypted).
+		SetCredentialAlg(credentialAlg).
+		SaveX(context.Background())
+}
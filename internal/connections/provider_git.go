@@ -0,0 +1,453 @@
+package connections
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"smarticky/internal/storage"
+)
+
+// errUnsafeGitConfig is returned for repositories whose own config names a
+// program for git to run.
+var errUnsafeGitConfig = errors.New("repository config runs external commands; remove it to connect")
+
+// gitUnsafeKeys are config keys, as section.key with any subsection
+// dropped, that make git run a command the repository chooses.
+var gitUnsafeKeys = map[string]bool{
+	"core.fsmonitor":            true,
+	"core.sshcommand":           true,
+	"core.gitproxy":             true,
+	"core.askpass":              true,
+	"core.alternaterefscommand": true,
+	"credential.helper":         true,
+	"diff.external":             true,
+	"diff.textconv":             true,
+	"diff.command":              true,
+	"merge.driver":              true,
+	"remote.uploadpack":         true,
+	"remote.receivepack":        true,
+}
+
+// gitRepoLocks serializes git commands per working tree across provider
+// instances, since a sync and a conflict resolution may push at once.
+var gitRepoLocks sync.Map
+
+// gitProvider mirrors notes into a Git repository as Markdown files laid out
+// like the Smarticky folder tree. A repository with a working tree is used
+// in place; a bare one is cloned under the data dir and pushed back to after
+// every commit. Reading goes through the vault reader on the working tree.
+type gitProvider struct {
+	repoDir string
+	workDir string
+	mirror  bool
+	vault   *vaultProvider
+	lock    *sync.Mutex
+	fetched bool
+	// checked is set once the repository config was found safe.
+	checked bool
+	// uploaded lists attachments written since the last commit; they are
+	// committed together with the note that links to them.
+	uploaded []string
+}
+
+func newGitProvider(repoDir, mirrorsDir string) Provider {
+	workDir, mirror := repoDir, false
+	if info, err := os.Stat(filepath.Join(repoDir, ".git")); err != nil || !info.IsDir() {
+		sum := sha256.Sum256([]byte(repoDir))
+		workDir, mirror = filepath.Join(mirrorsDir, hex.EncodeToString(sum[:8])), true
+	}
+	lock, _ := gitRepoLocks.LoadOrStore(workDir, &sync.Mutex{})
+	return &gitProvider{
+		repoDir: repoDir,
+		workDir: workDir,
+		mirror:  mirror,
+		vault:   &vaultProvider{root: workDir},
+		lock:    lock.(*sync.Mutex),
+	}
+}
+
+// defaultGitMirrorDir is where clones of bare repositories are kept.
+func defaultGitMirrorDir(fs *storage.FileSystem) string {
+	dir := filepath.Join(fs.GetDataDir(), "connections", "git")
+	if abs, err := filepath.Abs(dir); err == nil {
+		return abs
+	}
+	return dir
+}
+
+// normalizeGitEndpoint accepts an absolute path or a file:// URL. Network
+// remotes are not supported because they would need credentials.
+func normalizeGitEndpoint(endpoint string) (string, error) {
+	if strings.HasPrefix(endpoint, "file://") {
+		parsed, err := url.Parse(endpoint)
+		if err != nil {
+			return "", fmt.Errorf("invalid repository URL: %w", err)
+		}
+		if parsed.Host != "" && parsed.Host != "localhost" {
+			return "", errors.New("file URLs must point to this server")
+		}
+		if !filepath.IsAbs(parsed.Path) {
+			return "", errors.New("repository path must be absolute")
+		}
+		return "file://" + filepath.ToSlash(filepath.Clean(parsed.Path)), nil
+	}
+	if !filepath.IsAbs(endpoint) {
+		return "", errors.New("repository must be an absolute path or a file:// URL")
+	}
+	return filepath.Clean(endpoint), nil
+}
+
+// gitRepoPath returns the local path of a normalized endpoint.
+func gitRepoPath(endpoint string) string {
+	if strings.HasPrefix(endpoint, "file://") {
+		if parsed, err := url.Parse(endpoint); err == nil {
+			return filepath.FromSlash(parsed.Path)
+		}
+	}
+	return endpoint
+}
+
+func (p *gitProvider) Test(ctx context.Context) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, err := p.git(ctx, p.repoDir, "rev-parse", "--git-dir"); err != nil {
+		return err
+	}
+	return p.prepare(ctx)
+}
+
+func (p *gitProvider) ListTargets(ctx context.Context) ([]Target, error) {
+	if err := p.refresh(ctx); err != nil {
+		return nil, err
+	}
+	return p.vault.ListTargets(ctx)
+}
+
+func (p *gitProvider) ImportNotes(ctx context.Context, targetID string, limit int) ([]RemoteNote, error) {
+	if err := p.refresh(ctx); err != nil {
+		return nil, err
+	}
+	remotes, err := p.vault.ImportNotes(ctx, targetID, limit)
+	if err != nil {
+		return nil, err
+	}
+	for i := range remotes {
+		remotes[i] = gitRemoteNote(remotes[i])
+	}
+	return remotes, nil
+}
+
+func (p *gitProvider) GetNote(ctx context.Context, externalID string) (RemoteNote, error) {
+	if err := p.refresh(ctx); err != nil {
+		return RemoteNote{}, err
+	}
+	remote, err := p.vault.GetNote(ctx, externalID)
+	if err != nil {
+		return RemoteNote{}, err
+	}
+	return gitRemoteNote(remote), nil
+}
+
+// gitRemoteNote files every note under the repository root: the folder tree
+// comes from the file path, which is also where a push writes it back.
+func gitRemoteNote(remote RemoteNote) RemoteNote {
+	remote.TargetID = ""
+	return remote
+}
+
+func (p *gitProvider) ListAttachments(ctx context.Context, remote RemoteNote) ([]AttachmentRef, error) {
+	return p.vault.ListAttachments(ctx, remote)
+}
+
+func (p *gitProvider) DownloadAttachment(ctx context.Context, ref AttachmentRef) (RemoteAttachment, error) {
+	return p.vault.DownloadAttachment(ctx, ref)
+}
+
+func (p *gitProvider) UploadAttachment(ctx context.Context, file LocalAttachment) (string, error) {
+	if err := p.refresh(ctx); err != nil {
+		return "", err
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	rel, err := p.vault.storeAttachment(file)
+	if err != nil {
+		return "", err
+	}
+	p.uploaded = append(p.uploaded, rel)
+	return (&url.URL{Path: rel}).EscapedPath(), nil
+}
+
+func (p *gitProvider) PushNote(ctx context.Context, input PushInput) (PushResult, error) {
+	if err := p.refresh(ctx); err != nil {
+		return PushResult{}, err
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	base, err := p.vault.resolve(input.TargetID)
+	if err != nil {
+		return PushResult{}, err
+	}
+	dir := base
+	for _, segment := range input.FolderPath {
+		dir = filepath.Join(dir, vaultFileName(segment))
+	}
+	name := vaultFileName(input.Title)
+
+	existing := ""
+	existingFull := ""
+	if input.ExistingExternalID != "" && isVaultNote(input.ExistingExternalID) {
+		existingFull, err = p.vault.resolve(input.ExistingExternalID)
+		if err != nil {
+			return PushResult{}, err
+		}
+		if data, err := os.ReadFile(existingFull); err == nil {
+			existing = string(data)
+		} else if !os.IsNotExist(err) {
+			return PushResult{}, err
+		} else {
+			existingFull = ""
+		}
+	}
+
+	full := existingFull
+	if full == "" || !gitPathMatches(full, dir, name) {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return PushResult{}, err
+		}
+		full = availableVaultPath(dir, name)
+	}
+	stem := strings.TrimSuffix(filepath.Base(full), filepath.Ext(full))
+	content, err := vaultMarkdown(existing, stem, input)
+	if err != nil {
+		return PushResult{}, err
+	}
+
+	rel := p.vault.relative(full)
+	paths := append([]string{rel}, p.uploaded...)
+	subject := "Update " + titleOrUntitled(input.Title)
+	switch {
+	case existingFull == "":
+		subject = "Add " + titleOrUntitled(input.Title)
+	case existingFull != full:
+		if filepath.Dir(existingFull) == filepath.Dir(full) {
+			subject = "Rename " + strings.TrimSuffix(filepath.Base(existingFull), filepath.Ext(existingFull)) + " to " + titleOrUntitled(input.Title)
+		} else {
+			subject = "Move " + titleOrUntitled(input.Title) + " to " + gitDisplayDir(rel)
+		}
+		paths = append(paths, p.vault.relative(existingFull))
+		if err := os.Rename(existingFull, full); err != nil {
+			return PushResult{}, err
+		}
+		// Leave no empty folders behind; Remove fails on the ones in use.
+		for parent := filepath.Dir(existingFull); parent != p.workDir && pathWithin(p.workDir, parent); parent = filepath.Dir(parent) {
+			if os.Remove(parent) != nil {
+				break
+			}
+		}
+	}
+	if err := writeFileAtomic(full, []byte(content)); err != nil {
+		return PushResult{}, err
+	}
+	if err := p.commit(ctx, subject, fmt.Sprintf("Smarticky note %s", input.NoteID), paths...); err != nil {
+		return PushResult{}, err
+	}
+	p.uploaded = nil
+
+	return PushResult{
+		ExternalID: rel,
+		TargetID:   strings.TrimSpace(input.TargetID),
+		Path:       rel,
+	}, nil
+}
+
+func gitDisplayDir(rel string) string {
+	if dir := path.Dir(rel); dir != "." {
+		return dir
+	}
+	return "the repository root"
+}
+
+// gitPathMatches reports whether a note's current file already sits where
+// its folder and title put it, allowing the numbered names used to avoid
+// clashes.
+func gitPathMatches(full, dir, name string) bool {
+	if filepath.Dir(full) != dir {
+		return false
+	}
+	stem := strings.TrimSuffix(filepath.Base(full), filepath.Ext(full))
+	if stem == name {
+		return true
+	}
+	suffix, ok := strings.CutPrefix(stem, name+" ")
+	if !ok || suffix == "" {
+		return false
+	}
+	return strings.Trim(suffix, "0123456789") == ""
+}
+
+// commit records the changes to the given paths and, for mirrors, pushes
+// them. Other changes in a working tree used in place are left alone.
+func (p *gitProvider) commit(ctx context.Context, subject, body string, paths ...string) error {
+	if _, err := p.git(ctx, p.workDir, append([]string{"add", "-A", "--"}, paths...)...); err != nil {
+		return err
+	}
+	staged, err := p.git(ctx, p.workDir, append([]string{"diff", "--cached", "--name-only", "--no-ext-diff", "--no-textconv", "--"}, paths...)...)
+	if err != nil {
+		return err
+	}
+	if staged == "" {
+		return nil
+	}
+	if _, err := p.git(ctx, p.workDir, append([]string{"commit", "--quiet", "-m", subject, "-m", body, "--"}, paths...)...); err != nil {
+		return err
+	}
+	if !p.mirror {
+		return nil
+	}
+	if _, err := p.git(ctx, p.workDir, "push", "--quiet", "origin", "HEAD"); err == nil {
+		return nil
+	}
+	// Someone else pushed first: replay the commit on top and try once more.
+	p.fetched = false
+	if err := p.pull(ctx); err != nil {
+		return err
+	}
+	_, err = p.git(ctx, p.workDir, "push", "--quiet", "origin", "HEAD")
+	return err
+}
+
+// refresh brings a mirror up to date with the repository once per provider
+// instance, so a sync of many notes fetches only once.
+func (p *gitProvider) refresh(ctx context.Context) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.prepare(ctx); err != nil {
+		return err
+	}
+	if !p.mirror || p.fetched {
+		return nil
+	}
+	if err := p.pull(ctx); err != nil {
+		return err
+	}
+	p.fetched = true
+	return nil
+}
+
+// prepare clones a bare repository into its mirror on first use and checks
+// that neither the repository nor the working tree configures commands.
+func (p *gitProvider) prepare(ctx context.Context) error {
+	if p.checked {
+		return nil
+	}
+	if err := p.checkConfig(ctx, p.repoDir); err != nil {
+		return err
+	}
+	if p.mirror {
+		if _, err := os.Stat(filepath.Join(p.workDir, ".git")); err != nil {
+			if err := os.MkdirAll(filepath.Dir(p.workDir), 0755); err != nil {
+				return err
+			}
+			if _, err := p.git(ctx, "", "clone", "--quiet", "--", p.repoDir, p.workDir); err != nil {
+				return err
+			}
+		}
+		if err := p.checkConfig(ctx, p.workDir); err != nil {
+			return err
+		}
+	}
+	p.checked = true
+	return nil
+}
+
+// checkConfig rejects a repository whose local config, or a file it
+// includes, sets a filter driver or another key that runs a command. Git
+// only runs the filters named in .gitattributes when the config defines
+// them, so this also covers attributes.
+func (p *gitProvider) checkConfig(ctx context.Context, dir string) error {
+	names, err := p.git(ctx, dir, "config", "--local", "--includes", "--name-only", "--list")
+	if err != nil {
+		return err
+	}
+	for _, name := range strings.Split(names, "\n") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		section, _, _ := strings.Cut(name, ".")
+		key := name[strings.LastIndex(name, ".")+1:]
+		if section == "filter" || gitUnsafeKeys[section+"."+key] {
+			return fmt.Errorf("%w: %s", errUnsafeGitConfig, name)
+		}
+	}
+	return nil
+}
+
+func (p *gitProvider) pull(ctx context.Context) error {
+	if _, err := p.git(ctx, p.workDir, "fetch", "--quiet", "origin"); err != nil {
+		return err
+	}
+	branch, err := p.git(ctx, p.workDir, "symbolic-ref", "--short", "HEAD")
+	if err != nil {
+		return err
+	}
+	upstream := "origin/" + branch
+	if _, err := p.git(ctx, p.workDir, "rev-parse", "--verify", "--quiet", upstream); err != nil {
+		// Nothing has been pushed to this branch yet.
+		return nil
+	}
+	if _, err := p.git(ctx, p.workDir, "merge", "--ff-only", "--quiet", upstream); err == nil {
+		return nil
+	}
+	if _, err := p.git(ctx, p.workDir, "rebase", "--quiet", upstream); err != nil {
+		_, _ = p.git(ctx, p.workDir, "rebase", "--abort")
+		return fmt.Errorf("repository has diverged: %w", err)
+	}
+	return nil
+}
+
+// git runs a git command without hooks, prompts, signing or the system and
+// global config, and with the command-running keys a repository could set
+// overridden. The rest are refused by checkConfig before any command that
+// touches the working tree, so a repository cannot run code or block the job.
+func (p *gitProvider) git(ctx context.Context, dir string, args ...string) (string, error) {
+	full := append([]string{
+		"-c", "core.hooksPath=" + os.DevNull,
+		"-c", "core.fsmonitor=false",
+		"-c", "core.sshCommand=ssh",
+		"-c", "credential.helper=",
+		"-c", "protocol.ext.allow=never",
+		"-c", "commit.gpgSign=false",
+		"-c", "user.name=Smarticky",
+		"-c", "user.email=smarticky@localhost",
+	}, args...)
+	cmd := exec.CommandContext(ctx, "git", full...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_TERMINAL_PROMPT=0",
+		"GIT_CONFIG_NOSYSTEM=1",
+		"GIT_CONFIG_GLOBAL="+os.DevNull,
+		"GIT_ATTR_NOSYSTEM=1",
+		"LC_ALL=C",
+	)
+	out, err := cmd.CombinedOutput()
+	message := strings.TrimSpace(string(out))
+	if err != nil {
+		if message == "" {
+			message = err.Error()
+		}
+		return "", fmt.Errorf("git %s: %s", args[0], message)
+	}
+	return message, nil
+}
@@ -0,0 +1,252 @@
+package connections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"smarticky/ent/enttest"
+	"smarticky/ent/note"
+	"smarticky/ent/noteconnectionitemmap"
+	"smarticky/internal/storage"
+
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestGitProviderMirrorsNotesIntoBareRepository(t *testing.T) {
+	requireGit(t)
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestGitProviderMirrorsNotesIntoBareRepository?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetUsername("owner").
+		SetPasswordHash("hash").
+		SaveX(ctx)
+	roots := t.TempDir()
+	bare := filepath.Join(roots, "notes.git")
+	runGit(t, roots, "init", "--quiet", "--bare", "-b", "main", bare)
+	// Another contributor works in their own clone.
+	alice := filepath.Join(roots, "alice")
+	runGit(t, roots, "clone", "--quiet", bare, alice)
+	writeVaultFile(t, alice, "Inbox/Welcome.md", "---\ntags: [start]\n---\nHello")
+	runGit(t, alice, "add", "-A")
+	runGit(t, alice, "commit", "--quiet", "-m", "Welcome")
+	runGit(t, alice, "push", "--quiet", "origin", "HEAD:main")
+
+	service := NewService(client, testSecretBox(t), storage.NewMemoryFileSystem())
+	service.vaultRoots = []string{roots}
+	service.gitMirrors = t.TempDir()
+	for _, endpoint := range []string{"notes.git", "https://example.com/notes.git", "file:///etc"} {
+		if _, err := service.CreateAccount(ctx, u.ID, AccountInput{Provider: ProviderGit, Endpoint: endpoint, Enabled: true}); err == nil {
+			t.Fatalf("expected endpoint %q to be rejected", endpoint)
+		}
+	}
+	account, err := service.CreateAccount(ctx, u.ID, AccountInput{Provider: ProviderGit, Endpoint: "file://" + bare, Enabled: true})
+	if err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+	if _, err := service.TestAccount(ctx, u.ID, account.ID, nil); err != nil {
+		t.Fatalf("test account: %v", err)
+	}
+
+	result, err := service.ImportNotes(ctx, u.ID, account.ID, ImportRequest{})
+	if err != nil {
+		t.Fatalf("import notes: %v", err)
+	}
+	if result.ImportedCount != 1 {
+		t.Fatalf("expected one imported note, got %+v", result)
+	}
+	welcome := client.Note.Query().Where(note.TitleEQ("Welcome")).WithFolder().OnlyX(ctx)
+	if welcome.Edges.Folder == nil || welcome.Edges.Folder.Name != "Inbox" || welcome.Content != "Hello" {
+		t.Fatalf("unexpected imported note: %+v", welcome)
+	}
+
+	// Pushed notes land in folders matching the Smarticky folder tree.
+	projects := client.Folder.Create().SetName("Projects").SetUserID(u.ID).SaveX(ctx)
+	plans := client.Folder.Create().SetName("Plans").SetParent(projects).SetUserID(u.ID).SaveX(ctx)
+	roadmap := client.Note.Create().
+		SetTitle("Roadmap").
+		SetContent("Ship it").
+		SetFolder(plans).
+		SetUserID(u.ID).
+		SaveX(ctx)
+	if _, err := service.PushNote(ctx, u.ID, account.ID, roadmap.ID, ""); err != nil {
+		t.Fatalf("push note: %v", err)
+	}
+	runGit(t, alice, "pull", "--quiet", "--ff-only")
+	if got := readVaultFile(t, alice, "Projects/Plans/Roadmap.md"); !strings.HasSuffix(got, "---\nShip it\n") {
+		t.Fatalf("unexpected pushed file:\n%s", got)
+	}
+	if subject := runGit(t, alice, "log", "-1", "--format=%s"); subject != "Add Roadmap" {
+		t.Fatalf("unexpected commit subject %q", subject)
+	}
+
+	// Renaming the note renames the file and follows it in the item map.
+	roadmap.Update().SetTitle("Roadmap 2026").ExecX(ctx)
+	if _, err := service.PushNote(ctx, u.ID, account.ID, roadmap.ID, ""); err != nil {
+		t.Fatalf("push renamed note: %v", err)
+	}
+	runGit(t, alice, "pull", "--quiet", "--ff-only")
+	if _, err := os.Stat(filepath.Join(alice, "Projects/Plans/Roadmap.md")); !os.IsNotExist(err) {
+		t.Fatalf("expected the old file to be gone, got %v", err)
+	}
+	readVaultFile(t, alice, "Projects/Plans/Roadmap 2026.md")
+	if subject := runGit(t, alice, "log", "-1", "--format=%s"); subject != "Rename Roadmap to Roadmap 2026" {
+		t.Fatalf("unexpected commit subject %q", subject)
+	}
+	item := client.NoteConnectionItemMap.Query().Where(noteconnectionitemmap.NoteIDEQ(roadmap.ID)).OnlyX(ctx)
+	if item.ExternalPath != "Projects/Plans/Roadmap 2026.md" {
+		t.Fatalf("unexpected external path %q", item.ExternalPath)
+	}
+
+	// Changes pushed by others are pulled by a sync.
+	writeVaultFile(t, alice, "Inbox/Welcome.md", "---\ntags: [start]\n---\nHello again")
+	runGit(t, alice, "commit", "--quiet", "-am", "Edit welcome")
+	runGit(t, alice, "push", "--quiet", "origin", "HEAD:main")
+	synced, err := runQueuedSync(ctx, service, u.ID, account.ID)
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if synced.PulledCount != 1 || synced.FailedCount != 0 {
+		t.Fatalf("expected the remote edit to be pulled, got %+v", synced)
+	}
+	if got := client.Note.GetX(ctx, welcome.ID).Content; got != "Hello again" {
+		t.Fatalf("unexpected pulled content %q", got)
+	}
+}
+
+func TestGitProviderCommitsInPlace(t *testing.T) {
+	requireGit(t)
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestGitProviderCommitsInPlace?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetUsername("owner").
+		SetPasswordHash("hash").
+		SaveX(ctx)
+	repo := t.TempDir()
+	runGit(t, repo, "init", "--quiet", "-b", "main")
+	writeVaultFile(t, repo, "scratch.md", "work in progress")
+
+	fs := storage.NewMemoryFileSystem()
+	service := NewService(client, testSecretBox(t), fs)
+	service.vaultRoots = []string{repo}
+	service.gitMirrors = t.TempDir()
+	account, err := service.CreateAccount(ctx, u.ID, AccountInput{Provider: ProviderGit, Endpoint: repo, Enabled: true})
+	if err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+	idea := client.Note.Create().
+		SetTitle("Idea").
+		SetContent("Write it down").
+		SetUserID(u.ID).
+		SaveX(ctx)
+	// Attachments are committed with the note that links to them.
+	filePath := filepath.Join(fs.GetUploadsDir("attachments"), "sketch.png")
+	if err := fs.WriteFile(filePath, []byte("sketch"), 0644); err != nil {
+		t.Fatalf("write attachment: %v", err)
+	}
+	sketch := client.Attachment.Create().
+		SetFilename("sketch.png").
+		SetFilePath(filePath).
+		SetFileSize(6).
+		SetMimeType("image/png").
+		SetNote(idea).
+		SetUserID(u.ID).
+		SaveX(ctx)
+	idea.Update().SetContent(fmt.Sprintf("Write it down\n\n![sketch](/api/attachments/%d/download)", sketch.ID)).ExecX(ctx)
+	pushed, err := service.PushNote(ctx, u.ID, account.ID, idea.ID, "")
+	if err != nil {
+		t.Fatalf("push note: %v", err)
+	}
+	if pushed.Result.ExternalID != "Idea.md" {
+		t.Fatalf("unexpected external id %q", pushed.Result.ExternalID)
+	}
+	if log := runGit(t, repo, "log", "--format=%s%n%b"); !strings.Contains(log, "Add Idea") || !strings.Contains(log, idea.ID.String()) {
+		t.Fatalf("unexpected history:\n%s", log)
+	}
+	if status := runGit(t, repo, "status", "--porcelain"); status != "?? scratch.md" {
+		t.Fatalf("unrelated changes were touched: %q", status)
+	}
+	if files := runGit(t, repo, "ls-files"); files != "Idea.md\nattachments/sketch.png" {
+		t.Fatalf("unexpected tracked files:\n%s", files)
+	}
+
+	// Pushing an unchanged note does not add an empty commit.
+	if _, err := service.PushNote(ctx, u.ID, account.ID, idea.ID, ""); err != nil {
+		t.Fatalf("push unchanged note: %v", err)
+	}
+	if count := runGit(t, repo, "rev-list", "--count", "HEAD"); count != "1" {
+		t.Fatalf("expected a single commit, got %s", count)
+	}
+}
+
+func TestGitProviderRefusesRepositoriesThatRunCommands(t *testing.T) {
+	requireGit(t)
+	ctx := context.Background()
+	marker := filepath.Join(t.TempDir(), "ran")
+	for _, setting := range [][2]string{
+		{"filter.lfs.clean", "touch " + marker},
+		{"core.fsmonitor", "touch " + marker},
+		{"core.sshCommand", "touch " + marker},
+		{"diff.word.textconv", "touch " + marker},
+	} {
+		repo := t.TempDir()
+		runGit(t, repo, "init", "--quiet", "-b", "main")
+		runGit(t, repo, "config", setting[0], setting[1])
+		writeVaultFile(t, repo, ".gitattributes", "*.md filter=lfs diff=word\n")
+
+		provider := newGitProvider(repo, t.TempDir())
+		if err := provider.Test(ctx); !errors.Is(err, errUnsafeGitConfig) {
+			t.Fatalf("%s: test error = %v, want errUnsafeGitConfig", setting[0], err)
+		}
+		if _, err := provider.ListTargets(ctx); !errors.Is(err, errUnsafeGitConfig) {
+			t.Fatalf("%s: list error = %v, want errUnsafeGitConfig", setting[0], err)
+		}
+	}
+	if _, err := os.Stat(marker); !os.IsNotExist(err) {
+		t.Fatalf("a repository command ran: %v", err)
+	}
+}
+
+func TestGitPathMatchesNumberedNames(t *testing.T) {
+	dir := filepath.Join("repo", "Projects")
+	for name, want := range map[string]bool{
+		"Plan.md":     true,
+		"Plan 2.md":   true,
+		"Plan B.md":   false,
+		"Planning.md": false,
+	} {
+		if got := gitPathMatches(filepath.Join(dir, name), dir, "Plan"); got != want {
+			t.Fatalf("gitPathMatches(%q) = %v, want %v", name, got, want)
+		}
+	}
+	if gitPathMatches(filepath.Join("repo", "Plan.md"), dir, "Plan") {
+		t.Fatal("expected a file in another folder not to match")
+	}
+}
+
+func requireGit(t *testing.T) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-c", "user.name=Alice", "-c", "user.email=alice@example.com"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
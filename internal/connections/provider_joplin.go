@@ -4,15 +4,22 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 )
 
+// joplinResourceLinkPattern matches Markdown links to Joplin resources,
+// which notes write as ![name](:/0123abcd...).
+var joplinResourceLinkPattern = regexp.MustCompile(`!?\[[^\]\n]*\]\(:/([0-9a-fA-F]{32})\)`)
+
 type joplinProvider struct {
 	endpoint string
 	token    string
@@ -30,6 +37,22 @@ type joplinFolder struct {
 	ParentID string `json:"parent_id"`
 }
 
+type joplinResource struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Mime  string `json:"mime"`
+	Size  int64  `json:"size"`
+}
+
+type joplinStatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *joplinStatusError) Error() string {
+	return e.Message
+}
+
 type joplinNote struct {
 	ID          string `json:"id"`
 	Title       string `json:"title"`
@@ -154,6 +177,28 @@ func (p *joplinProvider) ImportNotes(ctx context.Context, targetID string, limit
 	return notes, nil
 }
 
+func (p *joplinProvider) GetNote(ctx context.Context, externalID string) (RemoteNote, error) {
+	var item joplinNote
+	err := p.doJSON(ctx, http.MethodGet, "/notes/"+url.PathEscape(externalID), url.Values{
+		"fields": {"id,title,body,parent_id,created_time,updated_time"},
+	}, nil, &item)
+	var statusErr *joplinStatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+		return RemoteNote{}, ErrRemoteNoteNotFound
+	}
+	if err != nil {
+		return RemoteNote{}, err
+	}
+	return RemoteNote{
+		ExternalID: item.ID,
+		TargetID:   item.ParentID,
+		Title:      titleOrUntitled(item.Title),
+		Content:    item.Body,
+		CreatedAt:  parseJoplinTime(item.CreatedTime),
+		UpdatedAt:  parseJoplinTime(item.UpdatedTime),
+	}, nil
+}
+
 func joplinFolderPaths(targets []Target) map[string]string {
 	byID := make(map[string]Target, len(targets))
 	for _, target := range targets {
@@ -212,48 +257,144 @@ func (p *joplinProvider) PushNote(ctx context.Context, input PushInput) (PushRes
 	}, nil
 }
 
-func (p *joplinProvider) doJSON(ctx context.Context, method, path string, query url.Values, body any, out any) error {
-	target, err := url.Parse(p.endpoint + path)
+func (p *joplinProvider) ListAttachments(ctx context.Context, remote RemoteNote) ([]AttachmentRef, error) {
+	var refs []AttachmentRef
+	seen := map[string]bool{}
+	for _, match := range joplinResourceLinkPattern.FindAllStringSubmatch(remote.Content, -1) {
+		if seen[match[0]] {
+			continue
+		}
+		seen[match[0]] = true
+		refs = append(refs, AttachmentRef{ID: match[1], Embed: match[0]})
+	}
+	return refs, nil
+}
+
+func (p *joplinProvider) DownloadAttachment(ctx context.Context, ref AttachmentRef) (RemoteAttachment, error) {
+	resourcePath := "/resources/" + url.PathEscape(ref.ID)
+	var resource joplinResource
+	if err := p.doJSON(ctx, http.MethodGet, resourcePath, url.Values{
+		"fields": {"id,title,mime,size"},
+	}, nil, &resource); err != nil {
+		return RemoteAttachment{}, err
+	}
+	if resource.Size > maxRemoteAttachmentSize {
+		return RemoteAttachment{}, fmt.Errorf("joplin resource is larger than %d bytes", maxRemoteAttachmentSize)
+	}
+	resp, err := p.do(ctx, http.MethodGet, resourcePath+"/file", nil, nil, "")
 	if err != nil {
-		return err
+		return RemoteAttachment{}, err
 	}
-	values := target.Query()
-	values.Set("token", p.token)
-	for key, list := range query {
-		for _, value := range list {
-			values.Add(key, value)
-		}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteAttachmentSize+1))
+	if err != nil {
+		return RemoteAttachment{}, err
 	}
-	target.RawQuery = values.Encode()
+	if len(data) > maxRemoteAttachmentSize {
+		return RemoteAttachment{}, fmt.Errorf("joplin resource is larger than %d bytes", maxRemoteAttachmentSize)
+	}
+	return RemoteAttachment{
+		Name:     defaultString(resource.Title, ref.ID),
+		MIMEType: resource.Mime,
+		Data:     data,
+		Embed:    ref.Embed,
+		Link:     ":/" + ref.ID,
+	}, nil
+}
+
+func (p *joplinProvider) UploadAttachment(ctx context.Context, file LocalAttachment) (string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	props, err := json.Marshal(map[string]string{"title": file.Name, "filename": file.Name})
+	if err != nil {
+		return "", err
+	}
+	if err := writer.WriteField("props", string(props)); err != nil {
+		return "", err
+	}
+	part, err := writer.CreateFormFile("data", file.Name)
+	if err != nil {
+		return "", err
+	}
+	if _, err := part.Write(file.Data); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	resp, err := p.do(ctx, http.MethodPost, "/resources", nil, &body, writer.FormDataContentType())
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	var resource joplinResource
+	if err := json.NewDecoder(resp.Body).Decode(&resource); err != nil {
+		return "", err
+	}
+	if resource.ID == "" {
+		return "", errors.New("joplin did not return a resource id")
+	}
+	return ":/" + resource.ID, nil
+}
 
+func (p *joplinProvider) doJSON(ctx context.Context, method, path string, query url.Values, body any, out any) error {
 	var reader io.Reader
+	contentType := ""
 	if body != nil {
 		raw, err := json.Marshal(body)
 		if err != nil {
 			return err
 		}
 		reader = bytes.NewReader(raw)
+		contentType = "application/json"
 	}
-	req, err := http.NewRequestWithContext(ctx, method, target.String(), reader)
+	resp, err := p.do(ctx, method, path, query, reader, contentType)
 	if err != nil {
 		return err
 	}
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	defer resp.Body.Close()
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// do sends an authenticated request to the clipper server. Error statuses
+// are returned as a joplinStatusError; otherwise the caller closes the body.
+func (p *joplinProvider) do(ctx context.Context, method, path string, query url.Values, body io.Reader, contentType string) (*http.Response, error) {
+	target, err := url.Parse(p.endpoint + path)
+	if err != nil {
+		return nil, err
+	}
+	values := target.Query()
+	values.Set("token", p.token)
+	for key, list := range query {
+		for _, value := range list {
+			values.Add(key, value)
+		}
+	}
+	target.RawQuery = values.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
 	}
 	resp, err := p.client.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
 		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
-		return fmt.Errorf("joplin request failed: %s %s", resp.Status, strings.TrimSpace(string(raw)))
-	}
-	if out == nil {
-		return nil
+		return nil, &joplinStatusError{
+			StatusCode: resp.StatusCode,
+			Message:    fmt.Sprintf("joplin request failed: %s %s", resp.Status, strings.TrimSpace(string(raw))),
+		}
 	}
-	return json.NewDecoder(resp.Body).Decode(out)
+	return resp, nil
 }
 
 func parseJoplinTime(value int64) *time.Time {
@@ -0,0 +1,473 @@
+package connections
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	memosPageSize = 100
+	// memosMaxPages bounds how far ImportNotes and ListTargets page, since
+	// Memos filters by tag differently across versions and the filtering
+	// happens here.
+	memosMaxPages = 50
+)
+
+// memosFileLinkPattern matches Markdown links to files Memos serves for
+// attachments, and resources on versions before attachments.
+var memosFileLinkPattern = regexp.MustCompile(`!?\[[^\]\n]*\]\((/file/((?:attachments|resources)/[A-Za-z0-9_-]+)/[^)\s]*)\)`)
+
+// memosTagLinePattern matches a line holding only hashtags, which pushes
+// append and imports strip again.
+var memosTagLinePattern = regexp.MustCompile(`^#[^\s#]+(?:\s+#[^\s#]+)*$`)
+
+type memosProvider struct {
+	endpoint string
+	token    string
+	client   *http.Client
+}
+
+type memosMemo struct {
+	Name        string            `json:"name"`
+	Content     string            `json:"content"`
+	Visibility  string            `json:"visibility,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	CreateTime  string            `json:"createTime,omitempty"`
+	UpdateTime  string            `json:"updateTime,omitempty"`
+	Attachments []memosAttachment `json:"attachments,omitempty"`
+	Resources   []memosAttachment `json:"resources,omitempty"`
+	Property    *struct {
+		Tags []string `json:"tags"`
+	} `json:"property,omitempty"`
+}
+
+type memosAttachment struct {
+	Name     string `json:"name"`
+	Filename string `json:"filename"`
+	Type     string `json:"type"`
+	Size     string `json:"size,omitempty"`
+}
+
+type memosStatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *memosStatusError) Error() string {
+	return e.Message
+}
+
+func newMemosProvider(endpoint, token string, httpClient *http.Client) Provider {
+	return &memosProvider{endpoint: endpoint, token: token, client: httpClient}
+}
+
+func (p *memosProvider) Test(ctx context.Context) error {
+	var response struct {
+		Memos []memosMemo `json:"memos"`
+	}
+	return p.doJSON(ctx, http.MethodGet, "/api/v1/memos", url.Values{"pageSize": {"1"}}, nil, &response)
+}
+
+// ListTargets offers the tags in use, with nested tags such as #work/notes
+// under their parent.
+func (p *memosProvider) ListTargets(ctx context.Context) ([]Target, error) {
+	memos, err := p.listMemos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	var targets []Target
+	for _, memo := range memos {
+		for _, tag := range memo.tags() {
+			parent := ""
+			for _, segment := range strings.Split(tag, "/") {
+				id := strings.TrimPrefix(parent+"/"+segment, "/")
+				if segment == "" || seen[id] {
+					parent = id
+					continue
+				}
+				seen[id] = true
+				targets = append(targets, Target{ID: id, Name: segment, Kind: "tag", ParentID: parent})
+				parent = id
+			}
+		}
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i].ID < targets[j].ID })
+	return targets, nil
+}
+
+// ImportNotes reads the memos tagged with the target, or every memo when
+// no target is set. The memo's tag becomes its folder.
+func (p *memosProvider) ImportNotes(ctx context.Context, targetID string, limit int) ([]RemoteNote, error) {
+	limit = clampLimit(limit)
+	targetID = strings.Trim(strings.TrimSpace(targetID), "#/")
+	memos, err := p.listMemos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var notes []RemoteNote
+	for _, memo := range memos {
+		if len(notes) >= limit {
+			break
+		}
+		folder, ok := memo.folderTag(targetID)
+		if !ok {
+			continue
+		}
+		remote := p.remoteNote(memo, folder)
+		remote.TargetID = targetID
+		notes = append(notes, remote)
+	}
+	return notes, nil
+}
+
+func (p *memosProvider) GetNote(ctx context.Context, externalID string) (RemoteNote, error) {
+	var memo memosMemo
+	err := p.doJSON(ctx, http.MethodGet, "/api/v1/"+memosResourcePath(externalID), nil, nil, &memo)
+	var statusErr *memosStatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+		return RemoteNote{}, ErrRemoteNoteNotFound
+	}
+	if err != nil {
+		return RemoteNote{}, err
+	}
+	folder, _ := memo.folderTag("")
+	remote := p.remoteNote(memo, folder)
+	remote.TargetID = strings.SplitN(folder, "/", 2)[0]
+	return remote, nil
+}
+
+func (p *memosProvider) remoteNote(memo memosMemo, folder string) RemoteNote {
+	title, content := memosTitle(memo.Content)
+	var tags []string
+	for _, tag := range memo.tags() {
+		if tag != folder {
+			tags = append(tags, tag)
+		}
+	}
+	for _, attachment := range memo.attachments() {
+		link := "/file/" + attachment.Name + "/" + url.PathEscape(attachment.Filename)
+		if strings.Contains(content, link) {
+			continue
+		}
+		embed := "[" + attachment.Filename + "](" + link + ")"
+		if strings.HasPrefix(attachment.Type, "image/") {
+			embed = "!" + embed
+		}
+		content = strings.TrimRight(content, "\n") + "\n\n" + embed + "\n"
+	}
+	return RemoteNote{
+		ExternalID: memo.Name,
+		URL:        p.endpoint + "/" + memo.Name,
+		Path:       strings.Trim(folder+"/"+title, "/"),
+		Title:      title,
+		Content:    content,
+		Tags:       tags,
+		CreatedAt:  parseMemosTime(memo.CreateTime),
+		UpdatedAt:  parseMemosTime(memo.UpdateTime),
+	}
+}
+
+func (p *memosProvider) listMemos(ctx context.Context) ([]memosMemo, error) {
+	var memos []memosMemo
+	pageToken := ""
+	for page := 0; page < memosMaxPages; page++ {
+		query := url.Values{"pageSize": {fmt.Sprint(memosPageSize)}}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		var response struct {
+			Memos         []memosMemo `json:"memos"`
+			NextPageToken string      `json:"nextPageToken"`
+		}
+		if err := p.doJSON(ctx, http.MethodGet, "/api/v1/memos", query, nil, &response); err != nil {
+			return nil, err
+		}
+		memos = append(memos, response.Memos...)
+		if response.NextPageToken == "" {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+	return memos, nil
+}
+
+// PushNote writes the title as a heading and the folder and tags as a
+// hashtag line, which is how Memos organises memos.
+func (p *memosProvider) PushNote(ctx context.Context, input PushInput) (PushResult, error) {
+	memo := memosMemo{Content: memosContent(input)}
+	var saved memosMemo
+	if input.ExistingExternalID != "" {
+		memo.Name = memosResourcePath(input.ExistingExternalID)
+		err := p.doJSON(ctx, http.MethodPatch, "/api/v1/"+memo.Name, url.Values{"updateMask": {"content"}}, memo, &saved)
+		if err != nil {
+			return PushResult{}, err
+		}
+	} else {
+		memo.Visibility = "PRIVATE"
+		if err := p.doJSON(ctx, http.MethodPost, "/api/v1/memos", nil, memo, &saved); err != nil {
+			return PushResult{}, err
+		}
+	}
+	if saved.Name == "" {
+		return PushResult{}, errors.New("memos did not return a memo name")
+	}
+	return PushResult{
+		ExternalID: saved.Name,
+		TargetID:   strings.Trim(strings.TrimSpace(input.TargetID), "#/"),
+		URL:        p.endpoint + "/" + saved.Name,
+	}, nil
+}
+
+func memosContent(input PushInput) string {
+	var builder strings.Builder
+	builder.WriteString("# " + titleOrUntitled(input.Title) + "\n\n")
+	builder.WriteString(strings.TrimRight(input.Content, "\n"))
+
+	var segments []string
+	if target := strings.Trim(strings.TrimSpace(input.TargetID), "#/"); target != "" {
+		segments = append(segments, target)
+	}
+	for _, segment := range input.FolderPath {
+		if name := memosTagName(segment); name != "" {
+			segments = append(segments, name)
+		}
+	}
+	var tags []string
+	if len(segments) > 0 {
+		tags = append(tags, "#"+strings.Join(segments, "/"))
+	}
+	for _, tag := range input.Tags {
+		if name := memosTagName(tag); name != "" {
+			tags = append(tags, "#"+name)
+		}
+	}
+	if len(tags) > 0 {
+		builder.WriteString("\n\n" + strings.Join(tags, " "))
+	}
+	return builder.String() + "\n"
+}
+
+func (p *memosProvider) ListAttachments(ctx context.Context, remote RemoteNote) ([]AttachmentRef, error) {
+	var refs []AttachmentRef
+	seen := map[string]bool{}
+	for _, match := range memosFileLinkPattern.FindAllStringSubmatch(remote.Content, -1) {
+		if seen[match[0]] {
+			continue
+		}
+		seen[match[0]] = true
+		name, _ := url.PathUnescape(path.Base(match[1]))
+		refs = append(refs, AttachmentRef{ID: match[1], Name: name, Embed: match[0]})
+	}
+	return refs, nil
+}
+
+func (p *memosProvider) DownloadAttachment(ctx context.Context, ref AttachmentRef) (RemoteAttachment, error) {
+	if !strings.HasPrefix(ref.ID, "/file/") {
+		return RemoteAttachment{}, fmt.Errorf("unsupported memos file link %q", ref.ID)
+	}
+	resp, err := p.do(ctx, http.MethodGet, ref.ID, nil, nil, "")
+	if err != nil {
+		return RemoteAttachment{}, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteAttachmentSize+1))
+	if err != nil {
+		return RemoteAttachment{}, err
+	}
+	if len(data) > maxRemoteAttachmentSize {
+		return RemoteAttachment{}, fmt.Errorf("memos file is larger than %d bytes", maxRemoteAttachmentSize)
+	}
+	return RemoteAttachment{
+		Name:     defaultString(ref.Name, path.Base(ref.ID)),
+		MIMEType: resp.Header.Get("Content-Type"),
+		Data:     data,
+		Embed:    ref.Embed,
+		Link:     ref.ID,
+	}, nil
+}
+
+func (p *memosProvider) UploadAttachment(ctx context.Context, file LocalAttachment) (string, error) {
+	var attachment memosAttachment
+	if err := p.doJSON(ctx, http.MethodPost, "/api/v1/attachments", nil, map[string]string{
+		"filename": file.Name,
+		"type":     defaultString(file.MIMEType, "application/octet-stream"),
+		"content":  base64.StdEncoding.EncodeToString(file.Data),
+	}, &attachment); err != nil {
+		return "", err
+	}
+	if attachment.Name == "" {
+		return "", errors.New("memos did not return an attachment name")
+	}
+	return "/file/" + attachment.Name + "/" + url.PathEscape(defaultString(attachment.Filename, file.Name)), nil
+}
+
+func (p *memosProvider) doJSON(ctx context.Context, method, path string, query url.Values, body any, out any) error {
+	var reader io.Reader
+	contentType := ""
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(raw)
+		contentType = "application/json"
+	}
+	resp, err := p.do(ctx, method, path, query, reader, contentType)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// do sends an authenticated request. Error statuses are returned as a
+// memosStatusError; otherwise the caller closes the body.
+func (p *memosProvider) do(ctx context.Context, method, path string, query url.Values, body io.Reader, contentType string) (*http.Response, error) {
+	target := p.endpoint + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.token)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		var failure struct {
+			Message string `json:"message"`
+		}
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		message := strings.TrimSpace(string(raw))
+		if json.Unmarshal(raw, &failure) == nil && failure.Message != "" {
+			message = failure.Message
+		}
+		return nil, &memosStatusError{
+			StatusCode: resp.StatusCode,
+			Message:    fmt.Sprintf("memos request failed: %s %s", resp.Status, message),
+		}
+	}
+	return resp, nil
+}
+
+func (m memosMemo) tags() []string {
+	if len(m.Tags) > 0 {
+		return m.Tags
+	}
+	if m.Property != nil {
+		return m.Property.Tags
+	}
+	return nil
+}
+
+func (m memosMemo) attachments() []memosAttachment {
+	if len(m.Attachments) > 0 {
+		return m.Attachments
+	}
+	return m.Resources
+}
+
+// folderTag picks the tag used as the memo's folder: the first tag at or
+// below target, or the first tag when no target is set. It reports false
+// when the memo is outside the target.
+func (m memosMemo) folderTag(target string) (string, bool) {
+	tags := m.tags()
+	if target == "" {
+		if len(tags) == 0 {
+			return "", true
+		}
+		return tags[0], true
+	}
+	for _, tag := range tags {
+		if tag == target || strings.HasPrefix(tag, target+"/") {
+			return tag, true
+		}
+	}
+	return "", false
+}
+
+// memosTitle takes the title from a leading heading, which it removes, or
+// from the first line, which it keeps. Trailing hashtag lines are dropped
+// because the tags are carried separately.
+func memosTitle(content string) (string, string) {
+	lines := strings.Split(strings.TrimRight(content, "\n"), "\n")
+	for len(lines) > 0 && (strings.TrimSpace(lines[len(lines)-1]) == "" || memosTagLinePattern.MatchString(strings.TrimSpace(lines[len(lines)-1]))) {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return "Untitled", ""
+	}
+	first := strings.TrimSpace(lines[0])
+	if strings.HasPrefix(first, "# ") {
+		body := strings.TrimLeft(strings.Join(lines[1:], "\n"), "\n")
+		if body != "" {
+			body += "\n"
+		}
+		return titleOrUntitled(strings.TrimPrefix(first, "# ")), body
+	}
+	title := strings.TrimLeft(first, "#>-*+ ")
+	if len([]rune(title)) > 80 {
+		title = string([]rune(title)[:80])
+	}
+	return titleOrUntitled(title), strings.Join(lines, "\n") + "\n"
+}
+
+// memosTagName turns a tag or folder name into a hashtag Memos recognises,
+// which cannot hold spaces or punctuation other than - _ and /.
+func memosTagName(tag string) string {
+	var builder strings.Builder
+	for _, r := range strings.Trim(strings.TrimSpace(tag), "#") {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == '_', r == '-', r == '/':
+			builder.WriteRune(r)
+		case unicode.IsSpace(r):
+			builder.WriteRune('-')
+		}
+	}
+	return strings.Trim(builder.String(), "/")
+}
+
+// memosResourcePath accepts a memo name with or without the memos/ prefix.
+func memosResourcePath(name string) string {
+	name = strings.Trim(strings.TrimSpace(name), "/")
+	if !strings.HasPrefix(name, "memos/") {
+		name = "memos/" + name
+	}
+	return name
+}
+
+func parseMemosTime(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil
+	}
+	parsed = parsed.UTC()
+	return &parsed
+}
@@ -0,0 +1,175 @@
+package connections
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMemosImportNotesFiltersByNestedTag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer memos-token" {
+			t.Fatalf("authorization = %q, want bearer token", r.Header.Get("Authorization"))
+		}
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/memos" {
+			t.Fatalf("unexpected Memos request: %s %s", r.Method, r.URL.Path)
+		}
+		switch r.URL.Query().Get("pageToken") {
+		case "":
+			writeMemosJSON(t, w, map[string]any{
+				"memos": []map[string]any{{
+					"name":       "memos/1",
+					"content":    "# Plan\n\nShip it\n\n#work/projects #urgent",
+					"tags":       []string{"work/projects", "urgent"},
+					"createTime": "2025-01-02T03:04:05Z",
+					"updateTime": "2025-01-03T03:04:05Z",
+					"attachments": []map[string]string{
+						{"name": "attachments/a1", "filename": "shot.png", "type": "image/png"},
+					},
+				}},
+				"nextPageToken": "page-2",
+			})
+		case "page-2":
+			writeMemosJSON(t, w, map[string]any{
+				"memos": []map[string]any{
+					{"name": "memos/2", "content": "Groceries: milk and eggs\n#home", "tags": []string{"home"}},
+					{"name": "memos/3", "content": "Quick thought", "property": map[string]any{"tags": []string{"work"}}},
+				},
+			})
+		default:
+			t.Fatalf("unexpected page token %q", r.URL.Query().Get("pageToken"))
+		}
+	}))
+	defer server.Close()
+	provider := newMemosProvider(server.URL, "memos-token", server.Client())
+
+	targets, err := provider.ListTargets(context.Background())
+	if err != nil {
+		t.Fatalf("list targets: %v", err)
+	}
+	var ids []string
+	for _, target := range targets {
+		ids = append(ids, target.ID+"<"+target.ParentID)
+	}
+	if got := strings.Join(ids, ","); got != "home<,urgent<,work<,work/projects<work" {
+		t.Fatalf("targets = %q, want nested tags under their parent", got)
+	}
+
+	notes, err := provider.ImportNotes(context.Background(), "work", 10)
+	if err != nil {
+		t.Fatalf("import notes: %v", err)
+	}
+	if len(notes) != 2 {
+		t.Fatalf("notes = %#v, want the two memos tagged work", notes)
+	}
+	plan := notes[0]
+	if plan.Title != "Plan" || plan.Path != "work/projects/Plan" || plan.TargetID != "work" {
+		t.Fatalf("plan = %#v, want title from heading and path from tag", plan)
+	}
+	if plan.Content != "Ship it\n\n![shot.png](/file/attachments/a1/shot.png)\n" {
+		t.Fatalf("content = %q, want heading and tag line removed and attachment linked", plan.Content)
+	}
+	if strings.Join(plan.Tags, ",") != "urgent" {
+		t.Fatalf("tags = %#v, want urgent", plan.Tags)
+	}
+	if plan.UpdatedAt == nil || plan.UpdatedAt.Format("2006-01-02") != "2025-01-03" {
+		t.Fatalf("updated_at = %v, want parsed update time", plan.UpdatedAt)
+	}
+	if notes[1].Title != "Quick thought" || notes[1].Path != "work/Quick thought" {
+		t.Fatalf("second note = %#v, want title from first line and legacy property tags", notes[1])
+	}
+
+	refs, err := provider.ListAttachments(context.Background(), plan)
+	if err != nil || len(refs) != 1 || refs[0].ID != "/file/attachments/a1/shot.png" || refs[0].Name != "shot.png" {
+		t.Fatalf("attachments = %#v, %v, want the linked file", refs, err)
+	}
+}
+
+func TestMemosPushNoteWritesTagsAndUploadsAttachments(t *testing.T) {
+	var created, updated map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/memos":
+			decodeMemosJSON(t, r, &created)
+			writeMemosJSON(t, w, map[string]string{"name": "memos/42", "content": created["content"]})
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/memos/42":
+			if r.URL.Query().Get("updateMask") != "content" {
+				t.Fatalf("update mask = %q, want content", r.URL.Query().Get("updateMask"))
+			}
+			decodeMemosJSON(t, r, &updated)
+			writeMemosJSON(t, w, map[string]string{"name": "memos/42", "content": updated["content"]})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/attachments":
+			var body map[string]string
+			decodeMemosJSON(t, r, &body)
+			if data, _ := base64.StdEncoding.DecodeString(body["content"]); string(data) != "png-bytes" || body["type"] != "image/png" {
+				t.Fatalf("attachment = %#v, want base64 image content", body)
+			}
+			writeMemosJSON(t, w, map[string]string{"name": "attachments/a9", "filename": body["filename"]})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/memos/404":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"message":"memo not found"}`)
+		default:
+			t.Fatalf("unexpected Memos request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	provider := newMemosProvider(server.URL, "memos-token", server.Client())
+
+	result, err := provider.PushNote(context.Background(), PushInput{
+		Title:      "Plan",
+		Content:    "Ship it\n",
+		TargetID:   "work",
+		FolderPath: []string{"Q3 Goals"},
+		Tags:       []string{"urgent"},
+	})
+	if err != nil {
+		t.Fatalf("push note: %v", err)
+	}
+	if result.ExternalID != "memos/42" || result.URL != server.URL+"/memos/42" {
+		t.Fatalf("result = %#v, want created memo", result)
+	}
+	if created["content"] != "# Plan\n\nShip it\n\n#work/Q3-Goals #urgent\n" || created["visibility"] != "PRIVATE" {
+		t.Fatalf("created = %#v, want heading, body and tag line", created)
+	}
+	title, content := memosTitle(created["content"])
+	if title != "Plan" || content != "Ship it\n" {
+		t.Fatalf("round trip = %q %q, want title and body back", title, content)
+	}
+
+	if _, err := provider.PushNote(context.Background(), PushInput{Title: "Plan", Content: "Done", ExistingExternalID: "memos/42"}); err != nil {
+		t.Fatalf("update note: %v", err)
+	}
+	if updated["content"] != "# Plan\n\nDone\n" {
+		t.Fatalf("updated = %#v, want new content", updated)
+	}
+
+	link, err := provider.UploadAttachment(context.Background(), LocalAttachment{Name: "shot.png", MIMEType: "image/png", Data: []byte("png-bytes")})
+	if err != nil || link != "/file/attachments/a9/shot.png" {
+		t.Fatalf("upload = %q, %v, want file link", link, err)
+	}
+
+	if _, err := provider.GetNote(context.Background(), "404"); !errors.Is(err, ErrRemoteNoteNotFound) {
+		t.Fatalf("error = %v, want ErrRemoteNoteNotFound", err)
+	}
+}
+
+func writeMemosJSON(t *testing.T, w http.ResponseWriter, value any) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		t.Fatalf("encode Memos response: %v", err)
+	}
+}
+
+func decodeMemosJSON(t *testing.T, r *http.Request, out any) {
+	t.Helper()
+	if err := json.NewDecoder(r.Body).Decode(out); err != nil {
+		t.Fatalf("decode Memos request: %v", err)
+	}
+}
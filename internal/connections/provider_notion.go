@@ -1,21 +1,56 @@
 package connections
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
+	"net/url"
+	"path"
+	"regexp"
 	"strings"
 
 	"github.com/jomei/notionapi"
 )
 
+const (
+	notionAPIURL     = "https://api.notion.com/v1"
+	notionAPIVersion = "2022-06-28"
+	// notionFileUploadScheme marks links to files uploaded by a push. They
+	// become file upload blocks when the content is converted.
+	notionFileUploadScheme = "notion-file-upload:"
+)
+
+var (
+	// notionLinkPattern matches Markdown images and links.
+	notionLinkPattern = regexp.MustCompile(`!?\[([^\]\n]*)\]\(([^)\s]+)\)`)
+	// notionFileLinePattern matches a line that is nothing but an image or
+	// a file link, which is pushed as a block of its own.
+	notionFileLinePattern = regexp.MustCompile(`^(!?)\[([^\]\n]*)\]\(([^)\s]+)\)$`)
+)
+
 type notionProvider struct {
-	client *notionapi.Client
+	client     *notionapi.Client
+	token      string
+	httpClient *http.Client
+	// hosted maps the URLs of files stored by Notion that were seen while
+	// reading pages to their file names. The URLs expire after an hour, so
+	// only files of pages read by this provider can be downloaded.
+	hosted map[string]string
 }
 
 func newNotionProvider(token string, httpClient *http.Client) Provider {
 	return &notionProvider{
-		client: notionapi.NewClient(notionapi.Token(token), notionapi.WithHTTPClient(httpClient)),
+		client:     notionapi.NewClient(notionapi.Token(token), notionapi.WithHTTPClient(httpClient)),
+		token:      token,
+		httpClient: httpClient,
+		hosted:     map[string]string{},
 	}
 }
 
@@ -66,7 +101,7 @@ func (p *notionProvider) ImportNotes(ctx context.Context, targetID string, limit
 		if err != nil {
 			return nil, err
 		}
-		content, err := p.pageMarkdown(ctx, notionapi.BlockID(id), 0)
+		content, err := p.pageMarkdown(ctx, notionapi.BlockID(id))
 		if err != nil {
 			return nil, err
 		}
@@ -87,6 +122,32 @@ func (p *notionProvider) ImportNotes(ctx context.Context, targetID string, limit
 	}
 }
 
+func (p *notionProvider) GetNote(ctx context.Context, externalID string) (RemoteNote, error) {
+	page, err := p.client.Page.Get(ctx, notionapi.PageID(externalID))
+	var apiErr *notionapi.Error
+	if errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound {
+		return RemoteNote{}, ErrRemoteNoteNotFound
+	}
+	if err != nil {
+		return RemoteNote{}, err
+	}
+	if page.Archived {
+		return RemoteNote{}, ErrRemoteNoteNotFound
+	}
+	content, err := p.pageMarkdown(ctx, notionapi.BlockID(page.ID))
+	if err != nil {
+		return RemoteNote{}, err
+	}
+	return RemoteNote{
+		ExternalID: string(page.ID),
+		Title:      titleOrUntitled(notionPageTitle(page)),
+		Content:    content,
+		CreatedAt:  &page.CreatedTime,
+		UpdatedAt:  &page.LastEditedTime,
+		URL:        page.URL,
+	}, nil
+}
+
 func (p *notionProvider) importDatabasePages(ctx context.Context, databaseID, targetID string, limit int) ([]RemoteNote, error) {
 	var notes []RemoteNote
 	var cursor notionapi.Cursor
@@ -99,7 +160,7 @@ func (p *notionProvider) importDatabasePages(ctx context.Context, databaseID, ta
 			return nil, err
 		}
 		for _, page := range resp.Results {
-			content, err := p.pageMarkdown(ctx, notionapi.BlockID(page.ID), 0)
+			content, err := p.pageMarkdown(ctx, notionapi.BlockID(page.ID))
 			if err != nil {
 				content = ""
 			}
@@ -168,11 +229,15 @@ func (p *notionProvider) PushNote(ctx context.Context, input PushInput) (PushRes
 	page, err := p.client.Page.Create(ctx, &notionapi.PageCreateRequest{
 		Parent:     parent,
 		Properties: properties,
-		Children:   markdownToNotionBlocks(input.Content),
 	})
 	if err != nil {
 		return PushResult{}, err
 	}
+	if err := p.appendBlocks(ctx, notionapi.BlockID(page.ID), markdownToNotionBlocks(input.Content)); err != nil {
+		// Archive the incomplete page so a retry does not leave a duplicate.
+		_, _ = p.client.Page.Update(ctx, notionapi.PageID(page.ID), &notionapi.PageUpdateRequest{Archived: true})
+		return PushResult{}, err
+	}
 	return PushResult{
 		ExternalID: string(page.ID),
 		TargetID:   input.TargetID,
@@ -197,214 +262,330 @@ func (p *notionProvider) replacePageChildren(ctx context.Context, pageID notiona
 		}
 		cursor = notionapi.Cursor(resp.NextCursor)
 	}
-	blocks := markdownToNotionBlocks(markdown)
-	for start := 0; start < len(blocks); start += 100 {
-		end := start + 100
-		if end > len(blocks) {
-			end = len(blocks)
+	return p.appendBlocks(ctx, pageID, markdownToNotionBlocks(markdown))
+}
+
+// appendBlocks appends blocks under parent in batches Notion accepts. A
+// request may only nest blocks two levels deep, so children are detached
+// and appended to their block once it exists.
+func (p *notionProvider) appendBlocks(ctx context.Context, parent notionapi.BlockID, blocks notionapi.Blocks) error {
+	for start := 0; start < len(blocks); start += notionMaxBlocks {
+		batch := blocks[start:min(start+notionMaxBlocks, len(blocks))]
+		nested := make([]notionapi.Blocks, len(batch))
+		for i, block := range batch {
+			children := notionChildren(block)
+			if children == nil {
+				continue
+			}
+			if _, ok := block.(*notionapi.TableBlock); ok {
+				// Rows have to be sent with their table.
+				if len(*children) > notionMaxBlocks {
+					nested[i], *children = (*children)[notionMaxBlocks:], (*children)[:notionMaxBlocks]
+				}
+				continue
+			}
+			nested[i], *children = *children, nil
 		}
-		if _, err := p.client.Block.AppendChildren(ctx, pageID, &notionapi.AppendBlockChildrenRequest{Children: blocks[start:end]}); err != nil {
+		resp, err := p.client.Block.AppendChildren(ctx, parent, &notionapi.AppendBlockChildrenRequest{Children: batch})
+		if err != nil {
 			return err
 		}
+		for i, children := range nested {
+			if len(children) == 0 {
+				continue
+			}
+			if i >= len(resp.Results) {
+				return fmt.Errorf("notion did not return the appended blocks")
+			}
+			if err := p.appendBlocks(ctx, resp.Results[i].GetID(), children); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
-func (p *notionProvider) pageMarkdown(ctx context.Context, blockID notionapi.BlockID, depth int) (string, error) {
-	if depth > 4 {
-		return "", nil
+func (p *notionProvider) pageMarkdown(ctx context.Context, pageID notionapi.BlockID) (string, error) {
+	blocks, err := p.blockTree(ctx, pageID, 0)
+	if err != nil {
+		return "", err
 	}
-	var builder strings.Builder
+	return strings.TrimSpace(notionBlocksMarkdown(blocks)), nil
+}
+
+// blockTree reads the blocks under blockID with their nested children.
+// Child pages and databases are notes of their own and are not descended.
+func (p *notionProvider) blockTree(ctx context.Context, blockID notionapi.BlockID, depth int) (notionapi.Blocks, error) {
+	var blocks notionapi.Blocks
 	var cursor notionapi.Cursor
 	for {
 		resp, err := p.client.Block.GetChildren(ctx, blockID, &notionapi.Pagination{StartCursor: cursor, PageSize: 100})
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		for _, block := range resp.Results {
-			builder.WriteString(notionBlockMarkdown(block))
-			if block.GetHasChildren() {
-				child, err := p.pageMarkdown(ctx, block.GetID(), depth+1)
-				if err == nil && strings.TrimSpace(child) != "" {
-					builder.WriteString(child)
+			if fileURL, name, ok := notionHostedFile(block); ok {
+				p.hosted[fileURL] = name
+			}
+			if children := notionChildren(block); children != nil && block.GetHasChildren() && depth < notionMaxDepth {
+				if nested, err := p.blockTree(ctx, block.GetID(), depth+1); err == nil {
+					*children = nested
 				}
 			}
+			blocks = append(blocks, block)
 		}
 		if !resp.HasMore {
 			break
 		}
 		cursor = notionapi.Cursor(resp.NextCursor)
 	}
-	return strings.TrimSpace(builder.String()), nil
+	return blocks, nil
 }
 
-func notionBlockMarkdown(block notionapi.Block) string {
-	switch typed := block.(type) {
-	case *notionapi.ParagraphBlock:
-		return richTextPlain(typed.Paragraph.RichText) + "\n\n"
-	case notionapi.ParagraphBlock:
-		return richTextPlain(typed.Paragraph.RichText) + "\n\n"
-	case *notionapi.Heading1Block:
-		return "# " + richTextPlain(typed.Heading1.RichText) + "\n\n"
-	case notionapi.Heading1Block:
-		return "# " + richTextPlain(typed.Heading1.RichText) + "\n\n"
-	case *notionapi.Heading2Block:
-		return "## " + richTextPlain(typed.Heading2.RichText) + "\n\n"
-	case notionapi.Heading2Block:
-		return "## " + richTextPlain(typed.Heading2.RichText) + "\n\n"
-	case *notionapi.Heading3Block:
-		return "### " + richTextPlain(typed.Heading3.RichText) + "\n\n"
-	case notionapi.Heading3Block:
-		return "### " + richTextPlain(typed.Heading3.RichText) + "\n\n"
-	case *notionapi.BulletedListItemBlock:
-		return "- " + richTextPlain(typed.BulletedListItem.RichText) + "\n"
-	case notionapi.BulletedListItemBlock:
-		return "- " + richTextPlain(typed.BulletedListItem.RichText) + "\n"
-	case *notionapi.NumberedListItemBlock:
-		return "1. " + richTextPlain(typed.NumberedListItem.RichText) + "\n"
-	case notionapi.NumberedListItemBlock:
-		return "1. " + richTextPlain(typed.NumberedListItem.RichText) + "\n"
-	case *notionapi.ToDoBlock:
-		return "- [" + checkboxMark(typed.ToDo.Checked) + "] " + richTextPlain(typed.ToDo.RichText) + "\n"
-	case notionapi.ToDoBlock:
-		return "- [" + checkboxMark(typed.ToDo.Checked) + "] " + richTextPlain(typed.ToDo.RichText) + "\n"
-	case *notionapi.QuoteBlock:
-		return "> " + richTextPlain(typed.Quote.RichText) + "\n\n"
-	case notionapi.QuoteBlock:
-		return "> " + richTextPlain(typed.Quote.RichText) + "\n\n"
-	case *notionapi.CodeBlock:
-		return "```" + typed.Code.Language + "\n" + richTextPlain(typed.Code.RichText) + "\n```\n\n"
-	case notionapi.CodeBlock:
-		return "```" + typed.Code.Language + "\n" + richTextPlain(typed.Code.RichText) + "\n```\n\n"
-	case *notionapi.DividerBlock, notionapi.DividerBlock:
-		return "---\n\n"
-	case *notionapi.ChildPageBlock:
-		return "## " + typed.ChildPage.Title + "\n\n"
-	case notionapi.ChildPageBlock:
-		return "## " + typed.ChildPage.Title + "\n\n"
-	default:
+func notionImageMarkdown(image notionapi.Image) string {
+	if image.GetURL() == "" {
 		return ""
 	}
+	return "![" + notionLinkLabel(richTextPlain(image.Caption)) + "](" + image.GetURL() + ")"
 }
 
-func markdownToNotionBlocks(markdown string) []notionapi.Block {
-	lines := strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n")
-	blocks := make([]notionapi.Block, 0, 32)
-	var paragraph []string
-	inCode := false
-	codeLang := ""
-	var codeLines []string
+func notionFileMarkdown(caption []notionapi.RichText, file, external *notionapi.FileObject) string {
+	fileURL := notionFileObjectURL(file, external)
+	if fileURL == "" {
+		return ""
+	}
+	label := defaultString(notionLinkLabel(richTextPlain(caption)), notionFileName(fileURL))
+	return "[" + label + "](" + fileURL + ")"
+}
 
-	flushParagraph := func() {
-		text := strings.TrimSpace(strings.Join(paragraph, "\n"))
-		paragraph = nil
-		if text == "" {
-			return
-		}
-		for _, chunk := range textChunks(text, 1900) {
-			blocks = append(blocks, notionParagraph(chunk))
-		}
+func notionFileObjectURL(file, external *notionapi.FileObject) string {
+	if file != nil {
+		return file.URL
 	}
-	flushCode := func() {
-		blocks = append(blocks, notionapi.CodeBlock{
-			BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeCode},
-			Code: notionapi.Code{
-				Language: defaultString(codeLang, "plain text"),
-				RichText: notionRichText(strings.Join(codeLines, "\n")),
-			},
-		})
-		codeLang = ""
-		codeLines = nil
-	}
-
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)
-		if strings.HasPrefix(trimmed, "```") {
-			if inCode {
-				flushCode()
-				inCode = false
-			} else {
-				flushParagraph()
-				inCode = true
-				codeLang = strings.TrimSpace(strings.TrimPrefix(trimmed, "```"))
-			}
-			continue
-		}
-		if inCode {
-			codeLines = append(codeLines, line)
-			continue
-		}
-		if trimmed == "" {
-			flushParagraph()
-			continue
-		}
-		if strings.HasPrefix(trimmed, "# ") {
-			flushParagraph()
-			blocks = append(blocks, notionHeading(1, strings.TrimSpace(strings.TrimPrefix(trimmed, "# "))))
-			continue
-		}
-		if strings.HasPrefix(trimmed, "## ") {
-			flushParagraph()
-			blocks = append(blocks, notionHeading(2, strings.TrimSpace(strings.TrimPrefix(trimmed, "## "))))
-			continue
-		}
-		if strings.HasPrefix(trimmed, "### ") {
-			flushParagraph()
-			blocks = append(blocks, notionHeading(3, strings.TrimSpace(strings.TrimPrefix(trimmed, "### "))))
-			continue
-		}
-		if strings.HasPrefix(trimmed, "- ") {
-			flushParagraph()
-			blocks = append(blocks, notionapi.BulletedListItemBlock{
-				BasicBlock:       notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeBulletedListItem},
-				BulletedListItem: notionapi.ListItem{RichText: notionRichText(strings.TrimSpace(strings.TrimPrefix(trimmed, "- ")))},
-			})
+	if external != nil {
+		return external.URL
+	}
+	return ""
+}
+
+// notionHostedFile returns the URL and name of a file a block shows when
+// Notion stores the file itself rather than linking elsewhere.
+func notionHostedFile(block notionapi.Block) (string, string, bool) {
+	var file *notionapi.FileObject
+	switch typed := block.(type) {
+	case *notionapi.ImageBlock:
+		file = typed.Image.File
+	case notionapi.ImageBlock:
+		file = typed.Image.File
+	case *notionapi.FileBlock:
+		file = typed.File.File
+	case notionapi.FileBlock:
+		file = typed.File.File
+	case *notionapi.PdfBlock:
+		file = typed.Pdf.File
+	case notionapi.PdfBlock:
+		file = typed.Pdf.File
+	}
+	if file == nil || file.URL == "" {
+		return "", "", false
+	}
+	return file.URL, notionFileName(file.URL), true
+}
+
+func notionFileName(fileURL string) string {
+	parsed, err := url.Parse(fileURL)
+	if err != nil {
+		return "file"
+	}
+	name := path.Base(parsed.Path)
+	if name == "." || name == "/" {
+		return "file"
+	}
+	return name
+}
+
+func notionLinkLabel(text string) string {
+	return strings.NewReplacer("[", "", "]", "", "\n", " ").Replace(strings.TrimSpace(text))
+}
+
+func (p *notionProvider) ListAttachments(ctx context.Context, remote RemoteNote) ([]AttachmentRef, error) {
+	var refs []AttachmentRef
+	seen := map[string]bool{}
+	for _, match := range notionLinkPattern.FindAllStringSubmatch(remote.Content, -1) {
+		name, ok := p.hosted[match[2]]
+		if !ok || seen[match[0]] {
 			continue
 		}
-		paragraph = append(paragraph, line)
-		if len(blocks) >= 90 {
-			break
-		}
+		seen[match[0]] = true
+		refs = append(refs, AttachmentRef{ID: match[2], Name: name, Embed: match[0]})
+	}
+	return refs, nil
+}
+
+func (p *notionProvider) DownloadAttachment(ctx context.Context, ref AttachmentRef) (RemoteAttachment, error) {
+	if _, ok := p.hosted[ref.ID]; !ok {
+		return RemoteAttachment{}, fmt.Errorf("%s is not a file stored by notion", ref.Name)
+	}
+	// The URL is signed, so it is fetched without the integration token.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ref.ID, nil)
+	if err != nil {
+		return RemoteAttachment{}, err
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return RemoteAttachment{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return RemoteAttachment{}, fmt.Errorf("notion file download failed: %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteAttachmentSize+1))
+	if err != nil {
+		return RemoteAttachment{}, err
+	}
+	if len(data) > maxRemoteAttachmentSize {
+		return RemoteAttachment{}, fmt.Errorf("notion file is larger than %d bytes", maxRemoteAttachmentSize)
+	}
+	mimeType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mimeType == "" || mimeType == "application/octet-stream" {
+		mimeType = vaultMIMEType(ref.Name, data)
+	}
+	return RemoteAttachment{
+		Name:     ref.Name,
+		MIMEType: mimeType,
+		Data:     data,
+		Embed:    ref.Embed,
+	}, nil
+}
+
+type notionFileUploadResponse struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// UploadAttachment sends a file through Notion's file upload API, which
+// notionapi does not cover, and links it by its upload id.
+func (p *notionProvider) UploadAttachment(ctx context.Context, file LocalAttachment) (string, error) {
+	mimeType := defaultString(file.MIMEType, "application/octet-stream")
+	created, err := json.Marshal(map[string]string{"filename": file.Name, "content_type": mimeType})
+	if err != nil {
+		return "", err
+	}
+	var upload notionFileUploadResponse
+	if err := p.doFileUpload(ctx, "/file_uploads", bytes.NewReader(created), "application/json", &upload); err != nil {
+		return "", err
+	}
+	if upload.ID == "" {
+		return "", errors.New("notion did not return a file upload id")
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{"name": "file", "filename": file.Name}))
+	header.Set("Content-Type", mimeType)
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return "", err
 	}
-	if inCode {
-		flushCode()
+	if _, err := part.Write(file.Data); err != nil {
+		return "", err
 	}
-	flushParagraph()
-	if len(blocks) == 0 {
-		blocks = append(blocks, notionParagraph(""))
+	if err := writer.Close(); err != nil {
+		return "", err
 	}
-	if len(blocks) > 100 {
-		return blocks[:100]
+	if err := p.doFileUpload(ctx, "/file_uploads/"+url.PathEscape(upload.ID)+"/send", &body, writer.FormDataContentType(), &upload); err != nil {
+		return "", err
 	}
-	return blocks
+	if upload.Status != "uploaded" {
+		return "", fmt.Errorf("notion file upload is %s", defaultString(upload.Status, "incomplete"))
+	}
+	return notionFileUploadScheme + upload.ID, nil
 }
 
-func notionParagraph(text string) notionapi.ParagraphBlock {
-	return notionapi.ParagraphBlock{
-		BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeParagraph},
-		Paragraph:  notionapi.Paragraph{RichText: notionRichText(text)},
+func (p *notionProvider) doFileUpload(ctx context.Context, apiPath string, body io.Reader, contentType string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, notionAPIURL+apiPath, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.token)
+	req.Header.Set("Notion-Version", notionAPIVersion)
+	req.Header.Set("Content-Type", contentType)
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
 	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("notion file upload failed: %s %s", resp.Status, strings.TrimSpace(string(raw)))
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
 }
 
-func notionHeading(level int, text string) notionapi.Block {
-	heading := notionapi.Heading{RichText: notionRichText(text)}
-	switch level {
-	case 1:
-		return notionapi.Heading1Block{
-			BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeHeading1},
-			Heading1:   heading,
-		}
-	case 2:
-		return notionapi.Heading2Block{
-			BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeHeading2},
-			Heading2:   heading,
-		}
-	default:
-		return notionapi.Heading3Block{
-			BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeHeading3},
-			Heading3:   heading,
-		}
+// notionFileUploadBlock shows an uploaded file as an image or file block.
+// notionapi's block types cannot refer to a file upload.
+type notionFileUploadBlock struct {
+	notionapi.BasicBlock
+	Image *notionUploadedFile `json:"image,omitempty"`
+	File  *notionUploadedFile `json:"file,omitempty"`
+}
+
+type notionUploadedFile struct {
+	Caption    []notionapi.RichText `json:"caption,omitempty"`
+	Type       string               `json:"type"`
+	FileUpload notionFileUploadID   `json:"file_upload"`
+	// Name is the file name shown by file blocks.
+	Name string `json:"name,omitempty"`
+}
+
+type notionFileUploadID struct {
+	ID string `json:"id"`
+}
+
+func (b notionFileUploadBlock) GetRichTextString() string {
+	return ""
+}
+
+// notionFileBlock turns a line holding only an image or a file link into a
+// block showing the file. Files uploaded by a push use their upload id;
+// images elsewhere on the web are embedded by URL. Other links stay text.
+func notionFileBlock(line string) (notionapi.Block, bool) {
+	match := notionFileLinePattern.FindStringSubmatch(line)
+	if match == nil {
+		return nil, false
+	}
+	image, label, target := match[1] == "!", match[2], match[3]
+	var caption []notionapi.RichText
+	if label != "" {
+		caption = notionRichText(label)
+	}
+	if uploadID, ok := strings.CutPrefix(target, notionFileUploadScheme); ok {
+		file := &notionUploadedFile{Type: "file_upload", FileUpload: notionFileUploadID{ID: uploadID}}
+		if image {
+			file.Caption = caption
+			return &notionFileUploadBlock{
+				BasicBlock: notionBasicBlock(notionapi.BlockTypeImage),
+				Image:      file,
+			}, true
+		}
+		file.Name = label
+		return &notionFileUploadBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeFile),
+			File:       file,
+		}, true
+	}
+	if image && (strings.HasPrefix(target, "https://") || strings.HasPrefix(target, "http://")) {
+		return &notionapi.ImageBlock{
+			BasicBlock: notionBasicBlock(notionapi.BlockTypeImage),
+			Image: notionapi.Image{
+				Caption:  caption,
+				Type:     notionapi.FileTypeExternal,
+				External: &notionapi.FileObject{URL: target},
+			},
+		}, true
 	}
+	return nil, false
 }
 
 func notionRichText(text string) []notionapi.RichText {
@@ -0,0 +1,130 @@
+package connections
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/jomei/notionapi"
+)
+
+func TestNotionFileBlocksRoundTrip(t *testing.T) {
+	hostedURL := "https://files.example.com/space/diagram.png?X-Amz-Signature=abc"
+	image := &notionapi.ImageBlock{
+		BasicBlock: notionapi.BasicBlock{Type: notionapi.BlockTypeImage},
+		Image: notionapi.Image{
+			Caption: notionRichText("Diagram"),
+			Type:    notionapi.FileTypeFile,
+			File:    &notionapi.FileObject{URL: hostedURL},
+		},
+	}
+	if got := notionBlockMarkdown(image, 0); got != "![Diagram]("+hostedURL+")" {
+		t.Fatalf("image markdown = %q", got)
+	}
+	pdf := &notionapi.PdfBlock{
+		BasicBlock: notionapi.BasicBlock{Type: notionapi.BlockTypePdf},
+		Pdf: notionapi.Pdf{
+			Type:     notionapi.FileTypeExternal,
+			External: &notionapi.FileObject{URL: "https://example.com/papers/spec.pdf"},
+		},
+	}
+	if got := notionBlockMarkdown(pdf, 0); got != "[spec.pdf](https://example.com/papers/spec.pdf)" {
+		t.Fatalf("pdf markdown = %q", got)
+	}
+	if _, _, ok := notionHostedFile(pdf); ok {
+		t.Fatal("expected an external file not to be treated as hosted")
+	}
+
+	// Only files Notion stores are listed as attachments.
+	provider := &notionProvider{hosted: map[string]string{}}
+	fileURL, name, ok := notionHostedFile(image)
+	if !ok {
+		t.Fatal("expected the image to be hosted by notion")
+	}
+	provider.hosted[fileURL] = name
+	refs, err := provider.ListAttachments(context.Background(), RemoteNote{
+		Content: "![Diagram](" + hostedURL + ")\n\n[spec.pdf](https://example.com/papers/spec.pdf)",
+	})
+	if err != nil {
+		t.Fatalf("ListAttachments: %v", err)
+	}
+	if len(refs) != 1 || refs[0].Name != "diagram.png" {
+		t.Fatalf("refs = %+v, want the hosted image", refs)
+	}
+
+	blocks := markdownToNotionBlocks("Intro\n![Chart](" + notionFileUploadScheme + "up-1)\n[notes.txt](" + notionFileUploadScheme + "up-2)\n![Logo](https://example.com/logo.png)\nSee [docs](https://example.com)")
+	if len(blocks) != 5 {
+		t.Fatalf("expected five blocks, got %d", len(blocks))
+	}
+	raw, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatalf("marshal blocks: %v", err)
+	}
+	for _, want := range []string{
+		`"type":"image","image":{"caption":[{"type":"text","text":{"content":"Chart"}`,
+		`"type":"file_upload","file_upload":{"id":"up-1"}`,
+		`"type":"file","file":{"type":"file_upload","file_upload":{"id":"up-2"},"name":"notes.txt"}`,
+		`"external":{"url":"https://example.com/logo.png"}`,
+		`"content":"docs","link":{"url":"https://example.com"}`,
+	} {
+		if !strings.Contains(string(raw), want) {
+			t.Fatalf("blocks are missing %s:\n%s", want, raw)
+		}
+	}
+}
+
+func TestNotionUploadAttachmentSendsFile(t *testing.T) {
+	var sent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer notion-token" || r.Header.Get("Notion-Version") == "" {
+			t.Fatalf("missing notion headers: %v", r.Header)
+		}
+		switch r.URL.Path {
+		case "/v1/file_uploads":
+			var payload map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode create request: %v", err)
+			}
+			if payload["filename"] != "chart.png" || payload["content_type"] != "image/png" {
+				t.Fatalf("unexpected create request: %v", payload)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"id": "up-1", "status": "pending"})
+		case "/v1/file_uploads/up-1/send":
+			file, header, err := r.FormFile("file")
+			if err != nil {
+				t.Fatalf("read upload: %v", err)
+			}
+			data, _ := io.ReadAll(file)
+			sent = header.Header.Get("Content-Type") + " " + string(data)
+			_ = json.NewEncoder(w).Encode(map[string]string{"id": "up-1", "status": "uploaded"})
+		default:
+			t.Fatalf("unexpected notion path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	httpClient := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		r.URL.Scheme, r.URL.Host = target.Scheme, target.Host
+		return http.DefaultTransport.RoundTrip(r)
+	})}
+	provider := newNotionProvider("notion-token", httpClient)
+	link, err := provider.UploadAttachment(context.Background(), LocalAttachment{Name: "chart.png", MIMEType: "image/png", Data: []byte("png")})
+	if err != nil {
+		t.Fatalf("UploadAttachment: %v", err)
+	}
+	if link != notionFileUploadScheme+"up-1" || sent != "image/png png" {
+		t.Fatalf("link = %q, sent %q", link, sent)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
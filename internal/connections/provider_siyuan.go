@@ -1,6 +1,7 @@
 package connections
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net/http"
@@ -28,6 +29,14 @@ type siYuanFrontMatter struct {
 var siYuanFrontMatterListPattern = regexp.MustCompile(`[\[\],]`)
 var siYuanLooseMetaKeyPattern = regexp.MustCompile(`(?i)(^|\s)(title|date|created|created_at|lastmod|updated|updated_at|tags|tag)\s*:\s*`)
 
+// siYuanAssetLinkPattern matches Markdown images and links to files in the
+// workspace's assets folder.
+var siYuanAssetLinkPattern = regexp.MustCompile(`!?\[[^\]\n]*\]\((assets/[^)\s]+)(?:\s+"[^"\n]*")?\)`)
+
+// siYuanAssetSuffixPattern matches the timestamp and id SiYuan appends to
+// the name of an uploaded asset.
+var siYuanAssetSuffixPattern = regexp.MustCompile(`-\d{14}-[0-9a-z]{7}$`)
+
 const siYuanDocTargetPrefix = "doc:"
 
 type siYuanImportTarget struct {
@@ -141,56 +150,81 @@ func (p *siYuanProvider) ImportNotes(ctx context.Context, targetID string, limit
 
 	notes := make([]RemoteNote, 0, len(rows))
 	for _, row := range rows {
-		id := sqlString(row, "id")
-		if id == "" {
-			continue
+		if remote, ok := p.remoteNote(ctx, row, targetNames); ok {
+			notes = append(notes, remote)
 		}
-		content := sqlString(row, "markdown")
-		exported, err := p.client.Export.MarkdownContent(ctx, siyuan.BlockID(id))
-		if err == nil && strings.TrimSpace(exported.Content) != "" {
-			content = exported.Content
-		}
-		metadata := parseSiYuanFrontMatter(content)
-		content = metadata.Content
-		hpath := sqlString(row, "hpath")
-		title := sqlString(row, "content")
-		if metadata.Title != "" {
-			title = metadata.Title
-		}
-		box := sqlString(row, "box")
-		createdAt := parseSiYuanTime(sqlString(row, "created"))
-		if metadata.CreatedAt != nil {
-			createdAt = metadata.CreatedAt
-		}
-		updatedAt := parseSiYuanTime(sqlString(row, "updated"))
-		if metadata.UpdatedAt != nil {
-			updatedAt = metadata.UpdatedAt
-		}
-		tags := metadata.Tags
-		if attrs, err := p.client.Attributes.GetBlockAttrs(ctx, siyuan.BlockID(id)); err == nil {
-			if attrTitle := strings.TrimSpace(attrs["title"]); attrTitle != "" && strings.TrimSpace(title) == "" {
-				title = attrTitle
-			}
-			tags = append(tags, siYuanAttrTags(attrs)...)
-		}
-		if strings.TrimSpace(title) == "" {
-			title = path.Base(strings.Trim(hpath, "/"))
-		}
-		notes = append(notes, RemoteNote{
-			ExternalID: id,
-			TargetID:   box,
-			TargetName: targetNames[box],
-			Path:       hpath,
-			Title:      titleOrUntitled(title),
-			Content:    content,
-			Tags:       uniqueStrings(tags),
-			CreatedAt:  createdAt,
-			UpdatedAt:  updatedAt,
-		})
 	}
 	return notes, nil
 }
 
+// remoteNote builds a note from a document row of the blocks table.
+func (p *siYuanProvider) remoteNote(ctx context.Context, row siyuan.SQLRow, targetNames map[string]string) (RemoteNote, bool) {
+	id := sqlString(row, "id")
+	if id == "" {
+		return RemoteNote{}, false
+	}
+	content := sqlString(row, "markdown")
+	exported, err := p.client.Export.MarkdownContent(ctx, siyuan.BlockID(id))
+	if err == nil && strings.TrimSpace(exported.Content) != "" {
+		content = exported.Content
+	}
+	metadata := parseSiYuanFrontMatter(content)
+	content = metadata.Content
+	hpath := sqlString(row, "hpath")
+	title := sqlString(row, "content")
+	if metadata.Title != "" {
+		title = metadata.Title
+	}
+	box := sqlString(row, "box")
+	createdAt := parseSiYuanTime(sqlString(row, "created"))
+	if metadata.CreatedAt != nil {
+		createdAt = metadata.CreatedAt
+	}
+	updatedAt := parseSiYuanTime(sqlString(row, "updated"))
+	if metadata.UpdatedAt != nil {
+		updatedAt = metadata.UpdatedAt
+	}
+	tags := metadata.Tags
+	if attrs, err := p.client.Attributes.GetBlockAttrs(ctx, siyuan.BlockID(id)); err == nil {
+		if attrTitle := strings.TrimSpace(attrs["title"]); attrTitle != "" && strings.TrimSpace(title) == "" {
+			title = attrTitle
+		}
+		tags = append(tags, siYuanAttrTags(attrs)...)
+	}
+	if strings.TrimSpace(title) == "" {
+		title = path.Base(strings.Trim(hpath, "/"))
+	}
+	return RemoteNote{
+		ExternalID: id,
+		TargetID:   box,
+		TargetName: targetNames[box],
+		Path:       hpath,
+		Title:      titleOrUntitled(title),
+		Content:    content,
+		Tags:       uniqueStrings(tags),
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}, true
+}
+
+func (p *siYuanProvider) GetNote(ctx context.Context, externalID string) (RemoteNote, error) {
+	rows, err := p.client.SQL.Query(ctx, fmt.Sprintf(
+		"SELECT id, content, markdown, hpath, box, path, created, updated FROM blocks WHERE type = 'd' AND id = '%s' LIMIT 1",
+		escapeSQLString(externalID),
+	))
+	if err != nil {
+		return RemoteNote{}, err
+	}
+	if len(rows) == 0 {
+		return RemoteNote{}, ErrRemoteNoteNotFound
+	}
+	remote, ok := p.remoteNote(ctx, rows[0], nil)
+	if !ok {
+		return RemoteNote{}, ErrRemoteNoteNotFound
+	}
+	return remote, nil
+}
+
 func (p *siYuanProvider) PushNote(ctx context.Context, input PushInput) (PushResult, error) {
 	targetID := strings.TrimSpace(input.TargetID)
 	if targetID == "" {
@@ -227,6 +261,68 @@ func (p *siYuanProvider) PushNote(ctx context.Context, input PushInput) (PushRes
 	}, nil
 }
 
+func (p *siYuanProvider) ListAttachments(ctx context.Context, remote RemoteNote) ([]AttachmentRef, error) {
+	var refs []AttachmentRef
+	seen := map[string]bool{}
+	for _, match := range siYuanAssetLinkPattern.FindAllStringSubmatch(remote.Content, -1) {
+		if seen[match[0]] {
+			continue
+		}
+		seen[match[0]] = true
+		assetPath := match[1]
+		if unescaped, err := url.PathUnescape(assetPath); err == nil {
+			assetPath = unescaped
+		}
+		assetPath = path.Clean(assetPath)
+		if !strings.HasPrefix(assetPath, "assets/") {
+			continue
+		}
+		refs = append(refs, AttachmentRef{ID: assetPath, Name: siYuanAssetName(assetPath), Embed: match[0]})
+	}
+	return refs, nil
+}
+
+func (p *siYuanProvider) DownloadAttachment(ctx context.Context, ref AttachmentRef) (RemoteAttachment, error) {
+	data, err := p.client.Files.Get(ctx, "/data/"+ref.ID)
+	if err != nil {
+		return RemoteAttachment{}, err
+	}
+	if len(data) > maxRemoteAttachmentSize {
+		return RemoteAttachment{}, fmt.Errorf("siyuan asset is larger than %d bytes", maxRemoteAttachmentSize)
+	}
+	name := siYuanAssetName(ref.ID)
+	return RemoteAttachment{
+		Name:     name,
+		MIMEType: vaultMIMEType(name, data),
+		Data:     data,
+		Embed:    ref.Embed,
+		Link:     (&url.URL{Path: ref.ID}).EscapedPath(),
+	}, nil
+}
+
+func (p *siYuanProvider) UploadAttachment(ctx context.Context, file LocalAttachment) (string, error) {
+	result, err := p.client.Assets.Upload(ctx, siyuan.UploadAssetsRequest{
+		AssetsDirPath: "/assets/",
+		Files:         []siyuan.UploadAssetFile{{Name: file.Name, Reader: bytes.NewReader(file.Data)}},
+	})
+	if err != nil {
+		return "", err
+	}
+	assetPath := result.SuccMap[file.Name]
+	if assetPath == "" {
+		return "", fmt.Errorf("siyuan did not store %s", file.Name)
+	}
+	return (&url.URL{Path: assetPath}).EscapedPath(), nil
+}
+
+// siYuanAssetName recovers the uploaded file name of an asset, so a file
+// pushed from here and pulled back matches its original attachment.
+func siYuanAssetName(assetPath string) string {
+	base := path.Base(assetPath)
+	ext := path.Ext(base)
+	return siYuanAssetSuffixPattern.ReplaceAllString(strings.TrimSuffix(base, ext), "") + ext
+}
+
 func encodeSiYuanDocTargetID(box, hpath string) string {
 	box = strings.TrimSpace(box)
 	hpath = normalizeSiYuanHPath(hpath)
@@ -3,6 +3,7 @@ package connections
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -163,6 +164,66 @@ func TestSiYuanImportNotesFiltersDocumentSubtree(t *testing.T) {
 	}
 }
 
+func TestSiYuanAssetsAreListedDownloadedAndUploaded(t *testing.T) {
+	var uploaded []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/file/getFile":
+			var payload map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode file request: %v", err)
+			}
+			if payload["path"] != "/data/assets/chart-20260102030405-abcdefg.png" {
+				t.Fatalf("unexpected file path %q", payload["path"])
+			}
+			_, _ = w.Write([]byte("\x89PNG\r\n\x1a\nchart"))
+		case "/api/asset/upload":
+			file, header, err := r.FormFile("file[]")
+			if err != nil {
+				t.Fatalf("read upload: %v", err)
+			}
+			uploaded, _ = io.ReadAll(file)
+			writeSiYuanJSON(t, w, map[string]any{
+				"errFiles": []string{},
+				"succMap":  map[string]string{header.Filename: "assets/my notes-20260102030405-hijklmn.txt"},
+			})
+		default:
+			t.Fatalf("unexpected SiYuan path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	provider, err := newSiYuanProvider(server.URL, "token", server.Client())
+	if err != nil {
+		t.Fatalf("new SiYuan provider: %v", err)
+	}
+	ctx := context.Background()
+	refs, err := provider.ListAttachments(ctx, RemoteNote{
+		Content: "![chart](assets/chart-20260102030405-abcdefg.png)\n[conf](assets/../conf/conf.json)\n![web](https://example.com/a.png)",
+	})
+	if err != nil {
+		t.Fatalf("ListAttachments: %v", err)
+	}
+	if len(refs) != 1 || refs[0].Name != "chart.png" || refs[0].Embed != "![chart](assets/chart-20260102030405-abcdefg.png)" {
+		t.Fatalf("refs = %+v, want only the asset", refs)
+	}
+	file, err := provider.DownloadAttachment(ctx, refs[0])
+	if err != nil {
+		t.Fatalf("DownloadAttachment: %v", err)
+	}
+	if file.Name != "chart.png" || file.MIMEType != "image/png" || file.Link != "assets/chart-20260102030405-abcdefg.png" {
+		t.Fatalf("unexpected download: %+v", file)
+	}
+
+	link, err := provider.UploadAttachment(ctx, LocalAttachment{Name: "my notes.txt", MIMEType: "text/plain", Data: []byte("todo")})
+	if err != nil {
+		t.Fatalf("UploadAttachment: %v", err)
+	}
+	if link != "assets/my%20notes-20260102030405-hijklmn.txt" || string(uploaded) != "todo" {
+		t.Fatalf("link = %q, uploaded %q", link, uploaded)
+	}
+}
+
 func writeSiYuanJSON(t *testing.T, w http.ResponseWriter, data any) {
 	t.Helper()
 	w.Header().Set("Content-Type", "application/json")
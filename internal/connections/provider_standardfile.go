@@ -0,0 +1,536 @@
+package connections
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	standardFileNote = "Note"
+	standardFileTag  = "Tag"
+	// standardFileParentRef is the reference a nested tag holds to its parent.
+	standardFileParentRef = "TagToParentTag"
+	// maxStandardFileSize bounds the export file read from the endpoint.
+	maxStandardFileSize = 64 << 20
+)
+
+var errStandardFileEncrypted = errors.New("standard file export is encrypted; export a decrypted backup instead")
+
+// standardFileProvider reads and writes a decrypted Standard Notes backup
+// (the Standard File format) served over HTTP, such as a file on a WebDAV
+// share. Every call reads the whole file and pushes write it back with
+// If-Match, so a file changed in between is never overwritten.
+//
+// Items are kept as raw maps so fields this provider does not know about
+// survive a push unchanged.
+type standardFileProvider struct {
+	endpoint string
+	token    string
+	client   *http.Client
+}
+
+type standardFileDocument struct {
+	raw   map[string]any
+	items []map[string]any
+	etag  string
+}
+
+type standardFileStatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *standardFileStatusError) Error() string {
+	return e.Message
+}
+
+func newStandardFileProvider(endpoint, token string, httpClient *http.Client) Provider {
+	return &standardFileProvider{endpoint: endpoint, token: token, client: httpClient}
+}
+
+func (p *standardFileProvider) Test(ctx context.Context) error {
+	_, err := p.load(ctx)
+	return err
+}
+
+// ListTargets offers the tags, with nested tags under their parent.
+func (p *standardFileProvider) ListTargets(ctx context.Context) ([]Target, error) {
+	doc, err := p.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var targets []Target
+	for _, item := range doc.itemsOfType(standardFileTag) {
+		targets = append(targets, Target{
+			ID:       sfString(item, "uuid"),
+			Name:     titleOrUntitled(sfString(sfContent(item), "title")),
+			Kind:     "tag",
+			ParentID: sfParentTag(item),
+		})
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i].Name < targets[j].Name })
+	return targets, nil
+}
+
+// ImportNotes reads the notes tagged with the target or one of its nested
+// tags, or every note when no target is set. The note's first tag becomes
+// its folder and the others its tags.
+func (p *standardFileProvider) ImportNotes(ctx context.Context, targetID string, limit int) ([]RemoteNote, error) {
+	limit = clampLimit(limit)
+	doc, err := p.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targetID = strings.TrimSpace(targetID)
+	if targetID != "" && doc.item(targetID) == nil {
+		return nil, fmt.Errorf("standard file tag %q not found", targetID)
+	}
+	var notes []RemoteNote
+	for _, item := range doc.itemsOfType(standardFileNote) {
+		if len(notes) >= limit {
+			break
+		}
+		remote, ok := doc.remoteNote(item, targetID)
+		if !ok {
+			continue
+		}
+		remote.TargetID = targetID
+		remote.URL = p.endpoint + "#" + remote.ExternalID
+		notes = append(notes, remote)
+	}
+	return notes, nil
+}
+
+func (p *standardFileProvider) GetNote(ctx context.Context, externalID string) (RemoteNote, error) {
+	doc, err := p.load(ctx)
+	if err != nil {
+		return RemoteNote{}, err
+	}
+	item := doc.item(externalID)
+	if item == nil || sfString(item, "content_type") != standardFileNote || sfDeleted(item) {
+		return RemoteNote{}, ErrRemoteNoteNotFound
+	}
+	remote, _ := doc.remoteNote(item, "")
+	remote.URL = p.endpoint + "#" + remote.ExternalID
+	return remote, nil
+}
+
+// PushNote upserts the note, files it under a chain of nested tags for its
+// folder below the target tag, and tags it with its tags.
+func (p *standardFileProvider) PushNote(ctx context.Context, input PushInput) (PushResult, error) {
+	doc, err := p.load(ctx)
+	if err != nil {
+		return PushResult{}, err
+	}
+	now := time.Now().UTC()
+
+	note := doc.item(input.ExistingExternalID)
+	if note == nil || sfString(note, "content_type") != standardFileNote {
+		note = doc.newItem(standardFileNote, input.CreatedAt, now)
+	}
+	content := sfContent(note)
+	content["title"] = input.Title
+	content["text"] = input.Content
+	delete(content, "trashed")
+	sfTouch(note, now)
+	noteID := sfString(note, "uuid")
+
+	wanted := map[string]bool{}
+	folderID := ""
+	if target := strings.TrimSpace(input.TargetID); target != "" {
+		if tag := doc.item(target); tag != nil && sfString(tag, "content_type") == standardFileTag {
+			folderID = target
+		}
+	}
+	for _, segment := range input.FolderPath {
+		folderID = doc.tagNamed(titleOrUntitled(segment), folderID, true, now)
+	}
+	if folderID != "" {
+		wanted[folderID] = true
+	}
+	for _, tag := range input.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			wanted[doc.tagNamed(tag, "", false, now)] = true
+		}
+	}
+
+	for _, tag := range doc.itemsOfType(standardFileTag) {
+		tagID := sfString(tag, "uuid")
+		has := sfReferences(tag, noteID)
+		switch {
+		case wanted[tagID] && !has:
+			sfAddReference(tag, noteID, standardFileNote, "")
+			sfTouch(tag, now)
+		case !wanted[tagID] && has:
+			sfRemoveReference(tag, noteID)
+			sfTouch(tag, now)
+		}
+	}
+
+	if err := p.save(ctx, doc); err != nil {
+		return PushResult{}, err
+	}
+	return PushResult{
+		ExternalID: noteID,
+		TargetID:   strings.TrimSpace(input.TargetID),
+		URL:        p.endpoint + "#" + noteID,
+	}, nil
+}
+
+// ListAttachments reports none: Standard Notes keeps files encrypted
+// outside the backup, so links in notes are left as they are.
+func (p *standardFileProvider) ListAttachments(ctx context.Context, remote RemoteNote) ([]AttachmentRef, error) {
+	return nil, nil
+}
+
+func (p *standardFileProvider) DownloadAttachment(ctx context.Context, ref AttachmentRef) (RemoteAttachment, error) {
+	return RemoteAttachment{}, errors.New("standard file exports do not hold attachments")
+}
+
+// UploadAttachment keeps the local link, since the export has nowhere to
+// store the file.
+func (p *standardFileProvider) UploadAttachment(ctx context.Context, file LocalAttachment) (string, error) {
+	return "", nil
+}
+
+func (p *standardFileProvider) load(ctx context.Context) (*standardFileDocument, error) {
+	resp, err := p.do(ctx, http.MethodGet, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxStandardFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxStandardFileSize {
+		return nil, fmt.Errorf("standard file export is larger than %d bytes", maxStandardFileSize)
+	}
+	doc, err := parseStandardFile(data)
+	if err != nil {
+		return nil, err
+	}
+	doc.etag = resp.Header.Get("ETag")
+	return doc, nil
+}
+
+func parseStandardFile(data []byte) (*standardFileDocument, error) {
+	doc := &standardFileDocument{raw: map[string]any{}}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc.raw); err != nil {
+		return nil, fmt.Errorf("invalid standard file export: %w", err)
+	}
+	rawItems, ok := doc.raw["items"].([]any)
+	if !ok {
+		return nil, errors.New("invalid standard file export: items are missing")
+	}
+	for _, raw := range rawItems {
+		item, ok := raw.(map[string]any)
+		if !ok {
+			return nil, errors.New("invalid standard file export: item is not an object")
+		}
+		switch sfString(item, "content_type") {
+		case standardFileNote, standardFileTag:
+			if _, ok := item["content"].(map[string]any); !ok && !sfDeleted(item) {
+				return nil, errStandardFileEncrypted
+			}
+		}
+		doc.items = append(doc.items, item)
+	}
+	return doc, nil
+}
+
+func (p *standardFileProvider) save(ctx context.Context, doc *standardFileDocument) error {
+	items := make([]any, len(doc.items))
+	for i, item := range doc.items {
+		items[i] = item
+	}
+	doc.raw["items"] = items
+	raw, err := json.MarshalIndent(doc.raw, "", "  ")
+	if err != nil {
+		return err
+	}
+	resp, err := p.do(ctx, http.MethodPut, bytes.NewReader(raw), doc.etag)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// do sends a request to the export file. A user:password token is sent as
+// basic auth and any other token as a bearer token. Error statuses are
+// returned as a standardFileStatusError; otherwise the caller closes the
+// body.
+func (p *standardFileProvider) do(ctx context.Context, method string, body io.Reader, ifMatch string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, p.endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	if user, password, ok := strings.Cut(p.token, ":"); ok {
+		req.SetBasicAuth(user, password)
+	} else if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		message := fmt.Sprintf("standard file request failed: %s %s", resp.Status, strings.TrimSpace(string(raw)))
+		if resp.StatusCode == http.StatusPreconditionFailed {
+			message = "standard file export changed while it was being updated; try again"
+		}
+		return nil, &standardFileStatusError{StatusCode: resp.StatusCode, Message: message}
+	}
+	return resp, nil
+}
+
+func (d *standardFileDocument) item(id string) map[string]any {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil
+	}
+	for _, item := range d.items {
+		if sfString(item, "uuid") == id {
+			return item
+		}
+	}
+	return nil
+}
+
+func (d *standardFileDocument) itemsOfType(contentType string) []map[string]any {
+	var items []map[string]any
+	for _, item := range d.items {
+		if sfString(item, "content_type") == contentType && !sfDeleted(item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func (d *standardFileDocument) newItem(contentType string, createdAt, now time.Time) map[string]any {
+	created := now
+	if !createdAt.IsZero() {
+		created = createdAt.UTC()
+	}
+	item := map[string]any{
+		"uuid":         uuid.NewString(),
+		"content_type": contentType,
+		"created_at":   formatStandardFileTime(created),
+		"updated_at":   formatStandardFileTime(now),
+		"content": map[string]any{
+			"references": []any{},
+			"appData":    map[string]any{},
+		},
+	}
+	d.items = append(d.items, item)
+	return item
+}
+
+// tagNamed finds the tag with the title under parent, creating it when
+// there is none. Unless nested is set, a tag with the title at any depth
+// also matches, so plain tags reuse the tags already in the export.
+func (d *standardFileDocument) tagNamed(title, parentID string, nested bool, now time.Time) string {
+	var anywhere string
+	for _, tag := range d.itemsOfType(standardFileTag) {
+		if !strings.EqualFold(strings.TrimSpace(sfString(sfContent(tag), "title")), title) {
+			continue
+		}
+		if sfParentTag(tag) == parentID {
+			return sfString(tag, "uuid")
+		}
+		if anywhere == "" {
+			anywhere = sfString(tag, "uuid")
+		}
+	}
+	if !nested && anywhere != "" {
+		return anywhere
+	}
+	tag := d.newItem(standardFileTag, time.Time{}, now)
+	sfContent(tag)["title"] = title
+	if parentID != "" {
+		sfAddReference(tag, parentID, standardFileTag, standardFileParentRef)
+	}
+	return sfString(tag, "uuid")
+}
+
+// tagPath names the tag and its parents, outermost first.
+func (d *standardFileDocument) tagPath(tagID string) []string {
+	var names []string
+	seen := map[string]bool{}
+	for tagID != "" && !seen[tagID] {
+		seen[tagID] = true
+		tag := d.item(tagID)
+		if tag == nil {
+			break
+		}
+		names = append([]string{titleOrUntitled(sfString(sfContent(tag), "title"))}, names...)
+		tagID = sfParentTag(tag)
+	}
+	return names
+}
+
+// withinTag reports whether the tag is target or nested below it.
+func (d *standardFileDocument) withinTag(tagID, target string) bool {
+	seen := map[string]bool{}
+	for tagID != "" && !seen[tagID] {
+		if tagID == target {
+			return true
+		}
+		seen[tagID] = true
+		tag := d.item(tagID)
+		if tag == nil {
+			return false
+		}
+		tagID = sfParentTag(tag)
+	}
+	return false
+}
+
+// remoteNote reads a note. Its folder is the first of its tags within
+// target, and it is skipped when target is set and none of its tags are.
+func (d *standardFileDocument) remoteNote(item map[string]any, target string) (RemoteNote, bool) {
+	content := sfContent(item)
+	if trashed, _ := content["trashed"].(bool); trashed {
+		return RemoteNote{}, false
+	}
+	noteID := sfString(item, "uuid")
+	folderID := ""
+	var tagIDs []string
+	for _, tag := range d.itemsOfType(standardFileTag) {
+		if !sfReferences(tag, noteID) {
+			continue
+		}
+		tagID := sfString(tag, "uuid")
+		if folderID == "" && (target == "" || d.withinTag(tagID, target)) {
+			folderID = tagID
+			continue
+		}
+		tagIDs = append(tagIDs, tagID)
+	}
+	if target != "" && folderID == "" {
+		return RemoteNote{}, false
+	}
+	var tags []string
+	for _, tagID := range tagIDs {
+		tags = append(tags, strings.Join(d.tagPath(tagID), "/"))
+	}
+	title := titleOrUntitled(sfString(content, "title"))
+	return RemoteNote{
+		ExternalID: noteID,
+		Path:       strings.Join(append(d.tagPath(folderID), title), "/"),
+		Title:      title,
+		Content:    sfString(content, "text"),
+		Tags:       tags,
+		CreatedAt:  parseStandardFileTime(sfString(item, "created_at")),
+		UpdatedAt:  parseStandardFileTime(sfString(item, "updated_at")),
+	}, true
+}
+
+func sfString(values map[string]any, key string) string {
+	value, _ := values[key].(string)
+	return value
+}
+
+func sfDeleted(item map[string]any) bool {
+	deleted, _ := item["deleted"].(bool)
+	return deleted
+}
+
+// sfContent returns the item's content, adding an empty one if it has none.
+func sfContent(item map[string]any) map[string]any {
+	content, ok := item["content"].(map[string]any)
+	if !ok {
+		content = map[string]any{}
+		item["content"] = content
+	}
+	return content
+}
+
+func sfReferenceList(item map[string]any) []any {
+	references, _ := sfContent(item)["references"].([]any)
+	return references
+}
+
+func sfReferences(item map[string]any, id string) bool {
+	for _, raw := range sfReferenceList(item) {
+		if ref, ok := raw.(map[string]any); ok && sfString(ref, "uuid") == id {
+			return true
+		}
+	}
+	return false
+}
+
+func sfParentTag(tag map[string]any) string {
+	for _, raw := range sfReferenceList(tag) {
+		if ref, ok := raw.(map[string]any); ok && sfString(ref, "reference_type") == standardFileParentRef {
+			return sfString(ref, "uuid")
+		}
+	}
+	return ""
+}
+
+func sfAddReference(item map[string]any, id, contentType, referenceType string) {
+	ref := map[string]any{"uuid": id, "content_type": contentType}
+	if referenceType != "" {
+		ref["reference_type"] = referenceType
+	}
+	sfContent(item)["references"] = append(sfReferenceList(item), ref)
+}
+
+func sfRemoveReference(item map[string]any, id string) {
+	references := sfReferenceList(item)
+	kept := make([]any, 0, len(references))
+	for _, raw := range references {
+		if ref, ok := raw.(map[string]any); ok && sfString(ref, "uuid") == id {
+			continue
+		}
+		kept = append(kept, raw)
+	}
+	sfContent(item)["references"] = kept
+}
+
+// sfTouch sets the item's modification time, including the microsecond
+// timestamp newer exports carry.
+func sfTouch(item map[string]any, now time.Time) {
+	item["updated_at"] = formatStandardFileTime(now)
+	if _, ok := item["updated_at_timestamp"]; ok {
+		item["updated_at_timestamp"] = now.UnixMicro()
+	}
+}
+
+func formatStandardFileTime(value time.Time) string {
+	return value.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+func parseStandardFileTime(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil
+	}
+	parsed = parsed.UTC()
+	return &parsed
+}
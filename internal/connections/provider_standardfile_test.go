@@ -0,0 +1,202 @@
+package connections
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const standardFileExport = `{
+  "version": "004",
+  "items": [
+    {"uuid": "tag-work", "content_type": "Tag", "created_at": "2025-01-01T00:00:00.000Z", "updated_at": "2025-01-01T00:00:00.000Z",
+     "content": {"title": "Work", "references": [{"uuid": "note-plan", "content_type": "Note"}], "appData": {"org.standardnotes.sn": {"client_updated_at": "x"}}}},
+    {"uuid": "tag-projects", "content_type": "Tag", "created_at": "2025-01-01T00:00:00.000Z", "updated_at": "2025-01-01T00:00:00.000Z",
+     "content": {"title": "Projects", "references": [{"uuid": "tag-work", "content_type": "Tag", "reference_type": "TagToParentTag"}, {"uuid": "note-plan", "content_type": "Note"}]}},
+    {"uuid": "tag-urgent", "content_type": "Tag", "created_at": "2025-01-01T00:00:00.000Z", "updated_at": "2025-01-01T00:00:00.000Z",
+     "content": {"title": "Urgent", "references": [{"uuid": "note-plan", "content_type": "Note"}]}},
+    {"uuid": "note-plan", "content_type": "Note", "created_at": "2025-01-02T03:04:05.000Z", "updated_at": "2025-01-03T03:04:05.000Z",
+     "content": {"title": "Plan", "text": "Ship it", "references": [], "preview_plain": "Ship it", "appData": {"org.standardnotes.sn": {"pinned": true}}}},
+    {"uuid": "note-old", "content_type": "Note", "created_at": "2025-01-02T03:04:05.000Z", "updated_at": "2025-01-03T03:04:05.000Z",
+     "content": {"title": "Old", "text": "gone", "references": [], "trashed": true}},
+    {"uuid": "pref", "content_type": "SN|UserPreferences", "content": {"editorLeft": 12}}
+  ]
+}`
+
+// fakeStandardFile serves one export file with an ETag and accepts
+// conditional writes of it.
+type fakeStandardFile struct {
+	t       *testing.T
+	lock    sync.Mutex
+	data    string
+	version int
+}
+
+func (f *fakeStandardFile) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if user, password, ok := r.BasicAuth(); !ok || user != "sync" || password != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	etag := fmt.Sprintf(`"v%d"`, f.version)
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("ETag", etag)
+		_, _ = io.WriteString(w, f.data)
+	case http.MethodPut:
+		if r.Header.Get("If-Match") != etag {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		raw, _ := io.ReadAll(r.Body)
+		f.data = string(raw)
+		f.version++
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		f.t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}
+}
+
+func (f *fakeStandardFile) item(id string) map[string]any {
+	doc, err := parseStandardFile([]byte(f.data))
+	if err != nil {
+		f.t.Fatalf("parse written export: %v", err)
+	}
+	return doc.item(id)
+}
+
+func TestStandardFileImportNotesMapsTagsToFoldersAndTags(t *testing.T) {
+	server := httptest.NewServer(&fakeStandardFile{t: t, data: standardFileExport})
+	defer server.Close()
+	provider := newStandardFileProvider(server.URL+"/notes.json", "sync:secret", server.Client())
+
+	if err := provider.Test(context.Background()); err != nil {
+		t.Fatalf("test connection: %v", err)
+	}
+	targets, err := provider.ListTargets(context.Background())
+	if err != nil {
+		t.Fatalf("list targets: %v", err)
+	}
+	if len(targets) != 3 || targets[0].ID != "tag-projects" || targets[0].ParentID != "tag-work" {
+		t.Fatalf("targets = %#v, want Projects nested under Work", targets)
+	}
+
+	notes, err := provider.ImportNotes(context.Background(), "tag-projects", 10)
+	if err != nil {
+		t.Fatalf("import notes: %v", err)
+	}
+	if len(notes) != 1 {
+		t.Fatalf("notes = %#v, want the untrashed note in Projects", notes)
+	}
+	plan := notes[0]
+	if plan.ExternalID != "note-plan" || plan.Title != "Plan" || plan.Content != "Ship it" || plan.Path != "Work/Projects/Plan" {
+		t.Fatalf("plan = %#v, want note filed under Work/Projects", plan)
+	}
+	if got := strings.Join(plan.Tags, ","); got != "Work,Urgent" {
+		t.Fatalf("tags = %q, want the other tags", got)
+	}
+	if plan.CreatedAt == nil || plan.CreatedAt.Format("2006-01-02") != "2025-01-02" {
+		t.Fatalf("created_at = %v, want parsed time", plan.CreatedAt)
+	}
+
+	if _, err := provider.GetNote(context.Background(), "note-old-missing"); !errors.Is(err, ErrRemoteNoteNotFound) {
+		t.Fatalf("error = %v, want ErrRemoteNoteNotFound", err)
+	}
+}
+
+func TestStandardFilePushNoteUpdatesTagsAndKeepsUnknownFields(t *testing.T) {
+	fake := &fakeStandardFile{t: t, data: standardFileExport}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	provider := newStandardFileProvider(server.URL+"/notes.json", "sync:secret", server.Client())
+
+	result, err := provider.PushNote(context.Background(), PushInput{
+		Title:              "Plan v2",
+		Content:            "Shipped",
+		ExistingExternalID: "note-plan",
+		TargetID:           "tag-work",
+		FolderPath:         []string{"Archive"},
+		Tags:               []string{"urgent", "Later"},
+	})
+	if err != nil {
+		t.Fatalf("push note: %v", err)
+	}
+	if result.ExternalID != "note-plan" {
+		t.Fatalf("external id = %q, want existing note updated", result.ExternalID)
+	}
+	note := fake.item("note-plan")
+	content := sfContent(note)
+	if content["title"] != "Plan v2" || content["text"] != "Shipped" || content["preview_plain"] != "Ship it" {
+		t.Fatalf("note content = %#v, want new text with unknown fields kept", content)
+	}
+	if pinned := content["appData"].(map[string]any)["org.standardnotes.sn"].(map[string]any)["pinned"]; pinned != true {
+		t.Fatalf("appData = %#v, want pinned kept", content["appData"])
+	}
+	if fake.item("pref") == nil {
+		t.Fatal("preferences item was dropped")
+	}
+
+	doc, _ := parseStandardFile([]byte(fake.data))
+	tagged := map[string]bool{}
+	var archive map[string]any
+	for _, tag := range doc.itemsOfType(standardFileTag) {
+		title := sfString(sfContent(tag), "title")
+		if sfReferences(tag, "note-plan") {
+			tagged[title] = true
+		}
+		if title == "Archive" {
+			archive = tag
+		}
+	}
+	if archive == nil || sfParentTag(archive) != "tag-work" {
+		t.Fatalf("archive tag = %#v, want it nested under Work", archive)
+	}
+	if len(tagged) != 3 || !tagged["Archive"] || !tagged["Urgent"] || !tagged["Later"] {
+		t.Fatalf("tagged = %#v, want Archive, Urgent and Later only", tagged)
+	}
+
+	created, err := provider.PushNote(context.Background(), PushInput{Title: "New", Content: "Body"})
+	if err != nil {
+		t.Fatalf("create note: %v", err)
+	}
+	if item := fake.item(created.ExternalID); item == nil || sfString(sfContent(item), "title") != "New" {
+		t.Fatalf("created item = %#v, want new note", item)
+	}
+}
+
+func TestStandardFileRejectsEncryptedAndChangedExports(t *testing.T) {
+	if _, err := parseStandardFile([]byte(`{"keyParams":{},"items":[{"uuid":"n","content_type":"Note","content":"004:abc"}]}`)); !errors.Is(err, errStandardFileEncrypted) {
+		t.Fatalf("error = %v, want encrypted export rejected", err)
+	}
+
+	fake := &fakeStandardFile{t: t, data: standardFileExport}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			// Another client writes the file between the read and the write.
+			fake.lock.Lock()
+			fake.version++
+			fake.lock.Unlock()
+		}
+		fake.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+	provider := newStandardFileProvider(server.URL, "sync:secret", server.Client())
+
+	_, err := provider.PushNote(context.Background(), PushInput{Title: "New", Content: "Body"})
+	var statusErr *standardFileStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusPreconditionFailed {
+		t.Fatalf("error = %v, want precondition failure", err)
+	}
+	var raw map[string]any
+	if err := json.Unmarshal([]byte(fake.data), &raw); err != nil || len(raw["items"].([]any)) != 6 {
+		t.Fatalf("export was modified despite the conflict")
+	}
+}
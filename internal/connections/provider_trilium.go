@@ -0,0 +1,629 @@
+package connections
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	triliumRootNoteID = "root"
+	// triliumAttachmentsLabel marks the note that owns the files pushes
+	// upload. Trilium attachments need an owner, and the file is uploaded
+	// before the note that links it exists.
+	triliumAttachmentsLabel = "smartickyAttachments"
+	// triliumMaxTargets bounds how many parent notes are offered as targets.
+	triliumMaxTargets = 500
+)
+
+// triliumAttachmentLinkPattern matches Markdown links to files Trilium
+// serves: attachments of a note, or the content of an older image note.
+var triliumAttachmentLinkPattern = regexp.MustCompile(`!?\[[^\]\n]*\]\((api/(attachments|images)/([A-Za-z0-9_]+)(?:/[^)\s]*)?)\)`)
+
+// triliumSystemLabels are labels Trilium itself uses to configure notes,
+// which are not tags.
+var triliumSystemLabels = map[string]bool{
+	"archived":               true,
+	"autoReadOnlyDisabled":   true,
+	"bookmarked":             true,
+	"excludeFromNoteMap":     true,
+	"hidePromotedAttributes": true,
+	"readOnly":               true,
+	"shareHiddenFromTree":    true,
+	"shareRoot":              true,
+	"sorted":                 true,
+	"template":               true,
+	"workspace":              true,
+	triliumAttachmentsLabel:  true,
+}
+
+type triliumProvider struct {
+	endpoint string
+	token    string
+	client   *http.Client
+	// notes caches notes read while walking the tree.
+	notes map[string]triliumNote
+}
+
+type triliumNote struct {
+	NoteID          string             `json:"noteId"`
+	Title           string             `json:"title"`
+	Type            string             `json:"type"`
+	Mime            string             `json:"mime"`
+	IsProtected     bool               `json:"isProtected"`
+	Attributes      []triliumAttribute `json:"attributes"`
+	ParentNoteIDs   []string           `json:"parentNoteIds"`
+	ChildNoteIDs    []string           `json:"childNoteIds"`
+	UTCDateCreated  string             `json:"utcDateCreated"`
+	UTCDateModified string             `json:"utcDateModified"`
+}
+
+type triliumAttribute struct {
+	AttributeID string `json:"attributeId,omitempty"`
+	NoteID      string `json:"noteId"`
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Value       string `json:"value"`
+}
+
+type triliumAttachment struct {
+	AttachmentID string `json:"attachmentId"`
+	OwnerID      string `json:"ownerId"`
+	Role         string `json:"role"`
+	Mime         string `json:"mime"`
+	Title        string `json:"title"`
+}
+
+type triliumStatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *triliumStatusError) Error() string {
+	return e.Message
+}
+
+func newTriliumProvider(endpoint, token string, httpClient *http.Client) Provider {
+	return &triliumProvider{
+		endpoint: endpoint,
+		token:    token,
+		client:   httpClient,
+		notes:    map[string]triliumNote{},
+	}
+}
+
+func (p *triliumProvider) Test(ctx context.Context) error {
+	var info struct {
+		AppVersion string `json:"appVersion"`
+	}
+	if err := p.doJSON(ctx, http.MethodGet, "/app-info", nil, nil, &info); err != nil {
+		return err
+	}
+	if info.AppVersion == "" {
+		return errors.New("trilium ETAPI not found")
+	}
+	return nil
+}
+
+// ListTargets offers the notes that have children, which Trilium uses as
+// folders.
+func (p *triliumProvider) ListTargets(ctx context.Context) ([]Target, error) {
+	var response struct {
+		Results []triliumNote `json:"results"`
+	}
+	err := p.doJSON(ctx, http.MethodGet, "/notes", url.Values{
+		"search":  {"note.childrenCount > 0"},
+		"limit":   {fmt.Sprint(triliumMaxTargets)},
+		"orderBy": {"title"},
+	}, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	targets := []Target{{ID: triliumRootNoteID, Name: "root", Kind: "note"}}
+	for _, item := range response.Results {
+		if item.NoteID == triliumRootNoteID || strings.HasPrefix(item.NoteID, "_") || item.hasLabel(triliumAttachmentsLabel) {
+			continue
+		}
+		p.notes[item.NoteID] = item
+		targets = append(targets, Target{
+			ID:       item.NoteID,
+			Name:     titleOrUntitled(item.Title),
+			Kind:     "note",
+			ParentID: item.parentID(),
+		})
+	}
+	return targets, nil
+}
+
+// ImportNotes reads the text notes below the target, nearest first. Notes
+// with children become folders of the notes below them.
+func (p *triliumProvider) ImportNotes(ctx context.Context, targetID string, limit int) ([]RemoteNote, error) {
+	limit = clampLimit(limit)
+	rootID := defaultString(strings.TrimSpace(targetID), triliumRootNoteID)
+	root, err := p.note(ctx, rootID)
+	if err != nil {
+		return nil, err
+	}
+
+	type queued struct {
+		id     string
+		folder string
+	}
+	queue := make([]queued, 0, len(root.ChildNoteIDs))
+	for _, id := range root.ChildNoteIDs {
+		queue = append(queue, queued{id: id})
+	}
+	seen := map[string]bool{rootID: true}
+	var notes []RemoteNote
+	for len(queue) > 0 && len(notes) < limit {
+		next := queue[0]
+		queue = queue[1:]
+		if seen[next.id] || strings.HasPrefix(next.id, "_") {
+			continue
+		}
+		seen[next.id] = true
+		item, err := p.note(ctx, next.id)
+		if err != nil {
+			return nil, err
+		}
+		if item.hasLabel(triliumAttachmentsLabel) {
+			continue
+		}
+		title := titleOrUntitled(item.Title)
+		for _, child := range item.ChildNoteIDs {
+			queue = append(queue, queued{id: child, folder: strings.Trim(next.folder+"/"+title, "/")})
+		}
+		if item.Type != "text" || item.IsProtected {
+			continue
+		}
+		remote, err := p.remoteNote(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		remote.TargetID = rootID
+		remote.Path = strings.Trim(next.folder+"/"+title, "/")
+		notes = append(notes, remote)
+	}
+	return notes, nil
+}
+
+func (p *triliumProvider) GetNote(ctx context.Context, externalID string) (RemoteNote, error) {
+	item, err := p.note(ctx, externalID)
+	var statusErr *triliumStatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+		return RemoteNote{}, ErrRemoteNoteNotFound
+	}
+	if err != nil {
+		return RemoteNote{}, err
+	}
+	remote, err := p.remoteNote(ctx, item)
+	if err != nil {
+		return RemoteNote{}, err
+	}
+	remote.TargetID = item.parentID()
+	return remote, nil
+}
+
+func (p *triliumProvider) remoteNote(ctx context.Context, item triliumNote) (RemoteNote, error) {
+	content, err := p.content(ctx, "/notes/"+url.PathEscape(item.NoteID)+"/content")
+	if err != nil {
+		return RemoteNote{}, err
+	}
+	return RemoteNote{
+		ExternalID: item.NoteID,
+		URL:        p.endpoint + "/#root/" + item.NoteID,
+		Title:      titleOrUntitled(item.Title),
+		Content:    htmlToMarkdown(string(content)),
+		Tags:       item.tags(),
+		CreatedAt:  parseTriliumTime(item.UTCDateCreated),
+		UpdatedAt:  parseTriliumTime(item.UTCDateModified),
+	}, nil
+}
+
+func (p *triliumProvider) note(ctx context.Context, id string) (triliumNote, error) {
+	if item, ok := p.notes[id]; ok && item.ChildNoteIDs != nil {
+		return item, nil
+	}
+	var item triliumNote
+	if err := p.doJSON(ctx, http.MethodGet, "/notes/"+url.PathEscape(id), nil, nil, &item); err != nil {
+		return triliumNote{}, err
+	}
+	if item.ChildNoteIDs == nil {
+		item.ChildNoteIDs = []string{}
+	}
+	p.notes[id] = item
+	return item, nil
+}
+
+func (p *triliumProvider) PushNote(ctx context.Context, input PushInput) (PushResult, error) {
+	content := markdownToHTML(input.Content)
+	var item triliumNote
+	if input.ExistingExternalID != "" {
+		id := url.PathEscape(input.ExistingExternalID)
+		if err := p.doJSON(ctx, http.MethodPatch, "/notes/"+id, nil, map[string]string{"title": input.Title}, &item); err != nil {
+			return PushResult{}, err
+		}
+		resp, err := p.do(ctx, http.MethodPut, "/notes/"+id+"/content", nil, strings.NewReader(content), "text/plain")
+		if err != nil {
+			return PushResult{}, err
+		}
+		resp.Body.Close()
+	} else {
+		parentID := defaultString(strings.TrimSpace(input.TargetID), triliumRootNoteID)
+		for _, segment := range input.FolderPath {
+			var err error
+			parentID, err = p.childFolder(ctx, parentID, segment)
+			if err != nil {
+				return PushResult{}, err
+			}
+		}
+		var created struct {
+			Note triliumNote `json:"note"`
+		}
+		if err := p.doJSON(ctx, http.MethodPost, "/create-note", nil, map[string]string{
+			"parentNoteId": parentID,
+			"title":        input.Title,
+			"type":         "text",
+			"content":      content,
+		}, &created); err != nil {
+			return PushResult{}, err
+		}
+		item = created.Note
+	}
+	if item.NoteID == "" {
+		return PushResult{}, errors.New("trilium did not return a note id")
+	}
+	if err := p.syncLabels(ctx, item, input.Tags); err != nil {
+		return PushResult{}, err
+	}
+	return PushResult{
+		ExternalID: item.NoteID,
+		TargetID:   item.parentID(),
+		URL:        p.endpoint + "/#root/" + item.NoteID,
+	}, nil
+}
+
+// childFolder finds the child note of parent with the title, creating it
+// when there is none.
+func (p *triliumProvider) childFolder(ctx context.Context, parentID, title string) (string, error) {
+	title = titleOrUntitled(title)
+	parent, err := p.note(ctx, parentID)
+	if err != nil {
+		return "", err
+	}
+	for _, id := range parent.ChildNoteIDs {
+		child, err := p.note(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		if strings.EqualFold(strings.TrimSpace(child.Title), title) {
+			return child.NoteID, nil
+		}
+	}
+	var created struct {
+		Note triliumNote `json:"note"`
+	}
+	if err := p.doJSON(ctx, http.MethodPost, "/create-note", nil, map[string]string{
+		"parentNoteId": parentID,
+		"title":        title,
+		"type":         "text",
+		"content":      "",
+	}, &created); err != nil {
+		return "", err
+	}
+	parent.ChildNoteIDs = append(parent.ChildNoteIDs, created.Note.NoteID)
+	p.notes[parentID] = parent
+	created.Note.ChildNoteIDs = []string{}
+	p.notes[created.Note.NoteID] = created.Note
+	return created.Note.NoteID, nil
+}
+
+// syncLabels makes the note's tag labels match the tags.
+func (p *triliumProvider) syncLabels(ctx context.Context, item triliumNote, tags []string) error {
+	want := map[string]bool{}
+	for _, tag := range tags {
+		if name := triliumLabelName(tag); name != "" {
+			want[name] = true
+		}
+	}
+	for _, attr := range item.Attributes {
+		if !isTriliumTagLabel(attr) {
+			continue
+		}
+		if want[attr.Name] {
+			delete(want, attr.Name)
+			continue
+		}
+		if attr.AttributeID == "" {
+			continue
+		}
+		resp, err := p.do(ctx, http.MethodDelete, "/attributes/"+url.PathEscape(attr.AttributeID), nil, nil, "")
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+	}
+	names := make([]string, 0, len(want))
+	for name := range want {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		err := p.doJSON(ctx, http.MethodPost, "/attributes", nil, triliumAttribute{
+			NoteID: item.NoteID,
+			Type:   "label",
+			Name:   name,
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *triliumProvider) ListAttachments(ctx context.Context, remote RemoteNote) ([]AttachmentRef, error) {
+	var refs []AttachmentRef
+	seen := map[string]bool{}
+	for _, match := range triliumAttachmentLinkPattern.FindAllStringSubmatch(remote.Content, -1) {
+		if seen[match[0]] {
+			continue
+		}
+		seen[match[0]] = true
+		name, _ := url.PathUnescape(path.Base(match[1]))
+		refs = append(refs, AttachmentRef{ID: match[1], Name: name, Embed: match[0]})
+	}
+	return refs, nil
+}
+
+// DownloadAttachment fetches an attachment, or the content of an image
+// note for links written by Trilium versions before attachments.
+func (p *triliumProvider) DownloadAttachment(ctx context.Context, ref AttachmentRef) (RemoteAttachment, error) {
+	match := triliumAttachmentLinkPattern.FindStringSubmatch("[](" + ref.ID + ")")
+	if match == nil {
+		return RemoteAttachment{}, fmt.Errorf("unsupported trilium file link %q", ref.ID)
+	}
+	kind, id := match[2], url.PathEscape(match[3])
+	var name, mimeType, contentPath string
+	if kind == "attachments" {
+		var attachment triliumAttachment
+		if err := p.doJSON(ctx, http.MethodGet, "/attachments/"+id, nil, nil, &attachment); err != nil {
+			return RemoteAttachment{}, err
+		}
+		name, mimeType, contentPath = attachment.Title, attachment.Mime, "/attachments/"+id+"/content"
+	} else {
+		item, err := p.note(ctx, match[3])
+		if err != nil {
+			return RemoteAttachment{}, err
+		}
+		name, mimeType, contentPath = item.Title, item.Mime, "/notes/"+id+"/content"
+	}
+	data, err := p.content(ctx, contentPath)
+	if err != nil {
+		return RemoteAttachment{}, err
+	}
+	return RemoteAttachment{
+		Name:     defaultString(name, ref.Name),
+		MIMEType: mimeType,
+		Data:     data,
+		Embed:    ref.Embed,
+		Link:     ref.ID,
+	}, nil
+}
+
+// UploadAttachment stores the file as an attachment of the note labelled
+// #smartickyAttachments, creating that note under the root the first time.
+func (p *triliumProvider) UploadAttachment(ctx context.Context, file LocalAttachment) (string, error) {
+	ownerID, err := p.attachmentsOwner(ctx)
+	if err != nil {
+		return "", err
+	}
+	role := "file"
+	if strings.HasPrefix(file.MIMEType, "image/") {
+		role = "image"
+	}
+	var attachment triliumAttachment
+	if err := p.doJSON(ctx, http.MethodPost, "/attachments", nil, map[string]string{
+		"ownerId": ownerID,
+		"role":    role,
+		"mime":    defaultString(file.MIMEType, "application/octet-stream"),
+		"title":   file.Name,
+		"content": "",
+	}, &attachment); err != nil {
+		return "", err
+	}
+	if attachment.AttachmentID == "" {
+		return "", errors.New("trilium did not return an attachment id")
+	}
+	resp, err := p.do(ctx, http.MethodPut, "/attachments/"+url.PathEscape(attachment.AttachmentID)+"/content", nil, bytes.NewReader(file.Data), "application/octet-stream")
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	kind := "download"
+	if role == "image" {
+		kind = "image"
+	}
+	return "api/attachments/" + attachment.AttachmentID + "/" + kind + "/" + url.PathEscape(file.Name), nil
+}
+
+func (p *triliumProvider) attachmentsOwner(ctx context.Context) (string, error) {
+	var response struct {
+		Results []triliumNote `json:"results"`
+	}
+	if err := p.doJSON(ctx, http.MethodGet, "/notes", url.Values{
+		"search": {"#" + triliumAttachmentsLabel},
+		"limit":  {"1"},
+	}, nil, &response); err != nil {
+		return "", err
+	}
+	if len(response.Results) > 0 {
+		return response.Results[0].NoteID, nil
+	}
+	var created struct {
+		Note triliumNote `json:"note"`
+	}
+	if err := p.doJSON(ctx, http.MethodPost, "/create-note", nil, map[string]string{
+		"parentNoteId": triliumRootNoteID,
+		"title":        "Smarticky attachments",
+		"type":         "text",
+		"content":      "",
+	}, &created); err != nil {
+		return "", err
+	}
+	if err := p.doJSON(ctx, http.MethodPost, "/attributes", nil, triliumAttribute{
+		NoteID: created.Note.NoteID,
+		Type:   "label",
+		Name:   triliumAttachmentsLabel,
+	}, nil); err != nil {
+		return "", err
+	}
+	return created.Note.NoteID, nil
+}
+
+func (p *triliumProvider) content(ctx context.Context, path string) ([]byte, error) {
+	resp, err := p.do(ctx, http.MethodGet, path, nil, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteAttachmentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxRemoteAttachmentSize {
+		return nil, fmt.Errorf("trilium content is larger than %d bytes", maxRemoteAttachmentSize)
+	}
+	return data, nil
+}
+
+func (p *triliumProvider) doJSON(ctx context.Context, method, path string, query url.Values, body any, out any) error {
+	var reader io.Reader
+	contentType := ""
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(raw)
+		contentType = "application/json"
+	}
+	resp, err := p.do(ctx, method, path, query, reader, contentType)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// do sends an authenticated ETAPI request. Error statuses are returned as a
+// triliumStatusError; otherwise the caller closes the body.
+func (p *triliumProvider) do(ctx context.Context, method, path string, query url.Values, body io.Reader, contentType string) (*http.Response, error) {
+	target := p.endpoint + "/etapi" + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", p.token)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		var failure struct {
+			Message string `json:"message"`
+		}
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		message := strings.TrimSpace(string(raw))
+		if json.Unmarshal(raw, &failure) == nil && failure.Message != "" {
+			message = failure.Message
+		}
+		return nil, &triliumStatusError{
+			StatusCode: resp.StatusCode,
+			Message:    fmt.Sprintf("trilium request failed: %s %s", resp.Status, message),
+		}
+	}
+	return resp, nil
+}
+
+func (n triliumNote) parentID() string {
+	for _, id := range n.ParentNoteIDs {
+		if id != "none" && !strings.HasPrefix(id, "_") {
+			return id
+		}
+	}
+	return ""
+}
+
+func (n triliumNote) hasLabel(name string) bool {
+	for _, attr := range n.Attributes {
+		if attr.Type == "label" && attr.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// tags reads the note's own labels without a value, like #todo, as tags.
+func (n triliumNote) tags() []string {
+	var tags []string
+	for _, attr := range n.Attributes {
+		if isTriliumTagLabel(attr) && attr.NoteID == n.NoteID {
+			tags = append(tags, attr.Name)
+		}
+	}
+	return tags
+}
+
+func isTriliumTagLabel(attr triliumAttribute) bool {
+	return attr.Type == "label" && attr.Value == "" && !triliumSystemLabels[attr.Name]
+}
+
+// triliumLabelName turns a tag into a label name, which may only hold
+// letters, digits, underscores and colons.
+func triliumLabelName(tag string) string {
+	var builder strings.Builder
+	for _, r := range strings.TrimSpace(tag) {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == '_', r == ':':
+			builder.WriteRune(r)
+		case unicode.IsSpace(r), r == '-', r == '/':
+			builder.WriteRune('_')
+		}
+	}
+	return builder.String()
+}
+
+// parseTriliumTime reads Trilium's UTC dates, such as
+// "2021-12-31 19:18:11.930Z".
+func parseTriliumTime(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	parsed, err := time.Parse("2006-01-02 15:04:05.999Z07:00", value)
+	if err != nil {
+		return nil
+	}
+	parsed = parsed.UTC()
+	return &parsed
+}
@@ -0,0 +1,252 @@
+package connections
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeTrilium serves the parts of ETAPI the provider uses from memory.
+type fakeTrilium struct {
+	t        *testing.T
+	lock     sync.Mutex
+	notes    map[string]*triliumNote
+	contents map[string]string
+	nextID   int
+}
+
+func newFakeTrilium(t *testing.T) *fakeTrilium {
+	return &fakeTrilium{
+		t:        t,
+		notes:    map[string]*triliumNote{"root": {NoteID: "root", Title: "root", Type: "text", ParentNoteIDs: []string{"none"}}},
+		contents: map[string]string{"root": ""},
+	}
+}
+
+func (f *fakeTrilium) add(parentID, id, title, noteType, content string, labels ...string) *triliumNote {
+	note := &triliumNote{
+		NoteID:          id,
+		Title:           title,
+		Type:            noteType,
+		ParentNoteIDs:   []string{parentID},
+		UTCDateCreated:  "2025-01-02 03:04:05.000Z",
+		UTCDateModified: "2025-01-03 03:04:05.000Z",
+	}
+	for i, label := range labels {
+		name, value, _ := strings.Cut(label, "=")
+		note.Attributes = append(note.Attributes, triliumAttribute{AttributeID: fmt.Sprintf("%s-attr-%d", id, i), NoteID: id, Type: "label", Name: name, Value: value})
+	}
+	f.notes[id] = note
+	f.contents[id] = content
+	parent := f.notes[parentID]
+	parent.ChildNoteIDs = append(parent.ChildNoteIDs, id)
+	return note
+}
+
+func (f *fakeTrilium) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if r.Header.Get("Authorization") != "etapi-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/etapi")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case r.Method == http.MethodGet && path == "/app-info":
+		f.write(w, map[string]string{"appVersion": "0.63.0"})
+	case r.Method == http.MethodGet && path == "/notes":
+		var results []triliumNote
+		for _, note := range f.notes {
+			if r.URL.Query().Get("search") == "note.childrenCount > 0" && len(note.ChildNoteIDs) > 0 {
+				results = append(results, *note)
+			}
+		}
+		f.write(w, map[string]any{"results": results})
+	case r.Method == http.MethodPost && path == "/create-note":
+		var body map[string]string
+		f.decode(r, &body)
+		f.nextID++
+		note := f.add(body["parentNoteId"], fmt.Sprintf("new%d", f.nextID), body["title"], body["type"], body["content"])
+		f.write(w, map[string]any{"note": note})
+	case r.Method == http.MethodPost && path == "/attributes":
+		var attr triliumAttribute
+		f.decode(r, &attr)
+		f.nextID++
+		attr.AttributeID = fmt.Sprintf("attr%d", f.nextID)
+		note := f.notes[attr.NoteID]
+		note.Attributes = append(note.Attributes, attr)
+		f.write(w, attr)
+	case r.Method == http.MethodDelete && len(segments) == 2 && segments[0] == "attributes":
+		for _, note := range f.notes {
+			for i, attr := range note.Attributes {
+				if attr.AttributeID == segments[1] {
+					note.Attributes = append(note.Attributes[:i], note.Attributes[i+1:]...)
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	case len(segments) >= 2 && segments[0] == "notes":
+		note, ok := f.notes[segments[1]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			f.write(w, map[string]string{"message": "Note not found"})
+			return
+		}
+		switch {
+		case len(segments) == 2 && r.Method == http.MethodGet:
+			f.write(w, note)
+		case len(segments) == 2 && r.Method == http.MethodPatch:
+			var body map[string]string
+			f.decode(r, &body)
+			note.Title = body["title"]
+			f.write(w, note)
+		case segments[2] == "content" && r.Method == http.MethodGet:
+			_, _ = io.WriteString(w, f.contents[note.NoteID])
+		case segments[2] == "content" && r.Method == http.MethodPut:
+			raw, _ := io.ReadAll(r.Body)
+			f.contents[note.NoteID] = string(raw)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			f.t.Fatalf("unexpected Trilium request: %s %s", r.Method, r.URL.Path)
+		}
+	default:
+		f.t.Fatalf("unexpected Trilium request: %s %s", r.Method, r.URL.Path)
+	}
+}
+
+func (f *fakeTrilium) write(w http.ResponseWriter, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		f.t.Fatalf("encode Trilium response: %v", err)
+	}
+}
+
+func (f *fakeTrilium) decode(r *http.Request, out any) {
+	if err := json.NewDecoder(r.Body).Decode(out); err != nil {
+		f.t.Fatalf("decode Trilium request: %v", err)
+	}
+}
+
+func (f *fakeTrilium) labels(id string) []string {
+	var names []string
+	for _, attr := range f.notes[id].Attributes {
+		names = append(names, attr.Name)
+	}
+	return names
+}
+
+func TestTriliumImportNotesWalksTreeAndReadsLabels(t *testing.T) {
+	fake := newFakeTrilium(t)
+	fake.add("root", "projects", "Projects", "text", "<p>Folder note</p>")
+	fake.add("projects", "plan", "Plan", "text", "<h2>Goals</h2><ul><li>Ship <strong>it</strong></li></ul>", "urgent", "archived", "priority=high")
+	fake.add("projects", "secret", "Secret", "text", "<p>hidden</p>").IsProtected = true
+	fake.add("projects", "script", "Script", "code", "console.log(1)")
+	fake.add("root", "_hidden", "Hidden", "text", "<p>system</p>")
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	provider := newTriliumProvider(server.URL, "etapi-token", server.Client())
+	if err := provider.Test(context.Background()); err != nil {
+		t.Fatalf("test connection: %v", err)
+	}
+	targets, err := provider.ListTargets(context.Background())
+	if err != nil {
+		t.Fatalf("list targets: %v", err)
+	}
+	if len(targets) != 2 || targets[0].ID != "root" || targets[1].ID != "projects" || targets[1].ParentID != "root" {
+		t.Fatalf("targets = %#v, want root and projects", targets)
+	}
+
+	notes, err := provider.ImportNotes(context.Background(), "", 10)
+	if err != nil {
+		t.Fatalf("import notes: %v", err)
+	}
+	if len(notes) != 2 {
+		t.Fatalf("notes = %#v, want projects and plan only", notes)
+	}
+	plan := notes[1]
+	if plan.ExternalID != "plan" || plan.Path != "Projects/Plan" || plan.TargetID != "root" {
+		t.Fatalf("plan = %#v, want path Projects/Plan under root", plan)
+	}
+	if plan.Content != "# Goals\n\n- Ship **it**" {
+		t.Fatalf("content = %q, want Markdown converted from HTML", plan.Content)
+	}
+	if strings.Join(plan.Tags, ",") != "urgent" {
+		t.Fatalf("tags = %#v, want urgent only", plan.Tags)
+	}
+	if plan.UpdatedAt == nil || plan.UpdatedAt.Format("2006-01-02 15:04:05") != "2025-01-03 03:04:05" {
+		t.Fatalf("updated_at = %v, want parsed Trilium date", plan.UpdatedAt)
+	}
+	if plan.URL != server.URL+"/#root/plan" {
+		t.Fatalf("url = %q, want note link", plan.URL)
+	}
+}
+
+func TestTriliumPushNoteCreatesFolderChainAndSyncsLabels(t *testing.T) {
+	fake := newFakeTrilium(t)
+	fake.add("root", "projects", "Projects", "text", "")
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	provider := newTriliumProvider(server.URL, "etapi-token", server.Client())
+
+	result, err := provider.PushNote(context.Background(), PushInput{
+		Title:      "Plan",
+		Content:    "# Goals\n\n- [x] Done\n",
+		FolderPath: []string{"projects", "Q3"},
+		Tags:       []string{"work", "to do"},
+	})
+	if err != nil {
+		t.Fatalf("push note: %v", err)
+	}
+	created := fake.notes[result.ExternalID]
+	if created == nil || created.Title != "Plan" {
+		t.Fatalf("created note = %#v, want Plan", created)
+	}
+	folder := fake.notes[result.TargetID]
+	if folder == nil || folder.Title != "Q3" || folder.ParentNoteIDs[0] != "projects" {
+		t.Fatalf("folder = %#v, want Q3 created under the existing Projects note", folder)
+	}
+	if !strings.Contains(fake.contents[result.ExternalID], "<h2>Goals</h2>") || !strings.Contains(fake.contents[result.ExternalID], `checked="checked"`) {
+		t.Fatalf("content = %q, want HTML with heading and checked to-do", fake.contents[result.ExternalID])
+	}
+	if got := strings.Join(fake.labels(result.ExternalID), ","); got != "to_do,work" {
+		t.Fatalf("labels = %q, want to_do,work", got)
+	}
+
+	_, err = provider.PushNote(context.Background(), PushInput{
+		Title:              "Plan v2",
+		Content:            "Updated",
+		ExistingExternalID: result.ExternalID,
+		Tags:               []string{"work"},
+	})
+	if err != nil {
+		t.Fatalf("update note: %v", err)
+	}
+	if created.Title != "Plan v2" || fake.contents[result.ExternalID] != "<p>Updated</p>" {
+		t.Fatalf("updated note = %q %q, want new title and content", created.Title, fake.contents[result.ExternalID])
+	}
+	if got := strings.Join(fake.labels(result.ExternalID), ","); got != "work" {
+		t.Fatalf("labels = %q, want stale label removed", got)
+	}
+}
+
+func TestTriliumGetNoteReportsMissingNote(t *testing.T) {
+	server := httptest.NewServer(newFakeTrilium(t))
+	defer server.Close()
+	provider := newTriliumProvider(server.URL, "etapi-token", server.Client())
+
+	_, err := provider.GetNote(context.Background(), "gone")
+	if !errors.Is(err, ErrRemoteNoteNotFound) {
+		t.Fatalf("error = %v, want ErrRemoteNoteNotFound", err)
+	}
+}
@@ -0,0 +1,662 @@
+package connections
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"smarticky/internal/notes"
+	"smarticky/internal/storage"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	maxVaultNoteSize = 5 << 20
+	maxVaultTargets  = 2000
+	// vaultAttachmentDir is where pushed attachments are written, relative
+	// to the vault root.
+	vaultAttachmentDir = "attachments"
+)
+
+var ErrVaultPathNotAllowed = errors.New("vault folder is outside the allowed vault roots")
+
+var (
+	// vaultEmbedPattern matches Obsidian embeds such as ![[photo.png]],
+	// ![[photo.png|300]] and ![[Note#Heading]].
+	vaultEmbedPattern = regexp.MustCompile(`!\[\[([^\]\n]+)\]\]`)
+	// vaultLinkPattern matches Markdown images and links, which Logseq uses
+	// for files in its assets folder.
+	vaultLinkPattern = regexp.MustCompile(`!?\[[^\]\n]*\]\(([^)\s]+)(?:\s+"[^"\n]*")?\)`)
+	// vaultFileNameReplacer drops characters that are invalid in file names
+	// on some systems or that break wiki links.
+	vaultFileNameReplacer = strings.NewReplacer(
+		"/", "-", "\\", "-", ":", "-", "*", "-", "?", "-", "\"", "-",
+		"<", "-", ">", "-", "|", "-", "#", "-", "^", "-", "[", "(", "]", ")",
+	)
+)
+
+// vaultProvider treats a folder of Markdown files, such as an Obsidian or
+// Logseq vault, as a remote. Folders are targets and the path of a file
+// relative to the vault is its external id.
+type vaultProvider struct {
+	root  string
+	index vaultFileIndex
+}
+
+// vaultFileIndex maps lower-cased file names to vault-relative paths so
+// that embeds given by name alone can be found. It is built on first use.
+type vaultFileIndex struct {
+	built  bool
+	byName map[string][]string
+}
+
+func newVaultProvider(root string) Provider {
+	return &vaultProvider{root: root}
+}
+
+// defaultVaultRoots lists the folders vault accounts may point into: the
+// SMARTICKY_VAULT_ROOTS path list, or the vaults folder of the data dir.
+func defaultVaultRoots(fs *storage.FileSystem) []string {
+	var roots []string
+	for _, root := range filepath.SplitList(os.Getenv("SMARTICKY_VAULT_ROOTS")) {
+		if root = strings.TrimSpace(root); root == "" {
+			continue
+		}
+		if abs, err := filepath.Abs(root); err == nil {
+			roots = append(roots, abs)
+		}
+	}
+	if len(roots) > 0 {
+		return roots
+	}
+	if abs, err := filepath.Abs(filepath.Join(fs.GetDataDir(), "vaults")); err == nil {
+		return []string{abs}
+	}
+	return nil
+}
+
+func (s *Service) checkVaultPath(dir string) error {
+	for _, root := range s.vaultRoots {
+		if pathWithin(root, dir) {
+			return nil
+		}
+	}
+	return ErrVaultPathNotAllowed
+}
+
+// pathWithin reports whether target is root or below it once symlinks are
+// resolved.
+func pathWithin(root, target string) bool {
+	rel, err := filepath.Rel(resolvedPath(root), resolvedPath(target))
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func resolvedPath(value string) string {
+	if resolved, err := filepath.EvalSymlinks(value); err == nil {
+		return resolved
+	}
+	return filepath.Clean(value)
+}
+
+func (p *vaultProvider) Test(ctx context.Context) error {
+	info, err := os.Stat(p.root)
+	if err != nil {
+		return fmt.Errorf("open vault: %w", err)
+	}
+	if !info.IsDir() {
+		return errors.New("vault path is not a folder")
+	}
+	_, err = os.ReadDir(p.root)
+	return err
+}
+
+func (p *vaultProvider) ListTargets(ctx context.Context) ([]Target, error) {
+	var targets []Target
+	err := filepath.WalkDir(p.root, func(full string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !entry.IsDir() || full == p.root {
+			return nil
+		}
+		rel := p.relative(full)
+		if skipVaultDir(rel) {
+			return fs.SkipDir
+		}
+		parent := path.Dir(rel)
+		if parent == "." {
+			parent = ""
+		}
+		targets = append(targets, Target{
+			ID:       rel,
+			Name:     entry.Name(),
+			Kind:     "folder",
+			ParentID: parent,
+		})
+		if len(targets) >= maxVaultTargets {
+			return fs.SkipAll
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+func (p *vaultProvider) ImportNotes(ctx context.Context, targetID string, limit int) ([]RemoteNote, error) {
+	limit = clampLimit(limit)
+	start, err := p.resolve(targetID)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	err = filepath.WalkDir(start, func(full string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rel := p.relative(full)
+		if entry.IsDir() {
+			if full != start && skipVaultDir(rel) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		// Symlinks are not followed, so a link cannot pull in files from
+		// outside the vault.
+		if entry.Type().IsRegular() && isVaultNote(rel) {
+			paths = append(paths, rel)
+		}
+		if len(paths) >= limit {
+			return fs.SkipAll
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]RemoteNote, 0, len(paths))
+	for _, rel := range paths {
+		remote, err := p.readNote(rel)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", rel, err)
+		}
+		out = append(out, remote)
+	}
+	return out, nil
+}
+
+func (p *vaultProvider) GetNote(ctx context.Context, externalID string) (RemoteNote, error) {
+	if !isVaultNote(externalID) {
+		return RemoteNote{}, ErrRemoteNoteNotFound
+	}
+	return p.readNote(externalID)
+}
+
+func (p *vaultProvider) PushNote(ctx context.Context, input PushInput) (PushResult, error) {
+	var full, existing string
+	if input.ExistingExternalID != "" && isVaultNote(input.ExistingExternalID) {
+		resolved, err := p.resolve(input.ExistingExternalID)
+		if err != nil {
+			return PushResult{}, err
+		}
+		full = resolved
+		if data, err := os.ReadFile(full); err == nil {
+			existing = string(data)
+		} else if !os.IsNotExist(err) {
+			return PushResult{}, err
+		}
+	} else {
+		dir, err := p.resolve(input.TargetID)
+		if err != nil {
+			return PushResult{}, err
+		}
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			return PushResult{}, ErrMissingTarget
+		}
+		full = availableVaultPath(dir, vaultFileName(input.Title))
+	}
+
+	stem := strings.TrimSuffix(filepath.Base(full), filepath.Ext(full))
+	content, err := vaultMarkdown(existing, stem, input)
+	if err != nil {
+		return PushResult{}, err
+	}
+	if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+		return PushResult{}, err
+	}
+	if err := writeFileAtomic(full, []byte(content)); err != nil {
+		return PushResult{}, err
+	}
+
+	rel := p.relative(full)
+	targetID := path.Dir(rel)
+	if targetID == "." {
+		targetID = ""
+	}
+	return PushResult{
+		ExternalID: rel,
+		TargetID:   targetID,
+		Path:       rel,
+	}, nil
+}
+
+func (p *vaultProvider) readNote(rel string) (RemoteNote, error) {
+	full, err := p.resolve(rel)
+	if err != nil {
+		return RemoteNote{}, err
+	}
+	info, err := os.Stat(full)
+	if os.IsNotExist(err) || (err == nil && !info.Mode().IsRegular()) {
+		return RemoteNote{}, ErrRemoteNoteNotFound
+	}
+	if err != nil {
+		return RemoteNote{}, err
+	}
+	if info.Size() > maxVaultNoteSize {
+		return RemoteNote{}, fmt.Errorf("note file is larger than %d bytes", maxVaultNoteSize)
+	}
+	data, err := os.ReadFile(full)
+	if err != nil {
+		return RemoteNote{}, err
+	}
+
+	rel = p.relative(full)
+	fm, body, _ := notes.ParseFrontMatter(string(data))
+	title := fm.Title
+	if title == "" {
+		title = strings.TrimSuffix(path.Base(rel), path.Ext(rel))
+	}
+	// Editors rarely touch the updated key, so the file time is what shows
+	// that a note changed.
+	updated := info.ModTime()
+	if fm.Updated != nil && fm.Updated.After(updated) {
+		updated = *fm.Updated
+	}
+	dir := path.Dir(rel)
+	if dir == "." {
+		dir = ""
+	}
+	targetName := ""
+	if dir != "" {
+		targetName = strings.SplitN(dir, "/", 2)[0]
+	}
+	return RemoteNote{
+		ExternalID: rel,
+		TargetID:   dir,
+		TargetName: targetName,
+		Path:       rel,
+		Title:      titleOrUntitled(title),
+		Content:    body,
+		Tags:       fm.Tags,
+		Aliases:    fm.Aliases,
+		CreatedAt:  fm.Created,
+		UpdatedAt:  &updated,
+	}, nil
+}
+
+// ListAttachments finds the vault files a note embeds or links to. Embeds of
+// other notes, remote URLs and files that cannot be found are left as they
+// are.
+func (p *vaultProvider) ListAttachments(ctx context.Context, remote RemoteNote) ([]AttachmentRef, error) {
+	noteDir := path.Dir(remote.ExternalID)
+	if noteDir == "." {
+		noteDir = ""
+	}
+	var refs []AttachmentRef
+	seen := map[string]bool{}
+	add := func(embed, rel string) {
+		if seen[embed] || rel == "" || isVaultNote(rel) {
+			return
+		}
+		seen[embed] = true
+		refs = append(refs, AttachmentRef{ID: rel, Name: path.Base(rel), Embed: embed})
+	}
+
+	for _, match := range vaultEmbedPattern.FindAllStringSubmatch(remote.Content, -1) {
+		name := match[1]
+		if cut := strings.IndexAny(name, "|#"); cut >= 0 {
+			name = name[:cut]
+		}
+		add(match[0], p.findEmbed(strings.TrimSpace(name), noteDir))
+	}
+	for _, match := range vaultLinkPattern.FindAllStringSubmatch(remote.Content, -1) {
+		target := match[1]
+		if strings.Contains(target, ":") || strings.HasPrefix(target, "/") || strings.HasPrefix(target, "#") {
+			continue
+		}
+		if unescaped, err := url.PathUnescape(target); err == nil {
+			target = unescaped
+		}
+		add(match[0], p.findEmbed(target, noteDir))
+	}
+	return refs, nil
+}
+
+func (p *vaultProvider) DownloadAttachment(ctx context.Context, ref AttachmentRef) (RemoteAttachment, error) {
+	full, err := p.resolve(ref.ID)
+	if err != nil {
+		return RemoteAttachment{}, err
+	}
+	info, err := os.Stat(full)
+	if err != nil {
+		return RemoteAttachment{}, err
+	}
+	if !info.Mode().IsRegular() || info.Size() > maxRemoteAttachmentSize {
+		return RemoteAttachment{}, fmt.Errorf("%s is not a file of at most %d bytes", ref.ID, maxRemoteAttachmentSize)
+	}
+	data, err := os.ReadFile(full)
+	if err != nil {
+		return RemoteAttachment{}, err
+	}
+	return RemoteAttachment{
+		Name:     path.Base(ref.ID),
+		MIMEType: vaultMIMEType(ref.ID, data),
+		Data:     data,
+		Embed:    ref.Embed,
+		Link:     (&url.URL{Path: ref.ID}).EscapedPath(),
+	}, nil
+}
+
+func (p *vaultProvider) UploadAttachment(ctx context.Context, file LocalAttachment) (string, error) {
+	rel, err := p.storeAttachment(file)
+	if err != nil {
+		return "", err
+	}
+	return (&url.URL{Path: rel}).EscapedPath(), nil
+}
+
+// storeAttachment writes a pushed file into the attachments folder and
+// returns its vault-relative path. A file with the same name and content is
+// reused rather than copied again.
+func (p *vaultProvider) storeAttachment(file LocalAttachment) (string, error) {
+	dir, err := p.resolve(vaultAttachmentDir)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	ext := path.Ext(file.Name)
+	stem := vaultFileName(strings.TrimSuffix(file.Name, ext))
+	ext = vaultFileNameReplacer.Replace(ext)
+	full := filepath.Join(dir, stem+ext)
+	for n := 2; ; n++ {
+		existing, err := os.ReadFile(full)
+		if os.IsNotExist(err) {
+			break
+		}
+		if err == nil && bytes.Equal(existing, file.Data) {
+			return p.relative(full), nil
+		}
+		full = filepath.Join(dir, fmt.Sprintf("%s %d%s", stem, n, ext))
+	}
+	if err := writeFileAtomic(full, file.Data); err != nil {
+		return "", err
+	}
+	return p.relative(full), nil
+}
+
+// findEmbed resolves an embed the way Obsidian does: a path relative to
+// the vault or the note, otherwise the file with that name closest to the
+// vault root.
+func (p *vaultProvider) findEmbed(name, noteDir string) string {
+	if name == "" {
+		return ""
+	}
+	name = filepath.ToSlash(name)
+	for _, candidate := range []string{path.Join(noteDir, name), name} {
+		if full, err := p.resolve(candidate); err == nil {
+			if info, err := os.Stat(full); err == nil && info.Mode().IsRegular() {
+				return p.relative(full)
+			}
+		}
+	}
+	if strings.Contains(name, "/") {
+		return ""
+	}
+	if !p.index.built {
+		p.index.byName = p.indexFiles()
+		p.index.built = true
+	}
+	if matches := p.index.byName[strings.ToLower(name)]; len(matches) > 0 {
+		return matches[0]
+	}
+	return ""
+}
+
+func (p *vaultProvider) indexFiles() map[string][]string {
+	byName := map[string][]string{}
+	_ = filepath.WalkDir(p.root, func(full string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		rel := p.relative(full)
+		if entry.IsDir() {
+			if full != p.root && skipVaultDir(rel) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if entry.Type().IsRegular() && !isVaultNote(rel) {
+			key := strings.ToLower(entry.Name())
+			byName[key] = append(byName[key], rel)
+		}
+		return nil
+	})
+	for _, paths := range byName {
+		sort.SliceStable(paths, func(i, j int) bool {
+			return strings.Count(paths[i], "/") < strings.Count(paths[j], "/")
+		})
+	}
+	return byName
+}
+
+// resolve maps a vault-relative path to a file system path. Paths cannot
+// climb out of the vault, either with .. or through symlinks.
+func (p *vaultProvider) resolve(rel string) (string, error) {
+	clean := strings.TrimPrefix(path.Clean("/"+filepath.ToSlash(strings.TrimSpace(rel))), "/")
+	full := filepath.Join(p.root, filepath.FromSlash(clean))
+	if _, err := os.Lstat(full); err == nil && !pathWithin(p.root, full) {
+		return "", ErrVaultPathNotAllowed
+	}
+	return full, nil
+}
+
+func (p *vaultProvider) relative(full string) string {
+	rel, err := filepath.Rel(p.root, full)
+	if err != nil || rel == "." {
+		return ""
+	}
+	return filepath.ToSlash(rel)
+}
+
+// skipVaultDir reports folders that hold editor state rather than notes:
+// hidden folders such as .obsidian and .trash, and Logseq's config folder.
+func skipVaultDir(rel string) bool {
+	name := path.Base(rel)
+	return strings.HasPrefix(name, ".") || rel == "logseq"
+}
+
+func isVaultNote(rel string) bool {
+	switch strings.ToLower(path.Ext(rel)) {
+	case ".md", ".markdown":
+		return true
+	default:
+		return false
+	}
+}
+
+func vaultMIMEType(name string, data []byte) string {
+	if value := mime.TypeByExtension(strings.ToLower(path.Ext(name))); value != "" {
+		if mediaType, _, err := mime.ParseMediaType(value); err == nil {
+			return mediaType
+		}
+	}
+	mediaType, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	return mediaType
+}
+
+func vaultFileName(title string) string {
+	name := strings.Join(strings.Fields(vaultFileNameReplacer.Replace(title)), " ")
+	name = strings.Trim(name, ". ")
+	if runes := []rune(name); len(runes) > 120 {
+		name = strings.TrimSpace(string(runes[:120]))
+	}
+	if name == "" {
+		return "Untitled"
+	}
+	return name
+}
+
+// availableVaultPath picks a file name in dir that is not taken yet,
+// numbering it the way Obsidian does for duplicates.
+func availableVaultPath(dir, name string) string {
+	candidate := filepath.Join(dir, name+".md")
+	for n := 2; ; n++ {
+		if _, err := os.Lstat(candidate); os.IsNotExist(err) {
+			return candidate
+		}
+		candidate = filepath.Join(dir, fmt.Sprintf("%s %d.md", name, n))
+	}
+}
+
+// vaultMarkdown renders a pushed note with its metadata as front matter.
+// Keys the vault already had and that Smarticky does not manage are kept.
+func vaultMarkdown(existing, stem string, input PushInput) (string, error) {
+	doc := &yaml.Node{Kind: yaml.MappingNode}
+	if block, ok := frontMatterBlock(existing); ok {
+		var parsed yaml.Node
+		if err := yaml.Unmarshal([]byte(block), &parsed); err == nil && len(parsed.Content) == 1 && parsed.Content[0].Kind == yaml.MappingNode {
+			doc = parsed.Content[0]
+		}
+	}
+
+	title := titleOrUntitled(input.Title)
+	if yamlKeyIndex(doc, "title") >= 0 || title != stem {
+		setYAMLKey(doc, "title", yamlString(title))
+	}
+	deleteYAMLKey(doc, "alias")
+	if len(input.Aliases) > 0 {
+		setYAMLKey(doc, "aliases", yamlStrings(input.Aliases))
+	} else {
+		deleteYAMLKey(doc, "aliases")
+	}
+	deleteYAMLKey(doc, "tag")
+	if len(input.Tags) > 0 {
+		setYAMLKey(doc, "tags", yamlStrings(input.Tags))
+	} else {
+		deleteYAMLKey(doc, "tags")
+	}
+	if !input.CreatedAt.IsZero() {
+		setYAMLKey(doc, "created", yamlString(input.CreatedAt.Format(time.RFC3339)))
+	}
+	if !input.UpdatedAt.IsZero() {
+		setYAMLKey(doc, "updated", yamlString(input.UpdatedAt.Format(time.RFC3339)))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	if len(doc.Content) > 0 {
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(doc); err != nil {
+			return "", err
+		}
+		if err := encoder.Close(); err != nil {
+			return "", err
+		}
+	}
+	buf.WriteString("---\n")
+	buf.WriteString(input.Content)
+	if !strings.HasSuffix(input.Content, "\n") {
+		buf.WriteString("\n")
+	}
+	return buf.String(), nil
+}
+
+// frontMatterBlock returns the YAML between the leading --- lines when the
+// content has front matter that notes.ParseFrontMatter accepts.
+func frontMatterBlock(content string) (string, bool) {
+	if _, _, ok := notes.ParseFrontMatter(content); !ok {
+		return "", false
+	}
+	rest := strings.TrimPrefix(strings.ReplaceAll(content, "\r\n", "\n"), "---\n")
+	return rest[:strings.Index(rest, "\n---")], true
+}
+
+func yamlKeyIndex(doc *yaml.Node, key string) int {
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		if doc.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func setYAMLKey(doc *yaml.Node, key string, value *yaml.Node) {
+	if i := yamlKeyIndex(doc, key); i >= 0 {
+		doc.Content[i+1] = value
+		return
+	}
+	doc.Content = append(doc.Content, yamlString(key), value)
+}
+
+func deleteYAMLKey(doc *yaml.Node, key string) {
+	if i := yamlKeyIndex(doc, key); i >= 0 {
+		doc.Content = append(doc.Content[:i], doc.Content[i+2:]...)
+	}
+}
+
+func yamlString(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+func yamlStrings(values []string) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.SequenceNode}
+	for _, value := range values {
+		node.Content = append(node.Content, yamlString(value))
+	}
+	return node
+}
+
+// writeFileAtomic replaces the file in one step so that an editor watching
+// the vault never sees a half-written note.
+func writeFileAtomic(full string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(full), ".smarticky-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), full)
+}
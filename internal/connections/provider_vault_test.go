@@ -0,0 +1,279 @@
+package connections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"smarticky/ent/enttest"
+	"smarticky/ent/note"
+	"smarticky/internal/storage"
+
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestVaultProviderImportsAndPushesMarkdown(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestVaultProviderImportsAndPushesMarkdown?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetUsername("owner").
+		SetPasswordHash("hash").
+		SaveX(ctx)
+	roots := t.TempDir()
+	vault := filepath.Join(roots, "Brain")
+	writeVaultFile(t, vault, "Projects/Plan.md", `---
+tags: [work, "#plan"]
+aliases:
+  - Roadmap
+created: 2024-01-02
+cssclasses: wide
+---
+# Plan
+
+![[diagram.png|300]]
+See ![[spec.pdf]] and ![[Other note]].
+`)
+	writeVaultFile(t, vault, "attachments/diagram.png", "\x89PNG\r\n\x1a\nimage")
+	writeVaultFile(t, vault, "Projects/spec.pdf", "%PDF-1.4")
+	writeVaultFile(t, vault, "Inbox.md", "Loose thought")
+	writeVaultFile(t, vault, ".obsidian/workspace.md", "editor state")
+	writeVaultFile(t, roots, "secret.md", "outside the vault")
+	if err := os.Symlink(filepath.Join(roots, "secret.md"), filepath.Join(vault, "escape.md")); err != nil {
+		t.Fatalf("symlink: %v", err)
+	}
+
+	service := NewService(client, testSecretBox(t), storage.NewMemoryFileSystem())
+	service.vaultRoots = []string{vault}
+	if _, err := service.CreateAccount(ctx, u.ID, AccountInput{Provider: ProviderVault, Endpoint: roots, Enabled: true}); !errors.Is(err, ErrVaultPathNotAllowed) {
+		t.Fatalf("create outside roots error = %v, want ErrVaultPathNotAllowed", err)
+	}
+	if _, err := service.CreateAccount(ctx, u.ID, AccountInput{Provider: ProviderVault, Endpoint: "Brain", Enabled: true}); err == nil {
+		t.Fatal("expected a relative vault path to be rejected")
+	}
+	account, err := service.CreateAccount(ctx, u.ID, AccountInput{Provider: ProviderVault, Endpoint: vault, Enabled: true})
+	if err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+	if !account.HasCredentials || account.AuthType != "none" || account.Name != "Markdown Vault" {
+		t.Fatalf("unexpected vault account: %+v", account)
+	}
+	if _, err := service.TestAccount(ctx, u.ID, account.ID, nil); err != nil {
+		t.Fatalf("test account: %v", err)
+	}
+
+	targets, err := service.ListTargets(ctx, u.ID, account.ID)
+	if err != nil {
+		t.Fatalf("list targets: %v", err)
+	}
+	targetIDs := map[string]bool{}
+	for _, target := range targets {
+		targetIDs[target.ID] = true
+	}
+	if !targetIDs["Projects"] || !targetIDs["attachments"] || targetIDs[".obsidian"] {
+		t.Fatalf("unexpected targets: %+v", targets)
+	}
+
+	result, err := service.ImportNotes(ctx, u.ID, account.ID, ImportRequest{})
+	if err != nil {
+		t.Fatalf("import notes: %v", err)
+	}
+	if result.ImportedCount != 2 || result.FailedCount != 0 {
+		t.Fatalf("expected the two vault notes to import, got %+v", result)
+	}
+	plan := client.Note.Query().Where(note.TitleEQ("Plan")).WithFolder().WithTags().OnlyX(ctx)
+	if plan.Edges.Folder == nil || plan.Edges.Folder.Name != "Projects" {
+		t.Fatalf("expected the note in the Projects folder, got %+v", plan.Edges.Folder)
+	}
+	if len(plan.Edges.Tags) != 2 || len(plan.Aliases) != 1 || plan.Aliases[0] != "Roadmap" {
+		t.Fatalf("front matter was not imported: tags %+v aliases %v", plan.Edges.Tags, plan.Aliases)
+	}
+	if plan.CreatedAt.Year() != 2024 || strings.HasPrefix(plan.Content, "---") {
+		t.Fatalf("unexpected created time %s or content %q", plan.CreatedAt, plan.Content)
+	}
+	attachments := plan.QueryAttachments().AllX(ctx)
+	if len(attachments) != 2 {
+		t.Fatalf("expected two embedded files as attachments, got %d", len(attachments))
+	}
+	for _, row := range attachments {
+		if row.Filename == "diagram.png" && row.MimeType != "image/png" {
+			t.Fatalf("unexpected image mime type %q", row.MimeType)
+		}
+	}
+	if !strings.Contains(plan.Content, "![diagram.png](/api/attachments/") ||
+		!strings.Contains(plan.Content, "[spec.pdf](/api/attachments/") ||
+		!strings.Contains(plan.Content, "![[Other note]]") {
+		t.Fatalf("embeds were not rewritten: %q", plan.Content)
+	}
+	if _, err := getVaultNote(t, service, vault, "escape.md"); !errors.Is(err, ErrVaultPathNotAllowed) {
+		t.Fatalf("symlinked note error = %v, want ErrVaultPathNotAllowed", err)
+	}
+	if _, err := getVaultNote(t, service, vault, "../secret.md"); !errors.Is(err, ErrRemoteNoteNotFound) {
+		t.Fatalf("parent path error = %v, want ErrRemoteNoteNotFound", err)
+	}
+
+	// New notes are written as Markdown files with front matter.
+	idea := client.Note.Create().
+		SetTitle("New: Idea").
+		SetContent("Something worth keeping").
+		SetAliases([]string{"Idea"}).
+		SetUserID(u.ID).
+		SaveX(ctx)
+	tagRow := client.Tag.Create().SetName("draft").SetUserID(u.ID).SaveX(ctx)
+	idea.Update().AddTags(tagRow).ExecX(ctx)
+	pushed, err := service.PushNote(ctx, u.ID, account.ID, idea.ID, "Projects")
+	if err != nil {
+		t.Fatalf("push new note: %v", err)
+	}
+	if pushed.Result.ExternalID != "Projects/New- Idea.md" {
+		t.Fatalf("unexpected pushed path %q", pushed.Result.ExternalID)
+	}
+	written := readVaultFile(t, vault, pushed.Result.ExternalID)
+	for _, want := range []string{"title: 'New: Idea'", "aliases:\n  - Idea", "tags:\n  - draft", "updated: ", "---\nSomething worth keeping\n"} {
+		if !strings.Contains(written, want) {
+			t.Fatalf("pushed file is missing %q:\n%s", want, written)
+		}
+	}
+
+	// Pushing an imported note rewrites its file and keeps unknown keys.
+	plan.Update().SetContent("Plan v2").ExecX(ctx)
+	if _, err := service.PushNote(ctx, u.ID, account.ID, plan.ID, ""); err != nil {
+		t.Fatalf("push imported note: %v", err)
+	}
+	written = readVaultFile(t, vault, "Projects/Plan.md")
+	if !strings.Contains(written, "cssclasses: wide") || !strings.Contains(written, "\nPlan v2\n") || strings.Contains(written, "title:") {
+		t.Fatalf("unexpected rewritten note:\n%s", written)
+	}
+
+	// Edits made in the vault are pulled by a sync.
+	writeVaultFile(t, vault, "Inbox.md", "Loose thought, refined")
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(vault, "Inbox.md"), later, later); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+	synced, err := runQueuedSync(ctx, service, u.ID, account.ID)
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if synced.PulledCount != 1 {
+		t.Fatalf("expected the vault edit to be pulled, got %+v", synced)
+	}
+	if got := client.Note.Query().Where(note.TitleEQ("Inbox")).OnlyX(ctx).Content; got != "Loose thought, refined" {
+		t.Fatalf("unexpected pulled content %q", got)
+	}
+}
+
+func TestVaultPushCopiesAttachments(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestVaultPushCopiesAttachments?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetUsername("owner").
+		SetPasswordHash("hash").
+		SaveX(ctx)
+	vault := t.TempDir()
+	writeVaultFile(t, vault, "attachments/chart.png", "someone else's chart")
+	fs := storage.NewMemoryFileSystem()
+	service := NewService(client, testSecretBox(t), fs)
+	service.vaultRoots = []string{vault}
+	account, err := service.CreateAccount(ctx, u.ID, AccountInput{Provider: ProviderVault, Endpoint: vault, Enabled: true})
+	if err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+
+	report := client.Note.Create().SetTitle("Report").SetContent("draft").SetUserID(u.ID).SaveX(ctx)
+	filePath := filepath.Join(fs.GetUploadsDir("attachments"), "upload.png")
+	if err := fs.WriteFile(filePath, []byte("our chart"), 0644); err != nil {
+		t.Fatalf("write attachment: %v", err)
+	}
+	chart := client.Attachment.Create().
+		SetFilename("chart.png").
+		SetFilePath(filePath).
+		SetFileSize(9).
+		SetMimeType("image/png").
+		SetNote(report).
+		SetUserID(u.ID).
+		SaveX(ctx)
+	content := fmt.Sprintf("Results\n\n![chart](/api/attachments/%d/download)", chart.ID)
+	report.Update().SetContent(content).ExecX(ctx)
+	pushed, err := service.PushNote(ctx, u.ID, account.ID, report.ID, "")
+	if err != nil {
+		t.Fatalf("push note: %v", err)
+	}
+	if got := readVaultFile(t, vault, "attachments/chart 2.png"); got != "our chart" {
+		t.Fatalf("unexpected copied attachment %q", got)
+	}
+	if written := readVaultFile(t, vault, pushed.Result.ExternalID); !strings.Contains(written, "![chart](attachments/chart%202.png)") {
+		t.Fatalf("link was not rewritten:\n%s", written)
+	}
+
+	// The copy is found again when the note is read back.
+	remote, err := getVaultNote(t, service, vault, pushed.Result.ExternalID)
+	if err != nil {
+		t.Fatalf("get note: %v", err)
+	}
+	provider, _ := service.newProvider(ProviderVault, vault, Credentials{})
+	refs, err := provider.ListAttachments(ctx, remote)
+	if err != nil || len(refs) != 1 || refs[0].ID != "attachments/chart 2.png" {
+		t.Fatalf("refs = %+v, %v", refs, err)
+	}
+
+	// Pushing again does not copy the file a second time.
+	report.Update().SetContent(content + "\n\nMore").ExecX(ctx)
+	if _, err := service.PushNote(ctx, u.ID, account.ID, report.ID, ""); err != nil {
+		t.Fatalf("push again: %v", err)
+	}
+	entries, err := os.ReadDir(filepath.Join(vault, "attachments"))
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("expected two files in attachments, got %d (%v)", len(entries), err)
+	}
+}
+
+func TestVaultFileNameAvoidsUnsafeCharacters(t *testing.T) {
+	for title, want := range map[string]string{
+		"Meeting: 2024/01/02": "Meeting- 2024-01-02",
+		"[[Link]] #tag":       "((Link)) -tag",
+		" .hidden. ":          "hidden",
+		"":                    "Untitled",
+	} {
+		if got := vaultFileName(title); got != want {
+			t.Fatalf("vaultFileName(%q) = %q, want %q", title, got, want)
+		}
+	}
+}
+
+func getVaultNote(t *testing.T, s *Service, root, rel string) (RemoteNote, error) {
+	t.Helper()
+	provider, err := s.newProvider(ProviderVault, root, Credentials{})
+	if err != nil {
+		t.Fatalf("open vault: %v", err)
+	}
+	return provider.GetNote(context.Background(), rel)
+}
+
+func writeVaultFile(t *testing.T, root, rel, content string) {
+	t.Helper()
+	full := filepath.Join(root, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(full, []byte(content), 0644); err != nil {
+		t.Fatalf("write %s: %v", rel, err)
+	}
+}
+
+func readVaultFile(t *testing.T, root, rel string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(rel)))
+	if err != nil {
+		t.Fatalf("read %s: %v", rel, err)
+	}
+	return string(data)
+}
@@ -0,0 +1,413 @@
+package connections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/noteconnectionitemmap"
+	"smarticky/ent/noteconnectionjob"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultQueueWorkers = 2
+	defaultJobAttempts  = 3
+	jobRetryBaseDelay   = 30 * time.Second
+	jobRetryMaxDelay    = 30 * time.Minute
+	jobPollInterval     = 5 * time.Second
+)
+
+var (
+	ErrJobFinished = errors.New("note connection job has already finished")
+)
+
+// QueueOptions configures the background job workers.
+type QueueOptions struct {
+	// Workers is the number of jobs run at the same time. Jobs for the same
+	// account never run concurrently.
+	Workers int
+	// OnFinish is called once a job reaches a final status.
+	OnFinish func(ctx context.Context, job *ent.NoteConnectionJob)
+}
+
+// jobQueue holds the in-process side of the persistent job queue: the wake
+// signal for idle workers and the cancel functions of running jobs. The
+// queue itself lives in the note_connection_jobs table.
+type jobQueue struct {
+	wake     chan struct{}
+	claimMu  sync.Mutex
+	mu       sync.Mutex
+	running  map[int]context.CancelFunc
+	onFinish func(ctx context.Context, job *ent.NoteConnectionJob)
+	stop     context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func newJobQueue() *jobQueue {
+	return &jobQueue{
+		wake:    make(chan struct{}, 1),
+		running: map[int]context.CancelFunc{},
+	}
+}
+
+func (q *jobQueue) notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (q *jobQueue) track(jobID int, cancel context.CancelFunc) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.running[jobID] = cancel
+}
+
+func (q *jobQueue) untrack(jobID int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.running, jobID)
+}
+
+func (q *jobQueue) cancel(jobID int) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	cancel, ok := q.running[jobID]
+	if ok {
+		cancel()
+	}
+	return ok
+}
+
+// StartQueue recovers jobs interrupted by a previous shutdown, starts the
+// workers that drain pending jobs and registers the scheduled syncs of every
+// account. It stops when ctx is canceled or StopQueue is called.
+func (s *Service) StartQueue(ctx context.Context, options QueueOptions) error {
+	if _, err := s.client.NoteConnectionJob.Update().
+		Where(noteconnectionjob.StatusEQ(JobRunning)).
+		SetStatus(JobPending).
+		ClearNextAttemptAt().
+		Save(ctx); err != nil {
+		return fmt.Errorf("recover note connection jobs: %w", err)
+	}
+
+	ctx, stop := context.WithCancel(ctx)
+	if err := s.startSyncSchedules(ctx); err != nil {
+		stop()
+		return err
+	}
+	workers := options.Workers
+	if workers <= 0 {
+		workers = defaultQueueWorkers
+	}
+	s.queue.onFinish = options.OnFinish
+	s.queue.stop = stop
+	for range workers {
+		s.queue.wg.Add(1)
+		go func() {
+			defer s.queue.wg.Done()
+			s.runQueueWorker(ctx)
+		}()
+	}
+	return nil
+}
+
+// StopQueue stops the workers and scheduled syncs and waits for running jobs
+// to return. Interrupted jobs go back to pending and resume on the next start.
+func (s *Service) StopQueue() {
+	if s.queue.stop != nil {
+		s.queue.stop()
+	}
+	s.queue.wg.Wait()
+	s.stopSyncSchedules()
+}
+
+func (s *Service) runQueueWorker(ctx context.Context) {
+	for {
+		job, err := s.claimNextJob(ctx)
+		if err == nil && job != nil {
+			s.runQueuedJob(ctx, job)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.queue.wake:
+		case <-time.After(jobPollInterval):
+		}
+	}
+}
+
+// claimNextJob moves the oldest due pending job to running. Claims are
+// serialized so that two workers never pick up jobs for the same account.
+func (s *Service) claimNextJob(ctx context.Context) (*ent.NoteConnectionJob, error) {
+	s.queue.claimMu.Lock()
+	defer s.queue.claimMu.Unlock()
+	now := time.Now()
+	candidates, err := s.client.NoteConnectionJob.Query().
+		Where(
+			noteconnectionjob.StatusEQ(JobPending),
+			noteconnectionjob.Or(
+				noteconnectionjob.NextAttemptAtIsNil(),
+				noteconnectionjob.NextAttemptAtLTE(now),
+			),
+		).
+		Order(ent.Asc(noteconnectionjob.FieldCreatedAt), ent.Asc(noteconnectionjob.FieldID)).
+		Limit(10).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, candidate := range candidates {
+		busy, err := s.client.NoteConnectionJob.Query().
+			Where(
+				noteconnectionjob.AccountIDEQ(candidate.AccountID),
+				noteconnectionjob.StatusEQ(JobRunning),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if busy {
+			continue
+		}
+		claimed, err := s.client.NoteConnectionJob.Update().
+			Where(noteconnectionjob.ID(candidate.ID), noteconnectionjob.StatusEQ(JobPending)).
+			SetStatus(JobRunning).
+			SetStartedAt(now).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if claimed == 1 {
+			return s.client.NoteConnectionJob.Get(ctx, candidate.ID)
+		}
+	}
+	return nil, nil
+}
+
+func (s *Service) runQueuedJob(ctx context.Context, job *ent.NoteConnectionJob) {
+	jobCtx, cancel := context.WithCancel(ctx)
+	s.queue.track(job.ID, cancel)
+	defer func() {
+		s.queue.untrack(job.ID)
+		cancel()
+	}()
+
+	var err error
+	switch job.Operation {
+	case OperationImport:
+		_, err = s.runImportJob(jobCtx, job)
+	case OperationPush:
+		_, err = s.runPushJob(jobCtx, job)
+	case OperationSync:
+		_, err = s.runSyncJob(jobCtx, job)
+	default:
+		err = fmt.Errorf("unknown note connection operation %q", job.Operation)
+	}
+
+	finishCtx := context.WithoutCancel(ctx)
+	switch {
+	case err == nil:
+	case ctx.Err() != nil:
+		// Shutting down: give the attempt back so the job resumes on restart.
+		_ = s.client.NoteConnectionJob.UpdateOneID(job.ID).
+			SetStatus(JobPending).
+			AddAttempts(-1).
+			Exec(finishCtx)
+		return
+	case jobCtx.Err() != nil:
+		_ = s.client.NoteConnectionJob.UpdateOneID(job.ID).
+			SetStatus(JobCanceled).
+			ClearNextAttemptAt().
+			SetCompletedAt(time.Now()).
+			Exec(finishCtx)
+	case job.Attempts < job.MaxAttempts && retryableJobError(err):
+		_ = s.client.NoteConnectionJob.UpdateOneID(job.ID).
+			SetStatus(JobPending).
+			SetMessage(redactError(err)).
+			SetNextAttemptAt(time.Now().Add(jobRetryDelay(job.Attempts))).
+			Exec(finishCtx)
+		return
+	default:
+		s.failJob(finishCtx, job, err)
+	}
+	s.finishJob(finishCtx, job.ID)
+}
+
+func (s *Service) finishJob(ctx context.Context, jobID int) {
+	if s.queue.onFinish == nil {
+		return
+	}
+	job, err := s.client.NoteConnectionJob.Get(ctx, jobID)
+	if err != nil {
+		return
+	}
+	s.queue.onFinish(ctx, job)
+}
+
+// JobNoteIDs returns the local notes a job imported or overwrote with a
+// remote version, including those from an attempt that later failed.
+func (s *Service) JobNoteIDs(ctx context.Context, job *ent.NoteConnectionJob) ([]uuid.UUID, error) {
+	if job.StartedAt.IsZero() {
+		return nil, nil
+	}
+	var ids []uuid.UUID
+	err := s.client.NoteConnectionItemMap.Query().
+		Where(
+			noteconnectionitemmap.AccountIDEQ(job.AccountID),
+			noteconnectionitemmap.LastImportedAtGTE(job.StartedAt),
+		).
+		Select(noteconnectionitemmap.FieldNoteID).
+		Scan(ctx, &ids)
+	return ids, err
+}
+
+// jobRetryDelay doubles the wait after every failed attempt.
+func jobRetryDelay(attempts int) time.Duration {
+	delay := jobRetryBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= jobRetryMaxDelay {
+			return jobRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+// retryableJobError reports whether another attempt could succeed. Errors
+// that need the user to change something are final.
+func retryableJobError(err error) bool {
+	switch {
+	case ent.IsNotFound(err),
+		errors.Is(err, ErrAccountDisabled),
+		errors.Is(err, ErrMissingCredential),
+		errors.Is(err, ErrAuthorizationExpired),
+		errors.Is(err, ErrUnsupportedProvider),
+		errors.Is(err, errProtectedPush):
+		return false
+	default:
+		return true
+	}
+}
+
+// EnqueueImport queues an import of the account's notes.
+func (s *Service) EnqueueImport(ctx context.Context, userID, accountID int, req ImportRequest) (*ent.NoteConnectionJob, error) {
+	row, err := s.queueableAccount(ctx, userID, accountID, OperationImport)
+	if err != nil {
+		return nil, err
+	}
+	return s.enqueue(ctx, s.importJobCreate(row, req))
+}
+
+// EnqueuePush queues a push of one note to the account.
+func (s *Service) EnqueuePush(ctx context.Context, userID, accountID int, noteUUID uuid.UUID, targetID string) (*ent.NoteConnectionJob, error) {
+	create, err := s.pushJobCreate(ctx, userID, accountID, noteUUID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	return s.enqueue(ctx, create)
+}
+
+// EnqueueSync queues a two-way sync of the account.
+func (s *Service) EnqueueSync(ctx context.Context, userID, accountID int, trigger string) (*ent.NoteConnectionJob, error) {
+	row, err := s.queueableAccount(ctx, userID, accountID, OperationSync)
+	if err != nil {
+		return nil, err
+	}
+	return s.enqueue(ctx, s.syncJobCreate(row, trigger))
+}
+
+// queueableAccount checks the account can run the operation and that no
+// job for the same operation is already waiting or running.
+func (s *Service) queueableAccount(ctx context.Context, userID, accountID int, operation string) (*ent.NoteConnectionAccount, error) {
+	row, err := s.accountForUser(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureAccountEnabled(row); err != nil {
+		return nil, err
+	}
+	if _, err := s.providerForAccount(ctx, row, nil); err != nil {
+		return nil, err
+	}
+	queued, err := s.client.NoteConnectionJob.Query().
+		Where(
+			noteconnectionjob.AccountIDEQ(row.ID),
+			noteconnectionjob.OperationEQ(operation),
+			noteconnectionjob.StatusIn(JobPending, JobRunning),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		if operation == OperationSync {
+			return nil, ErrSyncRunning
+		}
+		return nil, ErrJobRunning
+	}
+	return row, nil
+}
+
+func (s *Service) enqueue(ctx context.Context, create *ent.NoteConnectionJobCreate) (*ent.NoteConnectionJob, error) {
+	job, err := create.
+		SetStatus(JobPending).
+		SetMaxAttempts(defaultJobAttempts).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.queue.notify()
+	return job, nil
+}
+
+func (s *Service) GetJob(ctx context.Context, userID, jobID int) (*ent.NoteConnectionJob, error) {
+	return s.client.NoteConnectionJob.Query().
+		Where(noteconnectionjob.ID(jobID), noteconnectionjob.UserIDEQ(userID)).
+		Only(ctx)
+}
+
+// CancelJob stops a pending or running job, or discards an import preview.
+// A running job stops before its next note; work already done is kept.
+func (s *Service) CancelJob(ctx context.Context, userID, jobID int) (*ent.NoteConnectionJob, error) {
+	job, err := s.GetJob(ctx, userID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	switch job.Status {
+	case JobPending:
+		canceled, err := s.client.NoteConnectionJob.Update().
+			Where(noteconnectionjob.ID(job.ID), noteconnectionjob.StatusEQ(JobPending)).
+			SetStatus(JobCanceled).
+			ClearNextAttemptAt().
+			SetCompletedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if canceled == 0 {
+			// A worker claimed it in the meantime.
+			s.queue.cancel(job.ID)
+		}
+	case JobRunning:
+		s.queue.cancel(job.ID)
+	case JobPreviewed:
+		// Discarding a preview; nothing was imported.
+		if err := s.client.NoteConnectionJob.UpdateOneID(job.ID).
+			SetStatus(JobCanceled).
+			SetCompletedAt(time.Now()).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrJobFinished
+	}
+	return s.GetJob(ctx, userID, jobID)
+}
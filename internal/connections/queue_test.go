@@ -0,0 +1,239 @@
+package connections
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/enttest"
+	"smarticky/ent/noteconnectionjob"
+
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestQueueRunsRetriesAndCancelsJobs(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestQueueRunsRetriesAndCancelsJobs?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetUsername("owner").
+		SetPasswordHash("hash").
+		SaveX(ctx)
+	joplin := newFakeJoplin(t, "joplin-token")
+	joplin.put(joplinNote{ID: "alpha", Title: "Alpha", Body: "Alpha v1", UpdatedTime: time.Now().Add(-time.Hour).UnixMilli()})
+	joplin.put(joplinNote{ID: "beta", Title: "Beta", Body: "Beta v1", UpdatedTime: time.Now().Add(-time.Hour).UnixMilli()})
+	service := NewService(client, testSecretBox(t), nil)
+	account, err := service.CreateAccount(ctx, u.ID, AccountInput{
+		Provider: ProviderJoplin,
+		Endpoint: joplin.server.URL,
+		Token:    stringPtr("joplin-token"),
+		Enabled:  true,
+	})
+	if err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+
+	// Jobs queued before the workers start wait in the table.
+	queued, err := service.EnqueueImport(ctx, u.ID, account.ID, ImportRequest{})
+	if err != nil {
+		t.Fatalf("enqueue import: %v", err)
+	}
+	if queued.Status != JobPending {
+		t.Fatalf("expected a pending job, got %q", queued.Status)
+	}
+	if _, err := service.EnqueueImport(ctx, u.ID, account.ID, ImportRequest{}); !errors.Is(err, ErrJobRunning) {
+		t.Fatalf("second import error = %v, want ErrJobRunning", err)
+	}
+	queuedSync, err := service.EnqueueSync(ctx, u.ID, account.ID, TriggerManual)
+	if err != nil {
+		t.Fatalf("enqueue sync: %v", err)
+	}
+	if _, err := service.CancelJob(ctx, u.ID, queuedSync.ID); err != nil {
+		t.Fatalf("cancel pending sync: %v", err)
+	}
+	if _, err := service.CancelJob(ctx, u.ID, queuedSync.ID); !errors.Is(err, ErrJobFinished) {
+		t.Fatalf("cancel finished job error = %v, want ErrJobFinished", err)
+	}
+	// A job left running by a crash is picked up again.
+	client.NoteConnectionJob.UpdateOneID(queued.ID).SetStatus(JobRunning).ExecX(ctx)
+
+	finished := make(chan *ent.NoteConnectionJob, 8)
+	if err := service.StartQueue(ctx, QueueOptions{
+		Workers: 2,
+		OnFinish: func(_ context.Context, job *ent.NoteConnectionJob) {
+			finished <- job
+		},
+	}); err != nil {
+		t.Fatalf("start queue: %v", err)
+	}
+	defer service.StopQueue()
+	waitFinished := func() *ent.NoteConnectionJob {
+		t.Helper()
+		select {
+		case job := <-finished:
+			return job
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for a job to finish")
+			return nil
+		}
+	}
+
+	job := waitFinished()
+	if job.ID != queued.ID || job.Status != JobCompleted || job.ImportedCount != 2 || job.Attempts != 1 {
+		t.Fatalf("unexpected import job: %+v", job)
+	}
+	if n := client.Note.Query().CountX(ctx); n != 2 {
+		t.Fatalf("expected two imported notes, got %d", n)
+	}
+	if ids, err := service.JobNoteIDs(ctx, job); err != nil || len(ids) != 2 {
+		t.Fatalf("import job notes = %v, %v, want both imported notes", ids, err)
+	}
+
+	// A failing provider is retried with backoff and then given up on.
+	joplin.mu.Lock()
+	joplin.failList = true
+	joplin.mu.Unlock()
+	failing, err := service.EnqueueImport(ctx, u.ID, account.ID, ImportRequest{})
+	if err != nil {
+		t.Fatalf("enqueue failing import: %v", err)
+	}
+	retry := waitForJob(t, client, failing.ID, func(job *ent.NoteConnectionJob) bool {
+		return job.Status == JobPending && job.NextAttemptAt != nil
+	})
+	if retry.Attempts != 1 || retry.Message == "" || retry.NextAttemptAt.Before(time.Now().Add(20*time.Second)) {
+		t.Fatalf("expected a delayed retry, got %+v", retry)
+	}
+	client.NoteConnectionJob.UpdateOneID(failing.ID).
+		SetAttempts(retry.MaxAttempts - 1).
+		SetNextAttemptAt(time.Now().Add(-time.Second)).
+		ExecX(ctx)
+	service.queue.notify()
+	job = waitFinished()
+	if job.ID != failing.ID || job.Status != JobFailed || job.Attempts != job.MaxAttempts || !strings.Contains(job.Message, "503") {
+		t.Fatalf("expected the import to fail after the last attempt, got %+v", job)
+	}
+
+	// Running jobs stop when canceled.
+	joplin.mu.Lock()
+	joplin.failList = false
+	joplin.hold = make(chan struct{})
+	joplin.mu.Unlock()
+	blocked, err := service.EnqueueImport(ctx, u.ID, account.ID, ImportRequest{})
+	if err != nil {
+		t.Fatalf("enqueue blocked import: %v", err)
+	}
+	waitForJob(t, client, blocked.ID, func(job *ent.NoteConnectionJob) bool {
+		return job.Status == JobRunning
+	})
+	if _, err := service.CancelJob(ctx, u.ID, blocked.ID); err != nil {
+		t.Fatalf("cancel running import: %v", err)
+	}
+	job = waitFinished()
+	if job.ID != blocked.ID || job.Status != JobCanceled {
+		t.Fatalf("expected the running import to be canceled, got %+v", job)
+	}
+	close(joplin.hold)
+
+	// Scheduled syncs are queued at most once at a time.
+	if err := service.runScheduledSync(ctx, account.ID, syncScheduleData{}); err != nil {
+		t.Fatalf("scheduled sync: %v", err)
+	}
+	job = waitFinished()
+	if job.Operation != OperationSync || job.Trigger != TriggerScheduled || job.Status != JobCompleted || job.SkippedCount != 2 {
+		t.Fatalf("unexpected scheduled sync: %+v", job)
+	}
+	if ids, err := service.JobNoteIDs(ctx, job); err != nil || len(ids) != 0 {
+		t.Fatalf("scheduled sync notes = %v, %v, want none changed", ids, err)
+	}
+	client.NoteConnectionJob.Create().
+		SetProvider(ProviderJoplin).
+		SetOperation(OperationSync).
+		SetStatus(JobPending).
+		SetNextAttemptAt(time.Now().Add(time.Hour)).
+		SetUserID(u.ID).
+		SetAccountID(account.ID).
+		ExecX(ctx)
+	if err := service.runScheduledSync(ctx, account.ID, syncScheduleData{}); err != nil {
+		t.Fatalf("scheduled sync: %v", err)
+	}
+	if n := client.NoteConnectionJob.Query().Where(noteconnectionjob.OperationEQ(OperationSync), noteconnectionjob.StatusEQ(JobPending)).CountX(ctx); n != 1 {
+		t.Fatalf("expected the scheduled sync to be skipped while one is queued, got %d pending", n)
+	}
+}
+
+func TestSyncIntervalSchedulesAccounts(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestSyncIntervalSchedulesAccounts?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetUsername("owner").
+		SetPasswordHash("hash").
+		SaveX(ctx)
+	service := NewService(client, testSecretBox(t), nil)
+	input := AccountInput{
+		Provider:            ProviderJoplin,
+		Endpoint:            "http://127.0.0.1:41184",
+		Token:               stringPtr("joplin-token"),
+		Enabled:             true,
+		SyncIntervalMinutes: 5,
+	}
+	if _, err := service.CreateAccount(ctx, u.ID, input); !errors.Is(err, ErrInvalidSyncInterval) {
+		t.Fatalf("create error = %v, want ErrInvalidSyncInterval", err)
+	}
+
+	if err := service.StartQueue(ctx, QueueOptions{Workers: 1}); err != nil {
+		t.Fatalf("start queue: %v", err)
+	}
+	defer service.StopQueue()
+
+	input.SyncIntervalMinutes = 60
+	before := time.Now()
+	account, err := service.CreateAccount(ctx, u.ID, input)
+	if err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+	if account.SyncIntervalMinutes != 60 || account.NextSyncAt == nil || account.NextSyncAt.Before(before.Add(59*time.Minute)) {
+		t.Fatalf("expected an hourly sync to be scheduled, got %+v", account)
+	}
+
+	input.SyncIntervalMinutes = 0
+	account, err = service.UpdateAccount(ctx, u.ID, account.ID, input)
+	if err != nil {
+		t.Fatalf("update account: %v", err)
+	}
+	if account.NextSyncAt != nil {
+		t.Fatalf("expected the schedule to stop, got %v", account.NextSyncAt)
+	}
+}
+
+func TestJobRetryDelayBacksOff(t *testing.T) {
+	for attempts, want := range map[int]time.Duration{
+		1:  30 * time.Second,
+		2:  time.Minute,
+		3:  2 * time.Minute,
+		10: jobRetryMaxDelay,
+	} {
+		if got := jobRetryDelay(attempts); got != want {
+			t.Fatalf("jobRetryDelay(%d) = %s, want %s", attempts, got, want)
+		}
+	}
+}
+
+func waitForJob(t *testing.T, client *ent.Client, jobID int, done func(*ent.NoteConnectionJob) bool) *ent.NoteConnectionJob {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		job := client.NoteConnectionJob.GetX(context.Background(), jobID)
+		if done(job) {
+			return job
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for job %d", jobID)
+	return nil
+}
@@ -0,0 +1,142 @@
+package connections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/noteconnectionaccount"
+
+	"github.com/lib-x/timewheel/scheduler"
+)
+
+const (
+	minSyncIntervalMinutes = 15
+	maxSyncIntervalMinutes = 7 * 24 * 60
+)
+
+type syncScheduleData struct {
+	Enabled         bool
+	IntervalMinutes int
+}
+
+// syncSchedules keeps the per-account scheduled syncs on the time wheel.
+// The scheduler only enqueues sync jobs; the queue workers run them.
+type syncSchedules struct {
+	mu        sync.Mutex
+	scheduler *scheduler.Scheduler[int, syncScheduleData]
+}
+
+func (s *Service) startSyncSchedules(ctx context.Context) error {
+	sched, err := scheduler.NewScheduler[int, syncScheduleData](
+		scheduler.Options[int, syncScheduleData]{
+			Next: nextScheduledSync,
+			Run:  s.runScheduledSync,
+		},
+		scheduler.WithWheel(time.Minute, 24*60),
+		scheduler.WithReschedulePolicy(scheduler.RescheduleAfterFinish),
+	)
+	if err != nil {
+		return fmt.Errorf("create note connection sync scheduler: %w", err)
+	}
+	rows, err := s.client.NoteConnectionAccount.Query().
+		Where(noteconnectionaccount.SyncIntervalMinutesGT(0)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	items := make([]scheduler.Item[int, syncScheduleData], 0, len(rows))
+	for _, row := range rows {
+		items = append(items, scheduler.Item[int, syncScheduleData]{
+			Key:  row.ID,
+			Data: syncScheduleDataFromAccount(row),
+		})
+	}
+	if err := sched.ReplaceAll(items); err != nil {
+		return fmt.Errorf("register note connection syncs: %w", err)
+	}
+	if err := sched.Start(ctx); err != nil {
+		return fmt.Errorf("start note connection sync scheduler: %w", err)
+	}
+	s.schedules.mu.Lock()
+	s.schedules.scheduler = sched
+	s.schedules.mu.Unlock()
+	return nil
+}
+
+func (s *Service) stopSyncSchedules() {
+	s.schedules.mu.Lock()
+	sched := s.schedules.scheduler
+	s.schedules.scheduler = nil
+	s.schedules.mu.Unlock()
+	if sched != nil {
+		_ = sched.Close()
+	}
+}
+
+func (s *Service) syncScheduler() *scheduler.Scheduler[int, syncScheduleData] {
+	s.schedules.mu.Lock()
+	defer s.schedules.mu.Unlock()
+	return s.schedules.scheduler
+}
+
+func (s *Service) upsertSyncSchedule(row *ent.NoteConnectionAccount) {
+	if sched := s.syncScheduler(); sched != nil {
+		_ = sched.Upsert(scheduler.Item[int, syncScheduleData]{
+			Key:  row.ID,
+			Data: syncScheduleDataFromAccount(row),
+		})
+	}
+}
+
+func (s *Service) removeSyncSchedule(accountID int) {
+	if sched := s.syncScheduler(); sched != nil {
+		_ = sched.Remove(accountID)
+	}
+}
+
+func (s *Service) nextSyncAt(accountID int) *time.Time {
+	sched := s.syncScheduler()
+	if sched == nil {
+		return nil
+	}
+	if runtime, ok := sched.Snapshot()[accountID]; ok && runtime.NextRunAt != nil {
+		return runtime.NextRunAt
+	}
+	return nil
+}
+
+// runScheduledSync queues a sync for the account. Accounts that cannot sync
+// right now, or that already have a sync queued, are skipped until the next
+// tick.
+func (s *Service) runScheduledSync(ctx context.Context, accountID int, _ syncScheduleData) error {
+	row, err := s.client.NoteConnectionAccount.Get(ctx, accountID)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_, err = s.EnqueueSync(ctx, row.UserID, row.ID, TriggerScheduled)
+	if errors.Is(err, ErrSyncRunning) || errors.Is(err, ErrAccountDisabled) || errors.Is(err, ErrMissingCredential) {
+		return nil
+	}
+	return err
+}
+
+func nextScheduledSync(now time.Time, _ int, data syncScheduleData) (time.Time, bool, error) {
+	if !data.Enabled || data.IntervalMinutes <= 0 {
+		return time.Time{}, false, nil
+	}
+	return now.Add(time.Duration(data.IntervalMinutes) * time.Minute), true, nil
+}
+
+func syncScheduleDataFromAccount(row *ent.NoteConnectionAccount) syncScheduleData {
+	return syncScheduleData{
+		Enabled:         row.Enabled,
+		IntervalMinutes: row.SyncIntervalMinutes,
+	}
+}
@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"smarticky/ent"
@@ -17,11 +19,14 @@ import (
 	"smarticky/ent/folder"
 	"smarticky/ent/note"
 	"smarticky/ent/noteconnectionaccount"
+	"smarticky/ent/noteconnectionconflict"
 	"smarticky/ent/noteconnectionitemmap"
 	"smarticky/ent/noteconnectionjob"
 	"smarticky/ent/tag"
 	"smarticky/ent/user"
+	"smarticky/internal/notes"
 	"smarticky/internal/secrets"
+	"smarticky/internal/storage"
 
 	"github.com/google/uuid"
 )
@@ -38,23 +43,47 @@ var (
 	ErrMissingTarget       = errors.New("missing target")
 	ErrAccountDisabled     = errors.New("note connection account is disabled")
 	ErrJobRunning          = errors.New("note connection import is already running")
+	ErrRemoteNoteNotFound  = errors.New("remote note not found")
+	ErrConflictResolved    = errors.New("note connection conflict is already resolved")
+	ErrInvalidSyncInterval = errors.New("sync interval must be 0 or between 15 minutes and 7 days")
+
+	errProtectedPush = errors.New("protected notes cannot be pushed while locked")
 )
 
 var sensitiveQueryValuePattern = regexp.MustCompile(`(?i)([?&](?:token|access_token|api_key|apikey|password|secret)=)([^&\s"']+)`)
 
 type Service struct {
-	client *ent.Client
-	box    *secrets.Box
-	http   *http.Client
+	client     *ent.Client
+	box        *secrets.Box
+	fs         *storage.FileSystem
+	http       *http.Client
+	queue      *jobQueue
+	schedules  *syncSchedules
+	vaultRoots []string
+	gitMirrors string
+	// notionOAuth is the public integration used to connect Notion without
+	// a pasted token; oauthRefresh serializes token refreshes.
+	notionOAuth  OAuthConfig
+	oauthRefresh sync.Mutex
+	deliveries   webhookDeliveries
 }
 
-func NewService(client *ent.Client, box *secrets.Box) *Service {
+func NewService(client *ent.Client, box *secrets.Box, fs *storage.FileSystem) *Service {
+	if fs == nil {
+		fs = storage.NewMemoryFileSystem()
+	}
 	return &Service{
 		client: client,
 		box:    box,
+		fs:     fs,
 		http: &http.Client{
 			Timeout: 20 * time.Second,
 		},
+		queue:       newJobQueue(),
+		schedules:   &syncSchedules{},
+		vaultRoots:  defaultVaultRoots(fs),
+		gitMirrors:  defaultGitMirrorDir(fs),
+		notionOAuth: notionOAuthConfigFromEnv(),
 	}
 }
 
@@ -68,7 +97,9 @@ func (s *Service) ListAccounts(ctx context.Context, userID int) ([]AccountRespon
 	}
 	out := make([]AccountResponse, 0, len(rows))
 	for _, row := range rows {
-		out = append(out, accountResponse(row))
+		response := accountResponse(row)
+		response.NextSyncAt = s.nextSyncAt(row.ID)
+		out = append(out, response)
 	}
 	return out, nil
 }
@@ -78,31 +109,43 @@ func (s *Service) CreateAccount(ctx context.Context, userID int, input AccountIn
 	if err != nil {
 		return AccountResponse{}, err
 	}
-	if normalized.Token == nil || strings.TrimSpace(*normalized.Token) == "" {
-		return AccountResponse{}, ErrMissingCredential
-	}
-
-	encrypted, err := s.encryptCredentials(Credentials{Token: strings.TrimSpace(*normalized.Token)})
-	if err != nil {
+	if err := s.checkAccountEndpoint(normalized.Provider, normalized.Endpoint); err != nil {
 		return AccountResponse{}, err
 	}
 
-	row, err := s.client.NoteConnectionAccount.Create().
+	create := s.client.NoteConnectionAccount.Create().
 		SetName(normalized.Name).
 		SetProvider(normalized.Provider).
 		SetUserID(userID).
 		SetEndpoint(normalized.Endpoint).
 		SetEnabled(normalized.Enabled).
-		SetAuthType("token").
-		SetEncryptedCredentials(encrypted).
-		SetCredentialAlg(credentialAlg).
 		SetDefaultTargetID(normalized.DefaultTargetID).
 		SetDefaultTargetName(normalized.DefaultTargetName).
-		Save(ctx)
+		SetSyncIntervalMinutes(normalized.SyncIntervalMinutes)
+	hasToken := normalized.Token != nil && strings.TrimSpace(*normalized.Token) != ""
+	if providerNeedsToken(normalized.Provider) && !hasToken {
+		return AccountResponse{}, ErrMissingCredential
+	}
+	if providerAcceptsToken(normalized.Provider) && hasToken {
+		encrypted, err := s.encryptCredentials(Credentials{Token: strings.TrimSpace(*normalized.Token)})
+		if err != nil {
+			return AccountResponse{}, err
+		}
+		create.SetAuthType(AuthTypeToken).
+			SetEncryptedCredentials(encrypted).
+			SetCredentialAlg(credentialAlg)
+	} else {
+		create.SetAuthType(AuthTypeNone)
+	}
+
+	row, err := create.Save(ctx)
 	if err != nil {
 		return AccountResponse{}, err
 	}
-	return accountResponse(row), nil
+	s.upsertSyncSchedule(row)
+	response := accountResponse(row)
+	response.NextSyncAt = s.nextSyncAt(row.ID)
+	return response, nil
 }
 
 func (s *Service) UpdateAccount(ctx context.Context, userID, accountID int, input AccountInput) (AccountResponse, error) {
@@ -111,41 +154,55 @@ func (s *Service) UpdateAccount(ctx context.Context, userID, accountID int, inpu
 		return AccountResponse{}, err
 	}
 	normalized, err := normalizeAccountInput(AccountInput{
-		Name:              input.Name,
-		Provider:          row.Provider,
-		Endpoint:          input.Endpoint,
-		Token:             input.Token,
-		DefaultTargetID:   input.DefaultTargetID,
-		DefaultTargetName: input.DefaultTargetName,
-		Enabled:           input.Enabled,
-		ClearCredentials:  input.ClearCredentials,
+		Name:                input.Name,
+		Provider:            row.Provider,
+		Endpoint:            input.Endpoint,
+		Token:               input.Token,
+		DefaultTargetID:     input.DefaultTargetID,
+		DefaultTargetName:   input.DefaultTargetName,
+		Enabled:             input.Enabled,
+		ClearCredentials:    input.ClearCredentials,
+		SyncIntervalMinutes: input.SyncIntervalMinutes,
 	})
 	if err != nil {
 		return AccountResponse{}, err
 	}
+	if err := s.checkAccountEndpoint(normalized.Provider, normalized.Endpoint); err != nil {
+		return AccountResponse{}, err
+	}
 
 	update := row.Update().
 		SetName(normalized.Name).
 		SetEndpoint(normalized.Endpoint).
 		SetEnabled(normalized.Enabled).
 		SetDefaultTargetID(normalized.DefaultTargetID).
-		SetDefaultTargetName(normalized.DefaultTargetName)
+		SetDefaultTargetName(normalized.DefaultTargetName).
+		SetSyncIntervalMinutes(normalized.SyncIntervalMinutes)
 
 	if normalized.ClearCredentials {
 		update.ClearEncryptedCredentials().ClearCredentialAlg()
-	} else if normalized.Token != nil && strings.TrimSpace(*normalized.Token) != "" {
+		if providerNeedsToken(normalized.Provider) {
+			update.SetAuthType(AuthTypeToken)
+		} else {
+			update.SetAuthType(AuthTypeNone)
+		}
+	} else if providerAcceptsToken(normalized.Provider) && normalized.Token != nil && strings.TrimSpace(*normalized.Token) != "" {
 		encrypted, err := s.encryptCredentials(Credentials{Token: strings.TrimSpace(*normalized.Token)})
 		if err != nil {
 			return AccountResponse{}, err
 		}
-		update.SetEncryptedCredentials(encrypted).SetCredentialAlg(credentialAlg)
+		// A pasted token replaces any OAuth grant the account had.
+		update.SetAuthType(AuthTypeToken).SetEncryptedCredentials(encrypted).SetCredentialAlg(credentialAlg)
 	}
 
 	row, err = update.Save(ctx)
 	if err != nil {
 		return AccountResponse{}, err
 	}
-	return accountResponse(row), nil
+	s.upsertSyncSchedule(row)
+	response := accountResponse(row)
+	response.NextSyncAt = s.nextSyncAt(row.ID)
+	return response, nil
 }
 
 func (s *Service) DeleteAccount(ctx context.Context, userID, accountID int) error {
@@ -176,6 +233,11 @@ func (s *Service) DeleteAccount(ctx context.Context, userID, accountID int) erro
 		Exec(ctx); err != nil {
 		return err
 	}
+	if _, err := txClient.NoteConnectionConflict.Delete().
+		Where(noteconnectionconflict.AccountIDEQ(row.ID)).
+		Exec(ctx); err != nil {
+		return err
+	}
 	count, err := txClient.NoteConnectionAccount.Delete().
 		Where(noteconnectionaccount.ID(accountID), noteconnectionaccount.UserIDEQ(userID)).
 		Exec(ctx)
@@ -189,6 +251,7 @@ func (s *Service) DeleteAccount(ctx context.Context, userID, accountID int) erro
 		return err
 	}
 	committed = true
+	s.removeSyncSchedule(accountID)
 	return nil
 }
 
@@ -197,10 +260,11 @@ func (s *Service) TestUnsaved(ctx context.Context, input AccountInput) error {
 	if err != nil {
 		return err
 	}
-	if normalized.Token == nil || strings.TrimSpace(*normalized.Token) == "" {
-		return ErrMissingCredential
+	credentials := Credentials{}
+	if normalized.Token != nil {
+		credentials.Token = strings.TrimSpace(*normalized.Token)
 	}
-	provider, err := s.newProvider(normalized.Provider, normalized.Endpoint, Credentials{Token: strings.TrimSpace(*normalized.Token)})
+	provider, err := s.newProvider(normalized.Provider, normalized.Endpoint, credentials)
 	if err != nil {
 		return err
 	}
@@ -300,40 +364,56 @@ func (s *Service) ImportNotes(ctx context.Context, userID, accountID int, req Im
 	if running {
 		return nil, ErrJobRunning
 	}
-	provider, err := s.providerForAccount(ctx, row, nil)
+	if _, err := s.providerForAccount(ctx, row, nil); err != nil {
+		return nil, err
+	}
+
+	job, err := s.importJobCreate(row, req).
+		SetStatus(JobRunning).
+		SetStartedAt(time.Now()).
+		Save(ctx)
 	if err != nil {
 		return nil, err
 	}
+	result, err := s.runImportJob(ctx, job)
+	if err != nil {
+		s.failJob(ctx, job, err)
+		return nil, err
+	}
+	return result, nil
+}
 
-	limit := clampLimit(req.Limit)
-	preserveRemoteHierarchy := importPreserveRemoteHierarchy(req)
-	optionsJSON := importOptionsJSON(req.TargetID, limit, preserveRemoteHierarchy)
-	job, err := s.client.NoteConnectionJob.Create().
+func (s *Service) importJobCreate(row *ent.NoteConnectionAccount, req ImportRequest) *ent.NoteConnectionJobCreate {
+	return s.client.NoteConnectionJob.Create().
 		SetProvider(row.Provider).
 		SetOperation(OperationImport).
-		SetStatus(JobRunning).
-		SetUserID(userID).
+		SetUserID(row.UserID).
 		SetAccountID(row.ID).
 		SetTotalCount(0).
-		SetOptionsJSON(optionsJSON).
-		SetStartedAt(time.Now()).
-		Save(ctx)
+		SetOptionsJSON(importOptionsJSON(req.TargetID, clampLimit(req.Limit), importPreserveRemoteHierarchy(req)))
+}
+
+// runImportJob imports the notes described by the job's options. Per-note
+// failures are counted on the job; a returned error means the job as a whole
+// did not run and is left for the caller to record.
+func (s *Service) runImportJob(ctx context.Context, job *ent.NoteConnectionJob) (*ImportResult, error) {
+	row, provider, err := s.jobAccountProvider(ctx, job)
 	if err != nil {
 		return nil, err
 	}
-
-	remoteNotes, err := provider.ImportNotes(ctx, req.TargetID, limit)
+	options, err := decodeImportOptions(job.OptionsJSON)
 	if err != nil {
-		err = redactProviderError(err)
-		_ = job.Update().
-			SetStatus(JobFailed).
-			SetFailedCount(1).
-			SetMessage(redactError(err)).
-			SetCompletedAt(time.Now()).
-			Exec(ctx)
 		return nil, err
 	}
 
+	remoteNotes, err := provider.ImportNotes(ctx, options.TargetID, options.Limit)
+	if err != nil {
+		return nil, redactProviderError(err)
+	}
+	if options.ExternalIDs != nil {
+		remoteNotes = selectRemoteNotes(remoteNotes, options.ExternalIDs)
+	}
+
 	result := &ImportResult{JobID: job.ID, TotalCount: len(remoteNotes)}
 	failureMessages := make([]string, 0, 3)
 	_ = job.Update().
@@ -343,6 +423,9 @@ func (s *Service) ImportNotes(ctx context.Context, userID, accountID int, req Im
 		SetFailedCount(0).
 		Exec(ctx)
 	for _, remote := range remoteNotes {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if strings.TrimSpace(remote.ExternalID) == "" {
 			result.FailedCount++
 			appendImportFailure(&failureMessages, remote, errors.New("missing external id"))
@@ -366,7 +449,14 @@ func (s *Service) ImportNotes(ctx context.Context, userID, accountID int, req Im
 			s.updateImportProgress(ctx, job, result)
 			continue
 		}
-		if err := s.importRemoteNote(ctx, userID, row, remote, preserveRemoteHierarchy); err != nil {
+		remote, err = fetchRemoteAttachments(ctx, provider, remote)
+		if err != nil {
+			result.FailedCount++
+			appendImportFailure(&failureMessages, remote, redactProviderError(err))
+			s.updateImportProgress(ctx, job, result)
+			continue
+		}
+		if err := s.importRemoteNote(ctx, job.UserID, row, remote, options); err != nil {
 			result.FailedCount++
 			appendImportFailure(&failureMessages, remote, err)
 			s.updateImportProgress(ctx, job, result)
@@ -383,15 +473,16 @@ func (s *Service) ImportNotes(ctx context.Context, userID, accountID int, req Im
 		SetImportedCount(result.ImportedCount).
 		SetSkippedCount(result.SkippedCount).
 		SetFailedCount(result.FailedCount).
+		ClearNextAttemptAt().
 		SetCompletedAt(time.Now())
 	if len(failureMessages) > 0 {
 		update.SetMessage(strings.Join(failureMessages, "\n"))
+	} else {
+		update.ClearMessage()
 	}
-	job, err = update.Save(ctx)
-	if err != nil {
+	if err := update.Exec(ctx); err != nil {
 		return nil, err
 	}
-	result.JobID = job.ID
 	return result, nil
 }
 
@@ -429,20 +520,65 @@ func importPreserveRemoteHierarchy(req ImportRequest) bool {
 	return *req.PreserveRemoteHierarchy
 }
 
+type importOptions struct {
+	TargetID                string `json:"target_id"`
+	Limit                   int    `json:"limit"`
+	PreserveRemoteHierarchy bool   `json:"preserve_remote_hierarchy"`
+	// ExternalIDs limits a previewed import to the notes chosen from it.
+	ExternalIDs []string `json:"external_ids,omitempty"`
+	// FolderMap files notes whose folder path is a key into the local
+	// folder with the value's id, or into no folder for an empty value.
+	FolderMap map[string]string `json:"folder_map,omitempty"`
+}
+
 func importOptionsJSON(targetID string, limit int, preserveRemoteHierarchy bool) string {
-	payload := map[string]any{
-		"target_id":                 strings.TrimSpace(targetID),
-		"limit":                     limit,
-		"preserve_remote_hierarchy": preserveRemoteHierarchy,
-	}
-	data, err := json.Marshal(payload)
+	return encodeImportOptions(importOptions{
+		TargetID:                strings.TrimSpace(targetID),
+		Limit:                   limit,
+		PreserveRemoteHierarchy: preserveRemoteHierarchy,
+	})
+}
+
+func encodeImportOptions(options importOptions) string {
+	data, err := json.Marshal(options)
 	if err != nil {
 		return "{}"
 	}
 	return string(data)
 }
 
+func decodeImportOptions(raw string) (importOptions, error) {
+	options := importOptions{Limit: defaultLimit, PreserveRemoteHierarchy: true}
+	if strings.TrimSpace(raw) == "" {
+		return options, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &options); err != nil {
+		return importOptions{}, fmt.Errorf("decode import options: %w", err)
+	}
+	options.Limit = clampLimit(options.Limit)
+	return options, nil
+}
+
 func (s *Service) PushNote(ctx context.Context, userID, accountID int, noteUUID uuid.UUID, targetID string) (*PushResponse, error) {
+	create, err := s.pushJobCreate(ctx, userID, accountID, noteUUID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	job, err := create.SetStatus(JobRunning).SetStartedAt(time.Now()).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := s.runPushJob(ctx, job)
+	if err != nil {
+		s.failJob(ctx, job, err)
+		return nil, err
+	}
+	return response, nil
+}
+
+// pushJobCreate checks that the note can be pushed through the account and
+// prepares the job that will push it.
+func (s *Service) pushJobCreate(ctx context.Context, userID, accountID int, noteUUID uuid.UUID, targetID string) (*ent.NoteConnectionJobCreate, error) {
 	row, err := s.accountForUser(ctx, userID, accountID)
 	if err != nil {
 		return nil, err
@@ -450,11 +586,23 @@ func (s *Service) PushNote(ctx context.Context, userID, accountID int, noteUUID
 	if err := ensureAccountEnabled(row); err != nil {
 		return nil, err
 	}
-	provider, err := s.providerForAccount(ctx, row, nil)
-	if err != nil {
+	if _, err := s.providerForAccount(ctx, row, nil); err != nil {
 		return nil, err
 	}
+	if _, err := s.pushableNote(ctx, userID, noteUUID); err != nil {
+		return nil, err
+	}
+	return s.client.NoteConnectionJob.Create().
+		SetProvider(row.Provider).
+		SetOperation(OperationPush).
+		SetUserID(userID).
+		SetAccountID(row.ID).
+		SetNoteID(noteUUID).
+		SetTotalCount(1).
+		SetOptionsJSON(pushOptionsJSON(targetID)), nil
+}
 
+func (s *Service) pushableNote(ctx context.Context, userID int, noteUUID uuid.UUID) (*ent.Note, error) {
 	noteRow, err := s.client.Note.Query().
 		Where(note.IDEQ(noteUUID), note.HasUserWith(user.IDEQ(userID)), note.IsDeleted(false)).
 		Only(ctx)
@@ -462,60 +610,61 @@ func (s *Service) PushNote(ctx context.Context, userID, accountID int, noteUUID
 		return nil, err
 	}
 	if noteRow.ProtectionMode != note.ProtectionModeNone {
-		return nil, errors.New("protected notes cannot be pushed while locked")
+		return nil, errProtectedPush
+	}
+	return noteRow, nil
+}
+
+func (s *Service) runPushJob(ctx context.Context, job *ent.NoteConnectionJob) (*PushResponse, error) {
+	row, provider, err := s.jobAccountProvider(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	if job.NoteID == nil {
+		return nil, errors.New("push job has no note")
+	}
+	noteRow, err := s.pushableNote(ctx, job.UserID, *job.NoteID)
+	if err != nil {
+		return nil, err
+	}
+	var options pushOptions
+	if strings.TrimSpace(job.OptionsJSON) != "" {
+		if err := json.Unmarshal([]byte(job.OptionsJSON), &options); err != nil {
+			return nil, fmt.Errorf("decode push options: %w", err)
+		}
 	}
 
-	existingID := ""
 	existingMap, err := s.client.NoteConnectionItemMap.Query().
 		Where(
 			noteconnectionitemmap.AccountIDEQ(row.ID),
-			noteconnectionitemmap.NoteIDEQ(noteUUID),
+			noteconnectionitemmap.NoteIDEQ(noteRow.ID),
 		).
 		Only(ctx)
-	if err == nil {
-		existingID = existingMap.ExternalID
-	} else if !ent.IsNotFound(err) {
+	if err != nil && !ent.IsNotFound(err) {
 		return nil, err
 	}
 
-	job, err := s.client.NoteConnectionJob.Create().
-		SetProvider(row.Provider).
-		SetOperation(OperationPush).
-		SetStatus(JobRunning).
-		SetUserID(userID).
-		SetAccountID(row.ID).
-		SetNoteID(noteUUID).
-		SetTotalCount(1).
-		SetStartedAt(time.Now()).
-		Save(ctx)
+	input, meta, err := s.pushInput(ctx, provider, job.UserID, noteRow, options.TargetID, existingMap)
 	if err != nil {
-		return nil, err
+		return nil, redactProviderError(err)
 	}
-
-	result, err := provider.PushNote(ctx, PushInput{
-		NoteID:             noteRow.ID,
-		Title:              noteRow.Title,
-		Content:            noteRow.Content,
-		TargetID:           strings.TrimSpace(targetID),
-		ExistingExternalID: existingID,
-	})
-	finished := time.Now()
+	result, err := provider.PushNote(ctx, input)
 	if err != nil {
-		err = redactProviderError(err)
-		_ = job.Update().
-			SetStatus(JobFailed).
-			SetFailedCount(1).
-			SetMessage(redactError(err)).
-			SetCompletedAt(finished).
-			Exec(ctx)
+		return nil, redactProviderError(err)
+	}
+	finished := time.Now()
+	if err := s.saveItemMap(ctx, row, noteRow.ID, result.ExternalID, result.TargetID, result.Path, result.URL, OperationPush, nil); err != nil {
 		return nil, err
 	}
-	if err := s.saveItemMap(ctx, row, noteRow.ID, result.ExternalID, result.TargetID, result.Path, result.URL, OperationPush); err != nil {
+	if err := saveItemMetadata(ctx, s.client, row.ID, noteRow.ID, meta); err != nil {
 		return nil, err
 	}
 	job, err = job.Update().
 		SetStatus(JobCompleted).
 		SetPushedCount(1).
+		SetFailedCount(0).
+		ClearMessage().
+		ClearNextAttemptAt().
 		SetCompletedAt(finished).
 		Save(ctx)
 	if err != nil {
@@ -529,6 +678,80 @@ func (s *Service) PushNote(ctx context.Context, userID, accountID int, noteUUID
 	}, nil
 }
 
+// pushInput describes a local note for a provider, including the metadata
+// that only some providers store. item is the note's existing link, if any.
+// Attachments the content links to are uploaded first; the returned
+// metadata records where they went and is saved once the push succeeds.
+func (s *Service) pushInput(ctx context.Context, provider Provider, userID int, noteRow *ent.Note, targetID string, item *ent.NoteConnectionItemMap) (PushInput, itemMetadata, error) {
+	existingID := ""
+	if item != nil {
+		existingID = item.ExternalID
+	}
+	meta := decodeItemMetadata(item)
+	tagNames, err := noteRow.QueryTags().Order(ent.Asc(tag.FieldName)).Select(tag.FieldName).Strings(ctx)
+	if err != nil {
+		return PushInput{}, meta, err
+	}
+	folderPath, err := noteFolderPath(ctx, noteRow)
+	if err != nil {
+		return PushInput{}, meta, err
+	}
+	content, err := s.uploadLocalAttachments(ctx, provider, userID, noteRow.Content, meta.Attachments)
+	if err != nil {
+		return PushInput{}, meta, err
+	}
+	return PushInput{
+		NoteID:             noteRow.ID,
+		Title:              noteRow.Title,
+		Content:            content,
+		TargetID:           targetID,
+		ExistingExternalID: existingID,
+		Tags:               tagNames,
+		Aliases:            noteRow.Aliases,
+		CreatedAt:          noteRow.CreatedAt,
+		UpdatedAt:          noteRow.UpdatedAt,
+		FolderPath:         folderPath,
+	}, meta, nil
+}
+
+// noteFolderPath lists the names of the note's folder and its parents,
+// outermost first.
+func noteFolderPath(ctx context.Context, noteRow *ent.Note) ([]string, error) {
+	current, err := noteRow.QueryFolder().Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	seen := map[uuid.UUID]bool{}
+	for current != nil && !seen[current.ID] {
+		seen[current.ID] = true
+		names = append([]string{current.Name}, names...)
+		current, err = current.QueryParent().Only(ctx)
+		if ent.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return names, nil
+}
+
+type pushOptions struct {
+	TargetID string `json:"target_id"`
+}
+
+func pushOptionsJSON(targetID string) string {
+	data, err := json.Marshal(pushOptions{TargetID: strings.TrimSpace(targetID)})
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
+
 func (s *Service) ListJobs(ctx context.Context, userID int) ([]*ent.NoteConnectionJob, error) {
 	return s.client.NoteConnectionJob.Query().
 		Where(noteconnectionjob.UserIDEQ(userID)).
@@ -550,11 +773,48 @@ func ensureAccountEnabled(row *ent.NoteConnectionAccount) error {
 	return nil
 }
 
+// jobAccountProvider loads the account a job runs against and opens its
+// provider, re-checking that the account is still usable.
+func (s *Service) jobAccountProvider(ctx context.Context, job *ent.NoteConnectionJob) (*ent.NoteConnectionAccount, Provider, error) {
+	row, err := s.accountForUser(ctx, job.UserID, job.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := ensureAccountEnabled(row); err != nil {
+		return nil, nil, err
+	}
+	provider, err := s.providerForAccount(ctx, row, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return row, provider, nil
+}
+
+// failJob records a job that could not run at all.
+func (s *Service) failJob(ctx context.Context, job *ent.NoteConnectionJob, err error) {
+	if errors.Is(err, ErrAuthorizationExpired) {
+		s.markAuthorizationExpired(ctx, job.AccountID)
+	}
+	_ = s.client.NoteConnectionJob.UpdateOneID(job.ID).
+		SetStatus(JobFailed).
+		SetFailedCount(1).
+		SetMessage(redactError(err)).
+		ClearNextAttemptAt().
+		SetCompletedAt(time.Now()).
+		Exec(context.WithoutCancel(ctx))
+}
+
 func (s *Service) providerForAccount(ctx context.Context, row *ent.NoteConnectionAccount, tokenOverride *string) (Provider, error) {
 	credentials := Credentials{}
 	if tokenOverride != nil && strings.TrimSpace(*tokenOverride) != "" {
 		credentials.Token = strings.TrimSpace(*tokenOverride)
-	} else {
+	} else if row.AuthType == AuthTypeOAuth {
+		var err error
+		credentials, err = s.oauthCredentials(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+	} else if providerNeedsToken(row.Provider) || (providerAcceptsToken(row.Provider) && row.EncryptedCredentials != "") {
 		var err error
 		credentials, err = s.decryptCredentials(row)
 		if err != nil {
@@ -565,7 +825,7 @@ func (s *Service) providerForAccount(ctx context.Context, row *ent.NoteConnectio
 }
 
 func (s *Service) newProvider(provider, endpoint string, credentials Credentials) (Provider, error) {
-	if strings.TrimSpace(credentials.Token) == "" {
+	if providerNeedsToken(provider) && strings.TrimSpace(credentials.Token) == "" {
 		return nil, ErrMissingCredential
 	}
 	endpoint, err := normalizeEndpoint(provider, endpoint)
@@ -579,11 +839,54 @@ func (s *Service) newProvider(provider, endpoint string, credentials Credentials
 		return newNotionProvider(credentials.Token, s.http), nil
 	case ProviderJoplin:
 		return newJoplinProvider(endpoint, credentials.Token, s.http), nil
+	case ProviderVault:
+		if err := s.checkVaultPath(endpoint); err != nil {
+			return nil, err
+		}
+		return newVaultProvider(endpoint), nil
+	case ProviderGit:
+		repoDir := gitRepoPath(endpoint)
+		if err := s.checkVaultPath(repoDir); err != nil {
+			return nil, err
+		}
+		return newGitProvider(repoDir, s.gitMirrors), nil
+	case ProviderTrilium:
+		return newTriliumProvider(endpoint, credentials.Token, s.http), nil
+	case ProviderMemos:
+		return newMemosProvider(endpoint, credentials.Token, s.http), nil
+	case ProviderStandardFile:
+		return newStandardFileProvider(endpoint, credentials.Token, s.http), nil
 	default:
 		return nil, ErrUnsupportedProvider
 	}
 }
 
+// providerNeedsToken reports whether the provider authenticates with an API
+// token. Vaults and Git repositories are local and have no credentials, and
+// a Standard File export may be served without any.
+func providerNeedsToken(provider string) bool {
+	return providerAcceptsToken(provider) && provider != ProviderStandardFile
+}
+
+// providerAcceptsToken reports whether the provider can use a token when one
+// is given.
+func providerAcceptsToken(provider string) bool {
+	return provider != ProviderVault && provider != ProviderGit
+}
+
+// checkAccountEndpoint applies the checks that depend on the server setup
+// rather than on the endpoint alone.
+func (s *Service) checkAccountEndpoint(provider, endpoint string) error {
+	switch provider {
+	case ProviderVault:
+		return s.checkVaultPath(endpoint)
+	case ProviderGit:
+		return s.checkVaultPath(gitRepoPath(endpoint))
+	default:
+		return nil
+	}
+}
+
 func (s *Service) encryptCredentials(credentials Credentials) (string, error) {
 	raw, err := json.Marshal(credentials)
 	if err != nil {
@@ -610,7 +913,7 @@ func (s *Service) decryptCredentials(row *ent.NoteConnectionAccount) (Credential
 	return credentials, nil
 }
 
-func (s *Service) importRemoteNote(ctx context.Context, userID int, account *ent.NoteConnectionAccount, remote RemoteNote, preserveRemoteHierarchy bool) error {
+func (s *Service) importRemoteNote(ctx context.Context, userID int, account *ent.NoteConnectionAccount, remote RemoteNote, options importOptions) error {
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		return err
@@ -623,11 +926,27 @@ func (s *Service) importRemoteNote(ctx context.Context, userID int, account *ent
 	}()
 
 	txClient := tx.Client()
-	created, err := s.createImportedNoteWithClient(ctx, txClient, userID, account, remote, preserveRemoteHierarchy)
+	created, err := s.createImportedNoteWithClient(ctx, txClient, userID, account, remote, options)
 	if err != nil {
 		return err
 	}
-	if err := s.saveItemMapWithClient(ctx, txClient, account, created.ID, remote.ExternalID, remote.TargetID, remote.Path, remote.URL, OperationImport); err != nil {
+	meta := decodeItemMetadata(nil)
+	if len(remote.Attachments) > 0 {
+		content, err := s.storeRemoteAttachments(ctx, txClient, userID, created.ID, remote, meta.Attachments)
+		if err != nil {
+			return err
+		}
+		if err := created.Update().
+			SetContent(content).
+			SetUpdatedAt(created.UpdatedAt).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	if err := s.saveItemMapWithClient(ctx, txClient, account, created.ID, remote.ExternalID, remote.TargetID, remote.Path, remote.URL, OperationImport, remote.UpdatedAt); err != nil {
+		return err
+	}
+	if err := saveItemMetadata(ctx, txClient, account.ID, created.ID, meta); err != nil {
 		return err
 	}
 	if err := tx.Commit(); err != nil {
@@ -638,22 +957,33 @@ func (s *Service) importRemoteNote(ctx context.Context, userID int, account *ent
 }
 
 func (s *Service) createImportedNote(ctx context.Context, userID int, account *ent.NoteConnectionAccount, remote RemoteNote, preserveRemoteHierarchy bool) (*ent.Note, error) {
-	return s.createImportedNoteWithClient(ctx, s.client, userID, account, remote, preserveRemoteHierarchy)
+	return s.createImportedNoteWithClient(ctx, s.client, userID, account, remote, importOptions{PreserveRemoteHierarchy: preserveRemoteHierarchy})
 }
 
-func (s *Service) createImportedNoteWithClient(ctx context.Context, client *ent.Client, userID int, account *ent.NoteConnectionAccount, remote RemoteNote, preserveRemoteHierarchy bool) (*ent.Note, error) {
+func (s *Service) createImportedNoteWithClient(ctx context.Context, client *ent.Client, userID int, account *ent.NoteConnectionAccount, remote RemoteNote, options importOptions) (*ent.Note, error) {
 	title := titleOrUntitled(remote.Title)
 	create := client.Note.Create().
 		SetTitle(title).
 		SetContent(remote.Content).
 		SetUserID(userID)
+	if aliases := notes.NormalizeAliases(remote.Aliases); len(aliases) > 0 {
+		create.SetAliases(aliases)
+	}
 	if remote.CreatedAt != nil {
 		create.SetCreatedAt(*remote.CreatedAt)
 	}
 	if remote.UpdatedAt != nil {
 		create.SetUpdatedAt(*remote.UpdatedAt)
 	}
-	if segments := importFolderSegments(account, remote, preserveRemoteHierarchy); len(segments) > 0 {
+	segments := importFolderSegments(account, remote, options.PreserveRemoteHierarchy)
+	if folderID, ok := options.FolderMap[strings.Join(segments, "/")]; ok {
+		if folderID != "" {
+			if err := s.checkImportFolder(ctx, client, userID, folderID); err != nil {
+				return nil, err
+			}
+			create.SetFolderID(uuid.MustParse(folderID))
+		}
+	} else if len(segments) > 0 {
 		folderRow, err := s.findOrCreateFolderPathWithClient(ctx, client, userID, segments)
 		if err != nil {
 			return nil, err
@@ -870,11 +1200,14 @@ func cleanRemoteFolderSegments(values []string) []string {
 	return out
 }
 
-func (s *Service) saveItemMap(ctx context.Context, account *ent.NoteConnectionAccount, noteID uuid.UUID, externalID, targetID, path, externalURL, direction string) error {
-	return s.saveItemMapWithClient(ctx, s.client, account, noteID, externalID, targetID, path, externalURL, direction)
+func (s *Service) saveItemMap(ctx context.Context, account *ent.NoteConnectionAccount, noteID uuid.UUID, externalID, targetID, path, externalURL, direction string, remoteUpdatedAt *time.Time) error {
+	return s.saveItemMapWithClient(ctx, s.client, account, noteID, externalID, targetID, path, externalURL, direction, remoteUpdatedAt)
 }
 
-func (s *Service) saveItemMapWithClient(ctx context.Context, client *ent.Client, account *ent.NoteConnectionAccount, noteID uuid.UUID, externalID, targetID, path, externalURL, direction string) error {
+// saveItemMapWithClient links a note with its external item after an import
+// or push, which leaves both sides equal. remoteUpdatedAt is the remote
+// modification time when the provider reported one.
+func (s *Service) saveItemMapWithClient(ctx context.Context, client *ent.Client, account *ent.NoteConnectionAccount, noteID uuid.UUID, externalID, targetID, path, externalURL, direction string, remoteUpdatedAt *time.Time) error {
 	now := time.Now()
 	existing, err := client.NoteConnectionItemMap.Query().
 		Where(noteconnectionitemmap.AccountIDEQ(account.ID), noteconnectionitemmap.NoteIDEQ(noteID)).
@@ -885,12 +1218,18 @@ func (s *Service) saveItemMapWithClient(ctx context.Context, client *ent.Client,
 			SetExternalTargetID(targetID).
 			SetExternalPath(path).
 			SetExternalURL(externalURL).
-			SetLastSyncDirection(direction)
+			SetLastSyncDirection(direction).
+			SetLastSyncedAt(now)
 		if direction == OperationImport {
 			update.SetLastImportedAt(now)
 		} else {
 			update.SetLastPushedAt(now)
 		}
+		if remoteUpdatedAt != nil {
+			update.SetRemoteUpdatedAt(*remoteUpdatedAt)
+		} else {
+			update.ClearRemoteUpdatedAt()
+		}
 		return update.Exec(ctx)
 	}
 	if !ent.IsNotFound(err) {
@@ -905,7 +1244,9 @@ func (s *Service) saveItemMapWithClient(ctx context.Context, client *ent.Client,
 		SetExternalTargetID(targetID).
 		SetExternalPath(path).
 		SetExternalURL(externalURL).
-		SetLastSyncDirection(direction)
+		SetLastSyncDirection(direction).
+		SetLastSyncedAt(now).
+		SetNillableRemoteUpdatedAt(remoteUpdatedAt)
 	if direction == OperationImport {
 		create.SetLastImportedAt(now)
 	} else {
@@ -916,20 +1257,22 @@ func (s *Service) saveItemMapWithClient(ctx context.Context, client *ent.Client,
 
 func accountResponse(row *ent.NoteConnectionAccount) AccountResponse {
 	return AccountResponse{
-		ID:                row.ID,
-		Name:              row.Name,
-		Provider:          row.Provider,
-		Endpoint:          row.Endpoint,
-		Enabled:           row.Enabled,
-		AuthType:          row.AuthType,
-		HasCredentials:    row.EncryptedCredentials != "",
-		DefaultTargetID:   row.DefaultTargetID,
-		DefaultTargetName: row.DefaultTargetName,
-		LastTestStatus:    row.LastTestStatus,
-		LastTestError:     row.LastTestError,
-		LastTestAt:        timePtr(row.LastTestAt),
-		CreatedAt:         row.CreatedAt,
-		UpdatedAt:         row.UpdatedAt,
+		ID:                  row.ID,
+		Name:                row.Name,
+		Provider:            row.Provider,
+		Endpoint:            row.Endpoint,
+		Enabled:             row.Enabled,
+		AuthType:            row.AuthType,
+		HasCredentials:      row.EncryptedCredentials != "" || !providerNeedsToken(row.Provider),
+		DefaultTargetID:     row.DefaultTargetID,
+		DefaultTargetName:   row.DefaultTargetName,
+		LastTestStatus:      row.LastTestStatus,
+		LastTestError:       row.LastTestError,
+		LastTestAt:          timePtr(row.LastTestAt),
+		SyncIntervalMinutes: row.SyncIntervalMinutes,
+		WebhookEnabled:      row.EncryptedWebhookSecret != "",
+		CreatedAt:           row.CreatedAt,
+		UpdatedAt:           row.UpdatedAt,
 	}
 }
 
@@ -943,7 +1286,8 @@ func timePtr(value time.Time) *time.Time {
 func normalizeAccountInput(input AccountInput) (AccountInput, error) {
 	provider := strings.ToLower(strings.TrimSpace(input.Provider))
 	switch provider {
-	case ProviderSiYuan, ProviderNotion, ProviderJoplin:
+	case ProviderSiYuan, ProviderNotion, ProviderJoplin, ProviderVault, ProviderGit,
+		ProviderTrilium, ProviderMemos, ProviderStandardFile:
 	default:
 		return input, ErrUnsupportedProvider
 	}
@@ -963,6 +1307,9 @@ func normalizeAccountInput(input AccountInput) (AccountInput, error) {
 	input.Endpoint = endpoint
 	input.DefaultTargetID = strings.TrimSpace(input.DefaultTargetID)
 	input.DefaultTargetName = strings.TrimSpace(input.DefaultTargetName)
+	if input.SyncIntervalMinutes != 0 && (input.SyncIntervalMinutes < minSyncIntervalMinutes || input.SyncIntervalMinutes > maxSyncIntervalMinutes) {
+		return input, ErrInvalidSyncInterval
+	}
 	return input, nil
 }
 
@@ -979,6 +1326,25 @@ func normalizeEndpoint(provider, endpoint string) (string, error) {
 		if endpoint == "" {
 			endpoint = "http://127.0.0.1:41184"
 		}
+	case ProviderTrilium:
+		if endpoint == "" {
+			endpoint = "http://127.0.0.1:8080"
+		}
+	case ProviderMemos:
+		if endpoint == "" {
+			endpoint = "http://127.0.0.1:5230"
+		}
+	case ProviderStandardFile:
+		if endpoint == "" {
+			return "", errors.New("standard file export URL is required")
+		}
+	case ProviderVault:
+		if !filepath.IsAbs(endpoint) {
+			return "", errors.New("vault folder must be an absolute path")
+		}
+		return filepath.Clean(endpoint), nil
+	case ProviderGit:
+		return normalizeGitEndpoint(endpoint)
 	}
 	parsed, err := url.Parse(endpoint)
 	if err != nil {
@@ -1003,6 +1369,16 @@ func defaultAccountName(provider string) string {
 		return "Notion"
 	case ProviderJoplin:
 		return "Joplin"
+	case ProviderVault:
+		return "Markdown Vault"
+	case ProviderGit:
+		return "Git"
+	case ProviderTrilium:
+		return "Trilium"
+	case ProviderMemos:
+		return "Memos"
+	case ProviderStandardFile:
+		return "Standard Notes Export"
 	default:
 		return "Note Account"
 	}
@@ -1054,7 +1430,11 @@ func redactProviderError(err error) error {
 	if err == nil {
 		return nil
 	}
+	if authorizationRevoked(err) {
+		return ErrAuthorizationExpired
+	}
 	if errors.Is(err, ErrUnsupportedProvider) ||
+		errors.Is(err, ErrAuthorizationExpired) ||
 		errors.Is(err, ErrMissingCredential) ||
 		errors.Is(err, ErrMissingTarget) ||
 		errors.Is(err, ErrAccountDisabled) {
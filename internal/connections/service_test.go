@@ -30,7 +30,7 @@ func TestAccountCredentialsAreEncryptedAndRedacted(t *testing.T) {
 		SetPasswordHash("hash").
 		SaveX(ctx)
 	box := testSecretBox(t)
-	service := NewService(client, box)
+	service := NewService(client, box, nil)
 
 	response, err := service.CreateAccount(ctx, u.ID, AccountInput{
 		Name:     "Local SiYuan",
@@ -70,7 +70,7 @@ func TestUpdateAccountWithoutTokenKeepsStoredCredential(t *testing.T) {
 		SetUsername("owner").
 		SetPasswordHash("hash").
 		SaveX(ctx)
-	service := NewService(client, testSecretBox(t))
+	service := NewService(client, testSecretBox(t), nil)
 
 	created, err := service.CreateAccount(ctx, u.ID, AccountInput{
 		Name:     "Joplin",
@@ -107,7 +107,7 @@ func TestDeleteAccountRemovesConnectionRecordsButKeepsLocalNote(t *testing.T) {
 		SetUsername("owner").
 		SetPasswordHash("hash").
 		SaveX(ctx)
-	service := NewService(client, testSecretBox(t))
+	service := NewService(client, testSecretBox(t), nil)
 
 	created, err := service.CreateAccount(ctx, u.ID, AccountInput{
 		Name:     "SiYuan",
@@ -185,7 +185,7 @@ func TestDisabledAccountCannotSync(t *testing.T) {
 		SetContent("body").
 		SetUserID(u.ID).
 		SaveX(ctx)
-	service := NewService(client, testSecretBox(t))
+	service := NewService(client, testSecretBox(t), nil)
 
 	if _, err := service.ListTargets(ctx, u.ID, account.ID); err == nil || err.Error() != "note connection account is disabled" {
 		t.Fatalf("ListTargets error = %v, want disabled account error", err)
@@ -196,6 +196,9 @@ func TestDisabledAccountCannotSync(t *testing.T) {
 	if _, err := service.PushNote(ctx, u.ID, account.ID, note.ID, "target"); err == nil || err.Error() != "note connection account is disabled" {
 		t.Fatalf("PushNote error = %v, want disabled account error", err)
 	}
+	if _, err := service.EnqueueSync(ctx, u.ID, account.ID, TriggerManual); err == nil || err.Error() != "note connection account is disabled" {
+		t.Fatalf("EnqueueSync error = %v, want disabled account error", err)
+	}
 }
 
 func TestImportNotesRejectsConcurrentImportJob(t *testing.T) {
@@ -222,7 +225,7 @@ func TestImportNotesRejectsConcurrentImportJob(t *testing.T) {
 		SetUserID(u.ID).
 		SetAccountID(account.ID).
 		ExecX(ctx)
-	service := NewService(client, testSecretBox(t))
+	service := NewService(client, testSecretBox(t), nil)
 
 	_, err := service.ImportNotes(ctx, u.ID, account.ID, ImportRequest{})
 	if !errors.Is(err, ErrJobRunning) {
@@ -259,7 +262,7 @@ func TestCreateImportedNotePreservesRemoteHierarchyAndRaisesFolderDepth(t *testi
 		SetUserID(u.ID).
 		SaveX(ctx)
 	client.BackupConfig.Create().SetFolderMaxDepth(1).SaveX(ctx)
-	service := NewService(client, testSecretBox(t))
+	service := NewService(client, testSecretBox(t), nil)
 
 	created, err := service.createImportedNote(ctx, u.ID, account, RemoteNote{
 		ExternalID: "doc-1",
@@ -318,7 +321,7 @@ func TestCreateImportedNoteCanIgnoreRemoteHierarchy(t *testing.T) {
 		SetUserID(u.ID).
 		SaveX(ctx)
 	client.BackupConfig.Create().SetFolderMaxDepth(1).SaveX(ctx)
-	service := NewService(client, testSecretBox(t))
+	service := NewService(client, testSecretBox(t), nil)
 
 	created, err := service.createImportedNote(ctx, u.ID, account, RemoteNote{
 		ExternalID: "note-1",
@@ -376,7 +379,7 @@ func TestImportRemoteNoteRollsBackLocalCreatesWhenItemMapFails(t *testing.T) {
 		SetExternalID("dup-doc").
 		ExecX(ctx)
 	client.BackupConfig.Create().SetFolderMaxDepth(1).SaveX(ctx)
-	service := NewService(client, testSecretBox(t))
+	service := NewService(client, testSecretBox(t), nil)
 
 	err := service.importRemoteNote(ctx, u.ID, account, RemoteNote{
 		ExternalID: "dup-doc",
@@ -386,7 +389,7 @@ func TestImportRemoteNoteRollsBackLocalCreatesWhenItemMapFails(t *testing.T) {
 		Title:      "Imported",
 		Content:    "body",
 		Tags:       []string{"rolled-back-tag"},
-	}, true)
+	}, importOptions{PreserveRemoteHierarchy: true})
 	if err == nil {
 		t.Fatal("expected duplicate external id to fail item map creation")
 	}
@@ -434,3 +437,50 @@ func testSecretBox(t *testing.T) *secrets.Box {
 func stringPtr(value string) *string {
 	return &value
 }
+
+func TestStandardFileAccountTokenIsOptional(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestStandardFileAccountTokenIsOptional?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetUsername("owner").
+		SetPasswordHash("hash").
+		SaveX(ctx)
+	service := NewService(client, testSecretBox(t), nil)
+
+	if _, err := service.CreateAccount(ctx, u.ID, AccountInput{Provider: ProviderTrilium, Enabled: true}); !errors.Is(err, ErrMissingCredential) {
+		t.Fatalf("trilium without token error = %v, want ErrMissingCredential", err)
+	}
+	if _, err := service.CreateAccount(ctx, u.ID, AccountInput{Provider: ProviderStandardFile, Enabled: true}); err == nil {
+		t.Fatal("expected standard file account without an export URL to be rejected")
+	}
+
+	open, err := service.CreateAccount(ctx, u.ID, AccountInput{
+		Provider: ProviderStandardFile,
+		Endpoint: "https://dav.example.com/notes.json",
+		Enabled:  true,
+	})
+	if err != nil {
+		t.Fatalf("create account without token: %v", err)
+	}
+	if open.AuthType != AuthTypeNone || !open.HasCredentials || open.Name != "Standard Notes Export" {
+		t.Fatalf("account = %#v, want usable account without credentials", open)
+	}
+
+	secured, err := service.CreateAccount(ctx, u.ID, AccountInput{
+		Name:     "Shared export",
+		Provider: ProviderStandardFile,
+		Endpoint: "https://dav.example.com/notes.json",
+		Token:    stringPtr("sync:secret"),
+		Enabled:  true,
+	})
+	if err != nil {
+		t.Fatalf("create account with token: %v", err)
+	}
+	row := client.NoteConnectionAccount.GetX(ctx, secured.ID)
+	credentials, err := service.decryptCredentials(row)
+	if err != nil || credentials.Token != "sync:secret" || row.AuthType != AuthTypeToken {
+		t.Fatalf("credentials = %#v, %v, want stored token", credentials, err)
+	}
+}
@@ -0,0 +1,435 @@
+package connections
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/note"
+	"smarticky/ent/noteconnectionconflict"
+	"smarticky/ent/noteconnectionitemmap"
+)
+
+var (
+	ErrSyncRunning               = errors.New("note connection sync is already running")
+	ErrInvalidConflictResolution = errors.New("conflict resolution must be local or remote")
+)
+
+type syncOutcome int
+
+const (
+	syncSkipped syncOutcome = iota
+	syncPulled
+	syncPushed
+	syncConflict
+)
+
+func (s *Service) syncJobCreate(row *ent.NoteConnectionAccount, trigger string) *ent.NoteConnectionJobCreate {
+	return s.client.NoteConnectionJob.Create().
+		SetProvider(row.Provider).
+		SetOperation(OperationSync).
+		SetTrigger(trigger).
+		SetUserID(row.UserID).
+		SetAccountID(row.ID)
+}
+
+// runSyncJob brings the linked notes of a sync job's account up to date in
+// both directions. A side counts as changed when it was modified after the
+// last sync; when only one side changed it overwrites the other, and when
+// both did a conflict is recorded with both versions and neither is touched.
+// Notes that were never imported or pushed are left to import jobs.
+func (s *Service) runSyncJob(ctx context.Context, job *ent.NoteConnectionJob) (*SyncResult, error) {
+	row, provider, err := s.jobAccountProvider(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	options, err := decodeSyncOptions(job.OptionsJSON)
+	if err != nil {
+		return nil, err
+	}
+	query := s.client.NoteConnectionItemMap.Query().
+		Where(noteconnectionitemmap.AccountIDEQ(row.ID))
+	if options.ExternalIDs != nil {
+		query.Where(noteconnectionitemmap.ExternalIDIn(options.ExternalIDs...))
+	}
+	items, err := query.
+		WithNote().
+		Order(ent.Asc(noteconnectionitemmap.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SyncResult{JobID: job.ID, TotalCount: len(items)}
+	s.updateSyncProgress(ctx, job, result)
+	failureMessages := make([]string, 0, 3)
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		outcome, err := s.syncItem(ctx, provider, row, item)
+		if err != nil {
+			result.FailedCount++
+			label := RemoteNote{ExternalID: item.ExternalID, Path: item.ExternalPath}
+			if item.Edges.Note != nil {
+				label.Title = item.Edges.Note.Title
+			}
+			appendImportFailure(&failureMessages, label, redactProviderError(err))
+			s.updateSyncProgress(ctx, job, result)
+			continue
+		}
+		switch outcome {
+		case syncPulled:
+			result.PulledCount++
+		case syncPushed:
+			result.PushedCount++
+		case syncConflict:
+			result.ConflictCount++
+		default:
+			result.SkippedCount++
+		}
+		s.updateSyncProgress(ctx, job, result)
+	}
+
+	result.Status = jobStatus(result.TotalCount-result.FailedCount, result.FailedCount)
+	update := job.Update().
+		SetStatus(result.Status).
+		SetTotalCount(result.TotalCount).
+		SetImportedCount(result.PulledCount).
+		SetPushedCount(result.PushedCount).
+		SetSkippedCount(result.SkippedCount).
+		SetConflictCount(result.ConflictCount).
+		SetFailedCount(result.FailedCount).
+		ClearNextAttemptAt().
+		SetCompletedAt(time.Now())
+	if len(failureMessages) > 0 {
+		update.SetMessage(strings.Join(failureMessages, "\n"))
+	} else {
+		update.ClearMessage()
+	}
+	if err := update.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *Service) updateSyncProgress(ctx context.Context, job *ent.NoteConnectionJob, result *SyncResult) {
+	_ = job.Update().
+		SetTotalCount(result.TotalCount).
+		SetImportedCount(result.PulledCount).
+		SetPushedCount(result.PushedCount).
+		SetSkippedCount(result.SkippedCount).
+		SetConflictCount(result.ConflictCount).
+		SetFailedCount(result.FailedCount).
+		Exec(ctx)
+}
+
+func (s *Service) syncItem(ctx context.Context, provider Provider, account *ent.NoteConnectionAccount, item *ent.NoteConnectionItemMap) (syncOutcome, error) {
+	local := item.Edges.Note
+	if local == nil || local.IsDeleted || local.ProtectionMode != note.ProtectionModeNone {
+		return syncSkipped, nil
+	}
+	open, err := s.client.NoteConnectionConflict.Query().
+		Where(
+			noteconnectionconflict.AccountIDEQ(account.ID),
+			noteconnectionconflict.NoteIDEQ(local.ID),
+			noteconnectionconflict.StatusEQ(ConflictOpen),
+		).
+		Exist(ctx)
+	if err != nil {
+		return syncSkipped, err
+	}
+	if open {
+		return syncConflict, nil
+	}
+
+	remote, err := provider.GetNote(ctx, item.ExternalID)
+	if err != nil {
+		return syncSkipped, err
+	}
+	baseline := syncBaseline(item)
+	localChanged := local.UpdatedAt.After(baseline)
+	remoteChanged := remoteChangedSince(item, remote, baseline)
+
+	switch {
+	case sameNoteVersion(local.Title, local.Content, remote.Title, remote.Content):
+		if localChanged || remoteChanged {
+			if err := markItemSynced(ctx, s.client, item, OperationSync, remote.UpdatedAt, time.Now()); err != nil {
+				return syncSkipped, err
+			}
+		}
+		return syncSkipped, nil
+	case localChanged && remoteChanged:
+		if err := s.client.NoteConnectionConflict.Create().
+			SetAccountID(account.ID).
+			SetNoteID(local.ID).
+			SetExternalID(item.ExternalID).
+			SetLocalTitle(local.Title).
+			SetLocalContent(local.Content).
+			SetLocalUpdatedAt(local.UpdatedAt).
+			SetRemoteTitle(titleOrUntitled(remote.Title)).
+			SetRemoteContent(remote.Content).
+			SetNillableRemoteUpdatedAt(remote.UpdatedAt).
+			Exec(ctx); err != nil {
+			return syncSkipped, err
+		}
+		return syncConflict, nil
+	case remoteChanged:
+		remote, err = fetchRemoteAttachments(ctx, provider, remote)
+		if err != nil {
+			return syncSkipped, err
+		}
+		if err := s.pullRemoteVersion(ctx, account, item, remote); err != nil {
+			return syncSkipped, err
+		}
+		return syncPulled, nil
+	case localChanged:
+		if err := s.pushLocalVersion(ctx, provider, account, item, local); err != nil {
+			return syncSkipped, err
+		}
+		return syncPushed, nil
+	default:
+		return syncSkipped, nil
+	}
+}
+
+// syncBaseline is the moment both sides were last known to match. Links made
+// before sync existed only know when they were last imported or pushed.
+func syncBaseline(item *ent.NoteConnectionItemMap) time.Time {
+	if !item.LastSyncedAt.IsZero() {
+		return item.LastSyncedAt
+	}
+	if item.LastPushedAt.After(item.LastImportedAt) {
+		return item.LastPushedAt
+	}
+	return item.LastImportedAt
+}
+
+// remoteChangedSince compares against the remote modification time seen at
+// the last sync when there is one, so clock skew between the two systems
+// does not matter; otherwise it falls back to the local baseline.
+func remoteChangedSince(item *ent.NoteConnectionItemMap, remote RemoteNote, baseline time.Time) bool {
+	if remote.UpdatedAt == nil {
+		return false
+	}
+	if !item.RemoteUpdatedAt.IsZero() {
+		return remote.UpdatedAt.After(item.RemoteUpdatedAt)
+	}
+	return remote.UpdatedAt.After(baseline)
+}
+
+func sameNoteVersion(localTitle, localContent, remoteTitle, remoteContent string) bool {
+	return titleOrUntitled(localTitle) == titleOrUntitled(remoteTitle) &&
+		strings.TrimSpace(localContent) == strings.TrimSpace(remoteContent)
+}
+
+// pullRemoteVersion overwrites the local note with a remote version and marks
+// the link as synced in one transaction. Files the remote version embeds are
+// stored as attachments of the note.
+func (s *Service) pullRemoteVersion(ctx context.Context, account *ent.NoteConnectionAccount, item *ent.NoteConnectionItemMap, remote RemoteNote) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	meta := decodeItemMetadata(item)
+	content, err := s.storeRemoteAttachments(ctx, tx.Client(), account.UserID, item.NoteID, remote, meta.Attachments)
+	if err != nil {
+		return err
+	}
+	if err := saveItemMetadata(ctx, tx.Client(), item.AccountID, item.NoteID, meta); err != nil {
+		return err
+	}
+	now := time.Now()
+	if err := tx.Note.UpdateOneID(item.NoteID).
+		SetTitle(titleOrUntitled(remote.Title)).
+		SetContent(content).
+		SetUpdatedAt(now).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if err := markItemSynced(ctx, tx.Client(), item, OperationImport, remote.UpdatedAt, now); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
+func (s *Service) pushLocalVersion(ctx context.Context, provider Provider, account *ent.NoteConnectionAccount, item *ent.NoteConnectionItemMap, local *ent.Note) error {
+	input, meta, err := s.pushInput(ctx, provider, account.UserID, local, item.ExternalTargetID, item)
+	if err != nil {
+		return err
+	}
+	result, err := provider.PushNote(ctx, input)
+	if err != nil {
+		return err
+	}
+	if err := saveItemMetadata(ctx, s.client, item.AccountID, item.NoteID, meta); err != nil {
+		return err
+	}
+	if result.ExternalID != "" && result.ExternalID != item.ExternalID {
+		// Providers that name files after the note move them when the note
+		// is renamed or filed elsewhere.
+		if err := s.client.NoteConnectionItemMap.UpdateOneID(item.ID).
+			SetExternalID(result.ExternalID).
+			SetExternalTargetID(result.TargetID).
+			SetExternalPath(result.Path).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	// The remote modification time of the push is unknown until the next
+	// fetch, so the next sync compares against the push time instead.
+	return markItemSynced(ctx, s.client, item, OperationPush, nil, time.Now())
+}
+
+func markItemSynced(ctx context.Context, client *ent.Client, item *ent.NoteConnectionItemMap, direction string, remoteUpdatedAt *time.Time, now time.Time) error {
+	update := client.NoteConnectionItemMap.UpdateOneID(item.ID).
+		SetLastSyncDirection(direction).
+		SetLastSyncedAt(now)
+	switch direction {
+	case OperationImport:
+		update.SetLastImportedAt(now)
+	case OperationPush:
+		update.SetLastPushedAt(now)
+	}
+	if remoteUpdatedAt != nil {
+		update.SetRemoteUpdatedAt(*remoteUpdatedAt)
+	} else {
+		update.ClearRemoteUpdatedAt()
+	}
+	return update.Exec(ctx)
+}
+
+func (s *Service) ListConflicts(ctx context.Context, userID, accountID int) ([]ConflictResponse, error) {
+	row, err := s.accountForUser(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	conflicts, err := s.client.NoteConnectionConflict.Query().
+		Where(
+			noteconnectionconflict.AccountIDEQ(row.ID),
+			noteconnectionconflict.StatusEQ(ConflictOpen),
+		).
+		Order(ent.Desc(noteconnectionconflict.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ConflictResponse, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		out = append(out, conflictResponse(conflict))
+	}
+	return out, nil
+}
+
+// ResolveConflict settles an open conflict by keeping one version: "local"
+// pushes the note as it is now, "remote" replaces it with the remote version
+// recorded in the conflict.
+func (s *Service) ResolveConflict(ctx context.Context, userID, accountID, conflictID int, req ResolveConflictRequest) (ConflictResponse, error) {
+	keep := strings.ToLower(strings.TrimSpace(req.Keep))
+	if keep != ResolveKeepLocal && keep != ResolveKeepRemote {
+		return ConflictResponse{}, ErrInvalidConflictResolution
+	}
+	row, err := s.accountForUser(ctx, userID, accountID)
+	if err != nil {
+		return ConflictResponse{}, err
+	}
+	if err := ensureAccountEnabled(row); err != nil {
+		return ConflictResponse{}, err
+	}
+	conflict, err := s.client.NoteConnectionConflict.Query().
+		Where(
+			noteconnectionconflict.ID(conflictID),
+			noteconnectionconflict.AccountIDEQ(row.ID),
+		).
+		Only(ctx)
+	if err != nil {
+		return ConflictResponse{}, err
+	}
+	if conflict.Status != ConflictOpen {
+		return ConflictResponse{}, ErrConflictResolved
+	}
+	item, err := s.client.NoteConnectionItemMap.Query().
+		Where(
+			noteconnectionitemmap.AccountIDEQ(row.ID),
+			noteconnectionitemmap.NoteIDEQ(conflict.NoteID),
+		).
+		WithNote().
+		Only(ctx)
+	if err != nil {
+		return ConflictResponse{}, err
+	}
+
+	switch keep {
+	case ResolveKeepLocal:
+		local := item.Edges.Note
+		if local.ProtectionMode != note.ProtectionModeNone {
+			return ConflictResponse{}, errors.New("protected notes cannot be pushed while locked")
+		}
+		provider, err := s.providerForAccount(ctx, row, nil)
+		if err != nil {
+			return ConflictResponse{}, err
+		}
+		if err := s.pushLocalVersion(ctx, provider, row, item, local); err != nil {
+			return ConflictResponse{}, redactProviderError(err)
+		}
+	case ResolveKeepRemote:
+		remote := RemoteNote{
+			ExternalID: item.ExternalID,
+			Title:      conflict.RemoteTitle,
+			Content:    conflict.RemoteContent,
+			UpdatedAt:  timePtr(conflict.RemoteUpdatedAt),
+		}
+		// The remote version is kept even when its files cannot be fetched
+		// any more; their links are then left as they are.
+		if provider, err := s.providerForAccount(ctx, row, nil); err == nil {
+			if withFiles, err := fetchRemoteAttachments(ctx, provider, remote); err == nil {
+				remote = withFiles
+			}
+		}
+		if err := s.pullRemoteVersion(ctx, row, item, remote); err != nil {
+			return ConflictResponse{}, err
+		}
+	}
+
+	conflict, err = conflict.Update().
+		SetStatus(ConflictResolved).
+		SetResolution(keep).
+		SetResolvedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return ConflictResponse{}, err
+	}
+	return conflictResponse(conflict), nil
+}
+
+func conflictResponse(row *ent.NoteConnectionConflict) ConflictResponse {
+	return ConflictResponse{
+		ID:              row.ID,
+		AccountID:       row.AccountID,
+		NoteID:          row.NoteID,
+		ExternalID:      row.ExternalID,
+		LocalTitle:      row.LocalTitle,
+		LocalContent:    row.LocalContent,
+		LocalUpdatedAt:  row.LocalUpdatedAt,
+		RemoteTitle:     row.RemoteTitle,
+		RemoteContent:   row.RemoteContent,
+		RemoteUpdatedAt: timePtr(row.RemoteUpdatedAt),
+		Status:          row.Status,
+		Resolution:      row.Resolution,
+		CreatedAt:       row.CreatedAt,
+		ResolvedAt:      timePtr(row.ResolvedAt),
+	}
+}
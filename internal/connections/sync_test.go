@@ -0,0 +1,332 @@
+package connections
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"smarticky/ent/enttest"
+	"smarticky/ent/note"
+	"smarticky/ent/noteconnectionconflict"
+
+	_ "github.com/lib-x/entsqlite"
+)
+
+// runQueuedSync queues a manual sync of the account and runs the claimed job
+// the way the queue worker does, returning its result.
+func runQueuedSync(ctx context.Context, service *Service, userID, accountID int) (*SyncResult, error) {
+	if _, err := service.EnqueueSync(ctx, userID, accountID, TriggerManual); err != nil {
+		return nil, err
+	}
+	job, err := service.claimNextJob(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, errors.New("queued sync job was not claimed")
+	}
+	result, err := service.runSyncJob(ctx, job)
+	if err != nil {
+		service.failJob(ctx, job, err)
+		return nil, err
+	}
+	return result, nil
+}
+
+func TestSyncJobPullsPushesAndRecordsConflicts(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestSyncJobPullsPushesAndRecordsConflicts?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetUsername("owner").
+		SetPasswordHash("hash").
+		SaveX(ctx)
+	joplin := newFakeJoplin(t, "joplin-token")
+	hourAgo := time.Now().Add(-time.Hour)
+	for _, title := range []string{"Alpha", "Beta", "Gamma", "Delta", "Epsilon"} {
+		joplin.put(joplinNote{ID: strings.ToLower(title), Title: title, Body: title + " v1", UpdatedTime: hourAgo.UnixMilli()})
+	}
+	service := NewService(client, testSecretBox(t), nil)
+	account, err := service.CreateAccount(ctx, u.ID, AccountInput{
+		Provider: ProviderJoplin,
+		Endpoint: joplin.server.URL,
+		Token:    stringPtr("joplin-token"),
+		Enabled:  true,
+	})
+	if err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+	if _, err := service.ImportNotes(ctx, u.ID, account.ID, ImportRequest{}); err != nil {
+		t.Fatalf("import notes: %v", err)
+	}
+
+	result, err := runQueuedSync(ctx, service, u.ID, account.ID)
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if result.TotalCount != 5 || result.SkippedCount != 5 || result.Status != JobCompleted {
+		t.Fatalf("expected an unchanged account to skip every note, got %+v", result)
+	}
+
+	localContent := func(title string) string {
+		t.Helper()
+		return client.Note.Query().Where(note.TitleEQ(title)).OnlyX(ctx).Content
+	}
+	editLocal := func(title, content string) {
+		t.Helper()
+		client.Note.Update().Where(note.TitleEQ(title)).SetContent(content).ExecX(ctx)
+	}
+	editLocal("Beta", "Beta local")
+	editLocal("Gamma", "Gamma local")
+	editLocal("Epsilon", "Epsilon local")
+	joplin.edit("alpha", "Alpha remote")
+	joplin.edit("gamma", "Gamma remote")
+	joplin.edit("epsilon", "Epsilon remote")
+	joplin.remove("delta")
+
+	result, err = runQueuedSync(ctx, service, u.ID, account.ID)
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	want := SyncResult{JobID: result.JobID, Status: JobCompletedWithErrors, TotalCount: 5, PulledCount: 1, PushedCount: 1, ConflictCount: 2, FailedCount: 1}
+	if *result != want {
+		t.Fatalf("sync result = %+v, want %+v", *result, want)
+	}
+	if got := localContent("Alpha"); got != "Alpha remote" {
+		t.Fatalf("expected the remote edit to be pulled, got %q", got)
+	}
+	if got := joplin.get("beta").Body; got != "Beta local" {
+		t.Fatalf("expected the local edit to be pushed, got %q", got)
+	}
+	if got := localContent("Gamma"); got != "Gamma local" {
+		t.Fatalf("conflicting local note was overwritten: %q", got)
+	}
+	if got := joplin.get("gamma").Body; got != "Gamma remote" {
+		t.Fatalf("conflicting remote note was overwritten: %q", got)
+	}
+	job := client.NoteConnectionJob.GetX(ctx, result.JobID)
+	if job.Operation != OperationSync || job.ConflictCount != 2 || !strings.Contains(job.Message, "Delta") {
+		t.Fatalf("unexpected sync job: %+v", job)
+	}
+
+	conflicts, err := service.ListConflicts(ctx, u.ID, account.ID)
+	if err != nil {
+		t.Fatalf("list conflicts: %v", err)
+	}
+	if len(conflicts) != 2 {
+		t.Fatalf("expected two conflicts, got %+v", conflicts)
+	}
+	byExternalID := map[string]ConflictResponse{}
+	for _, conflict := range conflicts {
+		byExternalID[conflict.ExternalID] = conflict
+	}
+	gamma := byExternalID["gamma"]
+	if gamma.LocalContent != "Gamma local" || gamma.RemoteContent != "Gamma remote" || gamma.RemoteUpdatedAt == nil {
+		t.Fatalf("conflict does not keep both versions: %+v", gamma)
+	}
+
+	// Nothing moves again until the conflicts are resolved.
+	result, err = runQueuedSync(ctx, service, u.ID, account.ID)
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if result.PulledCount != 0 || result.PushedCount != 0 || result.ConflictCount != 2 || result.SkippedCount != 2 {
+		t.Fatalf("expected synced notes to stay put, got %+v", result)
+	}
+	if n := client.NoteConnectionConflict.Query().CountX(ctx); n != 2 {
+		t.Fatalf("expected open conflicts not to be recorded twice, got %d", n)
+	}
+
+	if _, err := service.ResolveConflict(ctx, u.ID, account.ID, gamma.ID, ResolveConflictRequest{Keep: "both"}); !errors.Is(err, ErrInvalidConflictResolution) {
+		t.Fatalf("resolve error = %v, want ErrInvalidConflictResolution", err)
+	}
+	resolved, err := service.ResolveConflict(ctx, u.ID, account.ID, gamma.ID, ResolveConflictRequest{Keep: ResolveKeepRemote})
+	if err != nil {
+		t.Fatalf("resolve gamma: %v", err)
+	}
+	if resolved.Status != ConflictResolved || resolved.Resolution != ResolveKeepRemote {
+		t.Fatalf("unexpected resolved conflict: %+v", resolved)
+	}
+	if got := localContent("Gamma"); got != "Gamma remote" {
+		t.Fatalf("expected the remote version to be kept, got %q", got)
+	}
+	if _, err := service.ResolveConflict(ctx, u.ID, account.ID, byExternalID["epsilon"].ID, ResolveConflictRequest{Keep: ResolveKeepLocal}); err != nil {
+		t.Fatalf("resolve epsilon: %v", err)
+	}
+	if got := joplin.get("epsilon").Body; got != "Epsilon local" {
+		t.Fatalf("expected the local version to be pushed, got %q", got)
+	}
+	if _, err := service.ResolveConflict(ctx, u.ID, account.ID, gamma.ID, ResolveConflictRequest{Keep: ResolveKeepLocal}); !errors.Is(err, ErrConflictResolved) {
+		t.Fatalf("resolve error = %v, want ErrConflictResolved", err)
+	}
+	if n := client.NoteConnectionConflict.Query().Where(noteconnectionconflict.StatusEQ(ConflictOpen)).CountX(ctx); n != 0 {
+		t.Fatalf("expected no open conflicts, got %d", n)
+	}
+
+	result, err = runQueuedSync(ctx, service, u.ID, account.ID)
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if result.SkippedCount != 4 || result.FailedCount != 1 {
+		t.Fatalf("expected resolved notes to be in sync, got %+v", result)
+	}
+}
+
+// fakeJoplin serves the parts of the Joplin data API that import and sync
+// use, keeping notes and resources in memory. Listing notes fails while
+// failList is set and waits for hold to be closed when it is not nil.
+type fakeJoplin struct {
+	server    *httptest.Server
+	mu        sync.Mutex
+	notes     map[string]joplinNote
+	order     []string
+	resources map[string]joplinResource
+	files     map[string][]byte
+	failList  bool
+	hold      chan struct{}
+}
+
+func newFakeJoplin(t *testing.T, token string) *fakeJoplin {
+	t.Helper()
+	f := &fakeJoplin{notes: map[string]joplinNote{}, resources: map[string]joplinResource{}, files: map[string][]byte{}}
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("token") != token {
+			http.Error(w, "invalid token", http.StatusForbidden)
+			return
+		}
+		if r.URL.Path == "/notes" {
+			f.mu.Lock()
+			hold, failList := f.hold, f.failList
+			f.mu.Unlock()
+			if hold != nil {
+				select {
+				case <-hold:
+				case <-r.Context().Done():
+					return
+				}
+			}
+			if failList {
+				http.Error(w, "unavailable", http.StatusServiceUnavailable)
+				return
+			}
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		switch {
+		case r.URL.Path == "/folders":
+			_ = json.NewEncoder(w).Encode(joplinList[joplinFolder]{})
+		case r.URL.Path == "/notes" && r.Method == http.MethodGet:
+			var list joplinList[joplinNote]
+			for _, id := range f.order {
+				if item, ok := f.notes[id]; ok {
+					list.Items = append(list.Items, item)
+				}
+			}
+			_ = json.NewEncoder(w).Encode(list)
+		case strings.HasPrefix(r.URL.Path, "/notes/"):
+			id := strings.TrimPrefix(r.URL.Path, "/notes/")
+			item, ok := f.notes[id]
+			if !ok {
+				http.Error(w, "not found", http.StatusNotFound)
+				return
+			}
+			if r.Method == http.MethodPut {
+				var body struct {
+					Title string `json:"title"`
+					Body  string `json:"body"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				item.Title, item.Body, item.UpdatedTime = body.Title, body.Body, time.Now().UnixMilli()
+				f.notes[id] = item
+			}
+			_ = json.NewEncoder(w).Encode(item)
+		case r.URL.Path == "/resources" && r.Method == http.MethodPost:
+			file, header, err := r.FormFile("data")
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			data, _ := io.ReadAll(file)
+			var props struct {
+				Title string `json:"title"`
+			}
+			_ = json.Unmarshal([]byte(r.FormValue("props")), &props)
+			resource := joplinResource{
+				ID:    fmt.Sprintf("%032x", len(f.resources)+1),
+				Title: props.Title,
+				Mime:  header.Header.Get("Content-Type"),
+				Size:  int64(len(data)),
+			}
+			f.resources[resource.ID], f.files[resource.ID] = resource, data
+			_ = json.NewEncoder(w).Encode(resource)
+		case strings.HasPrefix(r.URL.Path, "/resources/"):
+			id, file := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/resources/"), "/file")
+			resource, ok := f.resources[id]
+			if !ok {
+				http.Error(w, "not found", http.StatusNotFound)
+				return
+			}
+			if file {
+				_, _ = w.Write(f.files[id])
+				return
+			}
+			_ = json.NewEncoder(w).Encode(resource)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *fakeJoplin) put(item joplinNote) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.notes[item.ID] = item
+	f.order = append(f.order, item.ID)
+}
+
+func (f *fakeJoplin) putResource(id, title, mimeType string, data []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.resources[id] = joplinResource{ID: id, Title: title, Mime: mimeType, Size: int64(len(data))}
+	f.files[id] = data
+}
+
+func (f *fakeJoplin) resourceCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.resources)
+}
+
+func (f *fakeJoplin) edit(id, body string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	item := f.notes[id]
+	item.Body, item.UpdatedTime = body, time.Now().UnixMilli()
+	f.notes[id] = item
+}
+
+func (f *fakeJoplin) remove(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.notes, id)
+}
+
+func (f *fakeJoplin) get(id string) joplinNote {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.notes[id]
+}
@@ -8,9 +8,14 @@ import (
 )
 
 const (
-	ProviderSiYuan = "siyuan"
-	ProviderNotion = "notion"
-	ProviderJoplin = "joplin"
+	ProviderSiYuan       = "siyuan"
+	ProviderNotion       = "notion"
+	ProviderJoplin       = "joplin"
+	ProviderVault        = "vault"
+	ProviderGit          = "git"
+	ProviderTrilium      = "trilium"
+	ProviderMemos        = "memos"
+	ProviderStandardFile = "standardfile"
 
 	StatusNever   = "never"
 	StatusSuccess = "success"
@@ -18,16 +23,35 @@ const (
 
 	OperationImport = "import"
 	OperationPush   = "push"
+	OperationSync   = "sync"
+
+	ConflictOpen     = "open"
+	ConflictResolved = "resolved"
+
+	ResolveKeepLocal  = "local"
+	ResolveKeepRemote = "remote"
 
 	JobPending             = "pending"
 	JobRunning             = "running"
 	JobCompleted           = "completed"
 	JobCompletedWithErrors = "completed_with_errors"
 	JobFailed              = "failed"
+	JobCanceled            = "canceled"
+	// JobPreviewed is an import preview waiting to be confirmed. Workers
+	// never pick it up.
+	JobPreviewed = "previewed"
+
+	TriggerManual    = "manual"
+	TriggerScheduled = "scheduled"
+	TriggerWebhook   = "webhook"
 )
 
 type Credentials struct {
 	Token string `json:"token"`
+	// RefreshToken and ExpiresAt are set for accounts connected with OAuth
+	// whose access tokens expire.
+	RefreshToken string     `json:"refresh_token,omitempty"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
 }
 
 type AccountInput struct {
@@ -39,23 +63,28 @@ type AccountInput struct {
 	DefaultTargetName string  `json:"default_target_name"`
 	Enabled           bool    `json:"enabled"`
 	ClearCredentials  bool    `json:"clear_credentials"`
+	// SyncIntervalMinutes schedules a two-way sync; 0 turns it off.
+	SyncIntervalMinutes int `json:"sync_interval_minutes"`
 }
 
 type AccountResponse struct {
-	ID                int        `json:"id"`
-	Name              string     `json:"name"`
-	Provider          string     `json:"provider"`
-	Endpoint          string     `json:"endpoint"`
-	Enabled           bool       `json:"enabled"`
-	AuthType          string     `json:"auth_type"`
-	HasCredentials    bool       `json:"has_credentials"`
-	DefaultTargetID   string     `json:"default_target_id"`
-	DefaultTargetName string     `json:"default_target_name"`
-	LastTestStatus    string     `json:"last_test_status"`
-	LastTestError     string     `json:"last_test_error,omitempty"`
-	LastTestAt        *time.Time `json:"last_test_at,omitempty"`
-	CreatedAt         time.Time  `json:"created_at"`
-	UpdatedAt         time.Time  `json:"updated_at"`
+	ID                  int        `json:"id"`
+	Name                string     `json:"name"`
+	Provider            string     `json:"provider"`
+	Endpoint            string     `json:"endpoint"`
+	Enabled             bool       `json:"enabled"`
+	AuthType            string     `json:"auth_type"`
+	HasCredentials      bool       `json:"has_credentials"`
+	DefaultTargetID     string     `json:"default_target_id"`
+	DefaultTargetName   string     `json:"default_target_name"`
+	LastTestStatus      string     `json:"last_test_status"`
+	LastTestError       string     `json:"last_test_error,omitempty"`
+	LastTestAt          *time.Time `json:"last_test_at,omitempty"`
+	SyncIntervalMinutes int        `json:"sync_interval_minutes"`
+	NextSyncAt          *time.Time `json:"next_sync_at,omitempty"`
+	WebhookEnabled      bool       `json:"webhook_enabled"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
 }
 
 type Target struct {
@@ -74,8 +103,38 @@ type RemoteNote struct {
 	Title      string
 	Content    string
 	Tags       []string
+	Aliases    []string
 	CreatedAt  *time.Time
 	UpdatedAt  *time.Time
+	// Attachments are the downloaded files the note embeds, filled in by
+	// the service from ListAttachments. Each Embed occurrence in Content is
+	// replaced by a link to the stored attachment on import.
+	Attachments []RemoteAttachment
+}
+
+type RemoteAttachment struct {
+	Name     string
+	MIMEType string
+	Data     []byte
+	Embed    string
+	// Link is how pushed content can refer to the remote copy again. It is
+	// empty when the copy cannot be reused, for example an expiring URL.
+	Link string
+}
+
+// AttachmentRef points at a file a remote note embeds. ID is whatever the
+// provider needs to download it, such as a resource id or an asset path.
+type AttachmentRef struct {
+	ID    string
+	Name  string
+	Embed string
+}
+
+// LocalAttachment is a file of a local note that is uploaded on push.
+type LocalAttachment struct {
+	Name     string
+	MIMEType string
+	Data     []byte
 }
 
 type PushInput struct {
@@ -84,6 +143,14 @@ type PushInput struct {
 	Content            string
 	TargetID           string
 	ExistingExternalID string
+	// Tags, Aliases and the timestamps are only written by providers that
+	// store them next to the content, such as front matter in a vault.
+	Tags      []string
+	Aliases   []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// FolderPath is the note's folder and its parents, outermost first.
+	FolderPath []string
 }
 
 type PushResult struct {
@@ -125,4 +192,49 @@ type Provider interface {
 	ListTargets(ctx context.Context) ([]Target, error)
 	ImportNotes(ctx context.Context, targetID string, limit int) ([]RemoteNote, error)
 	PushNote(ctx context.Context, input PushInput) (PushResult, error)
+	// GetNote fetches one note by its external id. It returns
+	// ErrRemoteNoteNotFound when the note no longer exists.
+	GetNote(ctx context.Context, externalID string) (RemoteNote, error)
+	// ListAttachments finds the files a remote note embeds that are stored
+	// by the provider. Links to other sites are not included.
+	ListAttachments(ctx context.Context, remote RemoteNote) ([]AttachmentRef, error)
+	// DownloadAttachment fetches a file found by ListAttachments.
+	DownloadAttachment(ctx context.Context, ref AttachmentRef) (RemoteAttachment, error)
+	// UploadAttachment stores a local file with the provider and returns
+	// the link that pushed content should use for it.
+	UploadAttachment(ctx context.Context, file LocalAttachment) (string, error)
+}
+
+type SyncResult struct {
+	JobID         int    `json:"job_id"`
+	Status        string `json:"status"`
+	TotalCount    int    `json:"total_count"`
+	PulledCount   int    `json:"pulled_count"`
+	PushedCount   int    `json:"pushed_count"`
+	SkippedCount  int    `json:"skipped_count"`
+	ConflictCount int    `json:"conflict_count"`
+	FailedCount   int    `json:"failed_count"`
+}
+
+type ConflictResponse struct {
+	ID              int        `json:"id"`
+	AccountID       int        `json:"account_id"`
+	NoteID          uuid.UUID  `json:"note_id"`
+	ExternalID      string     `json:"external_id"`
+	LocalTitle      string     `json:"local_title"`
+	LocalContent    string     `json:"local_content"`
+	LocalUpdatedAt  time.Time  `json:"local_updated_at"`
+	RemoteTitle     string     `json:"remote_title"`
+	RemoteContent   string     `json:"remote_content"`
+	RemoteUpdatedAt *time.Time `json:"remote_updated_at,omitempty"`
+	Status          string     `json:"status"`
+	Resolution      string     `json:"resolution,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	ResolvedAt      *time.Time `json:"resolved_at,omitempty"`
+}
+
+type ResolveConflictRequest struct {
+	// Keep is "local" to push the local note or "remote" to take the remote
+	// version.
+	Keep string `json:"keep"`
 }
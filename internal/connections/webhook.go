@@ -0,0 +1,326 @@
+package connections
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/noteconnectionitemmap"
+	"smarticky/ent/noteconnectionjob"
+)
+
+const (
+	// WebhookSignaturePrefix starts the signature header value, which is
+	// followed by the hex HMAC-SHA256, keyed with the account's webhook
+	// secret, of "<timestamp>.<delivery id>.<body>".
+	WebhookSignaturePrefix = "sha256="
+	// webhookTolerance is how far a delivery's timestamp may be from the
+	// server clock. Delivery ids are remembered for as long.
+	webhookTolerance = 5 * time.Minute
+	// maxWebhookDeliveryID bounds the length of a delivery id.
+	maxWebhookDeliveryID = 200
+	// maxWebhookExternalIDs bounds the notes one delivery can name.
+	maxWebhookExternalIDs = 500
+)
+
+var (
+	ErrWebhookUnauthorized = errors.New("webhook secret or signature is invalid")
+	ErrWebhookExpired      = errors.New("webhook timestamp is more than 5 minutes off")
+	ErrWebhookReplayed     = errors.New("webhook delivery was already received")
+	ErrInvalidWebhook      = errors.New("webhook body must list between 1 and 500 external_ids")
+)
+
+// WebhookSecretResponse carries a newly created webhook secret. The secret
+// is shown once; rotating replaces it.
+type WebhookSecretResponse struct {
+	AccountID int    `json:"account_id"`
+	Path      string `json:"path"`
+	Secret    string `json:"secret"`
+}
+
+// WebhookAuth is what a delivery presented: the secret itself, or a
+// signature made with it, plus the unix time it was sent and an id that is
+// unique per delivery. Both are required, and signatures cover them.
+type WebhookAuth struct {
+	Token      string
+	Signature  string
+	Timestamp  string
+	DeliveryID string
+}
+
+// webhookDeliveries remembers recently accepted delivery ids so a captured
+// delivery cannot be replayed while its timestamp is still accepted.
+type webhookDeliveries struct {
+	mu   sync.Mutex
+	seen map[webhookDeliveryKey]time.Time // -> when the id can be forgotten
+}
+
+type webhookDeliveryKey struct {
+	accountID int
+	id        string
+}
+
+// claim records the delivery, reporting false if it was seen before.
+func (d *webhookDeliveries) claim(key webhookDeliveryKey, until, now time.Time) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.seen == nil {
+		d.seen = make(map[webhookDeliveryKey]time.Time)
+	}
+	for seen, expires := range d.seen {
+		if now.After(expires) {
+			delete(d.seen, seen)
+		}
+	}
+	if _, ok := d.seen[key]; ok {
+		return false
+	}
+	d.seen[key] = until
+	return true
+}
+
+// release forgets a delivery that failed, so the sender can retry it.
+func (d *webhookDeliveries) release(key webhookDeliveryKey) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.seen, key)
+}
+
+// WebhookDelivery is the body providers and local automation send when
+// remote notes change.
+type WebhookDelivery struct {
+	ExternalIDs []string `json:"external_ids"`
+}
+
+// WebhookResult reports which of the delivered ids are linked to local
+// notes. Job is the sync that refreshes them, or nil when none were.
+type WebhookResult struct {
+	Job          *ent.NoteConnectionJob `json:"job,omitempty"`
+	MatchedCount int                    `json:"matched_count"`
+	IgnoredCount int                    `json:"ignored_count"`
+}
+
+// syncOptions is kept in a sync job's options_json.
+type syncOptions struct {
+	// ExternalIDs limits the sync to these linked notes; nil syncs all.
+	ExternalIDs []string `json:"external_ids,omitempty"`
+}
+
+func encodeSyncOptions(options syncOptions) string {
+	data, err := json.Marshal(options)
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
+
+func decodeSyncOptions(raw string) (syncOptions, error) {
+	var options syncOptions
+	if strings.TrimSpace(raw) == "" {
+		return options, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &options); err != nil {
+		return syncOptions{}, fmt.Errorf("decode sync options: %w", err)
+	}
+	return options, nil
+}
+
+// WebhookPath is the public route that receives an account's webhooks.
+func WebhookPath(accountID int) string {
+	return fmt.Sprintf("/api/note-connections/webhooks/%d", accountID)
+}
+
+// RotateWebhookSecret enables the account's webhook with a new secret,
+// invalidating the previous one.
+func (s *Service) RotateWebhookSecret(ctx context.Context, userID, accountID int) (*WebhookSecretResponse, error) {
+	row, err := s.accountForUser(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	secret := "smky_whsec_" + base64.RawURLEncoding.EncodeToString(raw)
+	encrypted, err := s.box.Seal([]byte(secret))
+	if err != nil {
+		return nil, err
+	}
+	if err := row.Update().SetEncryptedWebhookSecret(encrypted).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return &WebhookSecretResponse{AccountID: row.ID, Path: WebhookPath(row.ID), Secret: secret}, nil
+}
+
+// DisableWebhook removes the account's webhook secret so deliveries are
+// rejected.
+func (s *Service) DisableWebhook(ctx context.Context, userID, accountID int) (AccountResponse, error) {
+	row, err := s.accountForUser(ctx, userID, accountID)
+	if err != nil {
+		return AccountResponse{}, err
+	}
+	row, err = row.Update().ClearEncryptedWebhookSecret().Save(ctx)
+	if err != nil {
+		return AccountResponse{}, err
+	}
+	response := accountResponse(row)
+	response.NextSyncAt = s.nextSyncAt(row.ID)
+	return response, nil
+}
+
+// ReceiveWebhook verifies a delivery for the account and queues a sync of
+// the linked notes it names. Unknown accounts and accounts without a
+// webhook are reported as unauthorized so the route does not reveal which
+// accounts exist. Deliveries sent more than webhookTolerance from now, or
+// whose id was already accepted, are refused.
+func (s *Service) ReceiveWebhook(ctx context.Context, accountID int, auth WebhookAuth, body []byte) (*WebhookResult, error) {
+	row, err := s.client.NoteConnectionAccount.Get(ctx, accountID)
+	if ent.IsNotFound(err) {
+		return nil, ErrWebhookUnauthorized
+	}
+	if err != nil {
+		return nil, err
+	}
+	if row.EncryptedWebhookSecret == "" {
+		return nil, ErrWebhookUnauthorized
+	}
+	secret, err := s.box.Open(row.EncryptedWebhookSecret)
+	if err != nil {
+		return nil, err
+	}
+	if !verifyWebhook(secret, auth, body) {
+		return nil, ErrWebhookUnauthorized
+	}
+	sentAt, _ := strconv.ParseInt(strings.TrimSpace(auth.Timestamp), 10, 64)
+	sent, now := time.Unix(sentAt, 0), time.Now()
+	if sent.Before(now.Add(-webhookTolerance)) || sent.After(now.Add(webhookTolerance)) {
+		return nil, ErrWebhookExpired
+	}
+	if err := ensureAccountEnabled(row); err != nil {
+		return nil, err
+	}
+	key := webhookDeliveryKey{accountID: row.ID, id: strings.TrimSpace(auth.DeliveryID)}
+	if !s.deliveries.claim(key, sent.Add(webhookTolerance), now) {
+		return nil, ErrWebhookReplayed
+	}
+	result, err := s.receiveWebhook(ctx, row, body)
+	if err != nil {
+		s.deliveries.release(key)
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *Service) receiveWebhook(ctx context.Context, row *ent.NoteConnectionAccount, body []byte) (*WebhookResult, error) {
+	var delivery WebhookDelivery
+	if err := json.Unmarshal(body, &delivery); err != nil {
+		return nil, ErrInvalidWebhook
+	}
+	externalIDs := uniqueStrings(delivery.ExternalIDs)
+	if len(externalIDs) == 0 || len(externalIDs) > maxWebhookExternalIDs {
+		return nil, ErrInvalidWebhook
+	}
+	matched, err := s.client.NoteConnectionItemMap.Query().
+		Where(
+			noteconnectionitemmap.AccountIDEQ(row.ID),
+			noteconnectionitemmap.ExternalIDIn(externalIDs...),
+		).
+		Select(noteconnectionitemmap.FieldExternalID).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matched = uniqueStrings(matched)
+	result := &WebhookResult{MatchedCount: len(matched), IgnoredCount: len(externalIDs) - len(matched)}
+	if len(matched) == 0 {
+		return result, nil
+	}
+	result.Job, err = s.enqueueWebhookSync(ctx, row, matched)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// enqueueWebhookSync queues a sync of the given linked notes. Deliveries
+// that arrive while a sync is still waiting are folded into it, so a burst
+// of changes runs as one job.
+func (s *Service) enqueueWebhookSync(ctx context.Context, row *ent.NoteConnectionAccount, externalIDs []string) (*ent.NoteConnectionJob, error) {
+	if _, err := s.providerForAccount(ctx, row, nil); err != nil {
+		return nil, err
+	}
+	pending, err := s.client.NoteConnectionJob.Query().
+		Where(
+			noteconnectionjob.AccountIDEQ(row.ID),
+			noteconnectionjob.OperationEQ(OperationSync),
+			noteconnectionjob.StatusEQ(JobPending),
+		).
+		Order(ent.Asc(noteconnectionjob.FieldID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if pending != nil {
+		options, err := decodeSyncOptions(pending.OptionsJSON)
+		if err != nil {
+			return nil, err
+		}
+		if options.ExternalIDs == nil {
+			// A full sync is already waiting and covers these notes.
+			return pending, nil
+		}
+		options.ExternalIDs = uniqueStrings(append(options.ExternalIDs, externalIDs...))
+		updated, err := s.client.NoteConnectionJob.Update().
+			Where(noteconnectionjob.ID(pending.ID), noteconnectionjob.StatusEQ(JobPending)).
+			SetOptionsJSON(encodeSyncOptions(options)).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if updated == 1 {
+			return s.client.NoteConnectionJob.Get(ctx, pending.ID)
+		}
+		// A worker claimed the job in the meantime; queue a new one.
+	}
+	return s.enqueue(ctx, s.syncJobCreate(row, TriggerWebhook).
+		SetOptionsJSON(encodeSyncOptions(syncOptions{ExternalIDs: externalIDs})))
+}
+
+// verifyWebhook accepts a valid signature or, failing that, the secret
+// itself. Both comparisons take constant time. A delivery without a
+// timestamp or delivery id is never accepted.
+func verifyWebhook(secret []byte, auth WebhookAuth, body []byte) bool {
+	timestamp, deliveryID := strings.TrimSpace(auth.Timestamp), strings.TrimSpace(auth.DeliveryID)
+	if timestamp == "" || deliveryID == "" || len(deliveryID) > maxWebhookDeliveryID {
+		return false
+	}
+	if signature, ok := strings.CutPrefix(strings.TrimSpace(auth.Signature), WebhookSignaturePrefix); ok {
+		got, err := hex.DecodeString(signature)
+		if err != nil {
+			return false
+		}
+		return hmac.Equal(got, webhookSignature(secret, timestamp, deliveryID, body))
+	}
+	token := strings.TrimSpace(auth.Token)
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), secret) == 1
+}
+
+// webhookSignature is the HMAC-SHA256 a signed delivery must carry.
+func webhookSignature(secret []byte, timestamp, deliveryID string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp + "." + deliveryID + "."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,145 @@
+package connections
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"smarticky/ent/enttest"
+	"smarticky/ent/note"
+)
+
+func TestReceiveWebhookQueuesSyncOfLinkedNotes(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestReceiveWebhookQueuesSyncOfLinkedNotes?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	u := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	joplin := newFakeJoplin(t, "joplin-token")
+	hourAgo := time.Now().Add(-time.Hour)
+	for _, title := range []string{"Alpha", "Beta", "Gamma"} {
+		joplin.put(joplinNote{ID: strings.ToLower(title), Title: title, Body: title + " v1", UpdatedTime: hourAgo.UnixMilli()})
+	}
+	service := NewService(client, testSecretBox(t), nil)
+	account, err := service.CreateAccount(ctx, u.ID, AccountInput{
+		Provider: ProviderJoplin,
+		Endpoint: joplin.server.URL,
+		Token:    stringPtr("joplin-token"),
+		Enabled:  true,
+	})
+	if err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+	if _, err := service.ImportNotes(ctx, u.ID, account.ID, ImportRequest{}); err != nil {
+		t.Fatalf("import notes: %v", err)
+	}
+
+	deliveries := 0
+	// fresh stamps auth as a new delivery sent now.
+	fresh := func(auth WebhookAuth) WebhookAuth {
+		deliveries++
+		auth.Timestamp = strconv.FormatInt(time.Now().Unix(), 10)
+		auth.DeliveryID = "delivery-" + strconv.Itoa(deliveries)
+		return auth
+	}
+	if _, err := service.ReceiveWebhook(ctx, account.ID, fresh(WebhookAuth{Token: "guess"}), []byte(`{"external_ids":["alpha"]}`)); !errors.Is(err, ErrWebhookUnauthorized) {
+		t.Fatalf("delivery without a webhook error = %v, want ErrWebhookUnauthorized", err)
+	}
+	secret, err := service.RotateWebhookSecret(ctx, u.ID, account.ID)
+	if err != nil {
+		t.Fatalf("rotate webhook secret: %v", err)
+	}
+	// signed is a fresh delivery of body signed with the secret.
+	signed := func(body string) WebhookAuth {
+		auth := fresh(WebhookAuth{})
+		mac := hmac.New(sha256.New, []byte(secret.Secret))
+		mac.Write([]byte(auth.Timestamp + "." + auth.DeliveryID + "." + body))
+		auth.Signature = WebhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+		return auth
+	}
+
+	body := []byte(`{"external_ids":["alpha","missing"]}`)
+	forged := signed(string(body))
+	forged.DeliveryID += "-again"
+	for _, auth := range []WebhookAuth{
+		fresh(WebhookAuth{Token: secret.Secret + "x"}),
+		signed(`{"external_ids":["gamma"]}`),
+		fresh(WebhookAuth{Signature: "sha256=not-hex", Token: secret.Secret}),
+		forged,
+		{Token: secret.Secret},
+	} {
+		if _, err := service.ReceiveWebhook(ctx, account.ID, auth, body); !errors.Is(err, ErrWebhookUnauthorized) {
+			t.Fatalf("delivery with %+v error = %v, want ErrWebhookUnauthorized", auth, err)
+		}
+	}
+	stale := fresh(WebhookAuth{Token: secret.Secret})
+	stale.Timestamp = strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10)
+	if _, err := service.ReceiveWebhook(ctx, account.ID, stale, body); !errors.Is(err, ErrWebhookExpired) {
+		t.Fatalf("stale delivery error = %v, want ErrWebhookExpired", err)
+	}
+	tokenAuth := fresh(WebhookAuth{Token: secret.Secret})
+	first, err := service.ReceiveWebhook(ctx, account.ID, tokenAuth, body)
+	if err != nil {
+		t.Fatalf("token delivery: %v", err)
+	}
+	if _, err := service.ReceiveWebhook(ctx, account.ID, tokenAuth, body); !errors.Is(err, ErrWebhookReplayed) {
+		t.Fatalf("replayed delivery error = %v, want ErrWebhookReplayed", err)
+	}
+	if first.Job == nil || first.Job.Trigger != TriggerWebhook || first.MatchedCount != 1 || first.IgnoredCount != 1 {
+		t.Fatalf("token delivery = %+v, want a webhook sync of alpha", first)
+	}
+	beta := `{"external_ids":["beta"]}`
+	second, err := service.ReceiveWebhook(ctx, account.ID, signed(beta), []byte(beta))
+	if err != nil {
+		t.Fatalf("signed delivery: %v", err)
+	}
+	if second.Job == nil || second.Job.ID != first.Job.ID {
+		t.Fatalf("signed delivery = %+v, want it folded into the waiting job %d", second, first.Job.ID)
+	}
+	if unmatched, err := service.ReceiveWebhook(ctx, account.ID, fresh(WebhookAuth{Token: secret.Secret}), []byte(`{"external_ids":["missing"]}`)); err != nil || unmatched.Job != nil || unmatched.IgnoredCount != 1 {
+		t.Fatalf("unmatched delivery = %+v, %v, want no job", unmatched, err)
+	}
+	empty := fresh(WebhookAuth{Token: secret.Secret})
+	if _, err := service.ReceiveWebhook(ctx, account.ID, empty, []byte(`{"external_ids":[]}`)); !errors.Is(err, ErrInvalidWebhook) {
+		t.Fatalf("empty delivery error = %v, want ErrInvalidWebhook", err)
+	}
+	// A rejected delivery can be retried with its id.
+	if _, err := service.ReceiveWebhook(ctx, account.ID, empty, []byte(`{"external_ids":["missing"]}`)); err != nil {
+		t.Fatalf("retried delivery: %v", err)
+	}
+
+	joplin.edit("alpha", "Alpha remote")
+	joplin.edit("beta", "Beta remote")
+	joplin.edit("gamma", "Gamma remote")
+	result, err := service.runSyncJob(ctx, client.NoteConnectionJob.GetX(ctx, first.Job.ID))
+	if err != nil {
+		t.Fatalf("run webhook sync: %v", err)
+	}
+	if result.TotalCount != 2 || result.PulledCount != 2 {
+		t.Fatalf("result = %+v, want only alpha and beta pulled", result)
+	}
+	if got := client.Note.Query().Where(note.TitleEQ("Gamma")).OnlyX(ctx).Content; got != "Gamma v1" {
+		t.Fatalf("gamma = %q, want it left for a full sync", got)
+	}
+
+	rotated, err := service.RotateWebhookSecret(ctx, u.ID, account.ID)
+	if err != nil {
+		t.Fatalf("rotate again: %v", err)
+	}
+	if _, err := service.ReceiveWebhook(ctx, account.ID, fresh(WebhookAuth{Token: secret.Secret}), body); !errors.Is(err, ErrWebhookUnauthorized) {
+		t.Fatalf("old secret error = %v, want ErrWebhookUnauthorized", err)
+	}
+	disabled, err := service.DisableWebhook(ctx, u.ID, account.ID)
+	if err != nil || disabled.WebhookEnabled {
+		t.Fatalf("disable webhook = %+v, %v, want it disabled", disabled, err)
+	}
+	if _, err := service.ReceiveWebhook(ctx, account.ID, fresh(WebhookAuth{Token: rotated.Secret}), body); !errors.Is(err, ErrWebhookUnauthorized) {
+		t.Fatalf("disabled webhook error = %v, want ErrWebhookUnauthorized", err)
+	}
+}
@@ -2,7 +2,6 @@ package handler
 
 import (
 	"archive/tar"
-	"bytes"
 	"compress/gzip"
 	"context"
 	"database/sql"
@@ -30,9 +29,11 @@ import (
 )
 
 type backupScheduleData struct {
-	TaskID   int
-	Enabled  bool
-	Schedule string
+	TaskID         int
+	Enabled        bool
+	Schedule       string
+	CronExpression string
+	Timezone       string
 }
 
 var errBackupSchedulerUnavailable = errors.New("backup scheduler is not running")
@@ -79,56 +80,22 @@ func (h *Handler) removeDatabaseSidecars() error {
 	return nil
 }
 
-// createBackupArchive creates a tar.gz archive containing database and uploads
-func (h *Handler) createBackupArchive() (*bytes.Buffer, error) {
-	buf := new(bytes.Buffer)
-	gzWriter := gzip.NewWriter(buf)
-	defer gzWriter.Close()
-
+// writeBackupArchive streams a tar.gz archive containing a database snapshot,
+// its manifest and uploads into w without buffering the archive in memory
+func (h *Handler) writeBackupArchive(w io.Writer) error {
+	gzWriter := gzip.NewWriter(w)
 	tarWriter := tar.NewWriter(gzWriter)
-	defer tarWriter.Close()
 
 	dataDir := h.fs.GetDataDir()
 	fs := h.fs.GetFs()
 
-	// Helper function to add a file to tar archive
 	addFile := func(path string, name string) error {
-		fileInfo, err := h.fs.Stat(path)
-		if err != nil {
-			return fmt.Errorf("failed to stat %s: %w", path, err)
-		}
-
-		header, err := tar.FileInfoHeader(fileInfo, "")
-		if err != nil {
-			return fmt.Errorf("failed to create tar header: %w", err)
-		}
-		header.Name = name
-
-		if err := tarWriter.WriteHeader(header); err != nil {
-			return fmt.Errorf("failed to write tar header: %w", err)
-		}
-
-		if fileInfo.IsDir() {
-			return nil
-		}
-
-		file, err := h.fs.Open(path)
-		if err != nil {
-			return fmt.Errorf("failed to open %s: %w", path, err)
-		}
-		defer file.Close()
-
-		if _, err := io.Copy(tarWriter, file); err != nil {
-			return fmt.Errorf("failed to copy file data: %w", err)
-		}
-
-		return nil
+		return h.addBackupArchiveFile(tarWriter, path, name)
 	}
 
-	// Add database file
-	dbPath := h.getDBPath()
-	if err := addFile(dbPath, "smarticky.db"); err != nil {
-		return nil, err
+	// Add database snapshot and manifest
+	if err := h.addBackupDatabase(tarWriter); err != nil {
+		return err
 	}
 
 	// Add uploads directory recursively
@@ -140,7 +107,7 @@ func (h *Handler) createBackupArchive() (*bytes.Buffer, error) {
 			Typeflag: tar.TypeDir,
 			Mode:     0755,
 		}); err != nil {
-			return nil, fmt.Errorf("failed to add empty uploads directory: %w", err)
+			return fmt.Errorf("failed to add empty uploads directory: %w", err)
 		}
 	} else {
 		err := afero.Walk(fs, uploadsDir, func(path string, info os.FileInfo, err error) error {
@@ -154,35 +121,68 @@ func (h *Handler) createBackupArchive() (*bytes.Buffer, error) {
 				return err
 			}
 
-			return addFile(path, relPath)
+			return addFile(path, filepath.ToSlash(relPath))
 		})
 
 		if err != nil {
-			return nil, fmt.Errorf("failed to add uploads directory: %w", err)
+			return fmt.Errorf("failed to add uploads directory: %w", err)
 		}
 	}
 
 	if err := tarWriter.Close(); err != nil {
-		return nil, err
+		return err
+	}
+	return gzWriter.Close()
+}
+
+// addBackupArchiveFile adds the file or directory at path to tarWriter as name.
+func (h *Handler) addBackupArchiveFile(tarWriter *tar.Writer, path string, name string) error {
+	fileInfo, err := h.fs.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+
+	header, err := tar.FileInfoHeader(fileInfo, "")
+	if err != nil {
+		return fmt.Errorf("failed to create tar header: %w", err)
 	}
-	if err := gzWriter.Close(); err != nil {
-		return nil, err
+	header.Name = name
+
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write tar header: %w", err)
 	}
 
-	return buf, nil
+	if fileInfo.IsDir() {
+		return nil
+	}
+
+	file, err := h.fs.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(tarWriter, file); err != nil {
+		return fmt.Errorf("failed to copy file data: %w", err)
+	}
+
+	return nil
 }
 
 // extractBackupArchive extracts a tar.gz archive to the data directory
-func (h *Handler) extractBackupArchive(data []byte) error {
-	buf := bytes.NewReader(data)
-	gzReader, err := gzip.NewReader(buf)
+func (h *Handler) extractBackupArchive(r io.Reader) error {
+	return h.extractBackupArchiveTo(r, h.fs.GetDataDir())
+}
+
+// extractBackupArchiveTo extracts a tar.gz archive into dataDir
+func (h *Handler) extractBackupArchiveTo(r io.Reader, dataDir string) error {
+	gzReader, err := gzip.NewReader(r)
 	if err != nil {
 		return fmt.Errorf("failed to create gzip reader: %w", err)
 	}
 	defer gzReader.Close()
 
 	tarReader := tar.NewReader(gzReader)
-	dataDir := h.fs.GetDataDir()
 
 	for {
 		header, err := tarReader.Next()
@@ -196,6 +196,12 @@ func (h *Handler) extractBackupArchive(data []byte) error {
 		if err := validateBackupArchiveName(header.Name); err != nil {
 			return err
 		}
+		switch path.Clean(strings.ReplaceAll(header.Name, "\\", "/")) {
+		case backupManifestName, backupSnapshotManifestName:
+			// Manifests describe the archive; snapshot blobs are fetched
+			// separately by restoreBackupFile.
+			continue
+		}
 		target, err := safeArchiveTarget(dataDir, header.Name)
 		if err != nil {
 			return err
@@ -237,7 +243,8 @@ func validateBackupArchiveName(name string) error {
 		return err
 	}
 	cleanName := path.Clean(strings.ReplaceAll(name, "\\", "/"))
-	if cleanName == "smarticky.db" || cleanName == "uploads" || strings.HasPrefix(cleanName, "uploads/") {
+	if cleanName == "smarticky.db" || cleanName == backupManifestName || cleanName == backupSnapshotManifestName ||
+		cleanName == "uploads" || strings.HasPrefix(cleanName, "uploads/") {
 		return nil
 	}
 	return fmt.Errorf("invalid backup archive entry %q", name)
@@ -371,34 +378,25 @@ func (h *Handler) BackupWebDAV(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
-	url := config.WebdavURL
-	user := config.WebdavUser
-	password := config.WebdavPassword
-
-	if url == "" {
+	if config.WebdavURL == "" {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "WebDAV URL not configured"})
 	}
 
-	// Checkpoint WAL to ensure data consistency
-	if err := h.checkpointWAL(); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": fmt.Sprintf("failed to prepare database for backup: %v", err),
-		})
-	}
-
-	// Create backup archive
-	archive, err := h.createBackupArchive()
+	// Connect to WebDAV
+	client, err := newBackupTargetClient(legacyWebDAVTargetInput(config))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": fmt.Sprintf("failed to create backup: %v", err)})
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
-	// Connect to WebDAV
-	client := gowebdav.NewClient(url, user, password)
-
 	filename := fmt.Sprintf("smarticky_backup_%s.tar.gz", time.Now().Format("20060102_150405"))
 
-	if err := client.Write(filename, archive.Bytes(), 0644); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": fmt.Sprintf("webdav upload failed: %v", err)})
+	// Stream the backup archive straight into the upload
+	uploadErrs, err := h.uploadBackupArchive(ctx, filename, "", []backupTargetClient{client})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": fmt.Sprintf("failed to create backup: %v", err)})
+	}
+	if uploadErrs[0] != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": fmt.Sprintf("webdav upload failed: %v", uploadErrs[0])})
 	}
 
 	// Update last backup time
@@ -428,54 +426,25 @@ func (h *Handler) BackupS3(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
-	endpoint := backupConfig.S3Endpoint
-	region := backupConfig.S3Region
-	bucket := backupConfig.S3Bucket
-	accessKey := backupConfig.S3AccessKey
-	secretKey := backupConfig.S3SecretKey
-
-	if endpoint == "" || bucket == "" || accessKey == "" || secretKey == "" {
+	if backupConfig.S3Endpoint == "" || backupConfig.S3Bucket == "" || backupConfig.S3AccessKey == "" || backupConfig.S3SecretKey == "" {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "S3 configuration incomplete"})
 	}
 
-	// Checkpoint WAL to ensure data consistency
-	if err := h.checkpointWAL(); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": fmt.Sprintf("failed to prepare database for backup: %v", err),
-		})
-	}
-
-	// Create backup archive
-	archive, err := h.createBackupArchive()
-	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": fmt.Sprintf("failed to create backup: %v", err)})
-	}
-
 	// Configure S3 client with custom endpoint
-	cfg, err := awsconfig.LoadDefaultConfig(ctx,
-		awsconfig.WithRegion(region),
-		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(accessKey, secretKey, "")),
-	)
+	client, err := newBackupTargetClient(legacyS3TargetInput(backupConfig))
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to create s3 config"})
 	}
 
-	// Create S3 client with custom endpoint resolver
-	svc := s3.NewFromConfig(cfg, func(o *s3.Options) {
-		o.BaseEndpoint = aws.String(endpoint)
-		o.UsePathStyle = true
-	})
-
 	filename := fmt.Sprintf("smarticky_backup_%s.tar.gz", time.Now().Format("20060102_150405"))
 
-	_, err = svc.PutObject(ctx, &s3.PutObjectInput{
-		Bucket: aws.String(bucket),
-		Key:    aws.String(filename),
-		Body:   bytes.NewReader(archive.Bytes()),
-	})
-
+	// Stream the backup archive straight into the upload
+	uploadErrs, err := h.uploadBackupArchive(ctx, filename, "", []backupTargetClient{client})
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": fmt.Sprintf("s3 upload failed: %v", err)})
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": fmt.Sprintf("failed to create backup: %v", err)})
+	}
+	if uploadErrs[0] != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": fmt.Sprintf("s3 upload failed: %v", uploadErrs[0])})
 	}
 
 	// Update last backup time
@@ -508,42 +477,19 @@ func (h *Handler) RestoreWebDAV(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "backup not configured"})
 	}
 
-	// Download from WebDAV
-	client := gowebdav.NewClient(config.WebdavURL, config.WebdavUser, config.WebdavPassword)
-
-	data, err := client.Read(req.Filename)
+	client, err := newBackupTargetClient(legacyWebDAVTargetInput(config))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": fmt.Sprintf("failed to download: %v", err)})
-	}
-
-	if err := h.checkpointWAL(); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": fmt.Sprintf("failed to prepare database for pre-restore backup: %v", err),
-		})
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
-	// Create backup of current data before restore
-	backupArchive, err := h.createBackupArchive()
+	// Download into a temporary file so the archive never sits in memory
+	backupPath, cleanup, err := h.downloadBackupToTemp(ctx, client, req.Filename)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": fmt.Sprintf("failed to create pre-restore backup: %v", err),
-		})
-	}
-
-	// Save current backup
-	backupFilename := fmt.Sprintf("smarticky_pre_restore_backup_%s.tar.gz", time.Now().Format("20060102_150405"))
-	backupPath := filepath.Join(h.fs.GetDataDir(), backupFilename)
-	if err := h.fs.WriteFile(backupPath, backupArchive.Bytes(), 0644); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": fmt.Sprintf("failed to save pre-restore backup: %v", err),
-		})
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": fmt.Sprintf("failed to download: %v", err)})
 	}
+	defer cleanup()
 
-	// Extract archive to data directory
-	if err := h.extractBackupArchive(data); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": fmt.Sprintf("failed to extract backup: %v", err)})
-	}
-	if err := h.removeDatabaseSidecars(); err != nil {
+	if err := h.restoreBackupFile(ctx, client, backupPath, ""); err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
@@ -573,63 +519,19 @@ func (h *Handler) RestoreS3(c echo.Context) error {
 	}
 
 	// Configure S3 client
-	cfg, err := awsconfig.LoadDefaultConfig(ctx,
-		awsconfig.WithRegion(backupConfig.S3Region),
-		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-			backupConfig.S3AccessKey, backupConfig.S3SecretKey, "")),
-	)
+	client, err := newBackupTargetClient(legacyS3TargetInput(backupConfig))
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to create s3 config"})
 	}
 
-	svc := s3.NewFromConfig(cfg, func(o *s3.Options) {
-		o.BaseEndpoint = aws.String(backupConfig.S3Endpoint)
-		o.UsePathStyle = true
-	})
-
-	// Download from S3
-	result, err := svc.GetObject(ctx, &s3.GetObjectInput{
-		Bucket: aws.String(backupConfig.S3Bucket),
-		Key:    aws.String(req.Filename),
-	})
+	// Download into a temporary file so the archive never sits in memory
+	backupPath, cleanup, err := h.downloadBackupToTemp(ctx, client, req.Filename)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": fmt.Sprintf("failed to download: %v", err)})
 	}
-	defer result.Body.Close()
-
-	data, err := io.ReadAll(result.Body)
-	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to read data"})
-	}
-
-	if err := h.checkpointWAL(); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": fmt.Sprintf("failed to prepare database for pre-restore backup: %v", err),
-		})
-	}
-
-	// Create backup of current data before restore
-	backupArchive, err := h.createBackupArchive()
-	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": fmt.Sprintf("failed to create pre-restore backup: %v", err),
-		})
-	}
-
-	// Save current backup
-	backupFilename := fmt.Sprintf("smarticky_pre_restore_backup_%s.tar.gz", time.Now().Format("20060102_150405"))
-	backupPath := filepath.Join(h.fs.GetDataDir(), backupFilename)
-	if err := h.fs.WriteFile(backupPath, backupArchive.Bytes(), 0644); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": fmt.Sprintf("failed to save pre-restore backup: %v", err),
-		})
-	}
+	defer cleanup()
 
-	// Extract archive to data directory
-	if err := h.extractBackupArchive(data); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": fmt.Sprintf("failed to extract backup: %v", err)})
-	}
-	if err := h.removeDatabaseSidecars(); err != nil {
+	if err := h.restoreBackupFile(ctx, client, backupPath, ""); err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
@@ -652,59 +554,43 @@ func (h *Handler) performAutoBackup() {
 		return // Silently skip if not configured or disabled
 	}
 
-	// Checkpoint WAL to ensure data consistency
-	if err := h.checkpointWAL(); err != nil {
-		fmt.Printf("Auto backup failed: WAL checkpoint error: %v\n", err)
-		return
-	}
-
-	// Create backup archive
-	archive, err := h.createBackupArchive()
-	if err != nil {
-		fmt.Printf("Auto backup failed: archive creation error: %v\n", err)
-		return
-	}
-
 	filename := fmt.Sprintf("smarticky_auto_backup_%s.tar.gz", time.Now().Format("20060102_150405"))
 
 	// Try WebDAV backup first if configured
 	if config.WebdavURL != "" {
-		client := gowebdav.NewClient(config.WebdavURL, config.WebdavUser, config.WebdavPassword)
-		if err := client.Write(filename, archive.Bytes(), 0644); err == nil {
-			h.client.BackupConfig.UpdateOneID(config.ID).
-				SetLastBackupAt(time.Now()).
-				SaveX(ctx)
-
-			// Cleanup old backups
-			if err := h.cleanupWebDAVBackups(config); err != nil {
-				fmt.Printf("Failed to cleanup old WebDAV backups: %v\n", err)
+		client, err := newBackupTargetClient(legacyWebDAVTargetInput(config))
+		if err == nil {
+			uploadErrs, archiveErr := h.uploadBackupArchive(ctx, filename, "", []backupTargetClient{client})
+			if archiveErr != nil {
+				fmt.Printf("Auto backup failed: archive creation error: %v\n", archiveErr)
+				return
 			}
+			if uploadErrs[0] == nil {
+				h.client.BackupConfig.UpdateOneID(config.ID).
+					SetLastBackupAt(time.Now()).
+					SaveX(ctx)
+
+				// Cleanup old backups
+				if err := h.cleanupWebDAVBackups(config); err != nil {
+					fmt.Printf("Failed to cleanup old WebDAV backups: %v\n", err)
+				}
 
-			fmt.Printf("Auto backup successful (WebDAV): %s\n", filename)
-			return
+				fmt.Printf("Auto backup successful (WebDAV): %s\n", filename)
+				return
+			}
 		}
 	}
 
 	// Try S3 backup if WebDAV failed or not configured
 	if config.S3Endpoint != "" && config.S3Bucket != "" {
-		cfg, err := awsconfig.LoadDefaultConfig(ctx,
-			awsconfig.WithRegion(config.S3Region),
-			awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-				config.S3AccessKey, config.S3SecretKey, "")),
-		)
+		client, err := newBackupTargetClient(legacyS3TargetInput(config))
 		if err == nil {
-			svc := s3.NewFromConfig(cfg, func(o *s3.Options) {
-				o.BaseEndpoint = aws.String(config.S3Endpoint)
-				o.UsePathStyle = true
-			})
-
-			_, err = svc.PutObject(ctx, &s3.PutObjectInput{
-				Bucket: aws.String(config.S3Bucket),
-				Key:    aws.String(filename),
-				Body:   bytes.NewReader(archive.Bytes()),
-			})
-
-			if err == nil {
+			uploadErrs, archiveErr := h.uploadBackupArchive(ctx, filename, "", []backupTargetClient{client})
+			if archiveErr != nil {
+				fmt.Printf("Auto backup failed: archive creation error: %v\n", archiveErr)
+				return
+			}
+			if uploadErrs[0] == nil {
 				h.client.BackupConfig.UpdateOneID(config.ID).
 					SetLastBackupAt(time.Now()).
 					SaveX(ctx)
@@ -773,6 +659,7 @@ func (h *Handler) StartAutoBackup() *scheduler.Scheduler[int, backupScheduleData
 		return nil
 	}
 	h.backupScheduler = s
+	go h.watchBackupTargets(ctx)
 
 	fmt.Println("Auto backup scheduler started")
 	return s
@@ -800,57 +687,21 @@ func nextBackupRun(now time.Time, _ int, data backupScheduleData) (time.Time, bo
 	if !data.Enabled || data.Schedule == "manual" {
 		return time.Time{}, false, nil
 	}
-	if !validBackupSchedule(data.Schedule) {
-		return time.Time{}, false, fmt.Errorf("unknown backup schedule %q", data.Schedule)
-	}
-	return nextBackupScheduleTime(data.Schedule, now), true, nil
-}
-
-func nextBackupScheduleTime(schedule string, now time.Time) time.Time {
-	switch schedule {
-	case "weekly":
-		return nextWeeklyBackupTime(now)
-	case "monthly":
-		return nextMonthlyBackupTime(now)
-	default:
-		return nextDailyBackupTime(now)
-	}
-}
-
-func nextDailyBackupTime(now time.Time) time.Time {
-	next := scheduledBackupTime(now)
-	if !next.After(now) {
-		next = next.AddDate(0, 0, 1)
-	}
-	return next
-}
-
-func nextWeeklyBackupTime(now time.Time) time.Time {
-	daysUntilSunday := (int(time.Sunday) - int(now.Weekday()) + 7) % 7
-	next := scheduledBackupTime(now.AddDate(0, 0, daysUntilSunday))
-	if !next.After(now) {
-		next = next.AddDate(0, 0, 7)
-	}
-	return next
-}
-
-func nextMonthlyBackupTime(now time.Time) time.Time {
-	next := time.Date(now.Year(), now.Month(), 1, 2, 0, 0, 0, now.Location())
-	if !next.After(now) {
-		next = next.AddDate(0, 1, 0)
+	spec, loc, err := backupScheduleCronSpec(data.Schedule, data.CronExpression, data.Timezone, now.Location())
+	if err != nil {
+		return time.Time{}, false, err
 	}
-	return next
-}
-
-func scheduledBackupTime(day time.Time) time.Time {
-	return time.Date(day.Year(), day.Month(), day.Day(), 2, 0, 0, 0, day.Location())
+	next, ok := spec.next(now, loc)
+	return next, ok, nil
 }
 
 func backupScheduleDataFromTask(task *ent.BackupTask) backupScheduleData {
 	return backupScheduleData{
-		TaskID:   task.ID,
-		Enabled:  task.Enabled,
-		Schedule: task.Schedule,
+		TaskID:         task.ID,
+		Enabled:        task.Enabled,
+		Schedule:       task.Schedule,
+		CronExpression: task.CronExpression,
+		Timezone:       task.Timezone,
 	}
 }
 
@@ -889,6 +740,11 @@ type BackupFileInfo struct {
 	Filename  string    `json:"filename"`
 	Size      int64     `json:"size"`
 	CreatedAt time.Time `json:"created_at"`
+	Encrypted bool      `json:"encrypted"`
+	Snapshot  bool      `json:"snapshot"`
+	// Pinned backups are never removed by retention cleanup.
+	Pinned  bool   `json:"pinned"`
+	PinNote string `json:"pin_note,omitempty"`
 }
 
 // ListWebDAVBackups lists all backup files on WebDAV
@@ -1004,6 +860,30 @@ type BackupVerificationResult struct {
 	TotalSize  int64             `json:"total_size"`
 	FileCount  int               `json:"file_count"`
 	VerifiedAt time.Time         `json:"verified_at"`
+	Encrypted  bool              `json:"encrypted"`
+	// PassphraseRequired asks the client to prompt for the archive passphrase.
+	PassphraseRequired bool `json:"passphrase_required,omitempty"`
+	// Snapshot marks an incremental snapshot whose attachments are blobs
+	// stored next to it on the target.
+	Snapshot bool `json:"snapshot"`
+	// Manifest describes the database snapshot; archives made before
+	// manifests existed have none.
+	Manifest *BackupManifest `json:"manifest,omitempty"`
+
+	snapshot *backupSnapshotManifest
+}
+
+func (r *BackupVerificationResult) setReadError(action string, err error) {
+	if errors.Is(err, errBackupPassphraseInvalid) {
+		r.Error = err.Error()
+		r.PassphraseRequired = true
+		return
+	}
+	if errors.Is(err, errBackupCiphertext) {
+		r.Error = err.Error()
+		return
+	}
+	r.Error = fmt.Sprintf("%s: %v", action, err)
 }
 
 // FileCheckResult represents the check result for a single file
@@ -1035,17 +915,22 @@ func (h *Handler) VerifyWebDAVBackup(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "WebDAV not configured"})
 	}
 
-	// Download from WebDAV
-	client := gowebdav.NewClient(config.WebdavURL, config.WebdavUser, config.WebdavPassword)
-	data, err := client.Read(req.Filename)
+	client, err := newBackupTargetClient(legacyWebDAVTargetInput(config))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	// Verify the backup while it streams in
+	body, err := client.Download(ctx, req.Filename)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"error": fmt.Sprintf("failed to download backup: %v", err),
 		})
 	}
+	defer body.Close()
 
-	// Verify the backup
-	result := h.verifyBackupData(data)
+	result := h.verifyBackupData(body, "")
+	verifyBackupSnapshotBlobs(ctx, client, &result)
 	return c.JSON(http.StatusOK, result)
 }
 
@@ -1074,64 +959,54 @@ func (h *Handler) VerifyS3Backup(c echo.Context) error {
 	}
 
 	// Configure S3 client
-	cfg, err := awsconfig.LoadDefaultConfig(ctx,
-		awsconfig.WithRegion(backupConfig.S3Region),
-		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-			backupConfig.S3AccessKey, backupConfig.S3SecretKey, "")),
-	)
+	client, err := newBackupTargetClient(legacyS3TargetInput(backupConfig))
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to create s3 config"})
 	}
 
-	svc := s3.NewFromConfig(cfg, func(o *s3.Options) {
-		o.BaseEndpoint = aws.String(backupConfig.S3Endpoint)
-		o.UsePathStyle = true
-	})
-
-	// Download from S3
-	result, err := svc.GetObject(ctx, &s3.GetObjectInput{
-		Bucket: aws.String(backupConfig.S3Bucket),
-		Key:    aws.String(req.Filename),
-	})
+	// Verify the backup while it streams in
+	body, err := client.Download(ctx, req.Filename)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"error": fmt.Sprintf("failed to download backup: %v", err),
 		})
 	}
-	defer result.Body.Close()
-
-	data, err := io.ReadAll(result.Body)
-	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to read backup data"})
-	}
+	defer body.Close()
 
-	// Verify the backup
-	verifyResult := h.verifyBackupData(data)
-	return c.JSON(http.StatusOK, verifyResult)
+	result := h.verifyBackupData(body, "")
+	verifyBackupSnapshotBlobs(ctx, client, &result)
+	return c.JSON(http.StatusOK, result)
 }
 
-// verifyBackupData verifies backup integrity by extracting to memory
-func (h *Handler) verifyBackupData(data []byte) BackupVerificationResult {
+// verifyBackupData verifies backup integrity by reading the archive stream
+// once, without keeping file contents in memory. Encrypted archives are
+// decrypted with passphrase on the fly.
+func (h *Handler) verifyBackupData(r io.Reader, passphrase string) BackupVerificationResult {
 	result := BackupVerificationResult{
 		Valid:      false,
 		VerifiedAt: time.Now(),
 		FileChecks: []FileCheckResult{},
 	}
 
-	// Create an in-memory filesystem
-	memFs := afero.NewMemMapFs()
+	plain, encrypted, err := openBackupStream(r, passphrase)
+	result.Encrypted = encrypted
+	if err != nil {
+		result.Error = err.Error()
+		result.PassphraseRequired = errors.Is(err, errBackupPassphraseRequired)
+		return result
+	}
 
-	// Try to extract the backup to memory
-	buf := bytes.NewReader(data)
-	gzReader, err := gzip.NewReader(buf)
+	gzReader, err := gzip.NewReader(plain)
 	if err != nil {
-		result.Error = fmt.Sprintf("failed to decompress: %v", err)
+		result.setReadError("failed to decompress", err)
 		return result
 	}
 	defer gzReader.Close()
 
 	tarReader := tar.NewReader(gzReader)
 
+	// Entries seen so far, keyed by clean archive path
+	entries := make(map[string]FileCheckResult)
 	totalSize := int64(0)
 	fileCount := 0
 
@@ -1141,7 +1016,7 @@ func (h *Handler) verifyBackupData(data []byte) BackupVerificationResult {
 			break
 		}
 		if err != nil {
-			result.Error = fmt.Sprintf("failed to read tar: %v", err)
+			result.setReadError("failed to read tar", err)
 			return result
 		}
 
@@ -1149,41 +1024,62 @@ func (h *Handler) verifyBackupData(data []byte) BackupVerificationResult {
 			result.Error = err.Error()
 			return result
 		}
-		target, err := safeArchiveTarget("/", header.Name)
-		if err != nil {
+		if _, err := safeArchiveTarget("/", header.Name); err != nil {
 			result.Error = err.Error()
 			return result
 		}
+		name := path.Clean(strings.ReplaceAll(header.Name, "\\", "/"))
 
 		switch header.Typeflag {
 		case tar.TypeDir:
-			if err := memFs.MkdirAll(target, 0755); err != nil {
-				result.Error = fmt.Sprintf("failed to create directory: %v", err)
-				return result
-			}
+			entries[name] = FileCheckResult{Exists: true, IsDir: true}
 			fileCount++
 
 		case tar.TypeReg:
-			// Create parent directories if needed
-			if err := memFs.MkdirAll(filepath.Dir(target), 0755); err != nil {
-				result.Error = fmt.Sprintf("failed to create parent directory: %v", err)
-				return result
+			if name == backupSnapshotManifestName {
+				manifest, err := decodeBackupSnapshotManifest(tarReader)
+				if err != nil {
+					result.setReadError("failed to read snapshot manifest", err)
+					return result
+				}
+				result.Snapshot = true
+				result.snapshot = manifest
+				for _, file := range manifest.Files {
+					totalSize += file.Size
+				}
+				fileCount += len(manifest.Files)
+				continue
 			}
-
-			file, err := memFs.Create(target)
-			if err != nil {
-				result.Error = fmt.Sprintf("failed to create file in memory: %v", err)
-				return result
+			if name == backupManifestName {
+				manifest, err := decodeBackupManifest(tarReader)
+				if err != nil {
+					result.setReadError("failed to read backup manifest", err)
+					return result
+				}
+				result.Manifest = manifest
+				continue
 			}
 
-			written, err := io.Copy(file, tarReader)
-			file.Close()
+			// Parent directories exist implicitly, as they would on extract
+			for dir := path.Dir(name); dir != "."; dir = path.Dir(dir) {
+				if _, ok := entries[dir]; !ok {
+					entries[dir] = FileCheckResult{Exists: true, IsDir: true}
+				}
+			}
 
+			var written int64
+			var check string
+			if name == "smarticky.db" && result.Manifest != nil {
+				written, check, err = h.checkBackupDatabase(tarReader, result.Manifest)
+			} else {
+				written, err = io.Copy(io.Discard, tarReader)
+			}
 			if err != nil {
-				result.Error = fmt.Sprintf("failed to write file to memory: %v", err)
+				result.setReadError("failed to read file data", err)
 				return result
 			}
 
+			entries[name] = FileCheckResult{Exists: true, Size: written, Error: check}
 			totalSize += written
 			fileCount++
 		}
@@ -1191,26 +1087,18 @@ func (h *Handler) verifyBackupData(data []byte) BackupVerificationResult {
 
 	// Verify critical files
 	criticalFiles := []string{
-		"/smarticky.db",
-		"/uploads",
+		"smarticky.db",
+		"uploads",
 	}
 
-	for _, path := range criticalFiles {
-		check := FileCheckResult{Path: path}
-
-		stat, err := memFs.Stat(path)
-		if err != nil {
-			check.Exists = false
-			check.Error = err.Error()
-		} else {
-			check.Exists = true
-			check.Size = stat.Size()
-			check.IsDir = stat.IsDir()
-
+	for _, name := range criticalFiles {
+		check, ok := entries[name]
+		check.Path = "/" + name
+		if !ok {
+			check.Error = fmt.Sprintf("stat %s: file does not exist", check.Path)
+		} else if name == "smarticky.db" && check.Size == 0 {
 			// For database file, verify it's not empty
-			if path == "/smarticky.db" && stat.Size() == 0 {
-				check.Error = "database file is empty"
-			}
+			check.Error = "database file is empty"
 		}
 
 		result.FileChecks = append(result.FileChecks, check)
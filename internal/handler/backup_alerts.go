@@ -0,0 +1,492 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/backuprun"
+	"smarticky/ent/backuptarget"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// backupAlertTimeout bounds each webhook call and mail delivery.
+	backupAlertTimeout = 15 * time.Second
+	// backupStaleCheckInterval is how often targets are checked for a
+	// missing successful backup or a due restore drill.
+	backupStaleCheckInterval = 10 * time.Minute
+)
+
+// backupAlert is the JSON body POSTed to the alert webhook.
+type backupAlert struct {
+	Event         string     `json:"event"`
+	TaskID        *int       `json:"task_id,omitempty"`
+	TaskName      string     `json:"task_name,omitempty"`
+	TargetID      *int       `json:"target_id,omitempty"`
+	TargetName    string     `json:"target_name,omitempty"`
+	Filename      string     `json:"filename,omitempty"`
+	Error         string     `json:"error,omitempty"`
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+	At            time.Time  `json:"at"`
+}
+
+const (
+	backupAlertFailed      = "backup.failed"
+	backupAlertStale       = "backup.stale"
+	backupAlertDrillFailed = "backup.drill_failed"
+	backupAlertTest        = "backup.test"
+)
+
+type backupAlertSettings struct {
+	WebhookURL string
+	SMTPHost   string
+	SMTPPort   int
+	SMTPFrom   string
+	SMTPTo     []string
+	OnFailure  bool
+}
+
+func (s backupAlertSettings) configured() bool {
+	return s.WebhookURL != "" || s.SMTPHost != ""
+}
+
+type backupAlertSettingsPayload struct {
+	WebhookURL *string   `json:"webhook_url"`
+	SMTPHost   *string   `json:"smtp_host"`
+	SMTPPort   *int      `json:"smtp_port"`
+	SMTPFrom   *string   `json:"smtp_from"`
+	SMTPTo     *[]string `json:"smtp_to"`
+	OnFailure  *bool     `json:"on_failure"`
+}
+
+type BackupAlertSettingsResponse struct {
+	HasWebhookURL bool     `json:"has_webhook_url"`
+	SMTPHost      string   `json:"smtp_host"`
+	SMTPPort      int      `json:"smtp_port"`
+	SMTPFrom      string   `json:"smtp_from"`
+	SMTPTo        []string `json:"smtp_to"`
+	OnFailure     bool     `json:"on_failure"`
+}
+
+func (h *Handler) GetBackupAlertSettings(c echo.Context) error {
+	config, err := h.getOrCreateBackupConfig(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to load alert settings"})
+	}
+	return c.JSON(http.StatusOK, backupAlertSettingsResponse(config))
+}
+
+// UpdateBackupAlertSettings changes the alert channels. An empty webhook URL
+// or mail host turns that channel off.
+func (h *Handler) UpdateBackupAlertSettings(c echo.Context) error {
+	var req backupAlertSettingsPayload
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid alert settings"})
+	}
+	ctx := c.Request().Context()
+	config, err := h.getOrCreateBackupConfig(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to load alert settings"})
+	}
+	settings, err := h.backupAlertSettingsFromConfig(config)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	if req.WebhookURL != nil {
+		settings.WebhookURL = strings.TrimSpace(*req.WebhookURL)
+	}
+	if req.SMTPHost != nil {
+		settings.SMTPHost = strings.TrimSpace(*req.SMTPHost)
+	}
+	if req.SMTPPort != nil {
+		settings.SMTPPort = *req.SMTPPort
+	}
+	if req.SMTPFrom != nil {
+		settings.SMTPFrom = strings.TrimSpace(*req.SMTPFrom)
+	}
+	if req.SMTPTo != nil {
+		settings.SMTPTo = cleanBackupAlertRecipients(*req.SMTPTo)
+	}
+	if req.OnFailure != nil {
+		settings.OnFailure = *req.OnFailure
+	}
+	if err := validateBackupAlertSettings(settings); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	webhookURL, err := h.sealBackupSecret(settings.WebhookURL)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to seal webhook URL"})
+	}
+	config, err = config.Update().
+		SetAlertWebhookURL(webhookURL).
+		SetAlertSMTPHost(settings.SMTPHost).
+		SetAlertSMTPPort(settings.SMTPPort).
+		SetAlertSMTPFrom(settings.SMTPFrom).
+		SetAlertSMTPTo(strings.Join(settings.SMTPTo, ",")).
+		SetAlertOnFailure(settings.OnFailure).
+		Save(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to save alert settings"})
+	}
+	return c.JSON(http.StatusOK, backupAlertSettingsResponse(config))
+}
+
+// TestBackupAlerts sends a test alert through every configured channel.
+func (h *Handler) TestBackupAlerts(c echo.Context) error {
+	ctx := c.Request().Context()
+	settings, err := h.backupAlertSettings(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	if !settings.configured() {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "No alert channel is configured"})
+	}
+	if err := sendBackupAlert(ctx, settings, backupAlert{Event: backupAlertTest, At: time.Now()}); err != nil {
+		return c.JSON(http.StatusOK, map[string]interface{}{"ok": false, "error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"ok": true})
+}
+
+func backupAlertSettingsResponse(config *ent.BackupConfig) BackupAlertSettingsResponse {
+	return BackupAlertSettingsResponse{
+		HasWebhookURL: config.AlertWebhookURL != "",
+		SMTPHost:      config.AlertSMTPHost,
+		SMTPPort:      config.AlertSMTPPort,
+		SMTPFrom:      config.AlertSMTPFrom,
+		SMTPTo:        cleanBackupAlertRecipients(strings.Split(config.AlertSMTPTo, ",")),
+		OnFailure:     config.AlertOnFailure,
+	}
+}
+
+func cleanBackupAlertRecipients(values []string) []string {
+	recipients := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			recipients = append(recipients, value)
+		}
+	}
+	return recipients
+}
+
+func validateBackupAlertSettings(settings backupAlertSettings) error {
+	if settings.WebhookURL != "" {
+		parsed, err := url.Parse(settings.WebhookURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return errors.New("webhook URL must be an http or https URL")
+		}
+	}
+	if settings.SMTPHost == "" {
+		return nil
+	}
+	if settings.SMTPPort < 1 || settings.SMTPPort > 65535 {
+		return errors.New("mail port must be between 1 and 65535")
+	}
+	if _, err := mail.ParseAddress(settings.SMTPFrom); err != nil {
+		return errors.New("a valid sender address is required for mail alerts")
+	}
+	if len(settings.SMTPTo) == 0 {
+		return errors.New("at least one recipient is required for mail alerts")
+	}
+	for _, recipient := range settings.SMTPTo {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return fmt.Errorf("invalid recipient %q", recipient)
+		}
+	}
+	return nil
+}
+
+// backupAlertSettings loads the alert channels, unsealing the webhook URL.
+func (h *Handler) backupAlertSettings(ctx context.Context) (backupAlertSettings, error) {
+	config, err := h.client.BackupConfig.Query().First(ctx)
+	if ent.IsNotFound(err) {
+		return backupAlertSettings{}, nil
+	}
+	if err != nil {
+		return backupAlertSettings{}, err
+	}
+	return h.backupAlertSettingsFromConfig(config)
+}
+
+func (h *Handler) backupAlertSettingsFromConfig(config *ent.BackupConfig) (backupAlertSettings, error) {
+	webhookURL, err := h.openBackupSecret(config.AlertWebhookURL)
+	if err != nil {
+		return backupAlertSettings{}, fmt.Errorf("failed to unseal webhook URL: %w", err)
+	}
+	return backupAlertSettings{
+		WebhookURL: webhookURL,
+		SMTPHost:   config.AlertSMTPHost,
+		SMTPPort:   config.AlertSMTPPort,
+		SMTPFrom:   config.AlertSMTPFrom,
+		SMTPTo:     cleanBackupAlertRecipients(strings.Split(config.AlertSMTPTo, ",")),
+		OnFailure:  config.AlertOnFailure,
+	}, nil
+}
+
+func (h *Handler) alertBackupFailure(ctx context.Context, record backupRunRecord) {
+	alert := backupAlert{Event: backupAlertFailed, Filename: record.Filename, At: time.Now()}
+	if record.Err != nil {
+		alert.Error = record.Err.Error()
+	}
+	if record.Task != nil {
+		alert.TaskID, alert.TaskName = &record.Task.ID, record.Task.Name
+	}
+	if record.Target != nil {
+		alert.TargetID, alert.TargetName = &record.Target.ID, record.Target.Name
+	}
+	h.dispatchFailureAlert(ctx, alert)
+}
+
+// dispatchFailureAlert sends alert unless failure alerts are turned off.
+func (h *Handler) dispatchFailureAlert(ctx context.Context, alert backupAlert) {
+	settings, err := h.backupAlertSettings(ctx)
+	if err != nil {
+		fmt.Printf("Failed to load backup alert settings: %v\n", err)
+		return
+	}
+	if !settings.OnFailure || !settings.configured() {
+		return
+	}
+	dispatchBackupAlert(settings, alert)
+}
+
+// dispatchBackupAlert sends an alert in the background so a slow webhook or
+// relay never holds up a backup.
+func dispatchBackupAlert(settings backupAlertSettings, alert backupAlert) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*backupAlertTimeout)
+		defer cancel()
+		if err := sendBackupAlert(ctx, settings, alert); err != nil {
+			fmt.Printf("Failed to send backup alert: %v\n", err)
+		}
+	}()
+}
+
+func sendBackupAlert(ctx context.Context, settings backupAlertSettings, alert backupAlert) error {
+	var errs []error
+	if settings.WebhookURL != "" {
+		if err := postBackupAlertWebhook(ctx, settings.WebhookURL, alert); err != nil {
+			errs = append(errs, fmt.Errorf("webhook: %w", err))
+		}
+	}
+	if settings.SMTPHost != "" {
+		if err := mailBackupAlert(ctx, settings, alert); err != nil {
+			errs = append(errs, fmt.Errorf("mail: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func postBackupAlertWebhook(ctx context.Context, webhookURL string, alert backupAlert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, backupAlertTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// mailBackupAlert hands the alert to a mail relay over plain SMTP without
+// authentication, which is what a relay on the same host or network expects.
+func mailBackupAlert(ctx context.Context, settings backupAlertSettings, alert backupAlert) error {
+	dialer := net.Dialer{Timeout: backupAlertTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(settings.SMTPHost, strconv.Itoa(settings.SMTPPort)))
+	if err != nil {
+		return err
+	}
+	_ = conn.SetDeadline(time.Now().Add(backupAlertTimeout))
+	client, err := smtp.NewClient(conn, settings.SMTPHost)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+	if err := client.Mail(backupAlertMailbox(settings.SMTPFrom)); err != nil {
+		return err
+	}
+	for _, recipient := range settings.SMTPTo {
+		if err := client.Rcpt(backupAlertMailbox(recipient)); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(backupAlertMessage(settings, alert)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// backupAlertMailbox strips the display name from an address for the SMTP
+// envelope.
+func backupAlertMailbox(value string) string {
+	if address, err := mail.ParseAddress(value); err == nil {
+		return address.Address
+	}
+	return value
+}
+
+func backupAlertMessage(settings backupAlertSettings, alert backupAlert) []byte {
+	// Names end up in a header; keep them on one line.
+	header := strings.NewReplacer("\r", " ", "\n", " ")
+	subject := "Smarticky backup alert test"
+	var body strings.Builder
+	switch alert.Event {
+	case backupAlertFailed:
+		subject = "Smarticky backup failed"
+		fmt.Fprintf(&body, "A backup run failed at %s.\r\n", alert.At.Format(time.RFC1123Z))
+	case backupAlertDrillFailed:
+		subject = "Smarticky restore drill failed"
+		fmt.Fprintf(&body, "Restoring the latest backup as a test failed at %s.\r\n", alert.At.Format(time.RFC1123Z))
+	case backupAlertStale:
+		subject = "Smarticky backup missing"
+		body.WriteString("A backup target has not had a successful backup within its alert window.\r\n")
+		if alert.LastSuccessAt != nil {
+			fmt.Fprintf(&body, "Last success: %s\r\n", alert.LastSuccessAt.Format(time.RFC1123Z))
+		} else {
+			body.WriteString("Last success: never\r\n")
+		}
+	default:
+		body.WriteString("This is a test of the Smarticky backup alerts.\r\n")
+	}
+	if alert.TaskName != "" {
+		fmt.Fprintf(&body, "Task: %s\r\n", alert.TaskName)
+	}
+	if alert.TargetName != "" {
+		fmt.Fprintf(&body, "Target: %s\r\n", alert.TargetName)
+		subject += ": " + alert.TargetName
+	}
+	if alert.Filename != "" {
+		fmt.Fprintf(&body, "File: %s\r\n", alert.Filename)
+	}
+	if alert.Error != "" {
+		fmt.Fprintf(&body, "Error: %s\r\n", alert.Error)
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", settings.SMTPFrom)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(settings.SMTPTo, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", header.Replace(subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", alert.At.Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(body.String())
+	return msg.Bytes()
+}
+
+// watchBackupTargets checks targets for a missing successful backup and
+// runs due restore drills until ctx is done.
+func (h *Handler) watchBackupTargets(ctx context.Context) {
+	ticker := time.NewTicker(backupStaleCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := h.checkStaleBackupTargets(ctx, now); err != nil {
+				fmt.Printf("Failed to check for missing backups: %v\n", err)
+			}
+			if err := h.runDueBackupDrills(ctx, now); err != nil {
+				fmt.Printf("Failed to run restore drills: %v\n", err)
+			}
+		}
+	}
+}
+
+// checkStaleBackupTargets alerts once for every enabled target whose last
+// successful backup is older than its alert window. The next success arms
+// the alert again.
+func (h *Handler) checkStaleBackupTargets(ctx context.Context, now time.Time) error {
+	targets, err := h.client.BackupTarget.Query().
+		Where(
+			backuptarget.Enabled(true),
+			backuptarget.AlertAfterHoursGT(0),
+			backuptarget.StaleAlertedAtIsNil(),
+		).
+		All(ctx)
+	if err != nil || len(targets) == 0 {
+		return err
+	}
+	settings, err := h.backupAlertSettings(ctx)
+	if err != nil || !settings.configured() {
+		return err
+	}
+
+	for _, target := range targets {
+		lastSuccess, err := h.lastSuccessfulBackup(ctx, target)
+		if err != nil {
+			return err
+		}
+		since := target.CreatedAt
+		if lastSuccess != nil {
+			since = *lastSuccess
+		}
+		if now.Sub(since) < time.Duration(target.AlertAfterHours)*time.Hour {
+			continue
+		}
+		if err := target.Update().SetStaleAlertedAt(now).Exec(ctx); err != nil {
+			return err
+		}
+		dispatchBackupAlert(settings, backupAlert{
+			Event:         backupAlertStale,
+			TargetID:      &target.ID,
+			TargetName:    target.Name,
+			LastSuccessAt: lastSuccess,
+			At:            now,
+		})
+	}
+	return nil
+}
+
+// lastSuccessfulBackup returns when the target last received a backup, or
+// nil if it never did.
+func (h *Handler) lastSuccessfulBackup(ctx context.Context, target *ent.BackupTarget) (*time.Time, error) {
+	run, err := h.client.BackupRun.Query().
+		Where(backuprun.TargetID(target.ID), backuprun.Status("success")).
+		Order(ent.Desc(backuprun.FieldFinishedAt)).
+		First(ctx)
+	if err == nil {
+		return &run.FinishedAt, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+	// Targets that succeeded before run history was kept.
+	if target.LastBackupStatus == "success" {
+		return optionalTime(target.LastBackupAt), nil
+	}
+	return nil, nil
+}
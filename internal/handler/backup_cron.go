@@ -0,0 +1,305 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"smarticky/ent"
+)
+
+// backupScheduleCron maps the fixed schedules onto cron so every schedule
+// goes through the same time-zone aware code path.
+var backupScheduleCron = map[string]string{
+	"daily":   "0 2 * * *",
+	"weekly":  "0 2 * * 0",
+	"monthly": "0 2 1 * *",
+}
+
+var backupCronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var backupCronMonthNames = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+var backupCronDayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// backupCronHorizon bounds the search for the next run; eight years covers
+// every February 29th, including across a skipped leap year.
+const backupCronHorizon = 8*366 + 1
+
+// backupCronPreviewCount is how many upcoming runs the API previews.
+const backupCronPreviewCount = 5
+
+// backupCronSchedule is a parsed five-field cron expression: minute, hour,
+// day of month, month and day of week. Each field is a bit set of the
+// values it matches.
+type backupCronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// Like cron, when both day fields are restricted a day matching either
+	// of them fires.
+	domStar, dowStar bool
+}
+
+func parseBackupCron(expr string) (backupCronSchedule, error) {
+	expr = strings.TrimSpace(expr)
+	if macro, ok := backupCronMacros[strings.ToLower(expr)]; ok {
+		expr = macro
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return backupCronSchedule{}, errors.New("cron expression must have five fields: minute hour day-of-month month day-of-week")
+	}
+	var s backupCronSchedule
+	var err error
+	if s.minute, _, err = parseBackupCronField(fields[0], "minute", 0, 59, nil); err != nil {
+		return s, err
+	}
+	if s.hour, _, err = parseBackupCronField(fields[1], "hour", 0, 23, nil); err != nil {
+		return s, err
+	}
+	if s.dom, s.domStar, err = parseBackupCronField(fields[2], "day-of-month", 1, 31, nil); err != nil {
+		return s, err
+	}
+	if s.month, _, err = parseBackupCronField(fields[3], "month", 1, 12, backupCronMonthNames); err != nil {
+		return s, err
+	}
+	if s.dow, s.dowStar, err = parseBackupCronField(fields[4], "day-of-week", 0, 7, backupCronDayNames); err != nil {
+		return s, err
+	}
+	// 7 is another name for Sunday.
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	return s, nil
+}
+
+// parseBackupCronField parses a comma separated list of values, ranges and
+// steps. names, when set, are accepted in place of numbers starting at min.
+func parseBackupCronField(field string, label string, min int, max int, names []string) (uint64, bool, error) {
+	var bits uint64
+	// Like cron, a field starting with "*" counts as unrestricted.
+	star := strings.HasPrefix(field, "*")
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return 0, false, fmt.Errorf("invalid cron %s step %q", label, part)
+			}
+			rangePart, step = part[:i], n
+		}
+		lo, hi := min, max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if lo, err = parseBackupCronValue(bounds[0], min, max, names); err != nil {
+				return 0, false, fmt.Errorf("invalid cron %s %q", label, part)
+			}
+			if hi, err = parseBackupCronValue(bounds[1], min, max, names); err != nil || hi < lo {
+				return 0, false, fmt.Errorf("invalid cron %s %q", label, part)
+			}
+		default:
+			value, err := parseBackupCronValue(rangePart, min, max, names)
+			if err != nil {
+				return 0, false, fmt.Errorf("invalid cron %s %q", label, part)
+			}
+			lo = value
+			if step == 1 {
+				hi = value
+			}
+		}
+		for value := lo; value <= hi; value += step {
+			bits |= 1 << value
+		}
+	}
+	return bits, star, nil
+}
+
+func parseBackupCronValue(value string, min int, max int, names []string) (int, error) {
+	for i, name := range names {
+		if strings.EqualFold(value, name) {
+			return min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		return 0, errors.New("out of range")
+	}
+	return n, nil
+}
+
+func (s backupCronSchedule) matchesDay(day time.Time) bool {
+	if s.month&(1<<uint(day.Month())) == 0 {
+		return false
+	}
+	dom := s.dom&(1<<uint(day.Day())) != 0
+	dow := s.dow&(1<<uint(day.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// next returns the first run strictly after the given instant, reading the
+// expression as wall-clock time in loc. A wall time skipped by a daylight
+// saving jump runs at the jump; a wall time repeated when clocks fall back
+// runs once, at its first occurrence.
+func (s backupCronSchedule) next(after time.Time, loc *time.Location) (time.Time, bool) {
+	start := backupWallClock(after.In(loc)).Truncate(time.Minute).Add(time.Minute)
+	firstDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	for i := 0; i < backupCronHorizon; i++ {
+		day := firstDay.AddDate(0, 0, i)
+		if !s.matchesDay(day) {
+			continue
+		}
+		for hour := 0; hour < 24; hour++ {
+			if s.hour&(1<<hour) == 0 {
+				continue
+			}
+			for minute := 0; minute < 60; minute++ {
+				if s.minute&(1<<minute) == 0 {
+					continue
+				}
+				wall := day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+				if wall.Before(start) {
+					continue
+				}
+				if at := backupCronInstant(wall, loc); at.After(after) {
+					return at, true
+				}
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// backupCronInstant returns the earliest instant whose wall clock in loc
+// reads wall (given in UTC fields). When no instant does because clocks
+// jumped forward over it, the moment of the jump is returned.
+func backupCronInstant(wall time.Time, loc *time.Location) time.Time {
+	_, before := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(24 * time.Hour).In(loc).Zone()
+	var found time.Time
+	for _, offset := range []int{before, after} {
+		at := wall.Add(-time.Duration(offset) * time.Second)
+		if backupWallClock(at.In(loc)).Equal(wall) && (found.IsZero() || at.Before(found)) {
+			found = at
+		}
+	}
+	if !found.IsZero() {
+		return found.In(loc)
+	}
+
+	lo := wall.Add(-time.Duration(max(before, after)) * time.Second)
+	hi := wall.Add(-time.Duration(min(before, after)) * time.Second)
+	for hi.Sub(lo) > time.Second {
+		mid := lo.Add(hi.Sub(lo) / 2)
+		if backupWallClock(mid.In(loc)).Before(wall) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi.In(loc)
+}
+
+// backupWallClock returns t's wall clock reading as a UTC time so that wall
+// times can be compared without a zone getting in the way.
+func backupWallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+}
+
+// backupScheduleCronSpec resolves a task schedule to its cron expression
+// and the location it runs in. fallback is used when no time zone is set.
+func backupScheduleCronSpec(schedule string, expr string, timezone string, fallback *time.Location) (backupCronSchedule, *time.Location, error) {
+	if schedule != "cron" {
+		fixed, ok := backupScheduleCron[schedule]
+		if !ok {
+			return backupCronSchedule{}, nil, fmt.Errorf("unknown backup schedule %q", schedule)
+		}
+		expr = fixed
+	}
+	spec, err := parseBackupCron(expr)
+	if err != nil {
+		return backupCronSchedule{}, nil, err
+	}
+	loc, err := loadBackupTimezone(timezone, fallback)
+	if err != nil {
+		return backupCronSchedule{}, nil, err
+	}
+	return spec, loc, nil
+}
+
+func loadBackupTimezone(name string, fallback *time.Location) (*time.Location, error) {
+	if name == "" {
+		return fallback, nil
+	}
+	// "Local" would silently follow whatever zone the server runs in.
+	if name == "Local" {
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+	return loc, nil
+}
+
+// backupScheduleRunsFor validates a schedule and previews its next runs.
+// Manual schedules have none.
+func backupScheduleRunsFor(schedule string, expr string, timezone string, now time.Time) ([]time.Time, error) {
+	if schedule == "cron" && strings.TrimSpace(expr) == "" {
+		return nil, errors.New("a cron expression is required")
+	}
+	if schedule == "manual" {
+		if _, err := loadBackupTimezone(timezone, time.Local); err != nil {
+			return nil, err
+		}
+		return []time.Time{}, nil
+	}
+	spec, loc, err := backupScheduleCronSpec(schedule, expr, timezone, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	runs := backupScheduleRuns(spec, loc, now, backupCronPreviewCount)
+	if len(runs) == 0 {
+		return nil, errors.New("cron expression never matches a date")
+	}
+	return runs, nil
+}
+
+func backupTaskNextRuns(task *ent.BackupTask) []time.Time {
+	if !task.Enabled {
+		return []time.Time{}
+	}
+	runs, err := backupScheduleRunsFor(task.Schedule, task.CronExpression, task.Timezone, time.Now())
+	if err != nil {
+		return []time.Time{}
+	}
+	return runs
+}
+
+// backupScheduleRuns lists up to count runs after now.
+func backupScheduleRuns(spec backupCronSchedule, loc *time.Location, now time.Time, count int) []time.Time {
+	runs := make([]time.Time, 0, count)
+	for len(runs) < count {
+		next, ok := spec.next(now, loc)
+		if !ok {
+			break
+		}
+		runs = append(runs, next)
+		now = next
+	}
+	return runs
+}
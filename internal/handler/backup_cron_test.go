@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"smarticky/ent/enttest"
+	"smarticky/internal/storage"
+
+	"github.com/labstack/echo/v4"
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestParseBackupCronRejectsInvalidExpressions(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"* * * foo *",
+		"@every 5m",
+	} {
+		if _, err := parseBackupCron(expr); err == nil {
+			t.Errorf("parseBackupCron(%q) succeeded, want error", expr)
+		}
+	}
+}
+
+func TestBackupCronNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("load time zone: %v", err)
+	}
+	utc := time.UTC
+	tests := []struct {
+		name  string
+		expr  string
+		loc   *time.Location
+		after time.Time
+		want  []time.Time
+	}{
+		{
+			name:  "steps and named weekdays",
+			expr:  "*/30 17 * * mon-fri",
+			loc:   utc,
+			after: time.Date(2026, 7, 3, 17, 30, 0, 0, utc),
+			want: []time.Time{
+				time.Date(2026, 7, 6, 17, 0, 0, 0, utc),
+				time.Date(2026, 7, 6, 17, 30, 0, 0, utc),
+			},
+		},
+		{
+			name:  "restricted day fields match either",
+			expr:  "0 0 1 * mon",
+			loc:   utc,
+			after: time.Date(2026, 2, 28, 0, 0, 0, 0, utc),
+			want: []time.Time{
+				time.Date(2026, 3, 1, 0, 0, 0, 0, utc),
+				time.Date(2026, 3, 2, 0, 0, 0, 0, utc),
+				time.Date(2026, 3, 9, 0, 0, 0, 0, utc),
+			},
+		},
+		{
+			name:  "leap day",
+			expr:  "0 3 29 feb *",
+			loc:   utc,
+			after: time.Date(2026, 1, 1, 0, 0, 0, 0, utc),
+			want:  []time.Time{time.Date(2028, 2, 29, 3, 0, 0, 0, utc)},
+		},
+		{
+			name:  "wall time skipped by spring forward runs at the jump",
+			expr:  "30 2 * * *",
+			loc:   newYork,
+			after: time.Date(2026, 3, 7, 3, 0, 0, 0, newYork),
+			want: []time.Time{
+				time.Date(2026, 3, 8, 7, 0, 0, 0, utc),
+				time.Date(2026, 3, 9, 6, 30, 0, 0, utc),
+			},
+		},
+		{
+			name:  "wall time repeated by fall back runs once",
+			expr:  "30 1 * * *",
+			loc:   newYork,
+			after: time.Date(2026, 10, 31, 12, 0, 0, 0, newYork),
+			want: []time.Time{
+				time.Date(2026, 11, 1, 5, 30, 0, 0, utc),
+				time.Date(2026, 11, 2, 6, 30, 0, 0, utc),
+			},
+		},
+		{
+			name:  "hourly across fall back",
+			expr:  "@hourly",
+			loc:   newYork,
+			after: time.Date(2026, 11, 1, 0, 30, 0, 0, newYork),
+			want: []time.Time{
+				time.Date(2026, 11, 1, 5, 0, 0, 0, utc),
+				time.Date(2026, 11, 1, 7, 0, 0, 0, utc),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := parseBackupCron(tt.expr)
+			if err != nil {
+				t.Fatalf("parseBackupCron(%q): %v", tt.expr, err)
+			}
+			got := backupScheduleRuns(spec, tt.loc, tt.after, len(tt.want))
+			if len(got) != len(tt.want) {
+				t.Fatalf("runs = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Fatalf("run %d = %s, want %s", i, got[i].UTC(), tt.want[i].UTC())
+				}
+			}
+		})
+	}
+
+	if _, ok := (backupCronSchedule{}).next(time.Now(), utc); ok {
+		t.Fatal("expected an empty schedule never to fire")
+	}
+}
+
+func TestNextBackupRunUsesTaskTimezone(t *testing.T) {
+	now := time.Date(2026, 6, 22, 12, 0, 0, 0, time.UTC)
+	next, ok, err := nextBackupRun(now, 1, backupScheduleData{Enabled: true, Schedule: "daily", Timezone: "Asia/Shanghai"})
+	if err != nil || !ok {
+		t.Fatalf("nextBackupRun = %v, %v", ok, err)
+	}
+	if want := time.Date(2026, 6, 22, 18, 0, 0, 0, time.UTC); !next.Equal(want) {
+		t.Fatalf("next run = %s, want %s", next.UTC(), want)
+	}
+	if _, _, err := nextBackupRun(now, 1, backupScheduleData{Enabled: true, Schedule: "cron", CronExpression: "0 9 * * *", Timezone: "Mars/Olympus"}); err == nil {
+		t.Fatal("expected an unknown time zone to be an error")
+	}
+}
+
+func TestBackupTaskCronSchedule(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(dataDir, "smarticky.db")+"?_pragma=foreign_keys(1)")
+	defer client.Close()
+	h := NewHandler(client, storage.NewFileSystem(dataDir))
+	target := client.BackupTarget.Create().SetName("WebDAV").SetType("webdav").SetWebdavURL("http://127.0.0.1").SaveX(ctx)
+
+	post := func(handler echo.HandlerFunc, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		if err := handler(echo.New().NewContext(req, rec)); err != nil {
+			t.Fatalf("handler returned error: %v", err)
+		}
+		return rec
+	}
+
+	for _, body := range []string{
+		`{"schedule":"cron"}`,
+		`{"schedule":"cron","cron_expression":"0 0 31 2 *"}`,
+		`{"schedule":"daily","timezone":"Mars/Olympus"}`,
+	} {
+		if rec := post(h.PreviewBackupSchedule, body); rec.Code != http.StatusBadRequest {
+			t.Fatalf("preview %s: expected status 400, got %d", body, rec.Code)
+		}
+	}
+
+	rec := post(h.PreviewBackupSchedule, `{"schedule":"cron","cron_expression":" 15  4 * * sun ","timezone":"Europe/Berlin"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("preview: %d %s", rec.Code, rec.Body.String())
+	}
+	var preview BackupSchedulePreviewResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &preview); err != nil {
+		t.Fatalf("decode preview: %v", err)
+	}
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	if preview.Timezone != "Europe/Berlin" || len(preview.NextRuns) != backupCronPreviewCount {
+		t.Fatalf("unexpected preview: %+v", preview)
+	}
+	for _, run := range preview.NextRuns {
+		local := run.In(berlin)
+		if local.Weekday() != time.Sunday || local.Hour() != 4 || local.Minute() != 15 {
+			t.Fatalf("preview run %s is not Sunday 04:15 in Berlin", local)
+		}
+	}
+
+	// Schedule errors are the caller's fault even while no scheduler runs.
+	rec = post(h.CreateBackupTask, fmt.Sprintf(`{"name":"Nightly","target_ids":[%d],"schedule":"cron","cron_expression":"0 25 * * *"}`, target.ID))
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "hour") {
+		t.Fatalf("expected invalid cron to be rejected, got %d %s", rec.Code, rec.Body.String())
+	}
+	rec = post(h.CreateBackupTask, fmt.Sprintf(`{"name":"Nightly","enabled":false,"target_ids":[%d],"schedule":"cron","cron_expression":"0 4 * * *","timezone":"Asia/Tokyo"}`, target.ID))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create task: %d %s", rec.Code, rec.Body.String())
+	}
+	var created BackupTaskResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("decode task: %v", err)
+	}
+	if created.Schedule != "cron" || created.CronExpression != "0 4 * * *" || created.Timezone != "Asia/Tokyo" {
+		t.Fatalf("unexpected task schedule: %+v", created)
+	}
+	if len(created.NextRuns) != 0 {
+		t.Fatalf("expected a disabled task to have no upcoming runs, got %v", created.NextRuns)
+	}
+}
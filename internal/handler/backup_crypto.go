@@ -0,0 +1,353 @@
+package handler
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"smarticky/ent"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// Encrypted backups are a header followed by a chunked ChaCha20-Poly1305
+// stream. The header records the Argon2id parameters and is authenticated as
+// associated data of every chunk. Each chunk nonce is the header's random
+// prefix, a big-endian chunk counter and a final-chunk flag, so truncating,
+// reordering or splicing chunks fails authentication.
+//
+//	magic[8] kdf[1] time[4] memory[4] threads[1] salt[16] chunk[4] nonce[7]
+const (
+	backupEncryptedSuffix = ".enc"
+	backupCipherMagic     = "SMTKENC1"
+	backupKDFArgon2id     = 1
+	backupSaltSize        = 16
+	backupNoncePrefixSize = 7
+	backupHeaderSize      = len(backupCipherMagic) + 1 + 4 + 4 + 1 + backupSaltSize + 4 + backupNoncePrefixSize
+	backupMinPassphrase   = 8
+)
+
+// Argon2id parameters for new archives. Readers accept any parameters within
+// backupKDFLimits so they can be raised later without breaking old archives.
+var backupKDFDefaults = backupKDFParams{Time: 3, MemoryKiB: 64 * 1024, Threads: 4, ChunkSize: 64 * 1024}
+
+var backupKDFLimits = struct {
+	maxTime, minMemoryKiB, maxMemoryKiB uint32
+	maxThreads                          uint8
+	minChunk, maxChunk                  uint32
+}{maxTime: 16, minMemoryKiB: 8 * 1024, maxMemoryKiB: 1024 * 1024, maxThreads: 64, minChunk: 1024, maxChunk: 16 << 20}
+
+var (
+	errBackupPassphraseRequired = errors.New("backup is encrypted; a passphrase is required")
+	errBackupPassphraseInvalid  = errors.New("incorrect backup passphrase or corrupted archive")
+	errBackupCiphertext         = errors.New("encrypted backup is corrupted or truncated")
+)
+
+type backupKDFParams struct {
+	Time      uint32
+	MemoryKiB uint32
+	Threads   uint8
+	ChunkSize uint32
+}
+
+func isEncryptedBackupFilename(name string) bool {
+	return strings.HasSuffix(name, backupEncryptedSuffix)
+}
+
+func validateBackupPassphrase(passphrase string) error {
+	if len([]rune(passphrase)) < backupMinPassphrase {
+		return fmt.Errorf("encryption passphrase must be at least %d characters", backupMinPassphrase)
+	}
+	return nil
+}
+
+// newBackupEncryptWriter writes a fresh header to w and returns a writer that
+// encrypts everything written to it. Close must be called to emit the final
+// chunk; it does not close w.
+func newBackupEncryptWriter(w io.Writer, passphrase string) (io.WriteCloser, error) {
+	params := backupKDFDefaults
+	header := make([]byte, backupHeaderSize)
+	copy(header, backupCipherMagic)
+	offset := len(backupCipherMagic)
+	header[offset] = backupKDFArgon2id
+	binary.BigEndian.PutUint32(header[offset+1:], params.Time)
+	binary.BigEndian.PutUint32(header[offset+5:], params.MemoryKiB)
+	header[offset+9] = params.Threads
+	salt := header[offset+10 : offset+10+backupSaltSize]
+	binary.BigEndian.PutUint32(header[offset+10+backupSaltSize:], params.ChunkSize)
+	noncePrefix := header[backupHeaderSize-backupNoncePrefixSize:]
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(noncePrefix); err != nil {
+		return nil, err
+	}
+
+	aead, err := backupCipher(passphrase, salt, params)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+	return &backupEncryptWriter{
+		w:      w,
+		stream: backupChunkStream{aead: aead, header: header, noncePrefix: noncePrefix},
+		chunk:  int(params.ChunkSize),
+		buf:    make([]byte, 0, int(params.ChunkSize)+aead.Overhead()),
+	}, nil
+}
+
+// sealBackupReader returns a reader of r encrypted with passphrase. Close
+// stops the encryption and waits for it to finish reading r.
+func sealBackupReader(r io.Reader, passphrase string) io.ReadCloser {
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		sealer, err := newBackupEncryptWriter(pw, passphrase)
+		if err == nil {
+			_, err = io.Copy(sealer, r)
+		}
+		if err == nil {
+			err = sealer.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	return &sealedBackupReader{PipeReader: pr, done: done}
+}
+
+type sealedBackupReader struct {
+	*io.PipeReader
+	done chan struct{}
+}
+
+func (r *sealedBackupReader) Close() error {
+	err := r.PipeReader.Close()
+	<-r.done
+	return err
+}
+
+// backupSealedSize is the size newBackupEncryptWriter produces for size
+// bytes of plaintext: the header plus one tag per chunk, with a final chunk
+// even when there is no data.
+func backupSealedSize(size int64) int64 {
+	chunk := int64(backupKDFDefaults.ChunkSize)
+	chunks := max((size+chunk-1)/chunk, 1)
+	return int64(backupHeaderSize) + size + chunks*chacha20poly1305.Overhead
+}
+
+// openBackupStream returns a plaintext reader for a backup archive. Plain
+// archives pass through unchanged; encrypted ones require passphrase.
+func openBackupStream(r io.Reader, passphrase string) (io.Reader, bool, error) {
+	buffered := bufio.NewReaderSize(r, 64*1024)
+	magic, err := buffered.Peek(len(backupCipherMagic))
+	if err != nil || string(magic) != backupCipherMagic {
+		// Too short to be encrypted; let the archive reader report it.
+		return buffered, false, nil
+	}
+	if passphrase == "" {
+		return nil, true, errBackupPassphraseRequired
+	}
+
+	header := make([]byte, backupHeaderSize)
+	if _, err := io.ReadFull(buffered, header); err != nil {
+		return nil, true, errBackupCiphertext
+	}
+	offset := len(backupCipherMagic)
+	if header[offset] != backupKDFArgon2id {
+		return nil, true, fmt.Errorf("unsupported backup key derivation %d", header[offset])
+	}
+	params := backupKDFParams{
+		Time:      binary.BigEndian.Uint32(header[offset+1:]),
+		MemoryKiB: binary.BigEndian.Uint32(header[offset+5:]),
+		Threads:   header[offset+9],
+		ChunkSize: binary.BigEndian.Uint32(header[offset+10+backupSaltSize:]),
+	}
+	limits := backupKDFLimits
+	if params.Time == 0 || params.Time > limits.maxTime ||
+		params.MemoryKiB < limits.minMemoryKiB || params.MemoryKiB > limits.maxMemoryKiB ||
+		params.Threads == 0 || params.Threads > limits.maxThreads ||
+		params.ChunkSize < limits.minChunk || params.ChunkSize > limits.maxChunk {
+		return nil, true, errors.New("backup encryption parameters are out of range")
+	}
+
+	salt := header[offset+10 : offset+10+backupSaltSize]
+	aead, err := backupCipher(passphrase, salt, params)
+	if err != nil {
+		return nil, true, err
+	}
+	return &backupDecryptReader{
+		r:      buffered,
+		stream: backupChunkStream{aead: aead, header: header, noncePrefix: header[backupHeaderSize-backupNoncePrefixSize:]},
+		in:     make([]byte, int(params.ChunkSize)+aead.Overhead()),
+	}, true, nil
+}
+
+func backupCipher(passphrase string, salt []byte, params backupKDFParams) (cipher.AEAD, error) {
+	key := argon2.IDKey([]byte(passphrase), salt, params.Time, params.MemoryKiB, params.Threads, chacha20poly1305.KeySize)
+	return chacha20poly1305.New(key)
+}
+
+type backupChunkStream struct {
+	aead        cipher.AEAD
+	header      []byte
+	noncePrefix []byte
+	counter     uint32
+}
+
+func (s *backupChunkStream) nonce(final bool) ([]byte, error) {
+	if s.counter == ^uint32(0) {
+		return nil, errors.New("backup archive is too large to encrypt")
+	}
+	nonce := make([]byte, chacha20poly1305.NonceSize)
+	copy(nonce, s.noncePrefix)
+	binary.BigEndian.PutUint32(nonce[backupNoncePrefixSize:], s.counter)
+	if final {
+		nonce[len(nonce)-1] = 1
+	}
+	s.counter++
+	return nonce, nil
+}
+
+type backupEncryptWriter struct {
+	w      io.Writer
+	stream backupChunkStream
+	chunk  int
+	buf    []byte
+	closed bool
+}
+
+func (e *backupEncryptWriter) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.New("write to closed backup encrypter")
+	}
+	written := 0
+	for len(p) > 0 {
+		// Keep a full chunk buffered until more data arrives so the last
+		// chunk can always be sealed as final in Close.
+		if len(e.buf) == e.chunk {
+			if err := e.flush(false); err != nil {
+				return written, err
+			}
+		}
+		n := copy(e.buf[len(e.buf):e.chunk], p)
+		e.buf = e.buf[:len(e.buf)+n]
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+func (e *backupEncryptWriter) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	return e.flush(true)
+}
+
+func (e *backupEncryptWriter) flush(final bool) error {
+	nonce, err := e.stream.nonce(final)
+	if err != nil {
+		return err
+	}
+	sealed := e.stream.aead.Seal(e.buf[:0], nonce, e.buf, e.stream.header)
+	if _, err := e.w.Write(sealed); err != nil {
+		return err
+	}
+	e.buf = e.buf[:0]
+	return nil
+}
+
+type backupDecryptReader struct {
+	r      *bufio.Reader
+	stream backupChunkStream
+	in     []byte
+	plain  []byte
+	done   bool
+}
+
+func (d *backupDecryptReader) Read(p []byte) (int, error) {
+	for len(d.plain) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.plain)
+	d.plain = d.plain[n:]
+	return n, nil
+}
+
+func (d *backupDecryptReader) next() error {
+	n, err := io.ReadFull(d.r, d.in)
+	final := false
+	switch {
+	case err == io.EOF || err == io.ErrUnexpectedEOF:
+		final = true
+	case err != nil:
+		return err
+	default:
+		if _, peekErr := d.r.Peek(1); peekErr == io.EOF {
+			final = true
+		}
+	}
+	if n < d.stream.aead.Overhead() {
+		return errBackupCiphertext
+	}
+
+	first := d.stream.counter == 0
+	nonce, err := d.stream.nonce(final)
+	if err != nil {
+		return err
+	}
+	plain, err := d.stream.aead.Open(d.in[:0], nonce, d.in[:n], d.stream.header)
+	if err != nil {
+		if first {
+			return errBackupPassphraseInvalid
+		}
+		return errBackupCiphertext
+	}
+	d.plain = plain
+	d.done = final
+	return nil
+}
+
+// isBackupCipherError reports whether err came from decrypting an archive.
+func isBackupCipherError(err error) bool {
+	return errors.Is(err, errBackupPassphraseRequired) ||
+		errors.Is(err, errBackupPassphraseInvalid) ||
+		errors.Is(err, errBackupCiphertext)
+}
+
+func (h *Handler) sealBackupPassphrase(passphrase string) (string, error) {
+	if passphrase == "" {
+		return "", nil
+	}
+	return h.secrets.Seal([]byte(passphrase))
+}
+
+// backupTaskPassphrase returns the task's archive passphrase, or "" when the
+// task does not encrypt its backups.
+func (h *Handler) backupTaskPassphrase(task *ent.BackupTask) (string, error) {
+	if !task.EncryptionEnabled {
+		return "", nil
+	}
+	if task.EncryptionPassphrase == "" {
+		return "", errors.New("backup task encryption passphrase is missing")
+	}
+	passphrase, err := h.secrets.Open(task.EncryptionPassphrase)
+	if err != nil {
+		return "", fmt.Errorf("failed to unseal backup encryption passphrase: %w", err)
+	}
+	return string(passphrase), nil
+}
@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"smarticky/internal/version"
+
+	"github.com/spf13/afero"
+)
+
+// Every archive carries a manifest describing the database snapshot next to
+// it, written before the database entry so verification can check the
+// database while streaming past it.
+const (
+	backupManifestName    = "backup.json"
+	backupManifestVersion = 1
+)
+
+// BackupManifest records what the database looked like when it was backed up.
+type BackupManifest struct {
+	Version    int              `json:"version"`
+	AppVersion string           `json:"app_version"`
+	SchemaHash string           `json:"schema_hash"`
+	CreatedAt  time.Time        `json:"created_at"`
+	Tables     map[string]int64 `json:"tables"`
+}
+
+// snapshotDatabase copies the live database with VACUUM INTO, which reads it
+// in a single transaction, so the copy is consistent even while handlers and
+// sync jobs keep writing. The caller must call cleanup.
+func (h *Handler) snapshotDatabase() (string, func(), error) {
+	snapshotPath := filepath.Join(h.fs.GetDataDir(), fmt.Sprintf(".smarticky_snapshot_%d.db", time.Now().UnixNano()))
+	cleanup := func() { _ = h.fs.Remove(snapshotPath) }
+
+	db, err := sql.Open("sqlite3", backupDatabaseDSN(h.getDBPath()))
+	if err != nil {
+		return "", func() {}, fmt.Errorf("failed to open database for backup: %w", err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("VACUUM INTO ?", snapshotPath); err != nil {
+		cleanup()
+		return "", func() {}, fmt.Errorf("failed to snapshot database: %w", err)
+	}
+	return snapshotPath, cleanup, nil
+}
+
+func backupDatabaseDSN(path string) string {
+	return fmt.Sprintf("file:%s?_pragma=busy_timeout(10000)", path)
+}
+
+// addBackupDatabase snapshots the database and adds the manifest and the
+// snapshot to tarWriter.
+func (h *Handler) addBackupDatabase(tarWriter *tar.Writer) error {
+	snapshotPath, cleanup, err := h.snapshotDatabase()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	schemaHash, tables, err := inspectBackupDatabase(snapshotPath)
+	if err != nil {
+		return err
+	}
+	manifest := BackupManifest{
+		Version:    backupManifestVersion,
+		AppVersion: version.Version,
+		SchemaHash: schemaHash,
+		CreatedAt:  time.Now(),
+		Tables:     tables,
+	}
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name:     backupManifestName,
+		Typeflag: tar.TypeReg,
+		Mode:     0644,
+		Size:     int64(len(data)),
+		ModTime:  manifest.CreatedAt,
+	}); err != nil {
+		return fmt.Errorf("failed to write backup manifest: %w", err)
+	}
+	if _, err := tarWriter.Write(data); err != nil {
+		return fmt.Errorf("failed to write backup manifest: %w", err)
+	}
+	return h.addBackupArchiveFile(tarWriter, snapshotPath, "smarticky.db")
+}
+
+// inspectBackupDatabase returns a hash of the schema and the row count of
+// every table in the database at path.
+func inspectBackupDatabase(path string) (string, map[string]int64, error) {
+	db, err := sql.Open("sqlite3", backupDatabaseDSN(path))
+	if err != nil {
+		return "", nil, err
+	}
+	defer db.Close()
+
+	rows, err := db.Query(`SELECT type, name, sql FROM sqlite_master
+		WHERE sql IS NOT NULL AND name NOT LIKE 'sqlite_%' ORDER BY type, name`)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read database schema: %w", err)
+	}
+	hash := sha256.New()
+	var tables []string
+	for rows.Next() {
+		var kind, name, statement string
+		if err := rows.Scan(&kind, &name, &statement); err != nil {
+			rows.Close()
+			return "", nil, err
+		}
+		fmt.Fprintf(hash, "%s\x00%s\x00%s\n", kind, name, statement)
+		if kind == "table" {
+			tables = append(tables, name)
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return "", nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return "", nil, fmt.Errorf("failed to read database schema: %w", err)
+	}
+
+	counts := make(map[string]int64, len(tables))
+	for _, table := range tables {
+		var count int64
+		quoted := `"` + strings.ReplaceAll(table, `"`, `""`) + `"`
+		if err := db.QueryRow("SELECT COUNT(*) FROM " + quoted).Scan(&count); err != nil {
+			return "", nil, fmt.Errorf("failed to count %s rows: %w", table, err)
+		}
+		counts[table] = count
+	}
+	return hex.EncodeToString(hash.Sum(nil)), counts, nil
+}
+
+func decodeBackupManifest(r io.Reader) (*BackupManifest, error) {
+	var manifest BackupManifest
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("invalid backup manifest: %w", err)
+	}
+	if manifest.Version != backupManifestVersion {
+		return nil, fmt.Errorf("unsupported backup manifest version %d", manifest.Version)
+	}
+	return &manifest, nil
+}
+
+// checkBackupDatabase spools an archived database to a temporary file and
+// compares its schema and row counts with manifest. It returns the size
+// read and a description of the first mismatch, if any.
+func (h *Handler) checkBackupDatabase(r io.Reader, manifest *BackupManifest) (int64, string, error) {
+	file, err := afero.TempFile(h.fs.GetFs(), h.fs.GetDataDir(), ".smarticky_verify_*.db")
+	if err != nil {
+		return 0, "", err
+	}
+	path := file.Name()
+	defer func() { _ = h.fs.Remove(path) }()
+	written, err := io.Copy(file, r)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return written, "", err
+	}
+
+	schemaHash, tables, err := inspectBackupDatabase(path)
+	if err != nil {
+		return written, fmt.Sprintf("database cannot be read: %v", err), nil
+	}
+	if schemaHash != manifest.SchemaHash {
+		return written, "database schema does not match the backup manifest", nil
+	}
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	for name := range manifest.Tables {
+		if _, ok := tables[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if got, want := tables[name], manifest.Tables[name]; got != want {
+			return written, fmt.Sprintf("table %s has %d rows, manifest recorded %d", name, got, want), nil
+		}
+	}
+	return written, "", nil
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"smarticky/internal/storage"
+)
+
+func TestVerifyBackupDataChecksDatabaseManifest(t *testing.T) {
+	dataDir := t.TempDir()
+	fs := storage.NewFileSystem(dataDir)
+	h := &Handler{fs: fs}
+	writeTestDatabase(t, filepath.Join(dataDir, "smarticky.db"), []byte("db-data"))
+
+	var archive bytes.Buffer
+	if err := h.writeBackupArchive(&archive); err != nil {
+		t.Fatalf("write backup archive: %v", err)
+	}
+	result := h.verifyBackupData(bytes.NewReader(archive.Bytes()), "")
+	if !result.Valid || result.Manifest == nil {
+		t.Fatalf("expected archive with manifest to verify, got %+v", result)
+	}
+	if result.Manifest.SchemaHash == "" || result.Manifest.Tables["notes"] != 1 {
+		t.Fatalf("expected manifest to record schema and row counts, got %+v", result.Manifest)
+	}
+	if matches, _ := filepath.Glob(filepath.Join(dataDir, ".smarticky_*")); len(matches) != 0 {
+		t.Fatalf("expected snapshot and verify temp files to be removed, found %v", matches)
+	}
+
+	db, err := fs.ReadFile(filepath.Join(dataDir, "smarticky.db"))
+	if err != nil {
+		t.Fatalf("read db: %v", err)
+	}
+	tamper := func(edit func(*BackupManifest)) BackupVerificationResult {
+		t.Helper()
+		manifest := *result.Manifest
+		manifest.Tables = map[string]int64{"notes": 1}
+		edit(&manifest)
+		data, err := json.Marshal(manifest)
+		if err != nil {
+			t.Fatalf("encode manifest: %v", err)
+		}
+		return h.verifyBackupData(bytes.NewReader(makeTestArchive(t,
+			tarTestEntry{name: backupManifestName, body: string(data)},
+			tarTestEntry{name: "smarticky.db", body: string(db)},
+			tarTestEntry{name: "uploads", dir: true},
+		)), "")
+	}
+
+	if got := tamper(func(*BackupManifest) {}); !got.Valid {
+		t.Fatalf("expected untouched manifest to verify, got %+v", got)
+	}
+	got := tamper(func(m *BackupManifest) { m.Tables["notes"] = 2 })
+	if got.Valid || !strings.Contains(got.FileChecks[0].Error, "table notes has 1 rows") {
+		t.Fatalf("expected row count mismatch, got %+v", got)
+	}
+	got = tamper(func(m *BackupManifest) { m.SchemaHash = strings.Repeat("0", 64) })
+	if got.Valid || !strings.Contains(got.FileChecks[0].Error, "schema") {
+		t.Fatalf("expected schema mismatch, got %+v", got)
+	}
+}
@@ -0,0 +1,459 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/backupdrill"
+	"smarticky/ent/backuptarget"
+
+	"github.com/labstack/echo/v4"
+)
+
+// A restore drill proves that the latest backup of a target can actually be
+// restored: it is downloaded, verified and extracted into a scratch
+// directory, and the restored database is checked before being thrown away.
+// The live data directory is never touched.
+
+const (
+	defaultBackupDrillPageSize = 20
+	// backupDrillMissingLimit caps how many missing attachments are listed
+	// in a check's detail.
+	backupDrillMissingLimit = 10
+)
+
+// backupDrillTables are the tables whose row counts are compared between
+// the restored and the live database.
+var backupDrillTables = []string{"users", "folders", "notes", "tags", "attachments", "whiteboards"}
+
+// backupDrillTaskFilename recovers the task that wrote a backup, so the
+// drill can decrypt it with the task's passphrase.
+var backupDrillTaskFilename = regexp.MustCompile(`^smarticky_(?:auto_)?backup_task_(\d+)_`)
+
+type BackupDrillCheck struct {
+	Name   string `json:"name"`
+	OK     bool   `json:"ok"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type BackupDrillCount struct {
+	Table  string `json:"table"`
+	Backup int64  `json:"backup"`
+	Live   int64  `json:"live"`
+}
+
+type BackupDrillResponse struct {
+	ID         int                `json:"id"`
+	TargetID   int                `json:"target_id"`
+	Filename   string             `json:"filename,omitempty"`
+	Trigger    string             `json:"trigger"`
+	Status     string             `json:"status"`
+	Error      string             `json:"error,omitempty"`
+	Checks     []BackupDrillCheck `json:"checks"`
+	Counts     []BackupDrillCount `json:"counts"`
+	StartedAt  time.Time          `json:"started_at"`
+	FinishedAt time.Time          `json:"finished_at"`
+	DurationMS int64              `json:"duration_ms"`
+}
+
+// RunBackupTargetDrill restores the latest backup of a target into a
+// scratch directory and reports the checks. A failed drill is still a
+// successful request; its status says what went wrong.
+func (h *Handler) RunBackupTargetDrill(c echo.Context) error {
+	target, err := h.backupTargetFromParam(c)
+	if err != nil {
+		return backupTargetParamError(c, err)
+	}
+	drill, err := h.runBackupDrill(c.Request().Context(), target, "manual")
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to record restore drill"})
+	}
+	return c.JSON(http.StatusOK, backupDrillResponse(drill))
+}
+
+func (h *Handler) ListBackupTargetDrills(c echo.Context) error {
+	target, err := h.backupTargetFromParam(c)
+	if err != nil {
+		return backupTargetParamError(c, err)
+	}
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	if limit <= 0 {
+		limit = defaultBackupDrillPageSize
+	}
+	limit = min(limit, maxBackupRunPageSize)
+	drills, err := h.client.BackupDrill.Query().
+		Where(backupdrill.TargetID(target.ID)).
+		Order(ent.Desc(backupdrill.FieldStartedAt), ent.Desc(backupdrill.FieldID)).
+		Limit(limit).
+		All(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to list restore drills"})
+	}
+	response := make([]BackupDrillResponse, 0, len(drills))
+	for _, drill := range drills {
+		response = append(response, backupDrillResponse(drill))
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"drills": response})
+}
+
+func backupDrillResponse(drill *ent.BackupDrill) BackupDrillResponse {
+	response := BackupDrillResponse{
+		ID:         drill.ID,
+		TargetID:   drill.TargetID,
+		Filename:   drill.Filename,
+		Trigger:    drill.Trigger,
+		Status:     drill.Status,
+		Error:      drill.Error,
+		Checks:     []BackupDrillCheck{},
+		Counts:     []BackupDrillCount{},
+		StartedAt:  drill.StartedAt,
+		FinishedAt: drill.FinishedAt,
+		DurationMS: drill.DurationMs,
+	}
+	if drill.ChecksJSON != "" {
+		_ = json.Unmarshal([]byte(drill.ChecksJSON), &response.Checks)
+	}
+	if drill.CountsJSON != "" {
+		_ = json.Unmarshal([]byte(drill.CountsJSON), &response.Counts)
+	}
+	return response
+}
+
+// runDueBackupDrills runs a drill for every enabled target whose drill
+// interval has passed since its last drill.
+func (h *Handler) runDueBackupDrills(ctx context.Context, now time.Time) error {
+	targets, err := h.client.BackupTarget.Query().
+		Where(
+			backuptarget.Enabled(true),
+			backuptarget.DrillIntervalDaysGT(0),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, target := range targets {
+		last, err := h.client.BackupDrill.Query().
+			Where(backupdrill.TargetID(target.ID)).
+			Order(ent.Desc(backupdrill.FieldStartedAt)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if last != nil && now.Before(last.StartedAt.AddDate(0, 0, target.DrillIntervalDays)) {
+			continue
+		}
+		if _, err := h.runBackupDrill(ctx, target, "scheduled"); err != nil {
+			fmt.Printf("Failed to record restore drill for target %d: %v\n", target.ID, err)
+		}
+	}
+	return nil
+}
+
+// backupDrillResult collects the outcome of a drill while it runs.
+type backupDrillResult struct {
+	filename string
+	checks   []BackupDrillCheck
+	counts   []BackupDrillCount
+}
+
+func (r *backupDrillResult) check(name string, err error) bool {
+	check := BackupDrillCheck{Name: name, OK: err == nil}
+	if err != nil {
+		check.Detail = err.Error()
+	}
+	r.checks = append(r.checks, check)
+	return err == nil
+}
+
+// runBackupDrill runs a drill against target, records it and alerts when it
+// fails. Only a failure to record the drill is returned as an error.
+func (h *Handler) runBackupDrill(ctx context.Context, target *ent.BackupTarget, trigger string) (*ent.BackupDrill, error) {
+	started := time.Now()
+	var result backupDrillResult
+	drillErr := h.restoreBackupDrill(ctx, target, &result)
+
+	finished := time.Now()
+	create := h.client.BackupDrill.Create().
+		SetTargetID(target.ID).
+		SetFilename(result.filename).
+		SetTrigger(trigger).
+		SetStatus("passed").
+		SetStartedAt(started).
+		SetFinishedAt(finished).
+		SetDurationMs(finished.Sub(started).Milliseconds())
+	if result.checks != nil {
+		data, err := json.Marshal(result.checks)
+		if err != nil {
+			return nil, err
+		}
+		create.SetChecksJSON(string(data))
+	}
+	if result.counts != nil {
+		data, err := json.Marshal(result.counts)
+		if err != nil {
+			return nil, err
+		}
+		create.SetCountsJSON(string(data))
+	}
+	if drillErr != nil {
+		create.SetStatus("failed").SetError(backupRunError(drillErr))
+	}
+	drill, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.client.BackupDrill.Delete().
+		Where(backupdrill.StartedAtLT(finished.AddDate(0, 0, -backupRunHistoryDays))).
+		Exec(ctx); err != nil {
+		fmt.Printf("Failed to prune restore drill history: %v\n", err)
+	}
+	if drillErr != nil {
+		h.dispatchFailureAlert(ctx, backupAlert{
+			Event:      backupAlertDrillFailed,
+			TargetID:   &target.ID,
+			TargetName: target.Name,
+			Filename:   result.filename,
+			Error:      drill.Error,
+			At:         finished,
+		})
+	}
+	return drill, nil
+}
+
+// restoreBackupDrill downloads, verifies and extracts the latest backup of
+// target and checks the restored database. Every check that ran is added to
+// result; the returned error names the first one that failed.
+func (h *Handler) restoreBackupDrill(ctx context.Context, target *ent.BackupTarget, result *backupDrillResult) error {
+	client, err := h.backupTargetClient(target)
+	if err != nil {
+		return err
+	}
+	files, err := client.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list backups: %w", err)
+	}
+	if len(files) == 0 {
+		return errors.New("the target has no backups")
+	}
+	latest := files[0]
+	for _, file := range files[1:] {
+		if file.CreatedAt.After(latest.CreatedAt) {
+			latest = file
+		}
+	}
+	result.filename = latest.Filename
+
+	passphrase, err := h.backupFilePassphrase(ctx, latest.Filename)
+	if err != nil {
+		return err
+	}
+	backupPath, cleanup, err := h.downloadBackupToTemp(ctx, client, latest.Filename)
+	if err != nil {
+		return fmt.Errorf("failed to download backup: %w", err)
+	}
+	defer cleanup()
+
+	verification := h.verifyBackupFile(backupPath, passphrase)
+	verifyBackupSnapshotBlobs(ctx, client, &verification)
+	var archiveErr error
+	if !verification.Valid {
+		archiveErr = errors.New(verification.Error)
+	}
+	if !result.check("archive", archiveErr) {
+		return fmt.Errorf("backup archive is invalid: %w", archiveErr)
+	}
+
+	session, err := h.extractBackupRestoreSession(client, backupPath, passphrase)
+	if !result.check("extract", err) {
+		return err
+	}
+	defer session.close()
+
+	var failed []string
+	if !result.check("integrity", checkBackupDrillIntegrity(session.databasePath())) {
+		failed = append(failed, "integrity")
+	}
+	if !result.check("foreign_keys", checkBackupDrillForeignKeys(session.databasePath())) {
+		failed = append(failed, "foreign_keys")
+	}
+	counts, err := h.backupDrillCounts(session.databasePath())
+	result.counts = counts
+	if !result.check("counts", err) {
+		failed = append(failed, "counts")
+	}
+	err = session.openDatabase(ctx)
+	if err == nil {
+		err = h.checkBackupDrillAttachments(ctx, session)
+	}
+	if !result.check("attachments", err) {
+		failed = append(failed, "attachments")
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("restore drill checks failed: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// backupFilePassphrase returns the passphrase of the task that wrote
+// filename, for drills and snapshot garbage collection. Backups not written
+// by a task are never encrypted.
+func (h *Handler) backupFilePassphrase(ctx context.Context, filename string) (string, error) {
+	if !isEncryptedBackupFilename(filename) {
+		return "", nil
+	}
+	match := backupDrillTaskFilename.FindStringSubmatch(filename)
+	if match == nil {
+		return "", errors.New("cannot tell which task encrypted the backup")
+	}
+	taskID, _ := strconv.Atoi(match[1])
+	task, err := h.client.BackupTask.Get(ctx, taskID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", errors.New("the task that encrypted the backup no longer exists")
+		}
+		return "", err
+	}
+	return h.backupTaskPassphrase(task)
+}
+
+// checkBackupDrillIntegrity runs SQLite's integrity check on the restored
+// database.
+func checkBackupDrillIntegrity(path string) error {
+	db, err := sql.Open("sqlite3", backupDatabaseDSN(path))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	rows, err := db.Query("PRAGMA integrity_check")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	var problems []string
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line); err != nil {
+			return err
+		}
+		if line != "ok" {
+			problems = append(problems, line)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// checkBackupDrillForeignKeys reports rows of the restored database that
+// point at a missing parent row.
+func checkBackupDrillForeignKeys(path string) error {
+	db, err := sql.Open("sqlite3", backupDatabaseDSN(path))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	rows, err := db.Query("PRAGMA foreign_key_check")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	violations := map[string]int{}
+	total := 0
+	for rows.Next() {
+		var table, parent string
+		var rowID sql.NullInt64
+		var fkID int
+		if err := rows.Scan(&table, &rowID, &parent, &fkID); err != nil {
+			return err
+		}
+		violations[table+" -> "+parent]++
+		total++
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if total == 0 {
+		return nil
+	}
+	details := make([]string, 0, len(violations))
+	for relation, n := range violations {
+		details = append(details, fmt.Sprintf("%s (%d)", relation, n))
+	}
+	sort.Strings(details)
+	return fmt.Errorf("%d rows reference missing rows: %s", total, strings.Join(details, ", "))
+}
+
+// backupDrillCounts compares row counts of the restored database with the
+// live one. The live data keeps changing after a backup, so only a backup
+// that lost every user or note while the live data still has some fails.
+func (h *Handler) backupDrillCounts(path string) ([]BackupDrillCount, error) {
+	_, backupCounts, err := inspectBackupDatabase(path)
+	if err != nil {
+		return nil, err
+	}
+	_, liveCounts, err := inspectBackupDatabase(h.getDBPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count live data: %w", err)
+	}
+	counts := make([]BackupDrillCount, 0, len(backupDrillTables))
+	var empty []string
+	for _, table := range backupDrillTables {
+		count := BackupDrillCount{Table: table, Backup: backupCounts[table], Live: liveCounts[table]}
+		counts = append(counts, count)
+		if (table == "users" || table == "notes") && count.Backup == 0 && count.Live > 0 {
+			empty = append(empty, table)
+		}
+	}
+	if len(empty) > 0 {
+		return counts, fmt.Errorf("backup has no %s but the live data does", strings.Join(empty, " or "))
+	}
+	return counts, nil
+}
+
+// checkBackupDrillAttachments makes sure every attachment row of the
+// restored database has its file in the backup.
+func (h *Handler) checkBackupDrillAttachments(ctx context.Context, session *backupRestoreSession) error {
+	attachments, err := session.source.Attachment.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for _, a := range attachments {
+		rel, ok := backupUploadPath(a.FilePath)
+		if ok {
+			_, ok, err = session.uploadDigest(h, rel)
+			if err != nil {
+				return err
+			}
+		}
+		if !ok {
+			missing = append(missing, a.Filename)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	shown := missing
+	if len(shown) > backupDrillMissingLimit {
+		shown = shown[:backupDrillMissingLimit]
+	}
+	detail := strings.Join(shown, ", ")
+	if len(missing) > len(shown) {
+		detail += fmt.Sprintf(" and %d more", len(missing)-len(shown))
+	}
+	return fmt.Errorf("%d of %d attachment files are missing: %s", len(missing), len(attachments), detail)
+}
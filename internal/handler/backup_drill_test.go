@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"smarticky/ent/backupdrill"
+	"smarticky/ent/backuptask"
+	"smarticky/ent/enttest"
+	"smarticky/internal/storage"
+
+	"github.com/labstack/echo/v4"
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestBackupDrillRestoresLatestBackup(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(dataDir, "smarticky.db")+"?_pragma=foreign_keys(1)")
+	defer client.Close()
+	fs := storage.NewFileSystem(dataDir)
+	h := NewHandler(client, fs)
+
+	alerts := make(chan backupAlert, 4)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert backupAlert
+		if err := json.NewDecoder(r.Body).Decode(&alert); err != nil {
+			t.Errorf("decode alert: %v", err)
+		}
+		alerts <- alert
+	}))
+	defer webhook.Close()
+	sealed, err := h.sealBackupSecret(webhook.URL)
+	if err != nil {
+		t.Fatalf("seal webhook: %v", err)
+	}
+	config, err := h.getOrCreateBackupConfig(ctx)
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	config.Update().SetAlertWebhookURL(sealed).ExecX(ctx)
+
+	backupDir := t.TempDir()
+	target := client.BackupTarget.Create().SetName("Disk").SetType("local").SetLocalPath(backupDir).SaveX(ctx)
+
+	drill := func() BackupDrillResponse {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(fmt.Sprint(target.ID))
+		if err := h.RunBackupTargetDrill(c); err != nil {
+			t.Fatalf("run drill: %v", err)
+		}
+		if rec.Code != http.StatusOK {
+			t.Fatalf("run drill: %d %s", rec.Code, rec.Body.String())
+		}
+		var response BackupDrillResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+			t.Fatalf("decode drill: %v", err)
+		}
+		return response
+	}
+	checkOK := func(result BackupDrillResponse, name string) bool {
+		for _, check := range result.Checks {
+			if check.Name == name {
+				return check.OK
+			}
+		}
+		t.Fatalf("drill has no %s check: %+v", name, result.Checks)
+		return false
+	}
+
+	if result := drill(); result.Status != "failed" || !strings.Contains(result.Error, "no backups") {
+		t.Fatalf("expected a drill without backups to fail, got %+v", result)
+	}
+	select {
+	case alert := <-alerts:
+		if alert.Event != backupAlertDrillFailed || alert.TargetName != "Disk" {
+			t.Fatalf("unexpected alert: %+v", alert)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a drill failure alert")
+	}
+
+	alice := client.User.Create().SetUsername("alice").SetPasswordHash("hash").SaveX(ctx)
+	plan := client.Note.Create().SetTitle("Plan").SetContent("body").SetUser(alice).SaveX(ctx)
+	imagePath := filepath.Join(dataDir, "uploads", "attachments", "a.png")
+	if err := fs.WriteFile(imagePath, []byte("alpha"), 0644); err != nil {
+		t.Fatalf("write attachment: %v", err)
+	}
+	client.Attachment.Create().SetFilename("a.png").SetFilePath(imagePath).SetFileSize(5).SetNote(plan).SetUser(alice).SaveX(ctx)
+
+	passphrase, err := h.sealBackupPassphrase("correct horse battery")
+	if err != nil {
+		t.Fatalf("seal passphrase: %v", err)
+	}
+	created := client.BackupTask.Create().
+		SetName("Encrypted").
+		SetEncryptionEnabled(true).
+		SetEncryptionPassphrase(passphrase).
+		AddTargets(target).
+		SaveX(ctx)
+	task := client.BackupTask.Query().Where(backuptask.ID(created.ID)).WithTargets().OnlyX(ctx)
+	if _, err := h.runBackupTask(ctx, task, false); err != nil {
+		t.Fatalf("run backup task: %v", err)
+	}
+	client.Note.Create().SetTitle("After").SetContent("later").SetUser(alice).ExecX(ctx)
+
+	result := drill()
+	if result.Status != "passed" || !isEncryptedBackupFilename(result.Filename) {
+		t.Fatalf("expected the encrypted backup to pass, got %+v", result)
+	}
+	for _, name := range []string{"archive", "extract", "integrity", "foreign_keys", "counts", "attachments"} {
+		if !checkOK(result, name) {
+			t.Fatalf("expected %s to pass: %+v", name, result.Checks)
+		}
+	}
+	counts := map[string]BackupDrillCount{}
+	for _, count := range result.Counts {
+		counts[count.Table] = count
+	}
+	if counts["notes"] != (BackupDrillCount{Table: "notes", Backup: 1, Live: 2}) || counts["attachments"].Backup != 1 {
+		t.Fatalf("unexpected counts: %+v", result.Counts)
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, "uploads", "attachments", "a.png")); err != nil {
+		t.Fatalf("drill must not touch the live uploads: %v", err)
+	}
+
+	// An attachment row whose file was already gone when the backup ran.
+	client.Attachment.Create().
+		SetFilename("ghost.png").
+		SetFilePath(filepath.Join(dataDir, "uploads", "attachments", "ghost.png")).
+		SetNote(plan).
+		SetUser(alice).
+		ExecX(ctx)
+	time.Sleep(time.Second)
+	if _, err := h.runBackupTask(ctx, task, false); err != nil {
+		t.Fatalf("run backup task: %v", err)
+	}
+	result = drill()
+	if result.Status != "failed" || checkOK(result, "attachments") || !checkOK(result, "integrity") {
+		t.Fatalf("expected the missing attachment to fail the drill, got %+v", result)
+	}
+	for _, check := range result.Checks {
+		if check.Name == "attachments" && !strings.Contains(check.Detail, "ghost.png") {
+			t.Fatalf("expected the missing file to be named: %+v", check)
+		}
+	}
+
+	// A damaged upload newer than every good backup.
+	corrupt := filepath.Join(backupDir, "smarticky_backup_20990101_000000.tar.gz")
+	if err := os.WriteFile(corrupt, []byte("not a backup"), 0600); err != nil {
+		t.Fatalf("write corrupt backup: %v", err)
+	}
+	future := time.Now().Add(time.Hour)
+	if err := os.Chtimes(corrupt, future, future); err != nil {
+		t.Fatalf("touch corrupt backup: %v", err)
+	}
+	result = drill()
+	if result.Status != "failed" || result.Filename != filepath.Base(corrupt) || checkOK(result, "archive") || len(result.Checks) != 1 {
+		t.Fatalf("expected the corrupt backup to fail verification, got %+v", result)
+	}
+
+	// Scheduled drills run once the interval has passed since the last one.
+	client.BackupTarget.UpdateOneID(target.ID).SetDrillIntervalDays(7).ExecX(ctx)
+	before := client.BackupDrill.Query().CountX(ctx)
+	if err := h.runDueBackupDrills(ctx, time.Now().Add(24*time.Hour)); err != nil {
+		t.Fatalf("run due drills: %v", err)
+	}
+	if n := client.BackupDrill.Query().CountX(ctx); n != before {
+		t.Fatalf("expected no drill before the interval passed, got %d drills", n)
+	}
+	if err := h.runDueBackupDrills(ctx, time.Now().AddDate(0, 0, 8)); err != nil {
+		t.Fatalf("run due drills: %v", err)
+	}
+	if n := client.BackupDrill.Query().Where(backupdrill.Trigger("scheduled")).CountX(ctx); n != 1 {
+		t.Fatalf("expected one scheduled drill, got %d", n)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/?limit=2", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(fmt.Sprint(target.ID))
+	if err := h.ListBackupTargetDrills(c); err != nil {
+		t.Fatalf("list drills: %v", err)
+	}
+	var history struct {
+		Drills []BackupDrillResponse `json:"drills"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &history); err != nil {
+		t.Fatalf("decode drills: %v", err)
+	}
+	if len(history.Drills) != 2 || history.Drills[0].Trigger != "scheduled" || len(history.Drills[0].Checks) == 0 {
+		t.Fatalf("unexpected drill history: %+v", history.Drills)
+	}
+}
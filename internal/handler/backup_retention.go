@@ -0,0 +1,359 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/backuppin"
+	"smarticky/ent/backuptask"
+
+	"github.com/labstack/echo/v4"
+)
+
+// backupRetentionPolicy decides which of a task's backups survive cleanup.
+//
+// Without grandfather-father-son buckets a backup is deleted once it falls
+// outside MaxCount or RetentionDays. With any bucket set, retention works
+// like restic's forget: a backup is kept when it is among the newest
+// MaxCount, younger than RetentionDays, or the newest of one of the last N
+// hours, days, ISO weeks, months or years; everything else is deleted.
+// Pinned backups are always kept and do not use up any slot.
+type backupRetentionPolicy struct {
+	RetentionDays int
+	MaxCount      int
+	KeepHourly    int
+	KeepDaily     int
+	KeepWeekly    int
+	KeepMonthly   int
+	KeepYearly    int
+	// Location is where hours, days and weeks begin.
+	Location *time.Location
+}
+
+// backupRetentionDecision is the verdict for one backup file.
+type backupRetentionDecision struct {
+	File    BackupFileInfo
+	Keep    bool
+	Reasons []string
+}
+
+type BackupRetentionFile struct {
+	BackupFileInfo
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+type BackupRetentionTargetPreview struct {
+	TargetID int                   `json:"target_id"`
+	Name     string                `json:"name"`
+	Keep     []BackupRetentionFile `json:"keep"`
+	Delete   []BackupRetentionFile `json:"delete"`
+	Error    string                `json:"error,omitempty"`
+}
+
+type BackupRetentionPreviewResponse struct {
+	TaskID  int                            `json:"task_id"`
+	Targets []BackupRetentionTargetPreview `json:"targets"`
+}
+
+type backupPinPayload struct {
+	Filename string `json:"filename"`
+	Note     string `json:"note"`
+}
+
+func (p backupRetentionPolicy) gfs() bool {
+	return p.KeepHourly > 0 || p.KeepDaily > 0 || p.KeepWeekly > 0 || p.KeepMonthly > 0 || p.KeepYearly > 0
+}
+
+func (p backupRetentionPolicy) enabled() bool {
+	return p.RetentionDays > 0 || p.MaxCount > 0 || p.gfs()
+}
+
+func backupTaskRetentionPolicy(task *ent.BackupTask) backupRetentionPolicy {
+	loc, err := loadBackupTimezone(task.Timezone, time.Local)
+	if err != nil {
+		loc = time.Local
+	}
+	return backupRetentionPolicy{
+		RetentionDays: task.RetentionDays,
+		MaxCount:      task.MaxCount,
+		KeepHourly:    task.KeepHourly,
+		KeepDaily:     task.KeepDaily,
+		KeepWeekly:    task.KeepWeekly,
+		KeepMonthly:   task.KeepMonthly,
+		KeepYearly:    task.KeepYearly,
+		Location:      loc,
+	}
+}
+
+// planBackupRetention returns a decision for every file, newest first.
+func planBackupRetention(files []BackupFileInfo, policy backupRetentionPolicy, pinned map[string]bool, now time.Time) []backupRetentionDecision {
+	sorted := append([]BackupFileInfo(nil), files...)
+	sortBackupFiles(sorted)
+	decisions := make([]backupRetentionDecision, len(sorted))
+	loc := policy.Location
+	if loc == nil {
+		loc = time.Local
+	}
+
+	buckets := []struct {
+		reason string
+		count  int
+		key    func(time.Time) string
+	}{
+		{"hourly", policy.KeepHourly, func(t time.Time) string { return t.Format("2006-01-02 15") }},
+		{"daily", policy.KeepDaily, func(t time.Time) string { return t.Format("2006-01-02") }},
+		{"weekly", policy.KeepWeekly, func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}},
+		{"monthly", policy.KeepMonthly, func(t time.Time) string { return t.Format("2006-01") }},
+		{"yearly", policy.KeepYearly, func(t time.Time) string { return t.Format("2006") }},
+	}
+	lastKeys := make([]string, len(buckets))
+	remaining := make([]int, len(buckets))
+	for i, bucket := range buckets {
+		remaining[i] = bucket.count
+	}
+
+	maxAge := time.Duration(policy.RetentionDays) * 24 * time.Hour
+	position := 0
+	for i, file := range sorted {
+		decision := backupRetentionDecision{File: file}
+		if pinned[file.Filename] {
+			decision.Keep = true
+			decision.Reasons = append(decision.Reasons, "pinned")
+			decisions[i] = decision
+			continue
+		}
+		withinCount := policy.MaxCount <= 0 || position < policy.MaxCount
+		withinAge := policy.RetentionDays <= 0 || now.Sub(file.CreatedAt) <= maxAge
+		position++
+
+		if !policy.gfs() {
+			decision.Keep = withinCount && withinAge
+			decisions[i] = decision
+			continue
+		}
+		if policy.MaxCount > 0 && withinCount {
+			decision.Reasons = append(decision.Reasons, "last")
+		}
+		if policy.RetentionDays > 0 && withinAge {
+			decision.Reasons = append(decision.Reasons, "within")
+		}
+		created := file.CreatedAt.In(loc)
+		for b, bucket := range buckets {
+			if remaining[b] == 0 {
+				continue
+			}
+			if key := bucket.key(created); key != lastKeys[b] {
+				lastKeys[b] = key
+				remaining[b]--
+				decision.Reasons = append(decision.Reasons, bucket.reason)
+			}
+		}
+		decision.Keep = len(decision.Reasons) > 0
+		decisions[i] = decision
+	}
+	return decisions
+}
+
+// taskBackupFiles lists the backups on client that belong to a task.
+func taskBackupFiles(ctx context.Context, client backupTargetClient, filenamePrefixes ...string) ([]BackupFileInfo, error) {
+	allBackups, err := client.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	backups := make([]BackupFileInfo, 0, len(allBackups))
+	for _, backup := range allBackups {
+		if backupFilenameHasPrefix(backup.Filename, filenamePrefixes) {
+			backups = append(backups, backup)
+		}
+	}
+	return backups, nil
+}
+
+func pruneTargetBackups(ctx context.Context, client backupTargetClient, policy backupRetentionPolicy, pinned map[string]bool, filenamePrefixes ...string) error {
+	if !policy.enabled() {
+		return nil
+	}
+	backups, err := taskBackupFiles(ctx, client, filenamePrefixes...)
+	if err != nil {
+		return err
+	}
+	for _, decision := range planBackupRetention(backups, policy, pinned, time.Now()) {
+		if decision.Keep {
+			continue
+		}
+		if err := client.Delete(ctx, decision.File.Filename); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backupTargetPins returns the pinned filenames of a target with their notes.
+func (h *Handler) backupTargetPins(ctx context.Context, targetID int) (map[string]string, error) {
+	pins, err := h.client.BackupPin.Query().Where(backuppin.TargetID(targetID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	notes := make(map[string]string, len(pins))
+	for _, pin := range pins {
+		notes[pin.Filename] = pin.Note
+	}
+	return notes, nil
+}
+
+func markPinnedBackupFiles(files []BackupFileInfo, pins map[string]string) {
+	for i := range files {
+		if note, ok := pins[files[i].Filename]; ok {
+			files[i].Pinned = true
+			files[i].PinNote = note
+		}
+	}
+}
+
+func pinnedFilenames(pins map[string]string) map[string]bool {
+	pinned := make(map[string]bool, len(pins))
+	for filename := range pins {
+		pinned[filename] = true
+	}
+	return pinned
+}
+
+// PreviewBackupTaskRetention shows, per target, which backups the next
+// cleanup would keep and delete. Retention fields in the body override the
+// saved ones so an edited policy can be checked before saving it.
+func (h *Handler) PreviewBackupTaskRetention(c echo.Context) error {
+	id, err := intParam(c, "id")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid backup task"})
+	}
+	ctx := c.Request().Context()
+	task, err := h.client.BackupTask.Query().Where(backuptask.ID(id)).WithTargets().Only(ctx)
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Backup task not found"})
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to load backup task"})
+	}
+
+	policy := backupTaskRetentionPolicy(task)
+	if c.Request().ContentLength > 0 {
+		var req backupTaskPayload
+		if err := c.Bind(&req); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid backup task"})
+		}
+		input := taskInputFromPayload(taskInputFromEnt(task), req)
+		if err := validateBackupRetention(input); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		policy = backupRetentionPolicy{
+			RetentionDays: input.RetentionDays,
+			MaxCount:      input.MaxCount,
+			KeepHourly:    input.KeepHourly,
+			KeepDaily:     input.KeepDaily,
+			KeepWeekly:    input.KeepWeekly,
+			KeepMonthly:   input.KeepMonthly,
+			KeepYearly:    input.KeepYearly,
+			Location:      policy.Location,
+		}
+	}
+
+	response := BackupRetentionPreviewResponse{TaskID: task.ID, Targets: make([]BackupRetentionTargetPreview, 0, len(task.Edges.Targets))}
+	now := time.Now()
+	for _, target := range task.Edges.Targets {
+		preview := BackupRetentionTargetPreview{
+			TargetID: target.ID,
+			Name:     target.Name,
+			Keep:     []BackupRetentionFile{},
+			Delete:   []BackupRetentionFile{},
+		}
+		files, pins, err := h.retentionTargetState(ctx, target, task.ID)
+		if err != nil {
+			preview.Error = err.Error()
+			response.Targets = append(response.Targets, preview)
+			continue
+		}
+		for _, decision := range planBackupRetention(files, policy, pinnedFilenames(pins), now) {
+			file := BackupRetentionFile{BackupFileInfo: decision.File, Reasons: decision.Reasons}
+			if decision.Keep {
+				preview.Keep = append(preview.Keep, file)
+			} else {
+				preview.Delete = append(preview.Delete, file)
+			}
+		}
+		response.Targets = append(response.Targets, preview)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) retentionTargetState(ctx context.Context, target *ent.BackupTarget, taskID int) ([]BackupFileInfo, map[string]string, error) {
+	client, err := h.backupTargetClient(target)
+	if err != nil {
+		return nil, nil, err
+	}
+	files, err := taskBackupFiles(ctx, client, backupTaskFilenamePrefixes(taskID)...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list backup files")
+	}
+	pins, err := h.backupTargetPins(ctx, target.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load pinned backups")
+	}
+	markPinnedBackupFiles(files, pins)
+	return files, pins, nil
+}
+
+// PinBackupTargetFile protects a backup file from retention cleanup.
+func (h *Handler) PinBackupTargetFile(c echo.Context) error {
+	target, err := h.backupTargetFromParam(c)
+	if err != nil {
+		return backupTargetParamError(c, err)
+	}
+	var req backupPinPayload
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	if err := validateBackupFilename(req.Filename); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	ctx := c.Request().Context()
+	updated, err := h.client.BackupPin.Update().
+		Where(backuppin.TargetID(target.ID), backuppin.Filename(req.Filename)).
+		SetNote(req.Note).
+		Save(ctx)
+	if err == nil && updated == 0 {
+		err = h.client.BackupPin.Create().
+			SetTargetID(target.ID).
+			SetFilename(req.Filename).
+			SetNote(req.Note).
+			Exec(ctx)
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to pin backup"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"filename": req.Filename, "pinned": true, "pin_note": req.Note})
+}
+
+// UnpinBackupTargetFile lets cleanup remove a backup file again.
+func (h *Handler) UnpinBackupTargetFile(c echo.Context) error {
+	target, err := h.backupTargetFromParam(c)
+	if err != nil {
+		return backupTargetParamError(c, err)
+	}
+	filename := c.Param("filename")
+	if err := validateBackupFilename(filename); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	_, err = h.client.BackupPin.Delete().
+		Where(backuppin.TargetID(target.ID), backuppin.Filename(filename)).
+		Exec(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to unpin backup"})
+	}
+	return c.NoContent(http.StatusNoContent)
+}
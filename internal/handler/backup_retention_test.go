@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"smarticky/ent/enttest"
+	"smarticky/internal/storage"
+
+	"github.com/labstack/echo/v4"
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestPlanBackupRetentionGrandfatherFatherSon(t *testing.T) {
+	now := time.Date(2026, 6, 22, 12, 0, 0, 0, time.UTC)
+	var files []BackupFileInfo
+	for day := time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC); day.Before(now); day = day.AddDate(0, 0, 1) {
+		files = append(files, BackupFileInfo{Filename: day.Format("2006-01-02"), CreatedAt: day})
+	}
+	policy := backupRetentionPolicy{KeepDaily: 3, KeepWeekly: 2, KeepMonthly: 3, KeepYearly: 2, Location: time.UTC}
+	pinned := map[string]bool{"2025-01-01": true}
+
+	kept := make(map[string][]string)
+	for _, decision := range planBackupRetention(files, policy, pinned, now) {
+		if decision.Keep {
+			kept[decision.File.Filename] = decision.Reasons
+		}
+	}
+	want := map[string][]string{
+		"2026-06-22": {"daily", "weekly", "monthly", "yearly"},
+		"2026-06-21": {"daily", "weekly"},
+		"2026-06-20": {"daily"},
+		"2026-05-31": {"monthly"},
+		"2026-04-30": {"monthly"},
+		"2025-12-31": {"yearly"},
+		"2025-01-01": {"pinned"},
+	}
+	if !reflect.DeepEqual(kept, want) {
+		t.Fatalf("kept = %v, want %v", kept, want)
+	}
+
+	// Buckets follow the task's time zone: 02:00 UTC is still the previous
+	// day in New York.
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("load time zone: %v", err)
+	}
+	decisions := planBackupRetention(files[len(files)-2:], backupRetentionPolicy{KeepDaily: 1, Location: newYork}, nil, now)
+	if !decisions[0].Keep || decisions[1].Keep {
+		t.Fatalf("unexpected decisions in New York: %+v", decisions)
+	}
+
+	// Without buckets the count and age limits both apply, and pinned
+	// backups do not use up the count.
+	decisions = planBackupRetention(files[len(files)-3:], backupRetentionPolicy{MaxCount: 1, RetentionDays: 30}, map[string]bool{"2026-06-21": true}, now)
+	var keptNames []string
+	for _, decision := range decisions {
+		if decision.Keep {
+			keptNames = append(keptNames, decision.File.Filename)
+		}
+	}
+	if want := []string{"2026-06-22", "2026-06-21"}; !reflect.DeepEqual(keptNames, want) {
+		t.Fatalf("kept = %v, want %v", keptNames, want)
+	}
+}
+
+func TestBackupTaskRetentionPreviewAndPins(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(dataDir, "smarticky.db")+"?_pragma=foreign_keys(1)")
+	defer client.Close()
+	h := NewHandler(client, storage.NewFileSystem(dataDir))
+
+	backupDir := t.TempDir()
+	target := client.BackupTarget.Create().SetName("Disk").SetType("local").SetLocalPath(backupDir).SaveX(ctx)
+	task := client.BackupTask.Create().
+		SetName("Nightly").
+		SetRetentionDays(0).
+		SetMaxCount(0).
+		SetKeepDaily(2).
+		SetTimezone("UTC").
+		AddTargets(target).
+		SaveX(ctx)
+
+	now := time.Now().UTC()
+	var names []string
+	for day := 0; day < 3; day++ {
+		created := now.AddDate(0, 0, -day)
+		name := backupTaskFilename(task.ID, true, created)
+		names = append(names, name)
+		writeRetentionTestBackup(t, backupDir, name, created)
+	}
+	other := backupTaskFilename(task.ID+1, true, now.AddDate(0, 0, -10))
+	writeRetentionTestBackup(t, backupDir, other, now.AddDate(0, 0, -10))
+
+	call := func(handler echo.HandlerFunc, method string, body string, params ...string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(method, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		var paramNames, values []string
+		for i := 0; i+1 < len(params); i += 2 {
+			paramNames = append(paramNames, params[i])
+			values = append(values, params[i+1])
+		}
+		c.SetParamNames(paramNames...)
+		c.SetParamValues(values...)
+		if err := handler(c); err != nil {
+			t.Fatalf("handler returned error: %v", err)
+		}
+		return rec
+	}
+	preview := func(body string) BackupRetentionTargetPreview {
+		t.Helper()
+		rec := call(h.PreviewBackupTaskRetention, http.MethodPost, body, "id", fmt.Sprint(task.ID))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("preview: %d %s", rec.Code, rec.Body.String())
+		}
+		var response BackupRetentionPreviewResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+			t.Fatalf("decode preview: %v", err)
+		}
+		if len(response.Targets) != 1 || response.Targets[0].Error != "" {
+			t.Fatalf("unexpected preview: %+v", response)
+		}
+		return response.Targets[0]
+	}
+	filenames := func(files []BackupRetentionFile) []string {
+		out := make([]string, 0, len(files))
+		for _, file := range files {
+			out = append(out, file.Filename)
+		}
+		return out
+	}
+
+	got := preview("")
+	if !reflect.DeepEqual(filenames(got.Keep), names[:2]) || !reflect.DeepEqual(filenames(got.Delete), names[2:]) {
+		t.Fatalf("unexpected preview: keep %v delete %v", filenames(got.Keep), filenames(got.Delete))
+	}
+	got = preview(`{"keep_daily":1}`)
+	if !reflect.DeepEqual(filenames(got.Delete), names[1:]) {
+		t.Fatalf("expected the override to delete two backups, got %v", filenames(got.Delete))
+	}
+	if rec := call(h.PreviewBackupTaskRetention, http.MethodPost, `{"keep_weekly":-1}`, "id", fmt.Sprint(task.ID)); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected negative retention to be rejected, got %d", rec.Code)
+	}
+
+	oldest := names[2]
+	rec := call(h.PinBackupTargetFile, http.MethodPost, fmt.Sprintf(`{"filename":%q,"note":"before upgrade"}`, oldest), "id", fmt.Sprint(target.ID))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("pin: %d %s", rec.Code, rec.Body.String())
+	}
+	got = preview("")
+	if len(got.Delete) != 0 || len(got.Keep) != 3 || !got.Keep[2].Pinned || got.Keep[2].Reasons[0] != "pinned" {
+		t.Fatalf("expected the pinned backup to be kept, got %+v", got)
+	}
+
+	var list struct {
+		Backups []BackupFileInfo `json:"backups"`
+	}
+	if err := json.Unmarshal(call(h.ListBackupTargetFiles, http.MethodGet, "", "id", fmt.Sprint(target.ID)).Body.Bytes(), &list); err != nil {
+		t.Fatalf("decode list: %v", err)
+	}
+	for _, file := range list.Backups {
+		if file.Pinned != (file.Filename == oldest) || (file.Pinned && file.PinNote != "before upgrade") {
+			t.Fatalf("unexpected pin state: %+v", file)
+		}
+	}
+
+	targetClient, err := h.backupTargetClient(target)
+	if err != nil {
+		t.Fatalf("create target client: %v", err)
+	}
+	pins, err := h.backupTargetPins(ctx, target.ID)
+	if err != nil {
+		t.Fatalf("load pins: %v", err)
+	}
+	policy := backupTaskRetentionPolicy(client.BackupTask.GetX(ctx, task.ID))
+	policy.KeepDaily = 1
+	if err := cleanupTargetBackups(ctx, targetClient, policy, pinnedFilenames(pins), nil, backupTaskFilenamePrefixes(task.ID)...); err != nil {
+		t.Fatalf("cleanup: %v", err)
+	}
+	for name, want := range map[string]bool{names[0]: true, names[1]: false, oldest: true, other: true} {
+		if _, err := os.Stat(filepath.Join(backupDir, name)); (err == nil) != want {
+			t.Fatalf("%s exists = %v, want %v", name, err == nil, want)
+		}
+	}
+
+	rec = call(h.UnpinBackupTargetFile, http.MethodDelete, "", "id", fmt.Sprint(target.ID), "filename", oldest)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("unpin: %d %s", rec.Code, rec.Body.String())
+	}
+	if got := preview(`{"keep_daily":1}`); !reflect.DeepEqual(filenames(got.Delete), []string{oldest}) {
+		t.Fatalf("expected the unpinned backup to be deleted next, got %v", filenames(got.Delete))
+	}
+	rec = call(h.UnpinBackupTargetFile, http.MethodDelete, "", "id", fmt.Sprint(target.ID), "filename", "../smarticky.db")
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected an invalid filename to be rejected, got %d", rec.Code)
+	}
+}
+
+func writeRetentionTestBackup(t *testing.T, dir string, name string, created time.Time) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(name), 0600); err != nil {
+		t.Fatalf("write backup: %v", err)
+	}
+	if err := os.Chtimes(path, created, created); err != nil {
+		t.Fatalf("set backup time: %v", err)
+	}
+}
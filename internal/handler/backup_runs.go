@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"smarticky/ent"
+	"smarticky/ent/backuprun"
+	"smarticky/ent/predicate"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultBackupRunPageSize = 50
+	maxBackupRunPageSize     = 200
+	// backupRunHistoryDays bounds how long run history is kept.
+	backupRunHistoryDays = 365
+	// backupRunErrorLimit caps the stored error text of a run.
+	backupRunErrorLimit = 2000
+)
+
+// backupRunRecord is one attempt to write a backup to a target, as it is
+// handed to recordBackupRun.
+type backupRunRecord struct {
+	Task      *ent.BackupTask
+	Target    *ent.BackupTarget
+	Trigger   string
+	Filename  string
+	Bytes     int64
+	StartedAt time.Time
+	Err       error
+}
+
+type BackupRunHistoryEntry struct {
+	ID         int       `json:"id"`
+	TaskID     *int      `json:"task_id,omitempty"`
+	TaskName   string    `json:"task_name,omitempty"`
+	TargetID   *int      `json:"target_id,omitempty"`
+	TargetName string    `json:"target_name,omitempty"`
+	TargetType string    `json:"target_type,omitempty"`
+	Trigger    string    `json:"trigger"`
+	Status     string    `json:"status"`
+	Filename   string    `json:"filename,omitempty"`
+	Bytes      int64     `json:"bytes"`
+	Error      string    `json:"error,omitempty"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	DurationMS int64     `json:"duration_ms"`
+}
+
+type BackupRunHistoryResponse struct {
+	Runs []BackupRunHistoryEntry `json:"runs"`
+	// NextBefore is the cursor for the next page, if there is one.
+	NextBefore *int `json:"next_before,omitempty"`
+}
+
+// ListBackupRuns pages through run history, newest first. It filters by
+// task_id, target_id and status, and continues before the run id in before.
+func (h *Handler) ListBackupRuns(c echo.Context) error {
+	var filters []predicate.BackupRun
+	for _, param := range []struct {
+		name  string
+		apply func(int) predicate.BackupRun
+	}{
+		{"task_id", backuprun.TaskID},
+		{"target_id", backuprun.TargetID},
+		{"before", backuprun.IDLT},
+	} {
+		raw := c.QueryParam(param.name)
+		if raw == "" {
+			continue
+		}
+		value, err := strconv.Atoi(raw)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": fmt.Sprintf("invalid %s", param.name)})
+		}
+		filters = append(filters, param.apply(value))
+	}
+	switch status := c.QueryParam("status"); status {
+	case "":
+	case "success", "failed":
+		filters = append(filters, backuprun.Status(status))
+	default:
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "status must be success or failed"})
+	}
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	if limit <= 0 {
+		limit = defaultBackupRunPageSize
+	}
+	limit = min(limit, maxBackupRunPageSize)
+
+	runs, err := h.client.BackupRun.Query().
+		Where(filters...).
+		WithTask().
+		WithTarget().
+		Order(ent.Desc(backuprun.FieldID)).
+		Limit(limit + 1).
+		All(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to list backup runs"})
+	}
+
+	response := BackupRunHistoryResponse{Runs: make([]BackupRunHistoryEntry, 0, min(len(runs), limit))}
+	if len(runs) > limit {
+		runs = runs[:limit]
+		next := runs[limit-1].ID
+		response.NextBefore = &next
+	}
+	for _, run := range runs {
+		response.Runs = append(response.Runs, backupRunHistoryEntry(run))
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+func backupRunHistoryEntry(run *ent.BackupRun) BackupRunHistoryEntry {
+	entry := BackupRunHistoryEntry{
+		ID:         run.ID,
+		TaskID:     run.TaskID,
+		TargetID:   run.TargetID,
+		Trigger:    run.Trigger,
+		Status:     run.Status,
+		Filename:   run.Filename,
+		Bytes:      run.Bytes,
+		Error:      run.Error,
+		StartedAt:  run.StartedAt,
+		FinishedAt: run.FinishedAt,
+		DurationMS: run.DurationMs,
+	}
+	if task := run.Edges.Task; task != nil {
+		entry.TaskName = task.Name
+	}
+	if target := run.Edges.Target; target != nil {
+		entry.TargetName = target.Name
+		entry.TargetType = target.Type
+	}
+	return entry
+}
+
+// recordBackupRun saves a run to the history and sends a failure alert when
+// the run failed. History is best effort: a backup never fails because its
+// record could not be written.
+func (h *Handler) recordBackupRun(ctx context.Context, record backupRunRecord) {
+	finished := time.Now()
+	create := h.client.BackupRun.Create().
+		SetTrigger(record.Trigger).
+		SetStatus("success").
+		SetFilename(record.Filename).
+		SetBytes(record.Bytes).
+		SetStartedAt(record.StartedAt).
+		SetFinishedAt(finished).
+		SetDurationMs(finished.Sub(record.StartedAt).Milliseconds())
+	if record.Task != nil {
+		create.SetTaskID(record.Task.ID)
+	}
+	if record.Target != nil {
+		create.SetTargetID(record.Target.ID)
+	}
+	if record.Err != nil {
+		create.SetStatus("failed").SetError(backupRunError(record.Err))
+	}
+	if err := create.Exec(ctx); err != nil {
+		fmt.Printf("Failed to record backup run: %v\n", err)
+	}
+	if _, err := h.client.BackupRun.Delete().
+		Where(backuprun.StartedAtLT(finished.AddDate(0, 0, -backupRunHistoryDays))).
+		Exec(ctx); err != nil {
+		fmt.Printf("Failed to prune backup run history: %v\n", err)
+	}
+	if record.Err != nil {
+		h.alertBackupFailure(ctx, record)
+	}
+}
+
+// backupRunError returns err's text cut to backupRunErrorLimit bytes without
+// splitting a character.
+func backupRunError(err error) string {
+	message := err.Error()
+	if len(message) <= backupRunErrorLimit {
+		return message
+	}
+	end := backupRunErrorLimit
+	for end > 0 && !utf8.RuneStart(message[end]) {
+		end--
+	}
+	return message[:end]
+}
+
+func backupRunTrigger(automatic bool) string {
+	if automatic {
+		return "scheduled"
+	}
+	return "manual"
+}
+
+// countingBackupTargetClient counts the bytes uploaded through a client.
+type countingBackupTargetClient struct {
+	backupTargetClient
+	bytes atomic.Int64
+}
+
+func (c *countingBackupTargetClient) Upload(ctx context.Context, filename string, r io.Reader) error {
+	return c.backupTargetClient.Upload(ctx, filename, &backupCountingReader{r: r, n: &c.bytes})
+}
+
+type backupCountingReader struct {
+	r io.Reader
+	n *atomic.Int64
+}
+
+func (r *backupCountingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n.Add(int64(n))
+	return n, err
+}
@@ -0,0 +1,292 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"smarticky/ent/enttest"
+	"smarticky/internal/storage"
+
+	"github.com/labstack/echo/v4"
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestBackupRunHistoryAndFailureAlerts(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(dataDir, "smarticky.db")+"?_pragma=foreign_keys(1)")
+	defer client.Close()
+	fs := storage.NewFileSystem(dataDir)
+	h := NewHandler(client, fs)
+	if err := fs.WriteFile(filepath.Join(dataDir, "uploads", "a.txt"), []byte("alpha"), 0644); err != nil {
+		t.Fatalf("write upload: %v", err)
+	}
+
+	alerts := make(chan backupAlert, 4)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert backupAlert
+		if err := json.NewDecoder(r.Body).Decode(&alert); err != nil {
+			t.Errorf("decode alert: %v", err)
+		}
+		alerts <- alert
+	}))
+	defer webhook.Close()
+
+	call := func(handler echo.HandlerFunc, method string, target string, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		if err := handler(echo.New().NewContext(req, rec)); err != nil {
+			t.Fatalf("handler returned error: %v", err)
+		}
+		return rec
+	}
+
+	rec := call(h.UpdateBackupAlertSettings, http.MethodPut, "/", `{"webhook_url":"ftp://example.com"}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected a non-http webhook to be rejected, got %d", rec.Code)
+	}
+	rec = call(h.UpdateBackupAlertSettings, http.MethodPut, "/", `{"smtp_host":"mail.example.com","smtp_port":0}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected an invalid mail port to be rejected, got %d", rec.Code)
+	}
+	// The mail port only matters once a mail server is set.
+	rec = call(h.UpdateBackupAlertSettings, http.MethodPut, "/", fmt.Sprintf(`{"webhook_url":%q,"smtp_port":0}`, webhook.URL))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"has_webhook_url":true`) || strings.Contains(rec.Body.String(), webhook.URL) {
+		t.Fatalf("update alert settings: %d %s", rec.Code, rec.Body.String())
+	}
+
+	// A local target below a regular file can never be created.
+	blocker := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(blocker, nil, 0600); err != nil {
+		t.Fatalf("write blocker: %v", err)
+	}
+	healthy := client.BackupTarget.Create().SetName("Disk").SetType("local").SetLocalPath(t.TempDir()).SaveX(ctx)
+	broken := client.BackupTarget.Create().SetName("Broken").SetType("local").SetLocalPath(filepath.Join(blocker, "backups")).SaveX(ctx)
+	task := client.BackupTask.Create().SetName("Nightly").AddTargets(healthy, broken).SaveX(ctx)
+	task = client.BackupTask.Query().WithTargets().OnlyX(ctx)
+
+	result, err := h.runBackupTask(ctx, task, true)
+	if err != nil {
+		t.Fatalf("run backup task: %v", err)
+	}
+
+	select {
+	case alert := <-alerts:
+		if alert.Event != backupAlertFailed || alert.TargetName != "Broken" || alert.TaskName != "Nightly" || alert.Error == "" || alert.Filename != result.File {
+			t.Fatalf("unexpected alert: %+v", alert)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a failure alert")
+	}
+
+	listRuns := func(query string) BackupRunHistoryResponse {
+		t.Helper()
+		rec := call(h.ListBackupRuns, http.MethodGet, "/"+query, "")
+		var history BackupRunHistoryResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &history); err != nil {
+			t.Fatalf("decode history %s: %v", rec.Body.String(), err)
+		}
+		return history
+	}
+	history := listRuns("")
+	if len(history.Runs) != 2 {
+		t.Fatalf("expected two runs, got %+v", history)
+	}
+	byTarget := map[string]BackupRunHistoryEntry{}
+	for _, run := range history.Runs {
+		byTarget[run.TargetName] = run
+	}
+	if run := byTarget["Disk"]; run.Status != "success" || run.Trigger != "scheduled" || run.Bytes == 0 || run.Filename != result.File || run.TaskName != "Nightly" {
+		t.Fatalf("unexpected successful run: %+v", run)
+	}
+	if run := byTarget["Broken"]; run.Status != "failed" || run.Error == "" || run.FinishedAt.Before(run.StartedAt) {
+		t.Fatalf("unexpected failed run: %+v", run)
+	}
+	info, err := os.Stat(filepath.Join(healthy.LocalPath, result.File))
+	if err != nil || info.Size() != byTarget["Disk"].Bytes {
+		t.Fatalf("recorded %d bytes for a %v byte backup (%v)", byTarget["Disk"].Bytes, info, err)
+	}
+
+	if history := listRuns(fmt.Sprintf("?status=failed&target_id=%d", broken.ID)); len(history.Runs) != 1 || history.Runs[0].TargetName != "Broken" {
+		t.Fatalf("filter by status: %+v", history)
+	}
+	history = listRuns("?limit=1")
+	if len(history.Runs) != 1 || history.NextBefore == nil {
+		t.Fatalf("expected a first page with a cursor, got %+v", history)
+	}
+	first := history.Runs[0].ID
+	history = listRuns(fmt.Sprintf("?limit=1&before=%d", *history.NextBefore))
+	if len(history.Runs) != 1 || history.Runs[0].ID >= first || history.NextBefore != nil {
+		t.Fatalf("unexpected second page: %+v", history)
+	}
+	if rec := call(h.ListBackupRuns, http.MethodGet, "/?status=running", ""); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected an unknown status to be rejected, got %d", rec.Code)
+	}
+
+	// Deleting the task keeps the history of its targets.
+	client.BackupTask.DeleteOneID(task.ID).ExecX(ctx)
+	if n := client.BackupRun.Query().CountX(ctx); n != 2 {
+		t.Fatalf("expected run history to outlive the task, got %d runs", n)
+	}
+}
+
+func TestBackupStaleTargetAlertsByMail(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(dataDir, "smarticky.db")+"?_pragma=foreign_keys(1)")
+	defer client.Close()
+	h := NewHandler(client, storage.NewFileSystem(dataDir))
+
+	relay, messages := newTestSMTPRelay(t)
+	host, port, _ := net.SplitHostPort(relay)
+	config, err := h.getOrCreateBackupConfig(ctx)
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	var portNumber int
+	fmt.Sscan(port, &portNumber)
+	config.Update().
+		SetAlertSMTPHost(host).
+		SetAlertSMTPPort(portNumber).
+		SetAlertSMTPFrom("Smarticky <backup@example.com>").
+		SetAlertSMTPTo("ops@example.com").
+		ExecX(ctx)
+
+	now := time.Now()
+	target := client.BackupTarget.Create().
+		SetName("NAS").
+		SetType("local").
+		SetLocalPath(t.TempDir()).
+		SetAlertAfterHours(24).
+		SetCreatedAt(now.Add(-48 * time.Hour)).
+		SaveX(ctx)
+	client.BackupRun.Create().
+		SetTargetID(target.ID).
+		SetStatus("success").
+		SetStartedAt(now.Add(-30 * time.Hour)).
+		SetFinishedAt(now.Add(-30 * time.Hour)).
+		ExecX(ctx)
+
+	if err := h.checkStaleBackupTargets(ctx, now); err != nil {
+		t.Fatalf("check stale targets: %v", err)
+	}
+	select {
+	case message := <-messages:
+		if message.from != "backup@example.com" || message.to != "ops@example.com" ||
+			!strings.Contains(message.data, "Subject: Smarticky backup missing: NAS") ||
+			!strings.Contains(message.data, "Last success:") {
+			t.Fatalf("unexpected message: %+v", message)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a missing-backup mail")
+	}
+
+	// The alert is sent once until the target succeeds again.
+	if err := h.checkStaleBackupTargets(ctx, now.Add(time.Hour)); err != nil {
+		t.Fatalf("check stale targets: %v", err)
+	}
+	select {
+	case message := <-messages:
+		t.Fatalf("expected no repeated alert, got %+v", message)
+	case <-time.After(200 * time.Millisecond):
+	}
+	if err := h.updateTargetBackupStatus(ctx, target.ID, nil); err != nil {
+		t.Fatalf("update target status: %v", err)
+	}
+	if !client.BackupTarget.GetX(ctx, target.ID).StaleAlertedAt.IsZero() {
+		t.Fatal("expected a success to re-arm the alert")
+	}
+}
+
+type testSMTPMessage struct {
+	from, to, data string
+}
+
+// newTestSMTPRelay accepts mail without authentication, like a local relay,
+// and reports every message it receives.
+func newTestSMTPRelay(t *testing.T) (string, <-chan testSMTPMessage) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	messages := make(chan testSMTPMessage, 4)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestSMTPConn(conn, messages)
+		}
+	}()
+	return listener.Addr().String(), messages
+}
+
+func serveTestSMTPConn(conn net.Conn, messages chan<- testSMTPMessage) {
+	defer conn.Close()
+	text := textproto.NewConn(conn)
+	var message testSMTPMessage
+	_ = text.PrintfLine("220 relay ready")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch {
+		case verb == "EHLO" || verb == "HELO":
+			_ = text.PrintfLine("250 relay")
+		case strings.HasPrefix(strings.ToUpper(line), "MAIL FROM:"):
+			message.from = strings.Trim(line[len("MAIL FROM:"):], "<>")
+			_ = text.PrintfLine("250 ok")
+		case strings.HasPrefix(strings.ToUpper(line), "RCPT TO:"):
+			message.to = strings.Trim(line[len("RCPT TO:"):], "<>")
+			_ = text.PrintfLine("250 ok")
+		case verb == "DATA":
+			_ = text.PrintfLine("354 go ahead")
+			data, err := io.ReadAll(bufio.NewReader(text.DotReader()))
+			if err != nil {
+				return
+			}
+			message.data = string(data)
+			messages <- message
+			_ = text.PrintfLine("250 queued")
+		case verb == "QUIT":
+			_ = text.PrintfLine("221 bye")
+			return
+		default:
+			_ = text.PrintfLine("250 ok")
+		}
+	}
+}
+
+func TestBackupRunErrorKeepsWholeCharacters(t *testing.T) {
+	short := errors.New("备份失败")
+	if got := backupRunError(short); got != short.Error() {
+		t.Fatalf("expected short error unchanged, got %q", got)
+	}
+	// Each character is three bytes, so the limit falls inside one.
+	long := errors.New(strings.Repeat("失", backupRunErrorLimit/3+1))
+	got := backupRunError(long)
+	if !utf8.ValidString(got) || len(got) > backupRunErrorLimit || len(got) < backupRunErrorLimit-2 {
+		t.Fatalf("expected valid text near the limit, got %d bytes, valid=%v", len(got), utf8.ValidString(got))
+	}
+}
@@ -0,0 +1,1421 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/attachment"
+	"smarticky/ent/folder"
+	"smarticky/ent/note"
+	"smarticky/ent/predicate"
+	"smarticky/ent/tag"
+	"smarticky/ent/user"
+	"smarticky/ent/whiteboard"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/afero"
+)
+
+// A restore session keeps an extracted backup open so an admin can browse it
+// and copy individual notes, folders or users back without replacing the
+// whole data directory.
+const (
+	backupRestoreSessionTTL = 30 * time.Minute
+
+	backupImportCopy      = "copy"
+	backupImportOverwrite = "overwrite"
+)
+
+type backupRestoreSession struct {
+	id       string
+	targetID int
+	filename string
+	dir      string
+	fs       afero.Fs
+	source   *ent.Client
+	client   backupTargetClient
+	snapshot map[string]backupSnapshotFile // by upload path, for snapshots
+	// passphrase opens the snapshot's sealed blobs.
+	passphrase string
+	expiresAt  time.Time
+
+	mu sync.Mutex // serializes imports
+}
+
+type backupRestoreSessions struct {
+	mu       sync.Mutex
+	sessions map[string]*backupRestoreSession
+}
+
+func newBackupRestoreSessions() *backupRestoreSessions {
+	return &backupRestoreSessions{sessions: make(map[string]*backupRestoreSession)}
+}
+
+type BackupRestoreSessionResponse struct {
+	ID        string              `json:"id"`
+	TargetID  int                 `json:"target_id"`
+	Filename  string              `json:"filename"`
+	Snapshot  bool                `json:"snapshot"`
+	ExpiresAt time.Time           `json:"expires_at"`
+	Users     []BackupRestoreUser `json:"users"`
+}
+
+type BackupRestoreUser struct {
+	ID          int    `json:"id"`
+	Username    string `json:"username"`
+	Nickname    string `json:"nickname"`
+	NoteCount   int    `json:"note_count"`
+	FolderCount int    `json:"folder_count"`
+	LiveUserID  *int   `json:"live_user_id,omitempty"`
+}
+
+type BackupRestoreFolder struct {
+	ID       uuid.UUID  `json:"id"`
+	Name     string     `json:"name"`
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+	Exists   bool       `json:"exists"`
+}
+
+type BackupRestoreNote struct {
+	ID            uuid.UUID  `json:"id"`
+	Title         string     `json:"title"`
+	FolderID      *uuid.UUID `json:"folder_id,omitempty"`
+	IsDeleted     bool       `json:"is_deleted"`
+	Attachments   int        `json:"attachments"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	Exists        bool       `json:"exists"`
+	LiveUpdatedAt *time.Time `json:"live_updated_at,omitempty"`
+}
+
+type BackupRestoreItemsResponse struct {
+	Folders []BackupRestoreFolder `json:"folders"`
+	Notes   []BackupRestoreNote   `json:"notes"`
+}
+
+type backupImportRequest struct {
+	UserID         int         `json:"user_id"`
+	All            bool        `json:"all"`
+	FolderIDs      []uuid.UUID `json:"folder_ids"`
+	NoteIDs        []uuid.UUID `json:"note_ids"`
+	TargetUserID   int         `json:"target_user_id"`
+	TargetFolderID *uuid.UUID  `json:"target_folder_id"`
+	Mode           string      `json:"mode"`
+	DryRun         bool        `json:"dry_run"`
+}
+
+// BackupImportItem describes what happened to one restored item. Conflict
+// explains anything the admin may want to look at, whether or not the item
+// was still imported.
+type BackupImportItem struct {
+	Kind     string `json:"kind"`
+	SourceID string `json:"source_id"`
+	TargetID string `json:"target_id,omitempty"`
+	Name     string `json:"name"`
+	Action   string `json:"action"`
+	Conflict string `json:"conflict,omitempty"`
+}
+
+type BackupImportReport struct {
+	DryRun       bool               `json:"dry_run"`
+	Mode         string             `json:"mode"`
+	TargetUserID int                `json:"target_user_id"`
+	Created      int                `json:"created"`
+	Overwritten  int                `json:"overwritten"`
+	Skipped      int                `json:"skipped"`
+	Conflicts    int                `json:"conflicts"`
+	Items        []BackupImportItem `json:"items"`
+}
+
+func (r *BackupImportReport) add(item BackupImportItem) {
+	switch item.Action {
+	case "created":
+		r.Created++
+	case "overwritten":
+		r.Overwritten++
+	case "skipped":
+		r.Skipped++
+	}
+	if item.Conflict != "" {
+		r.Conflicts++
+	}
+	r.Items = append(r.Items, item)
+}
+
+func (s *backupRestoreSessions) add(session *backupRestoreSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweepLocked(time.Now())
+	s.sessions[session.id] = session
+}
+
+// get returns a live session and extends its lifetime.
+func (s *backupRestoreSessions) get(id string) *backupRestoreSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	s.sweepLocked(now)
+	session := s.sessions[id]
+	if session != nil {
+		session.expiresAt = now.Add(backupRestoreSessionTTL)
+	}
+	return session
+}
+
+func (s *backupRestoreSessions) remove(id string) bool {
+	s.mu.Lock()
+	session := s.sessions[id]
+	delete(s.sessions, id)
+	s.mu.Unlock()
+	if session == nil {
+		return false
+	}
+	session.close()
+	return true
+}
+
+func (s *backupRestoreSessions) sweepLocked(now time.Time) {
+	for id, session := range s.sessions {
+		if now.After(session.expiresAt) {
+			delete(s.sessions, id)
+			go session.close()
+		}
+	}
+}
+
+func (s *backupRestoreSession) close() {
+	// Wait for a running import before pulling the files out from under it.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.source != nil {
+		_ = s.source.Close()
+		s.source = nil
+	}
+	_ = s.fs.RemoveAll(s.dir)
+}
+
+// OpenBackupRestoreSession downloads and verifies a backup, then opens its
+// database read-only for browsing.
+func (h *Handler) OpenBackupRestoreSession(c echo.Context) error {
+	var req struct {
+		TargetID   int    `json:"target_id"`
+		Filename   string `json:"filename"`
+		Passphrase string `json:"passphrase"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	if err := validateBackupFilename(req.Filename); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	ctx := c.Request().Context()
+	target, err := h.client.BackupTarget.Get(ctx, req.TargetID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Backup target not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	client, err := h.backupTargetClient(target)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	backupPath, cleanup, err := h.downloadBackupToTemp(ctx, client, req.Filename)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to download backup"})
+	}
+	defer cleanup()
+	verification := h.verifyBackupFile(backupPath, req.Passphrase)
+	verifyBackupSnapshotBlobs(ctx, client, &verification)
+	if !verification.Valid {
+		return c.JSON(http.StatusBadRequest, verification)
+	}
+
+	session, err := h.openBackupRestoreSession(ctx, client, backupPath, req.Passphrase)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	session.targetID = target.ID
+	session.filename = req.Filename
+
+	users, err := h.backupRestoreUsers(ctx, session)
+	if err != nil {
+		session.close()
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	h.restoreSessions.add(session)
+	return c.JSON(http.StatusOK, BackupRestoreSessionResponse{
+		ID:        session.id,
+		TargetID:  session.targetID,
+		Filename:  session.filename,
+		Snapshot:  session.snapshot != nil,
+		ExpiresAt: session.expiresAt,
+		Users:     users,
+	})
+}
+
+// openBackupRestoreSession extracts the archive at backupPath into a private
+// directory and opens its database.
+func (h *Handler) openBackupRestoreSession(ctx context.Context, client backupTargetClient, backupPath string, passphrase string) (*backupRestoreSession, error) {
+	session, err := h.extractBackupRestoreSession(client, backupPath, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if err := session.openDatabase(ctx); err != nil {
+		session.close()
+		return nil, err
+	}
+	return session, nil
+}
+
+// extractBackupRestoreSession extracts the archive at backupPath into a
+// private directory without touching its database yet.
+func (h *Handler) extractBackupRestoreSession(client backupTargetClient, backupPath string, passphrase string) (*backupRestoreSession, error) {
+	manifest, err := h.readBackupSnapshotFile(backupPath, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup: %w", err)
+	}
+	fs := h.fs.GetFs()
+	dir, err := afero.TempDir(fs, h.fs.GetDataDir(), ".smarticky_browse_")
+	if err != nil {
+		return nil, err
+	}
+	session := &backupRestoreSession{
+		id:        uuid.NewString(),
+		dir:       dir,
+		fs:        fs,
+		client:    client,
+		expiresAt: time.Now().Add(backupRestoreSessionTTL),
+	}
+	if err := h.extractBackupFileTo(backupPath, passphrase, dir); err != nil {
+		_ = fs.RemoveAll(dir)
+		return nil, fmt.Errorf("failed to extract backup: %w", err)
+	}
+	if manifest != nil {
+		session.snapshot = make(map[string]backupSnapshotFile, len(manifest.Files))
+		for _, file := range manifest.Files {
+			session.snapshot[file.Path] = file
+		}
+		session.passphrase = passphrase
+	}
+
+	return session, nil
+}
+
+func (s *backupRestoreSession) databasePath() string {
+	return filepath.Join(s.dir, "smarticky.db")
+}
+
+// openDatabase opens the extracted database read-only. Older backups are
+// migrated to the current schema on the private copy first, so they can be
+// read with the same client code as the live database.
+func (s *backupRestoreSession) openDatabase(ctx context.Context) error {
+	dbPath := s.databasePath()
+	migrate, err := ent.Open("sqlite3", "file:"+dbPath+"?_pragma=foreign_keys(1)")
+	if err == nil {
+		err = migrate.Schema.Create(ctx)
+		if closeErr := migrate.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to prepare backup database: %w", err)
+	}
+	source, err := ent.Open("sqlite3", "file:"+dbPath+"?mode=ro&_pragma=busy_timeout(10000)")
+	if err != nil {
+		return fmt.Errorf("failed to open backup database: %w", err)
+	}
+	s.source = source
+	return nil
+}
+
+func (h *Handler) backupRestoreUsers(ctx context.Context, session *backupRestoreSession) ([]BackupRestoreUser, error) {
+	users, err := session.source.User.Query().Order(ent.Asc(user.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]BackupRestoreUser, 0, len(users))
+	for _, u := range users {
+		noteCount, err := session.source.Note.Query().Where(note.HasUserWith(user.ID(u.ID)), note.IsDeleted(false)).Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		folderCount, err := session.source.Folder.Query().Where(folder.HasUserWith(user.ID(u.ID))).Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		item := BackupRestoreUser{
+			ID:          u.ID,
+			Username:    u.Username,
+			Nickname:    u.Nickname,
+			NoteCount:   noteCount,
+			FolderCount: folderCount,
+		}
+		if live, err := h.client.User.Query().Where(user.Username(u.Username)).Only(ctx); err == nil {
+			item.LiveUserID = &live.ID
+		} else if !ent.IsNotFound(err) {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+func (h *Handler) backupRestoreSessionFromParam(c echo.Context) (*backupRestoreSession, error) {
+	session := h.restoreSessions.get(c.Param("session"))
+	if session == nil {
+		return nil, c.JSON(http.StatusNotFound, map[string]string{"error": "Restore session not found or expired"})
+	}
+	return session, nil
+}
+
+func (h *Handler) GetBackupRestoreSession(c echo.Context) error {
+	session, err := h.backupRestoreSessionFromParam(c)
+	if session == nil {
+		return err
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.source == nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Restore session not found or expired"})
+	}
+	users, err := h.backupRestoreUsers(c.Request().Context(), session)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, BackupRestoreSessionResponse{
+		ID:        session.id,
+		TargetID:  session.targetID,
+		Filename:  session.filename,
+		Snapshot:  session.snapshot != nil,
+		ExpiresAt: session.expiresAt,
+		Users:     users,
+	})
+}
+
+// ListBackupRestoreItems lists the folders and notes of one user in the
+// backup, marking the ones whose IDs still exist in the live database.
+func (h *Handler) ListBackupRestoreItems(c echo.Context) error {
+	session, err := h.backupRestoreSessionFromParam(c)
+	if session == nil {
+		return err
+	}
+	userID, err := intParam(c, "id")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid user ID"})
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.source == nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Restore session not found or expired"})
+	}
+	ctx := c.Request().Context()
+	folders, err := session.source.Folder.Query().
+		Where(folder.HasUserWith(user.ID(userID))).
+		WithParent().
+		Order(ent.Asc(folder.FieldSortOrder), ent.Asc(folder.FieldName)).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	notes, err := session.source.Note.Query().
+		Where(note.HasUserWith(user.ID(userID))).
+		WithFolder().
+		WithAttachments().
+		Order(ent.Desc(note.FieldUpdatedAt)).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	folderIDs := make([]uuid.UUID, 0, len(folders))
+	for _, f := range folders {
+		folderIDs = append(folderIDs, f.ID)
+	}
+	liveFolders, err := h.client.Folder.Query().Where(folder.IDIn(folderIDs...)).IDs(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	liveFolderSet := make(map[uuid.UUID]bool, len(liveFolders))
+	for _, id := range liveFolders {
+		liveFolderSet[id] = true
+	}
+	noteIDs := make([]uuid.UUID, 0, len(notes))
+	for _, n := range notes {
+		noteIDs = append(noteIDs, n.ID)
+	}
+	liveNotes, err := h.client.Note.Query().Where(note.IDIn(noteIDs...)).Select(note.FieldID, note.FieldUpdatedAt).All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	liveNoteUpdated := make(map[uuid.UUID]time.Time, len(liveNotes))
+	for _, n := range liveNotes {
+		liveNoteUpdated[n.ID] = n.UpdatedAt
+	}
+
+	response := BackupRestoreItemsResponse{
+		Folders: make([]BackupRestoreFolder, 0, len(folders)),
+		Notes:   make([]BackupRestoreNote, 0, len(notes)),
+	}
+	for _, f := range folders {
+		item := BackupRestoreFolder{ID: f.ID, Name: f.Name, Exists: liveFolderSet[f.ID]}
+		if f.Edges.Parent != nil {
+			item.ParentID = &f.Edges.Parent.ID
+		}
+		response.Folders = append(response.Folders, item)
+	}
+	for _, n := range notes {
+		item := BackupRestoreNote{
+			ID:          n.ID,
+			Title:       n.Title,
+			IsDeleted:   n.IsDeleted,
+			Attachments: len(n.Edges.Attachments),
+			UpdatedAt:   n.UpdatedAt,
+		}
+		if n.Edges.Folder != nil {
+			item.FolderID = &n.Edges.Folder.ID
+		}
+		if updated, ok := liveNoteUpdated[n.ID]; ok {
+			item.Exists = true
+			item.LiveUpdatedAt = &updated
+		}
+		response.Notes = append(response.Notes, item)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// ImportBackupRestoreItems copies the selected items from the backup into the
+// live database. Everything runs in one transaction; a dry run rolls it back
+// and only reports what would happen.
+func (h *Handler) ImportBackupRestoreItems(c echo.Context) error {
+	session, err := h.backupRestoreSessionFromParam(c)
+	if session == nil {
+		return err
+	}
+	var req backupImportRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	if req.Mode == "" {
+		req.Mode = backupImportCopy
+	}
+	if req.Mode != backupImportCopy && req.Mode != backupImportOverwrite {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Mode must be copy or overwrite"})
+	}
+	if req.UserID <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Source user is required"})
+	}
+	if !req.All && len(req.FolderIDs) == 0 && len(req.NoteIDs) == 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Nothing selected to restore"})
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.source == nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Restore session not found or expired"})
+	}
+	report, err := h.importBackupItems(c.Request().Context(), session, req)
+	if err != nil {
+		var requestErr backupImportRequestError
+		if errors.As(err, &requestErr) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+func (h *Handler) CloseBackupRestoreSession(c echo.Context) error {
+	if !h.restoreSessions.remove(c.Param("session")) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Restore session not found or expired"})
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+type backupImportRequestError string
+
+func (e backupImportRequestError) Error() string { return string(e) }
+
+// backupImporter carries the state of one import across folders, notes and
+// their attachments.
+type backupImporter struct {
+	h       *Handler
+	session *backupRestoreSession
+	tx      *ent.Tx
+	req     backupImportRequest
+	report  *BackupImportReport
+
+	targetUser   *ent.User
+	targetFolder *uuid.UUID
+	folders      map[uuid.UUID]uuid.UUID // source folder -> live folder
+	tags         map[string]*ent.Tag     // nil marks a tag that cannot be used
+	files        []backupImportFile
+	noteIDs      []uuid.UUID
+}
+
+// backupImportFile is an upload to copy from the backup once the import has
+// been planned. It is staged next to its target and renamed into place only
+// after the import commits, so a failed import leaves live files untouched.
+type backupImportFile struct {
+	source string
+	target string
+	staged string
+}
+
+func (h *Handler) importBackupItems(ctx context.Context, session *backupRestoreSession, req backupImportRequest) (*BackupImportReport, error) {
+	sourceUser, err := session.source.User.Get(ctx, req.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, backupImportRequestError("Source user not found in backup")
+		}
+		return nil, err
+	}
+	folders, notes, err := selectBackupImportItems(ctx, session.source, req)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := h.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	imp := &backupImporter{
+		h:       h,
+		session: session,
+		tx:      tx,
+		req:     req,
+		report:  &BackupImportReport{DryRun: req.DryRun, Mode: req.Mode, Items: []BackupImportItem{}},
+		folders: make(map[uuid.UUID]uuid.UUID),
+		tags:    make(map[string]*ent.Tag),
+	}
+	if err := imp.run(ctx, sourceUser, folders, notes); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if req.DryRun {
+		if err := tx.Rollback(); err != nil {
+			return nil, err
+		}
+		return imp.report, nil
+	}
+	if err := imp.stageFiles(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		imp.discardStagedFiles()
+		return nil, err
+	}
+	if err := imp.placeFiles(); err != nil {
+		return nil, fmt.Errorf("items restored but some files could not be put in place: %w", err)
+	}
+
+	if err := h.notes.SyncUserLinks(ctx, imp.targetUser.ID); err != nil {
+		return nil, fmt.Errorf("items restored but links could not be rebuilt: %w", err)
+	}
+	for _, id := range imp.noteIDs {
+		if n, err := h.client.Note.Get(ctx, id); err == nil {
+			h.indexNoteBestEffort(ctx, n)
+		}
+	}
+	return imp.report, nil
+}
+
+// selectBackupImportItems expands the request into source folders, parents
+// before children, and the notes to restore. Selected folders bring their
+// subfolders and the notes in them, except trashed ones.
+func selectBackupImportItems(ctx context.Context, source *ent.Client, req backupImportRequest) ([]*ent.Folder, []*ent.Note, error) {
+	allFolders, err := source.Folder.Query().
+		Where(folder.HasUserWith(user.ID(req.UserID))).
+		WithParent().
+		Order(ent.Asc(folder.FieldSortOrder), ent.Asc(folder.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[uuid.UUID]*ent.Folder, len(allFolders))
+	children := make(map[uuid.UUID][]uuid.UUID)
+	for _, f := range allFolders {
+		byID[f.ID] = f
+		if f.Edges.Parent != nil {
+			children[f.Edges.Parent.ID] = append(children[f.Edges.Parent.ID], f.ID)
+		}
+	}
+
+	selected := make(map[uuid.UUID]bool)
+	var expand func(id uuid.UUID)
+	expand = func(id uuid.UUID) {
+		if selected[id] {
+			return
+		}
+		selected[id] = true
+		for _, child := range children[id] {
+			expand(child)
+		}
+	}
+	if req.All {
+		for _, f := range allFolders {
+			selected[f.ID] = true
+		}
+	}
+	for _, id := range req.FolderIDs {
+		if byID[id] == nil {
+			return nil, nil, backupImportRequestError(fmt.Sprintf("Folder %s not found in backup", id))
+		}
+		expand(id)
+	}
+
+	var ordered []*ent.Folder
+	placed := make(map[uuid.UUID]bool)
+	var place func(f *ent.Folder)
+	place = func(f *ent.Folder) {
+		if placed[f.ID] {
+			return
+		}
+		placed[f.ID] = true
+		if parent := f.Edges.Parent; parent != nil && selected[parent.ID] {
+			place(byID[parent.ID])
+		}
+		ordered = append(ordered, f)
+	}
+	for _, f := range allFolders {
+		if selected[f.ID] {
+			place(f)
+		}
+	}
+
+	folderIDs := make([]uuid.UUID, 0, len(selected))
+	for id := range selected {
+		folderIDs = append(folderIDs, id)
+	}
+	query := source.Note.Query().Where(note.HasUserWith(user.ID(req.UserID)))
+	if req.All {
+		query = query.Where(note.IsDeleted(false))
+	} else {
+		query = query.Where(note.Or(
+			note.IDIn(req.NoteIDs...),
+			note.And(note.IsDeleted(false), note.HasFolderWith(folder.IDIn(folderIDs...))),
+		))
+	}
+	notes, err := query.
+		WithFolder().
+		WithTags().
+		WithAttachments().
+		WithWhiteboards().
+		Order(ent.Asc(note.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	found := make(map[uuid.UUID]bool, len(notes))
+	for _, n := range notes {
+		found[n.ID] = true
+	}
+	for _, id := range req.NoteIDs {
+		if !found[id] {
+			return nil, nil, backupImportRequestError(fmt.Sprintf("Note %s not found in backup", id))
+		}
+	}
+	return ordered, notes, nil
+}
+
+func (imp *backupImporter) run(ctx context.Context, sourceUser *ent.User, folders []*ent.Folder, notes []*ent.Note) error {
+	if err := imp.resolveTargetUser(ctx, sourceUser); err != nil {
+		return err
+	}
+	if id := imp.req.TargetFolderID; id != nil {
+		exists, err := imp.tx.Folder.Query().
+			Where(folder.ID(*id), folder.HasUserWith(user.ID(imp.targetUser.ID))).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return backupImportRequestError("Target folder not found")
+		}
+		imp.targetFolder = id
+	}
+	for _, f := range folders {
+		if err := imp.importFolder(ctx, f); err != nil {
+			return err
+		}
+	}
+	for _, n := range notes {
+		if err := imp.importNote(ctx, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveTargetUser picks the live owner of the restored items: the one
+// requested, else the live user with the backed-up username, else a
+// recreated copy of the backed-up user.
+func (imp *backupImporter) resolveTargetUser(ctx context.Context, sourceUser *ent.User) error {
+	if imp.req.TargetUserID > 0 {
+		u, err := imp.tx.User.Get(ctx, imp.req.TargetUserID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return backupImportRequestError("Target user not found")
+			}
+			return err
+		}
+		imp.targetUser = u
+		imp.report.TargetUserID = u.ID
+		return nil
+	}
+	u, err := imp.tx.User.Query().Where(user.Username(sourceUser.Username)).Only(ctx)
+	if err == nil {
+		imp.targetUser = u
+		imp.report.TargetUserID = u.ID
+		return nil
+	}
+	if !ent.IsNotFound(err) {
+		return err
+	}
+	u, err = imp.tx.User.Create().
+		SetUsername(sourceUser.Username).
+		SetPasswordHash(sourceUser.PasswordHash).
+		SetEmail(sourceUser.Email).
+		SetNickname(sourceUser.Nickname).
+		SetRole(sourceUser.Role).
+		SetAvatar(sourceUser.Avatar).
+		SetShareSignature(sourceUser.ShareSignature).
+		SetTimeZone(sourceUser.TimeZone).
+		SetLinkRenameMode(sourceUser.LinkRenameMode).
+		SetCreatedAt(sourceUser.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	imp.targetUser = u
+	imp.report.TargetUserID = u.ID
+	imp.report.add(BackupImportItem{
+		Kind:     "user",
+		SourceID: fmt.Sprint(sourceUser.ID),
+		TargetID: fmt.Sprint(u.ID),
+		Name:     u.Username,
+		Action:   "created",
+	})
+	return nil
+}
+
+func (imp *backupImporter) overwrite() bool {
+	return imp.req.Mode == backupImportOverwrite
+}
+
+func (imp *backupImporter) importFolder(ctx context.Context, f *ent.Folder) error {
+	item := BackupImportItem{Kind: "folder", SourceID: f.ID.String(), Name: f.Name}
+	parent := imp.targetFolder
+	parentSelected := false
+	if f.Edges.Parent != nil {
+		if id, ok := imp.folders[f.Edges.Parent.ID]; ok {
+			parent = &id
+			parentSelected = true
+		}
+	}
+
+	if imp.overwrite() {
+		existing, err := imp.tx.Folder.Query().Where(folder.ID(f.ID)).WithUser().WithParent().Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if existing != nil {
+			if existing.Edges.User == nil || existing.Edges.User.ID != imp.targetUser.ID {
+				item.Action = "skipped"
+				item.Conflict = "folder belongs to another user"
+				imp.report.add(item)
+				return nil
+			}
+			// A folder whose parent was not selected stays where it is.
+			if !parentSelected && imp.targetFolder == nil {
+				parent = nil
+				if existing.Edges.Parent != nil {
+					parent = &existing.Edges.Parent.ID
+				}
+			}
+			if parent != nil {
+				cycle, err := imp.folderWithin(ctx, *parent, f.ID)
+				if err != nil {
+					return err
+				}
+				if cycle {
+					parent = imp.targetFolder
+					item.Conflict = "moved to avoid a folder cycle"
+				}
+			}
+			update := imp.tx.Folder.UpdateOneID(f.ID).
+				SetName(f.Name).
+				SetSortOrder(f.SortOrder).
+				SetIsStarred(f.IsStarred).
+				ClearParent()
+			if parent != nil {
+				update.SetParentID(*parent)
+			}
+			if err := update.Exec(ctx); err != nil {
+				return err
+			}
+			imp.folders[f.ID] = f.ID
+			item.TargetID = f.ID.String()
+			item.Action = "overwritten"
+			imp.report.add(item)
+			return nil
+		}
+	}
+
+	exists, err := imp.tx.Folder.Query().Where(
+		folder.HasUserWith(user.ID(imp.targetUser.ID)),
+		folder.Name(f.Name),
+		folderParentIs(parent),
+	).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		item.Conflict = "a folder with the same name already exists here"
+	}
+	create := imp.tx.Folder.Create().
+		SetName(f.Name).
+		SetSortOrder(f.SortOrder).
+		SetIsStarred(f.IsStarred).
+		SetCreatedAt(f.CreatedAt).
+		SetUserID(imp.targetUser.ID).
+		SetNillableParentID(parent)
+	if imp.overwrite() {
+		create.SetID(f.ID)
+	}
+	created, err := create.Save(ctx)
+	if err != nil {
+		return err
+	}
+	imp.folders[f.ID] = created.ID
+	item.TargetID = created.ID.String()
+	item.Action = "created"
+	imp.report.add(item)
+	return nil
+}
+
+// folderWithin reports whether id is ancestor or one of its live
+// descendants.
+func (imp *backupImporter) folderWithin(ctx context.Context, id uuid.UUID, ancestor uuid.UUID) (bool, error) {
+	seen := make(map[uuid.UUID]bool)
+	for current := &id; current != nil; {
+		if *current == ancestor {
+			return true, nil
+		}
+		if seen[*current] {
+			return false, nil
+		}
+		seen[*current] = true
+		parent, err := imp.tx.Folder.Query().Where(folder.HasChildrenWith(folder.ID(*current))).Only(ctx)
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		current = &parent.ID
+	}
+	return false, nil
+}
+
+func folderParentIs(parent *uuid.UUID) predicate.Folder {
+	if parent == nil {
+		return folder.Not(folder.HasParent())
+	}
+	return folder.HasParentWith(folder.ID(*parent))
+}
+
+func (imp *backupImporter) importNote(ctx context.Context, n *ent.Note) error {
+	item := BackupImportItem{Kind: "note", SourceID: n.ID.String(), Name: n.Title}
+	folderID := imp.targetFolder
+	folderResolved := n.Edges.Folder == nil
+	if n.Edges.Folder != nil {
+		if id, ok := imp.folders[n.Edges.Folder.ID]; ok {
+			folderID = &id
+			folderResolved = true
+		} else if imp.overwrite() {
+			// Put a note back into its old folder if that folder is still there.
+			exists, err := imp.tx.Folder.Query().
+				Where(folder.ID(n.Edges.Folder.ID), folder.HasUserWith(user.ID(imp.targetUser.ID))).
+				Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if exists {
+				id := n.Edges.Folder.ID
+				folderID = &id
+				folderResolved = true
+			}
+		}
+	}
+
+	var targetID uuid.UUID
+	if imp.overwrite() {
+		existing, err := imp.tx.Note.Query().Where(note.ID(n.ID)).WithUser().WithFolder().Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if existing != nil {
+			if existing.Edges.User == nil || existing.Edges.User.ID != imp.targetUser.ID {
+				item.Action = "skipped"
+				item.Conflict = "note belongs to another user"
+				imp.report.add(item)
+				return nil
+			}
+			if existing.UpdatedAt.After(n.UpdatedAt) {
+				item.Conflict = "the live note was changed after this backup"
+			}
+			// Its backed-up folder is gone; leave the note where it is now.
+			if !folderResolved && imp.targetFolder == nil && existing.Edges.Folder != nil {
+				folderID = &existing.Edges.Folder.ID
+			}
+			update := imp.tx.Note.UpdateOneID(n.ID).
+				SetTitle(n.Title).
+				SetContent(n.Content).
+				SetColor(n.Color).
+				SetProtectionMode(n.ProtectionMode).
+				SetProtectionPasswordHash(n.ProtectionPasswordHash).
+				SetEncryptedContent(n.EncryptedContent).
+				SetEncryptionAlg(n.EncryptionAlg).
+				SetEncryptionKdf(n.EncryptionKdf).
+				SetEncryptionSalt(n.EncryptionSalt).
+				SetEncryptionNonce(n.EncryptionNonce).
+				SetAliases(n.Aliases).
+				SetIsStarred(n.IsStarred).
+				SetIsDeleted(n.IsDeleted).
+				ClearFolder().
+				ClearTags()
+			if folderID != nil {
+				update.SetFolderID(*folderID)
+			}
+			if err := update.Exec(ctx); err != nil {
+				return err
+			}
+			targetID = n.ID
+			item.Action = "overwritten"
+		}
+	}
+	if item.Action == "" {
+		exists, err := imp.tx.Note.Query().Where(
+			note.HasUserWith(user.ID(imp.targetUser.ID)),
+			note.Title(n.Title),
+			note.IsDeleted(false),
+			noteFolderIs(folderID),
+		).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			item.Conflict = "a note with the same title already exists here"
+		}
+		create := imp.tx.Note.Create().
+			SetTitle(n.Title).
+			SetContent(n.Content).
+			SetColor(n.Color).
+			SetProtectionMode(n.ProtectionMode).
+			SetProtectionPasswordHash(n.ProtectionPasswordHash).
+			SetEncryptedContent(n.EncryptedContent).
+			SetEncryptionAlg(n.EncryptionAlg).
+			SetEncryptionKdf(n.EncryptionKdf).
+			SetEncryptionSalt(n.EncryptionSalt).
+			SetEncryptionNonce(n.EncryptionNonce).
+			SetAliases(n.Aliases).
+			SetIsStarred(n.IsStarred).
+			SetIsDeleted(n.IsDeleted).
+			SetCreatedAt(n.CreatedAt).
+			SetUpdatedAt(n.UpdatedAt).
+			SetUserID(imp.targetUser.ID).
+			SetNillableFolderID(folderID)
+		if imp.overwrite() {
+			create.SetID(n.ID)
+		}
+		created, err := create.Save(ctx)
+		if err != nil {
+			return err
+		}
+		targetID = created.ID
+		item.Action = "created"
+	}
+	item.TargetID = targetID.String()
+	imp.report.add(item)
+	imp.noteIDs = append(imp.noteIDs, targetID)
+
+	if err := imp.importTags(ctx, n, targetID); err != nil {
+		return err
+	}
+	for _, wb := range n.Edges.Whiteboards {
+		if err := imp.importWhiteboard(ctx, wb, targetID); err != nil {
+			return err
+		}
+	}
+	moved := attachmentMoves{ids: make(map[int]int), paths: make(map[string]string)}
+	for _, a := range n.Edges.Attachments {
+		if err := imp.importAttachment(ctx, a, targetID, moved); err != nil {
+			return err
+		}
+	}
+	if content := moved.rewrite(n.Content); content != n.Content {
+		return imp.tx.Note.UpdateOneID(targetID).SetContent(content).Exec(ctx)
+	}
+	return nil
+}
+
+func noteFolderIs(folderID *uuid.UUID) predicate.Note {
+	if folderID == nil {
+		return note.Not(note.HasFolder())
+	}
+	return note.HasFolderWith(folder.ID(*folderID))
+}
+
+// importTags attaches the note's tags by name. Tag names are unique across
+// all users, so a name taken by someone else is reported and left off.
+func (imp *backupImporter) importTags(ctx context.Context, n *ent.Note, noteID uuid.UUID) error {
+	var ids []uuid.UUID
+	for _, source := range n.Edges.Tags {
+		t, cached := imp.tags[source.Name]
+		if !cached {
+			existing, err := imp.tx.Tag.Query().Where(tag.Name(source.Name)).WithUser().Only(ctx)
+			switch {
+			case err == nil && existing.Edges.User != nil && existing.Edges.User.ID == imp.targetUser.ID:
+				t = existing
+			case err == nil:
+				imp.report.add(BackupImportItem{
+					Kind:     "tag",
+					SourceID: source.ID.String(),
+					Name:     source.Name,
+					Action:   "skipped",
+					Conflict: "tag name is used by another user",
+				})
+			case ent.IsNotFound(err):
+				t, err = imp.tx.Tag.Create().
+					SetName(source.Name).
+					SetColor(source.Color).
+					SetUserID(imp.targetUser.ID).
+					Save(ctx)
+				if err != nil {
+					return err
+				}
+				imp.report.add(BackupImportItem{
+					Kind:     "tag",
+					SourceID: source.ID.String(),
+					TargetID: t.ID.String(),
+					Name:     t.Name,
+					Action:   "created",
+				})
+			default:
+				return err
+			}
+			imp.tags[source.Name] = t
+		}
+		if t != nil {
+			ids = append(ids, t.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return imp.tx.Note.UpdateOneID(noteID).AddTagIDs(ids...).Exec(ctx)
+}
+
+func (imp *backupImporter) importWhiteboard(ctx context.Context, wb *ent.Whiteboard, noteID uuid.UUID) error {
+	item := BackupImportItem{Kind: "whiteboard", SourceID: wb.ID.String(), Name: wb.Title}
+	if imp.overwrite() {
+		existing, err := imp.tx.Whiteboard.Query().Where(whiteboard.ID(wb.ID)).WithUser().Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if existing != nil {
+			if existing.Edges.User == nil || existing.Edges.User.ID != imp.targetUser.ID {
+				item.Action = "skipped"
+				item.Conflict = "whiteboard belongs to another user"
+				imp.report.add(item)
+				return nil
+			}
+			if err := imp.tx.Whiteboard.UpdateOneID(wb.ID).
+				SetTitle(wb.Title).
+				SetSceneJSON(wb.SceneJSON).
+				SetThumbnail(wb.Thumbnail).
+				SetNoteID(noteID).
+				Exec(ctx); err != nil {
+				return err
+			}
+			item.TargetID = wb.ID.String()
+			item.Action = "overwritten"
+			imp.report.add(item)
+			return nil
+		}
+	}
+	create := imp.tx.Whiteboard.Create().
+		SetTitle(wb.Title).
+		SetSceneJSON(wb.SceneJSON).
+		SetThumbnail(wb.Thumbnail).
+		SetCreatedAt(wb.CreatedAt).
+		SetNoteID(noteID).
+		SetUserID(imp.targetUser.ID)
+	if imp.overwrite() {
+		create.SetID(wb.ID)
+	}
+	created, err := create.Save(ctx)
+	if err != nil {
+		return err
+	}
+	item.TargetID = created.ID.String()
+	item.Action = "created"
+	imp.report.add(item)
+	return nil
+}
+
+// importAttachment restores an attachment file next to its row. A copy
+// never shares a file with a live attachment: if the path is taken the file
+// gets a new name. New names and attachment IDs are recorded in moved so the
+// note content can follow them.
+func (imp *backupImporter) importAttachment(ctx context.Context, a *ent.Attachment, noteID uuid.UUID, moved attachmentMoves) error {
+	item := BackupImportItem{Kind: "attachment", SourceID: fmt.Sprint(a.ID), Name: a.Filename}
+	rel, ok := backupUploadPath(a.FilePath)
+	if !ok {
+		item.Action = "skipped"
+		item.Conflict = "attachment is stored outside the uploads directory"
+		imp.report.add(item)
+		return nil
+	}
+	digest, ok, err := imp.session.uploadDigest(imp.h, rel)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		item.Action = "skipped"
+		item.Conflict = "attachment file is missing from the backup"
+		imp.report.add(item)
+		return nil
+	}
+	target, err := safeArchiveTarget(imp.h.fs.GetDataDir(), rel)
+	if err != nil {
+		return err
+	}
+
+	copyFile := true
+	liveExists, err := imp.h.fs.Exists(target)
+	if err != nil {
+		return err
+	}
+	if liveExists {
+		liveDigest, _, err := imp.h.hashBackupFile(target)
+		if err != nil {
+			return err
+		}
+		same := liveDigest == digest
+		switch {
+		case !imp.overwrite():
+			renamed := uuid.NewString() + filepath.Ext(target)
+			target = filepath.Join(filepath.Dir(target), renamed)
+			moved.paths[rel] = path.Join(path.Dir(rel), renamed)
+			if !same {
+				item.Conflict = "a different file already uses this name; restored under a new name"
+			}
+		case same:
+			copyFile = false
+		default:
+			item.Conflict = "replaced a different live file"
+		}
+	}
+	if copyFile {
+		imp.files = append(imp.files, backupImportFile{source: rel, target: target})
+	}
+
+	var existing *ent.Attachment
+	if imp.overwrite() {
+		existing, err = imp.tx.Attachment.Query().Where(attachment.FilePath(target)).First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+	}
+	if existing != nil {
+		err = imp.tx.Attachment.UpdateOneID(existing.ID).
+			SetFilename(a.Filename).
+			SetFileSize(a.FileSize).
+			SetMimeType(a.MimeType).
+			SetNoteID(noteID).
+			SetUserID(imp.targetUser.ID).
+			Exec(ctx)
+		item.TargetID = fmt.Sprint(existing.ID)
+		item.Action = "overwritten"
+		moved.add(a.ID, existing.ID)
+	} else {
+		var created *ent.Attachment
+		created, err = imp.tx.Attachment.Create().
+			SetFilename(a.Filename).
+			SetFilePath(target).
+			SetFileSize(a.FileSize).
+			SetMimeType(a.MimeType).
+			SetCreatedAt(a.CreatedAt).
+			SetNoteID(noteID).
+			SetUserID(imp.targetUser.ID).
+			Save(ctx)
+		if created != nil {
+			item.TargetID = fmt.Sprint(created.ID)
+			moved.add(a.ID, created.ID)
+		}
+		item.Action = "created"
+	}
+	if err != nil {
+		return err
+	}
+	imp.report.add(item)
+	return nil
+}
+
+// attachmentMoves maps a restored note's attachment references to their new
+// places: attachment IDs that changed and upload paths restored under a new
+// name.
+type attachmentMoves struct {
+	ids   map[int]int
+	paths map[string]string
+}
+
+func (m attachmentMoves) add(from, to int) {
+	if from != to {
+		m.ids[from] = to
+	}
+}
+
+// attachmentRefPattern matches the URLs note content uses to embed
+// attachments: the download endpoint and the static uploads path.
+var attachmentRefPattern = regexp.MustCompile(`/api/attachments/(\d+)/download|/uploads/[^\s()\[\]<>"'?#]+`)
+
+// rewrite points the attachment URLs in content at the moved attachments,
+// leaving all other text alone.
+func (m attachmentMoves) rewrite(content string) string {
+	if len(m.ids) == 0 && len(m.paths) == 0 {
+		return content
+	}
+	return attachmentRefPattern.ReplaceAllStringFunc(content, func(ref string) string {
+		if match := attachmentRefPattern.FindStringSubmatch(ref); match[1] != "" {
+			id, err := strconv.Atoi(match[1])
+			if to, ok := m.ids[id]; ok && err == nil {
+				return fmt.Sprintf("/api/attachments/%d/download", to)
+			}
+			return ref
+		}
+		if to, ok := m.paths[strings.TrimPrefix(ref, "/")]; ok {
+			return "/" + to
+		}
+		return ref
+	})
+}
+
+// stageFiles copies the planned uploads from the backup into temporary
+// files beside their targets.
+func (imp *backupImporter) stageFiles(ctx context.Context) error {
+	for i := range imp.files {
+		file := &imp.files[i]
+		source, err := imp.session.uploadFile(ctx, imp.h, file.source)
+		if err != nil {
+			imp.discardStagedFiles()
+			return fmt.Errorf("failed to read %s from backup: %w", file.source, err)
+		}
+		staged := filepath.Join(filepath.Dir(file.target), ".restore-"+uuid.NewString()+filepath.Ext(file.target))
+		if err := imp.h.copyBackupFile(source, staged); err != nil {
+			_ = imp.h.fs.Remove(staged)
+			imp.discardStagedFiles()
+			return fmt.Errorf("failed to restore %s: %w", file.source, err)
+		}
+		file.staged = staged
+	}
+	return nil
+}
+
+// discardStagedFiles removes the staged copies of an import that failed.
+func (imp *backupImporter) discardStagedFiles() {
+	for i := range imp.files {
+		if imp.files[i].staged != "" {
+			_ = imp.h.fs.Remove(imp.files[i].staged)
+			imp.files[i].staged = ""
+		}
+	}
+}
+
+// placeFiles renames the staged copies over their targets. It carries on
+// past a failure so one bad file does not hold back the others.
+func (imp *backupImporter) placeFiles() error {
+	var errs []error
+	for i := range imp.files {
+		file := &imp.files[i]
+		if file.staged == "" {
+			continue
+		}
+		if err := imp.h.fs.Rename(file.staged, file.target); err != nil {
+			_ = imp.h.fs.Remove(file.staged)
+			errs = append(errs, fmt.Errorf("%s: %w", file.source, err))
+		}
+		file.staged = ""
+	}
+	return errors.Join(errs...)
+}
+
+// uploadDigest returns the SHA-256 of an upload in the backup, and false if
+// the backup does not contain it.
+func (s *backupRestoreSession) uploadDigest(h *Handler, rel string) (string, bool, error) {
+	if s.snapshot != nil {
+		file, ok := s.snapshot[rel]
+		return file.SHA256, ok, nil
+	}
+	path := filepath.Join(s.dir, filepath.FromSlash(rel))
+	exists, err := h.fs.Exists(path)
+	if err != nil || !exists {
+		return "", false, err
+	}
+	digest, _, err := h.hashBackupFile(path)
+	return digest, err == nil, err
+}
+
+// uploadFile returns a local path holding the upload, downloading the blob
+// of a snapshot on first use.
+func (s *backupRestoreSession) uploadFile(ctx context.Context, h *Handler, rel string) (string, error) {
+	path := filepath.Join(s.dir, filepath.FromSlash(rel))
+	if s.snapshot == nil {
+		return path, nil
+	}
+	if exists, err := h.fs.Exists(path); err != nil || exists {
+		return path, err
+	}
+	file, ok := s.snapshot[rel]
+	if !ok {
+		return "", fmt.Errorf("%s is not in the snapshot", rel)
+	}
+	if err := h.downloadBackupBlob(ctx, s.client, file, path, s.passphrase); err != nil {
+		_ = h.fs.Remove(path)
+		return "", err
+	}
+	return path, nil
+}
+
+// backupUploadPath turns an attachment's absolute file path, recorded under
+// whatever data directory the backup was taken in, into its path relative
+// to the data directory.
+func backupUploadPath(filePath string) (string, bool) {
+	slashed := filepath.ToSlash(filePath)
+	idx := strings.Index(slashed, "/uploads/")
+	if idx < 0 {
+		if !strings.HasPrefix(slashed, "uploads/") {
+			return "", false
+		}
+		idx = -1
+	}
+	rel := path.Clean(slashed[idx+1:])
+	if !strings.HasPrefix(rel, "uploads/") {
+		return "", false
+	}
+	return rel, true
+}
@@ -0,0 +1,324 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"smarticky/ent/attachment"
+	"smarticky/ent/enttest"
+	"smarticky/ent/folder"
+	"smarticky/ent/note"
+	"smarticky/ent/user"
+	"smarticky/internal/storage"
+
+	"github.com/labstack/echo/v4"
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestBackupRestoreSessionImportsSelectedItems(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(dataDir, "smarticky.db")+"?_pragma=foreign_keys(1)")
+	defer client.Close()
+	fs := storage.NewFileSystem(dataDir)
+	h := NewHandler(client, fs)
+
+	alice := client.User.Create().SetUsername("alice").SetPasswordHash("hash").SaveX(ctx)
+	projects := client.Folder.Create().SetName("Projects").SetUser(alice).SaveX(ctx)
+	archive := client.Folder.Create().SetName("Archive").SetUser(alice).SetParent(projects).SaveX(ctx)
+	work := client.Tag.Create().SetName("work").SetUser(alice).SaveX(ctx)
+	imagePath := filepath.Join(dataDir, "uploads", "attachments", "a.png")
+	if err := fs.WriteFile(imagePath, []byte("alpha"), 0644); err != nil {
+		t.Fatalf("write attachment: %v", err)
+	}
+	plan := client.Note.Create().
+		SetTitle("Plan").
+		SetContent("![a](/uploads/attachments/a.png)").
+		SetUser(alice).
+		SetFolder(projects).
+		AddTags(work).
+		SaveX(ctx)
+	client.Attachment.Create().SetFilename("a.png").SetFilePath(imagePath).SetFileSize(5).SetNote(plan).SetUser(alice).SaveX(ctx)
+	client.Whiteboard.Create().SetTitle("Sketch").SetNote(plan).SetUser(alice).SaveX(ctx)
+	client.Note.Create().SetTitle("Old ideas").SetContent("See [[Plan]]").SetUser(alice).SetFolder(archive).SaveX(ctx)
+	journal := client.Note.Create().SetTitle("Journal").SetContent("before").SetUser(alice).SaveX(ctx)
+
+	server := newTestWebDAVServer(t)
+	target := client.BackupTarget.Create().
+		SetName("WebDAV").
+		SetType("webdav").
+		SetWebdavURL(server.URL).
+		SetWebdavUser("dav-user").
+		SetWebdavPassword("dav-pass").
+		SaveX(ctx)
+	targetClient, err := h.backupTargetClient(target)
+	if err != nil {
+		t.Fatalf("create target client: %v", err)
+	}
+	filename := backupTaskFilename(1, false, time.Now())
+	if _, err := h.uploadBackupArchive(ctx, filename, "", []backupTargetClient{targetClient}); err != nil {
+		t.Fatalf("upload backup: %v", err)
+	}
+
+	// Lose the plan note and edit the journal after the backup.
+	client.Attachment.Delete().Where(attachment.HasNoteWith(note.ID(plan.ID))).ExecX(ctx)
+	client.Whiteboard.Delete().ExecX(ctx)
+	client.Note.DeleteOneID(plan.ID).ExecX(ctx)
+	if err := fs.Remove(imagePath); err != nil {
+		t.Fatalf("remove attachment: %v", err)
+	}
+	client.Note.UpdateOneID(journal.ID).SetContent("after").SetUpdatedAt(time.Now().Add(time.Hour)).ExecX(ctx)
+
+	call := func(handler echo.HandlerFunc, method string, body string, params ...string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(method, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		var names, values []string
+		for i := 0; i+1 < len(params); i += 2 {
+			names = append(names, params[i])
+			values = append(values, params[i+1])
+		}
+		c.SetParamNames(names...)
+		c.SetParamValues(values...)
+		if err := handler(c); err != nil {
+			t.Fatalf("handler returned error: %v", err)
+		}
+		return rec
+	}
+	decode := func(rec *httptest.ResponseRecorder, v any) {
+		t.Helper()
+		if rec.Code != http.StatusOK {
+			t.Fatalf("unexpected status %d: %s", rec.Code, rec.Body.String())
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), v); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+	}
+
+	var session BackupRestoreSessionResponse
+	decode(call(h.OpenBackupRestoreSession, http.MethodPost, fmt.Sprintf(`{"target_id":%d,"filename":%q}`, target.ID, filename)), &session)
+	if len(session.Users) != 1 || session.Users[0].Username != "alice" || session.Users[0].NoteCount != 3 ||
+		session.Users[0].LiveUserID == nil || *session.Users[0].LiveUserID != alice.ID {
+		t.Fatalf("unexpected session users: %+v", session.Users)
+	}
+
+	var items BackupRestoreItemsResponse
+	decode(call(h.ListBackupRestoreItems, http.MethodGet, "", "session", session.ID, "id", fmt.Sprint(alice.ID)), &items)
+	if len(items.Folders) != 2 || len(items.Notes) != 3 {
+		t.Fatalf("unexpected backup items: %+v", items)
+	}
+	for _, n := range items.Notes {
+		if n.Exists != (n.ID != plan.ID) {
+			t.Fatalf("note %s exists = %v", n.Title, n.Exists)
+		}
+	}
+
+	importItems := func(body string) BackupImportReport {
+		t.Helper()
+		var report BackupImportReport
+		decode(call(h.ImportBackupRestoreItems, http.MethodPost, body, "session", session.ID), &report)
+		return report
+	}
+	overwrite := fmt.Sprintf(`{"user_id":%d,"note_ids":[%q,%q],"mode":"overwrite"`, alice.ID, plan.ID, journal.ID)
+
+	report := importItems(overwrite + `,"dry_run":true}`)
+	if report.Created != 3 || report.Overwritten != 1 || report.Conflicts != 1 {
+		t.Fatalf("unexpected dry run report: %+v", report)
+	}
+	if client.Note.Query().Where(note.ID(plan.ID)).ExistX(ctx) {
+		t.Fatal("dry run must not write to the database")
+	}
+	if exists, _ := fs.Exists(imagePath); exists {
+		t.Fatal("dry run must not restore files")
+	}
+
+	report = importItems(overwrite + `}`)
+	if report.Created != 3 || report.Overwritten != 1 {
+		t.Fatalf("unexpected overwrite report: %+v", report)
+	}
+	restored := client.Note.Query().Where(note.ID(plan.ID)).WithFolder().WithTags().WithWhiteboards().WithAttachments().OnlyX(ctx)
+	if restored.Edges.Folder == nil || restored.Edges.Folder.ID != projects.ID || len(restored.Edges.Tags) != 1 ||
+		len(restored.Edges.Whiteboards) != 1 || len(restored.Edges.Attachments) != 1 {
+		t.Fatalf("restored note lost its edges: %+v", restored.Edges)
+	}
+	if data, err := fs.ReadFile(imagePath); err != nil || string(data) != "alpha" {
+		t.Fatalf("restored attachment = %q, %v", data, err)
+	}
+	if got := client.Note.GetX(ctx, journal.ID).Content; got != "before" {
+		t.Fatalf("journal content = %q, want backed-up content", got)
+	}
+	if !hasBackupImportConflict(report, journal.ID.String(), "changed after this backup") {
+		t.Fatalf("expected newer live note to be reported, got %+v", report.Items)
+	}
+
+	report = importItems(fmt.Sprintf(`{"user_id":%d,"folder_ids":[%q]}`, alice.ID, projects.ID))
+	if report.Created != 6 {
+		t.Fatalf("expected two folders, two notes, a whiteboard and an attachment, got %+v", report)
+	}
+	if !hasBackupImportConflict(report, projects.ID.String(), "same name") {
+		t.Fatalf("expected duplicate folder name to be reported, got %+v", report.Items)
+	}
+	if got := client.Folder.Query().Where(folder.Name("Projects")).CountX(ctx); got != 2 {
+		t.Fatalf("expected a copied folder next to the original, got %d", got)
+	}
+	copied := client.Note.Query().Where(note.Title("Plan"), note.IDNEQ(plan.ID)).WithAttachments().OnlyX(ctx)
+	if copied.Content == plan.Content || len(copied.Edges.Attachments) != 1 {
+		t.Fatalf("expected copy to point at its own attachment, got %q", copied.Content)
+	}
+	copiedPath := copied.Edges.Attachments[0].FilePath
+	if copiedPath == imagePath || !strings.Contains(copied.Content, filepath.Base(copiedPath)) {
+		t.Fatalf("copied attachment %q does not match content %q", copiedPath, copied.Content)
+	}
+	if data, err := fs.ReadFile(copiedPath); err != nil || string(data) != "alpha" {
+		t.Fatalf("copied attachment = %q, %v", data, err)
+	}
+	if links := client.NoteLink.Query().CountX(ctx); links == 0 {
+		t.Fatal("expected links to be rebuilt after import")
+	}
+
+	bob := client.User.Create().SetUsername("bob").SetPasswordHash("hash").SaveX(ctx)
+	report = importItems(fmt.Sprintf(`{"user_id":%d,"target_user_id":%d,"note_ids":[%q],"mode":"overwrite"}`, alice.ID, bob.ID, plan.ID))
+	if report.Skipped != 1 || !hasBackupImportConflict(report, plan.ID.String(), "another user") {
+		t.Fatalf("expected note of another user to be skipped, got %+v", report)
+	}
+	if client.Note.Query().Where(note.HasUserWith(user.ID(bob.ID))).ExistX(ctx) {
+		t.Fatal("expected nothing to be imported for bob")
+	}
+	report = importItems(fmt.Sprintf(`{"user_id":%d,"target_user_id":%d,"note_ids":[%q]}`, alice.ID, bob.ID, plan.ID))
+	if !hasBackupImportConflict(report, work.ID.String(), "another user") {
+		t.Fatalf("expected tag of another user to be reported, got %+v", report.Items)
+	}
+	if copy := client.Note.Query().Where(note.HasUserWith(user.ID(bob.ID))).WithTags().OnlyX(ctx); len(copy.Edges.Tags) != 0 {
+		t.Fatalf("expected copy for bob without alice's tag, got %+v", copy.Edges.Tags)
+	}
+
+	// A session closed by expiry while still being looked up is reported as gone.
+	h.restoreSessions.get(session.ID).close()
+	for name, handler := range map[string]echo.HandlerFunc{
+		"session": h.GetBackupRestoreSession,
+		"items":   h.ListBackupRestoreItems,
+	} {
+		if rec := call(handler, http.MethodGet, "", "session", session.ID, "id", fmt.Sprint(alice.ID)); rec.Code != http.StatusNotFound {
+			t.Fatalf("expected closed session %s to be gone, got %d", name, rec.Code)
+		}
+	}
+
+	rec := call(h.CloseBackupRestoreSession, http.MethodDelete, "", "session", session.ID)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("close session: %d %s", rec.Code, rec.Body.String())
+	}
+	if matches, _ := filepath.Glob(filepath.Join(dataDir, ".smarticky_*")); len(matches) != 0 {
+		t.Fatalf("expected session files to be removed, found %v", matches)
+	}
+	rec = call(h.ImportBackupRestoreItems, http.MethodPost, overwrite+`}`, "session", session.ID)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected closed session to be gone, got %d", rec.Code)
+	}
+}
+
+func TestBackupImporterKeepsLiveFilesUntilCommitted(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	fs := storage.NewFileSystem(dataDir)
+	h := &Handler{fs: fs}
+	sessionDir := t.TempDir()
+	if err := fs.WriteFile(filepath.Join(sessionDir, "uploads", "attachments", "a.png"), []byte("backup"), 0644); err != nil {
+		t.Fatalf("write backup upload: %v", err)
+	}
+	live := filepath.Join(dataDir, "uploads", "attachments", "a.png")
+	other := filepath.Join(dataDir, "uploads", "attachments", "b.png")
+	for _, path := range []string{live, other} {
+		if err := fs.WriteFile(path, []byte("live"), 0644); err != nil {
+			t.Fatalf("write live upload: %v", err)
+		}
+	}
+	liveFiles := func() []string {
+		t.Helper()
+		matches, _ := filepath.Glob(filepath.Join(dataDir, "uploads", "attachments", "*"))
+		return matches
+	}
+	imp := &backupImporter{h: h, session: &backupRestoreSession{dir: sessionDir}}
+
+	// The second upload is missing from the backup, so staging fails after
+	// the first one was copied.
+	imp.files = []backupImportFile{
+		{source: "uploads/attachments/a.png", target: live},
+		{source: "uploads/attachments/b.png", target: other},
+	}
+	if err := imp.stageFiles(ctx); err == nil {
+		t.Fatal("expected staging a missing upload to fail")
+	}
+	if data, _ := fs.ReadFile(live); string(data) != "live" {
+		t.Fatalf("live file = %q after a failed import", data)
+	}
+	if files := liveFiles(); len(files) != 2 {
+		t.Fatalf("expected staged copies to be removed, found %v", files)
+	}
+
+	imp.files = []backupImportFile{{source: "uploads/attachments/a.png", target: live}}
+	if err := imp.stageFiles(ctx); err != nil {
+		t.Fatalf("stage files: %v", err)
+	}
+	if data, _ := fs.ReadFile(live); string(data) != "live" {
+		t.Fatalf("live file = %q before the import committed", data)
+	}
+	if err := imp.placeFiles(); err != nil {
+		t.Fatalf("place files: %v", err)
+	}
+	if data, _ := fs.ReadFile(live); string(data) != "backup" {
+		t.Fatalf("live file = %q, want the restored copy", data)
+	}
+	if files := liveFiles(); len(files) != 2 {
+		t.Fatalf("expected no staged copies left, found %v", files)
+	}
+}
+
+func TestBackupUploadPath(t *testing.T) {
+	for input, want := range map[string]string{
+		"/data/uploads/attachments/a.png":    "uploads/attachments/a.png",
+		"/old/root/uploads/../uploads/b.png": "uploads/b.png",
+		"uploads/c.png":                      "uploads/c.png",
+		"/data/uploads/../smarticky.db":      "",
+		"/data/attachments/a.png":            "",
+		`C:\smarticky\uploads\attachments\d`: "",
+	} {
+		got, ok := backupUploadPath(input)
+		if got != want || ok != (want != "") {
+			t.Errorf("backupUploadPath(%q) = %q, %v; want %q", input, got, ok, want)
+		}
+	}
+}
+
+func TestAttachmentMovesRewriteOnlyReferences(t *testing.T) {
+	moved := attachmentMoves{
+		ids:   map[int]int{3: 7, 7: 9},
+		paths: map[string]string{"uploads/a.png": "uploads/b.png"},
+	}
+	content := "a.png and /api/attachments/3 stay.\n" +
+		"![a](/uploads/a.png) ![x](/uploads/a.png.bak)\n" +
+		"[3](/api/attachments/3/download) [7](/api/attachments/7/download) [4](/api/attachments/4/download)"
+	want := "a.png and /api/attachments/3 stay.\n" +
+		"![a](/uploads/b.png) ![x](/uploads/a.png.bak)\n" +
+		"[3](/api/attachments/7/download) [7](/api/attachments/9/download) [4](/api/attachments/4/download)"
+	if got := moved.rewrite(content); got != want {
+		t.Fatalf("rewrite() = %q, want %q", got, want)
+	}
+}
+
+func hasBackupImportConflict(report BackupImportReport, sourceID string, conflict string) bool {
+	for _, item := range report.Items {
+		if item.SourceID == sourceID && strings.Contains(item.Conflict, conflict) {
+			return true
+		}
+	}
+	return false
+}
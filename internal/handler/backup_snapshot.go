@@ -0,0 +1,570 @@
+package handler
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/afero"
+	"golang.org/x/crypto/argon2"
+)
+
+// Incremental backups store every attachment once per target as a blob named
+// by its SHA-256 digest. A snapshot is a small archive holding a manifest of
+// attachment paths and digests followed by the database file. Retention
+// deletes old snapshots like any other backup file and then garbage-collects
+// the blobs no remaining snapshot references. Tasks with a passphrase seal
+// the snapshot and every blob with it, and name their blobs by a keyed hash
+// of the digest so stored names reveal nothing about attachment contents and
+// tasks with different passphrases never share a blob.
+const (
+	backupSnapshotSuffix       = ".snapshot.tar.gz"
+	backupSnapshotManifestName = "snapshot.json"
+	backupSnapshotVersion      = 1
+	backupBlobDir              = "blobs"
+	// Unreferenced blobs younger than this survive garbage collection, since
+	// a concurrent run may have uploaded them before writing its snapshot.
+	backupBlobGCGrace = 24 * time.Hour
+)
+
+// backupBlobNameSalt fixes the salt of the key that names sealed blobs, so
+// every run of a task derives the same names.
+const backupBlobNameSalt = "smarticky backup blob names"
+
+type backupSnapshotManifest struct {
+	Version   int                  `json:"version"`
+	CreatedAt time.Time            `json:"created_at"`
+	Files     []backupSnapshotFile `json:"files"`
+}
+
+type backupSnapshotFile struct {
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+	// Blob and BlobSize describe the stored blob when it is sealed; plain
+	// blobs are named by SHA256 and have the attachment's size.
+	Blob     string `json:"blob,omitempty"`
+	BlobSize int64  `json:"blob_size,omitempty"`
+}
+
+func (f backupSnapshotFile) blob() string {
+	if f.Blob != "" {
+		return f.Blob
+	}
+	return f.SHA256
+}
+
+func (f backupSnapshotFile) blobSize() int64 {
+	if f.Blob != "" {
+		return f.BlobSize
+	}
+	return f.Size
+}
+
+type backupBlobInfo struct {
+	Digest  string
+	Size    int64
+	ModTime time.Time
+}
+
+func isBackupSnapshotFilename(name string) bool {
+	return strings.HasSuffix(strings.TrimSuffix(name, backupEncryptedSuffix), backupSnapshotSuffix)
+}
+
+func backupSnapshotFilename(filename string) string {
+	return strings.TrimSuffix(filename, ".tar.gz") + backupSnapshotSuffix
+}
+
+func backupBlobName(digest string) string {
+	return backupBlobDir + "/" + digest
+}
+
+// sealBackupSnapshotManifest names and sizes the blobs of manifest for
+// sealing with passphrase.
+func sealBackupSnapshotManifest(manifest *backupSnapshotManifest, passphrase string) {
+	params := backupKDFDefaults
+	key := argon2.IDKey([]byte(passphrase), []byte(backupBlobNameSalt), params.Time, params.MemoryKiB, params.Threads, sha256.Size)
+	for i := range manifest.Files {
+		file := &manifest.Files[i]
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(file.SHA256))
+		file.Blob = hex.EncodeToString(mac.Sum(nil))
+		file.BlobSize = backupSealedSize(file.Size)
+	}
+}
+
+func validBackupBlobDigest(digest string) bool {
+	if len(digest) != sha256.Size*2 || strings.ToLower(digest) != digest {
+		return false
+	}
+	_, err := hex.DecodeString(digest)
+	return err == nil
+}
+
+// uploadBackupSnapshot hashes the attachments, uploads the blobs each target
+// is missing and then streams the snapshot archive to every target whose
+// blobs are complete, sealing both when passphrase is set. It returns
+// per-client errors like uploadBackupArchive.
+func (h *Handler) uploadBackupSnapshot(ctx context.Context, filename string, passphrase string, clients []backupTargetClient) ([]error, error) {
+	manifest, sources, err := h.scanBackupUploads()
+	if err != nil {
+		return nil, err
+	}
+	if passphrase != "" {
+		sealBackupSnapshotManifest(manifest, passphrase)
+	}
+
+	errs := make([]error, len(clients))
+	var wg sync.WaitGroup
+	for i, client := range clients {
+		wg.Add(1)
+		go func(i int, client backupTargetClient) {
+			defer wg.Done()
+			errs[i] = h.syncBackupBlobs(ctx, client, manifest, sources, passphrase)
+		}(i, client)
+	}
+	wg.Wait()
+
+	// A snapshot is only useful next to its blobs.
+	ready := make([]backupTargetClient, 0, len(clients))
+	readyIndexes := make([]int, 0, len(clients))
+	for i, client := range clients {
+		if errs[i] == nil {
+			ready = append(ready, client)
+			readyIndexes = append(readyIndexes, i)
+		}
+	}
+	snapshotErrs, archiveErr := h.uploadBackupStream(ctx, filename, func(w io.Writer) error {
+		if passphrase == "" {
+			return h.writeBackupSnapshot(w, manifest)
+		}
+		encrypter, err := newBackupEncryptWriter(w, passphrase)
+		if err != nil {
+			return err
+		}
+		if err := h.writeBackupSnapshot(encrypter, manifest); err != nil {
+			return err
+		}
+		return encrypter.Close()
+	}, ready)
+	for position, i := range readyIndexes {
+		errs[i] = snapshotErrs[position]
+	}
+	return errs, archiveErr
+}
+
+// scanBackupUploads hashes every attachment into a snapshot manifest. It also
+// returns one local path per digest to upload missing blobs from.
+func (h *Handler) scanBackupUploads() (*backupSnapshotManifest, map[string]string, error) {
+	manifest := &backupSnapshotManifest{
+		Version:   backupSnapshotVersion,
+		CreatedAt: time.Now(),
+		Files:     []backupSnapshotFile{},
+	}
+	sources := make(map[string]string)
+
+	dataDir := h.fs.GetDataDir()
+	uploadsDir := filepath.Join(dataDir, "uploads")
+	if exists, _ := h.fs.Exists(uploadsDir); !exists {
+		return manifest, sources, nil
+	}
+	err := afero.Walk(h.fs.GetFs(), uploadsDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+		relPath, err := filepath.Rel(dataDir, path)
+		if err != nil {
+			return err
+		}
+		digest, size, err := h.hashBackupFile(path)
+		if err != nil {
+			return err
+		}
+		manifest.Files = append(manifest.Files, backupSnapshotFile{
+			Path:   filepath.ToSlash(relPath),
+			SHA256: digest,
+			Size:   size,
+		})
+		sources[digest] = path
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to scan uploads directory: %w", err)
+	}
+	return manifest, sources, nil
+}
+
+func (h *Handler) hashBackupFile(path string) (string, int64, error) {
+	file, err := h.fs.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// syncBackupBlobs uploads the manifest's blobs that client does not have yet.
+// A stored blob whose size disagrees, such as a partial upload, is replaced.
+func (h *Handler) syncBackupBlobs(ctx context.Context, client backupTargetClient, manifest *backupSnapshotManifest, sources map[string]string, passphrase string) error {
+	blobs, err := client.ListBlobs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list backup blobs: %w", err)
+	}
+	stored := make(map[string]int64, len(blobs))
+	for _, blob := range blobs {
+		stored[blob.Digest] = blob.Size
+	}
+	for _, file := range manifest.Files {
+		if size, ok := stored[file.blob()]; ok && size == file.blobSize() {
+			continue
+		}
+		if err := h.uploadBackupBlob(ctx, client, file, sources[file.SHA256], passphrase); err != nil {
+			return err
+		}
+		stored[file.blob()] = file.blobSize()
+	}
+	return nil
+}
+
+func (h *Handler) uploadBackupBlob(ctx context.Context, client backupTargetClient, blob backupSnapshotFile, source string, passphrase string) error {
+	file, err := h.fs.Open(source)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	name := backupBlobName(blob.blob())
+	body := io.TeeReader(file, hash)
+	if passphrase != "" {
+		sealed := sealBackupReader(body, passphrase)
+		err = client.Upload(ctx, name, sealed)
+		// Wait for the encrypter before the hash is read.
+		sealed.Close()
+	} else {
+		err = client.Upload(ctx, name, body)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to upload blob %s: %w", blob.blob(), err)
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != blob.SHA256 {
+		// The attachment changed after it was hashed; never leave a blob
+		// whose content does not match its name.
+		_ = client.Delete(context.WithoutCancel(ctx), name)
+		return fmt.Errorf("attachment %s changed during backup", source)
+	}
+	return nil
+}
+
+// writeBackupSnapshot writes a snapshot archive: the snapshot manifest first,
+// so it can be read without downloading the database, then the database with
+// its manifest and an uploads directory entry.
+func (h *Handler) writeBackupSnapshot(w io.Writer, manifest *backupSnapshotManifest) error {
+	gzWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzWriter)
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name:     backupSnapshotManifestName,
+		Typeflag: tar.TypeReg,
+		Mode:     0644,
+		Size:     int64(len(data)),
+		ModTime:  manifest.CreatedAt,
+	}); err != nil {
+		return fmt.Errorf("failed to write snapshot manifest: %w", err)
+	}
+	if _, err := tarWriter.Write(data); err != nil {
+		return fmt.Errorf("failed to write snapshot manifest: %w", err)
+	}
+	if err := h.addBackupDatabase(tarWriter); err != nil {
+		return err
+	}
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name:     "uploads",
+		Typeflag: tar.TypeDir,
+		Mode:     0755,
+	}); err != nil {
+		return fmt.Errorf("failed to add uploads directory: %w", err)
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzWriter.Close()
+}
+
+func decodeBackupSnapshotManifest(r io.Reader) (*backupSnapshotManifest, error) {
+	var manifest backupSnapshotManifest
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("invalid snapshot manifest: %w", err)
+	}
+	if manifest.Version != backupSnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d", manifest.Version)
+	}
+	for _, file := range manifest.Files {
+		if err := validateBackupArchiveName(file.Path); err != nil || !strings.HasPrefix(path.Clean(file.Path), "uploads/") {
+			return nil, fmt.Errorf("invalid snapshot path %q", file.Path)
+		}
+		if !validBackupBlobDigest(file.SHA256) || file.Size < 0 ||
+			(file.Blob != "" && !validBackupBlobDigest(file.Blob)) || file.BlobSize < 0 {
+			return nil, fmt.Errorf("invalid snapshot blob for %q", file.Path)
+		}
+	}
+	return &manifest, nil
+}
+
+// readBackupSnapshotManifest reads the manifest at the head of a snapshot
+// archive. It returns nil without error for full archives.
+func readBackupSnapshotManifest(r io.Reader) (*backupSnapshotManifest, error) {
+	gzReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress: %w", err)
+	}
+	defer gzReader.Close()
+
+	tarReader := tar.NewReader(gzReader)
+	header, err := tarReader.Next()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tar: %w", err)
+	}
+	if path.Clean(strings.ReplaceAll(header.Name, "\\", "/")) != backupSnapshotManifestName {
+		return nil, nil
+	}
+	return decodeBackupSnapshotManifest(tarReader)
+}
+
+// readBackupSnapshotFile returns the manifest of the local backup at path, or
+// nil when it is a full archive.
+func (h *Handler) readBackupSnapshotFile(path string, passphrase string) (*backupSnapshotManifest, error) {
+	file, err := h.fs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	plain, _, err := openBackupStream(file, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return readBackupSnapshotManifest(plain)
+}
+
+func readRemoteBackupSnapshotManifest(ctx context.Context, client backupTargetClient, filename string, passphrase string) (*backupSnapshotManifest, error) {
+	body, err := client.Download(ctx, filename)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	plain, _, err := openBackupStream(body, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := readBackupSnapshotManifest(plain)
+	if err == nil && manifest == nil {
+		err = errors.New("snapshot manifest is missing")
+	}
+	return manifest, err
+}
+
+// verifyBackupSnapshotBlobs checks that every blob a verified snapshot
+// references is stored on client with the expected size.
+func verifyBackupSnapshotBlobs(ctx context.Context, client backupTargetClient, result *BackupVerificationResult) {
+	if result.snapshot == nil || !result.Valid {
+		return
+	}
+	blobs, err := client.ListBlobs(ctx)
+	if err != nil {
+		result.Valid = false
+		result.Error = fmt.Sprintf("failed to list snapshot blobs: %v", err)
+		return
+	}
+	sizes := make(map[string]int64, len(blobs))
+	for _, blob := range blobs {
+		sizes[blob.Digest] = blob.Size
+	}
+	for _, file := range result.snapshot.Files {
+		size, ok := sizes[file.blob()]
+		if ok && size == file.blobSize() {
+			continue
+		}
+		check := FileCheckResult{Path: "/" + file.Path, Exists: ok, Size: size}
+		if ok {
+			check.Error = fmt.Sprintf("blob %s has size %d, want %d", file.blob(), size, file.blobSize())
+		} else {
+			check.Error = fmt.Sprintf("blob %s is missing", file.blob())
+		}
+		result.FileChecks = append(result.FileChecks, check)
+		result.Valid = false
+	}
+	if !result.Valid {
+		result.Error = "one or more snapshot blobs are missing or damaged"
+	}
+}
+
+// stageBackupSnapshotBlobs downloads every blob of manifest into a temporary
+// directory in the data directory, opening sealed blobs with passphrase and
+// checking each digest, so a restore only starts once all attachments are at
+// hand. The caller must call cleanup.
+func (h *Handler) stageBackupSnapshotBlobs(ctx context.Context, client backupTargetClient, manifest *backupSnapshotManifest, passphrase string) (string, func(), error) {
+	fs := h.fs.GetFs()
+	stageDir, err := afero.TempDir(fs, h.fs.GetDataDir(), ".smarticky_restore_")
+	if err != nil {
+		return "", func() {}, err
+	}
+	cleanup := func() { _ = fs.RemoveAll(stageDir) }
+
+	staged := make(map[string]bool)
+	for _, file := range manifest.Files {
+		if staged[file.SHA256] {
+			continue
+		}
+		if err := h.downloadBackupBlob(ctx, client, file, filepath.Join(stageDir, file.SHA256), passphrase); err != nil {
+			cleanup()
+			return "", func() {}, err
+		}
+		staged[file.SHA256] = true
+	}
+	return stageDir, cleanup, nil
+}
+
+// downloadBackupBlob writes the attachment held by blob to target.
+func (h *Handler) downloadBackupBlob(ctx context.Context, client backupTargetClient, blob backupSnapshotFile, target string, passphrase string) error {
+	digest := blob.SHA256
+	body, err := client.Download(ctx, backupBlobName(blob.blob()))
+	if err != nil {
+		return fmt.Errorf("failed to download blob %s: %w", blob.blob(), err)
+	}
+	defer body.Close()
+	var plain io.Reader = body
+	if blob.Blob != "" {
+		if plain, _, err = openBackupStream(body, passphrase); err != nil {
+			return fmt.Errorf("failed to open blob %s: %w", blob.Blob, err)
+		}
+	}
+
+	file, err := h.fs.Create(target)
+	if err != nil {
+		return err
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(file, hash), plain); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to download blob %s: %w", digest, err)
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != digest {
+		return fmt.Errorf("blob %s is corrupted", digest)
+	}
+	return nil
+}
+
+// placeBackupSnapshotBlobs copies staged blobs to their attachment paths.
+func (h *Handler) placeBackupSnapshotBlobs(stageDir string, manifest *backupSnapshotManifest) error {
+	dataDir := h.fs.GetDataDir()
+	for _, file := range manifest.Files {
+		target, err := safeArchiveTarget(dataDir, file.Path)
+		if err != nil {
+			return err
+		}
+		if err := h.copyBackupFile(filepath.Join(stageDir, file.SHA256), target); err != nil {
+			return fmt.Errorf("failed to restore %s: %w", file.Path, err)
+		}
+	}
+	return nil
+}
+
+func (h *Handler) copyBackupFile(source string, target string) error {
+	in, err := h.fs.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := h.fs.Create(target)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// backupPassphraseLookup returns the passphrase that opens the encrypted
+// backup filename.
+type backupPassphraseLookup func(ctx context.Context, filename string) (string, error)
+
+// collectBackupBlobs deletes blobs that no snapshot on client references.
+// Snapshots of every task count, since tasks sharing a target share its
+// blobs; encrypted ones are opened with the passphrase lookup returns. Any
+// unreadable snapshot aborts collection rather than risk deleting blobs it
+// still needs.
+func collectBackupBlobs(ctx context.Context, client backupTargetClient, passphrases backupPassphraseLookup) error {
+	blobs, err := client.ListBlobs(ctx)
+	if err != nil || len(blobs) == 0 {
+		return err
+	}
+	files, err := client.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	refs := make(map[string]int)
+	for _, file := range files {
+		if !isBackupSnapshotFilename(file.Filename) {
+			continue
+		}
+		passphrase := ""
+		if isEncryptedBackupFilename(file.Filename) {
+			if passphrases == nil {
+				return fmt.Errorf("no passphrase to read snapshot %s", file.Filename)
+			}
+			if passphrase, err = passphrases(ctx, file.Filename); err != nil {
+				return fmt.Errorf("failed to read snapshot %s: %w", file.Filename, err)
+			}
+		}
+		manifest, err := readRemoteBackupSnapshotManifest(ctx, client, file.Filename, passphrase)
+		if err != nil {
+			return fmt.Errorf("failed to read snapshot %s: %w", file.Filename, err)
+		}
+		for _, entry := range manifest.Files {
+			refs[entry.blob()]++
+		}
+	}
+
+	now := time.Now()
+	for _, blob := range blobs {
+		if refs[blob.Digest] > 0 || now.Sub(blob.ModTime) < backupBlobGCGrace {
+			continue
+		}
+		if err := client.Delete(ctx, backupBlobName(blob.Digest)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,417 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"smarticky/ent/backuptask"
+	"smarticky/ent/enttest"
+	"smarticky/internal/storage"
+
+	"github.com/labstack/echo/v4"
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestRunBackupTaskStoresIncrementalSnapshots(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(dataDir, "smarticky.db")+"?_pragma=foreign_keys(1)")
+	defer client.Close()
+	fs := storage.NewFileSystem(dataDir)
+	h := NewHandler(client, fs)
+
+	writeUpload := func(name, body string) {
+		t.Helper()
+		if err := fs.WriteFile(filepath.Join(dataDir, "uploads", filepath.FromSlash(name)), []byte(body), 0644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	writeUpload("attachments/a.png", "alpha")
+	writeUpload("attachments/copy.png", "alpha")
+	writeUpload("b.txt", "beta")
+
+	backupDir := t.TempDir()
+	target := client.BackupTarget.Create().SetName("Disk").SetType("local").SetLocalPath(backupDir).SaveX(ctx)
+	created := client.BackupTask.Create().
+		SetName("Incremental").
+		SetIncremental(true).
+		AddTargets(target).
+		SaveX(ctx)
+	task := client.BackupTask.Query().Where(backuptask.ID(created.ID)).WithTargets().OnlyX(ctx)
+
+	blobTimes := func() map[string]time.Time {
+		t.Helper()
+		entries, err := os.ReadDir(filepath.Join(backupDir, backupBlobDir))
+		if err != nil {
+			t.Fatalf("read blobs: %v", err)
+		}
+		times := make(map[string]time.Time, len(entries))
+		for _, entry := range entries {
+			info, err := entry.Info()
+			if err != nil {
+				t.Fatalf("stat blob: %v", err)
+			}
+			times[entry.Name()] = info.ModTime()
+		}
+		return times
+	}
+
+	first, err := h.runBackupTask(ctx, task, false)
+	if err != nil {
+		t.Fatalf("first run: %v (%+v)", err, first)
+	}
+	if !isBackupSnapshotFilename(first.File) {
+		t.Fatalf("expected snapshot filename, got %q", first.File)
+	}
+	uploaded := blobTimes()
+	if len(uploaded) != 2 {
+		t.Fatalf("first run stored %d blobs, want 2", len(uploaded))
+	}
+
+	writeUpload("c.txt", "gamma")
+	time.Sleep(10 * time.Millisecond)
+	second, err := h.runBackupTask(ctx, task, true)
+	if err != nil {
+		t.Fatalf("second run: %v (%+v)", err, second)
+	}
+	stored := blobTimes()
+	if len(stored) != 3 {
+		t.Fatalf("second run left %d blobs, want 3", len(stored))
+	}
+	for name, modTime := range uploaded {
+		if !stored[name].Equal(modTime) {
+			t.Fatalf("second run uploaded blob %s again", name)
+		}
+	}
+	targetClient, err := h.backupTargetClient(target)
+	if err != nil {
+		t.Fatalf("create target client: %v", err)
+	}
+	files, err := targetClient.List(ctx)
+	if err != nil {
+		t.Fatalf("list backups: %v", err)
+	}
+	if len(files) != 2 || !files[0].Snapshot || !files[1].Snapshot {
+		t.Fatalf("expected two snapshots, got %+v", files)
+	}
+
+	post := func(handler echo.HandlerFunc, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(fmt.Sprint(target.ID))
+		if err := handler(c); err != nil {
+			t.Fatalf("handler returned error: %v", err)
+		}
+		return rec
+	}
+	verify := func(filename string) BackupVerificationResult {
+		t.Helper()
+		rec := post(h.VerifyBackupTargetFile, fmt.Sprintf(`{"filename":%q}`, filename))
+		var result BackupVerificationResult
+		if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+			t.Fatalf("decode verification: %v", err)
+		}
+		return result
+	}
+	if result := verify(second.File); !result.Valid || !result.Snapshot {
+		t.Fatalf("expected snapshot to verify, got %+v", result)
+	}
+
+	if err := os.RemoveAll(filepath.Join(dataDir, "uploads")); err != nil {
+		t.Fatalf("remove uploads: %v", err)
+	}
+	rec := post(h.RestoreBackupTargetFile, fmt.Sprintf(`{"filename":%q,"confirmation":"RESTORE"}`, second.File))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected restore to succeed, got %d: %s", rec.Code, rec.Body.String())
+	}
+	for name, want := range map[string]string{
+		"attachments/a.png":    "alpha",
+		"attachments/copy.png": "alpha",
+		"b.txt":                "beta",
+		"c.txt":                "gamma",
+	} {
+		got, err := fs.ReadFile(filepath.Join(dataDir, "uploads", filepath.FromSlash(name)))
+		if err != nil || string(got) != want {
+			t.Fatalf("restored %s = %q, %v; want %q", name, got, err, want)
+		}
+	}
+	if exists, _ := fs.Exists(filepath.Join(dataDir, backupSnapshotManifestName)); exists {
+		t.Fatal("expected snapshot manifest to stay out of the data directory")
+	}
+
+	digest, _, err := h.hashBackupFile(filepath.Join(dataDir, "uploads", "c.txt"))
+	if err != nil {
+		t.Fatalf("hash attachment: %v", err)
+	}
+	if err := targetClient.Delete(ctx, backupBlobName(digest)); err != nil {
+		t.Fatalf("delete blob: %v", err)
+	}
+	result := verify(second.File)
+	if result.Valid || len(result.FileChecks) == 0 || result.FileChecks[len(result.FileChecks)-1].Path != "/uploads/c.txt" {
+		t.Fatalf("expected missing blob to fail verification, got %+v", result)
+	}
+	if result := verify(first.File); !result.Valid {
+		t.Fatalf("expected first snapshot to stay valid, got %+v", result)
+	}
+}
+
+func TestEncryptedIncrementalBackupsRoundTripOverWebDAV(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(dataDir, "smarticky.db")+"?_pragma=foreign_keys(1)")
+	defer client.Close()
+	fs := storage.NewFileSystem(dataDir)
+	h := NewHandler(client, fs)
+
+	writeUpload := func(name, body string) {
+		t.Helper()
+		if err := fs.WriteFile(filepath.Join(dataDir, "uploads", filepath.FromSlash(name)), []byte(body), 0644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	writeUpload("attachments/a.png", "alpha secret")
+	writeUpload("attachments/copy.png", "alpha secret")
+	writeUpload("b.txt", "beta secret")
+
+	var blobPuts atomic.Int32
+	var stored sync.Map // path -> body
+	server := newTestWebDAVServer(t, func(r *http.Request) {
+		if r.Method != http.MethodPut {
+			return
+		}
+		if strings.HasPrefix(r.URL.Path, "/"+backupBlobDir+"/") {
+			blobPuts.Add(1)
+		}
+		body, _ := io.ReadAll(r.Body)
+		stored.Store(r.URL.Path, body)
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	})
+	target := client.BackupTarget.Create().
+		SetName("WebDAV").
+		SetType("webdav").
+		SetWebdavURL(server.URL).
+		SetWebdavUser("dav-user").
+		SetWebdavPassword("dav-pass").
+		SaveX(ctx)
+	passphrase := "correct horse battery"
+	sealed, err := h.sealBackupPassphrase(passphrase)
+	if err != nil {
+		t.Fatalf("seal passphrase: %v", err)
+	}
+	created := client.BackupTask.Create().
+		SetName("Incremental").
+		SetIncremental(true).
+		SetEncryptionEnabled(true).
+		SetEncryptionPassphrase(sealed).
+		SetMaxCount(1).
+		AddTargets(target).
+		SaveX(ctx)
+	task := client.BackupTask.Query().Where(backuptask.ID(created.ID)).WithTargets().OnlyX(ctx)
+	input := taskInputFromEnt(task)
+	input.HasEncryptionPassphrase = true
+	if err := h.validateBackupTaskInput(ctx, input); err != nil {
+		t.Fatalf("expected encrypted incremental WebDAV task to be accepted: %v", err)
+	}
+
+	first, err := h.runBackupTask(ctx, task, false)
+	if err != nil {
+		t.Fatalf("first run: %v (%+v)", err, first)
+	}
+	if !isBackupSnapshotFilename(first.File) || !isEncryptedBackupFilename(first.File) {
+		t.Fatalf("expected encrypted snapshot filename, got %q", first.File)
+	}
+	if got := blobPuts.Load(); got != 2 {
+		t.Fatalf("first run uploaded %d blobs, want 2", got)
+	}
+	betaDigest, _, err := h.hashBackupFile(filepath.Join(dataDir, "uploads", "b.txt"))
+	if err != nil {
+		t.Fatalf("hash attachment: %v", err)
+	}
+	stored.Range(func(key, value any) bool {
+		if bytes.Contains(value.([]byte), []byte("secret")) || strings.Contains(key.(string), betaDigest) {
+			t.Fatalf("%s leaks attachment contents or digests", key)
+		}
+		return true
+	})
+
+	writeUpload("c.txt", "gamma secret")
+	// WebDAV modification times have second resolution; retention needs the
+	// two snapshots apart to keep the newer one.
+	time.Sleep(time.Second)
+	blobPuts.Store(0)
+	second, err := h.runBackupTask(ctx, task, true)
+	if err != nil {
+		t.Fatalf("second run: %v (%+v)", err, second)
+	}
+	if got := blobPuts.Load(); got != 1 {
+		t.Fatalf("second run uploaded %d blobs, want only the new attachment", got)
+	}
+
+	targetClient, err := h.backupTargetClient(target)
+	if err != nil {
+		t.Fatalf("create target client: %v", err)
+	}
+	files, err := targetClient.List(ctx)
+	if err != nil {
+		t.Fatalf("list backups: %v", err)
+	}
+	// Retention read the encrypted snapshots and kept every blob the
+	// remaining one needs.
+	if len(files) != 1 || files[0].Filename != second.File || !files[0].Snapshot || !files[0].Encrypted {
+		t.Fatalf("expected only the second encrypted snapshot, got %+v", files)
+	}
+	if blobs, err := targetClient.ListBlobs(ctx); err != nil || len(blobs) != 3 {
+		t.Fatalf("expected 3 stored blobs, got %+v, %v", blobs, err)
+	}
+
+	post := func(handler echo.HandlerFunc, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(fmt.Sprint(target.ID))
+		if err := handler(c); err != nil {
+			t.Fatalf("handler returned error: %v", err)
+		}
+		return rec
+	}
+	rec := post(h.VerifyBackupTargetFile, fmt.Sprintf(`{"filename":%q,"passphrase":%q}`, second.File, passphrase))
+	var result BackupVerificationResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil || !result.Valid || !result.Snapshot || !result.Encrypted {
+		t.Fatalf("expected encrypted snapshot to verify, got %+v, %v", result, err)
+	}
+
+	if err := os.RemoveAll(filepath.Join(dataDir, "uploads")); err != nil {
+		t.Fatalf("remove uploads: %v", err)
+	}
+	rec = post(h.RestoreBackupTargetFile, fmt.Sprintf(`{"filename":%q,"passphrase":%q,"confirmation":"RESTORE"}`, second.File, passphrase))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected restore to succeed, got %d: %s", rec.Code, rec.Body.String())
+	}
+	for name, want := range map[string]string{
+		"attachments/a.png":    "alpha secret",
+		"attachments/copy.png": "alpha secret",
+		"b.txt":                "beta secret",
+		"c.txt":                "gamma secret",
+	} {
+		got, err := fs.ReadFile(filepath.Join(dataDir, "uploads", filepath.FromSlash(name)))
+		if err != nil || string(got) != want {
+			t.Fatalf("restored %s = %q, %v; want %q", name, got, err, want)
+		}
+	}
+}
+
+func TestCleanupTargetBackupsCollectsUnreferencedBlobs(t *testing.T) {
+	h := &Handler{fs: storage.NewFileSystem(t.TempDir())}
+	writeTestDatabase(t, h.getDBPath(), []byte("db-data"))
+
+	now := time.Now()
+	digest := func(c string) string { return strings.Repeat(c, 64) }
+	client := &memoryBackupTargetClient{data: map[string][]byte{}}
+	addSnapshot := func(name string, createdAt time.Time, digests ...string) {
+		t.Helper()
+		manifest := &backupSnapshotManifest{Version: backupSnapshotVersion, CreatedAt: createdAt}
+		for i, d := range digests {
+			manifest.Files = append(manifest.Files, backupSnapshotFile{Path: fmt.Sprintf("uploads/%d.bin", i), SHA256: d, Size: 1})
+		}
+		var archive bytes.Buffer
+		if err := h.writeBackupSnapshot(&archive, manifest); err != nil {
+			t.Fatalf("write snapshot: %v", err)
+		}
+		client.data[name] = archive.Bytes()
+		client.files = append(client.files, BackupFileInfo{Filename: name, CreatedAt: createdAt, Snapshot: true})
+	}
+
+	oldSnapshot := backupSnapshotFilename(backupTaskFilename(7, true, now.Add(-2*time.Hour)))
+	addSnapshot(oldSnapshot, now.Add(-2*time.Hour), digest("a"), digest("b"))
+	addSnapshot(backupSnapshotFilename(backupTaskFilename(7, true, now.Add(-time.Hour))), now.Add(-time.Hour), digest("b"), digest("c"))
+	// Another task's snapshot on the same target keeps its blob alive.
+	addSnapshot(backupSnapshotFilename(backupTaskFilename(8, false, now.Add(-3*time.Hour))), now.Add(-3*time.Hour), digest("e"))
+	client.blobs = []backupBlobInfo{
+		{Digest: digest("a"), Size: 1, ModTime: now.Add(-48 * time.Hour)},
+		{Digest: digest("b"), Size: 1, ModTime: now.Add(-48 * time.Hour)},
+		{Digest: digest("c"), Size: 1, ModTime: now.Add(-48 * time.Hour)},
+		{Digest: digest("d"), Size: 1, ModTime: now.Add(-48 * time.Hour)},
+		{Digest: digest("e"), Size: 1, ModTime: now.Add(-48 * time.Hour)},
+		// Unreferenced but fresh: a concurrent run may still need it.
+		{Digest: digest("f"), Size: 1, ModTime: now.Add(-time.Minute)},
+	}
+
+	if err := cleanupTargetBackups(context.Background(), client, backupRetentionPolicy{MaxCount: 1}, nil, nil, backupTaskFilenamePrefixes(7)...); err != nil {
+		t.Fatalf("cleanup target backups: %v", err)
+	}
+
+	want := []string{oldSnapshot, backupBlobName(digest("a")), backupBlobName(digest("d"))}
+	sort.Strings(client.deleted)
+	sort.Strings(want)
+	if strings.Join(client.deleted, ",") != strings.Join(want, ",") {
+		t.Fatalf("deleted = %#v, want %#v", client.deleted, want)
+	}
+}
+
+// memoryBackupTargetClient keeps backup files and blobs in memory.
+type memoryBackupTargetClient struct {
+	files   []BackupFileInfo
+	data    map[string][]byte
+	blobs   []backupBlobInfo
+	deleted []string
+}
+
+func (c *memoryBackupTargetClient) List(context.Context) ([]BackupFileInfo, error) {
+	files := append([]BackupFileInfo(nil), c.files...)
+	sortBackupFiles(files)
+	return files, nil
+}
+
+func (c *memoryBackupTargetClient) Upload(_ context.Context, filename string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	c.data[filename] = data
+	return err
+}
+
+func (c *memoryBackupTargetClient) Download(_ context.Context, filename string) (io.ReadCloser, error) {
+	data, ok := c.data[filename]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (c *memoryBackupTargetClient) Delete(_ context.Context, filename string) error {
+	c.deleted = append(c.deleted, filename)
+	delete(c.data, filename)
+	for i, file := range c.files {
+		if file.Filename == filename {
+			c.files = append(c.files[:i], c.files[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (c *memoryBackupTargetClient) Test(context.Context) error {
+	return nil
+}
+
+func (c *memoryBackupTargetClient) ListBlobs(context.Context) ([]backupBlobInfo, error) {
+	return append([]backupBlobInfo(nil), c.blobs...), nil
+}
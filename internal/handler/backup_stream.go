@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+var (
+	errBackupUploadStopped = errors.New("backup upload stopped reading")
+	errAllBackupUploads    = errors.New("backup upload failed for all targets")
+)
+
+// writeBackupArchiveFile writes a backup archive to a local file.
+func (h *Handler) writeBackupArchiveFile(path string) error {
+	file, err := h.fs.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := h.writeBackupArchive(file); err != nil {
+		file.Close()
+		_ = h.fs.Remove(path)
+		return err
+	}
+	return file.Close()
+}
+
+// uploadBackupArchive builds one archive and streams it to every client at
+// once, encrypting it first when passphrase is set. A failing target stops
+// receiving data without aborting the others. It returns the per-client
+// upload errors and the archive error, if any.
+func (h *Handler) uploadBackupArchive(ctx context.Context, filename string, passphrase string, clients []backupTargetClient) ([]error, error) {
+	return h.uploadBackupStream(ctx, filename, func(w io.Writer) error {
+		return h.writeBackupArchiveTo(w, passphrase)
+	}, clients)
+}
+
+// uploadBackupStream runs write once and streams its output to every client
+// concurrently, with the error semantics of uploadBackupArchive.
+func (h *Handler) uploadBackupStream(ctx context.Context, filename string, write func(io.Writer) error, clients []backupTargetClient) ([]error, error) {
+	errs := make([]error, len(clients))
+	if len(clients) == 0 {
+		return errs, nil
+	}
+
+	readers := make([]*io.PipeReader, len(clients))
+	writers := make([]*io.PipeWriter, len(clients))
+	var wg sync.WaitGroup
+	for i, client := range clients {
+		readers[i], writers[i] = io.Pipe()
+		wg.Add(1)
+		go func(i int, client backupTargetClient) {
+			defer wg.Done()
+			errs[i] = client.Upload(ctx, filename, readers[i])
+			// Unblock the archive writer if the upload returned early.
+			readers[i].CloseWithError(errBackupUploadStopped)
+		}(i, client)
+	}
+
+	fanout := &backupFanout{writers: writers, failed: make([]error, len(writers))}
+	archiveErr := write(fanout)
+	for _, writer := range writers {
+		writer.CloseWithError(archiveErr)
+	}
+	wg.Wait()
+
+	for i := range errs {
+		switch {
+		case archiveErr != nil && !errors.Is(archiveErr, errAllBackupUploads):
+			errs[i] = archiveErr
+		case errs[i] == nil && fanout.failed[i] != nil:
+			errs[i] = fanout.failed[i]
+		}
+	}
+	if errors.Is(archiveErr, errAllBackupUploads) {
+		archiveErr = nil
+	}
+	return errs, archiveErr
+}
+
+// backupFanout copies archive bytes to several upload pipes, dropping pipes
+// whose reader has gone away.
+type backupFanout struct {
+	writers []*io.PipeWriter
+	failed  []error
+}
+
+func (f *backupFanout) Write(p []byte) (int, error) {
+	active := 0
+	for i, writer := range f.writers {
+		if f.failed[i] != nil {
+			continue
+		}
+		if _, err := writer.Write(p); err != nil {
+			f.failed[i] = err
+			continue
+		}
+		active++
+	}
+	if active == 0 {
+		return 0, errAllBackupUploads
+	}
+	return len(p), nil
+}
+
+// downloadBackupToTemp spools a remote backup into a temporary file in the
+// data directory so it can be verified and then extracted without holding it
+// in memory. The caller must call cleanup.
+func (h *Handler) downloadBackupToTemp(ctx context.Context, client backupTargetClient, filename string) (string, func(), error) {
+	body, err := client.Download(ctx, filename)
+	if err != nil {
+		return "", func() {}, err
+	}
+	defer body.Close()
+
+	file, err := afero.TempFile(h.fs.GetFs(), h.fs.GetDataDir(), ".smarticky_download_*.tar.gz")
+	if err != nil {
+		return "", func() {}, err
+	}
+	path := file.Name()
+	cleanup := func() { _ = h.fs.Remove(path) }
+	if _, err := io.Copy(file, body); err != nil {
+		file.Close()
+		cleanup()
+		return "", func() {}, err
+	}
+	if err := file.Close(); err != nil {
+		cleanup()
+		return "", func() {}, err
+	}
+	return path, cleanup, nil
+}
+
+// writeBackupArchiveTo writes the archive to w, sealing it with passphrase
+// when one is given.
+func (h *Handler) writeBackupArchiveTo(w io.Writer, passphrase string) error {
+	if passphrase == "" {
+		return h.writeBackupArchive(w)
+	}
+	encrypter, err := newBackupEncryptWriter(w, passphrase)
+	if err != nil {
+		return err
+	}
+	if err := h.writeBackupArchive(encrypter); err != nil {
+		return err
+	}
+	return encrypter.Close()
+}
+
+func (h *Handler) verifyBackupFile(path string, passphrase string) BackupVerificationResult {
+	file, err := h.fs.Open(path)
+	if err != nil {
+		result := BackupVerificationResult{VerifiedAt: time.Now(), FileChecks: []FileCheckResult{}}
+		result.Error = fmt.Sprintf("failed to open backup: %v", err)
+		return result
+	}
+	defer file.Close()
+	return h.verifyBackupData(file, passphrase)
+}
+
+func (h *Handler) extractBackupFile(path string, passphrase string) error {
+	return h.extractBackupFileTo(path, passphrase, h.fs.GetDataDir())
+}
+
+func (h *Handler) extractBackupFileTo(path string, passphrase string, dataDir string) error {
+	file, err := h.fs.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	plain, _, err := openBackupStream(file, passphrase)
+	if err != nil {
+		return err
+	}
+	return h.extractBackupArchiveTo(plain, dataDir)
+}
+
+// forwardOnlyReader lets gowebdav stream a request body: its authorizer
+// buffers the whole body for retries unless the body is an io.Seeker. Only a
+// rewind before the first read succeeds, so retries after streaming started
+// fail instead of replaying a partial body.
+type forwardOnlyReader struct {
+	r       io.Reader
+	started bool
+}
+
+func (f *forwardOnlyReader) Read(p []byte) (int, error) {
+	f.started = true
+	return f.r.Read(p)
+}
+
+func (f *forwardOnlyReader) Seek(offset int64, whence int) (int64, error) {
+	if !f.started && offset == 0 && whence == io.SeekStart {
+		return 0, nil
+	}
+	return 0, errors.New("backup stream cannot be rewound")
+}
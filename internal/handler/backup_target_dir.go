@@ -0,0 +1,403 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/backuptarget"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// errSFTPHostKeyMismatch is reported when an SFTP server no longer presents
+// the host key pinned for its target.
+var errSFTPHostKeyMismatch = errors.New("SFTP host key mismatch")
+
+// backupDirFS is the part of a filesystem that a directory-backed target
+// needs. The local disk and an SFTP session both provide it, so the two
+// target types share one client. Names use forward slashes.
+type backupDirFS interface {
+	ReadDir(name string) ([]os.FileInfo, error)
+	Open(name string) (io.ReadCloser, error)
+	Create(name string) (io.WriteCloser, error)
+	MkdirAll(name string) error
+	Rename(oldname, newname string) error
+	Remove(name string) error
+}
+
+// dirBackupTargetClient stores backups as plain files below root. connect
+// opens the filesystem for one operation; the returned func releases it.
+type dirBackupTargetClient struct {
+	root    string
+	connect func(ctx context.Context) (backupDirFS, func() error, error)
+}
+
+func (c *dirBackupTargetClient) path(name string) string {
+	return path.Join(c.root, name)
+}
+
+func (c *dirBackupTargetClient) List(ctx context.Context) ([]BackupFileInfo, error) {
+	fs, release, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	files, err := fs.ReadDir(c.root)
+	if errors.Is(err, iofs.ErrNotExist) {
+		return []BackupFileInfo{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	backups := make([]BackupFileInfo, 0)
+	for _, file := range files {
+		if !file.IsDir() && isBackupFilename(file.Name()) {
+			backups = append(backups, BackupFileInfo{
+				Filename:  file.Name(),
+				Size:      file.Size(),
+				CreatedAt: file.ModTime(),
+				Encrypted: isEncryptedBackupFilename(file.Name()),
+				Snapshot:  isBackupSnapshotFilename(file.Name()),
+			})
+		}
+	}
+	sortBackupFiles(backups)
+	return backups, nil
+}
+
+// Upload writes to a temporary name and renames it into place, so an
+// interrupted upload never shows up as a backup or as a valid blob.
+func (c *dirBackupTargetClient) Upload(ctx context.Context, filename string, r io.Reader) error {
+	fs, release, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	target := c.path(filename)
+	if err := fs.MkdirAll(path.Dir(target)); err != nil {
+		return err
+	}
+	partial := path.Join(path.Dir(target), fmt.Sprintf(".%s.%d.partial", path.Base(target), time.Now().UnixNano()))
+	file, err := fs.Create(partial)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, r); err != nil {
+		file.Close()
+		_ = fs.Remove(partial)
+		return err
+	}
+	if err := file.Close(); err != nil {
+		_ = fs.Remove(partial)
+		return err
+	}
+	if err := fs.Rename(partial, target); err != nil {
+		_ = fs.Remove(partial)
+		return err
+	}
+	return nil
+}
+
+func (c *dirBackupTargetClient) Download(ctx context.Context, filename string) (io.ReadCloser, error) {
+	fs, release, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	file, err := fs.Open(c.path(filename))
+	if err != nil {
+		release()
+		return nil, err
+	}
+	return &releasingReadCloser{ReadCloser: file, release: release}, nil
+}
+
+func (c *dirBackupTargetClient) Delete(ctx context.Context, filename string) error {
+	fs, release, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return fs.Remove(c.path(filename))
+}
+
+func (c *dirBackupTargetClient) ListBlobs(ctx context.Context) ([]backupBlobInfo, error) {
+	fs, release, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	files, err := fs.ReadDir(c.path(backupBlobDir))
+	if errors.Is(err, iofs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]backupBlobInfo, 0, len(files))
+	for _, file := range files {
+		if !file.IsDir() && validBackupBlobDigest(file.Name()) {
+			blobs = append(blobs, backupBlobInfo{Digest: file.Name(), Size: file.Size(), ModTime: file.ModTime()})
+		}
+	}
+	return blobs, nil
+}
+
+func (c *dirBackupTargetClient) Test(ctx context.Context) error {
+	probe := fmt.Sprintf(".smarticky_connection_test_%d.txt", time.Now().UnixNano())
+	if err := c.Upload(ctx, probe, bytes.NewReader([]byte("smarticky"))); err != nil {
+		return err
+	}
+	body, err := c.Download(ctx, probe)
+	if err != nil {
+		_ = c.Delete(ctx, probe)
+		return err
+	}
+	data, readErr := io.ReadAll(body)
+	body.Close()
+	removeErr := c.Delete(ctx, probe)
+	if readErr != nil {
+		return readErr
+	}
+	if removeErr != nil {
+		return removeErr
+	}
+	if !bytes.Equal(data, []byte("smarticky")) {
+		return errors.New("probe read mismatch")
+	}
+	return nil
+}
+
+// releasingReadCloser releases the connection behind a download once the
+// caller is done reading it.
+type releasingReadCloser struct {
+	io.ReadCloser
+	release func() error
+}
+
+func (r *releasingReadCloser) Close() error {
+	err := r.ReadCloser.Close()
+	if releaseErr := r.release(); err == nil {
+		err = releaseErr
+	}
+	return err
+}
+
+func newLocalBackupTargetClient(dir string) *dirBackupTargetClient {
+	return &dirBackupTargetClient{
+		root: filepath.ToSlash(filepath.Clean(dir)),
+		connect: func(context.Context) (backupDirFS, func() error, error) {
+			return localBackupDirFS{}, func() error { return nil }, nil
+		},
+	}
+}
+
+type localBackupDirFS struct{}
+
+func (localBackupDirFS) ReadDir(name string) ([]os.FileInfo, error) {
+	entries, err := os.ReadDir(filepath.FromSlash(name))
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]os.FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if errors.Is(err, iofs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (localBackupDirFS) Open(name string) (io.ReadCloser, error) {
+	return os.Open(filepath.FromSlash(name))
+}
+
+func (localBackupDirFS) Create(name string) (io.WriteCloser, error) {
+	return os.OpenFile(filepath.FromSlash(name), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+}
+
+func (localBackupDirFS) MkdirAll(name string) error {
+	return os.MkdirAll(filepath.FromSlash(name), 0700)
+}
+
+func (localBackupDirFS) Rename(oldname, newname string) error {
+	return os.Rename(filepath.FromSlash(oldname), filepath.FromSlash(newname))
+}
+
+func (localBackupDirFS) Remove(name string) error {
+	return os.Remove(filepath.FromSlash(name))
+}
+
+// sftpBackupTargetClient is a directory target on an SFTP server. Each
+// operation opens its own SSH connection, so a dropped connection only fails
+// the operation it interrupted.
+type sftpBackupTargetClient struct {
+	*dirBackupTargetClient
+	addr    string
+	config  *ssh.ClientConfig
+	hostKey string
+
+	mu       sync.Mutex
+	observed string
+}
+
+func newSFTPBackupTargetClient(input backupTargetInput) (*sftpBackupTargetClient, error) {
+	var auth []ssh.AuthMethod
+	if input.SFTPPrivateKey != "" {
+		signer, err := ssh.ParsePrivateKey([]byte(input.SFTPPrivateKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid SFTP private key: %w", err)
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if input.SFTPPassword != "" {
+		auth = append(auth, ssh.Password(input.SFTPPassword))
+	}
+	root := input.SFTPPath
+	if root == "" {
+		root = "."
+	}
+	c := &sftpBackupTargetClient{
+		addr:    net.JoinHostPort(input.SFTPHost, strconv.Itoa(backupTargetSFTPPort(input))),
+		hostKey: input.SFTPHostKey,
+	}
+	c.config = &ssh.ClientConfig{
+		User:            input.SFTPUser,
+		Auth:            auth,
+		HostKeyCallback: c.checkHostKey,
+		Timeout:         30 * time.Second,
+	}
+	c.dirBackupTargetClient = &dirBackupTargetClient{root: path.Clean(root), connect: c.connect}
+	return c, nil
+}
+
+// checkHostKey accepts the pinned host key, or any key while none is pinned
+// yet; the key seen is remembered so it can be pinned afterwards.
+func (c *sftpBackupTargetClient) checkHostKey(_ string, _ net.Addr, key ssh.PublicKey) error {
+	fingerprint := ssh.FingerprintSHA256(key)
+	if c.hostKey != "" && fingerprint != c.hostKey {
+		return fmt.Errorf("%w: server presented %s, expected %s", errSFTPHostKeyMismatch, fingerprint, c.hostKey)
+	}
+	c.mu.Lock()
+	c.observed = fingerprint
+	c.mu.Unlock()
+	return nil
+}
+
+// ObservedHostKey returns the fingerprint of the last host key accepted.
+func (c *sftpBackupTargetClient) ObservedHostKey() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.observed
+}
+
+func (c *sftpBackupTargetClient) connect(ctx context.Context) (backupDirFS, func() error, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, c.addr, c.config)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	sshClient := ssh.NewClient(sshConn, chans, reqs)
+	client, err := sftp.NewClient(sshClient)
+	if err != nil {
+		sshClient.Close()
+		return nil, nil, err
+	}
+	release := func() error {
+		err := client.Close()
+		if closeErr := sshClient.Close(); err == nil {
+			err = closeErr
+		}
+		return err
+	}
+	return sftpBackupDirFS{client: client}, release, nil
+}
+
+type sftpBackupDirFS struct {
+	client *sftp.Client
+}
+
+func (f sftpBackupDirFS) ReadDir(name string) ([]os.FileInfo, error) {
+	return f.client.ReadDir(name)
+}
+
+func (f sftpBackupDirFS) Open(name string) (io.ReadCloser, error) {
+	return f.client.Open(name)
+}
+
+func (f sftpBackupDirFS) Create(name string) (io.WriteCloser, error) {
+	return f.client.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+}
+
+func (f sftpBackupDirFS) MkdirAll(name string) error {
+	return f.client.MkdirAll(name)
+}
+
+// Rename replaces newname if it exists, like os.Rename. Plain SFTP renames
+// refuse to overwrite, so the posix-rename extension is used when offered.
+func (f sftpBackupDirFS) Rename(oldname, newname string) error {
+	if _, ok := f.client.HasExtension("posix-rename@openssh.com"); ok {
+		return f.client.PosixRename(oldname, newname)
+	}
+	if err := f.client.Remove(newname); err != nil && !errors.Is(err, iofs.ErrNotExist) {
+		return err
+	}
+	return f.client.Rename(oldname, newname)
+}
+
+func (f sftpBackupDirFS) Remove(name string) error {
+	return f.client.Remove(name)
+}
+
+func backupTargetSFTPPort(input backupTargetInput) int {
+	if input.SFTPPort == 0 {
+		return 22
+	}
+	return input.SFTPPort
+}
+
+// pinBackupTargetHostKey saves the host key an SFTP target presented on its
+// first successful use, so later connections refuse a different server.
+func (h *Handler) pinBackupTargetHostKey(ctx context.Context, target *ent.BackupTarget, client backupTargetClient) {
+	sftpClient, ok := client.(*sftpBackupTargetClient)
+	if !ok || target.Type != "sftp" || target.SftpHostKey != "" {
+		return
+	}
+	// The client may have been built from edited, unsaved settings.
+	if sftpClient.addr != net.JoinHostPort(target.SftpHost, strconv.Itoa(target.SftpPort)) {
+		return
+	}
+	fingerprint := sftpClient.ObservedHostKey()
+	if fingerprint == "" {
+		return
+	}
+	_ = h.client.BackupTarget.UpdateOneID(target.ID).
+		Where(backuptarget.Or(backuptarget.SftpHostKeyIsNil(), backuptarget.SftpHostKeyEQ(""))).
+		SetSftpHostKey(fingerprint).
+		Exec(ctx)
+}
@@ -0,0 +1,269 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"smarticky/ent/enttest"
+	"smarticky/internal/storage"
+
+	"github.com/labstack/echo/v4"
+	_ "github.com/lib-x/entsqlite"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSFTPBackupTargetSealsCredentialsAndPinsHostKey(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(dataDir, "smarticky.db")+"?_pragma=foreign_keys(1)")
+	defer client.Close()
+	fs := storage.NewFileSystem(dataDir)
+	h := NewHandler(client, fs)
+	if err := fs.WriteFile(filepath.Join(dataDir, "uploads", "a.txt"), []byte("alpha"), 0644); err != nil {
+		t.Fatalf("write upload: %v", err)
+	}
+
+	addr, fingerprint := newTestSFTPServer(t)
+	host, port, _ := net.SplitHostPort(addr)
+
+	call := func(handler echo.HandlerFunc, id int, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.Set("role", "user")
+		if id > 0 {
+			c.SetParamNames("id")
+			c.SetParamValues(fmt.Sprint(id))
+		}
+		if err := handler(c); err != nil {
+			t.Fatalf("handler returned error: %v", err)
+		}
+		return rec
+	}
+
+	rec := call(h.CreateBackupTarget, 0, fmt.Sprintf(
+		`{"name":"NAS","type":"sftp","sftp_host":%q,"sftp_port":%s,"sftp_user":"sftp-user","sftp_password":"sftp-pass","sftp_path":"backups/smarticky"}`,
+		host, port))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create target: %d %s", rec.Code, rec.Body.String())
+	}
+	if strings.Contains(rec.Body.String(), "sftp-pass") {
+		t.Fatalf("response leaks the password: %s", rec.Body.String())
+	}
+	var created BackupTargetResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("decode target: %v", err)
+	}
+	stored := client.BackupTarget.GetX(ctx, created.ID)
+	if !created.HasSFTPPassword || stored.SftpPassword == "" || stored.SftpPassword == "sftp-pass" {
+		t.Fatalf("expected the password to be stored sealed, got %q", stored.SftpPassword)
+	}
+
+	if rec := call(h.TestBackupTarget, created.ID, ""); !strings.Contains(rec.Body.String(), `"ok":true`) {
+		t.Fatalf("test target: %s", rec.Body.String())
+	}
+	if got := client.BackupTarget.GetX(ctx, created.ID).SftpHostKey; got != fingerprint {
+		t.Fatalf("pinned host key = %q, want %q", got, fingerprint)
+	}
+
+	rec = call(h.RunBackupTarget, created.ID, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("run backup: %d %s", rec.Code, rec.Body.String())
+	}
+	var run BackupRunResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &run); err != nil {
+		t.Fatalf("decode run: %v", err)
+	}
+	var list struct {
+		Backups []BackupFileInfo `json:"backups"`
+	}
+	if err := json.Unmarshal(call(h.ListBackupTargetFiles, created.ID, "").Body.Bytes(), &list); err != nil {
+		t.Fatalf("decode list: %v", err)
+	}
+	if len(list.Backups) != 1 || list.Backups[0].Filename != run.File {
+		t.Fatalf("expected the uploaded backup to be listed, got %+v", list)
+	}
+	var verification BackupVerificationResult
+	if err := json.Unmarshal(call(h.VerifyBackupTargetFile, created.ID, fmt.Sprintf(`{"filename":%q}`, run.File)).Body.Bytes(), &verification); err != nil {
+		t.Fatalf("decode verification: %v", err)
+	}
+	if !verification.Valid {
+		t.Fatalf("expected backup to verify, got %+v", verification)
+	}
+
+	if err := os.Remove(filepath.Join(dataDir, "uploads", "a.txt")); err != nil {
+		t.Fatalf("remove upload: %v", err)
+	}
+	rec = call(h.RestoreBackupTargetFile, created.ID, fmt.Sprintf(`{"filename":%q,"confirmation":"RESTORE"}`, run.File))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("restore: %d %s", rec.Code, rec.Body.String())
+	}
+	if data, err := fs.ReadFile(filepath.Join(dataDir, "uploads", "a.txt")); err != nil || string(data) != "alpha" {
+		t.Fatalf("restored upload = %q, %v", data, err)
+	}
+
+	// Tasks that encrypt their backups keep plain copies off the target.
+	client.BackupTask.Create().
+		SetName("Encrypted").
+		SetEncryptionEnabled(true).
+		SetEncryptionPassphrase("sealed").
+		AddTargetIDs(created.ID).
+		ExecX(ctx)
+	if rec := call(h.RunBackupTarget, created.ID, ""); rec.Code != http.StatusConflict {
+		t.Fatalf("run backup on an encrypted task's target: %d %s", rec.Code, rec.Body.String())
+	}
+
+	client.BackupTarget.UpdateOneID(created.ID).SetSftpHostKey("SHA256:" + strings.Repeat("A", 43)).ExecX(ctx)
+	if rec := call(h.TestBackupTarget, created.ID, ""); !strings.Contains(rec.Body.String(), "host key mismatch") {
+		t.Fatalf("expected a different host key to be refused, got %s", rec.Body.String())
+	}
+}
+
+func TestLocalBackupTarget(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "mnt", "backups")
+	client, err := newBackupTargetClient(backupTargetInput{Type: "local", LocalPath: dir})
+	if err != nil {
+		t.Fatalf("create local client: %v", err)
+	}
+	if files, err := client.List(ctx); err != nil || len(files) != 0 {
+		t.Fatalf("list missing directory = %+v, %v", files, err)
+	}
+	if err := client.Test(ctx); err != nil {
+		t.Fatalf("test local target: %v", err)
+	}
+
+	filename := "smarticky_backup_20260101_000000.tar.gz"
+	for _, body := range []string{"first", "second"} {
+		if err := client.Upload(ctx, filename, strings.NewReader(body)); err != nil {
+			t.Fatalf("upload: %v", err)
+		}
+	}
+	digest := strings.Repeat("ab", 32)
+	if err := client.Upload(ctx, backupBlobName(digest), strings.NewReader("blob")); err != nil {
+		t.Fatalf("upload blob: %v", err)
+	}
+	// Leftovers of an interrupted upload are not backups.
+	if err := os.WriteFile(filepath.Join(dir, "."+filename+".1.partial"), []byte("half"), 0600); err != nil {
+		t.Fatalf("write partial: %v", err)
+	}
+
+	files, err := client.List(ctx)
+	if err != nil || len(files) != 1 || files[0].Filename != filename {
+		t.Fatalf("list = %+v, %v", files, err)
+	}
+	body, err := client.Download(ctx, filename)
+	if err != nil {
+		t.Fatalf("download: %v", err)
+	}
+	data, _ := io.ReadAll(body)
+	body.Close()
+	if string(data) != "second" {
+		t.Fatalf("expected the second upload to replace the first, got %q", data)
+	}
+	blobs, err := client.ListBlobs(ctx)
+	if err != nil || len(blobs) != 1 || blobs[0].Digest != digest {
+		t.Fatalf("list blobs = %+v, %v", blobs, err)
+	}
+	if err := client.Delete(ctx, filename); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	h := &Handler{fs: storage.NewMemoryFileSystem()}
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(fmt.Sprintf(`{"name":"Disk","type":"local","local_path":%q}`, dir)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.Set("role", "user")
+	if err := h.CreateBackupTarget(c); err != nil {
+		t.Fatalf("create target: %v", err)
+	}
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("expected non-admins to be refused local targets, got %d", rec.Code)
+	}
+	if err := validateBackupTargetInput(backupTargetInput{Name: "Disk", Type: "local", LocalPath: "relative/dir"}, true); err == nil {
+		t.Fatal("expected a relative local path to be rejected")
+	}
+}
+
+// newTestSFTPServer serves an in-memory SFTP filesystem to sftp-user /
+// sftp-pass and returns its address and host key fingerprint.
+func newTestSFTPServer(t *testing.T) (string, string) {
+	t.Helper()
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate host key: %v", err)
+	}
+	signer, err := ssh.NewSignerFromKey(private)
+	if err != nil {
+		t.Fatalf("host key signer: %v", err)
+	}
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(meta ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if meta.User() == "sftp-user" && bytes.Equal(password, []byte("sftp-pass")) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("access denied")
+		},
+	}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	handlers := sftp.InMemHandler()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestSFTPConn(conn, config, handlers)
+		}
+	}()
+	return listener.Addr().String(), ssh.FingerprintSHA256(signer.PublicKey())
+}
+
+func serveTestSFTPConn(conn net.Conn, config *ssh.ServerConfig, handlers sftp.Handlers) {
+	defer conn.Close()
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "unsupported channel")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			for req := range requests {
+				_ = req.Reply(req.Type == "subsystem" && string(req.Payload[4:]) == "sftp", nil)
+			}
+		}()
+		server := sftp.NewRequestServer(channel, handlers)
+		_ = server.Serve()
+		server.Close()
+	}
+}
@@ -22,12 +22,18 @@ import (
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/labstack/echo/v4"
 	"github.com/studio-b12/gowebdav"
+	"golang.org/x/crypto/ssh"
 )
 
 const restoreConfirmation = "RESTORE"
 
+// s3BackupPartSize is the multipart chunk size for streamed S3 uploads; S3
+// requires at least 5 MiB for every part but the last.
+const s3BackupPartSize = 8 << 20
+
 type backupTargetPayload struct {
 	Name           *string `json:"name"`
 	Type           *string `json:"type"`
@@ -40,20 +46,44 @@ type backupTargetPayload struct {
 	S3Bucket       *string `json:"s3_bucket"`
 	S3AccessKey    *string `json:"s3_access_key"`
 	S3SecretKey    *string `json:"s3_secret_key"`
+	LocalPath      *string `json:"local_path"`
+	SFTPHost       *string `json:"sftp_host"`
+	SFTPPort       *int    `json:"sftp_port"`
+	SFTPUser       *string `json:"sftp_user"`
+	SFTPPassword   *string `json:"sftp_password"`
+	SFTPPrivateKey *string `json:"sftp_private_key"`
+	SFTPPath       *string `json:"sftp_path"`
+	SFTPHostKey    *string `json:"sftp_host_key"`
+	// AlertAfterHours raises an alert once the target has gone this long
+	// without a successful backup; 0 turns the alert off.
+	AlertAfterHours *int `json:"alert_after_hours"`
+	// DrillIntervalDays restores the latest backup as a test every N days;
+	// 0 turns drills off.
+	DrillIntervalDays *int `json:"drill_interval_days"`
 }
 
 type backupTargetInput struct {
-	Name           string
-	Type           string
-	Enabled        bool
-	WebDAVURL      string
-	WebDAVUser     string
-	WebDAVPassword string
-	S3Endpoint     string
-	S3Region       string
-	S3Bucket       string
-	S3AccessKey    string
-	S3SecretKey    string
+	Name              string
+	Type              string
+	Enabled           bool
+	WebDAVURL         string
+	WebDAVUser        string
+	WebDAVPassword    string
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKey       string
+	S3SecretKey       string
+	LocalPath         string
+	SFTPHost          string
+	SFTPPort          int
+	SFTPUser          string
+	SFTPPassword      string
+	SFTPPrivateKey    string
+	SFTPPath          string
+	SFTPHostKey       string
+	AlertAfterHours   int
+	DrillIntervalDays int
 }
 
 type BackupTargetResponse struct {
@@ -69,6 +99,16 @@ type BackupTargetResponse struct {
 	S3Bucket          string     `json:"s3_bucket,omitempty"`
 	HasS3AccessKey    bool       `json:"has_s3_access_key"`
 	HasS3SecretKey    bool       `json:"has_s3_secret_key"`
+	LocalPath         string     `json:"local_path,omitempty"`
+	SFTPHost          string     `json:"sftp_host,omitempty"`
+	SFTPPort          int        `json:"sftp_port,omitempty"`
+	SFTPUser          string     `json:"sftp_user,omitempty"`
+	HasSFTPPassword   bool       `json:"has_sftp_password"`
+	HasSFTPPrivateKey bool       `json:"has_sftp_private_key"`
+	SFTPPath          string     `json:"sftp_path,omitempty"`
+	SFTPHostKey       string     `json:"sftp_host_key,omitempty"`
+	AlertAfterHours   int        `json:"alert_after_hours"`
+	DrillIntervalDays int        `json:"drill_interval_days"`
 	LastBackupStatus  string     `json:"last_backup_status"`
 	LastBackupError   string     `json:"last_backup_error,omitempty"`
 	LastBackupAt      *time.Time `json:"last_backup_at,omitempty"`
@@ -80,38 +120,82 @@ type BackupTargetResponse struct {
 }
 
 type backupTaskPayload struct {
-	Name          *string `json:"name"`
-	Enabled       *bool   `json:"enabled"`
-	Schedule      *string `json:"schedule"`
-	RetentionDays *int    `json:"retention_days"`
-	MaxCount      *int    `json:"max_count"`
-	TargetIDs     []int   `json:"target_ids"`
+	Name                 *string `json:"name"`
+	Enabled              *bool   `json:"enabled"`
+	Schedule             *string `json:"schedule"`
+	CronExpression       *string `json:"cron_expression"`
+	Timezone             *string `json:"timezone"`
+	RetentionDays        *int    `json:"retention_days"`
+	MaxCount             *int    `json:"max_count"`
+	KeepHourly           *int    `json:"keep_hourly"`
+	KeepDaily            *int    `json:"keep_daily"`
+	KeepWeekly           *int    `json:"keep_weekly"`
+	KeepMonthly          *int    `json:"keep_monthly"`
+	KeepYearly           *int    `json:"keep_yearly"`
+	TargetIDs            []int   `json:"target_ids"`
+	EncryptionEnabled    *bool   `json:"encryption_enabled"`
+	EncryptionPassphrase *string `json:"encryption_passphrase"`
+	Incremental          *bool   `json:"incremental"`
 }
 
 type backupTaskInput struct {
-	Name          string
-	Enabled       bool
-	Schedule      string
-	RetentionDays int
-	MaxCount      int
-	TargetIDs     []int
+	Name              string
+	Enabled           bool
+	Schedule          string
+	CronExpression    string
+	Timezone          string
+	RetentionDays     int
+	MaxCount          int
+	KeepHourly        int
+	KeepDaily         int
+	KeepWeekly        int
+	KeepMonthly       int
+	KeepYearly        int
+	TargetIDs         []int
+	EncryptionEnabled bool
+	// EncryptionPassphrase is a newly submitted passphrase, still in plain text.
+	EncryptionPassphrase    string
+	HasEncryptionPassphrase bool
+	Incremental             bool
 }
 
 type BackupTaskResponse struct {
-	ID               int                    `json:"id"`
-	Name             string                 `json:"name"`
-	Enabled          bool                   `json:"enabled"`
-	Schedule         string                 `json:"schedule"`
-	RetentionDays    int                    `json:"retention_days"`
-	MaxCount         int                    `json:"max_count"`
-	TargetIDs        []int                  `json:"target_ids"`
-	Targets          []BackupTargetResponse `json:"targets"`
-	LastBackupStatus string                 `json:"last_backup_status"`
-	LastBackupError  string                 `json:"last_backup_error,omitempty"`
-	LastBackupAt     *time.Time             `json:"last_backup_at,omitempty"`
-	NextRunAt        *time.Time             `json:"next_run_at,omitempty"`
-	CreatedAt        time.Time              `json:"created_at"`
-	UpdatedAt        time.Time              `json:"updated_at"`
+	ID                      int                    `json:"id"`
+	Name                    string                 `json:"name"`
+	Enabled                 bool                   `json:"enabled"`
+	Schedule                string                 `json:"schedule"`
+	CronExpression          string                 `json:"cron_expression,omitempty"`
+	Timezone                string                 `json:"timezone,omitempty"`
+	RetentionDays           int                    `json:"retention_days"`
+	MaxCount                int                    `json:"max_count"`
+	KeepHourly              int                    `json:"keep_hourly"`
+	KeepDaily               int                    `json:"keep_daily"`
+	KeepWeekly              int                    `json:"keep_weekly"`
+	KeepMonthly             int                    `json:"keep_monthly"`
+	KeepYearly              int                    `json:"keep_yearly"`
+	TargetIDs               []int                  `json:"target_ids"`
+	Targets                 []BackupTargetResponse `json:"targets"`
+	EncryptionEnabled       bool                   `json:"encryption_enabled"`
+	HasEncryptionPassphrase bool                   `json:"has_encryption_passphrase"`
+	Incremental             bool                   `json:"incremental"`
+	LastBackupStatus        string                 `json:"last_backup_status"`
+	LastBackupError         string                 `json:"last_backup_error,omitempty"`
+	LastBackupAt            *time.Time             `json:"last_backup_at,omitempty"`
+	NextRunAt               *time.Time             `json:"next_run_at,omitempty"`
+	NextRuns                []time.Time            `json:"next_runs"`
+	CreatedAt               time.Time              `json:"created_at"`
+	UpdatedAt               time.Time              `json:"updated_at"`
+}
+
+type backupSchedulePreviewPayload struct {
+	Schedule       string `json:"schedule"`
+	CronExpression string `json:"cron_expression"`
+	Timezone       string `json:"timezone"`
+}
+
+type BackupSchedulePreviewResponse struct {
+	Timezone string      `json:"timezone"`
+	NextRuns []time.Time `json:"next_runs"`
 }
 
 type BackupConnectionTestResponse struct {
@@ -136,10 +220,12 @@ type BackupRunResponse struct {
 
 type backupTargetClient interface {
 	List(ctx context.Context) ([]BackupFileInfo, error)
-	Upload(ctx context.Context, filename string, data []byte) error
-	Download(ctx context.Context, filename string) ([]byte, error)
+	Upload(ctx context.Context, filename string, r io.Reader) error
+	Download(ctx context.Context, filename string) (io.ReadCloser, error)
 	Delete(ctx context.Context, filename string) error
 	Test(ctx context.Context) error
+	// ListBlobs lists the content-addressed blobs of incremental snapshots.
+	ListBlobs(ctx context.Context) ([]backupBlobInfo, error)
 }
 
 func (h *Handler) ListBackupTargets(c echo.Context) error {
@@ -168,10 +254,17 @@ func (h *Handler) CreateBackupTarget(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid target"})
 	}
 
-	input := targetInputFromPayload(backupTargetInput{Enabled: true}, req)
+	input := targetInputFromPayload(backupTargetInput{Enabled: true, SFTPPort: 22}, req)
 	if err := validateBackupTargetInput(input, true); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
+	if input.Type == "local" && c.Get("role") != "admin" {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Only admins can add local directory targets"})
+	}
+	input, err := h.sealBackupTargetInput(input)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to seal target credentials"})
+	}
 
 	target, err := createBackupTargetBuilder(h.client.BackupTarget.Create(), input).Save(c.Request().Context())
 	if err != nil {
@@ -200,10 +293,26 @@ func (h *Handler) UpdateBackupTarget(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid target"})
 	}
 
-	input := targetInputFromPayload(targetInputFromEnt(target), req)
+	base, err := h.targetInputFromEnt(target)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	input := targetInputFromPayload(base, req)
 	if err := validateBackupTargetInput(input, true); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
+	if (input.Type == "local" || target.Type == "local") && c.Get("role") != "admin" {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Only admins can change local directory targets"})
+	}
+	// A different server must be pinned afresh.
+	if input.SFTPHost != base.SFTPHost || input.SFTPPort != base.SFTPPort {
+		if req.SFTPHostKey == nil {
+			input.SFTPHostKey = ""
+		}
+	}
+	if input, err = h.sealBackupTargetInput(input); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to seal target credentials"})
+	}
 
 	updated, err := updateBackupTargetBuilder(target.Update(), input).Save(ctx)
 	if err != nil {
@@ -240,10 +349,13 @@ func (h *Handler) TestUnsavedBackupTarget(c echo.Context) error {
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid target"})
 	}
-	input := targetInputFromPayload(backupTargetInput{Enabled: true}, req)
+	input := targetInputFromPayload(backupTargetInput{Enabled: true, SFTPPort: 22}, req)
 	if err := validateBackupTargetInput(input, false); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
+	if input.Type == "local" && c.Get("role") != "admin" {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Only admins can test local directory targets"})
+	}
 
 	client, err := newBackupTargetClient(input)
 	if err != nil {
@@ -258,7 +370,10 @@ func (h *Handler) TestBackupTarget(c echo.Context) error {
 		return backupTargetParamError(c, err)
 	}
 
-	input := targetInputFromEnt(target)
+	input, err := h.targetInputFromEnt(target)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
 	if c.Request().ContentLength > 0 {
 		var req backupTargetPayload
 		if err := c.Bind(&req); err != nil {
@@ -269,12 +384,18 @@ func (h *Handler) TestBackupTarget(c echo.Context) error {
 	if err := validateBackupTargetInput(input, false); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
+	if input.Type == "local" && target.Type != "local" && c.Get("role") != "admin" {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Only admins can test local directory targets"})
+	}
 	client, err := newBackupTargetClient(input)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
 	testErr := client.Test(c.Request().Context())
+	if testErr == nil {
+		h.pinBackupTargetHostKey(c.Request().Context(), target, client)
+	}
 	if updateErr := h.updateTargetTestStatus(c.Request().Context(), target.ID, testErr); updateErr != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to update test status"})
 	}
@@ -314,16 +435,33 @@ func (h *Handler) CreateBackupTask(c echo.Context) error {
 	if err := h.validateBackupTaskInput(c.Request().Context(), input); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
-	if err := h.validateBackupTaskScheduler(input); err != nil {
+	if err := h.validateBackupTaskScheduler(input); errors.Is(err, errBackupSchedulerUnavailable) {
 		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": err.Error()})
+	} else if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	sealedPassphrase, err := h.sealBackupPassphrase(input.EncryptionPassphrase)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to store encryption passphrase"})
 	}
 
 	task, err := h.client.BackupTask.Create().
 		SetName(input.Name).
 		SetEnabled(input.Enabled).
 		SetSchedule(input.Schedule).
+		SetCronExpression(input.CronExpression).
+		SetTimezone(input.Timezone).
 		SetRetentionDays(input.RetentionDays).
 		SetMaxCount(input.MaxCount).
+		SetKeepHourly(input.KeepHourly).
+		SetKeepDaily(input.KeepDaily).
+		SetKeepWeekly(input.KeepWeekly).
+		SetKeepMonthly(input.KeepMonthly).
+		SetKeepYearly(input.KeepYearly).
+		SetEncryptionEnabled(input.EncryptionEnabled).
+		SetEncryptionPassphrase(sealedPassphrase).
+		SetIncremental(input.Incremental).
 		AddTargetIDs(input.TargetIDs...).
 		Save(c.Request().Context())
 	if err != nil {
@@ -362,19 +500,37 @@ func (h *Handler) UpdateBackupTask(c echo.Context) error {
 	if err := h.validateBackupTaskInput(ctx, input); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
-	if err := h.validateBackupTaskScheduler(input); err != nil {
+	if err := h.validateBackupTaskScheduler(input); errors.Is(err, errBackupSchedulerUnavailable) {
 		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": err.Error()})
+	} else if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
-	if err := task.Update().
+	update := task.Update().
 		SetName(input.Name).
 		SetEnabled(input.Enabled).
 		SetSchedule(input.Schedule).
+		SetCronExpression(input.CronExpression).
+		SetTimezone(input.Timezone).
 		SetRetentionDays(input.RetentionDays).
 		SetMaxCount(input.MaxCount).
+		SetKeepHourly(input.KeepHourly).
+		SetKeepDaily(input.KeepDaily).
+		SetKeepWeekly(input.KeepWeekly).
+		SetKeepMonthly(input.KeepMonthly).
+		SetKeepYearly(input.KeepYearly).
+		SetEncryptionEnabled(input.EncryptionEnabled).
+		SetIncremental(input.Incremental).
 		ClearTargets().
-		AddTargetIDs(input.TargetIDs...).
-		Exec(ctx); err != nil {
+		AddTargetIDs(input.TargetIDs...)
+	if input.EncryptionPassphrase != "" {
+		sealedPassphrase, err := h.sealBackupPassphrase(input.EncryptionPassphrase)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to store encryption passphrase"})
+		}
+		update.SetEncryptionPassphrase(sealedPassphrase)
+	}
+	if err := update.Exec(ctx); err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to update backup task"})
 	}
 
@@ -404,6 +560,24 @@ func (h *Handler) DeleteBackupTask(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+// PreviewBackupSchedule returns the next runs of an unsaved schedule so the
+// task form can show when it would fire.
+func (h *Handler) PreviewBackupSchedule(c echo.Context) error {
+	var req backupSchedulePreviewPayload
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid schedule"})
+	}
+	schedule := normalizeBackupSchedule(req.Schedule)
+	expr := strings.Join(strings.Fields(req.CronExpression), " ")
+	timezone := strings.TrimSpace(req.Timezone)
+	runs, err := backupScheduleRunsFor(schedule, expr, timezone, time.Now())
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	loc, _ := loadBackupTimezone(timezone, time.Local)
+	return c.JSON(http.StatusOK, BackupSchedulePreviewResponse{Timezone: loc.String(), NextRuns: runs})
+}
+
 func (h *Handler) RunBackupTask(c echo.Context) error {
 	id, err := intParam(c, "id")
 	if err != nil {
@@ -433,28 +607,51 @@ func (h *Handler) RunBackupTarget(c echo.Context) error {
 	if err != nil {
 		return backupTargetParamError(c, err)
 	}
-	input := targetInputFromEnt(target)
+	input, err := h.targetInputFromEnt(target)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
 	if err := validateBackupTargetInput(input, false); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
-	if err := h.checkpointWAL(); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to prepare database for backup"})
-	}
-	archive, err := h.createBackupArchive()
+	// A run outside any task has no passphrase, so it would put a plain copy
+	// next to the encrypted ones.
+	encrypted, err := h.client.BackupTask.Query().
+		Where(backuptask.HasTargetsWith(backuptarget.ID(target.ID)), backuptask.EncryptionEnabled(true)).
+		Exist(c.Request().Context())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create backup"})
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to check backup target usage"})
 	}
-
-	filename := fmt.Sprintf("smarticky_backup_%s.tar.gz", time.Now().Format("20060102_150405"))
+	if encrypted {
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Backup target is used by encrypted backup tasks; run one of those tasks instead"})
+	}
+	started := time.Now()
+	filename := fmt.Sprintf("smarticky_backup_%s.tar.gz", started.Format("20060102_150405"))
 	client, err := newBackupTargetClient(input)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
-	uploadErr := client.Upload(c.Request().Context(), filename, archive.Bytes())
+	counter := &countingBackupTargetClient{backupTargetClient: client}
+	uploadErrs, err := h.uploadBackupArchive(c.Request().Context(), filename, "", []backupTargetClient{counter})
+	if err != nil {
+		_ = h.updateTargetBackupStatus(c.Request().Context(), target.ID, err)
+		h.recordBackupRun(c.Request().Context(), backupRunRecord{Target: target, Trigger: "manual", StartedAt: started, Err: err})
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create backup"})
+	}
+	uploadErr := uploadErrs[0]
 	_ = h.updateTargetBackupStatus(c.Request().Context(), target.ID, uploadErr)
+	h.recordBackupRun(c.Request().Context(), backupRunRecord{
+		Target:    target,
+		Trigger:   "manual",
+		Filename:  filename,
+		Bytes:     counter.bytes.Load(),
+		StartedAt: started,
+		Err:       uploadErr,
+	})
 	if uploadErr != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Backup upload failed"})
 	}
+	h.pinBackupTargetHostKey(c.Request().Context(), target, client)
 	return c.JSON(http.StatusOK, BackupRunResponse{
 		Message: "backup successful",
 		File:    filename,
@@ -472,7 +669,7 @@ func (h *Handler) ListBackupTargetFiles(c echo.Context) error {
 	if err != nil {
 		return backupTargetParamError(c, err)
 	}
-	client, err := newBackupTargetClient(targetInputFromEnt(target))
+	client, err := h.backupTargetClient(target)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
@@ -480,6 +677,11 @@ func (h *Handler) ListBackupTargetFiles(c echo.Context) error {
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to list backup files"})
 	}
+	pins, err := h.backupTargetPins(c.Request().Context(), target.ID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to load pinned backups"})
+	}
+	markPinnedBackupFiles(files, pins)
 	return c.JSON(http.StatusOK, map[string]interface{}{"backups": files})
 }
 
@@ -490,7 +692,8 @@ func (h *Handler) VerifyBackupTargetFile(c echo.Context) error {
 	}
 
 	var req struct {
-		Filename string `json:"filename"`
+		Filename   string `json:"filename"`
+		Passphrase string `json:"passphrase"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
@@ -499,15 +702,18 @@ func (h *Handler) VerifyBackupTargetFile(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
-	client, err := newBackupTargetClient(targetInputFromEnt(target))
+	client, err := h.backupTargetClient(target)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
-	data, err := client.Download(c.Request().Context(), req.Filename)
+	body, err := client.Download(c.Request().Context(), req.Filename)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to download backup"})
 	}
-	return c.JSON(http.StatusOK, h.verifyBackupData(data))
+	defer body.Close()
+	result := h.verifyBackupData(body, req.Passphrase)
+	verifyBackupSnapshotBlobs(c.Request().Context(), client, &result)
+	return c.JSON(http.StatusOK, result)
 }
 
 func (h *Handler) RestoreBackupTargetFile(c echo.Context) error {
@@ -519,6 +725,7 @@ func (h *Handler) RestoreBackupTargetFile(c echo.Context) error {
 	var req struct {
 		Filename     string `json:"filename"`
 		Confirmation string `json:"confirmation"`
+		Passphrase   string `json:"passphrase"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
@@ -530,18 +737,21 @@ func (h *Handler) RestoreBackupTargetFile(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
-	client, err := newBackupTargetClient(targetInputFromEnt(target))
+	client, err := h.backupTargetClient(target)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
-	data, err := client.Download(c.Request().Context(), req.Filename)
+	backupPath, cleanup, err := h.downloadBackupToTemp(c.Request().Context(), client, req.Filename)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to download backup"})
 	}
-	if verification := h.verifyBackupData(data); !verification.Valid {
+	defer cleanup()
+	verification := h.verifyBackupFile(backupPath, req.Passphrase)
+	verifyBackupSnapshotBlobs(c.Request().Context(), client, &verification)
+	if !verification.Valid {
 		return c.JSON(http.StatusBadRequest, verification)
 	}
-	if err := h.restoreVerifiedBackupData(data); err != nil {
+	if err := h.restoreBackupFile(c.Request().Context(), client, backupPath, req.Passphrase); err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
@@ -579,60 +789,113 @@ func (h *Handler) performScheduledBackups() {
 }
 
 func (h *Handler) runBackupTask(ctx context.Context, task *ent.BackupTask, automatic bool) (BackupRunResponse, error) {
+	now := time.Now()
+	trigger := backupRunTrigger(automatic)
 	targets := task.Edges.Targets
 	if len(targets) == 0 {
 		err := errors.New("backup task has no targets")
 		_ = h.updateTaskBackupStatus(ctx, task.ID, err)
+		h.recordBackupRun(ctx, backupRunRecord{Task: task, Trigger: trigger, StartedAt: now, Err: err})
 		return BackupRunResponse{Message: err.Error()}, err
 	}
-	if err := h.checkpointWAL(); err != nil {
-		err = fmt.Errorf("failed to prepare database for backup")
-		_ = h.updateTaskBackupStatus(ctx, task.ID, err)
-		return BackupRunResponse{Message: err.Error()}, err
-	}
-	archive, err := h.createBackupArchive()
+
+	passphrase, err := h.backupTaskPassphrase(task)
 	if err != nil {
-		err = fmt.Errorf("failed to create backup archive")
 		_ = h.updateTaskBackupStatus(ctx, task.ID, err)
+		h.recordBackupRun(ctx, backupRunRecord{Task: task, Trigger: trigger, StartedAt: now, Err: err})
 		return BackupRunResponse{Message: err.Error()}, err
 	}
 
-	now := time.Now()
 	filename := backupTaskFilename(task.ID, automatic, now)
+	if task.Incremental {
+		filename = backupSnapshotFilename(filename)
+	}
+	if passphrase != "" {
+		filename += backupEncryptedSuffix
+	}
 	cleanupPrefixes := backupTaskFilenamePrefixes(task.ID)
 	response := BackupRunResponse{Message: "backup completed", File: filename}
-	successes := 0
-	for _, target := range targets {
-		item := BackupTargetRunResult{
+
+	// Every enabled target receives the same archive stream concurrently.
+	results := make([]BackupTargetRunResult, len(targets))
+	clients := make([]backupTargetClient, 0, len(targets))
+	uploads := make([]backupTargetClient, 0, len(targets))
+	counters := make([]*countingBackupTargetClient, 0, len(targets))
+	clientIndexes := make([]int, 0, len(targets))
+	for index, target := range targets {
+		results[index] = BackupTargetRunResult{
 			TargetID: target.ID,
 			Name:     target.Name,
 			Type:     target.Type,
 		}
 		if !target.Enabled {
-			item.Error = "target disabled"
-			response.Results = append(response.Results, item)
-			_ = h.updateTargetBackupStatus(ctx, target.ID, errors.New(item.Error))
+			results[index].Error = "target disabled"
+			_ = h.updateTargetBackupStatus(ctx, target.ID, errors.New(results[index].Error))
 			continue
 		}
-		client, err := newBackupTargetClient(targetInputFromEnt(target))
-		if err == nil {
-			err = client.Upload(ctx, filename, archive.Bytes())
-		}
+		client, err := h.backupTargetClient(target)
 		if err != nil {
-			item.Error = "backup upload failed"
+			results[index].Error = "backup upload failed"
+			_ = h.updateTargetBackupStatus(ctx, target.ID, err)
+			h.recordBackupRun(ctx, backupRunRecord{Task: task, Target: target, Trigger: trigger, StartedAt: now, Err: err})
+			continue
+		}
+		counter := &countingBackupTargetClient{backupTargetClient: client}
+		clients = append(clients, client)
+		uploads = append(uploads, counter)
+		counters = append(counters, counter)
+		clientIndexes = append(clientIndexes, index)
+	}
+
+	var uploadErrs []error
+	var archiveErr error
+	if task.Incremental {
+		uploadErrs, archiveErr = h.uploadBackupSnapshot(ctx, filename, passphrase, uploads)
+	} else {
+		uploadErrs, archiveErr = h.uploadBackupArchive(ctx, filename, passphrase, uploads)
+	}
+	if archiveErr != nil {
+		err := fmt.Errorf("failed to create backup archive")
+		_ = h.updateTaskBackupStatus(ctx, task.ID, err)
+		for _, index := range clientIndexes {
+			h.recordBackupRun(ctx, backupRunRecord{Task: task, Target: targets[index], Trigger: trigger, StartedAt: now, Err: archiveErr})
+		}
+		return BackupRunResponse{Message: err.Error()}, err
+	}
+
+	successes := 0
+	for position, index := range clientIndexes {
+		target := targets[index]
+		run := backupRunRecord{
+			Task:      task,
+			Target:    target,
+			Trigger:   trigger,
+			Filename:  filename,
+			Bytes:     counters[position].bytes.Load(),
+			StartedAt: now,
+			Err:       uploadErrs[position],
+		}
+		h.recordBackupRun(ctx, run)
+		if err := uploadErrs[position]; err != nil {
+			results[index].Error = "backup upload failed"
 			_ = h.updateTargetBackupStatus(ctx, target.ID, err)
-			response.Results = append(response.Results, item)
 			continue
 		}
 
-		item.OK = true
+		results[index].OK = true
 		successes++
 		_ = h.updateTargetBackupStatus(ctx, target.ID, nil)
-		if cleanupErr := cleanupTargetBackups(ctx, client, task.RetentionDays, task.MaxCount, cleanupPrefixes...); cleanupErr != nil {
+		h.pinBackupTargetHostKey(ctx, target, clients[position])
+		pins, err := h.backupTargetPins(ctx, target.ID)
+		if err != nil {
+			fmt.Printf("Skipping cleanup for target %d: failed to load pinned backups: %v\n", target.ID, err)
+			continue
+		}
+		if cleanupErr := cleanupTargetBackups(ctx, clients[position], backupTaskRetentionPolicy(task), pinnedFilenames(pins), h.backupFilePassphrase, cleanupPrefixes...); cleanupErr != nil {
 			fmt.Printf("Failed to cleanup old backups for target %d: %v\n", target.ID, cleanupErr)
 		}
-		response.Results = append(response.Results, item)
 	}
+	response.Results = results
 
 	var statusErr error
 	if successes == 0 {
@@ -645,21 +908,39 @@ func (h *Handler) runBackupTask(ctx context.Context, task *ent.BackupTask, autom
 	return response, statusErr
 }
 
-func (h *Handler) restoreVerifiedBackupData(data []byte) error {
+// restoreBackupFile saves a pre-restore backup of the current data and then
+// extracts the archive at backupPath over the data directory. Snapshot
+// attachments are downloaded from client before anything is overwritten.
+func (h *Handler) restoreBackupFile(ctx context.Context, client backupTargetClient, backupPath string, passphrase string) error {
+	manifest, err := h.readBackupSnapshotFile(backupPath, passphrase)
+	if err != nil {
+		return fmt.Errorf("failed to read backup: %w", err)
+	}
+	var stageDir string
+	if manifest != nil {
+		dir, cleanup, err := h.stageBackupSnapshotBlobs(ctx, client, manifest, passphrase)
+		if err != nil {
+			return fmt.Errorf("failed to download snapshot attachments: %w", err)
+		}
+		defer cleanup()
+		stageDir = dir
+	}
+
 	if err := h.checkpointWAL(); err != nil {
 		return fmt.Errorf("failed to prepare database for pre-restore backup: %w", err)
 	}
-	backupArchive, err := h.createBackupArchive()
-	if err != nil {
-		return fmt.Errorf("failed to create pre-restore backup: %w", err)
-	}
 	backupFilename := fmt.Sprintf("smarticky_pre_restore_backup_%s.tar.gz", time.Now().Format("20060102_150405"))
-	if err := h.fs.WriteFile(filepath.Join(h.fs.GetDataDir(), backupFilename), backupArchive.Bytes(), 0644); err != nil {
+	if err := h.writeBackupArchiveFile(filepath.Join(h.fs.GetDataDir(), backupFilename)); err != nil {
 		return fmt.Errorf("failed to save pre-restore backup: %w", err)
 	}
-	if err := h.extractBackupArchive(data); err != nil {
+	if err := h.extractBackupFile(backupPath, passphrase); err != nil {
 		return fmt.Errorf("failed to extract backup: %w", err)
 	}
+	if manifest != nil {
+		if err := h.placeBackupSnapshotBlobs(stageDir, manifest); err != nil {
+			return err
+		}
+	}
 	if err := h.removeDatabaseSidecars(); err != nil {
 		return err
 	}
@@ -753,30 +1034,38 @@ func (h *Handler) ensureBackupTargetsMigrated(ctx context.Context) error {
 func legacyBackupTargetInputs(config *ent.BackupConfig) []backupTargetInput {
 	inputs := make([]backupTargetInput, 0, 2)
 	if strings.TrimSpace(config.WebdavURL) != "" {
-		inputs = append(inputs, backupTargetInput{
-			Name:           "WebDAV",
-			Type:           "webdav",
-			Enabled:        true,
-			WebDAVURL:      config.WebdavURL,
-			WebDAVUser:     config.WebdavUser,
-			WebDAVPassword: config.WebdavPassword,
-		})
+		inputs = append(inputs, legacyWebDAVTargetInput(config))
 	}
 	if strings.TrimSpace(config.S3Endpoint) != "" && strings.TrimSpace(config.S3Bucket) != "" {
-		inputs = append(inputs, backupTargetInput{
-			Name:        "S3",
-			Type:        "s3",
-			Enabled:     true,
-			S3Endpoint:  config.S3Endpoint,
-			S3Region:    config.S3Region,
-			S3Bucket:    config.S3Bucket,
-			S3AccessKey: config.S3AccessKey,
-			S3SecretKey: config.S3SecretKey,
-		})
+		inputs = append(inputs, legacyS3TargetInput(config))
 	}
 	return inputs
 }
 
+func legacyWebDAVTargetInput(config *ent.BackupConfig) backupTargetInput {
+	return backupTargetInput{
+		Name:           "WebDAV",
+		Type:           "webdav",
+		Enabled:        true,
+		WebDAVURL:      config.WebdavURL,
+		WebDAVUser:     config.WebdavUser,
+		WebDAVPassword: config.WebdavPassword,
+	}
+}
+
+func legacyS3TargetInput(config *ent.BackupConfig) backupTargetInput {
+	return backupTargetInput{
+		Name:        "S3",
+		Type:        "s3",
+		Enabled:     true,
+		S3Endpoint:  config.S3Endpoint,
+		S3Region:    config.S3Region,
+		S3Bucket:    config.S3Bucket,
+		S3AccessKey: config.S3AccessKey,
+		S3SecretKey: config.S3SecretKey,
+	}
+}
+
 func findLegacyBackupTarget(targets []*ent.BackupTarget, input backupTargetInput) *ent.BackupTarget {
 	for _, target := range targets {
 		if target.Type != input.Type {
@@ -850,29 +1139,120 @@ func targetInputFromPayload(base backupTargetInput, req backupTargetPayload) bac
 	if req.S3SecretKey != nil {
 		base.S3SecretKey = *req.S3SecretKey
 	}
+	if req.LocalPath != nil {
+		base.LocalPath = strings.TrimSpace(*req.LocalPath)
+	}
+	if req.SFTPHost != nil {
+		base.SFTPHost = strings.TrimSpace(*req.SFTPHost)
+	}
+	if req.SFTPPort != nil {
+		base.SFTPPort = *req.SFTPPort
+	}
+	if req.SFTPUser != nil {
+		base.SFTPUser = strings.TrimSpace(*req.SFTPUser)
+	}
+	if req.SFTPPassword != nil {
+		base.SFTPPassword = *req.SFTPPassword
+	}
+	if req.SFTPPrivateKey != nil {
+		base.SFTPPrivateKey = strings.TrimSpace(*req.SFTPPrivateKey)
+	}
+	if req.SFTPPath != nil {
+		base.SFTPPath = strings.TrimSpace(*req.SFTPPath)
+	}
+	if req.SFTPHostKey != nil {
+		base.SFTPHostKey = strings.TrimSpace(*req.SFTPHostKey)
+	}
+	if req.AlertAfterHours != nil {
+		base.AlertAfterHours = *req.AlertAfterHours
+	}
+	if req.DrillIntervalDays != nil {
+		base.DrillIntervalDays = *req.DrillIntervalDays
+	}
 	return base
 }
 
-func targetInputFromEnt(target *ent.BackupTarget) backupTargetInput {
+// targetInputFromEnt loads a saved target, unsealing its SFTP credentials.
+func (h *Handler) targetInputFromEnt(target *ent.BackupTarget) (backupTargetInput, error) {
+	password, err := h.openBackupSecret(target.SftpPassword)
+	if err != nil {
+		return backupTargetInput{}, fmt.Errorf("failed to unseal SFTP password: %w", err)
+	}
+	privateKey, err := h.openBackupSecret(target.SftpPrivateKey)
+	if err != nil {
+		return backupTargetInput{}, fmt.Errorf("failed to unseal SFTP private key: %w", err)
+	}
 	return backupTargetInput{
-		Name:           target.Name,
-		Type:           target.Type,
-		Enabled:        target.Enabled,
-		WebDAVURL:      target.WebdavURL,
-		WebDAVUser:     target.WebdavUser,
-		WebDAVPassword: target.WebdavPassword,
-		S3Endpoint:     target.S3Endpoint,
-		S3Region:       target.S3Region,
-		S3Bucket:       target.S3Bucket,
-		S3AccessKey:    target.S3AccessKey,
-		S3SecretKey:    target.S3SecretKey,
+		Name:              target.Name,
+		Type:              target.Type,
+		Enabled:           target.Enabled,
+		WebDAVURL:         target.WebdavURL,
+		WebDAVUser:        target.WebdavUser,
+		WebDAVPassword:    target.WebdavPassword,
+		S3Endpoint:        target.S3Endpoint,
+		S3Region:          target.S3Region,
+		S3Bucket:          target.S3Bucket,
+		S3AccessKey:       target.S3AccessKey,
+		S3SecretKey:       target.S3SecretKey,
+		LocalPath:         target.LocalPath,
+		SFTPHost:          target.SftpHost,
+		SFTPPort:          target.SftpPort,
+		SFTPUser:          target.SftpUser,
+		SFTPPassword:      password,
+		SFTPPrivateKey:    privateKey,
+		SFTPPath:          target.SftpPath,
+		SFTPHostKey:       target.SftpHostKey,
+		AlertAfterHours:   target.AlertAfterHours,
+		DrillIntervalDays: target.DrillIntervalDays,
+	}, nil
+}
+
+// backupTargetClient returns a client for a saved target.
+func (h *Handler) backupTargetClient(target *ent.BackupTarget) (backupTargetClient, error) {
+	input, err := h.targetInputFromEnt(target)
+	if err != nil {
+		return nil, err
+	}
+	return newBackupTargetClient(input)
+}
+
+// sealBackupTargetInput seals the SFTP credentials of input for storage.
+func (h *Handler) sealBackupTargetInput(input backupTargetInput) (backupTargetInput, error) {
+	var err error
+	if input.SFTPPassword, err = h.sealBackupSecret(input.SFTPPassword); err != nil {
+		return input, err
 	}
+	if input.SFTPPrivateKey, err = h.sealBackupSecret(input.SFTPPrivateKey); err != nil {
+		return input, err
+	}
+	return input, nil
+}
+
+func (h *Handler) sealBackupSecret(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	return h.secrets.Seal([]byte(value))
+}
+
+func (h *Handler) openBackupSecret(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	plain, err := h.secrets.Open(value)
+	return string(plain), err
 }
 
 func validateBackupTargetInput(input backupTargetInput, requireName bool) error {
 	if requireName && strings.TrimSpace(input.Name) == "" {
 		return errors.New("target name is required")
 	}
+	if input.AlertAfterHours < 0 {
+		return errors.New("alert window cannot be negative")
+	}
+	if input.DrillIntervalDays < 0 {
+		return errors.New("restore drill interval cannot be negative")
+	}
 	switch input.Type {
 	case "webdav":
 		if strings.TrimSpace(input.WebDAVURL) == "" {
@@ -885,8 +1265,30 @@ func validateBackupTargetInput(input backupTargetInput, requireName bool) error
 			strings.TrimSpace(input.S3SecretKey) == "" {
 			return errors.New("S3 configuration is incomplete")
 		}
+	case "local":
+		if input.LocalPath == "" || !filepath.IsAbs(input.LocalPath) {
+			return errors.New("local directory must be an absolute path")
+		}
+	case "sftp":
+		if input.SFTPHost == "" || input.SFTPUser == "" {
+			return errors.New("SFTP host and user are required")
+		}
+		if input.SFTPPort < 1 || input.SFTPPort > 65535 {
+			return errors.New("SFTP port must be between 1 and 65535")
+		}
+		if input.SFTPPassword == "" && input.SFTPPrivateKey == "" {
+			return errors.New("SFTP password or private key is required")
+		}
+		if input.SFTPPrivateKey != "" {
+			if _, err := ssh.ParsePrivateKey([]byte(input.SFTPPrivateKey)); err != nil {
+				return fmt.Errorf("invalid SFTP private key: %w", err)
+			}
+		}
+		if input.SFTPHostKey != "" && !strings.HasPrefix(input.SFTPHostKey, "SHA256:") {
+			return errors.New("SFTP host key must be a SHA256 fingerprint")
+		}
 	default:
-		return errors.New("target type must be webdav or s3")
+		return errors.New("target type must be webdav, s3, local or sftp")
 	}
 	return nil
 }
@@ -903,7 +1305,17 @@ func createBackupTargetBuilder(builder *ent.BackupTargetCreate, input backupTarg
 		SetS3Region(input.S3Region).
 		SetS3Bucket(input.S3Bucket).
 		SetS3AccessKey(input.S3AccessKey).
-		SetS3SecretKey(input.S3SecretKey)
+		SetS3SecretKey(input.S3SecretKey).
+		SetLocalPath(input.LocalPath).
+		SetSftpHost(input.SFTPHost).
+		SetSftpPort(backupTargetSFTPPort(input)).
+		SetSftpUser(input.SFTPUser).
+		SetSftpPassword(input.SFTPPassword).
+		SetSftpPrivateKey(input.SFTPPrivateKey).
+		SetSftpPath(input.SFTPPath).
+		SetSftpHostKey(input.SFTPHostKey).
+		SetAlertAfterHours(input.AlertAfterHours).
+		SetDrillIntervalDays(input.DrillIntervalDays)
 }
 
 func updateBackupTargetBuilder(builder *ent.BackupTargetUpdateOne, input backupTargetInput) *ent.BackupTargetUpdateOne {
@@ -918,7 +1330,17 @@ func updateBackupTargetBuilder(builder *ent.BackupTargetUpdateOne, input backupT
 		SetS3Region(input.S3Region).
 		SetS3Bucket(input.S3Bucket).
 		SetS3AccessKey(input.S3AccessKey).
-		SetS3SecretKey(input.S3SecretKey)
+		SetS3SecretKey(input.S3SecretKey).
+		SetLocalPath(input.LocalPath).
+		SetSftpHost(input.SFTPHost).
+		SetSftpPort(backupTargetSFTPPort(input)).
+		SetSftpUser(input.SFTPUser).
+		SetSftpPassword(input.SFTPPassword).
+		SetSftpPrivateKey(input.SFTPPrivateKey).
+		SetSftpPath(input.SFTPPath).
+		SetSftpHostKey(input.SFTPHostKey).
+		SetAlertAfterHours(input.AlertAfterHours).
+		SetDrillIntervalDays(input.DrillIntervalDays)
 }
 
 func backupTargetResponse(target *ent.BackupTarget) BackupTargetResponse {
@@ -935,6 +1357,16 @@ func backupTargetResponse(target *ent.BackupTarget) BackupTargetResponse {
 		S3Bucket:          target.S3Bucket,
 		HasS3AccessKey:    target.S3AccessKey != "",
 		HasS3SecretKey:    target.S3SecretKey != "",
+		LocalPath:         target.LocalPath,
+		SFTPHost:          target.SftpHost,
+		SFTPPort:          target.SftpPort,
+		SFTPUser:          target.SftpUser,
+		HasSFTPPassword:   target.SftpPassword != "",
+		HasSFTPPrivateKey: target.SftpPrivateKey != "",
+		SFTPPath:          target.SftpPath,
+		SFTPHostKey:       target.SftpHostKey,
+		AlertAfterHours:   target.AlertAfterHours,
+		DrillIntervalDays: target.DrillIntervalDays,
 		LastBackupStatus:  target.LastBackupStatus,
 		LastBackupError:   target.LastBackupError,
 		LastBackupAt:      optionalTime(target.LastBackupAt),
@@ -956,15 +1388,46 @@ func taskInputFromPayload(base backupTaskInput, req backupTaskPayload) backupTas
 	if req.Schedule != nil {
 		base.Schedule = normalizeBackupSchedule(*req.Schedule)
 	}
+	if req.CronExpression != nil {
+		base.CronExpression = strings.Join(strings.Fields(*req.CronExpression), " ")
+	}
+	if req.Timezone != nil {
+		base.Timezone = strings.TrimSpace(*req.Timezone)
+	}
 	if req.RetentionDays != nil {
 		base.RetentionDays = *req.RetentionDays
 	}
 	if req.MaxCount != nil {
 		base.MaxCount = *req.MaxCount
 	}
+	if req.KeepHourly != nil {
+		base.KeepHourly = *req.KeepHourly
+	}
+	if req.KeepDaily != nil {
+		base.KeepDaily = *req.KeepDaily
+	}
+	if req.KeepWeekly != nil {
+		base.KeepWeekly = *req.KeepWeekly
+	}
+	if req.KeepMonthly != nil {
+		base.KeepMonthly = *req.KeepMonthly
+	}
+	if req.KeepYearly != nil {
+		base.KeepYearly = *req.KeepYearly
+	}
 	if req.TargetIDs != nil {
 		base.TargetIDs = uniquePositiveInts(req.TargetIDs)
 	}
+	if req.EncryptionEnabled != nil {
+		base.EncryptionEnabled = *req.EncryptionEnabled
+	}
+	if req.EncryptionPassphrase != nil && *req.EncryptionPassphrase != "" {
+		base.EncryptionPassphrase = *req.EncryptionPassphrase
+		base.HasEncryptionPassphrase = true
+	}
+	if req.Incremental != nil {
+		base.Incremental = *req.Incremental
+	}
 	return base
 }
 
@@ -974,12 +1437,22 @@ func taskInputFromEnt(task *ent.BackupTask) backupTaskInput {
 		ids = append(ids, target.ID)
 	}
 	return backupTaskInput{
-		Name:          task.Name,
-		Enabled:       task.Enabled,
-		Schedule:      task.Schedule,
-		RetentionDays: task.RetentionDays,
-		MaxCount:      task.MaxCount,
-		TargetIDs:     ids,
+		Name:                    task.Name,
+		Enabled:                 task.Enabled,
+		Schedule:                task.Schedule,
+		CronExpression:          task.CronExpression,
+		Timezone:                task.Timezone,
+		RetentionDays:           task.RetentionDays,
+		MaxCount:                task.MaxCount,
+		KeepHourly:              task.KeepHourly,
+		KeepDaily:               task.KeepDaily,
+		KeepWeekly:              task.KeepWeekly,
+		KeepMonthly:             task.KeepMonthly,
+		KeepYearly:              task.KeepYearly,
+		TargetIDs:               ids,
+		EncryptionEnabled:       task.EncryptionEnabled,
+		HasEncryptionPassphrase: task.EncryptionPassphrase != "",
+		Incremental:             task.Incremental,
 	}
 }
 
@@ -988,10 +1461,18 @@ func (h *Handler) validateBackupTaskInput(ctx context.Context, input backupTaskI
 		return errors.New("task name is required")
 	}
 	if !validBackupSchedule(input.Schedule) {
-		return errors.New("schedule must be manual, daily, weekly, or monthly")
+		return errors.New("schedule must be manual, daily, weekly, monthly, or cron")
+	}
+	if err := validateBackupRetention(input); err != nil {
+		return err
 	}
-	if input.RetentionDays < 0 || input.MaxCount < 0 {
-		return errors.New("retention values must be non-negative")
+	if input.EncryptionPassphrase != "" {
+		if err := validateBackupPassphrase(input.EncryptionPassphrase); err != nil {
+			return err
+		}
+	}
+	if input.EncryptionEnabled && !input.HasEncryptionPassphrase {
+		return errors.New("an encryption passphrase is required")
 	}
 	if len(input.TargetIDs) == 0 {
 		return errors.New("select at least one backup target")
@@ -1006,7 +1487,21 @@ func (h *Handler) validateBackupTaskInput(ctx context.Context, input backupTaskI
 	return nil
 }
 
+func validateBackupRetention(input backupTaskInput) error {
+	for _, value := range []int{input.RetentionDays, input.MaxCount, input.KeepHourly, input.KeepDaily, input.KeepWeekly, input.KeepMonthly, input.KeepYearly} {
+		if value < 0 {
+			return errors.New("retention values must be non-negative")
+		}
+	}
+	return nil
+}
+
+// validateBackupTaskScheduler checks that the schedule can be evaluated and
+// that a scheduler is running to fire it.
 func (h *Handler) validateBackupTaskScheduler(input backupTaskInput) error {
+	if _, err := backupScheduleRunsFor(input.Schedule, input.CronExpression, input.Timezone, time.Now()); err != nil {
+		return err
+	}
 	if input.Enabled && input.Schedule != "manual" && h.backupScheduler == nil {
 		return errBackupSchedulerUnavailable
 	}
@@ -1022,20 +1517,31 @@ func (h *Handler) backupTaskResponse(task *ent.BackupTask) BackupTaskResponse {
 	}
 	sort.Ints(targetIDs)
 	return BackupTaskResponse{
-		ID:               task.ID,
-		Name:             task.Name,
-		Enabled:          task.Enabled,
-		Schedule:         task.Schedule,
-		RetentionDays:    task.RetentionDays,
-		MaxCount:         task.MaxCount,
-		TargetIDs:        targetIDs,
-		Targets:          targets,
-		LastBackupStatus: task.LastBackupStatus,
-		LastBackupError:  task.LastBackupError,
-		LastBackupAt:     optionalTime(task.LastBackupAt),
-		NextRunAt:        h.backupTaskNextRunAt(task),
-		CreatedAt:        task.CreatedAt,
-		UpdatedAt:        task.UpdatedAt,
+		ID:                      task.ID,
+		Name:                    task.Name,
+		Enabled:                 task.Enabled,
+		Schedule:                task.Schedule,
+		CronExpression:          task.CronExpression,
+		Timezone:                task.Timezone,
+		RetentionDays:           task.RetentionDays,
+		MaxCount:                task.MaxCount,
+		KeepHourly:              task.KeepHourly,
+		KeepDaily:               task.KeepDaily,
+		KeepWeekly:              task.KeepWeekly,
+		KeepMonthly:             task.KeepMonthly,
+		KeepYearly:              task.KeepYearly,
+		TargetIDs:               targetIDs,
+		Targets:                 targets,
+		EncryptionEnabled:       task.EncryptionEnabled,
+		HasEncryptionPassphrase: task.EncryptionPassphrase != "",
+		Incremental:             task.Incremental,
+		LastBackupStatus:        task.LastBackupStatus,
+		LastBackupError:         task.LastBackupError,
+		LastBackupAt:            optionalTime(task.LastBackupAt),
+		NextRunAt:               h.backupTaskNextRunAt(task),
+		NextRuns:                backupTaskNextRuns(task),
+		CreatedAt:               task.CreatedAt,
+		UpdatedAt:               task.UpdatedAt,
 	}
 }
 
@@ -1064,7 +1570,7 @@ func normalizeBackupSchedule(value string) string {
 
 func validBackupSchedule(value string) bool {
 	switch value {
-	case "manual", "daily", "weekly", "monthly":
+	case "manual", "daily", "weekly", "monthly", "cron":
 		return true
 	default:
 		return false
@@ -1134,7 +1640,7 @@ func connectionTestResponse(err error) BackupConnectionTestResponse {
 	if err != nil {
 		return BackupConnectionTestResponse{
 			OK:        false,
-			Message:   "connection test failed",
+			Message:   connectionTestFailure(err),
 			CheckedAt: time.Now(),
 		}
 	}
@@ -1145,12 +1651,21 @@ func connectionTestResponse(err error) BackupConnectionTestResponse {
 	}
 }
 
+// connectionTestFailure keeps remote error details out of responses, except
+// for a changed SFTP host key which the admin has to act on.
+func connectionTestFailure(err error) string {
+	if errors.Is(err, errSFTPHostKeyMismatch) {
+		return "SFTP host key mismatch; clear the pinned host key if the server key was changed on purpose"
+	}
+	return "connection test failed"
+}
+
 func (h *Handler) updateTargetTestStatus(ctx context.Context, targetID int, testErr error) error {
 	update := h.client.BackupTarget.UpdateOneID(targetID).
 		SetLastTestAt(time.Now())
 	if testErr != nil {
 		return update.SetLastTestStatus("failed").
-			SetLastTestError("connection test failed").
+			SetLastTestError(connectionTestFailure(testErr)).
 			Exec(ctx)
 	}
 	return update.SetLastTestStatus("success").
@@ -1168,6 +1683,7 @@ func (h *Handler) updateTargetBackupStatus(ctx context.Context, targetID int, ba
 	}
 	return update.SetLastBackupStatus("success").
 		ClearLastBackupError().
+		ClearStaleAlertedAt().
 		Exec(ctx)
 }
 
@@ -1192,6 +1708,14 @@ func newBackupTargetClient(input backupTargetInput) (backupTargetClient, error)
 		}, nil
 	case "s3":
 		return newS3BackupTargetClient(context.Background(), input)
+	case "local":
+		return newLocalBackupTargetClient(input.LocalPath), nil
+	case "sftp":
+		client, err := newSFTPBackupTargetClient(input)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
 	default:
 		return nil, errors.New("unsupported backup target type")
 	}
@@ -1213,6 +1737,8 @@ func (c *webdavBackupTargetClient) List(ctx context.Context) ([]BackupFileInfo,
 				Filename:  file.Name(),
 				Size:      file.Size(),
 				CreatedAt: file.ModTime(),
+				Encrypted: isEncryptedBackupFilename(file.Name()),
+				Snapshot:  isBackupSnapshotFilename(file.Name()),
 			})
 		}
 	}
@@ -1220,18 +1746,40 @@ func (c *webdavBackupTargetClient) List(ctx context.Context) ([]BackupFileInfo,
 	return backups, nil
 }
 
-func (c *webdavBackupTargetClient) Upload(ctx context.Context, filename string, data []byte) error {
-	return c.client.Write(filename, data, 0644)
+func (c *webdavBackupTargetClient) Upload(ctx context.Context, filename string, r io.Reader) error {
+	// Settle authentication on a cheap request first: the streamed PUT body
+	// cannot be replayed if the server challenges it.
+	if _, err := c.client.Stat("/"); err != nil {
+		return err
+	}
+	return c.client.WriteStreamWithLength(filename, &forwardOnlyReader{r: r}, -1, 0644)
 }
 
-func (c *webdavBackupTargetClient) Download(ctx context.Context, filename string) ([]byte, error) {
-	return c.client.Read(filename)
+func (c *webdavBackupTargetClient) Download(ctx context.Context, filename string) (io.ReadCloser, error) {
+	return c.client.ReadStream(filename)
 }
 
 func (c *webdavBackupTargetClient) Delete(ctx context.Context, filename string) error {
 	return c.client.Remove(filename)
 }
 
+func (c *webdavBackupTargetClient) ListBlobs(ctx context.Context) ([]backupBlobInfo, error) {
+	files, err := c.client.ReadDir(backupBlobDir)
+	if gowebdav.IsErrNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]backupBlobInfo, 0, len(files))
+	for _, file := range files {
+		if !file.IsDir() && validBackupBlobDigest(file.Name()) {
+			blobs = append(blobs, backupBlobInfo{Digest: file.Name(), Size: file.Size(), ModTime: file.ModTime()})
+		}
+	}
+	return blobs, nil
+}
+
 func (c *webdavBackupTargetClient) Test(ctx context.Context) error {
 	probe := fmt.Sprintf(".smarticky_connection_test_%d.txt", time.Now().UnixNano())
 	if _, err := c.client.ReadDir("/"); err != nil {
@@ -1294,6 +1842,8 @@ func (c *s3BackupTargetClient) List(ctx context.Context) ([]BackupFileInfo, erro
 					Filename:  name,
 					Size:      *obj.Size,
 					CreatedAt: *obj.LastModified,
+					Encrypted: isEncryptedBackupFilename(name),
+					Snapshot:  isBackupSnapshotFilename(name),
 				})
 			}
 		}
@@ -1302,16 +1852,76 @@ func (c *s3BackupTargetClient) List(ctx context.Context) ([]BackupFileInfo, erro
 	return backups, nil
 }
 
-func (c *s3BackupTargetClient) Upload(ctx context.Context, filename string, data []byte) error {
-	_, err := c.svc.PutObject(ctx, &s3.PutObjectInput{
+// Upload sends r in fixed-size parts so memory stays bounded by one part.
+// Streams that fit in a single part are sent with one PutObject call.
+func (c *s3BackupTargetClient) Upload(ctx context.Context, filename string, r io.Reader) error {
+	part := make([]byte, s3BackupPartSize)
+	n, err := io.ReadFull(r, part)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		_, err = c.svc.PutObject(ctx, &s3.PutObjectInput{
+			Bucket: aws.String(c.bucket),
+			Key:    aws.String(filename),
+			Body:   bytes.NewReader(part[:n]),
+		})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	created, err := c.svc.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
 		Bucket: aws.String(c.bucket),
 		Key:    aws.String(filename),
-		Body:   bytes.NewReader(data),
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	parts, err := c.uploadParts(ctx, filename, created.UploadId, r, part, n)
+	if err == nil {
+		_, err = c.svc.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+			Bucket:          aws.String(c.bucket),
+			Key:             aws.String(filename),
+			UploadId:        created.UploadId,
+			MultipartUpload: &s3types.CompletedMultipartUpload{Parts: parts},
+		})
+	}
+	if err != nil {
+		_, _ = c.svc.AbortMultipartUpload(context.WithoutCancel(ctx), &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(c.bucket),
+			Key:      aws.String(filename),
+			UploadId: created.UploadId,
+		})
+		return err
+	}
+	return nil
 }
 
-func (c *s3BackupTargetClient) Download(ctx context.Context, filename string) ([]byte, error) {
+// uploadParts uploads the already read first part, then keeps reusing the
+// same buffer for the rest of r.
+func (c *s3BackupTargetClient) uploadParts(ctx context.Context, filename string, uploadID *string, r io.Reader, part []byte, n int) ([]s3types.CompletedPart, error) {
+	var parts []s3types.CompletedPart
+	for number := int32(1); n > 0; number++ {
+		result, err := c.svc.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:     aws.String(c.bucket),
+			Key:        aws.String(filename),
+			UploadId:   uploadID,
+			PartNumber: aws.Int32(number),
+			Body:       bytes.NewReader(part[:n]),
+		})
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, s3types.CompletedPart{ETag: result.ETag, PartNumber: aws.Int32(number)})
+
+		n, err = io.ReadFull(r, part)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return nil, err
+		}
+	}
+	return parts, nil
+}
+
+func (c *s3BackupTargetClient) Download(ctx context.Context, filename string) (io.ReadCloser, error) {
 	result, err := c.svc.GetObject(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(c.bucket),
 		Key:    aws.String(filename),
@@ -1319,8 +1929,7 @@ func (c *s3BackupTargetClient) Download(ctx context.Context, filename string) ([
 	if err != nil {
 		return nil, err
 	}
-	defer result.Body.Close()
-	return io.ReadAll(result.Body)
+	return result.Body, nil
 }
 
 func (c *s3BackupTargetClient) Delete(ctx context.Context, filename string) error {
@@ -1331,6 +1940,31 @@ func (c *s3BackupTargetClient) Delete(ctx context.Context, filename string) erro
 	return err
 }
 
+func (c *s3BackupTargetClient) ListBlobs(ctx context.Context) ([]backupBlobInfo, error) {
+	prefix := backupBlobDir + "/"
+	paginator := s3.NewListObjectsV2Paginator(c.svc, &s3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(prefix),
+	})
+	blobs := make([]backupBlobInfo, 0)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range page.Contents {
+			if obj.Key == nil || obj.LastModified == nil || obj.Size == nil {
+				continue
+			}
+			digest := strings.TrimPrefix(*obj.Key, prefix)
+			if validBackupBlobDigest(digest) {
+				blobs = append(blobs, backupBlobInfo{Digest: digest, Size: *obj.Size, ModTime: *obj.LastModified})
+			}
+		}
+	}
+	return blobs, nil
+}
+
 func (c *s3BackupTargetClient) Test(ctx context.Context) error {
 	probe := fmt.Sprintf(".smarticky_connection_test_%d.txt", time.Now().UnixNano())
 	if _, err := c.svc.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
@@ -1339,7 +1973,7 @@ func (c *s3BackupTargetClient) Test(ctx context.Context) error {
 	}); err != nil {
 		return err
 	}
-	if err := c.Upload(ctx, probe, []byte("smarticky")); err != nil {
+	if err := c.Upload(ctx, probe, strings.NewReader("smarticky")); err != nil {
 		return err
 	}
 	_, headErr := c.svc.HeadObject(ctx, &s3.HeadObjectInput{
@@ -1356,37 +1990,14 @@ func (c *s3BackupTargetClient) Test(ctx context.Context) error {
 	return nil
 }
 
-func cleanupTargetBackups(ctx context.Context, client backupTargetClient, retentionDays int, maxCount int, filenamePrefixes ...string) error {
-	if retentionDays == 0 && maxCount == 0 {
-		return nil
-	}
-	allBackups, err := client.List(ctx)
-	if err != nil {
+// cleanupTargetBackups applies a task's retention to its backups on client
+// and then garbage-collects the snapshot blobs nothing references anymore,
+// opening encrypted snapshots with the passphrases lookup returns.
+func cleanupTargetBackups(ctx context.Context, client backupTargetClient, policy backupRetentionPolicy, pinned map[string]bool, passphrases backupPassphraseLookup, filenamePrefixes ...string) error {
+	if err := pruneTargetBackups(ctx, client, policy, pinned, filenamePrefixes...); err != nil {
 		return err
 	}
-	backups := make([]BackupFileInfo, 0, len(allBackups))
-	for _, backup := range allBackups {
-		if backupFilenameHasPrefix(backup.Filename, filenamePrefixes) {
-			backups = append(backups, backup)
-		}
-	}
-	sortBackupFiles(backups)
-	now := time.Now()
-	for index, backup := range backups {
-		shouldDelete := false
-		if maxCount > 0 && index >= maxCount {
-			shouldDelete = true
-		}
-		if retentionDays > 0 && now.Sub(backup.CreatedAt) > time.Duration(retentionDays)*24*time.Hour {
-			shouldDelete = true
-		}
-		if shouldDelete {
-			if err := client.Delete(ctx, backup.Filename); err != nil {
-				return err
-			}
-		}
-	}
-	return nil
+	return collectBackupBlobs(ctx, client, passphrases)
 }
 
 func backupFilenameHasPrefix(filename string, prefixes []string) bool {
@@ -5,16 +5,27 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"smarticky/ent/backuptask"
 	"smarticky/ent/enttest"
 	"smarticky/internal/storage"
 
+	"github.com/labstack/echo/v4"
 	_ "github.com/lib-x/entsqlite"
+	"golang.org/x/net/webdav"
 )
 
 type tarTestEntry struct {
@@ -73,7 +84,7 @@ func TestExtractBackupArchiveRestoresFiles(t *testing.T) {
 		tarTestEntry{name: "uploads/attachments/a.txt", body: "attachment"},
 	)
 
-	if err := h.extractBackupArchive(archive); err != nil {
+	if err := h.extractBackupArchive(bytes.NewReader(archive)); err != nil {
 		t.Fatalf("extract backup archive: %v", err)
 	}
 
@@ -95,7 +106,7 @@ func TestExtractBackupArchiveRejectsPathTraversal(t *testing.T) {
 		tarTestEntry{name: "../escape.txt", body: "escaped"},
 	)
 
-	err := h.extractBackupArchive(archive)
+	err := h.extractBackupArchive(bytes.NewReader(archive))
 	if err == nil {
 		t.Fatal("expected path traversal archive to be rejected")
 	}
@@ -117,7 +128,7 @@ func TestExtractBackupArchiveRejectsUnexpectedDataFile(t *testing.T) {
 		tarTestEntry{name: "search.bleve/index", body: "index-data"},
 	)
 
-	err := h.extractBackupArchive(archive)
+	err := h.extractBackupArchive(bytes.NewReader(archive))
 	if err == nil {
 		t.Fatal("expected unexpected data file archive to be rejected")
 	}
@@ -157,10 +168,10 @@ func TestRemoveDatabaseSidecars(t *testing.T) {
 func TestVerifyBackupDataRejectsPathTraversal(t *testing.T) {
 	h := &Handler{fs: storage.NewMemoryFileSystem()}
 
-	result := h.verifyBackupData(makeTestArchive(t,
+	result := h.verifyBackupData(bytes.NewReader(makeTestArchive(t,
 		tarTestEntry{name: "smarticky.db", body: "db-data"},
 		tarTestEntry{name: "../escape.txt", body: "escaped"},
-	))
+	)), "")
 
 	if result.Valid {
 		t.Fatal("expected traversal backup to be invalid")
@@ -173,11 +184,11 @@ func TestVerifyBackupDataRejectsPathTraversal(t *testing.T) {
 func TestVerifyBackupDataRejectsUnexpectedDataFile(t *testing.T) {
 	h := &Handler{fs: storage.NewMemoryFileSystem()}
 
-	result := h.verifyBackupData(makeTestArchive(t,
+	result := h.verifyBackupData(bytes.NewReader(makeTestArchive(t,
 		tarTestEntry{name: "smarticky.db", body: "db-data"},
 		tarTestEntry{name: "uploads", dir: true},
 		tarTestEntry{name: "search.bleve/index", body: "index-data"},
-	))
+	)), "")
 
 	if result.Valid {
 		t.Fatal("expected unexpected data file backup to be invalid")
@@ -192,20 +203,35 @@ func TestCreateBackupArchiveIncludesEmptyUploadsDirectory(t *testing.T) {
 	fs := storage.NewFileSystem(dataDir)
 	h := &Handler{fs: fs}
 
-	if err := fs.WriteFile(filepath.Join(dataDir, "smarticky.db"), []byte("db-data"), 0644); err != nil {
-		t.Fatalf("write db: %v", err)
-	}
+	writeTestDatabase(t, filepath.Join(dataDir, "smarticky.db"), []byte("db-data"))
 
-	archive, err := h.createBackupArchive()
-	if err != nil {
-		t.Fatalf("create backup archive: %v", err)
+	var archive bytes.Buffer
+	if err := h.writeBackupArchive(&archive); err != nil {
+		t.Fatalf("write backup archive: %v", err)
 	}
-	result := h.verifyBackupData(archive.Bytes())
+	result := h.verifyBackupData(&archive, "")
 	if !result.Valid {
 		t.Fatalf("expected generated backup to be valid, got %q", result.Error)
 	}
 }
 
+// writeTestDatabase creates a SQLite database at path with one row holding
+// payload, standing in for the application database.
+func writeTestDatabase(t *testing.T, path string, payload []byte) {
+	t.Helper()
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("CREATE TABLE notes (id INTEGER PRIMARY KEY, body BLOB)"); err != nil {
+		t.Fatalf("create test table: %v", err)
+	}
+	if _, err := db.Exec("INSERT INTO notes (body) VALUES (?)", payload); err != nil {
+		t.Fatalf("insert test row: %v", err)
+	}
+}
+
 func TestIsBackupFilename(t *testing.T) {
 	tests := []struct {
 		name string
@@ -329,7 +355,7 @@ func TestCleanupTargetBackupsKeepsNewestAcrossManualAndAutomatic(t *testing.T) {
 		},
 	}
 
-	if err := cleanupTargetBackups(context.Background(), client, 0, 2, backupTaskFilenamePrefixes(7)...); err != nil {
+	if err := cleanupTargetBackups(context.Background(), client, backupRetentionPolicy{MaxCount: 2}, nil, nil, backupTaskFilenamePrefixes(7)...); err != nil {
 		t.Fatalf("cleanup target backups: %v", err)
 	}
 
@@ -348,12 +374,13 @@ func (c *fakeBackupTargetClient) List(context.Context) ([]BackupFileInfo, error)
 	return append([]BackupFileInfo(nil), c.files...), nil
 }
 
-func (c *fakeBackupTargetClient) Upload(context.Context, string, []byte) error {
-	return nil
+func (c *fakeBackupTargetClient) Upload(_ context.Context, _ string, r io.Reader) error {
+	_, err := io.Copy(io.Discard, r)
+	return err
 }
 
-func (c *fakeBackupTargetClient) Download(context.Context, string) ([]byte, error) {
-	return nil, nil
+func (c *fakeBackupTargetClient) Download(context.Context, string) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(nil)), nil
 }
 
 func (c *fakeBackupTargetClient) Delete(_ context.Context, filename string) error {
@@ -365,6 +392,10 @@ func (c *fakeBackupTargetClient) Test(context.Context) error {
 	return nil
 }
 
+func (c *fakeBackupTargetClient) ListBlobs(context.Context) ([]backupBlobInfo, error) {
+	return nil, nil
+}
+
 func TestEnsureBackupTargetsMigratedCompletesPartialLegacyMigration(t *testing.T) {
 	ctx := context.Background()
 	client := enttest.Open(t, "sqlite3", "file:TestEnsureBackupTargetsMigratedCompletesPartialLegacyMigration?mode=memory&cache=shared&_pragma=foreign_keys(1)")
@@ -426,3 +457,252 @@ func TestEnsureBackupTargetsMigratedCompletesPartialLegacyMigration(t *testing.T
 		t.Fatalf("task count after repeat migration = %d, want 1", got)
 	}
 }
+
+type streamingBackupTargetClient struct {
+	fakeBackupTargetClient
+	verify     func(io.Reader, string) BackupVerificationResult
+	result     BackupVerificationResult
+	failUpload error
+}
+
+func (c *streamingBackupTargetClient) Upload(_ context.Context, _ string, r io.Reader) error {
+	if c.failUpload != nil {
+		return c.failUpload
+	}
+	c.result = c.verify(r, "")
+	return nil
+}
+
+func TestUploadBackupArchiveStreamsToEveryTarget(t *testing.T) {
+	dataDir := t.TempDir()
+	fs := storage.NewFileSystem(dataDir)
+	h := &Handler{fs: fs}
+
+	writeTestDatabase(t, filepath.Join(dataDir, "smarticky.db"), []byte("db-data"))
+	if err := fs.MkdirAll(filepath.Join(dataDir, "uploads", "attachments"), 0755); err != nil {
+		t.Fatalf("create uploads: %v", err)
+	}
+	if err := fs.WriteFile(filepath.Join(dataDir, "uploads", "attachments", "a.bin"), bytes.Repeat([]byte("x"), 256<<10), 0644); err != nil {
+		t.Fatalf("write attachment: %v", err)
+	}
+
+	healthy := &streamingBackupTargetClient{verify: h.verifyBackupData}
+	broken := &streamingBackupTargetClient{failUpload: errors.New("connection refused")}
+	errs, err := h.uploadBackupArchive(context.Background(), "smarticky_backup_test.tar.gz", "", []backupTargetClient{broken, healthy})
+	if err != nil {
+		t.Fatalf("upload backup archive: %v", err)
+	}
+	if errs[0] == nil {
+		t.Fatal("expected broken target to report an error")
+	}
+	if errs[1] != nil {
+		t.Fatalf("expected healthy target to succeed, got %v", errs[1])
+	}
+	if !healthy.result.Valid {
+		t.Fatalf("expected streamed archive to verify, got %q", healthy.result.Error)
+	}
+	if healthy.result.FileCount != 4 {
+		t.Fatalf("expected 4 archive entries, got %d", healthy.result.FileCount)
+	}
+}
+
+func TestWebDAVBackupTargetClientStreamsWithAuthentication(t *testing.T) {
+	server := newTestWebDAVServer(t)
+
+	client, err := newBackupTargetClient(backupTargetInput{
+		Type:           "webdav",
+		WebDAVURL:      server.URL,
+		WebDAVUser:     "dav-user",
+		WebDAVPassword: "dav-pass",
+	})
+	if err != nil {
+		t.Fatalf("create webdav client: %v", err)
+	}
+
+	payload := bytes.Repeat([]byte("backup-"), 1<<17)
+	// A pipe hides the payload length, like a live archive stream.
+	pr, pw := io.Pipe()
+	go func() {
+		_, err := pw.Write(payload)
+		pw.CloseWithError(err)
+	}()
+	if err := client.Upload(context.Background(), "smarticky_backup_test.tar.gz", pr); err != nil {
+		t.Fatalf("upload stream: %v", err)
+	}
+
+	body, err := client.Download(context.Background(), "smarticky_backup_test.tar.gz")
+	if err != nil {
+		t.Fatalf("download stream: %v", err)
+	}
+	defer body.Close()
+	got, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatalf("read download: %v", err)
+	}
+	if !bytes.Equal(got, payload) {
+		t.Fatalf("expected %d downloaded bytes to match upload, got %d", len(payload), len(got))
+	}
+}
+
+// newTestWebDAVServer serves an in-memory WebDAV share behind basic auth as
+// dav-user / dav-pass. Observers see every authenticated request.
+func newTestWebDAVServer(t *testing.T, observers ...func(*http.Request)) *httptest.Server {
+	t.Helper()
+	dav := &webdav.Handler{FileSystem: webdav.NewMemFS(), LockSystem: webdav.NewMemLS()}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "dav-user" || pass != "dav-pass" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="backups"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		for _, observe := range observers {
+			observe(r)
+		}
+		dav.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestEncryptedBackupArchiveRequiresPassphrase(t *testing.T) {
+	dataDir := t.TempDir()
+	fs := storage.NewFileSystem(dataDir)
+	h := &Handler{fs: fs}
+	// Incompressible data so the archive spans several cipher chunks.
+	db := make([]byte, 256<<10)
+	rand.New(rand.NewSource(1)).Read(db)
+	writeTestDatabase(t, filepath.Join(dataDir, "smarticky.db"), db)
+
+	var archive bytes.Buffer
+	if err := h.writeBackupArchiveTo(&archive, "correct horse battery"); err != nil {
+		t.Fatalf("write encrypted archive: %v", err)
+	}
+	data := archive.Bytes()
+	if bytes.Contains(data, []byte("smarticky.db")) {
+		t.Fatal("expected archive entry names to be encrypted")
+	}
+
+	result := h.verifyBackupData(bytes.NewReader(data), "")
+	if result.Valid || !result.Encrypted || !result.PassphraseRequired {
+		t.Fatalf("expected passphrase prompt without passphrase, got %+v", result)
+	}
+	result = h.verifyBackupData(bytes.NewReader(data), "wrong horse battery")
+	if result.Valid || !result.PassphraseRequired {
+		t.Fatalf("expected passphrase prompt for wrong passphrase, got %+v", result)
+	}
+	result = h.verifyBackupData(bytes.NewReader(data), "correct horse battery")
+	if !result.Valid || !result.Encrypted {
+		t.Fatalf("expected encrypted archive to verify, got %+v", result)
+	}
+
+	// Cut inside the second chunk, after the first one authenticated.
+	truncated := data[:backupHeaderSize+int(backupKDFDefaults.ChunkSize)+100]
+	result = h.verifyBackupData(bytes.NewReader(truncated), "correct horse battery")
+	if result.Valid || result.PassphraseRequired {
+		t.Fatalf("expected truncated archive to be rejected as corrupt, got %+v", result)
+	}
+
+	tampered := append([]byte(nil), data...)
+	tampered[len(backupCipherMagic)+1]++ // Argon2id time cost
+	result = h.verifyBackupData(bytes.NewReader(tampered), "correct horse battery")
+	if result.Valid {
+		t.Fatal("expected archive with tampered KDF parameters to be rejected")
+	}
+}
+
+func TestRunBackupTaskEncryptsArchives(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(dataDir, "smarticky.db")+"?_pragma=foreign_keys(1)")
+	defer client.Close()
+	h := NewHandler(client, storage.NewFileSystem(dataDir))
+
+	server := newTestWebDAVServer(t)
+	target := client.BackupTarget.Create().
+		SetName("WebDAV").
+		SetType("webdav").
+		SetWebdavURL(server.URL).
+		SetWebdavUser("dav-user").
+		SetWebdavPassword("dav-pass").
+		SaveX(ctx)
+
+	post := func(handler echo.HandlerFunc, body string, params ...string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		if len(params) == 2 {
+			c.SetParamNames(params[0])
+			c.SetParamValues(params[1])
+		}
+		if err := handler(c); err != nil {
+			t.Fatalf("handler returned error: %v", err)
+		}
+		return rec
+	}
+
+	rec := post(h.CreateBackupTask, fmt.Sprintf(`{"name":"Nightly","target_ids":[%d],"encryption_enabled":true}`, target.ID))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected encryption without passphrase to be rejected, got %d", rec.Code)
+	}
+	rec = post(h.CreateBackupTask, fmt.Sprintf(`{"name":"Nightly","target_ids":[%d],"encryption_enabled":true,"encryption_passphrase":"correct horse battery"}`, target.ID))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if strings.Contains(rec.Body.String(), "correct horse") {
+		t.Fatal("expected passphrase to be omitted from the response")
+	}
+	var created BackupTaskResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("decode task: %v", err)
+	}
+	stored := client.BackupTask.GetX(ctx, created.ID)
+	if !created.HasEncryptionPassphrase || strings.Contains(stored.EncryptionPassphrase, "correct horse") {
+		t.Fatalf("expected sealed passphrase to be stored, got %q", stored.EncryptionPassphrase)
+	}
+
+	task := client.BackupTask.Query().Where(backuptask.ID(created.ID)).WithTargets().OnlyX(ctx)
+	run, err := h.runBackupTask(ctx, task, false)
+	if err != nil {
+		t.Fatalf("run backup task: %v (%+v)", err, run)
+	}
+	if !strings.HasSuffix(run.File, backupEncryptedSuffix) {
+		t.Fatalf("expected encrypted filename, got %q", run.File)
+	}
+
+	targetClient, err := h.backupTargetClient(target)
+	if err != nil {
+		t.Fatalf("create target client: %v", err)
+	}
+	files, err := targetClient.List(ctx)
+	if err != nil {
+		t.Fatalf("list backups: %v", err)
+	}
+	if len(files) != 1 || files[0].Filename != run.File || !files[0].Encrypted {
+		t.Fatalf("expected one encrypted backup file, got %+v", files)
+	}
+
+	targetID := fmt.Sprint(target.ID)
+	rec = post(h.VerifyBackupTargetFile, fmt.Sprintf(`{"filename":%q}`, run.File), "id", targetID)
+	var verification BackupVerificationResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &verification); err != nil {
+		t.Fatalf("decode verification: %v", err)
+	}
+	if verification.Valid || !verification.PassphraseRequired {
+		t.Fatalf("expected verify to ask for a passphrase, got %+v", verification)
+	}
+	rec = post(h.VerifyBackupTargetFile, fmt.Sprintf(`{"filename":%q,"passphrase":"correct horse battery"}`, run.File), "id", targetID)
+	verification = BackupVerificationResult{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &verification); err != nil {
+		t.Fatalf("decode verification: %v", err)
+	}
+	if !verification.Valid || !verification.Encrypted {
+		t.Fatalf("expected encrypted backup to verify, got %+v", verification)
+	}
+
+	rec = post(h.RestoreBackupTargetFile, fmt.Sprintf(`{"filename":%q,"confirmation":"RESTORE"}`, run.File), "id", targetID)
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), `"passphrase_required":true`) {
+		t.Fatalf("expected restore to ask for a passphrase, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
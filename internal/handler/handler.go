@@ -21,7 +21,9 @@ type Handler struct {
 	notes           *notes.Service
 	search          *searchsvc.Service
 	shareImages     *shareimage.Service
+	secrets         *secrets.Box
 	backupScheduler *scheduler.Scheduler[int, backupScheduleData]
+	restoreSessions *backupRestoreSessions
 }
 
 func NewHandler(client *ent.Client, fs *storage.FileSystem) *Handler {
@@ -36,13 +38,15 @@ func NewHandlerWithSearch(client *ent.Client, fs *storage.FileSystem, searchServ
 	box, _ := secrets.OpenBox(fs)
 
 	return &Handler{
-		client:      client,
-		fs:          fs,
-		importer:    importsvc.NewService(client, fs),
-		connections: connectsvc.NewService(client, box),
-		notes:       notes.NewService(client, searchService),
-		search:      searchService,
-		shareImages: shareimage.NewService(client, fs.GetDataDir()),
+		client:          client,
+		fs:              fs,
+		importer:        importsvc.NewService(client, fs),
+		connections:     connectsvc.NewService(client, box, fs),
+		notes:           notes.NewService(client, searchService),
+		search:          searchService,
+		shareImages:     shareimage.NewService(client, fs.GetDataDir()),
+		secrets:         box,
+		restoreSessions: newBackupRestoreSessions(),
 	}
 }
 
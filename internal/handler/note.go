@@ -16,11 +16,13 @@ import (
 	"smarticky/ent/attachment"
 	"smarticky/ent/folder"
 	"smarticky/ent/note"
+	"smarticky/ent/noteconnectionconflict"
 	"smarticky/ent/noteconnectionitemmap"
 	"smarticky/ent/noteconnectionjob"
 	"smarticky/ent/tag"
 	"smarticky/ent/user"
 	"smarticky/ent/whiteboard"
+	"smarticky/internal/notes"
 	searchsvc "smarticky/internal/search"
 
 	"github.com/google/uuid"
@@ -94,6 +96,7 @@ type NoteResponse struct {
 	EncryptionKDF    string     `json:"encryption_kdf,omitempty"`
 	EncryptionSalt   string     `json:"encryption_salt,omitempty"`
 	EncryptionNonce  string     `json:"encryption_nonce,omitempty"`
+	Aliases          []string   `json:"aliases"`
 	IsStarred        bool       `json:"is_starred"`
 	IsDeleted        bool       `json:"is_deleted"`
 	FolderID         *uuid.UUID `json:"folder_id"`
@@ -107,6 +110,11 @@ func noteToResponse(ctx context.Context, n *ent.Note, revealContent bool) (NoteR
 		return NoteResponse{}, err
 	}
 
+	aliases := n.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+
 	content := n.Content
 	redacted := false
 	if !revealContent {
@@ -132,6 +140,7 @@ func noteToResponse(ctx context.Context, n *ent.Note, revealContent bool) (NoteR
 		EncryptionKDF:    n.EncryptionKdf,
 		EncryptionSalt:   n.EncryptionSalt,
 		EncryptionNonce:  n.EncryptionNonce,
+		Aliases:          aliases,
 		IsStarred:        n.IsStarred,
 		IsDeleted:        n.IsDeleted,
 		FolderID:         folderID,
@@ -156,9 +165,18 @@ type CreateNoteRequest struct {
 	Title    string       `json:"title"`
 	Content  string       `json:"content"`
 	Color    string       `json:"color"`
+	Aliases  []string     `json:"aliases"`
 	FolderID OptionalUUID `json:"folder_id"`
 }
 
+// UpdateNoteResponse reports what happened to backlinks when the title changed,
+// according to the user's link_rename_mode.
+type UpdateNoteResponse struct {
+	NoteResponse
+	LinkRewrite      *notes.LinkRewriteView `json:"link_rewrite,omitempty"`
+	LinkRewriteOffer *notes.RenameOffer     `json:"link_rewrite_offer,omitempty"`
+}
+
 type UpdateNoteRequest struct {
 	Title              *string      `json:"title"`
 	Content            *string      `json:"content"`
@@ -170,6 +188,7 @@ type UpdateNoteRequest struct {
 	EncryptionKDF      *string      `json:"encryption_kdf"`
 	EncryptionSalt     *string      `json:"encryption_salt"`
 	EncryptionNonce    *string      `json:"encryption_nonce"`
+	Aliases            *[]string    `json:"aliases"`
 	IsStarred          *bool        `json:"is_starred"`
 	IsDeleted          *bool        `json:"is_deleted"`
 	FolderID           OptionalUUID `json:"folder_id"`
@@ -406,6 +425,25 @@ func (h *Handler) deleteNoteFromIndexBestEffort(id uuid.UUID) {
 	}
 }
 
+// reindexNotesBestEffort refreshes the search index for notes changed
+// outside the note handlers, dropping the ones that no longer exist.
+func (h *Handler) reindexNotesBestEffort(ctx context.Context, ids ...uuid.UUID) {
+	if h.search == nil {
+		return
+	}
+	for _, id := range ids {
+		n, err := h.client.Note.Get(ctx, id)
+		switch {
+		case ent.IsNotFound(err):
+			h.deleteNoteFromIndexBestEffort(id)
+		case err != nil:
+			zap.L().Warn("Failed to load note for indexing", zap.String("note_id", id.String()), zap.Error(err))
+		default:
+			h.indexNoteBestEffort(ctx, n)
+		}
+	}
+}
+
 func (h *Handler) rebuildSearchIndexBestEffort(ctx context.Context) {
 	if h.search == nil {
 		return
@@ -430,6 +468,9 @@ func (h *Handler) CreateNote(c echo.Context) error {
 		SetContent(req.Content).
 		SetColor(req.Color).
 		SetUserID(userID)
+	if aliases := notes.NormalizeAliases(req.Aliases); len(aliases) > 0 {
+		create.SetAliases(aliases)
+	}
 	if req.FolderID.Set && req.FolderID.Value != nil {
 		if _, err := h.folderForUser(ctx, userID, *req.FolderID.Value); err != nil {
 			if ent.IsNotFound(err) {
@@ -538,6 +579,8 @@ func (h *Handler) UpdateNote(c echo.Context) error {
 	}
 
 	// 更新笔记
+	oldContent := n.Content
+	oldTitle := n.Title
 	update := n.Update().SetUpdatedAt(time.Now())
 
 	if req.Title != nil {
@@ -603,6 +646,9 @@ func (h *Handler) UpdateNote(c echo.Context) error {
 			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid protection_mode"})
 		}
 	}
+	if req.Aliases != nil {
+		update.SetAliases(notes.NormalizeAliases(*req.Aliases))
+	}
 	if req.IsStarred != nil {
 		update.SetIsStarred(*req.IsStarred)
 	}
@@ -628,17 +674,43 @@ func (h *Handler) UpdateNote(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 	h.indexNoteBestEffort(ctx, n)
-	if req.Title != nil || req.Content != nil || req.ProtectionMode != nil {
+	if req.Content != nil && n.ProtectionMode != note.ProtectionModeEncrypted && n.Content != oldContent {
+		repaired, err := h.notes.RepairHeadingAnchors(ctx, userID, n.ID, oldContent, n.Content)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		}
+		if repaired > 0 {
+			// The note may link to its own renamed headings.
+			if n, err = h.client.Note.Get(ctx, n.ID); err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			}
+		}
+	}
+	if req.Title != nil || req.Content != nil || req.ProtectionMode != nil || req.Aliases != nil {
 		if err := h.notes.SyncUserLinks(ctx, userID); err != nil {
 			return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 		}
 	}
 
-	response, err := noteToResponse(ctx, n, false)
+	var result UpdateNoteResponse
+	if n.Title != oldTitle {
+		result.LinkRewrite, result.LinkRewriteOffer, err = h.followNoteRename(ctx, userID, n, oldTitle)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		}
+		if result.LinkRewrite != nil && result.LinkRewrite.RewrittenCount > 0 {
+			// The renamed note may link to itself by its old title.
+			if n, err = h.client.Note.Get(ctx, n.ID); err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			}
+		}
+	}
+
+	result.NoteResponse, err = noteToResponse(ctx, n, false)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
-	return c.JSON(http.StatusOK, response)
+	return c.JSON(http.StatusOK, result)
 }
 
 func (h *Handler) DeleteNote(c echo.Context) error {
@@ -775,6 +847,16 @@ func (h *Handler) detachNoteConnectionRecords(ctx context.Context, userID int, i
 		Exec(ctx); err != nil {
 		return err
 	}
+	if _, err := h.client.NoteConnectionConflict.Delete().
+		Where(
+			noteconnectionconflict.HasNoteWith(
+				note.IDIn(uniqueIDs...),
+				note.HasUserWith(user.IDEQ(userID)),
+			),
+		).
+		Exec(ctx); err != nil {
+		return err
+	}
 	return h.client.NoteConnectionJob.Update().
 		Where(
 			noteconnectionjob.HasNoteWith(
@@ -1,15 +1,20 @@
 package handler
 
 import (
+	"context"
 	"errors"
+	"io"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 
 	"smarticky/ent"
 	connectsvc "smarticky/internal/connections"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
 )
 
 func (h *Handler) ListNoteConnectionAccounts(c echo.Context) error {
@@ -123,15 +128,48 @@ func (h *Handler) ImportNoteConnection(c echo.Context) error {
 	if err := bindStrictJSON(c, &req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid import request"})
 	}
-	result, err := h.connections.ImportNotes(c.Request().Context(), userID, accountID, req)
+	job, err := h.connections.EnqueueImport(c.Request().Context(), userID, accountID, req)
 	if err != nil {
 		return noteConnectionError(c, err)
 	}
-	if err := h.notes.SyncUserLinks(c.Request().Context(), userID); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to sync note links"})
+	return c.JSON(http.StatusAccepted, job)
+}
+
+func (h *Handler) PreviewNoteConnectionImport(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	accountID, err := noteConnectionAccountID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid account ID"})
+	}
+	var req connectsvc.ImportRequest
+	if err := bindStrictJSON(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid import request"})
+	}
+	preview, err := h.connections.PreviewImport(c.Request().Context(), userID, accountID, req)
+	if err != nil {
+		return noteConnectionError(c, err)
+	}
+	return c.JSON(http.StatusOK, preview)
+}
+
+func (h *Handler) ConfirmNoteConnectionImport(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	accountID, err := noteConnectionAccountID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid account ID"})
+	}
+	var req connectsvc.ImportConfirmRequest
+	if err := bindStrictJSON(c, &req); err != nil || req.JobID <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid import request"})
+	}
+	job, err := h.connections.ConfirmImport(c.Request().Context(), userID, accountID, req)
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Import preview not found"})
 	}
-	h.rebuildSearchIndexBestEffort(c.Request().Context())
-	return c.JSON(http.StatusOK, result)
+	if err != nil {
+		return noteConnectionError(c, err)
+	}
+	return c.JSON(http.StatusAccepted, job)
 }
 
 func (h *Handler) PushNoteConnection(c echo.Context) error {
@@ -151,11 +189,131 @@ func (h *Handler) PushNoteConnection(c echo.Context) error {
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid note ID"})
 	}
-	result, err := h.connections.PushNote(c.Request().Context(), userID, accountID, noteID, req.TargetID)
+	job, err := h.connections.EnqueuePush(c.Request().Context(), userID, accountID, noteID, req.TargetID)
+	if err != nil {
+		return noteConnectionError(c, err)
+	}
+	return c.JSON(http.StatusAccepted, job)
+}
+
+func (h *Handler) SyncNoteConnection(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	accountID, err := noteConnectionAccountID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid account ID"})
+	}
+	job, err := h.connections.EnqueueSync(c.Request().Context(), userID, accountID, connectsvc.TriggerManual)
+	if err != nil {
+		return noteConnectionError(c, err)
+	}
+	return c.JSON(http.StatusAccepted, job)
+}
+
+// maxNoteConnectionWebhookBytes bounds a webhook body, which only lists
+// external ids.
+const maxNoteConnectionWebhookBytes = 256 << 10
+
+func (h *Handler) RotateNoteConnectionWebhook(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	accountID, err := noteConnectionAccountID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid account ID"})
+	}
+	secret, err := h.connections.RotateWebhookSecret(c.Request().Context(), userID, accountID)
+	if err != nil {
+		return noteConnectionError(c, err)
+	}
+	return c.JSON(http.StatusOK, secret)
+}
+
+func (h *Handler) DisableNoteConnectionWebhook(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	accountID, err := noteConnectionAccountID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid account ID"})
+	}
+	account, err := h.connections.DisableWebhook(c.Request().Context(), userID, accountID)
+	if err != nil {
+		return noteConnectionError(c, err)
+	}
+	return c.JSON(http.StatusOK, account)
+}
+
+// ReceiveNoteConnectionWebhook is called by providers or local automation
+// when remote notes change. It carries no session; the account's webhook
+// secret authenticates it, either as a bearer token or as an HMAC-SHA256
+// signature in X-Smarticky-Signature. Every delivery also sends its unix
+// time in X-Smarticky-Timestamp and a unique id in X-Smarticky-Delivery,
+// which the signature covers together with the body.
+func (h *Handler) ReceiveNoteConnectionWebhook(c echo.Context) error {
+	accountID, err := noteConnectionAccountID(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Invalid webhook secret or signature"})
+	}
+	req := c.Request()
+	req.Body = http.MaxBytesReader(c.Response().Writer, req.Body, maxNoteConnectionWebhookBytes)
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return c.JSON(http.StatusRequestEntityTooLarge, map[string]string{"error": "Webhook body is too large"})
+		}
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid webhook body"})
+	}
+	auth := connectsvc.WebhookAuth{
+		Token:      strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "),
+		Signature:  req.Header.Get("X-Smarticky-Signature"),
+		Timestamp:  req.Header.Get("X-Smarticky-Timestamp"),
+		DeliveryID: req.Header.Get("X-Smarticky-Delivery"),
+	}
+	result, err := h.connections.ReceiveWebhook(req.Context(), accountID, auth, body)
+	if err != nil {
+		return noteConnectionError(c, err)
+	}
+	if result.Job == nil {
+		return c.JSON(http.StatusOK, result)
+	}
+	return c.JSON(http.StatusAccepted, result)
+}
+
+func (h *Handler) ListNoteConnectionConflicts(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	accountID, err := noteConnectionAccountID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid account ID"})
+	}
+	conflicts, err := h.connections.ListConflicts(c.Request().Context(), userID, accountID)
+	if err != nil {
+		return noteConnectionError(c, err)
+	}
+	return c.JSON(http.StatusOK, conflicts)
+}
+
+func (h *Handler) ResolveNoteConnectionConflict(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	accountID, err := noteConnectionAccountID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid account ID"})
+	}
+	conflictID, err := strconv.Atoi(c.Param("conflictId"))
+	if err != nil || conflictID <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid conflict ID"})
+	}
+	var req connectsvc.ResolveConflictRequest
+	if err := bindStrictJSON(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid conflict request"})
+	}
+	conflict, err := h.connections.ResolveConflict(c.Request().Context(), userID, accountID, conflictID, req)
 	if err != nil {
 		return noteConnectionError(c, err)
 	}
-	return c.JSON(http.StatusOK, result)
+	if conflict.Resolution == connectsvc.ResolveKeepRemote {
+		if err := h.notes.SyncUserLinks(c.Request().Context(), userID); err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to sync note links"})
+		}
+		h.reindexNotesBestEffort(c.Request().Context(), conflict.NoteID)
+	}
+	return c.JSON(http.StatusOK, conflict)
 }
 
 func (h *Handler) ListNoteConnectionJobs(c echo.Context) error {
@@ -167,6 +325,112 @@ func (h *Handler) ListNoteConnectionJobs(c echo.Context) error {
 	return c.JSON(http.StatusOK, jobs)
 }
 
+func (h *Handler) GetNoteConnectionJob(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	jobID, err := strconv.Atoi(c.Param("jobId"))
+	if err != nil || jobID <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid job ID"})
+	}
+	job, err := h.connections.GetJob(c.Request().Context(), userID, jobID)
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Note connection job not found"})
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to load note connection job"})
+	}
+	return c.JSON(http.StatusOK, job)
+}
+
+func (h *Handler) CancelNoteConnectionJob(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	jobID, err := strconv.Atoi(c.Param("jobId"))
+	if err != nil || jobID <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid job ID"})
+	}
+	job, err := h.connections.CancelJob(c.Request().Context(), userID, jobID)
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Note connection job not found"})
+	}
+	if err != nil {
+		return noteConnectionError(c, err)
+	}
+	return c.JSON(http.StatusOK, job)
+}
+
+func (h *Handler) ListNoteConnectionOAuthProviders(c echo.Context) error {
+	return c.JSON(http.StatusOK, h.connections.OAuthProviders())
+}
+
+func (h *Handler) StartNotionOAuth(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	var req connectsvc.OAuthStartRequest
+	if err := bindStrictJSON(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid OAuth request"})
+	}
+	resp, err := h.connections.StartNotionOAuth(c.Request().Context(), userID, req)
+	if err != nil {
+		return noteConnectionError(c, err)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// NotionOAuthCallback is where Notion sends the browser back. It carries no
+// session, so it hands the code to the app, which completes the connection
+// as the signed-in user.
+func (h *Handler) NotionOAuthCallback(c echo.Context) error {
+	fragment := url.Values{}
+	if message := c.QueryParam("error"); message != "" {
+		fragment.Set("notion_oauth_error", message)
+	} else {
+		fragment.Set("notion_oauth_code", c.QueryParam("code"))
+		fragment.Set("notion_oauth_state", c.QueryParam("state"))
+	}
+	return c.Redirect(http.StatusFound, "/#"+fragment.Encode())
+}
+
+func (h *Handler) CompleteNotionOAuth(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	var req connectsvc.OAuthCompleteRequest
+	if err := bindStrictJSON(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid OAuth request"})
+	}
+	account, err := h.connections.CompleteNotionOAuth(c.Request().Context(), userID, req)
+	if err != nil {
+		return noteConnectionError(c, err)
+	}
+	return c.JSON(http.StatusOK, account)
+}
+
+// StartNoteConnectionQueue starts the background workers that run note
+// connection imports, pushes and syncs, including scheduled syncs.
+func (h *Handler) StartNoteConnectionQueue() {
+	err := h.connections.StartQueue(context.Background(), connectsvc.QueueOptions{
+		OnFinish: h.afterNoteConnectionJob,
+	})
+	if err != nil {
+		zap.L().Error("Failed to start note connection queue", zap.Error(err))
+		return
+	}
+	zap.L().Info("Note connection queue started")
+}
+
+// afterNoteConnectionJob refreshes links and the search index entries of the
+// notes a job brought in.
+func (h *Handler) afterNoteConnectionJob(ctx context.Context, job *ent.NoteConnectionJob) {
+	if job.ImportedCount == 0 {
+		return
+	}
+	if err := h.notes.SyncUserLinks(ctx, job.UserID); err != nil {
+		zap.L().Warn("Failed to sync note links after note connection job", zap.Int("job_id", job.ID), zap.Error(err))
+	}
+	ids, err := h.connections.JobNoteIDs(ctx, job)
+	if err != nil {
+		zap.L().Warn("Failed to list notes changed by note connection job", zap.Int("job_id", job.ID), zap.Error(err))
+		return
+	}
+	h.reindexNotesBestEffort(ctx, ids...)
+}
+
 func noteConnectionAccountID(c echo.Context) (int, error) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil || id <= 0 {
@@ -189,6 +453,38 @@ func noteConnectionError(c echo.Context, err error) error {
 		return c.JSON(http.StatusConflict, map[string]string{"error": "Note connection account is disabled"})
 	case errors.Is(err, connectsvc.ErrJobRunning):
 		return c.JSON(http.StatusConflict, map[string]string{"error": "Note connection import is already running"})
+	case errors.Is(err, connectsvc.ErrSyncRunning):
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Note connection sync is already running"})
+	case errors.Is(err, connectsvc.ErrInvalidConflictResolution):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Conflict resolution must be local or remote"})
+	case errors.Is(err, connectsvc.ErrJobFinished):
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Note connection job has already finished"})
+	case errors.Is(err, connectsvc.ErrInvalidSyncInterval):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Sync interval must be off or between 15 minutes and 7 days"})
+	case errors.Is(err, connectsvc.ErrVaultPathNotAllowed):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Vault folder is outside the allowed vault roots"})
+	case errors.Is(err, connectsvc.ErrOAuthNotConfigured):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Notion OAuth is not configured"})
+	case errors.Is(err, connectsvc.ErrInvalidOAuthState):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "OAuth request is invalid or has expired"})
+	case errors.Is(err, connectsvc.ErrAuthorizationExpired):
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Provider authorization expired or was revoked; reconnect the account"})
+	case errors.Is(err, connectsvc.ErrWebhookUnauthorized):
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Invalid webhook secret or signature"})
+	case errors.Is(err, connectsvc.ErrWebhookExpired):
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Webhook timestamp is more than 5 minutes off"})
+	case errors.Is(err, connectsvc.ErrWebhookReplayed):
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Webhook delivery was already received"})
+	case errors.Is(err, connectsvc.ErrInvalidWebhook):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Webhook body must list between 1 and 500 external_ids"})
+	case errors.Is(err, connectsvc.ErrPreviewClosed):
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Import preview was already confirmed or discarded"})
+	case errors.Is(err, connectsvc.ErrNothingSelected):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Select at least one note to import"})
+	case errors.Is(err, connectsvc.ErrInvalidImportFolder):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Import folder does not exist"})
+	case errors.Is(err, connectsvc.ErrConflictResolved):
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Conflict is already resolved"})
 	default:
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
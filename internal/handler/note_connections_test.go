@@ -2,11 +2,16 @@ package handler
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"smarticky/ent/enttest"
 	connectsvc "smarticky/internal/connections"
@@ -57,3 +62,176 @@ func TestListNoteConnectionTargetsRejectsDisabledAccount(t *testing.T) {
 		t.Fatalf("error = %q, want disabled account message", body["error"])
 	}
 }
+
+func TestNotionOAuthCallbackHandsCodeToApp(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:TestNotionOAuthCallbackHandsCodeToApp?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+	h := NewHandler(client, storage.NewMemoryFileSystem())
+
+	for query, want := range map[string]string{
+		"code=abc&state=s%2B1": "/#notion_oauth_code=abc&notion_oauth_state=s%2B1",
+		"error=access_denied":  "/#notion_oauth_error=access_denied",
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/api/note-connections/oauth/notion/callback?"+query, nil)
+		rec := httptest.NewRecorder()
+		if err := h.NotionOAuthCallback(echo.New().NewContext(req, rec)); err != nil {
+			t.Fatalf("NotionOAuthCallback returned error: %v", err)
+		}
+		if rec.Code != http.StatusFound || rec.Header().Get("Location") != want {
+			t.Fatalf("%s redirected with %d to %q, want %q", query, rec.Code, rec.Header().Get("Location"), want)
+		}
+	}
+}
+
+func TestConfirmNoteConnectionImportReportsMissingPreviewAndSelection(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestConfirmNoteConnectionImportReportsMissingPreviewAndSelection?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetUsername("owner").
+		SetPasswordHash("hash").
+		SaveX(ctx)
+	account := client.NoteConnectionAccount.Create().
+		SetName("Vault").
+		SetProvider(connectsvc.ProviderVault).
+		SetEndpoint("/data/vaults/notes").
+		SetEnabled(true).
+		SetAuthType("none").
+		SetUserID(u.ID).
+		SaveX(ctx)
+	preview := client.NoteConnectionJob.Create().
+		SetProvider(connectsvc.ProviderVault).
+		SetOperation(connectsvc.OperationImport).
+		SetUserID(u.ID).
+		SetAccountID(account.ID).
+		SetStatus(connectsvc.JobPreviewed).
+		SetOptionsJSON(`{"external_ids":["a.md"]}`).
+		SaveX(ctx)
+
+	h := NewHandler(client, storage.NewMemoryFileSystem())
+	for _, tc := range []struct {
+		body   string
+		status int
+		error  string
+	}{
+		{`{"job_id":` + strconv.Itoa(preview.ID+1) + `,"external_ids":["a.md"]}`, http.StatusNotFound, "Import preview not found"},
+		{`{"job_id":` + strconv.Itoa(preview.ID) + `,"external_ids":[]}`, http.StatusBadRequest, "Select at least one note to import"},
+	} {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/api/note-connections/accounts/"+strconv.Itoa(account.ID)+"/import/confirm", strings.NewReader(tc.body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", u.ID)
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(account.ID))
+
+		if err := h.ConfirmNoteConnectionImport(c); err != nil {
+			t.Fatalf("ConfirmNoteConnectionImport returned error: %v", err)
+		}
+		var body map[string]string
+		_ = json.NewDecoder(rec.Body).Decode(&body)
+		if rec.Code != tc.status || body["error"] != tc.error {
+			t.Fatalf("confirm %s = %d %q, want %d %q", tc.body, rec.Code, body["error"], tc.status, tc.error)
+		}
+	}
+}
+
+func TestReceiveNoteConnectionWebhookChecksSignature(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestReceiveNoteConnectionWebhookChecksSignature?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	vault := t.TempDir()
+	t.Setenv("SMARTICKY_VAULT_ROOTS", vault)
+	u := client.User.Create().
+		SetUsername("owner").
+		SetPasswordHash("hash").
+		SaveX(ctx)
+	account := client.NoteConnectionAccount.Create().
+		SetName("Vault").
+		SetProvider(connectsvc.ProviderVault).
+		SetEndpoint(vault).
+		SetEnabled(true).
+		SetAuthType("none").
+		SetUserID(u.ID).
+		SaveX(ctx)
+	linked := client.Note.Create().SetTitle("Plan").SetContent("Ship it").SetUserID(u.ID).SaveX(ctx)
+	client.NoteConnectionItemMap.Create().
+		SetProvider(connectsvc.ProviderVault).
+		SetAccountID(account.ID).
+		SetNoteID(linked.ID).
+		SetExternalID("plan.md").
+		ExecX(ctx)
+
+	h := NewHandler(client, storage.NewMemoryFileSystem())
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/api/note-connections/accounts/"+strconv.Itoa(account.ID)+"/webhook", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", u.ID)
+	c.SetParamNames("id")
+	c.SetParamValues(strconv.Itoa(account.ID))
+	if err := h.RotateNoteConnectionWebhook(c); err != nil {
+		t.Fatalf("RotateNoteConnectionWebhook returned error: %v", err)
+	}
+	var secret connectsvc.WebhookSecretResponse
+	if err := json.NewDecoder(rec.Body).Decode(&secret); err != nil || secret.Secret == "" {
+		t.Fatalf("rotate webhook = %d %+v, %v, want a secret", rec.Code, secret, err)
+	}
+	if secret.Path != "/api/note-connections/webhooks/"+strconv.Itoa(account.ID) {
+		t.Fatalf("webhook path = %q", secret.Path)
+	}
+
+	body := `{"external_ids":["plan.md","other.md"]}`
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	stale := strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10)
+	sign := func(timestamp, delivery string) string {
+		mac := hmac.New(sha256.New, []byte(secret.Secret))
+		mac.Write([]byte(timestamp + "." + delivery + "." + body))
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+	for _, tc := range []struct {
+		name      string
+		timestamp string
+		delivery  string
+		signature string
+		status    int
+	}{
+		{"unsigned", now, "d1", "", http.StatusUnauthorized},
+		{"wrong signature", now, "d1", "sha256=" + strings.Repeat("0", 64), http.StatusUnauthorized},
+		{"signed for another delivery", now, "d1", sign(now, "d2"), http.StatusUnauthorized},
+		{"without delivery id", now, "", sign(now, ""), http.StatusUnauthorized},
+		{"stale", stale, "d1", sign(stale, "d1"), http.StatusUnauthorized},
+		{"signed", now, "d1", sign(now, "d1"), http.StatusAccepted},
+		{"replayed", now, "d1", sign(now, "d1"), http.StatusConflict},
+	} {
+		req := httptest.NewRequest(http.MethodPost, secret.Path, strings.NewReader(body))
+		req.Header.Set("X-Smarticky-Timestamp", tc.timestamp)
+		req.Header.Set("X-Smarticky-Delivery", tc.delivery)
+		if tc.signature != "" {
+			req.Header.Set("X-Smarticky-Signature", tc.signature)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(account.ID))
+		if err := h.ReceiveNoteConnectionWebhook(c); err != nil {
+			t.Fatalf("ReceiveNoteConnectionWebhook returned error: %v", err)
+		}
+		if rec.Code != tc.status {
+			t.Fatalf("%s delivery = %d, want %d: %s", tc.name, rec.Code, tc.status, rec.Body.String())
+		}
+		if tc.status != http.StatusAccepted {
+			continue
+		}
+		var result connectsvc.WebhookResult
+		if err := json.NewDecoder(rec.Body).Decode(&result); err != nil {
+			t.Fatalf("decode webhook result: %v", err)
+		}
+		if result.Job == nil || result.MatchedCount != 1 || result.IgnoredCount != 1 || result.Job.Trigger != connectsvc.TriggerWebhook {
+			t.Fatalf("result = %+v, want a webhook sync for the linked note", result)
+		}
+	}
+}
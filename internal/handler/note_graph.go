@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"smarticky/internal/notes"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type GraphNodesResponse struct {
+	Notes []notes.GraphNode `json:"notes"`
+}
+
+type DanglingTargetsResponse struct {
+	Targets []notes.DanglingTarget `json:"targets"`
+}
+
+type GraphClustersResponse struct {
+	Clusters []notes.GraphCluster `json:"clusters"`
+}
+
+// graphScopeFromQuery reads the folder_id, tag and include_trash filters
+// shared by every graph analytics endpoint.
+func graphScopeFromQuery(c echo.Context) (notes.GraphScope, error) {
+	scope := notes.GraphScope{
+		Tag:          strings.TrimSpace(c.QueryParam("tag")),
+		IncludeTrash: strings.EqualFold(c.QueryParam("include_trash"), "true"),
+	}
+	if raw := strings.TrimSpace(c.QueryParam("folder_id")); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return scope, err
+		}
+		scope.FolderID = &id
+	}
+	return scope, nil
+}
+
+func graphErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, notes.ErrGraphFolderNotFound), errors.Is(err, notes.ErrGraphNoteNotFound), errors.Is(err, notes.ErrGraphNoPath):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+}
+
+func (h *Handler) ListGraphOrphans(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	scope, err := graphScopeFromQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid folder_id"})
+	}
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	orphans, err := h.notes.GraphOrphans(c.Request().Context(), userID, scope, limit)
+	if err != nil {
+		return graphErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, GraphNodesResponse{Notes: orphans})
+}
+
+func (h *Handler) ListGraphDanglingTargets(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	scope, err := graphScopeFromQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid folder_id"})
+	}
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	targets, err := h.notes.GraphDanglingTargets(c.Request().Context(), userID, scope, limit)
+	if err != nil {
+		return graphErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, DanglingTargetsResponse{Targets: targets})
+}
+
+func (h *Handler) ListGraphHubs(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	scope, err := graphScopeFromQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid folder_id"})
+	}
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	hubs, err := h.notes.GraphHubs(c.Request().Context(), userID, scope, limit)
+	if err != nil {
+		return graphErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, GraphNodesResponse{Notes: hubs})
+}
+
+func (h *Handler) ListGraphClusters(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	scope, err := graphScopeFromQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid folder_id"})
+	}
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	minSize, _ := strconv.Atoi(c.QueryParam("min_size"))
+
+	clusters, err := h.notes.GraphClusters(c.Request().Context(), userID, scope, minSize, limit)
+	if err != nil {
+		return graphErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, GraphClustersResponse{Clusters: clusters})
+}
+
+func (h *Handler) GetNoteNeighborhood(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+	userID := c.Get("user_id").(int)
+	scope, err := graphScopeFromQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid folder_id"})
+	}
+	depth, _ := strconv.Atoi(c.QueryParam("depth"))
+
+	neighborhood, err := h.notes.GraphNeighborhood(c.Request().Context(), userID, id, depth, scope)
+	if err != nil {
+		return graphErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, neighborhood)
+}
+
+func (h *Handler) GetGraphPath(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	fromID, err := uuid.Parse(c.QueryParam("from"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid from"})
+	}
+	toID, err := uuid.Parse(c.QueryParam("to"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid to"})
+	}
+	scope, err := graphScopeFromQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid folder_id"})
+	}
+	directed := strings.EqualFold(c.QueryParam("directed"), "true")
+
+	path, err := h.notes.GraphShortestPath(c.Request().Context(), userID, fromID, toID, directed, scope)
+	if err != nil {
+		return graphErrorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, path)
+}
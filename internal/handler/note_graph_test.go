@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"smarticky/ent/enttest"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestListGraphHubsScopesByFolder(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestListGraphHubsScopesByFolder?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	scoped := client.Folder.Create().SetName("Scoped").SetUserID(owner.ID).SaveX(ctx)
+	hub := client.Note.Create().SetTitle("Hub").SetUserID(owner.ID).SetFolder(scoped).SaveX(ctx)
+	client.Note.Create().SetTitle("Inside").SetContent("[[Hub]]").SetUserID(owner.ID).SetFolder(scoped).SaveX(ctx)
+	client.Note.Create().SetTitle("Outside").SetContent("[[Hub]]").SetUserID(owner.ID).SaveX(ctx)
+	h := NewHandler(client, nil)
+	if err := h.notes.SyncUserLinks(ctx, owner.ID); err != nil {
+		t.Fatalf("sync links: %v", err)
+	}
+
+	get := func(query string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/note-links/hubs?"+query, nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.Set("user_id", owner.ID)
+		if err := h.ListGraphHubs(c); err != nil {
+			t.Fatalf("ListGraphHubs returned error: %v", err)
+		}
+		return rec
+	}
+
+	rec := get("folder_id=" + scoped.ID.String())
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var response GraphNodesResponse
+	if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(response.Notes) != 1 || response.Notes[0].ID != hub.ID || response.Notes[0].InDegree != 1 {
+		t.Fatalf("expected only in-folder backlinks to count, got %+v", response.Notes)
+	}
+
+	if rec := get("folder_id=not-a-uuid"); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+	if rec := get("folder_id=" + uuid.NewString()); rec.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d", rec.Code)
+	}
+}
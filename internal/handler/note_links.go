@@ -2,7 +2,9 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,6 +12,8 @@ import (
 	"smarticky/ent/note"
 	"smarticky/ent/notelink"
 	"smarticky/ent/user"
+	"smarticky/internal/notes"
+	searchsvc "smarticky/internal/search"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -35,6 +39,9 @@ type NoteLinkResponse struct {
 	TargetKey       string                `json:"target_key"`
 	DisplayText     string                `json:"display_text"`
 	LinkType        string                `json:"link_type"`
+	AnchorType      string                `json:"anchor_type"`
+	Anchor          string                `json:"anchor"`
+	AnchorResolved  bool                  `json:"anchor_resolved"`
 	OccurrenceCount int                   `json:"occurrence_count"`
 	SourceNote      *NoteMetadataResponse `json:"source_note,omitempty"`
 	TargetNote      *NoteMetadataResponse `json:"target_note,omitempty"`
@@ -62,6 +69,35 @@ type NoteLinkGraphEdge struct {
 	OccurrenceCount int       `json:"occurrence_count"`
 }
 
+type LinkSuggestionsResponse struct {
+	Suggestions []notes.LinkSuggestion `json:"suggestions"`
+}
+
+type UnresolvedLinksResponse struct {
+	Links []notes.UnresolvedLink `json:"links"`
+}
+
+type FixNoteLinksRequest struct {
+	Fixes []notes.LinkFix `json:"fixes"`
+}
+
+type RenameLinksRequest struct {
+	OldTitle string `json:"old_title"`
+}
+
+type LinkRewritesResponse struct {
+	Rewrites []notes.LinkRewriteView `json:"rewrites"`
+}
+
+type RelatedNoteResponse struct {
+	NoteMetadataResponse
+	Score float64 `json:"score"`
+}
+
+type RelatedNotesResponse struct {
+	Notes []RelatedNoteResponse `json:"notes"`
+}
+
 func (h *Handler) GetNoteLinks(c echo.Context) error {
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
@@ -189,7 +225,11 @@ func (h *Handler) noteLinkToResponse(ctx context.Context, row *ent.NoteLink) (No
 		sourceMeta = &meta
 	}
 	var targetMeta *NoteMetadataResponse
+	anchorResolved := row.AnchorType == notelink.AnchorTypeNone
 	if row.Edges.TargetNote != nil {
+		if !anchorResolved && row.Edges.TargetNote.ProtectionMode != note.ProtectionModeEncrypted {
+			anchorResolved = notes.AnchorExists(row.Edges.TargetNote.Content, string(row.AnchorType), row.Anchor)
+		}
 		meta, err := h.noteMetadata(ctx, row.Edges.TargetNote)
 		if err != nil {
 			return NoteLinkResponse{}, err
@@ -204,6 +244,9 @@ func (h *Handler) noteLinkToResponse(ctx context.Context, row *ent.NoteLink) (No
 		TargetKey:       row.TargetKey,
 		DisplayText:     row.DisplayText,
 		LinkType:        string(row.LinkType),
+		AnchorType:      string(row.AnchorType),
+		Anchor:          row.Anchor,
+		AnchorResolved:  anchorResolved,
 		OccurrenceCount: row.OccurrenceCount,
 		SourceNote:      sourceMeta,
 		TargetNote:      targetMeta,
@@ -230,3 +273,218 @@ func (h *Handler) noteMetadata(ctx context.Context, row *ent.Note) (NoteMetadata
 		UpdatedAt:       row.UpdatedAt,
 	}, nil
 }
+
+func (h *Handler) ListRelatedNotes(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+	userID := c.Get("user_id").(int)
+	ctx := c.Request().Context()
+
+	if _, err := h.client.Note.Query().
+		Where(note.IDEQ(id), note.HasUserWith(user.IDEQ(userID))).
+		Only(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "note not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	if h.search == nil {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": searchsvc.ErrSemanticDisabled.Error()})
+	}
+
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	hits, err := h.search.Similar(ctx, searchsvc.SimilarOptions{
+		UserID:       userID,
+		NoteID:       id,
+		IncludeTrash: strings.EqualFold(c.QueryParam("include_trash"), "true"),
+		Limit:        limit,
+	})
+	if errors.Is(err, searchsvc.ErrNoteNotEmbedded) {
+		return c.JSON(http.StatusOK, RelatedNotesResponse{Notes: []RelatedNoteResponse{}})
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	ids := make([]uuid.UUID, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.ID)
+	}
+	rows, err := h.client.Note.Query().
+		Where(note.IDIn(ids...), note.HasUserWith(user.IDEQ(userID))).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	byID := make(map[uuid.UUID]*ent.Note, len(rows))
+	for _, row := range rows {
+		byID[row.ID] = row
+	}
+
+	response := RelatedNotesResponse{Notes: make([]RelatedNoteResponse, 0, len(hits))}
+	for _, hit := range hits {
+		row, ok := byID[hit.ID]
+		if !ok {
+			continue
+		}
+		meta, err := h.noteMetadata(ctx, row)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		}
+		response.Notes = append(response.Notes, RelatedNoteResponse{NoteMetadataResponse: meta, Score: hit.Score})
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) SuggestNoteLinkTargets(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	suggestions, err := h.notes.SuggestLinkTargets(c.Request().Context(), userID, c.QueryParam("q"), limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, LinkSuggestionsResponse{Suggestions: suggestions})
+}
+
+func (h *Handler) ListUnresolvedNoteLinks(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	var sourceNoteID *uuid.UUID
+	if raw := strings.TrimSpace(c.QueryParam("note_id")); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid note_id"})
+		}
+		sourceNoteID = &id
+	}
+
+	links, err := h.notes.SuggestUnresolvedLinks(c.Request().Context(), userID, sourceNoteID, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, UnresolvedLinksResponse{Links: links})
+}
+
+func (h *Handler) FixNoteLinks(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+
+	var req FixNoteLinksRequest
+	if err := bindStrictJSON(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request"})
+	}
+	if len(req.Fixes) == 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "fixes are required"})
+	}
+
+	result, err := h.notes.FixDanglingLinks(c.Request().Context(), userID, req.Fixes)
+	switch {
+	case errors.Is(err, notes.ErrLinkNotFound), errors.Is(err, notes.ErrLinkTargetNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, notes.ErrLinkAlreadyTargets), errors.Is(err, notes.ErrUnlinkableTitle):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	case err != nil:
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// followNoteRename applies or offers a backlink rewrite after n was renamed
+// from oldTitle, depending on the owner's link_rename_mode.
+func (h *Handler) followNoteRename(ctx context.Context, userID int, n *ent.Note, oldTitle string) (*notes.LinkRewriteView, *notes.RenameOffer, error) {
+	if n.ProtectionMode == note.ProtectionModeEncrypted || strings.TrimSpace(oldTitle) == "" {
+		return nil, nil, nil
+	}
+	owner, err := h.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch string(owner.LinkRenameMode) {
+	case notes.RenameModeAuto:
+		rewrite, err := h.notes.ApplyRenameRewrite(ctx, userID, n.ID, oldTitle)
+		if errors.Is(err, notes.ErrTitleUnchanged) || errors.Is(err, notes.ErrUnlinkableTitle) {
+			return nil, nil, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		return &rewrite, nil, nil
+	case notes.RenameModeAsk:
+		backlinks, err := h.notes.RenameBacklinks(ctx, userID, n.ID, oldTitle)
+		if err != nil || len(backlinks) == 0 {
+			return nil, nil, err
+		}
+		return nil, &notes.RenameOffer{NoteID: n.ID, OldTitle: oldTitle, NewTitle: n.Title, Backlinks: backlinks}, nil
+	default:
+		return nil, nil, nil
+	}
+}
+
+// RewriteRenamedNoteLinks accepts a rename offer, rewriting links that still
+// use old_title to the note's current title.
+func (h *Handler) RewriteRenamedNoteLinks(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+	userID := c.Get("user_id").(int)
+
+	var req RenameLinksRequest
+	if err := bindStrictJSON(c, &req); err != nil || strings.TrimSpace(req.OldTitle) == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "old_title is required"})
+	}
+
+	rewrite, err := h.notes.ApplyRenameRewrite(c.Request().Context(), userID, id, req.OldTitle)
+	switch {
+	case ent.IsNotFound(err):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "note not found"})
+	case errors.Is(err, notes.ErrTitleUnchanged), errors.Is(err, notes.ErrUnlinkableTitle):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	case err != nil:
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, rewrite)
+}
+
+func (h *Handler) ListLinkRewrites(c echo.Context) error {
+	userID := c.Get("user_id").(int)
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	var noteID *uuid.UUID
+	if raw := strings.TrimSpace(c.QueryParam("note_id")); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid note_id"})
+		}
+		noteID = &id
+	}
+
+	rewrites, err := h.notes.ListLinkRewrites(c.Request().Context(), userID, noteID, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, LinkRewritesResponse{Rewrites: rewrites})
+}
+
+func (h *Handler) UndoLinkRewrite(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+	userID := c.Get("user_id").(int)
+
+	result, err := h.notes.UndoLinkRewrite(c.Request().Context(), userID, id)
+	switch {
+	case errors.Is(err, notes.ErrRewriteNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, notes.ErrRewriteAlreadyUndone):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	case err != nil:
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, result)
+}
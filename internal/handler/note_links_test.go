@@ -12,6 +12,7 @@ import (
 	"smarticky/ent/note"
 	"smarticky/ent/notelink"
 	"smarticky/internal/notes"
+	searchsvc "smarticky/internal/search"
 
 	"github.com/labstack/echo/v4"
 	_ "github.com/lib-x/entsqlite"
@@ -207,3 +208,173 @@ func TestListNoteLinkGraphFiltersTrashByDefault(t *testing.T) {
 		}
 	}
 }
+
+func TestListRelatedNotesReturnsSimilarOwnedNotes(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestListRelatedNotesReturnsSimilarOwnedNotes?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	source := client.Note.Create().SetTitle("Garden plan").SetContent("Plant tomatoes and basil in the raised garden beds").SetUserID(owner.ID).SaveX(ctx)
+	related := client.Note.Create().SetTitle("Tomato care").SetContent("Water tomatoes in the garden beds and pinch basil flowers").SetUserID(owner.ID).SaveX(ctx)
+	client.Note.Create().SetTitle("Invoices").SetContent("Reconcile receipts for the accountant").SetUserID(owner.ID).SaveX(ctx)
+
+	index, err := searchsvc.NewMemory()
+	if err != nil {
+		t.Fatalf("NewMemory: %v", err)
+	}
+	if err := index.Rebuild(ctx, client); err != nil {
+		t.Fatalf("Rebuild: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/notes/"+source.ID.String()+"/related?limit=5", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.Set("user_id", owner.ID)
+	c.SetParamNames("id")
+	c.SetParamValues(source.ID.String())
+
+	if err := NewHandlerWithSearch(client, nil, index).ListRelatedNotes(c); err != nil {
+		t.Fatalf("ListRelatedNotes returned error: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var response RelatedNotesResponse
+	if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(response.Notes) == 0 || response.Notes[0].ID != related.ID {
+		t.Fatalf("expected %s to be the top related note, got %+v", related.ID, response.Notes)
+	}
+	if response.Notes[0].Score <= 0 {
+		t.Fatalf("expected positive similarity score, got %v", response.Notes[0].Score)
+	}
+}
+
+func TestUpdateNoteKeepsHeadingBacklinksValid(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestUpdateNoteKeepsHeadingBacklinksValid?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	target := client.Note.Create().SetTitle("Handbook").SetContent("## Onboarding\nWelcome").SetUserID(owner.ID).SaveX(ctx)
+	source := client.Note.Create().SetTitle("Source").SetContent("Read [[Handbook#Onboarding]] first").SetUserID(owner.ID).SaveX(ctx)
+	h := NewHandler(client, nil)
+	if err := h.notes.SyncNoteLinks(ctx, owner.ID, source.ID); err != nil {
+		t.Fatalf("sync links: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPut, "/api/notes/"+target.ID.String(), strings.NewReader(`{"content":"## First Week\nWelcome","aliases":["Staff Guide"]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.Set("user_id", owner.ID)
+	c.SetParamNames("id")
+	c.SetParamValues(target.ID.String())
+	if err := h.UpdateNote(c); err != nil {
+		t.Fatalf("UpdateNote returned error: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	if got := client.Note.GetX(ctx, source.ID).Content; got != "Read [[Handbook#First Week]] first" {
+		t.Fatalf("expected backlink anchor to follow the heading rename, got %q", got)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/notes/"+source.ID.String()+"/links", nil)
+	rec = httptest.NewRecorder()
+	c = echo.New().NewContext(req, rec)
+	c.Set("user_id", owner.ID)
+	c.SetParamNames("id")
+	c.SetParamValues(source.ID.String())
+	if err := h.GetNoteLinks(c); err != nil {
+		t.Fatalf("GetNoteLinks returned error: %v", err)
+	}
+	var links NoteLinksResponse
+	if err := json.NewDecoder(rec.Body).Decode(&links); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(links.Outgoing) != 1 {
+		t.Fatalf("expected one outgoing link, got %+v", links)
+	}
+	link := links.Outgoing[0]
+	if link.AnchorType != "heading" || link.Anchor != "First Week" || !link.AnchorResolved {
+		t.Fatalf("expected resolved heading anchor, got %+v", link)
+	}
+}
+
+func TestUpdateNoteRenameFollowsLinkRenameMode(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestUpdateNoteRenameFollowsLinkRenameMode?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	target := client.Note.Create().SetTitle("Roadmap").SetUserID(owner.ID).SaveX(ctx)
+	source := client.Note.Create().SetTitle("Source").SetContent("See [[roadmap#Q3|plans]]").SetUserID(owner.ID).SaveX(ctx)
+	h := NewHandler(client, nil)
+	if err := h.notes.SyncNoteLinks(ctx, owner.ID, source.ID); err != nil {
+		t.Fatalf("sync links: %v", err)
+	}
+
+	rename := func(title string) UpdateNoteResponse {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPut, "/api/notes/"+target.ID.String(), strings.NewReader(`{"title":"`+title+`"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.Set("user_id", owner.ID)
+		c.SetParamNames("id")
+		c.SetParamValues(target.ID.String())
+		if err := h.UpdateNote(c); err != nil {
+			t.Fatalf("UpdateNote returned error: %v", err)
+		}
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var response UpdateNoteResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		return response
+	}
+
+	asked := rename("Product Roadmap")
+	if asked.LinkRewrite != nil || asked.LinkRewriteOffer == nil || len(asked.LinkRewriteOffer.Backlinks) != 1 {
+		t.Fatalf("expected a rewrite offer in ask mode, got %+v", asked)
+	}
+	if got := client.Note.GetX(ctx, source.ID).Content; got != "See [[roadmap#Q3|plans]]" {
+		t.Fatalf("expected ask mode to leave content untouched, got %q", got)
+	}
+
+	client.User.UpdateOneID(owner.ID).SetLinkRenameMode("auto").ExecX(ctx)
+	// Renaming back resolves the link again, so rename once more to exercise auto mode.
+	rename("Roadmap")
+	auto := rename("Roadmap 2026")
+	if auto.LinkRewrite == nil || auto.LinkRewrite.RewrittenCount != 1 {
+		t.Fatalf("expected an applied rewrite in auto mode, got %+v", auto)
+	}
+	if got := client.Note.GetX(ctx, source.ID).Content; got != "See [[Roadmap 2026#Q3|plans]]" {
+		t.Fatalf("expected auto mode to rewrite the backlink, got %q", got)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/link-rewrites/"+auto.LinkRewrite.ID.String()+"/undo", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.Set("user_id", owner.ID)
+	c.SetParamNames("id")
+	c.SetParamValues(auto.LinkRewrite.ID.String())
+	if err := h.UndoLinkRewrite(c); err != nil {
+		t.Fatalf("UndoLinkRewrite returned error: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if got := client.Note.GetX(ctx, target.ID).Title; got != "Roadmap" {
+		t.Fatalf("expected undo to restore the title, got %q", got)
+	}
+	if got := client.Note.GetX(ctx, source.ID).Content; got != "See [[roadmap#Q3|plans]]" {
+		t.Fatalf("expected undo to restore the backlink, got %q", got)
+	}
+}
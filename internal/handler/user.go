@@ -130,6 +130,7 @@ func (h *Handler) UpdateUser(c echo.Context) error {
 		ShareSignature *string `json:"share_signature"`
 		TimeZone       *string `json:"time_zone"`
 		LazycatUID     *string `json:"lazycat_uid"`
+		LinkRenameMode *string `json:"link_rename_mode"`
 	}
 
 	if err := c.Bind(&req); err != nil {
@@ -178,6 +179,14 @@ func (h *Handler) UpdateUser(c echo.Context) error {
 		}
 	}
 
+	if req.LinkRenameMode != nil {
+		mode := user.LinkRenameMode(strings.TrimSpace(*req.LinkRenameMode))
+		if err := user.LinkRenameModeValidator(mode); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid link rename mode"})
+		}
+		updateQuery = updateQuery.SetLinkRenameMode(mode)
+	}
+
 	// Only admin can change role
 	if req.Role != nil && currentRole == "admin" {
 		role := strings.TrimSpace(*req.Role)
@@ -43,15 +43,16 @@ func userResponse(u *ent.User, includeCreatedAt bool) map[string]interface{} {
 		timeZone = defaultUserTimeZone
 	}
 	response := map[string]interface{}{
-		"id":              u.ID,
-		"username":        u.Username,
-		"email":           u.Email,
-		"nickname":        u.Nickname,
-		"role":            u.Role,
-		"avatar":          u.Avatar,
-		"share_signature": normalizeShareSignature(u.ShareSignature),
-		"time_zone":       timeZone,
-		"lazycat_uid":     u.LazycatUID,
+		"id":               u.ID,
+		"username":         u.Username,
+		"email":            u.Email,
+		"nickname":         u.Nickname,
+		"role":             u.Role,
+		"avatar":           u.Avatar,
+		"share_signature":  normalizeShareSignature(u.ShareSignature),
+		"time_zone":        timeZone,
+		"lazycat_uid":      u.LazycatUID,
+		"link_rename_mode": u.LinkRenameMode,
 	}
 	if includeCreatedAt {
 		response["created_at"] = u.CreatedAt
@@ -3,6 +3,7 @@ package mcpserver
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"smarticky/ent/note"
 	"smarticky/ent/user"
 	"smarticky/internal/notes"
+	searchsvc "smarticky/internal/search"
 	"smarticky/internal/shareimage"
 
 	"github.com/google/uuid"
@@ -273,6 +275,84 @@ func TestHTTPHandlerGenerateImageReturnsTokenDownloadURL(t *testing.T) {
 	}
 }
 
+func TestHTTPHandlerSimilarNotesDefaultsToTenResults(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestHTTPHandlerSimilarNotesDefaultsToTenResults?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	u := client.User.Create().
+		SetUsername("alice").
+		SetPasswordHash("hash").
+		SaveX(ctx)
+	for i := 0; i < 15; i++ {
+		client.Note.Create().
+			SetTitle(fmt.Sprintf("Sourdough %d", i)).
+			SetContent("Feed the sourdough starter with flour and water before baking bread.").
+			SetUserID(u.ID).
+			SaveX(ctx)
+	}
+	token, err := GenerateToken()
+	if err != nil {
+		t.Fatalf("GenerateToken returned error: %v", err)
+	}
+	client.MCPToken.Create().
+		SetName("test").
+		SetTokenHash(HashToken(token)).
+		SetUserID(u.ID).
+		SaveX(ctx)
+
+	index, err := searchsvc.NewMemory()
+	if err != nil {
+		t.Fatalf("NewMemory returned error: %v", err)
+	}
+	if err := index.Rebuild(ctx, client); err != nil {
+		t.Fatalf("Rebuild returned error: %v", err)
+	}
+	httpServer := httptest.NewServer(NewHTTPHandler(
+		client,
+		notes.NewService(client, index),
+		shareimage.NewService(client, t.TempDir()),
+		false,
+	))
+	defer httpServer.Close()
+
+	mcpClient := mcpsdk.NewClient(&mcpsdk.Implementation{Name: "test-client", Version: "0.0.0"}, nil)
+	session, err := mcpClient.Connect(ctx, &mcpsdk.StreamableClientTransport{
+		Endpoint:             httpServer.URL,
+		HTTPClient:           &http.Client{Transport: bearerTransport{token: token}},
+		DisableStandaloneSSE: true,
+	}, nil)
+	if err != nil {
+		t.Fatalf("Connect returned error: %v", err)
+	}
+	defer session.Close()
+
+	result, err := session.CallTool(ctx, &mcpsdk.CallToolParams{
+		Name: "smarticky_similar_notes",
+		Arguments: map[string]any{
+			"query": "sourdough starter flour water baking bread",
+		},
+	})
+	if err != nil {
+		t.Fatalf("CallTool returned error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("tool returned error: %v", result.GetError())
+	}
+
+	var output similarNotesOutput
+	raw, err := json.Marshal(result.StructuredContent)
+	if err != nil {
+		t.Fatalf("marshal structured content: %v", err)
+	}
+	if err := json.Unmarshal(raw, &output); err != nil {
+		t.Fatalf("unmarshal structured content: %v", err)
+	}
+	if output.Count == 0 || output.Count > 10 || len(output.Notes) != output.Count {
+		t.Fatalf("expected at most 10 similar notes by default, got %d", output.Count)
+	}
+}
+
 func TestHTTPHandlerRejectsProtectedNoteImage(t *testing.T) {
 	ctx := context.Background()
 	client := enttest.Open(t, "sqlite3", "file:TestHTTPHandlerRejectsProtectedNoteImage?mode=memory&cache=shared&_pragma=foreign_keys(1)")
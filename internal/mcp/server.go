@@ -33,6 +33,12 @@ type getNoteInput struct {
 	ID string `json:"id" jsonschema:"note UUID"`
 }
 
+type similarNotesInput struct {
+	NoteID string `json:"note_id,omitempty" jsonschema:"owned note UUID to find related notes for"`
+	Query  string `json:"query,omitempty" jsonschema:"free text to match by meaning when note_id is omitted"`
+	Limit  int    `json:"limit,omitempty" jsonschema:"maximum number of notes to return, defaults to 10 and caps at 50"`
+}
+
 type createNoteInput struct {
 	Title   string `json:"title,omitempty" jsonschema:"note title, defaults to Untitled"`
 	Content string `json:"content,omitempty" jsonschema:"note body"`
@@ -54,6 +60,16 @@ type notesOutput struct {
 	Count int       `json:"count"`
 }
 
+type similarNotesOutput struct {
+	Notes []mcpSimilarNote `json:"notes"`
+	Count int              `json:"count"`
+}
+
+type mcpSimilarNote struct {
+	mcpNote
+	Score float64 `json:"score"`
+}
+
 type noteOutput struct {
 	Note mcpNote `json:"note"`
 }
@@ -150,6 +166,34 @@ func registerTools(server *mcpsdk.Server, noteService *notes.Service, imageServi
 		return nil, noteOutput{Note: mcpNoteFrom(row)}, err
 	})
 
+	mcpsdk.AddTool(server, &mcpsdk.Tool{
+		Name:        "smarticky_similar_notes",
+		Title:       "Find Similar Smarticky Notes",
+		Description: "Find the current Smarticky user's notes that are semantically related to an owned note or to free text, ranked by similarity score. Protected note content is redacted.",
+	}, func(ctx context.Context, _ *mcpsdk.CallToolRequest, input similarNotesInput) (*mcpsdk.CallToolResult, similarNotesOutput, error) {
+		principal, err := requirePrincipal(ctx)
+		if err != nil {
+			return nil, similarNotesOutput{}, err
+		}
+		similar := notes.SimilarInput{Query: input.Query, Limit: input.Limit}
+		if strings.TrimSpace(input.NoteID) != "" {
+			id, err := uuid.Parse(strings.TrimSpace(input.NoteID))
+			if err != nil {
+				return nil, similarNotesOutput{}, errors.New("invalid note id")
+			}
+			similar.NoteID = &id
+		}
+		rows, err := noteService.Similar(ctx, principal.UserID, similar, true)
+		if err != nil {
+			return nil, similarNotesOutput{}, err
+		}
+		out := make([]mcpSimilarNote, 0, len(rows))
+		for _, row := range rows {
+			out = append(out, mcpSimilarNote{mcpNote: mcpNoteFrom(row.NoteView), Score: row.Score})
+		}
+		return nil, similarNotesOutput{Notes: out, Count: len(out)}, nil
+	})
+
 	mcpsdk.AddTool(server, &mcpsdk.Tool{
 		Name:        "smarticky_create_note",
 		Title:       "Create Smarticky Note",
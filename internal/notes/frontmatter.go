@@ -0,0 +1,147 @@
+package notes
+
+import (
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FrontMatter is the subset of a leading YAML block that Smarticky understands.
+type FrontMatter struct {
+	Title   string
+	Aliases []string
+	Tags    []string
+	Created *time.Time
+	Updated *time.Time
+}
+
+// ParseFrontMatter splits a leading `---` YAML block from Markdown content.
+// Malformed blocks are treated as ordinary content.
+func ParseFrontMatter(content string) (FrontMatter, string, bool) {
+	normalized := strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(normalized, "---\n") {
+		return FrontMatter{}, content, false
+	}
+	rest := normalized[len("---\n"):]
+	end := strings.Index(rest, "\n---")
+	if end < 0 {
+		return FrontMatter{}, content, false
+	}
+	block := rest[:end]
+	body := rest[end+len("\n---"):]
+	if newline := strings.IndexByte(body, '\n'); newline >= 0 {
+		if strings.TrimSpace(body[:newline]) != "" {
+			return FrontMatter{}, content, false
+		}
+		body = body[newline+1:]
+	} else if strings.TrimSpace(body) != "" {
+		return FrontMatter{}, content, false
+	} else {
+		body = ""
+	}
+
+	var raw map[string]any
+	if err := yaml.Unmarshal([]byte(block), &raw); err != nil {
+		return FrontMatter{}, content, false
+	}
+
+	var fm FrontMatter
+	fm.Title = strings.TrimSpace(stringValue(raw["title"]))
+	fm.Aliases = uniqueStrings(append(stringList(raw["aliases"]), stringList(raw["alias"])...))
+	fm.Tags = uniqueStrings(append(stringList(raw["tags"]), stringList(raw["tag"])...))
+	for i, tagName := range fm.Tags {
+		fm.Tags[i] = strings.TrimPrefix(tagName, "#")
+	}
+	fm.Created = timeValue(firstPresent(raw, "created", "date", "created_at"))
+	fm.Updated = timeValue(firstPresent(raw, "updated", "modified", "updated_at"))
+	return fm, body, true
+}
+
+// NoteAliases merges the alias field with aliases declared in front matter.
+func NoteAliases(fieldAliases []string, content string) []string {
+	fm, _, _ := ParseFrontMatter(content)
+	return uniqueStrings(append(append([]string{}, fieldAliases...), fm.Aliases...))
+}
+
+// NormalizeAliases trims, de-duplicates and bounds user-supplied aliases.
+func NormalizeAliases(values []string) []string {
+	aliases := uniqueStrings(values)
+	for i, alias := range aliases {
+		if runes := []rune(alias); len(runes) > MaxTitleLen {
+			aliases[i] = string(runes[:MaxTitleLen])
+		}
+	}
+	return aliases
+}
+
+func firstPresent(raw map[string]any, keys ...string) any {
+	for _, key := range keys {
+		if value, ok := raw[key]; ok {
+			return value
+		}
+	}
+	return nil
+}
+
+func stringValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		out, err := yaml.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(string(out))
+	}
+}
+
+func stringList(value any) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			out = append(out, stringValue(item))
+		}
+		return out
+	case string:
+		// Logseq and some Obsidian vaults use comma-separated scalars.
+		return strings.Split(v, ",")
+	default:
+		return []string{stringValue(v)}
+	}
+}
+
+func timeValue(value any) *time.Time {
+	switch v := value.(type) {
+	case time.Time:
+		return &v
+	case string:
+		for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02 15:04", "2006-01-02"} {
+			if parsed, err := time.ParseInLocation(layout, strings.TrimSpace(v), time.Local); err == nil {
+				return &parsed
+			}
+		}
+	}
+	return nil
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		key := normalizeLinkRef(value)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, value)
+	}
+	return out
+}
@@ -0,0 +1,488 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"smarticky/ent"
+	"smarticky/ent/folder"
+	"smarticky/ent/note"
+	"smarticky/ent/notelink"
+	"smarticky/ent/tag"
+	"smarticky/ent/user"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultGraphDepth = 1
+	MaxGraphDepth     = 5
+)
+
+var (
+	ErrGraphNoteNotFound   = errors.New("note is not part of the graph scope")
+	ErrGraphNoPath         = errors.New("no link path between the notes")
+	ErrGraphFolderNotFound = errors.New("folder not found")
+)
+
+// GraphScope restricts graph queries to a folder subtree and/or a tag. Only
+// links whose endpoints are both inside the scope are considered.
+type GraphScope struct {
+	FolderID     *uuid.UUID
+	Tag          string
+	IncludeTrash bool
+}
+
+type GraphNode struct {
+	ID        uuid.UUID `json:"id"`
+	Title     string    `json:"title"`
+	InDegree  int       `json:"in_degree"`
+	OutDegree int       `json:"out_degree"`
+	// InboundOccurrences counts every `[[link]]` occurrence pointing here.
+	InboundOccurrences int `json:"inbound_occurrences"`
+}
+
+type GraphEdge struct {
+	Source          uuid.UUID `json:"source"`
+	Target          uuid.UUID `json:"target"`
+	OccurrenceCount int       `json:"occurrence_count"`
+}
+
+type NeighborhoodNode struct {
+	GraphNode
+	Distance int `json:"distance"`
+}
+
+type GraphNeighborhood struct {
+	Center uuid.UUID          `json:"center"`
+	Depth  int                `json:"depth"`
+	Nodes  []NeighborhoodNode `json:"nodes"`
+	Edges  []GraphEdge        `json:"edges"`
+}
+
+type GraphPath struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+type GraphCluster struct {
+	Size    int         `json:"size"`
+	Hub     GraphNode   `json:"hub"`
+	NoteIDs []uuid.UUID `json:"note_ids"`
+	Edges   int         `json:"edge_count"`
+}
+
+type DanglingTarget struct {
+	TargetRef       string      `json:"target_ref"`
+	TargetRefNorm   string      `json:"target_ref_norm"`
+	OccurrenceCount int         `json:"occurrence_count"`
+	SourceNoteIDs   []uuid.UUID `json:"source_note_ids"`
+}
+
+type linkGraph struct {
+	nodes    map[uuid.UUID]*GraphNode
+	order    []uuid.UUID
+	out      map[uuid.UUID]map[uuid.UUID]int
+	in       map[uuid.UUID]map[uuid.UUID]int
+	dangling []*ent.NoteLink
+}
+
+// loadLinkGraph builds the in-memory link graph for the notes in scope.
+// Self-links are ignored and links that differ only by anchor are merged.
+func (s *Service) loadLinkGraph(ctx context.Context, userID int, scope GraphScope) (*linkGraph, error) {
+	query := s.client.Note.Query().
+		Where(note.HasUserWith(user.IDEQ(userID))).
+		Select(note.FieldID, note.FieldTitle)
+	if !scope.IncludeTrash {
+		query.Where(note.IsDeleted(false))
+	}
+	if scope.FolderID != nil {
+		folderIDs, err := s.folderSubtree(ctx, userID, *scope.FolderID)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(note.HasFolderWith(folder.IDIn(folderIDs...)))
+	}
+	if name := strings.TrimPrefix(strings.TrimSpace(scope.Tag), "#"); name != "" {
+		query.Where(note.HasTagsWith(tag.NameEqualFold(name)))
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &linkGraph{
+		nodes: make(map[uuid.UUID]*GraphNode, len(rows)),
+		order: make([]uuid.UUID, 0, len(rows)),
+		out:   make(map[uuid.UUID]map[uuid.UUID]int),
+		in:    make(map[uuid.UUID]map[uuid.UUID]int),
+	}
+	for _, row := range rows {
+		g.nodes[row.ID] = &GraphNode{ID: row.ID, Title: row.Title}
+		g.order = append(g.order, row.ID)
+	}
+	sort.Slice(g.order, func(i, j int) bool {
+		left, right := g.nodes[g.order[i]], g.nodes[g.order[j]]
+		if left.Title != right.Title {
+			return left.Title < right.Title
+		}
+		return left.ID.String() < right.ID.String()
+	})
+
+	links, err := s.client.NoteLink.Query().
+		Where(notelink.UserIDEQ(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, link := range links {
+		if _, ok := g.nodes[link.SourceNoteID]; !ok {
+			continue
+		}
+		if link.TargetNoteID == nil {
+			g.dangling = append(g.dangling, link)
+			continue
+		}
+		target := *link.TargetNoteID
+		if _, ok := g.nodes[target]; !ok || target == link.SourceNoteID {
+			continue
+		}
+		if g.out[link.SourceNoteID] == nil {
+			g.out[link.SourceNoteID] = make(map[uuid.UUID]int)
+		}
+		if g.in[target] == nil {
+			g.in[target] = make(map[uuid.UUID]int)
+		}
+		g.out[link.SourceNoteID][target] += link.OccurrenceCount
+		g.in[target][link.SourceNoteID] += link.OccurrenceCount
+	}
+	for id, node := range g.nodes {
+		node.OutDegree = len(g.out[id])
+		node.InDegree = len(g.in[id])
+		for _, count := range g.in[id] {
+			node.InboundOccurrences += count
+		}
+	}
+	return g, nil
+}
+
+func (s *Service) folderSubtree(ctx context.Context, userID int, rootID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := s.client.Folder.Query().
+		Where(folder.HasUserWith(user.IDEQ(userID))).
+		WithParent().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[uuid.UUID][]uuid.UUID)
+	found := false
+	for _, row := range rows {
+		if row.ID == rootID {
+			found = true
+		}
+		if row.Edges.Parent != nil {
+			children[row.Edges.Parent.ID] = append(children[row.Edges.Parent.ID], row.ID)
+		}
+	}
+	if !found {
+		return nil, ErrGraphFolderNotFound
+	}
+	ids := []uuid.UUID{rootID}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, children[ids[i]]...)
+	}
+	return ids, nil
+}
+
+// neighbors returns linked notes in either direction, or only outgoing ones
+// when directed is set.
+func (g *linkGraph) neighbors(id uuid.UUID, directed bool) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool)
+	var out []uuid.UUID
+	for target := range g.out[id] {
+		seen[target] = true
+		out = append(out, target)
+	}
+	if !directed {
+		for source := range g.in[id] {
+			if !seen[source] {
+				out = append(out, source)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].String() < out[j].String() })
+	return out
+}
+
+func (g *linkGraph) edgesWithin(members map[uuid.UUID]bool) []GraphEdge {
+	edges := []GraphEdge{}
+	for _, source := range g.order {
+		if !members[source] {
+			continue
+		}
+		for _, target := range g.neighbors(source, true) {
+			if members[target] {
+				edges = append(edges, GraphEdge{Source: source, Target: target, OccurrenceCount: g.out[source][target]})
+			}
+		}
+	}
+	return edges
+}
+
+func (g *linkGraph) edge(a, b uuid.UUID) GraphEdge {
+	if count, ok := g.out[a][b]; ok {
+		return GraphEdge{Source: a, Target: b, OccurrenceCount: count}
+	}
+	return GraphEdge{Source: b, Target: a, OccurrenceCount: g.out[b][a]}
+}
+
+// GraphOrphans lists notes in scope with no incoming or outgoing links.
+func (s *Service) GraphOrphans(ctx context.Context, userID int, scope GraphScope, limit int) ([]GraphNode, error) {
+	g, err := s.loadLinkGraph(ctx, userID, scope)
+	if err != nil {
+		return nil, err
+	}
+	limit = clampLimit(limit)
+	orphans := []GraphNode{}
+	for _, id := range g.order {
+		node := g.nodes[id]
+		if node.InDegree == 0 && node.OutDegree == 0 {
+			orphans = append(orphans, *node)
+			if len(orphans) == limit {
+				break
+			}
+		}
+	}
+	return orphans, nil
+}
+
+// GraphDanglingTargets groups unresolved link references from notes in scope,
+// most referenced first.
+func (s *Service) GraphDanglingTargets(ctx context.Context, userID int, scope GraphScope, limit int) ([]DanglingTarget, error) {
+	g, err := s.loadLinkGraph(ctx, userID, scope)
+	if err != nil {
+		return nil, err
+	}
+	byRef := make(map[string]*DanglingTarget)
+	for _, link := range g.dangling {
+		item, ok := byRef[link.TargetRefNorm]
+		if !ok {
+			item = &DanglingTarget{TargetRef: link.TargetRef, TargetRefNorm: link.TargetRefNorm, SourceNoteIDs: []uuid.UUID{}}
+			byRef[link.TargetRefNorm] = item
+		}
+		item.OccurrenceCount += link.OccurrenceCount
+		if !containsUUID(item.SourceNoteIDs, link.SourceNoteID) {
+			item.SourceNoteIDs = append(item.SourceNoteIDs, link.SourceNoteID)
+		}
+	}
+
+	targets := make([]DanglingTarget, 0, len(byRef))
+	for _, item := range byRef {
+		targets = append(targets, *item)
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		if len(targets[i].SourceNoteIDs) != len(targets[j].SourceNoteIDs) {
+			return len(targets[i].SourceNoteIDs) > len(targets[j].SourceNoteIDs)
+		}
+		if targets[i].OccurrenceCount != targets[j].OccurrenceCount {
+			return targets[i].OccurrenceCount > targets[j].OccurrenceCount
+		}
+		return targets[i].TargetRefNorm < targets[j].TargetRefNorm
+	})
+	if limit = clampLimit(limit); len(targets) > limit {
+		targets = targets[:limit]
+	}
+	return targets, nil
+}
+
+// GraphNeighborhood returns notes within depth hops of noteID, following
+// links in both directions, and the links between them.
+func (s *Service) GraphNeighborhood(ctx context.Context, userID int, noteID uuid.UUID, depth int, scope GraphScope) (GraphNeighborhood, error) {
+	g, err := s.loadLinkGraph(ctx, userID, scope)
+	if err != nil {
+		return GraphNeighborhood{}, err
+	}
+	if _, ok := g.nodes[noteID]; !ok {
+		return GraphNeighborhood{}, ErrGraphNoteNotFound
+	}
+	if depth <= 0 {
+		depth = DefaultGraphDepth
+	}
+	if depth > MaxGraphDepth {
+		depth = MaxGraphDepth
+	}
+
+	distance := map[uuid.UUID]int{noteID: 0}
+	queue := []uuid.UUID{noteID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if distance[current] == depth {
+			continue
+		}
+		for _, next := range g.neighbors(current, false) {
+			if _, ok := distance[next]; !ok {
+				distance[next] = distance[current] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	result := GraphNeighborhood{Center: noteID, Depth: depth, Nodes: make([]NeighborhoodNode, 0, len(distance))}
+	members := make(map[uuid.UUID]bool, len(distance))
+	for _, id := range g.order {
+		if d, ok := distance[id]; ok {
+			members[id] = true
+			result.Nodes = append(result.Nodes, NeighborhoodNode{GraphNode: *g.nodes[id], Distance: d})
+		}
+	}
+	sort.SliceStable(result.Nodes, func(i, j int) bool { return result.Nodes[i].Distance < result.Nodes[j].Distance })
+	result.Edges = g.edgesWithin(members)
+	return result, nil
+}
+
+// GraphShortestPath finds the fewest-hop link path from one note to another.
+// Unless directed is set, links may be followed backwards.
+func (s *Service) GraphShortestPath(ctx context.Context, userID int, fromID, toID uuid.UUID, directed bool, scope GraphScope) (GraphPath, error) {
+	g, err := s.loadLinkGraph(ctx, userID, scope)
+	if err != nil {
+		return GraphPath{}, err
+	}
+	if _, ok := g.nodes[fromID]; !ok {
+		return GraphPath{}, ErrGraphNoteNotFound
+	}
+	if _, ok := g.nodes[toID]; !ok {
+		return GraphPath{}, ErrGraphNoteNotFound
+	}
+
+	previous := map[uuid.UUID]uuid.UUID{fromID: fromID}
+	queue := []uuid.UUID{fromID}
+	for len(queue) > 0 && !hasUUIDKey(previous, toID) {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range g.neighbors(current, directed) {
+			if !hasUUIDKey(previous, next) {
+				previous[next] = current
+				queue = append(queue, next)
+			}
+		}
+	}
+	if !hasUUIDKey(previous, toID) {
+		return GraphPath{}, ErrGraphNoPath
+	}
+
+	ids := []uuid.UUID{toID}
+	for ids[0] != fromID {
+		ids = append([]uuid.UUID{previous[ids[0]]}, ids...)
+	}
+	path := GraphPath{Nodes: make([]GraphNode, 0, len(ids)), Edges: make([]GraphEdge, 0, len(ids)-1)}
+	for i, id := range ids {
+		path.Nodes = append(path.Nodes, *g.nodes[id])
+		if i > 0 {
+			path.Edges = append(path.Edges, g.edge(ids[i-1], id))
+		}
+	}
+	return path, nil
+}
+
+// GraphClusters groups linked notes into connected components, ignoring link
+// direction. Components smaller than minSize (default 2) are skipped, so
+// orphans never show up as clusters.
+func (s *Service) GraphClusters(ctx context.Context, userID int, scope GraphScope, minSize, limit int) ([]GraphCluster, error) {
+	g, err := s.loadLinkGraph(ctx, userID, scope)
+	if err != nil {
+		return nil, err
+	}
+	if minSize < 2 {
+		minSize = 2
+	}
+
+	visited := make(map[uuid.UUID]bool, len(g.nodes))
+	clusters := []GraphCluster{}
+	for _, start := range g.order {
+		if visited[start] {
+			continue
+		}
+		visited[start] = true
+		component := []uuid.UUID{start}
+		for i := 0; i < len(component); i++ {
+			for _, next := range g.neighbors(component[i], false) {
+				if !visited[next] {
+					visited[next] = true
+					component = append(component, next)
+				}
+			}
+		}
+		if len(component) < minSize {
+			continue
+		}
+
+		members := make(map[uuid.UUID]bool, len(component))
+		for _, id := range component {
+			members[id] = true
+		}
+		cluster := GraphCluster{Size: len(component), NoteIDs: make([]uuid.UUID, 0, len(component))}
+		for _, id := range g.order {
+			if !members[id] {
+				continue
+			}
+			cluster.NoteIDs = append(cluster.NoteIDs, id)
+			if node := g.nodes[id]; len(cluster.NoteIDs) == 1 || hubLess(*node, cluster.Hub) {
+				cluster.Hub = *node
+			}
+			cluster.Edges += len(g.out[id])
+		}
+		clusters = append(clusters, cluster)
+	}
+
+	sort.SliceStable(clusters, func(i, j int) bool { return clusters[i].Size > clusters[j].Size })
+	if limit = clampLimit(limit); len(clusters) > limit {
+		clusters = clusters[:limit]
+	}
+	return clusters, nil
+}
+
+// GraphHubs ranks notes by how many distinct notes link to them.
+func (s *Service) GraphHubs(ctx context.Context, userID int, scope GraphScope, limit int) ([]GraphNode, error) {
+	g, err := s.loadLinkGraph(ctx, userID, scope)
+	if err != nil {
+		return nil, err
+	}
+	hubs := make([]GraphNode, 0, len(g.nodes))
+	for _, id := range g.order {
+		if node := g.nodes[id]; node.InDegree > 0 {
+			hubs = append(hubs, *node)
+		}
+	}
+	sort.SliceStable(hubs, func(i, j int) bool { return hubLess(hubs[i], hubs[j]) })
+	if limit = clampLimit(limit); len(hubs) > limit {
+		hubs = hubs[:limit]
+	}
+	return hubs, nil
+}
+
+func hubLess(a, b GraphNode) bool {
+	if a.InDegree != b.InDegree {
+		return a.InDegree > b.InDegree
+	}
+	if a.InboundOccurrences != b.InboundOccurrences {
+		return a.InboundOccurrences > b.InboundOccurrences
+	}
+	return a.OutDegree > b.OutDegree
+}
+
+func containsUUID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, existing := range ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
+
+func hasUUIDKey(m map[uuid.UUID]uuid.UUID, id uuid.UUID) bool {
+	_, ok := m[id]
+	return ok
+}
@@ -0,0 +1,106 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"smarticky/ent/enttest"
+
+	"github.com/google/uuid"
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestGraphAnalytics(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestGraphAnalytics?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	parent := client.Folder.Create().SetName("Projects").SetUserID(owner.ID).SaveX(ctx)
+	child := client.Folder.Create().SetName("Archive").SetUserID(owner.ID).SetParent(parent).SaveX(ctx)
+	label := client.Tag.Create().SetName("Work").SetUserID(owner.ID).SaveX(ctx)
+
+	create := func(title, content string) uuid.UUID {
+		return client.Note.Create().SetTitle(title).SetContent(content).SetUserID(owner.ID).SaveX(ctx).ID
+	}
+	a := create("A", "[[B]] [[B#Part]]")
+	b := create("B", "[[C]]")
+	c := create("C", "")
+	create("D", "[[B]] [[Missing]] [[missing]]")
+	e := create("E", "")
+	g := client.Note.Create().SetTitle("G").SetContent("[[H]] [[Missing]]").SetUserID(owner.ID).SetFolder(parent).AddTags(label).SaveX(ctx).ID
+	h := client.Note.Create().SetTitle("H").SetContent("[[G]] [[A]]").SetUserID(owner.ID).SetFolder(child).SaveX(ctx).ID
+
+	svc := NewService(client)
+	if err := svc.SyncUserLinks(ctx, owner.ID); err != nil {
+		t.Fatalf("sync links: %v", err)
+	}
+
+	orphans, err := svc.GraphOrphans(ctx, owner.ID, GraphScope{}, 0)
+	if err != nil {
+		t.Fatalf("orphans: %v", err)
+	}
+	if len(orphans) != 1 || orphans[0].ID != e {
+		t.Fatalf("expected E to be the only orphan, got %+v", orphans)
+	}
+
+	hubs, err := svc.GraphHubs(ctx, owner.ID, GraphScope{}, 1)
+	if err != nil {
+		t.Fatalf("hubs: %v", err)
+	}
+	if len(hubs) != 1 || hubs[0].ID != b || hubs[0].InDegree != 2 || hubs[0].InboundOccurrences != 3 {
+		t.Fatalf("expected B to be the top hub, got %+v", hubs)
+	}
+
+	dangling, err := svc.GraphDanglingTargets(ctx, owner.ID, GraphScope{}, 0)
+	if err != nil {
+		t.Fatalf("dangling: %v", err)
+	}
+	if len(dangling) != 1 || dangling[0].TargetRefNorm != "missing" || dangling[0].OccurrenceCount != 3 || len(dangling[0].SourceNoteIDs) != 2 {
+		t.Fatalf("unexpected dangling targets: %+v", dangling)
+	}
+
+	neighborhood, err := svc.GraphNeighborhood(ctx, owner.ID, a, 1, GraphScope{})
+	if err != nil {
+		t.Fatalf("neighborhood: %v", err)
+	}
+	if len(neighborhood.Nodes) != 3 || neighborhood.Nodes[0].ID != a || len(neighborhood.Edges) != 2 {
+		t.Fatalf("expected A with B and H one hop away, got %+v", neighborhood)
+	}
+
+	path, err := svc.GraphShortestPath(ctx, owner.ID, g, c, false, GraphScope{})
+	if err != nil {
+		t.Fatalf("shortest path: %v", err)
+	}
+	if len(path.Nodes) != 5 || path.Nodes[1].ID != h || path.Nodes[4].ID != c {
+		t.Fatalf("expected G -> H -> A -> B -> C, got %+v", path.Nodes)
+	}
+	if _, err := svc.GraphShortestPath(ctx, owner.ID, c, a, true, GraphScope{}); !errors.Is(err, ErrGraphNoPath) {
+		t.Fatalf("expected no directed path from C to A, got %v", err)
+	}
+
+	clusters, err := svc.GraphClusters(ctx, owner.ID, GraphScope{}, 0, 0)
+	if err != nil {
+		t.Fatalf("clusters: %v", err)
+	}
+	if len(clusters) != 1 || clusters[0].Size != 6 || clusters[0].Hub.ID != b {
+		t.Fatalf("expected one cluster of six notes around B, got %+v", clusters)
+	}
+
+	folderScope := GraphScope{FolderID: &parent.ID}
+	clusters, err = svc.GraphClusters(ctx, owner.ID, folderScope, 0, 0)
+	if err != nil {
+		t.Fatalf("folder clusters: %v", err)
+	}
+	if len(clusters) != 1 || clusters[0].Size != 2 {
+		t.Fatalf("expected the folder subtree to hold the G-H cluster, got %+v", clusters)
+	}
+	orphans, err = svc.GraphOrphans(ctx, owner.ID, GraphScope{Tag: "#work"}, 0)
+	if err != nil {
+		t.Fatalf("tag orphans: %v", err)
+	}
+	if len(orphans) != 1 || orphans[0].ID != g {
+		t.Fatalf("expected G to be orphaned within the tag scope, got %+v", orphans)
+	}
+}
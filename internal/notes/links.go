@@ -6,41 +6,68 @@ import (
 	"unicode"
 )
 
-const wikiLinkType = "wiki"
+const (
+	wikiLinkType = "wiki"
+
+	AnchorNone    = "none"
+	AnchorHeading = "heading"
+	AnchorBlock   = "block"
+)
 
 var wikiLinkPattern = regexp.MustCompile(`\[\[([^\[\]]+)\]\]`)
 
 type ParsedLink struct {
-	TargetRef       string
-	TargetRefNorm   string
-	DisplayText     string
-	LinkType        string
+	TargetRef     string
+	TargetRefNorm string
+	DisplayText   string
+	LinkType      string
+	// AnchorType and Anchor address a heading (`#`) or block (`^`) inside the target.
+	AnchorType string
+	Anchor     string
+	// RawRef is the reference before the anchor split, used as a fallback for
+	// titles that legitimately contain `#` or `^`.
+	RawRef          string
 	OccurrenceCount int
 }
 
+// wikiLinkParts is the editable form of one `[[target#anchor|display]]` body.
+type wikiLinkParts struct {
+	Target     string
+	AnchorType string
+	Anchor     string
+	Display    string
+	HasDisplay bool
+	// raw is the reference exactly as written, before the anchor split.
+	raw string
+}
+
 func ParseWikiLinks(content string) []ParsedLink {
 	matches := wikiLinkPattern.FindAllStringSubmatch(content, -1)
 	links := make([]ParsedLink, 0, len(matches))
-	byNorm := make(map[string]int, len(matches))
+	byKey := make(map[string]int, len(matches))
 
 	for _, match := range matches {
-		targetRef, displayText := splitWikiLink(match[1])
-		norm := normalizeLinkRef(targetRef)
+		parts := parseWikiLinkBody(match[1])
+		norm := normalizeLinkRef(parts.Target)
 		if norm == "" {
 			continue
 		}
 
-		if idx, ok := byNorm[norm]; ok {
+		key := norm + "\x00" + parts.AnchorType + "\x00" + normalizeLinkRef(parts.Anchor)
+		if idx, ok := byKey[key]; ok {
 			links[idx].OccurrenceCount++
 			continue
 		}
 
-		byNorm[norm] = len(links)
+		byKey[key] = len(links)
 		links = append(links, ParsedLink{
-			TargetRef:       targetRef,
+			TargetRef:       parts.Target,
 			TargetRefNorm:   norm,
-			DisplayText:     displayText,
+			DisplayText:     parts.Display,
 			LinkType:        wikiLinkType,
+			AnchorType:      parts.AnchorType,
+			Anchor:          parts.Anchor,
+			RawRef:          parts.raw,
 			OccurrenceCount: 1,
 		})
 	}
@@ -48,6 +75,47 @@ func ParseWikiLinks(content string) []ParsedLink {
 	return links
 }
 
+func parseWikiLinkBody(raw string) wikiLinkParts {
+	ref, displayText := splitWikiLink(raw)
+	parts := wikiLinkParts{
+		Target:     ref,
+		AnchorType: AnchorNone,
+		Display:    displayText,
+		HasDisplay: strings.Contains(raw, "|") && displayText != ref,
+		raw:        ref,
+	}
+	if idx := strings.IndexAny(ref, "#^"); idx >= 0 {
+		anchor := strings.TrimSpace(ref[idx+1:])
+		if anchor != "" {
+			parts.Target = strings.TrimSpace(ref[:idx])
+			parts.Anchor = anchor
+			parts.AnchorType = AnchorHeading
+			if ref[idx] == '^' {
+				parts.AnchorType = AnchorBlock
+			}
+		}
+	}
+	return parts
+}
+
+func (p wikiLinkParts) ref() string {
+	switch p.AnchorType {
+	case AnchorHeading:
+		return p.Target + "#" + p.Anchor
+	case AnchorBlock:
+		return p.Target + "^" + p.Anchor
+	default:
+		return p.Target
+	}
+}
+
+func (p wikiLinkParts) String() string {
+	if p.HasDisplay {
+		return p.ref() + "|" + p.Display
+	}
+	return p.ref()
+}
+
 func splitWikiLink(raw string) (string, string) {
 	parts := strings.SplitN(raw, "|", 2)
 	targetRef := strings.TrimSpace(parts[0])
@@ -65,3 +133,18 @@ func normalizeLinkRef(value string) string {
 	fields := strings.FieldsFunc(strings.TrimSpace(value), unicode.IsSpace)
 	return strings.ToLower(strings.Join(fields, " "))
 }
+
+// rewriteWikiLinks replaces each wiki link for which fn returns new parts;
+// other links and surrounding text are left untouched.
+func rewriteWikiLinks(content string, fn func(parts wikiLinkParts) (wikiLinkParts, bool)) (string, int) {
+	changed := 0
+	out := wikiLinkPattern.ReplaceAllStringFunc(content, func(match string) string {
+		replacement, ok := fn(parseWikiLinkBody(match[2 : len(match)-2]))
+		if !ok {
+			return match
+		}
+		changed++
+		return "[[" + replacement.String() + "]]"
+	})
+	return out, changed
+}
@@ -0,0 +1,172 @@
+package notes
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"smarticky/ent/notelink"
+
+	"github.com/google/uuid"
+)
+
+var (
+	headingPattern = regexp.MustCompile(`^ {0,3}(#{1,6})[ \t]+(.+?)(?:[ \t]+#+)?[ \t]*$`)
+	blockIDPattern = regexp.MustCompile(`(?:^|\s)\^([A-Za-z0-9][A-Za-z0-9-]*)[ \t]*$`)
+)
+
+// MarkdownHeadings returns ATX heading texts in document order, skipping
+// front matter and fenced code blocks.
+func MarkdownHeadings(content string) []string {
+	var headings []string
+	forEachMarkdownLine(content, func(line string) {
+		if match := headingPattern.FindStringSubmatch(line); match != nil {
+			headings = append(headings, strings.TrimSpace(match[2]))
+		}
+	})
+	return headings
+}
+
+// MarkdownBlockIDs returns `^block-id` markers that end a line.
+func MarkdownBlockIDs(content string) []string {
+	var ids []string
+	forEachMarkdownLine(content, func(line string) {
+		if match := blockIDPattern.FindStringSubmatch(line); match != nil {
+			ids = append(ids, match[1])
+		}
+	})
+	return ids
+}
+
+// AnchorExists reports whether a heading or block anchor is present in content.
+func AnchorExists(content, anchorType, anchor string) bool {
+	switch anchorType {
+	case AnchorHeading:
+		norm := normalizeLinkRef(anchor)
+		for _, heading := range MarkdownHeadings(content) {
+			if normalizeLinkRef(heading) == norm {
+				return true
+			}
+		}
+		return false
+	case AnchorBlock:
+		anchor = strings.TrimSpace(anchor)
+		for _, id := range MarkdownBlockIDs(content) {
+			if id == anchor {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+func forEachMarkdownLine(content string, fn func(line string)) {
+	_, body, ok := ParseFrontMatter(content)
+	if !ok {
+		body = content
+	}
+	fence := ""
+	for _, line := range strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+		fn(line)
+	}
+}
+
+// headingRenames pairs headings that disappeared with headings that appeared
+// in the same order. Edits that add or remove a different number of headings
+// are ambiguous and produce no renames.
+func headingRenames(oldContent, newContent string) map[string]string {
+	oldHeadings := MarkdownHeadings(oldContent)
+	newHeadings := MarkdownHeadings(newContent)
+	oldSet := make(map[string]bool, len(oldHeadings))
+	for _, heading := range oldHeadings {
+		oldSet[normalizeLinkRef(heading)] = true
+	}
+	newSet := make(map[string]bool, len(newHeadings))
+	for _, heading := range newHeadings {
+		newSet[normalizeLinkRef(heading)] = true
+	}
+
+	var removed, added []string
+	for _, heading := range oldHeadings {
+		if !newSet[normalizeLinkRef(heading)] {
+			removed = append(removed, heading)
+		}
+	}
+	for _, heading := range newHeadings {
+		if !oldSet[normalizeLinkRef(heading)] {
+			added = append(added, heading)
+		}
+	}
+	if len(removed) == 0 || len(removed) != len(added) {
+		return nil
+	}
+
+	renames := make(map[string]string, len(removed))
+	for i, heading := range removed {
+		renames[normalizeLinkRef(heading)] = added[i]
+	}
+	return renames
+}
+
+// RepairHeadingAnchors rewrites `[[Note#Heading]]` backlinks after the target
+// note renamed headings, returning how many link occurrences were updated.
+func (s *Service) RepairHeadingAnchors(ctx context.Context, userID int, noteID uuid.UUID, oldContent, newContent string) (int, error) {
+	renames := headingRenames(oldContent, newContent)
+	if len(renames) == 0 {
+		return 0, nil
+	}
+
+	rows, err := s.client.NoteLink.Query().
+		Where(
+			notelink.UserIDEQ(userID),
+			notelink.TargetNoteIDEQ(noteID),
+			notelink.AnchorTypeEQ(notelink.AnchorTypeHeading),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Source notes may refer to the target by title or alias, so remember the
+	// exact reference each source used.
+	refsBySource := make(map[uuid.UUID]map[string]bool)
+	var sources []uuid.UUID
+	for _, row := range rows {
+		if _, ok := renames[normalizeLinkRef(row.Anchor)]; !ok {
+			continue
+		}
+		refs, ok := refsBySource[row.SourceNoteID]
+		if !ok {
+			refs = make(map[string]bool)
+			refsBySource[row.SourceNoteID] = refs
+			sources = append(sources, row.SourceNoteID)
+		}
+		refs[row.TargetRefNorm] = true
+	}
+
+	_, rewritten, err := s.rewriteNotesLinks(ctx, userID, sources, func(sourceID uuid.UUID, parts wikiLinkParts) (wikiLinkParts, bool) {
+		if parts.AnchorType != AnchorHeading || !refsBySource[sourceID][normalizeLinkRef(parts.Target)] {
+			return parts, false
+		}
+		renamed, ok := renames[normalizeLinkRef(parts.Anchor)]
+		if !ok {
+			return parts, false
+		}
+		parts.Anchor = renamed
+		return parts, true
+	}, nil)
+	return rewritten, err
+}
@@ -0,0 +1,103 @@
+package notes
+
+import (
+	"context"
+	"testing"
+
+	"smarticky/ent/enttest"
+	"smarticky/ent/notelink"
+
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestParseWikiLinksSplitsHeadingAndBlockAnchors(t *testing.T) {
+	got := ParseWikiLinks("[[Guide#Install Steps]] [[guide#install  steps|again]] [[Guide^abc-1]] [[Guide]]")
+
+	if len(got) != 3 {
+		t.Fatalf("expected 3 links, got %d: %+v", len(got), got)
+	}
+	if got[0].TargetRef != "Guide" || got[0].AnchorType != AnchorHeading || got[0].Anchor != "Install Steps" || got[0].OccurrenceCount != 2 {
+		t.Fatalf("unexpected heading link: %+v", got[0])
+	}
+	if got[1].AnchorType != AnchorBlock || got[1].Anchor != "abc-1" || got[1].DisplayText != "Guide^abc-1" {
+		t.Fatalf("unexpected block link: %+v", got[1])
+	}
+	if got[2].AnchorType != AnchorNone || got[2].Anchor != "" {
+		t.Fatalf("unexpected plain link: %+v", got[2])
+	}
+}
+
+func TestSyncNoteLinksResolvesAliasesAndStoresAnchors(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestSyncNoteLinksResolvesAliasesAndStoresAnchors?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	fieldAlias := client.Note.Create().SetTitle("Kubernetes").SetAliases([]string{"k8s"}).SetUserID(owner.ID).SaveX(ctx)
+	frontMatterAlias := client.Note.Create().
+		SetTitle("Continuous Integration").
+		SetContent("---\naliases:\n  - CI\n---\n# Pipelines\n").
+		SetUserID(owner.ID).
+		SaveX(ctx)
+	hashTitle := client.Note.Create().SetTitle("C# Tips").SetUserID(owner.ID).SaveX(ctx)
+	source := client.Note.Create().
+		SetTitle("Source").
+		SetContent("[[K8S]] [[ci#Pipelines]] [[C# Tips]] [[Kubernetes]]").
+		SetUserID(owner.ID).
+		SaveX(ctx)
+
+	if err := NewService(client).SyncNoteLinks(ctx, owner.ID, source.ID); err != nil {
+		t.Fatalf("sync links: %v", err)
+	}
+
+	aliasLink := client.NoteLink.Query().Where(notelink.SourceNoteIDEQ(source.ID), notelink.TargetNoteIDEQ(fieldAlias.ID)).OnlyX(ctx)
+	if aliasLink.OccurrenceCount != 2 {
+		t.Fatalf("expected title and alias links to merge, got %+v", aliasLink)
+	}
+	anchored := client.NoteLink.Query().Where(notelink.SourceNoteIDEQ(source.ID), notelink.TargetNoteIDEQ(frontMatterAlias.ID)).OnlyX(ctx)
+	if anchored.AnchorType != notelink.AnchorTypeHeading || anchored.Anchor != "Pipelines" || anchored.TargetKey != "note:"+frontMatterAlias.ID.String()+"#pipelines" {
+		t.Fatalf("expected heading anchor through front matter alias, got %+v", anchored)
+	}
+	hashLink := client.NoteLink.Query().Where(notelink.SourceNoteIDEQ(source.ID), notelink.TargetNoteIDEQ(hashTitle.ID)).OnlyX(ctx)
+	if hashLink.AnchorType != notelink.AnchorTypeNone {
+		t.Fatalf("expected title containing # to resolve as a plain link, got %+v", hashLink)
+	}
+}
+
+func TestRepairHeadingAnchorsRewritesBacklinks(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestRepairHeadingAnchorsRewritesBacklinks?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	oldContent := "# Setup\n\n## Install\n\n```\n# not a heading\n```\n"
+	target := client.Note.Create().SetTitle("Guide").SetContent(oldContent).SetUserID(owner.ID).SaveX(ctx)
+	source := client.Note.Create().
+		SetTitle("Source").
+		SetContent("[[Guide#Install|how to install]] and [[Guide#Setup]]").
+		SetUserID(owner.ID).
+		SaveX(ctx)
+
+	svc := NewService(client)
+	if err := svc.SyncNoteLinks(ctx, owner.ID, source.ID); err != nil {
+		t.Fatalf("sync links: %v", err)
+	}
+
+	newContent := "# Setup\n\n## Installation\n"
+	client.Note.UpdateOne(target).SetContent(newContent).ExecX(ctx)
+	rewritten, err := svc.RepairHeadingAnchors(ctx, owner.ID, target.ID, oldContent, newContent)
+	if err != nil {
+		t.Fatalf("repair anchors: %v", err)
+	}
+	if rewritten != 1 {
+		t.Fatalf("expected one rewritten link, got %d", rewritten)
+	}
+
+	updated := client.Note.GetX(ctx, source.ID)
+	if want := "[[Guide#Installation|how to install]] and [[Guide#Setup]]"; updated.Content != want {
+		t.Fatalf("expected %q, got %q", want, updated.Content)
+	}
+	if !client.NoteLink.Query().Where(notelink.SourceNoteIDEQ(source.ID), notelink.AnchorEQ("Installation")).ExistX(ctx) {
+		t.Fatalf("expected re-synced link to carry the renamed anchor")
+	}
+}
@@ -0,0 +1,321 @@
+package notes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"smarticky/ent"
+	"smarticky/ent/linkrewrite"
+	"smarticky/ent/note"
+	"smarticky/ent/notelink"
+	"smarticky/ent/user"
+
+	"github.com/google/uuid"
+)
+
+const (
+	RenameModeAsk  = "ask"
+	RenameModeAuto = "auto"
+	RenameModeOff  = "off"
+)
+
+var (
+	ErrRewriteNotFound      = errors.New("link rewrite not found")
+	ErrRewriteAlreadyUndone = errors.New("link rewrite was already undone")
+	ErrTitleUnchanged       = errors.New("note title did not change")
+)
+
+type RenameBacklink struct {
+	SourceNoteID    uuid.UUID `json:"source_note_id"`
+	SourceTitle     string    `json:"source_title"`
+	OccurrenceCount int       `json:"occurrence_count"`
+}
+
+// RenameOffer describes backlinks that still use a note's previous title.
+type RenameOffer struct {
+	NoteID    uuid.UUID        `json:"note_id"`
+	OldTitle  string           `json:"old_title"`
+	NewTitle  string           `json:"new_title"`
+	Backlinks []RenameBacklink `json:"backlinks"`
+}
+
+type LinkRewriteView struct {
+	ID             uuid.UUID   `json:"id"`
+	NoteID         uuid.UUID   `json:"note_id"`
+	OldTitle       string      `json:"old_title"`
+	NewTitle       string      `json:"new_title"`
+	Status         string      `json:"status"`
+	RewrittenCount int         `json:"rewritten_count"`
+	UpdatedNoteIDs []uuid.UUID `json:"updated_note_ids"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UndoneAt       *time.Time  `json:"undone_at,omitempty"`
+}
+
+type UndoLinkRewriteResult struct {
+	Rewrite         LinkRewriteView `json:"rewrite"`
+	RestoredNoteIDs []uuid.UUID     `json:"restored_note_ids"`
+	// ConflictNoteIDs were edited after the rewrite and were left untouched.
+	ConflictNoteIDs []uuid.UUID `json:"conflict_note_ids"`
+}
+
+type linkRewriteChange struct {
+	NoteID uuid.UUID `json:"note_id"`
+	Before string    `json:"before"`
+	After  string    `json:"after"`
+}
+
+// RenameBacklinks lists notes whose wiki links still use oldTitle for noteID.
+// It works both before the rename is synced, when those links still resolve
+// to the note, and afterwards, when they have become dangling.
+func (s *Service) RenameBacklinks(ctx context.Context, userID int, noteID uuid.UUID, oldTitle string) ([]RenameBacklink, error) {
+	oldNorm := normalizeLinkRef(oldTitle)
+	if oldNorm == "" {
+		return []RenameBacklink{}, nil
+	}
+	rows, err := s.client.NoteLink.Query().
+		Where(
+			notelink.UserIDEQ(userID),
+			notelink.TargetRefNormEQ(oldNorm),
+			notelink.Or(notelink.TargetNoteIDEQ(noteID), notelink.TargetNoteIDIsNil()),
+		).
+		WithSourceNote().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bySource := make(map[uuid.UUID]*RenameBacklink)
+	for _, row := range rows {
+		source := row.Edges.SourceNote
+		if source == nil || source.ProtectionMode == note.ProtectionModeEncrypted {
+			continue
+		}
+		item, ok := bySource[source.ID]
+		if !ok {
+			item = &RenameBacklink{SourceNoteID: source.ID, SourceTitle: source.Title}
+			bySource[source.ID] = item
+		}
+		item.OccurrenceCount += row.OccurrenceCount
+	}
+
+	backlinks := make([]RenameBacklink, 0, len(bySource))
+	for _, item := range bySource {
+		backlinks = append(backlinks, *item)
+	}
+	sort.Slice(backlinks, func(i, j int) bool {
+		if backlinks[i].SourceTitle != backlinks[j].SourceTitle {
+			return backlinks[i].SourceTitle < backlinks[j].SourceTitle
+		}
+		return backlinks[i].SourceNoteID.String() < backlinks[j].SourceNoteID.String()
+	})
+	return backlinks, nil
+}
+
+// ApplyRenameRewrite rewrites `[[oldTitle]]` links to the note's current
+// title and records the change as a single undoable LinkRewrite.
+func (s *Service) ApplyRenameRewrite(ctx context.Context, userID int, noteID uuid.UUID, oldTitle string) (LinkRewriteView, error) {
+	target, err := s.client.Note.Query().
+		Where(note.IDEQ(noteID), note.HasUserWith(user.IDEQ(userID))).
+		Only(ctx)
+	if err != nil {
+		return LinkRewriteView{}, err
+	}
+	oldTitle = strings.TrimSpace(oldTitle)
+	oldNorm := normalizeLinkRef(oldTitle)
+	if oldNorm == "" || oldNorm == normalizeLinkRef(target.Title) {
+		return LinkRewriteView{}, ErrTitleUnchanged
+	}
+	if strings.ContainsAny(target.Title, "[]|#^") {
+		return LinkRewriteView{}, ErrUnlinkableTitle
+	}
+
+	backlinks, err := s.RenameBacklinks(ctx, userID, noteID, oldTitle)
+	if err != nil {
+		return LinkRewriteView{}, err
+	}
+	sources := make([]uuid.UUID, 0, len(backlinks))
+	for _, backlink := range backlinks {
+		sources = append(sources, backlink.SourceNoteID)
+	}
+
+	var record *ent.LinkRewrite
+	_, _, err = s.rewriteNotesLinks(ctx, userID, sources, func(_ uuid.UUID, parts wikiLinkParts) (wikiLinkParts, bool) {
+		if normalizeLinkRef(parts.Target) != oldNorm {
+			return parts, false
+		}
+		parts.Target = target.Title
+		return parts, true
+	}, func(tx *ent.Tx, changes []contentChange, rewritten int) error {
+		entries := make([]linkRewriteChange, 0, len(changes))
+		for _, change := range changes {
+			entries = append(entries, linkRewriteChange{NoteID: change.Row.ID, Before: change.Before, After: change.Row.Content})
+		}
+		data, err := json.Marshal(entries)
+		if err != nil {
+			return err
+		}
+		record, err = tx.LinkRewrite.Create().
+			SetUserID(userID).
+			SetNoteID(noteID).
+			SetOldTitle(oldTitle).
+			SetNewTitle(target.Title).
+			SetRewrittenCount(rewritten).
+			SetChangesJSON(string(data)).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return LinkRewriteView{}, err
+	}
+	if record == nil {
+		// No source note needed changes; still record the rename for undo.
+		record, err = s.client.LinkRewrite.Create().
+			SetUserID(userID).
+			SetNoteID(noteID).
+			SetOldTitle(oldTitle).
+			SetNewTitle(target.Title).
+			Save(ctx)
+		if err != nil {
+			return LinkRewriteView{}, err
+		}
+	}
+	return linkRewriteView(record), nil
+}
+
+// UndoLinkRewrite restores the note's previous title and the source notes'
+// previous content. Notes edited since the rewrite are reported, not reverted.
+func (s *Service) UndoLinkRewrite(ctx context.Context, userID int, id uuid.UUID) (UndoLinkRewriteResult, error) {
+	record, err := s.client.LinkRewrite.Query().
+		Where(linkrewrite.IDEQ(id), linkrewrite.UserIDEQ(userID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return UndoLinkRewriteResult{}, ErrRewriteNotFound
+	}
+	if err != nil {
+		return UndoLinkRewriteResult{}, err
+	}
+	if record.Status == linkrewrite.StatusUndone {
+		return UndoLinkRewriteResult{}, ErrRewriteAlreadyUndone
+	}
+	var changes []linkRewriteChange
+	if err := json.Unmarshal([]byte(record.ChangesJSON), &changes); err != nil {
+		return UndoLinkRewriteResult{}, err
+	}
+
+	result := UndoLinkRewriteResult{RestoredNoteIDs: []uuid.UUID{}, ConflictNoteIDs: []uuid.UUID{}}
+	var touched []*ent.Note
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return result, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, change := range changes {
+		row, err := tx.Note.Query().
+			Where(note.IDEQ(change.NoteID), note.HasUserWith(user.IDEQ(userID))).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return result, err
+		}
+		if row.Content != change.After {
+			result.ConflictNoteIDs = append(result.ConflictNoteIDs, row.ID)
+			continue
+		}
+		row, err = tx.Note.UpdateOne(row).SetContent(change.Before).Save(ctx)
+		if err != nil {
+			return result, err
+		}
+		touched = append(touched, row)
+		result.RestoredNoteIDs = append(result.RestoredNoteIDs, row.ID)
+	}
+
+	target, err := tx.Note.Query().
+		Where(note.IDEQ(record.NoteID), note.HasUserWith(user.IDEQ(userID))).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+	case err != nil:
+		return result, err
+	case target.Title != record.NewTitle:
+		result.ConflictNoteIDs = append(result.ConflictNoteIDs, target.ID)
+	default:
+		target, err = tx.Note.UpdateOne(target).SetTitle(record.OldTitle).Save(ctx)
+		if err != nil {
+			return result, err
+		}
+		touched = append(touched, target)
+		result.RestoredNoteIDs = append(result.RestoredNoteIDs, target.ID)
+	}
+
+	record, err = tx.LinkRewrite.UpdateOne(record).
+		SetStatus(linkrewrite.StatusUndone).
+		SetUndoneAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return result, err
+	}
+	if err := tx.Commit(); err != nil {
+		return result, err
+	}
+	committed = true
+
+	for _, row := range touched {
+		s.indexBestEffort(ctx, row)
+	}
+	if err := s.SyncUserLinks(ctx, userID); err != nil {
+		return result, err
+	}
+	result.Rewrite = linkRewriteView(record)
+	return result, nil
+}
+
+func (s *Service) ListLinkRewrites(ctx context.Context, userID int, noteID *uuid.UUID, limit int) ([]LinkRewriteView, error) {
+	query := s.client.LinkRewrite.Query().
+		Where(linkrewrite.UserIDEQ(userID)).
+		Order(ent.Desc(linkrewrite.FieldCreatedAt)).
+		Limit(clampLimit(limit))
+	if noteID != nil {
+		query.Where(linkrewrite.NoteIDEQ(*noteID))
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	views := make([]LinkRewriteView, 0, len(rows))
+	for _, row := range rows {
+		views = append(views, linkRewriteView(row))
+	}
+	return views, nil
+}
+
+func linkRewriteView(row *ent.LinkRewrite) LinkRewriteView {
+	var changes []linkRewriteChange
+	_ = json.Unmarshal([]byte(row.ChangesJSON), &changes)
+	ids := make([]uuid.UUID, 0, len(changes))
+	for _, change := range changes {
+		ids = append(ids, change.NoteID)
+	}
+	return LinkRewriteView{
+		ID:             row.ID,
+		NoteID:         row.NoteID,
+		OldTitle:       row.OldTitle,
+		NewTitle:       row.NewTitle,
+		Status:         string(row.Status),
+		RewrittenCount: row.RewrittenCount,
+		UpdatedNoteIDs: ids,
+		CreatedAt:      row.CreatedAt,
+		UndoneAt:       row.UndoneAt,
+	}
+}
@@ -0,0 +1,77 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"smarticky/ent/enttest"
+	"smarticky/ent/notelink"
+
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestApplyRenameRewriteAndUndo(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestApplyRenameRewriteAndUndo?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	target := client.Note.Create().SetTitle("Old Name").SetUserID(owner.ID).SaveX(ctx)
+	first := client.Note.Create().SetTitle("First").SetContent("[[Old Name]] and [[old  name^b1|block]]").SetUserID(owner.ID).SaveX(ctx)
+	second := client.Note.Create().SetTitle("Second").SetContent("[[Old Name#Intro]]").SetUserID(owner.ID).SaveX(ctx)
+	untouched := client.Note.Create().SetTitle("Third").SetContent("[[Other]]").SetUserID(owner.ID).SaveX(ctx)
+
+	svc := NewService(client)
+	if err := svc.SyncUserLinks(ctx, owner.ID); err != nil {
+		t.Fatalf("sync links: %v", err)
+	}
+	client.Note.UpdateOne(target).SetTitle("New Name").ExecX(ctx)
+	if err := svc.SyncUserLinks(ctx, owner.ID); err != nil {
+		t.Fatalf("sync links: %v", err)
+	}
+
+	backlinks, err := svc.RenameBacklinks(ctx, owner.ID, target.ID, "Old Name")
+	if err != nil {
+		t.Fatalf("rename backlinks: %v", err)
+	}
+	if len(backlinks) != 2 || backlinks[0].SourceNoteID != first.ID || backlinks[0].OccurrenceCount != 2 {
+		t.Fatalf("unexpected backlinks: %+v", backlinks)
+	}
+
+	rewrite, err := svc.ApplyRenameRewrite(ctx, owner.ID, target.ID, "Old Name")
+	if err != nil {
+		t.Fatalf("apply rewrite: %v", err)
+	}
+	if rewrite.RewrittenCount != 3 || len(rewrite.UpdatedNoteIDs) != 2 {
+		t.Fatalf("unexpected rewrite: %+v", rewrite)
+	}
+	if got := client.Note.GetX(ctx, first.ID).Content; got != "[[New Name]] and [[New Name^b1|block]]" {
+		t.Fatalf("unexpected rewritten content %q", got)
+	}
+	if got := client.Note.GetX(ctx, untouched.ID).Content; got != "[[Other]]" {
+		t.Fatalf("expected unrelated note to be untouched, got %q", got)
+	}
+	if n := client.NoteLink.Query().Where(notelink.TargetNoteIDEQ(target.ID)).CountX(ctx); n != 3 {
+		t.Fatalf("expected rewritten links to resolve, got %d", n)
+	}
+
+	// A later edit to the second note must survive the undo.
+	client.Note.UpdateOneID(second.ID).SetContent("[[New Name#Intro]] edited").ExecX(ctx)
+	result, err := svc.UndoLinkRewrite(ctx, owner.ID, rewrite.ID)
+	if err != nil {
+		t.Fatalf("undo rewrite: %v", err)
+	}
+	if len(result.ConflictNoteIDs) != 1 || result.ConflictNoteIDs[0] != second.ID {
+		t.Fatalf("expected the edited note to be reported as a conflict, got %+v", result)
+	}
+	if got := client.Note.GetX(ctx, first.ID).Content; got != "[[Old Name]] and [[old  name^b1|block]]" {
+		t.Fatalf("expected original content to be restored, got %q", got)
+	}
+	if got := client.Note.GetX(ctx, target.ID).Title; got != "Old Name" {
+		t.Fatalf("expected original title to be restored, got %q", got)
+	}
+	if _, err := svc.UndoLinkRewrite(ctx, owner.ID, rewrite.ID); !errors.Is(err, ErrRewriteAlreadyUndone) {
+		t.Fatalf("expected ErrRewriteAlreadyUndone, got %v", err)
+	}
+}
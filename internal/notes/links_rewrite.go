@@ -0,0 +1,87 @@
+package notes
+
+import (
+	"context"
+
+	"smarticky/ent"
+	"smarticky/ent/note"
+	"smarticky/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// contentChange is one source note rewritten by rewriteNotesLinks.
+type contentChange struct {
+	Row    *ent.Note
+	Before string
+}
+
+// rewriteNotesLinks applies fn to every wiki link in the given source notes in
+// one transaction, then re-syncs their outgoing links and search documents.
+// beforeCommit, when set, runs inside the same transaction so callers can
+// record the change atomically.
+func (s *Service) rewriteNotesLinks(ctx context.Context, userID int, sourceIDs []uuid.UUID, fn func(sourceID uuid.UUID, parts wikiLinkParts) (wikiLinkParts, bool), beforeCommit func(tx *ent.Tx, changes []contentChange, rewritten int) error) ([]contentChange, int, error) {
+	if len(sourceIDs) == 0 {
+		return nil, 0, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var changes []contentChange
+	rewritten := 0
+	for _, sourceID := range sourceIDs {
+		source, err := tx.Note.Query().
+			Where(note.IDEQ(sourceID), note.HasUserWith(user.IDEQ(userID))).
+			Only(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		if source.ProtectionMode == note.ProtectionModeEncrypted {
+			continue
+		}
+		content, changed := rewriteWikiLinks(source.Content, func(parts wikiLinkParts) (wikiLinkParts, bool) {
+			return fn(sourceID, parts)
+		})
+		if changed == 0 {
+			continue
+		}
+		row, err := tx.Note.UpdateOne(source).SetContent(content).Save(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		changes = append(changes, contentChange{Row: row, Before: source.Content})
+		rewritten += changed
+	}
+	if beforeCommit != nil {
+		if err := beforeCommit(tx, changes, rewritten); err != nil {
+			return nil, 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	committed = true
+
+	for _, change := range changes {
+		if err := s.SyncNoteLinks(ctx, userID, change.Row.ID); err != nil {
+			return nil, 0, err
+		}
+		s.indexBestEffort(ctx, change.Row)
+	}
+	return changes, rewritten, nil
+}
+
+func (s *Service) indexBestEffort(ctx context.Context, row *ent.Note) {
+	if s.search != nil {
+		_ = s.search.IndexNote(ctx, row)
+	}
+}
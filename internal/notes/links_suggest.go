@@ -0,0 +1,301 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"smarticky/ent"
+	"smarticky/ent/note"
+	"smarticky/ent/notelink"
+	"smarticky/ent/user"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultLinkCandidates = 5
+	minFuzzyLinkScore     = 0.6
+)
+
+var (
+	ErrLinkNotFound       = errors.New("link not found")
+	ErrLinkAlreadyTargets = errors.New("link is already resolved")
+	ErrLinkTargetNotFound = errors.New("link target note not found")
+	ErrUnlinkableTitle    = errors.New("target title cannot be written as a wiki link")
+)
+
+type LinkSuggestion struct {
+	NoteID      uuid.UUID `json:"note_id"`
+	Title       string    `json:"title"`
+	MatchedName string    `json:"matched_name"`
+	MatchKind   string    `json:"match_kind"`
+	Score       float64   `json:"score"`
+}
+
+type UnresolvedLink struct {
+	LinkID          uuid.UUID        `json:"link_id"`
+	SourceNoteID    uuid.UUID        `json:"source_note_id"`
+	SourceTitle     string           `json:"source_title"`
+	TargetRef       string           `json:"target_ref"`
+	OccurrenceCount int              `json:"occurrence_count"`
+	Candidates      []LinkSuggestion `json:"candidates"`
+}
+
+type LinkFix struct {
+	LinkID       uuid.UUID `json:"link_id"`
+	TargetNoteID uuid.UUID `json:"target_note_id"`
+}
+
+type FixLinksResult struct {
+	UpdatedNoteIDs []uuid.UUID `json:"updated_note_ids"`
+	Rewritten      int         `json:"rewritten"`
+}
+
+type linkCandidate struct {
+	row   *ent.Note
+	names []string
+}
+
+// SuggestLinkTargets ranks the user's notes for wiki-link autocomplete. An
+// empty query returns the most recently updated notes.
+func (s *Service) SuggestLinkTargets(ctx context.Context, userID int, query string, limit int) ([]LinkSuggestion, error) {
+	limit = clampLimit(limit)
+	candidates, err := s.linkCandidates(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if normalizeLinkRef(query) == "" {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].row.UpdatedAt.After(candidates[j].row.UpdatedAt)
+		})
+		result := make([]LinkSuggestion, 0, min(limit, len(candidates)))
+		for _, candidate := range candidates[:min(limit, len(candidates))] {
+			result = append(result, LinkSuggestion{
+				NoteID:      candidate.row.ID,
+				Title:       candidate.row.Title,
+				MatchedName: candidate.row.Title,
+				MatchKind:   "recent",
+			})
+		}
+		return result, nil
+	}
+	return rankLinkCandidates(candidates, query, true, uuid.Nil, limit), nil
+}
+
+// SuggestUnresolvedLinks lists dangling links, optionally for one source note,
+// each with fuzzy-matched candidate targets.
+func (s *Service) SuggestUnresolvedLinks(ctx context.Context, userID int, sourceNoteID *uuid.UUID, limit int) ([]UnresolvedLink, error) {
+	if limit <= 0 {
+		limit = defaultLinkCandidates
+	}
+	limit = clampLimit(limit)
+
+	query := s.client.NoteLink.Query().
+		Where(notelink.UserIDEQ(userID), notelink.TargetNoteIDIsNil()).
+		WithSourceNote().
+		Order(notelink.ByTargetRef())
+	if sourceNoteID != nil {
+		query.Where(notelink.SourceNoteIDEQ(*sourceNoteID))
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := s.linkCandidates(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]UnresolvedLink, 0, len(rows))
+	for _, row := range rows {
+		source := row.Edges.SourceNote
+		if source == nil || source.IsDeleted {
+			continue
+		}
+		result = append(result, UnresolvedLink{
+			LinkID:          row.ID,
+			SourceNoteID:    row.SourceNoteID,
+			SourceTitle:     source.Title,
+			TargetRef:       row.TargetRef,
+			OccurrenceCount: row.OccurrenceCount,
+			Candidates:      rankLinkCandidates(candidates, row.TargetRef, false, row.SourceNoteID, limit),
+		})
+	}
+	return result, nil
+}
+
+// FixDanglingLinks rewrites confirmed dangling links in their source notes to
+// point at the chosen target titles, then re-syncs those notes' links.
+func (s *Service) FixDanglingLinks(ctx context.Context, userID int, fixes []LinkFix) (FixLinksResult, error) {
+	result := FixLinksResult{UpdatedNoteIDs: []uuid.UUID{}}
+	if len(fixes) == 0 {
+		return result, nil
+	}
+
+	type rewrite struct {
+		from  string
+		title string
+	}
+	bySource := make(map[uuid.UUID][]rewrite)
+	var order []uuid.UUID
+	for _, fix := range fixes {
+		link, err := s.client.NoteLink.Query().
+			Where(notelink.IDEQ(fix.LinkID), notelink.UserIDEQ(userID)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return result, ErrLinkNotFound
+		}
+		if err != nil {
+			return result, err
+		}
+		if link.TargetNoteID != nil {
+			return result, ErrLinkAlreadyTargets
+		}
+		target, err := s.client.Note.Query().
+			Where(note.IDEQ(fix.TargetNoteID), note.HasUserWith(user.IDEQ(userID)), note.IsDeleted(false)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return result, ErrLinkTargetNotFound
+		}
+		if err != nil {
+			return result, err
+		}
+		if strings.ContainsAny(target.Title, "[]|") || normalizeLinkRef(target.Title) == "" {
+			return result, ErrUnlinkableTitle
+		}
+		if _, ok := bySource[link.SourceNoteID]; !ok {
+			order = append(order, link.SourceNoteID)
+		}
+		bySource[link.SourceNoteID] = append(bySource[link.SourceNoteID], rewrite{from: link.TargetRefNorm, title: target.Title})
+	}
+
+	updated, rewritten, err := s.rewriteNotesLinks(ctx, userID, order, func(sourceID uuid.UUID, parts wikiLinkParts) (wikiLinkParts, bool) {
+		norm := normalizeLinkRef(parts.Target)
+		for _, item := range bySource[sourceID] {
+			if item.from == norm {
+				parts.Target = item.title
+				return parts, true
+			}
+		}
+		return parts, false
+	}, nil)
+	if err != nil {
+		return result, err
+	}
+	result.Rewritten = rewritten
+	for _, change := range updated {
+		result.UpdatedNoteIDs = append(result.UpdatedNoteIDs, change.Row.ID)
+	}
+	return result, nil
+}
+
+func (s *Service) linkCandidates(ctx context.Context, userID int) ([]linkCandidate, error) {
+	rows, err := s.client.Note.Query().
+		Where(note.HasUserWith(user.IDEQ(userID)), note.IsDeleted(false)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]linkCandidate, 0, len(rows))
+	for _, row := range rows {
+		names := append([]string{row.Title}, NoteAliases(row.Aliases, row.Content)...)
+		candidates = append(candidates, linkCandidate{row: row, names: names})
+	}
+	return candidates, nil
+}
+
+func rankLinkCandidates(candidates []linkCandidate, query string, prefix bool, exclude uuid.UUID, limit int) []LinkSuggestion {
+	suggestions := make([]LinkSuggestion, 0)
+	for _, candidate := range candidates {
+		if candidate.row.ID == exclude {
+			continue
+		}
+		best := LinkSuggestion{}
+		for _, name := range candidate.names {
+			score, kind := linkMatchScore(query, name, prefix)
+			if score > best.Score {
+				best = LinkSuggestion{
+					NoteID:      candidate.row.ID,
+					Title:       candidate.row.Title,
+					MatchedName: name,
+					MatchKind:   kind,
+					Score:       score,
+				}
+			}
+		}
+		if best.Score >= minFuzzyLinkScore {
+			suggestions = append(suggestions, best)
+		}
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].Title < suggestions[j].Title
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+// linkMatchScore scores a name against a link reference in [0,1]. Prefix mode
+// also tolerates typos in a partially typed autocomplete query.
+func linkMatchScore(query, name string, prefix bool) (float64, string) {
+	q := []rune(normalizeLinkRef(query))
+	n := []rune(normalizeLinkRef(name))
+	if len(q) == 0 || len(n) == 0 {
+		return 0, ""
+	}
+	qs, ns := string(q), string(n)
+	switch {
+	case qs == ns:
+		return 1, "exact"
+	case strings.HasPrefix(ns, qs):
+		return roundScore(0.9 + 0.09*float64(len(q))/float64(len(n))), "prefix"
+	case strings.Contains(ns, qs) || strings.Contains(qs, ns):
+		shorter, longer := min(len(q), len(n)), max(len(q), len(n))
+		return roundScore(0.7 + 0.19*float64(shorter)/float64(longer)), "contains"
+	}
+
+	score := 1 - float64(editDistance(q, n))/float64(max(len(q), len(n)))
+	if prefix && len(n) > len(q) {
+		head := n[:len(q)]
+		prefixScore := 0.85 * (1 - float64(editDistance(q, head))/float64(len(q)))
+		score = max(score, prefixScore)
+	}
+	return roundScore(score * 0.89), "fuzzy"
+}
+
+// editDistance is the optimal string alignment distance, so a swapped pair of
+// adjacent letters counts as one typo.
+func editDistance(a, b []rune) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
+
+func roundScore(score float64) float64 {
+	return float64(int(score*1000+0.5)) / 1000
+}
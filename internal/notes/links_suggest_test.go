@@ -0,0 +1,98 @@
+package notes
+
+import (
+	"context"
+	"testing"
+
+	"smarticky/ent/enttest"
+	"smarticky/ent/notelink"
+
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestSuggestLinkTargetsToleratesTyposInPrefix(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestSuggestLinkTargetsToleratesTyposInPrefix?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	other := client.User.Create().SetUsername("other").SetPasswordHash("hash").SaveX(ctx)
+	roadmap := client.Note.Create().SetTitle("Product Roadmap").SetUserID(owner.ID).SaveX(ctx)
+	client.Note.Create().SetTitle("Grocery List").SetUserID(owner.ID).SaveX(ctx)
+	client.Note.Create().SetTitle("Product Roadmap").SetUserID(other.ID).SaveX(ctx)
+	client.Note.Create().SetTitle("Product Roadmap Draft").SetIsDeleted(true).SetUserID(owner.ID).SaveX(ctx)
+
+	svc := NewService(client)
+	prefix, err := svc.SuggestLinkTargets(ctx, owner.ID, "prod", 10)
+	if err != nil {
+		t.Fatalf("suggest prefix: %v", err)
+	}
+	if len(prefix) != 1 || prefix[0].NoteID != roadmap.ID || prefix[0].MatchKind != "prefix" {
+		t.Fatalf("expected owned live prefix match, got %+v", prefix)
+	}
+
+	typo, err := svc.SuggestLinkTargets(ctx, owner.ID, "prodcut road", 10)
+	if err != nil {
+		t.Fatalf("suggest typo: %v", err)
+	}
+	if len(typo) == 0 || typo[0].NoteID != roadmap.ID || typo[0].MatchKind != "fuzzy" {
+		t.Fatalf("expected fuzzy match for transposed letters, got %+v", typo)
+	}
+}
+
+func TestFixDanglingLinksRewritesSourceContent(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestFixDanglingLinksRewritesSourceContent?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	target := client.Note.Create().SetTitle("Meeting Notes").SetUserID(owner.ID).SaveX(ctx)
+	source := client.Note.Create().
+		SetTitle("Source").
+		SetContent("See [[Meeting Ntoes]] and [[meeting ntoes|last week]]; keep [[Elsewhere]].").
+		SetUserID(owner.ID).
+		SaveX(ctx)
+
+	svc := NewService(client)
+	if err := svc.SyncNoteLinks(ctx, owner.ID, source.ID); err != nil {
+		t.Fatalf("sync links: %v", err)
+	}
+
+	unresolved, err := svc.SuggestUnresolvedLinks(ctx, owner.ID, &source.ID, 3)
+	if err != nil {
+		t.Fatalf("suggest unresolved: %v", err)
+	}
+	if len(unresolved) != 2 {
+		t.Fatalf("expected 2 unresolved links, got %+v", unresolved)
+	}
+	var typoLink UnresolvedLink
+	for _, link := range unresolved {
+		if link.TargetRef == "Meeting Ntoes" {
+			typoLink = link
+		}
+	}
+	if len(typoLink.Candidates) == 0 || typoLink.Candidates[0].NoteID != target.ID {
+		t.Fatalf("expected Meeting Notes as top candidate, got %+v", typoLink)
+	}
+
+	result, err := svc.FixDanglingLinks(ctx, owner.ID, []LinkFix{{LinkID: typoLink.LinkID, TargetNoteID: target.ID}})
+	if err != nil {
+		t.Fatalf("fix links: %v", err)
+	}
+	if result.Rewritten != 2 || len(result.UpdatedNoteIDs) != 1 {
+		t.Fatalf("expected two rewrites in one note, got %+v", result)
+	}
+
+	updated := client.Note.GetX(ctx, source.ID)
+	want := "See [[Meeting Notes]] and [[Meeting Notes|last week]]; keep [[Elsewhere]]."
+	if updated.Content != want {
+		t.Fatalf("expected content %q, got %q", want, updated.Content)
+	}
+	if count := client.NoteLink.Query().Where(notelink.SourceNoteIDEQ(source.ID), notelink.TargetNoteIDEQ(target.ID)).CountX(ctx); count != 1 {
+		t.Fatalf("expected rewritten link to resolve, got %d", count)
+	}
+
+	if _, err := svc.FixDanglingLinks(ctx, owner.ID, []LinkFix{{LinkID: typoLink.LinkID, TargetNoteID: target.ID}}); err != ErrLinkNotFound {
+		t.Fatalf("expected replaced link row to be gone, got %v", err)
+	}
+}
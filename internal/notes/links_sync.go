@@ -3,6 +3,7 @@ package notes
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"smarticky/ent"
 	"smarticky/ent/note"
@@ -19,13 +20,23 @@ func (s *Service) SyncNoteLinks(ctx context.Context, userID int, sourceNoteID uu
 	if err != nil {
 		return err
 	}
+	return s.syncNoteLinks(ctx, userID, source, nil)
+}
 
+// syncNoteLinks replaces the outgoing links of source. The index of link
+// targets is built on first use when targets is nil.
+func (s *Service) syncNoteLinks(ctx context.Context, userID int, source *ent.Note, targets *linkTargetIndex) error {
+	sourceNoteID := source.ID
 	var resolvedLinks []resolvedLink
 	if source.ProtectionMode != note.ProtectionModeEncrypted {
-		resolvedLinks, err = s.resolveParsedLinks(ctx, userID, ParseWikiLinks(source.Content))
-		if err != nil {
-			return err
+		parsed := ParseWikiLinks(source.Content)
+		if len(parsed) > 0 && targets == nil {
+			var err error
+			if targets, err = s.linkTargets(ctx, userID); err != nil {
+				return err
+			}
 		}
+		resolvedLinks = resolveParsedLinks(targets, parsed)
 	}
 
 	tx, err := s.client.Tx(ctx)
@@ -63,6 +74,8 @@ func (s *Service) SyncNoteLinks(ctx context.Context, userID int, sourceNoteID uu
 			SetTargetKey(link.TargetKey).
 			SetDisplayText(link.DisplayText).
 			SetLinkType(notelink.LinkTypeWiki).
+			SetAnchorType(notelink.AnchorType(link.AnchorType)).
+			SetAnchor(link.Anchor).
 			SetOccurrenceCount(link.OccurrenceCount)
 		if link.TargetNoteID != nil {
 			builder.SetTargetNoteID(*link.TargetNoteID)
@@ -80,15 +93,18 @@ func (s *Service) SyncNoteLinks(ctx context.Context, userID int, sourceNoteID uu
 	return nil
 }
 
+// SyncUserLinks rebuilds the links of every note of the user, resolving
+// them against one index of titles and aliases.
 func (s *Service) SyncUserLinks(ctx context.Context, userID int) error {
-	ids, err := s.client.Note.Query().
+	rows, err := s.client.Note.Query().
 		Where(note.HasUserWith(user.IDEQ(userID))).
-		IDs(ctx)
+		All(ctx)
 	if err != nil {
 		return err
 	}
-	for _, id := range ids {
-		if err := s.SyncNoteLinks(ctx, userID, id); err != nil {
+	targets := newLinkTargetIndex(rows)
+	for _, row := range rows {
+		if err := s.syncNoteLinks(ctx, userID, row, targets); err != nil {
 			return err
 		}
 	}
@@ -101,60 +117,116 @@ type resolvedLink struct {
 	TargetKey    string
 }
 
-func (s *Service) resolveParsedLinks(ctx context.Context, userID int, parsed []ParsedLink) ([]resolvedLink, error) {
+// linkTargetIndex maps titles and aliases to the notes that carry them, so
+// links resolve without rescanning every note.
+type linkTargetIndex struct {
+	titles      map[string][]uuid.UUID
+	foldedTitle map[string][]uuid.UUID
+	aliases     map[string][]uuid.UUID
+}
+
+func newLinkTargetIndex(rows []*ent.Note) *linkTargetIndex {
+	index := &linkTargetIndex{
+		titles:      make(map[string][]uuid.UUID, len(rows)),
+		foldedTitle: make(map[string][]uuid.UUID, len(rows)),
+		aliases:     make(map[string][]uuid.UUID),
+	}
+	for _, row := range rows {
+		index.titles[row.Title] = append(index.titles[row.Title], row.ID)
+		folded := normalizeLinkRef(row.Title)
+		index.foldedTitle[folded] = append(index.foldedTitle[folded], row.ID)
+		seen := make(map[string]bool)
+		for _, alias := range NoteAliases(row.Aliases, row.Content) {
+			norm := normalizeLinkRef(alias)
+			if seen[norm] {
+				continue
+			}
+			seen[norm] = true
+			index.aliases[norm] = append(index.aliases[norm], row.ID)
+		}
+	}
+	return index
+}
+
+func (s *Service) linkTargets(ctx context.Context, userID int) (*linkTargetIndex, error) {
+	rows, err := s.client.Note.Query().
+		Where(note.HasUserWith(user.IDEQ(userID))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newLinkTargetIndex(rows), nil
+}
+
+func resolveParsedLinks(targets *linkTargetIndex, parsed []ParsedLink) []resolvedLink {
+	if len(parsed) == 0 {
+		return nil
+	}
 	resolved := make([]resolvedLink, 0, len(parsed))
+	byKey := make(map[string]int, len(parsed))
 	for _, link := range parsed {
-		targetID, err := s.resolveLinkTarget(ctx, userID, link.TargetRef, link.TargetRefNorm)
-		if err != nil {
-			return nil, err
+		targetID := targets.resolve(link.TargetRef, link.TargetRefNorm)
+		if targetID == nil && link.AnchorType != AnchorNone {
+			// A title such as "C# tips" is more likely than a heading link
+			// when the full reference names an existing note.
+			if fullID := targets.resolve(link.RawRef, normalizeLinkRef(link.RawRef)); fullID != nil {
+				targetID = fullID
+				link.TargetRef = link.RawRef
+				link.TargetRefNorm = normalizeLinkRef(link.RawRef)
+				link.AnchorType = AnchorNone
+				link.Anchor = ""
+			}
 		}
 
 		targetKey := "title:" + link.TargetRefNorm
 		if targetID != nil {
 			targetKey = "note:" + targetID.String()
 		}
+		targetKey += anchorKey(link.AnchorType, link.Anchor)
+		if idx, ok := byKey[targetKey]; ok {
+			// A title and an alias of the same note collapse into one link.
+			resolved[idx].OccurrenceCount += link.OccurrenceCount
+			continue
+		}
+		byKey[targetKey] = len(resolved)
 		resolved = append(resolved, resolvedLink{
 			ParsedLink:   link,
 			TargetNoteID: targetID,
 			TargetKey:    targetKey,
 		})
 	}
-	return resolved, nil
+	return resolved
 }
 
-func (s *Service) resolveLinkTarget(ctx context.Context, userID int, targetRef string, targetRefNorm string) (*uuid.UUID, error) {
-	exact, err := s.client.Note.Query().
-		Where(note.TitleEQ(targetRef), note.HasUserWith(user.IDEQ(userID))).
-		All(ctx)
-	if err != nil {
-		return nil, err
+func anchorKey(anchorType, anchor string) string {
+	switch anchorType {
+	case AnchorHeading:
+		return "#" + normalizeLinkRef(anchor)
+	case AnchorBlock:
+		return "^" + strings.TrimSpace(anchor)
+	default:
+		return ""
 	}
-	if len(exact) == 1 {
-		id := exact[0].ID
-		return &id, nil
+}
+
+// resolve matches an exact title first, then a folded title, then a folded
+// alias. Ambiguous matches at any level leave the link unresolved.
+func (idx *linkTargetIndex) resolve(targetRef string, targetRefNorm string) *uuid.UUID {
+	if exact := idx.titles[targetRef]; len(exact) > 0 {
+		return uniqueNoteID(exact)
 	}
-	if len(exact) > 1 {
-		return nil, nil
+	if folded := idx.foldedTitle[targetRefNorm]; len(folded) > 0 {
+		return uniqueNoteID(folded)
 	}
+	return uniqueNoteID(idx.aliases[targetRefNorm])
+}
 
-	rows, err := s.client.Note.Query().
-		Where(note.HasUserWith(user.IDEQ(userID))).
-		All(ctx)
-	if err != nil {
-		return nil, err
-	}
-	var match *uuid.UUID
-	for _, row := range rows {
-		if normalizeLinkRef(row.Title) != targetRefNorm {
-			continue
-		}
-		if match != nil {
-			return nil, nil
-		}
-		id := row.ID
-		match = &id
+func uniqueNoteID(ids []uuid.UUID) *uuid.UUID {
+	if len(ids) != 1 {
+		return nil
 	}
-	return match, nil
+	id := ids[0]
+	return &id
 }
 
 func (s *Service) DeleteLinksForNotes(ctx context.Context, userID int, noteIDs ...uuid.UUID) error {
@@ -0,0 +1,87 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"smarticky/ent"
+	"smarticky/ent/note"
+	"smarticky/ent/user"
+	searchsvc "smarticky/internal/search"
+
+	"github.com/google/uuid"
+)
+
+type SimilarInput struct {
+	// NoteID finds notes related to an owned note; Query is used when it is nil.
+	NoteID *uuid.UUID
+	Query  string
+	// Limit is left to the search index, which applies its own default and cap.
+	Limit int
+}
+
+type SimilarNote struct {
+	NoteView
+	Score float64 `json:"score"`
+}
+
+// Similar returns the user's notes ranked by semantic similarity.
+func (s *Service) Similar(ctx context.Context, userID int, input SimilarInput, redactLocked bool) ([]SimilarNote, error) {
+	if s.search == nil {
+		return nil, searchsvc.ErrSemanticDisabled
+	}
+	opts := searchsvc.SimilarOptions{
+		UserID: userID,
+		Text:   strings.TrimSpace(input.Query),
+		Limit:  input.Limit,
+	}
+	if input.NoteID != nil {
+		if _, err := s.client.Note.Query().
+			Where(note.IDEQ(*input.NoteID), note.HasUserWith(user.IDEQ(userID))).
+			Only(ctx); err != nil {
+			return nil, err
+		}
+		opts.NoteID = *input.NoteID
+		opts.Text = ""
+	} else if opts.Text == "" {
+		return nil, errors.New("note id or query is required")
+	}
+
+	hits, err := s.search.Similar(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(hits) == 0 {
+		return []SimilarNote{}, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.ID)
+	}
+	rows, err := s.client.Note.Query().
+		Where(note.IDIn(ids...), note.HasUserWith(user.IDEQ(userID)), note.IsDeleted(false)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*ent.Note, len(rows))
+	for _, row := range rows {
+		byID[row.ID] = row
+	}
+
+	result := make([]SimilarNote, 0, len(hits))
+	for _, hit := range hits {
+		row, ok := byID[hit.ID]
+		if !ok {
+			continue
+		}
+		view, err := s.noteToView(ctx, row, redactLocked)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, SimilarNote{NoteView: view, Score: hit.Score})
+	}
+	return result, nil
+}
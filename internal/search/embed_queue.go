@@ -0,0 +1,150 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// embeddingDebounce is how long a note must stay unchanged before a remote
+// embedder is asked for its vector, so autosaves while typing cost one call.
+const embeddingDebounce = 5 * time.Second
+
+// embedQueue embeds notes for remote embedders in the background, keeping
+// slow embedding calls off the request that saved the note. Each note is
+// queued once with its latest text.
+type embedQueue struct {
+	mu       sync.Mutex
+	debounce time.Duration
+	queued   map[uuid.UUID]queuedEmbedding
+	// inflight holds notes being embedded; false marks one deleted meanwhile.
+	inflight map[uuid.UUID]bool
+	wake     chan struct{}
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+type queuedEmbedding struct {
+	doc Document
+	due time.Time
+}
+
+func newEmbedQueue(debounce time.Duration) *embedQueue {
+	return &embedQueue{
+		debounce: debounce,
+		queued:   make(map[uuid.UUID]queuedEmbedding),
+		inflight: make(map[uuid.UUID]bool),
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// add queues doc, replacing an older version of the same note and restarting
+// its wait. The worker starts on first use.
+func (q *embedQueue) add(v *vectorStore, id uuid.UUID, doc Document) {
+	q.mu.Lock()
+	q.queued[id] = queuedEmbedding{doc: doc, due: time.Now().Add(q.debounce)}
+	if q.done == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		q.cancel = cancel
+		q.done = make(chan struct{})
+		go q.run(ctx, v)
+	}
+	q.mu.Unlock()
+	q.notify()
+}
+
+// remove drops a queued note and discards a vector still being computed.
+func (q *embedQueue) remove(id uuid.UUID) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.queued, id)
+	if _, ok := q.inflight[id]; ok {
+		q.inflight[id] = false
+	}
+}
+
+func (q *embedQueue) notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// stop ends the worker. Notes still queued keep their old vectors until the
+// next rebuild re-embeds them.
+func (q *embedQueue) stop() {
+	q.mu.Lock()
+	cancel, done := q.cancel, q.done
+	q.mu.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+func (q *embedQueue) run(ctx context.Context, v *vectorStore) {
+	defer close(q.done)
+	for {
+		wait := q.process(ctx, v, false)
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.wake:
+		case <-time.After(wait):
+		}
+	}
+}
+
+// process embeds the notes whose wait is over, or all of them when force is
+// set, and returns how long until the next one is due.
+func (q *embedQueue) process(ctx context.Context, v *vectorStore, force bool) time.Duration {
+	for ctx.Err() == nil {
+		ids, docs, wait := q.take(force)
+		if len(ids) == 0 {
+			return wait
+		}
+		vectors, err := v.embedDocuments(ctx, docs)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			zap.L().Warn("Failed to embed notes", zap.Int("notes", len(ids)), zap.Error(err))
+		}
+		q.mu.Lock()
+		for i, id := range ids {
+			wanted := q.inflight[id]
+			delete(q.inflight, id)
+			if err == nil && wanted {
+				v.put(id, vectors[i])
+			}
+		}
+		q.mu.Unlock()
+	}
+	return 0
+}
+
+// take removes up to one batch of due notes from the queue.
+func (q *embedQueue) take(force bool) ([]uuid.UUID, []Document, time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := time.Now()
+	wait := time.Hour
+	var ids []uuid.UUID
+	var docs []Document
+	for id, item := range q.queued {
+		if !force && item.due.After(now) {
+			wait = min(wait, item.due.Sub(now))
+			continue
+		}
+		if len(ids) == embeddingBatchSize {
+			return ids, docs, 0
+		}
+		delete(q.queued, id)
+		q.inflight[id] = true
+		ids = append(ids, id)
+		docs = append(docs, item.doc)
+	}
+	return ids, docs, wait
+}
@@ -0,0 +1,278 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	defaultHashedDimensions = 2048
+	embeddingBatchSize      = 32
+	maxEmbeddingTextRunes   = 8000
+)
+
+// Embedder turns note text into fixed-length vectors for similarity search.
+type Embedder interface {
+	// Name identifies the vector space; vectors from different names are never compared.
+	Name() string
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// corpusWeighted is implemented by embedders that emit raw term frequencies
+// and expect the vector store to apply inverse document frequency weights.
+type corpusWeighted interface {
+	CorpusWeighted() bool
+}
+
+// remoteEmbedder is implemented by embedders that call another service.
+// Their note vectors are computed in the background instead of on save.
+type remoteEmbedder interface {
+	Remote() bool
+}
+
+// HashedEmbedder is the dependency-free default: hashed word, word-bigram and
+// character n-gram term frequencies, weighted by IDF inside the vector store.
+type HashedEmbedder struct {
+	dims int
+}
+
+func NewHashedEmbedder(dims int) *HashedEmbedder {
+	if dims <= 0 {
+		dims = defaultHashedDimensions
+	}
+	return &HashedEmbedder{dims: dims}
+}
+
+func (e *HashedEmbedder) Name() string {
+	return "hashed-ngram-" + strconv.Itoa(e.dims)
+}
+
+func (e *HashedEmbedder) CorpusWeighted() bool {
+	return true
+}
+
+func (e *HashedEmbedder) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	out := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		out = append(out, e.embed(text))
+	}
+	return out, nil
+}
+
+func (e *HashedEmbedder) embed(text string) []float32 {
+	counts := make([]float64, e.dims)
+	add := func(feature string, weight float64) {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(feature))
+		counts[h.Sum64()%uint64(e.dims)] += weight
+	}
+
+	prevWord := ""
+	prevCJK := rune(0)
+	for _, token := range tokenize(text) {
+		if token.cjk {
+			r := []rune(token.text)[0]
+			add("c:"+token.text, 0.5)
+			if prevCJK != 0 {
+				add("c:"+string([]rune{prevCJK, r}), 1)
+			}
+			prevCJK = r
+			prevWord = ""
+			continue
+		}
+		prevCJK = 0
+		add("w:"+token.text, 1)
+		if prevWord != "" {
+			add("b:"+prevWord+" "+token.text, 0.5)
+		}
+		prevWord = token.text
+
+		grams := charTrigrams(token.text)
+		for _, gram := range grams {
+			add("g:"+gram, 1/float64(len(grams)))
+		}
+	}
+
+	vector := make([]float32, e.dims)
+	for i, count := range counts {
+		if count > 0 {
+			vector[i] = float32(1 + math.Log(1+count))
+		}
+	}
+	return vector
+}
+
+type textToken struct {
+	text string
+	cjk  bool
+}
+
+func tokenize(text string) []textToken {
+	var tokens []textToken
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, textToken{text: string(word)})
+			word = word[:0]
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case isCJK(r):
+			flush()
+			tokens = append(tokens, textToken{text: string(r), cjk: true})
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word = append(word, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+func charTrigrams(word string) []string {
+	runes := []rune("^" + word + "$")
+	if len(runes) < 5 {
+		return nil
+	}
+	grams := make([]string, 0, len(runes)-2)
+	for i := 0; i+3 <= len(runes); i++ {
+		grams = append(grams, string(runes[i:i+3]))
+	}
+	return grams
+}
+
+// HTTPEmbedderConfig points at an OpenAI-compatible /embeddings endpoint.
+type HTTPEmbedderConfig struct {
+	Endpoint string
+	Model    string
+	APIKey   string
+	Client   *http.Client
+}
+
+// HTTPEmbedder calls an OpenAI-compatible embeddings API, such as a local
+// Ollama, llama.cpp or LocalAI server.
+type HTTPEmbedder struct {
+	endpoint string
+	model    string
+	apiKey   string
+	http     *http.Client
+}
+
+func NewHTTPEmbedder(cfg HTTPEmbedderConfig) (*HTTPEmbedder, error) {
+	endpoint := strings.TrimRight(strings.TrimSpace(cfg.Endpoint), "/")
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		return nil, errors.New("embedding endpoint must start with http:// or https://")
+	}
+	if !strings.HasSuffix(endpoint, "/embeddings") {
+		endpoint += "/embeddings"
+	}
+	model := strings.TrimSpace(cfg.Model)
+	if model == "" {
+		return nil, errors.New("embedding model is required")
+	}
+	client := cfg.Client
+	if client == nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+	return &HTTPEmbedder{
+		endpoint: endpoint,
+		model:    model,
+		apiKey:   strings.TrimSpace(cfg.APIKey),
+		http:     client,
+	}, nil
+}
+
+func (e *HTTPEmbedder) Name() string {
+	return "http:" + e.model
+}
+
+func (e *HTTPEmbedder) Remote() bool {
+	return true
+}
+
+func (e *HTTPEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+	body, err := json.Marshal(map[string]any{"model": e.model, "input": texts})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+e.apiKey)
+	}
+
+	resp, err := e.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("embedding endpoint returned HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(snippet)))
+	}
+
+	var payload struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("decode embedding response: %w", err)
+	}
+	if len(payload.Data) != len(texts) {
+		return nil, fmt.Errorf("embedding endpoint returned %d vectors for %d inputs", len(payload.Data), len(texts))
+	}
+	out := make([][]float32, len(texts))
+	for _, item := range payload.Data {
+		if item.Index < 0 || item.Index >= len(texts) || out[item.Index] != nil {
+			return nil, errors.New("embedding endpoint returned an invalid index")
+		}
+		out[item.Index] = item.Embedding
+	}
+	return out, nil
+}
+
+func embeddingText(doc Document) string {
+	var b strings.Builder
+	b.WriteString(doc.Title)
+	b.WriteString("\n")
+	// Repeat the title so short notes are not dominated by body boilerplate.
+	b.WriteString(doc.Title)
+	if len(doc.Tags) > 0 {
+		b.WriteString("\n")
+		b.WriteString(strings.Join(doc.Tags, " "))
+	}
+	if doc.Content != "" {
+		b.WriteString("\n")
+		b.WriteString(doc.Content)
+	}
+	text := b.String()
+	if runes := []rune(text); len(runes) > maxEmbeddingTextRunes {
+		text = string(runes[:maxEmbeddingTextRunes])
+	}
+	return text
+}
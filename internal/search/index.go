@@ -29,6 +29,7 @@ type Service struct {
 	index    bleve.Index
 	path     string
 	inMemory bool
+	vectors  *vectorStore
 }
 
 type Document struct {
@@ -55,9 +56,10 @@ type SearchOptions struct {
 }
 
 func Open(path string) (*Service, error) {
+	vectors := newVectorStore(vectorPath(path), NewHashedEmbedder(0))
 	idx, err := bleve.Open(path)
 	if err == nil {
-		return &Service{index: idx, path: path}, nil
+		return &Service{index: idx, path: path, vectors: vectors}, nil
 	}
 	if err != bleve.ErrorIndexPathDoesNotExist && err != bleve.ErrorIndexMetaMissing {
 		return nil, err
@@ -74,7 +76,7 @@ func Open(path string) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Service{index: idx, path: path}, nil
+	return &Service{index: idx, path: path, vectors: vectors}, nil
 }
 
 func NewMemory() (*Service, error) {
@@ -82,7 +84,16 @@ func NewMemory() (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Service{index: idx, inMemory: true}, nil
+	return &Service{index: idx, inMemory: true, vectors: newVectorStore("", NewHashedEmbedder(0))}, nil
+}
+
+// SetEmbedder swaps the embedding provider used for similarity search.
+// Vectors from a different provider are discarded; call Rebuild afterwards.
+func (s *Service) SetEmbedder(embedder Embedder) {
+	if embedder == nil {
+		return
+	}
+	s.vectors.setEmbedder(embedder)
 }
 
 func (s *Service) Close() error {
@@ -91,7 +102,8 @@ func (s *Service) Close() error {
 	if s.index == nil {
 		return nil
 	}
-	err := s.index.Close()
+	s.vectors.queue.stop()
+	err := errors.Join(s.index.Close(), s.vectors.save())
 	s.index = nil
 	return err
 }
@@ -127,20 +139,30 @@ func (s *Service) Rebuild(ctx context.Context, client *ent.Client) error {
 		_ = old.Close()
 	}
 
-	for _, row := range rows {
-		if err := s.IndexNote(ctx, row); err != nil {
+	docs, err := documentsFromNotes(ctx, rows)
+	if err != nil {
+		return err
+	}
+	for _, doc := range docs {
+		if err := s.IndexDocument(doc); err != nil {
 			return err
 		}
 	}
-	return nil
+	return semanticError(s.vectors.rebuild(ctx, docs))
 }
 
+// IndexNote updates both the keyword index and the note's embedding. Vector
+// failures are wrapped in ErrSemanticIndex after the keyword index succeeds.
+// With a remote embedder the embedding is queued and computed later.
 func (s *Service) IndexNote(ctx context.Context, row *ent.Note) error {
 	doc, err := documentFromNote(ctx, row)
 	if err != nil {
 		return err
 	}
-	return s.IndexDocument(doc)
+	if err := s.IndexDocument(doc); err != nil {
+		return err
+	}
+	return semanticError(s.vectors.index(ctx, doc))
 }
 
 func (s *Service) IndexDocument(doc Document) error {
@@ -160,9 +182,18 @@ func (s *Service) DeleteNote(id uuid.UUID) error {
 	if idx == nil {
 		return errClosed
 	}
+	s.vectors.delete(id)
 	return idx.Delete(id.String())
 }
 
+// Similar ranks the user's notes by embedding similarity to a note or text.
+func (s *Service) Similar(ctx context.Context, opts SimilarOptions) ([]SimilarHit, error) {
+	if s == nil || s.vectors == nil {
+		return nil, ErrSemanticDisabled
+	}
+	return s.vectors.similar(ctx, opts)
+}
+
 func (s *Service) Search(ctx context.Context, opts SearchOptions) ([]uuid.UUID, error) {
 	limit := opts.Limit
 	if limit <= 0 {
@@ -0,0 +1,451 @@
+package search
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"smarticky/ent"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultSimilarLimit    = 10
+	maxSimilarLimit        = 50
+	defaultSimilarMinScore = 0.05
+)
+
+var (
+	// ErrSemanticIndex wraps failures that only affect the vector store, so
+	// callers can keep keyword search running when embeddings are unavailable.
+	ErrSemanticIndex    = errors.New("semantic index error")
+	ErrSemanticDisabled = errors.New("semantic search is not enabled")
+	ErrNoteNotEmbedded  = errors.New("note has no embedding yet")
+)
+
+type SimilarOptions struct {
+	UserID int
+	// NoteID compares against an indexed note; the note itself is excluded.
+	NoteID       uuid.UUID
+	Text         string
+	IncludeTrash bool
+	Limit        int
+	MinScore     float64
+}
+
+type SimilarHit struct {
+	ID    uuid.UUID `json:"id"`
+	Score float64   `json:"score"`
+}
+
+type vectorEntry struct {
+	UserID    int
+	IsDeleted bool
+	Digest    string
+	Vector    []float32
+}
+
+type vectorFile struct {
+	Model   string
+	Entries map[string]vectorEntry
+}
+
+// vectorStore keeps one embedding per note in memory and persists them as a
+// cache next to the bleve index so remote embeddings survive restarts.
+type vectorStore struct {
+	mu       sync.RWMutex
+	path     string
+	embedder Embedder
+	entries  map[uuid.UUID]vectorEntry
+	idf      []float64
+	queue    *embedQueue
+}
+
+func newVectorStore(path string, embedder Embedder) *vectorStore {
+	store := &vectorStore{
+		path:     path,
+		embedder: embedder,
+		entries:  make(map[uuid.UUID]vectorEntry),
+		queue:    newEmbedQueue(embeddingDebounce),
+	}
+	store.load()
+	return store
+}
+
+func vectorPath(indexPath string) string {
+	return strings.TrimSuffix(indexPath, filepath.Ext(indexPath)) + ".vectors"
+}
+
+func (v *vectorStore) load() {
+	if v.path == "" {
+		return
+	}
+	f, err := os.Open(v.path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	var data vectorFile
+	if err := gob.NewDecoder(f).Decode(&data); err != nil || data.Model != v.embedder.Name() {
+		return
+	}
+	for key, entry := range data.Entries {
+		id, err := uuid.Parse(key)
+		if err != nil {
+			continue
+		}
+		v.entries[id] = entry
+	}
+}
+
+func (v *vectorStore) save() error {
+	if v.path == "" {
+		return nil
+	}
+	v.mu.RLock()
+	data := vectorFile{Model: v.embedder.Name(), Entries: make(map[string]vectorEntry, len(v.entries))}
+	for id, entry := range v.entries {
+		data.Entries[id.String()] = entry
+	}
+	v.mu.RUnlock()
+
+	if err := os.MkdirAll(filepath.Dir(v.path), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(v.path), filepath.Base(v.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := gob.NewEncoder(tmp).Encode(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), v.path)
+}
+
+func (v *vectorStore) setEmbedder(embedder Embedder) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.embedder.Name() == embedder.Name() {
+		v.embedder = embedder
+		return
+	}
+	v.embedder = embedder
+	v.entries = make(map[uuid.UUID]vectorEntry)
+	v.idf = nil
+	v.load()
+}
+
+// index updates a note's vector. Remote embedders are called from the
+// background queue; local ones embed right away.
+func (v *vectorStore) index(ctx context.Context, doc Document) error {
+	id, err := uuid.Parse(doc.ID)
+	if err != nil {
+		return err
+	}
+	digest := textDigest(embeddingText(doc))
+
+	v.mu.RLock()
+	existing, ok := v.entries[id]
+	embedder := v.embedder
+	v.mu.RUnlock()
+	if ok && existing.Digest == digest {
+		v.queue.remove(id)
+		existing.UserID = doc.UserID
+		existing.IsDeleted = doc.IsDeleted
+		v.put(id, existing)
+		return nil
+	}
+	if remote, ok := embedder.(remoteEmbedder); ok && remote.Remote() {
+		v.queue.add(v, id, doc)
+		return nil
+	}
+
+	entries, err := v.embedDocuments(ctx, []Document{doc})
+	if err != nil {
+		return err
+	}
+	v.put(id, entries[0])
+	return nil
+}
+
+// embedDocuments embeds docs in one call to the current embedder.
+func (v *vectorStore) embedDocuments(ctx context.Context, docs []Document) ([]vectorEntry, error) {
+	v.mu.RLock()
+	embedder := v.embedder
+	v.mu.RUnlock()
+	texts := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		texts = append(texts, embeddingText(doc))
+	}
+	vectors, err := embedder.Embed(ctx, texts)
+	if err != nil {
+		return nil, err
+	}
+	if len(vectors) != len(docs) {
+		return nil, errors.New("embedder returned a short batch")
+	}
+	entries := make([]vectorEntry, len(docs))
+	for i, doc := range docs {
+		entries[i] = vectorEntry{UserID: doc.UserID, IsDeleted: doc.IsDeleted, Digest: textDigest(texts[i]), Vector: vectors[i]}
+	}
+	return entries, nil
+}
+
+func (v *vectorStore) put(id uuid.UUID, entry vectorEntry) {
+	v.mu.Lock()
+	v.entries[id] = entry
+	v.idf = nil
+	v.mu.Unlock()
+}
+
+func (v *vectorStore) delete(id uuid.UUID) {
+	v.queue.remove(id)
+	v.mu.Lock()
+	delete(v.entries, id)
+	v.idf = nil
+	v.mu.Unlock()
+}
+
+// rebuild re-embeds changed notes in batches. A failed batch keeps any stale
+// vectors it already had rather than leaving those notes unsearchable.
+func (v *vectorStore) rebuild(ctx context.Context, docs []Document) error {
+	v.mu.RLock()
+	old := v.entries
+	embedder := v.embedder
+	v.mu.RUnlock()
+
+	next := make(map[uuid.UUID]vectorEntry, len(docs))
+	type pending struct {
+		id    uuid.UUID
+		entry vectorEntry
+		text  string
+	}
+	var todo []pending
+	for _, doc := range docs {
+		id, err := uuid.Parse(doc.ID)
+		if err != nil {
+			continue
+		}
+		text := embeddingText(doc)
+		entry := vectorEntry{UserID: doc.UserID, IsDeleted: doc.IsDeleted, Digest: textDigest(text)}
+		if prev, ok := old[id]; ok && prev.Digest == entry.Digest {
+			entry.Vector = prev.Vector
+			next[id] = entry
+			continue
+		}
+		todo = append(todo, pending{id: id, entry: entry, text: text})
+	}
+
+	var firstErr error
+	for start := 0; start < len(todo); start += embeddingBatchSize {
+		end := min(start+embeddingBatchSize, len(todo))
+		batch := todo[start:end]
+		texts := make([]string, 0, len(batch))
+		for _, item := range batch {
+			texts = append(texts, item.text)
+		}
+		vectors, err := embedder.Embed(ctx, texts)
+		if err == nil && len(vectors) != len(batch) {
+			err = errors.New("embedder returned a short batch")
+		}
+		for i, item := range batch {
+			if err == nil {
+				item.entry.Vector = vectors[i]
+				next[item.id] = item.entry
+			} else if prev, ok := old[item.id]; ok {
+				prev.UserID = item.entry.UserID
+				prev.IsDeleted = item.entry.IsDeleted
+				next[item.id] = prev
+			}
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	v.mu.Lock()
+	v.entries = next
+	v.idf = nil
+	v.mu.Unlock()
+
+	if err := v.save(); err != nil && firstErr == nil {
+		firstErr = err
+	}
+	return firstErr
+}
+
+func (v *vectorStore) similar(ctx context.Context, opts SimilarOptions) ([]SimilarHit, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = defaultSimilarLimit
+	}
+	if limit > maxSimilarLimit {
+		limit = maxSimilarLimit
+	}
+	minScore := opts.MinScore
+	if minScore <= 0 {
+		minScore = defaultSimilarMinScore
+	}
+
+	var query []float32
+	if opts.NoteID != uuid.Nil {
+		v.mu.RLock()
+		entry, ok := v.entries[opts.NoteID]
+		v.mu.RUnlock()
+		if !ok || entry.UserID != opts.UserID || len(entry.Vector) == 0 {
+			return nil, ErrNoteNotEmbedded
+		}
+		query = entry.Vector
+	} else {
+		text := strings.TrimSpace(opts.Text)
+		if text == "" {
+			return nil, errors.New("similarity query text is required")
+		}
+		v.mu.RLock()
+		embedder := v.embedder
+		v.mu.RUnlock()
+		vectors, err := embedder.Embed(ctx, []string{text})
+		if err != nil {
+			return nil, err
+		}
+		if len(vectors) != 1 {
+			return nil, errors.New("embedder returned no vector")
+		}
+		query = vectors[0]
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	weights := v.weightsLocked()
+	queryNorm := weightedNorm(query, weights)
+	if queryNorm == 0 {
+		return []SimilarHit{}, nil
+	}
+
+	hits := make([]SimilarHit, 0)
+	for id, entry := range v.entries {
+		if id == opts.NoteID || entry.UserID != opts.UserID {
+			continue
+		}
+		if entry.IsDeleted && !opts.IncludeTrash {
+			continue
+		}
+		if len(entry.Vector) != len(query) {
+			continue
+		}
+		norm := weightedNorm(entry.Vector, weights)
+		if norm == 0 {
+			continue
+		}
+		var dot float64
+		for i, value := range entry.Vector {
+			w := 1.0
+			if weights != nil {
+				w = weights[i] * weights[i]
+			}
+			dot += float64(value) * float64(query[i]) * w
+		}
+		score := dot / (norm * queryNorm)
+		if score >= minScore {
+			hits = append(hits, SimilarHit{ID: id, Score: math.Round(score*10000) / 10000})
+		}
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score == hits[j].Score {
+			return hits[i].ID.String() < hits[j].ID.String()
+		}
+		return hits[i].Score > hits[j].Score
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
+// weightsLocked returns per-dimension IDF weights for corpus-weighted
+// embedders, or nil when vectors should be compared as-is.
+func (v *vectorStore) weightsLocked() []float64 {
+	weighted, ok := v.embedder.(corpusWeighted)
+	if !ok || !weighted.CorpusWeighted() {
+		return nil
+	}
+	if v.idf != nil {
+		return v.idf
+	}
+
+	dims := 0
+	for _, entry := range v.entries {
+		dims = max(dims, len(entry.Vector))
+	}
+	df := make([]float64, dims)
+	for _, entry := range v.entries {
+		for i, value := range entry.Vector {
+			if value > 0 {
+				df[i]++
+			}
+		}
+	}
+	total := float64(len(v.entries))
+	idf := make([]float64, dims)
+	for i := range df {
+		idf[i] = math.Log((1+total)/(1+df[i])) + 1
+	}
+	v.idf = idf
+	return idf
+}
+
+func weightedNorm(vector []float32, weights []float64) float64 {
+	var sum float64
+	for i, value := range vector {
+		x := float64(value)
+		if weights != nil {
+			if i >= len(weights) {
+				continue
+			}
+			x *= weights[i]
+		}
+		sum += x * x
+	}
+	return math.Sqrt(sum)
+}
+
+func textDigest(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
+func semanticError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%w: %v", ErrSemanticIndex, err)
+}
+
+func documentsFromNotes(ctx context.Context, rows []*ent.Note) ([]Document, error) {
+	docs := make([]Document, 0, len(rows))
+	for _, row := range rows {
+		doc, err := documentFromNote(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
@@ -0,0 +1,270 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"smarticky/ent/enttest"
+
+	_ "github.com/lib-x/entsqlite"
+)
+
+func TestSimilarRanksRelatedNotesWithHashedEmbeddings(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestSimilarRanksRelatedNotesWithHashedEmbeddings?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	other := client.User.Create().SetUsername("other").SetPasswordHash("hash").SaveX(ctx)
+	source := client.Note.Create().SetTitle("Sourdough starter").SetContent("Feed the sourdough starter with flour and water every morning before baking bread.").SetUserID(owner.ID).SaveX(ctx)
+	related := client.Note.Create().SetTitle("Baking bread").SetContent("My sourdough bread needs a lively starter, flour, water and a long proof.").SetUserID(owner.ID).SaveX(ctx)
+	unrelated := client.Note.Create().SetTitle("Quarterly taxes").SetContent("Submit the VAT return and reconcile invoices with the accountant.").SetUserID(owner.ID).SaveX(ctx)
+	foreign := client.Note.Create().SetTitle("Sourdough starter").SetContent("Feed the sourdough starter with flour and water every morning before baking bread.").SetUserID(other.ID).SaveX(ctx)
+	trashed := client.Note.Create().SetTitle("Old sourdough bread").SetContent("sourdough starter flour water baking bread").SetIsDeleted(true).SetUserID(owner.ID).SaveX(ctx)
+
+	svc, err := NewMemory()
+	if err != nil {
+		t.Fatalf("NewMemory: %v", err)
+	}
+	if err := svc.Rebuild(ctx, client); err != nil {
+		t.Fatalf("Rebuild: %v", err)
+	}
+
+	hits, err := svc.Similar(ctx, SimilarOptions{UserID: owner.ID, NoteID: source.ID, Limit: 10})
+	if err != nil {
+		t.Fatalf("Similar: %v", err)
+	}
+	if len(hits) == 0 || hits[0].ID != related.ID {
+		t.Fatalf("expected %s to rank first, got %v", related.ID, hits)
+	}
+	for _, hit := range hits {
+		switch hit.ID {
+		case source.ID:
+			t.Fatalf("source note must not be returned as related to itself")
+		case foreign.ID:
+			t.Fatalf("another user's note must not be returned")
+		case trashed.ID:
+			t.Fatalf("trashed notes must be excluded by default")
+		case unrelated.ID:
+			if hit.Score >= hits[0].Score {
+				t.Fatalf("expected unrelated note to score below related note, got %v", hits)
+			}
+		}
+	}
+
+	textHits, err := svc.Similar(ctx, SimilarOptions{UserID: owner.ID, Text: "sourdough bakng", Limit: 1})
+	if err != nil {
+		t.Fatalf("Similar text: %v", err)
+	}
+	if len(textHits) != 1 || (textHits[0].ID != source.ID && textHits[0].ID != related.ID) {
+		t.Fatalf("expected typo query to match a sourdough note, got %v", textHits)
+	}
+}
+
+func TestHTTPEmbedderUsesOpenAICompatibleEndpoint(t *testing.T) {
+	var gotAuth, gotModel string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/embeddings" {
+			http.NotFound(w, r)
+			return
+		}
+		gotAuth = r.Header.Get("Authorization")
+		var req struct {
+			Model string   `json:"model"`
+			Input []string `json:"input"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		gotModel = req.Model
+		type item struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		}
+		data := make([]item, 0, len(req.Input))
+		// Answer in reverse order to exercise index handling.
+		for i := len(req.Input) - 1; i >= 0; i-- {
+			vector := []float32{0, 0, 0}
+			switch {
+			case strings.Contains(req.Input[i], "cat"):
+				vector[0] = 1
+			case strings.Contains(req.Input[i], "kitten"):
+				vector[0], vector[1] = 0.9, 0.1
+			default:
+				vector[2] = 1
+			}
+			data = append(data, item{Index: i, Embedding: vector})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	defer server.Close()
+
+	embedder, err := NewHTTPEmbedder(HTTPEmbedderConfig{Endpoint: server.URL + "/v1", Model: "stand-in", APIKey: "secret"})
+	if err != nil {
+		t.Fatalf("NewHTTPEmbedder: %v", err)
+	}
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestHTTPEmbedderUsesOpenAICompatibleEndpoint?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	cat := client.Note.Create().SetTitle("cat").SetUserID(owner.ID).SaveX(ctx)
+	kitten := client.Note.Create().SetTitle("kitten").SetUserID(owner.ID).SaveX(ctx)
+	client.Note.Create().SetTitle("tractor").SetUserID(owner.ID).SaveX(ctx)
+
+	svc, err := NewMemory()
+	if err != nil {
+		t.Fatalf("NewMemory: %v", err)
+	}
+	svc.SetEmbedder(embedder)
+	if err := svc.Rebuild(ctx, client); err != nil {
+		t.Fatalf("Rebuild: %v", err)
+	}
+	if gotAuth != "Bearer secret" || gotModel != "stand-in" {
+		t.Fatalf("expected bearer auth and model, got %q %q", gotAuth, gotModel)
+	}
+
+	hits, err := svc.Similar(ctx, SimilarOptions{UserID: owner.ID, NoteID: cat.ID, Limit: 5, MinScore: 0.5})
+	if err != nil {
+		t.Fatalf("Similar: %v", err)
+	}
+	if len(hits) != 1 || hits[0].ID != kitten.ID {
+		t.Fatalf("expected only kitten to be similar, got %v", hits)
+	}
+}
+
+func TestRemoteEmbeddingsAreQueuedPerNote(t *testing.T) {
+	var mu sync.Mutex
+	var inputs [][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Input []string `json:"input"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		mu.Lock()
+		inputs = append(inputs, req.Input)
+		mu.Unlock()
+		data := make([]map[string]any, 0, len(req.Input))
+		for i := range req.Input {
+			data = append(data, map[string]any{"index": i, "embedding": []float32{1, 0}})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	defer server.Close()
+	calls := func() [][]string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([][]string(nil), inputs...)
+	}
+
+	embedder, err := NewHTTPEmbedder(HTTPEmbedderConfig{Endpoint: server.URL, Model: "stand-in"})
+	if err != nil {
+		t.Fatalf("NewHTTPEmbedder: %v", err)
+	}
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestRemoteEmbeddingsAreQueuedPerNote?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	draft := client.Note.Create().SetTitle("Draft").SetContent("first").SetUserID(owner.ID).SaveX(ctx)
+	dropped := client.Note.Create().SetTitle("Dropped").SetUserID(owner.ID).SaveX(ctx)
+
+	svc, err := NewMemory()
+	if err != nil {
+		t.Fatalf("NewMemory: %v", err)
+	}
+	defer svc.Close()
+	svc.SetEmbedder(embedder)
+	svc.vectors.queue.debounce = time.Hour
+
+	// Saving only touches the keyword index; repeated saves replace the
+	// queued text.
+	for _, content := range []string{"first", "second", "final"} {
+		draft = draft.Update().SetContent(content).SaveX(ctx)
+		if err := svc.IndexNote(ctx, draft); err != nil {
+			t.Fatalf("IndexNote: %v", err)
+		}
+	}
+	if err := svc.IndexNote(ctx, dropped); err != nil {
+		t.Fatalf("IndexNote: %v", err)
+	}
+	if err := svc.DeleteNote(dropped.ID); err != nil {
+		t.Fatalf("DeleteNote: %v", err)
+	}
+	if got := calls(); len(got) != 0 {
+		t.Fatalf("expected no embedding calls while saving, got %v", got)
+	}
+	if ids, err := svc.Search(ctx, SearchOptions{UserID: owner.ID, Query: "final"}); err != nil || len(ids) != 1 {
+		t.Fatalf("keyword search = %v, %v, want the draft", ids, err)
+	}
+	if _, err := svc.Similar(ctx, SimilarOptions{UserID: owner.ID, NoteID: draft.ID}); !errors.Is(err, ErrNoteNotEmbedded) {
+		t.Fatalf("Similar before embedding error = %v, want ErrNoteNotEmbedded", err)
+	}
+
+	svc.vectors.queue.process(ctx, svc.vectors, true)
+	got := calls()
+	if len(got) != 1 || len(got[0]) != 1 || !strings.Contains(got[0][0], "final") {
+		t.Fatalf("expected one call with the latest draft, got %v", got)
+	}
+	if _, err := svc.Similar(ctx, SimilarOptions{UserID: owner.ID, NoteID: draft.ID}); err != nil {
+		t.Fatalf("Similar after embedding: %v", err)
+	}
+}
+
+func TestVectorStorePersistsAndReusesEmbeddings(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:TestVectorStorePersistsAndReusesEmbeddings?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	defer client.Close()
+
+	owner := client.User.Create().SetUsername("owner").SetPasswordHash("hash").SaveX(ctx)
+	client.Note.Create().SetTitle("Persisted").SetContent("vector cache").SetUserID(owner.ID).SaveX(ctx)
+
+	path := filepath.Join(t.TempDir(), "search.bleve")
+	svc, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if err := svc.Rebuild(ctx, client); err != nil {
+		t.Fatalf("Rebuild: %v", err)
+	}
+	if err := svc.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	counting := &countingEmbedder{Embedder: NewHashedEmbedder(0)}
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer reopened.Close()
+	reopened.SetEmbedder(counting)
+	if err := reopened.Rebuild(ctx, client); err != nil {
+		t.Fatalf("Rebuild reopened: %v", err)
+	}
+	if counting.calls != 0 {
+		t.Fatalf("expected cached vectors to be reused, got %d embed calls", counting.calls)
+	}
+}
+
+type countingEmbedder struct {
+	Embedder
+	calls int
+}
+
+func (e *countingEmbedder) CorpusWeighted() bool {
+	return true
+}
+
+func (e *countingEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	e.calls++
+	return e.Embedder.Embed(ctx, texts)
+}
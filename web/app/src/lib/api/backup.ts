@@ -1,7 +1,7 @@
 import { apiFetch } from "./client";
 
-export type BackupTargetType = "webdav" | "s3";
-export type BackupSchedule = "manual" | "daily" | "weekly" | "monthly";
+export type BackupTargetType = "webdav" | "s3" | "local" | "sftp";
+export type BackupSchedule = "manual" | "daily" | "weekly" | "monthly" | "cron";
 export type BackupStatus = "never" | "success" | "failed";
 
 export interface BackupTarget {
@@ -17,6 +17,16 @@ export interface BackupTarget {
   s3_bucket?: string;
   has_s3_access_key: boolean;
   has_s3_secret_key: boolean;
+  local_path?: string;
+  sftp_host?: string;
+  sftp_port?: number;
+  sftp_user?: string;
+  has_sftp_password: boolean;
+  has_sftp_private_key: boolean;
+  sftp_path?: string;
+  sftp_host_key?: string;
+  alert_after_hours: number;
+  drill_interval_days: number;
   last_backup_status: BackupStatus;
   last_backup_error?: string;
   last_backup_at?: string;
@@ -39,6 +49,18 @@ export interface BackupTargetInput {
   s3_bucket?: string;
   s3_access_key?: string;
   s3_secret_key?: string;
+  local_path?: string;
+  sftp_host?: string;
+  sftp_port?: number;
+  sftp_user?: string;
+  sftp_password?: string;
+  sftp_private_key?: string;
+  sftp_path?: string;
+  sftp_host_key?: string;
+  // Alert when no backup succeeded for this many hours; 0 turns it off.
+  alert_after_hours: number;
+  // Restore the latest backup as a test every N days; 0 turns it off.
+  drill_interval_days: number;
 }
 
 export interface BackupTask {
@@ -46,14 +68,25 @@ export interface BackupTask {
   name: string;
   enabled: boolean;
   schedule: BackupSchedule;
+  cron_expression?: string;
+  timezone?: string;
   retention_days: number;
   max_count: number;
+  keep_hourly: number;
+  keep_daily: number;
+  keep_weekly: number;
+  keep_monthly: number;
+  keep_yearly: number;
   target_ids: number[];
   targets: BackupTarget[];
+  encryption_enabled: boolean;
+  has_encryption_passphrase: boolean;
+  incremental: boolean;
   last_backup_status: BackupStatus;
   last_backup_error?: string;
   last_backup_at?: string;
   next_run_at?: string;
+  next_runs: string[];
   created_at: string;
   updated_at: string;
 }
@@ -62,9 +95,27 @@ export interface BackupTaskInput {
   name: string;
   enabled: boolean;
   schedule: BackupSchedule;
+  cron_expression?: string;
+  // IANA time zone name; empty uses the server's time zone.
+  timezone?: string;
   retention_days: number;
   max_count: number;
+  // Grandfather-father-son buckets; 0 disables a bucket.
+  keep_hourly: number;
+  keep_daily: number;
+  keep_weekly: number;
+  keep_monthly: number;
+  keep_yearly: number;
   target_ids: number[];
+  encryption_enabled: boolean;
+  // Leave empty to keep the stored passphrase.
+  encryption_passphrase?: string;
+  incremental: boolean;
+}
+
+export interface BackupSchedulePreview {
+  timezone: string;
+  next_runs: string[];
 }
 
 export interface BackupConnectionTestResponse {
@@ -77,6 +128,37 @@ export interface BackupFileInfo {
   filename: string;
   size: number;
   created_at: string;
+  encrypted: boolean;
+  snapshot: boolean;
+  pinned: boolean;
+  pin_note?: string;
+}
+
+export type BackupRetentionReason =
+  | "pinned"
+  | "last"
+  | "within"
+  | "hourly"
+  | "daily"
+  | "weekly"
+  | "monthly"
+  | "yearly";
+
+export interface BackupRetentionFile extends BackupFileInfo {
+  reasons?: BackupRetentionReason[];
+}
+
+export interface BackupRetentionTargetPreview {
+  target_id: number;
+  name: string;
+  keep: BackupRetentionFile[];
+  delete: BackupRetentionFile[];
+  error?: string;
+}
+
+export interface BackupRetentionPreview {
+  task_id: number;
+  targets: BackupRetentionTargetPreview[];
 }
 
 export interface BackupListResponse {
@@ -97,6 +179,81 @@ export interface BackupRunResponse {
   results: BackupTargetRunResult[];
 }
 
+export interface BackupRunHistoryEntry {
+  id: number;
+  task_id?: number;
+  task_name?: string;
+  target_id?: number;
+  target_name?: string;
+  target_type?: BackupTargetType;
+  trigger: "manual" | "scheduled";
+  status: "success" | "failed";
+  filename?: string;
+  bytes: number;
+  error?: string;
+  started_at: string;
+  finished_at: string;
+  duration_ms: number;
+}
+
+export interface BackupRunHistory {
+  runs: BackupRunHistoryEntry[];
+  next_before?: number;
+}
+
+export interface BackupRunHistoryQuery {
+  task_id?: number;
+  target_id?: number;
+  status?: "success" | "failed";
+  limit?: number;
+  before?: number;
+}
+
+export interface BackupDrillCheck {
+  name: string;
+  ok: boolean;
+  detail?: string;
+}
+
+export interface BackupDrillCount {
+  table: string;
+  backup: number;
+  live: number;
+}
+
+export interface BackupDrill {
+  id: number;
+  target_id: number;
+  filename?: string;
+  trigger: "manual" | "scheduled";
+  status: "passed" | "failed";
+  error?: string;
+  checks: BackupDrillCheck[];
+  counts: BackupDrillCount[];
+  started_at: string;
+  finished_at: string;
+  duration_ms: number;
+}
+
+export interface BackupAlertSettings {
+  has_webhook_url: boolean;
+  smtp_host: string;
+  smtp_port: number;
+  smtp_from: string;
+  smtp_to: string[];
+  on_failure: boolean;
+}
+
+export interface BackupAlertSettingsInput {
+  // Leave undefined to keep the stored URL; an empty string removes it.
+  webhook_url?: string;
+  smtp_host: string;
+  smtp_port: number;
+  smtp_from: string;
+  smtp_to: string[];
+  on_failure: boolean;
+}
+
 export interface BackupRestoreResponse {
   message: string;
   warning?: string;
@@ -118,6 +275,18 @@ export interface BackupVerificationResult {
   total_size: number;
   file_count: number;
   verified_at: string;
+  encrypted: boolean;
+  passphrase_required?: boolean;
+  snapshot: boolean;
+  manifest?: BackupManifest;
+}
+
+export interface BackupManifest {
+  version: number;
+  app_version: string;
+  schema_hash: string;
+  created_at: string;
+  tables: Record<string, number>;
 }
 
 export function listBackupTargets(): Promise<BackupTarget[]> {
@@ -179,6 +348,15 @@ export function createBackupTask(task: BackupTaskInput): Promise<BackupTask> {
   });
 }
 
+export function previewBackupSchedule(
+  schedule: Pick<BackupTaskInput, "schedule" | "cron_expression" | "timezone">,
+): Promise<BackupSchedulePreview> {
+  return apiFetch<BackupSchedulePreview>("/backup/tasks/schedule-preview", {
+    method: "POST",
+    body: JSON.stringify(schedule),
+  });
+}
+
 export function updateBackupTask(
   id: number,
   task: BackupTaskInput,
@@ -201,21 +379,93 @@ export function runBackupTask(id: number): Promise<BackupRunResponse> {
   });
 }
 
+export function listBackupRuns(query: BackupRunHistoryQuery = {}): Promise<BackupRunHistory> {
+  const params = new URLSearchParams();
+  for (const [key, value] of Object.entries(query)) {
+    if (value !== undefined) params.set(key, String(value));
+  }
+  const search = params.toString();
+  return apiFetch<BackupRunHistory>(`/backup/runs${search ? `?${search}` : ""}`);
+}
+
+export function getBackupAlertSettings(): Promise<BackupAlertSettings> {
+  return apiFetch<BackupAlertSettings>("/backup/alerts");
+}
+
+export function updateBackupAlertSettings(
+  settings: BackupAlertSettingsInput,
+): Promise<BackupAlertSettings> {
+  return apiFetch<BackupAlertSettings>("/backup/alerts", {
+    method: "PUT",
+    body: JSON.stringify(settings),
+  });
+}
+
+export function testBackupAlerts(): Promise<{ ok: boolean; error?: string }> {
+  return apiFetch<{ ok: boolean; error?: string }>("/backup/alerts/test", {
+    method: "POST",
+  });
+}
+
 export function listBackupFiles(
   targetId: number,
 ): Promise<BackupListResponse> {
   return apiFetch<BackupListResponse>(`/backup/targets/${targetId}/files`);
 }
 
+// runBackupDrill restores the latest backup of a target into a scratch
+// directory and checks it; the live data is not touched.
+export function runBackupDrill(targetId: number): Promise<BackupDrill> {
+  return apiFetch<BackupDrill>(`/backup/targets/${targetId}/drill`, { method: "POST" });
+}
+
+export async function listBackupDrills(targetId: number, limit = 20): Promise<BackupDrill[]> {
+  const response = await apiFetch<{ drills: BackupDrill[] }>(
+    `/backup/targets/${targetId}/drills?limit=${limit}`,
+  );
+  return response.drills;
+}
+
+export function pinBackupFile(
+  targetId: number,
+  filename: string,
+  note: string,
+): Promise<void> {
+  return apiFetch<void>(`/backup/targets/${targetId}/pins`, {
+    method: "POST",
+    body: JSON.stringify({ filename, note }),
+  });
+}
+
+export function unpinBackupFile(targetId: number, filename: string): Promise<void> {
+  return apiFetch<void>(
+    `/backup/targets/${targetId}/pins/${encodeURIComponent(filename)}`,
+    { method: "DELETE" },
+  );
+}
+
+// previewBackupRetention lists what the next cleanup would delete; the
+// optional policy overrides the saved one.
+export function previewBackupRetention(
+  taskId: number,
+  policy?: Partial<BackupTaskInput>,
+): Promise<BackupRetentionPreview> {
+  return apiFetch<BackupRetentionPreview>(`/backup/tasks/${taskId}/retention-preview`, {
+    method: "POST",
+    body: JSON.stringify(policy ?? {}),
+  });
+}
+
 export function verifyBackupFile(
   targetId: number,
   filename: string,
+  passphrase = "",
 ): Promise<BackupVerificationResult> {
   return apiFetch<BackupVerificationResult>(
     `/backup/targets/${targetId}/verify`,
     {
       method: "POST",
-      body: JSON.stringify({ filename }),
+      body: JSON.stringify({ filename, passphrase }),
     },
   );
 }
@@ -224,12 +474,126 @@ export function restoreBackupFile(
   targetId: number,
   filename: string,
   confirmation: string,
+  passphrase = "",
 ): Promise<BackupRestoreResponse> {
   return apiFetch<BackupRestoreResponse>(
     `/backup/targets/${targetId}/restore`,
     {
       method: "POST",
-      body: JSON.stringify({ filename, confirmation }),
+      body: JSON.stringify({ filename, confirmation, passphrase }),
+    },
+  );
+}
+
+export interface BackupRestoreUser {
+  id: number;
+  username: string;
+  nickname: string;
+  note_count: number;
+  folder_count: number;
+  live_user_id?: number;
+}
+
+export interface BackupRestoreSession {
+  id: string;
+  target_id: number;
+  filename: string;
+  snapshot: boolean;
+  expires_at: string;
+  users: BackupRestoreUser[];
+}
+
+export interface BackupRestoreFolder {
+  id: string;
+  name: string;
+  parent_id?: string;
+  exists: boolean;
+}
+
+export interface BackupRestoreNote {
+  id: string;
+  title: string;
+  folder_id?: string;
+  is_deleted: boolean;
+  attachments: number;
+  updated_at: string;
+  exists: boolean;
+  live_updated_at?: string;
+}
+
+export interface BackupRestoreItems {
+  folders: BackupRestoreFolder[];
+  notes: BackupRestoreNote[];
+}
+
+export type BackupImportMode = "copy" | "overwrite";
+
+export interface BackupImportInput {
+  user_id: number;
+  all?: boolean;
+  folder_ids?: string[];
+  note_ids?: string[];
+  target_user_id?: number;
+  target_folder_id?: string;
+  mode: BackupImportMode;
+  dry_run: boolean;
+}
+
+export interface BackupImportItem {
+  kind: "user" | "folder" | "note" | "tag" | "whiteboard" | "attachment";
+  source_id: string;
+  target_id?: string;
+  name: string;
+  action: "created" | "overwritten" | "skipped";
+  conflict?: string;
+}
+
+export interface BackupImportReport {
+  dry_run: boolean;
+  mode: BackupImportMode;
+  target_user_id: number;
+  created: number;
+  overwritten: number;
+  skipped: number;
+  conflicts: number;
+  items: BackupImportItem[];
+}
+
+export function openBackupRestoreSession(
+  targetId: number,
+  filename: string,
+  passphrase = "",
+): Promise<BackupRestoreSession> {
+  return apiFetch<BackupRestoreSession>("/backup/restore-sessions", {
+    method: "POST",
+    body: JSON.stringify({ target_id: targetId, filename, passphrase }),
+  });
+}
+
+export function listBackupRestoreItems(
+  sessionId: string,
+  userId: number,
+): Promise<BackupRestoreItems> {
+  return apiFetch<BackupRestoreItems>(
+    `/backup/restore-sessions/${sessionId}/users/${userId}`,
+  );
+}
+
+export function importBackupRestoreItems(
+  sessionId: string,
+  input: BackupImportInput,
+): Promise<BackupImportReport> {
+  return apiFetch<BackupImportReport>(
+    `/backup/restore-sessions/${sessionId}/import`,
+    {
+      method: "POST",
+      body: JSON.stringify(input),
     },
   );
 }
+
+export function closeBackupRestoreSession(sessionId: string): Promise<void> {
+  return apiFetch<void>(`/backup/restore-sessions/${sessionId}`, {
+    method: "DELETE",
+  });
+}
@@ -1,6 +1,14 @@
 import { apiFetch } from "./client";
 
-export type NoteConnectionProvider = "siyuan" | "notion" | "joplin";
+export type NoteConnectionProvider =
+  | "siyuan"
+  | "notion"
+  | "joplin"
+  | "vault"
+  | "git"
+  | "trilium"
+  | "memos"
+  | "standardfile";
 export type NoteConnectionStatus = "never" | "success" | "failed";
 
 export interface NoteConnectionAccount {
@@ -9,17 +17,26 @@ export interface NoteConnectionAccount {
   provider: NoteConnectionProvider;
   endpoint: string;
   enabled: boolean;
-  auth_type: "token";
+  auth_type: "token" | "oauth" | "none";
   has_credentials: boolean;
   default_target_id: string;
   default_target_name: string;
   last_test_status: NoteConnectionStatus;
   last_test_error?: string;
   last_test_at?: string;
+  sync_interval_minutes: number;
+  next_sync_at?: string;
+  webhook_enabled: boolean;
   created_at: string;
   updated_at: string;
 }
 
+export interface NoteConnectionWebhookSecret {
+  account_id: number;
+  path: string;
+  secret: string;
+}
+
 export interface NoteConnectionAccountInput {
   name: string;
   provider: NoteConnectionProvider;
@@ -29,6 +46,7 @@ export interface NoteConnectionAccountInput {
   default_target_name: string;
   enabled: boolean;
   clear_credentials?: boolean;
+  sync_interval_minutes: number;
 }
 
 export interface NoteConnectionTarget {
@@ -42,37 +60,64 @@ export interface NoteConnectionJob {
   id: number;
   account_id: number;
   provider: NoteConnectionProvider;
-  operation: "import" | "push";
-  status: "pending" | "running" | "completed" | "completed_with_errors" | "failed";
+  operation: "import" | "push" | "sync";
+  status: "previewed" | "pending" | "running" | "completed" | "completed_with_errors" | "failed" | "canceled";
+  trigger?: "manual" | "scheduled" | "webhook";
+  attempts?: number;
+  max_attempts?: number;
+  next_attempt_at?: string;
   total_count?: number;
   imported_count?: number;
   pushed_count?: number;
   skipped_count?: number;
+  conflict_count?: number;
   failed_count?: number;
   message?: string;
   created_at?: string;
   completed_at?: string;
 }
 
-export interface NoteConnectionImportResult {
+export interface NoteConnectionImportPreviewItem {
+  external_id: string;
+  title: string;
+  path?: string;
+  folder: string;
+  tags: string[];
+  updated_at?: string;
+  duplicate?: "linked" | "title";
+  note_id?: string;
+}
+
+export interface NoteConnectionImportPreviewFolder {
+  path: string;
+  note_count: number;
+  local_folder_id?: string;
+}
+
+export interface NoteConnectionImportPreview {
+  job: NoteConnectionJob;
   job_id: number;
-  status: string;
   total_count: number;
-  imported_count: number;
-  skipped_count: number;
-  failed_count: number;
+  duplicate_count: number;
+  items: NoteConnectionImportPreviewItem[];
+  folders: NoteConnectionImportPreviewFolder[];
 }
 
-export interface NoteConnectionPushResult {
-  job_id: number;
-  status: string;
-  result: {
-    external_id: string;
-    target_id?: string;
-    path?: string;
-    url?: string;
-  };
-  finished_at: string;
+export interface NoteConnectionConflict {
+  id: number;
+  account_id: number;
+  note_id: string;
+  external_id: string;
+  local_title: string;
+  local_content: string;
+  local_updated_at: string;
+  remote_title: string;
+  remote_content: string;
+  remote_updated_at?: string;
+  status: "open" | "resolved";
+  resolution?: "local" | "remote";
+  created_at: string;
+  resolved_at?: string;
 }
 
 export async function listNoteConnectionAccounts(): Promise<NoteConnectionAccount[]> {
@@ -133,8 +178,8 @@ export async function importFromNoteConnection(
   targetId: string,
   limit: number,
   preserveRemoteHierarchy = true,
-): Promise<NoteConnectionImportResult> {
-  return apiFetch<NoteConnectionImportResult>(`/note-connections/accounts/${id}/import`, {
+): Promise<NoteConnectionJob> {
+  return apiFetch<NoteConnectionJob>(`/note-connections/accounts/${id}/import`, {
     method: "POST",
     body: JSON.stringify({
       target_id: targetId,
@@ -144,17 +189,122 @@ export async function importFromNoteConnection(
   });
 }
 
+export async function previewNoteConnectionImport(
+  id: number,
+  targetId: string,
+  limit: number,
+  preserveRemoteHierarchy = true,
+): Promise<NoteConnectionImportPreview> {
+  return apiFetch<NoteConnectionImportPreview>(`/note-connections/accounts/${id}/import/preview`, {
+    method: "POST",
+    body: JSON.stringify({
+      target_id: targetId,
+      limit,
+      preserve_remote_hierarchy: preserveRemoteHierarchy,
+    }),
+  });
+}
+
+export async function confirmNoteConnectionImport(
+  id: number,
+  jobId: number,
+  externalIds: string[],
+  folderMap: Record<string, string>,
+): Promise<NoteConnectionJob> {
+  return apiFetch<NoteConnectionJob>(`/note-connections/accounts/${id}/import/confirm`, {
+    method: "POST",
+    body: JSON.stringify({
+      job_id: jobId,
+      external_ids: externalIds,
+      folder_map: folderMap,
+    }),
+  });
+}
+
 export async function pushNoteToConnection(
   id: number,
   noteId: string,
   targetId: string,
-): Promise<NoteConnectionPushResult> {
-  return apiFetch<NoteConnectionPushResult>(`/note-connections/accounts/${id}/push`, {
+): Promise<NoteConnectionJob> {
+  return apiFetch<NoteConnectionJob>(`/note-connections/accounts/${id}/push`, {
     method: "POST",
     body: JSON.stringify({ note_id: noteId, target_id: targetId }),
   });
 }
 
+export async function syncNoteConnection(id: number): Promise<NoteConnectionJob> {
+  return apiFetch<NoteConnectionJob>(`/note-connections/accounts/${id}/sync`, {
+    method: "POST",
+  });
+}
+
+export async function rotateNoteConnectionWebhook(id: number): Promise<NoteConnectionWebhookSecret> {
+  return apiFetch<NoteConnectionWebhookSecret>(`/note-connections/accounts/${id}/webhook`, {
+    method: "POST",
+  });
+}
+
+export async function disableNoteConnectionWebhook(id: number): Promise<NoteConnectionAccount> {
+  return apiFetch<NoteConnectionAccount>(`/note-connections/accounts/${id}/webhook`, {
+    method: "DELETE",
+  });
+}
+
+export async function listNoteConnectionConflicts(id: number): Promise<NoteConnectionConflict[]> {
+  return apiFetch<NoteConnectionConflict[]>(`/note-connections/accounts/${id}/conflicts`);
+}
+
+export async function resolveNoteConnectionConflict(
+  id: number,
+  conflictId: number,
+  keep: "local" | "remote",
+): Promise<NoteConnectionConflict> {
+  return apiFetch<NoteConnectionConflict>(
+    `/note-connections/accounts/${id}/conflicts/${conflictId}/resolve`,
+    {
+      method: "POST",
+      body: JSON.stringify({ keep }),
+    },
+  );
+}
+
+export interface NoteConnectionOAuthProviders {
+  notion: boolean;
+}
+
+export async function listNoteConnectionOAuthProviders(): Promise<NoteConnectionOAuthProviders> {
+  return apiFetch<NoteConnectionOAuthProviders>("/note-connections/oauth");
+}
+
+export async function startNotionOAuth(accountId?: number): Promise<{ authorize_url: string }> {
+  return apiFetch<{ authorize_url: string }>("/note-connections/oauth/notion/start", {
+    method: "POST",
+    body: JSON.stringify(accountId ? { account_id: accountId } : {}),
+  });
+}
+
+export async function completeNotionOAuth(
+  code: string,
+  state: string,
+): Promise<NoteConnectionAccount> {
+  return apiFetch<NoteConnectionAccount>("/note-connections/oauth/notion/complete", {
+    method: "POST",
+    body: JSON.stringify({ code, state }),
+  });
+}
+
 export async function listNoteConnectionJobs(): Promise<NoteConnectionJob[]> {
   return apiFetch<NoteConnectionJob[]>("/note-connections/jobs");
 }
+
+export async function getNoteConnectionJob(id: number): Promise<NoteConnectionJob> {
+  return apiFetch<NoteConnectionJob>(`/note-connections/jobs/${id}`);
+}
+
+export async function cancelNoteConnectionJob(id: number): Promise<NoteConnectionJob> {
+  return apiFetch<NoteConnectionJob>(`/note-connections/jobs/${id}/cancel`, { method: "POST" });
+}
+
+export function isFinishedNoteConnectionJob(job: NoteConnectionJob): boolean {
+  return job.status !== "pending" && job.status !== "running";
+}
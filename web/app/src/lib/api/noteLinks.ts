@@ -1,7 +1,17 @@
 import { apiFetch } from "./client";
-import type { NoteLinkGraph } from "./types";
+import type { LinkRewrite, NoteLinkGraph } from "./types";
 
 export function fetchNoteLinkGraph(includeTrash = false): Promise<NoteLinkGraph> {
   const query = includeTrash ? "?include_trash=true" : "";
   return apiFetch<NoteLinkGraph>(`/note-links${query}`);
 }
+
+export function rewriteRenamedNoteLinks(
+  noteID: string,
+  oldTitle: string,
+): Promise<LinkRewrite> {
+  return apiFetch<LinkRewrite>(`/notes/${noteID}/rename-links`, {
+    method: "POST",
+    body: JSON.stringify({ old_title: oldTitle }),
+  });
+}
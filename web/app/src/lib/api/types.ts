@@ -96,6 +96,38 @@ export interface NoteLinkGraph {
   edges: NoteLinkGraphEdge[];
 }
 
+export interface LinkRenameBacklink {
+  source_note_id: UUID;
+  source_title: string;
+  occurrence_count: number;
+}
+
+export interface LinkRenameOffer {
+  note_id: UUID;
+  old_title: string;
+  new_title: string;
+  backlinks: LinkRenameBacklink[];
+}
+
+export interface LinkRewrite {
+  id: UUID;
+  note_id: UUID;
+  old_title: string;
+  new_title: string;
+  status: string;
+  rewritten_count: number;
+  updated_note_ids: UUID[];
+  created_at: string;
+  undone_at?: string;
+}
+
+// NoteUpdateResult is a saved note plus what happened to backlinks that used
+// its previous title.
+export interface NoteUpdateResult extends Note {
+  link_rewrite?: LinkRewrite;
+  link_rewrite_offer?: LinkRenameOffer;
+}
+
 export interface Folder {
   id: UUID;
   name: string;
@@ -16,6 +16,8 @@ interface InputRequest {
   message?: string;
   initialValue?: string;
   placeholder?: string;
+  // secret masks the input and keeps surrounding whitespace.
+  secret?: boolean;
   confirmLabel: string;
   cancelLabel: string;
   requiredMessage: string;
@@ -1,6 +1,6 @@
 import { get, writable } from "svelte/store";
 import { apiFetch } from "../api/client";
-import type { Note, ProtectionMode } from "../api/types";
+import type { Note, NoteUpdateResult, ProtectionMode } from "../api/types";
 import type { CalendarTimeBasis } from "../calendar/noteCalendar";
 import { preferencesStore, t } from "./preferences";
 
@@ -179,12 +179,14 @@ export function createNotesStore() {
   async function updateNote(
     noteId: string,
     fields: NoteProtectionUpdateFields,
-  ): Promise<Note> {
-    const updated = await apiFetch<Note>(`/notes/${noteId}`, {
+  ): Promise<NoteUpdateResult> {
+    const updated = await apiFetch<NoteUpdateResult>(`/notes/${noteId}`, {
       method: "PUT",
       body: JSON.stringify(fields),
     });
-    applyUpdatedNote(updated);
+    const { link_rewrite: _rewrite, link_rewrite_offer: _offer, ...note } =
+      updated;
+    applyUpdatedNote(note);
     return updated;
   }
 
@@ -426,15 +428,19 @@ export function createNotesStore() {
       }));
       await loadNotesAndCalendar();
     },
-    async updateSelected(fields: NoteUpdateFields) {
+    async updateSelected(
+      fields: NoteUpdateFields,
+    ): Promise<NoteUpdateResult | null> {
       const state = get({ subscribe });
-      if (!state.selected) return;
+      if (!state.selected) return null;
 
       const selectedID = state.selected.id;
       update((current) => ({ ...current, error: "" }));
-      await updateNote(selectedID, fields);
+      return updateNote(selectedID, fields);
     },
-    async updateProtection(fields: NoteProtectionUpdateFields): Promise<Note | null> {
+    async updateProtection(
+      fields: NoteProtectionUpdateFields,
+    ): Promise<NoteUpdateResult | null> {
       const state = get({ subscribe });
       if (!state.selected) return null;
 
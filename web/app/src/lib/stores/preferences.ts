@@ -45,8 +45,57 @@ const messages = {
     backupListTitle: "备份文件",
     backupManual: "手动",
     backupMonthly: "每月（1 日）",
+    backupCron: "自定义（cron）",
+    backupCronExpression: "cron 表达式",
+    backupCronHelp: "分 时 日 月 周，例如 30 3 * * 1-5 表示工作日 03:30。",
+    backupTimezone: "时区",
+    backupServerTimezone: "服务器时区",
+    backupUpcomingRuns: "接下来的运行时间",
     backupMaxCount: "最大备份数",
     backupMaxCountHelp: "仅保留最近的 N 个备份，0 表示不限制",
+    backupGFS: "分级保留（GFS）",
+    backupGFSHelp: "设置任一项后，备份只要满足保留天数、最近 N 个或下列任一规则就会保留，其余的会被删除。",
+    backupKeepHourly: "每小时",
+    backupKeepDaily: "每天",
+    backupKeepWeekly: "每周",
+    backupKeepMonthly: "每月",
+    backupKeepYearly: "每年",
+    backupRetentionPreview: "预览清理",
+    backupRetentionKeep: "保留",
+    backupRetentionDelete: "删除",
+    backupPin: "固定",
+    backupUnpin: "取消固定",
+    backupPinned: "已固定",
+    backupPinNote: "固定原因",
+    backupPinNotePlaceholder: "例如：升级前的备份",
+    backupPinNoteRequired: "请填写固定原因",
+    backupRunHistory: "运行记录",
+    backupNoRuns: "暂无备份运行记录",
+    backupOneOffRun: "单次备份",
+    backupTriggerScheduled: "定时",
+    backupTriggerManual: "手动",
+    loadMore: "加载更多",
+    backupAlerts: "备份告警",
+    backupAlertsHelp: "备份失败或目标超过告警时长没有成功备份时，通过 Webhook 或邮件通知。邮件通过无需认证的本地 SMTP 中继发送。",
+    backupAlertOnFailure: "备份失败时告警",
+    backupAlertWebhook: "Webhook 地址",
+    backupAlertWebhookSaved: "已保存，留空则保持不变",
+    backupAlertWebhookRemove: "移除 Webhook",
+    backupAlertSMTPHost: "SMTP 中继主机",
+    backupAlertSMTPFrom: "发件人",
+    backupAlertSMTPTo: "收件人（逗号分隔）",
+    backupAlertTest: "发送测试告警",
+    backupAlertTestSent: "测试告警已发送",
+    backupAlertAfterHours: "未成功备份告警（小时）",
+    backupAlertAfterHoursHelp: "超过该时长没有成功备份时发送告警，0 表示关闭",
+    backupDrill: "恢复演练",
+    backupDrillRun: "恢复演练",
+    backupDrillPassed: "恢复演练通过",
+    backupDrillFailed: "恢复演练失败",
+    backupDrillBackupRows: "备份",
+    backupDrillLiveRows: "当前",
+    backupDrillInterval: "恢复演练间隔（天）",
+    backupDrillIntervalHelp: "定期把最新备份恢复到临时目录并检查，0 表示关闭",
     backupNever: "从未运行",
     backupNoFiles: "暂无备份文件",
     backupNoTargets: "暂无远程存储目标",
@@ -67,8 +116,41 @@ const messages = {
     backupTasks: "备份任务",
     backupTitle: "备份与恢复",
     backupTotalSize: "总大小",
+    backupAppVersion: "备份时版本",
+    backupDatabaseRows: "数据库记录数",
     backupVerify: "验证",
     backupVerifyConfirm: "验证备份",
+    backupEncrypted: "已加密",
+    backupIncremental: "增量备份",
+    backupIncrementalHelp: "附件按内容只上传一次，每次备份仅包含数据库和附件清单。开启加密时附件也会用同一口令加密",
+    backupSnapshot: "增量快照",
+    backupBrowse: "浏览",
+    backupBrowseTitle: "选择性恢复",
+    backupBrowseUser: "备份中的用户",
+    backupBrowseFolders: "文件夹",
+    backupBrowseNotes: "笔记",
+    backupBrowseEmpty: "没有内容",
+    backupBrowseExists: "当前仍存在",
+    backupBrowseTrashed: "回收站",
+    backupImportMode: "恢复方式",
+    backupImportCopy: "恢复为新副本",
+    backupImportOverwrite: "覆盖同一条目",
+    backupImportTargetUser: "恢复到用户",
+    backupImportSameUser: "同名用户（不存在则重建）",
+    backupImportPreview: "预览",
+    backupImportSubmit: "恢复所选",
+    backupImportCopyConfirm: "所选条目将以新副本导入，现有数据不会被修改。",
+    backupImportOverwriteConfirm: "现有的同一条目会被备份中的版本覆盖，此操作无法撤销。",
+    backupImportDone: "所选内容已恢复",
+    backupImportCreated: "新建",
+    backupImportOverwritten: "覆盖",
+    backupImportSkipped: "跳过",
+    backupImportConflicts: "冲突",
+    backupEncryption: "加密备份",
+    backupPassphrase: "加密口令",
+    backupPassphraseHelp: "至少 8 个字符，留空则保留当前口令。遗失口令将无法恢复备份",
+    backupPassphrasePrompt: "此备份已加密，请输入加密口令",
+    backupPassphraseRequired: "请输入加密口令",
     backupVerifyFailed: "备份验证失败",
     backupVerifySuccess: "备份有效",
     backupWeekly: "每周（周日）",
@@ -244,6 +326,12 @@ const messages = {
     lazycatUidHint: "用于懒猫微服内应用委托访问 MCP 时映射到当前笔记用户。",
     lazycatUidPlaceholder: "例如：lazycat",
     lightTheme: "浅色",
+    linkRewriteDone: "已更新双链",
+    linkRewriteFailed: "双链更新失败",
+    linkRewriteOfferConfirm: "更新双链",
+    linkRewriteOfferMessage: "仍使用旧标题的双链",
+    linkRewriteOfferSkip: "保持不变",
+    linkRewriteOfferTitle: "更新指向这篇笔记的双链？",
     loadFailed: "加载失败",
     loadNotesFailed: "无法加载笔记，请稍后重试",
     loading: "加载中",
@@ -286,33 +374,98 @@ const messages = {
     noteConnectionAccounts: "互联账户",
     noteConnectionAdd: "新增账户",
     noteConnectionApiToken: "API Token",
+    noteConnectionConflictLocal: "本地版本",
+    noteConnectionConflictRemote: "远端版本",
+    noteConnectionConflicts: "同步冲突",
+    noteConnectionConflictsHint: "两边都修改过的笔记会在这里保留两个版本，选择要保留的一份。",
+    noteConnectionDays: "天",
     noteConnectionDefaultTarget: "默认目标位置",
     noteConnectionDeleteConfirm: "确认删除这个互联账户？本地笔记不会被删除。",
     noteConnectionEdit: "编辑账户",
     noteConnectionEndpoint: "服务地址",
     noteConnectionEndpointHint: "本地服务地址需要 Smarticky 服务端能够访问。",
+    noteConnectionHours: "小时",
     noteConnectionImport: "从账户导入",
     noteConnectionImportLimit: "导入数量上限",
     noteConnectionImportSuccess: "导入完成",
     noteConnectionImportTarget: "导入来源",
+    noteConnectionJobCanceled: "已取消",
+    noteConnectionJobPreviewed: "待确认",
+    noteConnectionJobQueued: "已加入后台任务队列",
+    noteConnectionJobRetrying: "等待重试",
+    noteConnectionJobScheduled: "定时",
+    noteConnectionJobWebhook: "Webhook",
     noteConnectionJobs: "最近同步记录",
+    noteConnectionKeepLocal: "保留本地",
+    noteConnectionKeepRemote: "保留远端",
+    noteConnectionMinutes: "分钟",
+    noteConnectionNextSync: "下次同步",
     noteConnectionNoAccounts: "暂无互联账户",
+    noteConnectionNoConflicts: "暂无待处理的冲突",
     noteConnectionNoJobs: "暂无同步记录",
     noteConnectionNoTargets: "暂无可选目标",
+    noteConnectionNotionConnect: "通过 Notion 授权",
+    noteConnectionNotionConnected: "Notion 已连接",
+    noteConnectionNotionConnectFailed: "Notion 授权失败",
+    noteConnectionOAuthCredential: "已通过 OAuth 授权",
     noteConnectionPreserveHierarchy: "保留远端目录结构",
     noteConnectionPreserveHierarchyHint: "远端层级更深时自动创建本地笔记本组，并提升系统层级上限。",
+    noteConnectionPreview: "预览",
+    noteConnectionPreviewDiscard: "放弃预览",
+    noteConnectionPreviewDuplicateLinked: "已导入",
+    noteConnectionPreviewDuplicateTitle: "同名笔记已存在",
+    noteConnectionPreviewDuplicates: "重复",
+    noteConnectionPreviewEmpty: "没有可导入的笔记",
+    noteConnectionPreviewFolderExisting: "放入现有笔记本组",
+    noteConnectionPreviewFolderNew: "新建笔记本组",
+    noteConnectionPreviewFolders: "笔记本组映射",
+    noteConnectionPreviewImport: "导入所选",
+    noteConnectionPreviewNotes: "笔记",
     noteConnectionProvider: "服务商",
     noteConnectionPush: "同步到云笔记",
     noteConnectionPushSuccess: "同步完成",
+    noteConnectionReconnect: "重新授权",
+    noteConnectionRepository: "仓库地址",
+    noteConnectionRepositoryHint: "服务端上 Git 仓库的绝对路径或 file:// 地址。带工作区的仓库直接提交；裸仓库会克隆到数据目录并在每次提交后推送回去。",
     noteConnectionSavedCredential: "已保存凭据",
     noteConnectionSelectAccount: "选择账户",
     noteConnectionSelectTarget: "选择目标位置",
+    noteConnectionStandardFile: "导出文件地址",
+    noteConnectionStandardFileHint: "解密后的 Standard Notes 备份文件的 http(s) 地址，例如 WebDAV 上的 JSON 文件。推送会整体写回这个文件。",
+    noteConnectionStandardFileToken: "可选。填写 用户名:密码 使用 Basic 认证，其他内容作为 Bearer token 发送。",
+    noteConnectionSync: "双向同步",
+    noteConnectionSyncConflicts: "冲突",
+    noteConnectionSyncInterval: "自动同步",
+    noteConnectionSyncIntervalHint: "按间隔在后台执行双向同步。",
+    noteConnectionSyncOff: "关闭",
+    noteConnectionSyncPulled: "拉取",
+    noteConnectionSyncPushed: "推送",
+    noteConnectionSyncSuccess: "同步完成",
     noteConnectionTarget: "目标位置",
     noteConnectionTokenPlaceholder: "输入访问 token",
     noteConnectionUnsupportedImport: "这个服务暂不支持批量导入",
+    noteConnectionVaultFolder: "仓库文件夹",
+    noteConnectionVaultFolderHint: "挂载到服务端的 Obsidian 或 Logseq 仓库的绝对路径，需位于允许的仓库目录下（默认是数据目录中的 vaults）。",
+    noteConnectionWebhook: "Webhook",
+    noteConnectionWebhookCopied: "已复制",
+    noteConnectionWebhookCopyFailed: "复制失败",
+    noteConnectionWebhookCreate: "生成密钥",
+    noteConnectionWebhookDisable: "停用 Webhook",
+    noteConnectionWebhookEnabled: "Webhook 已启用，远端变更会立即刷新已关联的笔记。",
+    noteConnectionWebhookHint: "向接收地址 POST {\"external_ids\": [\"远端笔记 ID\"]}，带上 X-Smarticky-Timestamp（Unix 秒）和唯一的 X-Smarticky-Delivery，并用 Authorization: Bearer 密钥验证，或在 X-Smarticky-Signature 头里放 sha256= 加「时间戳.投递 ID.请求体」的 HMAC-SHA256 签名。超过 5 分钟或重复的投递会被拒绝，只会刷新已关联的笔记。",
+    noteConnectionWebhookOff: "未启用 Webhook，远端变更只能通过同步获取。",
+    noteConnectionWebhookOneTime: "请立即复制，此密钥只显示一次。",
+    noteConnectionWebhookRotate: "更换密钥",
+    noteConnectionWebhookRotateConfirm: "更换后旧密钥立即失效，确认继续？",
+    noteConnectionWebhookUrl: "接收地址",
     notion: "Notion",
     siyuan: "思源笔记",
     joplin: "Joplin",
+    markdownVault: "Markdown 仓库",
+    gitRepository: "Git 仓库",
+    trilium: "Trilium",
+    memos: "Memos",
+    standardNotesExport: "Standard Notes 导出",
     newNote: "新建笔记",
     noteCreateFailed: "新建笔记失败",
     noteContent: "笔记正文",
@@ -451,6 +604,14 @@ const messages = {
     s3Endpoint: "端点地址",
     s3Region: "区域",
     s3SecretKey: "密钥",
+    localDirectory: "本地目录",
+    localPath: "目录路径",
+    sftpHost: "SFTP 主机",
+    sftpPort: "端口",
+    sftpPrivateKey: "私钥（可选）",
+    sftpPath: "远程目录",
+    sftpHostKey: "主机密钥指纹（留空则首次连接时固定）",
+    sftpHostKeyMismatch: "SFTP 主机密钥与已固定的指纹不一致。如果服务器密钥是有意更换的，请清空主机密钥指纹后重试。",
     systemDefault: "系统默认",
     systemFonts: "系统字体",
     tags: "标签",
@@ -553,8 +714,57 @@ const messages = {
     backupListTitle: "Backup files",
     backupManual: "Manual",
     backupMonthly: "Monthly (day 1)",
+    backupCron: "Custom (cron)",
+    backupCronExpression: "Cron expression",
+    backupCronHelp: "minute hour day month weekday, e.g. 30 3 * * 1-5 for 03:30 on weekdays.",
+    backupTimezone: "Time zone",
+    backupServerTimezone: "Server time zone",
+    backupUpcomingRuns: "Upcoming runs",
     backupMaxCount: "Maximum backups",
     backupMaxCountHelp: "Keep only the most recent N backups. 0 means no limit",
+    backupGFS: "Grandfather-father-son retention",
+    backupGFSHelp: "When any bucket is set, a backup is kept if it is within the retention days, among the latest N, or the newest of one of these periods; everything else is deleted.",
+    backupKeepHourly: "Hourly",
+    backupKeepDaily: "Daily",
+    backupKeepWeekly: "Weekly",
+    backupKeepMonthly: "Monthly",
+    backupKeepYearly: "Yearly",
+    backupRetentionPreview: "Preview cleanup",
+    backupRetentionKeep: "keep",
+    backupRetentionDelete: "delete",
+    backupPin: "Pin",
+    backupUnpin: "Unpin",
+    backupPinned: "Pinned",
+    backupPinNote: "Reason",
+    backupPinNotePlaceholder: "e.g. before the upgrade",
+    backupPinNoteRequired: "Enter a reason for pinning",
+    backupRunHistory: "Run history",
+    backupNoRuns: "No backup runs yet",
+    backupOneOffRun: "One-off backup",
+    backupTriggerScheduled: "Scheduled",
+    backupTriggerManual: "Manual",
+    loadMore: "Load more",
+    backupAlerts: "Backup alerts",
+    backupAlertsHelp: "Get a webhook call or an email when a backup fails or a target has gone longer than its alert window without a successful backup. Mail goes through a local SMTP relay without authentication.",
+    backupAlertOnFailure: "Alert when a backup fails",
+    backupAlertWebhook: "Webhook URL",
+    backupAlertWebhookSaved: "Saved. Leave empty to keep it",
+    backupAlertWebhookRemove: "Remove webhook",
+    backupAlertSMTPHost: "SMTP relay host",
+    backupAlertSMTPFrom: "Sender",
+    backupAlertSMTPTo: "Recipients (comma separated)",
+    backupAlertTest: "Send test alert",
+    backupAlertTestSent: "Test alert sent",
+    backupAlertAfterHours: "Alert after (hours without success)",
+    backupAlertAfterHoursHelp: "Alert when no backup succeeded for this long. 0 turns it off",
+    backupDrill: "Restore drill",
+    backupDrillRun: "Restore drill",
+    backupDrillPassed: "Restore drill passed",
+    backupDrillFailed: "Restore drill failed",
+    backupDrillBackupRows: "backup",
+    backupDrillLiveRows: "live",
+    backupDrillInterval: "Restore drill interval (days)",
+    backupDrillIntervalHelp: "Regularly restore the latest backup into a scratch directory and check it. 0 turns it off",
     backupNever: "Never run",
     backupNoFiles: "No backup files found",
     backupNoTargets: "No remote storage targets",
@@ -575,8 +785,41 @@ const messages = {
     backupTasks: "Backup tasks",
     backupTitle: "Backup & Restore",
     backupTotalSize: "Total size",
+    backupAppVersion: "Backed up by version",
+    backupDatabaseRows: "Database rows",
     backupVerify: "Verify",
     backupVerifyConfirm: "Verify backup",
+    backupEncrypted: "Encrypted",
+    backupIncremental: "Incremental backups",
+    backupIncrementalHelp: "Attachments are uploaded once by content; each backup holds only the database and an attachment manifest. With encryption on, attachments are sealed with the same passphrase",
+    backupSnapshot: "Snapshot",
+    backupBrowse: "Browse",
+    backupBrowseTitle: "Selective restore",
+    backupBrowseUser: "User in backup",
+    backupBrowseFolders: "Folders",
+    backupBrowseNotes: "Notes",
+    backupBrowseEmpty: "Nothing here",
+    backupBrowseExists: "still exists",
+    backupBrowseTrashed: "in trash",
+    backupImportMode: "Restore as",
+    backupImportCopy: "New copies",
+    backupImportOverwrite: "Overwrite the same items",
+    backupImportTargetUser: "Restore into user",
+    backupImportSameUser: "User with the same name (recreated if missing)",
+    backupImportPreview: "Preview",
+    backupImportSubmit: "Restore selected",
+    backupImportCopyConfirm: "The selected items will be imported as new copies. Existing data is left untouched.",
+    backupImportOverwriteConfirm: "Existing items will be replaced by their backed-up versions. This cannot be undone.",
+    backupImportDone: "Selected items restored",
+    backupImportCreated: "Created",
+    backupImportOverwritten: "Overwritten",
+    backupImportSkipped: "Skipped",
+    backupImportConflicts: "Conflicts",
+    backupEncryption: "Encrypt backups",
+    backupPassphrase: "Encryption passphrase",
+    backupPassphraseHelp: "At least 8 characters. Leave blank to keep the current passphrase. Backups cannot be restored without it",
+    backupPassphrasePrompt: "This backup is encrypted. Enter its passphrase",
+    backupPassphraseRequired: "Enter the encryption passphrase",
     backupVerifyFailed: "Backup verification failed",
     backupVerifySuccess: "Backup is valid",
     backupWeekly: "Weekly (Sunday)",
@@ -752,6 +995,12 @@ const messages = {
     lazycatUidHint: "Maps delegated LazyCat MCP access to this notes user.",
     lazycatUidPlaceholder: "Example: lazycat",
     lightTheme: "Light",
+    linkRewriteDone: "Links updated",
+    linkRewriteFailed: "Failed to update links",
+    linkRewriteOfferConfirm: "Update links",
+    linkRewriteOfferMessage: "Links still using the old title",
+    linkRewriteOfferSkip: "Leave as is",
+    linkRewriteOfferTitle: "Update links to this note?",
     loadFailed: "Load failed",
     loadNotesFailed: "Could not load notes. Try again later",
     loading: "Loading",
@@ -794,33 +1043,98 @@ const messages = {
     noteConnectionAccounts: "Connected accounts",
     noteConnectionAdd: "Add account",
     noteConnectionApiToken: "API token",
+    noteConnectionConflictLocal: "Local version",
+    noteConnectionConflictRemote: "Remote version",
+    noteConnectionConflicts: "Sync conflicts",
+    noteConnectionConflictsHint: "Notes changed on both sides keep both versions here until you pick one.",
+    noteConnectionDays: "days",
     noteConnectionDefaultTarget: "Default target",
     noteConnectionDeleteConfirm: "Delete this connected account? Local notes will not be deleted.",
     noteConnectionEdit: "Edit account",
     noteConnectionEndpoint: "Endpoint",
     noteConnectionEndpointHint: "Local service endpoints must be reachable from the Smarticky server.",
+    noteConnectionHours: "hours",
     noteConnectionImport: "Import from account",
     noteConnectionImportLimit: "Import limit",
     noteConnectionImportSuccess: "Import completed",
     noteConnectionImportTarget: "Import source",
+    noteConnectionJobCanceled: "Canceled",
+    noteConnectionJobPreviewed: "Awaiting confirmation",
+    noteConnectionJobQueued: "Queued in the background",
+    noteConnectionJobRetrying: "Retrying",
+    noteConnectionJobScheduled: "Scheduled",
+    noteConnectionJobWebhook: "Webhook",
     noteConnectionJobs: "Recent sync jobs",
+    noteConnectionKeepLocal: "Keep local",
+    noteConnectionKeepRemote: "Keep remote",
+    noteConnectionMinutes: "minutes",
+    noteConnectionNextSync: "Next sync",
     noteConnectionNoAccounts: "No connected accounts",
+    noteConnectionNoConflicts: "No open conflicts",
     noteConnectionNoJobs: "No sync jobs yet",
     noteConnectionNoTargets: "No targets available",
+    noteConnectionNotionConnect: "Connect with Notion",
+    noteConnectionNotionConnected: "Notion connected",
+    noteConnectionNotionConnectFailed: "Could not connect Notion",
+    noteConnectionOAuthCredential: "Authorized with OAuth",
     noteConnectionPreserveHierarchy: "Preserve remote hierarchy",
     noteConnectionPreserveHierarchyHint: "Create local notebook groups from deeper remote paths and raise the system depth limit when needed.",
+    noteConnectionPreview: "Preview",
+    noteConnectionPreviewDiscard: "Discard preview",
+    noteConnectionPreviewDuplicateLinked: "Already imported",
+    noteConnectionPreviewDuplicateTitle: "Same title exists",
+    noteConnectionPreviewDuplicates: "duplicates",
+    noteConnectionPreviewEmpty: "No notes to import",
+    noteConnectionPreviewFolderExisting: "Use existing notebook group",
+    noteConnectionPreviewFolderNew: "Create notebook group",
+    noteConnectionPreviewFolders: "Notebook groups",
+    noteConnectionPreviewImport: "Import selected",
+    noteConnectionPreviewNotes: "notes",
     noteConnectionProvider: "Provider",
     noteConnectionPush: "Push to cloud notes",
     noteConnectionPushSuccess: "Push completed",
+    noteConnectionReconnect: "Reconnect",
+    noteConnectionRepository: "Repository",
+    noteConnectionRepositoryHint: "Absolute path or file:// URL of a Git repository on the server. Repositories with a working tree are committed to directly; bare ones are cloned into the data directory and pushed back after every commit.",
     noteConnectionSavedCredential: "Credential saved",
     noteConnectionSelectAccount: "Select account",
     noteConnectionSelectTarget: "Select target",
+    noteConnectionStandardFile: "Export file URL",
+    noteConnectionStandardFileHint: "http(s) URL of a decrypted Standard Notes backup, such as a JSON file on a WebDAV share. Pushes write the whole file back.",
+    noteConnectionStandardFileToken: "Optional. Enter user:password for basic auth; anything else is sent as a bearer token.",
+    noteConnectionSync: "Two-way sync",
+    noteConnectionSyncConflicts: "Conflicts",
+    noteConnectionSyncInterval: "Auto sync",
+    noteConnectionSyncIntervalHint: "Runs a two-way sync in the background at this interval.",
+    noteConnectionSyncOff: "Off",
+    noteConnectionSyncPulled: "Pulled",
+    noteConnectionSyncPushed: "Pushed",
+    noteConnectionSyncSuccess: "Sync completed",
     noteConnectionTarget: "Target",
     noteConnectionTokenPlaceholder: "Enter access token",
     noteConnectionUnsupportedImport: "Bulk import is not supported by this provider yet",
+    noteConnectionVaultFolder: "Vault folder",
+    noteConnectionVaultFolderHint: "Absolute path of an Obsidian or Logseq vault mounted on the server. It must be inside an allowed vault root (by default, vaults in the data directory).",
+    noteConnectionWebhook: "Webhook",
+    noteConnectionWebhookCopied: "Copied",
+    noteConnectionWebhookCopyFailed: "Copy failed",
+    noteConnectionWebhookCreate: "Create secret",
+    noteConnectionWebhookDisable: "Disable webhook",
+    noteConnectionWebhookEnabled: "Webhook is on; remote changes refresh linked notes right away.",
+    noteConnectionWebhookHint: "POST {\"external_ids\": [\"remote note id\"]} to the endpoint with X-Smarticky-Timestamp (unix seconds) and a unique X-Smarticky-Delivery. Authenticate with Authorization: Bearer and the secret, or sign \"timestamp.delivery.body\" with HMAC-SHA256 and send sha256= plus the hex digest in X-Smarticky-Signature. Deliveries more than 5 minutes old or seen before are refused. Only linked notes are refreshed.",
+    noteConnectionWebhookOff: "Webhook is off; remote changes arrive only through syncs.",
+    noteConnectionWebhookOneTime: "Copy it now; the secret is only shown once.",
+    noteConnectionWebhookRotate: "Rotate secret",
+    noteConnectionWebhookRotateConfirm: "The old secret stops working immediately. Continue?",
+    noteConnectionWebhookUrl: "Endpoint URL",
     notion: "Notion",
     siyuan: "SiYuan",
     joplin: "Joplin",
+    markdownVault: "Markdown Vault",
+    gitRepository: "Git repository",
+    trilium: "Trilium",
+    memos: "Memos",
+    standardNotesExport: "Standard Notes Export",
     newNote: "New note",
     noteCreateFailed: "Failed to create note",
     noteContent: "Note content",
@@ -959,6 +1273,14 @@ const messages = {
     s3Endpoint: "Endpoint",
     s3Region: "Region",
     s3SecretKey: "Secret key",
+    localDirectory: "Local directory",
+    localPath: "Directory path",
+    sftpHost: "SFTP host",
+    sftpPort: "Port",
+    sftpPrivateKey: "Private key (optional)",
+    sftpPath: "Remote directory",
+    sftpHostKey: "Host key fingerprint (pinned on first connection when empty)",
+    sftpHostKeyMismatch: "The SFTP host key does not match the pinned fingerprint. Clear the fingerprint and test again if the server key was changed on purpose.",
     systemDefault: "System default",
     systemFonts: "System fonts",
     tags: "Tags",